@@ -122,6 +122,7 @@ BINARIES := docgen:./cmd/docgen \
 	dynamic-rp:./cmd/dynamic-rp \
 	ucpd:./cmd/ucpd \
 	controller:./cmd/controller \
+	connection-agent:./cmd/connection-agent \
 	testrp:./test/testrp \
 	magpiego:./test/magpiego
 
@@ -104,6 +104,7 @@ APPS_MAP := ucpd:./deploy/images/ucpd \
 	applications-rp:./deploy/images/applications-rp \
 	dynamic-rp:./deploy/images/dynamic-rp \
 	controller:./deploy/images/controller \
+	connection-agent:./deploy/images/connection-agent \
 	testrp:./test/testrp \
 	magpiego:./test/magpiego
 
@@ -26,10 +26,13 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/rp/health"
 	"github.com/radius-project/radius/pkg/server"
 
 	"github.com/radius-project/radius/pkg/components/hosting"
@@ -63,6 +66,15 @@ var rootCmd = &cobra.Command{
 		// Must set the logger before using controller-runtime.
 		runtimelog.SetLogger(logger)
 
+		httpclient.ConfigureProxy(options.Config.Proxy)
+
+		// Reload the settings which can be changed at runtime when the configuration file changes.
+		options.ConfigWatcher = hostoptions.NewConfigWatcher(configFilePath, hostoptions.LoadConfig)
+		options.ConfigWatcher.OnChange(func(ctx context.Context, config *hostoptions.ProviderConfig) error {
+			httpclient.ConfigureProxy(config.Proxy)
+			return ucplog.SetLevel(&config.Logging)
+		})
+
 		services := []hosting.Service{}
 		if options.Config.MetricsProvider.Enabled {
 			services = append(services, &metricsservice.Service{Options: &options.Config.MetricsProvider})
@@ -76,15 +88,26 @@ var rootCmd = &cobra.Command{
 			services = append(services, &traceservice.Service{Options: &options.Config.TracerProvider})
 		}
 
-		builders, err := builders(options)
+		config, err := controllerconfig.New(options)
 		if err != nil {
 			return err
 		}
 
+		// The resource providers register the health evaluators of their resource types when their builders are created.
+		builders := builders(config)
+
+		if options.Config.HealthChecks.Enabled {
+			services = append(services, health.NewService(databaseprovider.FromOptions(options.Config.DatabaseProvider), config.HealthRegistry, health.Options{
+				Interval: options.Config.HealthChecks.Interval,
+				Timeout:  options.Config.HealthChecks.Timeout,
+			}))
+		}
+
 		services = append(
 			services,
 			server.NewAPIService(options, builders),
 			server.NewAsyncWorker(options, builders),
+			options.ConfigWatcher,
 		)
 
 		host := &hosting.Host{
@@ -107,17 +130,12 @@ func Execute() {
 	cobra.CheckErr(rootCmd.ExecuteContext(context.Background()))
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig) []builder.Builder {
 	return []builder.Builder{
 		corerp_setup.SetupNamespace(config).GenerateBuilder(),
 		daprrp_setup.SetupNamespace(config).GenerateBuilder(),
 		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
 		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
 }
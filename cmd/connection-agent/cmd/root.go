@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/radius-project/radius/pkg/corerp/connectionagent"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/rest"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "connection-agent",
+	Short: "Radius connection agent",
+	Long:  `Fetches the values of the connections of a container from the Radius control plane and writes them to files.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		outputDirectory, err := cmd.Flags().GetString("output-dir")
+		if err != nil {
+			return err
+		}
+
+		refreshInterval, err := cmd.Flags().GetDuration("refresh-interval")
+		if err != nil {
+			return err
+		}
+
+		connectionArgs, err := cmd.Flags().GetStringArray("connection")
+		if err != nil {
+			return err
+		}
+
+		connections := map[string]string{}
+		for _, arg := range connectionArgs {
+			name, resourceID, ok := strings.Cut(arg, "=")
+			if !ok || name == "" || resourceID == "" {
+				return fmt.Errorf("invalid connection %q, expected format is <name>=<resource id>", arg)
+			}
+			connections[name] = resourceID
+		}
+
+		logger, flush, err := ucplog.NewLogger("connection-agent", &ucplog.LoggingOptions{Level: "info", Json: true})
+		if err != nil {
+			return err
+		}
+		defer flush()
+
+		ctx := logr.NewContext(cmd.Context(), logger)
+
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load in-cluster configuration: %w", err)
+		}
+
+		connection, err := sdk.NewKubernetesConnectionFromConfig(config)
+		if err != nil {
+			return err
+		}
+
+		agent := &connectionagent.Agent{
+			Client:          connectionagent.NewUCPResourceClient(connection),
+			Connections:     connections,
+			OutputDirectory: outputDirectory,
+		}
+
+		return agent.Run(ctx, refreshInterval)
+	},
+}
+
+func Execute() {
+	rootCmd.Flags().String("output-dir", connectionagent.DefaultOutputDirectory, "The directory where the connection values are written.")
+	rootCmd.Flags().StringArray("connection", []string{}, "A connection to fetch, in the format <name>=<resource id>. Can be specified multiple times.")
+	rootCmd.Flags().Duration("refresh-interval", 0, "The interval at which the connection values are refreshed. The values are fetched once if not specified.")
+
+	cobra.CheckErr(rootCmd.ExecuteContext(context.Background()))
+}
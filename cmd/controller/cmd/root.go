@@ -29,6 +29,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/hosting"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
 	"github.com/radius-project/radius/pkg/controller"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -62,6 +63,8 @@ var rootCmd = &cobra.Command{
 
 		logger.Info("Loaded options", "configfile", configFilePath)
 
+		httpclient.ConfigureProxy(options.Config.Proxy)
+
 		services := []hosting.Service{
 			&controller.Service{Options: options, TLSCertDir: tlsCertDir},
 		}
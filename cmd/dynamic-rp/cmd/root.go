@@ -27,6 +27,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/hosting"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/dynamicrp"
 	"github.com/radius-project/radius/pkg/dynamicrp/server"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -62,6 +63,15 @@ var rootCmd = &cobra.Command{
 		// Must set the logger before using controller-runtime.
 		runtimelog.SetLogger(logger)
 
+		httpclient.ConfigureProxy(options.Config.Proxy)
+
+		// Reload the settings which can be changed at runtime when the configuration file changes.
+		options.ConfigWatcher = hostoptions.NewConfigWatcher(configFilePath, dynamicrp.LoadConfig)
+		options.ConfigWatcher.OnChange(func(ctx context.Context, config *dynamicrp.Config) error {
+			httpclient.ConfigureProxy(config.Proxy)
+			return ucplog.SetLevel(&config.Logging)
+		})
+
 		host, err := server.NewServer(options)
 		if err != nil {
 			return err
@@ -17,23 +17,18 @@ limitations under the License.
 package cmd
 
 import (
-	"github.com/radius-project/radius/pkg/cli/bicep"
-	"github.com/radius-project/radius/pkg/cli/output"
-	"github.com/radius-project/radius/pkg/version"
 	"github.com/spf13/cobra"
 )
 
-var bicepDownloadCmd = &cobra.Command{
-	Use:   "download",
-	Short: "Download the bicep compiler",
-	Long:  `Downloads the bicep compiler locally`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		output.LogInfo("Downloading Bicep for channel %s...", version.Channel())
-		err := bicep.DownloadBicep()
-		return err
-	},
+func init() {
+	RootCmd.AddCommand(planeCmd)
+	planeCmd.PersistentFlags().StringP("workspace", "w", "", "The workspace name")
 }
 
-func init() {
-	bicepCmd.AddCommand(bicepDownloadCmd)
+func NewPlaneCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "plane",
+		Short: "Manage planes",
+		Long:  `Manage planes`,
+	}
 }
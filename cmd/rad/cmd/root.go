@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/acarl005/stripansi"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
@@ -30,35 +31,55 @@ import (
 	"github.com/radius-project/radius/pkg/cli/azure"
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	cmd_admin "github.com/radius-project/radius/pkg/cli/cmd/admin"
+	admin_inspectoperation "github.com/radius-project/radius/pkg/cli/cmd/admin/inspectoperation"
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
+	app_devprofile "github.com/radius-project/radius/pkg/cli/cmd/app/devprofile"
+	app_export "github.com/radius-project/radius/pkg/cli/cmd/app/export"
 	app_graph "github.com/radius-project/radius/pkg/cli/cmd/app/graph"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
+	app_promote "github.com/radius-project/radius/pkg/cli/cmd/app/promote"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
+	bicep_download "github.com/radius-project/radius/pkg/cli/cmd/bicep/download"
 	bicep_generate_kubernetes_manifest "github.com/radius-project/radius/pkg/cli/cmd/bicep/generatekubernetesmanifest"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	bicep_publishextension "github.com/radius-project/radius/pkg/cli/cmd/bicep/publishextension"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
+	cmd_delete "github.com/radius-project/radius/pkg/cli/cmd/delete"
+	delete_orphanedresources "github.com/radius-project/radius/pkg/cli/cmd/delete/orphanedresources"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_delete "github.com/radius-project/radius/pkg/cli/cmd/env/delete"
 	env_switch "github.com/radius-project/radius/pkg/cli/cmd/env/envswitch"
+	env_graph "github.com/radius-project/radius/pkg/cli/cmd/env/graph"
+	env_kubeconfig "github.com/radius-project/radius/pkg/cli/cmd/env/kubeconfig"
 	env_list "github.com/radius-project/radius/pkg/cli/cmd/env/list"
 	"github.com/radius-project/radius/pkg/cli/cmd/env/namespace"
 	env_show "github.com/radius-project/radius/pkg/cli/cmd/env/show"
 	env_update "github.com/radius-project/radius/pkg/cli/cmd/env/update"
+	"github.com/radius-project/radius/pkg/cli/cmd/exec"
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/cmd/logs"
+	plane_show "github.com/radius-project/radius/pkg/cli/cmd/plane/show"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
+	recipe_plan "github.com/radius-project/radius/pkg/cli/cmd/recipe/plan"
+	recipe_refreshdefaults "github.com/radius-project/radius/pkg/cli/cmd/recipe/refreshdefaults"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
+	recipe_test "github.com/radius-project/radius/pkg/cli/cmd/recipe/test"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_graph "github.com/radius-project/radius/pkg/cli/cmd/resource/graph"
+	resource_history "github.com/radius-project/radius/pkg/cli/cmd/resource/history"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
+	resource_rotatesecret "github.com/radius-project/radius/pkg/cli/cmd/resource/rotatesecret"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
+	resource_yaml "github.com/radius-project/radius/pkg/cli/cmd/resource/yaml"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
 	resourceprovider_delete "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/delete"
 	resourceprovider_list "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/list"
@@ -68,11 +89,18 @@ import (
 	resourcetype_list "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/list"
 	resourcetype_show "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/show"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
+	cmd_telemetry "github.com/radius-project/radius/pkg/cli/cmd/telemetry"
+	telemetry_disable "github.com/radius-project/radius/pkg/cli/cmd/telemetry/disable"
+	telemetry_enable "github.com/radius-project/radius/pkg/cli/cmd/telemetry/enable"
+	telemetry_status "github.com/radius-project/radius/pkg/cli/cmd/telemetry/status"
 	"github.com/radius-project/radius/pkg/cli/cmd/uninstall"
 	uninstall_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/uninstall/kubernetes"
+	cmd_version "github.com/radius-project/radius/pkg/cli/cmd/version"
 	workspace_create "github.com/radius-project/radius/pkg/cli/cmd/workspace/create"
 	workspace_delete "github.com/radius-project/radius/pkg/cli/cmd/workspace/delete"
 	workspace_list "github.com/radius-project/radius/pkg/cli/cmd/workspace/list"
+	workspace_ping "github.com/radius-project/radius/pkg/cli/cmd/workspace/ping"
+	workspace_repair "github.com/radius-project/radius/pkg/cli/cmd/workspace/repair"
 	workspace_show "github.com/radius-project/radius/pkg/cli/cmd/workspace/show"
 	workspace_switch "github.com/radius-project/radius/pkg/cli/cmd/workspace/switch"
 	"github.com/radius-project/radius/pkg/cli/config"
@@ -85,6 +113,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/kubernetes/portforward"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/telemetry"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -114,6 +143,7 @@ const (
 
 var applicationCmd = NewAppCommand()
 var resourceCmd = NewResourceCommand()
+var planeCmd = NewPlaneCommand()
 var resourceProviderCmd = NewResourceProviderCommand()
 var resourceTypeCmd = NewResourceTypeCommand()
 var recipeCmd = NewRecipeCommand()
@@ -164,7 +194,9 @@ func Execute() error {
 	spanName := getRootSpanName()
 	ctx, span := tr.Start(ctx, spanName)
 	defer span.End()
-	err = RootCmd.ExecuteContext(ctx)
+	start := time.Now()
+	cmd, err := RootCmd.ExecuteContextC(ctx)
+	recordUsage(ctx, cmd, time.Since(start), err == nil)
 	if clierrors.IsFriendlyError(err) {
 		fmt.Println(err.Error())
 		fmt.Println("") // Output an extra blank line for readability
@@ -186,6 +218,26 @@ func Execute() error {
 	return nil
 }
 
+// recordUsage records an anonymous usage event for the command when the user has opted in to telemetry. Failures are
+// ignored so that telemetry never affects the result of a command.
+func recordUsage(ctx context.Context, cmd *cobra.Command, duration time.Duration, success bool) {
+	if cmd == nil || ConfigHolder.Config == nil {
+		return
+	}
+
+	section, err := cli.ReadTelemetrySection(ConfigHolder.Config)
+	if err != nil || !section.Enabled {
+		return
+	}
+
+	recorder, err := telemetry.NewRecorder(ConfigHolder.Config)
+	if err != nil {
+		return
+	}
+
+	_ = recorder.Record(ctx, section, telemetry.NewEvent(cmd.CommandPath(), duration, success))
+}
+
 func initTracer() (func(context.Context) error, error) {
 	// Intialize the tracer provider
 	tp := sdktrace.NewTracerProvider(
@@ -218,7 +270,7 @@ func init() {
 
 func initSubCommands() {
 	framework := &framework.Impl{
-		Bicep:             &bicep.Impl{},
+		Bicep:             &bicep.Impl{ConfigProvider: func() *viper.Viper { return ConfigHolder.Config }},
 		ConnectionFactory: connections.DefaultFactory,
 		ConfigHolder:      ConfigHolder,
 		Deploy:            &deploy.Impl{},
@@ -242,6 +294,12 @@ func initSubCommands() {
 	runCmd, _ := run.NewCommand(framework)
 	RootCmd.AddCommand(runCmd)
 
+	logsCmd, _ := logs.NewCommand(framework)
+	RootCmd.AddCommand(logsCmd)
+
+	execCmd, _ := exec.NewCommand(framework)
+	RootCmd.AddCommand(execCmd)
+
 	resourceShowCmd, _ := resource_show.NewCommand(framework)
 	resourceCmd.AddCommand(resourceShowCmd)
 
@@ -254,6 +312,21 @@ func initSubCommands() {
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
+	resourceHistoryCmd, _ := resource_history.NewCommand(framework)
+	resourceCmd.AddCommand(resourceHistoryCmd)
+
+	resourceGraphCmd, _ := resource_graph.NewCommand(framework)
+	resourceCmd.AddCommand(resourceGraphCmd)
+
+	resourceYAMLCmd, _ := resource_yaml.NewCommand(framework)
+	resourceCmd.AddCommand(resourceYAMLCmd)
+
+	resourceRotateSecretCmd, _ := resource_rotatesecret.NewCommand(framework)
+	resourceCmd.AddCommand(resourceRotateSecretCmd)
+
+	planeShowCmd, _ := plane_show.NewCommand(framework)
+	planeCmd.AddCommand(planeShowCmd)
+
 	resourceProviderShowCmd, _ := resourceprovider_show.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderShowCmd)
 
@@ -281,12 +354,21 @@ func initSubCommands() {
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
+	planRecipeCmd, _ := recipe_plan.NewCommand(framework)
+	recipeCmd.AddCommand(planRecipeCmd)
+
 	registerRecipeCmd, _ := recipe_register.NewCommand(framework)
 	recipeCmd.AddCommand(registerRecipeCmd)
 
+	refreshDefaultsRecipeCmd, _ := recipe_refreshdefaults.NewCommand(framework)
+	recipeCmd.AddCommand(refreshDefaultsRecipeCmd)
+
 	showRecipeCmd, _ := recipe_show.NewCommand(framework)
 	recipeCmd.AddCommand(showRecipeCmd)
 
+	testRecipeCmd, _ := recipe_test.NewCommand(framework)
+	recipeCmd.AddCommand(testRecipeCmd)
+
 	unregisterRecipeCmd, _ := recipe_unregister.NewCommand(framework)
 	recipeCmd.AddCommand(unregisterRecipeCmd)
 
@@ -305,12 +387,18 @@ func initSubCommands() {
 	envDeleteCmd, _ := env_delete.NewCommand(framework)
 	envCmd.AddCommand(envDeleteCmd)
 
+	envGraphCmd, _ := env_graph.NewCommand(framework)
+	envCmd.AddCommand(envGraphCmd)
+
 	envListCmd, _ := env_list.NewCommand(framework)
 	envCmd.AddCommand(envListCmd)
 
 	envShowCmd, _ := env_show.NewCommand(framework)
 	envCmd.AddCommand(envShowCmd)
 
+	envKubeconfigCmd, _ := env_kubeconfig.NewCommand(framework)
+	envCmd.AddCommand(envKubeconfigCmd)
+
 	envUpdateCmd, _ := env_update.NewCommand(framework)
 	envCmd.AddCommand(envUpdateCmd)
 
@@ -323,6 +411,12 @@ func initSubCommands() {
 	workspaceListCmd, _ := workspace_list.NewCommand(framework)
 	workspaceCmd.AddCommand(workspaceListCmd)
 
+	workspacePingCmd, _ := workspace_ping.NewCommand(framework)
+	workspaceCmd.AddCommand(workspacePingCmd)
+
+	workspaceRepairCmd, _ := workspace_repair.NewCommand(framework)
+	workspaceCmd.AddCommand(workspaceRepairCmd)
+
 	workspaceShowCmd, _ := workspace_show.NewCommand(framework)
 	workspaceCmd.AddCommand(workspaceShowCmd)
 
@@ -344,9 +438,21 @@ func initSubCommands() {
 	appGraphCmd, _ := app_graph.NewCommand(framework)
 	applicationCmd.AddCommand(appGraphCmd)
 
+	appDevProfileCmd, _ := app_devprofile.NewCommand(framework)
+	applicationCmd.AddCommand(appDevProfileCmd)
+
+	appPromoteCmd, _ := app_promote.NewCommand(framework)
+	applicationCmd.AddCommand(appPromoteCmd)
+
+	appExportCmd, _ := app_export.NewCommand(framework)
+	applicationCmd.AddCommand(appExportCmd)
+
 	envSwitchCmd, _ := env_switch.NewCommand(framework)
 	envCmd.AddCommand(envSwitchCmd)
 
+	bicepDownloadCmd, _ := bicep_download.NewCommand(framework)
+	bicepCmd.AddCommand(bicepDownloadCmd)
+
 	bicepPublishCmd, _ := bicep_publish.NewCommand(framework)
 	bicepCmd.AddCommand(bicepPublishCmd)
 
@@ -367,6 +473,33 @@ func initSubCommands() {
 
 	uninstallKubernetesCmd, _ := uninstall_kubernetes.NewCommand(framework)
 	uninstallCmd.AddCommand(uninstallKubernetesCmd)
+
+	deleteCmd := cmd_delete.NewCommand()
+	RootCmd.AddCommand(deleteCmd)
+
+	deleteOrphanedResourcesCmd, _ := delete_orphanedresources.NewCommand(framework)
+	deleteCmd.AddCommand(deleteOrphanedResourcesCmd)
+
+	adminCmd := cmd_admin.NewCommand()
+	RootCmd.AddCommand(adminCmd)
+
+	adminInspectOperationCmd, _ := admin_inspectoperation.NewCommand(framework)
+	adminCmd.AddCommand(adminInspectOperationCmd)
+
+	telemetryCmd := cmd_telemetry.NewCommand()
+	RootCmd.AddCommand(telemetryCmd)
+
+	telemetryEnableCmd, _ := telemetry_enable.NewCommand(framework)
+	telemetryCmd.AddCommand(telemetryEnableCmd)
+
+	telemetryDisableCmd, _ := telemetry_disable.NewCommand(framework)
+	telemetryCmd.AddCommand(telemetryDisableCmd)
+
+	telemetryStatusCmd, _ := telemetry_status.NewCommand(framework)
+	telemetryCmd.AddCommand(telemetryStatusCmd)
+
+	versionCmd, _ := cmd_version.NewCommand(framework)
+	RootCmd.AddCommand(versionCmd)
 }
 
 // The dance we do with config is kinda complex. We want commands to be able to retrieve a config (*viper.Viper)
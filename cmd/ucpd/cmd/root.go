@@ -27,6 +27,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/hosting"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/ucp"
 	"github.com/radius-project/radius/pkg/ucp/server"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -63,6 +64,15 @@ var rootCmd = &cobra.Command{
 		// Must set the logger before using controller-runtime.
 		runtimelog.SetLogger(logger)
 
+		httpclient.ConfigureProxy(options.Config.Proxy)
+
+		// Reload the settings which can be changed at runtime when the configuration file changes.
+		options.ConfigWatcher = hostoptions.NewConfigWatcher(configFilePath, ucp.LoadConfig)
+		options.ConfigWatcher.OnChange(func(ctx context.Context, config *ucp.Config) error {
+			httpclient.ConfigureProxy(config.Proxy)
+			return ucplog.SetLevel(&config.Logging)
+		})
+
 		host, err := server.NewServer(options)
 		if err != nil {
 			return err
@@ -41,3 +41,66 @@ References:
 {{- printf "%s" $value -}}
 {{- end -}}
 {{- end -}}
+
+{{/*
+Returns true if an HTTP proxy is configured in global.proxy.
+*/}}
+{{- define "radius.proxyenabled" -}}
+{{- if and .Values.global.proxy (or .Values.global.proxy.httpProxy .Values.global.proxy.httpsProxy) -}}
+true
+{{- end -}}
+{{- end -}}
+
+{{/*
+Renders the destinations that bypass the proxy: the loopback interface, the services of the cluster, the namespace of
+the release so that the services can reach each other by their short names, and the destinations of global.proxy.noProxy.
+*/}}
+{{- define "radius.noproxy" -}}
+{{- $noProxy := list "localhost" "127.0.0.1" "::1" ".svc" ".cluster.local" .Release.Namespace -}}
+{{- join "," (concat $noProxy (default (list) .Values.global.proxy.noProxy)) -}}
+{{- end -}}
+
+{{/*
+Renders the proxy section of the configuration file of the Radius services written in Go.
+
+Usage:
+{{- include "radius.proxyconfig" . | nindent 4 }}
+*/}}
+{{- define "radius.proxyconfig" -}}
+{{- if include "radius.proxyenabled" . -}}
+proxy:
+  {{- with .Values.global.proxy.httpProxy }}
+  httpProxy: {{ . | quote }}
+  {{- end }}
+  {{- with .Values.global.proxy.httpsProxy }}
+  httpsProxy: {{ . | quote }}
+  {{- end }}
+  noProxy:
+    - {{ .Release.Namespace | quote }}
+    {{- range .Values.global.proxy.noProxy }}
+    - {{ . | quote }}
+    {{- end }}
+{{- end }}
+{{- end -}}
+
+{{/*
+Renders the proxy environment variables of the containers that do not read the Radius configuration file, such as the
+deployment engine.
+
+Usage:
+{{- include "radius.proxyenv" . | nindent 8 }}
+*/}}
+{{- define "radius.proxyenv" -}}
+{{- if include "radius.proxyenabled" . -}}
+- name: NO_PROXY
+  value: {{ include "radius.noproxy" . | quote }}
+{{- with .Values.global.proxy.httpProxy }}
+- name: HTTP_PROXY
+  value: {{ . | quote }}
+{{- end }}
+{{- with .Values.global.proxy.httpsProxy }}
+- name: HTTPS_PROXY
+  value: {{ . | quote }}
+{{- end }}
+{{- end }}
+{{- end -}}
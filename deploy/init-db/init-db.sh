@@ -1,8 +1,6 @@
 #!/bin/bash
 set -e
 
-SCRIPT_DIR=$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )
-
 # Array of usernames
 RESOURCE_PROVIDERS=("ucp" "applications_rp")
 
@@ -13,11 +11,9 @@ for RESOURCE_PROVIDER in "${RESOURCE_PROVIDERS[@]}"; do
         CREATE USER $RESOURCE_PROVIDER WITH PASSWORD '$POSTGRES_PASSWORD';
         CREATE DATABASE $RESOURCE_PROVIDER;
         GRANT ALL PRIVILEGES ON DATABASE $RESOURCE_PROVIDER TO $RESOURCE_PROVIDER;
+        ALTER DATABASE $RESOURCE_PROVIDER OWNER TO $RESOURCE_PROVIDER;
 EOSQL
 done
 
-# Create tables within those databases
-for RESOURCE_PROVIDER in "${RESOURCE_PROVIDERS[@]}"; do
-    echo "Creating tables in database $RESOURCE_PROVIDER"
-    psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$RESOURCE_PROVIDER" < $SCRIPT_DIR/db.sql.txt
-done
\ No newline at end of file
+# Each user owns its database so that the Radius services can create tables on startup
+# using the migrations in pkg/components/database/postgres/migrations.
@@ -42,6 +42,12 @@ const (
 
 	// TopParameterName is an optional query parameter that defines the number of records requested by the client.
 	TopParameterName = "top"
+
+	// ARMSkipTokenParameterName is the OData form of the skip token query string parameter used by ARM clients.
+	ARMSkipTokenParameterName = "$skipToken"
+
+	// ARMTopParameterName is the OData form of the top query string parameter used by ARM clients.
+	ARMTopParameterName = "$top"
 )
 
 // The constants below define the default, max, and min values for the number of records to be returned by the server.
@@ -196,7 +202,7 @@ func FromARMRequest(r *http.Request, pathBase, location string) (*ARMRequestCont
 		// do not stop extracting headers. handler needs to care invalid resource id.
 	}
 
-	queryItemCount, err := getQueryItemCount(r.URL.Query().Get(TopParameterName))
+	queryItemCount, err := getQueryItemCount(getQueryParameter(r.URL.Query(), TopParameterName, ARMTopParameterName))
 	if err != nil {
 		log.V(ucplog.LevelDebug).Info(fmt.Sprintf("Error parsing top query parameter: %v", r.URL.Query()))
 		return nil, err
@@ -226,7 +232,7 @@ func FromARMRequest(r *http.Request, pathBase, location string) (*ARMRequestCont
 		IfMatch:     r.Header.Get(IfMatch),
 		IfNoneMatch: r.Header.Get(IfNoneMatch),
 
-		SkipToken: r.URL.Query().Get(SkipTokenParameterName),
+		SkipToken: getQueryParameter(r.URL.Query(), SkipTokenParameterName, ARMSkipTokenParameterName),
 		Top:       queryItemCount,
 
 		HTTPMethod:  r.Method,
@@ -251,6 +257,17 @@ func (rc ARMRequestContext) SystemData() *SystemData {
 	return systemDataProp
 }
 
+// getQueryParameter returns the value of the first of the given query parameters which is set.
+func getQueryParameter(query url.Values, names ...string) string {
+	for _, name := range names {
+		if value := query.Get(name); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
 // getQueryItemCount function returns the number of records requested.
 // The default value is defined above.
 // If there is a top query parameter, we use that instead of the default one.
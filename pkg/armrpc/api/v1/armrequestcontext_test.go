@@ -129,6 +129,9 @@ func TestTopQueryParam(t *testing.T) {
 		{"invalid-top-query-param", "top", "xyz", 0, true},
 		{"out-of-bounds-top-query-param", "top", "100000", 0, true},
 		{"out-of-bounds-top-query-param", "top", "-100", 0, true},
+		{"top-query-param", "top", "15", 15, false},
+		{"odata-top-query-param", "$top", "15", 15, false},
+		{"out-of-bounds-odata-top-query-param", "$top", "100000", 0, true},
 	}
 
 	for _, tt := range topQueryParamCases {
@@ -154,6 +157,34 @@ func TestTopQueryParam(t *testing.T) {
 	}
 }
 
+func TestSkipTokenQueryParam(t *testing.T) {
+	skipTokenQueryParamCases := []struct {
+		desc              string
+		qpKey             string
+		qpValue           string
+		expectedSkipToken string
+	}{
+		{"no-skip-token-query-param", "skipToken", "", ""},
+		{"skip-token-query-param", "skipToken", "token", "token"},
+		{"odata-skip-token-query-param", "$skipToken", "token", "token"},
+	}
+
+	for _, tt := range skipTokenQueryParamCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			req, err := getTestHTTPRequest("./testdata/armrpcheaders.json")
+			require.NoError(t, err)
+
+			q := req.URL.Query()
+			q.Add(tt.qpKey, tt.qpValue)
+			req.URL.RawQuery = q.Encode()
+
+			serviceCtx, err := FromARMRequest(req, "", LocationGlobal)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSkipToken, serviceCtx.SkipToken)
+		})
+	}
+}
+
 func getTestHTTPRequest(headerFile string) (*http.Request, error) {
 	jsonData, err := os.ReadFile(headerFile)
 	if err != nil {
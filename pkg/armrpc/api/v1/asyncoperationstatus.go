@@ -39,4 +39,8 @@ type AsyncOperationStatus struct {
 
 	// Error represents the error occurred during provisioning.
 	Error *ErrorDetails `json:"error,omitempty"`
+
+	// Progress represents the intermediate progress reported while the async operation is running. It is returned
+	// as the optional "progress" property of the operation status response and is omitted when no progress was reported.
+	Progress *OperationProgress `json:"progress,omitempty"`
 }
@@ -31,4 +31,7 @@ var (
 
 	// HostingConfigContextKey is the context key for hosting configuration.
 	HostingConfigContextKey = &contextKey{"hostingConfig"}
+
+	// progressReporterContextKey is the context key for the async operation progress reporter.
+	progressReporterContextKey = &contextKey{"progressReporter"}
 )
@@ -29,6 +29,9 @@ const (
 	// Used when a dependency to carry out current operation is missing.
 	CodeDependencyMissing = "DependencyMissing"
 
+	// Used when a dependency of the current operation is not ready.
+	CodeDependencyNotReady = "DependencyNotReady"
+
 	// Used for CodeNotFound error.
 	CodeNotFound = "NotFound"
 
@@ -61,4 +64,10 @@ const (
 
 	// Used for failed invalid spec api validation.
 	CodeHTTPRequestPayloadAPISpecValidationFailed = "HttpRequestPayloadAPISpecValidationFailed"
+
+	// Used when a quota is exceeded.
+	CodeQuotaExceeded = "QuotaExceeded"
+
+	// Used when a request is rejected by a policy, such as the image policy of an environment.
+	CodeRequestDisallowedByPolicy = "RequestDisallowedByPolicy"
 )
@@ -42,3 +42,9 @@ type ErrorAdditionalInfo struct {
 	Type string         `json:"type"`
 	Info map[string]any `json:"info"`
 }
+
+const (
+	// SupportedAPIVersionsInfoType is the type of the ErrorAdditionalInfo that lists the API versions supported by a
+	// resource type when a request uses an unsupported api-version.
+	SupportedAPIVersionsInfoType = "SupportedAPIVersions"
+)
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+)
+
+// OperationProgress represents the intermediate progress of a running async operation.
+type OperationProgress struct {
+	// Stage is the name of the stage the operation is currently in, such as "Rendering" or "ExecutingRecipe".
+	Stage string `json:"stage,omitempty"`
+
+	// PercentComplete is the estimated completion of the operation, from 0 to 100.
+	PercentComplete *int `json:"percentComplete,omitempty"`
+
+	// Message is a human readable description of the current stage.
+	Message string `json:"message,omitempty"`
+
+	// CurrentStep describes what the operation is currently working on, such as the address of the resource being created.
+	CurrentStep string `json:"currentStep,omitempty"`
+
+	// InProgress is the number of sub-resources which are currently being provisioned.
+	InProgress int `json:"inProgress"`
+
+	// Completed is the number of sub-resources which have been provisioned successfully.
+	Completed int `json:"completed"`
+
+	// Failed is the number of sub-resources which have failed to provision.
+	Failed int `json:"failed"`
+}
+
+// OperationProgressReporter reports the intermediate progress of the async operation associated with the context.
+type OperationProgressReporter func(ctx context.Context, progress OperationProgress) error
+
+// WithOperationProgressReporter adds the OperationProgressReporter to the context and returns the new context.
+func WithOperationProgressReporter(ctx context.Context, reporter OperationProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterContextKey, reporter)
+}
+
+// OperationProgressReporterFromContext retrieves the OperationProgressReporter from the given context. It returns nil
+// if the context does not belong to an async operation.
+func OperationProgressReporterFromContext(ctx context.Context) OperationProgressReporter {
+	reporter, ok := ctx.Value(progressReporterContextKey).(OperationProgressReporter)
+	if !ok {
+		return nil
+	}
+	return reporter
+}
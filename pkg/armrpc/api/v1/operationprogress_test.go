@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationProgressReporterFromContext(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		require.Nil(t, OperationProgressReporterFromContext(context.Background()))
+	})
+
+	t.Run("set", func(t *testing.T) {
+		reported := []OperationProgress{}
+		reporter := func(ctx context.Context, progress OperationProgress) error {
+			reported = append(reported, progress)
+			return nil
+		}
+
+		ctx := WithOperationProgressReporter(context.Background(), reporter)
+		actual := OperationProgressReporterFromContext(ctx)
+		require.NotNil(t, actual)
+
+		err := actual(ctx, OperationProgress{CurrentStep: "step", InProgress: 1})
+		require.NoError(t, err)
+		require.Equal(t, []OperationProgress{{CurrentStep: "step", InProgress: 1}}, reported)
+	})
+}
@@ -32,3 +32,12 @@ type OperationDisplayProperties struct {
 	Provider    string `json:"provider"`
 	Resource    string `json:"resource"`
 }
+
+// ResourceTypeAPIVersions represents the API versions supported by a resource type.
+type ResourceTypeAPIVersions struct {
+	// ResourceType is the resource type, e.g. "Applications.Core/environments".
+	ResourceType string `json:"resourceType"`
+
+	// APIVersions is the sorted list of API versions supported by the resource type.
+	APIVersions []string `json:"apiVersions"`
+}
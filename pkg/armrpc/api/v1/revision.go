@@ -0,0 +1,39 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import "time"
+
+// ResourceRevision represents a prior revision of a resource returned by the resource history API.
+type ResourceRevision struct {
+	// Revision is the sequence number of the revision.
+	Revision int `json:"revision"`
+
+	// ETag is the ETag of the resource at this revision.
+	ETag string `json:"etag,omitempty"`
+
+	// Timestamp is the time at which the revision was replaced by an update.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Resource is the versioned resource at this revision.
+	Resource any `json:"resource"`
+}
+
+// ResourceRevisionList represents the prior revisions of a resource, ordered from newest to oldest.
+type ResourceRevisionList struct {
+	Value []ResourceRevision `json:"value"`
+}
@@ -54,6 +54,9 @@ var operationMethodToHTTPMethod = map[OperationMethod]string{
 	// ARM RPC specific operations.
 	OperationPutSubscriptions: http.MethodPut,
 
+	// Resource history operations.
+	OperationGetHistory: http.MethodGet,
+
 	// Non-idempotent lifecycle operations.
 	OperationGetImperative:    http.MethodPost,
 	OperationPutImperative:    http.MethodPost,
@@ -87,6 +90,9 @@ const (
 	OperationPutSubscriptions OperationMethod = "PUTSUBSCRIPTIONS"
 	OperationPost             OperationMethod = "POST"
 
+	// OperationGetHistory is used to get the prior revisions of a resource.
+	OperationGetHistory OperationMethod = "GETHISTORY"
+
 	// Imperative operation methods for non-idempotent lifecycle operations.
 	// UCP extends the ARM resource lifecycle to support using POST for non-idempotent resource types.
 	//
@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ReportProgress publishes the stage of a running async operation, its estimated percent complete and a message
+// describing it. Progress is informational, so it is a no-op when the context does not belong to an async operation
+// and a failure to report it is logged rather than returned.
+func ReportProgress(ctx context.Context, stage string, percentComplete int, message string) {
+	reporter := v1.OperationProgressReporterFromContext(ctx)
+	if reporter == nil {
+		return
+	}
+
+	percentComplete = min(max(percentComplete, 0), 100)
+	err := reporter(ctx, v1.OperationProgress{
+		Stage:           stage,
+		PercentComplete: to.Ptr(percentComplete),
+		Message:         message,
+	})
+	if err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "Failed to report the progress of the async operation", "stage", stage)
+	}
+}
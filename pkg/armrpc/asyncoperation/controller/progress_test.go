@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportProgress(t *testing.T) {
+	t.Run("no reporter", func(t *testing.T) {
+		// Must not panic when the context does not belong to an async operation.
+		ReportProgress(context.Background(), "Rendering", 10, "Rendering the resource")
+	})
+
+	t.Run("reports progress", func(t *testing.T) {
+		reported := []v1.OperationProgress{}
+		ctx := v1.WithOperationProgressReporter(context.Background(), func(ctx context.Context, progress v1.OperationProgress) error {
+			reported = append(reported, progress)
+			return nil
+		})
+
+		ReportProgress(ctx, "Rendering", 10, "Rendering the resource")
+		ReportProgress(ctx, "Saving", 150, "Saving the resource")
+
+		expected := []v1.OperationProgress{
+			{Stage: "Rendering", PercentComplete: to.Ptr(10), Message: "Rendering the resource"},
+			{Stage: "Saving", PercentComplete: to.Ptr(100), Message: "Saving the resource"},
+		}
+		require.Equal(t, expected, reported)
+	})
+
+	t.Run("reporter error is ignored", func(t *testing.T) {
+		ctx := v1.WithOperationProgressReporter(context.Background(), func(ctx context.Context, progress v1.OperationProgress) error {
+			return errors.New("failed")
+		})
+
+		ReportProgress(ctx, "Rendering", 10, "Rendering the resource")
+	})
+}
@@ -120,6 +120,44 @@ func (c *MockStatusManagerGetCall) DoAndReturn(f func(context.Context, resources
 	return c
 }
 
+// Lease mocks base method.
+func (m *MockStatusManager) Lease(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 string, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lease", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lease indicates an expected call of Lease.
+func (mr *MockStatusManagerMockRecorder) Lease(arg0, arg1, arg2, arg3, arg4 any) *MockStatusManagerLeaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lease", reflect.TypeOf((*MockStatusManager)(nil).Lease), arg0, arg1, arg2, arg3, arg4)
+	return &MockStatusManagerLeaseCall{Call: call}
+}
+
+// MockStatusManagerLeaseCall wrap *gomock.Call
+type MockStatusManagerLeaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerLeaseCall) Return(arg0 error) *MockStatusManagerLeaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerLeaseCall) Do(f func(context.Context, resources.ID, uuid.UUID, string, time.Time) error) *MockStatusManagerLeaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerLeaseCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, string, time.Time) error) *MockStatusManagerLeaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // QueueAsyncOperation mocks base method.
 func (m *MockStatusManager) QueueAsyncOperation(arg0 context.Context, arg1 *v1.ARMRequestContext, arg2 QueueOperationOptions) error {
 	m.ctrl.T.Helper()
@@ -195,3 +233,41 @@ func (c *MockStatusManagerUpdateCall) DoAndReturn(f func(context.Context, resour
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateProgress mocks base method.
+func (m *MockStatusManager) UpdateProgress(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 v1.OperationProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockStatusManagerMockRecorder) UpdateProgress(arg0, arg1, arg2, arg3 any) *MockStatusManagerUpdateProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockStatusManager)(nil).UpdateProgress), arg0, arg1, arg2, arg3)
+	return &MockStatusManagerUpdateProgressCall{Call: call}
+}
+
+// MockStatusManagerUpdateProgressCall wrap *gomock.Call
+type MockStatusManagerUpdateProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerUpdateProgressCall) Return(arg0 error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerUpdateProgressCall) Do(f func(context.Context, resources.ID, uuid.UUID, v1.OperationProgress) error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerUpdateProgressCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, v1.OperationProgress) error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -43,4 +43,11 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
+
+	// LeaseHolder identifies the delivery of the queue message whose worker is processing the async operation.
+	LeaseHolder string `json:"leaseHolder,omitempty"`
+
+	// LeaseExpiresAt is the time until which the lease holder processes the async operation. Once the lease
+	// has expired, the operation can be processed by the other message.
+	LeaseExpiresAt time.Time `json:"leaseExpiresAt,omitempty"`
 }
@@ -34,6 +34,19 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// maxUpdateAttempts is the maximum number of attempts to update the operation status when it is concurrently modified.
+	maxUpdateAttempts = 3
+)
+
+var (
+	// ErrOperationLeased is returned when the async operation is being processed by the worker of the other message.
+	ErrOperationLeased = errors.New("async operation is being processed by the other worker")
+
+	// ErrOperationCompleted is returned when the async operation has already reached a terminal state.
+	ErrOperationCompleted = errors.New("async operation has already been completed")
+)
+
 // statusManager includes the necessary functions to manage asynchronous operations.
 type statusManager struct {
 	databaseClient database.Client
@@ -59,6 +72,10 @@ type StatusManager interface {
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
 	// Update updates an async operation status.
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// UpdateProgress updates the intermediate progress of a running async operation.
+	UpdateProgress(ctx context.Context, id resources.ID, operationID uuid.UUID, progress v1.OperationProgress) error
+	// Lease claims an async operation for the delivery of the message identified by leaseHolder until the given expiry time.
+	Lease(ctx context.Context, id resources.ID, operationID uuid.UUID, leaseHolder string, expiresAt time.Time) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
@@ -144,9 +161,23 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 }
 
 // Update retrieves an existing operation status resource from the store, updates its fields with the
-// given parameters, and saves it back to the store.
+// given parameters, and saves it back to the store. The update is retried if the status was concurrently
+// modified, for example by a progress update, so that the new state is never lost.
 func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
 	opID := aom.operationStatusResourceID(id, operationID)
+
+	var err error
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		err = aom.update(ctx, opID, state, endTime, opError)
+		if !errors.Is(err, &database.ErrConcurrency{}) {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (aom *statusManager) update(ctx context.Context, opID string, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
 	obj, err := aom.databaseClient.Get(ctx, opID)
 	if err != nil {
 		return err
@@ -173,6 +204,89 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 	return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
 }
 
+// UpdateProgress retrieves an existing operation status resource from the store, updates its progress
+// and saves it back to the store. Progress is not updated once the operation has reached a terminal state.
+//
+// When the progress does not set a stage, the stage, percent complete and message of the previous progress are
+// kept so that sub-resource counters can be reported within the current stage of the operation.
+func (aom *statusManager) UpdateProgress(ctx context.Context, id resources.ID, operationID uuid.UUID, progress v1.OperationProgress) error {
+	opID := aom.operationStatusResourceID(id, operationID)
+	obj, err := aom.databaseClient.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	if s.Status.IsTerminal() {
+		return nil
+	}
+
+	if progress.Stage == "" && s.Progress != nil {
+		progress.Stage = s.Progress.Stage
+		progress.PercentComplete = s.Progress.PercentComplete
+		progress.Message = s.Progress.Message
+	}
+
+	s.Progress = &progress
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+
+	return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+}
+
+// Lease records in the operation status that the async operation is processed by the worker of the message delivery
+// identified by leaseHolder until the given expiry time. The lease is saved with the ETag of the operation status so
+// that only one message of the same operation is processed at a time across all workers sharing the database. The
+// delivery which holds the lease can renew it, for example when its lock is extended.
+//
+// Lease returns ErrOperationCompleted if the operation has already reached a terminal state, and ErrOperationLeased if
+// the operation is leased by the other message and the lease has not expired.
+func (aom *statusManager) Lease(ctx context.Context, id resources.ID, operationID uuid.UUID, leaseHolder string, expiresAt time.Time) error {
+	opID := aom.operationStatusResourceID(id, operationID)
+
+	var err error
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		err = aom.lease(ctx, opID, leaseHolder, expiresAt)
+		if !errors.Is(err, &database.ErrConcurrency{}) {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (aom *statusManager) lease(ctx context.Context, opID string, leaseHolder string, expiresAt time.Time) error {
+	obj, err := aom.databaseClient.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	if s.Status.IsTerminal() {
+		return ErrOperationCompleted
+	}
+
+	if s.LeaseHolder != "" && s.LeaseHolder != leaseHolder && s.LeaseExpiresAt.After(time.Now()) {
+		return ErrOperationLeased
+	}
+
+	s.LeaseHolder = leaseHolder
+	s.LeaseExpiresAt = expiresAt.UTC()
+
+	obj.Data = s
+
+	return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+}
+
 // Delete deletes the operation status resource associated with the given ID and
 // operationID, and returns an error if unsuccessful.
 func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
@@ -194,5 +308,5 @@ func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMR
 		OperationTimeout: &operationTimeout,
 	}
 
-	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
+	return aom.queue.Enqueue(ctx, queue.NewMessage(msg), queue.WithDeduplicationKey(sCtx.OperationID.String()))
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -325,3 +326,279 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateAsyncOperationStatus_ConcurrencyRetry(t *testing.T) {
+	retryCases := []struct {
+		Desc     string
+		SaveErrs []error
+		Err      error
+	}{
+		{
+			Desc:     "update_retry_success",
+			SaveErrs: []error{&database.ErrConcurrency{}, nil},
+		},
+		{
+			Desc:     "update_retry_exhausted",
+			SaveErrs: []error{&database.ErrConcurrency{}, &database.ErrConcurrency{}, &database.ErrConcurrency{}},
+			Err:      &database.ErrConcurrency{},
+		},
+		{
+			Desc:     "update_no-retry-other-error",
+			SaveErrs: []error{errors.New(saveErr)},
+			Err:      errors.New(saveErr),
+		},
+	}
+
+	for _, tt := range retryCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			for _, saveErr := range tt.SaveErrs {
+				aomTest.databaseClient.
+					EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(newTestStatusObject(v1.ProvisioningStateUpdating), nil)
+
+				aomTest.databaseClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+						s, ok := obj.Data.(*Status)
+						require.True(t, ok)
+						require.Equal(t, v1.ProvisioningStateCanceled, s.Status)
+						return saveErr
+					})
+			}
+
+			rid, err := resources.ParseResource(azureEnvResourceID)
+			require.NoError(t, err)
+			err = aomTest.manager.Update(context.TODO(), rid, opID, v1.ProvisioningStateCanceled, nil, nil)
+
+			if tt.Err == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.Err.Error())
+			}
+		})
+	}
+}
+
+func TestUpdateProgressAsyncOperationStatus(t *testing.T) {
+	progressCases := []struct {
+		Desc     string
+		GetErr   error
+		Obj      *database.Object
+		SaveErr  error
+		Terminal bool
+	}{
+		{
+			Desc: "update_progress_success",
+			Obj:  newTestStatusObject(v1.ProvisioningStateUpdating),
+		},
+		{
+			Desc:   "update_progress_get-error",
+			GetErr: errors.New(getErr),
+		},
+		{
+			Desc:    "update_progress_save-error",
+			Obj:     newTestStatusObject(v1.ProvisioningStateUpdating),
+			SaveErr: errors.New(saveErr),
+		},
+		{
+			Desc:     "update_progress_terminal-status",
+			Obj:      newTestStatusObject(v1.ProvisioningStateCanceled),
+			Terminal: true,
+		},
+	}
+
+	for _, tt := range progressCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			progress := v1.OperationProgress{CurrentStep: "random_pet.animal", InProgress: 1, Completed: 2}
+
+			aomTest.databaseClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tt.Obj, tt.GetErr)
+
+			// Progress must never overwrite an operation status which has reached a terminal state.
+			if tt.GetErr == nil && !tt.Terminal {
+				aomTest.databaseClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+						s, ok := obj.Data.(*Status)
+						require.True(t, ok)
+						require.Equal(t, &progress, s.Progress)
+						return tt.SaveErr
+					})
+			}
+
+			rid, err := resources.ParseResource(azureEnvResourceID)
+			require.NoError(t, err)
+			err = aomTest.manager.UpdateProgress(context.TODO(), rid, opID, progress)
+
+			if tt.GetErr == nil && tt.SaveErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateProgressAsyncOperationStatus_KeepsStage(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	obj := newTestStatusObject(v1.ProvisioningStateUpdating)
+	obj.Data.(*Status).Progress = &v1.OperationProgress{Stage: "ExecutingRecipe", PercentComplete: to.Ptr(30), Message: "Executing recipe default"}
+
+	aomTest.databaseClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(obj, nil)
+	aomTest.databaseClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+			s, ok := obj.Data.(*Status)
+			require.True(t, ok)
+
+			expected := &v1.OperationProgress{
+				Stage:           "ExecutingRecipe",
+				PercentComplete: to.Ptr(30),
+				Message:         "Executing recipe default",
+				CurrentStep:     "random_pet.animal",
+				InProgress:      1,
+			}
+			require.Equal(t, expected, s.Progress)
+			return nil
+		})
+
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+	err = aomTest.manager.UpdateProgress(context.TODO(), rid, opID, v1.OperationProgress{CurrentStep: "random_pet.animal", InProgress: 1})
+	require.NoError(t, err)
+}
+
+func TestLeaseAsyncOperationStatus(t *testing.T) {
+	expiresAt := time.Now().Add(time.Minute).UTC()
+	leaseCases := []struct {
+		Desc      string
+		State     v1.ProvisioningState
+		Holder    string
+		ExpiresAt time.Time
+		SaveErrs  []error
+		Err       error
+	}{
+		{
+			Desc:  "lease_not-leased",
+			State: v1.ProvisioningStateAccepted,
+		},
+		{
+			Desc:      "lease_renew",
+			State:     v1.ProvisioningStateUpdating,
+			Holder:    "message",
+			ExpiresAt: time.Now().Add(time.Minute),
+		},
+		{
+			Desc:      "lease_expired",
+			State:     v1.ProvisioningStateUpdating,
+			Holder:    "other-message",
+			ExpiresAt: time.Now().Add(-time.Minute),
+		},
+		{
+			Desc:      "lease_leased-by-other-message",
+			State:     v1.ProvisioningStateUpdating,
+			Holder:    "other-message",
+			ExpiresAt: time.Now().Add(time.Minute),
+			Err:       ErrOperationLeased,
+		},
+		{
+			Desc:  "lease_completed",
+			State: v1.ProvisioningStateSucceeded,
+			Err:   ErrOperationCompleted,
+		},
+		{
+			Desc:     "lease_retry_success",
+			State:    v1.ProvisioningStateAccepted,
+			SaveErrs: []error{&database.ErrConcurrency{}, nil},
+		},
+		{
+			Desc:     "lease_retry_exhausted",
+			State:    v1.ProvisioningStateAccepted,
+			SaveErrs: []error{&database.ErrConcurrency{}, &database.ErrConcurrency{}, &database.ErrConcurrency{}},
+			Err:      &database.ErrConcurrency{},
+		},
+	}
+
+	for _, tt := range leaseCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			saveErrs := tt.SaveErrs
+			if tt.Err == nil && saveErrs == nil {
+				saveErrs = []error{nil}
+			}
+
+			attempts := len(saveErrs)
+			if attempts == 0 {
+				attempts = 1
+			}
+
+			for i := 0; i < attempts; i++ {
+				obj := newTestStatusObject(tt.State)
+				obj.Data.(*Status).LeaseHolder = tt.Holder
+				obj.Data.(*Status).LeaseExpiresAt = tt.ExpiresAt
+				aomTest.databaseClient.
+					EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(obj, nil)
+			}
+
+			for _, saveErr := range saveErrs {
+				aomTest.databaseClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+						s, ok := obj.Data.(*Status)
+						require.True(t, ok)
+						require.Equal(t, "message", s.LeaseHolder)
+						require.Equal(t, expiresAt, s.LeaseExpiresAt)
+						require.Len(t, options, 1, "lease must be saved with the etag")
+						return saveErr
+					})
+			}
+
+			rid, err := resources.ParseResource(azureEnvResourceID)
+			require.NoError(t, err)
+			err = aomTest.manager.Lease(context.TODO(), rid, opID, "message", expiresAt)
+
+			if tt.Err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.Err)
+			}
+		})
+	}
+}
+
+func newTestStatusObject(state v1.ProvisioningState) *database.Object {
+	return &database.Object{
+		Metadata: database.Metadata{ID: opID.String(), ETag: "etag"},
+		Data: &Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        opID.String(),
+				Name:      opID.String(),
+				Status:    state,
+				StartTime: time.Now().UTC(),
+			},
+			Location: "test-location",
+		},
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"sync"
+)
+
+// limiter limits the number of operations processed concurrently. Unlike semaphore.Weighted, the limit can be
+// changed while operations are being processed.
+type limiter struct {
+	lock   sync.Mutex
+	limit  int
+	active int
+
+	// changed is closed and replaced when an operation completes or the limit changes to wake up the waiters.
+	changed chan struct{}
+}
+
+func newLimiter(limit int) *limiter {
+	return &limiter{
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// Acquire blocks until an operation can be processed or the context is canceled.
+func (l *limiter) Acquire(ctx context.Context) error {
+	for {
+		l.lock.Lock()
+		if l.active < l.limit {
+			l.active++
+			l.lock.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// Release marks an operation as completed.
+func (l *limiter) Release() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.active--
+	l.notify()
+}
+
+// SetLimit changes the limit. When the limit is lowered, the operations being processed are not interrupted, and new
+// operations are processed once the number of active operations drops below the new limit.
+func (l *limiter) SetLimit(limit int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.limit = limit
+	l.notify()
+}
+
+func (l *limiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Acquire(t *testing.T) {
+	l := newLimiter(1)
+	require.NoError(t, l.Acquire(context.Background()))
+
+	// The limit is reached, so Acquire blocks until the context is canceled.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, l.Acquire(ctx), context.DeadlineExceeded)
+
+	l.Release()
+	require.NoError(t, l.Acquire(context.Background()))
+}
+
+func TestLimiter_SetLimit(t *testing.T) {
+	l := newLimiter(1)
+	require.NoError(t, l.Acquire(context.Background()))
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- l.Acquire(context.Background())
+	}()
+
+	// Raising the limit unblocks the waiter.
+	l.SetLimit(2)
+	require.NoError(t, <-acquired)
+
+	// Lowering the limit blocks new operations until enough active operations are released.
+	l.SetLimit(1)
+	go func() {
+		acquired <- l.Acquire(context.Background())
+	}()
+
+	l.Release()
+	select {
+	case <-acquired:
+		require.Fail(t, "Acquire should block while the number of active operations is at the limit")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	l.Release()
+	require.NoError(t, <-acquired)
+}
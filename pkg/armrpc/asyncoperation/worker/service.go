@@ -46,6 +46,12 @@ type Service struct {
 
 	// controllersInit is used to ensure single initialization of controllers.
 	controllersInit sync.Once
+
+	// lock protects Options and worker from concurrent updates.
+	lock sync.Mutex
+
+	// worker is the running worker.
+	worker *AsyncRequestProcessWorker
 }
 
 // Controllers returns the controller registry for the worker service.
@@ -62,7 +68,10 @@ func (s *Service) Start(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Create and start worker.
+	s.lock.Lock()
 	worker := New(s.Options, s.OperationStatusManager, s.QueueClient, s.Controllers())
+	s.worker = worker
+	s.lock.Unlock()
 
 	logger.Info("Start Worker...")
 	if err := worker.Start(ctx); err != nil {
@@ -73,3 +82,16 @@ func (s *Service) Start(ctx context.Context) error {
 	logger.Info("Worker stopped...")
 	return nil
 }
+
+// UpdateOptions updates MaxOperationConcurrency and MaxOperationRetryCount of the worker, the other options are
+// ignored. The options are applied to the running worker, or used when the worker is started.
+func (s *Service) UpdateOptions(options Options) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.Options.MaxOperationConcurrency = options.MaxOperationConcurrency
+	s.Options.MaxOperationRetryCount = options.MaxOperationRetryCount
+	if s.worker != nil {
+		s.worker.UpdateOptions(options)
+	}
+}
@@ -23,11 +23,13 @@ import (
 	"fmt"
 	"runtime/debug"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/queue"
@@ -37,7 +39,6 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/google/uuid"
-	"golang.org/x/sync/semaphore"
 )
 
 const (
@@ -81,6 +82,24 @@ type Options struct {
 	DequeueIntervalDuration time.Duration
 }
 
+// OptionsFromConfig returns the options of the worker from the worker server configuration of a service. The defaults
+// of the worker are used for the settings which are not configured.
+func OptionsFromConfig(config *hostoptions.WorkerServerOptions) Options {
+	options := Options{}
+	if config == nil {
+		return options
+	}
+
+	if config.MaxOperationConcurrency != nil {
+		options.MaxOperationConcurrency = *config.MaxOperationConcurrency
+	}
+	if config.MaxOperationRetryCount != nil {
+		options.MaxOperationRetryCount = *config.MaxOperationRetryCount
+	}
+
+	return options
+}
+
 // AsyncRequestProcessWorker is the worker to process async requests.
 type AsyncRequestProcessWorker struct {
 	options      Options
@@ -88,7 +107,11 @@ type AsyncRequestProcessWorker struct {
 	registry     *ControllerRegistry
 	requestQueue queue.Client
 
-	sem *semaphore.Weighted
+	// limiter limits the number of operations processed concurrently.
+	limiter *limiter
+
+	// maxOperationRetryCount is the maximum retry count, which can be changed while the worker is running.
+	maxOperationRetryCount atomic.Int64
 }
 
 // New creates AsyncRequestProcessWorker server instance.
@@ -116,13 +139,31 @@ func New(
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
 
-	return &AsyncRequestProcessWorker{
+	w := &AsyncRequestProcessWorker{
 		options:      options,
 		sm:           sm,
 		registry:     ctrlRegistry,
 		requestQueue: qu,
-		sem:          semaphore.NewWeighted(int64(options.MaxOperationConcurrency)),
+		limiter:      newLimiter(options.MaxOperationConcurrency),
+	}
+	w.maxOperationRetryCount.Store(int64(options.MaxOperationRetryCount))
+
+	return w
+}
+
+// UpdateOptions updates MaxOperationConcurrency and MaxOperationRetryCount of the running worker, the other options
+// are ignored. Zero values reset the options to their defaults. Operations which are being processed are not
+// interrupted when the concurrency is lowered.
+func (w *AsyncRequestProcessWorker) UpdateOptions(options Options) {
+	if options.MaxOperationConcurrency == 0 {
+		options.MaxOperationConcurrency = defaultMaxOperationConcurrency
+	}
+	if options.MaxOperationRetryCount == 0 {
+		options.MaxOperationRetryCount = defaultMaxOperationRetryCount
 	}
+
+	w.limiter.SetLimit(options.MaxOperationConcurrency)
+	w.maxOperationRetryCount.Store(int64(options.MaxOperationRetryCount))
 }
 
 // Start starts worker's message loop - it starts a loop to process messages from a queue concurrently, and handles deduplication, updating
@@ -134,15 +175,18 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 		return err
 	}
 
+	// Report the depth of the queue if the queue client supports it.
+	metrics.DefaultQueueMetrics.RegisterQueue(w.requestQueue)
+
 	// this loop will run until msgCh is closed (or when ctx is canceled)
 	for msg := range msgCh {
-		// This semaphore will maintain the number of go routines to process the messages concurrently.
-		if err := w.sem.Acquire(ctx, 1); err != nil {
+		// This limiter will maintain the number of go routines to process the messages concurrently.
+		if err := w.limiter.Acquire(ctx); err != nil {
 			break
 		}
 
 		go func(msgreq *queue.Message) {
-			defer w.sem.Release(1)
+			defer w.limiter.Release()
 
 			op := &ctrl.Request{}
 			if err := json.Unmarshal(msgreq.Data, op); err != nil {
@@ -160,6 +204,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				logging.LogFieldDequeueCount, msgreq.DequeueCount)
 
 			opLogger := ucplog.FromContextOrDiscard(reqCtx)
+			metrics.DefaultAsyncOperationMetrics.RecordAsyncOperationMessageAge(reqCtx, op, msgreq.EnqueueAt)
 
 			armReqCtx, err := op.ARMRequestContext()
 			if err != nil {
@@ -171,21 +216,22 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			asyncCtrl, err := w.registry.Get(armReqCtx.OperationType)
 			if err != nil {
 				opLogger.Error(err, "failed to get async controller.")
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
-				}
+				w.finishMessage(reqCtx, msgreq)
 				return
 			}
 
 			if asyncCtrl == nil {
 				opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
-				}
+				w.finishMessage(reqCtx, msgreq)
 				return
 			}
 
-			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
+			// Skip the duplicated message if the same operation is being processed or has already been processed by any worker.
+			if !w.leaseOperation(reqCtx, msgreq, armReqCtx.ResourceID, op.OperationID) {
+				return
+			}
+
+			if int64(msgreq.DequeueCount) > w.maxOperationRetryCount.Load() {
 				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
 				opLogger.Error(nil, errMsg)
 				failed := ctrl.NewFailedResult(v1.ErrorDetails{
@@ -232,7 +278,13 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		logger.Error(err, "failed to unmarshal queue message.")
 		return
 	}
-	asyncReqCtx, opCancel := context.WithCancel(ctx)
+
+	// The message is dequeued more than once when the previous attempt was requeued or failed to complete.
+	if message.DequeueCount > 1 {
+		trace.AddRetryEvent(ctx, message.DequeueCount, "redelivered", nil)
+	}
+
+	asyncReqCtx, opCancel := context.WithCancel(w.withProgressReporter(ctx, asyncReq))
 	// Ensure that asyncReqCtx context is cancelled when runOperation returns.
 	// That is, cancelling asyncReqCtx signals to ctrl.Run() to cancel the execution,
 	// resulting in completing the go-routine calling ctrl.Run() when runOperation returns.
@@ -295,6 +347,7 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
+				w.renewLease(ctx, message, asyncReq)
 			}
 			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
 
@@ -323,6 +376,40 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// withProgressReporter returns a context which allows the async controller to report the intermediate progress of the operation.
+// Progress is no longer reported once the operation context is canceled, for example when the operation has timed out.
+func (w *AsyncRequestProcessWorker) withProgressReporter(ctx context.Context, req *ctrl.Request) context.Context {
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to parse resource ID, progress of the operation will not be reported")
+		return ctx
+	}
+
+	return v1.WithOperationProgressReporter(ctx, func(ctx context.Context, progress v1.OperationProgress) error {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return w.sm.UpdateProgress(ctx, rID, req.OperationID, progress)
+	})
+}
+
+// renewLease extends the lease of the operation to the extended message lock so that the duplicated messages are not
+// processed while the operation is running.
+func (w *AsyncRequestProcessWorker) renewLease(ctx context.Context, message *queue.Message, req *ctrl.Request) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID, the lease of the operation is not renewed")
+		return
+	}
+
+	if err := w.sm.Lease(ctx, rID, req.OperationID, message.ID, message.NextVisibleAt); err != nil {
+		logger.Error(err, "failed to renew the lease of the operation")
+	}
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -347,14 +434,65 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 
 	// Finish the message only if Requeue is false. Otherwise, AsyncRequestProcessWorker will requeue the message and process it again.
 	if !result.Requeue {
-		if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
-			logger.Error(err, "failed to finish the message")
-		}
+		w.finishMessage(ctx, message)
+	} else {
+		trace.AddRetryEvent(ctx, message.DequeueCount, "requeued", nil)
+		metrics.DefaultAsyncOperationMetrics.RecordRequeuedAsyncOperation(ctx, req)
 	}
 
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
+// finishMessage finishes the message in the queue. The message which has already been finished or leased by the other
+// worker is not finished again.
+func (w *AsyncRequestProcessWorker) finishMessage(ctx context.Context, message *queue.Message) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	err := w.requestQueue.FinishMessage(ctx, message)
+	if errors.Is(err, queue.ErrDequeuedMessage) || errors.Is(err, queue.ErrInvalidMessage) {
+		logger.Info("message has already been finished or leased by the other worker", "reason", err.Error())
+	} else if err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+}
+
+// leaseOperation claims the operation for the message in the operation status, which is shared by all workers, until
+// the message lock expires. It returns false if the message must not be processed. The duplicated message of the
+// operation which has been completed or is being processed by the other message is finished, as well as the message
+// whose operation status no longer exists.
+func (w *AsyncRequestProcessWorker) leaseOperation(ctx context.Context, message *queue.Message, id resources.ID, operationID uuid.UUID) bool {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	err := w.sm.Lease(ctx, id, operationID, leaseHolder(message), message.NextVisibleAt)
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, manager.ErrOperationCompleted):
+		logger.Info("message has already been processed")
+		w.finishMessage(ctx, message)
+	case errors.Is(err, manager.ErrOperationLeased):
+		logger.Info("message is being processed by the other worker")
+		w.finishMessage(ctx, message)
+	case errors.Is(err, &database.ErrNotFound{}):
+		logger.Info("operation status of the message no longer exists")
+		w.finishMessage(ctx, message)
+	default:
+		logger.Error(err, "failed to lease the async operation")
+	}
+
+	return false
+}
+
+// leaseHolder returns the identity of the delivery of the message which holds the lease of its operation. The duplicated
+// deliveries of a message can share its ID, e.g. the ID of a Service Bus message is its deduplication key, so the lock
+// token of the delivery is used if the queue has one.
+func leaseHolder(message *queue.Message) string {
+	if message.LockToken != "" {
+		return message.LockToken
+	}
+
+	return message.ID
+}
+
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -435,5 +573,6 @@ func updateResourceState(ctx context.Context, sc database.Client, id string, sta
 		return err
 	}
 
+	trace.AddStateTransitionEvent(ctx, pState, string(state))
 	return nil
 }
@@ -206,6 +206,7 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().Lease(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	expectedDequeueCount := 2
@@ -267,6 +268,7 @@ func TestStart_MaxConcurrency(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Lease(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	registry := NewControllerRegistry()
@@ -343,6 +345,7 @@ func TestStart_RunOperation(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Lease(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	registry := NewControllerRegistry()
@@ -401,6 +404,129 @@ func TestStart_RunOperation(t *testing.T) {
 	require.Equal(t, 1, testMessage.DequeueCount)
 }
 
+func TestStart_DuplicatedMessage(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// The operation statuses are kept in the database shared by all workers.
+	databaseClient := inmemorystore.NewClient()
+	sm := manager.New(databaseClient, tCtx.testQueue, "test-location")
+
+	registry := NewControllerRegistry()
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, sm, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	runCount := atomic.NewInt32(0)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			runCount.Inc()
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+	err := registry.Register(
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	// The frontend creates the operation status and queues the message to the staging queue so that the test
+	// controls when the worker receives the message.
+	stagingQueue := inmemory.New(inmemory.NewInMemQueue(defaultTestLockTime))
+	frontend := manager.New(databaseClient, stagingQueue, "test-location")
+	queueOperation := func(t *testing.T) (*v1.ARMRequestContext, []byte) {
+		rID, err := resources.ParseResource(fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/%s", uuid.NewString()))
+		require.NoError(t, err)
+		armCtx := &v1.ARMRequestContext{
+			ResourceID:    rID,
+			OperationID:   uuid.New(),
+			OperationType: v1.OperationType{Type: testResourceType, Method: v1.OperationPut},
+		}
+		err = frontend.QueueAsyncOperation(ctx, armCtx, manager.QueueOperationOptions{OperationTimeout: ctrl.DefaultAsyncOperationTimeout})
+		require.NoError(t, err)
+
+		msg, err := stagingQueue.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		return armCtx, msg.Data
+	}
+
+	t.Run("duplicated message of completed operation", func(t *testing.T) {
+		armCtx, data := queueOperation(t)
+
+		err := tCtx.testQueue.Enqueue(ctx, queue.NewMessage(data))
+		require.NoError(t, err)
+		tCtx.drainQueueOrAssert(t)
+		require.Equal(t, int32(1), runCount.Load())
+
+		status, err := sm.Get(ctx, armCtx.ResourceID, armCtx.OperationID)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateSucceeded, status.Status)
+
+		// Queue the duplicated message for the same operation.
+		err = tCtx.testQueue.Enqueue(ctx, queue.NewMessage(data))
+		require.NoError(t, err)
+		tCtx.drainQueueOrAssert(t)
+		require.Equal(t, int32(1), runCount.Load(), "duplicated message must not be processed")
+	})
+
+	t.Run("duplicated message of operation leased by the other worker", func(t *testing.T) {
+		armCtx, data := queueOperation(t)
+
+		// The other worker is processing the operation.
+		err := sm.Lease(ctx, armCtx.ResourceID, armCtx.OperationID, "other-message", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		err = tCtx.testQueue.Enqueue(ctx, queue.NewMessage(data))
+		require.NoError(t, err)
+		tCtx.drainQueueOrAssert(t)
+		require.Equal(t, int32(1), runCount.Load(), "duplicated message must not be processed")
+
+		status, err := sm.Get(ctx, armCtx.ResourceID, armCtx.OperationID)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateAccepted, status.Status)
+		require.Equal(t, "other-message", status.LeaseHolder)
+	})
+
+	t.Run("message of deleted operation status", func(t *testing.T) {
+		armCtx, data := queueOperation(t)
+
+		err := sm.Delete(ctx, armCtx.ResourceID, armCtx.OperationID)
+		require.NoError(t, err)
+
+		err = tCtx.testQueue.Enqueue(ctx, queue.NewMessage(data))
+		require.NoError(t, err)
+		tCtx.drainQueueOrAssert(t)
+		require.Equal(t, int32(1), runCount.Load(), "message of deleted operation status must not be processed")
+	})
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+}
+
 func TestRunOperation_Successfully(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -449,6 +575,7 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Lease(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).MinTimes(1)
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
@@ -22,8 +22,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -37,6 +44,26 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
 }
 
+func TestUpdateOptions(t *testing.T) {
+	worker := New(Options{}, nil, nil, nil)
+
+	worker.UpdateOptions(Options{MaxOperationConcurrency: 2, MaxOperationRetryCount: 5})
+	require.Equal(t, 2, worker.limiter.limit)
+	require.Equal(t, int64(5), worker.maxOperationRetryCount.Load())
+
+	worker.UpdateOptions(Options{})
+	require.Equal(t, defaultMaxOperationConcurrency, worker.limiter.limit)
+	require.Equal(t, int64(defaultMaxOperationRetryCount), worker.maxOperationRetryCount.Load())
+}
+
+func TestOptionsFromConfig(t *testing.T) {
+	require.Equal(t, Options{}, OptionsFromConfig(nil))
+	require.Equal(t, Options{}, OptionsFromConfig(&hostoptions.WorkerServerOptions{}))
+
+	options := OptionsFromConfig(&hostoptions.WorkerServerOptions{MaxOperationConcurrency: to.Ptr(2), MaxOperationRetryCount: to.Ptr(5)})
+	require.Equal(t, Options{MaxOperationConcurrency: 2, MaxOperationRetryCount: 5}, options)
+}
+
 func TestUpdateResourceState(t *testing.T) {
 	updateStates := []struct {
 		tc          string
@@ -138,6 +165,15 @@ func TestGetMessageExtendDuration(t *testing.T) {
 	}
 }
 
+func TestLeaseHolder(t *testing.T) {
+	// Duplicated deliveries of a Service Bus message share the ID of the message.
+	msg := &queue.Message{Metadata: queue.Metadata{ID: "operation-id", LockToken: "lock-token"}}
+	require.Equal(t, "lock-token", leaseHolder(msg))
+
+	msg = &queue.Message{Metadata: queue.Metadata{ID: "message-id"}}
+	require.Equal(t, "message-id", leaseHolder(msg))
+}
+
 func TestErrorHandling(t *testing.T) {
 	tests := []struct {
 		err            error
@@ -158,3 +194,45 @@ func TestErrorHandling(t *testing.T) {
 		require.Equal(t, tt.expectedArmErr, armErr)
 	}
 }
+
+func TestWithProgressReporter(t *testing.T) {
+	const resourceID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/redis0"
+	operationID := uuid.New()
+	progress := v1.OperationProgress{CurrentStep: "random_pet.pet", InProgress: 1, Completed: 2}
+
+	t.Run("reports progress to status manager", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		sm := statusmanager.NewMockStatusManager(mctrl)
+		sm.EXPECT().
+			UpdateProgress(gomock.Any(), resources.MustParse(resourceID), operationID, progress).
+			Return(nil).
+			Times(1)
+
+		worker := New(Options{}, sm, nil, nil)
+		ctx := worker.withProgressReporter(context.Background(), &ctrl.Request{ResourceID: resourceID, OperationID: operationID})
+
+		reporter := v1.OperationProgressReporterFromContext(ctx)
+		require.NotNil(t, reporter)
+		require.NoError(t, reporter(ctx, progress))
+	})
+
+	t.Run("does not report progress after cancellation", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		sm := statusmanager.NewMockStatusManager(mctrl)
+
+		worker := New(Options{}, sm, nil, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx = worker.withProgressReporter(ctx, &ctrl.Request{ResourceID: resourceID, OperationID: operationID})
+		cancel()
+
+		reporter := v1.OperationProgressReporterFromContext(ctx)
+		require.NotNil(t, reporter)
+		require.NoError(t, reporter(ctx, progress))
+	})
+
+	t.Run("invalid resource ID", func(t *testing.T) {
+		worker := New(Options{}, nil, nil, nil)
+		ctx := worker.withProgressReporter(context.Background(), &ctrl.Request{ResourceID: "invalid", OperationID: operationID})
+		require.Nil(t, v1.OperationProgressReporterFromContext(ctx))
+	})
+}
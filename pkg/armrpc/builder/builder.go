@@ -46,7 +46,8 @@ func defaultHandlerOptions(
 	rootRouter chi.Router,
 	rootScopePath string,
 	namespace string,
-	availableOperations []v1.Operation) []server.HandlerOptions {
+	availableOperations []v1.Operation,
+	apiVersions []v1.ResourceTypeAPIVersions) []server.HandlerOptions {
 	namespace = strings.ToLower(namespace)
 
 	handlers := []server.HandlerOptions{}
@@ -63,6 +64,20 @@ func defaultHandlerOptions(
 		})
 	}
 
+	if len(apiVersions) > 0 {
+		// Lists the API versions supported by each resource type of the namespace so that clients can negotiate a
+		// supported API version.
+		handlers = append(handlers, server.HandlerOptions{
+			ParentRouter: rootRouter,
+			Path:         rootScopePath + "/providers/" + namespace + "/apiversions",
+			ResourceType: namespace + "/apiversions",
+			Method:       v1.OperationGet,
+			ControllerFactory: func(op apictrl.Options) (apictrl.Controller, error) {
+				return defaultoperation.NewGetAPIVersions(op, apiVersions)
+			},
+		})
+	}
+
 	statusType := namespace + "/operationstatuses"
 	resultType := namespace + "/operationresults"
 	handlers = append(handlers, server.HandlerOptions{
@@ -73,6 +88,15 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	// Lists the operation statuses of a resource so that clients can report the progress of running operations.
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationStatuses,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -119,8 +143,22 @@ func NewOpenAPIValidator(ctx context.Context, base, namespace string) (func(h ht
 func (b *Builder) ApplyAPIHandlers(ctx context.Context, r chi.Router, ctrlOpts apictrl.Options, middlewares ...func(h http.Handler) http.Handler) error {
 	rootScopePath := ctrlOpts.PathBase + UCPRootScopePath
 
+	// Record the API versions supported by each resource type. Requests using other API versions are rejected.
+	apiVersions := server.NewAPIVersionRegistry()
+	for _, h := range b.registrations {
+		if h == nil {
+			continue
+		}
+
+		if len(h.APIVersions) > 0 {
+			apiVersions.Register(h.ResourceType, h.APIVersions...)
+		} else {
+			apiVersions.Register(h.ResourceType, b.namespaceNode.apiVersions...)
+		}
+	}
+
 	// Configure the default handlers.
-	handlerOptions := defaultHandlerOptions(r, rootScopePath, b.namespaceNode.Name, b.namespaceNode.availableOperations)
+	handlerOptions := defaultHandlerOptions(r, rootScopePath, b.namespaceNode.Name, b.namespaceNode.availableOperations, apiVersions.List())
 
 	routerMap := map[string]chi.Router{}
 	for _, h := range b.registrations {
@@ -128,6 +166,20 @@ func (b *Builder) ApplyAPIHandlers(ctx context.Context, r chi.Router, ctrlOpts a
 			continue
 		}
 
+		if h.Method == v1.OperationGetHistory {
+			// The resource history API is not described by the OpenAPI spec of the resource type, so it is registered
+			// without the middlewares such as the API validator.
+			handlerOptions = append(handlerOptions, server.HandlerOptions{
+				ParentRouter:       r,
+				Path:               fmt.Sprintf("%s/resourcegroups/{resourceGroupName}/providers/%s%s", rootScopePath, h.ResourceNamePattern, strings.ToLower(h.Path)),
+				ResourceType:       h.ResourceType,
+				Method:             h.Method,
+				ControllerFactory:  h.APIController,
+				APIVersionRegistry: apiVersions,
+			})
+			continue
+		}
+
 		key := ""
 		route := ""
 		switch h.Method {
@@ -147,11 +199,12 @@ func (b *Builder) ApplyAPIHandlers(ctx context.Context, r chi.Router, ctrlOpts a
 		}
 
 		handlerOptions = append(handlerOptions, server.HandlerOptions{
-			ParentRouter:      routerMap[key],
-			Path:              strings.ToLower(h.Path),
-			ResourceType:      h.ResourceType,
-			Method:            h.Method,
-			ControllerFactory: h.APIController,
+			ParentRouter:       routerMap[key],
+			Path:               strings.ToLower(h.Path),
+			ResourceType:       h.ResourceType,
+			Method:             h.Method,
+			ControllerFactory:  h.APIController,
+			APIVersionRegistry: apiVersions,
 		})
 	}
 
@@ -18,7 +18,9 @@ package builder
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-chi/chi/v5"
@@ -98,6 +100,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/containers", Method: "ACTIONGETRESOURCE"},
 		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/getresource",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/containers", Method: v1.OperationGetHistory},
+		Path:          "/resourcegroups/testrg/providers/applications.compute/containers/container0/history",
+		Method:        http.MethodGet,
 	},
 	// applications.compute/containers/secrets
 	{
@@ -160,6 +166,10 @@ var defaultHandlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationList},
+		Path:          "/providers/applications.compute/locations/global/operationstatuses",
+		Method:        http.MethodGet,
 	}, {
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationResults", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
@@ -237,6 +247,58 @@ func TestApplyAPIHandlers_AvailableOperations(t *testing.T) {
 	})
 }
 
+func TestApplyAPIHandlers_APIVersions(t *testing.T) {
+	ns := newTestNamespace(t)
+	ns.SetAPIVersions("2023-10-01-preview")
+	builder := ns.GenerateBuilder()
+
+	ctx := testcontext.New(t)
+	r := chi.NewRouter()
+	options := apictrl.Options{
+		Address:        "localhost:8080",
+		PathBase:       "/api.ucp.dev",
+		DatabaseClient: inmemory.NewClient(),
+		StatusManager:  statusmanager.NewMockStatusManager(gomock.NewController(t)),
+	}
+	err := builder.ApplyAPIHandlers(ctx, r, options)
+	require.NoError(t, err)
+
+	serve := func(uri string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, uri, nil)
+		req = req.WithContext(v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{}))
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("list api versions", func(t *testing.T) {
+		w := serve("/api.ucp.dev/planes/radius/local/providers/applications.compute/apiversions")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		body := struct {
+			Value []v1.ResourceTypeAPIVersions `json:"value"`
+		}{}
+		err := json.Unmarshal(w.Body.Bytes(), &body)
+		require.NoError(t, err)
+		require.NotEmpty(t, body.Value)
+		for _, v := range body.Value {
+			require.Equal(t, []string{"2023-10-01-preview"}, v.APIVersions, "resource type %s", v.ResourceType)
+		}
+	})
+
+	t.Run("unsupported api version", func(t *testing.T) {
+		w := serve("/api.ucp.dev/planes/radius/local/resourcegroups/testrg/providers/applications.compute/virtualmachines/vm0?api-version=2022-01-01")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		armerr := v1.ErrorResponse{}
+		err := json.Unmarshal(w.Body.Bytes(), &armerr)
+		require.NoError(t, err)
+		require.Equal(t, v1.CodeInvalidApiVersionParameter, armerr.Error.Code)
+		require.Len(t, armerr.Error.AdditionalInfo, 1)
+		require.Equal(t, []any{"2023-10-01-preview"}, armerr.Error.AdditionalInfo[0].Info["apiVersions"])
+	})
+}
+
 func TestApplyAsyncHandler(t *testing.T) {
 	ns := newTestNamespace(t)
 	builder := ns.GenerateBuilder()
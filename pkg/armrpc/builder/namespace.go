@@ -28,6 +28,9 @@ type Namespace struct {
 
 	// availableOperations is the list of available operations for the namespace.
 	availableOperations []v1.Operation
+
+	// apiVersions is the list of API versions supported by the resource types of the namespace.
+	apiVersions []string
 }
 
 // NewNamespace creates a new namespace.
@@ -46,6 +49,12 @@ func (p *Namespace) SetAvailableOperations(operations []v1.Operation) {
 	p.availableOperations = operations
 }
 
+// SetAPIVersions sets the API versions supported by the resource types of the namespace. Resource types can override
+// the API versions using ResourceOption.APIVersions.
+func (p *Namespace) SetAPIVersions(versions ...string) {
+	p.apiVersions = versions
+}
+
 // GenerateBuilder Builder object by traversing resource nodes from namespace.
 func (p *Namespace) GenerateBuilder() Builder {
 	return Builder{
@@ -137,8 +137,9 @@ func newTestNamespace(t *testing.T) *Namespace {
 
 	// register containers resource
 	containerResource := ns.AddResource("containers", &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
-		RequestConverter:  rpctest.TestResourceDataModelFromVersioned,
-		ResponseConverter: rpctest.TestResourceDataModelToVersioned,
+		RequestConverter:     rpctest.TestResourceDataModelFromVersioned,
+		ResponseConverter:    rpctest.TestResourceDataModelToVersioned,
+		RevisionHistoryLimit: 10,
 
 		Put: Operation[rpctest.TestResourceDataModel]{
 			APIController: newTestController,
@@ -363,6 +364,12 @@ func TestNamespaceBuild(t *testing.T) {
 			path:                "/getresource",
 			method:              "ACTIONGETRESOURCE",
 		},
+		{
+			resourceType:        "Applications.Compute/containers",
+			resourceNamePattern: "applications.compute/containers/{containerName}",
+			path:                "/history",
+			method:              "GETHISTORY",
+		},
 		{
 			resourceType:        "Applications.Compute/containers/secrets",
 			resourceNamePattern: "applications.compute/containers/{containerName}/secrets",
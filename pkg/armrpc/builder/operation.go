@@ -70,12 +70,26 @@ type ResourceOption[P interface {
 	// If not set, the parameter name will be generated by adding "Name" suffix to the resource name.
 	ResourceParamName string
 
+	// APIVersions is the list of API versions supported by the resource type. This is optional.
+	// If not set, the API versions of the namespace will be used.
+	APIVersions []string
+
+	// RevisionHistoryLimit is the maximum number of prior revisions kept for each resource. This is optional.
+	// If set, the default Put and Patch controllers record the prior revision of updated resources and the
+	// prior revisions can be retrieved using GET on the history path of the resource.
+	RevisionHistoryLimit int
+
 	// RequestConverter is the request converter.
 	RequestConverter v1.ConvertToDataModel[T]
 
 	// ResponseConverter is the response converter.
 	ResponseConverter v1.ConvertToAPIModel[T]
 
+	// Defaulters is a slice of defaulters that apply server-side defaults to the resource on Put and Patch operations.
+	// Defaulters run before the UpdateFilters of the operation. Use controller.ForAPIVersions to apply a default only
+	// for specific API versions.
+	Defaulters []controller.Defaulter[T]
+
 	// ListPlane defines the operation for listing resources by plane scope.
 	ListPlane Operation[T]
 
@@ -127,6 +141,7 @@ func (r *ResourceOption[P, T]) BuildHandlerOutputs(opts BuildOptions) []*Operati
 		r.putOutput,
 		r.patchOutput,
 		r.deleteOutput,
+		r.historyOutput,
 	}
 
 	hs := []*OperationRegistration{}
@@ -135,8 +150,13 @@ func (r *ResourceOption[P, T]) BuildHandlerOutputs(opts BuildOptions) []*Operati
 			hs = append(hs, out)
 		}
 	}
+	hs = append(hs, r.customActionOutputs(opts)...)
+
+	for _, h := range hs {
+		h.APIVersions = r.APIVersions
+	}
 
-	return append(hs, r.customActionOutputs(opts)...)
+	return hs
 }
 
 func (r *ResourceOption[P, T]) listPlaneOutput(opts BuildOptions) *OperationRegistration {
@@ -251,8 +271,10 @@ func (r *ResourceOption[P, T]) putOutput(opts BuildOptions) *OperationRegistrati
 			RequestConverter:         r.RequestConverter,
 			ResponseConverter:        r.ResponseConverter,
 			UpdateFilters:            r.Put.UpdateFilters,
+			Defaulters:               r.Defaulters,
 			AsyncOperationTimeout:    getOrDefaultAsyncOperationTimeout(r.Put.AsyncOperationTimeout),
 			AsyncOperationRetryAfter: getOrDefaultRetryAfter(r.Put.AsyncOperationRetryAfter),
+			RevisionHistoryLimit:     r.RevisionHistoryLimit,
 		}
 
 		if r.Put.AsyncJobController == nil {
@@ -289,8 +311,10 @@ func (r *ResourceOption[P, T]) patchOutput(opts BuildOptions) *OperationRegistra
 			RequestConverter:         r.RequestConverter,
 			ResponseConverter:        r.ResponseConverter,
 			UpdateFilters:            r.Patch.UpdateFilters,
+			Defaulters:               r.Defaulters,
 			AsyncOperationTimeout:    getOrDefaultAsyncOperationTimeout(r.Patch.AsyncOperationTimeout),
 			AsyncOperationRetryAfter: getOrDefaultRetryAfter(r.Patch.AsyncOperationRetryAfter),
+			RevisionHistoryLimit:     r.RevisionHistoryLimit,
 		}
 
 		if r.Patch.AsyncJobController == nil {
@@ -344,6 +368,26 @@ func (r *ResourceOption[P, T]) deleteOutput(opts BuildOptions) *OperationRegistr
 	return h
 }
 
+func (r *ResourceOption[P, T]) historyOutput(opts BuildOptions) *OperationRegistration {
+	if r.RevisionHistoryLimit <= 0 {
+		return nil
+	}
+
+	return &OperationRegistration{
+		ResourceType:        opts.ResourceType,
+		ResourceNamePattern: opts.ResourceNamePattern + "/" + opts.ParameterName,
+		Path:                "/history",
+		Method:              v1.OperationGetHistory,
+		APIController: func(opt controller.Options) (controller.Controller, error) {
+			return defaultoperation.NewGetResourceHistory[P, T](opt,
+				controller.ResourceOptions[T]{
+					ResponseConverter: r.ResponseConverter,
+				},
+			)
+		},
+	}
+}
+
 func (r *ResourceOption[P, T]) customActionOutputs(opts BuildOptions) []*OperationRegistration {
 	handlers := []*OperationRegistration{}
 
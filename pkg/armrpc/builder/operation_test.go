@@ -510,3 +510,28 @@ func TestResourceOption_CustomActionOutput(t *testing.T) {
 		})
 	})
 }
+
+func TestResourceOption_HistoryOutput(t *testing.T) {
+	node := &ResourceNode{Name: "virtualMachines", Kind: TrackedResourceKind}
+
+	t.Run("revision history is disabled", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode: node,
+		}
+		require.Nil(t, option.historyOutput(testBuildOptionsWithName))
+	})
+
+	t.Run("revision history is enabled", func(t *testing.T) {
+		option := &ResourceOption[*rpctest.TestResourceDataModel, rpctest.TestResourceDataModel]{
+			linkedNode:           node,
+			RevisionHistoryLimit: 10,
+		}
+		h := option.historyOutput(testBuildOptionsWithName)
+		require.NotNil(t, h)
+		require.NotNil(t, h.APIController)
+		require.Equal(t, v1.OperationGetHistory, h.Method)
+		require.Equal(t, "Applications.Compute/virtualMachines", h.ResourceType)
+		require.Equal(t, "applications.compute/virtualmachines/{virtualMachineName}", h.ResourceNamePattern)
+		require.Equal(t, "/history", h.Path)
+	})
+}
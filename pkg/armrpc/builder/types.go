@@ -79,4 +79,8 @@ type OperationRegistration struct {
 
 	// AsyncController represents the async controller handler.
 	AsyncController worker.ControllerFactoryFunc
+
+	// APIVersions represents the API versions supported by the resource type. If empty, the API versions of the
+	// namespace are used.
+	APIVersions []string
 }
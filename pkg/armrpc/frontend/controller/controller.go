@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -102,6 +103,10 @@ type ResourceOptions[T any] struct {
 	// UpdateFilters is a slice of filters that execute prior to updating a resource.
 	UpdateFilters []UpdateFilter[T]
 
+	// Defaulters is a slice of defaulters that apply server-side defaults to a resource prior to running the
+	// UpdateFilters and saving the resource.
+	Defaulters []Defaulter[T]
+
 	// AsyncOperationTimeout is the default timeout duration of async put operation.
 	AsyncOperationTimeout time.Duration
 
@@ -115,6 +120,10 @@ type ResourceOptions[T any] struct {
 	//
 	// This is ignored by non-list controllers.
 	ListRecursiveQuery bool
+
+	// RevisionHistoryLimit is the maximum number of prior revisions kept in the revision history of each resource.
+	// Prior revisions are recorded when a resource is updated. Revision history is disabled if this is 0.
+	RevisionHistoryLimit int
 }
 
 // TODO: Remove Controller when all controller uses Operation
@@ -245,3 +254,27 @@ type DeleteFilter[T any] func(ctx context.Context, oldResource *T, options *Opti
 // UpdateFilters should return a rest.Response to handle the request without allowing updates to occur. Any
 // errors returned will be treated as "unhandled" and logged before sending back an HTTP 500.
 type UpdateFilter[T any] func(ctx context.Context, newResource *T, oldResource *T, options *Options) (rest.Response, error)
+
+// Defaulter is a function that is executed as part of the controller lifecycle. Defaulters can be used to:
+//
+// - Set the server-side default value of a property that the client has not specified.
+// - Normalize the value of a property, for example the casing of an enum.
+//
+// Defaulters run after the request has been converted to the data model and before the UpdateFilters, so validation
+// and storage observe the same resource regardless of the client or the API version used to create it. Any errors
+// returned are handled in the same way as errors returned by the request converter.
+type Defaulter[T any] func(ctx context.Context, newResource *T, oldResource *T, options *Options) error
+
+// ForAPIVersions returns a Defaulter that runs the given Defaulter only for requests using one of the given API versions.
+func ForAPIVersions[T any](defaulter Defaulter[T], apiVersions ...string) Defaulter[T] {
+	return func(ctx context.Context, newResource *T, oldResource *T, options *Options) error {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		for _, apiVersion := range apiVersions {
+			if strings.EqualFold(serviceCtx.APIVersion, apiVersion) {
+				return defaulter(ctx, newResource, oldResource, options)
+			}
+		}
+
+		return nil
+	}
+}
@@ -123,6 +123,15 @@ func (c *Operation[P, T]) SaveResource(ctx context.Context, id string, in *T, et
 	return nr.ETag, nil
 }
 
+// SaveRevision records the old resource as the newest prior revision of the resource if revision history is enabled.
+func (c *Operation[P, T]) SaveRevision(ctx context.Context, id string, old *T, etag string) error {
+	if old == nil || c.resourceOptions.RevisionHistoryLimit <= 0 {
+		return nil
+	}
+
+	return database.SaveRevision(ctx, c.DatabaseClient(), id, etag, old, c.resourceOptions.RevisionHistoryLimit)
+}
+
 // PrepareResource validates incoming request and populate the metadata to new resource.
 func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request, newResource *T, oldResource *T, etag string) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -244,6 +253,27 @@ func (b *Operation[P, T]) UpdateFilters() []UpdateFilter[T] {
 	return b.resourceOptions.UpdateFilters
 }
 
+// Defaulters returns the set of defaulters to execute on update (PUT/PATCH) operations.
+func (b *Operation[P, T]) Defaulters() []Defaulter[T] {
+	return b.resourceOptions.Defaulters
+}
+
+// ApplyDefaults runs the defaulters of the resource type on the new resource.
+func (b *Operation[P, T]) ApplyDefaults(ctx context.Context, newResource *T, oldResource *T) error {
+	for _, defaulter := range b.resourceOptions.Defaulters {
+		if err := defaulter(ctx, newResource, oldResource, b.Options()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RevisionHistoryLimit returns the maximum number of prior revisions kept for each resource.
+func (b *Operation[P, T]) RevisionHistoryLimit() int {
+	return b.resourceOptions.RevisionHistoryLimit
+}
+
 // AsyncOperationTimeout returns the timeput for the operation.
 func (b *Operation[P, T]) AsyncOperationTimeout() time.Duration {
 	if b.resourceOptions.AsyncOperationTimeout == 0 {
@@ -140,9 +140,9 @@ func GetNextLinkURL(ctx context.Context, req *http.Request, paginationToken stri
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
 	qps := url.Values{}
-	qps.Add("api-version", serviceCtx.APIVersion)
-	qps.Add("skipToken", paginationToken)
-	qps.Add("top", strconv.Itoa(serviceCtx.Top))
+	qps.Add(v1.APIVersionParameterName, serviceCtx.APIVersion)
+	qps.Add(v1.SkipTokenParameterName, paginationToken)
+	qps.Add(v1.TopParameterName, strconv.Itoa(serviceCtx.Top))
 
 	return GetURLFromReqWithQueryParameters(req, qps).String()
 }
@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 // DefaultAsyncPut is the controller implementation to create or update async resource.
@@ -42,7 +43,7 @@ func NewDefaultAsyncPut[P interface {
 }
 
 // Run executes asynchronous create or update operation by validating new resource metadata, ensuring if it is new resource
-// or updated resource, running custom update filters, and queuing async operation and returns an async response.
+// or updated resource, applying server-side defaults, running custom update filters, and queuing async operation and returns an async response.
 func (e *DefaultAsyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
@@ -59,15 +60,25 @@ func (e *DefaultAsyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter,
 		return r, err
 	}
 
+	if err := e.ApplyDefaults(ctx, newResource, old); err != nil {
+		return nil, err
+	}
+
 	for _, filter := range e.UpdateFilters() {
 		if resp, err := filter(ctx, newResource, old, e.Options()); resp != nil || err != nil {
 			return resp, err
 		}
 	}
 
+	oldEtag := etag
 	if r, err := e.PrepareAsyncOperation(ctx, newResource, v1.ProvisioningStateAccepted, e.AsyncOperationTimeout(), &etag); r != nil || err != nil {
 		return r, err
 	}
 
+	// The update has been saved, so failing to record the prior revision should not fail the request.
+	if err := e.SaveRevision(ctx, serviceCtx.ResourceID.String(), old, oldEtag); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to save the prior revision of the resource")
+	}
+
 	return e.ConstructAsyncResponse(ctx, req.Method, etag, newResource)
 }
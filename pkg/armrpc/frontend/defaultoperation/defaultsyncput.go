@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 // DefaultSyncPut is the controller implementation to create or update resource synchronously.
@@ -42,7 +43,7 @@ func NewDefaultSyncPut[P interface {
 }
 
 // Run executes synchronous create or update operation by validating new resource metadata, ensuring if it is new resource or updated resource,
-// running custom update filters, and upserting resource metadata and returns an resource as a response.
+// applying server-side defaults, running custom update filters, and upserting resource metadata and returns an resource as a response.
 func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
@@ -58,6 +59,10 @@ func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, r
 		return r, err
 	}
 
+	if err := e.ApplyDefaults(ctx, newResource, old); err != nil {
+		return nil, err
+	}
+
 	for _, filter := range e.UpdateFilters() {
 		if resp, err := filter(ctx, newResource, old, e.Options()); resp != nil || err != nil {
 			return resp, err
@@ -70,5 +75,10 @@ func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, r
 		return nil, err
 	}
 
+	// The update has been saved, so failing to record the prior revision should not fail the request.
+	if err := e.SaveRevision(ctx, serviceCtx.ResourceID.String(), old, etag); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to save the prior revision of the resource")
+	}
+
 	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
@@ -26,8 +26,10 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/test/testutil"
 
 	"github.com/stretchr/testify/require"
@@ -239,3 +241,151 @@ func TestDefaultSyncPut_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultSyncPut_RevisionHistory(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+
+	reqModel := &TestResource{}
+	_ = json.Unmarshal(testutil.ReadFixture("resource-sync-request.json"), reqModel)
+
+	oldDataModel := &TestResourceDataModel{}
+	_ = json.Unmarshal(testutil.ReadFixture("resource-sync-datamodel.json"), oldDataModel)
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPatch, resourceTestHeaderFile, reqModel)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(req)
+	sCtx := v1.ARMRequestContextFromContext(ctx)
+
+	old := &database.Object{
+		Metadata: database.Metadata{ID: sCtx.ResourceID.String()},
+		Data:     oldDataModel,
+	}
+	err = databaseClient.Save(ctx, old)
+	require.NoError(t, err)
+
+	opts := ctrl.Options{
+		DatabaseClient: databaseClient,
+	}
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:     testResourceDataModelFromVersioned,
+		ResponseConverter:    testResourceDataModelToVersioned,
+		RevisionHistoryLimit: 5,
+	}
+
+	ctl, err := NewDefaultSyncPut(opts, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	history, err := database.GetRevisionHistory(ctx, databaseClient, sCtx.ResourceID.String())
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 1)
+	require.Equal(t, 1, history.Revisions[0].Revision)
+	require.Equal(t, old.ETag, history.Revisions[0].ETag)
+
+	revision := &TestResourceDataModel{}
+	err = database.DecodeMap(history.Revisions[0].Data, revision)
+	require.NoError(t, err)
+	require.Equal(t, oldDataModel.Name, revision.Name)
+}
+
+func TestDefaultSyncPut_Defaulters(t *testing.T) {
+	setDefaultPropertyB := func(ctx context.Context, newResource *TestResourceDataModel, oldResource *TestResourceDataModel, options *ctrl.Options) error {
+		if newResource.Properties.PropertyB == "" {
+			newResource.Properties.PropertyB = "defaultB"
+		}
+		return nil
+	}
+	setPropertyA := func(value string) ctrl.Defaulter[TestResourceDataModel] {
+		return func(ctx context.Context, newResource *TestResourceDataModel, oldResource *TestResourceDataModel, options *ctrl.Options) error {
+			newResource.Properties.PropertyA = value
+			return nil
+		}
+	}
+
+	t.Run("defaults are applied before update filters and saving", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+
+		reqModel, _, _ := loadTestResurce()
+		reqModel.Properties.PropertyB = nil
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, resourceTestHeaderFile, reqModel)
+		require.NoError(t, err)
+
+		ctx := rpctest.NewARMRequestContext(req)
+		sCtx := v1.ARMRequestContextFromContext(ctx)
+
+		filtered := ""
+		resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+			RequestConverter:  testResourceDataModelFromVersioned,
+			ResponseConverter: testResourceDataModelToVersioned,
+			Defaulters: []ctrl.Defaulter[TestResourceDataModel]{
+				setDefaultPropertyB,
+				ctrl.ForAPIVersions(setPropertyA("2023-10-01-preview"), "2023-10-01-preview"),
+				ctrl.ForAPIVersions(setPropertyA("2099-01-01"), "2099-01-01"),
+			},
+			UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+				func(ctx context.Context, newResource *TestResourceDataModel, oldResource *TestResourceDataModel, options *ctrl.Options) (rest.Response, error) {
+					filtered = newResource.Properties.PropertyB
+					return nil, nil
+				},
+			},
+		}
+
+		ctl, err := NewDefaultSyncPut(ctrl.Options{DatabaseClient: databaseClient}, resourceOpts)
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.Equal(t, "defaultB", filtered)
+
+		obj, err := databaseClient.Get(ctx, sCtx.ResourceID.String())
+		require.NoError(t, err)
+		saved := &TestResourceDataModel{}
+		require.NoError(t, obj.As(saved))
+		require.Equal(t, "defaultB", saved.Properties.PropertyB)
+		require.Equal(t, "2023-10-01-preview", saved.Properties.PropertyA)
+	})
+
+	t.Run("defaulter error", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+
+		reqModel, _, _ := loadTestResurce()
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, resourceTestHeaderFile, reqModel)
+		require.NoError(t, err)
+
+		ctx := rpctest.NewARMRequestContext(req)
+		sCtx := v1.ARMRequestContextFromContext(ctx)
+
+		defaultErr := v1.NewClientErrInvalidRequest("propertyA is invalid")
+		resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+			RequestConverter:  testResourceDataModelFromVersioned,
+			ResponseConverter: testResourceDataModelToVersioned,
+			Defaulters: []ctrl.Defaulter[TestResourceDataModel]{
+				func(ctx context.Context, newResource *TestResourceDataModel, oldResource *TestResourceDataModel, options *ctrl.Options) error {
+					return defaultErr
+				},
+			},
+		}
+
+		ctl, err := NewDefaultSyncPut(ctrl.Options{DatabaseClient: databaseClient}, resourceOpts)
+		require.NoError(t, err)
+
+		_, err = ctl.Run(ctx, w, req)
+		require.ErrorIs(t, err, defaultErr)
+
+		_, err = databaseClient.Get(ctx, sCtx.ResourceID.String())
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: sCtx.ResourceID.String()})
+	})
+}
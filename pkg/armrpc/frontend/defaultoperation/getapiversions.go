@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+var _ ctrl.Controller = (*GetAPIVersions)(nil)
+
+// GetAPIVersions is the controller implementation to get the API versions supported by the resource types of a namespace.
+type GetAPIVersions struct {
+	ctrl.BaseController
+
+	apiVersions []any
+}
+
+// NewGetAPIVersions creates a new GetAPIVersions controller and returns it, or returns an error if one occurs.
+func NewGetAPIVersions(opts ctrl.Options, apiVersionList []v1.ResourceTypeAPIVersions) (ctrl.Controller, error) {
+	versions := []any{}
+	for _, v := range apiVersionList {
+		versions = append(versions, v)
+	}
+	return &GetAPIVersions{ctrl.NewBaseController(opts), versions}, nil
+}
+
+// Run returns the list of resource types of the namespace and the API versions they support.
+func (c *GetAPIVersions) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	return rest.NewOKResponse(&v1.PaginatedList{Value: c.apiVersions}), nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+// GetResourceHistory is the controller implementation to get the prior revisions of a resource.
+type GetResourceHistory[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewGetResourceHistory creates a new GetResourceHistory controller instance.
+func NewGetResourceHistory[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T]) (ctrl.Controller, error) {
+	return &GetResourceHistory[P, T]{
+		ctrl.NewOperation[P](opts, resourceOpts),
+	}, nil
+}
+
+// Run returns the prior revisions of the requested resource, ordered from newest to oldest. Each revision is converted
+// to the requested API version. If the resource does not exist, a not found response is returned.
+func (e *GetResourceHistory[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The request URL refers to the history of the resource, for example: ".../containers/{containerName}/history".
+	id := serviceCtx.ResourceID.Truncate()
+
+	resource, _, err := e.GetResource(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(id), nil
+	}
+
+	history, err := database.GetRevisionHistory(ctx, e.DatabaseClient(), id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	result := v1.ResourceRevisionList{Value: []v1.ResourceRevision{}}
+	for i := len(history.Revisions) - 1; i >= 0; i-- {
+		revision := history.Revisions[i]
+
+		dm := new(T)
+		if err := database.DecodeMap(revision.Data, dm); err != nil {
+			return nil, err
+		}
+
+		versioned, err := e.ResponseConverter()(dm, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Value = append(result.Value, v1.ResourceRevision{
+			Revision:  revision.Revision,
+			ETag:      revision.ETag,
+			Timestamp: revision.Timestamp,
+			Resource:  versioned,
+		})
+	}
+
+	return rest.NewOKResponse(result), nil
+}
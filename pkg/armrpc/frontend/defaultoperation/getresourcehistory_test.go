@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResourceHistoryRun(t *testing.T) {
+	const resourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/resource/test-resource"
+
+	newRequest := func(t *testing.T) (context.Context, *http.Request) {
+		req := httptest.NewRequest(http.MethodGet, resourceID+"/history?api-version="+testAPIVersion, nil)
+		return rpctest.NewARMRequestContext(req), req
+	}
+
+	t.Run("get history of non-existing resource", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		ctx, req := newRequest(t)
+		w := httptest.NewRecorder()
+
+		ctl, err := NewGetResourceHistory(ctrl.Options{DatabaseClient: databaseClient}, ctrl.ResourceOptions[testDataModel]{
+			ResponseConverter: resourceToVersioned,
+		})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("get history of existing resource", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		ctx, req := newRequest(t)
+		w := httptest.NewRecorder()
+
+		err := databaseClient.Save(ctx, &database.Object{
+			Metadata: database.Metadata{ID: resourceID},
+			Data:     &testDataModel{Name: "v3"},
+		})
+		require.NoError(t, err)
+
+		for _, name := range []string{"v1", "v2"} {
+			err := database.SaveRevision(ctx, databaseClient, resourceID, "etag-"+name, &testDataModel{Name: name}, 10)
+			require.NoError(t, err)
+		}
+
+		ctl, err := NewGetResourceHistory(ctrl.Options{DatabaseClient: databaseClient}, ctrl.ResourceOptions[testDataModel]{
+			ResponseConverter: resourceToVersioned,
+		})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := struct {
+			Value []struct {
+				Revision int                `json:"revision"`
+				ETag     string             `json:"etag"`
+				Resource testVersionedModel `json:"resource"`
+			} `json:"value"`
+		}{}
+		err = json.Unmarshal(w.Body.Bytes(), &actual)
+		require.NoError(t, err)
+
+		require.Len(t, actual.Value, 2)
+		require.Equal(t, 2, actual.Value[0].Revision)
+		require.Equal(t, "etag-v2", actual.Value[0].ETag)
+		require.Equal(t, "v2", actual.Value[0].Resource.Name)
+		require.Equal(t, 1, actual.Value[1].Revision)
+		require.Equal(t, "v1", actual.Value[1].Resource.Name)
+	})
+
+	t.Run("get empty history of existing resource", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		ctx, req := newRequest(t)
+		w := httptest.NewRecorder()
+
+		err := databaseClient.Save(ctx, &database.Object{
+			Metadata: database.Metadata{ID: resourceID},
+			Data:     &testDataModel{Name: "v1"},
+		})
+		require.NoError(t, err)
+
+		ctl, err := NewGetResourceHistory(ctrl.Options{DatabaseClient: databaseClient}, ctrl.ResourceOptions[testDataModel]{
+			ResponseConverter: resourceToVersioned,
+		})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := v1.ResourceRevisionList{}
+		err = json.Unmarshal(w.Body.Bytes(), &actual)
+		require.NoError(t, err)
+		require.Empty(t, actual.Value)
+	})
+}
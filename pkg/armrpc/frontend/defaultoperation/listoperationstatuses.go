@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+const (
+	// resourceIDParameterName is the name of the query parameter used to select the resource of the operation statuses.
+	resourceIDParameterName = "resourceId"
+)
+
+var _ ctrl.Controller = (*ListOperationStatuses)(nil)
+
+// ListOperationStatuses is the controller implementation to list the async operation statuses of a resource.
+type ListOperationStatuses struct {
+	ctrl.BaseController
+}
+
+// NewListOperationStatuses creates a new ListOperationStatuses.
+func NewListOperationStatuses(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListOperationStatuses{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the statuses of the asynchronous operations of the resource given by the resourceId query parameter,
+// the most recent operation first. A BadRequest error is returned if the resourceId query parameter is missing.
+func (e *ListOperationStatuses) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceID := req.URL.Query().Get(resourceIDParameterName)
+	if resourceID == "" {
+		return rest.NewBadRequestResponse("The resourceId query parameter is required."), nil
+	}
+
+	result, err := e.DatabaseClient().Query(ctx, database.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: serviceCtx.ResourceID.Type(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := []manager.Status{}
+	for _, item := range result.Items {
+		os := manager.Status{}
+		if err := item.As(&os); err != nil {
+			return nil, err
+		}
+
+		// Resource IDs are case-insensitive.
+		if strings.EqualFold(os.LinkedResourceID, resourceID) {
+			statuses = append(statuses, os)
+		}
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].StartTime.After(statuses[j].StartTime)
+	})
+
+	items := []any{}
+	for _, os := range statuses {
+		items = append(items, os.AsyncOperationStatus)
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{Value: items}), nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operationStatusesCollectionURL = "http://localhost/planes/radius/local/providers/applications.core/locations/global/operationstatuses"
+	operationStatusesLinkedID      = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/c0"
+)
+
+func TestListOperationStatusesRun(t *testing.T) {
+	ctx := context.Background()
+	databaseClient := inmemory.NewClient()
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	statuses := []*manager.Status{
+		{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/op0",
+				Name:      "op0",
+				Status:    v1.ProvisioningStateSucceeded,
+				StartTime: startTime,
+			},
+			LinkedResourceID: operationStatusesLinkedID,
+		},
+		{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/op1",
+				Name:      "op1",
+				Status:    v1.ProvisioningStateUpdating,
+				StartTime: startTime.Add(time.Minute),
+				Progress: &v1.OperationProgress{
+					Stage:           "Deploying",
+					PercentComplete: to.Ptr(30),
+				},
+			},
+			LinkedResourceID: "/planes/radius/local/resourcegroups/RG/providers/Applications.Core/containers/C0",
+		},
+		{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/op2",
+				Name:      "op2",
+				Status:    v1.ProvisioningStateUpdating,
+				StartTime: startTime.Add(2 * time.Minute),
+			},
+			LinkedResourceID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/c1",
+		},
+	}
+	for _, os := range statuses {
+		err := databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: os.ID}, Data: os})
+		require.NoError(t, err)
+	}
+
+	t.Run("missing resourceId", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestWithContent(ctx, http.MethodGet, operationStatusesCollectionURL, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListOperationStatuses(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+
+	t.Run("list statuses of resource", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestWithContent(ctx, http.MethodGet, operationStatusesCollectionURL+"?resourceId="+operationStatusesLinkedID, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListOperationStatuses(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := struct {
+			Value []v1.AsyncOperationStatus `json:"value"`
+		}{}
+		err = json.Unmarshal(w.Body.Bytes(), &actual)
+		require.NoError(t, err)
+
+		require.Len(t, actual.Value, 2)
+		require.Equal(t, "op1", actual.Value[0].Name)
+		require.Equal(t, "Deploying", actual.Value[0].Progress.Stage)
+		require.Equal(t, 30, *actual.Value[0].Progress.PercentComplete)
+		require.Equal(t, "op0", actual.Value[1].Name)
+	})
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+// APIVersionRegistry records the API versions supported by each resource type served by a frontend.
+//
+// Resource types are compared case-insensitively.
+type APIVersionRegistry struct {
+	mu sync.RWMutex
+
+	// resourceTypes maps the lowercase resource type to its original casing.
+	resourceTypes map[string]string
+
+	// versions maps the lowercase resource type to its set of supported API versions.
+	versions map[string]map[string]struct{}
+}
+
+// NewAPIVersionRegistry creates a new empty APIVersionRegistry.
+func NewAPIVersionRegistry() *APIVersionRegistry {
+	return &APIVersionRegistry{
+		resourceTypes: map[string]string{},
+		versions:      map[string]map[string]struct{}{},
+	}
+}
+
+// Register adds the API versions to the versions supported by the resource type.
+func (r *APIVersionRegistry) Register(resourceType string, apiVersions ...string) {
+	if len(apiVersions) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := strings.ToLower(resourceType)
+	if _, ok := r.versions[key]; !ok {
+		r.resourceTypes[key] = resourceType
+		r.versions[key] = map[string]struct{}{}
+	}
+
+	for _, v := range apiVersions {
+		r.versions[key][v] = struct{}{}
+	}
+}
+
+// SupportedVersions returns the sorted API versions supported by the resource type, or an empty list if the
+// resource type is not registered.
+func (r *APIVersionRegistry) SupportedVersions(resourceType string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions := []string{}
+	for v := range r.versions[strings.ToLower(resourceType)] {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// IsSupported returns true if the resource type is not registered or if it supports the API version.
+// Unregistered resource types are not validated by the registry.
+func (r *APIVersionRegistry) IsSupported(resourceType string, apiVersion string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions, ok := r.versions[strings.ToLower(resourceType)]
+	if !ok {
+		return true
+	}
+
+	_, ok = versions[apiVersion]
+	return ok
+}
+
+// List returns the API versions supported by every registered resource type, sorted by resource type.
+func (r *APIVersionRegistry) List() []v1.ResourceTypeAPIVersions {
+	r.mu.RLock()
+	resourceTypes := map[string]string{}
+	keys := []string{}
+	for k := range r.versions {
+		keys = append(keys, k)
+		resourceTypes[k] = r.resourceTypes[k]
+	}
+	r.mu.RUnlock()
+	sort.Strings(keys)
+
+	result := []v1.ResourceTypeAPIVersions{}
+	for _, k := range keys {
+		result = append(result, v1.ResourceTypeAPIVersions{
+			ResourceType: resourceTypes[k],
+			APIVersions:  r.SupportedVersions(k),
+		})
+	}
+	return result
+}
+
+// handlerWithAPIVersionValidation wraps the handler to reject requests using an api-version that is not supported by
+// the resource type of the operation. The error response lists the API versions supported by the resource type.
+func handlerWithAPIVersionValidation(registry *APIVersionRegistry, operationType v1.OperationType, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		apiVersion := req.URL.Query().Get(APIVersionParam)
+		if registry.IsSupported(operationType.Type, apiVersion) {
+			h(w, req)
+			return
+		}
+
+		// Set the operation type in the context so that the rejected request is attributed to the operation.
+		rpcCtx := v1.ARMRequestContextFromContext(req.Context())
+		rpcCtx.OperationType = operationType
+
+		resp := rest.NewUnsupportedAPIVersionResponse(apiVersion, operationType.Type, registry.SupportedVersions(operationType.Type))
+		if err := resp.Apply(req.Context(), w, req); err != nil {
+			HandleError(req.Context(), w, req, err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_APIVersionRegistry(t *testing.T) {
+	registry := NewAPIVersionRegistry()
+	registry.Register("Applications.Core/environments", "2023-10-01-preview", "2022-03-15-privatepreview")
+	registry.Register("applications.core/environments", "2023-10-01-preview")
+	registry.Register("Applications.Core/applications", "2023-10-01-preview")
+	registry.Register("Applications.Core/containers")
+
+	require.Equal(t, []string{"2022-03-15-privatepreview", "2023-10-01-preview"}, registry.SupportedVersions("APPLICATIONS.CORE/ENVIRONMENTS"))
+	require.Equal(t, []string{}, registry.SupportedVersions("Applications.Core/containers"))
+
+	require.True(t, registry.IsSupported("Applications.Core/environments", "2023-10-01-preview"))
+	require.False(t, registry.IsSupported("Applications.Core/environments", "2024-01-01"))
+	require.True(t, registry.IsSupported("Applications.Core/containers", "2024-01-01"), "unregistered resource types are not validated")
+
+	expected := []v1.ResourceTypeAPIVersions{
+		{ResourceType: "Applications.Core/applications", APIVersions: []string{"2023-10-01-preview"}},
+		{ResourceType: "Applications.Core/environments", APIVersions: []string{"2022-03-15-privatepreview", "2023-10-01-preview"}},
+	}
+	require.Equal(t, expected, registry.List())
+}
+
+func Test_handlerWithAPIVersionValidation(t *testing.T) {
+	registry := NewAPIVersionRegistry()
+	registry.Register("Applications.Core/environments", "2023-10-01-preview")
+
+	operationType := v1.OperationType{Type: "Applications.Core/environments", Method: v1.OperationGet}
+	handler := handlerWithAPIVersionValidation(registry, operationType, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("supported api-version", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/resourcegroups/rg/providers/applications.core/environments/env0?api-version=2023-10-01-preview", nil)
+		handler(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("unsupported api-version", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/resourcegroups/rg/providers/applications.core/environments/env0?api-version=2024-01-01", nil)
+		rpcCtx := &v1.ARMRequestContext{}
+		req = req.WithContext(v1.WithARMRequestContext(req.Context(), rpcCtx))
+		handler(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Equal(t, operationType, rpcCtx.OperationType)
+
+		actual := v1.ErrorResponse{}
+		err := json.Unmarshal(w.Body.Bytes(), &actual)
+		require.NoError(t, err)
+
+		expected := v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidApiVersionParameter,
+				Message: "API version '2024-01-01' for type 'Applications.Core/environments' is not supported. The supported api-versions are '2023-10-01-preview'.",
+				AdditionalInfo: []*v1.ErrorAdditionalInfo{
+					{
+						Type: v1.SupportedAPIVersionsInfoType,
+						Info: map[string]any{
+							"resourceType": "Applications.Core/environments",
+							"apiVersions":  []any{"2023-10-01-preview"},
+						},
+					},
+				},
+			},
+		}
+		require.Equal(t, expected, actual)
+	})
+}
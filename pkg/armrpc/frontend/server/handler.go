@@ -81,6 +81,12 @@ type HandlerOptions struct {
 
 	// Middlewares are the middlewares to apply to the handler.
 	Middlewares []func(http.Handler) http.Handler
+
+	// APIVersionRegistry is the registry of API versions supported by resource types. This is optional.
+	//
+	// If specified, requests using an api-version that is not supported by the resource type of the operation are
+	// rejected with the list of supported API versions.
+	APIVersionRegistry *APIVersionRegistry
 }
 
 // NewSubrouter creates a new subrouter and mounts it on the parent router with the given middlewares.
@@ -175,6 +181,10 @@ func RegisterHandler(ctx context.Context, opts HandlerOptions, ctrlOpts ctrl.Opt
 	}
 
 	handler := HandlerForController(ctrl, *opts.OperationType)
+	if opts.APIVersionRegistry != nil {
+		handler = handlerWithAPIVersionValidation(opts.APIVersionRegistry, *opts.OperationType, handler)
+	}
+
 	namedRouter := opts.ParentRouter.With(opts.Middlewares...)
 	if opts.Path == CatchAllPath {
 		namedRouter.HandleFunc(opts.Path, handler)
@@ -251,6 +261,17 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses", rootScopePath, providerNamespace),
+		ResourceType:      statusRT,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationStatuses,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
@@ -290,7 +311,14 @@ func HandleError(ctx context.Context, w http.ResponseWriter, req *http.Request,
 			},
 		})
 	default:
-		if errors.Is(err, v1.ErrInvalidModelConversion) {
+		if errors.Is(err, v1.ErrUnsupportedAPIVersion) {
+			response = rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInvalidApiVersionParameter,
+					Message: fmt.Sprintf("API version '%s' is not supported.", req.URL.Query().Get(APIVersionParam)),
+				},
+			})
+		} else if errors.Is(err, v1.ErrInvalidModelConversion) {
 			response = rest.NewBadRequestARMResponse(v1.ErrorResponse{
 				Error: &v1.ErrorDetails{
 					Code:    v1.CodeHTTPRequestPayloadAPISpecValidationFailed,
@@ -242,6 +242,21 @@ func Test_HandlerErrInvalidModelConversion(t *testing.T) {
 	require.Equal(t, armerr.Error.Message, "invalid model conversion")
 }
 
+func Test_HandlerErrUnsupportedAPIVersion(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/resourcegroups/testrg/providers/applications.core/environments?api-version=2024-01-01", nil)
+	responseWriter := httptest.NewRecorder()
+	HandleError(context.Background(), responseWriter, req, v1.ErrUnsupportedAPIVersion)
+
+	require.Equal(t, http.StatusBadRequest, responseWriter.Code)
+	bodyBytes, e := io.ReadAll(responseWriter.Body)
+	require.NoError(t, e)
+	armerr := v1.ErrorResponse{}
+	e = json.Unmarshal(bodyBytes, &armerr)
+	require.NoError(t, e)
+	require.Equal(t, v1.CodeInvalidApiVersionParameter, armerr.Error.Code)
+	require.Equal(t, "API version '2024-01-01' is not supported.", armerr.Error.Message)
+}
+
 func Test_HandlerErrInternal(t *testing.T) {
 	var handlerTest = struct {
 		url    string
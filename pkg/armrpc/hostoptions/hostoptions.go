@@ -55,6 +55,10 @@ type HostOptions struct {
 
 	// UCPConnection is a connection to the UCP endpoint.
 	UCPConnection sdk.Connection
+
+	// ConfigWatcher watches the configuration file for changes to the settings which can be changed at runtime.
+	// This will default to nil, implying the configuration file is not watched, and is set by the server command.
+	ConfigWatcher *ConfigWatcher[ProviderConfig]
 }
 
 func getArmConfig(cfg *ProviderConfig, ucpconn sdk.Connection) (*armauth.ArmConfig, error) {
@@ -115,8 +119,19 @@ func loadConfig(configPath string) (*ProviderConfig, error) {
 		return nil, err
 	}
 
+	return LoadConfig(buf)
+}
+
+// LoadConfig parses the provider configuration from the given YAML content. References to environment variables and
+// files in the values are resolved by ResolveConfig.
+func LoadConfig(bs []byte) (*ProviderConfig, error) {
+	bs, err := ResolveConfig(bs)
+	if err != nil {
+		return nil, err
+	}
+
 	conf := &ProviderConfig{}
-	decoder := yaml.NewDecoder(bytes.NewBuffer(buf))
+	decoder := yaml.NewDecoder(bytes.NewBuffer(bs))
 	decoder.KnownFields(true)
 
 	err = decoder.Decode(conf)
@@ -18,8 +18,10 @@ package hostoptions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
@@ -46,6 +48,14 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                `yaml:"logging"`
 	Bicep            BicepOptions                         `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                     `yaml:"terraform,omitempty"`
+	ConnectionAgent  ConnectionAgentOptions               `yaml:"connectionAgent,omitempty"`
+	Naming           NamingOptions                        `yaml:"naming,omitempty"`
+	HealthChecks     HealthCheckOptions                   `yaml:"healthChecks,omitempty"`
+	Proxy            httpclient.ProxyOptions              `yaml:"proxy,omitempty"`
+
+	// ExternalRecipeDrivers is the map of the names of external recipe drivers, referenced by recipes with the
+	// "external" template kind, to their options.
+	ExternalRecipeDrivers map[string]ExternalRecipeDriverOptions `yaml:"externalRecipeDrivers,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -94,8 +104,56 @@ type BicepOptions struct {
 	DeleteRetryDelaySeconds string `yaml:"deleteRetryDelaySeconds,omitempty"`
 }
 
+// NamingOptions includes the options of the Kubernetes names generated by the resource provider.
+type NamingOptions struct {
+	// MaxNameLength is the maximum length of the generated Kubernetes names, such as the namespaces of applications.
+	// Longer names are truncated with a hash suffix. Defaults to 63, the maximum length of a namespace.
+	MaxNameLength int `yaml:"maxNameLength,omitempty"`
+}
+
 // TerraformOptions includes options required for terraform execution.
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+
+	// Binary is the Terraform compatible binary used to execute recipes, either "terraform" or "opentofu".
+	// Defaults to "terraform".
+	Binary string `yaml:"binary,omitempty"`
+
+	// Version is the version of the binary to install. Defaults to the latest version.
+	Version string `yaml:"version,omitempty"`
+
+	// SourceURL is an optional base URL to download the binary from instead of the official release site.
+	SourceURL string `yaml:"sourceURL,omitempty"`
+}
+
+// ExternalRecipeDriverOptions includes options of an external recipe driver, an HTTP endpoint executing recipes.
+type ExternalRecipeDriverOptions struct {
+	// URL is the base URL of the endpoint implementing the external recipe driver.
+	URL string `yaml:"url"`
+
+	// TokenFile is the path to a file containing the bearer token used to authenticate to the endpoint, eg: a mounted
+	// Kubernetes secret. The file is read for every request so that the token can be rotated.
+	TokenFile string `yaml:"tokenFile,omitempty"`
+
+	// Timeout is the timeout of a request to the endpoint, eg: "10m". Defaults to 30 minutes.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// ConnectionAgentOptions includes options for the agent fetching the values of container connections materialized at runtime.
+type ConnectionAgentOptions struct {
+	// Image is the image of the agent. Defaults to the latest published image.
+	Image string `yaml:"image,omitempty"`
+}
+
+// HealthCheckOptions includes options for the periodic evaluation of resource health.
+type HealthCheckOptions struct {
+	// Enabled enables the periodic evaluation of resource health.
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// Interval is the interval between two health evaluations, eg: "30s". Defaults to 30 seconds.
+	Interval time.Duration `yaml:"interval,omitempty"`
+
+	// Timeout is the timeout for evaluating the health of a single resource, eg: "10s". Defaults to 10 seconds.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
 }
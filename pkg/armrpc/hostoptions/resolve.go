@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostoptions
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// FileRefPrefix is the prefix of a configuration value which is read from a file, e.g. 'fileRef:/var/secrets/token'.
+	// This is used to read sensitive values from a mounted secret instead of storing them in the configuration file.
+	FileRefPrefix = "fileRef:"
+)
+
+// envVarPattern matches a reference to an environment variable, e.g. '${DATABASE_URL}'.
+var envVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ResolveConfig resolves the references in the values of a YAML configuration file and returns the resolved YAML.
+//
+// - '${NAME}' is replaced with the value of the environment variable NAME. An error is returned if the variable is not set.
+// - A value of the form 'fileRef:<path>' is replaced with the content of the file at <path>, without the trailing newline.
+//
+// Environment variables are replaced before files are read, so the path of a file can reference an environment variable.
+// The content is returned unchanged if it does not contain any reference, so the line numbers of decoding errors match
+// the configuration file.
+func ResolveConfig(bs []byte) ([]byte, error) {
+	root := yaml.Node{}
+	err := yaml.Unmarshal(bs, &root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load yaml: %w", err)
+	}
+
+	// Empty document, nothing to resolve.
+	if root.Kind == 0 {
+		return bs, nil
+	}
+
+	changed, err := resolveNode(&root)
+	if err != nil {
+		return nil, err
+	} else if !changed {
+		return bs, nil
+	}
+
+	return yaml.Marshal(&root)
+}
+
+// resolveNode resolves the scalar values of the node and its children, and returns true if any value was changed.
+func resolveNode(node *yaml.Node) (bool, error) {
+	children := []*yaml.Node{}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return resolveScalar(node)
+	case yaml.MappingNode:
+		// Keys are at even indices and are never resolved.
+		for i := 1; i < len(node.Content); i += 2 {
+			children = append(children, node.Content[i])
+		}
+	case yaml.DocumentNode, yaml.SequenceNode:
+		children = node.Content
+	}
+
+	changed := false
+	for _, child := range children {
+		c, err := resolveNode(child)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || c
+	}
+
+	return changed, nil
+}
+
+func resolveScalar(node *yaml.Node) (bool, error) {
+	value := node.Value
+
+	var err error
+	value = envVarPattern.ReplaceAllStringFunc(value, func(match string) string {
+		name := envVarPattern.FindStringSubmatch(match)[1]
+		v, ok := os.LookupEnv(name)
+		if !ok && err == nil {
+			err = fmt.Errorf("environment variable %q referenced by the configuration is not set", name)
+		}
+		return v
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if path, ok := strings.CutPrefix(value, FileRefPrefix); ok {
+		b, err := os.ReadFile(strings.TrimSpace(path))
+		if err != nil {
+			return false, fmt.Errorf("failed to read file referenced by the configuration: %w", err)
+		}
+		value = strings.TrimRight(string(b), "\r\n")
+	}
+
+	if value == node.Value {
+		return false, nil
+	}
+
+	// Let the resolved value determine its type, e.g. '${PORT}' resolves to an integer.
+	node.Value = value
+	node.Tag = ""
+	return true, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostoptions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig_Resolve(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "token"), []byte("s3cr3t\n"), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("TEST_SERVER_HOST", "localhost")
+	t.Setenv("TEST_SERVER_PORT", "8443")
+	t.Setenv("TEST_SECRET_DIR", dir)
+
+	content := `
+server:
+  host: "${TEST_SERVER_HOST}"
+  port: ${TEST_SERVER_PORT}
+  pathBase: /apis/${TEST_SERVER_HOST}/v1
+ucp:
+  kind: direct
+  direct:
+    endpoint: fileRef:${TEST_SECRET_DIR}/token
+featureFlags:
+  - "${TEST_SERVER_HOST}"
+`
+
+	conf, err := LoadConfig([]byte(content))
+	require.NoError(t, err)
+	require.Equal(t, "localhost", conf.Server.Host)
+	require.Equal(t, 8443, conf.Server.Port)
+	require.Equal(t, "/apis/localhost/v1", conf.Server.PathBase)
+	require.Equal(t, "s3cr3t", conf.UCP.Direct.Endpoint)
+	require.Equal(t, []string{"localhost"}, conf.FeatureFlags)
+}
+
+func TestResolveConfig_Errors(t *testing.T) {
+	t.Run("environment variable not set", func(t *testing.T) {
+		_, err := ResolveConfig([]byte("server:\n  host: ${TEST_UNSET_VARIABLE}\n"))
+		require.EqualError(t, err, `environment variable "TEST_UNSET_VARIABLE" referenced by the configuration is not set`)
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		_, err := ResolveConfig([]byte("server:\n  host: fileRef:" + filepath.Join(t.TempDir(), "missing") + "\n"))
+		require.ErrorContains(t, err, "failed to read file referenced by the configuration")
+	})
+}
+
+func TestResolveConfig_Unchanged(t *testing.T) {
+	content := []byte("server:\n  host: localhost\n  port: 8080\n")
+
+	resolved, err := ResolveConfig(content)
+	require.NoError(t, err)
+	require.Equal(t, string(content), string(resolved))
+
+	resolved, err = ResolveConfig([]byte{})
+	require.NoError(t, err)
+	require.Empty(t, resolved)
+}
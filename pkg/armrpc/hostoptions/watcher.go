@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostoptions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultConfigWatchInterval is the default interval at which the configuration file is checked for changes.
+	DefaultConfigWatchInterval = 10 * time.Second
+)
+
+// ConfigChangeHandler applies the settings of a reloaded configuration.
+type ConfigChangeHandler[T any] func(ctx context.Context, config *T) error
+
+// ConfigWatcher is a service that reloads the configuration file of a service when it changes, and passes the
+// reloaded configuration to the registered handlers. Handlers should only apply the settings which are safe to change
+// while the service is running, such as the logging level or the worker concurrency. Changes to the other settings
+// take effect when the service is restarted.
+//
+// Kubernetes updates a ConfigMap mounted as a volume by swapping a symbolic link, so the watcher periodically compares
+// the content of the file instead of relying on file system events.
+type ConfigWatcher[T any] struct {
+	// Interval is the interval at which the configuration file is checked for changes. Defaults to
+	// DefaultConfigWatchInterval.
+	Interval time.Duration
+
+	path string
+	load func(bs []byte) (*T, error)
+
+	lock     sync.Mutex
+	handlers []ConfigChangeHandler[T]
+}
+
+// NewConfigWatcher creates a ConfigWatcher for the configuration file at the given path. The load function parses
+// the content of the file.
+func NewConfigWatcher[T any](path string, load func(bs []byte) (*T, error)) *ConfigWatcher[T] {
+	return &ConfigWatcher[T]{
+		Interval: DefaultConfigWatchInterval,
+		path:     path,
+		load:     load,
+	}
+}
+
+// OnChange registers a handler which is called with the reloaded configuration when the configuration file changes.
+// Handlers are called in the order they are registered.
+func (w *ConfigWatcher[T]) OnChange(handler ConfigChangeHandler[T]) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.handlers = append(w.handlers, handler)
+}
+
+// Name returns the service name.
+func (w *ConfigWatcher[T]) Name() string {
+	return "config watcher"
+}
+
+// Run checks the configuration file for changes until the context is canceled. The content of the file when the
+// watcher starts is assumed to be the configuration the service was started with.
+func (w *ConfigWatcher[T]) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	content, err := os.ReadFile(w.path)
+	if err != nil {
+		return fmt.Errorf("failed to read configuration file %s: %w", w.path, err)
+	}
+
+	interval := w.Interval
+	if interval == 0 {
+		interval = DefaultConfigWatchInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		updated, err := os.ReadFile(w.path)
+		if err != nil {
+			// The file can be missing briefly while it is replaced, so try again on the next check.
+			logger.Error(err, "failed to read configuration file", "path", w.path)
+			continue
+		}
+
+		if bytes.Equal(content, updated) {
+			continue
+		}
+
+		content = updated
+		w.reload(ctx, updated)
+	}
+}
+
+// reload parses the content of the configuration file and calls the handlers. The current settings are kept when the
+// content cannot be parsed.
+func (w *ConfigWatcher[T]) reload(ctx context.Context, content []byte) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	config, err := w.load(content)
+	if err != nil {
+		logger.Error(err, "failed to parse the changed configuration file, keeping the current settings", "path", w.path)
+		return
+	}
+
+	logger.Info("Configuration file changed, applying the settings which can be changed at runtime", "path", w.path)
+
+	w.lock.Lock()
+	handlers := slices.Clone(w.handlers)
+	w.lock.Unlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, config); err != nil {
+			logger.Error(err, "failed to apply the changed configuration", "path", w.path)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostoptions
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testConfig = `
+logging:
+  level: "info"
+workerServer:
+  maxOperationConcurrency: 10
+`
+
+	testUpdatedConfig = `
+logging:
+  level: "debug"
+workerServer:
+  maxOperationConcurrency: 5
+`
+)
+
+func Test_ConfigWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(testcontext.New(t))
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testConfig), 0644))
+
+	watcher := NewConfigWatcher(path, LoadConfig)
+	watcher.Interval = 10 * time.Millisecond
+
+	changes := make(chan *ProviderConfig, 10)
+	watcher.OnChange(func(ctx context.Context, config *ProviderConfig) error {
+		changes <- config
+		return nil
+	})
+
+	stopped := make(chan error)
+	go func() {
+		stopped <- watcher.Run(ctx)
+	}()
+
+	// Give the watcher time to read the initial configuration.
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, changes)
+
+	require.NoError(t, os.WriteFile(path, []byte(testUpdatedConfig), 0644))
+	select {
+	case config := <-changes:
+		require.Equal(t, ucplog.LoggingOptions{Level: "debug"}, config.Logging)
+		require.Equal(t, 5, *config.WorkerServer.MaxOperationConcurrency)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for the configuration change")
+	}
+
+	// Invalid configuration is ignored.
+	require.NoError(t, os.WriteFile(path, []byte("unknown: true"), 0644))
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, changes)
+
+	cancel()
+	require.ErrorIs(t, <-stopped, context.Canceled)
+}
+
+func Test_ConfigWatcher_MissingFile(t *testing.T) {
+	watcher := NewConfigWatcher(filepath.Join(t.TempDir(), "config.yaml"), LoadConfig)
+	err := watcher.Run(testcontext.New(t))
+	require.ErrorContains(t, err, "failed to read configuration file")
+}
@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/textproto"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -431,6 +432,29 @@ func NewBadRequestResponse(message string) Response {
 	}
 }
 
+// NewUnsupportedAPIVersionResponse creates a BadRequestResponse for a request using an api-version that is not supported
+// by the resource type. The supported API versions are returned in the additional info of the error so that clients can
+// negotiate a supported version.
+func NewUnsupportedAPIVersionResponse(apiVersion string, resourceType string, supportedAPIVersions []string) Response {
+	return &BadRequestResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidApiVersionParameter,
+				Message: fmt.Sprintf("API version '%s' for type '%s' is not supported. The supported api-versions are '%s'.", apiVersion, resourceType, strings.Join(supportedAPIVersions, ", ")),
+				AdditionalInfo: []*v1.ErrorAdditionalInfo{
+					{
+						Type: v1.SupportedAPIVersionsInfoType,
+						Info: map[string]any{
+							"resourceType": resourceType,
+							"apiVersions":  supportedAPIVersions,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 // NewBadRequestARMResponse creates a BadRequestResponse with error message.
 func NewBadRequestARMResponse(body v1.ErrorResponse) Response {
 	return &BadRequestResponse{
@@ -690,6 +714,58 @@ func (r *PreconditionFailedResponse) Apply(ctx context.Context, w http.ResponseW
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewQuotaExceededResponse creates a ForbiddenResponse with CodeQuotaExceeded code for the given target resource and message.
+func NewQuotaExceededResponse(target string, message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeQuotaExceeded,
+				Message: message,
+				Target:  target,
+			},
+		},
+	}
+}
+
+// NewDisallowedByPolicyResponse creates a ForbiddenResponse with CodeRequestDisallowedByPolicy code for the given target
+// resource and message.
+func NewDisallowedByPolicyResponse(target string, message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeRequestDisallowedByPolicy,
+				Message: message,
+				Target:  target,
+			},
+		},
+	}
+}
+
+// Apply renders 403 Forbidden HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // ClientAuthenticationFailed represents an HTTP 401 with an ARM error payload.
 type ClientAuthenticationFailed struct {
 	Body v1.ErrorResponse
@@ -147,6 +147,45 @@ func Test_OKResponse_WithBody(t *testing.T) {
 	require.Equal(t, payload, body)
 }
 
+func Test_QuotaExceededResponse(t *testing.T) {
+	response := NewQuotaExceededResponse("/planes/radius/local/resourceGroups/test-rg", "quota exceeded")
+
+	req := httptest.NewRequest("PUT", "http://example.com", nil)
+	w := httptest.NewRecorder()
+
+	err := response.Apply(context.TODO(), w, req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Equal(t, []string{"application/json"}, w.Header()["Content-Type"])
+
+	body := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeQuotaExceeded, body.Error.Code)
+	require.Equal(t, "quota exceeded", body.Error.Message)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg", body.Error.Target)
+}
+
+func Test_DisallowedByPolicyResponse(t *testing.T) {
+	response := NewDisallowedByPolicyResponse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test", "image not allowed")
+
+	req := httptest.NewRequest("PUT", "http://example.com", nil)
+	w := httptest.NewRecorder()
+
+	err := response.Apply(context.TODO(), w, req)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	body := v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), &body)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeRequestDisallowedByPolicy, body.Error.Code)
+	require.Equal(t, "image not allowed", body.Error.Message)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test", body.Error.Target)
+}
+
 func TestGetAsyncLocationPath(t *testing.T) {
 	operationID := uuid.New()
 
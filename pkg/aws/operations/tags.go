@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operations
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+const (
+	// defaultTagProperty is the property used for tags by most AWS resource types.
+	defaultTagProperty = "Tags"
+)
+
+// resourceTypeTaggingSchema is the part of the resource type schema that describes tagging.
+//
+// See: https://docs.aws.amazon.com/cloudformation-cli/latest/userguide/resource-type-schema.html#schema-properties-tagging
+type resourceTypeTaggingSchema struct {
+	Properties map[string]any `json:"properties,omitempty"`
+	Tagging    *struct {
+		Taggable    *bool  `json:"taggable,omitempty"`
+		TagProperty string `json:"tagProperty,omitempty"`
+	} `json:"tagging,omitempty"`
+}
+
+// ApplyDefaultTags adds the given tags to the desired state of a resource if its resource type supports tagging.
+// Tags specified in the desired state take precedence over the default tags. The tag property is found using the
+// resource type schema, and both the list-of-key-value-pairs and map formats are supported. It returns true if the
+// desired state was modified.
+func ApplyDefaultTags(properties map[string]any, schema []byte, defaultTags map[string]string) (bool, error) {
+	if len(defaultTags) == 0 {
+		return false, nil
+	}
+
+	var resourceTypeSchema resourceTypeTaggingSchema
+	err := json.Unmarshal(schema, &resourceTypeSchema)
+	if err != nil {
+		return false, err
+	}
+
+	tagProperty := defaultTagProperty
+	if resourceTypeSchema.Tagging != nil {
+		if resourceTypeSchema.Tagging.Taggable != nil && !*resourceTypeSchema.Tagging.Taggable {
+			return false, nil
+		}
+
+		if resourceTypeSchema.Tagging.TagProperty != "" {
+			tagProperty, err = ParsePropertyName(resourceTypeSchema.Tagging.TagProperty)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	definition, ok := resourceTypeSchema.Properties[tagProperty].(map[string]any)
+	if !ok {
+		// The resource type does not support tagging.
+		return false, nil
+	}
+
+	switch definition["type"] {
+	case "array":
+		return applyDefaultTagsList(properties, tagProperty, defaultTags), nil
+	case "object":
+		return applyDefaultTagsMap(properties, tagProperty, defaultTags), nil
+	default:
+		// We don't know the format of the tags, so we leave them alone.
+		return false, nil
+	}
+}
+
+// applyDefaultTagsList applies the default tags to a tag property of the form [{"Key": "...", "Value": "..."}].
+func applyDefaultTagsList(properties map[string]any, tagProperty string, defaultTags map[string]string) bool {
+	tags := []any{}
+	if existing, ok := properties[tagProperty]; ok {
+		tags, ok = existing.([]any)
+		if !ok {
+			return false
+		}
+	}
+
+	keys := map[string]bool{}
+	for _, tag := range tags {
+		if tag, ok := tag.(map[string]any); ok {
+			if key, ok := tag["Key"].(string); ok {
+				keys[key] = true
+			}
+		}
+	}
+
+	modified := false
+	for _, key := range sortedKeys(defaultTags) {
+		if keys[key] {
+			continue
+		}
+
+		tags = append(tags, map[string]any{"Key": key, "Value": defaultTags[key]})
+		modified = true
+	}
+
+	if modified {
+		properties[tagProperty] = tags
+	}
+
+	return modified
+}
+
+// applyDefaultTagsMap applies the default tags to a tag property of the form {"key": "value"}.
+func applyDefaultTagsMap(properties map[string]any, tagProperty string, defaultTags map[string]string) bool {
+	tags := map[string]any{}
+	if existing, ok := properties[tagProperty]; ok {
+		tags, ok = existing.(map[string]any)
+		if !ok {
+			return false
+		}
+	}
+
+	modified := false
+	for key, value := range defaultTags {
+		if _, ok := tags[key]; ok {
+			continue
+		}
+
+		tags[key] = value
+		modified = true
+	}
+
+	if modified {
+		properties[tagProperty] = tags
+	}
+
+	return modified
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ApplyDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{
+		"costCenter": "1234",
+		"owner":      "platform",
+	}
+
+	testCases := []struct {
+		name             string
+		schema           string
+		properties       map[string]any
+		defaultTags      map[string]string
+		expected         map[string]any
+		expectedModified bool
+	}{
+		{
+			name:             "list of tags",
+			schema:           `{"properties": {"Tags": {"type": "array", "items": {"$ref": "#/definitions/Tag"}}}}`,
+			properties:       map[string]any{"Name": "test"},
+			defaultTags:      defaultTags,
+			expectedModified: true,
+			expected: map[string]any{
+				"Name": "test",
+				"Tags": []any{
+					map[string]any{"Key": "costCenter", "Value": "1234"},
+					map[string]any{"Key": "owner", "Value": "platform"},
+				},
+			},
+		},
+		{
+			name:   "list of tags specified by user take precedence",
+			schema: `{"properties": {"Tags": {"type": "array"}}, "tagging": {"taggable": true}}`,
+			properties: map[string]any{
+				"Tags": []any{
+					map[string]any{"Key": "owner", "Value": "app-team"},
+				},
+			},
+			defaultTags:      defaultTags,
+			expectedModified: true,
+			expected: map[string]any{
+				"Tags": []any{
+					map[string]any{"Key": "owner", "Value": "app-team"},
+					map[string]any{"Key": "costCenter", "Value": "1234"},
+				},
+			},
+		},
+		{
+			name:             "map of tags with custom tag property",
+			schema:           `{"properties": {"Labels": {"type": "object"}}, "tagging": {"taggable": true, "tagProperty": "/properties/Labels"}}`,
+			properties:       map[string]any{"Labels": map[string]any{"owner": "app-team"}},
+			defaultTags:      defaultTags,
+			expectedModified: true,
+			expected: map[string]any{
+				"Labels": map[string]any{"owner": "app-team", "costCenter": "1234"},
+			},
+		},
+		{
+			name:             "all tags specified by user",
+			schema:           `{"properties": {"Tags": {"type": "object"}}}`,
+			properties:       map[string]any{"Tags": map[string]any{"owner": "app-team", "costCenter": "5678"}},
+			defaultTags:      defaultTags,
+			expectedModified: false,
+			expected:         map[string]any{"Tags": map[string]any{"owner": "app-team", "costCenter": "5678"}},
+		},
+		{
+			name:             "not taggable",
+			schema:           `{"properties": {"Tags": {"type": "array"}}, "tagging": {"taggable": false}}`,
+			properties:       map[string]any{"Name": "test"},
+			defaultTags:      defaultTags,
+			expectedModified: false,
+			expected:         map[string]any{"Name": "test"},
+		},
+		{
+			name:             "no tag property",
+			schema:           `{"properties": {"Name": {"type": "string"}}}`,
+			properties:       map[string]any{"Name": "test"},
+			defaultTags:      defaultTags,
+			expectedModified: false,
+			expected:         map[string]any{"Name": "test"},
+		},
+		{
+			name:             "unknown tag format",
+			schema:           `{"properties": {"Tags": {"$ref": "#/definitions/Tags"}}}`,
+			properties:       map[string]any{"Name": "test"},
+			defaultTags:      defaultTags,
+			expectedModified: false,
+			expected:         map[string]any{"Name": "test"},
+		},
+		{
+			name:             "no default tags",
+			schema:           `{"properties": {"Tags": {"type": "array"}}}`,
+			properties:       map[string]any{"Name": "test"},
+			expectedModified: false,
+			expected:         map[string]any{"Name": "test"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			modified, err := ApplyDefaultTags(tc.properties, []byte(tc.schema), tc.defaultTags)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedModified, modified)
+			require.Equal(t, tc.expected, tc.properties)
+		})
+	}
+}
+
+func Test_ApplyDefaultTags_InvalidSchema(t *testing.T) {
+	_, err := ApplyDefaultTags(map[string]any{}, []byte(`{`), map[string]string{"owner": "platform"})
+	require.Error(t, err)
+
+	_, err = ApplyDefaultTags(map[string]any{}, []byte(`{"tagging": {"tagProperty": "Tags"}}`), map[string]string{"owner": "platform"})
+	require.Error(t, err)
+}
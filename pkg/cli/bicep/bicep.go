@@ -17,11 +17,14 @@ limitations under the License.
 package bicep
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/bicep/tools"
+	"github.com/spf13/viper"
 )
 
 const (
@@ -73,38 +76,56 @@ func DeleteBicep() error {
 // DownloadBicep updates our local copy of bicep
 //
 
-// DownloadBicep() attempts to download a file from a given URI and save it to a local filepath, retrying up to 10 times if
-// the download fails. If an error occurs, an error is returned.
-func DownloadBicep() error {
+// DownloadBicep() attempts to download the Bicep binary for the given options and save it to the local filepath, retrying
+// up to 10 times if the download fails. Downloads of versions that do not exist and binaries that fail checksum
+// validation are not retried. It returns the SHA-256 checksum of the downloaded binary.
+func DownloadBicep(options tools.DownloadOptions) (string, error) {
 	filepath, err := GetBicepFilePath()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	retryAttempts := 10
 	for attempt := 1; attempt <= retryAttempts; attempt++ {
-		success, err := retry(filepath, attempt, retryAttempts)
+		checksum, success, err := retry(filepath, options, attempt, retryAttempts)
 		if err != nil {
-			return err
+			return "", err
 		}
 		if success {
-			break
+			return checksum, nil
 		}
 	}
 
-	return nil
+	return "", nil
 }
 
-func retry(filepath string, attempt, retryAttempts int) (bool, error) {
-	err := tools.DownloadToFolder(filepath)
+func retry(filepath string, options tools.DownloadOptions, attempt, retryAttempts int) (string, bool, error) {
+	checksum, err := tools.DownloadToFolder(filepath, options)
 	if err != nil {
-		if attempt == retryAttempts {
-			return false, fmt.Errorf("failed to download bicep: %v", err)
+		if attempt == retryAttempts || !isRetryable(err) {
+			return "", false, fmt.Errorf("failed to download bicep: %w", err)
 		}
 		fmt.Printf("Attempt %d failed to download bicep: %v\nRetrying...", attempt, err)
 		time.Sleep(retryDelaySecs * time.Second)
-		return false, nil
+		return "", false, nil
 	}
 
-	return true, nil
+	return checksum, true, nil
+}
+
+// isRetryable returns false for download errors that will not be resolved by retrying the download.
+func isRetryable(err error) bool {
+	var checksumErr *tools.ErrChecksumMismatch
+	var notFoundErr tools.ErrToolNotFound
+	return !errors.As(err, &checksumErr) && !errors.As(err, &notFoundErr)
+}
+
+// PinnedDownloadOptions returns the options to download the Bicep version pinned in the given rad CLI configuration.
+// The options download the latest release of Bicep if no version is pinned.
+func PinnedDownloadOptions(config *viper.Viper) (tools.DownloadOptions, error) {
+	section, err := cli.ReadBicepSection(config)
+	if err != nil {
+		return tools.DownloadOptions{}, err
+	}
+
+	return tools.DownloadOptions{Version: section.Version, Checksum: section.Checksum}, nil
 }
@@ -18,6 +18,7 @@ package bicep
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,15 +28,19 @@ import (
 	"strings"
 
 	"github.com/radius-project/radius/pkg/cli/bicep/tools"
+	"github.com/radius-project/radius/pkg/components/trace"
 )
 
 // Official regex for semver
 // https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
 const SemanticVersionRegex = `(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?`
 
+// tracerName is the name of the tracer of the rad CLI.
+const tracerName = "cli"
+
 // Run rad-bicep with the given args and return the stdout. The stderr
-// is not capture but instead redirected to that of the current process.
-func runBicepRaw(args ...string) ([]byte, error) {
+// is redirected to that of the current process, and recorded on the span of the rad-bicep execution.
+func runBicepRaw(ctx context.Context, args ...string) ([]byte, error) {
 	if installed, _ := IsBicepInstalled(); !installed {
 		return nil, fmt.Errorf("rad-bicep not installed, run \"rad bicep download\" to install")
 	}
@@ -45,17 +50,22 @@ func runBicepRaw(args ...string) ([]byte, error) {
 		return nil, fmt.Errorf("failed to find rad-bicep: %w", err)
 	}
 
+	ctx, span := trace.StartProcessSpan(ctx, tracerName, binaryName, args[0])
+	stderr := &trace.StderrBuffer{}
+
 	// runs 'rad-bicep'
 	fullCmd := binPath + " " + strings.Join(args, " ")
-	c := exec.Command(binPath, args...)
-	c.Stderr = os.Stderr
+	c := exec.CommandContext(ctx, binPath, args...)
+	c.Stderr = io.MultiWriter(os.Stderr, stderr)
 	stdout, err := c.StdoutPipe()
 	if err != nil {
+		trace.EndProcessSpan(span, err, "")
 		return nil, fmt.Errorf("failed to create pipe: %w", err)
 	}
 
 	err = c.Start()
 	if err != nil {
+		trace.EndProcessSpan(span, err, "")
 		return nil, fmt.Errorf("failed executing %q: %w", fullCmd, err)
 	}
 
@@ -66,6 +76,7 @@ func runBicepRaw(args ...string) ([]byte, error) {
 
 	// Wait() will wait for us to finish draining stderr before returning the exit code
 	err = c.Wait()
+	trace.EndProcessSpan(span, err, stderr.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed executing %q: %w", fullCmd, err)
 	}
@@ -79,8 +90,8 @@ func runBicepRaw(args ...string) ([]byte, error) {
 	return bytes, nil
 }
 
-func runBicepJson(args ...string) (map[string]any, error) {
-	bytes, err := runBicepRaw(args...)
+func runBicepJson(ctx context.Context, args ...string) (map[string]any, error) {
+	bytes, err := runBicepRaw(ctx, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,10 +110,10 @@ func runBicepJson(args ...string) (map[string]any, error) {
 
 // Build() reads a Bicep file at the given file path and returns a map of the compiled output and an error if the
 // compilation fails.
-func Build(filePath string) (map[string]any, error) {
+func Build(ctx context.Context, filePath string) (map[string]any, error) {
 	// rad-bicep is being told to output the template to stdout and we will capture it
 	// rad-bicep will output compilation errors to stderr which will go to the user's console
-	return runBicepJson("build", "--stdout", filePath)
+	return runBicepJson(ctx, "build", "--stdout", filePath)
 }
 
 // Return a Bicep version.
@@ -112,8 +123,8 @@ func Build(filePath string) (map[string]any, error) {
 
 // Version() attempts to retrieve the version of Bicep by running the command "--version" and returns the version as a
 // string, or an error message if an error occurs.
-func Version() string {
-	bytes, err := runBicepRaw("--version")
+func Version(ctx context.Context) string {
+	bytes, err := runBicepRaw(ctx, "--version")
 	if err != nil {
 		return fmt.Sprintf("unknown (%s)", err)
 	}
@@ -16,6 +16,45 @@ limitations under the License.
 
 package bicep
 
+// DeploymentContextParam is the name of the parameter Radius injects with the deployment context.
+const DeploymentContextParam = "context"
+
+// DeploymentContext represents the values Radius knows about a deployment. It is injected into templates which declare
+// a 'context' parameter so that templates don't need to hardcode them.
+type DeploymentContext struct {
+	// Environment represents the environment the template is deployed to.
+	Environment DeploymentContextResource `json:"environment"`
+	// Application represents the application the template is deployed to. It is empty when no application is specified.
+	Application DeploymentContextResource `json:"application"`
+	// ResourceGroup represents the Radius resource group the template is deployed to.
+	ResourceGroup DeploymentContextResource `json:"resourceGroup"`
+	// Runtime represents the runtime configuration of the environment.
+	Runtime DeploymentContextRuntime `json:"runtime"`
+}
+
+// DeploymentContextResource represents the name and id of a resource in the deployment context.
+type DeploymentContextResource struct {
+	// Name represents the resource name.
+	Name string `json:"name"`
+	// ID represents the fully qualified resource id.
+	ID string `json:"id"`
+}
+
+// DeploymentContextRuntime represents the runtime configuration in the deployment context.
+type DeploymentContextRuntime struct {
+	// Kubernetes represents the Kubernetes runtime configuration.
+	Kubernetes *DeploymentContextKubernetes `json:"kubernetes,omitempty"`
+}
+
+// DeploymentContextKubernetes represents the Kubernetes runtime configuration in the deployment context.
+type DeploymentContextKubernetes struct {
+	// Namespace is the namespace the resources of the application are deployed to. It is the environment namespace
+	// when no application is specified.
+	Namespace string `json:"namespace"`
+	// EnvironmentNamespace is the namespace of the environment.
+	EnvironmentNamespace string `json:"environmentNamespace"`
+}
+
 // InjectEnvironmentParam injects an argument for environment into the parameters if required.
 //
 // - parameters.environment exists && param not passed in -> inject environmentId
@@ -34,7 +73,16 @@ func InjectApplicationParam(deploymentTemplate map[string]any, parameters map[st
 	return injectParam(deploymentTemplate, parameters, "application", applicationId)
 }
 
-func injectParam(deploymentTemplate map[string]any, parameters map[string]map[string]any, parameter string, value string) error {
+// InjectContextParam injects an argument for the deployment context into the parameters if required.
+//
+// - parameters.context exists && param not passed in -> inject deployment context
+// - parameters.context does not exist -> noop
+// - input parameters already include context -> noop.
+func InjectContextParam(deploymentTemplate map[string]any, parameters map[string]map[string]any, deploymentContext DeploymentContext) error {
+	return injectParam(deploymentTemplate, parameters, DeploymentContextParam, deploymentContext)
+}
+
+func injectParam(deploymentTemplate map[string]any, parameters map[string]map[string]any, parameter string, value any) error {
 	formalParams, err := ExtractParameters(deploymentTemplate)
 	if err != nil {
 		return err
@@ -57,6 +57,47 @@ func Test_InjectApplicationParam_InjectedIfParamAvailable(t *testing.T) {
 	require.Equal(t, "/planes/radius/local/resourceGroups/my-rg/providers/Application.Core/applications/my", params["application"]["value"])
 }
 
+func Test_InjectContextParam_InjectedIfParamAvailable(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("testdata", "test-injectcontext.json"))
+	require.NoError(t, err)
+	template := map[string]any{}
+
+	err = json.Unmarshal(input, &template)
+	require.NoError(t, err)
+
+	params := map[string]map[string]any{}
+
+	deploymentContext := DeploymentContext{
+		Environment: DeploymentContextResource{
+			Name: "my",
+			ID:   "/planes/radius/local/resourceGroups/my-rg/providers/Application.Core/environments/my",
+		},
+		ResourceGroup: DeploymentContextResource{
+			Name: "my-rg",
+			ID:   "/planes/radius/local/resourceGroups/my-rg",
+		},
+		Runtime: DeploymentContextRuntime{
+			Kubernetes: &DeploymentContextKubernetes{
+				Namespace:            "default",
+				EnvironmentNamespace: "default",
+			},
+		},
+	}
+	err = InjectContextParam(template, params, deploymentContext)
+	require.NoError(t, err)
+
+	require.Equal(t, deploymentContext, params["context"]["value"])
+
+	b, err := json.Marshal(params["context"]["value"])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"environment": {"name": "my", "id": "/planes/radius/local/resourceGroups/my-rg/providers/Application.Core/environments/my"},
+		"application": {"name": "", "id": ""},
+		"resourceGroup": {"name": "my-rg", "id": "/planes/radius/local/resourceGroups/my-rg"},
+		"runtime": {"kubernetes": {"namespace": "default", "environmentNamespace": "default"}}
+	}`, string(b))
+}
+
 func Test_injectParam_InjectedIfParamAvailable(t *testing.T) {
 	input, err := os.ReadFile(filepath.Join("testdata", "test-injectenvid.json"))
 	require.NoError(t, err)
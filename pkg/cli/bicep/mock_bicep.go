@@ -10,6 +10,7 @@
 package bicep
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -39,18 +40,18 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 }
 
 // PrepareTemplate mocks base method.
-func (m *MockInterface) PrepareTemplate(arg0 string) (map[string]any, error) {
+func (m *MockInterface) PrepareTemplate(arg0 context.Context, arg1 string) (map[string]any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PrepareTemplate", arg0)
+	ret := m.ctrl.Call(m, "PrepareTemplate", arg0, arg1)
 	ret0, _ := ret[0].(map[string]any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PrepareTemplate indicates an expected call of PrepareTemplate.
-func (mr *MockInterfaceMockRecorder) PrepareTemplate(arg0 any) *MockInterfacePrepareTemplateCall {
+func (mr *MockInterfaceMockRecorder) PrepareTemplate(arg0, arg1 any) *MockInterfacePrepareTemplateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareTemplate", reflect.TypeOf((*MockInterface)(nil).PrepareTemplate), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareTemplate", reflect.TypeOf((*MockInterface)(nil).PrepareTemplate), arg0, arg1)
 	return &MockInterfacePrepareTemplateCall{Call: call}
 }
 
@@ -66,13 +67,13 @@ func (c *MockInterfacePrepareTemplateCall) Return(arg0 map[string]any, arg1 erro
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfacePrepareTemplateCall) Do(f func(string) (map[string]any, error)) *MockInterfacePrepareTemplateCall {
+func (c *MockInterfacePrepareTemplateCall) Do(f func(context.Context, string) (map[string]any, error)) *MockInterfacePrepareTemplateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfacePrepareTemplateCall) DoAndReturn(f func(string) (map[string]any, error)) *MockInterfacePrepareTemplateCall {
+func (c *MockInterfacePrepareTemplateCall) DoAndReturn(f func(context.Context, string) (map[string]any, error)) *MockInterfacePrepareTemplateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// modulePattern matches the path of a module declaration, eg: module db 'db.bicep' = { ... }.
+	modulePattern = regexp.MustCompile(`(?m)^\s*module\s+\w+\s+'([^']+)'`)
+	// importPattern matches the path of a compile-time import, eg: import { config } from 'config.bicep'.
+	importPattern = regexp.MustCompile(`(?m)^\s*import\s+.+\s+from\s+'([^']+)'`)
+	// loadPattern matches the path of a file loaded by a function, eg: loadTextContent('script.sh').
+	loadPattern = regexp.MustCompile(`\bload(?:Text|Json|Yaml|FileAsBase64)Content\(\s*'([^']+)'`)
+)
+
+// TemplateFiles returns the files a template is built from: the template file itself, the local Bicep modules and
+// imports it references recursively, and the files loaded with functions like loadTextContent. Modules from a
+// registry are not included. The paths are absolute, and the files that don't exist are included so that they can
+// be watched for creation.
+func TemplateFiles(filePath string) ([]string, error) {
+	root, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	seen := map[string]bool{}
+
+	var visit func(file string)
+	visit = func(file string) {
+		if seen[file] {
+			return
+		}
+
+		seen[file] = true
+		files = append(files, file)
+
+		if !strings.EqualFold(filepath.Ext(file), ".bicep") {
+			return
+		}
+
+		// Missing or unreadable files are reported by Bicep when the template is built.
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return
+		}
+
+		for _, pattern := range []*regexp.Regexp{modulePattern, importPattern, loadPattern} {
+			for _, match := range pattern.FindAllStringSubmatch(string(content), -1) {
+				reference := match[1]
+
+				// References like 'br:...', 'br/public:...' and 'ts:...' are modules from a registry.
+				if strings.Contains(reference, ":") {
+					continue
+				}
+
+				if !filepath.IsAbs(reference) {
+					reference = filepath.Join(filepath.Dir(file), filepath.FromSlash(reference))
+				}
+
+				visit(filepath.Clean(reference))
+			}
+		}
+	}
+
+	visit(root)
+	return files, nil
+}
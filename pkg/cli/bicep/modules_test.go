@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TemplateFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	write("app.bicep", `
+import { config } from 'shared/config.bicep'
+
+module db 'modules/db.bicep' = {
+  name: 'db'
+}
+
+module frontend 'modules/frontend.bicep' = {
+  name: 'frontend'
+}
+
+module redis 'br/public:avm/res/cache/redis:0.1.0' = {
+  name: 'redis'
+}
+
+module missing 'modules/missing.bicep' = {
+  name: 'missing'
+}
+`)
+	write("shared/config.bicep", `
+@export()
+var config = loadJsonContent('config.json')
+`)
+	write("shared/config.json", `{}`)
+	write("modules/db.bicep", `
+module shared '../shared/config.bicep' = {
+  name: 'shared'
+}
+`)
+	write("modules/frontend.bicep", `
+var script = loadTextContent( 'init.sh')
+`)
+	write("modules/init.sh", `echo hello`)
+
+	files, err := TemplateFiles(filepath.Join(dir, "app.bicep"))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "app.bicep"),
+		filepath.Join(dir, "modules", "db.bicep"),
+		filepath.Join(dir, "shared", "config.bicep"),
+		filepath.Join(dir, "shared", "config.json"),
+		filepath.Join(dir, "modules", "frontend.bicep"),
+		filepath.Join(dir, "modules", "init.sh"),
+		filepath.Join(dir, "modules", "missing.bicep"),
+	}, files)
+}
+
+func Test_TemplateFiles_JSON(t *testing.T) {
+	dir := t.TempDir()
+	files, err := TemplateFiles(filepath.Join(dir, "app.json"))
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "app.json")}, files)
+}
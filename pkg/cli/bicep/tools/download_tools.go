@@ -17,21 +17,49 @@ limitations under the License.
 package tools
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path"
 	"runtime"
-
-	"github.com/radius-project/radius/pkg/cli/clients"
+	"strings"
 )
 
 const (
 	// binaryRepo is the name of the remote bicep binary repository
-	binaryRepo = "https://github.com/Azure/bicep/releases/latest/download/"
+	binaryRepo = "https://github.com/Azure/bicep/releases/"
+
+	// LatestVersion is the version used to download the latest release of Bicep.
+	LatestVersion = "latest"
+
+	// checksumPrefix is the optional prefix of SHA-256 checksums, e.g. 'sha256:<checksum>'.
+	checksumPrefix = "sha256:"
 )
 
+// DownloadOptions is the options used to download the Bicep binary.
+type DownloadOptions struct {
+	// Version is the version of Bicep to download, for example 'v0.30.3'. The latest release is downloaded if the
+	// version is empty or 'latest'.
+	Version string
+
+	// Checksum is the expected SHA-256 checksum of the Bicep binary. The checksum is not verified if it is empty.
+	Checksum string
+}
+
+// ErrChecksumMismatch is returned when the checksum of a downloaded binary does not match the expected checksum.
+type ErrChecksumMismatch struct {
+	Expected string
+	Actual   string
+}
+
+// Error returns a string describing the expected and actual checksums.
+func (e *ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("checksum mismatch: expected sha256 %s, got %s", e.Expected, e.Actual)
+}
+
 // validPlatforms is a map of valid platforms to download for. The key is the combination of GOOS and GOARCH.
 var validPlatforms = map[string]string{
 	"windows-amd64": "bicep-win-x64",
@@ -121,61 +149,140 @@ func GetValidPlatform(currentOS, currentArch string) (string, error) {
 	return platform, nil
 }
 
-// DownloadToFolder creates a folder and a file, downloads the bicep binary to the file,
-func DownloadToFolder(filepath string) error {
-	// Create folders
-	err := os.MkdirAll(path.Dir(filepath), os.ModePerm)
-	if err != nil {
-		return fmt.Errorf("failed to create folder %s: %v", path.Dir(filepath), err)
+// NormalizeVersion returns the release tag of the given Bicep version, or LatestVersion if the version is empty.
+// 0.30.3 -> v0.30.3
+func NormalizeVersion(version string) string {
+	version = strings.TrimSpace(version)
+	if version == "" || strings.EqualFold(version, LatestVersion) {
+		return LatestVersion
 	}
 
-	// Create the file
-	bicepBinary, err := os.Create(filepath)
-	if err != nil {
-		return err
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
 	}
-	defer bicepBinary.Close()
 
+	return version
+}
+
+// NormalizeChecksum returns the given SHA-256 checksum in lowercase hex without the optional 'sha256:' prefix. It returns
+// an error if the checksum is not a valid SHA-256 checksum.
+func NormalizeChecksum(checksum string) (string, error) {
+	checksum = strings.ToLower(strings.TrimSpace(checksum))
+	checksum = strings.TrimPrefix(checksum, checksumPrefix)
+
+	decoded, err := hex.DecodeString(checksum)
+	if err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("%q is not a valid sha256 checksum", checksum)
+	}
+
+	return checksum, nil
+}
+
+// GetDownloadURL returns the URL of the Bicep binary with the given name for the given version.
+func GetDownloadURL(binaryName string, version string) string {
+	version = NormalizeVersion(version)
+	if version == LatestVersion {
+		return binaryRepo + "latest/download/" + binaryName
+	}
+
+	return binaryRepo + "download/" + version + "/" + binaryName
+}
+
+// DownloadToFolder creates a folder and downloads the bicep binary for the current platform to the given filepath, verifying
+// the checksum of the binary when one is given. It returns the SHA-256 checksum of the downloaded binary.
+func DownloadToFolder(filepath string, options DownloadOptions) (string, error) {
 	// Get file binary
 	binary, err := GetValidPlatform(runtime.GOOS, runtime.GOARCH)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// Get binaryName extension
 	binaryName, err := getFilename(binary)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	return downloadFile(filepath, GetDownloadURL(binaryName, options.Version), options.Checksum)
+}
+
+// downloadFile downloads the binary at the given URL to the given filepath and marks it as executable. The binary is
+// downloaded to a temporary file first, so that an existing binary is only replaced once the download is complete and
+// verified.
+func downloadFile(filepath string, url string, checksum string) (string, error) {
+	expected := ""
+	if checksum != "" {
+		var err error
+		expected, err = NormalizeChecksum(checksum)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Create folders
+	err := os.MkdirAll(path.Dir(filepath), os.ModePerm)
+	if err != nil {
+		return "", fmt.Errorf("failed to create folder %s: %v", path.Dir(filepath), err)
 	}
 
+	// Create the temporary file
+	bicepBinary, err := os.CreateTemp(path.Dir(filepath), path.Base(filepath)+"-*.download")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = bicepBinary.Close()
+		_ = os.Remove(bicepBinary.Name())
+	}()
+
 	// Get the data
-	resp, err := http.Get(binaryRepo + binaryName)
-	if clients.Is404Error(err) {
-		return fmt.Errorf("unable to locate bicep binary resource %s: %v", binaryRepo+binaryName, err)
-	} else if err != nil {
-		return err
+	resp, err := http.Get(url)
+	if err != nil {
+		return "", err
 	}
 	defer resp.Body.Close()
 
-	// Write the body to file
-	_, err = io.Copy(bicepBinary, resp.Body)
+	if resp.StatusCode == http.StatusNotFound {
+		return "", ErrToolNotFound{Tool: "bicep", Message: fmt.Sprintf("unable to locate bicep binary resource %s", url)}
+	} else if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download bicep binary resource %s: %s", url, resp.Status)
+	}
+
+	// Write the body to file while computing the checksum
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(bicepBinary, hash), resp.Body)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	actual := hex.EncodeToString(hash.Sum(nil))
+	if expected != "" && actual != expected {
+		return "", &ErrChecksumMismatch{Expected: expected, Actual: actual}
 	}
 
 	// Get the filemode so we can mark it as executable
 	file, err := bicepBinary.Stat()
 	if err != nil {
-		return fmt.Errorf("failed to read file attributes %s: %v", filepath, err)
+		return "", fmt.Errorf("failed to read file attributes %s: %v", filepath, err)
 	}
 
 	// Make file executable by everyone
-	err = bicepBinary.Chmod(file.Mode() | 0111)
+	err = bicepBinary.Chmod(file.Mode() | 0755)
+	if err != nil {
+		return "", fmt.Errorf("failed to change permissions for %s: %v", filepath, err)
+	}
+
+	err = bicepBinary.Close()
+	if err != nil {
+		return "", err
+	}
+
+	err = os.Rename(bicepBinary.Name(), filepath)
 	if err != nil {
-		return fmt.Errorf("failed to change permissions for %s: %v", filepath, err)
+		return "", fmt.Errorf("failed to install %s: %v", filepath, err)
 	}
 
-	return nil
+	return actual, nil
 }
 
 func getFilename(base string) (string, error) {
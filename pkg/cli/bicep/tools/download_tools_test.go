@@ -17,7 +17,13 @@ limitations under the License.
 package tools
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -80,3 +86,82 @@ func TestGetValidPlatform(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeVersion(t *testing.T) {
+	require.Equal(t, LatestVersion, NormalizeVersion(""))
+	require.Equal(t, LatestVersion, NormalizeVersion("Latest"))
+	require.Equal(t, "v0.30.3", NormalizeVersion("0.30.3"))
+	require.Equal(t, "v0.30.3", NormalizeVersion("v0.30.3"))
+}
+
+func TestNormalizeChecksum(t *testing.T) {
+	checksum := sha256.Sum256([]byte("bicep"))
+	expected := hex.EncodeToString(checksum[:])
+
+	actual, err := NormalizeChecksum("SHA256:" + expected)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	_, err = NormalizeChecksum("abc123")
+	require.Error(t, err)
+}
+
+func TestGetDownloadURL(t *testing.T) {
+	require.Equal(t, "https://github.com/Azure/bicep/releases/latest/download/bicep-linux-x64", GetDownloadURL("bicep-linux-x64", ""))
+	require.Equal(t, "https://github.com/Azure/bicep/releases/download/v0.30.3/bicep-linux-x64", GetDownloadURL("bicep-linux-x64", "0.30.3"))
+}
+
+func TestDownloadFile(t *testing.T) {
+	content := []byte("bicep binary")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bicep" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	t.Run("success", func(t *testing.T) {
+		binaryPath := filepath.Join(t.TempDir(), "bin", "rad-bicep")
+
+		actual, err := downloadFile(binaryPath, server.URL+"/bicep", "sha256:"+checksum)
+		require.NoError(t, err)
+		require.Equal(t, checksum, actual)
+
+		data, err := os.ReadFile(binaryPath)
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+	})
+
+	t.Run("checksum mismatch keeps existing binary", func(t *testing.T) {
+		binaryPath := filepath.Join(t.TempDir(), "rad-bicep")
+		err := os.WriteFile(binaryPath, []byte("existing"), 0755)
+		require.NoError(t, err)
+
+		mismatch := sha256.Sum256([]byte("other"))
+		_, err = downloadFile(binaryPath, server.URL+"/bicep", hex.EncodeToString(mismatch[:]))
+		checksumErr := &ErrChecksumMismatch{}
+		require.ErrorAs(t, err, &checksumErr)
+		require.Equal(t, checksum, checksumErr.Actual)
+
+		data, err := os.ReadFile(binaryPath)
+		require.NoError(t, err)
+		require.Equal(t, []byte("existing"), data)
+
+		entries, err := os.ReadDir(filepath.Dir(binaryPath))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		binaryPath := filepath.Join(t.TempDir(), "rad-bicep")
+
+		_, err := downloadFile(binaryPath, server.URL+"/v0.0.0/bicep", "")
+		require.ErrorAs(t, err, &ErrToolNotFound{})
+		require.NoFileExists(t, binaryPath)
+	})
+}
@@ -17,19 +17,21 @@ limitations under the License.
 package bicep
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
 	"strings"
 
+	"github.com/radius-project/radius/pkg/cli/bicep/tools"
 	"github.com/radius-project/radius/pkg/cli/output"
-	"github.com/radius-project/radius/pkg/version"
+	"github.com/spf13/viper"
 )
 
 // Interface is the interface for preparing Bicep or ARM-JSON templates for deployment. This interface
 // is designed to be called from the CLI and will print output to the console.
 type Interface interface {
-	PrepareTemplate(filePath string) (map[string]any, error)
+	PrepareTemplate(ctx context.Context, filePath string) (map[string]any, error)
 }
 
 var _ Interface = (*Impl)(nil)
@@ -38,12 +40,15 @@ var _ Interface = (*Impl)(nil)
 
 // Impl is the implementation of Interface.
 type Impl struct {
+	// ConfigProvider returns the rad CLI configuration. It is used to download the pinned version of Bicep when Bicep
+	// is not installed. The latest release of Bicep is downloaded if ConfigProvider is nil.
+	ConfigProvider func() *viper.Viper
 }
 
 // PrepareTemplate checks if the file is a .json or .bicep file, downloads Bicep if it is not installed, checks if the file
 //
 //	exists, and builds the template if it does. It returns a map of strings to any and an error if one occurs.
-func (*Impl) PrepareTemplate(filePath string) (map[string]any, error) {
+func (i *Impl) PrepareTemplate(ctx context.Context, filePath string) (map[string]any, error) {
 	if strings.EqualFold(path.Ext(filePath), ".json") {
 		return ReadARMJSON(filePath)
 	} else if !strings.EqualFold(path.Ext(filePath), ".bicep") {
@@ -56,8 +61,16 @@ func (*Impl) PrepareTemplate(filePath string) (map[string]any, error) {
 	}
 
 	if !ok {
-		output.LogInfo("Downloading Bicep for channel %s...", version.Channel())
-		err = DownloadBicep()
+		options := tools.DownloadOptions{}
+		if i.ConfigProvider != nil {
+			options, err = PinnedDownloadOptions(i.ConfigProvider())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		output.LogInfo("Downloading Bicep %s...", tools.NormalizeVersion(options.Version))
+		_, err = DownloadBicep(options)
 		if err != nil {
 			return nil, fmt.Errorf("failed to download rad-bicep: %w", err)
 		}
@@ -70,7 +83,7 @@ func (*Impl) PrepareTemplate(filePath string) (map[string]any, error) {
 	}
 
 	step := output.BeginStep("Building %s...", filePath)
-	template, err := Build(filePath)
+	template, err := Build(ctx, filePath)
 	if err != nil {
 		return nil, err
 	}
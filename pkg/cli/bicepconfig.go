@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"github.com/spf13/viper"
+)
+
+const (
+	// BicepKey is the key used for the bicep section of the config.
+	BicepKey string = "bicep"
+)
+
+// BicepSection is the section of the config that pins the build of the Bicep compiler downloaded by the CLI. The latest
+// release of Bicep is downloaded unless a version is pinned.
+type BicepSection struct {
+	// Version is the pinned version of Bicep, for example 'v0.30.3'.
+	Version string `json:"version,omitempty" mapstructure:"version" yaml:"version,omitempty"`
+
+	// Checksum is the SHA-256 checksum of the pinned Bicep binary for the platform of this installation. The downloaded
+	// binary is verified against the checksum when it is set.
+	Checksum string `json:"checksum,omitempty" mapstructure:"checksum" yaml:"checksum,omitempty"`
+}
+
+// ReadBicepSection reads the BicepSection from the given viper instance. If the section is not present, an empty
+// section is returned.
+func ReadBicepSection(v *viper.Viper) (BicepSection, error) {
+	section := BicepSection{}
+	if v == nil || !v.IsSet(BicepKey) {
+		return section, nil
+	}
+
+	err := v.UnmarshalKey(BicepKey, &section)
+	if err != nil {
+		return BicepSection{}, err
+	}
+
+	return section, nil
+}
+
+// UpdateBicepSection updates the BicepKey in the given viper instance with the given BicepSection.
+func UpdateBicepSection(v *viper.Viper, section BicepSection) {
+	v.Set(BicepKey, section)
+}
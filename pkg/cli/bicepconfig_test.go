@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BicepSection(t *testing.T) {
+	t.Run("missing section is empty", func(t *testing.T) {
+		section, err := ReadBicepSection(viper.New())
+		require.NoError(t, err)
+		require.Equal(t, BicepSection{}, section)
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		configFilePath := filepath.Join(t.TempDir(), "config.yaml")
+		v := viper.New()
+		v.SetConfigFile(configFilePath)
+
+		expected := BicepSection{Version: "v0.30.3", Checksum: "8e3d8a1b6c2a1fd34a2b4c49bb9b0e0f9a0aa9b2e7ee1e5ad5f2fd33a7e8f8c1"}
+		UpdateBicepSection(v, expected)
+		section, err := ReadBicepSection(v)
+		require.NoError(t, err)
+		require.Equal(t, expected, section)
+
+		err = SaveConfig(v)
+		require.NoError(t, err)
+
+		v, err = LoadConfig(configFilePath)
+		require.NoError(t, err)
+
+		section, err = ReadBicepSection(v)
+		require.NoError(t, err)
+		require.Equal(t, expected, section)
+	})
+}
@@ -20,7 +20,9 @@ import (
 	"context"
 	"io"
 	"os"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -71,6 +73,15 @@ const (
 type ResourceProgress struct {
 	Resource ucpresources.ID
 	Status   ResourceStatus
+
+	// Stage is the current stage of the operation on the resource reported by the resource provider, if any.
+	Stage string
+
+	// PercentComplete is the percent complete of the operation on the resource reported by the resource provider, if any.
+	PercentComplete *int
+
+	// Message is a human readable description of the current stage, if any.
+	Message string
 }
 
 type DeploymentOutput struct {
@@ -78,14 +89,75 @@ type DeploymentOutput struct {
 	Value any    `json:"value"`
 }
 
+// DeploymentOperation is the status of the deployment of a single resource, similar to an ARM deployment operation.
+type DeploymentOperation struct {
+	// Resource is the ID of the deployed resource.
+	Resource ucpresources.ID
+
+	// ProvisioningState is the provisioning state of the resource.
+	ProvisioningState string
+
+	// Error is the error message reported when the deployment of the resource failed.
+	Error string
+
+	// Timestamp is the time of the last update to the operation.
+	Timestamp *time.Time
+
+	// Duration is the duration of the operation in ISO 8601 format.
+	Duration string
+}
+
 type DeploymentResult struct {
 	Resources []ucpresources.ID
 	Outputs   map[string]DeploymentOutput
+
+	// Operations is the status of the deployment of each resource. When the deployment fails, the result returned
+	// with the error contains only the operations.
+	Operations []DeploymentOperation
+}
+
+// WhatIfChangeType is the kind of change a deployment would make to a resource.
+type WhatIfChangeType string
+
+const (
+	// WhatIfChangeCreate is a resource which does not exist and would be created.
+	WhatIfChangeCreate WhatIfChangeType = "Create"
+	// WhatIfChangeModify is a resource which exists and would be changed.
+	WhatIfChangeModify WhatIfChangeType = "Modify"
+	// WhatIfChangeDelete is a resource which exists and would be deleted.
+	WhatIfChangeDelete WhatIfChangeType = "Delete"
+	// WhatIfChangeDeploy is a resource which exists and would be redeployed, its changes cannot be predicted.
+	WhatIfChangeDeploy WhatIfChangeType = "Deploy"
+	// WhatIfChangeNoChange is a resource which exists and would not be changed.
+	WhatIfChangeNoChange WhatIfChangeType = "NoChange"
+	// WhatIfChangeIgnore is a resource which is not declared by the template and would not be changed.
+	WhatIfChangeIgnore WhatIfChangeType = "Ignore"
+	// WhatIfChangeUnsupported is a resource whose changes cannot be computed.
+	WhatIfChangeUnsupported WhatIfChangeType = "Unsupported"
+)
+
+// WhatIfChange is a change a deployment would make to a resource.
+type WhatIfChange struct {
+	Resource   ucpresources.ID
+	ChangeType WhatIfChangeType
+
+	// Properties are the paths of the properties which would be changed, eg: properties.container.image. They are only
+	// set for modified resources.
+	Properties []string
+}
+
+// WhatIfResult is the result of previewing a deployment.
+type WhatIfResult struct {
+	Changes []WhatIfChange
 }
 
 // DeploymentClient is used to deploy ARM-JSON templates (compiled Bicep output).
 type DeploymentClient interface {
 	Deploy(ctx context.Context, options DeploymentOptions) (DeploymentResult, error)
+
+	// WhatIf previews the changes a deployment would make to resources, without deploying the template. The progress
+	// channel of the options is not used.
+	WhatIf(ctx context.Context, options DeploymentOptions) (WhatIfResult, error)
 }
 
 //go:generate mockgen -typed -destination=./mock_diagnosticsclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients DiagnosticsClient
@@ -101,6 +173,7 @@ type ApplicationStatus struct {
 	Name          string
 	ResourceCount int
 	Gateways      []GatewayStatus
+	Health        []ResourceHealthStatus
 }
 
 type GatewayStatus struct {
@@ -108,6 +181,14 @@ type GatewayStatus struct {
 	Endpoint string
 }
 
+// ResourceHealthStatus represents the health reported for a resource of an application.
+type ResourceHealthStatus struct {
+	Name    string
+	Type    string
+	State   string
+	Message string
+}
+
 type EndpointOptions struct {
 	ResourceID ucpresources.ID
 }
@@ -161,12 +242,23 @@ type ApplicationsManagementClient interface {
 	// GetResource retrieves a resource by its type and name (or id).
 	GetResource(ctx context.Context, resourceType string, resourceNameOrID string) (generated.GenericResource, error)
 
+	// ListResourceHistory lists the prior revisions of a resource by its type and name (or id), ordered from newest to oldest.
+	ListResourceHistory(ctx context.Context, resourceType string, resourceNameOrID string) ([]*generated.GenericResourceRevision, error)
+
+	// GetResourceGraph retrieves the resources a resource depends on and the resources which depend on it by its type
+	// and name (or id).
+	GetResourceGraph(ctx context.Context, resourceType string, resourceNameOrID string) (ResourceGraph, error)
+
 	// CreateOrUpdateResource creates or updates a resource using its type name (or id).
 	CreateOrUpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, resource *generated.GenericResource) (generated.GenericResource, error)
 
 	// DeleteResource deletes a resource by its type and name (or id).
 	DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string) (bool, error)
 
+	// RotateSecretStore generates new values for the secrets of a secret store by its name (or id), and restarts the
+	// containers that reference the secrets.
+	RotateSecretStore(ctx context.Context, secretStoreNameOrID string, request corerp.SecretStoreRotateRequest) (corerp.SecretStoreRotateResult, error)
+
 	// ListApplications lists all applications in the configured scope.
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 
@@ -176,6 +268,9 @@ type ApplicationsManagementClient interface {
 	// GetApplicationGraph retrieves the application graph of an application by its name (or id).
 	GetApplicationGraph(ctx context.Context, applicationNameOrID string) (corerp.ApplicationGraphResponse, error)
 
+	// GetApplicationDevProfile retrieves the dev profile of an application by its name (or id).
+	GetApplicationDevProfile(ctx context.Context, applicationNameOrID string) (corerp.ApplicationDevProfileResponse, error)
+
 	// CreateOrUpdateApplication creates or updates an application by its name (or id).
 	CreateOrUpdateApplication(ctx context.Context, applicationNameOrID string, resource *corerp.ApplicationResource) error
 
@@ -197,18 +292,27 @@ type ApplicationsManagementClient interface {
 	// GetRecipeMetadata shows recipe details including list of all parameters for a given recipe registered to an environment.
 	GetRecipeMetadata(ctx context.Context, environmentNameOrID string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
 
+	// PlanRecipe computes the changes the deployment of a recipe registered to an environment would make, without deploying it.
+	PlanRecipe(ctx context.Context, environmentNameOrID string, plan corerp.RecipePlan) (corerp.RecipePlanResponse, error)
+
 	// CreateOrUpdateEnvironment creates an environment by its name (or id).
 	CreateOrUpdateEnvironment(ctx context.Context, environmentNameOrID string, resource *corerp.EnvironmentResource) error
 
 	// DeleteEnvironment deletes an environment and all of its resources by its name (in the configured scope) or resource ID.
 	DeleteEnvironment(ctx context.Context, environmentNameOrID string) (bool, error)
 
+	// ListPlanes lists all planes.
+	ListPlanes(ctx context.Context) ([]ucp_v20231001preview.GenericPlaneResource, error)
+
 	// ListResourceGroups lists all resource groups in the configured scope.
 	ListResourceGroups(ctx context.Context, planeName string) ([]ucp_v20231001preview.ResourceGroupResource, error)
 
 	// GetResourceGroup retrieves a resource group by its name.
 	GetResourceGroup(ctx context.Context, planeName string, resourceGroupName string) (ucp_v20231001preview.ResourceGroupResource, error)
 
+	// GetResourceGroupUsage retrieves the quota and usage of a resource group and of its environments.
+	GetResourceGroupUsage(ctx context.Context, planeName string, resourceGroupName string) (ucp_v20231001preview.ResourceGroupUsage, error)
+
 	// CreateOrUpdateResourceGroup creates a resource group by its name.
 	CreateOrUpdateResourceGroup(ctx context.Context, planeName string, resourceGroupName string, resource *ucp_v20231001preview.ResourceGroupResource) error
 
@@ -224,8 +328,9 @@ type ApplicationsManagementClient interface {
 	// CreateOrUpdateResourceProvider creates or updates a resource provider in the configured scope.
 	CreateOrUpdateResourceProvider(ctx context.Context, planeName string, providerNamespace string, resource *ucp_v20231001preview.ResourceProviderResource) (ucp_v20231001preview.ResourceProviderResource, error)
 
-	// DeleteResourceProvider deletes a resource provider in the configured scope.
-	DeleteResourceProvider(ctx context.Context, planeName string, providerNamespace string) (bool, error)
+	// DeleteResourceProvider deletes a resource provider in the configured scope. The resource provider is not deleted
+	// while resources of its resource types exist, unless force is true.
+	DeleteResourceProvider(ctx context.Context, planeName string, providerNamespace string, force bool) (bool, error)
 
 	// ListResourceProviderSummaries lists the summary data of all resource providers in the configured scope.
 	ListResourceProviderSummaries(ctx context.Context, planeName string) ([]ucp_v20231001preview.ResourceProviderSummary, error)
@@ -244,6 +349,24 @@ type ApplicationsManagementClient interface {
 
 	// CreateOrUpdateLocation creates or updates a resource provider location in the configured scope.
 	CreateOrUpdateLocation(ctx context.Context, planeName string, providerNamespace string, locationName string, resource *ucp_v20231001preview.LocationResource) (ucp_v20231001preview.LocationResource, error)
+
+	// GetTemplateSpecVersion gets a version of a template spec stored in the template spec library of a resource group.
+	GetTemplateSpecVersion(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, versionName string) (ucp_v20231001preview.TemplateSpecVersionResource, error)
+
+	// ListTemplateSpecVersions lists the versions of a template spec stored in the template spec library of a resource group.
+	ListTemplateSpecVersions(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string) ([]ucp_v20231001preview.TemplateSpecVersionResource, error)
+
+	// CreateOrUpdateTemplateSpec creates or updates a template spec in the template spec library of a resource group.
+	CreateOrUpdateTemplateSpec(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, resource *ucp_v20231001preview.TemplateSpecResource) (ucp_v20231001preview.TemplateSpecResource, error)
+
+	// CreateOrUpdateTemplateSpecVersion creates or updates a version of a template spec in the template spec library of a resource group.
+	CreateOrUpdateTemplateSpecVersion(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, versionName string, resource *ucp_v20231001preview.TemplateSpecVersionResource) (ucp_v20231001preview.TemplateSpecVersionResource, error)
+
+	// DeleteTemplateSpecVersion deletes a version of a template spec in the template spec library of a resource group.
+	DeleteTemplateSpecVersion(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, versionName string) (bool, error)
+
+	// GetOperationStatus gets the status of an asynchronous operation of a resource provider by the operation id.
+	GetOperationStatus(ctx context.Context, planeName string, providerNamespace string, operationID string) (v1.AsyncOperationStatus, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
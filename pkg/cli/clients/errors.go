@@ -69,3 +69,17 @@ func Is404Error(err error) bool {
 
 	return false
 }
+
+// Is409ConflictError returns true if the error is a 409 Conflict response from an autorest operation.
+func Is409ConflictError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	responseError := &azcore.ResponseError{}
+	if errors.As(err, &responseError) {
+		return responseError.ErrorCode == v1.CodeConflict || responseError.StatusCode == http.StatusConflict
+	}
+
+	return false
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIs404Error(t *testing.T) {
@@ -69,3 +70,11 @@ func TestIs404Error(t *testing.T) {
 		t.Errorf("Expected Is404Error to return true for fake server not found response, but it returned false")
 	}
 }
+
+func TestIs409ConflictError(t *testing.T) {
+	require.True(t, Is409ConflictError(&azcore.ResponseError{ErrorCode: v1.CodeConflict}))
+	require.True(t, Is409ConflictError(&azcore.ResponseError{StatusCode: http.StatusConflict}))
+	require.False(t, Is409ConflictError(&azcore.ResponseError{StatusCode: http.StatusNotFound}))
+	require.False(t, Is409ConflictError(errors.New("Some other error")))
+	require.False(t, Is409ConflictError(nil))
+}
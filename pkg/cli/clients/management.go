@@ -47,11 +47,15 @@ type UCPApplicationsManagementClient struct {
 	genericResourceClientFactory     func(scope string, resourceType string) (genericResourceClient, error)
 	applicationResourceClientFactory func(scope string) (applicationResourceClient, error)
 	environmentResourceClientFactory func(scope string) (environmentResourceClient, error)
+	secretStoreResourceClientFactory func(scope string) (secretStoreResourceClient, error)
 	resourceGroupClientFactory       func() (resourceGroupClient, error)
 	resourceProviderClientFactory    func() (resourceProviderClient, error)
 	resourceTypeClientFactory        func() (resourceTypeClient, error)
 	apiVersionClientFactory          func() (apiVersionClient, error)
 	locationClientFactory            func() (locationClient, error)
+	planeClientFactory               func() (planeClient, error)
+	templateSpecClientFactory        func() (templateSpecClient, error)
+	templateSpecVersionClientFactory func() (templateSpecVersionClient, error)
 	capture                          func(ctx context.Context, capture **http.Response) context.Context
 }
 
@@ -200,6 +204,64 @@ func (amc *UCPApplicationsManagementClient) GetResource(ctx context.Context, res
 	return getResponse.GenericResource, nil
 }
 
+// ListResourceHistory lists the prior revisions of a resource by its type and name (or id), ordered from newest to oldest.
+func (amc *UCPApplicationsManagementClient) ListResourceHistory(ctx context.Context, resourceType string, resourceNameOrID string) ([]*generated.GenericResourceRevision, error) {
+	scope, name, err := amc.extractScopeAndName(resourceNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := amc.createGenericClient(scope, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.ListHistory(ctx, name, &generated.GenericResourcesClientListHistoryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Value, nil
+}
+
+// GetResourceGraph retrieves the resources a resource depends on and the resources which depend on it by its type
+// and name (or id).
+func (amc *UCPApplicationsManagementClient) GetResourceGraph(ctx context.Context, resourceType string, resourceNameOrID string) (ResourceGraph, error) {
+	resource, err := amc.GetResource(ctx, resourceType, resourceNameOrID)
+	if err != nil {
+		return ResourceGraph{}, err
+	}
+
+	applicationID, _ := resource.Properties["application"].(string)
+	environmentID, _ := resource.Properties["environment"].(string)
+
+	var applicationGraph *corerpv20231001.ApplicationGraphResponse
+	related := []generated.GenericResource{}
+	if applicationID != "" {
+		graph, err := amc.GetApplicationGraph(ctx, applicationID)
+		if err != nil {
+			return ResourceGraph{}, err
+		}
+		applicationGraph = &graph
+
+		resources, err := amc.ListResourcesInApplication(ctx, applicationID)
+		if err != nil {
+			return ResourceGraph{}, err
+		}
+		related = append(related, resources...)
+	}
+
+	if environmentID != "" {
+		resources, err := amc.ListResourcesInEnvironment(ctx, environmentID)
+		if err != nil {
+			return ResourceGraph{}, err
+		}
+		related = append(related, resources...)
+	}
+
+	return computeResourceGraph(resource, applicationGraph, related), nil
+}
+
 // CreateOrUpdateResource creates or updates a resource using its type name (or id).
 func (amc *UCPApplicationsManagementClient) CreateOrUpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, resource *generated.GenericResource) (generated.GenericResource, error) {
 	scope, name, err := amc.extractScopeAndName(resourceNameOrID)
@@ -253,6 +315,27 @@ func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context,
 	return response.StatusCode != 204, nil
 }
 
+// RotateSecretStore generates new values for the secrets of a secret store by its name (or id), and restarts the
+// containers that reference the secrets.
+func (amc *UCPApplicationsManagementClient) RotateSecretStore(ctx context.Context, secretStoreNameOrID string, request corerpv20231001.SecretStoreRotateRequest) (corerpv20231001.SecretStoreRotateResult, error) {
+	scope, name, err := amc.extractScopeAndName(secretStoreNameOrID)
+	if err != nil {
+		return corerpv20231001.SecretStoreRotateResult{}, err
+	}
+
+	client, err := amc.createSecretStoreClient(scope)
+	if err != nil {
+		return corerpv20231001.SecretStoreRotateResult{}, err
+	}
+
+	resp, err := client.Rotate(ctx, name, request, &corerpv20231001.SecretStoresClientRotateOptions{})
+	if err != nil {
+		return corerpv20231001.SecretStoreRotateResult{}, err
+	}
+
+	return resp.SecretStoreRotateResult, nil
+}
+
 // ListApplications lists all applications in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListApplications(ctx context.Context) ([]corerpv20231001.ApplicationResource, error) {
 	client, err := amc.createApplicationClient(amc.RootScope)
@@ -337,6 +420,26 @@ func (amc *UCPApplicationsManagementClient) GetApplicationGraph(ctx context.Cont
 	return getResponse.ApplicationGraphResponse, nil
 }
 
+// GetApplicationDevProfile retrieves the dev profile of an application by its name (or id).
+func (amc *UCPApplicationsManagementClient) GetApplicationDevProfile(ctx context.Context, applicationNameOrID string) (corerpv20231001.ApplicationDevProfileResponse, error) {
+	scope, name, err := amc.extractScopeAndName(applicationNameOrID)
+	if err != nil {
+		return corerpv20231001.ApplicationDevProfileResponse{}, err
+	}
+
+	client, err := amc.createApplicationClient(scope)
+	if err != nil {
+		return corerpv20231001.ApplicationDevProfileResponse{}, err
+	}
+
+	getResponse, err := client.GetDevProfile(ctx, name, map[string]any{}, &corerpv20231001.ApplicationsClientGetDevProfileOptions{})
+	if err != nil {
+		return corerpv20231001.ApplicationDevProfileResponse{}, err
+	}
+
+	return getResponse.ApplicationDevProfileResponse, nil
+}
+
 // CreateOrUpdateApplication creates or updates an application by its name (or id).
 func (amc *UCPApplicationsManagementClient) CreateOrUpdateApplication(ctx context.Context, applicationNameOrID string, resource *corerpv20231001.ApplicationResource) error {
 	scope, name, err := amc.extractScopeAndName(applicationNameOrID)
@@ -545,6 +648,25 @@ func (amc *UCPApplicationsManagementClient) GetRecipeMetadata(ctx context.Contex
 	return resp.RecipeGetMetadataResponse, nil
 }
 
+// PlanRecipe computes the changes the deployment of a recipe registered to an environment would make, without deploying it.
+func (amc *UCPApplicationsManagementClient) PlanRecipe(ctx context.Context, environmentNameOrID string, plan corerpv20231001.RecipePlan) (corerpv20231001.RecipePlanResponse, error) {
+	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+	client, err := amc.createEnvironmentClient(scope)
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	resp, err := client.PlanRecipe(ctx, name, plan, &corerpv20231001.EnvironmentsClientPlanRecipeOptions{})
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	return resp.RecipePlanResponse, nil
+}
+
 // CreateOrUpdateEnvironment creates an environment by its name (or id).
 func (amc *UCPApplicationsManagementClient) CreateOrUpdateEnvironment(ctx context.Context, environmentNameOrID string, resource *corerpv20231001.EnvironmentResource) error {
 	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
@@ -608,6 +730,29 @@ func (amc *UCPApplicationsManagementClient) DeleteEnvironment(ctx context.Contex
 	return response.StatusCode != 204, nil
 }
 
+// ListPlanes lists all planes.
+func (amc *UCPApplicationsManagementClient) ListPlanes(ctx context.Context) ([]ucpv20231001.GenericPlaneResource, error) {
+	client, err := amc.createPlaneClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ucpv20231001.GenericPlaneResource{}
+	pager := client.NewListPlanesPager(&ucpv20231001.PlanesClientListPlanesOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, plane := range page.Value {
+			results = append(results, *plane)
+		}
+	}
+
+	return results, nil
+}
+
 // ListResourceGroups lists all resource groups in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListResourceGroups(ctx context.Context, planeName string) ([]ucpv20231001.ResourceGroupResource, error) {
 	client, err := amc.createResourceGroupClient()
@@ -646,6 +791,21 @@ func (amc *UCPApplicationsManagementClient) GetResourceGroup(ctx context.Context
 	return response.ResourceGroupResource, nil
 }
 
+// GetResourceGroupUsage retrieves the quota and usage of a resource group and of its environments.
+func (amc *UCPApplicationsManagementClient) GetResourceGroupUsage(ctx context.Context, planeName string, resourceGroupName string) (ucpv20231001.ResourceGroupUsage, error) {
+	client, err := amc.createResourceGroupClient()
+	if err != nil {
+		return ucpv20231001.ResourceGroupUsage{}, err
+	}
+
+	response, err := client.GetUsage(ctx, planeName, resourceGroupName, &ucpv20231001.ResourceGroupsClientGetUsageOptions{})
+	if err != nil {
+		return ucpv20231001.ResourceGroupUsage{}, err
+	}
+
+	return response.ResourceGroupUsage, nil
+}
+
 // CreateOrUpdateResourceGroup creates a resource group by its name.
 func (amc *UCPApplicationsManagementClient) CreateOrUpdateResourceGroup(ctx context.Context, planeName string, resourceGroupName string, resourceGroup *ucpv20231001.ResourceGroupResource) error {
 	client, err := amc.createResourceGroupClient()
@@ -743,8 +903,9 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateResourceProvider(ctx c
 	return response.ResourceProviderResource, nil
 }
 
-// DeleteResourceProvider deletes a resource provider in the configured scope.
-func (amc *UCPApplicationsManagementClient) DeleteResourceProvider(ctx context.Context, planeName string, resourceProviderName string) (bool, error) {
+// DeleteResourceProvider deletes a resource provider in the configured scope. The resource provider is not deleted
+// while resources of its resource types exist, unless force is true.
+func (amc *UCPApplicationsManagementClient) DeleteResourceProvider(ctx context.Context, planeName string, resourceProviderName string, force bool) (bool, error) {
 	client, err := amc.createResourceProviderClient()
 	if err != nil {
 		return false, err
@@ -753,7 +914,7 @@ func (amc *UCPApplicationsManagementClient) DeleteResourceProvider(ctx context.C
 	var response *http.Response
 	ctx = amc.captureResponse(ctx, &response)
 
-	poller, err := client.BeginDelete(ctx, planeName, resourceProviderName, &ucpv20231001.ResourceProvidersClientBeginDeleteOptions{})
+	poller, err := client.BeginDelete(ctx, planeName, resourceProviderName, &ucpv20231001.ResourceProvidersClientBeginDeleteOptions{Force: &force})
 	if err != nil {
 		return false, err
 	}
@@ -887,6 +1048,92 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateLocation(ctx context.C
 	return response.LocationResource, nil
 }
 
+// GetTemplateSpecVersion gets a version of a template spec stored in the template spec library of a resource group.
+func (amc *UCPApplicationsManagementClient) GetTemplateSpecVersion(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, versionName string) (ucpv20231001.TemplateSpecVersionResource, error) {
+	client, err := amc.createTemplateSpecVersionClient()
+	if err != nil {
+		return ucpv20231001.TemplateSpecVersionResource{}, err
+	}
+
+	response, err := client.Get(ctx, planeName, resourceGroupName, templateSpecName, versionName, &ucpv20231001.TemplateSpecVersionsClientGetOptions{})
+	if err != nil {
+		return ucpv20231001.TemplateSpecVersionResource{}, err
+	}
+
+	return response.TemplateSpecVersionResource, nil
+}
+
+// ListTemplateSpecVersions lists the versions of a template spec stored in the template spec library of a resource group.
+func (amc *UCPApplicationsManagementClient) ListTemplateSpecVersions(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string) ([]ucpv20231001.TemplateSpecVersionResource, error) {
+	client, err := amc.createTemplateSpecVersionClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ucpv20231001.TemplateSpecVersionResource{}
+	pager := client.NewListPager(planeName, resourceGroupName, templateSpecName, &ucpv20231001.TemplateSpecVersionsClientListOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, version := range page.Value {
+			results = append(results, *version)
+		}
+	}
+
+	return results, nil
+}
+
+// CreateOrUpdateTemplateSpec creates or updates a template spec in the template spec library of a resource group.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateTemplateSpec(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, resource *ucpv20231001.TemplateSpecResource) (ucpv20231001.TemplateSpecResource, error) {
+	client, err := amc.createTemplateSpecClient()
+	if err != nil {
+		return ucpv20231001.TemplateSpecResource{}, err
+	}
+
+	response, err := client.CreateOrUpdate(ctx, planeName, resourceGroupName, templateSpecName, *resource, &ucpv20231001.TemplateSpecsClientCreateOrUpdateOptions{})
+	if err != nil {
+		return ucpv20231001.TemplateSpecResource{}, err
+	}
+
+	return response.TemplateSpecResource, nil
+}
+
+// CreateOrUpdateTemplateSpecVersion creates or updates a version of a template spec in the template spec library of a resource group.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateTemplateSpecVersion(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, versionName string, resource *ucpv20231001.TemplateSpecVersionResource) (ucpv20231001.TemplateSpecVersionResource, error) {
+	client, err := amc.createTemplateSpecVersionClient()
+	if err != nil {
+		return ucpv20231001.TemplateSpecVersionResource{}, err
+	}
+
+	response, err := client.CreateOrUpdate(ctx, planeName, resourceGroupName, templateSpecName, versionName, *resource, &ucpv20231001.TemplateSpecVersionsClientCreateOrUpdateOptions{})
+	if err != nil {
+		return ucpv20231001.TemplateSpecVersionResource{}, err
+	}
+
+	return response.TemplateSpecVersionResource, nil
+}
+
+// DeleteTemplateSpecVersion deletes a version of a template spec in the template spec library of a resource group.
+func (amc *UCPApplicationsManagementClient) DeleteTemplateSpecVersion(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, versionName string) (bool, error) {
+	client, err := amc.createTemplateSpecVersionClient()
+	if err != nil {
+		return false, err
+	}
+
+	var response *http.Response
+	ctx = amc.captureResponse(ctx, &response)
+
+	_, err = client.Delete(ctx, planeName, resourceGroupName, templateSpecName, versionName, &ucpv20231001.TemplateSpecVersionsClientDeleteOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != 204, nil
+}
+
 func (amc *UCPApplicationsManagementClient) createApplicationClient(scope string) (applicationResourceClient, error) {
 	if amc.applicationResourceClientFactory == nil {
 		// Generated client doesn't like the leading '/' in the scope.
@@ -905,6 +1152,15 @@ func (amc *UCPApplicationsManagementClient) createEnvironmentClient(scope string
 	return amc.environmentResourceClientFactory(scope)
 }
 
+func (amc *UCPApplicationsManagementClient) createSecretStoreClient(scope string) (secretStoreResourceClient, error) {
+	if amc.secretStoreResourceClientFactory == nil {
+		// Generated client doesn't like the leading '/' in the scope.
+		return corerpv20231001.NewSecretStoresClient(strings.TrimPrefix(scope, resources.SegmentSeparator), &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.secretStoreResourceClientFactory(scope)
+}
+
 func (amc *UCPApplicationsManagementClient) createGenericClient(scope string, resourceType string) (genericResourceClient, error) {
 	if amc.genericResourceClientFactory == nil {
 		// Generated client doesn't like the leading '/' in the scope.
@@ -954,6 +1210,30 @@ func (amc *UCPApplicationsManagementClient) createLocationClient() (locationClie
 	return amc.locationClientFactory()
 }
 
+func (amc *UCPApplicationsManagementClient) createTemplateSpecClient() (templateSpecClient, error) {
+	if amc.templateSpecClientFactory == nil {
+		return ucpv20231001.NewTemplateSpecsClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.templateSpecClientFactory()
+}
+
+func (amc *UCPApplicationsManagementClient) createTemplateSpecVersionClient() (templateSpecVersionClient, error) {
+	if amc.templateSpecVersionClientFactory == nil {
+		return ucpv20231001.NewTemplateSpecVersionsClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.templateSpecVersionClientFactory()
+}
+
+func (amc *UCPApplicationsManagementClient) createPlaneClient() (planeClient, error) {
+	if amc.planeClientFactory == nil {
+		return ucpv20231001.NewPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.planeClientFactory()
+}
+
 func (amc *UCPApplicationsManagementClient) extractScopeAndName(nameOrID string) (string, string, error) {
 	if strings.HasPrefix(nameOrID, resources.SegmentSeparator) {
 		// Treat this as a resource id.
@@ -34,13 +34,14 @@ import (
 // Because these interfaces are non-exported, they MUST be defined in their own file
 // and we MUST use -source on mockgen to generate mocks for them.
 
-//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient
+//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,secretStoreResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,planeClient,templateSpecClient,templateSpecVersionClient
 
 // genericResourceClient is an interface for mocking the generated SDK client for any resource.
 type genericResourceClient interface {
 	BeginCreateOrUpdate(ctx context.Context, resourceName string, genericResourceParameters generated.GenericResource, options *generated.GenericResourcesClientBeginCreateOrUpdateOptions) (*runtime.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], error)
 	BeginDelete(ctx context.Context, resourceName string, options *generated.GenericResourcesClientBeginDeleteOptions) (*runtime.Poller[generated.GenericResourcesClientDeleteResponse], error)
 	Get(ctx context.Context, resourceName string, options *generated.GenericResourcesClientGetOptions) (generated.GenericResourcesClientGetResponse, error)
+	ListHistory(ctx context.Context, resourceName string, options *generated.GenericResourcesClientListHistoryOptions) (generated.GenericResourcesClientListHistoryResponse, error)
 	NewListByRootScopePager(options *generated.GenericResourcesClientListByRootScopeOptions) *runtime.Pager[generated.GenericResourcesClientListByRootScopeResponse]
 }
 
@@ -51,6 +52,7 @@ type applicationResourceClient interface {
 	Get(ctx context.Context, applicationName string, options *corerpv20231001.ApplicationsClientGetOptions) (corerpv20231001.ApplicationsClientGetResponse, error)
 	NewListByScopePager(options *corerpv20231001.ApplicationsClientListByScopeOptions) *runtime.Pager[corerpv20231001.ApplicationsClientListByScopeResponse]
 
+	GetDevProfile(ctx context.Context, applicationName string, body map[string]any, options *corerpv20231001.ApplicationsClientGetDevProfileOptions) (corerpv20231001.ApplicationsClientGetDevProfileResponse, error)
 	GetGraph(ctx context.Context, applicationName string, body map[string]any, options *corerpv20231001.ApplicationsClientGetGraphOptions) (corerpv20231001.ApplicationsClientGetGraphResponse, error)
 }
 
@@ -62,6 +64,12 @@ type environmentResourceClient interface {
 	NewListByScopePager(options *corerpv20231001.EnvironmentsClientListByScopeOptions) *runtime.Pager[corerpv20231001.EnvironmentsClientListByScopeResponse]
 
 	GetMetadata(ctx context.Context, environmentName string, body corerpv20231001.RecipeGetMetadata, options *corerpv20231001.EnvironmentsClientGetMetadataOptions) (corerpv20231001.EnvironmentsClientGetMetadataResponse, error)
+	PlanRecipe(ctx context.Context, environmentName string, body corerpv20231001.RecipePlan, options *corerpv20231001.EnvironmentsClientPlanRecipeOptions) (corerpv20231001.EnvironmentsClientPlanRecipeResponse, error)
+}
+
+// secretStoreResourceClient is an interface for mocking the generated SDK client for secret store resources.
+type secretStoreResourceClient interface {
+	Rotate(ctx context.Context, secretStoreName string, body corerpv20231001.SecretStoreRotateRequest, options *corerpv20231001.SecretStoresClientRotateOptions) (corerpv20231001.SecretStoresClientRotateResponse, error)
 }
 
 // resourceGroupClient is an interface for mocking the generated SDK client for resource groups.
@@ -69,6 +77,7 @@ type resourceGroupClient interface {
 	CreateOrUpdate(ctx context.Context, planeName string, resourceGroupName string, resource ucpv20231001.ResourceGroupResource, options *ucpv20231001.ResourceGroupsClientCreateOrUpdateOptions) (ucpv20231001.ResourceGroupsClientCreateOrUpdateResponse, error)
 	Delete(ctx context.Context, planeName string, resourceGroupName string, options *ucpv20231001.ResourceGroupsClientDeleteOptions) (ucpv20231001.ResourceGroupsClientDeleteResponse, error)
 	Get(ctx context.Context, planeName string, resourceGroupName string, options *ucpv20231001.ResourceGroupsClientGetOptions) (ucpv20231001.ResourceGroupsClientGetResponse, error)
+	GetUsage(ctx context.Context, planeName string, resourceGroupName string, options *ucpv20231001.ResourceGroupsClientGetUsageOptions) (ucpv20231001.ResourceGroupsClientGetUsageResponse, error)
 	NewListPager(planeName string, options *ucpv20231001.ResourceGroupsClientListOptions) *runtime.Pager[ucpv20231001.ResourceGroupsClientListResponse]
 }
 
@@ -97,3 +106,21 @@ type apiVersionClient interface {
 type locationClient interface {
 	BeginCreateOrUpdate(ctx context.Context, planeName string, resourceProviderName string, locationName string, resource ucpv20231001.LocationResource, options *ucpv20231001.LocationsClientBeginCreateOrUpdateOptions) (*runtime.Poller[ucpv20231001.LocationsClientCreateOrUpdateResponse], error)
 }
+
+// planeClient is an interface for mocking the generated SDK client for planes.
+type planeClient interface {
+	NewListPlanesPager(options *ucpv20231001.PlanesClientListPlanesOptions) *runtime.Pager[ucpv20231001.PlanesClientListPlanesResponse]
+}
+
+// templateSpecClient is an interface for mocking the generated SDK client for template specs.
+type templateSpecClient interface {
+	CreateOrUpdate(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, resource ucpv20231001.TemplateSpecResource, options *ucpv20231001.TemplateSpecsClientCreateOrUpdateOptions) (ucpv20231001.TemplateSpecsClientCreateOrUpdateResponse, error)
+}
+
+// templateSpecVersionClient is an interface for mocking the generated SDK client for template spec versions.
+type templateSpecVersionClient interface {
+	CreateOrUpdate(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, templateSpecVersionName string, resource ucpv20231001.TemplateSpecVersionResource, options *ucpv20231001.TemplateSpecVersionsClientCreateOrUpdateOptions) (ucpv20231001.TemplateSpecVersionsClientCreateOrUpdateResponse, error)
+	Delete(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, templateSpecVersionName string, options *ucpv20231001.TemplateSpecVersionsClientDeleteOptions) (ucpv20231001.TemplateSpecVersionsClientDeleteResponse, error)
+	Get(ctx context.Context, planeName string, resourceGroupName string, templateSpecName string, templateSpecVersionName string, options *ucpv20231001.TemplateSpecVersionsClientGetOptions) (ucpv20231001.TemplateSpecVersionsClientGetResponse, error)
+	NewListPager(planeName string, resourceGroupName string, templateSpecName string, options *ucpv20231001.TemplateSpecVersionsClientListOptions) *runtime.Pager[ucpv20231001.TemplateSpecVersionsClientListResponse]
+}
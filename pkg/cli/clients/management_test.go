@@ -386,6 +386,27 @@ func Test_Application(t *testing.T) {
 		require.Equal(t, expectedGraph, graph)
 	})
 
+	t.Run("GetApplicationDevProfile", func(t *testing.T) {
+		mock := NewMockapplicationResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		expectedProfile := corerp.ApplicationDevProfileResponse{
+			Containers: []*corerp.ApplicationDevProfileContainer{
+				{
+					ID: &testResourceID,
+				},
+			},
+		}
+
+		mock.EXPECT().
+			GetDevProfile(gomock.Any(), testResourceName, gomock.Any(), gomock.Any()).
+			Return(corerp.ApplicationsClientGetDevProfileResponse{ApplicationDevProfileResponse: expectedProfile}, nil)
+
+		profile, err := client.GetApplicationDevProfile(context.Background(), testResourceID)
+		require.NoError(t, err)
+		require.Equal(t, expectedProfile, profile)
+	})
+
 	t.Run("CreateOrUpdateApplication", func(t *testing.T) {
 		mock := NewMockapplicationResourceClient(gomock.NewController(t))
 		client := createClient(mock)
@@ -618,6 +639,34 @@ func Test_Environment(t *testing.T) {
 		require.Equal(t, expectedResult, result)
 	})
 
+	t.Run("PlanRecipe", func(t *testing.T) {
+		mock := NewMockenvironmentResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		plan := corerp.RecipePlan{
+			Name:         to.Ptr("test-recipe"),
+			ResourceType: to.Ptr("Applications.Datastores/redisCaches"),
+		}
+
+		expectedResult := corerp.RecipePlanResponse{
+			TemplateKind: to.Ptr("terraform"),
+			Changes: []*corerp.RecipeResourceChange{
+				{
+					Action: to.Ptr(corerp.RecipeResourceChangeActionCreate),
+					Name:   to.Ptr("redis"),
+				},
+			},
+		}
+
+		mock.EXPECT().
+			PlanRecipe(gomock.Any(), testResourceName, plan, gomock.Any()).
+			Return(corerp.EnvironmentsClientPlanRecipeResponse{RecipePlanResponse: expectedResult}, nil)
+
+		result, err := client.PlanRecipe(context.Background(), testResourceID, plan)
+		require.NoError(t, err)
+		require.Equal(t, expectedResult, result)
+	})
+
 	t.Run("CreateOrUpdateEnviroment", func(t *testing.T) {
 		mock := NewMockenvironmentResourceClient(gomock.NewController(t))
 		client := createClient(mock)
@@ -723,6 +772,43 @@ func Test_Environment(t *testing.T) {
 	})
 }
 
+func Test_SecretStore(t *testing.T) {
+	createClient := func(wrapped secretStoreResourceClient) *UCPApplicationsManagementClient {
+		return &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			secretStoreResourceClientFactory: func(scope string) (secretStoreResourceClient, error) {
+				return wrapped, nil
+			},
+			capture: testCapture,
+		}
+	}
+
+	testResourceName := "test-secret-store"
+	testResourceID := testScope + "/providers/Applications.Core/secretStores/" + testResourceName
+
+	t.Run("RotateSecretStore", func(t *testing.T) {
+		mock := NewMocksecretStoreResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		request := corerp.SecretStoreRotateRequest{
+			Keys: []*string{to.Ptr("password")},
+		}
+
+		expectedResult := corerp.SecretStoreRotateResult{
+			RotatedKeys:         []*string{to.Ptr("password")},
+			RestartedContainers: []*string{to.Ptr(testScope + "/providers/Applications.Core/containers/frontend")},
+		}
+
+		mock.EXPECT().
+			Rotate(gomock.Any(), testResourceName, request, gomock.Any()).
+			Return(corerp.SecretStoresClientRotateResponse{SecretStoreRotateResult: expectedResult}, nil)
+
+		result, err := client.RotateSecretStore(context.Background(), testResourceID, request)
+		require.NoError(t, err)
+		require.Equal(t, expectedResult, result)
+	})
+}
+
 func Test_ResourceGroup(t *testing.T) {
 	createClient := func(wrapped resourceGroupClient) *UCPApplicationsManagementClient {
 		return &UCPApplicationsManagementClient{
@@ -948,13 +1034,13 @@ func Test_ResourceProvider(t *testing.T) {
 		client := createClient(mock)
 
 		mock.EXPECT().
-			BeginDelete(gomock.Any(), "local", testResourceProviderName, gomock.Any()).
+			BeginDelete(gomock.Any(), "local", testResourceProviderName, &ucp.ResourceProvidersClientBeginDeleteOptions{Force: to.Ptr(true)}).
 			DoAndReturn(func(ctx context.Context, s1, s2 string, rgcdo *ucp.ResourceProvidersClientBeginDeleteOptions) (*runtime.Poller[ucp.ResourceProvidersClientDeleteResponse], error) {
 				setCapture(ctx, &http.Response{StatusCode: 200})
 				return poller(&ucp.ResourceProvidersClientDeleteResponse{}), nil
 			})
 
-		deleted, err := client.DeleteResourceProvider(context.Background(), "local", testResourceProviderName)
+		deleted, err := client.DeleteResourceProvider(context.Background(), "local", testResourceProviderName, true)
 		require.NoError(t, err)
 		require.True(t, deleted)
 	})
@@ -1181,6 +1267,176 @@ func Test_Location(t *testing.T) {
 	})
 }
 
+func Test_Plane(t *testing.T) {
+	createClient := func(wrapped planeClient) *UCPApplicationsManagementClient {
+		return &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			planeClientFactory: func() (planeClient, error) {
+				return wrapped, nil
+			},
+			capture: testCapture,
+		}
+	}
+
+	t.Run("ListPlanes", func(t *testing.T) {
+		mock := NewMockplaneClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		planePages := []ucp.PlanesClientListPlanesResponse{
+			{
+				GenericPlaneResourceListResult: ucp.GenericPlaneResourceListResult{
+					Value: []*ucp.GenericPlaneResource{
+						{
+							ID:       to.Ptr("/planes/radius/local"),
+							Name:     to.Ptr("local"),
+							Type:     to.Ptr("System.Radius/planes"),
+							Location: to.Ptr(v1.LocationGlobal),
+						},
+					},
+					NextLink: to.Ptr("0"),
+				},
+			},
+			{
+				GenericPlaneResourceListResult: ucp.GenericPlaneResourceListResult{
+					Value: []*ucp.GenericPlaneResource{
+						{
+							ID:       to.Ptr("/planes/aws/aws"),
+							Name:     to.Ptr("aws"),
+							Type:     to.Ptr("System.AWS/planes"),
+							Location: to.Ptr(v1.LocationGlobal),
+						},
+					},
+					NextLink: to.Ptr("1"),
+				},
+			},
+		}
+
+		mock.EXPECT().
+			NewListPlanesPager(gomock.Any()).
+			Return(pager(planePages))
+
+		expected := []ucp.GenericPlaneResource{*planePages[0].Value[0], *planePages[1].Value[0]}
+
+		planes, err := client.ListPlanes(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, expected, planes)
+	})
+}
+
+func Test_TemplateSpecVersion(t *testing.T) {
+	createClient := func(wrapped templateSpecVersionClient) *UCPApplicationsManagementClient {
+		return &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			templateSpecVersionClientFactory: func() (templateSpecVersionClient, error) {
+				return wrapped, nil
+			},
+			capture: testCapture,
+		}
+	}
+
+	testTemplateSpecName := "myapp"
+	testVersionName := "1.0.0"
+
+	expectedResource := ucp.TemplateSpecVersionResource{
+		ID:   to.Ptr("/planes/radius/local/resourcegroups/test-group/providers/System.Resources/templateSpecs/" + testTemplateSpecName + "/versions/" + testVersionName),
+		Name: &testVersionName,
+		Type: to.Ptr("System.Resources/templateSpecs/versions"),
+		Properties: &ucp.TemplateSpecVersionProperties{
+			Template: map[string]any{"resources": map[string]any{}},
+		},
+	}
+
+	t.Run("GetTemplateSpecVersion", func(t *testing.T) {
+		mock := NewMocktemplateSpecVersionClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			Get(gomock.Any(), "local", "test-group", testTemplateSpecName, testVersionName, gomock.Any()).
+			Return(ucp.TemplateSpecVersionsClientGetResponse{TemplateSpecVersionResource: expectedResource}, nil)
+
+		result, err := client.GetTemplateSpecVersion(context.Background(), "local", "test-group", testTemplateSpecName, testVersionName)
+		require.NoError(t, err)
+		require.Equal(t, expectedResource, result)
+	})
+
+	t.Run("ListTemplateSpecVersions", func(t *testing.T) {
+		mock := NewMocktemplateSpecVersionClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		pages := []ucp.TemplateSpecVersionsClientListResponse{
+			{
+				TemplateSpecVersionResourceListResult: ucp.TemplateSpecVersionResourceListResult{
+					Value:    []*ucp.TemplateSpecVersionResource{&expectedResource},
+					NextLink: to.Ptr("0"),
+				},
+			},
+		}
+
+		mock.EXPECT().
+			NewListPager("local", "test-group", testTemplateSpecName, gomock.Any()).
+			Return(pager(pages))
+
+		result, err := client.ListTemplateSpecVersions(context.Background(), "local", "test-group", testTemplateSpecName)
+		require.NoError(t, err)
+		require.Equal(t, []ucp.TemplateSpecVersionResource{expectedResource}, result)
+	})
+
+	t.Run("CreateOrUpdateTemplateSpecVersion", func(t *testing.T) {
+		mock := NewMocktemplateSpecVersionClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			CreateOrUpdate(gomock.Any(), "local", "test-group", testTemplateSpecName, testVersionName, expectedResource, gomock.Any()).
+			Return(ucp.TemplateSpecVersionsClientCreateOrUpdateResponse{TemplateSpecVersionResource: expectedResource}, nil)
+
+		result, err := client.CreateOrUpdateTemplateSpecVersion(context.Background(), "local", "test-group", testTemplateSpecName, testVersionName, &expectedResource)
+		require.NoError(t, err)
+		require.Equal(t, expectedResource, result)
+	})
+
+	t.Run("DeleteTemplateSpecVersion", func(t *testing.T) {
+		mock := NewMocktemplateSpecVersionClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			Delete(gomock.Any(), "local", "test-group", testTemplateSpecName, testVersionName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, planeName, resourceGroupName, templateSpecName, versionName string, options *ucp.TemplateSpecVersionsClientDeleteOptions) (ucp.TemplateSpecVersionsClientDeleteResponse, error) {
+				setCapture(ctx, &http.Response{StatusCode: 200})
+				return ucp.TemplateSpecVersionsClientDeleteResponse{}, nil
+			})
+
+		deleted, err := client.DeleteTemplateSpecVersion(context.Background(), "local", "test-group", testTemplateSpecName, testVersionName)
+		require.NoError(t, err)
+		require.True(t, deleted)
+	})
+}
+
+func Test_TemplateSpec(t *testing.T) {
+	mock := NewMocktemplateSpecClient(gomock.NewController(t))
+	client := &UCPApplicationsManagementClient{
+		RootScope: testScope,
+		templateSpecClientFactory: func() (templateSpecClient, error) {
+			return mock, nil
+		},
+		capture: testCapture,
+	}
+
+	expectedResource := ucp.TemplateSpecResource{
+		ID:       to.Ptr("/planes/radius/local/resourcegroups/test-group/providers/System.Resources/templateSpecs/myapp"),
+		Name:     to.Ptr("myapp"),
+		Type:     to.Ptr("System.Resources/templateSpecs"),
+		Location: to.Ptr(v1.LocationGlobal),
+	}
+
+	mock.EXPECT().
+		CreateOrUpdate(gomock.Any(), "local", "test-group", "myapp", expectedResource, gomock.Any()).
+		Return(ucp.TemplateSpecsClientCreateOrUpdateResponse{TemplateSpecResource: expectedResource}, nil)
+
+	result, err := client.CreateOrUpdateTemplateSpec(context.Background(), "local", "test-group", "myapp", &expectedResource)
+	require.NoError(t, err)
+	require.Equal(t, expectedResource, result)
+}
+
 func Test_extractScopeAndName(t *testing.T) {
 	client := UCPApplicationsManagementClient{
 		RootScope: testScope,
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20231001preview0 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -389,6 +390,84 @@ func (c *MockApplicationsManagementClientCreateOrUpdateResourceTypeCall) DoAndRe
 	return c
 }
 
+// CreateOrUpdateTemplateSpec mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateTemplateSpec(arg0 context.Context, arg1, arg2, arg3 string, arg4 *v20231001preview0.TemplateSpecResource) (v20231001preview0.TemplateSpecResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateTemplateSpec", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(v20231001preview0.TemplateSpecResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateTemplateSpec indicates an expected call of CreateOrUpdateTemplateSpec.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateTemplateSpec(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateTemplateSpec", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateTemplateSpec), arg0, arg1, arg2, arg3, arg4)
+	return &MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall{Call: call}
+}
+
+// MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall wrap *gomock.Call
+type MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall) Return(arg0 v20231001preview0.TemplateSpecResource, arg1 error) *MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall) Do(f func(context.Context, string, string, string, *v20231001preview0.TemplateSpecResource) (v20231001preview0.TemplateSpecResource, error)) *MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall) DoAndReturn(f func(context.Context, string, string, string, *v20231001preview0.TemplateSpecResource) (v20231001preview0.TemplateSpecResource, error)) *MockApplicationsManagementClientCreateOrUpdateTemplateSpecCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateOrUpdateTemplateSpecVersion mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateTemplateSpecVersion(arg0 context.Context, arg1, arg2, arg3, arg4 string, arg5 *v20231001preview0.TemplateSpecVersionResource) (v20231001preview0.TemplateSpecVersionResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateTemplateSpecVersion", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(v20231001preview0.TemplateSpecVersionResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateTemplateSpecVersion indicates an expected call of CreateOrUpdateTemplateSpecVersion.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateTemplateSpecVersion(arg0, arg1, arg2, arg3, arg4, arg5 any) *MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateTemplateSpecVersion", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateTemplateSpecVersion), arg0, arg1, arg2, arg3, arg4, arg5)
+	return &MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall{Call: call}
+}
+
+// MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall wrap *gomock.Call
+type MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall) Return(arg0 v20231001preview0.TemplateSpecVersionResource, arg1 error) *MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall) Do(f func(context.Context, string, string, string, string, *v20231001preview0.TemplateSpecVersionResource) (v20231001preview0.TemplateSpecVersionResource, error)) *MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall) DoAndReturn(f func(context.Context, string, string, string, string, *v20231001preview0.TemplateSpecVersionResource) (v20231001preview0.TemplateSpecVersionResource, error)) *MockApplicationsManagementClientCreateOrUpdateTemplateSpecVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteApplication mocks base method.
 func (m *MockApplicationsManagementClient) DeleteApplication(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -546,18 +625,18 @@ func (c *MockApplicationsManagementClientDeleteResourceGroupCall) DoAndReturn(f
 }
 
 // DeleteResourceProvider mocks base method.
-func (m *MockApplicationsManagementClient) DeleteResourceProvider(arg0 context.Context, arg1, arg2 string) (bool, error) {
+func (m *MockApplicationsManagementClient) DeleteResourceProvider(arg0 context.Context, arg1, arg2 string, arg3 bool) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteResourceProvider", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "DeleteResourceProvider", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteResourceProvider indicates an expected call of DeleteResourceProvider.
-func (mr *MockApplicationsManagementClientMockRecorder) DeleteResourceProvider(arg0, arg1, arg2 any) *MockApplicationsManagementClientDeleteResourceProviderCall {
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteResourceProvider(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientDeleteResourceProviderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceProvider", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteResourceProvider), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceProvider", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteResourceProvider), arg0, arg1, arg2, arg3)
 	return &MockApplicationsManagementClientDeleteResourceProviderCall{Call: call}
 }
 
@@ -573,13 +652,13 @@ func (c *MockApplicationsManagementClientDeleteResourceProviderCall) Return(arg0
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockApplicationsManagementClientDeleteResourceProviderCall) Do(f func(context.Context, string, string) (bool, error)) *MockApplicationsManagementClientDeleteResourceProviderCall {
+func (c *MockApplicationsManagementClientDeleteResourceProviderCall) Do(f func(context.Context, string, string, bool) (bool, error)) *MockApplicationsManagementClientDeleteResourceProviderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockApplicationsManagementClientDeleteResourceProviderCall) DoAndReturn(f func(context.Context, string, string) (bool, error)) *MockApplicationsManagementClientDeleteResourceProviderCall {
+func (c *MockApplicationsManagementClientDeleteResourceProviderCall) DoAndReturn(f func(context.Context, string, string, bool) (bool, error)) *MockApplicationsManagementClientDeleteResourceProviderCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -623,6 +702,45 @@ func (c *MockApplicationsManagementClientDeleteResourceTypeCall) DoAndReturn(f f
 	return c
 }
 
+// DeleteTemplateSpecVersion mocks base method.
+func (m *MockApplicationsManagementClient) DeleteTemplateSpecVersion(arg0 context.Context, arg1, arg2, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateSpecVersion", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTemplateSpecVersion indicates an expected call of DeleteTemplateSpecVersion.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteTemplateSpecVersion(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationsManagementClientDeleteTemplateSpecVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateSpecVersion", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteTemplateSpecVersion), arg0, arg1, arg2, arg3, arg4)
+	return &MockApplicationsManagementClientDeleteTemplateSpecVersionCall{Call: call}
+}
+
+// MockApplicationsManagementClientDeleteTemplateSpecVersionCall wrap *gomock.Call
+type MockApplicationsManagementClientDeleteTemplateSpecVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientDeleteTemplateSpecVersionCall) Return(arg0 bool, arg1 error) *MockApplicationsManagementClientDeleteTemplateSpecVersionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientDeleteTemplateSpecVersionCall) Do(f func(context.Context, string, string, string, string) (bool, error)) *MockApplicationsManagementClientDeleteTemplateSpecVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientDeleteTemplateSpecVersionCall) DoAndReturn(f func(context.Context, string, string, string, string) (bool, error)) *MockApplicationsManagementClientDeleteTemplateSpecVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplication mocks base method.
 func (m *MockApplicationsManagementClient) GetApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
@@ -662,6 +780,45 @@ func (c *MockApplicationsManagementClientGetApplicationCall) DoAndReturn(f func(
 	return c
 }
 
+// GetApplicationDevProfile mocks base method.
+func (m *MockApplicationsManagementClient) GetApplicationDevProfile(arg0 context.Context, arg1 string) (v20231001preview.ApplicationDevProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationDevProfile", arg0, arg1)
+	ret0, _ := ret[0].(v20231001preview.ApplicationDevProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationDevProfile indicates an expected call of GetApplicationDevProfile.
+func (mr *MockApplicationsManagementClientMockRecorder) GetApplicationDevProfile(arg0, arg1 any) *MockApplicationsManagementClientGetApplicationDevProfileCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationDevProfile", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetApplicationDevProfile), arg0, arg1)
+	return &MockApplicationsManagementClientGetApplicationDevProfileCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetApplicationDevProfileCall wrap *gomock.Call
+type MockApplicationsManagementClientGetApplicationDevProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetApplicationDevProfileCall) Return(arg0 v20231001preview.ApplicationDevProfileResponse, arg1 error) *MockApplicationsManagementClientGetApplicationDevProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetApplicationDevProfileCall) Do(f func(context.Context, string) (v20231001preview.ApplicationDevProfileResponse, error)) *MockApplicationsManagementClientGetApplicationDevProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetApplicationDevProfileCall) DoAndReturn(f func(context.Context, string) (v20231001preview.ApplicationDevProfileResponse, error)) *MockApplicationsManagementClientGetApplicationDevProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationGraph mocks base method.
 func (m *MockApplicationsManagementClient) GetApplicationGraph(arg0 context.Context, arg1 string) (v20231001preview.ApplicationGraphResponse, error) {
 	m.ctrl.T.Helper()
@@ -740,6 +897,45 @@ func (c *MockApplicationsManagementClientGetEnvironmentCall) DoAndReturn(f func(
 	return c
 }
 
+// GetOperationStatus mocks base method.
+func (m *MockApplicationsManagementClient) GetOperationStatus(arg0 context.Context, arg1, arg2, arg3 string) (v1.AsyncOperationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(v1.AsyncOperationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationStatus indicates an expected call of GetOperationStatus.
+func (mr *MockApplicationsManagementClientMockRecorder) GetOperationStatus(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientGetOperationStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationStatus", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetOperationStatus), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientGetOperationStatusCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetOperationStatusCall wrap *gomock.Call
+type MockApplicationsManagementClientGetOperationStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetOperationStatusCall) Return(arg0 v1.AsyncOperationStatus, arg1 error) *MockApplicationsManagementClientGetOperationStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetOperationStatusCall) Do(f func(context.Context, string, string, string) (v1.AsyncOperationStatus, error)) *MockApplicationsManagementClientGetOperationStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetOperationStatusCall) DoAndReturn(f func(context.Context, string, string, string) (v1.AsyncOperationStatus, error)) *MockApplicationsManagementClientGetOperationStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRecipeMetadata mocks base method.
 func (m *MockApplicationsManagementClient) GetRecipeMetadata(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeGetMetadata) (v20231001preview.RecipeGetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -818,6 +1014,45 @@ func (c *MockApplicationsManagementClientGetResourceCall) DoAndReturn(f func(con
 	return c
 }
 
+// GetResourceGraph mocks base method.
+func (m *MockApplicationsManagementClient) GetResourceGraph(arg0 context.Context, arg1, arg2 string) (ResourceGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceGraph", arg0, arg1, arg2)
+	ret0, _ := ret[0].(ResourceGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceGraph indicates an expected call of GetResourceGraph.
+func (mr *MockApplicationsManagementClientMockRecorder) GetResourceGraph(arg0, arg1, arg2 any) *MockApplicationsManagementClientGetResourceGraphCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceGraph", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetResourceGraph), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientGetResourceGraphCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetResourceGraphCall wrap *gomock.Call
+type MockApplicationsManagementClientGetResourceGraphCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetResourceGraphCall) Return(arg0 ResourceGraph, arg1 error) *MockApplicationsManagementClientGetResourceGraphCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetResourceGraphCall) Do(f func(context.Context, string, string) (ResourceGraph, error)) *MockApplicationsManagementClientGetResourceGraphCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetResourceGraphCall) DoAndReturn(f func(context.Context, string, string) (ResourceGraph, error)) *MockApplicationsManagementClientGetResourceGraphCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetResourceGroup mocks base method.
 func (m *MockApplicationsManagementClient) GetResourceGroup(arg0 context.Context, arg1, arg2 string) (v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -857,6 +1092,45 @@ func (c *MockApplicationsManagementClientGetResourceGroupCall) DoAndReturn(f fun
 	return c
 }
 
+// GetResourceGroupUsage mocks base method.
+func (m *MockApplicationsManagementClient) GetResourceGroupUsage(arg0 context.Context, arg1, arg2 string) (v20231001preview0.ResourceGroupUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceGroupUsage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview0.ResourceGroupUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceGroupUsage indicates an expected call of GetResourceGroupUsage.
+func (mr *MockApplicationsManagementClientMockRecorder) GetResourceGroupUsage(arg0, arg1, arg2 any) *MockApplicationsManagementClientGetResourceGroupUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceGroupUsage", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetResourceGroupUsage), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientGetResourceGroupUsageCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetResourceGroupUsageCall wrap *gomock.Call
+type MockApplicationsManagementClientGetResourceGroupUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetResourceGroupUsageCall) Return(arg0 v20231001preview0.ResourceGroupUsage, arg1 error) *MockApplicationsManagementClientGetResourceGroupUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetResourceGroupUsageCall) Do(f func(context.Context, string, string) (v20231001preview0.ResourceGroupUsage, error)) *MockApplicationsManagementClientGetResourceGroupUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetResourceGroupUsageCall) DoAndReturn(f func(context.Context, string, string) (v20231001preview0.ResourceGroupUsage, error)) *MockApplicationsManagementClientGetResourceGroupUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetResourceProvider mocks base method.
 func (m *MockApplicationsManagementClient) GetResourceProvider(arg0 context.Context, arg1, arg2 string) (v20231001preview0.ResourceProviderResource, error) {
 	m.ctrl.T.Helper()
@@ -935,6 +1209,45 @@ func (c *MockApplicationsManagementClientGetResourceProviderSummaryCall) DoAndRe
 	return c
 }
 
+// GetTemplateSpecVersion mocks base method.
+func (m *MockApplicationsManagementClient) GetTemplateSpecVersion(arg0 context.Context, arg1, arg2, arg3, arg4 string) (v20231001preview0.TemplateSpecVersionResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateSpecVersion", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(v20231001preview0.TemplateSpecVersionResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateSpecVersion indicates an expected call of GetTemplateSpecVersion.
+func (mr *MockApplicationsManagementClientMockRecorder) GetTemplateSpecVersion(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationsManagementClientGetTemplateSpecVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateSpecVersion", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetTemplateSpecVersion), arg0, arg1, arg2, arg3, arg4)
+	return &MockApplicationsManagementClientGetTemplateSpecVersionCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetTemplateSpecVersionCall wrap *gomock.Call
+type MockApplicationsManagementClientGetTemplateSpecVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetTemplateSpecVersionCall) Return(arg0 v20231001preview0.TemplateSpecVersionResource, arg1 error) *MockApplicationsManagementClientGetTemplateSpecVersionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetTemplateSpecVersionCall) Do(f func(context.Context, string, string, string, string) (v20231001preview0.TemplateSpecVersionResource, error)) *MockApplicationsManagementClientGetTemplateSpecVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetTemplateSpecVersionCall) DoAndReturn(f func(context.Context, string, string, string, string) (v20231001preview0.TemplateSpecVersionResource, error)) *MockApplicationsManagementClientGetTemplateSpecVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListApplications mocks base method.
 func (m *MockApplicationsManagementClient) ListApplications(arg0 context.Context) ([]v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
@@ -1052,6 +1365,45 @@ func (c *MockApplicationsManagementClientListEnvironmentsAllCall) DoAndReturn(f
 	return c
 }
 
+// ListPlanes mocks base method.
+func (m *MockApplicationsManagementClient) ListPlanes(arg0 context.Context) ([]v20231001preview0.GenericPlaneResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlanes", arg0)
+	ret0, _ := ret[0].([]v20231001preview0.GenericPlaneResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlanes indicates an expected call of ListPlanes.
+func (mr *MockApplicationsManagementClientMockRecorder) ListPlanes(arg0 any) *MockApplicationsManagementClientListPlanesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlanes", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListPlanes), arg0)
+	return &MockApplicationsManagementClientListPlanesCall{Call: call}
+}
+
+// MockApplicationsManagementClientListPlanesCall wrap *gomock.Call
+type MockApplicationsManagementClientListPlanesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListPlanesCall) Return(arg0 []v20231001preview0.GenericPlaneResource, arg1 error) *MockApplicationsManagementClientListPlanesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListPlanesCall) Do(f func(context.Context) ([]v20231001preview0.GenericPlaneResource, error)) *MockApplicationsManagementClientListPlanesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListPlanesCall) DoAndReturn(f func(context.Context) ([]v20231001preview0.GenericPlaneResource, error)) *MockApplicationsManagementClientListPlanesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListResourceGroups mocks base method.
 func (m *MockApplicationsManagementClient) ListResourceGroups(arg0 context.Context, arg1 string) ([]v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -1091,6 +1443,45 @@ func (c *MockApplicationsManagementClientListResourceGroupsCall) DoAndReturn(f f
 	return c
 }
 
+// ListResourceHistory mocks base method.
+func (m *MockApplicationsManagementClient) ListResourceHistory(arg0 context.Context, arg1, arg2 string) ([]*generated.GenericResourceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*generated.GenericResourceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceHistory indicates an expected call of ListResourceHistory.
+func (mr *MockApplicationsManagementClientMockRecorder) ListResourceHistory(arg0, arg1, arg2 any) *MockApplicationsManagementClientListResourceHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceHistory", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListResourceHistory), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientListResourceHistoryCall{Call: call}
+}
+
+// MockApplicationsManagementClientListResourceHistoryCall wrap *gomock.Call
+type MockApplicationsManagementClientListResourceHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListResourceHistoryCall) Return(arg0 []*generated.GenericResourceRevision, arg1 error) *MockApplicationsManagementClientListResourceHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListResourceHistoryCall) Do(f func(context.Context, string, string) ([]*generated.GenericResourceRevision, error)) *MockApplicationsManagementClientListResourceHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListResourceHistoryCall) DoAndReturn(f func(context.Context, string, string) ([]*generated.GenericResourceRevision, error)) *MockApplicationsManagementClientListResourceHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListResourceProviderSummaries mocks base method.
 func (m *MockApplicationsManagementClient) ListResourceProviderSummaries(arg0 context.Context, arg1 string) ([]v20231001preview0.ResourceProviderSummary, error) {
 	m.ctrl.T.Helper()
@@ -1363,3 +1754,120 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInEnvironmentCall) D
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ListTemplateSpecVersions mocks base method.
+func (m *MockApplicationsManagementClient) ListTemplateSpecVersions(arg0 context.Context, arg1, arg2, arg3 string) ([]v20231001preview0.TemplateSpecVersionResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplateSpecVersions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]v20231001preview0.TemplateSpecVersionResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplateSpecVersions indicates an expected call of ListTemplateSpecVersions.
+func (mr *MockApplicationsManagementClientMockRecorder) ListTemplateSpecVersions(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientListTemplateSpecVersionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateSpecVersions", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListTemplateSpecVersions), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientListTemplateSpecVersionsCall{Call: call}
+}
+
+// MockApplicationsManagementClientListTemplateSpecVersionsCall wrap *gomock.Call
+type MockApplicationsManagementClientListTemplateSpecVersionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListTemplateSpecVersionsCall) Return(arg0 []v20231001preview0.TemplateSpecVersionResource, arg1 error) *MockApplicationsManagementClientListTemplateSpecVersionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListTemplateSpecVersionsCall) Do(f func(context.Context, string, string, string) ([]v20231001preview0.TemplateSpecVersionResource, error)) *MockApplicationsManagementClientListTemplateSpecVersionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListTemplateSpecVersionsCall) DoAndReturn(f func(context.Context, string, string, string) ([]v20231001preview0.TemplateSpecVersionResource, error)) *MockApplicationsManagementClientListTemplateSpecVersionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PlanRecipe mocks base method.
+func (m *MockApplicationsManagementClient) PlanRecipe(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipePlan) (v20231001preview.RecipePlanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.RecipePlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanRecipe(arg0, arg1, arg2 any) *MockApplicationsManagementClientPlanRecipeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanRecipe), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientPlanRecipeCall{Call: call}
+}
+
+// MockApplicationsManagementClientPlanRecipeCall wrap *gomock.Call
+type MockApplicationsManagementClientPlanRecipeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientPlanRecipeCall) Return(arg0 v20231001preview.RecipePlanResponse, arg1 error) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientPlanRecipeCall) Do(f func(context.Context, string, v20231001preview.RecipePlan) (v20231001preview.RecipePlanResponse, error)) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientPlanRecipeCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipePlan) (v20231001preview.RecipePlanResponse, error)) *MockApplicationsManagementClientPlanRecipeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RotateSecretStore mocks base method.
+func (m *MockApplicationsManagementClient) RotateSecretStore(arg0 context.Context, arg1 string, arg2 v20231001preview.SecretStoreRotateRequest) (v20231001preview.SecretStoreRotateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecretStore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.SecretStoreRotateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSecretStore indicates an expected call of RotateSecretStore.
+func (mr *MockApplicationsManagementClientMockRecorder) RotateSecretStore(arg0, arg1, arg2 any) *MockApplicationsManagementClientRotateSecretStoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecretStore", reflect.TypeOf((*MockApplicationsManagementClient)(nil).RotateSecretStore), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientRotateSecretStoreCall{Call: call}
+}
+
+// MockApplicationsManagementClientRotateSecretStoreCall wrap *gomock.Call
+type MockApplicationsManagementClientRotateSecretStoreCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientRotateSecretStoreCall) Return(arg0 v20231001preview.SecretStoreRotateResult, arg1 error) *MockApplicationsManagementClientRotateSecretStoreCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientRotateSecretStoreCall) Do(f func(context.Context, string, v20231001preview.SecretStoreRotateRequest) (v20231001preview.SecretStoreRotateResult, error)) *MockApplicationsManagementClientRotateSecretStoreCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientRotateSecretStoreCall) DoAndReturn(f func(context.Context, string, v20231001preview.SecretStoreRotateRequest) (v20231001preview.SecretStoreRotateResult, error)) *MockApplicationsManagementClientRotateSecretStoreCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
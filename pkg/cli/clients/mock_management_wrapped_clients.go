@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient
+//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,planeClient,templateSpecClient,templateSpecVersionClient
 //
 
 // Package clients is a generated GoMock package.
@@ -160,6 +160,45 @@ func (c *MockgenericResourceClientGetCall) DoAndReturn(f func(context.Context, s
 	return c
 }
 
+// ListHistory mocks base method.
+func (m *MockgenericResourceClient) ListHistory(ctx context.Context, resourceName string, options *generated.GenericResourcesClientListHistoryOptions) (generated.GenericResourcesClientListHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistory", ctx, resourceName, options)
+	ret0, _ := ret[0].(generated.GenericResourcesClientListHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistory indicates an expected call of ListHistory.
+func (mr *MockgenericResourceClientMockRecorder) ListHistory(ctx, resourceName, options any) *MockgenericResourceClientListHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistory", reflect.TypeOf((*MockgenericResourceClient)(nil).ListHistory), ctx, resourceName, options)
+	return &MockgenericResourceClientListHistoryCall{Call: call}
+}
+
+// MockgenericResourceClientListHistoryCall wrap *gomock.Call
+type MockgenericResourceClientListHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockgenericResourceClientListHistoryCall) Return(arg0 generated.GenericResourcesClientListHistoryResponse, arg1 error) *MockgenericResourceClientListHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockgenericResourceClientListHistoryCall) Do(f func(context.Context, string, *generated.GenericResourcesClientListHistoryOptions) (generated.GenericResourcesClientListHistoryResponse, error)) *MockgenericResourceClientListHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockgenericResourceClientListHistoryCall) DoAndReturn(f func(context.Context, string, *generated.GenericResourcesClientListHistoryOptions) (generated.GenericResourcesClientListHistoryResponse, error)) *MockgenericResourceClientListHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NewListByRootScopePager mocks base method.
 func (m *MockgenericResourceClient) NewListByRootScopePager(options *generated.GenericResourcesClientListByRootScopeOptions) *runtime.Pager[generated.GenericResourcesClientListByRootScopeResponse] {
 	m.ctrl.T.Helper()
@@ -338,6 +377,45 @@ func (c *MockapplicationResourceClientGetCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetDevProfile mocks base method.
+func (m *MockapplicationResourceClient) GetDevProfile(ctx context.Context, applicationName string, body map[string]any, options *v20231001preview.ApplicationsClientGetDevProfileOptions) (v20231001preview.ApplicationsClientGetDevProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevProfile", ctx, applicationName, body, options)
+	ret0, _ := ret[0].(v20231001preview.ApplicationsClientGetDevProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevProfile indicates an expected call of GetDevProfile.
+func (mr *MockapplicationResourceClientMockRecorder) GetDevProfile(ctx, applicationName, body, options any) *MockapplicationResourceClientGetDevProfileCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevProfile", reflect.TypeOf((*MockapplicationResourceClient)(nil).GetDevProfile), ctx, applicationName, body, options)
+	return &MockapplicationResourceClientGetDevProfileCall{Call: call}
+}
+
+// MockapplicationResourceClientGetDevProfileCall wrap *gomock.Call
+type MockapplicationResourceClientGetDevProfileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapplicationResourceClientGetDevProfileCall) Return(arg0 v20231001preview.ApplicationsClientGetDevProfileResponse, arg1 error) *MockapplicationResourceClientGetDevProfileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapplicationResourceClientGetDevProfileCall) Do(f func(context.Context, string, map[string]any, *v20231001preview.ApplicationsClientGetDevProfileOptions) (v20231001preview.ApplicationsClientGetDevProfileResponse, error)) *MockapplicationResourceClientGetDevProfileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapplicationResourceClientGetDevProfileCall) DoAndReturn(f func(context.Context, string, map[string]any, *v20231001preview.ApplicationsClientGetDevProfileOptions) (v20231001preview.ApplicationsClientGetDevProfileResponse, error)) *MockapplicationResourceClientGetDevProfileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetGraph mocks base method.
 func (m *MockapplicationResourceClient) GetGraph(ctx context.Context, applicationName string, body map[string]any, options *v20231001preview.ApplicationsClientGetGraphOptions) (v20231001preview.ApplicationsClientGetGraphResponse, error) {
 	m.ctrl.T.Helper()
@@ -632,6 +710,107 @@ func (c *MockenvironmentResourceClientNewListByScopePagerCall) DoAndReturn(f fun
 	return c
 }
 
+// PlanRecipe mocks base method.
+func (m *MockenvironmentResourceClient) PlanRecipe(ctx context.Context, environmentName string, body v20231001preview.RecipePlan, options *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", ctx, environmentName, body, options)
+	ret0, _ := ret[0].(v20231001preview.EnvironmentsClientPlanRecipeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockenvironmentResourceClientMockRecorder) PlanRecipe(ctx, environmentName, body, options any) *MockenvironmentResourceClientPlanRecipeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockenvironmentResourceClient)(nil).PlanRecipe), ctx, environmentName, body, options)
+	return &MockenvironmentResourceClientPlanRecipeCall{Call: call}
+}
+
+// MockenvironmentResourceClientPlanRecipeCall wrap *gomock.Call
+type MockenvironmentResourceClientPlanRecipeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockenvironmentResourceClientPlanRecipeCall) Return(arg0 v20231001preview.EnvironmentsClientPlanRecipeResponse, arg1 error) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockenvironmentResourceClientPlanRecipeCall) Do(f func(context.Context, string, v20231001preview.RecipePlan, *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error)) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockenvironmentResourceClientPlanRecipeCall) DoAndReturn(f func(context.Context, string, v20231001preview.RecipePlan, *v20231001preview.EnvironmentsClientPlanRecipeOptions) (v20231001preview.EnvironmentsClientPlanRecipeResponse, error)) *MockenvironmentResourceClientPlanRecipeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocksecretStoreResourceClient is a mock of secretStoreResourceClient interface.
+type MocksecretStoreResourceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretStoreResourceClientMockRecorder
+}
+
+// MocksecretStoreResourceClientMockRecorder is the mock recorder for MocksecretStoreResourceClient.
+type MocksecretStoreResourceClientMockRecorder struct {
+	mock *MocksecretStoreResourceClient
+}
+
+// NewMocksecretStoreResourceClient creates a new mock instance.
+func NewMocksecretStoreResourceClient(ctrl *gomock.Controller) *MocksecretStoreResourceClient {
+	mock := &MocksecretStoreResourceClient{ctrl: ctrl}
+	mock.recorder = &MocksecretStoreResourceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretStoreResourceClient) EXPECT() *MocksecretStoreResourceClientMockRecorder {
+	return m.recorder
+}
+
+// Rotate mocks base method.
+func (m *MocksecretStoreResourceClient) Rotate(ctx context.Context, secretStoreName string, body v20231001preview.SecretStoreRotateRequest, options *v20231001preview.SecretStoresClientRotateOptions) (v20231001preview.SecretStoresClientRotateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, secretStoreName, body, options)
+	ret0, _ := ret[0].(v20231001preview.SecretStoresClientRotateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MocksecretStoreResourceClientMockRecorder) Rotate(ctx, secretStoreName, body, options any) *MocksecretStoreResourceClientRotateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MocksecretStoreResourceClient)(nil).Rotate), ctx, secretStoreName, body, options)
+	return &MocksecretStoreResourceClientRotateCall{Call: call}
+}
+
+// MocksecretStoreResourceClientRotateCall wrap *gomock.Call
+type MocksecretStoreResourceClientRotateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocksecretStoreResourceClientRotateCall) Return(arg0 v20231001preview.SecretStoresClientRotateResponse, arg1 error) *MocksecretStoreResourceClientRotateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocksecretStoreResourceClientRotateCall) Do(f func(context.Context, string, v20231001preview.SecretStoreRotateRequest, *v20231001preview.SecretStoresClientRotateOptions) (v20231001preview.SecretStoresClientRotateResponse, error)) *MocksecretStoreResourceClientRotateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocksecretStoreResourceClientRotateCall) DoAndReturn(f func(context.Context, string, v20231001preview.SecretStoreRotateRequest, *v20231001preview.SecretStoresClientRotateOptions) (v20231001preview.SecretStoresClientRotateResponse, error)) *MocksecretStoreResourceClientRotateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockresourceGroupClient is a mock of resourceGroupClient interface.
 type MockresourceGroupClient struct {
 	ctrl     *gomock.Controller
@@ -772,6 +951,45 @@ func (c *MockresourceGroupClientGetCall) DoAndReturn(f func(context.Context, str
 	return c
 }
 
+// GetUsage mocks base method.
+func (m *MockresourceGroupClient) GetUsage(ctx context.Context, planeName, resourceGroupName string, options *v20231001preview0.ResourceGroupsClientGetUsageOptions) (v20231001preview0.ResourceGroupsClientGetUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, planeName, resourceGroupName, options)
+	ret0, _ := ret[0].(v20231001preview0.ResourceGroupsClientGetUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockresourceGroupClientMockRecorder) GetUsage(ctx, planeName, resourceGroupName, options any) *MockresourceGroupClientGetUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockresourceGroupClient)(nil).GetUsage), ctx, planeName, resourceGroupName, options)
+	return &MockresourceGroupClientGetUsageCall{Call: call}
+}
+
+// MockresourceGroupClientGetUsageCall wrap *gomock.Call
+type MockresourceGroupClientGetUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockresourceGroupClientGetUsageCall) Return(arg0 v20231001preview0.ResourceGroupsClientGetUsageResponse, arg1 error) *MockresourceGroupClientGetUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockresourceGroupClientGetUsageCall) Do(f func(context.Context, string, string, *v20231001preview0.ResourceGroupsClientGetUsageOptions) (v20231001preview0.ResourceGroupsClientGetUsageResponse, error)) *MockresourceGroupClientGetUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockresourceGroupClientGetUsageCall) DoAndReturn(f func(context.Context, string, string, *v20231001preview0.ResourceGroupsClientGetUsageOptions) (v20231001preview0.ResourceGroupsClientGetUsageResponse, error)) *MockresourceGroupClientGetUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NewListPager mocks base method.
 func (m *MockresourceGroupClient) NewListPager(planeName string, options *v20231001preview0.ResourceGroupsClientListOptions) *runtime.Pager[v20231001preview0.ResourceGroupsClientListResponse] {
 	m.ctrl.T.Helper()
@@ -1289,3 +1507,304 @@ func (c *MocklocationClientBeginCreateOrUpdateCall) DoAndReturn(f func(context.C
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockplaneClient is a mock of planeClient interface.
+type MockplaneClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockplaneClientMockRecorder
+}
+
+// MockplaneClientMockRecorder is the mock recorder for MockplaneClient.
+type MockplaneClientMockRecorder struct {
+	mock *MockplaneClient
+}
+
+// NewMockplaneClient creates a new mock instance.
+func NewMockplaneClient(ctrl *gomock.Controller) *MockplaneClient {
+	mock := &MockplaneClient{ctrl: ctrl}
+	mock.recorder = &MockplaneClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockplaneClient) EXPECT() *MockplaneClientMockRecorder {
+	return m.recorder
+}
+
+// NewListPlanesPager mocks base method.
+func (m *MockplaneClient) NewListPlanesPager(options *v20231001preview0.PlanesClientListPlanesOptions) *runtime.Pager[v20231001preview0.PlanesClientListPlanesResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPlanesPager", options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.PlanesClientListPlanesResponse])
+	return ret0
+}
+
+// NewListPlanesPager indicates an expected call of NewListPlanesPager.
+func (mr *MockplaneClientMockRecorder) NewListPlanesPager(options any) *MockplaneClientNewListPlanesPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPlanesPager", reflect.TypeOf((*MockplaneClient)(nil).NewListPlanesPager), options)
+	return &MockplaneClientNewListPlanesPagerCall{Call: call}
+}
+
+// MockplaneClientNewListPlanesPagerCall wrap *gomock.Call
+type MockplaneClientNewListPlanesPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockplaneClientNewListPlanesPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.PlanesClientListPlanesResponse]) *MockplaneClientNewListPlanesPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockplaneClientNewListPlanesPagerCall) Do(f func(*v20231001preview0.PlanesClientListPlanesOptions) *runtime.Pager[v20231001preview0.PlanesClientListPlanesResponse]) *MockplaneClientNewListPlanesPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockplaneClientNewListPlanesPagerCall) DoAndReturn(f func(*v20231001preview0.PlanesClientListPlanesOptions) *runtime.Pager[v20231001preview0.PlanesClientListPlanesResponse]) *MockplaneClientNewListPlanesPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktemplateSpecClient is a mock of templateSpecClient interface.
+type MocktemplateSpecClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateSpecClientMockRecorder
+}
+
+// MocktemplateSpecClientMockRecorder is the mock recorder for MocktemplateSpecClient.
+type MocktemplateSpecClientMockRecorder struct {
+	mock *MocktemplateSpecClient
+}
+
+// NewMocktemplateSpecClient creates a new mock instance.
+func NewMocktemplateSpecClient(ctrl *gomock.Controller) *MocktemplateSpecClient {
+	mock := &MocktemplateSpecClient{ctrl: ctrl}
+	mock.recorder = &MocktemplateSpecClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateSpecClient) EXPECT() *MocktemplateSpecClientMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MocktemplateSpecClient) CreateOrUpdate(ctx context.Context, planeName, resourceGroupName, templateSpecName string, resource v20231001preview0.TemplateSpecResource, options *v20231001preview0.TemplateSpecsClientCreateOrUpdateOptions) (v20231001preview0.TemplateSpecsClientCreateOrUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, planeName, resourceGroupName, templateSpecName, resource, options)
+	ret0, _ := ret[0].(v20231001preview0.TemplateSpecsClientCreateOrUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MocktemplateSpecClientMockRecorder) CreateOrUpdate(ctx, planeName, resourceGroupName, templateSpecName, resource, options any) *MocktemplateSpecClientCreateOrUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MocktemplateSpecClient)(nil).CreateOrUpdate), ctx, planeName, resourceGroupName, templateSpecName, resource, options)
+	return &MocktemplateSpecClientCreateOrUpdateCall{Call: call}
+}
+
+// MocktemplateSpecClientCreateOrUpdateCall wrap *gomock.Call
+type MocktemplateSpecClientCreateOrUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktemplateSpecClientCreateOrUpdateCall) Return(arg0 v20231001preview0.TemplateSpecsClientCreateOrUpdateResponse, arg1 error) *MocktemplateSpecClientCreateOrUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktemplateSpecClientCreateOrUpdateCall) Do(f func(context.Context, string, string, string, v20231001preview0.TemplateSpecResource, *v20231001preview0.TemplateSpecsClientCreateOrUpdateOptions) (v20231001preview0.TemplateSpecsClientCreateOrUpdateResponse, error)) *MocktemplateSpecClientCreateOrUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktemplateSpecClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, string, string, v20231001preview0.TemplateSpecResource, *v20231001preview0.TemplateSpecsClientCreateOrUpdateOptions) (v20231001preview0.TemplateSpecsClientCreateOrUpdateResponse, error)) *MocktemplateSpecClientCreateOrUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktemplateSpecVersionClient is a mock of templateSpecVersionClient interface.
+type MocktemplateSpecVersionClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateSpecVersionClientMockRecorder
+}
+
+// MocktemplateSpecVersionClientMockRecorder is the mock recorder for MocktemplateSpecVersionClient.
+type MocktemplateSpecVersionClientMockRecorder struct {
+	mock *MocktemplateSpecVersionClient
+}
+
+// NewMocktemplateSpecVersionClient creates a new mock instance.
+func NewMocktemplateSpecVersionClient(ctrl *gomock.Controller) *MocktemplateSpecVersionClient {
+	mock := &MocktemplateSpecVersionClient{ctrl: ctrl}
+	mock.recorder = &MocktemplateSpecVersionClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateSpecVersionClient) EXPECT() *MocktemplateSpecVersionClientMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MocktemplateSpecVersionClient) CreateOrUpdate(ctx context.Context, planeName, resourceGroupName, templateSpecName, templateSpecVersionName string, resource v20231001preview0.TemplateSpecVersionResource, options *v20231001preview0.TemplateSpecVersionsClientCreateOrUpdateOptions) (v20231001preview0.TemplateSpecVersionsClientCreateOrUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, resource, options)
+	ret0, _ := ret[0].(v20231001preview0.TemplateSpecVersionsClientCreateOrUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MocktemplateSpecVersionClientMockRecorder) CreateOrUpdate(ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, resource, options any) *MocktemplateSpecVersionClientCreateOrUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MocktemplateSpecVersionClient)(nil).CreateOrUpdate), ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, resource, options)
+	return &MocktemplateSpecVersionClientCreateOrUpdateCall{Call: call}
+}
+
+// MocktemplateSpecVersionClientCreateOrUpdateCall wrap *gomock.Call
+type MocktemplateSpecVersionClientCreateOrUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktemplateSpecVersionClientCreateOrUpdateCall) Return(arg0 v20231001preview0.TemplateSpecVersionsClientCreateOrUpdateResponse, arg1 error) *MocktemplateSpecVersionClientCreateOrUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktemplateSpecVersionClientCreateOrUpdateCall) Do(f func(context.Context, string, string, string, string, v20231001preview0.TemplateSpecVersionResource, *v20231001preview0.TemplateSpecVersionsClientCreateOrUpdateOptions) (v20231001preview0.TemplateSpecVersionsClientCreateOrUpdateResponse, error)) *MocktemplateSpecVersionClientCreateOrUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktemplateSpecVersionClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, string, string, string, v20231001preview0.TemplateSpecVersionResource, *v20231001preview0.TemplateSpecVersionsClientCreateOrUpdateOptions) (v20231001preview0.TemplateSpecVersionsClientCreateOrUpdateResponse, error)) *MocktemplateSpecVersionClientCreateOrUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MocktemplateSpecVersionClient) Delete(ctx context.Context, planeName, resourceGroupName, templateSpecName, templateSpecVersionName string, options *v20231001preview0.TemplateSpecVersionsClientDeleteOptions) (v20231001preview0.TemplateSpecVersionsClientDeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, options)
+	ret0, _ := ret[0].(v20231001preview0.TemplateSpecVersionsClientDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocktemplateSpecVersionClientMockRecorder) Delete(ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, options any) *MocktemplateSpecVersionClientDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocktemplateSpecVersionClient)(nil).Delete), ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, options)
+	return &MocktemplateSpecVersionClientDeleteCall{Call: call}
+}
+
+// MocktemplateSpecVersionClientDeleteCall wrap *gomock.Call
+type MocktemplateSpecVersionClientDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktemplateSpecVersionClientDeleteCall) Return(arg0 v20231001preview0.TemplateSpecVersionsClientDeleteResponse, arg1 error) *MocktemplateSpecVersionClientDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktemplateSpecVersionClientDeleteCall) Do(f func(context.Context, string, string, string, string, *v20231001preview0.TemplateSpecVersionsClientDeleteOptions) (v20231001preview0.TemplateSpecVersionsClientDeleteResponse, error)) *MocktemplateSpecVersionClientDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktemplateSpecVersionClientDeleteCall) DoAndReturn(f func(context.Context, string, string, string, string, *v20231001preview0.TemplateSpecVersionsClientDeleteOptions) (v20231001preview0.TemplateSpecVersionsClientDeleteResponse, error)) *MocktemplateSpecVersionClientDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MocktemplateSpecVersionClient) Get(ctx context.Context, planeName, resourceGroupName, templateSpecName, templateSpecVersionName string, options *v20231001preview0.TemplateSpecVersionsClientGetOptions) (v20231001preview0.TemplateSpecVersionsClientGetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, options)
+	ret0, _ := ret[0].(v20231001preview0.TemplateSpecVersionsClientGetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocktemplateSpecVersionClientMockRecorder) Get(ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, options any) *MocktemplateSpecVersionClientGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocktemplateSpecVersionClient)(nil).Get), ctx, planeName, resourceGroupName, templateSpecName, templateSpecVersionName, options)
+	return &MocktemplateSpecVersionClientGetCall{Call: call}
+}
+
+// MocktemplateSpecVersionClientGetCall wrap *gomock.Call
+type MocktemplateSpecVersionClientGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktemplateSpecVersionClientGetCall) Return(arg0 v20231001preview0.TemplateSpecVersionsClientGetResponse, arg1 error) *MocktemplateSpecVersionClientGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktemplateSpecVersionClientGetCall) Do(f func(context.Context, string, string, string, string, *v20231001preview0.TemplateSpecVersionsClientGetOptions) (v20231001preview0.TemplateSpecVersionsClientGetResponse, error)) *MocktemplateSpecVersionClientGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktemplateSpecVersionClientGetCall) DoAndReturn(f func(context.Context, string, string, string, string, *v20231001preview0.TemplateSpecVersionsClientGetOptions) (v20231001preview0.TemplateSpecVersionsClientGetResponse, error)) *MocktemplateSpecVersionClientGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NewListPager mocks base method.
+func (m *MocktemplateSpecVersionClient) NewListPager(planeName, resourceGroupName, templateSpecName string, options *v20231001preview0.TemplateSpecVersionsClientListOptions) *runtime.Pager[v20231001preview0.TemplateSpecVersionsClientListResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPager", planeName, resourceGroupName, templateSpecName, options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.TemplateSpecVersionsClientListResponse])
+	return ret0
+}
+
+// NewListPager indicates an expected call of NewListPager.
+func (mr *MocktemplateSpecVersionClientMockRecorder) NewListPager(planeName, resourceGroupName, templateSpecName, options any) *MocktemplateSpecVersionClientNewListPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPager", reflect.TypeOf((*MocktemplateSpecVersionClient)(nil).NewListPager), planeName, resourceGroupName, templateSpecName, options)
+	return &MocktemplateSpecVersionClientNewListPagerCall{Call: call}
+}
+
+// MocktemplateSpecVersionClientNewListPagerCall wrap *gomock.Call
+type MocktemplateSpecVersionClientNewListPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktemplateSpecVersionClientNewListPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.TemplateSpecVersionsClientListResponse]) *MocktemplateSpecVersionClientNewListPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktemplateSpecVersionClientNewListPagerCall) Do(f func(string, string, string, *v20231001preview0.TemplateSpecVersionsClientListOptions) *runtime.Pager[v20231001preview0.TemplateSpecVersionsClientListResponse]) *MocktemplateSpecVersionClientNewListPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktemplateSpecVersionClientNewListPagerCall) DoAndReturn(f func(string, string, string, *v20231001preview0.TemplateSpecVersionsClientListOptions) *runtime.Pager[v20231001preview0.TemplateSpecVersionsClientListResponse]) *MocktemplateSpecVersionClientNewListPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+)
+
+const (
+	// operationStatusLocation is the location of the operation statuses of the Radius resource providers.
+	operationStatusLocation = "global"
+
+	// operationStatusAPIVersion is the api-version used to get the status of asynchronous operations.
+	operationStatusAPIVersion = "2023-10-01-preview"
+
+	operationStatusModuleName    = "clients"
+	operationStatusModuleVersion = "v0.0.1"
+)
+
+// GetOperationStatus gets the status of an asynchronous operation of a resource provider by the operation id.
+func (amc *UCPApplicationsManagementClient) GetOperationStatus(ctx context.Context, planeName string, providerNamespace string, operationID string) (v1.AsyncOperationStatus, error) {
+	client, err := arm.NewClient(operationStatusModuleName, operationStatusModuleVersion, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return v1.AsyncOperationStatus{}, err
+	}
+
+	urlPath := fmt.Sprintf("/planes/radius/%s/providers/%s/locations/%s/operationStatuses/%s", url.PathEscape(planeName), url.PathEscape(providerNamespace), operationStatusLocation, url.PathEscape(operationID))
+	request, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.Endpoint(), urlPath))
+	if err != nil {
+		return v1.AsyncOperationStatus{}, err
+	}
+
+	query := request.Raw().URL.Query()
+	query.Set("api-version", operationStatusAPIVersion)
+	request.Raw().URL.RawQuery = query.Encode()
+	request.Raw().Header["Accept"] = []string{"application/json"}
+
+	response, err := client.Pipeline().Do(request)
+	if err != nil {
+		return v1.AsyncOperationStatus{}, err
+	}
+
+	if !runtime.HasStatusCode(response, http.StatusOK) {
+		return v1.AsyncOperationStatus{}, runtime.NewResponseError(response)
+	}
+
+	status := v1.AsyncOperationStatus{}
+	if err := runtime.UnmarshalAsJSON(response, &status); err != nil {
+		return v1.AsyncOperationStatus{}, err
+	}
+
+	return status, nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetOperationStatus(t *testing.T) {
+	operationID := "4c1f4bc4-8fe3-4b9e-8a43-3f9f3e1c5d9e"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/"+operationID {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NotFound","message":"not found"}}`))
+			return
+		}
+
+		require.Equal(t, "2023-10-01-preview", r.URL.Query().Get("api-version"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"` + r.URL.Path + `","name":"` + operationID + `","status":"Updating","startTime":"2024-01-01T10:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	client := &UCPApplicationsManagementClient{ClientOptions: sdk.NewClientOptions(connection)}
+
+	status, err := client.GetOperationStatus(context.Background(), "local", "Applications.Core", operationID)
+	require.NoError(t, err)
+	require.Equal(t, operationID, status.Name)
+	require.Equal(t, v1.ProvisioningStateUpdating, status.Status)
+	require.Equal(t, time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC), status.StartTime)
+
+	_, err = client.GetOperationStatus(context.Background(), "local", "Applications.Dapr", operationID)
+	require.True(t, Is404Error(err))
+}
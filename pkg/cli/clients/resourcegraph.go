@@ -0,0 +1,237 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// ResourceGraphEdgeKindConnection is a connection declared in the connections or routes of a resource.
+	ResourceGraphEdgeKindConnection = "Connection"
+
+	// ResourceGraphEdgeKindSecretReference is a reference to a secret store in the properties of a resource.
+	ResourceGraphEdgeKindSecretReference = "SecretReference"
+
+	// ResourceGraphEdgeKindReference is a reference to another resource in the properties of a resource.
+	ResourceGraphEdgeKindReference = "Reference"
+
+	// ResourceGraphEdgeKindRecipeOutput is a resource created by the recipe of a resource.
+	ResourceGraphEdgeKindRecipeOutput = "RecipeOutput"
+
+	// ResourceGraphEdgeKindOutputResource is a resource created when deploying a resource.
+	ResourceGraphEdgeKindOutputResource = "OutputResource"
+
+	secretStoreResourceType = "Applications.Core/secretStores"
+)
+
+// ResourceGraph is the dependency view of a single resource: the resources it depends on and the resources
+// which depend on it.
+type ResourceGraph struct {
+	// ID is the resource ID of the resource.
+	ID string `json:"id"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// Type is the resource type of the resource.
+	Type string `json:"type"`
+
+	// Dependencies are the resources the resource depends on, including the resources created for it.
+	Dependencies []ResourceGraphEdge `json:"dependencies"`
+
+	// Dependents are the resources which depend on the resource. These resources may break if the resource is deleted.
+	Dependents []ResourceGraphEdge `json:"dependents"`
+}
+
+// ResourceGraphEdge is a relationship between the resource of a ResourceGraph and another resource.
+type ResourceGraphEdge struct {
+	// ID is the resource ID of the other resource.
+	ID string `json:"id"`
+
+	// Name is the name of the other resource.
+	Name string `json:"name"`
+
+	// Type is the resource type of the other resource.
+	Type string `json:"type"`
+
+	// Kind is the kind of the relationship, e.g. Connection or SecretReference.
+	Kind string `json:"kind"`
+}
+
+// computeResourceGraph computes the dependency view of the resource from the application graph and the resources
+// that may refer to it. The application graph may be nil if the resource does not belong to an application.
+func computeResourceGraph(resource generated.GenericResource, applicationGraph *corerp.ApplicationGraphResponse, related []generated.GenericResource) ResourceGraph {
+	id := to.String(resource.ID)
+	graph := ResourceGraph{
+		ID:           id,
+		Name:         to.String(resource.Name),
+		Type:         to.String(resource.Type),
+		Dependencies: []ResourceGraphEdge{},
+		Dependents:   []ResourceGraphEdge{},
+	}
+
+	dependencies := map[string]ResourceGraphEdge{}
+	dependents := map[string]ResourceGraphEdge{}
+
+	// The connections (and routes) are resolved in both directions by the application graph.
+	if applicationGraph != nil {
+		for _, node := range applicationGraph.Resources {
+			if node == nil || !strings.EqualFold(to.String(node.ID), id) {
+				continue
+			}
+
+			for _, connection := range node.Connections {
+				edge := newResourceGraphEdge(to.String(connection.ID), ResourceGraphEdgeKindConnection)
+				if connection.Direction != nil && *connection.Direction == corerp.DirectionInbound {
+					addResourceGraphEdge(dependents, edge)
+				} else {
+					addResourceGraphEdge(dependencies, edge)
+				}
+			}
+
+			outputKind := ResourceGraphEdgeKindOutputResource
+			if _, ok := resource.Properties["recipe"]; ok {
+				outputKind = ResourceGraphEdgeKindRecipeOutput
+			}
+			for _, outputResource := range node.OutputResources {
+				addResourceGraphEdge(dependencies, ResourceGraphEdge{
+					ID:   to.String(outputResource.ID),
+					Name: to.String(outputResource.Name),
+					Type: to.String(outputResource.Type),
+					Kind: outputKind,
+				})
+			}
+		}
+	}
+
+	// References, such as secret references, are found in the properties of the resources.
+	for _, reference := range findResourceReferences(resource.Properties) {
+		if strings.EqualFold(reference, id) {
+			continue
+		}
+		addResourceGraphEdge(dependencies, newResourceGraphEdge(reference, referenceKind(reference)))
+	}
+
+	for _, other := range related {
+		otherID := to.String(other.ID)
+		if otherID == "" || strings.EqualFold(otherID, id) {
+			continue
+		}
+
+		for _, reference := range findResourceReferences(other.Properties) {
+			if strings.EqualFold(reference, id) {
+				addResourceGraphEdge(dependents, newResourceGraphEdge(otherID, referenceKind(id)))
+				break
+			}
+		}
+	}
+
+	graph.Dependencies = sortedResourceGraphEdges(dependencies)
+	graph.Dependents = sortedResourceGraphEdges(dependents)
+	return graph
+}
+
+func newResourceGraphEdge(id string, kind string) ResourceGraphEdge {
+	edge := ResourceGraphEdge{ID: id, Kind: kind}
+	if parsed, err := ucpresources.Parse(id); err == nil {
+		edge.Name = parsed.Name()
+		edge.Type = parsed.Type()
+	}
+
+	return edge
+}
+
+// addResourceGraphEdge adds the edge unless there is already an edge to the same resource. Connections are resolved
+// first, so they take precedence over the references to the same resource.
+func addResourceGraphEdge(edges map[string]ResourceGraphEdge, edge ResourceGraphEdge) {
+	if edge.ID == "" {
+		return
+	}
+
+	key := strings.ToLower(edge.ID)
+	if _, ok := edges[key]; !ok {
+		edges[key] = edge
+	}
+}
+
+func sortedResourceGraphEdges(edges map[string]ResourceGraphEdge) []ResourceGraphEdge {
+	sorted := []ResourceGraphEdge{}
+	for _, edge := range edges {
+		sorted = append(sorted, edge)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Kind != sorted[j].Kind {
+			return sorted[i].Kind < sorted[j].Kind
+		}
+		return strings.ToLower(sorted[i].ID) < strings.ToLower(sorted[j].ID)
+	})
+
+	return sorted
+}
+
+func referenceKind(id string) string {
+	parsed, err := ucpresources.Parse(id)
+	if err == nil && strings.EqualFold(parsed.Type(), secretStoreResourceType) {
+		return ResourceGraphEdgeKindSecretReference
+	}
+
+	return ResourceGraphEdgeKindReference
+}
+
+// findResourceReferences returns the resource IDs referenced in the properties of a resource. The application and
+// environment of the resource are not references, and the status is reported by the application graph.
+func findResourceReferences(properties map[string]any) []string {
+	references := []string{}
+	for key, value := range properties {
+		switch strings.ToLower(key) {
+		case "application", "environment", "status":
+			continue
+		}
+
+		references = appendResourceReferences(references, value)
+	}
+
+	return references
+}
+
+func appendResourceReferences(references []string, value any) []string {
+	switch v := value.(type) {
+	case string:
+		parsed, err := ucpresources.ParseResource(v)
+		if err == nil && parsed.IsResource() && parsed.ProviderNamespace() != "" {
+			references = append(references, v)
+		}
+	case map[string]any:
+		for _, item := range v {
+			references = appendResourceReferences(references, item)
+		}
+	case []any:
+		for _, item := range v {
+			references = appendResourceReferences(references, item)
+		}
+	}
+
+	return references
+}
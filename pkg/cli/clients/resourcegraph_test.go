@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	testGraphScope       = "/planes/radius/local/resourceGroups/test-group"
+	testApplicationID    = testGraphScope + "/providers/Applications.Core/applications/test-app"
+	testEnvironmentID    = testGraphScope + "/providers/Applications.Core/environments/test-env"
+	testFrontendID       = testGraphScope + "/providers/Applications.Core/containers/frontend"
+	testBackendID        = testGraphScope + "/providers/Applications.Core/containers/backend"
+	testRedisID          = testGraphScope + "/providers/Applications.Datastores/redisCaches/redis"
+	testSecretStoreID    = testGraphScope + "/providers/Applications.Core/secretStores/secrets"
+	testOutputResourceID = "/planes/kubernetes/local/namespaces/test-app/providers/apps/Deployment/redis"
+)
+
+func Test_computeResourceGraph(t *testing.T) {
+	applicationGraph := &corerp.ApplicationGraphResponse{
+		Resources: []*corerp.ApplicationGraphResource{
+			{
+				ID: to.Ptr(testRedisID),
+				Connections: []*corerp.ApplicationGraphConnection{
+					{ID: to.Ptr(testFrontendID), Direction: to.Ptr(corerp.DirectionInbound)},
+				},
+				OutputResources: []*corerp.ApplicationGraphOutputResource{
+					{ID: to.Ptr(testOutputResourceID), Name: to.Ptr("redis"), Type: to.Ptr("apps/Deployment")},
+				},
+			},
+			{
+				ID: to.Ptr(testFrontendID),
+				Connections: []*corerp.ApplicationGraphConnection{
+					{ID: to.Ptr(testRedisID), Direction: to.Ptr(corerp.DirectionOutbound)},
+				},
+			},
+		},
+	}
+
+	t.Run("resource with connections, recipe outputs and secret references", func(t *testing.T) {
+		redis := generated.GenericResource{
+			ID:   to.Ptr(testRedisID),
+			Name: to.Ptr("redis"),
+			Type: to.Ptr("Applications.Datastores/redisCaches"),
+			Properties: map[string]any{
+				"application": testApplicationID,
+				"environment": testEnvironmentID,
+				"recipe":      map[string]any{"name": "default"},
+				"secrets": map[string]any{
+					"password": map[string]any{"source": testSecretStoreID},
+				},
+			},
+		}
+		related := []generated.GenericResource{
+			redis,
+			{
+				ID: to.Ptr(testFrontendID),
+				Properties: map[string]any{
+					"application": testApplicationID,
+					"connections": map[string]any{"redis": map[string]any{"source": testRedisID}},
+				},
+			},
+			{
+				ID: to.Ptr(testBackendID),
+				Properties: map[string]any{
+					"application": testApplicationID,
+					"container": map[string]any{
+						"env": map[string]any{
+							"REDIS_HOST": map[string]any{"value": "redis"},
+							"REDIS_ID":   map[string]any{"value": testRedisID},
+						},
+					},
+				},
+			},
+		}
+
+		graph := computeResourceGraph(redis, applicationGraph, related)
+
+		require.Equal(t, ResourceGraph{
+			ID:   testRedisID,
+			Name: "redis",
+			Type: "Applications.Datastores/redisCaches",
+			Dependencies: []ResourceGraphEdge{
+				{ID: testOutputResourceID, Name: "redis", Type: "apps/Deployment", Kind: ResourceGraphEdgeKindRecipeOutput},
+				{ID: testSecretStoreID, Name: "secrets", Type: "Applications.Core/secretStores", Kind: ResourceGraphEdgeKindSecretReference},
+			},
+			Dependents: []ResourceGraphEdge{
+				{ID: testFrontendID, Name: "frontend", Type: "Applications.Core/containers", Kind: ResourceGraphEdgeKindConnection},
+				{ID: testBackendID, Name: "backend", Type: "Applications.Core/containers", Kind: ResourceGraphEdgeKindReference},
+			},
+		}, graph)
+	})
+
+	t.Run("secret store referenced by resources without an application graph", func(t *testing.T) {
+		secretStore := generated.GenericResource{
+			ID:   to.Ptr(testSecretStoreID),
+			Name: to.Ptr("secrets"),
+			Type: to.Ptr("Applications.Core/secretStores"),
+			Properties: map[string]any{
+				"environment": testEnvironmentID,
+			},
+		}
+		related := []generated.GenericResource{
+			{
+				ID: to.Ptr(testRedisID),
+				Properties: map[string]any{
+					"secrets": map[string]any{
+						"password": map[string]any{"source": testSecretStoreID},
+					},
+				},
+			},
+		}
+
+		graph := computeResourceGraph(secretStore, nil, related)
+
+		require.Empty(t, graph.Dependencies)
+		require.Equal(t, []ResourceGraphEdge{
+			{ID: testRedisID, Name: "redis", Type: "Applications.Datastores/redisCaches", Kind: ResourceGraphEdgeKindSecretReference},
+		}, graph.Dependents)
+	})
+}
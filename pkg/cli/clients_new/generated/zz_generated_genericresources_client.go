@@ -280,6 +280,56 @@ func (client *GenericResourcesClient) listByRootScopeHandleResponse(resp *http.R
 	return result, nil
 }
 
+// ListHistory - Lists the prior revisions of a resource
+// If the operation fails it returns an *azcore.ResponseError type.
+// Generated from API version 2023-10-01-preview
+// resourceName - The name of the generic resource
+// options - GenericResourcesClientListHistoryOptions contains the optional parameters for the GenericResourcesClient.ListHistory
+// method.
+func (client *GenericResourcesClient) ListHistory(ctx context.Context, resourceName string, options *GenericResourcesClientListHistoryOptions) (GenericResourcesClientListHistoryResponse, error) {
+	req, err := client.listHistoryCreateRequest(ctx, resourceName, options)
+	if err != nil {
+		return GenericResourcesClientListHistoryResponse{}, err
+	}
+	resp, err := client.pl.Do(req)
+	if err != nil {
+		return GenericResourcesClientListHistoryResponse{}, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return GenericResourcesClientListHistoryResponse{}, runtime.NewResponseError(resp)
+	}
+	return client.listHistoryHandleResponse(resp)
+}
+
+// listHistoryCreateRequest creates the ListHistory request.
+func (client *GenericResourcesClient) listHistoryCreateRequest(ctx context.Context, resourceName string, options *GenericResourcesClientListHistoryOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}/history"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.host, urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// listHistoryHandleResponse handles the ListHistory response.
+func (client *GenericResourcesClient) listHistoryHandleResponse(resp *http.Response) (GenericResourcesClientListHistoryResponse, error) {
+	result := GenericResourcesClientListHistoryResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.GenericResourceRevisionList); err != nil {
+		return GenericResourcesClientListHistoryResponse{}, err
+	}
+	return result, nil
+}
+
 // ListSecrets - Lists secrets for a resource
 // If the operation fails it returns an *azcore.ResponseError type.
 // Generated from API version 2023-10-01-preview
@@ -68,6 +68,27 @@ type GenericResource struct {
 	Type *string `json:"type,omitempty" azure:"ro"`
 }
 
+// GenericResourceRevision - A prior revision of a resource
+type GenericResourceRevision struct {
+	// The ETag of the resource at this revision.
+	Etag *string `json:"etag,omitempty"`
+
+	// The resource at this revision.
+	Resource *GenericResource `json:"resource,omitempty"`
+
+	// The sequence number of the revision.
+	Revision *int32 `json:"revision,omitempty"`
+
+	// The time at which the revision was replaced by an update.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// GenericResourceRevisionList - Object that includes the prior revisions of a resource, ordered from newest to oldest
+type GenericResourceRevisionList struct {
+	// List of prior revisions
+	Value []*GenericResourceRevision `json:"value,omitempty"`
+}
+
 // GenericResourcesClientBeginCreateOrUpdateOptions contains the optional parameters for the GenericResourcesClient.BeginCreateOrUpdate
 // method.
 type GenericResourcesClientBeginCreateOrUpdateOptions struct {
@@ -92,6 +113,11 @@ type GenericResourcesClientListByRootScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// GenericResourcesClientListHistoryOptions contains the optional parameters for the GenericResourcesClient.ListHistory method.
+type GenericResourcesClientListHistoryOptions struct {
+	// placeholder for future optional parameters
+}
+
 // GenericResourcesClientListSecretsOptions contains the optional parameters for the GenericResourcesClient.ListSecrets method.
 type GenericResourcesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
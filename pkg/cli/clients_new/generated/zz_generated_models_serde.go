@@ -167,6 +167,72 @@ func (g *GenericResource) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GenericResourceRevision.
+func (g GenericResourceRevision) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]interface{})
+	populate(objectMap, "etag", g.Etag)
+	populate(objectMap, "resource", g.Resource)
+	populate(objectMap, "revision", g.Revision)
+	populateTimeRFC3339(objectMap, "timestamp", g.Timestamp)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GenericResourceRevision.
+func (g *GenericResourceRevision) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "etag":
+				err = unpopulate(val, "Etag", &g.Etag)
+				delete(rawMsg, key)
+		case "resource":
+				err = unpopulate(val, "Resource", &g.Resource)
+				delete(rawMsg, key)
+		case "revision":
+				err = unpopulate(val, "Revision", &g.Revision)
+				delete(rawMsg, key)
+		case "timestamp":
+				err = unpopulateTimeRFC3339(val, "Timestamp", &g.Timestamp)
+				delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GenericResourceRevisionList.
+func (g GenericResourceRevisionList) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]interface{})
+	populate(objectMap, "value", g.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GenericResourceRevisionList.
+func (g *GenericResourceRevisionList) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "value":
+				err = unpopulate(val, "Value", &g.Value)
+				delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GenericResourcesList.
 func (g GenericResourcesList) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]interface{})
@@ -28,6 +28,11 @@ type GenericResourcesClientListByRootScopeResponse struct {
 	GenericResourcesList
 }
 
+// GenericResourcesClientListHistoryResponse contains the response from method GenericResourcesClient.ListHistory.
+type GenericResourcesClientListHistoryResponse struct {
+	GenericResourceRevisionList
+}
+
 // GenericResourcesClientListSecretsResponse contains the response from method GenericResourcesClient.ListSecrets.
 type GenericResourcesClientListSecretsResponse struct {
 	// Response to a list secrets request
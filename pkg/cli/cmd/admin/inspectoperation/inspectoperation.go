@@ -0,0 +1,364 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectoperation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/logging"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// planeName is the name of the Radius plane of the operations.
+	planeName = "local"
+
+	// defaultSince is the default duration before now from which the logs of the control plane are collected.
+	defaultSince = 24 * time.Hour
+
+	// logFieldTraceID is the field of the structured log lines of the control plane which holds the trace ID.
+	logFieldTraceID = "traceId"
+)
+
+// providerNamespaces are the namespaces of the resource providers searched for the status of an operation when the
+// resource provider cannot be found from the queue message of the operation.
+var providerNamespaces = []string{
+	"Applications.Core",
+	"Applications.Dapr",
+	"Applications.Datastores",
+	"Applications.Messaging",
+}
+
+// NewCommand creates an instance of the command and runner for the `rad admin inspect-operation` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "inspect-operation <operation-id>",
+		Short: "Show the diagnostics of an asynchronous operation",
+		Long: `Show the diagnostics of an asynchronous operation.
+
+Collects everything the control plane knows about an asynchronous operation and renders it as a timeline:
+
+- the message of the operation in the queue of its resource provider, while the operation is pending or running
+- the status of the operation and its error
+- the log lines of the control plane which belong to the operation, correlated by operation ID and trace ID,
+  including the output of the recipe engine and drivers
+
+The operation ID is the last segment of the Azure-AsyncOperation header returned when the operation was started.
+The logs are read from the pods of the control plane, so only the logs of running pods are collected.`,
+		Example: `
+# Show the diagnostics of an operation
+rad admin inspect-operation 2a5f0c8e-6c1b-4d2e-9f3a-7b8c9d0e1f2a
+
+# Show the diagnostics of an operation of the Applications.Datastores resource provider, looking at the last hour of logs
+rad admin inspect-operation 2a5f0c8e-6c1b-4d2e-9f3a-7b8c9d0e1f2a --resource-provider Applications.Datastores --since 1h
+
+# Show the diagnostics of an operation in JSON format
+rad admin inspect-operation 2a5f0c8e-6c1b-4d2e-9f3a-7b8c9d0e1f2a --output json`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().StringVar(&runner.ResourceProvider, "resource-provider", "", "The namespace of the resource provider of the operation, e.g. Applications.Core. Found from the queue message of the operation if not specified.")
+	cmd.Flags().DurationVar(&runner.Since, "since", defaultSince, "The duration before now from which the logs of the control plane are collected.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad admin inspect-operation` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Kubernetes        kubernetes.Interface
+	Output            output.Interface
+
+	Format           string
+	KubeContext      string
+	OperationID      string
+	ResourceProvider string
+	Since            time.Duration
+	Workspace        *workspaces.Workspace
+}
+
+// NewRunner creates a new instance of the `rad admin inspect-operation` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Kubernetes:        factory.GetKubernetesInterface(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// queueMessage is the message of an operation in the queue of its resource provider.
+type queueMessage struct {
+	Name          string
+	Queue         string
+	DequeueCount  int
+	EnqueueAt     time.Time
+	ExpireAt      time.Time
+	NextVisibleAt time.Time
+	TraceParent   string `json:"-"`
+}
+
+// operationReport is the diagnostics of an asynchronous operation.
+type operationReport struct {
+	OperationID   string
+	OperationType string
+	ResourceID    string
+	TraceIDs      []string
+	QueueMessage  *queueMessage
+	Status        *v1.AsyncOperationStatus
+	Timeline      []timelineEvent
+}
+
+// Validate runs validation for the `rad admin inspect-operation` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("The workspace %q does not use a Kubernetes connection. Operations can only be inspected in Kubernetes clusters.", r.Workspace.Name)
+	}
+	r.KubeContext = kubeContext
+
+	if _, err := uuid.Parse(args[0]); err != nil {
+		return clierrors.Message("The operation ID %q is invalid, it must be a UUID.", args[0])
+	}
+	r.OperationID = strings.ToLower(args[0])
+
+	if r.Since <= 0 {
+		return clierrors.Message("The value of --since must be a positive duration, e.g. 1h.")
+	}
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad admin inspect-operation` command.
+func (r *Runner) Run(ctx context.Context) error {
+	report := operationReport{OperationID: r.OperationID}
+
+	err := r.findQueueMessage(ctx, &report)
+	if err != nil {
+		return err
+	}
+
+	report.Status, err = r.getStatus(ctx, report.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	if report.QueueMessage != nil {
+		if traceID := traceIDFromTraceParent(report.QueueMessage.TraceParent); traceID != "" {
+			report.TraceIDs = append(report.TraceIDs, traceID)
+		}
+	}
+
+	lines, err := r.getLogs(ctx, &report)
+	if err != nil {
+		return err
+	}
+
+	if report.QueueMessage == nil && report.Status == nil && len(lines) == 0 {
+		return clierrors.Message("The operation %q was not found. Operations are only found while their status exists, and their logs only while the pods of the control plane which processed them are running.", r.OperationID)
+	}
+
+	report.Timeline = buildTimeline(report.QueueMessage, report.Status, lines)
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, report, output.FormatterOptions{})
+	}
+
+	r.Output.LogInfo("%s", formatSummary(report))
+	return r.Output.WriteFormatted(r.Format, report.Timeline, timelineFormat())
+}
+
+// findQueueMessage finds the message of the operation in the queues of the control plane. The message is deleted
+// once the operation completes.
+func (r *Runner) findQueueMessage(ctx context.Context, report *operationReport) error {
+	messages, err := r.Kubernetes.ListQueueMessages(ctx, r.KubeContext)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		data := struct {
+			OperationID   string `json:"asyncOperationID"`
+			OperationType string `json:"operationType"`
+			ResourceID    string `json:"resourceID"`
+			TraceParent   string `json:"traceparent"`
+		}{}
+		if err := json.Unmarshal(message.Data, &data); err != nil || !strings.EqualFold(data.OperationID, r.OperationID) {
+			continue
+		}
+
+		report.OperationType = data.OperationType
+		report.ResourceID = data.ResourceID
+		report.QueueMessage = &queueMessage{
+			Name:          message.Name,
+			Queue:         message.Queue,
+			DequeueCount:  message.DequeueCount,
+			EnqueueAt:     message.EnqueueAt,
+			ExpireAt:      message.ExpireAt,
+			NextVisibleAt: message.NextVisibleAt,
+			TraceParent:   data.TraceParent,
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// getStatus gets the status of the operation from its resource provider. The resource provider is the one specified
+// with --resource-provider, or the one of the resource of the operation, otherwise each known resource provider is
+// searched. Returns nil if the status is not found.
+func (r *Runner) getStatus(ctx context.Context, resourceID string) (*v1.AsyncOperationStatus, error) {
+	namespaces := providerNamespaces
+	if r.ResourceProvider != "" {
+		namespaces = []string{r.ResourceProvider}
+	} else if id, err := resources.ParseResource(resourceID); err == nil && id.ProviderNamespace() != "" {
+		namespaces = []string{id.ProviderNamespace()}
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, namespace := range namespaces {
+		status, err := client.GetOperationStatus(ctx, planeName, namespace, r.OperationID)
+		if clients.Is404Error(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return &status, nil
+	}
+
+	return nil, nil
+}
+
+// getLogs collects the log lines of the control plane which mention the operation ID or one of the trace IDs of the
+// operation. The trace IDs logged with the operation ID are added to the report, and their log lines collected too,
+// so the lines of the recipe engine and drivers, which are not logged with the operation ID, are included.
+func (r *Runner) getLogs(ctx context.Context, report *operationReport) ([]kubernetes.ControlPlaneLogLine, error) {
+	options := kubernetes.ControlPlaneLogsOptions{Since: r.Since, Contains: append([]string{r.OperationID}, report.TraceIDs...)}
+	lines, err := r.Kubernetes.GetControlPlaneLogs(ctx, r.KubeContext, options)
+	if err != nil {
+		return nil, err
+	}
+
+	found := len(report.TraceIDs)
+	for _, line := range lines {
+		fields := line.Fields()
+		traceID, _ := fields[logFieldTraceID].(string)
+		operationID, _ := fields[logging.LogFieldOperationID].(string)
+		if traceID != "" && strings.EqualFold(operationID, r.OperationID) && !slices.Contains(report.TraceIDs, traceID) {
+			report.TraceIDs = append(report.TraceIDs, traceID)
+		}
+		if report.OperationType == "" {
+			report.OperationType, _ = fields[logging.LogFieldOperationType].(string)
+		}
+		if report.ResourceID == "" {
+			report.ResourceID, _ = fields[logging.LogFieldResourceID].(string)
+		}
+	}
+
+	if len(report.TraceIDs) == found {
+		return lines, nil
+	}
+
+	options.Contains = append([]string{r.OperationID}, report.TraceIDs...)
+	return r.Kubernetes.GetControlPlaneLogs(ctx, r.KubeContext, options)
+}
+
+// traceIDFromTraceParent returns the trace ID of a W3C traceparent header, e.g. '00-<trace-id>-<span-id>-01'.
+func traceIDFromTraceParent(traceParent string) string {
+	parts := strings.Split(traceParent, "-")
+	if len(parts) != 4 {
+		return ""
+	}
+
+	return parts[1]
+}
+
+// formatSummary formats the details of the operation shown above its timeline.
+func formatSummary(report operationReport) string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "Operation:  %s\n", report.OperationID)
+	if report.OperationType != "" {
+		fmt.Fprintf(b, "Type:       %s\n", report.OperationType)
+	}
+	if report.ResourceID != "" {
+		fmt.Fprintf(b, "Resource:   %s\n", report.ResourceID)
+	}
+
+	if report.Status != nil {
+		fmt.Fprintf(b, "Status:     %s\n", report.Status.Status)
+		if report.Status.Error != nil {
+			fmt.Fprintf(b, "Error:      %s: %s\n", report.Status.Error.Code, report.Status.Error.Message)
+		}
+	} else {
+		fmt.Fprintf(b, "Status:     not found\n")
+	}
+
+	if message := report.QueueMessage; message != nil {
+		fmt.Fprintf(b, "Queue:      %s, dequeued %d time(s)", message.Queue, message.DequeueCount)
+		if !message.NextVisibleAt.IsZero() && message.NextVisibleAt.After(message.EnqueueAt) {
+			fmt.Fprintf(b, ", next visible at %s", message.NextVisibleAt.UTC().Format(time.RFC3339))
+		}
+		b.WriteString("\n")
+	} else {
+		fmt.Fprintf(b, "Queue:      no message, the operation is not pending\n")
+	}
+
+	if len(report.TraceIDs) > 0 {
+		fmt.Fprintf(b, "Trace IDs:  %s\n", strings.Join(report.TraceIDs, ", "))
+	}
+
+	return b.String()
+}
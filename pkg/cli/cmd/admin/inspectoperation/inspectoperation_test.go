@@ -0,0 +1,296 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectoperation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	operationID = "2a5f0c8e-6c1b-4d2e-9f3a-7b8c9d0e1f2a"
+	resourceID  = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+	traceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Inspect operation command with operation ID",
+			Input:         []string{operationID},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Inspect operation command with flags",
+			Input:         []string{operationID, "--resource-provider", "Applications.Core", "--since", "1h", "--output", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Inspect operation command without operation ID",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Inspect operation command with invalid operation ID",
+			Input:         []string{"not-an-operation"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Inspect operation command with invalid since",
+			Input:         []string{operationID, "--since", "-1h"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	enqueueAt := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	message := kubernetes.QueueMessage{
+		Name:         "applications.datastores-1",
+		Queue:        "applications.datastores",
+		DequeueCount: 1,
+		EnqueueAt:    enqueueAt,
+		Data:         []byte(`{"asyncOperationID":"` + operationID + `","operationType":"APPLICATIONS.DATASTORES/REDISCACHES|PUT","resourceID":"` + resourceID + `","traceparent":"00-` + traceID + `-00f067aa0ba902b7-01"}`),
+	}
+	status := v1.AsyncOperationStatus{
+		ID:        "/planes/radius/local/providers/Applications.Datastores/locations/global/operationStatuses/" + operationID,
+		Name:      operationID,
+		Status:    v1.ProvisioningStateUpdating,
+		StartTime: enqueueAt,
+	}
+	lines := []kubernetes.ControlPlaneLogLine{
+		{
+			Pod:       "applications-rp-0",
+			Container: "applications-rp",
+			Line:      `{"timestamp":"2023-11-14T22:13:21.000Z","message":"Start processing operation.","operationId":"` + operationID + `","traceId":"` + traceID + `"}`,
+		},
+		{
+			Pod:       "applications-rp-0",
+			Container: "applications-rp",
+			Line:      `{"timestamp":"2023-11-14T22:13:22.000Z","caller":"driver/bicep.go:89","message":"Deploying recipe","traceId":"` + traceID + `"}`,
+		},
+	}
+
+	t.Run("pending operation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		kubernetesClient := kubernetes.NewMockInterface(ctrl)
+		kubernetesClient.EXPECT().
+			ListQueueMessages(gomock.Any(), "kind-kind").
+			Return([]kubernetes.QueueMessage{{Name: "other", Data: []byte(`{"asyncOperationID":"other"}`)}, message}, nil).
+			Times(1)
+		kubernetesClient.EXPECT().
+			GetControlPlaneLogs(gomock.Any(), "kind-kind", kubernetes.ControlPlaneLogsOptions{Since: time.Hour, Contains: []string{operationID, traceID}}).
+			Return(lines, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetOperationStatus(gomock.Any(), "local", "Applications.Datastores", operationID).
+			Return(status, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Kubernetes:        kubernetesClient,
+			Output:            outputSink,
+			Workspace:         workspace,
+			KubeContext:       "kind-kind",
+			OperationID:       operationID,
+			Since:             time.Hour,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expectedTimeline := []timelineEvent{
+			{Time: "2023-11-14T22:13:20Z", Source: sourceQueue, Event: "Message enqueued to applications.datastores", at: enqueueAt},
+			{Time: "2023-11-14T22:13:20Z", Source: sourceStatus, Event: "Operation accepted", at: enqueueAt},
+			{Time: "2023-11-14T22:13:21Z", Source: "applications-rp", Event: "Start processing operation.", at: enqueueAt.Add(time.Second)},
+			{Time: "2023-11-14T22:13:22Z", Source: sourceRecipe, Event: "Deploying recipe", at: enqueueAt.Add(2 * time.Second)},
+		}
+		expected := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{
+					"Operation:  " + operationID + "\n" +
+						"Type:       APPLICATIONS.DATASTORES/REDISCACHES|PUT\n" +
+						"Resource:   " + resourceID + "\n" +
+						"Status:     Updating\n" +
+						"Queue:      applications.datastores, dequeued 1 time(s)\n" +
+						"Trace IDs:  " + traceID + "\n",
+				},
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     expectedTimeline,
+				Options: timelineFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("completed operation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		kubernetesClient := kubernetes.NewMockInterface(ctrl)
+		kubernetesClient.EXPECT().
+			ListQueueMessages(gomock.Any(), "kind-kind").
+			Return([]kubernetes.QueueMessage{}, nil).
+			Times(1)
+
+		// The trace ID is found from the log lines of the operation, and the logs are collected again with it.
+		gomock.InOrder(
+			kubernetesClient.EXPECT().
+				GetControlPlaneLogs(gomock.Any(), "kind-kind", kubernetes.ControlPlaneLogsOptions{Since: time.Hour, Contains: []string{operationID}}).
+				Return(lines[:1], nil).
+				Times(1),
+			kubernetesClient.EXPECT().
+				GetControlPlaneLogs(gomock.Any(), "kind-kind", kubernetes.ControlPlaneLogsOptions{Since: time.Hour, Contains: []string{operationID, traceID}}).
+				Return(lines, nil).
+				Times(1),
+		)
+
+		notFound := &azcore.ResponseError{StatusCode: http.StatusNotFound}
+		completed := status
+		completed.Status = v1.ProvisioningStateFailed
+		completed.EndTime = to.Ptr(enqueueAt.Add(3 * time.Second))
+		completed.Error = &v1.ErrorDetails{Code: "RecipeDeploymentFailed", Message: "failed to deploy recipe"}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetOperationStatus(gomock.Any(), "local", "Applications.Core", operationID).
+			Return(v1.AsyncOperationStatus{}, notFound).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetOperationStatus(gomock.Any(), "local", "Applications.Dapr", operationID).
+			Return(v1.AsyncOperationStatus{}, notFound).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetOperationStatus(gomock.Any(), "local", "Applications.Datastores", operationID).
+			Return(completed, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Kubernetes:        kubernetesClient,
+			Output:            outputSink,
+			Workspace:         workspace,
+			KubeContext:       "kind-kind",
+			OperationID:       operationID,
+			Since:             time.Hour,
+			Format:            "json",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		report := outputSink.Writes[0].(output.FormattedOutput).Obj.(operationReport)
+		require.Nil(t, report.QueueMessage)
+		require.Equal(t, &completed, report.Status)
+		require.Equal(t, []string{traceID}, report.TraceIDs)
+		require.Len(t, report.Timeline, 4)
+		require.Equal(t, "Operation completed with status Failed: RecipeDeploymentFailed: failed to deploy recipe", report.Timeline[3].Event)
+	})
+
+	t.Run("operation not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		kubernetesClient := kubernetes.NewMockInterface(ctrl)
+		kubernetesClient.EXPECT().
+			ListQueueMessages(gomock.Any(), "kind-kind").
+			Return([]kubernetes.QueueMessage{}, nil).
+			Times(1)
+		kubernetesClient.EXPECT().
+			GetControlPlaneLogs(gomock.Any(), "kind-kind", gomock.Any()).
+			Return([]kubernetes.ControlPlaneLogLine{}, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetOperationStatus(gomock.Any(), "local", "Applications.Core", operationID).
+			Return(v1.AsyncOperationStatus{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Kubernetes:        kubernetesClient,
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			KubeContext:       "kind-kind",
+			OperationID:       operationID,
+			ResourceProvider:  "Applications.Core",
+			Since:             time.Hour,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The operation %q was not found. Operations are only found while their status exists, and their logs only while the pods of the control plane which processed them are running.", operationID), err)
+	})
+}
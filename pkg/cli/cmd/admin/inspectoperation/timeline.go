@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectoperation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+)
+
+const (
+	sourceQueue  = "queue"
+	sourceStatus = "status"
+	sourceRecipe = "recipe"
+)
+
+// recipeCallerPrefixes are the prefixes of the callers of the log lines written by the recipe engine and drivers.
+var recipeCallerPrefixes = []string{"driver/", "engine/", "terraform/"}
+
+// logDetailFields are the fields of a structured log line which are shown next to its message.
+var logDetailFields = []string{"provisioningState", "success", "err", "error"}
+
+// timelineEvent is an event of the timeline of an asynchronous operation.
+type timelineEvent struct {
+	// Time is the time of the event, formatted as RFC3339.
+	Time string
+
+	// Source is the origin of the event: 'queue', 'status', 'recipe' or the name of the control plane container
+	// which logged the event.
+	Source string
+
+	// Event describes the event.
+	Event string
+
+	at time.Time
+}
+
+// buildTimeline builds the timeline of an asynchronous operation from its queue message, its status and the log lines
+// of the control plane. The events are sorted by time, events without a time are shown last.
+func buildTimeline(message *queueMessage, status *v1.AsyncOperationStatus, lines []kubernetes.ControlPlaneLogLine) []timelineEvent {
+	events := []timelineEvent{}
+	if message != nil && !message.EnqueueAt.IsZero() {
+		events = append(events, timelineEvent{at: message.EnqueueAt, Source: sourceQueue, Event: fmt.Sprintf("Message enqueued to %s", message.Queue)})
+	}
+
+	if status != nil {
+		if !status.StartTime.IsZero() {
+			events = append(events, timelineEvent{at: status.StartTime, Source: sourceStatus, Event: "Operation accepted"})
+		}
+		if status.EndTime != nil {
+			event := fmt.Sprintf("Operation completed with status %s", status.Status)
+			if status.Error != nil {
+				event += fmt.Sprintf(": %s: %s", status.Error.Code, status.Error.Message)
+			}
+			events = append(events, timelineEvent{at: *status.EndTime, Source: sourceStatus, Event: event})
+		}
+	}
+
+	for _, line := range lines {
+		events = append(events, logEvent(line))
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].at.IsZero() || events[j].at.IsZero() {
+			return !events[i].at.IsZero() && events[j].at.IsZero()
+		}
+		return events[i].at.Before(events[j].at)
+	})
+
+	for i := range events {
+		if !events[i].at.IsZero() {
+			events[i].Time = events[i].at.UTC().Format(time.RFC3339Nano)
+		}
+	}
+
+	return events
+}
+
+// logEvent converts a log line of the control plane to an event. Structured log lines are shown as their message and
+// a few well-known fields, other lines are shown as is.
+func logEvent(line kubernetes.ControlPlaneLogLine) timelineEvent {
+	event := timelineEvent{at: line.Time, Source: line.Container, Event: line.Line}
+
+	fields := line.Fields()
+	if fields == nil {
+		return event
+	}
+
+	if timestamp, ok := fields["timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			event.at = t
+		}
+	}
+
+	if caller, ok := fields["caller"].(string); ok {
+		for _, prefix := range recipeCallerPrefixes {
+			if strings.HasPrefix(caller, prefix) {
+				event.Source = sourceRecipe
+				break
+			}
+		}
+	}
+
+	if message, ok := fields["message"].(string); ok {
+		event.Event = message
+	}
+
+	details := []string{}
+	for _, key := range logDetailFields {
+		if value, ok := fields[key]; ok {
+			details = append(details, fmt.Sprintf("%s=%v", key, value))
+		}
+	}
+	if len(details) > 0 {
+		event.Event += fmt.Sprintf(" (%s)", strings.Join(details, ", "))
+	}
+
+	return event
+}
+
+// timelineFormat sets up the columns and headings for a table to display the timeline of an operation.
+func timelineFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TIME",
+				JSONPath: "{ .Time }",
+			},
+			{
+				Heading:  "SOURCE",
+				JSONPath: "{ .Source }",
+			},
+			{
+				Heading:  "EVENT",
+				JSONPath: "{ .Event }",
+			},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectoperation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_logEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     kubernetes.ControlPlaneLogLine
+		expected timelineEvent
+	}{
+		{
+			name: "structured line",
+			line: kubernetes.ControlPlaneLogLine{
+				Container: "applications-rp",
+				Time:      time.Date(2023, 11, 14, 22, 13, 25, 0, time.UTC),
+				Line:      `{"timestamp":"2023-11-14T22:13:21.500Z","caller":"worker/worker.go:326","message":"Operation returned","success":"false","provisioningState":"Failed"}`,
+			},
+			expected: timelineEvent{
+				Source: "applications-rp",
+				Event:  "Operation returned (provisioningState=Failed, success=false)",
+				at:     time.Date(2023, 11, 14, 22, 13, 21, 500000000, time.UTC),
+			},
+		},
+		{
+			name: "recipe line",
+			line: kubernetes.ControlPlaneLogLine{
+				Container: "applications-rp",
+				Line:      `{"caller":"driver/terraform.go:288","message":"Deploying terraform recipe"}`,
+			},
+			expected: timelineEvent{Source: sourceRecipe, Event: "Deploying terraform recipe"},
+		},
+		{
+			name: "unstructured line",
+			line: kubernetes.ControlPlaneLogLine{
+				Container: "ucp",
+				Time:      time.Date(2023, 11, 14, 22, 13, 25, 0, time.UTC),
+				Line:      "panic: operation failed",
+			},
+			expected: timelineEvent{
+				Source: "ucp",
+				Event:  "panic: operation failed",
+				at:     time.Date(2023, 11, 14, 22, 13, 25, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, logEvent(tt.line))
+		})
+	}
+}
+
+func Test_buildTimeline_EventsWithoutTimeAreLast(t *testing.T) {
+	enqueueAt := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	lines := []kubernetes.ControlPlaneLogLine{
+		{Container: "applications-rp", Line: "no timestamp"},
+		{Container: "applications-rp", Time: enqueueAt.Add(time.Second), Line: "after"},
+	}
+
+	events := buildTimeline(&queueMessage{Queue: "applications.core", EnqueueAt: enqueueAt}, nil, lines)
+	require.Equal(t, []timelineEvent{
+		{Time: "2023-11-14T22:13:20Z", Source: sourceQueue, Event: "Message enqueued to applications.core", at: enqueueAt},
+		{Time: "2023-11-14T22:13:21Z", Source: "applications-rp", Event: "after", at: enqueueAt.Add(time.Second)},
+		{Source: "applications-rp", Event: "no timestamp"},
+	}, events)
+}
@@ -0,0 +1,362 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// apiVersion is the API version of the resources in the exported template.
+	apiVersion = "2023-10-01-preview"
+
+	// applicationResourceType is the resource type of Radius applications.
+	applicationResourceType = "Applications.Core/applications"
+
+	// applicationSymbol is the symbolic name of the application in the exported template.
+	applicationSymbol = "app"
+
+	// environmentParameter is the name of the parameter for the environment ID in the exported template.
+	environmentParameter = "environment"
+
+	indent = "  "
+)
+
+var (
+	// identifierPattern matches the property names which can be written without quotes.
+	identifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// reservedSymbols are the names which cannot be used as symbolic names of resources.
+	reservedSymbols = map[string]bool{
+		applicationSymbol: true, environmentParameter: true,
+		"as": true, "existing": true, "extension": true, "false": true, "for": true, "func": true, "if": true,
+		"import": true, "in": true, "metadata": true, "module": true, "null": true, "output": true, "param": true,
+		"resource": true, "targetScope": true, "true": true, "type": true, "using": true, "var": true, "with": true,
+	}
+
+	// readOnlyProperties are the properties computed by Radius, which are not exported.
+	readOnlyProperties = map[string]bool{
+		"provisioningState": true,
+		"status":            true,
+	}
+
+	// readOnlyPropertiesByType are the properties computed by Radius for specific resource types.
+	readOnlyPropertiesByType = map[string]map[string]bool{
+		"applications.core/gateways": {"url": true},
+	}
+
+	// recipeInputProperties are the properties of a resource provisioned by a recipe which are set by the user. The
+	// other properties of the resource are the values computed by the recipe, and are not exported.
+	recipeInputProperties = map[string]bool{
+		"application":          true,
+		"environment":          true,
+		"recipe":               true,
+		"resourceProvisioning": true,
+	}
+)
+
+// expression is a Bicep expression written as-is in the exported template, such as a reference to another resource.
+type expression string
+
+// exportedResource is a resource of the exported template.
+type exportedResource struct {
+	Symbol     string
+	Type       string
+	Name       string
+	Tags       map[string]any
+	Properties map[string]any
+}
+
+// generateBicep generates a Bicep template reflecting the current state of the application and its resources. The
+// returned notes describe the parts of the state which could not be exported.
+func generateBicep(application corerp.ApplicationResource, resources []generated.GenericResource) (string, []string, error) {
+	applicationProperties, err := toMap(application.Properties)
+	if err != nil {
+		return "", nil, err
+	}
+
+	environmentID, _ := applicationProperties["environment"].(string)
+	references := map[string]expression{
+		strings.ToLower(to.String(application.ID)): expression(applicationSymbol + ".id"),
+	}
+	if environmentID != "" {
+		references[strings.ToLower(environmentID)] = expression(environmentParameter)
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		if !strings.EqualFold(to.String(resources[i].Type), to.String(resources[j].Type)) {
+			return strings.ToLower(to.String(resources[i].Type)) < strings.ToLower(to.String(resources[j].Type))
+		}
+		return strings.ToLower(to.String(resources[i].Name)) < strings.ToLower(to.String(resources[j].Name))
+	})
+
+	// Assign the symbolic names before exporting the properties, so that the resources can reference each other.
+	symbols := map[string]bool{}
+	exported := []exportedResource{}
+	for _, resource := range resources {
+		symbol := uniqueSymbol(to.String(resource.Name), symbols)
+		references[strings.ToLower(to.String(resource.ID))] = expression(symbol + ".id")
+		exported = append(exported, exportedResource{
+			Symbol: symbol,
+			Type:   to.String(resource.Type),
+			Name:   to.String(resource.Name),
+			Tags:   toTags(resource.Tags),
+		})
+	}
+
+	notes := []string{}
+	for i, resource := range resources {
+		properties, recipe := exportProperties(to.String(resource.Type), resource.Properties)
+		if recipe {
+			notes = append(notes, fmt.Sprintf("The resource %q is provisioned by a recipe. The values computed by the recipe are not exported.", exported[i].Name))
+		}
+		exported[i].Properties = replaceReferences(properties, references).(map[string]any)
+	}
+
+	applicationProperties, _ = exportProperties(applicationResourceType, applicationProperties)
+	app := exportedResource{
+		Symbol:     applicationSymbol,
+		Type:       applicationResourceType,
+		Name:       to.String(application.Name),
+		Tags:       toTags(application.Tags),
+		Properties: replaceReferences(applicationProperties, references).(map[string]any),
+	}
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "// Exported by 'rad app export' from the deployed state of the application '%s'.\n", app.Name)
+	b.WriteString("// This template is a best-effort reconstruction. Secrets are not exported.\n")
+	b.WriteString("// Review the template before deploying it.\n\n")
+	b.WriteString("extension radius\n\n")
+	b.WriteString("@description('The ID of the Radius environment. Set automatically by the rad CLI.')\n")
+	fmt.Fprintf(b, "param %s string\n", environmentParameter)
+
+	for _, resource := range append([]exportedResource{app}, exported...) {
+		b.WriteString("\n")
+		writeResource(b, resource)
+	}
+
+	return b.String(), notes, nil
+}
+
+// exportProperties returns the properties of a resource which are set by the user, and whether the resource is
+// provisioned by a recipe.
+func exportProperties(resourceType string, properties map[string]any) (map[string]any, bool) {
+	recipe := false
+	if _, ok := properties["recipe"]; ok {
+		provisioning, _ := properties["resourceProvisioning"].(string)
+		recipe = !strings.EqualFold(provisioning, "manual")
+	}
+
+	result := map[string]any{}
+	for key, value := range properties {
+		if readOnlyProperties[key] || readOnlyPropertiesByType[strings.ToLower(resourceType)][key] {
+			continue
+		}
+		if recipe && !recipeInputProperties[key] {
+			continue
+		}
+		result[key] = value
+	}
+
+	return result, recipe
+}
+
+// replaceReferences replaces the string values matching the ID of a resource of the template (ignoring case) with a
+// reference to the resource.
+func replaceReferences(value any, references map[string]expression) any {
+	switch v := value.(type) {
+	case string:
+		if reference, ok := references[strings.ToLower(v)]; ok && v != "" {
+			return reference
+		}
+		return v
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			result[key] = replaceReferences(item, references)
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			result = append(result, replaceReferences(item, references))
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// uniqueSymbol creates a symbolic name from the name of a resource, which does not conflict with the given symbols.
+func uniqueSymbol(name string, symbols map[string]bool) string {
+	symbol := ""
+	upper := false
+	for _, r := range name {
+		switch {
+		case r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'):
+			upper = symbol != ""
+		case upper:
+			symbol += string(unicode.ToUpper(r))
+			upper = false
+		default:
+			symbol += string(r)
+		}
+	}
+
+	if symbol == "" || unicode.IsDigit(rune(symbol[0])) {
+		symbol = "r" + symbol
+	}
+	if reservedSymbols[symbol] {
+		symbol += "Resource"
+	}
+
+	unique := symbol
+	for i := 2; symbols[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", symbol, i)
+	}
+	symbols[unique] = true
+
+	return unique
+}
+
+func writeResource(b *strings.Builder, resource exportedResource) {
+	fmt.Fprintf(b, "resource %s '%s@%s' = {\n", resource.Symbol, resource.Type, apiVersion)
+	fmt.Fprintf(b, "%sname: %s\n", indent, formatString(resource.Name))
+	if len(resource.Tags) > 0 {
+		fmt.Fprintf(b, "%stags: ", indent)
+		writeValue(b, resource.Tags, 1)
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(b, "%sproperties: ", indent)
+	writeValue(b, resource.Properties, 1)
+	b.WriteString("\n}\n")
+}
+
+// writeValue writes a JSON value as a Bicep value at the given indentation level.
+func writeValue(b *strings.Builder, value any, level int) {
+	switch v := value.(type) {
+	case nil:
+		b.WriteString("null")
+	case expression:
+		b.WriteString(string(v))
+	case string:
+		b.WriteString(formatString(v))
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	case float64:
+		b.WriteString(formatNumber(v))
+	case map[string]any:
+		if len(v) == 0 {
+			b.WriteString("{}")
+			return
+		}
+
+		keys := []string{}
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("{\n")
+		for _, key := range keys {
+			b.WriteString(strings.Repeat(indent, level+1))
+			if identifierPattern.MatchString(key) {
+				b.WriteString(key)
+			} else {
+				b.WriteString(formatString(key))
+			}
+			b.WriteString(": ")
+			writeValue(b, v[key], level+1)
+			b.WriteString("\n")
+		}
+		b.WriteString(strings.Repeat(indent, level) + "}")
+	case []any:
+		if len(v) == 0 {
+			b.WriteString("[]")
+			return
+		}
+
+		b.WriteString("[\n")
+		for _, item := range v {
+			b.WriteString(strings.Repeat(indent, level+1))
+			writeValue(b, item, level+1)
+			b.WriteString("\n")
+		}
+		b.WriteString(strings.Repeat(indent, level) + "]")
+	default:
+		// Values of other types are only created by the JSON decoder for numbers, which are handled above.
+		b.WriteString(formatString(fmt.Sprintf("%v", v)))
+	}
+}
+
+// formatString formats a string as a Bicep string literal.
+func formatString(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`'`, `\'`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", `\${`,
+	)
+	return "'" + replacer.Replace(value) + "'"
+}
+
+// formatNumber formats a number as a Bicep value. Bicep only supports integer literals, other numbers are written
+// with the json() function.
+func formatNumber(value float64) string {
+	if value == math.Trunc(value) && math.Abs(value) < 1<<53 {
+		return strconv.FormatInt(int64(value), 10)
+	}
+	return fmt.Sprintf("json('%s')", strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// toMap converts a model of the API to its JSON representation.
+func toMap(model any) (map[string]any, error) {
+	b, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	err = json.Unmarshal(b, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func toTags(tags map[string]*string) map[string]any {
+	result := map[string]any{}
+	for key, value := range tags {
+		result[key] = to.String(value)
+	}
+
+	return result
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_uniqueSymbol(t *testing.T) {
+	symbols := map[string]bool{}
+	require.Equal(t, "frontend", uniqueSymbol("frontend", symbols))
+	require.Equal(t, "frontend2", uniqueSymbol("frontend", symbols))
+	require.Equal(t, "myRedisCache", uniqueSymbol("my-redis.cache", symbols))
+	require.Equal(t, "r1api", uniqueSymbol("1api", symbols))
+	require.Equal(t, "appResource", uniqueSymbol("app", symbols))
+	require.Equal(t, "environmentResource", uniqueSymbol("environment", symbols))
+	require.Equal(t, "outputResource", uniqueSymbol("output", symbols))
+}
+
+func Test_formatString(t *testing.T) {
+	require.Equal(t, `'hello'`, formatString("hello"))
+	require.Equal(t, `'it\'s'`, formatString("it's"))
+	require.Equal(t, `'a\\b'`, formatString(`a\b`))
+	require.Equal(t, `'line1\nline2\ttab'`, formatString("line1\nline2\ttab"))
+	require.Equal(t, `'\${value} $value'`, formatString("${value} $value"))
+}
+
+func Test_formatNumber(t *testing.T) {
+	require.Equal(t, "3000", formatNumber(3000))
+	require.Equal(t, "-1", formatNumber(-1))
+	require.Equal(t, "json('1.5')", formatNumber(1.5))
+}
+
+func Test_exportProperties(t *testing.T) {
+	t.Run("read-only properties", func(t *testing.T) {
+		properties, recipe := exportProperties("Applications.Core/gateways", map[string]any{
+			"application":       "app",
+			"provisioningState": "Succeeded",
+			"status":            map[string]any{},
+			"url":               "http://localhost",
+		})
+		require.False(t, recipe)
+		require.Equal(t, map[string]any{"application": "app"}, properties)
+	})
+
+	t.Run("recipe", func(t *testing.T) {
+		properties, recipe := exportProperties("Applications.Datastores/sqlDatabases", map[string]any{
+			"application": "app",
+			"recipe":      map[string]any{"name": "default"},
+			"server":      "sql.example.com",
+		})
+		require.True(t, recipe)
+		require.Equal(t, map[string]any{"application": "app", "recipe": map[string]any{"name": "default"}}, properties)
+	})
+}
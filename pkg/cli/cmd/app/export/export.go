@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	destinationFileFlag = "destination-file"
+	forceFlag           = "force"
+)
+
+// NewCommand creates an instance of the `rad app export` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "export [application]",
+		Short: "Export a Radius Application to a Bicep file",
+		Long: `Export a Radius Application to a Bicep file.
+
+Generates a Bicep file reflecting the current state of the application and its resources, such as containers, gateways,
+secret stores and portable resources. Connections and other references between the resources of the application are
+exported as references to the resources of the Bicep file.
+
+The exported file is a best-effort reconstruction of the application, which can be used when the original template is
+no longer available. Secrets are not returned by Radius and are not exported, and the values computed by the recipes of
+the resources provisioned by recipes are not exported. Review the exported file before deploying it.`,
+		Example: `
+# export the current application to <application>.bicep
+rad app export
+
+# export an application to a specific file
+rad app export myapp --destination-file app.bicep
+
+# overwrite the destination file if it exists
+rad app export myapp --destination-file app.bicep --force
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().StringP(destinationFileFlag, "d", "", "Path of the exported Bicep file. Defaults to <application>.bicep")
+	_ = cmd.MarkFlagFilename(destinationFileFlag, ".bicep")
+	cmd.Flags().Bool(forceFlag, false, "Overwrite the destination file if it exists")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad app export` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	FileSystem        filesystem.FileSystem
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	DestinationFile string
+	Force           bool
+}
+
+// NewRunner creates a new instance of the `rad app export` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app export` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.DestinationFile, err = cmd.Flags().GetString(destinationFileFlag)
+	if err != nil {
+		return err
+	}
+
+	if r.DestinationFile == "" {
+		r.DestinationFile = r.ApplicationName + ".bicep"
+	}
+
+	if filepath.Ext(r.DestinationFile) != ".bicep" {
+		return clierrors.Message("Destination file must have a .bicep extension")
+	}
+
+	r.Force, err = cmd.Flags().GetBool(forceFlag)
+	if err != nil {
+		return err
+	}
+
+	if r.FileSystem == nil {
+		r.FileSystem = filesystem.NewOSFS()
+	}
+
+	return nil
+}
+
+// Run runs the `rad app export` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if !r.Force && r.FileSystem.Exists(r.DestinationFile) {
+		return clierrors.Message("The file %q already exists. Use --%s to overwrite it.", r.DestinationFile, forceFlag)
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	application, err := client.GetApplication(ctx, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	resources, err := client.ListResourcesInApplication(ctx, r.ApplicationName)
+	if err != nil {
+		return err
+	}
+
+	template, notes, err := generateBicep(application, resources)
+	if err != nil {
+		return err
+	}
+
+	err = r.FileSystem.WriteFile(r.DestinationFile, []byte(template), 0644)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Exported application %q with %d resource(s) to %s", r.ApplicationName, len(resources), r.DestinationFile)
+	for _, note := range notes {
+		r.Output.LogInfo("Note: %s", note)
+	}
+
+	return nil
+}
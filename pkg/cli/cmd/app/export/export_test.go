@@ -0,0 +1,316 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/config"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testScope         = "/planes/radius/local/resourceGroups/test-group"
+	testEnvironmentID = testScope + "/providers/Applications.Core/environments/test-env"
+	testApplicationID = testScope + "/providers/Applications.Core/applications/test-app"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Export Command with default application",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+				DirectoryConfig: &config.DirectoryConfig{
+					Workspace: config.DirectoryWorkspaceConfig{
+						Application: "test-app",
+					},
+				},
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "test-app.bicep", runner.(*Runner).DestinationFile)
+			},
+		},
+		{
+			Name:          "Export Command with destination file",
+			Input:         []string{"test-app", "--destination-file", "app.bicep", "--force"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "app.bicep", runner.(*Runner).DestinationFile)
+				require.True(t, runner.(*Runner).Force)
+			},
+		},
+		{
+			Name:          "Export Command with invalid destination file",
+			Input:         []string{"test-app", "--destination-file", "app.json"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+		},
+		{
+			Name:          "Export Command without application",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+		},
+		{
+			Name:          "Export Command with too many args",
+			Input:         []string{"foo", "bar"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: testScope,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(testApplication(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(testResources(), nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			FileSystem:        filesystem.NewMemMapFileSystem(),
+			Output:            outputSink,
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			DestinationFile:   "test-app.bicep",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected, err := os.ReadFile(filepath.Join("testdata", "test-app.bicep"))
+		require.NoError(t, err)
+
+		actual, err := runner.FileSystem.ReadFile("test-app.bicep")
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(actual))
+
+		expectedOutput := []any{
+			output.LogOutput{
+				Format: "Exported application %q with %d resource(s) to %s",
+				Params: []any{"test-app", 4, "test-app.bicep"},
+			},
+			output.LogOutput{
+				Format: "Note: %s",
+				Params: []any{"The resource \"cache\" is provisioned by a recipe. The values computed by the recipe are not exported."},
+			},
+		}
+		require.Equal(t, expectedOutput, outputSink.Writes)
+	})
+
+	t.Run("Error: Application Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(corerp.ApplicationResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			FileSystem:        filesystem.NewMemMapFileSystem(),
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			DestinationFile:   "test-app.bicep",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application \"test-app\" was not found or has been deleted."), err)
+		require.False(t, runner.FileSystem.Exists("test-app.bicep"))
+	})
+
+	t.Run("Error: Destination File Exists", func(t *testing.T) {
+		fs := filesystem.NewMemMapFileSystem()
+		require.NoError(t, fs.WriteFile("test-app.bicep", []byte("existing"), 0644))
+
+		runner := &Runner{
+			FileSystem:      fs,
+			Output:          &output.MockOutput{},
+			Workspace:       workspace,
+			ApplicationName: "test-app",
+			DestinationFile: "test-app.bicep",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The file %q already exists. Use --%s to overwrite it.", "test-app.bicep", forceFlag), err)
+	})
+}
+
+func testApplication() corerp.ApplicationResource {
+	return corerp.ApplicationResource{
+		ID:       to.Ptr(testApplicationID),
+		Name:     to.Ptr("test-app"),
+		Type:     to.Ptr("Applications.Core/applications"),
+		Location: to.Ptr("global"),
+		Properties: &corerp.ApplicationProperties{
+			Environment:       to.Ptr(testEnvironmentID),
+			ProvisioningState: to.Ptr(corerp.ProvisioningStateSucceeded),
+			Status: &corerp.ResourceStatus{
+				Compute: &corerp.KubernetesCompute{
+					Kind:      to.Ptr("kubernetes"),
+					Namespace: to.Ptr("test-env-test-app"),
+				},
+			},
+		},
+	}
+}
+
+func testResources() []generated.GenericResource {
+	return []generated.GenericResource{
+		{
+			ID:   to.Ptr(testScope + "/providers/Applications.Core/gateways/public"),
+			Name: to.Ptr("public"),
+			Type: to.Ptr("Applications.Core/gateways"),
+			Properties: map[string]any{
+				"application":       testApplicationID,
+				"provisioningState": "Succeeded",
+				"url":               "http://public.test-app.localhost",
+				"routes": []any{
+					map[string]any{
+						"path":        "/",
+						"destination": "http://frontend:3000",
+					},
+				},
+			},
+		},
+		{
+			ID:   to.Ptr(testScope + "/providers/Applications.Core/containers/frontend"),
+			Name: to.Ptr("frontend"),
+			Type: to.Ptr("Applications.Core/containers"),
+			Tags: map[string]*string{"team": to.Ptr("web")},
+			Properties: map[string]any{
+				"application":       testApplicationID,
+				"environment":       testEnvironmentID,
+				"provisioningState": "Succeeded",
+				"container": map[string]any{
+					"image": "ghcr.io/radius-project/samples/demo:latest",
+					"ports": map[string]any{
+						"web": map[string]any{
+							"containerPort": float64(3000),
+						},
+					},
+					"env": map[string]any{
+						"GREETING": map[string]any{
+							"value": "it's ${name}",
+						},
+					},
+				},
+				"connections": map[string]any{
+					"redis": map[string]any{
+						"source": testScope + "/providers/Applications.Datastores/redisCaches/cache",
+					},
+					"external-api": map[string]any{
+						"source": "https://example.com",
+					},
+				},
+				"extensions": []any{},
+				"status": map[string]any{
+					"outputResources": []any{},
+				},
+			},
+		},
+		{
+			ID:   to.Ptr(testScope + "/providers/Applications.Datastores/redisCaches/cache"),
+			Name: to.Ptr("cache"),
+			Type: to.Ptr("Applications.Datastores/redisCaches"),
+			Properties: map[string]any{
+				"application":          testApplicationID,
+				"environment":          testEnvironmentID,
+				"provisioningState":    "Succeeded",
+				"resourceProvisioning": "recipe",
+				"recipe": map[string]any{
+					"name": "default",
+					"parameters": map[string]any{
+						"memory": float64(1.5),
+					},
+				},
+				"host": "redis.test-env-test-app.svc.cluster.local",
+				"port": float64(6379),
+			},
+		},
+		{
+			ID:   to.Ptr(testScope + "/providers/Applications.Datastores/redisCaches/legacy-cache"),
+			Name: to.Ptr("legacy-cache"),
+			Type: to.Ptr("Applications.Datastores/redisCaches"),
+			Properties: map[string]any{
+				"application":          testApplicationID,
+				"environment":          testEnvironmentID,
+				"resourceProvisioning": "manual",
+				"host":                 "legacy.example.com",
+				"port":                 float64(6380),
+				"tls":                  true,
+			},
+		},
+	}
+}
@@ -17,10 +17,64 @@ limitations under the License.
 package graph
 
 import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
+const (
+	kindContainer   = "container"
+	kindGateway     = "gateway"
+	kindSecretStore = "secretStore"
+	kindVolume      = "volume"
+	kindExtender    = "extender"
+	kindDatastore   = "datastore"
+	kindMessaging   = "messaging"
+	kindDapr        = "dapr"
+	kindCustom      = "custom"
+)
+
+// resourceKind returns the kind of a resource of the application graph, which describes the role of the resource
+// independently of its exact type. Resources of user-defined types are of the custom kind.
+func resourceKind(resourceType string) string {
+	namespace, name, _ := strings.Cut(strings.ToLower(resourceType), "/")
+	switch namespace {
+	case "applications.core":
+		switch name {
+		case "containers":
+			return kindContainer
+		case "gateways":
+			return kindGateway
+		case "secretstores":
+			return kindSecretStore
+		case "volumes":
+			return kindVolume
+		case "extenders":
+			return kindExtender
+		}
+	case "applications.datastores":
+		return kindDatastore
+	case "applications.messaging":
+		return kindMessaging
+	case "applications.dapr":
+		return kindDapr
+	}
+
+	return kindCustom
+}
+
+// outputResourceProvider returns the provider of the output resource. The provider is computed from the resource ID
+// when it is not returned by the server.
+func outputResourceProvider(resource *v20231001preview.ApplicationGraphOutputResource) string {
+	if resource.Provider != nil && *resource.Provider != "" {
+		return *resource.Provider
+	}
+
+	return providerFromID(*resource.ID)
+}
+
 func providerFromID(id string) string {
 	parsed, err := resources.ParseResource(id)
 	if err != nil {
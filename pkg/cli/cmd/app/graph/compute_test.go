@@ -19,6 +19,8 @@ package graph
 import (
 	"testing"
 
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +34,31 @@ func Test_providerFromID(t *testing.T) {
 		require.Equal(t, "", providerFromID("\ndkdkfkdfs\t"))
 	})
 }
+
+func Test_outputResourceProvider(t *testing.T) {
+	t.Run("provider returned by the server", func(t *testing.T) {
+		resource := &corerpv20231001preview.ApplicationGraphOutputResource{
+			ID:       to.Ptr(awsMemoryDBResourceID),
+			Provider: to.Ptr("kubernetes"),
+		}
+		require.Equal(t, "kubernetes", outputResourceProvider(resource))
+	})
+
+	t.Run("provider computed from resource ID", func(t *testing.T) {
+		resource := &corerpv20231001preview.ApplicationGraphOutputResource{
+			ID: to.Ptr(awsMemoryDBResourceID),
+		}
+		require.Equal(t, "aws", outputResourceProvider(resource))
+	})
+}
+
+func Test_resourceKind(t *testing.T) {
+	require.Equal(t, kindContainer, resourceKind(containerResourceType))
+	require.Equal(t, kindGateway, resourceKind("Applications.Core/gateways"))
+	require.Equal(t, kindSecretStore, resourceKind("applications.core/secretstores"))
+	require.Equal(t, kindDatastore, resourceKind(redisResourceType))
+	require.Equal(t, kindMessaging, resourceKind("Applications.Messaging/rabbitMQQueues"))
+	require.Equal(t, kindDapr, resourceKind("Applications.Dapr/stateStores"))
+	require.Equal(t, kindCustom, resourceKind("Applications.Core/unknown"))
+	require.Equal(t, kindCustom, resourceKind("MyCompany.Resources/postgres"))
+}
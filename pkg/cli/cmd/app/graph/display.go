@@ -22,30 +22,13 @@ import (
 	"strings"
 
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/resourcemodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 // display builds the formatted output for the application graph as text.
 func display(applicationResources []*v20231001preview.ApplicationGraphResource, applicationName string) string {
-	// Sort by type (containers first), and then by other types, name and then by id.
-	containerType := "Applications.Core/containers"
-	sort.Slice(applicationResources, func(i, j int) bool {
-		if strings.EqualFold(*applicationResources[i].Type, containerType) !=
-			strings.EqualFold(*applicationResources[j].Type, containerType) {
-
-			return strings.EqualFold(*applicationResources[i].Type, containerType)
-		}
-
-		if *applicationResources[i].Type != *applicationResources[j].Type {
-			return *applicationResources[i].Type < *applicationResources[j].Type
-		}
-
-		if *applicationResources[i].Name != *applicationResources[j].Name {
-			return *applicationResources[i].Name < *applicationResources[j].Name
-		}
-		return *applicationResources[i].ID < *applicationResources[j].ID
-
-	})
+	sortResources(applicationResources)
 
 	output := &strings.Builder{}
 	output.WriteString(fmt.Sprintf("Displaying application: %s\n\n", applicationName))
@@ -88,12 +71,12 @@ func display(applicationResources []*v20231001preview.ApplicationGraphResource,
 		} else {
 			output.WriteString("Resources:\n")
 			for _, resource := range resource.OutputResources {
-				link := makeHyperlink(resource)
-				if link == "" {
-					output.WriteString(fmt.Sprintf("  %s (%s)\n", *resource.Name, *resource.Type))
-				} else {
-					output.WriteString(fmt.Sprintf("  %s (%s)\n", link, *resource.Type))
+				name := *resource.Name
+				if link := makeHyperlink(resource); link != "" {
+					name = link
 				}
+
+				output.WriteString(fmt.Sprintf("  %s (%s)%s\n", name, *resource.Type, makeMetadata(resource)))
 			}
 		}
 
@@ -103,10 +86,50 @@ func display(applicationResources []*v20231001preview.ApplicationGraphResource,
 	return output.String()
 }
 
+// displayHealth builds the formatted output for the reachability checks of the connections as text.
+func displayHealth(results []connectionHealth) string {
+	output := &strings.Builder{}
+	output.WriteString("Connection health:\n")
+
+	if len(results) == 0 {
+		output.WriteString("  (none)\n\n")
+		return output.String()
+	}
+
+	for _, result := range results {
+		status := result.Status
+		if result.Details != "" {
+			status = fmt.Sprintf("%s (%s)", result.Status, result.Details)
+		}
+
+		output.WriteString(fmt.Sprintf("  %s -> %s (%s): %s\n", result.Source, result.Target, result.TargetType, status))
+	}
+
+	output.WriteString("\n")
+	return output.String()
+}
+
+// makeMetadata builds the provider and region annotation of an output resource, for example " [aws, us-west-2]".
+func makeMetadata(resource *v20231001preview.ApplicationGraphOutputResource) string {
+	metadata := []string{}
+	if provider := outputResourceProvider(resource); provider != "" {
+		metadata = append(metadata, provider)
+	}
+	if resource.Region != nil && *resource.Region != "" {
+		metadata = append(metadata, *resource.Region)
+	}
+
+	if len(metadata) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" [%s]", strings.Join(metadata, ", "))
+}
+
 func makeHyperlink(resource *v20231001preview.ApplicationGraphOutputResource) string {
 	// Just azure for now.
-	provider := providerFromID(*resource.ID)
-	if provider != "azure" {
+	provider := outputResourceProvider(resource)
+	if provider != resourcemodel.ProviderAzure {
 		return ""
 	}
 	// https://portal.azure.com/#@{tenantId}/resource{resourceId}
@@ -116,3 +139,25 @@ func makeHyperlink(resource *v20231001preview.ApplicationGraphOutputResource) st
 	// \x1b]8;;h { URL } \x07 { link text } \x1b]8;;\x07
 	return fmt.Sprintf("\x1b]8;;%s\x07%s\x1b]8;;\x07", url, *resource.Name)
 }
+
+// sortResources sorts the resources of the application graph by type (containers first), name and then id.
+func sortResources(applicationResources []*v20231001preview.ApplicationGraphResource) {
+	containerType := "Applications.Core/containers"
+	sort.Slice(applicationResources, func(i, j int) bool {
+		if strings.EqualFold(*applicationResources[i].Type, containerType) !=
+			strings.EqualFold(*applicationResources[j].Type, containerType) {
+
+			return strings.EqualFold(*applicationResources[i].Type, containerType)
+		}
+
+		if *applicationResources[i].Type != *applicationResources[j].Type {
+			return *applicationResources[i].Type < *applicationResources[j].Type
+		}
+
+		if *applicationResources[i].Name != *applicationResources[j].Name {
+			return *applicationResources[i].Name < *applicationResources[j].Name
+		}
+		return *applicationResources[i].ID < *applicationResources[j].ID
+
+	})
+}
@@ -56,6 +56,16 @@ func Test_display(t *testing.T) {
 		azureRedisName := "redis"
 		azureRedisType := "Applications.Datastores/redis"
 
+		awsMemoryDBName := "redis-aqbjixghynqgg"
+		awsMemoryDBType := "AWS.MemoryDB/Cluster"
+		awsProvider := "aws"
+		awsRegion := "us-west-2"
+
+		deploymentID := "/planes/kubernetes/local/namespaces/test-app/providers/apps/Deployment/sql-ctnr"
+		deploymentName := "sql-ctnr"
+		deploymentType := "apps/Deployment"
+		kubernetesProvider := "kubernetes"
+
 		provisioningStateSuccess := "Succeeded"
 		dirInbound := corerpv20231001preview.DirectionInbound
 		dirOutbound := corerpv20231001preview.DirectionOutbound
@@ -79,7 +89,14 @@ func Test_display(t *testing.T) {
 				Name:              &sqlCntrName,
 				Type:              &sqlCntrType,
 				ProvisioningState: &provisioningStateSuccess,
-				OutputResources:   []*corerpv20231001preview.ApplicationGraphOutputResource{},
+				OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
+					{
+						ID:       &deploymentID,
+						Name:     &deploymentName,
+						Type:     &deploymentType,
+						Provider: &kubernetesProvider,
+					},
+				},
 				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
 					{
 						ID:        &backendID,
@@ -118,6 +135,13 @@ func Test_display(t *testing.T) {
 						Name: &azureRedisName,
 						Type: &azureRedisType,
 					},
+					{
+						ID:       &awsMemoryDBResourceID,
+						Name:     &awsMemoryDBName,
+						Type:     &awsMemoryDBType,
+						Provider: &awsProvider,
+						Region:   &awsRegion,
+					},
 				},
 				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
 					{
@@ -143,13 +167,15 @@ Resources: (none)
 Name: sql-ctnr (Applications.Core/containers)
 Connections:
   sql-ctnr -> backend (Applications.Core/containers)
-Resources: (none)
+Resources:
+  sql-ctnr (apps/Deployment) [kubernetes]
 
 Name: redis (Applications.Datastores/redis)
 Connections:
   sql-db (Applications.Datastores/sqlDatabases) -> redis
 Resources:
-  ` + "\x1b]8;;" + `https://portal.azure.com/#@72f988bf-86f1-41af-91ab-2d7cd011db47/resource/planes/azure/local/resourcegroups/default/providers/Applications.Datastores/Microsoft.Cache/Azure` + "\aredis\x1b]8;;\a" + ` (Applications.Datastores/redis)
+  ` + "\x1b]8;;" + `https://portal.azure.com/#@72f988bf-86f1-41af-91ab-2d7cd011db47/resource/planes/azure/local/resourcegroups/default/providers/Applications.Datastores/Microsoft.Cache/Azure` + "\aredis\x1b]8;;\a" + ` (Applications.Datastores/redis) [azure]
+  redis-aqbjixghynqgg (AWS.MemoryDB/Cluster) [aws, us-west-2]
 
 Name: sql-db (Applications.Datastores/sqlDatabases)
 Connections: (none)
@@ -162,3 +188,25 @@ Resources: (none)
 	})
 
 }
+
+func Test_displayHealth(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		require.Equal(t, "Connection health:\n  (none)\n\n", displayHealth([]connectionHealth{}))
+	})
+
+	t.Run("complex", func(t *testing.T) {
+		results := []connectionHealth{
+			{Source: "webapp", Target: "redis", TargetType: "Applications.Datastores/redisCaches", Status: healthReachable, Details: "redis.default:6379"},
+			{Source: "webapp", Target: "backend", TargetType: "Applications.Core/containers", Status: healthUnreachable, Details: "backend.default:3000"},
+			{Source: "worker", Target: "sql-db", TargetType: "Applications.Datastores/sqlDatabases", Status: healthUnknown, Details: "no endpoint found for sql-db"},
+		}
+
+		expected := `Connection health:
+  webapp -> redis (Applications.Datastores/redisCaches): reachable (redis.default:6379)
+  webapp -> backend (Applications.Core/containers): unreachable (backend.default:3000)
+  worker -> sql-db (Applications.Datastores/sqlDatabases): unknown (no endpoint found for sql-db)
+
+`
+		require.Equal(t, expected, displayHealth(results))
+	})
+}
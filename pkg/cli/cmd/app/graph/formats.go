@@ -0,0 +1,265 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// formatText is the human-readable text format of the application graph.
+	formatText = "text"
+
+	// formatDot is the Graphviz DOT format of the application graph.
+	formatDot = "dot"
+
+	// formatMermaid is the Mermaid flowchart format of the application graph.
+	formatMermaid = "mermaid"
+
+	// formatJSON is the JSON format of the application graph.
+	formatJSON = "json"
+)
+
+// supportedFormats are the formats supported by `rad app graph --format`.
+var supportedFormats = []string{formatText, formatDot, formatMermaid, formatJSON}
+
+// graphDocument is the application graph exported with the dot, mermaid and json formats. Unlike the response of the
+// API, where each connection is listed on both of its ends, each connection is listed once from its source to its
+// target.
+type graphDocument struct {
+	// Application is the name of the application.
+	Application string `json:"application"`
+
+	// Resources are the resources of the application graph, and the resources outside of the application they are
+	// connected to.
+	Resources []graphNode `json:"resources"`
+
+	// Connections are the connections between the resources.
+	Connections []graphEdge `json:"connections"`
+}
+
+// graphNode is a resource of the application graph.
+type graphNode struct {
+	ID                string                `json:"id"`
+	Name              string                `json:"name"`
+	Type              string                `json:"type"`
+	Kind              string                `json:"kind"`
+	ProvisioningState string                `json:"provisioningState,omitempty"`
+	OutputResources   []graphOutputResource `json:"outputResources"`
+}
+
+// graphOutputResource is an output resource of a resource of the application graph.
+type graphOutputResource struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Provider string `json:"provider,omitempty"`
+	Region   string `json:"region,omitempty"`
+}
+
+// graphEdge is a connection from the source resource to the target resource.
+type graphEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// buildGraphDocument builds the exported application graph from the resources of the application graph.
+func buildGraphDocument(applicationResources []*v20231001preview.ApplicationGraphResource, applicationName string) graphDocument {
+	sortResources(applicationResources)
+
+	document := graphDocument{
+		Application: applicationName,
+		Resources:   []graphNode{},
+		Connections: []graphEdge{},
+	}
+
+	known := map[string]bool{}
+	for _, resource := range applicationResources {
+		known[strings.ToLower(*resource.ID)] = true
+
+		node := graphNode{
+			ID:              *resource.ID,
+			Name:            *resource.Name,
+			Type:            *resource.Type,
+			Kind:            resourceKind(*resource.Type),
+			OutputResources: []graphOutputResource{},
+		}
+		if resource.ProvisioningState != nil {
+			node.ProvisioningState = *resource.ProvisioningState
+		}
+
+		for _, outputResource := range resource.OutputResources {
+			exported := graphOutputResource{
+				ID:       *outputResource.ID,
+				Name:     *outputResource.Name,
+				Type:     *outputResource.Type,
+				Provider: outputResourceProvider(outputResource),
+			}
+			if outputResource.Region != nil {
+				exported.Region = *outputResource.Region
+			}
+			node.OutputResources = append(node.OutputResources, exported)
+		}
+
+		document.Resources = append(document.Resources, node)
+	}
+
+	seen := map[string]bool{}
+	for _, resource := range applicationResources {
+		for _, connection := range resource.Connections {
+			edge := graphEdge{Source: *resource.ID, Target: *connection.ID}
+			if connection.Direction != nil && *connection.Direction == v20231001preview.DirectionInbound {
+				edge = graphEdge{Source: *connection.ID, Target: *resource.ID}
+			}
+
+			key := strings.ToLower(edge.Source + "|" + edge.Target)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			document.Connections = append(document.Connections, edge)
+
+			// Connections can target resources outside of the application, such as shared resources of the environment.
+			if !known[strings.ToLower(*connection.ID)] {
+				known[strings.ToLower(*connection.ID)] = true
+				document.Resources = append(document.Resources, externalNode(*connection.ID))
+			}
+		}
+	}
+
+	return document
+}
+
+// externalNode creates the node of a resource outside of the application graph from its ID.
+func externalNode(id string) graphNode {
+	node := graphNode{ID: id, Name: id, OutputResources: []graphOutputResource{}}
+	if parsed, err := resources.ParseResource(id); err == nil {
+		node.Name = parsed.Name()
+		node.Type = parsed.Type()
+		node.Kind = resourceKind(parsed.Type())
+	}
+
+	return node
+}
+
+// nodeIDs returns the identifiers of the nodes in the dot and mermaid formats, keyed by the lowercase resource ID. The
+// resource IDs contain characters which are not valid in identifiers, so the nodes are numbered instead.
+func nodeIDs(document graphDocument) map[string]string {
+	ids := map[string]string{}
+	for i, node := range document.Resources {
+		ids[strings.ToLower(node.ID)] = fmt.Sprintf("n%d", i)
+	}
+
+	return ids
+}
+
+// displayDot builds the output for the application graph in the Graphviz DOT format.
+func displayDot(document graphDocument) string {
+	ids := nodeIDs(document)
+
+	output := &strings.Builder{}
+	output.WriteString(fmt.Sprintf("digraph %s {\n", dotString(document.Application)))
+	output.WriteString("  rankdir=LR;\n")
+
+	for _, node := range document.Resources {
+		label := node.Name
+		if node.Type != "" {
+			label = fmt.Sprintf("%s\n%s", node.Name, node.Type)
+		}
+
+		output.WriteString(fmt.Sprintf("  %s [label=%s, shape=%s, tooltip=%s];\n", ids[strings.ToLower(node.ID)], dotString(label), dotShape(node.Kind), dotString(node.ID)))
+	}
+
+	for _, edge := range document.Connections {
+		output.WriteString(fmt.Sprintf("  %s -> %s;\n", ids[strings.ToLower(edge.Source)], ids[strings.ToLower(edge.Target)]))
+	}
+
+	output.WriteString("}\n")
+	return output.String()
+}
+
+// displayMermaid builds the output for the application graph as a Mermaid flowchart.
+func displayMermaid(document graphDocument) string {
+	ids := nodeIDs(document)
+
+	output := &strings.Builder{}
+	output.WriteString("flowchart LR\n")
+
+	for _, node := range document.Resources {
+		label := mermaidString(node.Name)
+		if node.Type != "" {
+			label = mermaidString(node.Name) + "<br/>" + mermaidString(node.Type)
+		}
+
+		start, end := mermaidShape(node.Kind)
+		output.WriteString(fmt.Sprintf("  %s%s\"%s\"%s\n", ids[strings.ToLower(node.ID)], start, label, end))
+	}
+
+	for _, edge := range document.Connections {
+		output.WriteString(fmt.Sprintf("  %s --> %s\n", ids[strings.ToLower(edge.Source)], ids[strings.ToLower(edge.Target)]))
+	}
+
+	return output.String()
+}
+
+// dotString quotes a string in the DOT format.
+func dotString(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// mermaidString escapes the characters of a string which cannot be used in a Mermaid label.
+func mermaidString(value string) string {
+	replacer := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+	return replacer.Replace(value)
+}
+
+// dotShape returns the shape of a node in the DOT format.
+func dotShape(kind string) string {
+	switch kind {
+	case kindContainer:
+		return "box"
+	case kindGateway:
+		return "hexagon"
+	case kindDatastore:
+		return "cylinder"
+	case kindMessaging:
+		return "cds"
+	default:
+		return "ellipse"
+	}
+}
+
+// mermaidShape returns the delimiters of the shape of a node in a Mermaid flowchart.
+func mermaidShape(kind string) (string, string) {
+	switch kind {
+	case kindContainer:
+		return "[", "]"
+	case kindGateway:
+		return "{{", "}}"
+	case kindDatastore:
+		return "[(", ")]"
+	case kindMessaging:
+		return ">", "]"
+	default:
+		return "(", ")"
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+const sharedRedisResourceID = "/planes/radius/local/resourceGroups/shared/providers/Applications.Datastores/redisCaches/shared-redis"
+
+func testGraph() []*corerpv20231001preview.ApplicationGraphResource {
+	return []*corerpv20231001preview.ApplicationGraphResource{
+		{
+			ID:                to.Ptr(redisResourceID),
+			Name:              to.Ptr(redisResourceName),
+			Type:              to.Ptr(redisResourceType),
+			ProvisioningState: to.Ptr(provisioningStateSuccess),
+			OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
+				{
+					ID:   to.Ptr(awsMemoryDBResourceID),
+					Type: to.Ptr("AWS.MemoryDB/Cluster"),
+					Name: to.Ptr("redis-aqbjixghynqgg"),
+				},
+			},
+			Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+				{
+					ID:        to.Ptr(containerResourceID),
+					Direction: &directionInbound,
+				},
+			},
+		},
+		{
+			ID:                to.Ptr(containerResourceID),
+			Name:              to.Ptr(containerResourceName),
+			Type:              to.Ptr(containerResourceType),
+			ProvisioningState: to.Ptr(provisioningStateSuccess),
+			Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+				{
+					ID:        to.Ptr(redisResourceID),
+					Direction: &directionOutbound,
+				},
+				{
+					ID:        to.Ptr(sharedRedisResourceID),
+					Direction: &directionOutbound,
+				},
+			},
+		},
+	}
+}
+
+func Test_buildGraphDocument(t *testing.T) {
+	document := buildGraphDocument(testGraph(), "test-app")
+
+	expected := graphDocument{
+		Application: "test-app",
+		Resources: []graphNode{
+			{
+				ID:                containerResourceID,
+				Name:              containerResourceName,
+				Type:              containerResourceType,
+				Kind:              kindContainer,
+				ProvisioningState: provisioningStateSuccess,
+				OutputResources:   []graphOutputResource{},
+			},
+			{
+				ID:                redisResourceID,
+				Name:              redisResourceName,
+				Type:              redisResourceType,
+				Kind:              kindDatastore,
+				ProvisioningState: provisioningStateSuccess,
+				OutputResources: []graphOutputResource{
+					{
+						ID:       awsMemoryDBResourceID,
+						Name:     "redis-aqbjixghynqgg",
+						Type:     "AWS.MemoryDB/Cluster",
+						Provider: "aws",
+					},
+				},
+			},
+			{
+				ID:              sharedRedisResourceID,
+				Name:            "shared-redis",
+				Type:            redisResourceType,
+				Kind:            kindDatastore,
+				OutputResources: []graphOutputResource{},
+			},
+		},
+		Connections: []graphEdge{
+			{Source: containerResourceID, Target: redisResourceID},
+			{Source: containerResourceID, Target: sharedRedisResourceID},
+		},
+	}
+	require.Equal(t, expected, document)
+}
+
+func Test_displayDot(t *testing.T) {
+	actual := displayDot(buildGraphDocument(testGraph(), "test-app"))
+
+	expected := `digraph "test-app" {
+  rankdir=LR;
+  n0 [label="webapp\nApplications.Core/containers", shape=box, tooltip="` + containerResourceID + `"];
+  n1 [label="redis\nApplications.Datastores/redisCaches", shape=cylinder, tooltip="` + redisResourceID + `"];
+  n2 [label="shared-redis\nApplications.Datastores/redisCaches", shape=cylinder, tooltip="` + sharedRedisResourceID + `"];
+  n0 -> n1;
+  n0 -> n2;
+}
+`
+	require.Equal(t, expected, actual)
+}
+
+func Test_displayMermaid(t *testing.T) {
+	actual := displayMermaid(buildGraphDocument(testGraph(), "test-app"))
+
+	expected := `flowchart LR
+  n0["webapp<br/>Applications.Core/containers"]
+  n1[("redis<br/>Applications.Datastores/redisCaches")]
+  n2[("shared-redis<br/>Applications.Datastores/redisCaches")]
+  n0 --> n1
+  n0 --> n2
+`
+	require.Equal(t, expected, actual)
+}
+
+func Test_displayEmpty(t *testing.T) {
+	document := buildGraphDocument([]*corerpv20231001preview.ApplicationGraphResource{}, "test-app")
+	require.Equal(t, "digraph \"test-app\" {\n  rankdir=LR;\n}\n", displayDot(document))
+	require.Equal(t, "flowchart LR\n", displayMermaid(document))
+}
+
+func Test_escaping(t *testing.T) {
+	require.Equal(t, `"a \"quoted\" \\ name\nline"`, dotString("a \"quoted\" \\ name\nline"))
+	require.Equal(t, "a #quot;quoted#quot; #lt;name#gt;", mermaidString(`a "quoted" <name>`))
+}
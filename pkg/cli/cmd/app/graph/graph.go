@@ -7,6 +7,9 @@ package graph
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -14,6 +17,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/spf13/cobra"
@@ -32,33 +36,49 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 rad app graph
 
 # Show graph for specified application
-rad app graph my-application`,
+rad app graph my-application
+
+# Show graph and check that the connections are reachable from within the cluster
+rad app graph my-application --health
+
+# Export graph as a Mermaid flowchart, for example to embed it in a Markdown document
+rad app graph my-application --format mermaid
+
+# Export graph in the Graphviz DOT format and render it as an image
+rad app graph my-application --format dot | dot -Tpng -o graph.png`,
 		RunE: framework.RunCommand(runner),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().Bool("health", false, "Check that the connections are reachable from within the cluster")
+	cmd.Flags().String("format", formatText, fmt.Sprintf("The format of the graph. Supported formats: %s", strings.Join(supportedFormats, ", ")))
 
 	return cmd, runner
 }
 
 // Runner is the runner implementation for the `rad app graph` command.
 type Runner struct {
-	ConfigHolder      *framework.ConfigHolder
-	ConnectionFactory connections.Factory
-	Output            output.Interface
+	ConfigHolder        *framework.ConfigHolder
+	ConnectionFactory   connections.Factory
+	KubernetesInterface kubernetes.Interface
+	Output              output.Interface
 
 	ApplicationName string
+	Format          string
+	Health          bool
+	KubeContext     string
 	Workspace       *workspaces.Workspace
 }
 
 // NewRunner creates a new instance of the `rad app graph` runner.
 func NewRunner(factory framework.Factory) *Runner {
 	return &Runner{
-		ConfigHolder:      factory.GetConfigHolder(),
-		Output:            factory.GetOutput(),
-		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:        factory.GetConfigHolder(),
+		Output:              factory.GetOutput(),
+		ConnectionFactory:   factory.GetConnectionFactory(),
+		KubernetesInterface: factory.GetKubernetesInterface(),
 	}
 }
 
@@ -80,6 +100,33 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.Health, err = cmd.Flags().GetBool("health")
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cmd.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(supportedFormats, r.Format) {
+		return clierrors.Message("The format %q is not supported. Supported formats: %s.", r.Format, strings.Join(supportedFormats, ", "))
+	}
+
+	if r.Health && r.Format != formatText {
+		return clierrors.Message("The --health flag can only be used with the %q format.", formatText)
+	}
+
+	if r.Health {
+		// Connections are probed from within the cluster, so a Kubernetes workspace is required.
+		kubeContext, ok := r.Workspace.KubernetesContext()
+		if !ok {
+			return clierrors.Message("The --health flag requires a Kubernetes workspace.")
+		}
+		r.KubeContext = kubeContext
+	}
+
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
 	if err != nil {
 		return err
@@ -108,8 +155,26 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 	graph := applicationGraphResponse.Resources
+
+	switch r.Format {
+	case formatDot:
+		r.Output.LogInfo("%s", displayDot(buildGraphDocument(graph, r.ApplicationName)))
+		return nil
+	case formatMermaid:
+		r.Output.LogInfo("%s", displayMermaid(buildGraphDocument(graph, r.ApplicationName)))
+		return nil
+	case formatJSON:
+		return r.Output.WriteFormatted(output.FormatJson, buildGraphDocument(graph, r.ApplicationName), output.FormatterOptions{})
+	}
+
 	display := display(graph, r.ApplicationName)
 	r.Output.LogInfo(display)
 
+	if r.Health {
+		r.Output.LogInfo("Checking connections from within the cluster...")
+		results := r.checkConnections(ctx, client, graph)
+		r.Output.LogInfo(displayHealth(results))
+	}
+
 	return nil
 }
@@ -11,8 +11,10 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -80,6 +82,65 @@ func Test_Validate(t *testing.T) {
 				require.Equal(t, "test-app", runner.ApplicationName)
 			},
 		},
+		{
+			Name:          "Graph command with health",
+			Input:         []string{"test-app", "--health"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetApplication(gomock.Any(), "test-app").
+					Return(application, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				// These values are used by Run()
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.True(t, runner.Health)
+				require.Equal(t, "test-context", runner.KubeContext)
+			},
+		},
+		{
+			Name:          "Graph command with format",
+			Input:         []string{"test-app", "--format", "mermaid"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetApplication(gomock.Any(), "test-app").
+					Return(application, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "mermaid", runner.Format)
+			},
+		},
+		{
+			Name:          "Graph command with unsupported format",
+			Input:         []string{"test-app", "--format", "svg"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Graph command with health and format",
+			Input:         []string{"test-app", "--health", "--format", "dot"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Graph command missing application",
 			Input:         []string{"-a", "test-app"},
@@ -123,9 +184,10 @@ func Test_Run(t *testing.T) {
 				ProvisioningState: to.Ptr(provisioningStateSuccess),
 				OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
 					{
-						ID:   to.Ptr("/planes/radius/local/resourcegroups/test-group/providers/kubernetes/Deployments/demo"),
-						Type: to.Ptr("kubernetes: apps/Deployment"),
-						Name: to.Ptr("demo"),
+						ID:       to.Ptr("/planes/radius/local/resourcegroups/test-group/providers/kubernetes/Deployments/demo"),
+						Type:     to.Ptr("kubernetes: apps/Deployment"),
+						Name:     to.Ptr("demo"),
+						Provider: to.Ptr("kubernetes"),
 					},
 				},
 				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
@@ -142,9 +204,10 @@ func Test_Run(t *testing.T) {
 				ProvisioningState: to.Ptr(provisioningStateSuccess),
 				OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
 					{
-						ID:   to.Ptr("/planes/radius/local/resourcegroups/test-group/providers/AWS.MemoryDB/Cluster/redis-aqbjixghynqgg"),
-						Type: to.Ptr("aws: AWS.MemoryDB/Cluster"),
-						Name: to.Ptr("redis-aqbjixghynqgg"),
+						ID:       to.Ptr("/planes/radius/local/resourcegroups/test-group/providers/AWS.MemoryDB/Cluster/redis-aqbjixghynqgg"),
+						Type:     to.Ptr("aws: AWS.MemoryDB/Cluster"),
+						Name:     to.Ptr("redis-aqbjixghynqgg"),
+						Provider: to.Ptr("aws"),
 					},
 				},
 				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
@@ -190,13 +253,13 @@ Name: webapp (Applications.Core/containers)
 Connections:
   webapp -> redis (Applications.Datastores/redisCaches)
 Resources:
-  demo (kubernetes: apps/Deployment)
+  demo (kubernetes: apps/Deployment) [kubernetes]
 
 Name: redis (Applications.Datastores/redisCaches)
 Connections:
   webapp (Applications.Core/containers) -> redis
 Resources:
-  redis-aqbjixghynqgg (aws: AWS.MemoryDB/Cluster)
+  redis-aqbjixghynqgg (aws: AWS.MemoryDB/Cluster) [aws]
 
 `
 
@@ -208,3 +271,234 @@ Resources:
 
 	require.Equal(t, expected, outputSink.Writes)
 }
+
+func Test_Run_Format(t *testing.T) {
+	graph := corerpv20231001preview.ApplicationGraphResponse{
+		Resources: []*corerpv20231001preview.ApplicationGraphResource{
+			{
+				ID:                to.Ptr(containerResourceID),
+				Name:              to.Ptr(containerResourceName),
+				Type:              to.Ptr(containerResourceType),
+				ProvisioningState: to.Ptr(provisioningStateSuccess),
+				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+					{
+						ID:        to.Ptr(redisResourceID),
+						Direction: &directionOutbound,
+					},
+				},
+			},
+			{
+				ID:                to.Ptr(redisResourceID),
+				Name:              to.Ptr(redisResourceName),
+				Type:              to.Ptr(redisResourceType),
+				ProvisioningState: to.Ptr(provisioningStateSuccess),
+				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+					{
+						ID:        to.Ptr(containerResourceID),
+						Direction: &directionInbound,
+					},
+				},
+			},
+		},
+	}
+
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	run := func(t *testing.T, format string) []any {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplicationGraph(gomock.Any(), "test-app").
+			Return(graph, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+
+			// Populated by Validate()
+			ApplicationName: "test-app",
+			Format:          format,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		return outputSink.Writes
+	}
+
+	t.Run("mermaid", func(t *testing.T) {
+		expected := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{"flowchart LR\n" +
+					"  n0[\"webapp<br/>Applications.Core/containers\"]\n" +
+					"  n1[(\"redis<br/>Applications.Datastores/redisCaches\")]\n" +
+					"  n0 --> n1\n"},
+			},
+		}
+		require.Equal(t, expected, run(t, formatMermaid))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		writes := run(t, formatJSON)
+		require.Len(t, writes, 1)
+
+		formatted, ok := writes[0].(output.FormattedOutput)
+		require.True(t, ok)
+		require.Equal(t, output.FormatJson, formatted.Format)
+
+		document, ok := formatted.Obj.(graphDocument)
+		require.True(t, ok)
+		require.Len(t, document.Resources, 2)
+		require.Equal(t, []graphEdge{{Source: containerResourceID, Target: redisResourceID}}, document.Connections)
+	})
+}
+
+func Test_Run_Health(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backendResourceID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/backend"
+	graph := corerpv20231001preview.ApplicationGraphResponse{
+		Resources: []*corerpv20231001preview.ApplicationGraphResource{
+			{
+				ID:                to.Ptr(containerResourceID),
+				Name:              to.Ptr(containerResourceName),
+				Type:              to.Ptr(containerResourceType),
+				ProvisioningState: to.Ptr(provisioningStateSuccess),
+				OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
+					{
+						ID:       to.Ptr("/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/webapp"),
+						Type:     to.Ptr("apps/Deployment"),
+						Name:     to.Ptr("webapp"),
+						Provider: to.Ptr("kubernetes"),
+					},
+				},
+				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+					{
+						ID:        to.Ptr(backendResourceID),
+						Direction: &directionOutbound,
+					},
+					{
+						ID:        to.Ptr(redisResourceID),
+						Direction: &directionOutbound,
+					},
+				},
+			},
+			{
+				ID:                to.Ptr(backendResourceID),
+				Name:              to.Ptr("backend"),
+				Type:              to.Ptr(containerResourceType),
+				ProvisioningState: to.Ptr(provisioningStateSuccess),
+				OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
+					{
+						ID:       to.Ptr("/planes/kubernetes/local/namespaces/test-ns/providers/core/Service/backend"),
+						Type:     to.Ptr("core/Service"),
+						Name:     to.Ptr("backend"),
+						Provider: to.Ptr("kubernetes"),
+					},
+				},
+				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+					{
+						ID:        to.Ptr(containerResourceID),
+						Direction: &directionInbound,
+					},
+				},
+			},
+			{
+				ID:                to.Ptr(redisResourceID),
+				Name:              to.Ptr(redisResourceName),
+				Type:              to.Ptr(redisResourceType),
+				ProvisioningState: to.Ptr(provisioningStateSuccess),
+				Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+					{
+						ID:        to.Ptr(containerResourceID),
+						Direction: &directionInbound,
+					},
+				},
+			},
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		GetApplicationGraph(gomock.Any(), "test-app").
+		Return(graph, nil).
+		Times(1)
+	appManagementClient.EXPECT().
+		GetResource(gomock.Any(), containerResourceType, backendResourceID).
+		Return(generated.GenericResource{
+			Properties: map[string]any{
+				"container": map[string]any{
+					"ports": map[string]any{
+						"web": map[string]any{"containerPort": float64(3000)},
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+	appManagementClient.EXPECT().
+		GetResource(gomock.Any(), redisResourceType, redisResourceID).
+		Return(generated.GenericResource{
+			Properties: map[string]any{
+				"host": "redis.test-ns.svc.cluster.local",
+				"port": float64(6379),
+			},
+		}, nil).
+		Times(1)
+
+	kubernetesClient := kubernetes.NewMockInterface(ctrl)
+	kubernetesClient.EXPECT().
+		ProbeConnection(gomock.Any(), "kind-kind", kubernetes.ConnectionProbeOptions{Namespace: "test-ns", Host: "backend.test-ns", Port: 3000}).
+		Return(&kubernetes.ConnectionProbeResult{Reachable: true, Target: "backend.test-ns:3000"}, nil).
+		Times(1)
+	kubernetesClient.EXPECT().
+		ProbeConnection(gomock.Any(), "kind-kind", kubernetes.ConnectionProbeOptions{Namespace: "test-ns", Host: "redis.test-ns.svc.cluster.local", Port: 6379}).
+		Return(&kubernetes.ConnectionProbeResult{Reachable: false, Target: "redis.test-ns.svc.cluster.local:6379"}, nil).
+		Times(1)
+
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory:   &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		KubernetesInterface: kubernetesClient,
+		Workspace:           workspace,
+		Output:              outputSink,
+
+		// Populated by Validate()
+		ApplicationName: "test-app",
+		Health:          true,
+		KubeContext:     "kind-kind",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expectedHealth := `Connection health:
+  webapp -> backend (Applications.Core/containers): reachable (backend.test-ns:3000)
+  webapp -> redis (Applications.Datastores/redisCaches): unreachable (redis.test-ns.svc.cluster.local:6379)
+
+`
+
+	require.Len(t, outputSink.Writes, 3)
+	require.Equal(t, output.LogOutput{Format: "Checking connections from within the cluster..."}, outputSink.Writes[1])
+	require.Equal(t, output.LogOutput{Format: expectedHealth}, outputSink.Writes[2])
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	healthReachable   = "reachable"
+	healthUnreachable = "unreachable"
+	healthUnknown     = "unknown"
+
+	// kubernetesServiceType is the type of the Kubernetes service output resource of a container.
+	kubernetesServiceType = "core/Service"
+)
+
+// connectionHealth is the result of the reachability check of an outbound connection.
+type connectionHealth struct {
+	// Source is the name of the resource which owns the connection.
+	Source string
+
+	// Target is the name of the resource the connection points to.
+	Target string
+
+	// TargetType is the type of the resource the connection points to.
+	TargetType string
+
+	// Status is one of reachable, unreachable or unknown.
+	Status string
+
+	// Details is the probed endpoint, or the reason why the status is unknown.
+	Details string
+}
+
+// checkConnections probes the outbound connections of the application from within the cluster. Each connection is probed
+// from the Kubernetes namespace of the resource which owns the connection.
+func (r *Runner) checkConnections(ctx context.Context, client clients.ApplicationsManagementClient, applicationResources []*v20231001preview.ApplicationGraphResource) []connectionHealth {
+	byID := map[string]*v20231001preview.ApplicationGraphResource{}
+	for _, resource := range applicationResources {
+		byID[strings.ToLower(*resource.ID)] = resource
+	}
+
+	results := []connectionHealth{}
+	for _, resource := range applicationResources {
+		for _, connection := range resource.Connections {
+			if *connection.Direction != v20231001preview.DirectionOutbound {
+				continue
+			}
+
+			connectionID, err := resources.Parse(*connection.ID)
+			if err != nil {
+				continue
+			}
+
+			result := connectionHealth{
+				Source:     *resource.Name,
+				Target:     connectionID.Name(),
+				TargetType: connectionID.Type(),
+				Status:     healthUnknown,
+			}
+
+			namespace := kubernetesNamespace(resource)
+			if namespace == "" {
+				result.Details = "source is not running in Kubernetes"
+				results = append(results, result)
+				continue
+			}
+
+			options, err := resolveEndpoint(ctx, client, connectionID, byID[strings.ToLower(*connection.ID)])
+			if err != nil {
+				result.Details = err.Error()
+				results = append(results, result)
+				continue
+			}
+			options.Namespace = namespace
+
+			probe, err := r.KubernetesInterface.ProbeConnection(ctx, r.KubeContext, *options)
+			if err != nil {
+				result.Details = err.Error()
+				results = append(results, result)
+				continue
+			}
+
+			result.Status = healthUnreachable
+			if probe.Reachable {
+				result.Status = healthReachable
+			}
+			result.Details = probe.Target
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// resolveEndpoint finds the endpoint of the target of a connection. Containers are reached through their Kubernetes service,
+// other resources through their host and port, or their URL.
+func resolveEndpoint(ctx context.Context, client clients.ApplicationsManagementClient, id resources.ID, target *v20231001preview.ApplicationGraphResource) (*kubernetes.ConnectionProbeOptions, error) {
+	resource, err := client.GetResource(ctx, id.Type(), id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", id.Name(), err)
+	}
+
+	properties := resource.Properties
+	if strings.EqualFold(id.Type(), "Applications.Core/containers") {
+		host := serviceHost(target)
+		port := containerPort(properties)
+		if host == "" || port == 0 {
+			return nil, fmt.Errorf("container %s does not expose a port", id.Name())
+		}
+
+		return &kubernetes.ConnectionProbeOptions{Host: host, Port: port}, nil
+	}
+
+	host, _ := properties["host"].(string)
+	port := toPort(properties["port"])
+	if host != "" && port > 0 {
+		return &kubernetes.ConnectionProbeOptions{Host: host, Port: port}, nil
+	}
+
+	if url, ok := properties["url"].(string); ok && url != "" {
+		return &kubernetes.ConnectionProbeOptions{URL: url}, nil
+	}
+
+	return nil, fmt.Errorf("no endpoint found for %s", id.Name())
+}
+
+// kubernetesNamespace returns the Kubernetes namespace of the output resources of an application resource.
+func kubernetesNamespace(resource *v20231001preview.ApplicationGraphResource) string {
+	for _, outputResource := range resource.OutputResources {
+		id, err := resources.ParseResource(*outputResource.ID)
+		if err != nil {
+			continue
+		}
+
+		if namespace := id.FindScope("namespaces"); namespace != "" {
+			return namespace
+		}
+	}
+
+	return ""
+}
+
+// serviceHost returns the in-cluster host name of the Kubernetes service of a container.
+func serviceHost(resource *v20231001preview.ApplicationGraphResource) string {
+	if resource == nil {
+		return ""
+	}
+
+	for _, outputResource := range resource.OutputResources {
+		id, err := resources.ParseResource(*outputResource.ID)
+		if err != nil || !strings.EqualFold(id.Type(), kubernetesServiceType) {
+			continue
+		}
+
+		return fmt.Sprintf("%s.%s", id.Name(), id.FindScope("namespaces"))
+	}
+
+	return ""
+}
+
+// containerPort returns the service port of a container. The first port by name is used when the container exposes
+// several ports.
+func containerPort(properties map[string]any) int {
+	container, _ := properties["container"].(map[string]any)
+	ports, _ := container["ports"].(map[string]any)
+
+	names := []string{}
+	for name := range ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		port, _ := ports[name].(map[string]any)
+
+		// The service port defaults to the container port.
+		if value := toPort(port["port"]); value > 0 {
+			return value
+		}
+		if value := toPort(port["containerPort"]); value > 0 {
+			return value
+		}
+	}
+
+	return 0
+}
+
+func toPort(value any) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case int32:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,565 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/spf13/cobra"
+)
+
+const (
+	toEnvironmentFlag = "to-environment"
+	toGroupFlag       = "to-group"
+	deploymentFlag    = "deployment"
+	recipeFlag        = "recipe"
+
+	// defaultRecipeName is the name of the recipe used by a resource which does not specify a recipe name.
+	defaultRecipeName = "default"
+)
+
+// NewCommand creates an instance of the `rad app promote` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "promote [application]",
+		Short: "Promote a Radius Application to another environment",
+		Long: `Promote a Radius Application to another environment.
+
+Deploys the exact same template and parameters as a deployment of the application into another environment. When an
+application is deployed with 'rad deploy --application --record', the deployment is recorded in the deployment history
+of the application. The latest recorded deployment is promoted unless a deployment is specified with --deployment.
+
+The application is created in the resource group given by --to-group, or in the resource group of the target
+environment if --to-environment is a resource ID. The promoted deployment is recorded in the deployment history of the
+promoted application, so it can be promoted again, for example from dev to staging and then from staging to prod.
+
+Parameters which refer to the environment or the application are remapped to the target environment and application,
+and the 'context' parameter is set to the deployment context of the target environment and application.
+Use --parameters to override other environment-specific parameters, and --recipe to use a different recipe of the
+target environment for the resources which specify a recipe.`,
+		Example: `
+# promote the latest deployment of an application to the staging environment in the staging resource group
+rad app promote myapp --to-environment staging --to-group staging
+
+# promote an application to an environment specified by its resource ID
+rad app promote myapp --to-environment /planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod
+
+# promote a specific deployment of an application
+rad app promote myapp --to-environment prod --to-group prod --deployment 20231001-120000.000
+
+# promote an application overriding a parameter and using the 'azure' recipe instead of the 'default' recipe
+rad app promote myapp --to-environment prod --to-group prod --parameters replicas=3 --recipe default=azure
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().String(toEnvironmentFlag, "", "The name or resource ID of the environment to promote the application to")
+	cmd.Flags().String(toGroupFlag, "", "The resource group to promote the application to. Defaults to the resource group of the target environment if --to-environment is a resource ID")
+	cmd.Flags().String(deploymentFlag, "", "The name of the recorded deployment to promote. Defaults to the latest deployment")
+	cmd.Flags().StringArray(recipeFlag, []string{}, "Use a different recipe of the target environment, in the form <recipe>=<target recipe>")
+	_ = cmd.MarkFlagRequired(toEnvironmentFlag)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad app promote` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Deploy            deploy.Interface
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName           string
+	TargetEnvironmentNameOrID string
+	TargetScope               string
+	DeploymentName            string
+	Parameters                clients.DeploymentParameters
+	RecipeMappings            map[string]string
+}
+
+// NewRunner creates a new instance of the `rad app promote` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Deploy:            factory.GetDeploy(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app promote` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.TargetEnvironmentNameOrID, err = cmd.Flags().GetString(toEnvironmentFlag)
+	if err != nil {
+		return err
+	}
+
+	targetGroup, err := cmd.Flags().GetString(toGroupFlag)
+	if err != nil {
+		return err
+	}
+
+	sourceScope, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return err
+	}
+
+	if targetGroup == "" {
+		if environmentID, err := resources.ParseResource(r.TargetEnvironmentNameOrID); err == nil {
+			targetGroup = environmentID.FindScope(resources_radius.ScopeResourceGroups)
+		}
+	}
+	if targetGroup == "" {
+		return clierrors.Message("Specify the resource group to promote the application to with --%s, or specify the resource ID of the target environment with --%s.", toGroupFlag, toEnvironmentFlag)
+	}
+
+	r.TargetScope = fmt.Sprintf("/planes/radius/%s/resourceGroups/%s", sourceScope.FindScope(resources_radius.PlaneTypeRadius), targetGroup)
+	if strings.EqualFold(r.TargetScope, r.Workspace.Scope) {
+		return clierrors.Message("The application %q cannot be promoted to its own resource group %q. Specify a different resource group with --%s.", r.ApplicationName, targetGroup, toGroupFlag)
+	}
+
+	r.DeploymentName, err = cmd.Flags().GetString(deploymentFlag)
+	if err != nil {
+		return err
+	}
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: filesystem.NewOSFS()}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	recipeArgs, err := cmd.Flags().GetStringArray(recipeFlag)
+	if err != nil {
+		return err
+	}
+
+	r.RecipeMappings = map[string]string{}
+	for _, arg := range recipeArgs {
+		source, target, ok := strings.Cut(arg, "=")
+		if !ok || source == "" || target == "" {
+			return clierrors.Message("The recipe mapping %q is invalid. Specify the recipe mapping in the form <recipe>=<target recipe>.", arg)
+		}
+		r.RecipeMappings[source] = target
+	}
+
+	return nil
+}
+
+// Run runs the `rad app promote` command.
+func (r *Runner) Run(ctx context.Context) error {
+	sourceClient, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	application, err := sourceClient.GetApplication(ctx, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	sourceApplicationID := to.String(application.ID)
+	sourceEnvironmentID := ""
+	if application.Properties != nil {
+		sourceEnvironmentID = to.String(application.Properties.Environment)
+	}
+
+	deployment, err := r.findDeployment(ctx, sourceClient, sourceApplicationID)
+	if err != nil {
+		return err
+	}
+
+	targetWorkspace := *r.Workspace
+	targetWorkspace.Scope = r.TargetScope
+
+	targetClient, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, targetWorkspace)
+	if err != nil {
+		return err
+	}
+
+	environment, err := targetClient.GetEnvironment(ctx, r.TargetEnvironmentNameOrID)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q does not exist in scope %q. Run `rad env create` first. You could also provide the environment ID if the environment exists in a different group.", r.TargetEnvironmentNameOrID, r.TargetScope)
+	} else if err != nil {
+		return err
+	}
+
+	targetEnvironmentID := to.String(environment.ID)
+	targetApplicationID := r.TargetScope + "/providers/Applications.Core/applications/" + r.ApplicationName
+	targetWorkspace.Environment = targetEnvironmentID
+
+	template := deployment.Properties.Template
+	remapParameters(template, map[string]string{
+		sourceEnvironmentID: targetEnvironmentID,
+		sourceApplicationID: targetApplicationID,
+	})
+	remapRecipes(template, r.RecipeMappings)
+
+	err = validateRecipes(template, environment)
+	if err != nil {
+		return err
+	}
+
+	err = bicep.InjectEnvironmentParam(template, r.Parameters, targetEnvironmentID)
+	if err != nil {
+		return err
+	}
+
+	err = bicep.InjectApplicationParam(template, r.Parameters, targetApplicationID)
+	if err != nil {
+		return err
+	}
+
+	err = deploy.InjectContextParameter(ctx, deploy.ContextOptions{
+		ConnectionFactory:    r.ConnectionFactory,
+		Workspace:            targetWorkspace,
+		EnvironmentID:        targetEnvironmentID,
+		EnvironmentNamespace: deploy.EnvironmentNamespace(environment),
+		ApplicationID:        targetApplicationID,
+	}, template, r.Parameters)
+	if err != nil {
+		return err
+	}
+
+	err = r.reportMissingParameters(template)
+	if err != nil {
+		return err
+	}
+
+	err = targetClient.CreateApplicationIfNotFound(ctx, r.ApplicationName, &corerp.ApplicationResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &corerp.ApplicationProperties{
+			Environment: &targetEnvironmentID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	progressText := fmt.Sprintf(
+		"Promoting deployment '%v' of application '%v' to environment '%v' from workspace '%v'...\n\n"+
+			"Deployment In Progress...", to.String(deployment.Name), r.ApplicationName, targetEnvironmentID, r.Workspace.Name)
+
+	_, err = r.Deploy.DeployWithProgress(ctx, deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         targetWorkspace,
+		Template:          template,
+		Parameters:        r.Parameters,
+		ProgressText:      progressText,
+		CompletionText:    "Promotion Complete",
+		Providers:         providers(environment, targetApplicationID),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Record the promoted deployment so that it can be promoted to the next environment. The promotion has already
+	// succeeded, so a failure to record it is reported as a warning.
+	_, err = deploy.RecordDeployment(ctx, targetClient, targetApplicationID, targetEnvironmentID, template, r.Parameters)
+	if err != nil {
+		r.Output.LogInfo("Warning: the deployment could not be recorded in the deployment history of application %q: %v", r.ApplicationName, err)
+	}
+
+	return nil
+}
+
+// findDeployment returns the recorded deployment to promote.
+func (r *Runner) findDeployment(ctx context.Context, client clients.ApplicationsManagementClient, applicationID string) (ucp.TemplateSpecVersionResource, error) {
+	deployments, err := deploy.ListDeployments(ctx, client, applicationID)
+	if err != nil {
+		return ucp.TemplateSpecVersionResource{}, err
+	}
+
+	if len(deployments) == 0 {
+		return ucp.TemplateSpecVersionResource{}, clierrors.Message("The application %q has no recorded deployments. Deploy the application with `rad deploy --application %s --record` first.", r.ApplicationName, r.ApplicationName)
+	}
+
+	if r.DeploymentName == "" {
+		return validateDeployment(deployments[0])
+	}
+
+	for _, deployment := range deployments {
+		if strings.EqualFold(to.String(deployment.Name), r.DeploymentName) {
+			return validateDeployment(deployment)
+		}
+	}
+
+	names := []string{}
+	for _, deployment := range deployments {
+		names = append(names, to.String(deployment.Name))
+	}
+	return ucp.TemplateSpecVersionResource{}, clierrors.Message("The deployment %q was not found in the deployment history of application %q. Recorded deployments: %s.", r.DeploymentName, r.ApplicationName, strings.Join(names, ", "))
+}
+
+func validateDeployment(deployment ucp.TemplateSpecVersionResource) (ucp.TemplateSpecVersionResource, error) {
+	if deployment.Properties == nil || deployment.Properties.Template == nil {
+		return ucp.TemplateSpecVersionResource{}, clierrors.Message("The recorded deployment %q does not contain a template.", to.String(deployment.Name))
+	}
+
+	return deployment, nil
+}
+
+// reportMissingParameters reports the parameters of the template which have neither a recorded value nor a value
+// specified with --parameters, such as secure parameters.
+func (r *Runner) reportMissingParameters(template map[string]any) error {
+	declaredParameters, err := bicep.ExtractParameters(template)
+	if err != nil {
+		return err
+	}
+
+	missing := []string{}
+	for parameter, declared := range declaredParameters {
+		if _, ok := bicep.DefaultValue(declared); ok {
+			continue
+		}
+
+		match := false
+		for provided := range r.Parameters {
+			if strings.EqualFold(parameter, provided) {
+				match = true
+				break
+			}
+		}
+
+		if !match {
+			missing = append(missing, parameter)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	details := []string{}
+	for _, parameter := range missing {
+		details = append(details, fmt.Sprintf("  - The deployment requires a parameter %q. Use --parameters %s=<value> to specify the value.", parameter, parameter))
+	}
+
+	return clierrors.Message("The application %q could not be promoted because of the following errors:\n\n%v", r.ApplicationName, strings.Join(details, "\n"))
+}
+
+// remapParameters replaces the recorded parameter values which match a key of the mappings (ignoring case) with the
+// value of the mapping. This remaps the parameters which refer to the source environment and application.
+func remapParameters(template map[string]any, mappings map[string]string) {
+	declaredParameters, err := bicep.ExtractParameters(template)
+	if err != nil {
+		return
+	}
+
+	for _, declared := range declaredParameters {
+		parameter, ok := declared.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		value, ok := parameter["defaultValue"].(string)
+		if !ok {
+			continue
+		}
+
+		for source, target := range mappings {
+			if source != "" && strings.EqualFold(value, source) {
+				parameter["defaultValue"] = target
+				break
+			}
+		}
+	}
+}
+
+// remapRecipes replaces the names of the recipes used by the resources of the template.
+func remapRecipes(template map[string]any, mappings map[string]string) {
+	if len(mappings) == 0 {
+		return
+	}
+
+	for _, resource := range templateResources(template) {
+		recipe := resourceRecipe(resource)
+		if recipe == nil {
+			continue
+		}
+
+		name, _ := recipe["name"].(string)
+		if name == "" {
+			name = defaultRecipeName
+		}
+
+		if mapped, ok := mappings[name]; ok {
+			recipe["name"] = mapped
+		}
+	}
+}
+
+// validateRecipes validates that the target environment has the recipes used by the resources of the template. Recipe
+// names given as template expressions cannot be validated before the deployment.
+func validateRecipes(template map[string]any, environment corerp.EnvironmentResource) error {
+	registered := map[string]map[string]corerp.RecipePropertiesClassification{}
+	if environment.Properties != nil && environment.Properties.Recipes != nil {
+		for resourceType, recipes := range environment.Properties.Recipes {
+			registered[strings.ToLower(resourceType)] = recipes
+		}
+	}
+
+	missing := []string{}
+	for _, resource := range templateResources(template) {
+		recipe := resourceRecipe(resource)
+		if recipe == nil {
+			continue
+		}
+
+		name, _ := recipe["name"].(string)
+		if name == "" {
+			name = defaultRecipeName
+		}
+		if strings.HasPrefix(name, "[") {
+			continue
+		}
+
+		resourceType, _ := resource["type"].(string)
+		resourceType, _, _ = strings.Cut(resourceType, "@")
+		if _, ok := registered[strings.ToLower(resourceType)][name]; !ok {
+			missing = append(missing, fmt.Sprintf("  - The environment does not have the recipe %q for resource type %q. Use --%s %s=<target recipe> to use another recipe.", name, resourceType, recipeFlag, name))
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	return clierrors.Message("The application could not be promoted to environment %q because of the following errors:\n\n%v", to.String(environment.Name), strings.Join(missing, "\n"))
+}
+
+// templateResources returns the resources of the template. The resources are a map of symbolic names to resources
+// for templates with symbolic names, and a list of resources otherwise.
+func templateResources(template map[string]any) []map[string]any {
+	result := []map[string]any{}
+	switch resources := template["resources"].(type) {
+	case map[string]any:
+		for _, resource := range resources {
+			if r, ok := resource.(map[string]any); ok {
+				result = append(result, r)
+			}
+		}
+	case []any:
+		for _, resource := range resources {
+			if r, ok := resource.(map[string]any); ok {
+				result = append(result, r)
+			}
+		}
+	}
+
+	return result
+}
+
+// resourceRecipe returns the recipe configuration of a Radius resource of the template, or nil if the resource does not
+// use a recipe. The properties of Radius resources are nested in the body of the resource.
+func resourceRecipe(resource map[string]any) map[string]any {
+	body, ok := resource["properties"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	properties, ok := body["properties"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	if provisioning, ok := properties["resourceProvisioning"].(string); ok && strings.EqualFold(provisioning, "manual") {
+		return nil
+	}
+
+	recipe, _ := properties["recipe"].(map[string]any)
+	return recipe
+}
+
+func providers(environment corerp.EnvironmentResource, applicationID string) *clients.Providers {
+	providers := &clients.Providers{
+		Radius: &clients.RadiusProvider{
+			EnvironmentID: to.String(environment.ID),
+			ApplicationID: applicationID,
+		},
+	}
+
+	if environment.Properties != nil && environment.Properties.Providers != nil {
+		if environment.Properties.Providers.Aws != nil {
+			providers.AWS = &clients.AWSProvider{
+				Scope: to.String(environment.Properties.Providers.Aws.Scope),
+			}
+		}
+		if environment.Properties.Providers.Azure != nil {
+			providers.Azure = &clients.AzureProvider{
+				Scope: to.String(environment.Properties.Providers.Azure.Scope),
+			}
+		}
+	}
+
+	return providers
+}
@@ -0,0 +1,390 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	sourceScope         = "/planes/radius/local/resourceGroups/test-resource-group"
+	sourceEnvironmentID = sourceScope + "/providers/Applications.Core/environments/dev"
+	sourceApplicationID = sourceScope + "/providers/Applications.Core/applications/test-app"
+	targetScope         = "/planes/radius/local/resourceGroups/prod"
+	targetEnvironmentID = targetScope + "/providers/Applications.Core/environments/prod"
+	targetApplicationID = targetScope + "/providers/Applications.Core/applications/test-app"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Promote Command with target group",
+			Input:         []string{"test-app", "--to-environment", "prod", "--to-group", "prod", "--parameters", "replicas=3", "--recipe", "default=azure"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "test-app", r.ApplicationName)
+				require.Equal(t, "prod", r.TargetEnvironmentNameOrID)
+				require.Equal(t, targetScope, r.TargetScope)
+				require.Equal(t, clients.DeploymentParameters{"replicas": {"value": "3"}}, r.Parameters)
+				require.Equal(t, map[string]string{"default": "azure"}, r.RecipeMappings)
+			},
+		},
+		{
+			Name:          "Promote Command with target environment ID",
+			Input:         []string{"test-app", "--to-environment", targetEnvironmentID, "--deployment", "20231001-120000.000"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, targetScope, r.TargetScope)
+				require.Equal(t, "20231001-120000.000", r.DeploymentName)
+			},
+		},
+		{
+			Name:          "Promote Command without target environment",
+			Input:         []string{"test-app", "--to-group", "prod"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Promote Command without target group",
+			Input:         []string{"test-app", "--to-environment", "prod"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Promote Command to the same group",
+			Input:         []string{"test-app", "--to-environment", "prod", "--to-group", "test-resource-group"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Promote Command with invalid recipe mapping",
+			Input:         []string{"test-app", "--to-environment", "prod", "--to-group", "prod", "--recipe", "default"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Promote Command with too many args",
+			Input:         []string{"test-app", "other-app", "--to-environment", "prod", "--to-group", "prod"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: sourceScope,
+	}
+
+	application := corerp.ApplicationResource{
+		ID:   to.Ptr(sourceApplicationID),
+		Name: to.Ptr("test-app"),
+		Properties: &corerp.ApplicationProperties{
+			Environment: to.Ptr(sourceEnvironmentID),
+		},
+	}
+
+	environment := corerp.EnvironmentResource{
+		ID:   to.Ptr(targetEnvironmentID),
+		Name: to.Ptr("prod"),
+		Properties: &corerp.EnvironmentProperties{
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				"Applications.Datastores/redisCaches": {
+					"azure": &corerp.BicepRecipeProperties{TemplatePath: to.Ptr("recipes/azure-redis:1.0")},
+				},
+			},
+			Providers: &corerp.Providers{
+				Azure: &corerp.ProvidersAzure{Scope: to.Ptr("/subscriptions/test/resourceGroups/prod")},
+			},
+		},
+	}
+
+	recordedTemplate := func() map[string]any {
+		return map[string]any{
+			"parameters": map[string]any{
+				"environment": map[string]any{"type": "string"},
+				"application": map[string]any{"type": "string"},
+				"context":     map[string]any{"type": "object"},
+				"envId":       map[string]any{"type": "string", "defaultValue": sourceEnvironmentID},
+				"replicas":    map[string]any{"type": "int", "defaultValue": float64(1)},
+			},
+			"resources": map[string]any{
+				"redis": map[string]any{
+					"import": "Radius",
+					"type":   "Applications.Datastores/redisCaches@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "redis",
+						"properties": map[string]any{
+							"environment": "[parameters('environment')]",
+							"recipe":      map[string]any{"name": "default"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	deployments := func() []ucp.TemplateSpecVersionResource {
+		return []ucp.TemplateSpecVersionResource{
+			{Name: to.Ptr("20231001-100000.000"), Properties: &ucp.TemplateSpecVersionProperties{Template: map[string]any{}}},
+			{Name: to.Ptr("20231002-100000.000"), Properties: &ucp.TemplateSpecVersionProperties{Template: recordedTemplate()}},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", "test-resource-group", "rad-deployments-test-app").
+			Return(deployments(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "prod").
+			Return(environment, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateApplicationIfNotFound(gomock.Any(), "test-app", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, resource *corerp.ApplicationResource) error {
+				require.Equal(t, targetEnvironmentID, to.String(resource.Properties.Environment))
+				return nil
+			}).
+			Times(1)
+
+		// The promoted deployment is recorded in the deployment history of the promoted application.
+		appManagementClient.EXPECT().
+			CreateOrUpdateTemplateSpec(gomock.Any(), "local", "prod", "rad-deployments-test-app", gomock.Any()).
+			Return(ucp.TemplateSpecResource{}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateTemplateSpecVersion(gomock.Any(), "local", "prod", "rad-deployments-test-app", gomock.Any(), gomock.Any()).
+			Return(ucp.TemplateSpecVersionResource{}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", "prod", "rad-deployments-test-app").
+			Return([]ucp.TemplateSpecVersionResource{}, nil).
+			Times(1)
+
+		var options deploy.Options
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+				options = o
+				return clients.DeploymentResult{}, nil
+			}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Deploy:                    deployMock,
+			Output:                    outputSink,
+			Workspace:                 workspace,
+			ApplicationName:           "test-app",
+			TargetEnvironmentNameOrID: "prod",
+			TargetScope:               targetScope,
+			Parameters:                clients.DeploymentParameters{"replicas": {"value": float64(3)}},
+			RecipeMappings:            map[string]string{"default": "azure"},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, outputSink.Writes)
+
+		// The latest deployment is deployed to the target environment.
+		require.Equal(t, targetScope, options.Workspace.Scope)
+		require.Equal(t, targetEnvironmentID, options.Workspace.Environment)
+		require.Equal(t, &clients.Providers{
+			Radius: &clients.RadiusProvider{EnvironmentID: targetEnvironmentID, ApplicationID: targetApplicationID},
+			Azure:  &clients.AzureProvider{Scope: "/subscriptions/test/resourceGroups/prod"},
+		}, options.Providers)
+		require.Equal(t, clients.DeploymentParameters{
+			"environment": {"value": targetEnvironmentID},
+			"application": {"value": targetApplicationID},
+			"context": {"value": bicep.DeploymentContext{
+				Environment:   bicep.DeploymentContextResource{Name: "prod", ID: targetEnvironmentID},
+				Application:   bicep.DeploymentContextResource{Name: "test-app", ID: targetApplicationID},
+				ResourceGroup: bicep.DeploymentContextResource{Name: "prod", ID: targetScope},
+			}},
+			"replicas": {"value": float64(3)},
+		}, options.Parameters)
+
+		// The environment-scoped parameters and the recipes are remapped.
+		parameters := options.Template["parameters"].(map[string]any)
+		require.Equal(t, targetEnvironmentID, parameters["envId"].(map[string]any)["defaultValue"])
+		redis := options.Template["resources"].(map[string]any)["redis"].(map[string]any)
+		require.Equal(t, map[string]any{"name": "azure"}, redis["properties"].(map[string]any)["properties"].(map[string]any)["recipe"])
+
+		// Workspace of the command is not modified.
+		require.Equal(t, sourceScope, workspace.Scope)
+	})
+
+	t.Run("Application not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(corerp.ApplicationResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			TargetScope:       targetScope,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application %q was not found or has been deleted.", "test-app"), err)
+	})
+
+	t.Run("No recorded deployments", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", "test-resource-group", "rad-deployments-test-app").
+			Return(nil, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			TargetScope:       targetScope,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application %q has no recorded deployments. Deploy the application with `rad deploy --application %s --record` first.", "test-app", "test-app"), err)
+	})
+
+	t.Run("Deployment not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", "test-resource-group", "rad-deployments-test-app").
+			Return(deployments(), nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			TargetScope:       targetScope,
+			DeploymentName:    "20230101-000000.000",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The deployment %q was not found in the deployment history of application %q. Recorded deployments: %s.", "20230101-000000.000", "test-app", "20231002-100000.000, 20231001-100000.000"), err)
+	})
+
+	t.Run("Recipe not registered in target environment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", "test-resource-group", "rad-deployments-test-app").
+			Return(deployments(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "prod").
+			Return(environment, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                    &output.MockOutput{},
+			Workspace:                 workspace,
+			ApplicationName:           "test-app",
+			TargetEnvironmentNameOrID: "prod",
+			TargetScope:               targetScope,
+			Parameters:                clients.DeploymentParameters{},
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application could not be promoted to environment %q because of the following errors:\n\n%v", "prod",
+			"  - The environment does not have the recipe \"default\" for resource type \"Applications.Datastores/redisCaches\". Use --recipe default=<target recipe> to use another recipe."), err)
+	})
+}
+
+func Test_reportMissingParameters(t *testing.T) {
+	template := map[string]any{
+		"parameters": map[string]any{
+			"replicas": map[string]any{"type": "int", "defaultValue": float64(1)},
+			"password": map[string]any{"type": "securestring"},
+		},
+	}
+
+	runner := &Runner{ApplicationName: "test-app", Parameters: clients.DeploymentParameters{}}
+	err := runner.reportMissingParameters(template)
+	require.Equal(t, clierrors.Message("The application %q could not be promoted because of the following errors:\n\n%v", "test-app",
+		"  - The deployment requires a parameter \"password\". Use --parameters password=<value> to specify the value."), err)
+
+	runner.Parameters = clients.DeploymentParameters{"Password": {"value": "secret"}}
+	err = runner.reportMissingParameters(template)
+	require.NoError(t, err)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/objectformats"
@@ -54,7 +55,8 @@ rad app show my-app
 # Show specified application in a specified resource group
 rad app show my-app --group my-group
 `,
-		RunE: framework.RunCommand(runner),
+		RunE:              framework.RunCommand(runner),
+		ValidArgsFunction: completion.ApplicationNames(factory),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
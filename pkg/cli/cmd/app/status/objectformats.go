@@ -50,3 +50,28 @@ func gatewayFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// healthFormat returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of the health of the application resources.
+func healthFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "HEALTH",
+				JSONPath: "{ .State }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
@@ -52,3 +52,19 @@ func Test_GetApplicationGatewaysTableFormat(t *testing.T) {
 	expected := "GATEWAY   ENDPOINT\ntest      test-endpoint\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_GetApplicationHealthTableFormat(t *testing.T) {
+	obj := clients.ResourceHealthStatus{
+		Name:    "redis",
+		Type:    "Applications.Datastores/redisCaches",
+		State:   "Unhealthy",
+		Message: "connection refused",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, healthFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE  TYPE                                 HEALTH     MESSAGE\nredis     Applications.Datastores/redisCaches  Unhealthy  connection refused\n"
+	require.Equal(t, expected, buffer.String())
+}
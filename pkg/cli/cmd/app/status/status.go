@@ -21,12 +21,14 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/spf13/cobra"
 )
@@ -38,7 +40,7 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show Radius Application status",
-		Long:  `Show Radius Application status, such as public endpoints and resource count. Shows details for the user's default application (if configured) by default.`,
+		Long:  `Show Radius Application status, such as public endpoints, resource count and the health reported for resources. Shows details for the user's default application (if configured) by default.`,
 		Args:  cobra.MaximumNArgs(1),
 		Example: `
 # Show status of current application
@@ -167,6 +169,10 @@ func (r *Runner) Run(ctx context.Context) error {
 				Endpoint: *publicEndpoint,
 			})
 		}
+
+		if health := resourceHealth(resource); health != nil {
+			applicationStatus.Health = append(applicationStatus.Health, *health)
+		}
 	}
 
 	err = r.Output.WriteFormatted(r.Format, applicationStatus, statusFormat())
@@ -184,5 +190,38 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if r.Format == output.FormatTable && len(applicationStatus.Health) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.Health, healthFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// resourceHealth returns the health reported in 'properties.status.health' of the resource, or nil if the resource
+// does not report its health.
+func resourceHealth(resource generated.GenericResource) *clients.ResourceHealthStatus {
+	status, ok := resource.Properties["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	health, ok := status["health"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	state, _ := health["state"].(string)
+	message, _ := health["message"].(string)
+	return &clients.ResourceHealthStatus{
+		Name:    to.String(resource.Name),
+		Type:    to.String(resource.Type),
+		State:   state,
+		Message: message,
+	}
+}
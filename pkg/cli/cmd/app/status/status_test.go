@@ -120,6 +120,19 @@ func Test_Run(t *testing.T) {
 				Name: to.Ptr("test-gateway"),
 				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway"),
 			},
+			{
+				Name: to.Ptr("test-redis"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test-redis"),
+				Type: to.Ptr("Applications.Datastores/redisCaches"),
+				Properties: map[string]any{
+					"status": map[string]any{
+						"health": map[string]any{
+							"state":   "Unhealthy",
+							"message": "failed to connect to redis:6379: connection refused",
+						},
+					},
+				},
+			},
 		}
 
 		appManagementClient.EXPECT().
@@ -138,6 +151,11 @@ func Test_Run(t *testing.T) {
 			Return(to.Ptr("http://some-url.example.com"), nil).
 			Times(1)
 
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test-redis")}).
+			Return(nil, nil).
+			Times(1)
+
 		workspace := &workspaces.Workspace{
 			Connection: map[string]any{
 				"kind":    "kubernetes",
@@ -163,13 +181,21 @@ func Test_Run(t *testing.T) {
 
 		applicationStatus := clients.ApplicationStatus{
 			Name:          "test-app",
-			ResourceCount: 2,
+			ResourceCount: 3,
 			Gateways: []clients.GatewayStatus{
 				{
 					Name:     "test-gateway",
 					Endpoint: "http://some-url.example.com",
 				},
 			},
+			Health: []clients.ResourceHealthStatus{
+				{
+					Name:    "test-redis",
+					Type:    "Applications.Datastores/redisCaches",
+					State:   "Unhealthy",
+					Message: "failed to connect to redis:6379: connection refused",
+				},
+			},
 		}
 
 		expected := []any{
@@ -186,6 +212,14 @@ func Test_Run(t *testing.T) {
 				Obj:     applicationStatus.Gateways,
 				Options: gatewayFormat(),
 			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.Health,
+				Options: healthFormat(),
+			},
 		}
 
 		require.Equal(t, expected, outputSink.Writes)
@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package download
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/bicep/tools"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	versionFlag  = "version"
+	checksumFlag = "checksum"
+	pinFlag      = "pin"
+	unpinFlag    = "unpin"
+)
+
+// NewCommand creates an instance of the `rad bicep download` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download the bicep compiler",
+		Long: `Downloads the bicep compiler locally.
+
+By default the latest release of Bicep is downloaded. Use --version to download a specific release and --checksum to
+verify the SHA-256 checksum of the downloaded binary.
+
+Use --pin to record the version and checksum in the rad CLI configuration, so that the same Bicep build is downloaded by
+this installation whenever Bicep is installed, including when Bicep is downloaded automatically by 'rad deploy'. Use
+--unpin to remove the pinned version.`,
+		Example: `
+# Download the latest release of Bicep
+rad bicep download
+
+# Download a specific release of Bicep
+rad bicep download --version v0.30.3
+
+# Download a specific release of Bicep and verify its checksum
+rad bicep download --version v0.30.3 --checksum sha256:<checksum>
+
+# Download a specific release of Bicep and pin it for this installation
+rad bicep download --version v0.30.3 --pin
+
+# Remove the pinned version and download the latest release of Bicep
+rad bicep download --unpin`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	cmd.Flags().String(versionFlag, "", "The version of Bicep to download, for example 'v0.30.3' or 'latest'. Defaults to the pinned version, or the latest release if no version is pinned.")
+	cmd.Flags().String(checksumFlag, "", "The expected SHA-256 checksum of the Bicep binary for the current platform.")
+	cmd.Flags().Bool(pinFlag, false, "Pin the downloaded version and checksum of Bicep in the rad CLI configuration.")
+	cmd.Flags().Bool(unpinFlag, false, "Remove the pinned version of Bicep from the rad CLI configuration.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad bicep download` command.
+type Runner struct {
+	ConfigHolder        *framework.ConfigHolder
+	ConfigFileInterface framework.ConfigFileInterface
+	Output              output.Interface
+
+	// Download downloads the Bicep binary and returns its SHA-256 checksum.
+	Download func(options tools.DownloadOptions) (string, error)
+
+	Version  string
+	Checksum string
+	Pin      bool
+	Unpin    bool
+}
+
+// NewRunner creates a new instance of the `rad bicep download` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:        factory.GetConfigHolder(),
+		ConfigFileInterface: factory.GetConfigFileInterface(),
+		Output:              factory.GetOutput(),
+		Download:            bicep.DownloadBicep,
+	}
+}
+
+// Validate runs validation for the `rad bicep download` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	var err error
+	r.Version, err = cmd.Flags().GetString(versionFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Checksum, err = cmd.Flags().GetString(checksumFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Pin, err = cmd.Flags().GetBool(pinFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Unpin, err = cmd.Flags().GetBool(unpinFlag)
+	if err != nil {
+		return err
+	}
+
+	if r.Pin && r.Unpin {
+		return clierrors.Message("The --pin and --unpin flags cannot be used together.")
+	}
+
+	if r.Checksum != "" {
+		checksum, err := tools.NormalizeChecksum(r.Checksum)
+		if err != nil {
+			return clierrors.Message("The checksum %q is invalid. Specify the SHA-256 checksum of the Bicep binary as 64 hexadecimal characters.", r.Checksum)
+		}
+		r.Checksum = checksum
+	}
+
+	if r.Pin && tools.NormalizeVersion(r.Version) == tools.LatestVersion {
+		return clierrors.Message("A version of Bicep must be specified with --version to pin it, for example '--version v0.30.3 --pin'.")
+	}
+
+	return nil
+}
+
+// Run runs the `rad bicep download` command.
+func (r *Runner) Run(ctx context.Context) error {
+	section, err := cli.ReadBicepSection(r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+
+	options := tools.DownloadOptions{Version: r.Version, Checksum: r.Checksum}
+	if !r.Unpin {
+		// Download the pinned version of Bicep if no version is specified, and verify the pinned checksum when the
+		// pinned version is downloaded.
+		if options.Version == "" {
+			options.Version = section.Version
+		}
+
+		pinned := section.Version != "" && tools.NormalizeVersion(options.Version) == tools.NormalizeVersion(section.Version)
+		if pinned && options.Checksum == "" {
+			options.Checksum = section.Checksum
+		}
+	}
+
+	version := tools.NormalizeVersion(options.Version)
+	r.Output.LogInfo("Downloading Bicep %s...", version)
+	checksum, err := r.Download(options)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Downloaded Bicep %s (sha256:%s).", version, checksum)
+
+	if r.Pin {
+		err = r.ConfigFileInterface.SetBicepSection(ctx, r.ConfigHolder.Config, cli.BicepSection{Version: version, Checksum: checksum})
+		if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("Pinned Bicep %s.", version)
+	} else if r.Unpin && section != (cli.BicepSection{}) {
+		err = r.ConfigFileInterface.SetBicepSection(ctx, r.ConfigHolder.Config, cli.BicepSection{})
+		if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("Removed pinned Bicep %s.", section.Version)
+	}
+
+	return nil
+}
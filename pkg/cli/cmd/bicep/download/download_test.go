@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package download
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep/tools"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testChecksum = "8e3d8a1b6c2a1fd34a2b4c49bb9b0e0f9a0aa9b2e7ee1e5ad5f2fd33a7e8f8c1"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "download valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "download with version and checksum valid",
+			Input:         []string{"--version", "v0.30.3", "--checksum", "sha256:" + testChecksum},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "v0.30.3", r.Version)
+				require.Equal(t, testChecksum, r.Checksum)
+			},
+		},
+		{
+			Name:          "download with pin valid",
+			Input:         []string{"--version", "v0.30.3", "--pin"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "download with invalid checksum",
+			Input:         []string{"--checksum", "abc"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "download with pin requires version",
+			Input:         []string{"--pin"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "download with pin and unpin invalid",
+			Input:         []string{"--version", "v0.30.3", "--pin", "--unpin"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "download too-many-args invalid",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Download latest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+
+		// No calls expected for this case.
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+
+		var downloaded tools.DownloadOptions
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: viper.New()},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			Download: func(options tools.DownloadOptions) (string, error) {
+				downloaded = options
+				return testChecksum, nil
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, tools.DownloadOptions{}, downloaded)
+
+		expected := []any{
+			output.LogOutput{Format: "Downloading Bicep %s...", Params: []any{"latest"}},
+			output.LogOutput{Format: "Downloaded Bicep %s (sha256:%s).", Params: []any{"latest", testChecksum}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Download pinned version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+
+		config := viper.New()
+		cli.UpdateBicepSection(config, cli.BicepSection{Version: "v0.30.3", Checksum: testChecksum})
+
+		// No calls expected for this case.
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+
+		var downloaded tools.DownloadOptions
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: config},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			Download: func(options tools.DownloadOptions) (string, error) {
+				downloaded = options
+				return testChecksum, nil
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, tools.DownloadOptions{Version: "v0.30.3", Checksum: testChecksum}, downloaded)
+	})
+
+	t.Run("Download and pin version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+		config := viper.New()
+
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+		configFile.EXPECT().
+			SetBicepSection(gomock.Any(), config, cli.BicepSection{Version: "v0.30.3", Checksum: testChecksum}).
+			Return(nil).
+			Times(1)
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: config},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			Download: func(options tools.DownloadOptions) (string, error) {
+				return testChecksum, nil
+			},
+			Version: "0.30.3",
+			Pin:     true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "Downloading Bicep %s...", Params: []any{"v0.30.3"}},
+			output.LogOutput{Format: "Downloaded Bicep %s (sha256:%s).", Params: []any{"v0.30.3", testChecksum}},
+			output.LogOutput{Format: "Pinned Bicep %s.", Params: []any{"v0.30.3"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Unpin version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+
+		config := viper.New()
+		cli.UpdateBicepSection(config, cli.BicepSection{Version: "v0.30.3", Checksum: testChecksum})
+
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+		configFile.EXPECT().
+			SetBicepSection(gomock.Any(), config, cli.BicepSection{}).
+			Return(nil).
+			Times(1)
+
+		var downloaded tools.DownloadOptions
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: config},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			Download: func(options tools.DownloadOptions) (string, error) {
+				downloaded = options
+				return testChecksum, nil
+			},
+			Unpin: true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, tools.DownloadOptions{}, downloaded)
+		require.Equal(t, output.LogOutput{Format: "Removed pinned Bicep %s.", Params: []any{"v0.30.3"}}, outputSink.Writes[2])
+	})
+}
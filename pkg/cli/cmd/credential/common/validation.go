@@ -22,16 +22,18 @@ import (
 	"github.com/radius-project/radius/pkg/cli/aws"
 	"github.com/radius-project/radius/pkg/cli/azure"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/gcp"
 )
 
 // Used in tests
 const (
 	AzureCredentialID = "/planes/azure/azurecloud/providers/System.Azure/credentials/%s"
 	AWSCredentialID   = "/planes/aws/aws/providers/System.AWS/credentials/%s"
+	GCPCredentialID   = "/planes/gcp/gcp/providers/System.GCP/credentials/%s"
 )
 
 var (
-	supportedProviders = []string{azure.ProviderDisplayName, aws.ProviderDisplayName}
+	supportedProviders = []string{azure.ProviderDisplayName, aws.ProviderDisplayName, gcp.ProviderDisplayName}
 )
 
 // ValidateCloudProviderName checks if the given string is a supported cloud provider and returns an error if it is not.
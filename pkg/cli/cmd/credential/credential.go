@@ -20,6 +20,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
 	credential_list "github.com/radius-project/radius/pkg/cli/cmd/credential/list"
 	credential_register "github.com/radius-project/radius/pkg/cli/cmd/credential/register"
+	credential_rotate "github.com/radius-project/radius/pkg/cli/cmd/credential/rotate"
 	credential_show "github.com/radius-project/radius/pkg/cli/cmd/credential/show"
 	credential_unregister "github.com/radius-project/radius/pkg/cli/cmd/credential/unregister"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -55,6 +56,11 @@ rad credential show azure
 # Show cloud provider credential details for AWS
 rad credential show aws
 
+# Rotate the client secret of the Azure cloud provider credential
+rad credential rotate azure --client-secret <client secret>
+# Rotate the access key of the AWS cloud provider credential
+rad credential rotate aws --access-key-id <access-key-id> --secret-access-key <secret-access-key>
+
 # Delete Azure cloud provider configuration
 rad credential unregister azure
 # Delete AWS cloud provider configuration
@@ -74,5 +80,8 @@ rad credential unregister aws
 	show, _ := credential_show.NewCommand(factory)
 	cmd.AddCommand(show)
 
+	rotate, _ := credential_rotate.NewCommand(factory)
+	cmd.AddCommand(rotate)
+
 	return cmd
 }
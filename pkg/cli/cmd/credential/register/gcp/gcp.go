@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
+	"github.com/radius-project/radius/pkg/cli/cmd/credential/register/gcp/serviceaccountkey"
+	"github.com/radius-project/radius/pkg/cli/cmd/credential/register/gcp/workloadidentity"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for registering GCP cloud provider credentials with service account key or
+// workload identity authentication.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "gcp",
+		Short: "Register (Add or update) GCP cloud provider credential for a Radius installation.",
+		Long:  "Register (Add or update) GCP cloud provider credential for a Radius installation." + common.LongDescriptionBlurb,
+		Example: `
+# Register (Add or update) cloud provider credential for GCP with a service account key.
+rad credential register gcp service-account-key --key-file <path to key file>
+# Register (Add or update) cloud provider credential for GCP with workload identity.
+rad credential register gcp workload-identity --service-account-email <service account email>
+`,
+	}
+
+	serviceAccountKey, _ := serviceaccountkey.NewCommand(factory)
+	cmd.AddCommand(serviceAccountKey)
+
+	workloadIdentity, _ := workloadidentity.NewCommand(factory)
+	cmd.AddCommand(workloadIdentity)
+
+	return cmd
+}
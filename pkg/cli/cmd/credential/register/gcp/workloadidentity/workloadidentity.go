@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadidentity
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad credential register gcp workload-identity` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "workload-identity",
+		Short: "Register (Add or update) GCP cloud provider credential for a Radius installation.",
+		Long: `Register (Add or update) GCP cloud provider credential for a Radius installation.
+
+This command is intended for scripting or advanced use-cases. See 'rad init' for a user-friendly way
+to configure these settings.
+
+Radius will impersonate the provided GCP service account using workload identity for all interactions with GCP.
+` + common.LongDescriptionBlurb,
+		Example: `
+# Register (Add or update) cloud provider credential for GCP with workload identity
+rad credential register gcp workload-identity --service-account-email radius@my-project.iam.gserviceaccount.com
+`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	cmd.Flags().String("service-account-email", "", "The email of the GCP service account used by workload identity.")
+	_ = cmd.MarkFlagRequired("service-account-email")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad credential register gcp workload-identity` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	ServiceAccountEmail string
+	KubeContext         string
+}
+
+// NewRunner creates a new instance of the `rad credential register gcp workload-identity` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad credential register gcp workload-identity` command.
+//
+
+// Validate checks that the workspace, output format and service account email are present, and if not, returns an error.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	serviceAccountEmail, err := cmd.Flags().GetString("service-account-email")
+	if err != nil {
+		return err
+	}
+
+	r.ServiceAccountEmail = serviceAccountEmail
+	if r.ServiceAccountEmail == "" {
+		return clierrors.Message("Service account email %q cannot be empty.", r.ServiceAccountEmail)
+	}
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("A Kubernetes connection is required.")
+	}
+	r.KubeContext = kubeContext
+	return nil
+}
+
+// Run runs the `rad credential register gcp workload-identity` command.
+//
+
+// Run registers a GCP workload identity credential with the given workspace, and returns an error if unsuccessful.
+func (r *Runner) Run(ctx context.Context) error {
+	r.Output.LogInfo("Registering credential for %q cloud provider in Radius installation %q...", "gcp", r.Workspace.FmtConnection())
+	client, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	credential := ucp.GcpCredentialResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Type:     to.Ptr(cli_credential.GCPCredential),
+		Properties: &ucp.GcpWorkloadIdentityCredentialProperties{
+			Storage: &ucp.CredentialStorageProperties{
+				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+			},
+			ServiceAccountEmail: &r.ServiceAccountEmail,
+		},
+	}
+
+	err = client.PutGCP(ctx, credential)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Successfully registered credential for %q cloud provider. Tokens may take up to 30 seconds to refresh.", "gcp")
+
+	return nil
+}
@@ -0,0 +1,421 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rotate
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// defaultGracePeriod is the default time to wait before retiring the previous credential. Tokens may take up to
+	// 30 seconds to refresh.
+	defaultGracePeriod = 30 * time.Second
+
+	stepRegister = "Register"
+	stepVerify   = "Verify"
+	stepActivate = "Activate"
+	stepRetire   = "Retire"
+
+	statusSucceeded = "Succeeded"
+	statusSkipped   = "Skipped"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad credential rotate` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "rotate [name]",
+		Short: "Rotate the cloud provider credential for a Radius installation.",
+		Long: `Rotate the cloud provider credential for a Radius installation.
+
+Rotating a credential replaces the secret of the registered credential without removing it first:
+
+1. The new credential is registered alongside the active credential.
+2. The new credential is verified with a connectivity test to the cloud provider.
+3. The new credential replaces the active credential in a single update.
+4. After the grace period, the previous credential is retired. Revoke the previous secret with the cloud provider
+   once it is retired.
+
+Rotation is supported for Azure service principal and AWS access key credentials. The credential is shared by all
+environments of the Radius installation.
+` + common.LongDescriptionBlurb,
+		Example: `
+# Rotate the client secret of the Azure service principal
+rad credential rotate azure --client-secret <client secret>
+
+# Rotate the Azure credential to another service principal
+rad credential rotate azure --client-id <client id> --client-secret <client secret> --tenant-id <tenant id>
+
+# Rotate the AWS access key
+rad credential rotate aws --access-key-id <access-key-id> --secret-access-key <secret-access-key>
+
+# Rotate the AWS access key and wait 5 minutes before retiring the previous access key
+rad credential rotate aws --access-key-id <access-key-id> --secret-access-key <secret-access-key> --grace-period 5m
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	cmd.Flags().String("client-id", "", "The Azure client ID of the new credential. Defaults to the client ID of the active credential.")
+	cmd.Flags().String("client-secret", "", "The Azure client secret of the new credential.")
+	cmd.Flags().String("tenant-id", "", "The Azure tenant ID of the new credential. Defaults to the tenant ID of the active credential.")
+	cmd.Flags().String("access-key-id", "", "The AWS IAM access key id of the new credential.")
+	cmd.Flags().String("secret-access-key", "", "The AWS IAM secret access key of the new credential.")
+	cmd.Flags().Duration("grace-period", defaultGracePeriod, "The time to wait after activating the new credential before retiring the previous credential.")
+	cmd.Flags().Bool("skip-verify", false, "Skip the connectivity test of the new credential.")
+
+	return cmd, runner
+}
+
+// RotationStep is the status of a step of the credential rotation.
+type RotationStep struct {
+	// Name is the name of the step.
+	Name string
+	// Status is the status of the step.
+	Status string
+	// Details describes the outcome of the step.
+	Details string
+}
+
+// Runner is the runner implementation for the `rad credential rotate` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Verifier          cli_credential.Verifier
+	Format            string
+	Workspace         *workspaces.Workspace
+	Kind              string
+
+	ClientID        string
+	ClientSecret    string
+	TenantID        string
+	AccessKeyID     string
+	SecretAccessKey string
+	GracePeriod     time.Duration
+	SkipVerify      bool
+}
+
+// NewRunner creates a new instance of the `rad credential rotate` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		Verifier:          cli_credential.NewVerifier(),
+	}
+}
+
+// Validate runs validation for the `rad credential rotate` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.Kind = strings.ToLower(args[0]) // Validated by Cobra
+	err = common.ValidateCloudProviderName(r.Kind)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := r.Workspace.KubernetesContext(); !ok {
+		return clierrors.Message("A Kubernetes connection is required.")
+	}
+
+	r.ClientID, _ = cmd.Flags().GetString("client-id")
+	r.ClientSecret, _ = cmd.Flags().GetString("client-secret")
+	r.TenantID, _ = cmd.Flags().GetString("tenant-id")
+	r.AccessKeyID, _ = cmd.Flags().GetString("access-key-id")
+	r.SecretAccessKey, _ = cmd.Flags().GetString("secret-access-key")
+
+	r.GracePeriod, err = cmd.Flags().GetDuration("grace-period")
+	if err != nil {
+		return err
+	}
+	if r.GracePeriod < 0 {
+		return clierrors.Message("The grace period %q cannot be negative.", r.GracePeriod)
+	}
+
+	r.SkipVerify, err = cmd.Flags().GetBool("skip-verify")
+	if err != nil {
+		return err
+	}
+
+	switch r.Kind {
+	case cli_credential.AzureCredential:
+		if r.ClientSecret == "" {
+			return clierrors.Message("The --client-secret flag is required to rotate the Azure credential.")
+		}
+		if r.AccessKeyID != "" || r.SecretAccessKey != "" {
+			return clierrors.Message("The --access-key-id and --secret-access-key flags cannot be used to rotate the Azure credential.")
+		}
+	case cli_credential.AWSCredential:
+		if r.AccessKeyID == "" || r.SecretAccessKey == "" {
+			return clierrors.Message("The --access-key-id and --secret-access-key flags are required to rotate the AWS credential.")
+		}
+		if r.ClientID != "" || r.ClientSecret != "" || r.TenantID != "" {
+			return clierrors.Message("The --client-id, --client-secret and --tenant-id flags cannot be used to rotate the AWS credential.")
+		}
+	case cli_credential.GCPCredential:
+		return clierrors.Message("Rotating the GCP credential is not supported. Use 'rad credential register gcp' to replace it.")
+	}
+
+	return nil
+}
+
+// rotation holds the provider specific steps of the credential rotation.
+type rotation struct {
+	// stage registers the new credential without making it active.
+	stage func(ctx context.Context) error
+	// verify tests the connectivity of the new credential.
+	verify func(ctx context.Context) error
+	// activate replaces the active credential with the new credential.
+	activate func(ctx context.Context) error
+}
+
+// Run runs the `rad credential rotate` command.
+//
+// The active credential is only replaced once the new credential is registered and verified. Replacing the credential
+// is a single update of the credential, so there is no time when no credential is registered.
+func (r *Runner) Run(ctx context.Context) error {
+	r.Output.LogInfo("Rotating credential for %q cloud provider in Radius installation %q...", r.Kind, r.Workspace.FmtConnection())
+	client, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	active, err := client.Get(ctx, r.Kind)
+	if err != nil {
+		return err
+	}
+	if !active.Enabled {
+		return clierrors.Message("No credential is registered for cloud provider %q. Use 'rad credential register %s' to register a credential.", r.Kind, r.Kind)
+	}
+
+	var rot *rotation
+	switch r.Kind {
+	case cli_credential.AzureCredential:
+		rot, err = r.azureRotation(client, active)
+	case cli_credential.AWSCredential:
+		rot, err = r.awsRotation(client, active)
+	}
+	if err != nil {
+		return err
+	}
+
+	steps := []RotationStep{}
+
+	r.Output.LogInfo("Registering the new credential...")
+	err = rot.stage(ctx)
+	if err != nil {
+		return err
+	}
+	steps = append(steps, RotationStep{Name: stepRegister, Status: statusSucceeded, Details: "The new credential is registered alongside the active credential."})
+
+	if r.SkipVerify {
+		steps = append(steps, RotationStep{Name: stepVerify, Status: statusSkipped, Details: "The connectivity test was skipped."})
+	} else {
+		r.Output.LogInfo("Verifying the new credential...")
+		err = rot.verify(ctx)
+		if err != nil {
+			// The active credential is left in place.
+			if _, deleteErr := client.DeleteStaged(ctx, r.Kind); deleteErr != nil {
+				r.Output.LogInfo("Failed to remove the new credential: %v", deleteErr)
+			}
+			return clierrors.MessageWithCause(err, "The new credential for cloud provider %q failed the connectivity test. The active credential was not changed.", r.Kind)
+		}
+		steps = append(steps, RotationStep{Name: stepVerify, Status: statusSucceeded, Details: "The new credential passed the connectivity test."})
+	}
+
+	r.Output.LogInfo("Activating the new credential...")
+	err = rot.activate(ctx)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to activate the new credential for cloud provider %q. The active credential was not changed.", r.Kind)
+	}
+	steps = append(steps, RotationStep{Name: stepActivate, Status: statusSucceeded, Details: "The new credential replaced the active credential."})
+
+	if r.GracePeriod > 0 {
+		r.Output.LogInfo("Waiting %s before retiring the previous credential...", r.GracePeriod)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.GracePeriod):
+		}
+	}
+
+	_, err = client.DeleteStaged(ctx, r.Kind)
+	if err != nil {
+		return err
+	}
+	steps = append(steps, RotationStep{Name: stepRetire, Status: statusSucceeded, Details: "The previous credential is no longer used by Radius."})
+
+	err = r.Output.WriteFormatted(r.Format, steps, objectFormat())
+	if err != nil {
+		return err
+	}
+
+	environments, err := r.listEnvironments(ctx)
+	if err != nil {
+		return err
+	}
+	if len(environments) > 0 {
+		r.Output.LogInfo("Environments using the %q credential: %s", r.Kind, strings.Join(environments, ", "))
+	}
+
+	r.Output.LogInfo("Successfully rotated credential for %q cloud provider. Revoke the previous secret with the cloud provider once it is no longer used elsewhere.", r.Kind)
+
+	return nil
+}
+
+func (r *Runner) azureRotation(client cli_credential.CredentialManagementClient, active cli_credential.ProviderCredentialConfiguration) (*rotation, error) {
+	if active.AzureCredentials == nil || active.AzureCredentials.ServicePrincipal == nil {
+		return nil, clierrors.Message("Rotation is only supported for Azure service principal credentials. Workload identity credentials have no secret to rotate.")
+	}
+
+	clientID := r.ClientID
+	if clientID == "" {
+		clientID = to.String(active.AzureCredentials.ServicePrincipal.ClientID)
+	}
+	tenantID := r.TenantID
+	if tenantID == "" {
+		tenantID = to.String(active.AzureCredentials.ServicePrincipal.TenantID)
+	}
+
+	credential := ucp.AzureCredentialResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Type:     to.Ptr(cli_credential.AzureCredential),
+		Properties: &ucp.AzureServicePrincipalProperties{
+			Storage: &ucp.CredentialStorageProperties{
+				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+			},
+			TenantID:     &tenantID,
+			ClientID:     &clientID,
+			ClientSecret: &r.ClientSecret,
+			Kind:         to.Ptr(ucp.AzureCredentialKindServicePrincipal),
+		},
+	}
+
+	return &rotation{
+		stage: func(ctx context.Context) error {
+			return client.StageAzure(ctx, credential)
+		},
+		verify: func(ctx context.Context) error {
+			return r.Verifier.VerifyAzureServicePrincipal(ctx, tenantID, clientID, r.ClientSecret)
+		},
+		activate: func(ctx context.Context) error {
+			return client.PutAzure(ctx, credential)
+		},
+	}, nil
+}
+
+func (r *Runner) awsRotation(client cli_credential.CredentialManagementClient, active cli_credential.ProviderCredentialConfiguration) (*rotation, error) {
+	if active.AWSCredentials == nil || active.AWSCredentials.AccessKey == nil {
+		return nil, clierrors.Message("Rotation is only supported for AWS access key credentials. IRSA credentials have no secret to rotate.")
+	}
+
+	credential := ucp.AwsCredentialResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Type:     to.Ptr(cli_credential.AWSCredential),
+		Properties: &ucp.AwsAccessKeyCredentialProperties{
+			Storage: &ucp.CredentialStorageProperties{
+				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+			},
+			AccessKeyID:     &r.AccessKeyID,
+			SecretAccessKey: &r.SecretAccessKey,
+		},
+	}
+
+	return &rotation{
+		stage: func(ctx context.Context) error {
+			return client.StageAWS(ctx, credential)
+		},
+		verify: func(ctx context.Context) error {
+			return r.Verifier.VerifyAWSAccessKey(ctx, r.AccessKeyID, r.SecretAccessKey)
+		},
+		activate: func(ctx context.Context) error {
+			return client.PutAWS(ctx, credential)
+		},
+	}, nil
+}
+
+// listEnvironments lists the names of the environments configured with the cloud provider.
+func (r *Runner) listEnvironments(ctx context.Context) ([]string, error) {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	environments, err := client.ListEnvironmentsAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, environment := range environments {
+		if usesProvider(environment, r.Kind) {
+			names = append(names, to.String(environment.Name))
+		}
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+func usesProvider(environment corerp.EnvironmentResource, kind string) bool {
+	if environment.Properties == nil || environment.Properties.Providers == nil {
+		return false
+	}
+
+	switch kind {
+	case cli_credential.AzureCredential:
+		return environment.Properties.Providers.Azure != nil
+	case cli_credential.AWSCredential:
+		return environment.Properties.Providers.Aws != nil
+	default:
+		return false
+	}
+}
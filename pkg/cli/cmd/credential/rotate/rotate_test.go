@@ -0,0 +1,279 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rotate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID        = "test-client-id"
+	testClientSecret    = "test-client-secret"
+	testTenantID        = "test-tenant-id"
+	testAccessKeyID     = "TEST-ACCESS-KEY-ID"
+	testSecretAccessKey = "TEST-SECRET-ACCESS-KEY"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Azure command",
+			Input:         []string{"azure", "--client-secret", testClientSecret},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Valid AWS command",
+			Input:         []string{"aws", "--access-key-id", testAccessKeyID, "--secret-access-key", testSecretAccessKey, "--grace-period", "5m"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Unsupported cloud provider",
+			Input:         []string{"gcp", "--client-secret", testClientSecret},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Azure command without client secret",
+			Input:         []string{"azure", "--client-id", testClientID},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Azure command with AWS flags",
+			Input:         []string{"azure", "--client-secret", testClientSecret, "--access-key-id", testAccessKeyID},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "AWS command without secret access key",
+			Input:         []string{"aws", "--access-key-id", testAccessKeyID},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Negative grace period",
+			Input:         []string{"aws", "--access-key-id", testAccessKeyID, "--secret-access-key", testSecretAccessKey, "--grace-period", "-1s"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Too many positional args",
+			Input:         []string{"aws", "azure"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    workspaces.KindKubernetes,
+			"context": "my-context",
+		},
+		Source: workspaces.SourceUserConfig,
+	}
+
+	activeAzure := cli_credential.ProviderCredentialConfiguration{
+		CloudProviderStatus: cli_credential.CloudProviderStatus{Name: "azure", Enabled: true},
+		AzureCredentials: &cli_credential.AzureCredentialProperties{
+			ServicePrincipal: &cli_credential.AzureServicePrincipalCredentialProperties{
+				ClientID: to.Ptr(testClientID),
+				TenantID: to.Ptr(testTenantID),
+			},
+		},
+	}
+
+	environments := []corerp.EnvironmentResource{
+		{
+			Name: to.Ptr("prod"),
+			Properties: &corerp.EnvironmentProperties{
+				Providers: &corerp.Providers{Azure: &corerp.ProvidersAzure{Scope: to.Ptr("/subscriptions/test/resourceGroups/test")}},
+			},
+		},
+		{
+			Name:       to.Ptr("dev"),
+			Properties: &corerp.EnvironmentProperties{},
+		},
+	}
+
+	t.Run("Rotate Azure credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := cli_credential.NewMockCredentialManagementClient(ctrl)
+		verifier := cli_credential.NewMockVerifier(ctrl)
+		appClient := clients.NewMockApplicationsManagementClient(ctrl)
+
+		client.EXPECT().Get(gomock.Any(), "azure").Return(activeAzure, nil)
+		gomock.InOrder(
+			client.EXPECT().StageAzure(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, credential ucp.AzureCredentialResource) error {
+					properties := credential.Properties.(*ucp.AzureServicePrincipalProperties)
+					require.Equal(t, testClientID, *properties.ClientID)
+					require.Equal(t, testTenantID, *properties.TenantID)
+					require.Equal(t, "new-secret", *properties.ClientSecret)
+					return nil
+				}),
+			verifier.EXPECT().VerifyAzureServicePrincipal(gomock.Any(), testTenantID, testClientID, "new-secret").Return(nil),
+			client.EXPECT().PutAzure(gomock.Any(), gomock.Any()).Return(nil),
+			client.EXPECT().DeleteStaged(gomock.Any(), "azure").Return(true, nil),
+		)
+		appClient.EXPECT().ListEnvironmentsAll(gomock.Any()).Return(environments, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client, ApplicationsManagementClient: appClient},
+			Output:            outputSink,
+			Verifier:          verifier,
+			Workspace:         workspace,
+			Format:            "table",
+			Kind:              "azure",
+			ClientSecret:      "new-secret",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Rotating credential for %q cloud provider in Radius installation %q...",
+				Params: []any{"azure", "Kubernetes (context=my-context)"},
+			},
+			output.LogOutput{Format: "Registering the new credential..."},
+			output.LogOutput{Format: "Verifying the new credential..."},
+			output.LogOutput{Format: "Activating the new credential..."},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []RotationStep{
+					{Name: stepRegister, Status: statusSucceeded, Details: "The new credential is registered alongside the active credential."},
+					{Name: stepVerify, Status: statusSucceeded, Details: "The new credential passed the connectivity test."},
+					{Name: stepActivate, Status: statusSucceeded, Details: "The new credential replaced the active credential."},
+					{Name: stepRetire, Status: statusSucceeded, Details: "The previous credential is no longer used by Radius."},
+				},
+				Options: objectFormat(),
+			},
+			output.LogOutput{
+				Format: "Environments using the %q credential: %s",
+				Params: []any{"azure", "prod"},
+			},
+			output.LogOutput{
+				Format: "Successfully rotated credential for %q cloud provider. Revoke the previous secret with the cloud provider once it is no longer used elsewhere.",
+				Params: []any{"azure"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Verification failure keeps the active credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := cli_credential.NewMockCredentialManagementClient(ctrl)
+		verifier := cli_credential.NewMockVerifier(ctrl)
+
+		client.EXPECT().Get(gomock.Any(), "aws").Return(cli_credential.ProviderCredentialConfiguration{
+			CloudProviderStatus: cli_credential.CloudProviderStatus{Name: "aws", Enabled: true},
+			AWSCredentials: &cli_credential.AWSCredentialProperties{
+				AccessKey: &cli_credential.AWSAccessKeyCredentialProperties{AccessKeyID: to.Ptr("OLD-ACCESS-KEY-ID")},
+			},
+		}, nil)
+		client.EXPECT().StageAWS(gomock.Any(), gomock.Any()).Return(nil)
+		verifyErr := errors.New("InvalidClientTokenId")
+		verifier.EXPECT().VerifyAWSAccessKey(gomock.Any(), testAccessKeyID, testSecretAccessKey).Return(verifyErr)
+		client.EXPECT().DeleteStaged(gomock.Any(), "aws").Return(true, nil)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+			Output:            &output.MockOutput{},
+			Verifier:          verifier,
+			Workspace:         workspace,
+			Format:            "table",
+			Kind:              "aws",
+			AccessKeyID:       testAccessKeyID,
+			SecretAccessKey:   testSecretAccessKey,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.MessageWithCause(verifyErr, "The new credential for cloud provider %q failed the connectivity test. The active credential was not changed.", "aws"), err)
+	})
+
+	t.Run("No active credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := cli_credential.NewMockCredentialManagementClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), "aws").Return(cli_credential.ProviderCredentialConfiguration{
+			CloudProviderStatus: cli_credential.CloudProviderStatus{Name: "aws", Enabled: false},
+		}, nil)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			Kind:              "aws",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("No credential is registered for cloud provider %q. Use 'rad credential register %s' to register a credential.", "aws", "aws"), err)
+	})
+
+	t.Run("Workload identity credential", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := cli_credential.NewMockCredentialManagementClient(ctrl)
+		client.EXPECT().Get(gomock.Any(), "azure").Return(cli_credential.ProviderCredentialConfiguration{
+			CloudProviderStatus: cli_credential.CloudProviderStatus{Name: "azure", Enabled: true},
+			AzureCredentials: &cli_credential.AzureCredentialProperties{
+				WorkloadIdentity: &cli_credential.AzureWorkloadIdentityCredentialProperties{ClientID: to.Ptr(testClientID)},
+			},
+		}, nil)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			Kind:              "azure",
+			ClientSecret:      testClientSecret,
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+	})
+}
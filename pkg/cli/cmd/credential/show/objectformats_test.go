@@ -125,3 +125,28 @@ func Test_credentialFormatAWSIRSA(t *testing.T) {
 	expected := "NAME      REGISTERED  KIND      ROLEARN\ntest      true        IRSA      test-role-arn\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_credentialFormatGCPWorkloadIdentity(t *testing.T) {
+	obj := credential.ProviderCredentialConfiguration{
+		CloudProviderStatus: credential.CloudProviderStatus{
+			Name:    "test",
+			Enabled: true,
+		},
+		GCPCredentials: &credential.GCPCredentialProperties{
+			Kind: to.Ptr("WorkloadIdentity"),
+			WorkloadIdentity: &credential.GCPWorkloadIdentityCredentialProperties{
+				Kind:                to.Ptr("WorkloadIdentity"),
+				ServiceAccountEmail: to.Ptr("radius@my-project.iam.gserviceaccount.com"),
+			},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	credentialFormatOutput := credentialFormatGCPWorkloadIdentity()
+
+	err := output.Write(output.FormatTable, obj, buffer, credentialFormatOutput)
+	require.NoError(t, err)
+
+	expected := "NAME      REGISTERED  KIND              SERVICEACCOUNTEMAIL\ntest      true        WorkloadIdentity  radius@my-project.iam.gserviceaccount.com\n"
+	require.Equal(t, expected, buffer.String())
+}
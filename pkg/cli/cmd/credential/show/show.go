@@ -148,6 +148,15 @@ func (r *Runner) Run(ctx context.Context) error {
 		default:
 			return fmt.Errorf("unknown AWS credential kind, expected AccessKey or IRSA (got %s)", *providers.AWSCredentials.Kind)
 		}
+	case "gcp":
+		switch *providers.GCPCredentials.Kind {
+		case datamodel.GCPServiceAccountKeyCredentialKind:
+			output = credentialFormatGCPServiceAccountKey()
+		case datamodel.GCPWorkloadIdentityCredentialKind:
+			output = credentialFormatGCPWorkloadIdentity()
+		default:
+			return fmt.Errorf("unknown GCP credential kind, expected ServiceAccountKey or WorkloadIdentity (got %s)", *providers.GCPCredentials.Kind)
+		}
 	default:
 		return fmt.Errorf("unknown credential type: %s", r.Kind)
 	}
@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedresources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	deleteConfirmation = "Are you sure you want to delete %d orphaned resource(s)?"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad delete orphaned-resources` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "orphaned-resources",
+		Short: "List and delete orphaned resources",
+		Long: `List and delete orphaned resources.
+
+Orphaned resources are Kubernetes objects labeled as managed by Radius whose Radius resource no longer exists in any
+resource group of the workspace. They can be left behind by failed deletions or by changes made outside of Radius.
+
+By default the orphaned resources are only listed. Use the --delete flag to delete them.`,
+		Example: `
+# List orphaned resources in the cluster of the current workspace
+rad delete orphaned-resources
+
+# Delete orphaned resources without prompting for confirmation
+rad delete orphaned-resources --delete --yes`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().BoolVar(&runner.Delete, "delete", false, "Delete the orphaned resources after listing them.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad delete orphaned-resources` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	InputPrompter     prompt.Interface
+	Kubernetes        kubernetes.Interface
+	Output            output.Interface
+
+	Confirm     bool
+	Delete      bool
+	Format      string
+	KubeContext string
+	Workspace   *workspaces.Workspace
+}
+
+// NewRunner creates a new instance of the `rad delete orphaned-resources` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		InputPrompter:     factory.GetPrompter(),
+		Kubernetes:        factory.GetKubernetesInterface(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad delete orphaned-resources` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("The workspace %q does not use a Kubernetes connection. Orphaned resources can only be found in Kubernetes clusters.", r.Workspace.Name)
+	}
+	r.KubeContext = kubeContext
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad delete orphaned-resources` command.
+func (r *Runner) Run(ctx context.Context) error {
+	objects, err := r.Kubernetes.ListRadiusManagedObjects(ctx, r.KubeContext)
+	if err != nil {
+		return err
+	}
+
+	existing, err := r.listRadiusResources(ctx, objects)
+	if err != nil {
+		return err
+	}
+
+	orphans := []kubernetes.ManagedObject{}
+	for _, object := range objects {
+		if !existing[resourceKey(object.RadiusResourceType, object.RadiusResource)] {
+			orphans = append(orphans, object)
+		}
+	}
+
+	if len(orphans) == 0 {
+		r.Output.LogInfo("No orphaned resources were found.")
+		return nil
+	}
+
+	err = r.Output.WriteFormatted(r.Format, orphans, orphanedResourcesFormat())
+	if err != nil {
+		return err
+	}
+
+	if !r.Delete {
+		return nil
+	}
+
+	// Prompt user to confirm deletion
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(deleteConfirmation, len(orphans)), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			r.Output.LogInfo("Orphaned resources NOT deleted")
+			return nil
+		}
+	}
+
+	for _, orphan := range orphans {
+		r.Output.LogInfo("Deleting %s %s/%s", orphan.Kind, orphan.Namespace, orphan.Name)
+		err := r.Kubernetes.DeleteRadiusManagedObject(ctx, r.KubeContext, orphan)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.Output.LogInfo("Orphaned resources deleted")
+	return nil
+}
+
+// listRadiusResources returns the set of Radius resources, keyed by resourceKey, that exist in any resource group
+// and have one of the resource types referenced by the Kubernetes objects.
+func (r *Runner) listRadiusResources(ctx context.Context, objects []kubernetes.ManagedObject) (map[string]bool, error) {
+	existing := map[string]bool{}
+	if len(objects) == 0 {
+		return existing, nil
+	}
+
+	resourceTypes := []string{}
+	seen := map[string]bool{}
+	for _, object := range objects {
+		resourceType := canonicalResourceType(object.RadiusResourceType)
+		if !seen[resourceType] {
+			seen[resourceType] = true
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := client.ListResourceGroups(ctx, "local")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		// Resources are listed in the scope of the client, so we need a client for each resource group.
+		workspace := *r.Workspace
+		workspace.Scope = *group.ID
+		groupClient, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, workspace)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resourceType := range resourceTypes {
+			resources, err := groupClient.ListResourcesOfType(ctx, resourceType)
+			if clients.Is404Error(err) {
+				// The resource type is no longer registered, so none of its resources exist.
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			for _, resource := range resources {
+				existing[resourceKey(resourceType, *resource.Name)] = true
+			}
+		}
+	}
+
+	return existing, nil
+}
+
+// canonicalResourceType returns the well-known casing of a resource type converted from a label value, which is
+// always lowercase.
+func canonicalResourceType(resourceType string) string {
+	for _, knownType := range clients.ResourceTypesList {
+		if strings.EqualFold(knownType, resourceType) {
+			return knownType
+		}
+	}
+
+	return resourceType
+}
+
+// resourceKey returns the key used to match a Radius resource with the labels of a Kubernetes object. Label
+// values are lowercase, so the comparison is case-insensitive.
+func resourceKey(resourceType string, resourceName string) string {
+	return strings.ToLower(resourceType) + "|" + strings.ToLower(resourceName)
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedresources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Delete orphaned resources command with no args",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Delete orphaned resources command with delete and confirmation flags",
+			Input:         []string{"--delete", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Delete orphaned resources command with too many args",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	existingObject := kubernetes.ManagedObject{
+		Resource:           schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		Kind:               "Deployment",
+		Namespace:          "default-myapp",
+		Name:               "frontend",
+		Application:        "myapp",
+		RadiusResource:     "frontend",
+		RadiusResourceType: "applications.core/containers",
+	}
+	orphanedObject := kubernetes.ManagedObject{
+		Resource:           schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		Kind:               "Deployment",
+		Namespace:          "default-myapp",
+		Name:               "backend",
+		Application:        "myapp",
+		RadiusResource:     "backend",
+		RadiusResourceType: "applications.core/containers",
+	}
+
+	setupClients := func(ctrl *gomock.Controller) (*kubernetes.MockInterface, *clients.MockApplicationsManagementClient) {
+		kubernetesClient := kubernetes.NewMockInterface(ctrl)
+		kubernetesClient.EXPECT().
+			ListRadiusManagedObjects(gomock.Any(), "kind-kind").
+			Return([]kubernetes.ManagedObject{existingObject, orphanedObject}, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return([]v20231001preview.ResourceGroupResource{{ID: to.Ptr("/planes/radius/local/resourceGroups/test-group")}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfType(gomock.Any(), "Applications.Core/containers").
+			Return([]generated.GenericResource{{Name: to.Ptr("Frontend")}}, nil).
+			Times(1)
+
+		return kubernetesClient, appManagementClient
+	}
+
+	t.Run("List", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		kubernetesClient, appManagementClient := setupClients(ctrl)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Kubernetes:        kubernetesClient,
+			Output:            outputSink,
+			Workspace:         workspace,
+			KubeContext:       "kind-kind",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     []kubernetes.ManagedObject{orphanedObject},
+				Options: orphanedResourcesFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("No orphaned resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		kubernetesClient := kubernetes.NewMockInterface(ctrl)
+		kubernetesClient.EXPECT().
+			ListRadiusManagedObjects(gomock.Any(), "kind-kind").
+			Return([]kubernetes.ManagedObject{}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: clients.NewMockApplicationsManagementClient(ctrl)},
+			Kubernetes:        kubernetesClient,
+			Output:            outputSink,
+			Workspace:         workspace,
+			KubeContext:       "kind-kind",
+			Format:            "table",
+			Delete:            true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No orphaned resources were found.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Delete with confirmation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		kubernetesClient, appManagementClient := setupClients(ctrl)
+		kubernetesClient.EXPECT().
+			DeleteRadiusManagedObject(gomock.Any(), "kind-kind", orphanedObject).
+			Return(nil).
+			Times(1)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(deleteConfirmation, 1)).
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			InputPrompter:     prompter,
+			Kubernetes:        kubernetesClient,
+			Output:            outputSink,
+			Workspace:         workspace,
+			KubeContext:       "kind-kind",
+			Format:            "table",
+			Delete:            true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     []kubernetes.ManagedObject{orphanedObject},
+				Options: orphanedResourcesFormat(),
+			},
+			output.LogOutput{
+				Format: "Deleting %s %s/%s",
+				Params: []any{"Deployment", "default-myapp", "backend"},
+			},
+			output.LogOutput{
+				Format: "Orphaned resources deleted",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Delete cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		kubernetesClient, appManagementClient := setupClients(ctrl)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(deleteConfirmation, 1)).
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			InputPrompter:     prompter,
+			Kubernetes:        kubernetesClient,
+			Output:            outputSink,
+			Workspace:         workspace,
+			KubeContext:       "kind-kind",
+			Format:            "table",
+			Delete:            true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     []kubernetes.ManagedObject{orphanedObject},
+				Options: orphanedResourcesFormat(),
+			},
+			output.LogOutput{
+				Format: "Orphaned resources NOT deleted",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -36,10 +36,17 @@ import (
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
 )
 
+const (
+	templateSpecResourceType        = "System.Resources/templateSpecs"
+	templateSpecVersionResourceType = "System.Resources/templateSpecs/versions"
+)
+
 // NewCommand creates an instance of the command and runner for the `rad deploy` command.
 //
 
@@ -71,6 +78,30 @@ When passing multiple parameters in a single file, use the format described here
 
 You can specify parameters using multiple sources. Parameters can be overridden based on the 
 order they are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+Instead of a file, you can deploy a template that was published to the template spec library by passing the
+resource ID of a template spec version with the '--template-id' flag. This deploys the exact same template
+that was published, which makes it possible to promote a single artifact through multiple environments.
+
+You can use the '--watch' flag to keep the command running and redeploy the template each time the template file,
+the local modules it references or the files it loads are changed. The resources which changed are displayed before
+each deployment. Deployments are incremental, so resources removed from the template are not deleted.
+
+You can use the '--dry-run' flag to preview the changes the deployment would make without deploying the template.
+The resources which would be created, modified or deleted are displayed, along with the properties which would change.
+The application is not created and the deployment is not recorded in the deployment history.
+
+You can use the '--record' flag with '--application' to record the deployment in the deployment history of the
+application, so it can be promoted to another environment with 'rad app promote'. The template and the values of the
+parameters which are not secure are stored in the template spec library of the resource group of the application.
+
+Templates can declare a 'context' parameter of type object to use the values Radius knows about the deployment instead
+of hardcoding them. Radius sets the parameter to an object with the name and id of the environment, application and
+resource group, and the Kubernetes namespace of the application and environment:
+
+	param context object
+
+	var namespace = context.runtime.kubernetes.namespace
 `,
 		Example: `
 # deploy a Bicep template
@@ -106,8 +137,23 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# display progress as JSON events, eg: in a CI pipeline
+rad deploy myapp.bicep --progress json
+
+# redeploy the template each time it is changed
+rad deploy myapp.bicep --watch
+
+# preview the changes the deployment would make without deploying the template
+rad deploy myapp.bicep --dry-run
+
+# record the deployment so it can be promoted to another environment with 'rad app promote'
+rad deploy myapp.bicep --application myapp --record
+
+# deploy a template stored in the template spec library
+rad deploy --template-id /planes/radius/local/resourceGroups/shared/providers/System.Resources/templateSpecs/myapp/versions/1.0.0 --environment production
 `,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: framework.RunCommand(runner),
 	}
 
@@ -116,10 +162,41 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().String("template-id", "", "The resource ID of a template spec version to deploy instead of a template file")
+	cmd.Flags().Bool("watch", false, "Watch the template file and the files it references, and redeploy the template when they change")
+	cmd.Flags().Bool("dry-run", false, "Preview the changes the deployment would make to resources without deploying the template")
+	cmd.Flags().Bool("record", false, "Record the deployment in the deployment history of the application so it can be promoted with 'rad app promote'")
+	AddProgressFlag(cmd)
 
 	return cmd, runner
 }
 
+// AddProgressFlag adds a flag to the given command that allows the user to specify how deployment progress is displayed.
+func AddProgressFlag(cmd *cobra.Command) {
+	description := fmt.Sprintf("How to display the progress of the deployment (supported modes are %s). Progress is displayed as plain text when the output is not a terminal", strings.Join(deploy.SupportedProgressModes(), ", "))
+	cmd.Flags().String("progress", string(deploy.ProgressModeAuto), description)
+}
+
+// RequireProgressMode reads the progress mode from the --progress flag. The flag is optional for commands which
+// reuse the validation of `rad deploy`.
+func RequireProgressMode(cmd *cobra.Command) (deploy.ProgressMode, error) {
+	if cmd.Flags().Lookup("progress") == nil {
+		return deploy.ProgressModeAuto, nil
+	}
+
+	value, err := cmd.Flags().GetString("progress")
+	if err != nil {
+		return "", err
+	}
+
+	mode, err := deploy.ParseProgressMode(value)
+	if err != nil {
+		return "", clierrors.Message("The progress mode %q is not supported. Supported modes: %s.", value, strings.Join(deploy.SupportedProgressModes(), ", "))
+	}
+
+	return mode, nil
+}
+
 // Runner is the runner implementation for the `rad deploy` command.
 type Runner struct {
 	Bicep             bicep.Interface
@@ -128,12 +205,18 @@ type Runner struct {
 	Deploy            deploy.Interface
 	Output            output.Interface
 
-	ApplicationName     string
-	EnvironmentNameOrID string
-	FilePath            string
-	Parameters          map[string]map[string]any
-	Workspace           *workspaces.Workspace
-	Providers           *clients.Providers
+	ApplicationName      string
+	EnvironmentNameOrID  string
+	FilePath             string
+	TemplateID           resources.ID
+	Parameters           map[string]map[string]any
+	Workspace            *workspaces.Workspace
+	Providers            *clients.Providers
+	EnvironmentNamespace string
+	Progress             deploy.ProgressMode
+	Watch                bool
+	DryRun               bool
+	Record               bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -160,6 +243,60 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 	r.Workspace = workspace
 
+	// The flags are not defined by commands which reuse this validation, like `rad run`.
+	templateID := ""
+	if cmd.Flags().Lookup("template-id") != nil {
+		templateID, err = cmd.Flags().GetString("template-id")
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Flags().Lookup("watch") != nil {
+		r.Watch, err = cmd.Flags().GetBool("watch")
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Flags().Lookup("dry-run") != nil {
+		r.DryRun, err = cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Flags().Lookup("record") != nil {
+		r.Record, err = cmd.Flags().GetBool("record")
+		if err != nil {
+			return err
+		}
+	}
+
+	r.Progress, err = RequireProgressMode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 && templateID != "" {
+		return clierrors.Message("Specify either a template file or --template-id, but not both.")
+	} else if len(args) == 0 && templateID == "" {
+		return clierrors.Message("A template file or --template-id is required.")
+	} else if r.Watch && templateID != "" {
+		return clierrors.Message("The --watch flag can only be used with a template file.")
+	} else if r.Watch && r.DryRun {
+		return clierrors.Message("The --watch and --dry-run flags cannot be used together.")
+	}
+
+	if templateID != "" {
+		r.TemplateID, err = resources.ParseResource(templateID)
+		if err != nil || !strings.EqualFold(r.TemplateID.Type(), templateSpecVersionResourceType) || r.TemplateID.FindScope(resources_radius.ScopeResourceGroups) == "" {
+			return clierrors.Message("The template ID %q is not a valid template spec version ID. The ID must have the form /planes/radius/<plane>/resourceGroups/<group>/providers/%s/<name>/versions/<version>.", templateID, templateSpecResourceType)
+		}
+	} else {
+		r.FilePath = args[0]
+	}
+
 	// Allow --group to override the scope
 	scope, err := cli.RequireScope(cmd, *workspace)
 	if err != nil {
@@ -182,6 +319,10 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if r.Record && r.ApplicationName == "" {
+		return clierrors.Message("The --record flag requires an application. Specify the application with --application.")
+	}
+
 	// Validate that the environment exists.
 	// Right now we assume that every deployment uses a Radius Environment.
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
@@ -216,6 +357,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		r.Providers.Radius.ApplicationID = r.Workspace.Scope + "/providers/applications.core/applications/" + r.ApplicationName
 	}
 
+	r.EnvironmentNamespace = deploy.EnvironmentNamespace(env)
+
 	if env.Properties != nil && env.Properties.Providers != nil {
 		if env.Properties.Providers.Aws != nil {
 			r.Providers.AWS = &clients.AWSProvider{
@@ -229,8 +372,6 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	r.FilePath = args[0]
-
 	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
 	if err != nil {
 		return err
@@ -250,15 +391,26 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run deploys a Bicep template into an environment from a workspace, optionally creating an application if
 // specified, and displays progress and completion messages. It returns an error if any of the operations fail.
+// In watch mode, the template is redeployed each time its files change until the command is interrupted.
 func (r *Runner) Run(ctx context.Context) error {
-	template, err := r.Bicep.PrepareTemplate(r.FilePath)
+	if r.Watch {
+		return r.watch(ctx)
+	}
+
+	template, err := r.prepareTemplate(ctx)
 	if err != nil {
 		return err
 	}
 
+	return r.deployTemplate(ctx, template)
+}
+
+// deployTemplate deploys the prepared template and records the deployment in the deployment history of the application
+// if --record is specified.
+func (r *Runner) deployTemplate(ctx context.Context, template map[string]any) error {
 	// This is the earliest point where we can inject parameters, we have
 	// to wait until the template is prepared.
-	err = r.injectAutomaticParameters(template)
+	err := r.injectAutomaticParameters(ctx, template)
 	if err != nil {
 		return err
 	}
@@ -270,8 +422,13 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.DryRun {
+		return r.previewTemplate(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
+	recordDeployment := false
 	if r.ApplicationName != "" {
 		client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
 		if err != nil {
@@ -298,6 +455,8 @@ func (r *Runner) Run(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+
+			recordDeployment = r.Record
 		}
 	}
 
@@ -305,11 +464,11 @@ func (r *Runner) Run(ctx context.Context) error {
 	if r.ApplicationName == "" {
 		progressText = fmt.Sprintf(
 			"Deploying template '%v' into environment '%v' from workspace '%v'...\n\n"+
-				"Deployment In Progress...", r.FilePath, r.EnvironmentNameOrID, r.Workspace.Name)
+				"Deployment In Progress...", r.templateName(), r.EnvironmentNameOrID, r.Workspace.Name)
 	} else {
 		progressText = fmt.Sprintf(
 			"Deploying template '%v' for application '%v' and environment '%v' from workspace '%v'...\n\n"+
-				"Deployment In Progress... ", r.FilePath, r.ApplicationName, r.EnvironmentNameOrID, r.Workspace.Name)
+				"Deployment In Progress... ", r.templateName(), r.ApplicationName, r.EnvironmentNameOrID, r.Workspace.Name)
 	}
 
 	_, err = r.Deploy.DeployWithProgress(ctx, deploy.Options{
@@ -320,15 +479,96 @@ func (r *Runner) Run(ctx context.Context) error {
 		ProgressText:      progressText,
 		CompletionText:    "Deployment Complete",
 		Providers:         r.Providers,
+		Progress:          r.Progress,
 	})
 	if err != nil {
 		return err
 	}
 
+	if recordDeployment {
+		r.recordDeployment(ctx, template)
+	}
+
 	return nil
 }
 
-func (r *Runner) injectAutomaticParameters(template map[string]any) error {
+// previewTemplate displays the changes the deployment of the prepared template would make without deploying it.
+func (r *Runner) previewTemplate(ctx context.Context, template map[string]any) error {
+	progressText := ""
+	if r.ApplicationName == "" {
+		progressText = fmt.Sprintf(
+			"Previewing the deployment of template '%v' into environment '%v' from workspace '%v'...", r.templateName(), r.EnvironmentNameOrID, r.Workspace.Name)
+	} else {
+		progressText = fmt.Sprintf(
+			"Previewing the deployment of template '%v' for application '%v' and environment '%v' from workspace '%v'...", r.templateName(), r.ApplicationName, r.EnvironmentNameOrID, r.Workspace.Name)
+	}
+
+	_, err := r.Deploy.WhatIfWithProgress(ctx, deploy.Options{
+		ConnectionFactory: r.ConnectionFactory,
+		Workspace:         *r.Workspace,
+		Template:          template,
+		Parameters:        r.Parameters,
+		ProgressText:      progressText,
+		CompletionText:    "Dry run complete. No resources were deployed.",
+		Providers:         r.Providers,
+		Progress:          r.Progress,
+	})
+	return err
+}
+
+// recordDeployment records the deployment in the deployment history of the application so it can be promoted to
+// another environment with `rad app promote`. The deployment has already succeeded, so a failure to record it is
+// reported as a warning.
+func (r *Runner) recordDeployment(ctx context.Context, template map[string]any) {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err == nil {
+		_, err = deploy.RecordDeployment(ctx, client, r.Providers.Radius.ApplicationID, r.Providers.Radius.EnvironmentID, template, r.Parameters)
+	}
+	if err != nil {
+		r.Output.LogInfo("Warning: the deployment could not be recorded in the deployment history of application %q: %v", r.ApplicationName, err)
+	}
+}
+
+// prepareTemplate returns the template to deploy, either by compiling the template file or by retrieving
+// the template spec version from the template spec library.
+func (r *Runner) prepareTemplate(ctx context.Context) (map[string]any, error) {
+	if r.TemplateID.IsEmpty() {
+		return r.Bicep.PrepareTemplate(ctx, r.FilePath)
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := client.GetTemplateSpecVersion(ctx,
+		r.TemplateID.FindScope(resources_radius.PlaneTypeRadius),
+		r.TemplateID.FindScope(resources_radius.ScopeResourceGroups),
+		r.TemplateID.Truncate().Name(),
+		r.TemplateID.Name())
+	if clients.Is404Error(err) {
+		return nil, clierrors.Message("The template spec version %q does not exist. Publish the template before deploying it.", r.TemplateID.String())
+	} else if err != nil {
+		return nil, err
+	}
+
+	if version.Properties == nil || version.Properties.Template == nil {
+		return nil, clierrors.Message("The template spec version %q does not contain a template.", r.TemplateID.String())
+	}
+
+	return version.Properties.Template, nil
+}
+
+// templateName returns the name of the template being deployed for use in messages.
+func (r *Runner) templateName() string {
+	if r.TemplateID.IsEmpty() {
+		return r.FilePath
+	}
+
+	return r.TemplateID.String()
+}
+
+func (r *Runner) injectAutomaticParameters(ctx context.Context, template map[string]any) error {
 	if r.Providers.Radius.EnvironmentID != "" {
 		err := bicep.InjectEnvironmentParam(template, r.Parameters, r.Providers.Radius.EnvironmentID)
 		if err != nil {
@@ -343,6 +583,19 @@ func (r *Runner) injectAutomaticParameters(template map[string]any) error {
 		}
 	}
 
+	if r.Providers.Radius.EnvironmentID != "" {
+		err := deploy.InjectContextParameter(ctx, deploy.ContextOptions{
+			ConnectionFactory:    r.ConnectionFactory,
+			Workspace:            *r.Workspace,
+			EnvironmentID:        r.Providers.Radius.EnvironmentID,
+			EnvironmentNamespace: r.EnvironmentNamespace,
+			ApplicationID:        r.Providers.Radius.ApplicationID,
+		}, template, r.Parameters)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -374,7 +627,7 @@ func (r *Runner) reportMissingParameters(template map[string]any) error {
 		}
 
 		// Special case the parameters that are automatically injected
-		if strings.EqualFold(parameter, "environment") {
+		if strings.EqualFold(parameter, "environment") || strings.EqualFold(parameter, bicep.DeploymentContextParam) {
 			errors[parameter] = "The template requires an environment. Use --environment to specify the environment name."
 		} else if strings.EqualFold(parameter, "application") {
 			errors[parameter] = "The template requires an application. Use --application to specify the application name."
@@ -395,5 +648,5 @@ func (r *Runner) reportMissingParameters(template map[string]any) error {
 		details = append(details, fmt.Sprintf("  - %v", errors[key]))
 	}
 
-	return clierrors.Message("The template %q could not be deployed because of the following errors:\n\n%v", r.FilePath, strings.Join(details, "\n"))
+	return clierrors.Message("The template %q could not be deployed because of the following errors:\n\n%v", r.templateName(), strings.Join(details, "\n"))
 }
@@ -18,6 +18,7 @@ package deploy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -31,11 +32,15 @@ import (
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+const testTemplateID = "/planes/radius/local/resourceGroups/shared/providers/System.Resources/templateSpecs/myapp/versions/1.0.0"
+
 func Test_CommandValidation(t *testing.T) {
 	radcli.SharedCommandValidation(t, NewCommand)
 }
@@ -99,6 +104,33 @@ func Test_Validate(t *testing.T) {
 
 			},
 		},
+		{
+			Name:          "rad deploy - valid with kubernetes environment",
+			Input:         []string{"app.bicep", "-e", "prod"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), "prod").
+					Return(v20231001preview.EnvironmentResource{
+						ID: to.Ptr("/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/environments/prod"),
+						Properties: &v20231001preview.EnvironmentProperties{
+							Compute: &v20231001preview.KubernetesCompute{
+								Kind:      to.Ptr("kubernetes"),
+								Namespace: to.Ptr("prod-ns"),
+							},
+						},
+					}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "prod-ns", r.EnvironmentNamespace)
+			},
+		},
 		{
 			Name:          "rad deploy - env does not exist invalid",
 			Input:         []string{"app.bicep", "-e", "prod"},
@@ -233,6 +265,150 @@ func Test_Validate(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			Name:          "rad deploy - valid with template id",
+			Input:         []string{"--template-id", testTemplateID},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad deploy - file and template id invalid",
+			Input:         []string{"app.bicep", "--template-id", testTemplateID},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "rad deploy - missing file and template id invalid",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "rad deploy - valid with json progress",
+			Input:         []string{"app.bicep", "--progress", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad deploy - unsupported progress invalid",
+			Input:         []string{"app.bicep", "--progress", "fancy"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "rad deploy - valid with watch",
+			Input:         []string{"app.bicep", "--watch"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad deploy - watch with template id invalid",
+			Input:         []string{"--template-id", testTemplateID, "--watch"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "rad deploy - valid with dry run",
+			Input:         []string{"app.bicep", "--dry-run"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).DryRun)
+			},
+		},
+		{
+			Name:          "rad deploy - valid with record",
+			Input:         []string{"app.bicep", "-a", "my-app", "--record"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).Record)
+			},
+		},
+		{
+			Name:          "rad deploy - record without application invalid",
+			Input:         []string{"app.bicep", "--record"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "rad deploy - dry run with watch invalid",
+			Input:         []string{"app.bicep", "--dry-run", "--watch"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "rad deploy - template id of wrong type invalid",
+			Input:         []string{"--template-id", "/planes/radius/local/resourceGroups/shared/providers/System.Resources/templateSpecs/myapp"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 	}
 
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
@@ -245,7 +421,7 @@ func Test_Run(t *testing.T) {
 
 		bicep := bicep.NewMockInterface(ctrl)
 		bicep.EXPECT().
-			PrepareTemplate("app.bicep").
+			PrepareTemplate(gomock.Any(), "app.bicep").
 			Return(map[string]any{}, nil).
 			Times(1)
 
@@ -321,7 +497,7 @@ func Test_Run(t *testing.T) {
 
 		bicep := bicep.NewMockInterface(ctrl)
 		bicep.EXPECT().
-			PrepareTemplate("app.bicep").
+			PrepareTemplate(gomock.Any(), "app.bicep").
 			Return(map[string]any{}, nil).
 			Times(1)
 
@@ -395,7 +571,7 @@ func Test_Run(t *testing.T) {
 
 		bicep := bicep.NewMockInterface(ctrl)
 		bicep.EXPECT().
-			PrepareTemplate("app.bicep").
+			PrepareTemplate(gomock.Any(), "app.bicep").
 			Return(map[string]any{}, nil).
 			Times(1)
 
@@ -411,6 +587,20 @@ func Test_Run(t *testing.T) {
 			Return(nil).
 			Times(1)
 
+		// The deployment is recorded in the deployment history of the application because --record is specified.
+		appManagmentMock.EXPECT().
+			CreateOrUpdateTemplateSpec(gomock.Any(), "local", radcli.TestEnvironmentName, "rad-deployments-test-application", gomock.Any()).
+			Return(ucp.TemplateSpecResource{}, nil).
+			Times(1)
+		appManagmentMock.EXPECT().
+			CreateOrUpdateTemplateSpecVersion(gomock.Any(), "local", radcli.TestEnvironmentName, "rad-deployments-test-application", gomock.Any(), gomock.Any()).
+			Return(ucp.TemplateSpecVersionResource{}, nil).
+			Times(1)
+		appManagmentMock.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", radcli.TestEnvironmentName, "rad-deployments-test-application").
+			Return([]ucp.TemplateSpecVersionResource{}, nil).
+			Times(1)
+
 		deployMock := deploy.NewMockInterface(ctrl)
 		deployMock.EXPECT().
 			DeployWithProgress(gomock.Any(), gomock.Any()).
@@ -447,6 +637,7 @@ func Test_Run(t *testing.T) {
 			EnvironmentNameOrID: radcli.TestEnvironmentName,
 			Parameters:          map[string]map[string]any{},
 			Workspace:           workspace,
+			Record:              true,
 		}
 
 		err := runner.Run(context.Background())
@@ -461,13 +652,127 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 
+	t.Run("Application-scoped dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate(gomock.Any(), "app.bicep").
+			Return(map[string]any{}, nil).
+			Times(1)
+
+		options := deploy.Options{}
+
+		// The application is not created and the deployment is not recorded.
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			WhatIfWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.WhatIfResult, error) {
+				options = o
+				return clients.WhatIfResult{}, nil
+			}).
+			Times(1)
+
+		providers := clients.Providers{
+			Radius: &clients.RadiusProvider{
+				EnvironmentID: fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/applications.core/environments/%s", radcli.TestEnvironmentName, radcli.TestEnvironmentName),
+				ApplicationID: fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/applications.core/environments/%s/applications/test-application", radcli.TestEnvironmentName, radcli.TestEnvironmentName),
+			},
+		}
+
+		runner := &Runner{
+			Bicep:               bicep,
+			ConnectionFactory:   &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Deploy:              deployMock,
+			Output:              &output.MockOutput{},
+			Providers:           &providers,
+			FilePath:            "app.bicep",
+			ApplicationName:     "test-application",
+			EnvironmentNameOrID: radcli.TestEnvironmentName,
+			Parameters:          map[string]map[string]any{},
+			Workspace:           &workspaces.Workspace{Name: "kind-kind"},
+			DryRun:              true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, runner.Providers.Radius.ApplicationID, options.Providers.Radius.ApplicationID)
+		require.Equal(t, runner.Providers.Radius.EnvironmentID, options.Providers.Radius.EnvironmentID)
+		require.Contains(t, options.ProgressText, "Previewing the deployment of template 'app.bicep'")
+	})
+
+	t.Run("Application-scoped deployment that cannot be recorded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate(gomock.Any(), "app.bicep").
+			Return(map[string]any{}, nil).
+			Times(1)
+
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagmentMock.EXPECT().
+			GetEnvironment(gomock.Any(), radcli.TestEnvironmentName).
+			Return(v20231001preview.EnvironmentResource{}, nil).
+			Times(1)
+		appManagmentMock.EXPECT().
+			CreateApplicationIfNotFound(gomock.Any(), "test-application", gomock.Any()).
+			Return(nil).
+			Times(1)
+		appManagmentMock.EXPECT().
+			CreateOrUpdateTemplateSpec(gomock.Any(), "local", radcli.TestEnvironmentName, "rad-deployments-test-application", gomock.Any()).
+			Return(ucp.TemplateSpecResource{}, errors.New("storage is unavailable")).
+			Times(1)
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			Return(clients.DeploymentResult{}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Bicep:             bicep,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Deploy:            deployMock,
+			Output:            outputSink,
+			Providers: &clients.Providers{
+				Radius: &clients.RadiusProvider{
+					EnvironmentID: radcli.TestEnvironmentID,
+					ApplicationID: fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/Applications.Core/applications/test-application", radcli.TestEnvironmentName),
+				},
+			},
+			FilePath:            "app.bicep",
+			ApplicationName:     "test-application",
+			EnvironmentNameOrID: radcli.TestEnvironmentName,
+			Parameters:          map[string]map[string]any{},
+			Workspace:           &workspaces.Workspace{Name: "kind-kind"},
+			Record:              true,
+		}
+
+		// The deployment succeeded, so the failure to record it is only a warning.
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Warning: the deployment could not be recorded in the deployment history of application %q: %v",
+				Params: []any{"test-application", errors.New("storage is unavailable")},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Deployment that doesn't need an app or env", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		bicep := bicep.NewMockInterface(ctrl)
 		bicep.EXPECT().
-			PrepareTemplate("app.bicep").
+			PrepareTemplate(gomock.Any(), "app.bicep").
 			Return(map[string]any{}, nil).
 			Times(1)
 
@@ -526,13 +831,93 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 
+	t.Run("Deployment from template spec version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		templateID, err := resources.ParseResource(testTemplateID)
+		require.NoError(t, err)
+
+		template := map[string]any{"resources": map[string]any{}}
+
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagmentMock.EXPECT().
+			GetTemplateSpecVersion(gomock.Any(), "local", "shared", "myapp", "1.0.0").
+			Return(ucp.TemplateSpecVersionResource{
+				Properties: &ucp.TemplateSpecVersionProperties{Template: template},
+			}, nil).
+			Times(1)
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+				require.Equal(t, template, o.Template)
+				require.Contains(t, o.ProgressText, testTemplateID)
+				return clients.DeploymentResult{}, nil
+			}).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name: "kind-kind",
+		}
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:   &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Deploy:              deployMock,
+			Output:              outputSink,
+			Providers:           &clients.Providers{Radius: &clients.RadiusProvider{}},
+			TemplateID:          templateID,
+			EnvironmentNameOrID: radcli.TestEnvironmentName,
+			Parameters:          map[string]map[string]any{},
+			Workspace:           workspace,
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("Deployment from template spec version that does not exist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		templateID, err := resources.ParseResource(testTemplateID)
+		require.NoError(t, err)
+
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagmentMock.EXPECT().
+			GetTemplateSpecVersion(gomock.Any(), "local", "shared", "myapp", "1.0.0").
+			Return(ucp.TemplateSpecVersionResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:   &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Output:              &output.MockOutput{},
+			Providers:           &clients.Providers{Radius: &clients.RadiusProvider{}},
+			TemplateID:          templateID,
+			EnvironmentNameOrID: radcli.TestEnvironmentName,
+			Parameters:          map[string]map[string]any{},
+			Workspace:           &workspaces.Workspace{Name: "kind-kind"},
+		}
+
+		err = runner.Run(context.Background())
+		require.Error(t, err)
+		require.Equal(t, fmt.Sprintf("The template spec version %q does not exist. Publish the template before deploying it.", testTemplateID), err.Error())
+	})
+
 	t.Run("Deployment with missing parameters", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		bicep := bicep.NewMockInterface(ctrl)
 		bicep.EXPECT().
-			PrepareTemplate("app.bicep").
+			PrepareTemplate(gomock.Any(), "app.bicep").
 			Return(map[string]any{
 				"parameters": map[string]any{
 					"application": map[string]any{},
@@ -600,6 +985,7 @@ func Test_injectAutomaticParameters(t *testing.T) {
 				"value": "YO",
 			},
 		},
+		Workspace: &workspaces.Workspace{},
 		Providers: &clients.Providers{
 			Radius: &clients.RadiusProvider{
 				ApplicationID: "test-app",
@@ -607,7 +993,7 @@ func Test_injectAutomaticParameters(t *testing.T) {
 			},
 		},
 	}
-	err := runner.injectAutomaticParameters(template)
+	err := runner.injectAutomaticParameters(context.Background(), template)
 	require.NoError(t, err)
 
 	expected := map[string]map[string]any{
@@ -625,6 +1011,45 @@ func Test_injectAutomaticParameters(t *testing.T) {
 	require.Equal(t, expected, runner.Parameters)
 }
 
+func Test_injectAutomaticParameters_Context(t *testing.T) {
+	template := map[string]any{
+		"parameters": map[string]any{
+			"context": map[string]any{"type": "object"},
+		},
+	}
+
+	runner := Runner{
+		EnvironmentNamespace: "test-ns",
+		Parameters:           map[string]map[string]any{},
+		Workspace:            &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+		Providers: &clients.Providers{
+			Radius: &clients.RadiusProvider{
+				EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+			},
+		},
+	}
+	err := runner.injectAutomaticParameters(context.Background(), template)
+	require.NoError(t, err)
+
+	expected := bicep.DeploymentContext{
+		Environment: bicep.DeploymentContextResource{
+			Name: "test-env",
+			ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+		},
+		ResourceGroup: bicep.DeploymentContextResource{
+			Name: "test-group",
+			ID:   "/planes/radius/local/resourceGroups/test-group",
+		},
+		Runtime: bicep.DeploymentContextRuntime{
+			Kubernetes: &bicep.DeploymentContextKubernetes{
+				Namespace:            "test-ns",
+				EnvironmentNamespace: "test-ns",
+			},
+		},
+	}
+	require.Equal(t, expected, runner.Parameters["context"]["value"])
+}
+
 func Test_reportMissingParameters(t *testing.T) {
 	template := map[string]any{
 		"parameters": map[string]any{
@@ -667,6 +1092,23 @@ func Test_reportMissingParameters(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Missing context parameter", func(t *testing.T) {
+		runner := Runner{
+			FilePath:   "app.bicep",
+			Parameters: map[string]map[string]any{},
+		}
+		err := runner.reportMissingParameters(map[string]any{
+			"parameters": map[string]any{
+				"context": map[string]any{},
+			},
+		})
+
+		expected := `The template "app.bicep" could not be deployed because of the following errors:
+
+  - The template requires an environment. Use --environment to specify the environment name.`
+		require.Equal(t, expected, err.Error())
+	})
+
 	t.Run("All parameters provided (ignoring case)", func(t *testing.T) {
 		runner := Runner{
 			FilePath: "app.bicep",
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/signal"
+	"reflect"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"golang.org/x/exp/maps"
+)
+
+// watchInterval is the interval at which the files of the template are checked for changes in watch mode.
+var watchInterval = time.Second
+
+// watch deploys the template, then redeploys it each time one of its files changes until the command is interrupted.
+// Build and deployment failures are reported without stopping the watch, so they can be fixed by editing the files.
+func (r *Runner) watch(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	snapshot := snapshotTemplateFiles(r.FilePath)
+	deployed := r.redeploy(ctx, nil)
+
+	for {
+		r.Output.LogInfo("\nWatching %s for changes. Press CTRL+C to stop.", r.FilePath)
+
+	poll:
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(watchInterval):
+			}
+
+			current := snapshotTemplateFiles(r.FilePath)
+			if !maps.Equal(snapshot, current) {
+				snapshot = current
+				break poll
+			}
+		}
+
+		r.Output.LogInfo("\nChange detected in %s.", r.FilePath)
+		deployed = r.redeploy(ctx, deployed)
+	}
+}
+
+// redeploy builds and deploys the template, and returns the template that was last deployed successfully. The changes
+// to the resources since the previous deployment are displayed, and the deployment is skipped if the template is the
+// same.
+func (r *Runner) redeploy(ctx context.Context, previous map[string]any) map[string]any {
+	template, err := r.prepareTemplate(ctx)
+	if err != nil {
+		r.Output.LogInfo("Failed to build %s: %v", r.FilePath, err)
+		return previous
+	}
+
+	if previous != nil {
+		if reflect.DeepEqual(previous, template) {
+			r.Output.LogInfo("The template has not changed since the last deployment.")
+			return previous
+		}
+
+		changes := deploy.DiffTemplates(previous, template)
+		if len(changes) > 0 {
+			r.Output.LogInfo("Resource changes:")
+			for _, change := range changes {
+				r.Output.LogInfo("  %s", change)
+			}
+			r.Output.LogInfo("")
+		}
+	}
+
+	err = r.deployTemplate(ctx, template)
+	if err != nil {
+		r.Output.LogInfo("Deployment failed: %v", err)
+		return previous
+	}
+
+	return template
+}
+
+// snapshotTemplateFiles returns a hash of the content of each file the template is built from. Missing files have
+// an empty hash.
+func snapshotTemplateFiles(filePath string) map[string]string {
+	snapshot := map[string]string{}
+	files, err := bicep.TemplateFiles(filePath)
+	if err != nil {
+		return snapshot
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			snapshot[file] = ""
+			continue
+		}
+
+		hash := sha256.Sum256(content)
+		snapshot[file] = hex.EncodeToString(hash[:])
+	}
+
+	return snapshot
+}
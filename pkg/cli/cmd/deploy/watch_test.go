@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Run_Watch(t *testing.T) {
+	interval := watchInterval
+	watchInterval = 10 * time.Millisecond
+	t.Cleanup(func() { watchInterval = interval })
+
+	ctrl := gomock.NewController(t)
+
+	filePath := filepath.Join(t.TempDir(), "app.bicep")
+	require.NoError(t, os.WriteFile(filePath, []byte("// v1"), 0644))
+
+	frontend := map[string]any{"type": "Applications.Core/containers"}
+	backend := map[string]any{"type": "Applications.Core/containers"}
+	bicepMock := bicep.NewMockInterface(ctrl)
+	gomock.InOrder(
+		bicepMock.EXPECT().
+			PrepareTemplate(gomock.Any(), filePath).
+			Return(map[string]any{"resources": map[string]any{"frontend": frontend}}, nil),
+		bicepMock.EXPECT().
+			PrepareTemplate(gomock.Any(), filePath).
+			Return(map[string]any{"resources": map[string]any{"frontend": frontend, "backend": backend}}, nil),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deployments := 0
+	deployMock := deploy.NewMockInterface(ctrl)
+	deployMock.EXPECT().
+		DeployWithProgress(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+			deployments++
+			if deployments == 1 {
+				// Change the template file while the first deployment is in progress.
+				require.NoError(t, os.WriteFile(filePath, []byte("// v2"), 0644))
+			} else {
+				cancel()
+			}
+			return clients.DeploymentResult{}, nil
+		}).
+		Times(2)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Bicep:               bicepMock,
+		Deploy:              deployMock,
+		Output:              outputSink,
+		FilePath:            filePath,
+		EnvironmentNameOrID: radcli.TestEnvironmentID,
+		Parameters:          map[string]map[string]any{},
+		Workspace:           &workspaces.Workspace{Name: "kind-kind"},
+		Providers:           &clients.Providers{Radius: &clients.RadiusProvider{}},
+		Watch:               true,
+	}
+
+	err := runner.Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, deployments)
+
+	require.Contains(t, outputSink.Writes, output.LogOutput{
+		Format: "  %s",
+		Params: []any{deploy.ResourceChange{Kind: deploy.ResourceAdded, Name: "backend", Type: "Applications.Core/containers"}},
+	})
+}
+
+func Test_redeploy_Unchanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	template := map[string]any{"resources": map[string]any{"frontend": map[string]any{"type": "Applications.Core/containers"}}}
+	bicepMock := bicep.NewMockInterface(ctrl)
+	bicepMock.EXPECT().
+		PrepareTemplate(gomock.Any(), "app.bicep").
+		Return(template, nil)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Bicep:    bicepMock,
+		Output:   outputSink,
+		FilePath: "app.bicep",
+	}
+
+	deployed := runner.redeploy(context.Background(), template)
+	require.Equal(t, template, deployed)
+	require.Equal(t, []any{output.LogOutput{Format: "The template has not changed since the last deployment."}}, outputSink.Writes)
+}
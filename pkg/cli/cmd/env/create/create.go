@@ -34,6 +34,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	kube "github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
@@ -120,6 +121,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		r.Namespace = r.EnvironmentName
 	}
 
+	// Reject invalid namespaces before the environment is created, rather than when applications are deployed.
+	if err := kube.ValidateNamespace(r.Namespace); err != nil {
+		return clierrors.Message("The namespace of environment %q is invalid: %s. Use '--namespace' to specify a valid namespace.", r.EnvironmentName, err.Error())
+	}
+
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
 	if err != nil {
 		return err
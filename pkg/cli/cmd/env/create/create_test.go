@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -106,6 +107,15 @@ func Test_Validate(t *testing.T) {
 				createMocksWithInvalidResourceGroup(mocks.Namespace, mocks.ApplicationManagementClient, testResourceGroup)
 			},
 		},
+		{
+			Name:          "Create command with namespace which is too long",
+			Input:         []string{"testingenv", "-n", strings.Repeat("namespace", 8)},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Create command with fallback workspace",
 			Input:         []string{"testingenv", "--group", *testResourceGroup.Name},
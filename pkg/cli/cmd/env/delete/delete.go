@@ -22,18 +22,24 @@ import (
 	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
 const (
-	warnDependencies   = "There are currently application(s) or resource(s) associated with this environment."
-	deleteConfirmation = "Are you sure you want to delete environment '%v'?"
+	warnDependencies            = "There are currently application(s) or resource(s) associated with this environment."
+	warnForceDelete             = "The application(s) in this environment will also be deleted."
+	deleteConfirmation          = "Are you sure you want to delete environment '%v'?"
+	applicationsInUseMsg        = "Environment '%s' cannot be deleted because it is used by application(s): %s. Delete the application(s) first, or use '--force' to delete them along with the environment."
+	applicationsInUseUnknownMsg = "Environment '%s' cannot be deleted because it is used by one or more applications. Delete the application(s) first, or use '--force' to delete them along with the environment."
 )
 
 // NewCommand creates an instance of the command and runner for the `rad env delete` command.
@@ -61,6 +67,9 @@ rad env delete my-env
 
 # Delete specified environment in a specified resource group
 rad env delete my-env --group my-env
+
+# Delete specified environment and the applications that use it
+rad env delete my-env --force
 `,
 		RunE: framework.RunCommand(runner),
 	}
@@ -70,6 +79,7 @@ rad env delete my-env --group my-env
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddConfirmationFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("force", false, "Delete the applications in the environment before deleting the environment")
 
 	return cmd, runner
 }
@@ -83,6 +93,7 @@ type Runner struct {
 	InputPrompter     prompt.Interface
 
 	Confirm         bool
+	Force           bool
 	EnvironmentName string
 	Format          string
 }
@@ -126,6 +137,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.Force, err = cmd.Flags().GetBool("force")
+	if err != nil {
+		return err
+	}
+
 	format, err := cli.RequireOutput(cmd)
 	if err != nil {
 		return err
@@ -140,7 +156,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 //
 
 // Run prompts the user to confirm the deletion of an environment, creates an applications management client, and
-// deletes the environment if confirmed. It returns an error if the prompt or client creation fails.
+// deletes the environment if confirmed. When --force is specified the applications in the environment are deleted
+// first. It returns an error if the prompt or client creation fails, or if the environment is still in use.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
 	if err != nil {
@@ -165,6 +182,10 @@ func (r *Runner) Run(ctx context.Context) error {
 			promptBuilder.WriteString(warnDependencies)
 			promptBuilder.WriteString(" ")
 		}
+		if r.Force && len(appsInEnvironment) > 0 {
+			promptBuilder.WriteString(warnForceDelete)
+			promptBuilder.WriteString(" ")
+		}
 		promptBuilder.WriteString(fmt.Sprintf(deleteConfirmation, r.EnvironmentName))
 
 		confirmed, err := prompt.YesOrNoPrompt(promptBuilder.String(), prompt.ConfirmNo, r.InputPrompter)
@@ -176,8 +197,17 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if r.Force {
+		err = r.deleteApplications(ctx, client)
+		if err != nil {
+			return err
+		}
+	}
+
 	deleted, err := client.DeleteEnvironment(ctx, r.EnvironmentName)
-	if err != nil {
+	if clients.Is409ConflictError(err) {
+		return r.applicationsInUseError(ctx, client, err)
+	} else if err != nil {
 		return err
 	}
 
@@ -189,3 +219,37 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// deleteApplications deletes the applications that use the environment.
+func (r *Runner) deleteApplications(ctx context.Context, client clients.ApplicationsManagementClient) error {
+	apps, err := client.ListResourcesOfTypeInEnvironment(ctx, r.EnvironmentName, "Applications.Core/applications")
+	if err != nil {
+		return err
+	}
+
+	for _, app := range apps {
+		r.Output.LogInfo("Deleting application '%s'", to.String(app.Name))
+		_, err = client.DeleteApplication(ctx, to.String(app.ID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applicationsInUseError returns a friendly error listing the applications that prevent the deletion of the environment.
+func (r *Runner) applicationsInUseError(ctx context.Context, client clients.ApplicationsManagementClient, cause error) error {
+	apps, err := client.ListResourcesOfTypeInEnvironment(ctx, r.EnvironmentName, "Applications.Core/applications")
+	if err != nil || len(apps) == 0 {
+		// The blocking applications may be outside of the workspace scope.
+		return clierrors.MessageWithCause(cause, applicationsInUseUnknownMsg, r.EnvironmentName)
+	}
+
+	names := []string{}
+	for _, app := range apps {
+		names = append(names, to.String(app.Name))
+	}
+
+	return clierrors.MessageWithCause(cause, applicationsInUseMsg, r.EnvironmentName, strings.Join(names, ", "))
+}
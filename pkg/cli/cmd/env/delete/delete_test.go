@@ -20,15 +20,20 @@ import (
 	"context"
 	"fmt"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"net/http"
 	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -78,6 +83,15 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Delete Command with force",
+			Input:         []string{"--force"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Delete Command with fallback workspace",
 			Input:         []string{"--environment", "test-env", "--group", "test-group"},
@@ -453,4 +467,117 @@ func Test_Show(t *testing.T) {
 		require.Equal(t, &prompt.ErrExitConsole{}, err)
 		require.Empty(t, outputSink.Writes)
 	})
+
+	t.Run("Success: Force deletes applications", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInEnvironment(gomock.Any(), "test-env", "Applications.Core/applications").
+			Return([]generated.GenericResource{
+				{
+					ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"),
+					Name: to.Ptr("test-app"),
+				},
+			}, nil).
+			Times(1)
+
+		gomock.InOrder(
+			appManagementClient.EXPECT().
+				DeleteApplication(gomock.Any(), "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app").
+				Return(true, nil).
+				Times(1),
+			appManagementClient.EXPECT().
+				DeleteEnvironment(gomock.Any(), "test-env").
+				Return(true, nil).
+				Times(1),
+		)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            outputSink,
+			EnvironmentName:   "test-env",
+			Confirm:           true,
+			Force:             true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Deleting application '%s'",
+				Params: []any{"test-app"},
+			},
+			output.LogOutput{
+				Format: "Environment deleted",
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Environment in use", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		conflict := &azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: v1.CodeConflict}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DeleteEnvironment(gomock.Any(), "test-env").
+			Return(false, conflict).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInEnvironment(gomock.Any(), "test-env", "Applications.Core/applications").
+			Return([]generated.GenericResource{
+				{Name: to.Ptr("app0")},
+				{Name: to.Ptr("app1")},
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            outputSink,
+			EnvironmentName:   "test-env",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.MessageWithCause(conflict, applicationsInUseMsg, "test-env", "app0, app1"), err)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("Error: Environment in use by applications outside of scope", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		conflict := &azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: v1.CodeConflict}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DeleteEnvironment(gomock.Any(), "test-env").
+			Return(false, conflict).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInEnvironment(gomock.Any(), "test-env", "Applications.Core/applications").
+			Return([]generated.GenericResource{}, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            &output.MockOutput{},
+			EnvironmentName:   "test-env",
+			Confirm:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.MessageWithCause(conflict, applicationsInUseUnknownMsg, "test-env"), err)
+	})
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -42,12 +43,13 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
 
 	cmd := &cobra.Command{
-		Use:     "switch [environment]",
-		Short:   "Switch the current environment",
-		Long:    "Switch the current environment",
-		Args:    cobra.MaximumNArgs(1),
-		Example: `rad env switch newEnvironment`,
-		RunE:    framework.RunCommand(runner),
+		Use:               "switch [environment]",
+		Short:             "Switch the current environment",
+		Long:              "Switch the current environment",
+		Args:              cobra.MaximumNArgs(1),
+		Example:           `rad env switch newEnvironment`,
+		RunE:              framework.RunCommand(runner),
+		ValidArgsFunction: completion.EnvironmentNames(factory),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
@@ -0,0 +1,306 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/portableresources"
+	rp_portableresources "github.com/radius-project/radius/pkg/rp/portableresources"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// environmentDocument is the graph of the applications of an environment and of their resources.
+type environmentDocument struct {
+	// Environment is the name of the environment.
+	Environment string `json:"environment"`
+
+	// Applications are the applications of the environment.
+	Applications []applicationNode `json:"applications"`
+
+	// Resources are the resources of the environment which do not belong to an application.
+	Resources []resourceNode `json:"resources"`
+}
+
+// applicationNode is an application of the environment graph.
+type applicationNode struct {
+	Name      string         `json:"name"`
+	Resources []resourceNode `json:"resources"`
+}
+
+// resourceNode is a resource of an environment.
+type resourceNode struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Application string `json:"application,omitempty"`
+
+	// Recipe is the name of the recipe the resource is provisioned with. It is empty for resources which are not
+	// provisioned with a recipe.
+	Recipe string `json:"recipe,omitempty"`
+}
+
+// recipesDocument is the graph of the recipes registered to an environment and of the resources provisioned with them.
+type recipesDocument struct {
+	// Environment is the name of the environment.
+	Environment string `json:"environment"`
+
+	// ResourceTypes are the resource types which have recipes registered or which are provisioned with recipes by the
+	// resources of the environment.
+	ResourceTypes []resourceTypeNode `json:"resourceTypes"`
+}
+
+// resourceTypeNode is a resource type of the recipes graph.
+type resourceTypeNode struct {
+	ResourceType string       `json:"resourceType"`
+	Recipes      []recipeNode `json:"recipes"`
+
+	// UnresolvedResources are the resources of the resource type provisioned with a recipe which is not registered to
+	// the environment. Their deployment fails.
+	UnresolvedResources []resourceNode `json:"unresolvedResources"`
+
+	// MissingRecipes is true if resources of the environment use the resource type and no recipe is registered for it.
+	MissingRecipes bool `json:"missingRecipes"`
+}
+
+// recipeNode is a recipe registered to the environment.
+type recipeNode struct {
+	Name            string `json:"name"`
+	TemplateKind    string `json:"templateKind"`
+	TemplatePath    string `json:"templatePath"`
+	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// Default is true for the recipe used by the resources which do not select a recipe.
+	Default bool `json:"default"`
+
+	// Resources are the resources of the environment provisioned with the recipe.
+	Resources []resourceNode `json:"resources"`
+}
+
+// buildEnvironmentDocument builds the graph of the applications of the environment and of their resources.
+// Applications are listed even if they have no resources.
+func buildEnvironmentDocument(environmentName string, applications []corerp.ApplicationResource, environmentResources []generated.GenericResource) environmentDocument {
+	document := environmentDocument{
+		Environment:  environmentName,
+		Applications: []applicationNode{},
+		Resources:    []resourceNode{},
+	}
+
+	byApplication := map[string][]resourceNode{}
+	for _, application := range applications {
+		byApplication[strings.ToLower(to.String(application.Name))] = []resourceNode{}
+	}
+
+	for _, resource := range environmentResources {
+		node := newResourceNode(resource)
+		if node.Application == "" {
+			document.Resources = append(document.Resources, node)
+			continue
+		}
+
+		key := strings.ToLower(node.Application)
+		byApplication[key] = append(byApplication[key], node)
+	}
+
+	names := map[string]string{}
+	for _, application := range applications {
+		names[strings.ToLower(to.String(application.Name))] = to.String(application.Name)
+	}
+
+	for key, nodes := range byApplication {
+		name, ok := names[key]
+		if !ok {
+			name = nodes[0].Application
+		}
+
+		sortResourceNodes(nodes)
+		document.Applications = append(document.Applications, applicationNode{Name: name, Resources: nodes})
+	}
+
+	sort.Slice(document.Applications, func(i, j int) bool {
+		return strings.ToLower(document.Applications[i].Name) < strings.ToLower(document.Applications[j].Name)
+	})
+	sortResourceNodes(document.Resources)
+
+	return document
+}
+
+// buildRecipesDocument builds the graph of the recipes registered to the environment, and resolves the recipe of each
+// resource of the environment provisioned with a recipe.
+func buildRecipesDocument(environmentName string, environment corerp.EnvironmentResource, environmentResources []generated.GenericResource) recipesDocument {
+	// Resource types are case-insensitive, the first casing seen is displayed.
+	resourceTypes := map[string]*resourceTypeNode{}
+	resourceType := func(name string) *resourceTypeNode {
+		key := strings.ToLower(name)
+		if _, ok := resourceTypes[key]; !ok {
+			resourceTypes[key] = &resourceTypeNode{
+				ResourceType:        name,
+				Recipes:             []recipeNode{},
+				UnresolvedResources: []resourceNode{},
+			}
+		}
+		return resourceTypes[key]
+	}
+
+	if environment.Properties != nil {
+		for typeName, recipes := range environment.Properties.Recipes {
+			node := resourceType(typeName)
+			for recipeName, properties := range recipes {
+				node.Recipes = append(node.Recipes, newRecipeNode(recipeName, properties))
+			}
+		}
+	}
+
+	for _, resource := range environmentResources {
+		resourceNode := newResourceNode(resource)
+		if resourceNode.Recipe == "" {
+			continue
+		}
+
+		node := resourceType(resourceNode.Type)
+		resolved := false
+		for i := range node.Recipes {
+			if strings.EqualFold(node.Recipes[i].Name, resourceNode.Recipe) {
+				node.Recipes[i].Resources = append(node.Recipes[i].Resources, resourceNode)
+				resolved = true
+				break
+			}
+		}
+
+		if !resolved {
+			node.UnresolvedResources = append(node.UnresolvedResources, resourceNode)
+		}
+	}
+
+	document := recipesDocument{
+		Environment:   environmentName,
+		ResourceTypes: []resourceTypeNode{},
+	}
+	for _, node := range resourceTypes {
+		node.MissingRecipes = len(node.Recipes) == 0 && len(node.UnresolvedResources) > 0
+
+		// The default recipe is listed first.
+		sort.Slice(node.Recipes, func(i, j int) bool {
+			if node.Recipes[i].Default != node.Recipes[j].Default {
+				return node.Recipes[i].Default
+			}
+			return node.Recipes[i].Name < node.Recipes[j].Name
+		})
+		for _, recipe := range node.Recipes {
+			sortResourceNodes(recipe.Resources)
+		}
+		sortResourceNodes(node.UnresolvedResources)
+
+		document.ResourceTypes = append(document.ResourceTypes, *node)
+	}
+
+	sort.Slice(document.ResourceTypes, func(i, j int) bool {
+		return strings.ToLower(document.ResourceTypes[i].ResourceType) < strings.ToLower(document.ResourceTypes[j].ResourceType)
+	})
+
+	return document
+}
+
+// newRecipeNode converts the properties of a recipe registered to the environment to a recipe node.
+func newRecipeNode(name string, properties corerp.RecipePropertiesClassification) recipeNode {
+	node := recipeNode{
+		Name:      name,
+		Default:   strings.EqualFold(name, portableresources.DefaultRecipeName),
+		Resources: []resourceNode{},
+	}
+
+	switch recipe := properties.(type) {
+	case *corerp.TerraformRecipeProperties:
+		node.TemplateKind = to.String(recipe.TemplateKind)
+		node.TemplatePath = to.String(recipe.TemplatePath)
+		node.TemplateVersion = to.String(recipe.TemplateVersion)
+	case *corerp.BicepRecipeProperties:
+		node.TemplateKind = to.String(recipe.TemplateKind)
+		node.TemplatePath = to.String(recipe.TemplatePath)
+		node.TemplateVersion = imageReferenceVersion(node.TemplatePath)
+	default:
+		if recipe != nil && recipe.GetRecipeProperties() != nil {
+			node.TemplateKind = to.String(recipe.GetRecipeProperties().TemplateKind)
+			node.TemplatePath = to.String(recipe.GetRecipeProperties().TemplatePath)
+		}
+	}
+
+	return node
+}
+
+// imageReferenceVersion returns the tag or the digest of the OCI image reference of a Bicep recipe, or an empty string if
+// the reference has neither.
+func imageReferenceVersion(reference string) string {
+	if _, digest, ok := strings.Cut(reference, "@"); ok {
+		return digest
+	}
+
+	// The registry host can contain a port, so the tag is looked for in the last segment of the repository.
+	lastSegment := reference[strings.LastIndex(reference, "/")+1:]
+	if _, tag, ok := strings.Cut(lastSegment, ":"); ok {
+		return tag
+	}
+
+	return ""
+}
+
+// newResourceNode converts a resource of the environment to a resource node. The recipe of portable resources is the
+// recipe they select, or the default recipe, unless they are provisioned manually.
+func newResourceNode(resource generated.GenericResource) resourceNode {
+	node := resourceNode{
+		ID:   to.String(resource.ID),
+		Name: to.String(resource.Name),
+		Type: to.String(resource.Type),
+	}
+
+	if applicationID, ok := resource.Properties["application"].(string); ok && applicationID != "" {
+		if id, err := resources.ParseResource(applicationID); err == nil {
+			node.Application = id.Name()
+		}
+	}
+
+	if !rp_portableresources.IsValidPortableResourceType(node.Type) {
+		return node
+	}
+
+	if provisioning, ok := resource.Properties["resourceProvisioning"].(string); ok && strings.EqualFold(provisioning, string(portableresources.ResourceProvisioningManual)) {
+		return node
+	}
+
+	node.Recipe = portableresources.DefaultRecipeName
+	if recipe, ok := resource.Properties["recipe"].(map[string]any); ok {
+		if name, ok := recipe["name"].(string); ok && name != "" {
+			node.Recipe = name
+		}
+	}
+
+	return node
+}
+
+// sortResourceNodes sorts resources by application, then by name.
+func sortResourceNodes(nodes []resourceNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Application != nodes[j].Application {
+			return nodes[i].Application < nodes[j].Application
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+}
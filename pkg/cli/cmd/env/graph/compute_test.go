@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+	testApplicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/todo"
+)
+
+func testResource(name string, resourceType string, properties map[string]any) generated.GenericResource {
+	return generated.GenericResource{
+		ID:         to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/" + resourceType + "/" + name),
+		Name:       to.Ptr(name),
+		Type:       to.Ptr(resourceType),
+		Properties: properties,
+	}
+}
+
+func testEnvironment() corerp.EnvironmentResource {
+	return corerp.EnvironmentResource{
+		ID:   to.Ptr(testEnvironmentID),
+		Name: to.Ptr("test-env"),
+		Properties: &corerp.EnvironmentProperties{
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				"Applications.Datastores/redisCaches": {
+					"default": &corerp.BicepRecipeProperties{
+						TemplateKind: to.Ptr("bicep"),
+						TemplatePath: to.Ptr("localhost:5000/recipes/rediscaches:1.0"),
+					},
+					"premium": &corerp.TerraformRecipeProperties{
+						TemplateKind:    to.Ptr("terraform"),
+						TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+						TemplateVersion: to.Ptr("1.2.0"),
+					},
+				},
+				"Applications.Datastores/sqlDatabases": {
+					"default": &corerp.BicepRecipeProperties{
+						TemplateKind: to.Ptr("bicep"),
+						TemplatePath: to.Ptr("ghcr.io/recipes/sqldatabases@sha256:abcd"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func testEnvironmentResources() []generated.GenericResource {
+	return []generated.GenericResource{
+		testResource("cache", "Applications.Datastores/redisCaches", map[string]any{"application": testApplicationID}),
+		testResource("fast-cache", "Applications.Datastores/redisCaches", map[string]any{"application": testApplicationID, "recipe": map[string]any{"name": "premium"}}),
+		testResource("legacy-cache", "Applications.Datastores/redisCaches", map[string]any{"application": testApplicationID, "recipe": map[string]any{"name": "legacy"}}),
+		testResource("manual-cache", "Applications.Datastores/redisCaches", map[string]any{"resourceProvisioning": "manual"}),
+		testResource("queue", "Applications.Messaging/rabbitMQQueues", map[string]any{"application": testApplicationID}),
+		testResource("frontend", "Applications.Core/containers", map[string]any{"application": testApplicationID}),
+	}
+}
+
+func Test_buildRecipesDocument(t *testing.T) {
+	document := buildRecipesDocument("test-env", testEnvironment(), testEnvironmentResources())
+
+	expected := recipesDocument{
+		Environment: "test-env",
+		ResourceTypes: []resourceTypeNode{
+			{
+				ResourceType: "Applications.Datastores/redisCaches",
+				Recipes: []recipeNode{
+					{
+						Name:            "default",
+						TemplateKind:    "bicep",
+						TemplatePath:    "localhost:5000/recipes/rediscaches:1.0",
+						TemplateVersion: "1.0",
+						Default:         true,
+						Resources: []resourceNode{
+							{
+								ID:          "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/cache",
+								Name:        "cache",
+								Type:        "Applications.Datastores/redisCaches",
+								Application: "todo",
+								Recipe:      "default",
+							},
+						},
+					},
+					{
+						Name:            "premium",
+						TemplateKind:    "terraform",
+						TemplatePath:    "Azure/redis/azurerm",
+						TemplateVersion: "1.2.0",
+						Resources: []resourceNode{
+							{
+								ID:          "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/fast-cache",
+								Name:        "fast-cache",
+								Type:        "Applications.Datastores/redisCaches",
+								Application: "todo",
+								Recipe:      "premium",
+							},
+						},
+					},
+				},
+				UnresolvedResources: []resourceNode{
+					{
+						ID:          "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/legacy-cache",
+						Name:        "legacy-cache",
+						Type:        "Applications.Datastores/redisCaches",
+						Application: "todo",
+						Recipe:      "legacy",
+					},
+				},
+			},
+			{
+				ResourceType: "Applications.Datastores/sqlDatabases",
+				Recipes: []recipeNode{
+					{
+						Name:            "default",
+						TemplateKind:    "bicep",
+						TemplatePath:    "ghcr.io/recipes/sqldatabases@sha256:abcd",
+						TemplateVersion: "sha256:abcd",
+						Default:         true,
+						Resources:       []resourceNode{},
+					},
+				},
+				UnresolvedResources: []resourceNode{},
+			},
+			{
+				ResourceType: "Applications.Messaging/rabbitMQQueues",
+				Recipes:      []recipeNode{},
+				UnresolvedResources: []resourceNode{
+					{
+						ID:          "/planes/radius/local/resourceGroups/test-group/providers/Applications.Messaging/rabbitMQQueues/queue",
+						Name:        "queue",
+						Type:        "Applications.Messaging/rabbitMQQueues",
+						Application: "todo",
+						Recipe:      "default",
+					},
+				},
+				MissingRecipes: true,
+			},
+		},
+	}
+	require.Equal(t, expected, document)
+}
+
+func Test_buildEnvironmentDocument(t *testing.T) {
+	applications := []corerp.ApplicationResource{
+		{Name: to.Ptr("todo")},
+		{Name: to.Ptr("empty")},
+	}
+
+	document := buildEnvironmentDocument("test-env", applications, testEnvironmentResources())
+	require.Equal(t, "test-env", document.Environment)
+	require.Len(t, document.Applications, 2)
+	require.Equal(t, "empty", document.Applications[0].Name)
+	require.Empty(t, document.Applications[0].Resources)
+	require.Equal(t, "todo", document.Applications[1].Name)
+
+	names := []string{}
+	for _, resource := range document.Applications[1].Resources {
+		names = append(names, resource.Name)
+	}
+	require.Equal(t, []string{"cache", "fast-cache", "frontend", "legacy-cache", "queue"}, names)
+
+	require.Len(t, document.Resources, 1)
+	require.Equal(t, "manual-cache", document.Resources[0].Name)
+	require.Empty(t, document.Resources[0].Recipe)
+}
+
+func Test_imageReferenceVersion(t *testing.T) {
+	tests := []struct {
+		reference string
+		expected  string
+	}{
+		{reference: "ghcr.io/radius-project/recipes/local-dev/rediscaches:latest", expected: "latest"},
+		{reference: "localhost:5000/recipes/rediscaches", expected: ""},
+		{reference: "localhost:5000/recipes/rediscaches:1.0", expected: "1.0"},
+		{reference: "ghcr.io/recipes/sqldatabases@sha256:abcd", expected: "sha256:abcd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			require.Equal(t, tt.expected, imageReferenceVersion(tt.reference))
+		})
+	}
+}
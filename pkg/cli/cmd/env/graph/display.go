@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+// displayEnvironment builds the formatted output for the graph of the applications of an environment as text.
+func displayEnvironment(document environmentDocument) string {
+	output := &strings.Builder{}
+	output.WriteString(fmt.Sprintf("Displaying environment: %s\n\n", document.Environment))
+
+	if len(document.Applications) == 0 && len(document.Resources) == 0 {
+		output.WriteString("(empty)\n")
+		return output.String()
+	}
+
+	for _, application := range document.Applications {
+		output.WriteString(fmt.Sprintf("Application: %s\n", application.Name))
+		if len(application.Resources) == 0 {
+			output.WriteString("  (none)\n")
+		}
+		for _, resource := range application.Resources {
+			output.WriteString(fmt.Sprintf("  %s (%s)\n", resource.Name, resource.Type))
+		}
+		output.WriteString("\n")
+	}
+
+	if len(document.Resources) > 0 {
+		output.WriteString("Resources outside of an application:\n")
+		writeResources(output, document.Resources, "  ")
+		output.WriteString("\n")
+	}
+
+	return output.String()
+}
+
+// displayRecipes builds the formatted output for the graph of the recipes of an environment as text. Resource types
+// used by the resources of the environment which have no recipe registered are reported at the end.
+func displayRecipes(document recipesDocument) string {
+	output := &strings.Builder{}
+	output.WriteString(fmt.Sprintf("Displaying recipes of environment: %s\n\n", document.Environment))
+
+	if len(document.ResourceTypes) == 0 {
+		output.WriteString("(no recipes registered)\n")
+		return output.String()
+	}
+
+	missing := []string{}
+	for _, resourceType := range document.ResourceTypes {
+		if resourceType.MissingRecipes {
+			missing = append(missing, resourceType.ResourceType)
+			output.WriteString(fmt.Sprintf("%s (no recipe registered)\n", resourceType.ResourceType))
+		} else {
+			output.WriteString(fmt.Sprintf("%s\n", resourceType.ResourceType))
+		}
+
+		for _, recipe := range resourceType.Recipes {
+			name := recipe.Name
+			if recipe.Default {
+				name += " (default)"
+			}
+
+			output.WriteString(fmt.Sprintf("  Recipe: %s\n", name))
+			output.WriteString(fmt.Sprintf("    Driver: %s\n", recipe.TemplateKind))
+			output.WriteString(fmt.Sprintf("    Template: %s\n", recipe.TemplatePath))
+			if recipe.TemplateVersion != "" {
+				output.WriteString(fmt.Sprintf("    Version: %s\n", recipe.TemplateVersion))
+			}
+
+			if len(recipe.Resources) == 0 {
+				output.WriteString("    Resources: (none)\n")
+			} else {
+				output.WriteString("    Resources:\n")
+				writeResources(output, recipe.Resources, "      ")
+			}
+		}
+
+		if len(resourceType.UnresolvedResources) > 0 {
+			output.WriteString("  Resources without a registered recipe:\n")
+			for _, resource := range resourceType.UnresolvedResources {
+				output.WriteString(fmt.Sprintf("    %s -> recipe %q is not registered\n", resourceDisplayName(resource), resource.Recipe))
+			}
+		}
+
+		output.WriteString("\n")
+	}
+
+	if len(missing) > 0 {
+		output.WriteString(fmt.Sprintf("Warning: resources of the environment use resource types which have no recipe registered: %s. Use 'rad recipe register' to register a recipe.\n", strings.Join(missing, ", ")))
+	}
+
+	return output.String()
+}
+
+// writeResources writes a line for each resource with the given indentation.
+func writeResources(output *strings.Builder, resources []resourceNode, indent string) {
+	if len(resources) == 0 {
+		output.WriteString(indent + "(none)\n")
+		return
+	}
+
+	for _, resource := range resources {
+		output.WriteString(fmt.Sprintf("%s%s (%s)\n", indent, resourceDisplayName(resource), resource.Type))
+	}
+}
+
+// resourceDisplayName returns the name of the resource, prefixed by the name of its application if it has one.
+func resourceDisplayName(resource resourceNode) string {
+	if resource.Application == "" {
+		return resource.Name
+	}
+
+	return resource.Application + "/" + resource.Name
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_displayRecipes(t *testing.T) {
+	actual := displayRecipes(buildRecipesDocument("test-env", testEnvironment(), testEnvironmentResources()))
+
+	expected := `Displaying recipes of environment: test-env
+
+Applications.Datastores/redisCaches
+  Recipe: default (default)
+    Driver: bicep
+    Template: localhost:5000/recipes/rediscaches:1.0
+    Version: 1.0
+    Resources:
+      todo/cache (Applications.Datastores/redisCaches)
+  Recipe: premium
+    Driver: terraform
+    Template: Azure/redis/azurerm
+    Version: 1.2.0
+    Resources:
+      todo/fast-cache (Applications.Datastores/redisCaches)
+  Resources without a registered recipe:
+    todo/legacy-cache -> recipe "legacy" is not registered
+
+Applications.Datastores/sqlDatabases
+  Recipe: default (default)
+    Driver: bicep
+    Template: ghcr.io/recipes/sqldatabases@sha256:abcd
+    Version: sha256:abcd
+    Resources: (none)
+
+Applications.Messaging/rabbitMQQueues (no recipe registered)
+  Resources without a registered recipe:
+    todo/queue -> recipe "default" is not registered
+
+Warning: resources of the environment use resource types which have no recipe registered: Applications.Messaging/rabbitMQQueues. Use 'rad recipe register' to register a recipe.
+`
+	require.Equal(t, expected, actual)
+}
+
+func Test_displayEnvironment(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		actual := displayEnvironment(environmentDocument{Environment: "test-env"})
+		require.Equal(t, "Displaying environment: test-env\n\n(empty)\n", actual)
+	})
+
+	t.Run("applications", func(t *testing.T) {
+		document := environmentDocument{
+			Environment: "test-env",
+			Applications: []applicationNode{
+				{Name: "empty", Resources: []resourceNode{}},
+				{Name: "todo", Resources: []resourceNode{{Name: "frontend", Type: "Applications.Core/containers", Application: "todo"}}},
+			},
+			Resources: []resourceNode{{Name: "shared-cache", Type: "Applications.Datastores/redisCaches"}},
+		}
+
+		expected := `Displaying environment: test-env
+
+Application: empty
+  (none)
+
+Application: todo
+  frontend (Applications.Core/containers)
+
+Resources outside of an application:
+  shared-cache (Applications.Datastores/redisCaches)
+
+`
+		require.Equal(t, expected, displayEnvironment(document))
+	})
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// formatText is the human-readable text format of the environment graph.
+	formatText = "text"
+
+	// formatJSON is the JSON format of the environment graph.
+	formatJSON = "json"
+)
+
+// supportedFormats are the formats supported by `rad env graph --format`.
+var supportedFormats = []string{formatText, formatJSON}
+
+// NewCommand creates an instance of the command and runner for the `rad env graph` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Shows the graph of an environment",
+		Long: `Shows the graph of an environment. Shows the user's default environment by default.
+
+By default, the applications of the environment and their resources are displayed.
+
+Use '--recipes' to display, for each resource type, the recipes registered to the environment with their driver, template and version, and the resources of the environment provisioned with each recipe. Resources which select a recipe which is not registered, and resource types used by the resources of the environment which have no recipe registered, are flagged.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Show the graph of the current environment
+rad env graph
+
+# Show the recipes of the specified environment and the resources provisioned with them
+rad env graph my-env --recipes
+
+# Export the recipes of the current environment as JSON
+rad env graph --recipes --format json`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	cmd.Flags().Bool("recipes", false, "Show the recipes registered to the environment and the resources provisioned with them")
+	cmd.Flags().String("format", formatText, fmt.Sprintf("The format of the graph. Supported formats: %s", strings.Join(supportedFormats, ", ")))
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad env graph` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	EnvironmentName string
+	Format          string
+	Recipes         bool
+}
+
+// NewRunner creates a new instance of the `rad env graph` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad env graph` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	r.Workspace.Scope, err = cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.EnvironmentName, err = cli.RequireEnvironmentNameArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Recipes, err = cmd.Flags().GetBool("recipes")
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cmd.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+
+	if r.Format != formatText && r.Format != formatJSON {
+		return clierrors.Message("The format %q is not supported. Supported formats: %s.", r.Format, strings.Join(supportedFormats, ", "))
+	}
+
+	return nil
+}
+
+// Run runs the `rad env graph` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	environment, err := client.GetEnvironment(ctx, r.EnvironmentName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q was not found or has been deleted.", r.EnvironmentName)
+	} else if err != nil {
+		return err
+	}
+
+	environmentResources, err := client.ListResourcesInEnvironment(ctx, r.EnvironmentName)
+	if err != nil {
+		return err
+	}
+
+	if r.Recipes {
+		document := buildRecipesDocument(r.EnvironmentName, environment, environmentResources)
+		if r.Format == formatJSON {
+			return r.Output.WriteFormatted(output.FormatJson, document, output.FormatterOptions{})
+		}
+
+		r.Output.LogInfo("%s", displayRecipes(document))
+		return nil
+	}
+
+	applications, err := client.ListApplications(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Applications of other environments of the scope are not part of the graph.
+	environmentApplications := []corerp.ApplicationResource{}
+	for _, application := range applications {
+		if application.Properties != nil && strings.EqualFold(to.String(application.Properties.Environment), to.String(environment.ID)) {
+			environmentApplications = append(environmentApplications, application)
+		}
+	}
+
+	document := buildEnvironmentDocument(r.EnvironmentName, environmentApplications, environmentResources)
+	if r.Format == formatJSON {
+		return r.Output.WriteFormatted(output.FormatJson, document, output.FormatterOptions{})
+	}
+
+	r.Output.LogInfo("%s", displayEnvironment(document))
+	return nil
+}
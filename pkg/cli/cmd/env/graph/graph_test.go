@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Graph Command with default environment",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.False(t, runner.(*Runner).Recipes)
+				require.Equal(t, formatText, runner.(*Runner).Format)
+			},
+		},
+		{
+			Name:          "Graph Command with recipes",
+			Input:         []string{"test-env", "--recipes", "--format", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "test-env", runner.(*Runner).EnvironmentName)
+				require.True(t, runner.(*Runner).Recipes)
+				require.Equal(t, formatJSON, runner.(*Runner).Format)
+			},
+		},
+		{
+			Name:          "Graph Command with unsupported format",
+			Input:         []string{"--format", "dot"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Graph Command with too many args",
+			Input:         []string{"foo", "bar"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	t.Run("Recipes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(testEnvironment(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesInEnvironment(gomock.Any(), "test-env").
+			Return(testEnvironmentResources(), nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         workspace,
+			EnvironmentName:   "test-env",
+			Format:            formatText,
+			Recipes:           true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{displayRecipes(buildRecipesDocument("test-env", testEnvironment(), testEnvironmentResources()))},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Applications", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		applications := []corerp.ApplicationResource{
+			{Name: to.Ptr("todo"), Properties: &corerp.ApplicationProperties{Environment: to.Ptr(testEnvironmentID)}},
+			{Name: to.Ptr("other"), Properties: &corerp.ApplicationProperties{Environment: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/other")}},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(testEnvironment(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesInEnvironment(gomock.Any(), "test-env").
+			Return(testEnvironmentResources(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListApplications(gomock.Any()).
+			Return(applications, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         workspace,
+			EnvironmentName:   "test-env",
+			Format:            formatJSON,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		document := outputSink.Writes[0].(output.FormattedOutput).Obj.(environmentDocument)
+		require.Len(t, document.Applications, 1)
+		require.Equal(t, "todo", document.Applications[0].Name)
+	})
+}
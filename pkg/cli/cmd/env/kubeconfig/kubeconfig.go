@@ -0,0 +1,382 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// execCredentialAPIVersion is the API version of the ExecCredential emitted for the exec credential plugin.
+	execCredentialAPIVersion = "client.authentication.k8s.io/v1"
+
+	defaultRole     = "edit"
+	defaultDuration = 8 * time.Hour
+)
+
+// supportedRoles are the Kubernetes built-in cluster roles which can be granted in the environment namespaces.
+var supportedRoles = []string{"edit", "view"}
+
+// NewCommand creates an instance of the command and runner for the `rad env kubeconfig` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "kubeconfig [environment]",
+		Short: "Generate a kubeconfig scoped to the namespaces of an environment",
+		Long: `Generate a kubeconfig scoped to the namespaces of an environment.
+
+The kubeconfig authenticates as a Kubernetes service account which is granted the 'edit' or 'view' cluster role only in the
+namespace of the environment and the namespaces of its applications. Developers can use the kubeconfig with kubectl without
+access to the rest of the cluster.
+
+Generating the kubeconfig requires the Kubernetes context of the workspace to be able to create service accounts, tokens
+and role bindings. The token in the kubeconfig expires after the given duration. Use '--exec' to generate a kubeconfig
+which requests a new token with 'rad env kubeconfig' whenever kubectl needs one.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Print a kubeconfig for the current environment
+rad env kubeconfig
+
+# Write a read-only kubeconfig for the 'dev' environment to a file
+rad env kubeconfig dev --role view --file ./dev.kubeconfig
+
+# Generate a kubeconfig with a token which is valid for one hour
+rad env kubeconfig dev --duration 1h
+
+# Generate a kubeconfig which uses rad as the exec credential plugin
+rad env kubeconfig dev --exec`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	cmd.Flags().String("role", defaultRole, fmt.Sprintf("The cluster role granted in the environment namespaces. Supported roles: %s.", strings.Join(supportedRoles, ", ")))
+	cmd.Flags().Duration("duration", defaultDuration, "The lifetime of the token in the kubeconfig.")
+	cmd.Flags().String("file", "", "The path of the file to write the kubeconfig to. The kubeconfig is printed when not specified.")
+	cmd.Flags().Bool("exec", false, "Generate a kubeconfig which uses 'rad env kubeconfig' as the exec credential plugin instead of a static token.")
+	cmd.Flags().Bool("exec-credential", false, "Print the ExecCredential for the exec credential plugin.")
+	_ = cmd.Flags().MarkHidden("exec-credential")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad env kubeconfig` command.
+type Runner struct {
+	ConfigHolder        *framework.ConfigHolder
+	ConnectionFactory   connections.Factory
+	KubernetesInterface kubernetes.Interface
+	Output              output.Interface
+	Workspace           *workspaces.Workspace
+
+	EnvironmentName string
+	Role            string
+	Duration        time.Duration
+	File            string
+	Exec            bool
+	ExecCredential  bool
+}
+
+// NewRunner creates a new instance of the `rad env kubeconfig` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:        factory.GetConfigHolder(),
+		ConnectionFactory:   factory.GetConnectionFactory(),
+		KubernetesInterface: factory.GetKubernetesInterface(),
+		Output:              factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad env kubeconfig` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.EnvironmentName, err = cli.RequireEnvironmentNameArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := r.Workspace.KubernetesContext(); !ok {
+		return clierrors.Message("The workspace %q does not have a Kubernetes context.", r.Workspace.Name)
+	}
+
+	r.Role, err = cmd.Flags().GetString("role")
+	if err != nil {
+		return err
+	}
+	if !isSupportedRole(r.Role) {
+		return clierrors.Message("The role %q is not supported. Supported roles: %s.", r.Role, strings.Join(supportedRoles, ", "))
+	}
+
+	r.Duration, err = cmd.Flags().GetDuration("duration")
+	if err != nil {
+		return err
+	}
+	if r.Duration < 10*time.Minute {
+		return clierrors.Message("The duration must be at least 10m.")
+	}
+
+	r.File, err = cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	r.Exec, err = cmd.Flags().GetBool("exec")
+	if err != nil {
+		return err
+	}
+
+	r.ExecCredential, err = cmd.Flags().GetBool("exec-credential")
+	if err != nil {
+		return err
+	}
+	if r.ExecCredential && (r.Exec || r.File != "") {
+		return clierrors.Message("The '--exec' and '--file' flags cannot be used with '--exec-credential'.")
+	}
+
+	return nil
+}
+
+// Run runs the `rad env kubeconfig` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	env, err := client.GetEnvironment(ctx, r.EnvironmentName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q was not found or has been deleted.", r.EnvironmentName)
+	} else if err != nil {
+		return err
+	}
+
+	namespace := ""
+	if env.Properties != nil {
+		if compute, ok := env.Properties.Compute.(*corerp.KubernetesCompute); ok {
+			namespace = to.String(compute.Namespace)
+		}
+	}
+	if namespace == "" {
+		return clierrors.Message("The environment %q does not use a Kubernetes namespace.", r.EnvironmentName)
+	}
+
+	namespaces, err := r.applicationNamespaces(ctx, client, to.String(env.ID))
+	if err != nil {
+		return err
+	}
+	namespaces = uniqueSorted(append(namespaces, namespace))
+
+	kubeContext, _ := r.Workspace.KubernetesContext()
+	credential, err := r.KubernetesInterface.CreateScopedCredential(ctx, kubeContext, kubernetes.ScopedCredentialOptions{
+		Name:        serviceAccountName(r.EnvironmentName, r.Role),
+		Namespace:   namespace,
+		Namespaces:  namespaces,
+		ClusterRole: r.Role,
+		Duration:    r.Duration,
+	})
+	if err != nil {
+		return err
+	}
+
+	if r.ExecCredential {
+		return r.writeExecCredential(credential)
+	}
+
+	config := r.buildKubeconfig(namespace, credential)
+	if r.File != "" {
+		if err := clientcmd.WriteToFile(*config, r.File); err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("Wrote kubeconfig for environment %q with access to namespaces %s to %s", r.EnvironmentName, strings.Join(namespaces, ", "), r.File)
+		return nil
+	}
+
+	content, err := clientcmd.Write(*config)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("%s", strings.TrimSuffix(string(content), "\n"))
+	return nil
+}
+
+// applicationNamespaces returns the Kubernetes namespaces of the applications in the environment.
+func (r *Runner) applicationNamespaces(ctx context.Context, client clients.ApplicationsManagementClient, environmentID string) ([]string, error) {
+	applications, err := client.ListApplications(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := []string{}
+	for _, application := range applications {
+		if application.Properties == nil || !strings.EqualFold(to.String(application.Properties.Environment), environmentID) {
+			continue
+		}
+
+		status := application.Properties.Status
+		if status == nil || status.Compute == nil {
+			continue
+		}
+
+		if compute, ok := status.Compute.(*corerp.KubernetesCompute); ok && to.String(compute.Namespace) != "" {
+			namespaces = append(namespaces, to.String(compute.Namespace))
+		}
+	}
+
+	return namespaces, nil
+}
+
+// buildKubeconfig builds the kubeconfig with a single cluster, user and context.
+func (r *Runner) buildKubeconfig(namespace string, credential *kubernetes.ScopedCredential) *api.Config {
+	name := "rad-" + r.EnvironmentName
+
+	cluster := api.NewCluster()
+	cluster.Server = credential.Server
+	cluster.CertificateAuthorityData = credential.CertificateAuthorityData
+	cluster.InsecureSkipTLSVerify = credential.InsecureSkipTLSVerify
+
+	user := api.NewAuthInfo()
+	if r.Exec {
+		user.Exec = &api.ExecConfig{
+			APIVersion:      execCredentialAPIVersion,
+			Command:         "rad",
+			Args:            r.execArgs(),
+			InteractiveMode: api.NeverExecInteractiveMode,
+		}
+	} else {
+		user.Token = credential.Token
+	}
+
+	kubeContext := api.NewContext()
+	kubeContext.Cluster = name
+	kubeContext.AuthInfo = name
+	kubeContext.Namespace = namespace
+
+	config := api.NewConfig()
+	config.Clusters[name] = cluster
+	config.AuthInfos[name] = user
+	config.Contexts[name] = kubeContext
+	config.CurrentContext = name
+
+	return config
+}
+
+// execArgs returns the arguments of rad to request the ExecCredential of the environment.
+func (r *Runner) execArgs() []string {
+	args := []string{"env", "kubeconfig", r.EnvironmentName}
+	if r.Workspace.Name != "" {
+		args = append(args, "--workspace", r.Workspace.Name)
+	}
+
+	if id, err := resources.ParseScope(r.Workspace.Scope); err == nil {
+		if group := id.FindScope(resources_radius.ScopeResourceGroups); group != "" {
+			args = append(args, "--group", group)
+		}
+	}
+
+	return append(args, "--role", r.Role, "--duration", r.Duration.String(), "--exec-credential")
+}
+
+// writeExecCredential prints the ExecCredential consumed by the Kubernetes exec credential plugin.
+func (r *Runner) writeExecCredential(credential *kubernetes.ScopedCredential) error {
+	execCredential := clientauthenticationv1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: execCredentialAPIVersion,
+			Kind:       "ExecCredential",
+		},
+		Status: &clientauthenticationv1.ExecCredentialStatus{
+			Token: credential.Token,
+		},
+	}
+	if !credential.ExpirationTimestamp.IsZero() {
+		execCredential.Status.ExpirationTimestamp = &metav1.Time{Time: credential.ExpirationTimestamp}
+	}
+
+	b, err := json.Marshal(execCredential)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("%s", string(b))
+	return nil
+}
+
+// serviceAccountName returns the name of the service account for the environment and the role.
+func serviceAccountName(environmentName string, role string) string {
+	return fmt.Sprintf("rad-%s-%s", strings.ToLower(environmentName), role)
+}
+
+func isSupportedRole(role string) bool {
+	for _, supported := range supportedRoles {
+		if role == supported {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueSorted(values []string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeconfig
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Kubeconfig Command with default environment",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, defaultRole, r.Role)
+				require.Equal(t, defaultDuration, r.Duration)
+				require.False(t, r.Exec)
+			},
+		},
+		{
+			Name:          "Kubeconfig Command with flags",
+			Input:         []string{"test-env", "--role", "view", "--duration", "1h", "--file", "env.kubeconfig", "--exec"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "test-env", r.EnvironmentName)
+				require.Equal(t, "view", r.Role)
+				require.Equal(t, time.Hour, r.Duration)
+				require.Equal(t, "env.kubeconfig", r.File)
+				require.True(t, r.Exec)
+			},
+		},
+		{
+			Name:          "Kubeconfig Command with unsupported role",
+			Input:         []string{"test-env", "--role", "cluster-admin"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Kubeconfig Command with short duration",
+			Input:         []string{"test-env", "--duration", "1m"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Kubeconfig Command with exec and exec-credential",
+			Input:         []string{"test-env", "--exec", "--exec-credential"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Kubeconfig Command with file and exec-credential",
+			Input:         []string{"test-env", "--file", "env.kubeconfig", "--exec-credential"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Kubeconfig Command with incorrect args",
+			Input:         []string{"foo", "bar"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	environment := v20231001preview.EnvironmentResource{
+		ID:   to.Ptr(testEnvironmentID),
+		Name: to.Ptr("test-env"),
+		Properties: &v20231001preview.EnvironmentProperties{
+			Compute: &v20231001preview.KubernetesCompute{
+				Kind:      to.Ptr("kubernetes"),
+				Namespace: to.Ptr("test-ns"),
+			},
+		},
+	}
+
+	applications := []v20231001preview.ApplicationResource{
+		{
+			Name: to.Ptr("app1"),
+			Properties: &v20231001preview.ApplicationProperties{
+				Environment: to.Ptr(testEnvironmentID),
+				Status: &v20231001preview.ResourceStatus{
+					Compute: &v20231001preview.KubernetesCompute{
+						Kind:      to.Ptr("kubernetes"),
+						Namespace: to.Ptr("test-ns-app1"),
+					},
+				},
+			},
+		},
+		{
+			Name: to.Ptr("other-app"),
+			Properties: &v20231001preview.ApplicationProperties{
+				Environment: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/other-env"),
+				Status: &v20231001preview.ResourceStatus{
+					Compute: &v20231001preview.KubernetesCompute{
+						Kind:      to.Ptr("kubernetes"),
+						Namespace: to.Ptr("other-ns-app"),
+					},
+				},
+			},
+		},
+	}
+
+	credential := &kubernetes.ScopedCredential{
+		Server:                   "https://kubernetes.example.com",
+		CertificateAuthorityData: []byte("ca-data"),
+		Token:                    "test-token",
+		ExpirationTimestamp:      time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	setup := func(t *testing.T) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(environment, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListApplications(gomock.Any()).
+			Return(applications, nil).
+			Times(1)
+
+		kubernetesClient := kubernetes.NewMockInterface(ctrl)
+		kubernetesClient.EXPECT().
+			CreateScopedCredential(gomock.Any(), "kind-kind", kubernetes.ScopedCredentialOptions{
+				Name:        "rad-test-env-edit",
+				Namespace:   "test-ns",
+				Namespaces:  []string{"test-ns", "test-ns-app1"},
+				ClusterRole: "edit",
+				Duration:    time.Hour,
+			}).
+			Return(credential, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:   &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			KubernetesInterface: kubernetesClient,
+			Output:              outputSink,
+			Workspace:           workspace,
+			EnvironmentName:     "test-env",
+			Role:                "edit",
+			Duration:            time.Hour,
+		}
+
+		return runner, outputSink
+	}
+
+	t.Run("Success: token kubeconfig", func(t *testing.T) {
+		runner, outputSink := setup(t)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		logOutput := outputSink.Writes[0].(output.LogOutput)
+		config, err := clientcmd.Load([]byte(logOutput.Params[0].(string)))
+		require.NoError(t, err)
+
+		require.Equal(t, "rad-test-env", config.CurrentContext)
+		require.Equal(t, "test-ns", config.Contexts["rad-test-env"].Namespace)
+		require.Equal(t, "https://kubernetes.example.com", config.Clusters["rad-test-env"].Server)
+		require.Equal(t, []byte("ca-data"), config.Clusters["rad-test-env"].CertificateAuthorityData)
+		require.Equal(t, "test-token", config.AuthInfos["rad-test-env"].Token)
+		require.Nil(t, config.AuthInfos["rad-test-env"].Exec)
+	})
+
+	t.Run("Success: exec kubeconfig written to file", func(t *testing.T) {
+		runner, outputSink := setup(t)
+		runner.Exec = true
+		runner.File = filepath.Join(t.TempDir(), "env.kubeconfig")
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Wrote kubeconfig for environment %q with access to namespaces %s to %s",
+				Params: []any{"test-env", "test-ns, test-ns-app1", runner.File},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+
+		config, err := clientcmd.LoadFromFile(runner.File)
+		require.NoError(t, err)
+
+		user := config.AuthInfos["rad-test-env"]
+		require.Empty(t, user.Token)
+		require.NotNil(t, user.Exec)
+		require.Equal(t, "rad", user.Exec.Command)
+		require.Equal(t, []string{"env", "kubeconfig", "test-env", "--workspace", "kind-kind", "--group", "test-group", "--role", "edit", "--duration", "1h0m0s", "--exec-credential"}, user.Exec.Args)
+	})
+
+	t.Run("Success: exec credential", func(t *testing.T) {
+		runner, outputSink := setup(t)
+		runner.ExecCredential = true
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{`{"kind":"ExecCredential","apiVersion":"client.authentication.k8s.io/v1","spec":{"interactive":false},"status":{"expirationTimestamp":"2023-10-01T12:00:00Z","token":"test-token"}}`},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Environment is not a Kubernetes environment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(v20231001preview.EnvironmentResource{Properties: &v20231001preview.EnvironmentProperties{}}, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			EnvironmentName:   "test-env",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The environment %q does not use a Kubernetes namespace.", "test-env"), err)
+	})
+
+	t.Run("Error: Environment Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(v20231001preview.EnvironmentResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			EnvironmentName:   "test-env",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The environment %q was not found or has been deleted.", "test-env"), err)
+	})
+}
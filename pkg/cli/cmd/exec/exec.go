@@ -0,0 +1,322 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	k8sexec "k8s.io/client-go/util/exec"
+	"k8s.io/kubectl/pkg/util/term"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	kubeutil "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const containerResourceType = "Applications.Core/containers"
+
+// defaultCommand is the command run when no command is specified.
+var defaultCommand = []string{"/bin/sh"}
+
+// executorFactory creates the executor of a command in a container of a pod.
+type executorFactory func(namespace string, pod string, options *corev1.PodExecOptions) (remotecommand.Executor, error)
+
+// NewCommand creates an instance of the `rad exec` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "exec [application] [container] [-- command...]",
+		Short: "Run a command in a Radius container",
+		Long: `Run a command in a replica of a Radius container.
+
+The container is resolved to the Kubernetes deployment Radius created for it, and the command is run in a running pod of the deployment through the Kubernetes connection of the workspace. The command is specified after '--' and defaults to '/bin/sh', which opens a shell.
+
+The input of the command is read from the terminal, and a pseudo-terminal is allocated when the input is a terminal. Specify '--replica' to choose the pod to run the command in when the container has multiple replicas.`,
+		Example: `
+# Open a shell in the 'frontend' container of the 'todo' application
+rad exec todo frontend
+
+# Run a command in the 'frontend' container
+rad exec todo frontend -- ls -l /app
+
+# Open a shell in a specific replica of the 'frontend' container
+rad exec todo frontend --replica frontend-5d8c7b9f4-x2x7q
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().String("replica", "", "The name of the pod to run the command in. Defaults to the first running pod")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad exec` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	ContainerName   string
+	Replica         string
+	Command         []string
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	kubeContext      string
+	kubernetesClient k8sclient.Interface
+	newExecutor      executorFactory
+}
+
+// NewRunner creates an instance of the runner for the `rad exec` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		Stdin:             os.Stdin,
+		Stdout:            os.Stdout,
+		Stderr:            os.Stderr,
+	}
+}
+
+// Validate runs validation for the `rad exec` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("The workspace %q does not have a Kubernetes connection. Commands can only be run in containers on Kubernetes.", r.Workspace.Name)
+	}
+	r.kubeContext = kubeContext
+
+	// The arguments after '--' are the command.
+	positional, command := args, []string{}
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		positional, command = args[:dash], args[dash:]
+	}
+
+	if len(positional) != 2 {
+		return clierrors.Message("The application and container must be specified before '--', followed by the command to run.")
+	}
+	r.ApplicationName = positional[0]
+	r.ContainerName = positional[1]
+
+	r.Command = command
+	if len(r.Command) == 0 {
+		r.Command = defaultCommand
+	}
+
+	r.Replica, err = cmd.Flags().GetString("replica")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad exec` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	container, err := client.GetResource(ctx, containerResourceType, r.ContainerName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The container %q was not found or has been deleted.", r.ContainerName)
+	} else if err != nil {
+		return err
+	}
+
+	application, _ := container.Properties["application"].(string)
+	if applicationID, err := resources.ParseResource(application); err != nil || !strings.EqualFold(applicationID.Name(), r.ApplicationName) {
+		return clierrors.Message("The container %q does not belong to the application %q.", r.ContainerName, r.ApplicationName)
+	}
+
+	deploymentID, ok := kubernetes.FindDeployment(container.Properties)
+	if !ok {
+		return clierrors.Message("The container %q does not have a Kubernetes deployment. It may not have been deployed yet.", r.ContainerName)
+	}
+
+	if r.kubernetesClient == nil {
+		clientset, config, err := kubernetes.NewClientset(r.kubeContext)
+		if err != nil {
+			return err
+		}
+		r.kubernetesClient = clientset
+		r.newExecutor = spdyExecutorFactory(clientset, config)
+	}
+
+	pod, err := r.findPod(ctx, deploymentID)
+	if err != nil {
+		return err
+	}
+
+	tty := term.TTY{In: r.Stdin, Out: r.Stdout, Raw: true}
+	options := &corev1.PodExecOptions{
+		Container: podContainerName(pod, r.ContainerName),
+		Command:   r.Command,
+		Stdin:     r.Stdin != nil,
+		Stdout:    true,
+
+		// The output and the errors are written to the same stream when a pseudo-terminal is allocated.
+		Stderr: !tty.IsTerminalIn(),
+		TTY:    tty.IsTerminalIn(),
+	}
+
+	executor, err := r.newExecutor(pod.Namespace, pod.Name, options)
+	if err != nil {
+		return err
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdout: r.Stdout,
+		Tty:    options.TTY,
+	}
+	if options.Stdin {
+		streamOptions.Stdin = r.Stdin
+	}
+	if options.Stderr {
+		streamOptions.Stderr = r.Stderr
+	}
+	if options.TTY {
+		streamOptions.TerminalSizeQueue = tty.MonitorSize(tty.GetSize())
+	}
+
+	err = tty.Safe(func() error {
+		return executor.StreamWithContext(ctx, streamOptions)
+	})
+
+	exitErr := k8sexec.CodeExitError{}
+	if errors.Is(err, context.Canceled) {
+		// context.Canceled here means the user canceled.
+		return nil
+	} else if errors.As(err, &exitErr) {
+		return clierrors.Message("The command exited with code %d.", exitErr.Code)
+	}
+
+	return err
+}
+
+// findPod returns the pod of the Kubernetes deployment of the container to run the command in.
+func (r *Runner) findPod(ctx context.Context, deploymentID resources.ID) (*corev1.Pod, error) {
+	selector, err := kubernetes.DeploymentPodSelector(ctx, r.kubernetesClient, deploymentID)
+	if apierrors.IsNotFound(err) {
+		return nil, clierrors.Message("The Kubernetes deployment %q of container %q was not found. The container may have been deleted.", deploymentID.Name(), r.ContainerName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	pods, err := r.kubernetesClient.CoreV1().Pods(deploymentID.FindScope("namespaces")).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	running := []corev1.Pod{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
+			running = append(running, pod)
+		}
+	}
+	slices.SortFunc(running, func(a, b corev1.Pod) int { return strings.Compare(a.Name, b.Name) })
+
+	if r.Replica != "" {
+		i := slices.IndexFunc(running, func(pod corev1.Pod) bool { return pod.Name == r.Replica })
+		if i < 0 {
+			return nil, clierrors.Message("The container %q does not have a running replica %q.", r.ContainerName, r.Replica)
+		}
+
+		return &running[i], nil
+	}
+
+	if len(running) == 0 {
+		return nil, clierrors.Message("The container %q does not have a running replica.", r.ContainerName)
+	}
+
+	return &running[0], nil
+}
+
+// podContainerName returns the name of the Kubernetes container of a Radius container in a pod. The Kubernetes container
+// is named after the Radius container, the first container of the pod is used otherwise.
+func podContainerName(pod *corev1.Pod, containerName string) string {
+	name := kubeutil.NormalizeResourceName(containerName)
+	for _, container := range pod.Spec.Containers {
+		if strings.EqualFold(container.Name, name) {
+			return container.Name
+		}
+	}
+
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+
+	return name
+}
+
+// spdyExecutorFactory returns an executorFactory which runs commands through the exec API of the Kubernetes pods.
+func spdyExecutorFactory(clientset k8sclient.Interface, config *rest.Config) executorFactory {
+	return func(namespace string, pod string, options *corev1.PodExecOptions) (remotecommand.Executor, error) {
+		request := clientset.CoreV1().RESTClient().
+			Post().
+			Resource("pods").
+			Namespace(namespace).
+			Name(pod).
+			SubResource("exec").
+			VersionedParams(options, scheme.ParameterCodec)
+
+		return remotecommand.NewSPDYExecutor(config, "POST", request.URL())
+	}
+}
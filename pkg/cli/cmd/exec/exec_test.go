@@ -0,0 +1,255 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/remotecommand"
+	k8sexec "k8s.io/client-go/util/exec"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testApplicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	testDeploymentID  = "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/frontend"
+)
+
+type fakeExecutor struct {
+	namespace string
+	pod       string
+	options   *corev1.PodExecOptions
+	err       error
+}
+
+func (e *fakeExecutor) Stream(options remotecommand.StreamOptions) error {
+	return e.StreamWithContext(context.Background(), options)
+}
+
+func (e *fakeExecutor) StreamWithContext(ctx context.Context, options remotecommand.StreamOptions) error {
+	if e.err != nil {
+		return e.err
+	}
+
+	_, err := options.Stdout.Write([]byte(strings.Join(e.options.Command, " ")))
+	return err
+}
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid exec command",
+			Input:         []string{"test-app", "frontend"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "test-app", r.ApplicationName)
+				require.Equal(t, "frontend", r.ContainerName)
+				require.Equal(t, []string{"/bin/sh"}, r.Command)
+				require.Empty(t, r.Replica)
+			},
+		},
+		{
+			Name:          "Valid exec command with command and replica",
+			Input:         []string{"test-app", "frontend", "--replica", "frontend-abc", "--", "ls", "-l", "/app"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, []string{"ls", "-l", "/app"}, r.Command)
+				require.Equal(t, "frontend-abc", r.Replica)
+			},
+		},
+		{
+			Name:          "Valid exec command with fallback workspace",
+			Input:         []string{"test-app", "frontend", "--group", "test-group"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "Exec command without container",
+			Input:         []string{"test-app"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Exec command with command without '--'",
+			Input:         []string{"test-app", "frontend", "ls"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newContainer := func(application string, outputResources ...string) generated.GenericResource {
+		resources := []any{}
+		for _, id := range outputResources {
+			resources = append(resources, map[string]any{"id": id})
+		}
+
+		return generated.GenericResource{
+			Name: to.Ptr("frontend"),
+			Properties: map[string]any{
+				"application": application,
+				"status":      map[string]any{"outputResources": resources},
+			},
+		}
+	}
+
+	labels := map[string]string{"radapp.io/application": "test-app", "radapp.io/resource": "frontend"}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "test-ns"},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: labels}},
+	}
+	newPod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns", Labels: labels},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: "dapr"},
+				{Name: "frontend"},
+			}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	newRunner := func(t *testing.T, container generated.GenericResource, err error) (*Runner, *fakeExecutor, *bytes.Buffer) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResource(gomock.Any(), "Applications.Core/containers", "frontend").
+			Return(container, err).
+			Times(1)
+
+		executor := &fakeExecutor{}
+		stdout := &bytes.Buffer{}
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Name: "test-workspace"},
+			ApplicationName:   "test-app",
+			ContainerName:     "frontend",
+			Command:           []string{"ls", "/app"},
+			Stdin:             &bytes.Buffer{},
+			Stdout:            stdout,
+			Stderr:            &bytes.Buffer{},
+			kubernetesClient: fake.NewSimpleClientset(
+				deployment,
+				newPod("frontend-b", corev1.PodRunning),
+				newPod("frontend-c", corev1.PodRunning),
+				newPod("frontend-a", corev1.PodPending),
+			),
+			newExecutor: func(namespace string, pod string, options *corev1.PodExecOptions) (remotecommand.Executor, error) {
+				executor.namespace = namespace
+				executor.pod = pod
+				executor.options = options
+				return executor, nil
+			},
+		}, executor, stdout
+	}
+
+	t.Run("Runs the command in the first running pod", func(t *testing.T) {
+		runner, executor, stdout := newRunner(t, newContainer(testApplicationID, testDeploymentID), nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "test-ns", executor.namespace)
+		require.Equal(t, "frontend-b", executor.pod)
+		require.Equal(t, &corev1.PodExecOptions{
+			Container: "frontend",
+			Command:   []string{"ls", "/app"},
+			Stdin:     true,
+			Stdout:    true,
+			Stderr:    true,
+		}, executor.options)
+		require.Equal(t, "ls /app", stdout.String())
+	})
+
+	t.Run("Runs the command in the replica", func(t *testing.T) {
+		runner, executor, _ := newRunner(t, newContainer(testApplicationID, testDeploymentID), nil)
+		runner.Replica = "frontend-c"
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "frontend-c", executor.pod)
+	})
+
+	t.Run("Replica not running", func(t *testing.T) {
+		runner, _, _ := newRunner(t, newContainer(testApplicationID, testDeploymentID), nil)
+		runner.Replica = "frontend-a"
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The container %q does not have a running replica %q.", "frontend", "frontend-a"), err)
+	})
+
+	t.Run("Command fails", func(t *testing.T) {
+		runner, executor, _ := newRunner(t, newContainer(testApplicationID, testDeploymentID), nil)
+		executor.err = k8sexec.CodeExitError{Code: 2}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The command exited with code %d.", 2), err)
+	})
+
+	t.Run("Container not found", func(t *testing.T) {
+		runner, _, _ := newRunner(t, generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The container %q was not found or has been deleted.", "frontend"), err)
+	})
+
+	t.Run("Container of another application", func(t *testing.T) {
+		runner, _, _ := newRunner(t, newContainer("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/other-app", testDeploymentID), nil)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The container %q does not belong to the application %q.", "frontend", "test-app"), err)
+	})
+
+	t.Run("Container without deployment", func(t *testing.T) {
+		runner, _, _ := newRunner(t, newContainer(testApplicationID), nil)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The container %q does not have a Kubernetes deployment. It may not have been deployed yet.", "frontend"), err)
+	})
+}
@@ -22,6 +22,8 @@ import (
 	group_switch "github.com/radius-project/radius/pkg/cli/cmd/group/groupswitch"
 	group_list "github.com/radius-project/radius/pkg/cli/cmd/group/list"
 	group_show "github.com/radius-project/radius/pkg/cli/cmd/group/show"
+	group_tree "github.com/radius-project/radius/pkg/cli/cmd/group/tree"
+	group_usage "github.com/radius-project/radius/pkg/cli/cmd/group/usage"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/spf13/cobra"
 )
@@ -56,6 +58,12 @@ rad group delete prod
 
 # Show details of resource group in default workspace
 rad group show dev
+
+# Show the environments, applications and resources of resource group in default workspace
+rad group tree dev
+
+# Show the quota and usage of resource group in default workspace
+rad group usage dev
 `,
 	}
 
@@ -71,6 +79,12 @@ rad group show dev
 	show, _ := group_show.NewCommand(factory)
 	cmd.AddCommand(show)
 
+	tree, _ := group_tree.NewCommand(factory)
+	cmd.AddCommand(tree)
+
+	usage, _ := group_usage.NewCommand(factory)
+	cmd.AddCommand(usage)
+
 	groupswitch, _ := group_switch.NewCommand(factory)
 	cmd.AddCommand(groupswitch)
 
@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+const (
+	rankEnvironment = iota
+	rankApplication
+	rankResource
+)
+
+// node is a node of the tree printed by `rad group tree`.
+type node struct {
+	label    string
+	rank     int
+	children []*node
+}
+
+func (n *node) add(child *node) *node {
+	n.children = append(n.children, child)
+	return child
+}
+
+// render writes the node and its children to the builder. Children are drawn with box characters, environments first,
+// then applications and resources, each sorted by label.
+func (n *node) render(b *strings.Builder) {
+	b.WriteString(n.label)
+	b.WriteString("\n")
+	n.renderChildren(b, "")
+}
+
+func (n *node) renderChildren(b *strings.Builder, prefix string) {
+	sort.SliceStable(n.children, func(i, j int) bool {
+		if n.children[i].rank != n.children[j].rank {
+			return n.children[i].rank < n.children[j].rank
+		}
+		return strings.ToLower(n.children[i].label) < strings.ToLower(n.children[j].label)
+	})
+
+	for i, child := range n.children {
+		connector, indent := "├── ", "│   "
+		if i == len(n.children)-1 {
+			connector, indent = "└── ", "    "
+		}
+
+		b.WriteString(prefix + connector + child.label + "\n")
+		child.renderChildren(b, prefix+indent)
+	}
+}
+
+// builder places environments, applications and resources under the resource group they belong to.
+type builder struct {
+	groups map[string]*node
+
+	// nodes holds the environment and application nodes by resource group and resource ID. An environment or
+	// application referenced from another resource group is shown as a separate node in that resource group.
+	nodes map[string]*node
+}
+
+// buildTree builds one tree per resource group. Applications are nested under their environment, and resources under
+// their application, or their environment if they do not belong to an application.
+func buildTree(groups []string, environments []corerpv20231001.EnvironmentResource, applications []corerpv20231001.ApplicationResource, genericResources []generated.GenericResource) []*node {
+	b := &builder{groups: map[string]*node{}, nodes: map[string]*node{}}
+
+	sort.Slice(groups, func(i, j int) bool { return strings.ToLower(groups[i]) < strings.ToLower(groups[j]) })
+	roots := []*node{}
+	for _, group := range groups {
+		root := &node{label: "Resource Group: " + group}
+		b.groups[strings.ToLower(group)] = root
+		roots = append(roots, root)
+	}
+
+	for _, environment := range environments {
+		id := to.String(environment.ID)
+		group := b.groups[strings.ToLower(resourceGroup(id))]
+		if group == nil {
+			continue
+		}
+
+		var state *corerpv20231001.ProvisioningState
+		if environment.Properties != nil {
+			state = environment.Properties.ProvisioningState
+		}
+		b.nodes[key(resourceGroup(id), id)] = group.add(&node{label: label("Environment", to.String(environment.Name), provisioningState(state)), rank: rankEnvironment})
+	}
+
+	for _, application := range applications {
+		id := to.String(application.ID)
+		group := resourceGroup(id)
+		if b.groups[strings.ToLower(group)] == nil {
+			continue
+		}
+
+		parent := b.groups[strings.ToLower(group)]
+		var state *corerpv20231001.ProvisioningState
+		if application.Properties != nil {
+			state = application.Properties.ProvisioningState
+			if environment := to.String(application.Properties.Environment); environment != "" {
+				parent = b.environment(group, environment)
+			}
+		}
+
+		b.nodes[key(group, id)] = parent.add(&node{label: label("Application", to.String(application.Name), provisioningState(state)), rank: rankApplication})
+	}
+
+	for _, resource := range genericResources {
+		id := to.String(resource.ID)
+		group := resourceGroup(id)
+		if b.groups[strings.ToLower(group)] == nil {
+			continue
+		}
+
+		parent := b.groups[strings.ToLower(group)]
+		if application, _ := resource.Properties["application"].(string); application != "" {
+			parent = b.application(group, application)
+		} else if environment, _ := resource.Properties["environment"].(string); environment != "" {
+			parent = b.environment(group, environment)
+		}
+
+		state, _ := resource.Properties["provisioningState"].(string)
+		parent.add(&node{label: label(to.String(resource.Type), to.String(resource.Name), state), rank: rankResource})
+	}
+
+	return roots
+}
+
+// environment returns the node of an environment in the given resource group. An environment of another resource group
+// is added to the given resource group, labeled with the resource group it belongs to.
+func (b *builder) environment(group string, id string) *node {
+	if existing, ok := b.nodes[key(group, id)]; ok {
+		return existing
+	}
+
+	child := &node{label: reference("Environment", id), rank: rankEnvironment}
+	b.nodes[key(group, id)] = b.groups[strings.ToLower(group)].add(child)
+	return child
+}
+
+// application returns the node of an application in the given resource group. An application of another resource group
+// is added to the given resource group, labeled with the resource group it belongs to.
+func (b *builder) application(group string, id string) *node {
+	if existing, ok := b.nodes[key(group, id)]; ok {
+		return existing
+	}
+
+	child := &node{label: reference("Application", id), rank: rankApplication}
+	b.nodes[key(group, id)] = b.groups[strings.ToLower(group)].add(child)
+	return child
+}
+
+func key(group string, id string) string {
+	return strings.ToLower(group + "|" + id)
+}
+
+func label(kind string, name string, state string) string {
+	if state == "" {
+		return fmt.Sprintf("%s: %s", kind, name)
+	}
+
+	return fmt.Sprintf("%s: %s (%s)", kind, name, state)
+}
+
+func provisioningState(state *corerpv20231001.ProvisioningState) string {
+	if state == nil {
+		return ""
+	}
+
+	return string(*state)
+}
+
+// reference returns the label of an environment or application which is referenced by ID.
+func reference(kind string, id string) string {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return fmt.Sprintf("%s: %s", kind, id)
+	}
+
+	if group := parsed.FindScope(resources_radius.ScopeResourceGroups); group != "" {
+		return fmt.Sprintf("%s: %s (resource group: %s)", kind, parsed.Name(), group)
+	}
+
+	return fmt.Sprintf("%s: %s", kind, parsed.Name())
+}
+
+// resourceGroup returns the name of the resource group of a resource ID.
+func resourceGroup(id string) string {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.FindScope(resources_radius.ScopeResourceGroups)
+}
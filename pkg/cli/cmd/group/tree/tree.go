@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// planeScope is the scope of the Radius plane the resource groups belong to.
+	planeScope = "/planes/radius/local"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad group tree` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "tree [resourcegroupname]",
+		Short: "Show the environments, applications and resources of a resource group as a tree",
+		Long: `Show the environments, applications and resources of a resource group as a tree
+
+Environments are shown at the top of the tree, followed by the applications deployed to each environment, and the resources of each application. The provisioning state of each resource is shown next to its name.
+
+Use '--all' to show every resource group of the plane.
+`,
+		Example: `
+# Show the tree of the default resource group
+rad group tree
+
+# Show the tree of a specific resource group
+rad group tree prod
+
+# Show the tree of all resource groups
+rad group tree --all
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().Bool("all", false, "Show all resource groups of the plane")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad group tree` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	// ResourceGroupName is the name of the resource group to show. Empty if All is set.
+	ResourceGroupName string
+
+	// All is set if all resource groups of the plane are shown.
+	All bool
+}
+
+// NewRunner creates a new instance of the `rad group tree` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad group tree` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.All, err = cmd.Flags().GetBool("all")
+	if err != nil {
+		return err
+	}
+
+	if r.All {
+		if len(args) > 0 {
+			return clierrors.Message("Cannot specify a resource group name with '--all'.")
+		}
+
+		r.Workspace.Scope = planeScope
+		return nil
+	}
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ResourceGroupName, err = cli.RequireResourceGroupNameArgs(cmd, args, r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	if r.ResourceGroupName == "" {
+		return clierrors.Message("No resource group set, use `--group` to pass in a resource group name.")
+	}
+	r.Workspace.Scope = planeScope + "/resourceGroups/" + r.ResourceGroupName
+
+	return nil
+}
+
+// Run runs the `rad group tree` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	groups := []string{r.ResourceGroupName}
+	if r.All {
+		resourceGroups, err := client.ListResourceGroups(ctx, "local")
+		if err != nil {
+			return err
+		}
+
+		groups = []string{}
+		for _, resourceGroup := range resourceGroups {
+			groups = append(groups, to.String(resourceGroup.Name))
+		}
+	}
+
+	environments, err := client.ListEnvironments(ctx)
+	if err != nil {
+		return err
+	}
+
+	applications, err := client.ListApplications(ctx)
+	if err != nil {
+		return err
+	}
+
+	resources := []generated.GenericResource{}
+	for _, resourceType := range clients.ResourceTypesList {
+		result, err := client.ListResourcesOfType(ctx, resourceType)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, result...)
+	}
+
+	roots := buildTree(groups, environments, applications, resources)
+
+	b := &strings.Builder{}
+	for _, root := range roots {
+		root.render(b)
+	}
+	r.Output.LogInfo("%s", strings.TrimSuffix(b.String(), "\n"))
+
+	return nil
+}
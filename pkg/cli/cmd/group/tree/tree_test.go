@@ -0,0 +1,245 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tree
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Tree Command with default resource group",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "test-resource-group", r.ResourceGroupName)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", r.Workspace.Scope)
+				require.False(t, r.All)
+			},
+		},
+		{
+			Name:          "Tree Command with resource group name",
+			Input:         []string{"prod"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "prod", r.ResourceGroupName)
+				require.Equal(t, "/planes/radius/local/resourceGroups/prod", r.Workspace.Scope)
+			},
+		},
+		{
+			Name:          "Tree Command with all resource groups",
+			Input:         []string{"--all"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.True(t, r.All)
+				require.Equal(t, "/planes/radius/local", r.Workspace.Scope)
+			},
+		},
+		{
+			Name:          "Tree Command with resource group name and all resource groups",
+			Input:         []string{"prod", "--all"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Tree Command with too many args",
+			Input:         []string{"prod", "dev"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Tree Command with bad workspace",
+			Input:         []string{"-w", "doesnotexist"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	environments := []corerpv20231001.EnvironmentResource{
+		{
+			ID:         to.Ptr("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod-env"),
+			Name:       to.Ptr("prod-env"),
+			Properties: &corerpv20231001.EnvironmentProperties{ProvisioningState: to.Ptr(corerpv20231001.ProvisioningStateSucceeded)},
+		},
+		{
+			ID:         to.Ptr("/planes/radius/local/resourceGroups/shared/providers/Applications.Core/environments/shared-env"),
+			Name:       to.Ptr("shared-env"),
+			Properties: &corerpv20231001.EnvironmentProperties{ProvisioningState: to.Ptr(corerpv20231001.ProvisioningStateSucceeded)},
+		},
+	}
+
+	applications := []corerpv20231001.ApplicationResource{
+		{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/applications/shop"),
+			Name: to.Ptr("shop"),
+			Properties: &corerpv20231001.ApplicationProperties{
+				Environment:       environments[0].ID,
+				ProvisioningState: to.Ptr(corerpv20231001.ProvisioningStateSucceeded),
+			},
+		},
+		{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/applications/blog"),
+			Name: to.Ptr("blog"),
+			Properties: &corerpv20231001.ApplicationProperties{
+				Environment:       environments[1].ID,
+				ProvisioningState: to.Ptr(corerpv20231001.ProvisioningStateFailed),
+			},
+		},
+	}
+
+	containers := []generated.GenericResource{
+		{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/frontend"),
+			Name: to.Ptr("frontend"),
+			Type: to.Ptr("Applications.Core/containers"),
+			Properties: map[string]any{
+				"application":       *applications[0].ID,
+				"provisioningState": "Succeeded",
+			},
+		},
+		{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/backend"),
+			Name: to.Ptr("backend"),
+			Type: to.Ptr("Applications.Core/containers"),
+			Properties: map[string]any{
+				"application":       *applications[0].ID,
+				"provisioningState": "Updating",
+			},
+		},
+	}
+
+	redisCaches := []generated.GenericResource{
+		{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/prod/providers/Applications.Datastores/redisCaches/cache"),
+			Name: to.Ptr("cache"),
+			Type: to.Ptr("Applications.Datastores/redisCaches"),
+			Properties: map[string]any{
+				"environment":       *environments[0].ID,
+				"provisioningState": "Succeeded",
+			},
+		},
+	}
+
+	setup := func(client *clients.MockApplicationsManagementClient) {
+		client.EXPECT().ListEnvironments(gomock.Any()).Return(environments, nil).Times(1)
+		client.EXPECT().ListApplications(gomock.Any()).Return(applications, nil).Times(1)
+		for _, resourceType := range clients.ResourceTypesList {
+			switch resourceType {
+			case "Applications.Core/containers":
+				client.EXPECT().ListResourcesOfType(gomock.Any(), resourceType).Return(containers, nil).Times(1)
+			case "Applications.Datastores/redisCaches":
+				client.EXPECT().ListResourcesOfType(gomock.Any(), resourceType).Return(redisCaches, nil).Times(1)
+			default:
+				client.EXPECT().ListResourcesOfType(gomock.Any(), resourceType).Return([]generated.GenericResource{}, nil).Times(1)
+			}
+		}
+	}
+
+	t.Run("Single resource group", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		setup(client)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/prod"},
+			Output:            outputSink,
+			ResourceGroupName: "prod",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := `Resource Group: prod
+├── Environment: prod-env (Succeeded)
+│   ├── Application: shop (Succeeded)
+│   │   ├── Applications.Core/containers: backend (Updating)
+│   │   └── Applications.Core/containers: frontend (Succeeded)
+│   └── Applications.Datastores/redisCaches: cache (Succeeded)
+└── Environment: shared-env (resource group: shared)
+    └── Application: blog (Failed)`
+		require.Equal(t, []any{output.LogOutput{Format: "%s", Params: []any{expected}}}, outputSink.Writes)
+	})
+
+	t.Run("All resource groups", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().ListResourceGroups(gomock.Any(), "local").
+			Return([]v20231001preview.ResourceGroupResource{
+				radcli.CreateResourceGroup("shared"),
+				radcli.CreateResourceGroup("prod"),
+				radcli.CreateResourceGroup("empty"),
+			}, nil).
+			Times(1)
+		setup(client)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local"},
+			Output:            outputSink,
+			All:               true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := `Resource Group: empty
+Resource Group: prod
+├── Environment: prod-env (Succeeded)
+│   ├── Application: shop (Succeeded)
+│   │   ├── Applications.Core/containers: backend (Updating)
+│   │   └── Applications.Core/containers: frontend (Succeeded)
+│   └── Applications.Datastores/redisCaches: cache (Succeeded)
+└── Environment: shared-env (resource group: shared)
+    └── Application: blog (Failed)
+Resource Group: shared
+└── Environment: shared-env (Succeeded)`
+		require.Equal(t, []any{output.LogOutput{Format: "%s", Params: []any{expected}}}, outputSink.Writes)
+	})
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad group usage` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "usage resourcegroupname",
+		Short: "Show the quota and usage of a resource group",
+		Long: `Show the quota and usage of a resource group
+
+Shows the current consumption of the quota of the resource group and of each of its environments. Usage is shown as 'used/limit', or as 'used' when no limit is set.
+
+Deployments which would exceed a quota are rejected with a QuotaExceeded error.
+`,
+		Example: `
+# Show the quota and usage of the resource group in the default workspace
+rad group usage
+
+# Show the quota and usage of a specified resource group as JSON
+rad group usage rgprod --output json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad group usage` command.
+type Runner struct {
+	ConfigHolder         *framework.ConfigHolder
+	ConnectionFactory    connections.Factory
+	Output               output.Interface
+	Workspace            *workspaces.Workspace
+	UCPResourceGroupName string
+	Format               string
+}
+
+// NewRunner creates a new instance of the `rad group usage` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad group usage` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = output.FormatTable
+	}
+
+	resourceGroup, err := cli.RequireResourceGroupNameArgs(cmd, args, workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Format = format
+	r.UCPResourceGroupName = resourceGroup
+	r.Workspace = workspace
+
+	return nil
+}
+
+// Run runs the `rad group usage` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	usage, err := client.GetResourceGroupUsage(ctx, "local", r.UCPResourceGroupName)
+	if err != nil {
+		return err
+	}
+
+	if r.Format != output.FormatTable {
+		return r.Output.WriteFormatted(r.Format, usage, output.FormatterOptions{})
+	}
+
+	return r.Output.WriteFormatted(r.Format, usageRows(r.UCPResourceGroupName, usage), usageFormat())
+}
+
+// usageRow is a row of the table output of the `rad group usage` command.
+type usageRow struct {
+	Scope                 string
+	Name                  string
+	Containers            string
+	RecipeExecutionsToday string
+	CloudResources        string
+}
+
+// usageRows converts the quota and usage of a resource group into a row for the resource group and a row for each
+// of its environments.
+func usageRows(resourceGroupName string, usage ucpv20231001preview.ResourceGroupUsage) []usageRow {
+	rows := []usageRow{newUsageRow("group", resourceGroupName, usage.Quota, usage.Usage)}
+	for _, env := range usage.Environments {
+		if env == nil {
+			continue
+		}
+
+		rows = append(rows, newUsageRow("environment", to.String(env.Name), env.Quota, env.Usage))
+	}
+
+	return rows
+}
+
+func newUsageRow(scope string, name string, quota *ucpv20231001preview.ResourceQuota, usage *ucpv20231001preview.ResourceQuotaUsage) usageRow {
+	if quota == nil {
+		quota = &ucpv20231001preview.ResourceQuota{}
+	}
+	if usage == nil {
+		usage = &ucpv20231001preview.ResourceQuotaUsage{}
+	}
+
+	return usageRow{
+		Scope:                 scope,
+		Name:                  name,
+		Containers:            formatUsage(usage.Containers, quota.MaxContainers),
+		RecipeExecutionsToday: formatUsage(usage.RecipeExecutionsToday, quota.MaxRecipeExecutionsPerDay),
+		CloudResources:        formatUsage(usage.CloudResources, quota.MaxCloudResources),
+	}
+}
+
+// formatUsage formats the usage of a limit as 'used/limit', or as 'used' when the limit is not set.
+func formatUsage(used *int32, limit *int32) string {
+	if limit == nil {
+		return fmt.Sprintf("%d", to.Int32(used))
+	}
+
+	return fmt.Sprintf("%d/%d", to.Int32(used), *limit)
+}
+
+// usageFormat returns the table columns of the `rad group usage` command.
+func usageFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "SCOPE",
+				JSONPath: "{ .Scope }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "CONTAINERS",
+				JSONPath: "{ .Containers }",
+			},
+			{
+				Heading:  "RECIPE EXECUTIONS TODAY",
+				JSONPath: "{ .RecipeExecutionsToday }",
+			},
+			{
+				Heading:  "CLOUD RESOURCES",
+				JSONPath: "{ .CloudResources }",
+			},
+		},
+	}
+}
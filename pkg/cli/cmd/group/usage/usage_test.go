@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Usage Command with no args",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Usage Command with incorrect args",
+			Input:         []string{""},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Usage Command with correct options",
+			Input:         []string{"groupname", "--output", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	usage := v20231001preview.ResourceGroupUsage{
+		Quota: &v20231001preview.ResourceQuota{
+			MaxContainers: to.Ptr(int32(10)),
+		},
+		Usage: &v20231001preview.ResourceQuotaUsage{
+			Containers:            to.Ptr(int32(3)),
+			RecipeExecutionsToday: to.Ptr(int32(4)),
+			CloudResources:        to.Ptr(int32(2)),
+		},
+		Environments: []*v20231001preview.EnvironmentQuotaUsage{
+			{
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/environments/prod"),
+				Name: to.Ptr("prod"),
+				Quota: &v20231001preview.ResourceQuota{
+					MaxRecipeExecutionsPerDay: to.Ptr(int32(5)),
+					MaxCloudResources:         to.Ptr(int32(2)),
+				},
+				Usage: &v20231001preview.ResourceQuotaUsage{
+					Containers:            to.Ptr(int32(1)),
+					RecipeExecutionsToday: to.Ptr(int32(4)),
+					CloudResources:        to.Ptr(int32(2)),
+				},
+			},
+		},
+	}
+
+	setup := func(t *testing.T, format string) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().GetResourceGroupUsage(gomock.Any(), "local", "testrg").Return(usage, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:            &workspaces.Workspace{Name: "kind-kind"},
+			UCPResourceGroupName: "testrg",
+			Format:               format,
+			Output:               outputSink,
+		}
+
+		return runner, outputSink
+	}
+
+	t.Run("table", func(t *testing.T) {
+		runner, outputSink := setup(t, "table")
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []usageRow{
+					{Scope: "group", Name: "testrg", Containers: "3/10", RecipeExecutionsToday: "4", CloudResources: "2"},
+					{Scope: "environment", Name: "prod", Containers: "1", RecipeExecutionsToday: "4/5", CloudResources: "2/2"},
+				},
+				Options: usageFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		runner, outputSink := setup(t, "json")
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     usage,
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
+
+func Test_UsageFormat(t *testing.T) {
+	rows := []usageRow{
+		{Scope: "group", Name: "testrg", Containers: "3/10", RecipeExecutionsToday: "4", CloudResources: "2"},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, rows, buffer, usageFormat())
+	require.NoError(t, err)
+
+	require.Contains(t, buffer.String(), "SCOPE")
+	require.Contains(t, buffer.String(), "RECIPE EXECUTIONS TODAY")
+	require.Contains(t, buffer.String(), "3/10")
+}
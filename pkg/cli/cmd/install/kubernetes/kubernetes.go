@@ -19,9 +19,11 @@ package kubernetes
 import (
 	"context"
 
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/localcluster"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/version"
 	"github.com/spf13/cobra"
@@ -44,6 +46,9 @@ By default 'rad install kubernetes' will install Radius with the version matchin
 Radius will be installed in the 'radius-system' namespace. For more information visit https://docs.radapp.io/concepts/technical/architecture/
 
 Overrides can be set by specifying Helm chart values with the '--set' flag. For more information visit https://docs.radapp.io/guides/operations/kubernetes/install/.
+The overrides are validated against the values schema of the Helm chart before installing.
+
+Use '--render-only' to write the manifests that would be applied to the cluster for review, without installing Radius.
 `,
 		Example: `# Install Radius with default settings in current Kubernetes context
 rad install kubernetes
@@ -68,6 +73,15 @@ rad install kubernetes --chart /root/radius/deploy/Chart
 
 # Force re-install Radius with latest version
 rad install kubernetes --reinstall
+
+# Validate the overrides and write the manifests that would be applied to the cluster, without installing Radius
+rad install kubernetes --set rp.tag=latest --render-only > radius.yaml
+
+# Create a local kind cluster with a container registry and ingress configured, then install Radius
+rad install kubernetes --kind
+
+# Create a local k3d cluster named 'dev', then install Radius
+rad install kubernetes --k3d --cluster-name dev
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -78,20 +92,29 @@ rad install kubernetes --reinstall
 	cmd.Flags().StringVar(&runner.Chart, "chart", "", "Specify a file path to a helm chart to install Radius from")
 	cmd.Flags().StringArrayVar(&runner.Set, "set", []string{}, "Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
 	cmd.Flags().StringArrayVar(&runner.SetFile, "set-file", []string{}, "Set values from files on the command line (can specify multiple or separate files with commas: key1=filename1,key2=filename2)")
+	cmd.Flags().BoolVar(&runner.Kind, "kind", false, "Create a local kind cluster with a container registry and ingress configured before installing Radius")
+	cmd.Flags().BoolVar(&runner.K3d, "k3d", false, "Create a local k3d cluster with a container registry and ingress configured before installing Radius")
+	cmd.Flags().StringVar(&runner.ClusterName, "cluster-name", localcluster.DefaultClusterName, "The name of the local cluster created by '--kind' or '--k3d'")
+	cmd.Flags().BoolVar(&runner.RenderOnly, "render-only", false, "Write the manifests that would be applied to the cluster instead of installing Radius")
 
 	return cmd, runner
 }
 
 // Runner is the Runner implementation for the `rad install kubernetes` command.
 type Runner struct {
-	Helm   helm.Interface
-	Output output.Interface
+	Helm         helm.Interface
+	LocalCluster localcluster.Interface
+	Output       output.Interface
 
 	KubeContext string
 	Chart       string
 	Reinstall   bool
 	Set         []string
 	SetFile     []string
+	Kind        bool
+	K3d         bool
+	ClusterName string
+	RenderOnly  bool
 }
 
 // NewRunner creates an instance of the runner for the `rad install kubernetes` command.
@@ -101,22 +124,40 @@ type Runner struct {
 // objects returned by the Factory's GetHelmInterface and GetOutput methods respectively.
 func NewRunner(factory framework.Factory) *Runner {
 	return &Runner{
-		Helm:   factory.GetHelmInterface(),
-		Output: factory.GetOutput(),
+		Helm:         factory.GetHelmInterface(),
+		LocalCluster: localcluster.NewImpl(),
+		Output:       factory.GetOutput(),
 	}
 }
 
 // Validate runs validation for the `rad install kubernetes` command.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	if r.Kind && r.K3d {
+		return clierrors.Message("Specify either '--kind' or '--k3d', but not both.")
+	}
+
+	if (r.Kind || r.K3d) && r.KubeContext != "" {
+		return clierrors.Message("The '--kubecontext' flag cannot be used with '--kind' or '--k3d'. The context of the local cluster is used instead.")
+	}
+
+	if !r.Kind && !r.K3d && cmd.Flags().Changed("cluster-name") {
+		return clierrors.Message("The '--cluster-name' flag can only be used with '--kind' or '--k3d'.")
+	}
+
+	if r.RenderOnly && (r.Kind || r.K3d) {
+		return clierrors.Message("The '--render-only' flag cannot be used with '--kind' or '--k3d'.")
+	}
+
 	return nil
 }
 
 // Run runs the `rad install kubernetes` command.
 //
 
-// Run checks if a Radius installation exists, and if it does, it either skips the installation or reinstalls it
-// depending on the "Reinstall" flag. If no installation is found, it installs the version of Radius corresponding
-// to the cli version. It then returns any errors that occur during the installation.
+// Run validates the values set by the user, then checks if a Radius installation exists, and if it does, it either
+// skips the installation or reinstalls it depending on the "Reinstall" flag. If no installation is found, it installs
+// the version of Radius corresponding to the cli version. It then returns any errors that occur during the installation.
+// If the "RenderOnly" flag is set, it writes the manifests that would be installed instead.
 func (r *Runner) Run(ctx context.Context) error {
 	cliOptions := helm.CLIClusterOptions{
 		Radius: helm.ChartOptions{
@@ -127,6 +168,42 @@ func (r *Runner) Run(ctx context.Context) error {
 		},
 	}
 
+	provider := r.localClusterProvider()
+	clusterOptions := helm.PopulateDefaultClusterOptions(cliOptions)
+
+	// kind maps the ingress port of the host to the node, so Contour must listen on the node using host networking.
+	clusterOptions.Contour.HostNetwork = provider == localcluster.ProviderKind
+
+	if r.RenderOnly {
+		manifest, err := r.Helm.RenderRadius(ctx, clusterOptions, r.KubeContext)
+		if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("%s", manifest)
+		return nil
+	}
+
+	// Validate the values before creating a local cluster or contacting the cluster so that invalid values fail fast.
+	err := r.Helm.ValidateRadiusValues(ctx, clusterOptions, r.KubeContext)
+	if err != nil {
+		return err
+	}
+
+	if provider != "" {
+		r.Output.LogInfo("Creating local %s cluster %q...", provider, r.ClusterName)
+		result, err := r.LocalCluster.Create(ctx, localcluster.Options{Provider: provider, ClusterName: r.ClusterName})
+		if err != nil {
+			return err
+		}
+
+		if !result.Created {
+			r.Output.LogInfo("Using existing local %s cluster %q.", provider, r.ClusterName)
+		}
+		r.Output.LogInfo("Push images to the local registry at %s.", result.Registry)
+		r.KubeContext = result.KubeContext
+	}
+
 	state, err := r.Helm.CheckRadiusInstall(r.KubeContext)
 	if err != nil {
 		return err
@@ -144,7 +221,6 @@ func (r *Runner) Run(ctx context.Context) error {
 		r.Output.LogInfo("Installing Radius version %s to namespace: %s...", version, helm.RadiusSystemNamespace)
 	}
 
-	clusterOptions := helm.PopulateDefaultClusterOptions(cliOptions)
 	_, err = r.Helm.InstallRadius(ctx, clusterOptions, r.KubeContext)
 	if err != nil {
 		return err
@@ -152,3 +228,14 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+func (r *Runner) localClusterProvider() localcluster.Provider {
+	switch {
+	case r.Kind:
+		return localcluster.ProviderKind
+	case r.K3d:
+		return localcluster.ProviderK3d
+	default:
+		return ""
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/localcluster"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
@@ -43,11 +45,46 @@ func Test_Validate(t *testing.T) {
 			Input:         []string{"--reinstall", "--kubecontext", "foo", "--chart", "test-chart-path", "--set", "foo=bar", "--set", "bar=baz"},
 			ExpectedValid: true,
 		},
+		{
+			Name:          "valid (kind)",
+			Input:         []string{"--kind", "--cluster-name", "dev"},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "valid (k3d)",
+			Input:         []string{"--k3d"},
+			ExpectedValid: true,
+		},
 		{
 			Name:          "too many args",
 			Input:         []string{"blah"},
 			ExpectedValid: false,
 		},
+		{
+			Name:          "kind and k3d",
+			Input:         []string{"--kind", "--k3d"},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "kind with kubecontext",
+			Input:         []string{"--kind", "--kubecontext", "foo"},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "cluster name without local cluster",
+			Input:         []string{"--cluster-name", "dev"},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "valid (render only)",
+			Input:         []string{"--render-only", "--set", "foo=bar"},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "render only with kind",
+			Input:         []string{"--render-only", "--kind"},
+			ExpectedValid: false,
+		},
 	}
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
 }
@@ -68,6 +105,10 @@ func Test_Run(t *testing.T) {
 			Set:         []string{"foo=bar", "bar=baz"},
 		}
 
+		helmMock.EXPECT().ValidateRadiusValues(ctx, gomock.Any(), "test-context").
+			Return(nil).
+			Times(1)
+
 		helmMock.EXPECT().CheckRadiusInstall("test-context").
 			Return(helm.InstallState{}, nil).
 			Times(1)
@@ -108,6 +149,10 @@ func Test_Run(t *testing.T) {
 			Set:         []string{"foo=bar", "bar=baz"},
 		}
 
+		helmMock.EXPECT().ValidateRadiusValues(ctx, gomock.Any(), "test-context").
+			Return(nil).
+			Times(1)
+
 		helmMock.EXPECT().CheckRadiusInstall("test-context").
 			Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "test-version"}, nil).
 			Times(1)
@@ -138,6 +183,10 @@ func Test_Run(t *testing.T) {
 			Reinstall:   true,
 		}
 
+		helmMock.EXPECT().ValidateRadiusValues(ctx, gomock.Any(), "test-context").
+			Return(nil).
+			Times(1)
+
 		helmMock.EXPECT().CheckRadiusInstall("test-context").
 			Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "test-version"}, nil).
 			Times(1)
@@ -164,4 +213,165 @@ func Test_Run(t *testing.T) {
 		}
 		require.Equal(t, expectedWrites, outputMock.Writes)
 	})
+	t.Run("Success: Install with kind", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		localClusterMock := localcluster.NewMockInterface(ctrl)
+		outputMock := &output.MockOutput{}
+
+		ctx := context.Background()
+		runner := &Runner{
+			Helm:         helmMock,
+			LocalCluster: localClusterMock,
+			Output:       outputMock,
+
+			Kind:        true,
+			ClusterName: "dev",
+		}
+
+		localClusterMock.EXPECT().Create(ctx, localcluster.Options{Provider: localcluster.ProviderKind, ClusterName: "dev"}).
+			Return(localcluster.Result{KubeContext: "kind-dev", Registry: "localhost:5001", Created: true}, nil).
+			Times(1)
+
+		helmMock.EXPECT().ValidateRadiusValues(ctx, gomock.Any(), "").
+			Return(nil).
+			Times(1)
+
+		helmMock.EXPECT().CheckRadiusInstall("kind-dev").
+			Return(helm.InstallState{}, nil).
+			Times(1)
+
+		expectedOptions := helm.PopulateDefaultClusterOptions(helm.CLIClusterOptions{})
+		expectedOptions.Contour.HostNetwork = true
+		helmMock.EXPECT().InstallRadius(ctx, expectedOptions, "kind-dev").
+			Return(true, nil).
+			Times(1)
+
+		err := runner.Run(ctx)
+		require.NoError(t, err)
+
+		expectedWrites := []any{
+			output.LogOutput{
+				Format: "Creating local %s cluster %q...",
+				Params: []interface{}{localcluster.ProviderKind, "dev"},
+			},
+			output.LogOutput{
+				Format: "Push images to the local registry at %s.",
+				Params: []interface{}{"localhost:5001"},
+			},
+			output.LogOutput{
+				Format: "Installing Radius version %s to namespace: %s...",
+				Params: []interface{}{"edge", "radius-system"},
+			},
+		}
+		require.Equal(t, expectedWrites, outputMock.Writes)
+	})
+	t.Run("Success: Install with existing k3d cluster", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		localClusterMock := localcluster.NewMockInterface(ctrl)
+		outputMock := &output.MockOutput{}
+
+		ctx := context.Background()
+		runner := &Runner{
+			Helm:         helmMock,
+			LocalCluster: localClusterMock,
+			Output:       outputMock,
+
+			K3d:         true,
+			ClusterName: "radius",
+		}
+
+		localClusterMock.EXPECT().Create(ctx, localcluster.Options{Provider: localcluster.ProviderK3d, ClusterName: "radius"}).
+			Return(localcluster.Result{KubeContext: "k3d-radius", Registry: "localhost:5001"}, nil).
+			Times(1)
+
+		helmMock.EXPECT().ValidateRadiusValues(ctx, gomock.Any(), "").
+			Return(nil).
+			Times(1)
+
+		helmMock.EXPECT().CheckRadiusInstall("k3d-radius").
+			Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "test-version"}, nil).
+			Times(1)
+
+		err := runner.Run(ctx)
+		require.NoError(t, err)
+
+		expectedWrites := []any{
+			output.LogOutput{
+				Format: "Creating local %s cluster %q...",
+				Params: []interface{}{localcluster.ProviderK3d, "radius"},
+			},
+			output.LogOutput{
+				Format: "Using existing local %s cluster %q.",
+				Params: []interface{}{localcluster.ProviderK3d, "radius"},
+			},
+			output.LogOutput{
+				Format: "Push images to the local registry at %s.",
+				Params: []interface{}{"localhost:5001"},
+			},
+			output.LogOutput{
+				Format: "Found existing Radius installation. Use '--reinstall' to force reinstallation.",
+			},
+		}
+		require.Equal(t, expectedWrites, outputMock.Writes)
+	})
+	t.Run("Success: Render only", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		outputMock := &output.MockOutput{}
+
+		ctx := context.Background()
+		runner := &Runner{
+			Helm:   helmMock,
+			Output: outputMock,
+
+			KubeContext: "test-context",
+			Set:         []string{"foo=bar"},
+			RenderOnly:  true,
+		}
+
+		expectedOptions := helm.PopulateDefaultClusterOptions(helm.CLIClusterOptions{
+			Radius: helm.ChartOptions{
+				SetArgs: []string{"foo=bar"},
+			},
+		})
+		helmMock.EXPECT().RenderRadius(ctx, expectedOptions, "test-context").
+			Return("apiVersion: v1\nkind: Namespace\n", nil).
+			Times(1)
+
+		err := runner.Run(ctx)
+		require.NoError(t, err)
+
+		expectedWrites := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []interface{}{"apiVersion: v1\nkind: Namespace\n"},
+			},
+		}
+		require.Equal(t, expectedWrites, outputMock.Writes)
+	})
+	t.Run("Error: Invalid values", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		outputMock := &output.MockOutput{}
+
+		ctx := context.Background()
+		runner := &Runner{
+			Helm:   helmMock,
+			Output: outputMock,
+
+			KubeContext: "test-context",
+			Set:         []string{"global.prometheus.port=metrics"},
+		}
+
+		expectedErr := clierrors.Message("The values of the radius Helm chart do not match the values schema of the chart")
+		helmMock.EXPECT().ValidateRadiusValues(ctx, gomock.Any(), "test-context").
+			Return(expectedErr).
+			Times(1)
+
+		err := runner.Run(ctx)
+		require.Equal(t, expectedErr, err)
+		require.Empty(t, outputMock.Writes)
+	})
 }
@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sclient "k8s.io/client-go/kubernetes"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/kubernetes/logstream"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const containerResourceType = "Applications.Core/containers"
+
+// NewCommand creates an instance of the `rad logs` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "logs [application] [container]",
+		Short: "Show the logs of a Radius container",
+		Long: `Show the logs of the replicas of a Radius container.
+
+The container is resolved to the Kubernetes deployment Radius created for it, and the logs of the pods of the deployment are read through the Kubernetes connection of the workspace. Each line is prefixed with the name of the pod and of the Kubernetes container.
+
+Specify the '--follow' option to stream new logs as they are emitted, including the logs of replicas started later. Press CTRL+C to stop streaming.`,
+		Example: `
+# Show the logs of the 'frontend' container of the 'todo' application
+rad logs todo frontend
+
+# Stream the logs of the 'frontend' container
+rad logs todo frontend --follow
+
+# Show the logs of the last 10 minutes
+rad logs todo frontend --since 10m
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().BoolP("follow", "f", false, "Stream new logs until the command is canceled")
+	cmd.Flags().Duration("since", 0, "Only show logs newer than a relative duration, such as 5s, 2m or 3h. Defaults to 48h")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad logs` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Logstream         logstream.Interface
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	ContainerName   string
+	Follow          bool
+	Since           time.Duration
+
+	kubeContext      string
+	kubernetesClient k8sclient.Interface
+}
+
+// NewRunner creates an instance of the runner for the `rad logs` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Logstream:         factory.GetLogstream(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad logs` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("The workspace %q does not have a Kubernetes connection. Logs can only be read from Kubernetes.", r.Workspace.Name)
+	}
+	r.kubeContext = kubeContext
+
+	r.ApplicationName = args[0]
+	r.ContainerName = args[1]
+
+	r.Follow, err = cmd.Flags().GetBool("follow")
+	if err != nil {
+		return err
+	}
+
+	r.Since, err = cmd.Flags().GetDuration("since")
+	if err != nil {
+		return err
+	} else if r.Since < 0 {
+		return clierrors.Message("The value of '--since' must not be negative.")
+	}
+
+	return nil
+}
+
+// Run runs the `rad logs` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	container, err := client.GetResource(ctx, containerResourceType, r.ContainerName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The container %q was not found or has been deleted.", r.ContainerName)
+	} else if err != nil {
+		return err
+	}
+
+	if application := applicationName(container.Properties); !strings.EqualFold(application, r.ApplicationName) {
+		return clierrors.Message("The container %q does not belong to the application %q.", r.ContainerName, r.ApplicationName)
+	}
+
+	deploymentID, ok := kubernetes.FindDeployment(container.Properties)
+	if !ok {
+		return clierrors.Message("The container %q does not have a Kubernetes deployment. It may not have been deployed yet.", r.ContainerName)
+	}
+
+	if r.kubernetesClient == nil {
+		r.kubernetesClient, _, err = kubernetes.NewClientset(r.kubeContext)
+		if err != nil {
+			return err
+		}
+	}
+
+	selector, err := r.podSelector(ctx, deploymentID)
+	if err != nil {
+		return err
+	}
+
+	if r.Follow {
+		r.Output.LogInfo("Streaming logs of container %q. Press CTRL+C to exit...", r.ContainerName)
+	}
+
+	err = r.Logstream.Stream(ctx, logstream.Options{
+		ApplicationName: r.ApplicationName,
+		Namespace:       deploymentID.FindScope("namespaces"),
+		LabelSelector:   selector,
+		Since:           r.Since,
+		Follow:          r.Follow,
+		KubeClient:      r.kubernetesClient,
+
+		// Logs are written directly to stdout, like the logs streamed by 'rad run'.
+		Out: os.Stdout,
+	})
+
+	// context.Canceled here means the user canceled.
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return err
+}
+
+// podSelector returns the label selector of the pods of the Kubernetes deployment of the container.
+func (r *Runner) podSelector(ctx context.Context, deploymentID resources.ID) (labels.Selector, error) {
+	selector, err := kubernetes.DeploymentPodSelector(ctx, r.kubernetesClient, deploymentID)
+	if apierrors.IsNotFound(err) {
+		return nil, clierrors.Message("The Kubernetes deployment %q of container %q was not found. The container may have been deleted.", deploymentID.Name(), r.ContainerName)
+	}
+
+	return selector, err
+}
+
+// applicationName returns the name of the application of a resource, or an empty string if the resource is not part
+// of an application.
+func applicationName(properties map[string]any) string {
+	application, _ := properties["application"].(string)
+	id, err := resources.ParseResource(application)
+	if err != nil {
+		return ""
+	}
+
+	return id.Name()
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes/logstream"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testApplicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	testDeploymentID  = "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/frontend"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid logs command",
+			Input:         []string{"test-app", "frontend"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "test-app", r.ApplicationName)
+				require.Equal(t, "frontend", r.ContainerName)
+				require.False(t, r.Follow)
+				require.Zero(t, r.Since)
+			},
+		},
+		{
+			Name:          "Valid logs command with follow and since",
+			Input:         []string{"test-app", "frontend", "--follow", "--since", "10m"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.True(t, r.Follow)
+				require.Equal(t, 10*time.Minute, r.Since)
+			},
+		},
+		{
+			Name:          "Valid logs command with fallback workspace",
+			Input:         []string{"test-app", "frontend", "--group", "test-group"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "Logs command without container",
+			Input:         []string{"test-app"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Logs command with negative since",
+			Input:         []string{"test-app", "frontend", "--since", "-5m"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newContainer := func(application string, outputResources ...string) generated.GenericResource {
+		resources := []any{}
+		for _, id := range outputResources {
+			resources = append(resources, map[string]any{"id": id})
+		}
+
+		return generated.GenericResource{
+			Name: to.Ptr("frontend"),
+			Properties: map[string]any{
+				"application": application,
+				"status":      map[string]any{"outputResources": resources},
+			},
+		}
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "test-ns"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{
+				"radapp.io/application": "test-app",
+				"radapp.io/resource":    "frontend",
+			}},
+		},
+	}
+
+	newRunner := func(t *testing.T, container generated.GenericResource, err error) (*Runner, *logstream.MockInterface, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResource(gomock.Any(), "Applications.Core/containers", "frontend").
+			Return(container, err).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		logstreamMock := logstream.NewMockInterface(ctrl)
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Logstream:         logstreamMock,
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Name: "test-workspace"},
+			ApplicationName:   "test-app",
+			ContainerName:     "frontend",
+			kubernetesClient:  fake.NewSimpleClientset(deployment),
+		}, logstreamMock, outputSink
+	}
+
+	t.Run("Streams logs of the pods of the deployment", func(t *testing.T) {
+		runner, logstreamMock, outputSink := newRunner(t, newContainer(testApplicationID, "/planes/kubernetes/local/namespaces/test-ns/providers/core/Service/frontend", testDeploymentID), nil)
+		runner.Follow = true
+		runner.Since = time.Hour
+
+		logstreamMock.EXPECT().
+			Stream(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o logstream.Options) error {
+				require.Equal(t, "test-ns", o.Namespace)
+				require.Equal(t, "radapp.io/application=test-app,radapp.io/resource=frontend", o.LabelSelector.String())
+				require.Equal(t, time.Hour, o.Since)
+				require.True(t, o.Follow)
+				return context.Canceled
+			}).
+			Times(1)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{output.LogOutput{
+			Format: "Streaming logs of container %q. Press CTRL+C to exit...",
+			Params: []any{"frontend"},
+		}}, outputSink.Writes)
+	})
+
+	t.Run("Container not found", func(t *testing.T) {
+		runner, _, _ := newRunner(t, generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The container %q was not found or has been deleted.", "frontend"), err)
+	})
+
+	t.Run("Container of another application", func(t *testing.T) {
+		runner, _, _ := newRunner(t, newContainer("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/other-app", testDeploymentID), nil)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The container %q does not belong to the application %q.", "frontend", "test-app"), err)
+	})
+
+	t.Run("Container without deployment", func(t *testing.T) {
+		runner, _, _ := newRunner(t, newContainer(testApplicationID), nil)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The container %q does not have a Kubernetes deployment. It may not have been deployed yet.", "frontend"), err)
+	})
+
+	t.Run("Deployment not found", func(t *testing.T) {
+		runner, _, _ := newRunner(t, newContainer(testApplicationID, "/planes/kubernetes/local/namespaces/other-ns/providers/apps/Deployment/frontend"), nil)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The Kubernetes deployment %q of container %q was not found. The container may have been deleted.", "frontend", "frontend"), err)
+	})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad plane show` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "show [plane type] [plane name]",
+		Short: "Show plane",
+		Long: `Show plane
+
+Planes are the top-level scopes of UCP such as 'radius', 'aws' and 'azure'. The output includes the display name and
+the default location of the plane.`,
+		Example: `
+# Show the local Radius plane
+rad plane show radius local
+
+# Show the AWS plane as JSON
+rad plane show aws aws --output json`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad plane show` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+	PlaneType         string
+	PlaneName         string
+}
+
+// NewRunner creates an instance of the runner for the `rad plane show` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad plane show` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.PlaneType = args[0]
+	r.PlaneName = args[1]
+
+	return nil
+}
+
+// Run runs the `rad plane show` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	planes, err := client.ListPlanes(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Planes of different types are stored with different datamodels, so we look the plane up in the list of all
+	// planes instead of calling the type-specific API.
+	id := fmt.Sprintf("/planes/%s/%s", r.PlaneType, r.PlaneName)
+	for _, plane := range planes {
+		if strings.EqualFold(to.String(plane.ID), id) {
+			return r.Output.WriteFormatted(r.Format, plane, planeFormat())
+		}
+	}
+
+	return clierrors.Message("The plane %q of type %q was not found or has been deleted.", r.PlaneName, r.PlaneType)
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package radinit
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/helm"
 	"github.com/radius-project/radius/pkg/version"
 	"k8s.io/client-go/tools/clientcmd/api"
 )
@@ -52,6 +54,16 @@ func (r *Runner) enterClusterOptions(options *initOptions) error {
 		options.Cluster.Namespace = "radius-system"
 	}
 
+	// A custom dev recipe index specified by the user takes precedence over the one stored with the installation.
+	// When Radius is being installed the index is stored with the installation so later commands can use it.
+	options.Recipes.DevRecipesIndex = state.DevRecipesIndex
+	if r.DevRecipesIndex != "" {
+		options.Recipes.DevRecipesIndex = r.DevRecipesIndex
+		if options.Cluster.Install {
+			options.SetValues = append(options.SetValues, fmt.Sprintf("%s=%s", helm.DevRecipesIndexValue, r.DevRecipesIndex))
+		}
+	}
+
 	return nil
 }
 
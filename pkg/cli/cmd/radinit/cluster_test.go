@@ -45,6 +45,24 @@ func Test_enterClusterOptions(t *testing.T) {
 	require.Equal(t, true, options.Cluster.Install)
 }
 
+func Test_enterClusterOptions_DevRecipesIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	prompter := prompt.NewMockInterface(ctrl)
+	k8s := kubernetes.NewMockInterface(ctrl)
+	helm := helm.NewMockInterface(ctrl)
+	runner := Runner{Prompter: prompter, KubernetesInterface: k8s, HelmInterface: helm, Full: true, DevRecipesIndex: "myregistry.azurecr.io/recipes/index:1.0"}
+
+	initGetKubeContextSuccess(k8s)
+	initKubeContextWithKind(prompter)
+	initHelmMockRadiusNotInstalled(helm)
+
+	options := initOptions{}
+	err := runner.enterClusterOptions(&options)
+	require.NoError(t, err)
+	require.Equal(t, "myregistry.azurecr.io/recipes/index:1.0", options.Recipes.DevRecipesIndex)
+	require.Equal(t, []string{"global.devRecipes.index=myregistry.azurecr.io/recipes/index:1.0"}, options.SetValues)
+}
+
 func Test_selectCluster(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	prompter := prompt.NewMockInterface(ctrl)
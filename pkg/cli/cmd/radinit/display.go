@@ -245,7 +245,7 @@ func (m *summaryModel) View() string {
 		}
 
 		if options.Recipes.DevRecipes {
-			message.WriteString(fmt.Sprintf(summaryEnvironmentCreateRecipePackyFmt, highlight("local-dev")))
+			message.WriteString(fmt.Sprintf(summaryEnvironmentCreateRecipePackyFmt, highlight(recipePackName(options.Recipes))))
 		}
 	} else {
 		message.WriteString(fmt.Sprintf(summaryEnvironmentExistingHeadingFmt, highlight(options.Environment.Name)))
@@ -388,7 +388,7 @@ func (m *progressModel) View() string {
 		}
 
 		if options.Recipes.DevRecipes {
-			message.WriteString(fmt.Sprintf(summaryEnvironmentCreateRecipePackyFmt, highlight("local-dev")))
+			message.WriteString(fmt.Sprintf(summaryEnvironmentCreateRecipePackyFmt, highlight(recipePackName(options.Recipes))))
 		}
 	} else {
 		message.WriteString(fmt.Sprintf(summaryEnvironmentExistingHeadingFmt, highlight(options.Environment.Name)))
@@ -437,6 +437,15 @@ func (m *progressModel) writeProgressIcon(message *strings.Builder, condition bo
 	}
 }
 
+// recipePackName returns the display name of the dev recipe pack that will be registered in the environment.
+func recipePackName(options recipePackOptions) string {
+	if options.DevRecipesIndex != "" {
+		return options.DevRecipesIndex
+	}
+
+	return "local-dev"
+}
+
 func highlight(text string) string {
 	return foregroundBrightStyle.Render(text)
 }
@@ -68,7 +68,7 @@ func (r *Runner) CreateEnvironment(ctx context.Context) error {
 
 	var recipes map[string]map[string]corerp.RecipePropertiesClassification
 	if r.Options.Recipes.DevRecipes {
-		recipes, err = r.DevRecipeClient.GetDevRecipes(ctx)
+		recipes, err = r.DevRecipeClient.GetDevRecipes(ctx, r.Options.Recipes.DevRecipesIndex)
 		if err != nil {
 			return err
 		}
@@ -250,7 +250,7 @@ func (r *Runner) enterEnvironmentNamespace() (string, error) {
 	namespace, err := r.Prompter.GetTextInput(enterNamespacePrompt, prompt.TextInputOptions{
 		Default:     defaultEnvironmentNamespace,
 		Placeholder: defaultEnvironmentNamespace,
-		Validate:    prompt.ValidateResourceNameOrDefault,
+		Validate:    prompt.ValidateKubernetesNamespaceOrDefault,
 	})
 	if err != nil {
 		return "", err
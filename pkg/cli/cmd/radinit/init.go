@@ -72,6 +72,9 @@ rad init
 
 ## Prompt the user for all available options to create a new environment
 rad init --full
+
+## Create a new development environment using a custom set of dev recipes
+rad init --dev-recipes-index myregistry.azurecr.io/recipes/local-dev-index:1.0
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -80,6 +83,7 @@ rad init --full
 	// Define your flags here
 	commonflags.AddOutputFlag(cmd)
 	cmd.Flags().Bool("full", false, "Prompt user for all available configuration options")
+	cmd.Flags().String("dev-recipes-index", "", "OCI reference of a custom dev recipe index to use instead of the dev recipes built into the release. The index is stored with the installation when Radius is installed.")
 	return cmd, runner
 }
 
@@ -110,7 +114,7 @@ type Runner struct {
 	Prompter prompt.Interface
 
 	// DevRecipeClient is the interface for the dev recipe client.
-	DevRecipeClient DevRecipeClient
+	DevRecipeClient setup.DevRecipeClient
 
 	// Format is the output format.
 	Format string
@@ -121,6 +125,9 @@ type Runner struct {
 	// Full determines whether or not we ask the user for all options.
 	Full bool
 
+	// DevRecipesIndex is the custom dev recipe index specified by the user.
+	DevRecipesIndex string
+
 	// Options provides the options to used for Radius initialization. This will be populated by Validate.
 	Options *initOptions
 }
@@ -139,7 +146,7 @@ func NewRunner(factory framework.Factory) *Runner {
 		ConfigFileInterface: factory.GetConfigFileInterface(),
 		KubernetesInterface: factory.GetKubernetesInterface(),
 		HelmInterface:       factory.GetHelmInterface(),
-		DevRecipeClient:     NewDevRecipeClient(),
+		DevRecipeClient:     setup.NewDevRecipeClient(),
 		awsClient:           factory.GetAWSClient(),
 		azureClient:         factory.GetAzureClient(),
 	}
@@ -164,6 +171,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.DevRecipesIndex, err = cmd.Flags().GetString("dev-recipes-index")
+	if err != nil {
+		return err
+	}
+
 	for {
 		options, workspace, err := r.enterInitOptions(cmd.Context())
 		if err != nil {
@@ -43,6 +43,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/setup"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/recipes"
@@ -814,10 +815,10 @@ func Test_Run_InstallAndCreateEnvironment(t *testing.T) {
 				Return(nil).
 				Times(1)
 
-			devRecipeClient := NewMockDevRecipeClient(ctrl)
+			devRecipeClient := setup.NewMockDevRecipeClient(ctrl)
 			if !tc.full {
 				devRecipeClient.EXPECT().
-					GetDevRecipes(context.Background()).
+					GetDevRecipes(context.Background(), "").
 					Return(tc.recipes, nil).
 					Times(1)
 			}
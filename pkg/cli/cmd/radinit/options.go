@@ -62,6 +62,10 @@ type cloudProviderOptions struct {
 // recipePackOptions holds all of the options that will be used to initialize recipe packs as part of the environment.
 type recipePackOptions struct {
 	DevRecipes bool
+
+	// DevRecipesIndex is the custom dev recipe index to read dev recipes from. Blank means the dev recipes built into
+	// the release are used.
+	DevRecipesIndex string
 }
 
 // applicationOptions holds all of the options that will be used to initialize an application in the current directory.
@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const resourceNameFlag = "resource-name"
+
+// NewCommand creates an instance of the command and runner for the `rad recipe plan` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "plan [recipe-name]",
+		Short: "Show the changes the deployment of a recipe would make",
+		Long: `Show the changes the deployment of a recipe would make
+
+The recipe plan command runs 'terraform plan' for Terraform recipes, or a what-if deployment for Bicep recipes, against an environment without provisioning anything. It outputs the resources the recipe would create, update, replace or delete. Only the names of changed properties are reported, their values are not.
+
+The changes are computed for a resource of the recipe's resource type. By default the resource is named after the recipe, you can plan the recipe for an existing resource using the '--resource-name' flag. You can override parameters using the '--parameters' flag ('-p' for short), in the same formats as 'rad recipe register'.
+
+By default, the command is scoped to the resource group and environment defined in your rad.yaml workspace file. You can optionally override these values through the environment and group flags.`,
+		Example: `
+# show the changes the deployment of a recipe would make
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches
+
+# show the changes the deployment of a recipe would make to an existing resource, with a parameter
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches --resource-name cache --parameters sku=Premium
+
+# show the changes the deployment of a recipe would make, with a JSON output
+rad recipe plan redis-prod --resource-type Applications.Datastores/redisCaches --output json`,
+		RunE: framework.RunCommand(runner),
+		Args: cobra.ExactArgs(1),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	_ = cmd.MarkFlagRequired(cli.ResourceTypeFlag)
+	cmd.Flags().String(resourceNameFlag, "", "The name of the resource the recipe is planned for")
+
+	return cmd, runner
+}
+
+// ResourceChange is a change to a resource deployed by a recipe, formatted for display.
+type ResourceChange struct {
+	Action     string
+	Type       string
+	Name       string
+	Address    string
+	Properties string
+}
+
+// Runner is the runner implementation for the `rad recipe plan` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	RecipeName   string
+	ResourceType string
+	Parameters   map[string]any
+	Format       string
+
+	// ResourceID is the ID of the resource the recipe is planned for. When empty, the default of the server is used.
+	ResourceID string
+}
+
+// NewRunner creates a new instance of the `rad recipe plan` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe plan` command.
+//
+
+// Validate validates the command line arguments, setting the workspace, environment, recipe name, resource type,
+// parameters, resource ID and output format in the Runner struct.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	if !r.Workspace.IsNamedWorkspace() {
+		return workspaces.ErrNamedWorkspaceRequired
+	}
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	recipeName, err := cli.RequireRecipeNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.RecipeName = recipeName
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: filesystem.NewOSFS()}
+	parameters, err := parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+	r.Parameters = bicep.ConvertToMapStringInterface(parameters)
+
+	resourceName, err := cmd.Flags().GetString(resourceNameFlag)
+	if err != nil {
+		return err
+	}
+	if resourceName != "" {
+		r.ResourceID = workspace.Scope + "/providers/" + resourceType + "/" + resourceName
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = "table"
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe plan` command.
+//
+
+// Run computes the changes the deployment of the recipe would make and prints them in the specified format. The table
+// format only prints the changes, the other formats print the whole plan.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	request := corerp.RecipePlan{
+		Name:         &r.RecipeName,
+		ResourceType: &r.ResourceType,
+		Parameters:   r.Parameters,
+	}
+	if r.ResourceID != "" {
+		request.Resource = &r.ResourceID
+	}
+
+	plan, err := client.PlanRecipe(ctx, r.Workspace.Environment, request)
+	if err != nil {
+		return err
+	}
+
+	if r.Format != output.FormatTable {
+		return r.Output.WriteFormatted(r.Format, plan, planFormat())
+	}
+
+	r.Output.LogInfo("Planning recipe %q (%s: %s) for resource %q", r.RecipeName, to.String(plan.TemplateKind), to.String(plan.TemplatePath), to.String(plan.Resource))
+	r.Output.LogInfo("")
+
+	if len(plan.Changes) == 0 {
+		r.Output.LogInfo("No changes. The resources deployed by the recipe are up to date.")
+		return nil
+	}
+
+	changes := []ResourceChange{}
+	for _, change := range plan.Changes {
+		properties := []string{}
+		for _, property := range change.Properties {
+			properties = append(properties, to.String(property))
+		}
+
+		item := ResourceChange{
+			Type:       to.String(change.Type),
+			Name:       to.String(change.Name),
+			Address:    to.String(change.Address),
+			Properties: strings.Join(properties, ","),
+		}
+		if change.Action != nil {
+			item.Action = string(*change.Action)
+		}
+		changes = append(changes, item)
+	}
+
+	return r.Output.WriteFormatted(r.Format, changes, planFormat())
+}
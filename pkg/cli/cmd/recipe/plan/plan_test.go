@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	datastoresrp "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Plan Command",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Empty(t, runner.(*Runner).ResourceID)
+				require.Equal(t, "table", runner.(*Runner).Format)
+			},
+		},
+		{
+			Name:          "Valid Plan Command with resource name and parameters",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType, "--resource-name", "cache", "--parameters", "sku=Premium"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Datastores/redisCaches/cache", runner.(*Runner).ResourceID)
+				require.Equal(t, map[string]any{"sku": "Premium"}, runner.(*Runner).Parameters)
+			},
+		},
+		{
+			Name:          "Plan Command with incorrect fallback workspace",
+			Input:         []string{"-e", "my-env", "-g", "my-env", "recipeName", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Plan Command with too many positional args",
+			Input:         []string{"recipeName", "arg2", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command without ResourceType",
+			Input:         []string{"recipeName"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	plan := v20231001preview.RecipePlanResponse{
+		TemplateKind: to.Ptr(recipes.TemplateKindTerraform),
+		TemplatePath: to.Ptr("Azure/redis/azurerm"),
+		Resource:     to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"),
+		Changes: []*v20231001preview.RecipeResourceChange{
+			{
+				Action:  to.Ptr(v20231001preview.RecipeResourceChangeActionCreate),
+				Type:    to.Ptr("azurerm_redis_cache"),
+				Name:    to.Ptr("redis"),
+				Address: to.Ptr("module.default.azurerm_redis_cache.redis"),
+			},
+			{
+				Action:     to.Ptr(v20231001preview.RecipeResourceChangeActionUpdate),
+				Type:       to.Ptr("azurerm_redis_firewall_rule"),
+				Name:       to.Ptr("rule"),
+				Address:    to.Ptr("module.default.azurerm_redis_firewall_rule.rule"),
+				Properties: to.SliceOfPtrs("end_ip", "start_ip"),
+			},
+		},
+	}
+
+	t.Run("Plan recipe - Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), "test-env", v20231001preview.RecipePlan{
+				Name:         to.Ptr("redis"),
+				ResourceType: to.Ptr(datastoresrp.RedisCachesResourceType),
+				Resource:     to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"),
+			}).
+			Return(plan, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "test-env"},
+			Format:            "table",
+			RecipeName:        "redis",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+			ResourceID:        "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Planning recipe %q (%s: %s) for resource %q",
+				Params: []any{"redis", recipes.TemplateKindTerraform, "Azure/redis/azurerm", "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"},
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []ResourceChange{
+					{
+						Action:  "create",
+						Type:    "azurerm_redis_cache",
+						Name:    "redis",
+						Address: "module.default.azurerm_redis_cache.redis",
+					},
+					{
+						Action:     "update",
+						Type:       "azurerm_redis_firewall_rule",
+						Name:       "rule",
+						Address:    "module.default.azurerm_redis_firewall_rule.rule",
+						Properties: "end_ip,start_ip",
+					},
+				},
+				Options: planFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Plan recipe - No changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		noChanges := plan
+		noChanges.Changes = []*v20231001preview.RecipeResourceChange{}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(noChanges, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "table",
+			RecipeName:        "redis",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, output.LogOutput{Format: "No changes. The resources deployed by the recipe are up to date."}, outputSink.Writes[len(outputSink.Writes)-1])
+	})
+
+	t.Run("Plan recipe - JSON output", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(plan, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			Format:            "json",
+			RecipeName:        "redis",
+			ResourceType:      datastoresrp.RedisCachesResourceType,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     plan,
+				Options: planFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
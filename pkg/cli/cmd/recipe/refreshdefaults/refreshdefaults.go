@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package refreshdefaults
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/setup"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe refresh-defaults` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "refresh-defaults",
+		Short: "Refresh the dev recipes registered in an environment.",
+		Long: `Refresh the dev recipes registered in an environment.
+
+Dev recipes are read from the custom dev recipe index configured for the Radius installation, or from the dev recipes built into the release if no index is configured. Use the '--dev-recipes-index' flag to read dev recipes from a different index.
+
+Dev recipes replace recipes with the same resource type and name. Other recipes registered in the environment are not changed.`,
+		Example: `
+# Refresh the dev recipes in the current environment
+rad recipe refresh-defaults
+
+# Refresh the dev recipes in a specific environment
+rad recipe refresh-defaults --environment my-env
+
+# Refresh the dev recipes from a custom dev recipe index
+rad recipe refresh-defaults --dev-recipes-index myregistry.azurecr.io/recipes/local-dev-index:1.0
+`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	cmd.Flags().String("dev-recipes-index", "", "OCI reference of a custom dev recipe index to read dev recipes from. Defaults to the index configured for the Radius installation.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe refresh-defaults` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	HelmInterface     helm.Interface
+	DevRecipeClient   setup.DevRecipeClient
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	DevRecipesIndex   string
+}
+
+// NewRunner creates a new instance of the `rad recipe refresh-defaults` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		HelmInterface:     factory.GetHelmInterface(),
+		DevRecipeClient:   setup.NewDevRecipeClient(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe refresh-defaults` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	r.DevRecipesIndex, err = cmd.Flags().GetString("dev-recipes-index")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe refresh-defaults` command.
+//
+// Run reads the dev recipes, from the custom dev recipe index if one is configured, and registers them in the
+// environment, replacing recipes with the same resource type and name.
+func (r *Runner) Run(ctx context.Context) error {
+	index := r.DevRecipesIndex
+	if index == "" {
+		// Fall back to the index stored with the installation. Workspaces that are not connected to a Kubernetes
+		// cluster use the dev recipes built into the release.
+		if kubeContext, ok := r.Workspace.KubernetesContext(); ok {
+			state, err := r.HelmInterface.CheckRadiusInstall(kubeContext)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "Unable to verify Radius installation.")
+			}
+			index = state.DevRecipesIndex
+		}
+	}
+
+	devRecipes, err := r.DevRecipeClient.GetDevRecipes(ctx, index)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to get dev recipes.")
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	envResource, err := client.GetEnvironment(ctx, r.Workspace.Environment)
+	if err != nil {
+		return err
+	}
+
+	envRecipes := envResource.Properties.Recipes
+	if envRecipes == nil {
+		envRecipes = map[string]map[string]corerp.RecipePropertiesClassification{}
+	}
+
+	count := 0
+	for resourceType, recipes := range devRecipes {
+		if _, ok := envRecipes[resourceType]; !ok {
+			envRecipes[resourceType] = map[string]corerp.RecipePropertiesClassification{}
+		}
+
+		for name, properties := range recipes {
+			envRecipes[resourceType][name] = properties
+			count++
+		}
+	}
+	envResource.Properties.Recipes = envRecipes
+
+	err = client.CreateOrUpdateEnvironment(ctx, r.Workspace.Environment, &envResource)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to refresh the dev recipes in the environment %q.", r.Workspace.Environment)
+	}
+
+	r.Output.LogInfo("Successfully refreshed %d dev recipes in environment %q", count, r.Workspace.Environment)
+	return nil
+}
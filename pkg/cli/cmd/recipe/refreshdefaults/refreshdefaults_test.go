@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package refreshdefaults
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/setup"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid refresh-defaults command",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid refresh-defaults command with index",
+			Input:         []string{"--dev-recipes-index", "myregistry.azurecr.io/recipes/index:1.0"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "refresh-defaults command with too many args",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newEnvironment := func() v20231001preview.EnvironmentResource {
+		return v20231001preview.EnvironmentResource{
+			ID:       to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+			Name:     to.Ptr("kind-kind"),
+			Type:     to.Ptr("applications.core/environments"),
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.EnvironmentProperties{
+				Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+					ds_ctrl.MongoDatabasesResourceType: {
+						"default": &v20231001preview.BicepRecipeProperties{
+							TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+							TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/local-dev/mongodatabases:0.1"),
+						},
+						"custom": &v20231001preview.BicepRecipeProperties{
+							TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+							TemplatePath: to.Ptr("myregistry.azurecr.io/custom:1.0"),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	devRecipes := map[string]map[string]v20231001preview.RecipePropertiesClassification{
+		ds_ctrl.MongoDatabasesResourceType: {
+			"default": &v20231001preview.BicepRecipeProperties{
+				TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+				TemplatePath: to.Ptr("myregistry.azurecr.io/recipes/mongo:2.0"),
+			},
+		},
+		ds_ctrl.RedisCachesResourceType: {
+			"default": &v20231001preview.BicepRecipeProperties{
+				TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+				TemplatePath: to.Ptr("myregistry.azurecr.io/recipes/redis:2.0"),
+			},
+		},
+	}
+
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    workspaces.KindKubernetes,
+			"context": "kind-kind",
+		},
+		Environment: "kind-kind",
+	}
+
+	t.Run("Success: index from installation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		helmInterface := helm.NewMockInterface(ctrl)
+		helmInterface.EXPECT().
+			CheckRadiusInstall("kind-kind").
+			Return(helm.InstallState{RadiusInstalled: true, DevRecipesIndex: "myregistry.azurecr.io/recipes/index:2.0"}, nil).
+			Times(1)
+
+		devRecipeClient := setup.NewMockDevRecipeClient(ctrl)
+		devRecipeClient.EXPECT().
+			GetDevRecipes(gomock.Any(), "myregistry.azurecr.io/recipes/index:2.0").
+			Return(devRecipes, nil).
+			Times(1)
+
+		envResource := newEnvironment()
+		expected := newEnvironment()
+		expected.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["default"] = devRecipes[ds_ctrl.MongoDatabasesResourceType]["default"]
+		expected.Properties.Recipes[ds_ctrl.RedisCachesResourceType] = devRecipes[ds_ctrl.RedisCachesResourceType]
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "kind-kind").
+			Return(envResource, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateEnvironment(gomock.Any(), "kind-kind", &expected).
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			HelmInterface:     helmInterface,
+			DevRecipeClient:   devRecipeClient,
+			Output:            outputSink,
+			Workspace:         workspace,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expectedOutput := []any{
+			output.LogOutput{
+				Format: "Successfully refreshed %d dev recipes in environment %q",
+				Params: []any{2, "kind-kind"},
+			},
+		}
+		require.Equal(t, expectedOutput, outputSink.Writes)
+	})
+
+	t.Run("Success: index from flag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		devRecipeClient := setup.NewMockDevRecipeClient(ctrl)
+		devRecipeClient.EXPECT().
+			GetDevRecipes(gomock.Any(), "myregistry.azurecr.io/recipes/index:3.0").
+			Return(devRecipes, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "kind-kind").
+			Return(newEnvironment(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateEnvironment(gomock.Any(), "kind-kind", gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			HelmInterface:     helm.NewMockInterface(ctrl),
+			DevRecipeClient:   devRecipeClient,
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			DevRecipesIndex:   "myregistry.azurecr.io/recipes/index:3.0",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure: invalid index", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		devRecipeClient := setup.NewMockDevRecipeClient(ctrl)
+		devRecipeClient.EXPECT().
+			GetDevRecipes(gomock.Any(), "myregistry.azurecr.io/recipes/index:3.0").
+			Return(nil, errors.New("invalid dev recipe index")).
+			Times(1)
+
+		runner := &Runner{
+			HelmInterface:   helm.NewMockInterface(ctrl),
+			DevRecipeClient: devRecipeClient,
+			Output:          &output.MockOutput{},
+			Workspace:       workspace,
+			DevRecipesIndex: "myregistry.azurecr.io/recipes/index:3.0",
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
@@ -75,6 +76,8 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 	_ = cmd.MarkFlagRequired("template-path")
 	cmd.Flags().String("resource-type", "", "specify the type of the portable resource this recipe can be consumed by")
 	_ = cmd.MarkFlagRequired("resource-type")
+	cmd.Flags().String("driver", "", "specify the name of the external recipe driver executing the recipe. Required when the template kind is 'external'.")
+	cmd.Flags().StringSlice("allowed-output-resource-types", []string{}, "specify the resource types of the output resources the recipe is allowed to create, for example 'Microsoft.Cache/redis' or 'Microsoft.Cache/*'. All resource types are allowed when not specified.")
 	cmd.Flags().Bool("plain-http", false, "Connect to the Bicep registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).")
 	commonflags.AddParameterFlag(cmd)
 
@@ -83,17 +86,19 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 
 // Runner is the runner implementation for the `rad recipe register` command.
 type Runner struct {
-	ConfigHolder      *framework.ConfigHolder
-	ConnectionFactory connections.Factory
-	Output            output.Interface
-	Workspace         *workspaces.Workspace
-	TemplateKind      string
-	TemplatePath      string
-	PlainHTTP         bool
-	TemplateVersion   string
-	ResourceType      string
-	RecipeName        string
-	Parameters        map[string]map[string]any
+	ConfigHolder               *framework.ConfigHolder
+	ConnectionFactory          connections.Factory
+	Output                     output.Interface
+	Workspace                  *workspaces.Workspace
+	TemplateKind               string
+	TemplatePath               string
+	PlainHTTP                  bool
+	Driver                     string
+	AllowedOutputResourceTypes []string
+	TemplateVersion            string
+	ResourceType               string
+	RecipeName                 string
+	Parameters                 map[string]map[string]any
 }
 
 // NewRunner creates a new instance of the `rad recipe register` runner.
@@ -161,6 +166,25 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.PlainHTTP = plainHTTP
 
+	driver, err := cmd.Flags().GetString("driver")
+	if err != nil {
+		return err
+	}
+	if r.TemplateKind == recipes.TemplateKindExternal && driver == "" {
+		return clierrors.Message("The --driver flag is required when the template kind is %q.", recipes.TemplateKindExternal)
+	}
+	r.Driver = driver
+
+	r.AllowedOutputResourceTypes, err = cmd.Flags().GetStringSlice("allowed-output-resource-types")
+	if err != nil {
+		return err
+	}
+
+	err = recipes.ValidateAllowedOutputResourceTypes(r.AllowedOutputResourceTypes)
+	if err != nil {
+		return clierrors.Message("The value of --allowed-output-resource-types is invalid: %s.", err.Error())
+	}
+
 	return nil
 }
 
@@ -188,17 +212,27 @@ func (r *Runner) Run(ctx context.Context) error {
 	switch r.TemplateKind {
 	case recipes.TemplateKindTerraform:
 		properties = &corerp.TerraformRecipeProperties{
-			TemplateKind:    &r.TemplateKind,
-			TemplatePath:    &r.TemplatePath,
-			TemplateVersion: &r.TemplateVersion,
-			Parameters:      bicep.ConvertToMapStringInterface(r.Parameters),
+			TemplateKind:               &r.TemplateKind,
+			TemplatePath:               &r.TemplatePath,
+			TemplateVersion:            &r.TemplateVersion,
+			Parameters:                 bicep.ConvertToMapStringInterface(r.Parameters),
+			AllowedOutputResourceTypes: allowedOutputResourceTypes(r.AllowedOutputResourceTypes),
 		}
 	case recipes.TemplateKindBicep:
 		properties = &corerp.BicepRecipeProperties{
-			TemplateKind: &r.TemplateKind,
-			TemplatePath: &r.TemplatePath,
-			PlainHTTP:    &r.PlainHTTP,
-			Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
+			TemplateKind:               &r.TemplateKind,
+			TemplatePath:               &r.TemplatePath,
+			PlainHTTP:                  &r.PlainHTTP,
+			Parameters:                 bicep.ConvertToMapStringInterface(r.Parameters),
+			AllowedOutputResourceTypes: allowedOutputResourceTypes(r.AllowedOutputResourceTypes),
+		}
+	case recipes.TemplateKindExternal:
+		properties = &corerp.ExternalRecipeProperties{
+			TemplateKind:               &r.TemplateKind,
+			TemplatePath:               &r.TemplatePath,
+			Driver:                     &r.Driver,
+			Parameters:                 bicep.ConvertToMapStringInterface(r.Parameters),
+			AllowedOutputResourceTypes: allowedOutputResourceTypes(r.AllowedOutputResourceTypes),
 		}
 	}
 	if val, ok := envRecipes[r.ResourceType]; ok {
@@ -235,3 +269,13 @@ func requireRecipeProperties(cmd *cobra.Command) (templateKind, templatePath, te
 	}
 	return templateKind, templatePath, templateVersion, nil
 }
+
+// allowedOutputResourceTypes converts the allowed output resource types to the API representation, which omits
+// the property when no resource type is specified.
+func allowedOutputResourceTypes(resourceTypes []string) []*string {
+	if len(resourceTypes) == 0 {
+		return nil
+	}
+
+	return to.SliceOfPtrs(resourceTypes...)
+}
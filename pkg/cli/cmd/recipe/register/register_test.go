@@ -63,6 +63,45 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Valid Register Command for external recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindExternal, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--driver", "pulumi"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Register Command with allowed output resource types",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--allowed-output-resource-types", "Microsoft.DocumentDB/*,Microsoft.Network/privateEndpoints"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, []string{"Microsoft.DocumentDB/*", "Microsoft.Network/privateEndpoints"}, runner.(*Runner).AllowedOutputResourceTypes)
+			},
+		},
+		{
+			Name:          "Register Command with invalid allowed output resource types",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--allowed-output-resource-types", "Microsoft.DocumentDB"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Register Command for external recipe without driver",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindExternal, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Valid Register Command with parameters passed as file",
 			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--parameters", "@testdata/recipeparam.json", "--plain-http"},
@@ -0,0 +1,375 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// sandboxResourceGroupPrefix is the prefix of the name of the resource group created for each test.
+	sandboxResourceGroupPrefix = "recipe-test-"
+
+	// testResourceName is the name of the resource deployed with the recipe.
+	testResourceName = "recipe-test"
+
+	// recipeContextParameter is the name of the recipe parameter which is set by Radius.
+	recipeContextParameter = "context"
+
+	keepResourcesFlag = "keep-resources"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe test` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "test [recipe-name]",
+		Short: "Test a recipe by deploying it to a sandbox resource group",
+		Long: `Test a recipe by deploying it to a sandbox resource group
+
+The recipe test command executes a recipe registered to an environment without an application. It creates a sandbox resource group, deploys a resource of the recipe's resource type using the recipe, reports the outputs or the failure of the recipe, and deletes the resource and the sandbox resource group.
+
+Parameters of the recipe which have no default value and are not set by the environment are generated from their type. You can override parameters using the '--parameters' flag ('-p' for short), in the same formats as 'rad recipe register'.
+
+By default, the command is scoped to the environment defined in your rad.yaml workspace file. You can optionally override it through the environment flag.`,
+		Example: `
+# test a recipe registered to the current environment
+rad recipe test redis-prod --resource-type Applications.Datastores/redisCaches
+
+# test a recipe with a parameter
+rad recipe test redis-prod --resource-type Applications.Datastores/redisCaches --parameters sku=Basic
+
+# test a recipe and keep the deployed resources for troubleshooting
+rad recipe test redis-prod --resource-type Applications.Datastores/redisCaches --keep-resources`,
+		RunE: framework.RunCommand(runner),
+		Args: cobra.ExactArgs(1),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	_ = cmd.MarkFlagRequired(cli.ResourceTypeFlag)
+	cmd.Flags().Bool(keepResourcesFlag, false, "Keep the sandbox resource group and the deployed resource after the test")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe test` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	RecipeName   string
+	ResourceType string
+	Parameters   map[string]any
+
+	// PlaneName is the name of the Radius plane of the workspace.
+	PlaneName string
+
+	// SandboxResourceGroup is the name of the resource group created for the test.
+	SandboxResourceGroup string
+
+	// KeepResources skips the deletion of the sandbox resource group and the deployed resource.
+	KeepResources bool
+}
+
+// NewRunner creates a new instance of the `rad recipe test` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe test` command.
+//
+
+// Validate validates the command line arguments, setting the workspace, environment, recipe name, resource type,
+// parameters and the name of the sandbox resource group in the Runner struct.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	recipeName, err := cli.RequireRecipeNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.RecipeName = recipeName
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: filesystem.NewOSFS()}
+	parameters, err := parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+	r.Parameters = bicep.ConvertToMapStringInterface(parameters)
+
+	r.KeepResources, err = cmd.Flags().GetBool(keepResourcesFlag)
+	if err != nil {
+		return err
+	}
+
+	r.PlaneName = "local"
+	if id, err := resources.ParseScope(workspace.Scope); err == nil && id.FindScope(resources_radius.PlaneTypeRadius) != "" {
+		r.PlaneName = id.FindScope(resources_radius.PlaneTypeRadius)
+	}
+	r.SandboxResourceGroup = sandboxResourceGroupPrefix + strings.Split(uuid.New().String(), "-")[0]
+
+	return nil
+}
+
+// Run runs the `rad recipe test` command.
+//
+
+// Run creates the sandbox resource group, deploys a resource using the recipe with the generated test parameters, reports
+// the outputs or the failure of the recipe, and deletes the deployed resource and the sandbox resource group.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	environment, err := client.GetEnvironment(ctx, r.Workspace.Environment)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q does not exist. Please select a new environment and try again.", r.Workspace.Environment)
+	} else if err != nil {
+		return err
+	}
+
+	metadata, err := client.GetRecipeMetadata(ctx, r.Workspace.Environment, corerp.RecipeGetMetadata{Name: &r.RecipeName, ResourceType: &r.ResourceType})
+	if err != nil {
+		return err
+	}
+
+	parameters, err := generateTestParameters(metadata.Parameters, environmentParameters(environment, r.ResourceType, r.RecipeName), r.Parameters)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Creating sandbox resource group %q...", r.SandboxResourceGroup)
+	err = client.CreateOrUpdateResourceGroup(ctx, r.PlaneName, r.SandboxResourceGroup, &ucp.ResourceGroupResource{
+		Location: to.Ptr(v1.LocationGlobal),
+	})
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to create the sandbox resource group %q.", r.SandboxResourceGroup)
+	}
+
+	resourceID := fmt.Sprintf("/planes/radius/%s/resourceGroups/%s/providers/%s/%s", r.PlaneName, r.SandboxResourceGroup, r.ResourceType, testResourceName)
+	deployErr := r.deploy(ctx, client, to.String(environment.ID), resourceID, parameters)
+
+	if r.KeepResources {
+		r.Output.LogInfo("Keeping the sandbox resource group %q. Delete it with 'rad group delete %s' when you are done.", r.SandboxResourceGroup, r.SandboxResourceGroup)
+		return deployErr
+	}
+
+	teardownErr := r.teardown(ctx, client, resourceID)
+	if deployErr != nil {
+		return deployErr
+	}
+
+	return teardownErr
+}
+
+// deploy deploys the test resource using the recipe and reports its outputs.
+func (r *Runner) deploy(ctx context.Context, client clients.ApplicationsManagementClient, environmentID string, resourceID string, parameters map[string]any) error {
+	r.Output.LogInfo("Deploying recipe %q for resource type %q...", r.RecipeName, r.ResourceType)
+	if len(parameters) > 0 {
+		names := []string{}
+		for name := range parameters {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		r.Output.LogInfo("Using parameters: %s", strings.Join(names, ", "))
+	}
+
+	resource, err := client.CreateOrUpdateResource(ctx, r.ResourceType, resourceID, &generated.GenericResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: map[string]any{
+			"environment": environmentID,
+			"recipe": map[string]any{
+				"name":       r.RecipeName,
+				"parameters": parameters,
+			},
+		},
+	})
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Recipe %q failed to deploy.", r.RecipeName)
+	}
+
+	r.Output.LogInfo("Recipe %q deployed successfully.", r.RecipeName)
+	r.Output.LogInfo("")
+
+	// The recipe and the environment are inputs of the test, the remaining properties are the outputs of the recipe.
+	outputs := map[string]any{}
+	for key, value := range resource.Properties {
+		if key != "recipe" && key != "environment" {
+			outputs[key] = value
+		}
+	}
+
+	return r.Output.WriteFormatted(output.FormatJson, outputs, output.FormatterOptions{})
+}
+
+// teardown deletes the test resource and the sandbox resource group.
+func (r *Runner) teardown(ctx context.Context, client clients.ApplicationsManagementClient, resourceID string) error {
+	r.Output.LogInfo("Deleting sandbox resource group %q...", r.SandboxResourceGroup)
+
+	if _, err := client.DeleteResource(ctx, r.ResourceType, resourceID); err != nil {
+		return clierrors.MessageWithCause(err, "Failed to delete the test resource %q. Delete it manually with 'rad resource delete %s %s --group %s'.",
+			resourceID, r.ResourceType, testResourceName, r.SandboxResourceGroup)
+	}
+
+	if _, err := client.DeleteResourceGroup(ctx, r.PlaneName, r.SandboxResourceGroup); err != nil {
+		return clierrors.MessageWithCause(err, "Failed to delete the sandbox resource group %q. Delete it manually with 'rad group delete %s'.",
+			r.SandboxResourceGroup, r.SandboxResourceGroup)
+	}
+
+	return nil
+}
+
+// environmentParameters returns the parameters set by the environment for the recipe.
+func environmentParameters(environment corerp.EnvironmentResource, resourceType string, recipeName string) map[string]any {
+	if environment.Properties == nil {
+		return nil
+	}
+
+	for envResourceType, recipes := range environment.Properties.Recipes {
+		if !strings.EqualFold(envResourceType, resourceType) {
+			continue
+		}
+
+		if recipe, ok := recipes[recipeName]; ok && recipe != nil && recipe.GetRecipeProperties() != nil {
+			return recipe.GetRecipeProperties().Parameters
+		}
+	}
+
+	return nil
+}
+
+// generateTestParameters returns the parameters to deploy the recipe with. Parameters provided by the user are always used.
+// Parameters which have no default value and are not set by the environment are generated from their type.
+func generateTestParameters(recipeParameters map[string]any, environmentParameters map[string]any, userParameters map[string]any) (map[string]any, error) {
+	parameters := map[string]any{}
+	for name, value := range userParameters {
+		parameters[name] = value
+	}
+
+	for name, details := range recipeParameters {
+		if name == recipeContextParameter {
+			continue
+		}
+
+		if _, ok := parameters[name]; ok {
+			continue
+		}
+
+		if _, ok := environmentParameters[name]; ok {
+			continue
+		}
+
+		constraints, ok := details.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if _, ok := constraints["defaultValue"]; ok {
+			continue
+		}
+
+		value, err := generateParameterValue(name, constraints)
+		if err != nil {
+			return nil, err
+		}
+		parameters[name] = value
+	}
+
+	return parameters, nil
+}
+
+// generateParameterValue generates a value for a parameter from its type and constraints.
+func generateParameterValue(name string, constraints map[string]any) (any, error) {
+	if allowed, ok := constraints["allowedValues"].([]any); ok && len(allowed) > 0 {
+		return allowed[0], nil
+	}
+
+	parameterType, _ := constraints["type"].(string)
+	switch strings.ToLower(parameterType) {
+	case "string", "securestring":
+		return "test-" + strings.ToLower(name), nil
+	case "int", "number":
+		if minValue, ok := constraints["minValue"].(float64); ok {
+			return minValue, nil
+		}
+		return 1, nil
+	case "bool":
+		return false, nil
+	case "object", "secureobject":
+		return map[string]any{}, nil
+	case "array":
+		return []any{}, nil
+	default:
+		return nil, clierrors.Message("Unable to generate a value for the parameter %q of type %q. Provide a value with the '--parameters' flag.", name, parameterType)
+	}
+}
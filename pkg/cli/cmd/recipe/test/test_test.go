@@ -0,0 +1,266 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	datastoresrp "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnvironmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-environment"
+	testResourceGroup = "recipe-test-1234"
+	testResourceID    = "/planes/radius/local/resourceGroups/recipe-test-1234/providers/Applications.Datastores/redisCaches/recipe-test"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Test Command",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "recipeName", r.RecipeName)
+				require.Equal(t, datastoresrp.RedisCachesResourceType, r.ResourceType)
+				require.Equal(t, "local", r.PlaneName)
+				require.Regexp(t, "^recipe-test-[0-9a-f]{8}$", r.SandboxResourceGroup)
+				require.False(t, r.KeepResources)
+				require.Empty(t, r.Parameters)
+			},
+		},
+		{
+			Name:          "Valid Test Command with parameters and keep resources",
+			Input:         []string{"recipeName", "--resource-type", datastoresrp.RedisCachesResourceType, "--parameters", "sku=Basic", "--keep-resources"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, map[string]any{"sku": "Basic"}, r.Parameters)
+				require.True(t, r.KeepResources)
+			},
+		},
+		{
+			Name:          "Test Command with too many positional args",
+			Input:         []string{"recipeName", "arg2", "--resource-type", datastoresrp.RedisCachesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Test Command without ResourceType",
+			Input:         []string{"recipeName"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	environment := v20231001preview.EnvironmentResource{
+		ID: to.Ptr(testEnvironmentID),
+		Properties: &v20231001preview.EnvironmentProperties{
+			Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+				datastoresrp.RedisCachesResourceType: {
+					"redis": &v20231001preview.BicepRecipeProperties{
+						TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+						TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/redis:latest"),
+						Parameters: map[string]any{
+							"port": 6380,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	metadata := v20231001preview.RecipeGetMetadataResponse{
+		TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+		TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/redis:latest"),
+		Parameters: map[string]any{
+			"context": map[string]any{"type": "object"},
+			"port":    map[string]any{"type": "int"},
+			"sku":     map[string]any{"type": "string"},
+			"size":    map[string]any{"type": "int", "defaultValue": float64(1)},
+		},
+	}
+
+	setup := func(t *testing.T) (*clients.MockApplicationsManagementClient, *Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-environment").
+			Return(environment, nil).Times(1)
+		appManagementClient.EXPECT().
+			GetRecipeMetadata(gomock.Any(), "test-environment", gomock.Any()).
+			Return(metadata, nil).Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResourceGroup(gomock.Any(), "local", testResourceGroup, gomock.Any()).
+			Return(nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:               outputSink,
+			Workspace:            &workspaces.Workspace{Environment: "test-environment"},
+			RecipeName:           "redis",
+			ResourceType:         datastoresrp.RedisCachesResourceType,
+			PlaneName:            "local",
+			SandboxResourceGroup: testResourceGroup,
+		}
+
+		return appManagementClient, runner, outputSink
+	}
+
+	expectedResource := &generated.GenericResource{
+		Location: to.Ptr("global"),
+		Properties: map[string]any{
+			"environment": testEnvironmentID,
+			"recipe": map[string]any{
+				"name": "redis",
+				"parameters": map[string]any{
+					"sku": "test-sku",
+				},
+			},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		appManagementClient, runner, outputSink := setup(t)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), datastoresrp.RedisCachesResourceType, testResourceID, expectedResource).
+			Return(generated.GenericResource{
+				Properties: map[string]any{
+					"environment": testEnvironmentID,
+					"recipe":      map[string]any{"name": "redis"},
+					"host":        "redis.example.com",
+				},
+			}, nil).Times(1)
+		appManagementClient.EXPECT().
+			DeleteResource(gomock.Any(), datastoresrp.RedisCachesResourceType, testResourceID).
+			Return(true, nil).Times(1)
+		appManagementClient.EXPECT().
+			DeleteResourceGroup(gomock.Any(), "local", testResourceGroup).
+			Return(true, nil).Times(1)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Contains(t, outputSink.Writes, output.FormattedOutput{
+			Format:  output.FormatJson,
+			Obj:     map[string]any{"host": "redis.example.com"},
+			Options: output.FormatterOptions{},
+		})
+	})
+
+	t.Run("Recipe failure tears down sandbox", func(t *testing.T) {
+		appManagementClient, runner, _ := setup(t)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), datastoresrp.RedisCachesResourceType, testResourceID, expectedResource).
+			Return(generated.GenericResource{}, errors.New("recipe failed")).Times(1)
+		appManagementClient.EXPECT().
+			DeleteResource(gomock.Any(), datastoresrp.RedisCachesResourceType, testResourceID).
+			Return(true, nil).Times(1)
+		appManagementClient.EXPECT().
+			DeleteResourceGroup(gomock.Any(), "local", testResourceGroup).
+			Return(true, nil).Times(1)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.MessageWithCause(errors.New("recipe failed"), "Recipe %q failed to deploy.", "redis"), err)
+	})
+
+	t.Run("Keep resources", func(t *testing.T) {
+		appManagementClient, runner, _ := setup(t)
+		runner.KeepResources = true
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), datastoresrp.RedisCachesResourceType, testResourceID, expectedResource).
+			Return(generated.GenericResource{}, nil).Times(1)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+}
+
+func Test_generateTestParameters(t *testing.T) {
+	recipeParameters := map[string]any{
+		"context":  map[string]any{"type": "object"},
+		"name":     map[string]any{"type": "string"},
+		"replicas": map[string]any{"type": "int", "minValue": float64(2)},
+		"count":    map[string]any{"type": "int"},
+		"enabled":  map[string]any{"type": "bool"},
+		"tags":     map[string]any{"type": "object"},
+		"zones":    map[string]any{"type": "array"},
+		"tier":     map[string]any{"type": "string", "allowedValues": []any{"Basic", "Premium"}},
+		"size":     map[string]any{"type": "string", "defaultValue": "small"},
+		"port":     map[string]any{"type": "int"},
+		"sku":      map[string]any{"type": "string"},
+	}
+
+	t.Run("generates values for required parameters", func(t *testing.T) {
+		parameters, err := generateTestParameters(recipeParameters, map[string]any{"port": 6380}, map[string]any{"sku": "Standard"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"name":     "test-name",
+			"replicas": float64(2),
+			"count":    1,
+			"enabled":  false,
+			"tags":     map[string]any{},
+			"zones":    []any{},
+			"tier":     "Basic",
+			"sku":      "Standard",
+		}, parameters)
+	})
+
+	t.Run("unsupported parameter type", func(t *testing.T) {
+		_, err := generateTestParameters(map[string]any{"value": map[string]any{"type": "unknown"}}, nil, nil)
+		require.Error(t, err)
+	})
+}
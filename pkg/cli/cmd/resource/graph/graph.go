@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// relationshipDependsOn is the relationship of the resources the resource depends on.
+	relationshipDependsOn = "DependsOn"
+
+	// relationshipRequiredBy is the relationship of the resources which depend on the resource.
+	relationshipRequiredBy = "RequiredBy"
+)
+
+// NewCommand creates an instance of the `rad resource graph` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "graph [resourceID | resourceType resourceName]",
+		Short: "Show the dependencies of a Radius resource",
+		Long: `Show the dependencies of a Radius resource.
+
+Shows everything the resource depends on, such as its connections, the resources created by its recipe, and the secret
+stores it references, and everything that depends on it. Use this command to find out what breaks if the resource is
+deleted.`,
+		Example: `
+# show the dependencies of a resource by its resource ID
+rad resource graph /planes/radius/local/resourceGroups/default/providers/Applications.Datastores/redisCaches/redis
+
+# show the dependencies of a resource by its type and name
+rad resource graph redisCaches redis
+
+# show the dependencies of a resource in JSON format
+rad resource graph redisCaches redis --output json
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource graph` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad resource graph` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource graph` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	if len(args) == 1 {
+		id, err := resources.ParseResource(args[0])
+		if err != nil {
+			return clierrors.Message("The resource ID %q is invalid. Specify a resource ID or a resource type and name.", args[0])
+		}
+		r.ResourceType = id.Type()
+		r.ResourceName = id.String()
+	} else {
+		resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+		if err != nil {
+			return err
+		}
+		r.ResourceType = resourceType
+		r.ResourceName = resourceName
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource graph` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	graph, err := client.GetResourceGraph(ctx, r.ResourceType, r.ResourceName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q was not found or has been deleted.", r.ResourceName, r.ResourceType)
+	} else if err != nil {
+		return err
+	}
+
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, graph, objectformats.GetResourceGraphTableFormat())
+	}
+
+	r.Output.LogInfo("Resource %q of type %q:", graph.Name, graph.Type)
+	if len(graph.Dependencies) == 0 && len(graph.Dependents) == 0 {
+		r.Output.LogInfo("The resource has no dependencies or dependents.")
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, graphRows(graph), objectformats.GetResourceGraphTableFormat())
+}
+
+// graphRow is a row of the table output of the `rad resource graph` command.
+type graphRow struct {
+	Relationship string
+	Kind         string
+	Type         string
+	Name         string
+}
+
+func graphRows(graph clients.ResourceGraph) []graphRow {
+	rows := []graphRow{}
+	for _, edge := range graph.Dependencies {
+		rows = append(rows, graphRow{Relationship: relationshipDependsOn, Kind: edge.Kind, Type: edge.Type, Name: edge.Name})
+	}
+	for _, edge := range graph.Dependents {
+		rows = append(rows, graphRow{Relationship: relationshipRequiredBy, Kind: edge.Kind, Type: edge.Type, Name: edge.Name})
+	}
+
+	return rows
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testRedisID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Graph Command with resource ID",
+			Input:         []string{testRedisID},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Datastores/redisCaches", r.ResourceType)
+				require.Equal(t, testRedisID, r.ResourceName)
+				require.Equal(t, "table", r.Format)
+			},
+		},
+		{
+			Name:          "Graph Command with resource type and name",
+			Input:         []string{"Applications.Datastores/redisCaches", "redis", "--output", "json"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Datastores/redisCaches", r.ResourceType)
+				require.Equal(t, "redis", r.ResourceName)
+				require.Equal(t, "json", r.Format)
+			},
+		},
+		{
+			Name:          "Graph Command with invalid resource ID",
+			Input:         []string{"redis"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Graph Command with too many args",
+			Input:         []string{"Applications.Datastores/redisCaches", "redis", "foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	graph := clients.ResourceGraph{
+		ID:   testRedisID,
+		Name: "redis",
+		Type: "Applications.Datastores/redisCaches",
+		Dependencies: []clients.ResourceGraphEdge{
+			{
+				ID:   "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/redis",
+				Name: "redis",
+				Type: "apps/Deployment",
+				Kind: clients.ResourceGraphEdgeKindRecipeOutput,
+			},
+		},
+		Dependents: []clients.ResourceGraphEdge{
+			{
+				ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+				Name: "frontend",
+				Type: "Applications.Core/containers",
+				Kind: clients.ResourceGraphEdgeKindConnection,
+			},
+		},
+	}
+
+	setup := func(t *testing.T, graph clients.ResourceGraph, err error) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceGraph(gomock.Any(), "Applications.Datastores/redisCaches", "redis").
+			Return(graph, err).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         workspace,
+			ResourceType:      "Applications.Datastores/redisCaches",
+			ResourceName:      "redis",
+			Format:            "table",
+		}
+
+		return runner, outputSink
+	}
+
+	t.Run("Success: table", func(t *testing.T) {
+		runner, outputSink := setup(t, graph, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource %q of type %q:",
+				Params: []any{"redis", "Applications.Datastores/redisCaches"},
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []graphRow{
+					{Relationship: relationshipDependsOn, Kind: clients.ResourceGraphEdgeKindRecipeOutput, Type: "apps/Deployment", Name: "redis"},
+					{Relationship: relationshipRequiredBy, Kind: clients.ResourceGraphEdgeKindConnection, Type: "Applications.Core/containers", Name: "frontend"},
+				},
+				Options: objectformats.GetResourceGraphTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: json", func(t *testing.T) {
+		runner, outputSink := setup(t, graph, nil)
+		runner.Format = "json"
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     graph,
+				Options: objectformats.GetResourceGraphTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: no dependencies or dependents", func(t *testing.T) {
+		empty := clients.ResourceGraph{
+			ID:           testRedisID,
+			Name:         "redis",
+			Type:         "Applications.Datastores/redisCaches",
+			Dependencies: []clients.ResourceGraphEdge{},
+			Dependents:   []clients.ResourceGraphEdge{},
+		}
+		runner, outputSink := setup(t, empty, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource %q of type %q:",
+				Params: []any{"redis", "Applications.Datastores/redisCaches"},
+			},
+			output.LogOutput{
+				Format: "The resource has no dependencies or dependents.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Resource Not Found", func(t *testing.T) {
+		runner, _ := setup(t, clients.ResourceGraph{}, radcli.Create404Error())
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q was not found or has been deleted.", "redis", "Applications.Datastores/redisCaches"), err)
+	})
+}
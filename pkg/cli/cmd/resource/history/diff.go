@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+)
+
+// ignoredFields are the top-level fields of a resource that change on every update and are excluded from diffs.
+var ignoredFields = []string{"systemData"}
+
+// diffResources returns the changes between two revisions of a resource, sorted by path. Each change is formatted as
+// "+ path: value" for added values, "- path: value" for removed values, and "~ path: old -> new" for modified values.
+func diffResources(from *generated.GenericResource, to *generated.GenericResource) ([]string, error) {
+	fromValues, err := flattenResource(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toValues, err := flattenResource(to)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := map[string]struct{}{}
+	for path := range fromValues {
+		paths[path] = struct{}{}
+	}
+	for path := range toValues {
+		paths[path] = struct{}{}
+	}
+
+	sorted := []string{}
+	for path := range paths {
+		sorted = append(sorted, path)
+	}
+	sort.Strings(sorted)
+
+	changes := []string{}
+	for _, path := range sorted {
+		oldValue, hasOld := fromValues[path]
+		newValue, hasNew := toValues[path]
+		switch {
+		case !hasOld:
+			changes = append(changes, fmt.Sprintf("+ %s: %s", path, newValue))
+		case !hasNew:
+			changes = append(changes, fmt.Sprintf("- %s: %s", path, oldValue))
+		case oldValue != newValue:
+			changes = append(changes, fmt.Sprintf("~ %s: %s -> %s", path, oldValue, newValue))
+		}
+	}
+
+	return changes, nil
+}
+
+// flattenResource converts the resource into a map of JSON paths to JSON-encoded leaf values.
+func flattenResource(resource *generated.GenericResource) (map[string]string, error) {
+	result := map[string]string{}
+	if resource == nil {
+		return result, nil
+	}
+
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := map[string]any{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	for _, field := range ignoredFields {
+		delete(obj, field)
+	}
+
+	if err := flatten("", obj, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func flatten(prefix string, value any, result map[string]string) error {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			if err := flatten(path, child, result); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, child := range v {
+			if err := flatten(prefix+"["+strconv.Itoa(i)+"]", child, result); err != nil {
+				return err
+			}
+		}
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		result[prefix] = string(b)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+// ------------------------------------------------------------
+// Copyright 2023 The Radius Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// ------------------------------------------------------------.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_diffResources(t *testing.T) {
+	from := &generated.GenericResource{
+		Name: to.Ptr("foo"),
+		Tags: map[string]*string{"team": to.Ptr("a")},
+		Properties: map[string]any{
+			"container": map[string]any{"image": "v1", "ports": []any{80.0}},
+			"removed":   true,
+		},
+		SystemData: &generated.SystemData{CreatedBy: to.Ptr("a")},
+	}
+	updated := &generated.GenericResource{
+		Name: to.Ptr("foo"),
+		Tags: map[string]*string{"team": to.Ptr("a")},
+		Properties: map[string]any{
+			"container": map[string]any{"image": "v2", "ports": []any{80.0, 443.0}},
+		},
+		SystemData: &generated.SystemData{CreatedBy: to.Ptr("b")},
+	}
+
+	changes, err := diffResources(from, updated)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`~ properties.container.image: "v1" -> "v2"`,
+		`+ properties.container.ports[1]: 443`,
+		`- properties.removed: true`,
+	}, changes)
+
+	changes, err = diffResources(from, from)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	diffFlag = "diff"
+)
+
+// NewCommand creates an instance of the `rad resource history` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "history [resourceType] [resourceName]",
+		Short: "Show the revision history of a Radius resource",
+		Long: `Show the revision history of a Radius resource.
+
+Radius keeps a bounded number of prior revisions of a resource each time it is updated. Use --diff to show the
+changes made by each revision, ending with the current state of the resource.`,
+		Example: `
+# show the prior revisions of a container
+rad resource history containers orders
+
+# show the changes made between each revision of a container
+rad resource history containers orders --diff
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().Bool(diffFlag, false, "Show the changes between consecutive revisions of the resource")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource history` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+	Format            string
+	Diff              bool
+}
+
+// NewRunner creates a new instance of the `rad resource history` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource history` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.Diff, err = cmd.Flags().GetBool(diffFlag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource history` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := client.ListResourceHistory(ctx, r.ResourceType, r.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	if !r.Diff {
+		return r.Output.WriteFormatted(r.Format, revisions, objectformats.GetResourceRevisionTableFormat())
+	}
+
+	if len(revisions) == 0 {
+		r.Output.LogInfo("Resource %q of type %q has no prior revisions.", r.ResourceName, r.ResourceType)
+		return nil
+	}
+
+	current, err := client.GetResource(ctx, r.ResourceType, r.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	// Revisions are returned from newest to oldest. Walk them from oldest to newest so that each diff shows the
+	// changes made by the update that replaced the revision.
+	for i := len(revisions) - 1; i >= 0; i-- {
+		from := revisions[i]
+		next := &current
+		nextName := "current"
+		if i > 0 {
+			next = revisions[i-1].Resource
+			nextName = fmt.Sprintf("revision %d", revisionNumber(revisions[i-1]))
+		}
+
+		changes, err := diffResources(from.Resource, next)
+		if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("revision %d -> %s:", revisionNumber(from), nextName)
+		if len(changes) == 0 {
+			r.Output.LogInfo("  (no changes)")
+		}
+		for _, change := range changes {
+			r.Output.LogInfo("  %s", change)
+		}
+	}
+
+	return nil
+}
+
+func revisionNumber(revision *generated.GenericResourceRevision) int32 {
+	if revision.Revision == nil {
+		return 0
+	}
+	return *revision.Revision
+}
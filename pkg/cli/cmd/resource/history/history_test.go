@@ -0,0 +1,200 @@
+// ------------------------------------------------------------
+// Copyright 2023 The Radius Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// ------------------------------------------------------------.
+
+package history
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid History Command",
+			Input:         []string{"containers", "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.False(t, runner.(*Runner).Diff)
+			},
+		},
+		{
+			Name:          "History Command with diff",
+			Input:         []string{"containers", "foo", "--diff"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).Diff)
+			},
+		},
+		{
+			Name:          "History Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "History Command with insufficient args",
+			Input:         []string{"containers"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	createRevision := func(revision int32, image string) *generated.GenericResourceRevision {
+		resource := radcli.CreateResource("containers", "foo")
+		resource.Properties = map[string]any{"container": map[string]any{"image": image}}
+		return &generated.GenericResourceRevision{
+			Revision: to.Ptr(revision),
+			Etag:     to.Ptr("etag"),
+			Resource: &resource,
+		}
+	}
+
+	revisions := []*generated.GenericResourceRevision{
+		createRevision(2, "v2"),
+		createRevision(1, "v1"),
+	}
+
+	t.Run("table", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceHistory(gomock.Any(), "containers", "foo").
+			Return(revisions, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "containers",
+			ResourceName:      "foo",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     revisions,
+				Options: objectformats.GetResourceRevisionTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		current := radcli.CreateResource("containers", "foo")
+		current.Properties = map[string]any{"container": map[string]any{"image": "v3"}}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceHistory(gomock.Any(), "containers", "foo").
+			Return(revisions, nil).Times(1)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "containers", "foo").
+			Return(current, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "containers",
+			ResourceName:      "foo",
+			Format:            "table",
+			Diff:              true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "revision %d -> %s:", Params: []any{int32(1), "revision 2"}},
+			output.LogOutput{Format: "  %s", Params: []any{`~ properties.container.image: "v1" -> "v2"`}},
+			output.LogOutput{Format: "revision %d -> %s:", Params: []any{int32(2), "current"}},
+			output.LogOutput{Format: "  %s", Params: []any{`~ properties.container.image: "v2" -> "v3"`}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("diff without revisions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceHistory(gomock.Any(), "containers", "foo").
+			Return([]*generated.GenericResourceRevision{}, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "containers",
+			ResourceName:      "foo",
+			Format:            "table",
+			Diff:              true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "Resource %q of type %q has no prior revisions.", Params: []any{"foo", "containers"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rotatesecret
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	keyFlag = "key"
+)
+
+// NewCommand creates an instance of the `rad resource rotate-secret` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "rotate-secret [secretStoreName]",
+		Short: "Rotate the generated secrets of a secret store",
+		Long: `Rotate the generated secrets of an Applications.Core/secretStores resource.
+
+A new value is generated for each key of the secret store which has a generator. The containers which reference the secret store through environment variables are restarted so that they read the new values. Use --key to rotate only some of the keys.`,
+		Example: `
+# rotate all generated secrets of the 'db-credentials' secret store
+rad resource rotate-secret db-credentials
+
+# rotate only the 'password' key of the 'db-credentials' secret store
+rad resource rotate-secret db-credentials --key password
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().StringArray(keyFlag, []string{}, "Specify a key of the secret store to rotate. Can be specified multiple times. All generated keys are rotated when not specified.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource rotate-secret` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	SecretStoreName   string
+	Keys              []string
+}
+
+// NewRunner creates a new instance of the `rad resource rotate-secret` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource rotate-secret` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.SecretStoreName = args[0]
+
+	r.Keys, err = cmd.Flags().GetStringArray(keyFlag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource rotate-secret` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	request := corerpv20231001.SecretStoreRotateRequest{}
+	if len(r.Keys) > 0 {
+		request.Keys = to.SliceOfPtrs(r.Keys...)
+	}
+
+	result, err := client.RotateSecretStore(ctx, r.SecretStoreName, request)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The secret store %q was not found or has been deleted.", r.SecretStoreName)
+	} else if err != nil {
+		return err
+	}
+
+	rotatedKeys := toStrings(result.RotatedKeys)
+	if len(rotatedKeys) == 0 {
+		r.Output.LogInfo("No secrets of secret store %q were rotated.", r.SecretStoreName)
+		return nil
+	}
+
+	r.Output.LogInfo("Rotated secrets of secret store %q: %s", r.SecretStoreName, strings.Join(rotatedKeys, ", "))
+
+	restartedContainers := toStrings(result.RestartedContainers)
+	if len(restartedContainers) == 0 {
+		r.Output.LogInfo("No containers reference the secret store.")
+	} else {
+		r.Output.LogInfo("Restarted containers: %s", strings.Join(restartedContainers, ", "))
+	}
+
+	return nil
+}
+
+func toStrings(values []*string) []string {
+	result := []string{}
+	for _, value := range values {
+		if value != nil {
+			result = append(result, *value)
+		}
+	}
+
+	return result
+}
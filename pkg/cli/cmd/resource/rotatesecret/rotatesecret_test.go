@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rotatesecret
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid rotate-secret command",
+			Input:         []string{"db-credentials"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "db-credentials", r.SecretStoreName)
+				require.Empty(t, r.Keys)
+			},
+		},
+		{
+			Name:          "Valid rotate-secret command with keys",
+			Input:         []string{"db-credentials", "--key", "username", "--key", "password"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, []string{"username", "password"}, r.Keys)
+			},
+		},
+		{
+			Name:          "Valid rotate-secret command with fallback workspace",
+			Input:         []string{"db-credentials", "--group", "test-group"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "Rotate-secret command without secret store name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newRunner := func(t *testing.T, keys []string, expected corerpv20231001.SecretStoreRotateRequest, result corerpv20231001.SecretStoreRotateResult, err error) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			RotateSecretStore(gomock.Any(), "db-credentials", expected).
+			Return(result, err).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Name: "test-workspace"},
+			SecretStoreName:   "db-credentials",
+			Keys:              keys,
+		}, outputSink
+	}
+
+	t.Run("Rotates all keys", func(t *testing.T) {
+		runner, outputSink := newRunner(t, nil, corerpv20231001.SecretStoreRotateRequest{}, corerpv20231001.SecretStoreRotateResult{
+			RotatedKeys:         to.SliceOfPtrs("password", "username"),
+			RestartedContainers: to.SliceOfPtrs("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"),
+		}, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Rotated secrets of secret store %q: %s",
+				Params: []any{"db-credentials", "password, username"},
+			},
+			output.LogOutput{
+				Format: "Restarted containers: %s",
+				Params: []any{"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Rotates the given keys", func(t *testing.T) {
+		request := corerpv20231001.SecretStoreRotateRequest{Keys: to.SliceOfPtrs("password")}
+		runner, outputSink := newRunner(t, []string{"password"}, request, corerpv20231001.SecretStoreRotateResult{
+			RotatedKeys:         to.SliceOfPtrs("password"),
+			RestartedContainers: []*string{},
+		}, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Rotated secrets of secret store %q: %s",
+				Params: []any{"db-credentials", "password"},
+			},
+			output.LogOutput{
+				Format: "No containers reference the secret store.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("No generated keys", func(t *testing.T) {
+		runner, outputSink := newRunner(t, nil, corerpv20231001.SecretStoreRotateRequest{}, corerpv20231001.SecretStoreRotateResult{}, nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No secrets of secret store %q were rotated.",
+				Params: []any{"db-credentials"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Secret store not found", func(t *testing.T) {
+		runner, _ := newRunner(t, nil, corerpv20231001.SecretStoreRotateRequest{}, corerpv20231001.SecretStoreRotateResult{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The secret store %q was not found or has been deleted.", "db-credentials"), err)
+	})
+}
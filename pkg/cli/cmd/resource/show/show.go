@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/objectformats"
@@ -56,8 +57,9 @@ rad resource show containers orders --application icecream-store
 # show details of a specified resource in an application (shorthand flag)
 rad resource show containers orders -a icecream-store 
 `,
-		Args: cobra.ExactArgs(2),
-		RunE: framework.RunCommand(runner),
+		Args:              cobra.ExactArgs(2),
+		RunE:              framework.RunCommand(runner),
+		ValidArgsFunction: completion.ResourceTypeAndNames(factory),
 	}
 
 	commonflags.AddOutputFlag(cmd)
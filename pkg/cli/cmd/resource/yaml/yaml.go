@@ -0,0 +1,265 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package yaml
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const (
+	// redactedValue replaces the values of the data of secrets.
+	redactedValue = "<redacted>"
+
+	// lastAppliedConfigurationAnnotation is the annotation kubectl stores the last applied configuration in, which
+	// contains the data of secrets.
+	lastAppliedConfigurationAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// NewCommand creates an instance of the `rad resource yaml` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "yaml [resourceType] [resourceName]",
+		Short: "Show the live Kubernetes manifests of a Radius resource",
+		Long: `Show the live Kubernetes manifests of the output resources of a Radius resource.
+
+The Kubernetes resources Radius created for the resource, such as deployments, services and secrets, are read through the Kubernetes connection of the workspace and printed as YAML. Each manifest is preceded by the kubectl command which reads it. The values of secrets are redacted. Output resources which are not Kubernetes resources, such as Azure or AWS resources, are not shown.`,
+		Example: `
+# show the Kubernetes manifests of the 'frontend' container
+rad resource yaml containers frontend
+
+# show the Kubernetes manifests of the 'public' gateway in a resource group
+rad resource yaml Applications.Core/gateways public --group my-group
+`,
+		Args:              cobra.ExactArgs(2),
+		RunE:              framework.RunCommand(runner),
+		ValidArgsFunction: completion.ResourceTypeAndNames(factory),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource yaml` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+
+	kubeContext      string
+	kubernetesClient client.Client
+}
+
+// NewRunner creates a new instance of the `rad resource yaml` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource yaml` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("The workspace %q does not have a Kubernetes connection. Kubernetes manifests can only be read from Kubernetes.", r.Workspace.Name)
+	}
+	r.kubeContext = kubeContext
+
+	r.ResourceType, r.ResourceName, err = cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource yaml` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource, err := client.GetResource(ctx, r.ResourceType, r.ResourceName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of type %q was not found or has been deleted.", r.ResourceName, r.ResourceType)
+	} else if err != nil {
+		return err
+	}
+
+	ids := kubernetesOutputResources(resource.Properties)
+	if len(ids) == 0 {
+		return clierrors.Message("The resource %q of type %q does not have Kubernetes output resources.", r.ResourceName, r.ResourceType)
+	}
+
+	if r.kubernetesClient == nil {
+		r.kubernetesClient, err = kubernetes.NewRuntimeClient(r.kubeContext, kubernetes.Scheme)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, id := range ids {
+		if i > 0 {
+			r.Output.LogInfo("---")
+		}
+
+		manifest, err := r.getManifest(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		r.Output.LogInfo("# %s", kubectlCommand(id, r.kubeContext))
+		r.Output.LogInfo("%s", manifest)
+	}
+
+	return nil
+}
+
+// getManifest returns the YAML manifest of the Kubernetes resource with the given ID. A comment is returned if the
+// resource does not exist.
+func (r *Runner) getManifest(ctx context.Context, id resources.ID) (string, error) {
+	group, kind, namespace, name := resources_kubernetes.ToParts(id)
+	mapping, err := r.kubernetesClient.RESTMapper().RESTMapping(schema.GroupKind{Group: group, Kind: kind})
+	if err != nil {
+		return "", fmt.Errorf("failed to find the Kubernetes API of %s: %w", id.Type(), err)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(mapping.GroupVersionKind)
+	err = r.kubernetesClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj)
+	if apierrors.IsNotFound(err) {
+		return "# The resource was not found. It may have been deleted.", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	sanitize(obj)
+
+	b := &bytes.Buffer{}
+	if err := (&printers.YAMLPrinter{}).PrintObj(obj, b); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// sanitize removes the managed fields of a Kubernetes resource and redacts the data of secrets.
+func sanitize(obj *unstructured.Unstructured) {
+	obj.SetManagedFields(nil)
+
+	if obj.GroupVersionKind().GroupKind() != (schema.GroupKind{Kind: resources_kubernetes.KindSecret}) {
+		return
+	}
+
+	for _, field := range []string{"data", "stringData"} {
+		data, found, _ := unstructured.NestedMap(obj.Object, field)
+		if !found {
+			continue
+		}
+		for key := range data {
+			data[key] = redactedValue
+		}
+		_ = unstructured.SetNestedMap(obj.Object, data, field)
+	}
+
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[lastAppliedConfigurationAnnotation]; ok {
+		annotations[lastAppliedConfigurationAnnotation] = redactedValue
+		obj.SetAnnotations(annotations)
+	}
+}
+
+// kubernetesOutputResources returns the IDs of the Kubernetes output resources of a resource.
+func kubernetesOutputResources(properties map[string]any) []resources.ID {
+	ids := []resources.ID{}
+	status, _ := properties["status"].(map[string]any)
+	outputResources, _ := status["outputResources"].([]any)
+	for _, outputResource := range outputResources {
+		outputResource, _ := outputResource.(map[string]any)
+		id, err := resources.ParseResource(fmt.Sprint(outputResource["id"]))
+		if err != nil || !id.IsUCPQualified() || id.FindScope(resources_kubernetes.PlaneTypeKubernetes) == "" {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// kubectlCommand returns the kubectl command which reads the Kubernetes resource with the given ID.
+func kubectlCommand(id resources.ID, kubeContext string) string {
+	group, kind, namespace, name := resources_kubernetes.ToParts(id)
+	resource := strings.ToLower(kind)
+	if group != "" {
+		resource += "." + group
+	}
+
+	command := fmt.Sprintf("kubectl get %s %s", resource, name)
+	if namespace != "" {
+		command += " --namespace " + namespace
+	}
+	if kubeContext != "" {
+		command += " --context " + kubeContext
+	}
+
+	return command + " -o yaml"
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package yaml
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid yaml command",
+			Input:         []string{"containers", "frontend"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Core/containers", r.ResourceType)
+				require.Equal(t, "frontend", r.ResourceName)
+				require.Equal(t, "test-context", r.kubeContext)
+			},
+		},
+		{
+			Name:          "Valid yaml command with fallback workspace",
+			Input:         []string{"containers", "frontend", "--group", "test-group"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "Yaml command with invalid resource type",
+			Input:         []string{"invalidResourceType", "frontend"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Yaml command without resource name",
+			Input:         []string{"containers"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newContainer := func(outputResources ...string) generated.GenericResource {
+		resources := []any{}
+		for _, id := range outputResources {
+			resources = append(resources, map[string]any{"id": id})
+		}
+
+		return generated.GenericResource{
+			Name:       to.Ptr("frontend"),
+			Properties: map[string]any{"status": map[string]any{"outputResources": resources}},
+		}
+	}
+
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Group: "apps", Version: "v1"}, {Version: "v1"}})
+	restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Service"}, meta.RESTScopeNamespace)
+	restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, meta.RESTScopeNamespace)
+
+	kubernetesClient := fake.NewClientBuilder().
+		WithScheme(kubernetes.Scheme).
+		WithRESTMapper(restMapper).
+		WithObjects(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "test-ns"}},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "frontend",
+					Namespace:   "test-ns",
+					Annotations: map[string]string{lastAppliedConfigurationAnnotation: "{\"data\":{\"password\":\"c2VjcmV0\"}}"},
+				},
+				Data: map[string][]byte{"password": []byte("secret")},
+			},
+		).
+		Build()
+
+	newRunner := func(t *testing.T, resource generated.GenericResource, err error) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResource(gomock.Any(), "Applications.Core/containers", "frontend").
+			Return(resource, err).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Name: "test-workspace"},
+			ResourceType:      "Applications.Core/containers",
+			ResourceName:      "frontend",
+			kubeContext:       "test-context",
+			kubernetesClient:  kubernetesClient,
+		}, outputSink
+	}
+
+	t.Run("Prints the manifests of the Kubernetes output resources", func(t *testing.T) {
+		runner, outputSink := newRunner(t, newContainer(
+			"/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/frontend",
+			"/planes/kubernetes/local/namespaces/test-ns/providers/core/Secret/frontend",
+			"/planes/kubernetes/local/namespaces/test-ns/providers/core/Service/frontend",
+			"/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/cache",
+		), nil)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 8)
+		require.Equal(t, output.LogOutput{
+			Format: "# %s",
+			Params: []any{"kubectl get deployment.apps frontend --namespace test-ns --context test-context -o yaml"},
+		}, outputSink.Writes[0])
+		require.Contains(t, outputSink.Writes[1].(output.LogOutput).Params[0], "kind: Deployment")
+
+		require.Equal(t, output.LogOutput{Format: "---"}, outputSink.Writes[2])
+		require.Equal(t, output.LogOutput{
+			Format: "# %s",
+			Params: []any{"kubectl get secret frontend --namespace test-ns --context test-context -o yaml"},
+		}, outputSink.Writes[3])
+		secret := outputSink.Writes[4].(output.LogOutput).Params[0].(string)
+		require.Contains(t, secret, "password: <redacted>")
+		require.NotContains(t, secret, "c2VjcmV0")
+		require.False(t, strings.Contains(secret, "managedFields"))
+
+		require.Equal(t, output.LogOutput{
+			Format: "%s",
+			Params: []any{"# The resource was not found. It may have been deleted."},
+		}, outputSink.Writes[7])
+	})
+
+	t.Run("Resource not found", func(t *testing.T) {
+		runner, _ := newRunner(t, generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q was not found or has been deleted.", "frontend", "Applications.Core/containers"), err)
+	})
+
+	t.Run("Resource without Kubernetes output resources", func(t *testing.T) {
+		runner, _ := newRunner(t, newContainer("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/cache"), nil)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q does not have Kubernetes output resources.", "frontend", "Applications.Core/containers"), err)
+	})
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
@@ -33,7 +35,7 @@ import (
 )
 
 const (
-	deleteConfirmation = "Are you sure you want to delete resource provider %q? This will delete all resource types and resources defined by the resource provider."
+	deleteConfirmation = "Are you sure you want to delete resource provider %q? This will delete all resource types defined by the resource provider."
 )
 
 // NewCommand creates an instance of the `rad resource-provider delete` command and runner.
@@ -49,13 +51,16 @@ Resource providers are the entities that implement resource types such as 'Appli
 
 Deleting a resource provider will delete all resource types that it defines. For example, deleting 'Applications.Core' will delete 'Applications.Core/containers' and all other resource types defined by 'Applications.Core'.
 
-Deleting a resource type will delete all resources of the types. For example, deleting 'Applications.Core/containers' will delete all containers.`,
+Deleting a resource provider does not delete the resources of its resource types, and those resources can no longer be managed once the resource provider is deleted. The resource provider is not deleted while such resources exist, and the affected resources are listed instead. Delete the resources first, or use '--force' to delete the resource provider anyway.`,
 		Example: `
 # Delete a resource provider
 rad resource-provider delete Applications.Core
 
 # Delete a resource provider (bypass confirmation)
-rad resource-provider delete Applications.Core --yes`,
+rad resource-provider delete Applications.Core --yes
+
+# Delete a resource provider even when resources of its resource types exist
+rad resource-provider delete Applications.Core --force`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
 	}
@@ -63,6 +68,7 @@ rad resource-provider delete Applications.Core --yes`,
 	commonflags.AddConfirmationFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().Bool("force", false, "Delete the resource provider even when resources of its resource types exist. The resources are not deleted.")
 
 	return cmd, runner
 }
@@ -77,6 +83,7 @@ type Runner struct {
 	Workspace         *workspaces.Workspace
 
 	Confirm                   bool
+	Force                     bool
 	ResourceProviderNamespace string
 }
 
@@ -109,6 +116,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.Force, err = cmd.Flags().GetBool("force")
+	if err != nil {
+		return err
+	}
+
 	r.ResourceProviderNamespace = args[0]
 
 	return nil
@@ -132,9 +144,17 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
-	deleted, err := client.DeleteResourceProvider(ctx, "local", r.ResourceProviderNamespace)
+	deleted, err := client.DeleteResourceProvider(ctx, "local", r.ResourceProviderNamespace, r.Force)
 	if clients.Is404Error(err) {
 		return clierrors.Message("The resource provider %q was not found or has been deleted.", r.ResourceProviderNamespace)
+	} else if details := clientv2.TryUnfoldResponseError(err); details != nil && details.Code == v1.CodeConflict && len(details.Details) > 0 {
+		r.Output.LogInfo("Resources of resource provider %q:", r.ResourceProviderNamespace)
+		for _, detail := range details.Details {
+			r.Output.LogInfo("  %s", detail.Target)
+		}
+		r.Output.LogInfo("")
+
+		return clierrors.Message("The resource provider %q cannot be deleted because %d resource(s) of its resource types exist. Delete the resources first, or use '--force' to delete the resource provider anyway.", r.ResourceProviderNamespace, len(details.Details))
 	} else if err != nil {
 		return err
 	}
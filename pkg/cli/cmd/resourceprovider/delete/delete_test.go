@@ -19,9 +19,15 @@ package delete
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -45,6 +51,12 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: true,
 			ConfigHolder:  framework.ConfigHolder{Config: config},
 		},
+		{
+			Name:          "Valid: force",
+			Input:         []string{"Applications.Test", "--force"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
 		{
 			Name:          "Invalid: too many arguments",
 			Input:         []string{"Applications.Test", "dddd"},
@@ -68,7 +80,7 @@ func Test_Run(t *testing.T) {
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 		appManagementClient.EXPECT().
-			DeleteResourceProvider(gomock.Any(), "local", "Applications.Test").
+			DeleteResourceProvider(gomock.Any(), "local", "Applications.Test", false).
 			Return(true, nil).
 			Times(1)
 
@@ -109,7 +121,7 @@ func Test_Run(t *testing.T) {
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 		appManagementClient.EXPECT().
-			DeleteResourceProvider(gomock.Any(), "local", "Applications.Test").
+			DeleteResourceProvider(gomock.Any(), "local", "Applications.Test", false).
 			Return(false, nil).
 			Times(1)
 
@@ -150,7 +162,7 @@ func Test_Run(t *testing.T) {
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 		appManagementClient.EXPECT().
-			DeleteResourceProvider(gomock.Any(), "local", "Applications.Test").
+			DeleteResourceProvider(gomock.Any(), "local", "Applications.Test", false).
 			Return(true, nil).
 			Times(1)
 
@@ -190,4 +202,117 @@ func Test_Run(t *testing.T) {
 
 		require.Equal(t, expected, outputSink.Writes)
 	})
+
+	t.Run("Success: Force", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DeleteResourceProvider(gomock.Any(), "local", "Applications.Test", true).
+			Return(true, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 workspace,
+			Format:                    "table",
+			Output:                    outputSink,
+			ResourceProviderNamespace: "Applications.Test",
+			Confirm:                   true,
+			Force:                     true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource provider %q deleted.",
+				Params: []any{"Applications.Test"},
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Resources Exist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		body := `{
+			"error": {
+				"code": "Conflict",
+				"message": "the resource provider 'Applications.Test' cannot be deleted",
+				"details": [
+					{"code": "Conflict", "message": "in use", "target": "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testResources/a"},
+					{"code": "Conflict", "message": "in use", "target": "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testResources/b"}
+				]
+			}
+		}`
+		responseError := &azcore.ResponseError{
+			ErrorCode:  v1.CodeConflict,
+			StatusCode: http.StatusConflict,
+			RawResponse: &http.Response{
+				StatusCode: http.StatusConflict,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DeleteResourceProvider(gomock.Any(), "local", "Applications.Test", false).
+			Return(false, responseError).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 workspace,
+			Format:                    "table",
+			Output:                    outputSink,
+			ResourceProviderNamespace: "Applications.Test",
+			Confirm:                   true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource provider %q cannot be deleted because %d resource(s) of its resource types exist. Delete the resources first, or use '--force' to delete the resource provider anyway.", "Applications.Test", 2), err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resources of resource provider %q:",
+				Params: []any{"Applications.Test"},
+			},
+			output.LogOutput{
+				Format: "  %s",
+				Params: []any{"/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testResources/a"},
+			},
+			output.LogOutput{
+				Format: "  %s",
+				Params: []any{"/planes/radius/local/resourceGroups/rg/providers/Applications.Test/testResources/b"},
+			},
+			output.LogOutput{
+				Format: "",
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -88,6 +88,7 @@ rad run app.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	cmd.Flags().StringArrayP("parameters", "p", []string{}, "Specify parameters for the deployment")
+	deploycmd.AddProgressFlag(cmd)
 
 	return cmd, runner
 }
@@ -246,6 +247,7 @@ func (r *Runner) Run(ctx context.Context) error {
 			ApplicationName: r.ApplicationName,
 			Namespace:       namespace,
 			KubeClient:      r.kubernetesClient,
+			Follow:          true,
 
 			// Right now we don't need an abstraction for this because we don't really
 			// run the streaming logs in unit tests.
@@ -143,7 +143,7 @@ func Test_Run(t *testing.T) {
 
 	bicep := bicep.NewMockInterface(ctrl)
 	bicep.EXPECT().
-		PrepareTemplate("app.bicep").
+		PrepareTemplate(gomock.Any(), "app.bicep").
 		Return(map[string]any{}, nil).
 		Times(1)
 
@@ -297,6 +297,7 @@ func Test_Run(t *testing.T) {
 	// Logstream is scoped to application and namespace
 	require.Equal(t, runner.ApplicationName, logStreamOptions.ApplicationName)
 	require.Equal(t, "test-namespace-app", logStreamOptions.Namespace)
+	require.True(t, logStreamOptions.Follow)
 
 	appPortforwardOptions := <-appPortforwardOptionsChan
 	// Application Portforward is scoped to application and app namespace
@@ -338,7 +339,7 @@ func Test_Run_NoDashboard(t *testing.T) {
 
 	bicep := bicep.NewMockInterface(ctrl)
 	bicep.EXPECT().
-		PrepareTemplate("app.bicep").
+		PrepareTemplate(gomock.Any(), "app.bicep").
 		Return(map[string]any{}, nil).
 		Times(1)
 
@@ -472,6 +473,7 @@ func Test_Run_NoDashboard(t *testing.T) {
 	// Logstream is scoped to application and namespace
 	require.Equal(t, runner.ApplicationName, logStreamOptions.ApplicationName)
 	require.Equal(t, "test-namespace-app", logStreamOptions.Namespace)
+	require.True(t, logStreamOptions.Follow)
 
 	appPortforwardOptions := <-appPortforwardOptionsChan
 	// Application Portforward is scoped to application and app namespace
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disable
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad telemetry disable` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Disable anonymous usage reporting",
+		Long:  `Opt out of anonymous usage reporting of the rad CLI.`,
+		Example: `# Disable anonymous usage reporting
+rad telemetry disable`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad telemetry disable` command.
+type Runner struct {
+	ConfigHolder        *framework.ConfigHolder
+	ConfigFileInterface framework.ConfigFileInterface
+	Output              output.Interface
+}
+
+// NewRunner creates a new instance of the `rad telemetry disable` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:        factory.GetConfigHolder(),
+		ConfigFileInterface: factory.GetConfigFileInterface(),
+		Output:              factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad telemetry disable` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+// Run runs the `rad telemetry disable` command.
+func (r *Runner) Run(ctx context.Context) error {
+	section, err := cli.ReadTelemetrySection(r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+
+	if section.Enabled == false {
+		r.Output.LogInfo("Telemetry is already disabled.")
+		return nil
+	}
+
+	err = r.ConfigFileInterface.SetTelemetryEnabled(ctx, r.ConfigHolder.Config, false)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Telemetry disabled.")
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "enable valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "enable too-many-args invalid",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Telemetry already enabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+
+		config := viper.New()
+		cli.UpdateTelemetrySection(config, cli.TelemetrySection{Enabled: true})
+
+		// No calls expected for this case.
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: config},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Telemetry is already enabled.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Telemetry enabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+
+		config := viper.New()
+		cli.UpdateTelemetrySection(config, cli.TelemetrySection{Enabled: false})
+
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+		configFile.EXPECT().
+			SetTelemetryEnabled(gomock.Any(), config, true).
+			Return(nil).
+			Times(1)
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: config},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Telemetry enabled.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
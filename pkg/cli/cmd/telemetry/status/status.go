@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/telemetry"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad telemetry status` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of anonymous usage reporting",
+		Long:  `Show whether anonymous usage reporting is enabled, the endpoint that events are uploaded to, and the number of events waiting in the local queue.`,
+		Example: `# Show the status of anonymous usage reporting
+rad telemetry status`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Status is the status of anonymous usage reporting.
+type Status struct {
+	Enabled      bool
+	Endpoint     string
+	QueuedEvents int
+}
+
+// Runner is the runner implementation for the `rad telemetry status` command.
+type Runner struct {
+	ConfigHolder *framework.ConfigHolder
+	Output       output.Interface
+	Format       string
+}
+
+// NewRunner creates a new instance of the `rad telemetry status` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad telemetry status` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad telemetry status` command.
+func (r *Runner) Run(ctx context.Context) error {
+	section, err := cli.ReadTelemetrySection(r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+
+	path, err := telemetry.QueuePath(r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+
+	events, err := (&telemetry.Queue{Path: path}).Read()
+	if err != nil {
+		return err
+	}
+
+	status := Status{
+		Enabled:      section.Enabled,
+		Endpoint:     section.Endpoint,
+		QueuedEvents: len(events),
+	}
+
+	return r.Output.WriteFormatted(r.Format, status, statusFormat())
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/telemetry"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "status valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "status too-many-args invalid",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	config := viper.New()
+	config.SetConfigFile(filepath.Join(t.TempDir(), "config.yaml"))
+	cli.UpdateTelemetrySection(config, cli.TelemetrySection{Enabled: true, Endpoint: "https://example.com/events"})
+
+	path, err := telemetry.QueuePath(config)
+	require.NoError(t, err)
+	_, err = (&telemetry.Queue{Path: path}).Append(telemetry.Event{Command: "rad deploy"})
+	require.NoError(t, err)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConfigHolder: &framework.ConfigHolder{Config: config},
+		Output:       outputSink,
+		Format:       "table",
+	}
+
+	err = runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format: "table",
+			Obj: Status{
+				Enabled:      true,
+				Endpoint:     "https://example.com/events",
+				QueuedEvents: 1,
+			},
+			Options: statusFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
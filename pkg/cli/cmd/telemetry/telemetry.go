@@ -0,0 +1,31 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telemetry
+
+import "github.com/spf13/cobra"
+
+// NewCommand returns a new cobra command for `rad telemetry`.
+func NewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "telemetry",
+		Short: "Manage anonymous usage reporting",
+		Long: `Manage anonymous usage reporting of the rad CLI.
+
+Usage reporting is disabled unless you opt in with 'rad telemetry enable'. Only the name of each command, its duration,
+whether it succeeded, and the version, operating system, and architecture of the CLI are recorded.`,
+	}
+}
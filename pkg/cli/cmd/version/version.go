@@ -0,0 +1,302 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/Masterminds/semver"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/update"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad version` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Prints the versions of the rad cli",
+		Long: `Prints the versions of the rad CLI.
+
+Use '--check-updates' to query the Radius release feed. The rad CLI and the Radius control plane of the current workspace are compared with the latest release, version skew between them is reported, and the commands to upgrade are printed.`,
+		Example: `# Print the versions of the rad CLI
+rad version
+
+# Print only the version of the rad CLI
+rad version --cli
+
+# Check for newer releases of Radius and print the commands to upgrade
+rad version --check-updates`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().Bool("cli", false, "Use this flag to only show the rad CLI version")
+	cmd.Flags().Bool("check-updates", false, "Check for newer releases of Radius and version skew with the control plane of the workspace")
+
+	return cmd, runner
+}
+
+// Info is the version information of the rad CLI.
+type Info struct {
+	Release string `json:"release"`
+	Version string `json:"version"`
+	Bicep   string `json:"bicep"`
+	Commit  string `json:"commit"`
+
+	// Updates is the result of the update check. It is only set when '--check-updates' is specified.
+	Updates *UpdateStatus `json:"updates,omitempty"`
+}
+
+// UpdateStatus is the result of checking the rad CLI and the control plane of the workspace for updates.
+type UpdateStatus struct {
+	// LatestVersion is the latest stable release of Radius.
+	LatestVersion string `json:"latestVersion"`
+
+	// CLIVersion is the version of the rad CLI.
+	CLIVersion string `json:"cliVersion"`
+
+	// CLIUpdateAvailable is true if a newer release of the rad CLI is available.
+	CLIUpdateAvailable bool `json:"cliUpdateAvailable"`
+
+	// ControlPlaneVersion is the version of the Radius control plane of the workspace. It is empty if the version
+	// could not be determined.
+	ControlPlaneVersion string `json:"controlPlaneVersion,omitempty"`
+
+	// ControlPlaneUpdateAvailable is true if a newer release of the Radius control plane is available.
+	ControlPlaneUpdateAvailable bool `json:"controlPlaneUpdateAvailable"`
+
+	// VersionSkew is true if the versions of the rad CLI and the Radius control plane are not compatible.
+	VersionSkew bool `json:"versionSkew"`
+
+	// UpgradeCommands are the commands to run, in order, to upgrade the rad CLI and the Radius control plane.
+	UpgradeCommands []string `json:"upgradeCommands,omitempty"`
+
+	// controlPlaneErr is the error that prevented determining the version of the control plane.
+	controlPlaneErr error
+}
+
+// Runner is the runner implementation for the `rad version` command.
+type Runner struct {
+	ConfigHolder *framework.ConfigHolder
+	Helm         helm.Interface
+	Output       output.Interface
+	ReleaseFeed  *update.Feed
+	Workspace    *workspaces.Workspace
+
+	// CLIVersion is the semver version of the rad CLI compared with releases.
+	CLIVersion string
+
+	Format       string
+	CLIOnly      bool
+	CheckUpdates bool
+}
+
+// NewRunner creates a new instance of the `rad version` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Helm:         factory.GetHelmInterface(),
+		Output:       factory.GetOutput(),
+		ReleaseFeed:  update.NewFeed(),
+		CLIVersion:   version.ChartVersion(),
+	}
+}
+
+// Validate runs validation for the `rad version` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	cliOnly, err := cmd.Flags().GetBool("cli")
+	if err != nil {
+		return err
+	}
+
+	checkUpdates, err := cmd.Flags().GetBool("check-updates")
+	if err != nil {
+		return err
+	}
+
+	if checkUpdates {
+		workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+		if err != nil {
+			return err
+		}
+		r.Workspace = workspace
+	}
+
+	r.Format = format
+	r.CLIOnly = cliOnly
+	r.CheckUpdates = checkUpdates
+
+	return nil
+}
+
+// Run runs the `rad version` command.
+func (r *Runner) Run(ctx context.Context) error {
+	info := Info{
+		Release: version.Release(),
+		Version: version.Version(),
+		Bicep:   bicep.Version(ctx),
+		Commit:  version.Commit(),
+	}
+
+	if r.CheckUpdates {
+		status, err := r.checkUpdates(ctx)
+		if err != nil {
+			return err
+		}
+		info.Updates = status
+	}
+
+	if r.CLIOnly {
+		r.Output.LogInfo("%s", info.Version)
+	} else {
+		err := r.Output.WriteFormatted(r.Format, info, versionFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	// The update check is part of the object written in the other formats.
+	if info.Updates != nil && (r.CLIOnly || r.Format == output.FormatTable) {
+		r.displayUpdates(info.Updates)
+	}
+
+	return nil
+}
+
+// checkUpdates compares the rad CLI and the control plane of the workspace with the latest release of Radius and
+// with each other, and computes the commands to upgrade them.
+func (r *Runner) checkUpdates(ctx context.Context) (*UpdateStatus, error) {
+	latest, err := r.ReleaseFeed.LatestVersion(ctx)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to check for updates of Radius.")
+	}
+
+	status := &UpdateStatus{
+		LatestVersion: latest.String(),
+		CLIVersion:    r.CLIVersion,
+	}
+
+	// Development builds are not compared with releases.
+	cliVersion := parseRelease(r.CLIVersion)
+	if cliVersion != nil {
+		status.CLIUpdateAvailable = latest.GreaterThan(cliVersion)
+	}
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return status, nil
+	}
+
+	state, err := r.Helm.CheckRadiusInstall(kubeContext)
+	if err != nil {
+		status.controlPlaneErr = err
+		return status, nil
+	} else if !state.RadiusInstalled {
+		return status, nil
+	}
+
+	status.ControlPlaneVersion = state.RadiusVersion
+	status.VersionSkew = !workspaces.IsCompatibleVersion(state.RadiusVersion, r.CLIVersion)
+
+	controlPlaneVersion := parseRelease(state.RadiusVersion)
+	if controlPlaneVersion != nil {
+		status.ControlPlaneUpdateAvailable = latest.GreaterThan(controlPlaneVersion)
+	}
+
+	if cliVersion == nil {
+		return status, nil
+	}
+
+	// The control plane is upgraded to the version of the rad CLI, so the rad CLI is upgraded first.
+	if status.CLIUpdateAvailable {
+		status.UpgradeCommands = append(status.UpgradeCommands, update.CLIUpgradeCommand(latest, runtime.GOOS))
+	} else if status.VersionSkew && controlPlaneVersion != nil && controlPlaneVersion.GreaterThan(cliVersion) {
+		status.UpgradeCommands = append(status.UpgradeCommands, update.CLIUpgradeCommand(controlPlaneVersion, runtime.GOOS))
+	}
+
+	if status.ControlPlaneUpdateAvailable || (status.VersionSkew && (controlPlaneVersion == nil || cliVersion.GreaterThan(controlPlaneVersion))) {
+		status.UpgradeCommands = append(status.UpgradeCommands, update.ControlPlaneUpgradeCommand(kubeContext))
+	}
+
+	return status, nil
+}
+
+// displayUpdates writes the result of the update check as a human-readable report.
+func (r *Runner) displayUpdates(status *UpdateStatus) {
+	r.Output.LogInfo("")
+	r.Output.LogInfo("Latest release of Radius: %s", status.LatestVersion)
+
+	if parseRelease(status.CLIVersion) == nil {
+		r.Output.LogInfo("The rad CLI version %q is a development build and is not compared with releases.", status.CLIVersion)
+	} else if status.CLIUpdateAvailable {
+		r.Output.LogInfo("A newer version of the rad CLI is available: %s (installed: %s).", status.LatestVersion, status.CLIVersion)
+	} else {
+		r.Output.LogInfo("The rad CLI is up to date.")
+	}
+
+	if status.controlPlaneErr != nil {
+		r.Output.LogInfo("Unable to determine the version of the Radius control plane of the current workspace: %v", status.controlPlaneErr)
+	} else if status.ControlPlaneVersion == "" {
+		r.Output.LogInfo("The Radius control plane is not installed in the current workspace.")
+	} else if status.ControlPlaneUpdateAvailable {
+		r.Output.LogInfo("A newer version of the Radius control plane is available: %s (installed: %s).", status.LatestVersion, status.ControlPlaneVersion)
+	} else {
+		r.Output.LogInfo("The Radius control plane of the current workspace is at version %s.", status.ControlPlaneVersion)
+	}
+
+	if status.VersionSkew {
+		r.Output.LogInfo("Warning: Radius version %q of the current workspace does not match the rad CLI version %q. Some features may not be available.", status.ControlPlaneVersion, status.CLIVersion)
+	}
+
+	if len(status.UpgradeCommands) > 0 {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("To upgrade, run:")
+		for _, command := range status.UpgradeCommands {
+			r.Output.LogInfo("  %s", command)
+		}
+	}
+}
+
+// parseRelease parses a version of a release of Radius. It returns nil if the version is not valid semver or is a
+// pre-release, which is the case of development builds.
+func parseRelease(v string) *semver.Version {
+	parsed, err := semver.NewVersion(v)
+	if err != nil || parsed.Prerelease() != "" {
+		return nil
+	}
+
+	return parsed
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/Masterminds/semver"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/update"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "version valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.False(t, runner.(*Runner).CheckUpdates)
+				require.Nil(t, runner.(*Runner).Workspace)
+			},
+		},
+		{
+			Name:          "version check updates valid",
+			Input:         []string{"--check-updates"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).CheckUpdates)
+				require.Equal(t, radcli.TestWorkspaceName, runner.(*Runner).Workspace.Name)
+			},
+		},
+		{
+			Name:          "version check updates fallback workspace valid",
+			Input:         []string{"--check-updates"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "version too-many-args invalid",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	feedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"tag_name": "v0.44.0-rc1", "prerelease": true}, {"tag_name": "v0.43.0"}]`))
+	}))
+	t.Cleanup(feedServer.Close)
+	feed := &update.Feed{URL: feedServer.URL, HTTPClient: feedServer.Client()}
+
+	workspace := &workspaces.Workspace{
+		Name: "test-workspace",
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+	}
+
+	t.Run("Without update check", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output: outputSink,
+			Format: "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		formatted := outputSink.Writes[0].(output.FormattedOutput)
+		require.Equal(t, versionFormat(), formatted.Options)
+		require.Nil(t, formatted.Obj.(Info).Updates)
+	})
+
+	t.Run("Updates available", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		helmMock.EXPECT().
+			CheckRadiusInstall("kind-kind").
+			Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "0.41.0"}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Helm:         helmMock,
+			Output:       outputSink,
+			ReleaseFeed:  feed,
+			Workspace:    workspace,
+			CLIVersion:   "0.42.0",
+			Format:       "table",
+			CheckUpdates: true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := &UpdateStatus{
+			LatestVersion:               "0.43.0",
+			CLIVersion:                  "0.42.0",
+			CLIUpdateAvailable:          true,
+			ControlPlaneVersion:         "0.41.0",
+			ControlPlaneUpdateAvailable: true,
+			VersionSkew:                 true,
+			UpgradeCommands: []string{
+				update.CLIUpgradeCommand(semver.MustParse("0.43.0"), runtime.GOOS),
+				"rad install kubernetes --reinstall --kubecontext kind-kind",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes[0].(output.FormattedOutput).Obj.(Info).Updates)
+
+		require.Contains(t, outputSink.Writes, output.LogOutput{
+			Format: "A newer version of the rad CLI is available: %s (installed: %s).",
+			Params: []any{"0.43.0", "0.42.0"},
+		})
+		require.Contains(t, outputSink.Writes, output.LogOutput{
+			Format: "Warning: Radius version %q of the current workspace does not match the rad CLI version %q. Some features may not be available.",
+			Params: []any{"0.41.0", "0.42.0"},
+		})
+		require.Equal(t, output.LogOutput{
+			Format: "  %s",
+			Params: []any{"rad install kubernetes --reinstall --kubecontext kind-kind"},
+		}, outputSink.Writes[len(outputSink.Writes)-1])
+	})
+
+	t.Run("Control plane newer than CLI", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		helmMock.EXPECT().
+			CheckRadiusInstall("kind-kind").
+			Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "0.43.0"}, nil).
+			Times(1)
+
+		runner := &Runner{
+			Helm:         helmMock,
+			Output:       &output.MockOutput{},
+			ReleaseFeed:  feed,
+			Workspace:    workspace,
+			CLIVersion:   "0.43.0",
+			Format:       "json",
+			CheckUpdates: true,
+		}
+
+		status, err := runner.checkUpdates(context.Background())
+		require.NoError(t, err)
+		require.False(t, status.CLIUpdateAvailable)
+		require.False(t, status.ControlPlaneUpdateAvailable)
+		require.False(t, status.VersionSkew)
+		require.Empty(t, status.UpgradeCommands)
+
+		// A rad CLI older than the control plane is upgraded to the version of the control plane.
+		runner.CLIVersion = "0.42.0"
+		helmMock.EXPECT().
+			CheckRadiusInstall("kind-kind").
+			Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "0.43.0"}, nil).
+			Times(1)
+
+		status, err = runner.checkUpdates(context.Background())
+		require.NoError(t, err)
+		require.True(t, status.VersionSkew)
+		require.Equal(t, []string{update.CLIUpgradeCommand(semver.MustParse("0.43.0"), runtime.GOOS)}, status.UpgradeCommands)
+	})
+
+	t.Run("Control plane unavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		helmMock.EXPECT().
+			CheckRadiusInstall("kind-kind").
+			Return(helm.InstallState{}, errors.New("cluster unreachable")).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Helm:         helmMock,
+			Output:       outputSink,
+			ReleaseFeed:  feed,
+			Workspace:    workspace,
+			CLIVersion:   "0.43.0",
+			CLIOnly:      true,
+			CheckUpdates: true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "The rad CLI is up to date."})
+		require.Contains(t, outputSink.Writes, output.LogOutput{
+			Format: "Unable to determine the version of the Radius control plane of the current workspace: %v",
+			Params: []any{errors.New("cluster unreachable")},
+		})
+	})
+
+	t.Run("Development build", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		helmMock := helm.NewMockInterface(ctrl)
+		helmMock.EXPECT().
+			CheckRadiusInstall("kind-kind").
+			Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "0.41.0"}, nil).
+			Times(1)
+
+		runner := &Runner{
+			Helm:         helmMock,
+			Output:       &output.MockOutput{},
+			ReleaseFeed:  feed,
+			Workspace:    workspace,
+			CLIVersion:   "0.42.42-dev",
+			CheckUpdates: true,
+		}
+
+		status, err := runner.checkUpdates(context.Background())
+		require.NoError(t, err)
+		require.False(t, status.CLIUpdateAvailable)
+		require.True(t, status.ControlPlaneUpdateAvailable)
+		require.Empty(t, status.UpgradeCommands)
+	})
+
+	t.Run("Release feed failure", func(t *testing.T) {
+		failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		t.Cleanup(failingServer.Close)
+
+		runner := &Runner{
+			Output:       &output.MockOutput{},
+			ReleaseFeed:  &update.Feed{URL: failingServer.URL, HTTPClient: failingServer.Client()},
+			Workspace:    workspace,
+			CLIVersion:   "0.43.0",
+			Format:       "table",
+			CheckUpdates: true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.True(t, clierrors.IsFriendlyError(err))
+	})
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/version"
 	"github.com/spf13/cobra"
 )
 
@@ -49,6 +50,8 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		
 Available workspaceTypes: kubernetes
 
+The workspace can use any context of your kubeconfig. The version of Radius installed in the context is verified and recorded in the workspace, and a warning is shown if it is not compatible with the rad CLI.
+
 Workspaces allow you to manage multiple Radius platforms and environments using a local configuration file. 
 
 You can easily define and switch between workspaces to deploy and manage applications across local, test, and production environments.`,
@@ -131,6 +134,13 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
 	}
 
+	// Record whether the features of this version of the CLI are supported by the Radius installation, and warn
+	// about version skew up front rather than failing later with a confusing error.
+	compatibility := workspaces.NewCompatibility(state.RadiusVersion, version.ChartVersion())
+	if !compatibility.Compatible && !version.IsEdgeChannel() {
+		r.Output.LogInfo("Warning: Radius version %q installed in Kubernetes context %q does not match the rad CLI version %q. Some features may not be available. Use a rad CLI matching the installed version of Radius to avoid errors.", state.RadiusVersion, context, compatibility.CLIVersion)
+	}
+
 	workspaceExists, err := cli.HasWorkspace(config, workspaceName)
 	if err != nil {
 		return err
@@ -158,6 +168,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	r.Workspace.Connection = map[string]any{}
 	r.Workspace.Connection["context"] = context
 	r.Workspace.Connection["kind"] = args[0]
+	r.Workspace.Compatibility = compatibility
 
 	group, err := cmd.Flags().GetString("group")
 	if err != nil {
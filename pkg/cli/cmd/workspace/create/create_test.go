@@ -126,6 +126,52 @@ func Test_Validate(t *testing.T) {
 				mocks.ApplicationManagementClient.EXPECT().GetEnvironment(gomock.Any(), "env1").Return(corerp.EnvironmentResource{}, nil).Times(1)
 			},
 		},
+		{
+			Name:          "valid create command records compatible Radius version",
+			Input:         []string{"kubernetes", "-w", "ws", "--context", "k3d-radius-dev"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				// Any context of the kubeconfig can be used, not only the current one
+				mocks.Kubernetes.EXPECT().GetKubeContext().Return(getTestKubeConfig(), nil).Times(1)
+				mocks.Helm.EXPECT().CheckRadiusInstall("k3d-radius-dev").Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "0.42.0"}, nil).Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				workspace := runner.(*Runner).Workspace
+				require.Equal(t, "k3d-radius-dev", workspace.Connection["context"])
+				require.Equal(t, &workspaces.Compatibility{
+					RadiusVersion: "0.42.0",
+					CLIVersion:    "0.42.42-dev",
+					Compatible:    true,
+				}, workspace.Compatibility)
+			},
+		},
+		{
+			Name:          "valid create command records incompatible Radius version",
+			Input:         []string{"kubernetes", "-w", "ws", "--context", "k3d-radius-dev"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				// Any context of the kubeconfig can be used, not only the current one
+				mocks.Kubernetes.EXPECT().GetKubeContext().Return(getTestKubeConfig(), nil).Times(1)
+				mocks.Helm.EXPECT().CheckRadiusInstall("k3d-radius-dev").Return(helm.InstallState{RadiusInstalled: true, RadiusVersion: "0.1.0"}, nil).Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				workspace := runner.(*Runner).Workspace
+				require.Equal(t, "k3d-radius-dev", workspace.Connection["context"])
+				require.Equal(t, &workspaces.Compatibility{
+					RadiusVersion: "0.1.0",
+					CLIVersion:    "0.42.42-dev",
+					Compatible:    false,
+				}, workspace.Compatibility)
+			},
+		},
 	}
 
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
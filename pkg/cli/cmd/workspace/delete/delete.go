@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -31,6 +32,7 @@ import (
 
 const (
 	deleteConfirmationFmt = "Are you sure you want to delete workspace '%v' from local config? This will update config but will not delete any deployed resources."
+	purgeConfigFlag       = "purge-config"
 )
 
 // NewCommand creates an instance of the command and runner for the `rad workspace delete` command.
@@ -49,13 +51,17 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 rad workspace delete
 
 # Delete named workspace
-rad workspace delete my-workspace`,
+rad workspace delete my-workspace
+
+# Remove the config entry of a workspace that cannot be loaded
+rad workspace delete my-workspace --purge-config`,
 		Args: cobra.RangeArgs(0, 1),
 		RunE: framework.RunCommand(runner),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
 	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().Bool(purgeConfigFlag, false, "Remove the workspace entry from config even if it cannot be loaded. Other workspace entries are left unchanged.")
 
 	return cmd, runner
 }
@@ -68,6 +74,7 @@ type Runner struct {
 	InputPrompter       prompt.Interface
 	Workspace           *workspaces.Workspace
 	Confirm             bool
+	PurgeConfig         bool
 }
 
 // NewRunner creates a new instance of the `rad workspace delete` runner.
@@ -84,20 +91,43 @@ func NewRunner(factory framework.Factory) *Runner {
 //
 
 // Validate checks if the workspace is valid and sets the workspace and confirmation flags accordingly, returning
-// an error if the workspace is not stored in configuration.
+// an error if the workspace is not stored in configuration. When purging, only the workspace name is read so that
+// invalid workspace entries can be removed.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
-	workspace, err := cli.RequireWorkspaceArgs(cmd, r.ConfigHolder.Config, args)
+	yes, err := cmd.Flags().GetBool("yes")
 	if err != nil {
 		return err
 	}
 
-	yes, err := cmd.Flags().GetBool("yes")
+	purge, err := cmd.Flags().GetBool(purgeConfigFlag)
 	if err != nil {
 		return err
 	}
 
-	r.Workspace = workspace
 	r.Confirm = yes
+	r.PurgeConfig = purge
+
+	if r.PurgeConfig {
+		// The workspace entry may be invalid, so we can't load it. Only the name is needed to purge it.
+		name, err := cli.ReadWorkspaceNameArgs(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		if name == "" {
+			return clierrors.Message("The workspace name must be specified when using --%s.", purgeConfigFlag)
+		}
+
+		r.Workspace = &workspaces.Workspace{Name: name}
+		return nil
+	}
+
+	workspace, err := cli.RequireWorkspaceArgs(cmd, r.ConfigHolder.Config, args)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
 
 	if !r.Workspace.IsNamedWorkspace() {
 		// Only workspaces stored in configuration can be deleted.
@@ -111,7 +141,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 //
 
 // Run prompts the user to confirm the deletion of a workspace, and if confirmed, deletes the workspace from the
-// config file, returning an error if one occurs.
+// config file, returning an error if one occurs. When purging, only the keys of the selected workspace are removed.
 func (r *Runner) Run(ctx context.Context) error {
 	// Prompt user to confirm deletion
 	if !r.Confirm {
@@ -126,6 +156,19 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if r.PurgeConfig {
+		found, err := r.ConfigFileInterface.PurgeWorkspace(ctx, r.ConfigHolder.Config, r.Workspace.Name)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return clierrors.Message("The workspace %q does not exist in the config file.", r.Workspace.Name)
+		}
+
+		return nil
+	}
+
 	err := r.ConfigFileInterface.DeleteWorkspace(ctx, r.ConfigHolder.Config, r.Workspace.Name)
 	if err != nil {
 		return err
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
@@ -36,6 +37,13 @@ func Test_CommandValidation(t *testing.T) {
 
 func Test_Validate(t *testing.T) {
 	config := radcli.LoadConfigWithWorkspace(t)
+	corrupted := radcli.LoadConfig(t, `
+workspaces:
+  default: broken
+  items:
+    broken:
+      scope: /planes/radius/local/resourceGroups/test-resource-group
+`)
 
 	testcases := []radcli.ValidateInput{
 		{
@@ -74,6 +82,29 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: false,
 			ConfigHolder:  framework.ConfigHolder{Config: config},
 		},
+		{
+			Name:          "delete workspace purge-config with invalid entry valid",
+			Input:         []string{"broken", "--purge-config"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: corrupted},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.True(t, r.PurgeConfig)
+				require.Equal(t, "broken", r.Workspace.Name)
+			},
+		},
+		{
+			Name:          "delete workspace with invalid entry invalid",
+			Input:         []string{"broken"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: corrupted},
+		},
+		{
+			Name:          "delete workspace purge-config without name invalid",
+			Input:         []string{"--purge-config"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: corrupted},
+		},
 		{
 			Name:          "delete workspace flag and positional invalid",
 			Input:         []string{"other-workspace", "-w", "other-thing"},
@@ -179,6 +210,56 @@ func Test_Run(t *testing.T) {
 
 		require.Empty(t, outputSink.Writes)
 	})
+	t.Run("Purge workspace", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+		configFile.EXPECT().
+			PurgeWorkspace(gomock.Any(), gomock.Any(), "test-workspace").
+			Return(true, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			Workspace: &workspaces.Workspace{
+				Name: "test-workspace",
+			},
+
+			Confirm:     true,
+			PurgeConfig: true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Empty(t, outputSink.Writes)
+	})
+	t.Run("Purge workspace not found", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+		configFile.EXPECT().
+			PurgeWorkspace(gomock.Any(), gomock.Any(), "test-workspace").
+			Return(false, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			Workspace: &workspaces.Workspace{
+				Name: "test-workspace",
+			},
+
+			Confirm:     true,
+			PurgeConfig: true,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The workspace %q does not exist in the config file.", "test-workspace"), err)
+	})
 
 	t.Run("Exit Console with interrupt", func(t *testing.T) {
 		outputSink := &output.MockOutput{}
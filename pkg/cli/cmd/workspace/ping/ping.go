@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ping
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultCount = 3
+)
+
+// NewCommand creates an instance of the command and runner for the `rad workspace ping` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "ping [workspace]",
+		Short: "Test connectivity to the Radius control plane of a workspace",
+		Long: `Test connectivity to the Radius control plane of a workspace.
+
+Sends requests to the Radius control plane of the current or specified workspace and reports the round-trip latency of each request.`,
+		Example: `# Ping the control plane of the current workspace
+rad workspace ping
+
+# Ping the control plane of a named workspace 5 times
+rad workspace ping my-workspace --count 5`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().IntP("count", "n", defaultCount, "The number of requests to send")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad workspace ping` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Count             int
+}
+
+// NewRunner creates a new instance of the `rad workspace ping` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad workspace ping` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspaceArgs(cmd, r.ConfigHolder.Config, args)
+	if err != nil {
+		return err
+	}
+
+	count, err := cmd.Flags().GetInt("count")
+	if err != nil {
+		return err
+	}
+
+	if count < 1 {
+		return clierrors.Message("The number of requests must be at least 1.")
+	}
+
+	r.Workspace = workspace
+	r.Count = count
+
+	return nil
+}
+
+// Run runs the `rad workspace ping` command.
+func (r *Runner) Run(ctx context.Context) error {
+	r.Output.LogInfo("Pinging the Radius control plane of workspace %q (%s)...", r.Workspace.Name, r.Workspace.FmtConnection())
+	if r.Workspace.Compatibility != nil && !r.Workspace.Compatibility.Compatible {
+		r.Output.LogInfo("Warning: Radius version %q of the workspace does not match the rad CLI version %q.", r.Workspace.Compatibility.RadiusVersion, r.Workspace.Compatibility.CLIVersion)
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	latencies := []time.Duration{}
+	for i := 0; i < r.Count; i++ {
+		start := time.Now()
+		_, err := client.ListResourceGroups(ctx, "local")
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to connect to the Radius control plane of workspace %q.", r.Workspace.Name)
+		}
+
+		latency := time.Since(start)
+		latencies = append(latencies, latency)
+		r.Output.LogInfo("Reply from the Radius control plane: time=%s", latency.Round(time.Millisecond))
+	}
+
+	minimum, average, maximum := summarize(latencies)
+	r.Output.LogInfo("%d requests, min/avg/max = %s/%s/%s", len(latencies), minimum.Round(time.Millisecond), average.Round(time.Millisecond), maximum.Round(time.Millisecond))
+
+	return nil
+}
+
+// summarize returns the minimum, average and maximum of the given latencies, which must not be empty.
+func summarize(latencies []time.Duration) (time.Duration, time.Duration, time.Duration) {
+	minimum, maximum, total := latencies[0], latencies[0], time.Duration(0)
+	for _, latency := range latencies {
+		minimum = min(minimum, latency)
+		maximum = max(maximum, latency)
+		total += latency
+	}
+
+	return minimum, total / time.Duration(len(latencies)), maximum
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ping
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "ping current workspace valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, defaultCount, runner.(*Runner).Count)
+			},
+		},
+		{
+			Name:          "ping explicit workspace positional valid",
+			Input:         []string{radcli.TestWorkspaceName, "--count", "5"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, radcli.TestWorkspaceName, runner.(*Runner).Workspace.Name)
+				require.Equal(t, 5, runner.(*Runner).Count)
+			},
+		},
+		{
+			Name:          "ping workspace not-found invalid",
+			Input:         []string{"other-workspace"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "ping invalid count",
+			Input:         []string{"--count", "0"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Name: "test-workspace",
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Compatibility: &workspaces.Compatibility{RadiusVersion: "0.39.0", CLIVersion: "0.40.0", Compatible: false},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return([]ucp.ResourceGroupResource{}, nil).
+			Times(2)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         workspace,
+			Count:             2,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		// Header, compatibility warning, one reply per request and the summary.
+		require.Len(t, outputSink.Writes, 5)
+		require.Equal(t, output.LogOutput{
+			Format: "Warning: Radius version %q of the workspace does not match the rad CLI version %q.",
+			Params: []any{"0.39.0", "0.40.0"},
+		}, outputSink.Writes[1])
+		require.Equal(t, "%d requests, min/avg/max = %s/%s/%s", outputSink.Writes[4].(output.LogOutput).Format)
+	})
+
+	t.Run("Connection failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return(nil, errors.New("connection refused")).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			Count:             3,
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.True(t, clierrors.IsFriendlyError(err))
+		require.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func Test_Summarize(t *testing.T) {
+	minimum, average, maximum := summarize([]time.Duration{30 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond})
+	require.Equal(t, 10*time.Millisecond, minimum)
+	require.Equal(t, 20*time.Millisecond, average)
+	require.Equal(t, 30*time.Millisecond, maximum)
+}
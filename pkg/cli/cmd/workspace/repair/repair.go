@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repair
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	repairConfirmationFmt = "The following workspace entries in local config are invalid and will be removed:\n\n%s\n\nAre you sure you want to continue? This will update config but will not delete any deployed resources."
+	noProblemsMessage     = "No invalid workspace entries found in local config."
+)
+
+// NewCommand creates an instance of the command and runner for the `rad workspace repair` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Repair local workspace config",
+		Long: `Repair local workspace config.
+
+Workspace entries in the local config file that cannot be loaded are removed. If the default workspace does not exist,
+the default is cleared. Valid workspace entries are left unchanged.`,
+		Example: `# Remove the invalid workspace entries from local config
+rad workspace repair
+
+# Remove the invalid workspace entries without prompting for confirmation
+rad workspace repair --yes`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddConfirmationFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad workspace repair` command.
+type Runner struct {
+	ConfigHolder        *framework.ConfigHolder
+	ConfigFileInterface framework.ConfigFileInterface
+	Output              output.Interface
+	InputPrompter       prompt.Interface
+	Confirm             bool
+}
+
+// NewRunner creates a new instance of the `rad workspace repair` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigFileInterface: factory.GetConfigFileInterface(),
+		ConfigHolder:        factory.GetConfigHolder(),
+		InputPrompter:       factory.GetPrompter(),
+		Output:              factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad workspace repair` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	r.Confirm = yes
+
+	return nil
+}
+
+// Run runs the `rad workspace repair` command.
+//
+
+// Run lists the invalid workspace entries of the config file, prompts the user to confirm, and removes them.
+func (r *Runner) Run(ctx context.Context) error {
+	problems := cli.FindWorkspaceProblems(r.ConfigHolder.Config)
+	if len(problems) == 0 {
+		r.Output.LogInfo(noProblemsMessage)
+		return nil
+	}
+
+	if !r.Confirm {
+		message := fmt.Sprintf(repairConfirmationFmt, formatProblems(problems))
+		confirmed, err := prompt.YesOrNoPrompt(message, prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			return nil
+		}
+	}
+
+	repaired, err := r.ConfigFileInterface.RepairWorkspaces(ctx, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Repaired local config:\n\n%s", formatProblems(repaired))
+
+	return nil
+}
+
+func formatProblems(problems []cli.WorkspaceEntryError) string {
+	lines := []string{}
+	for _, problem := range problems {
+		lines = append(lines, "  - "+problem.Error())
+	}
+
+	return strings.Join(lines, "\n")
+}
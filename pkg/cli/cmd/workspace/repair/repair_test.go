@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repair
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const corruptedConfig = `
+workspaces:
+  default: test-workspace
+  items:
+    broken:
+      scope: /planes/radius/local/resourceGroups/test-resource-group
+    test-workspace:
+      connection:
+        context: test-context
+        kind: kubernetes
+      scope: /planes/radius/local/resourceGroups/test-resource-group
+`
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "repair valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "repair with corrupted config valid",
+			Input:         []string{"--yes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadConfig(t, corruptedConfig)},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).Confirm)
+			},
+		},
+		{
+			Name:          "repair too-many-args invalid",
+			Input:         []string{"other-thing"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	problems := []cli.WorkspaceEntryError{
+		{Name: "broken", Err: errors.New("Connection is a required field")},
+	}
+
+	t.Run("Repair with confirmation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+		config := radcli.LoadConfig(t, corruptedConfig)
+
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+		configFile.EXPECT().
+			RepairWorkspaces(gomock.Any(), config).
+			Return(problems, nil).
+			Times(1)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, fmt.Sprintf(repairConfirmationFmt, "  - workspace \"broken\": Connection is a required field")).
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: config},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			InputPrompter:       prompter,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Repaired local config:\n\n%s",
+				Params: []any{"  - workspace \"broken\": Connection is a required field"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Repair not confirmed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput(gomock.Any(), gomock.Any()).
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: radcli.LoadConfig(t, corruptedConfig)},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			InputPrompter:       prompter,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("Repair bypass confirmation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+
+		configFile := framework.NewMockConfigFileInterface(ctrl)
+		configFile.EXPECT().
+			RepairWorkspaces(gomock.Any(), gomock.Any()).
+			Return(problems, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: radcli.LoadConfig(t, corruptedConfig)},
+			ConfigFileInterface: configFile,
+			Output:              outputSink,
+			InputPrompter:       prompt.NewMockInterface(ctrl),
+			Confirm:             true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Len(t, outputSink.Writes, 1)
+	})
+
+	t.Run("No problems", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConfigHolder:        &framework.ConfigHolder{Config: radcli.LoadConfigWithWorkspace(t)},
+			ConfigFileInterface: framework.NewMockConfigFileInterface(ctrl),
+			Output:              outputSink,
+			InputPrompter:       prompt.NewMockInterface(ctrl),
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: noProblemsMessage,
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
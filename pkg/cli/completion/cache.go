@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package completion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// defaultCacheTTL is the time the results of a completion are cached.
+	defaultCacheTTL = 30 * time.Second
+)
+
+// Cache is a cache of completion results stored on disk.
+type Cache struct {
+	// Dir is the directory the results are stored in. The cache is disabled if Dir is empty.
+	Dir string
+
+	// TTL is the time the results are valid for.
+	TTL time.Duration
+}
+
+// NewCache creates a cache of completion results stored in the '.rad/cache/completion' directory of the user's home
+// directory. The cache is disabled if the home directory cannot be found.
+func NewCache() *Cache {
+	cache := &Cache{TTL: defaultCacheTTL}
+
+	home, err := os.UserHomeDir()
+	if err == nil {
+		cache.Dir = filepath.Join(home, ".rad", "cache", "completion")
+	}
+
+	return cache
+}
+
+// Get returns the cached names for the given key. The second return value is false if the names are not cached or
+// have expired.
+func (c *Cache) Get(key string) ([]string, bool) {
+	if c == nil || c.Dir == "" {
+		return nil, false
+	}
+
+	path := c.path(key)
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > c.TTL {
+		return nil, false
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	names := []string{}
+	err = json.Unmarshal(b, &names)
+	if err != nil {
+		return nil, false
+	}
+
+	return names, true
+}
+
+// Set stores the names for the given key. Errors are ignored, completion works without the cache.
+func (c *Cache) Set(key string, names []string) {
+	if c == nil || c.Dir == "" {
+		return
+	}
+
+	b, err := json.Marshal(names)
+	if err != nil {
+		return
+	}
+
+	err = os.MkdirAll(c.Dir, 0o700)
+	if err != nil {
+		return
+	}
+
+	_ = os.WriteFile(c.path(key), b, 0o600)
+}
+
+// path returns the path of the file storing the names of the given key. The key contains the connection of the
+// workspace, so it is hashed to produce a valid file name.
+func (c *Cache) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(hash[:])+".json")
+}
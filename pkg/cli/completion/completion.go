@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package completion
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// Func is the signature of a cobra completion function.
+type Func func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// DefaultCache is the cache used by the completion functions.
+var DefaultCache = NewCache()
+
+// listFunc lists the names of resources using the management client of the workspace.
+type listFunc func(ctx context.Context, client clients.ApplicationsManagementClient) ([]string, error)
+
+// ApplicationNames returns a completion function which completes the first argument of a command with the names of the
+// applications in the scope of the workspace.
+func ApplicationNames(factory framework.Factory) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return complete(cmd, factory, "Applications.Core/applications", toComplete, func(ctx context.Context, client clients.ApplicationsManagementClient) ([]string, error) {
+			applications, err := client.ListApplications(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			names := []string{}
+			for _, application := range applications {
+				names = append(names, to.String(application.Name))
+			}
+			return names, nil
+		})
+	}
+}
+
+// EnvironmentNames returns a completion function which completes the first argument of a command with the names of the
+// environments in the scope of the workspace.
+func EnvironmentNames(factory framework.Factory) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return complete(cmd, factory, "Applications.Core/environments", toComplete, func(ctx context.Context, client clients.ApplicationsManagementClient) ([]string, error) {
+			environments, err := client.ListEnvironments(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			names := []string{}
+			for _, environment := range environments {
+				names = append(names, to.String(environment.Name))
+			}
+			return names, nil
+		})
+	}
+}
+
+// ResourceTypeAndNames returns a completion function for commands which accept a resource type and a resource name.
+// The first argument is completed with the short names of the built-in resource types, and the second argument with the
+// names of the resources of that type in the scope of the workspace.
+func ResourceTypeAndNames(factory framework.Factory) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		switch len(args) {
+		case 0:
+			types := []string{}
+			for _, resourceType := range clients.ResourceTypesList {
+				types = append(types, strings.Split(resourceType, "/")[1])
+			}
+			return filter(types, toComplete), cobra.ShellCompDirectiveNoFileComp
+		case 1:
+			resourceType, err := cli.RequireResourceType(args)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return complete(cmd, factory, resourceType, toComplete, func(ctx context.Context, client clients.ApplicationsManagementClient) ([]string, error) {
+				resources, err := client.ListResourcesOfType(ctx, resourceType)
+				if err != nil {
+					return nil, err
+				}
+
+				names := []string{}
+				for _, resource := range resources {
+					names = append(names, to.String(resource.Name))
+				}
+				return names, nil
+			})
+		default:
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+}
+
+// complete lists the names of resources in the scope of the workspace of the command, or reads them from the cache.
+// Errors are not reported, the shell does not offer any completion instead.
+func complete(cmd *cobra.Command, factory framework.Factory, kind string, toComplete string, list listFunc) ([]string, cobra.ShellCompDirective) {
+	config := factory.GetConfigHolder()
+	workspace, err := cli.RequireWorkspace(cmd, config.Config, config.DirectoryConfig)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *workspace)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	workspace.Scope = scope
+
+	key := strings.ToLower(strings.Join([]string{workspace.FmtConnection(), workspace.Scope, kind}, "|"))
+	names, ok := DefaultCache.Get(key)
+	if !ok {
+		ctx := cmd.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		client, err := factory.GetConnectionFactory().CreateApplicationsManagementClient(ctx, *workspace)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		names, err = list(ctx, client)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		sort.Strings(names)
+		DefaultCache.Set(key, names)
+	}
+
+	return filter(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// filter returns the names which start with the text being completed, ignoring case.
+func filter(names []string, toComplete string) []string {
+	results := []string{}
+	for _, name := range names {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(toComplete)) {
+			results = append(results, name)
+		}
+	}
+	return results
+}
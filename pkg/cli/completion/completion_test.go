@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package completion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setup(t *testing.T) (*clients.MockApplicationsManagementClient, framework.Factory, *cobra.Command) {
+	DefaultCache = &Cache{Dir: t.TempDir(), TTL: time.Minute}
+
+	client := clients.NewMockApplicationsManagementClient(gomock.NewController(t))
+	factory := &framework.Impl{
+		ConfigHolder:      &framework.ConfigHolder{Config: radcli.LoadConfigWithWorkspace(t)},
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return client, factory, cmd
+}
+
+func Test_ApplicationNames(t *testing.T) {
+	t.Run("completes and caches application names", func(t *testing.T) {
+		client, factory, cmd := setup(t)
+		client.EXPECT().
+			ListApplications(gomock.Any()).
+			Return([]corerp.ApplicationResource{{Name: to.Ptr("frontend")}, {Name: to.Ptr("backend")}, {Name: to.Ptr("billing")}}, nil).
+			Times(1)
+
+		names, directive := ApplicationNames(factory)(cmd, []string{}, "")
+		require.Equal(t, []string{"backend", "billing", "frontend"}, names)
+		require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+		// The second completion is served from the cache.
+		names, _ = ApplicationNames(factory)(cmd, []string{}, "b")
+		require.Equal(t, []string{"backend", "billing"}, names)
+	})
+
+	t.Run("does not complete additional arguments", func(t *testing.T) {
+		_, factory, cmd := setup(t)
+
+		names, directive := ApplicationNames(factory)(cmd, []string{"frontend"}, "")
+		require.Empty(t, names)
+		require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+
+	t.Run("errors are not reported", func(t *testing.T) {
+		client, factory, cmd := setup(t)
+		client.EXPECT().
+			ListApplications(gomock.Any()).
+			Return(nil, errors.New("connection refused")).
+			Times(1)
+
+		names, directive := ApplicationNames(factory)(cmd, []string{}, "")
+		require.Empty(t, names)
+		require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+}
+
+func Test_EnvironmentNames(t *testing.T) {
+	client, factory, cmd := setup(t)
+	client.EXPECT().
+		ListEnvironments(gomock.Any()).
+		Return([]corerp.EnvironmentResource{{Name: to.Ptr("prod")}, {Name: to.Ptr("dev")}}, nil).
+		Times(1)
+
+	names, _ := EnvironmentNames(factory)(cmd, []string{}, "P")
+	require.Equal(t, []string{"prod"}, names)
+}
+
+func Test_ResourceTypeAndNames(t *testing.T) {
+	t.Run("completes resource types", func(t *testing.T) {
+		_, factory, cmd := setup(t)
+
+		names, _ := ResourceTypeAndNames(factory)(cmd, []string{}, "redis")
+		require.Equal(t, []string{"redisCaches"}, names)
+	})
+
+	t.Run("completes resource names", func(t *testing.T) {
+		client, factory, cmd := setup(t)
+		client.EXPECT().
+			ListResourcesOfType(gomock.Any(), "Applications.Core/containers").
+			Return([]generated.GenericResource{{Name: to.Ptr("orders")}, {Name: to.Ptr("frontend")}}, nil).
+			Times(1)
+
+		names, _ := ResourceTypeAndNames(factory)(cmd, []string{"containers"}, "")
+		require.Equal(t, []string{"frontend", "orders"}, names)
+	})
+
+	t.Run("does not complete unknown resource types", func(t *testing.T) {
+		_, factory, cmd := setup(t)
+
+		names, _ := ResourceTypeAndNames(factory)(cmd, []string{"unknown"}, "")
+		require.Empty(t, names)
+	})
+}
+
+func Test_Cache(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir(), TTL: time.Minute}
+
+	_, ok := cache.Get("key")
+	require.False(t, ok)
+
+	cache.Set("key", []string{"a", "b"})
+	names, ok := cache.Get("key")
+	require.True(t, ok)
+	require.Equal(t, []string{"a", "b"}, names)
+
+	expired := &Cache{Dir: cache.Dir, TTL: -time.Second}
+	_, ok = expired.Get("key")
+	require.False(t, ok)
+
+	disabled := &Cache{}
+	disabled.Set("key", []string{"a"})
+	_, ok = disabled.Get("key")
+	require.False(t, ok)
+}
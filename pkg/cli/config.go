@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -30,9 +31,11 @@ import (
 	validator "github.com/go-playground/validator/v10"
 	en_translations "github.com/go-playground/validator/v10/translations/en"
 	"github.com/gofrs/flock"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 	"golang.org/x/text/cases"
 
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 )
@@ -75,39 +78,174 @@ func (ws WorkspaceSection) GetWorkspace(name string) (*workspaces.Workspace, err
 	return &result, nil
 }
 
+// WorkspaceEntryError describes a problem with the workspaces section of the config file.
+type WorkspaceEntryError struct {
+	// Name is the name of the workspace entry with the problem. Name is empty when the problem applies to the
+	// workspaces section as a whole.
+	Name string
+
+	// Err describes the problem.
+	Err error
+}
+
+// Error returns the description of the problem, including the name of the workspace entry.
+func (e WorkspaceEntryError) Error() string {
+	if e.Name == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("workspace %q: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e WorkspaceEntryError) Unwrap() error {
+	return e.Err
+}
+
 // ReadWorkspaceSection reads the WorkspaceSection from radius config.
 //
 
 // ReadWorkspaceSection reads the WorkspaceSection from the given viper instance, validates it and returns it. If the
-// WorkspaceSection is not present, an empty one is returned. If any errors occur during validation, an error is returned.
+// WorkspaceSection is not present, an empty one is returned. If any workspace entry is invalid, an error describing
+// each invalid entry is returned.
 func ReadWorkspaceSection(v *viper.Viper) (WorkspaceSection, error) {
-	section := WorkspaceSection{}
-	s := v.Sub(WorkspacesKey)
-	if s == nil {
-		// This may happen if the key was set directly to one of our structs, so let's try reading
-		// that.
-		obj := v.Get(WorkspacesKey)
-		if obj == nil {
-			// OK really nil, return a blank config.
-			return WorkspaceSection{Items: map[string]workspaces.Workspace{}}, nil
+	section, problems := readWorkspaceEntries(v)
+	if len(problems) == 0 {
+		return section, nil
+	}
+
+	details := []string{}
+	for _, problem := range problems {
+		details = append(details, "  - "+problem.Error())
+	}
+
+	return WorkspaceSection{}, clierrors.Message("The config file %q contains invalid workspace entries:\n\n%s\n\nRun `rad workspace repair` to remove the invalid entries, or `rad workspace delete <name> --purge-config` to remove a single entry.", v.ConfigFileUsed(), strings.Join(details, "\n"))
+}
+
+// FindWorkspaceProblems returns a problem for each workspace entry of the config that cannot be loaded, and for a
+// default workspace that does not exist.
+func FindWorkspaceProblems(v *viper.Viper) []WorkspaceEntryError {
+	_, problems := repairWorkspaceEntries(v)
+	return problems
+}
+
+// RepairWorkspaceSection removes the workspace entries of the config that cannot be loaded and clears the default
+// workspace if it does not exist. Valid workspace entries are preserved. It returns the problems that were repaired.
+func RepairWorkspaceSection(v *viper.Viper) []WorkspaceEntryError {
+	section, problems := repairWorkspaceEntries(v)
+	if len(problems) > 0 {
+		UpdateWorkspaceSection(v, section)
+	}
+
+	return problems
+}
+
+// PurgeWorkspace removes the entry of the named workspace from the config, and clears the default workspace if it
+// refers to the named workspace. Unlike other edits, PurgeWorkspace does not require the other workspace entries to be
+// valid, and leaves them unchanged. It returns false if the config does not contain the named workspace.
+func PurgeWorkspace(v *viper.Viper, name string) (bool, error) {
+	switch obj := v.Get(WorkspacesKey).(type) {
+	case nil:
+		return false, nil
+	case WorkspaceSection:
+		if !obj.HasWorkspace(name) {
+			return false, nil
 		}
 
-		s, ok := obj.(WorkspaceSection)
-		if !ok {
-			return WorkspaceSection{}, fmt.Errorf("failed to read the config file: %s", v.ConfigFileUsed())
+		delete(obj.Items, cases.Fold().String(name))
+		if strings.EqualFold(obj.Default, name) {
+			obj.Default = ""
 		}
 
-		section = s
-	} else {
-		err := s.UnmarshalExact(&section)
-		if err != nil {
-			return WorkspaceSection{}, err
+		UpdateWorkspaceSection(v, obj)
+		return true, nil
+	case map[string]any:
+		found := false
+		if items, ok := obj["items"].(map[string]any); ok {
+			for key := range items {
+				if strings.EqualFold(key, name) {
+					delete(items, key)
+					found = true
+				}
+			}
+		}
+
+		if def, ok := obj["default"].(string); ok && strings.EqualFold(def, name) {
+			obj["default"] = ""
+			found = true
 		}
+
+		if found {
+			v.Set(WorkspacesKey, obj)
+		}
+
+		return found, nil
+	default:
+		return false, fmt.Errorf("failed to read the config file: %s", v.ConfigFileUsed())
 	}
+}
 
-	// if items is not present it will be nil
-	if section.Items == nil {
-		section.Items = map[string]workspaces.Workspace{}
+// repairWorkspaceEntries returns the valid workspace entries of the config, with the default workspace cleared if it
+// does not exist, and the problems found.
+func repairWorkspaceEntries(v *viper.Viper) (WorkspaceSection, []WorkspaceEntryError) {
+	section, problems := readWorkspaceEntries(v)
+	if section.Default != "" && !section.HasWorkspace(section.Default) {
+		problems = append(problems, WorkspaceEntryError{Name: section.Default, Err: errors.New("the default workspace does not exist")})
+		section.Default = ""
+	}
+
+	return section, problems
+}
+
+// readWorkspaceEntries reads and validates each workspace entry of the config independently. It returns the valid
+// entries and a problem for each invalid entry.
+func readWorkspaceEntries(v *viper.Viper) (WorkspaceSection, []WorkspaceEntryError) {
+	section := WorkspaceSection{Items: map[string]workspaces.Workspace{}}
+	problems := []WorkspaceEntryError{}
+
+	switch obj := v.Get(WorkspacesKey).(type) {
+	case nil:
+		// OK really nil, return a blank config.
+		return section, nil
+	case WorkspaceSection:
+		// This may happen if the key was set directly to one of our structs.
+		section.Default = obj.Default
+		for name, ws := range obj.Items {
+			section.Items[name] = ws
+		}
+	case map[string]any:
+		for key, value := range obj {
+			switch key {
+			case "default":
+				def, ok := value.(string)
+				if !ok && value != nil {
+					problems = append(problems, WorkspaceEntryError{Err: fmt.Errorf("the default workspace must be a string, got %T", value)})
+					continue
+				}
+				section.Default = def
+			case "items":
+				items, ok := value.(map[string]any)
+				if !ok && value != nil {
+					problems = append(problems, WorkspaceEntryError{Err: fmt.Errorf("the workspace items must be a map, got %T", value)})
+					continue
+				}
+
+				for name, item := range items {
+					ws := workspaces.Workspace{}
+					err := decodeExact(item, &ws)
+					if err != nil {
+						problems = append(problems, WorkspaceEntryError{Name: name, Err: err})
+						continue
+					}
+
+					section.Items[name] = ws
+				}
+			default:
+				problems = append(problems, WorkspaceEntryError{Err: fmt.Errorf("the workspaces section has an unknown key %q", key)})
+			}
+		}
+	default:
+		return section, []WorkspaceEntryError{{Err: fmt.Errorf("failed to read the config file: %s", v.ConfigFileUsed())}}
 	}
 
 	for name, ws := range section.Items {
@@ -121,15 +259,36 @@ func ReadWorkspaceSection(v *viper.Viper) (WorkspaceSection, error) {
 		// file.
 		copy.Source = workspaces.SourceUserConfig
 
+		err := validate(copy)
+		if err != nil {
+			problems = append(problems, WorkspaceEntryError{Name: name, Err: err})
+			delete(section.Items, name)
+			continue
+		}
+
 		section.Items[name] = copy
 	}
 
-	err := validate(section)
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Error() < problems[j].Error()
+	})
+
+	return section, problems
+}
+
+// decodeExact decodes the input into the output, and returns an error if the input has keys that don't match a
+// field of the output.
+func decodeExact(input any, output any) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		Result:           output,
+	})
 	if err != nil {
-		return WorkspaceSection{}, err
+		return err
 	}
 
-	return section, nil
+	return decoder.Decode(input)
 }
 
 // UpdateWorkspaceSection updates the WorkspacesKey in the given viper instance with the given WorkspaceSection.
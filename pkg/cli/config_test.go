@@ -76,6 +76,110 @@ workspaces:
 	require.Error(t, err)
 }
 
+const corruptedWorkspacesYaml = `
+workspaces:
+  default: broken
+  items:
+    broken:
+      scope: /a/b/c
+    typo:
+      connection:
+        kind: kubernetes
+      scoep: /a/b/c
+    test:
+      connection:
+        kind: kubernetes
+      scope: /a/b/c
+`
+
+func Test_ReadWorkspaceSection_Invalid_ReportsEachEntry(t *testing.T) {
+	v, err := makeConfig(corruptedWorkspacesYaml)
+	require.NoError(t, err)
+
+	_, err = ReadWorkspaceSection(v)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `workspace "broken": Connection is a required field`)
+	require.Contains(t, err.Error(), `workspace "typo":`)
+	require.Contains(t, err.Error(), "scoep")
+	require.Contains(t, err.Error(), "rad workspace repair")
+	require.NotContains(t, err.Error(), `workspace "test"`)
+}
+
+func Test_FindWorkspaceProblems(t *testing.T) {
+	v, err := makeConfig(corruptedWorkspacesYaml)
+	require.NoError(t, err)
+
+	problems := FindWorkspaceProblems(v)
+	names := []string{}
+	for _, problem := range problems {
+		names = append(names, problem.Name)
+	}
+	require.ElementsMatch(t, []string{"broken", "broken", "typo"}, names)
+
+	// Finding problems does not modify the config.
+	_, err = ReadWorkspaceSection(v)
+	require.Error(t, err)
+}
+
+func Test_FindWorkspaceProblems_Valid(t *testing.T) {
+	var yaml = `
+workspaces:
+  default: test
+  items:
+    test:
+      connection:
+        kind: kubernetes
+`
+
+	v, err := makeConfig(yaml)
+	require.NoError(t, err)
+	require.Empty(t, FindWorkspaceProblems(v))
+}
+
+func Test_RepairWorkspaceSection(t *testing.T) {
+	v, err := makeConfig(corruptedWorkspacesYaml)
+	require.NoError(t, err)
+
+	problems := RepairWorkspaceSection(v)
+	require.Len(t, problems, 3)
+
+	section, err := ReadWorkspaceSection(v)
+	require.NoError(t, err)
+	require.Empty(t, section.Default)
+	require.Len(t, section.Items, 1)
+	require.Equal(t, "/a/b/c", section.Items["test"].Scope)
+
+	require.Empty(t, RepairWorkspaceSection(v))
+}
+
+func Test_PurgeWorkspace(t *testing.T) {
+	v, err := makeConfig(corruptedWorkspacesYaml)
+	require.NoError(t, err)
+
+	found, err := PurgeWorkspace(v, "Broken")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// Only the purged entry is removed, the other invalid entry is left unchanged.
+	problems := FindWorkspaceProblems(v)
+	require.Len(t, problems, 1)
+	require.Equal(t, "typo", problems[0].Name)
+
+	found, err = PurgeWorkspace(v, "typo")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	section, err := ReadWorkspaceSection(v)
+	require.NoError(t, err)
+	require.Empty(t, section.Default)
+	require.Len(t, section.Items, 1)
+	require.True(t, section.HasWorkspace("test"))
+
+	found, err = PurgeWorkspace(v, "does-not-exist")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
 func Test_GetWorkspace_Nil_NoDefault(t *testing.T) {
 	var yaml = `
 workspaces:
@@ -50,8 +50,8 @@ var _ Factory = (*impl)(nil)
 type impl struct {
 }
 
-// CreateDeploymentClient connects to a workspace, tests the connection, creates a deployment client and an operations
-// client, and returns them along with the resource group name. It returns an error if any of the steps fail.
+// CreateDeploymentClient connects to a workspace, tests the connection, creates a deployment client, an operations
+// client and an operation statuses client, and returns them along with the resource group name. It returns an error if any of the steps fail.
 func (i *impl) CreateDeploymentClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeploymentClient, error) {
 	connection, err := workspace.Connect(ctx)
 	if err != nil {
@@ -77,6 +77,15 @@ func (i *impl) CreateDeploymentClient(ctx context.Context, workspace workspaces.
 		return nil, err
 	}
 
+	osc, err := sdkclients.NewOperationStatusesClient(&sdkclients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          connection.Endpoint(),
+		ARMClientOptions: armClientOptions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// This client wants a resource group name, but we store the ID instead, so compute that.
 	id, err := resources.ParseScope(workspace.Scope)
 	if err != nil {
@@ -84,9 +93,10 @@ func (i *impl) CreateDeploymentClient(ctx context.Context, workspace workspaces.
 	}
 
 	return &deployment.ResourceDeploymentClient{
-		Client:              dc,
-		OperationsClient:    doc,
-		RadiusResourceGroup: id.FindScope(resources_radius.ScopeResourceGroups),
+		Client:                  dc,
+		OperationsClient:        doc,
+		OperationStatusesClient: osc,
+		RadiusResourceGroup:     id.FindScope(resources_radius.ScopeResourceGroups),
 	}, nil
 }
 
@@ -186,6 +196,11 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 		return nil, err
 	}
 
+	gcpCredentialClient, err := v20231001preview.NewGcpCredentialsClient(&aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	azureCMClient := &cli_credential.AzureCredentialManagementClient{
 		AzureCredentialClient: *azureCredentialClient,
 	}
@@ -194,9 +209,14 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 		AWSCredentialClient: *awsCredentialClient,
 	}
 
+	gcpCMClient := &cli_credential.GCPCredentialManagementClient{
+		GCPCredentialClient: *gcpCredentialClient,
+	}
+
 	cpClient := &cli_credential.UCPCredentialManagementClient{
 		AzClient:  azureCMClient,
 		AWSClient: awsCMClient,
+		GCPClient: gcpCMClient,
 	}
 
 	return cpClient, nil
@@ -76,6 +76,8 @@ type AWSCredentialManagementClientInterface interface {
 	List(ctx context.Context) ([]CloudProviderStatus, error)
 	// Put registers an AWS credential with the respective ucp provider plane.
 	Put(ctx context.Context, credential_config ucp.AwsCredentialResource) error
+	// PutWithName registers a credential with the given name with the respective ucp provider plane.
+	PutWithName(ctx context.Context, name string, credential_config ucp.AwsCredentialResource) error
 	// Delete unregisters credential from the given ucp provider plane.
 	Delete(ctx context.Context, name string) (bool, error)
 }
@@ -93,6 +95,17 @@ func (cpm *AWSCredentialManagementClient) Put(ctx context.Context, credential uc
 	return &ErrUnsupportedCloudProvider{}
 }
 
+// PutWithName registers the credential with the given name. Radius only uses the credential named "default", which
+// is registered by Put.
+func (cpm *AWSCredentialManagementClient) PutWithName(ctx context.Context, name string, credential ucp.AwsCredentialResource) error {
+	if !strings.EqualFold(*credential.Type, AWSCredential) {
+		return &ErrUnsupportedCloudProvider{}
+	}
+
+	_, err := cpm.AWSCredentialClient.CreateOrUpdate(ctx, AWSPlaneName, name, credential, nil)
+	return err
+}
+
 // Get, gets the credential from the provided ucp provider plane
 //
 
@@ -41,6 +41,8 @@ type AzureCredentialManagementClientInterface interface {
 	List(ctx context.Context) ([]CloudProviderStatus, error)
 	// Put registers an AWS credential with the respective ucp provider plane.
 	Put(ctx context.Context, credential_config ucp.AzureCredentialResource) error
+	// PutWithName registers a credential with the given name with the respective ucp provider plane.
+	PutWithName(ctx context.Context, name string, credential_config ucp.AzureCredentialResource) error
 	// Delete unregisters credential from the given ucp provider plane.
 	Delete(ctx context.Context, name string) (bool, error)
 }
@@ -112,6 +114,17 @@ func (cpm *AzureCredentialManagementClient) Put(ctx context.Context, credential
 	return &ErrUnsupportedCloudProvider{}
 }
 
+// PutWithName registers the credential with the given name. Radius only uses the credential named "default", which
+// is registered by Put.
+func (cpm *AzureCredentialManagementClient) PutWithName(ctx context.Context, name string, credential ucp.AzureCredentialResource) error {
+	if !strings.EqualFold(*credential.Type, AzureCredential) {
+		return &ErrUnsupportedCloudProvider{}
+	}
+
+	_, err := cpm.AzureCredentialClient.CreateOrUpdate(ctx, AzurePlaneName, name, credential, nil)
+	return err
+}
+
 // Get, gets the credential from the provided ucp provider plane
 //
 
@@ -27,9 +27,14 @@ import (
 const (
 	AzurePlaneType    = "azure"
 	AWSPlaneType      = "aws"
+	GCPPlaneType      = "gcp"
 	AzureCredential   = "azure"
 	AzurePlaneName    = "azurecloud"
 	defaultSecretName = "default"
+
+	// stagedSecretName is the name of the credential registered while rotating the credential, before it is made
+	// active. Radius only uses the credential named "default".
+	stagedSecretName = "staged"
 )
 
 //go:generate mockgen -typed -destination=./mock_credentialmanagementclient.go -package=credential -self_package github.com/radius-project/radius/pkg/cli/credential github.com/radius-project/radius/pkg/cli/credential CredentialManagementClient
@@ -44,13 +49,21 @@ type CredentialManagementClient interface {
 	PutAWS(ctx context.Context, credential_config ucp.AwsCredentialResource) error
 	// PutAzure registers an Azure credential with the respective ucp provider plane.
 	PutAzure(ctx context.Context, credential_config ucp.AzureCredentialResource) error
+	// PutGCP registers a GCP credential with the respective ucp provider plane.
+	PutGCP(ctx context.Context, credential_config ucp.GcpCredentialResource) error
 	// Delete unregisters credential from the given ucp provider plane.
 	Delete(ctx context.Context, providerName string) (bool, error)
+	// StageAWS registers an AWS credential with the respective ucp provider plane without making it active.
+	StageAWS(ctx context.Context, credential_config ucp.AwsCredentialResource) error
+	// StageAzure registers an Azure credential with the respective ucp provider plane without making it active.
+	StageAzure(ctx context.Context, credential_config ucp.AzureCredentialResource) error
+	// DeleteStaged unregisters the staged credential from the given ucp provider plane.
+	DeleteStaged(ctx context.Context, providerName string) (bool, error)
 }
 
 // CloudProviderStatus is the representation of a cloud provider configuration.
 type CloudProviderStatus struct {
-	// Name is the name/kind of the provider. For right now this only supports Azure and AWS.
+	// Name is the name/kind of the provider. For right now this only supports Azure, AWS and GCP.
 	Name string
 
 	// Enabled is the enabled/disabled status of the provider.
@@ -65,12 +78,16 @@ type ProviderCredentialConfiguration struct {
 
 	// AWSCredentials is used to set the credentials on Puts. It is NOT returned on Get/List.
 	AWSCredentials *AWSCredentialProperties
+
+	// GCPCredentials is used to set the credentials on Puts. It is NOT returned on Get/List.
+	GCPCredentials *GCPCredentialProperties
 }
 
 // UCPCredentialManagementClient implements operations to manage credentials on ucp.
 type UCPCredentialManagementClient struct {
 	AzClient  AzureCredentialManagementClientInterface
 	AWSClient AWSCredentialManagementClientInterface
+	GCPClient GCPCredentialManagementClientInterface
 }
 
 var _ CredentialManagementClient = (*UCPCredentialManagementClient)(nil)
@@ -94,6 +111,12 @@ func (cpm *UCPCredentialManagementClient) PutAzure(ctx context.Context, credenti
 	return err
 }
 
+// PutGCP registers the GCP credential with the provided credential config, returning an error if the GCPClient.Put
+// call fails.
+func (cpm *UCPCredentialManagementClient) PutGCP(ctx context.Context, credential ucp.GcpCredentialResource) error {
+	return cpm.GCPClient.Put(ctx, credential)
+}
+
 // Get, gets the credential from the provided ucp provider plane
 // We've a single credential configured today for all providers which we name as "default"
 // example: If we ask for azure credential, then we will fetch the credential with the name "default" because that is the only
@@ -112,6 +135,9 @@ func (cpm *UCPCredentialManagementClient) Get(ctx context.Context, providerName
 	} else if strings.EqualFold(providerName, AWSCredential) {
 		// We send only the name when getting credentials from backend which we already have access to
 		cred, err = cpm.AWSClient.Get(ctx, defaultSecretName)
+	} else if strings.EqualFold(providerName, GCPCredential) {
+		// We send only the name when getting credentials from backend which we already have access to
+		cred, err = cpm.GCPClient.Get(ctx, defaultSecretName)
 	} else {
 		return ProviderCredentialConfiguration{}, &ErrUnsupportedCloudProvider{}
 	}
@@ -134,7 +160,7 @@ func (cpm *UCPCredentialManagementClient) Get(ctx context.Context, providerName
 // List, lists the credentials registered with all ucp provider planes
 //
 
-// List() lists the credentials from both Azure and AWS and returns a slice of CloudProviderStatus. It returns
+// List() lists the credentials from Azure, AWS and GCP and returns a slice of CloudProviderStatus. It returns
 // an error if either of the list operations fail.
 func (cpm *UCPCredentialManagementClient) List(ctx context.Context) ([]CloudProviderStatus, error) {
 	// list azure credential
@@ -149,6 +175,13 @@ func (cpm *UCPCredentialManagementClient) List(ctx context.Context) ([]CloudProv
 		return nil, err
 	}
 	res = append(res, awsList...)
+
+	// list gcp credential
+	gcpList, err := cpm.GCPClient.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res = append(res, gcpList...)
 	return res, nil
 }
 
@@ -165,7 +198,33 @@ func (cpm *UCPCredentialManagementClient) Delete(ctx context.Context, providerNa
 		return cpm.AzClient.Delete(ctx, defaultSecretName)
 	} else if strings.EqualFold(providerName, AWSCredential) {
 		return cpm.AWSClient.Delete(ctx, defaultSecretName)
+	} else if strings.EqualFold(providerName, GCPCredential) {
+		return cpm.GCPClient.Delete(ctx, defaultSecretName)
 	}
 
 	return true, nil
 }
+
+// StageAWS registers the AWS credential under the staged name. The staged credential is not used by Radius until it
+// is registered as the active credential with PutAWS.
+func (cpm *UCPCredentialManagementClient) StageAWS(ctx context.Context, credential ucp.AwsCredentialResource) error {
+	return cpm.AWSClient.PutWithName(ctx, stagedSecretName, credential)
+}
+
+// StageAzure registers the Azure credential under the staged name. The staged credential is not used by Radius until
+// it is registered as the active credential with PutAzure.
+func (cpm *UCPCredentialManagementClient) StageAzure(ctx context.Context, credential ucp.AzureCredentialResource) error {
+	return cpm.AzClient.PutWithName(ctx, stagedSecretName, credential)
+}
+
+// DeleteStaged deletes the staged credential from the given ucp provider plane. It returns false if no credential was
+// staged.
+func (cpm *UCPCredentialManagementClient) DeleteStaged(ctx context.Context, providerName string) (bool, error) {
+	if strings.EqualFold(providerName, AzureCredential) {
+		return cpm.AzClient.Delete(ctx, stagedSecretName)
+	} else if strings.EqualFold(providerName, AWSCredential) {
+		return cpm.AWSClient.Delete(ctx, stagedSecretName)
+	}
+
+	return false, &ErrUnsupportedCloudProvider{}
+}
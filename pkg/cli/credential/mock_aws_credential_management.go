@@ -194,3 +194,41 @@ func (c *MockAWSCredentialManagementClientInterfacePutCall) DoAndReturn(f func(c
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PutWithName mocks base method.
+func (m *MockAWSCredentialManagementClientInterface) PutWithName(arg0 context.Context, arg1 string, arg2 v20231001preview.AwsCredentialResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutWithName indicates an expected call of PutWithName.
+func (mr *MockAWSCredentialManagementClientInterfaceMockRecorder) PutWithName(arg0, arg1, arg2 any) *MockAWSCredentialManagementClientInterfacePutWithNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithName", reflect.TypeOf((*MockAWSCredentialManagementClientInterface)(nil).PutWithName), arg0, arg1, arg2)
+	return &MockAWSCredentialManagementClientInterfacePutWithNameCall{Call: call}
+}
+
+// MockAWSCredentialManagementClientInterfacePutWithNameCall wrap *gomock.Call
+type MockAWSCredentialManagementClientInterfacePutWithNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAWSCredentialManagementClientInterfacePutWithNameCall) Return(arg0 error) *MockAWSCredentialManagementClientInterfacePutWithNameCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAWSCredentialManagementClientInterfacePutWithNameCall) Do(f func(context.Context, string, v20231001preview.AwsCredentialResource) error) *MockAWSCredentialManagementClientInterfacePutWithNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAWSCredentialManagementClientInterfacePutWithNameCall) DoAndReturn(f func(context.Context, string, v20231001preview.AwsCredentialResource) error) *MockAWSCredentialManagementClientInterfacePutWithNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
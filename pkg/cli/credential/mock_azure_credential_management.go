@@ -194,3 +194,41 @@ func (c *MockAzureCredentialManagementClientInterfacePutCall) DoAndReturn(f func
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PutWithName mocks base method.
+func (m *MockAzureCredentialManagementClientInterface) PutWithName(arg0 context.Context, arg1 string, arg2 v20231001preview.AzureCredentialResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutWithName indicates an expected call of PutWithName.
+func (mr *MockAzureCredentialManagementClientInterfaceMockRecorder) PutWithName(arg0, arg1, arg2 any) *MockAzureCredentialManagementClientInterfacePutWithNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithName", reflect.TypeOf((*MockAzureCredentialManagementClientInterface)(nil).PutWithName), arg0, arg1, arg2)
+	return &MockAzureCredentialManagementClientInterfacePutWithNameCall{Call: call}
+}
+
+// MockAzureCredentialManagementClientInterfacePutWithNameCall wrap *gomock.Call
+type MockAzureCredentialManagementClientInterfacePutWithNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAzureCredentialManagementClientInterfacePutWithNameCall) Return(arg0 error) *MockAzureCredentialManagementClientInterfacePutWithNameCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAzureCredentialManagementClientInterfacePutWithNameCall) Do(f func(context.Context, string, v20231001preview.AzureCredentialResource) error) *MockAzureCredentialManagementClientInterfacePutWithNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAzureCredentialManagementClientInterfacePutWithNameCall) DoAndReturn(f func(context.Context, string, v20231001preview.AzureCredentialResource) error) *MockAzureCredentialManagementClientInterfacePutWithNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
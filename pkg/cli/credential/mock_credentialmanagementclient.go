@@ -79,6 +79,45 @@ func (c *MockCredentialManagementClientDeleteCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// DeleteStaged mocks base method.
+func (m *MockCredentialManagementClient) DeleteStaged(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaged", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaged indicates an expected call of DeleteStaged.
+func (mr *MockCredentialManagementClientMockRecorder) DeleteStaged(arg0, arg1 any) *MockCredentialManagementClientDeleteStagedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaged", reflect.TypeOf((*MockCredentialManagementClient)(nil).DeleteStaged), arg0, arg1)
+	return &MockCredentialManagementClientDeleteStagedCall{Call: call}
+}
+
+// MockCredentialManagementClientDeleteStagedCall wrap *gomock.Call
+type MockCredentialManagementClientDeleteStagedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCredentialManagementClientDeleteStagedCall) Return(arg0 bool, arg1 error) *MockCredentialManagementClientDeleteStagedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCredentialManagementClientDeleteStagedCall) Do(f func(context.Context, string) (bool, error)) *MockCredentialManagementClientDeleteStagedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCredentialManagementClientDeleteStagedCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockCredentialManagementClientDeleteStagedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Get mocks base method.
 func (m *MockCredentialManagementClient) Get(arg0 context.Context, arg1 string) (ProviderCredentialConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -232,3 +271,117 @@ func (c *MockCredentialManagementClientPutAzureCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PutGCP mocks base method.
+func (m *MockCredentialManagementClient) PutGCP(arg0 context.Context, arg1 v20231001preview.GcpCredentialResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutGCP", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutGCP indicates an expected call of PutGCP.
+func (mr *MockCredentialManagementClientMockRecorder) PutGCP(arg0, arg1 any) *MockCredentialManagementClientPutGCPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutGCP", reflect.TypeOf((*MockCredentialManagementClient)(nil).PutGCP), arg0, arg1)
+	return &MockCredentialManagementClientPutGCPCall{Call: call}
+}
+
+// MockCredentialManagementClientPutGCPCall wrap *gomock.Call
+type MockCredentialManagementClientPutGCPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCredentialManagementClientPutGCPCall) Return(arg0 error) *MockCredentialManagementClientPutGCPCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCredentialManagementClientPutGCPCall) Do(f func(context.Context, v20231001preview.GcpCredentialResource) error) *MockCredentialManagementClientPutGCPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCredentialManagementClientPutGCPCall) DoAndReturn(f func(context.Context, v20231001preview.GcpCredentialResource) error) *MockCredentialManagementClientPutGCPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StageAWS mocks base method.
+func (m *MockCredentialManagementClient) StageAWS(arg0 context.Context, arg1 v20231001preview.AwsCredentialResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageAWS", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StageAWS indicates an expected call of StageAWS.
+func (mr *MockCredentialManagementClientMockRecorder) StageAWS(arg0, arg1 any) *MockCredentialManagementClientStageAWSCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageAWS", reflect.TypeOf((*MockCredentialManagementClient)(nil).StageAWS), arg0, arg1)
+	return &MockCredentialManagementClientStageAWSCall{Call: call}
+}
+
+// MockCredentialManagementClientStageAWSCall wrap *gomock.Call
+type MockCredentialManagementClientStageAWSCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCredentialManagementClientStageAWSCall) Return(arg0 error) *MockCredentialManagementClientStageAWSCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCredentialManagementClientStageAWSCall) Do(f func(context.Context, v20231001preview.AwsCredentialResource) error) *MockCredentialManagementClientStageAWSCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCredentialManagementClientStageAWSCall) DoAndReturn(f func(context.Context, v20231001preview.AwsCredentialResource) error) *MockCredentialManagementClientStageAWSCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StageAzure mocks base method.
+func (m *MockCredentialManagementClient) StageAzure(arg0 context.Context, arg1 v20231001preview.AzureCredentialResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageAzure", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StageAzure indicates an expected call of StageAzure.
+func (mr *MockCredentialManagementClientMockRecorder) StageAzure(arg0, arg1 any) *MockCredentialManagementClientStageAzureCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageAzure", reflect.TypeOf((*MockCredentialManagementClient)(nil).StageAzure), arg0, arg1)
+	return &MockCredentialManagementClientStageAzureCall{Call: call}
+}
+
+// MockCredentialManagementClientStageAzureCall wrap *gomock.Call
+type MockCredentialManagementClientStageAzureCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCredentialManagementClientStageAzureCall) Return(arg0 error) *MockCredentialManagementClientStageAzureCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCredentialManagementClientStageAzureCall) Do(f func(context.Context, v20231001preview.AzureCredentialResource) error) *MockCredentialManagementClientStageAzureCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCredentialManagementClientStageAzureCall) DoAndReturn(f func(context.Context, v20231001preview.AzureCredentialResource) error) *MockCredentialManagementClientStageAzureCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credential
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+const (
+	// azureManagementScope is the scope of the token requested to verify an Azure credential.
+	azureManagementScope = "https://management.azure.com/.default"
+
+	// awsVerificationRegion is the region of the STS endpoint used to verify an AWS credential.
+	awsVerificationRegion = "us-east-1"
+)
+
+//go:generate mockgen -typed -destination=./mock_verifier.go -package=credential -self_package github.com/radius-project/radius/pkg/cli/credential github.com/radius-project/radius/pkg/cli/credential Verifier
+
+// Verifier tests the connectivity of a cloud provider credential before it is registered with Radius.
+type Verifier interface {
+	// VerifyAzureServicePrincipal verifies that a token can be acquired with the Azure service principal.
+	VerifyAzureServicePrincipal(ctx context.Context, tenantID string, clientID string, clientSecret string) error
+	// VerifyAWSAccessKey verifies that the caller identity can be retrieved with the AWS access key.
+	VerifyAWSAccessKey(ctx context.Context, accessKeyID string, secretAccessKey string) error
+}
+
+// NewVerifier returns a new Verifier.
+func NewVerifier() Verifier {
+	return &verifier{}
+}
+
+type verifier struct{}
+
+var _ Verifier = (*verifier)(nil)
+
+// VerifyAzureServicePrincipal verifies that a token for Azure Resource Manager can be acquired with the service principal.
+func (v *verifier) VerifyAzureServicePrincipal(ctx context.Context, tenantID string, clientID string, clientSecret string) error {
+	cred, err := azidentity.NewClientSecretCredential(tenantID, clientID, clientSecret, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureManagementScope}})
+	return err
+}
+
+// VerifyAWSAccessKey verifies that the caller identity can be retrieved from STS with the access key.
+func (v *verifier) VerifyAWSAccessKey(ctx context.Context, accessKeyID string, secretAccessKey string) error {
+	client := sts.New(sts.Options{
+		Region:      awsVerificationRegion,
+		Credentials: credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, ""),
+	})
+
+	_, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	return err
+}
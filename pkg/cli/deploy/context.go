@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// ContextOptions contains the options used to create the deployment context of a deployment.
+type ContextOptions struct {
+	// ConnectionFactory is used to create the client which reads the application.
+	ConnectionFactory connections.Factory
+
+	// Workspace is the workspace to use for deployment. Its scope is the resource group the template is deployed to.
+	Workspace workspaces.Workspace
+
+	// EnvironmentID is the resource id of the environment the template is deployed to.
+	EnvironmentID string
+
+	// EnvironmentNamespace is the Kubernetes namespace of the environment. It is empty when the environment does not
+	// use Kubernetes compute.
+	EnvironmentNamespace string
+
+	// ApplicationID is the resource id of the application the template is deployed to. This is optional.
+	ApplicationID string
+}
+
+// EnvironmentNamespace returns the Kubernetes namespace of the environment, or an empty string if the environment does
+// not use Kubernetes compute.
+func EnvironmentNamespace(environment corerp.EnvironmentResource) string {
+	if environment.Properties == nil {
+		return ""
+	}
+
+	compute, ok := environment.Properties.Compute.(*corerp.KubernetesCompute)
+	if !ok {
+		return ""
+	}
+
+	return to.String(compute.Namespace)
+}
+
+// InjectContextParameter injects the deployment context into the 'context' parameter if the template declares it and
+// the parameters don't include it already. The application is read to find its Kubernetes namespace, which defaults
+// to a namespace derived from the environment namespace for applications that don't exist yet.
+func InjectContextParameter(ctx context.Context, options ContextOptions, template map[string]any, parameters clients.DeploymentParameters) error {
+	declaredParameters, err := bicep.ExtractParameters(template)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := declaredParameters[bicep.DeploymentContextParam]; !ok {
+		return nil
+	}
+
+	if _, ok := parameters[bicep.DeploymentContextParam]; ok {
+		return nil
+	}
+
+	deploymentContext, err := newDeploymentContext(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	return bicep.InjectContextParam(template, parameters, deploymentContext)
+}
+
+func newDeploymentContext(ctx context.Context, options ContextOptions) (bicep.DeploymentContext, error) {
+	environmentID, err := resources.ParseResource(options.EnvironmentID)
+	if err != nil {
+		return bicep.DeploymentContext{}, err
+	}
+
+	scope, err := resources.ParseScope(options.Workspace.Scope)
+	if err != nil {
+		return bicep.DeploymentContext{}, err
+	}
+
+	deploymentContext := bicep.DeploymentContext{
+		Environment: bicep.DeploymentContextResource{
+			Name: environmentID.Name(),
+			ID:   environmentID.String(),
+		},
+		ResourceGroup: bicep.DeploymentContextResource{
+			Name: scope.FindScope(resources_radius.ScopeResourceGroups),
+			ID:   scope.String(),
+		},
+	}
+
+	namespace := options.EnvironmentNamespace
+	if options.ApplicationID != "" {
+		applicationID, err := resources.ParseResource(options.ApplicationID)
+		if err != nil {
+			return bicep.DeploymentContext{}, err
+		}
+
+		deploymentContext.Application = bicep.DeploymentContextResource{
+			Name: applicationID.Name(),
+			ID:   applicationID.String(),
+		}
+
+		if options.EnvironmentNamespace != "" {
+			namespace, err = applicationNamespace(ctx, options, applicationID)
+			if err != nil {
+				return bicep.DeploymentContext{}, err
+			}
+		}
+	}
+
+	if options.EnvironmentNamespace != "" {
+		deploymentContext.Runtime.Kubernetes = &bicep.DeploymentContextKubernetes{
+			Namespace:            namespace,
+			EnvironmentNamespace: options.EnvironmentNamespace,
+		}
+	}
+
+	return deploymentContext, nil
+}
+
+// applicationNamespace returns the Kubernetes namespace of the application. The default namespace of an application
+// is returned when the application does not exist yet.
+func applicationNamespace(ctx context.Context, options ContextOptions, applicationID resources.ID) (string, error) {
+	defaultNamespace := kubernetes.DefaultNamingStrategy.MakeName(options.EnvironmentNamespace, applicationID.Name())
+
+	client, err := options.ConnectionFactory.CreateApplicationsManagementClient(ctx, options.Workspace)
+	if err != nil {
+		return "", err
+	}
+
+	application, err := client.GetApplication(ctx, applicationID.String())
+	if clients.Is404Error(err) {
+		return defaultNamespace, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	if application.Properties != nil && application.Properties.Status != nil {
+		if compute, ok := application.Properties.Status.Compute.(*corerp.KubernetesCompute); ok && to.String(compute.Namespace) != "" {
+			return *compute.Namespace, nil
+		}
+	}
+
+	return defaultNamespace, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func Test_EnvironmentNamespace(t *testing.T) {
+	t.Run("kubernetes compute", func(t *testing.T) {
+		environment := corerp.EnvironmentResource{
+			Properties: &corerp.EnvironmentProperties{
+				Compute: &corerp.KubernetesCompute{
+					Kind:      to.Ptr("kubernetes"),
+					Namespace: to.Ptr("test-ns"),
+				},
+			},
+		}
+		require.Equal(t, "test-ns", EnvironmentNamespace(environment))
+	})
+
+	t.Run("no compute", func(t *testing.T) {
+		require.Equal(t, "", EnvironmentNamespace(corerp.EnvironmentResource{}))
+	})
+}
+
+func Test_InjectContextParameter(t *testing.T) {
+	const (
+		environmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+		applicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	)
+
+	newTemplate := func() map[string]any {
+		return map[string]any{
+			"parameters": map[string]any{
+				"context": map[string]any{"type": "object"},
+			},
+		}
+	}
+
+	newOptions := func(client clients.ApplicationsManagementClient, applicationID string) ContextOptions {
+		return ContextOptions{
+			ConnectionFactory:    &connections.MockFactory{ApplicationsManagementClient: client},
+			Workspace:            workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			EnvironmentID:        environmentID,
+			EnvironmentNamespace: "test-ns",
+			ApplicationID:        applicationID,
+		}
+	}
+
+	expectedContext := func(application bicep.DeploymentContextResource, namespace string) bicep.DeploymentContext {
+		return bicep.DeploymentContext{
+			Environment:   bicep.DeploymentContextResource{Name: "test-env", ID: environmentID},
+			Application:   application,
+			ResourceGroup: bicep.DeploymentContextResource{Name: "test-group", ID: "/planes/radius/local/resourceGroups/test-group"},
+			Runtime: bicep.DeploymentContextRuntime{
+				Kubernetes: &bicep.DeploymentContextKubernetes{
+					Namespace:            namespace,
+					EnvironmentNamespace: "test-ns",
+				},
+			},
+		}
+	}
+
+	t.Run("without application", func(t *testing.T) {
+		parameters := clients.DeploymentParameters{}
+
+		err := InjectContextParameter(context.Background(), newOptions(nil, ""), newTemplate(), parameters)
+		require.NoError(t, err)
+
+		require.Equal(t, expectedContext(bicep.DeploymentContextResource{}, "test-ns"), parameters["context"]["value"])
+	})
+
+	t.Run("without kubernetes compute", func(t *testing.T) {
+		parameters := clients.DeploymentParameters{}
+		options := newOptions(nil, applicationID)
+		options.EnvironmentNamespace = ""
+
+		err := InjectContextParameter(context.Background(), options, newTemplate(), parameters)
+		require.NoError(t, err)
+
+		expected := bicep.DeploymentContext{
+			Environment:   bicep.DeploymentContextResource{Name: "test-env", ID: environmentID},
+			Application:   bicep.DeploymentContextResource{Name: "test-app", ID: applicationID},
+			ResourceGroup: bicep.DeploymentContextResource{Name: "test-group", ID: "/planes/radius/local/resourceGroups/test-group"},
+		}
+		require.Equal(t, expected, parameters["context"]["value"])
+	})
+
+	t.Run("with existing application", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetApplication(gomock.Any(), applicationID).
+			Return(corerp.ApplicationResource{
+				Properties: &corerp.ApplicationProperties{
+					Status: &corerp.ResourceStatus{
+						Compute: &corerp.KubernetesCompute{
+							Kind:      to.Ptr("kubernetes"),
+							Namespace: to.Ptr("custom-ns"),
+						},
+					},
+				},
+			}, nil).
+			Times(1)
+		parameters := clients.DeploymentParameters{}
+
+		err := InjectContextParameter(context.Background(), newOptions(client, applicationID), newTemplate(), parameters)
+		require.NoError(t, err)
+
+		application := bicep.DeploymentContextResource{Name: "test-app", ID: applicationID}
+		require.Equal(t, expectedContext(application, "custom-ns"), parameters["context"]["value"])
+	})
+
+	t.Run("with new application", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetApplication(gomock.Any(), applicationID).
+			Return(corerp.ApplicationResource{}, &azcore.ResponseError{ErrorCode: v1.CodeNotFound, StatusCode: 404}).
+			Times(1)
+		parameters := clients.DeploymentParameters{}
+
+		err := InjectContextParameter(context.Background(), newOptions(client, applicationID), newTemplate(), parameters)
+		require.NoError(t, err)
+
+		application := bicep.DeploymentContextResource{Name: "test-app", ID: applicationID}
+		require.Equal(t, expectedContext(application, "test-ns-test-app"), parameters["context"]["value"])
+	})
+
+	t.Run("provided by the user", func(t *testing.T) {
+		parameters := clients.DeploymentParameters{"context": {"value": "provided"}}
+
+		err := InjectContextParameter(context.Background(), newOptions(nil, applicationID), newTemplate(), parameters)
+		require.NoError(t, err)
+
+		require.Equal(t, "provided", parameters["context"]["value"])
+	})
+
+	t.Run("not declared by the template", func(t *testing.T) {
+		parameters := clients.DeploymentParameters{}
+
+		err := InjectContextParameter(context.Background(), newOptions(nil, applicationID), map[string]any{"parameters": map[string]any{}}, parameters)
+		require.NoError(t, err)
+
+		require.NotContains(t, parameters, "context")
+	})
+}
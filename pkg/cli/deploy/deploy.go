@@ -18,6 +18,7 @@ package deploy
 
 import (
 	"context"
+	"os"
 	"sync"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -29,7 +30,8 @@ import (
 //
 
 // DeployWithProgress injects environment and application parameters into the template, displays progress updates while
-// deploying, and logs the deployment results and public endpoints. If an error occurs, an error is returned.
+// deploying, and logs the deployment results and public endpoints. If the deployment fails, the status of each resource
+// is displayed in a table and the error is returned.
 func DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error) {
 	deploymentClient, err := options.ConnectionFactory.CreateDeploymentClient(ctx, options.Workspace)
 	if err != nil {
@@ -41,7 +43,7 @@ func DeployWithProgress(ctx context.Context, options Options) (clients.Deploymen
 
 	// Watch for progress while we're deploying.
 	progressChan := make(chan clients.ResourceProgress, 1)
-	listener := NewProgressListener(progressChan)
+	listener := NewProgressListener(progressChan, options.Progress)
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
@@ -59,6 +61,16 @@ func DeployWithProgress(ctx context.Context, options Options) (clients.Deploymen
 	// Drain any UI progress updates before we process the results of the deployment.
 	wg.Wait()
 	if err != nil {
+		operations := newOperationDisplays(result.Operations)
+		if len(operations) > 0 {
+			output.LogInfo("")
+			output.LogInfo("Resources:")
+
+			// The deployment error is more relevant to the user than a failure to display the table.
+			_ = output.Write(output.FormatTable, operations, os.Stdout, operationsFormat())
+			output.LogInfo("")
+		}
+
 		return clients.DeploymentResult{}, err
 	}
 
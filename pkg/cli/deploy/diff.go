@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ResourceChangeKind is the kind of change made to a resource of a template.
+type ResourceChangeKind string
+
+const (
+	// ResourceAdded is a resource declared by the new template only.
+	ResourceAdded ResourceChangeKind = "+"
+	// ResourceModified is a resource declared by both templates with a different definition.
+	ResourceModified ResourceChangeKind = "~"
+	// ResourceRemoved is a resource declared by the previous template only. Deployments are incremental, so the
+	// resource is not deleted by deploying the new template.
+	ResourceRemoved ResourceChangeKind = "-"
+)
+
+// ResourceChange is a change made to a resource of a template.
+type ResourceChange struct {
+	// Kind is the kind of change.
+	Kind ResourceChangeKind
+	// Name is the symbolic name of the resource, or its name expression if the template doesn't use symbolic names.
+	Name string
+	// Type is the resource type, eg: Applications.Core/containers.
+	Type string
+}
+
+// String returns the change in a diff-style format, eg: "+ frontend (Applications.Core/containers)".
+func (c ResourceChange) String() string {
+	return fmt.Sprintf("%s %s (%s)", c.Kind, c.Name, c.Type)
+}
+
+// DiffTemplates compares the resources declared by two compiled templates and returns the resources which were added,
+// modified or removed, sorted by name.
+func DiffTemplates(previous map[string]any, current map[string]any) []ResourceChange {
+	previousResources := templateResources(previous)
+	currentResources := templateResources(current)
+
+	changes := []ResourceChange{}
+	for key, resource := range currentResources {
+		previousResource, ok := previousResources[key]
+		if !ok {
+			changes = append(changes, resource.change(ResourceAdded))
+		} else if !reflect.DeepEqual(previousResource.definition, resource.definition) {
+			changes = append(changes, resource.change(ResourceModified))
+		}
+	}
+
+	for key, resource := range previousResources {
+		if _, ok := currentResources[key]; !ok {
+			changes = append(changes, resource.change(ResourceRemoved))
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].Type < changes[j].Type
+	})
+
+	return changes
+}
+
+// templateResource is a resource declared by a template.
+type templateResource struct {
+	name       string
+	definition map[string]any
+}
+
+func (r templateResource) change(kind ResourceChangeKind) ResourceChange {
+	resourceType, _ := r.definition["type"].(string)
+	return ResourceChange{Kind: kind, Name: r.name, Type: resourceType}
+}
+
+// templateResources returns the resources of the template. Templates using symbolic names declare the resources in an
+// object keyed by symbolic name, other templates declare them in an array and the resources are keyed by type and name.
+func templateResources(template map[string]any) map[string]templateResource {
+	result := map[string]templateResource{}
+	switch resources := template["resources"].(type) {
+	case map[string]any:
+		for name, value := range resources {
+			if definition, ok := value.(map[string]any); ok {
+				result[name] = templateResource{name: name, definition: definition}
+			}
+		}
+	case []any:
+		for _, value := range resources {
+			if definition, ok := value.(map[string]any); ok {
+				name, _ := definition["name"].(string)
+				resourceType, _ := definition["type"].(string)
+				result[resourceType+"/"+name] = templateResource{name: name, definition: definition}
+			}
+		}
+	}
+
+	return result
+}
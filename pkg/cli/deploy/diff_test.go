@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DiffTemplates(t *testing.T) {
+	t.Run("symbolic names", func(t *testing.T) {
+		previous := map[string]any{
+			"resources": map[string]any{
+				"frontend": map[string]any{"type": "Applications.Core/containers", "properties": map[string]any{"image": "frontend:1"}},
+				"backend":  map[string]any{"type": "Applications.Core/containers", "properties": map[string]any{"image": "backend:1"}},
+				"db":       map[string]any{"type": "Applications.Datastores/redisCaches"},
+			},
+		}
+		current := map[string]any{
+			"resources": map[string]any{
+				"frontend": map[string]any{"type": "Applications.Core/containers", "properties": map[string]any{"image": "frontend:2"}},
+				"backend":  map[string]any{"type": "Applications.Core/containers", "properties": map[string]any{"image": "backend:1"}},
+				"gateway":  map[string]any{"type": "Applications.Core/gateways"},
+			},
+		}
+
+		changes := DiffTemplates(previous, current)
+		require.Equal(t, []ResourceChange{
+			{Kind: ResourceRemoved, Name: "db", Type: "Applications.Datastores/redisCaches"},
+			{Kind: ResourceModified, Name: "frontend", Type: "Applications.Core/containers"},
+			{Kind: ResourceAdded, Name: "gateway", Type: "Applications.Core/gateways"},
+		}, changes)
+		require.Equal(t, "~ frontend (Applications.Core/containers)", changes[1].String())
+	})
+
+	t.Run("resource array", func(t *testing.T) {
+		previous := map[string]any{
+			"resources": []any{
+				map[string]any{"type": "Applications.Core/containers", "name": "app", "properties": map[string]any{}},
+			},
+		}
+		current := map[string]any{
+			"resources": []any{
+				map[string]any{"type": "Applications.Core/containers", "name": "app", "properties": map[string]any{}},
+				map[string]any{"type": "Applications.Core/applications", "name": "app"},
+			},
+		}
+
+		require.Equal(t, []ResourceChange{
+			{Kind: ResourceAdded, Name: "app", Type: "Applications.Core/applications"},
+		}, DiffTemplates(previous, current))
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		template := map[string]any{
+			"resources": map[string]any{
+				"frontend": map[string]any{"type": "Applications.Core/containers"},
+			},
+		}
+
+		require.Empty(t, DiffTemplates(template, template))
+	})
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+const (
+	// deploymentHistoryPrefix is the prefix of the name of the template spec which records the deployment history
+	// of an application.
+	deploymentHistoryPrefix = "rad-deployments-"
+
+	// deploymentVersionFormat is the format of the names of the recorded deployments. The names sort in the order
+	// the deployments were recorded.
+	deploymentVersionFormat = "20060102-150405.000"
+
+	// MaxDeploymentHistory is the number of deployments kept in the deployment history of an application.
+	MaxDeploymentHistory = 10
+)
+
+// DeploymentHistoryName returns the name of the template spec which records the deployment history of the application.
+func DeploymentHistoryName(applicationName string) string {
+	return deploymentHistoryPrefix + applicationName
+}
+
+// RecordDeployment records the deployment of a template for an application in the deployment history of the application.
+// The deployment history is stored in the template spec library of the resource group of the application. The values of
+// the parameters passed to the deployment are recorded as the default values of the parameters of the template, so a
+// recorded deployment can be deployed again as is. The environment, application and context parameters, and the values
+// of secure parameters are not recorded. Only the latest MaxDeploymentHistory deployments are kept. RecordDeployment returns the
+// name of the recorded deployment.
+func RecordDeployment(ctx context.Context, client clients.ApplicationsManagementClient, applicationID string, environmentID string, template map[string]any, parameters clients.DeploymentParameters) (string, error) {
+	planeName, resourceGroupName, applicationName, err := parseApplicationID(applicationID)
+	if err != nil {
+		return "", err
+	}
+
+	recorded, err := recordedTemplate(template, parameters)
+	if err != nil {
+		return "", err
+	}
+
+	templateSpecName := DeploymentHistoryName(applicationName)
+	_, err = client.CreateOrUpdateTemplateSpec(ctx, planeName, resourceGroupName, templateSpecName, &ucp.TemplateSpecResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &ucp.TemplateSpecProperties{
+			Description: to.Ptr(fmt.Sprintf("Deployment history of application %q", applicationName)),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	versionName := time.Now().UTC().Format(deploymentVersionFormat)
+	_, err = client.CreateOrUpdateTemplateSpecVersion(ctx, planeName, resourceGroupName, templateSpecName, versionName, &ucp.TemplateSpecVersionResource{
+		Properties: &ucp.TemplateSpecVersionProperties{
+			Description: to.Ptr(fmt.Sprintf("Deployment of application %q to environment %q", applicationName, environmentID)),
+			Template:    recorded,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	versions, err := ListDeployments(ctx, client, applicationID)
+	if err != nil {
+		return "", err
+	}
+
+	for i := MaxDeploymentHistory; i < len(versions); i++ {
+		_, err = client.DeleteTemplateSpecVersion(ctx, planeName, resourceGroupName, templateSpecName, to.String(versions[i].Name))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return versionName, nil
+}
+
+// ListDeployments lists the deployments recorded in the deployment history of an application, from the newest to
+// the oldest.
+func ListDeployments(ctx context.Context, client clients.ApplicationsManagementClient, applicationID string) ([]ucp.TemplateSpecVersionResource, error) {
+	planeName, resourceGroupName, applicationName, err := parseApplicationID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := client.ListTemplateSpecVersions(ctx, planeName, resourceGroupName, DeploymentHistoryName(applicationName))
+	if clients.Is404Error(err) {
+		return []ucp.TemplateSpecVersionResource{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return to.String(versions[i].Name) > to.String(versions[j].Name)
+	})
+
+	return versions, nil
+}
+
+func parseApplicationID(applicationID string) (planeName string, resourceGroupName string, applicationName string, err error) {
+	id, err := resources.ParseResource(applicationID)
+	if err != nil {
+		return "", "", "", fmt.Errorf("invalid application ID %q: %w", applicationID, err)
+	}
+
+	resourceGroupName = id.FindScope(resources_radius.ScopeResourceGroups)
+	if resourceGroupName == "" {
+		return "", "", "", fmt.Errorf("invalid application ID %q: the application must be in a resource group", applicationID)
+	}
+
+	return id.FindScope(resources_radius.PlaneTypeRadius), resourceGroupName, id.Name(), nil
+}
+
+// recordedTemplate returns a copy of the template in which the values of the parameters are set as the default values
+// of the parameters.
+func recordedTemplate(template map[string]any, parameters clients.DeploymentParameters) (map[string]any, error) {
+	b, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := map[string]any{}
+	if err := json.Unmarshal(b, &recorded); err != nil {
+		return nil, err
+	}
+
+	declaredParameters, err := bicep.ExtractParameters(recorded)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, declared := range declaredParameters {
+		if strings.EqualFold(name, "environment") || strings.EqualFold(name, "application") || strings.EqualFold(name, bicep.DeploymentContextParam) {
+			continue
+		}
+
+		parameter, ok := declared.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		// Secrets must not be stored in the deployment history.
+		if parameterType, ok := parameter["type"].(string); ok && strings.HasPrefix(strings.ToLower(parameterType), "secure") {
+			continue
+		}
+
+		for provided, value := range parameters {
+			if !strings.EqualFold(name, provided) {
+				continue
+			}
+
+			if v, ok := value["value"]; ok {
+				parameter["defaultValue"] = v
+			}
+			break
+		}
+	}
+
+	return recorded, nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+const (
+	testApplicationID = "/planes/radius/local/resourceGroups/dev/providers/Applications.Core/applications/myapp"
+	testEnvironmentID = "/planes/radius/local/resourceGroups/dev/providers/Applications.Core/environments/dev"
+)
+
+func Test_RecordDeployment(t *testing.T) {
+	template := map[string]any{
+		"parameters": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"application": map[string]any{"type": "string"},
+			"context":     map[string]any{"type": "object"},
+			"replicas":    map[string]any{"type": "int"},
+			"tag":         map[string]any{"type": "string", "defaultValue": "latest"},
+			"password":    map[string]any{"type": "securestring"},
+		},
+		"resources": map[string]any{},
+	}
+	parameters := clients.DeploymentParameters{
+		"environment": {"value": testEnvironmentID},
+		"application": {"value": testApplicationID},
+		"context":     {"value": map[string]any{"environment": map[string]any{"name": "dev", "id": testEnvironmentID}}},
+		"Replicas":    {"value": float64(3)},
+		"password":    {"value": "secret"},
+	}
+
+	t.Run("records the deployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+
+		client.EXPECT().
+			CreateOrUpdateTemplateSpec(gomock.Any(), "local", "dev", "rad-deployments-myapp", gomock.Any()).
+			Return(ucp.TemplateSpecResource{}, nil).
+			Times(1)
+
+		var recorded *ucp.TemplateSpecVersionResource
+		client.EXPECT().
+			CreateOrUpdateTemplateSpecVersion(gomock.Any(), "local", "dev", "rad-deployments-myapp", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, planeName, resourceGroupName, templateSpecName, versionName string, resource *ucp.TemplateSpecVersionResource) (ucp.TemplateSpecVersionResource, error) {
+				recorded = resource
+				return *resource, nil
+			}).
+			Times(1)
+
+		// Only the latest deployments are kept.
+		versions := []ucp.TemplateSpecVersionResource{}
+		for i := 0; i < MaxDeploymentHistory+2; i++ {
+			versions = append(versions, ucp.TemplateSpecVersionResource{Name: to.Ptr(fmt.Sprintf("20231001-0000%02d.000", i))})
+		}
+		client.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", "dev", "rad-deployments-myapp").
+			Return(versions, nil).
+			Times(1)
+		client.EXPECT().
+			DeleteTemplateSpecVersion(gomock.Any(), "local", "dev", "rad-deployments-myapp", "20231001-000001.000").
+			Return(true, nil).
+			Times(1)
+		client.EXPECT().
+			DeleteTemplateSpecVersion(gomock.Any(), "local", "dev", "rad-deployments-myapp", "20231001-000000.000").
+			Return(true, nil).
+			Times(1)
+
+		name, err := RecordDeployment(context.Background(), client, testApplicationID, testEnvironmentID, template, parameters)
+		require.NoError(t, err)
+		require.Regexp(t, `^\d{8}-\d{6}\.\d{3}$`, name)
+
+		expected := map[string]any{
+			"parameters": map[string]any{
+				"environment": map[string]any{"type": "string"},
+				"application": map[string]any{"type": "string"},
+				"context":     map[string]any{"type": "object"},
+				"replicas":    map[string]any{"type": "int", "defaultValue": float64(3)},
+				"tag":         map[string]any{"type": "string", "defaultValue": "latest"},
+				"password":    map[string]any{"type": "securestring"},
+			},
+			"resources": map[string]any{},
+		}
+		require.Equal(t, expected, recorded.Properties.Template)
+		require.Equal(t, fmt.Sprintf("Deployment of application %q to environment %q", "myapp", testEnvironmentID), to.String(recorded.Properties.Description))
+
+		// The template passed to the deployment is not modified.
+		require.NotContains(t, template["parameters"].(map[string]any)["replicas"], "defaultValue")
+	})
+
+	t.Run("invalid application ID", func(t *testing.T) {
+		client := clients.NewMockApplicationsManagementClient(gomock.NewController(t))
+
+		_, err := RecordDeployment(context.Background(), client, "/planes/radius/local/providers/Applications.Core/applications/myapp", testEnvironmentID, template, parameters)
+		require.ErrorContains(t, err, "the application must be in a resource group")
+	})
+}
+
+func Test_ListDeployments(t *testing.T) {
+	t.Run("sorted from newest to oldest", func(t *testing.T) {
+		client := clients.NewMockApplicationsManagementClient(gomock.NewController(t))
+		client.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", "dev", "rad-deployments-myapp").
+			Return([]ucp.TemplateSpecVersionResource{
+				{Name: to.Ptr("20231001-000000.000")},
+				{Name: to.Ptr("20231003-000000.000")},
+				{Name: to.Ptr("20231002-000000.000")},
+			}, nil).
+			Times(1)
+
+		versions, err := ListDeployments(context.Background(), client, testApplicationID)
+		require.NoError(t, err)
+
+		names := []string{}
+		for _, version := range versions {
+			names = append(names, to.String(version.Name))
+		}
+		require.Equal(t, []string{"20231003-000000.000", "20231002-000000.000", "20231001-000000.000"}, names)
+	})
+
+	t.Run("no deployment history", func(t *testing.T) {
+		client := clients.NewMockApplicationsManagementClient(gomock.NewController(t))
+		client.EXPECT().
+			ListTemplateSpecVersions(gomock.Any(), "local", "dev", "rad-deployments-myapp").
+			Return(nil, &azcore.ResponseError{ErrorCode: v1.CodeNotFound, StatusCode: 404}).
+			Times(1)
+
+		versions, err := ListDeployments(context.Background(), client, testApplicationID)
+		require.NoError(t, err)
+		require.Empty(t, versions)
+	})
+}
@@ -78,3 +78,42 @@ func (c *MockInterfaceDeployWithProgressCall) DoAndReturn(f func(context.Context
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// WhatIfWithProgress mocks base method.
+func (m *MockInterface) WhatIfWithProgress(arg0 context.Context, arg1 Options) (clients.WhatIfResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhatIfWithProgress", arg0, arg1)
+	ret0, _ := ret[0].(clients.WhatIfResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhatIfWithProgress indicates an expected call of WhatIfWithProgress.
+func (mr *MockInterfaceMockRecorder) WhatIfWithProgress(arg0, arg1 any) *MockInterfaceWhatIfWithProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhatIfWithProgress", reflect.TypeOf((*MockInterface)(nil).WhatIfWithProgress), arg0, arg1)
+	return &MockInterfaceWhatIfWithProgressCall{Call: call}
+}
+
+// MockInterfaceWhatIfWithProgressCall wrap *gomock.Call
+type MockInterfaceWhatIfWithProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceWhatIfWithProgressCall) Return(arg0 clients.WhatIfResult, arg1 error) *MockInterfaceWhatIfWithProgressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceWhatIfWithProgressCall) Do(f func(context.Context, Options) (clients.WhatIfResult, error)) *MockInterfaceWhatIfWithProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceWhatIfWithProgressCall) DoAndReturn(f func(context.Context, Options) (clients.WhatIfResult, error)) *MockInterfaceWhatIfWithProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
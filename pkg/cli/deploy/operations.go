@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/output"
+)
+
+// operationDisplay is the representation of a deployment operation shown to the user.
+type operationDisplay struct {
+	Name      string
+	Type      string
+	Status    string
+	Timestamp string
+	Error     string
+}
+
+// newOperationDisplays converts deployment operations to their display representation, skipping the resources
+// that are hidden from the user.
+func newOperationDisplays(operations []clients.DeploymentOperation) []operationDisplay {
+	displays := []operationDisplay{}
+	for _, operation := range operations {
+		if !output.ShowResource(operation.Resource) {
+			continue
+		}
+
+		display := operationDisplay{
+			Name:   output.FormatResourceNameForDisplay(operation.Resource),
+			Type:   output.FormatResourceTypeForDisplay(operation.Resource),
+			Status: operation.ProvisioningState,
+			Error:  operation.Error,
+		}
+		if operation.Timestamp != nil {
+			display.Timestamp = operation.Timestamp.Format(time.RFC3339)
+		}
+
+		displays = append(displays, display)
+	}
+
+	return displays
+}
+
+// operationsFormat sets up the columns and headings for a table to display the status of each resource of a deployment.
+func operationsFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .Status }",
+			},
+			{
+				Heading:  "TIMESTAMP",
+				JSONPath: "{ .Timestamp }",
+			},
+			{
+				Heading:  "ERROR",
+				JSONPath: "{ .Error }",
+			},
+		},
+	}
+}
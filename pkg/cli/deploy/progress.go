@@ -17,8 +17,11 @@ limitations under the License.
 package deploy
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,19 +31,71 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 )
 
-// NewProgressListener creates a new ProgressListener based on whether the output is a terminal or not, returning an
-// InteractiveListener if it is a terminal and a NoOpListener if it is not.
-func NewProgressListener(progressChan <-chan clients.ResourceProgress) ProgressListener {
+// ProgressMode controls how the progress of a deployment is displayed.
+type ProgressMode string
+
+const (
+	// ProgressModeAuto displays an animated progress spinner when stdout is a terminal, and falls back to
+	// ProgressModePlain otherwise.
+	ProgressModeAuto ProgressMode = "auto"
+
+	// ProgressModePlain displays a line of text for each progress event. This is suitable for CI logs.
+	ProgressModePlain ProgressMode = "plain"
+
+	// ProgressModeJSON displays a JSON object on a single line for each progress event.
+	ProgressModeJSON ProgressMode = "json"
+)
+
+// SupportedProgressModes returns the progress modes that can be specified by the user.
+func SupportedProgressModes() []string {
+	return []string{string(ProgressModeAuto), string(ProgressModePlain), string(ProgressModeJSON)}
+}
+
+// ParseProgressMode parses the progress mode specified by the user. An empty string is parsed as ProgressModeAuto.
+func ParseProgressMode(value string) (ProgressMode, error) {
+	if value == "" {
+		return ProgressModeAuto, nil
+	}
+
+	for _, mode := range SupportedProgressModes() {
+		if strings.EqualFold(value, mode) {
+			return ProgressMode(mode), nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported progress mode %q, supported modes are: %s", value, strings.Join(SupportedProgressModes(), ", "))
+}
+
+// NewProgressListener creates a new ProgressListener for the given mode. In ProgressModeAuto an InteractiveListener
+// is returned if stdout is a terminal and a PlainListener is returned otherwise.
+func NewProgressListener(progressChan <-chan clients.ResourceProgress, mode ProgressMode) ProgressListener {
+	switch mode {
+	case ProgressModeJSON:
+		return &JSONListener{
+			progressChan: progressChan,
+			writer:       os.Stdout,
+			now:          time.Now,
+		}
+	case ProgressModePlain:
+		return &PlainListener{
+			progressChan: progressChan,
+			writer:       os.Stdout,
+			now:          time.Now,
+		}
+	}
+
 	if isatty.IsTerminal(os.Stdout.Fd()) {
 		return &InteractiveListener{
 			progressChan: progressChan,
 			writerDone:   &sync.WaitGroup{},
 			Spinner:      output.ProgressDefaultSpinner,
 		}
-	} else {
-		return &NoOpListener{
-			progressChan: progressChan,
-		}
+	}
+
+	return &PlainListener{
+		progressChan: progressChan,
+		writer:       os.Stdout,
+		now:          time.Now,
 	}
 }
 
@@ -50,14 +105,100 @@ type ProgressListener interface {
 	Run()
 }
 
-type NoOpListener struct {
+// PlainListener writes a line of text for each progress event without any animation.
+type PlainListener struct {
 	progressChan <-chan clients.ResourceProgress
+	writer       io.Writer
+	now          func() time.Time
 }
 
-// Run() continuously drains the updates from the progressChan channel without taking any action.
-func (listener *NoOpListener) Run() {
-	for range listener.progressChan {
-		// Do nothing except drain the updates.
+// Run writes a timestamped line for each resource update received from the progressChan channel.
+func (listener *PlainListener) Run() {
+	for update := range listener.progressChan {
+		if !output.ShowResource(update.Resource) {
+			continue
+		}
+
+		line := fmt.Sprintf("%s %-10s %s", listener.now().UTC().Format(time.RFC3339), update.Status, output.FormatResourceForDisplay(update.Resource))
+		if stage := formatStage(update); stage != "" {
+			line += " " + stage
+			if update.Message != "" {
+				line += ": " + update.Message
+			}
+		}
+
+		fmt.Fprintln(listener.writer, line)
+	}
+}
+
+// formatStage returns the stage and percent complete of a resource update for display, or an empty string if the
+// resource provider did not report a stage.
+func formatStage(update clients.ResourceProgress) string {
+	if update.Stage == "" {
+		return ""
+	}
+
+	if update.PercentComplete == nil {
+		return update.Stage
+	}
+
+	return fmt.Sprintf("%s (%d%%)", update.Stage, *update.PercentComplete)
+}
+
+// ProgressEvent is the JSON representation of a progress event written by the JSONListener.
+type ProgressEvent struct {
+	// Timestamp is the time the progress event was received.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Status is the status of the resource, eg: Started, Completed or Failed.
+	Status clients.ResourceStatus `json:"status"`
+
+	// ResourceID is the resource ID of the resource.
+	ResourceID string `json:"resourceId"`
+
+	// ResourceType is the type of the resource.
+	ResourceType string `json:"resourceType"`
+
+	// ResourceName is the name of the resource.
+	ResourceName string `json:"resourceName"`
+
+	// Stage is the current stage of the operation on the resource reported by the resource provider, if any.
+	Stage string `json:"stage,omitempty"`
+
+	// PercentComplete is the percent complete of the operation on the resource reported by the resource provider, if any.
+	PercentComplete *int `json:"percentComplete,omitempty"`
+
+	// Message is a human readable description of the current stage, if any.
+	Message string `json:"message,omitempty"`
+}
+
+// JSONListener writes a JSON object on a single line for each progress event.
+type JSONListener struct {
+	progressChan <-chan clients.ResourceProgress
+	writer       io.Writer
+	now          func() time.Time
+}
+
+// Run writes a ProgressEvent as a line of JSON for each resource update received from the progressChan channel.
+func (listener *JSONListener) Run() {
+	encoder := json.NewEncoder(listener.writer)
+	for update := range listener.progressChan {
+		if !output.ShowResource(update.Resource) {
+			continue
+		}
+
+		// The progress is informational, a failure to write an event should not interrupt the deployment.
+		_ = encoder.Encode(ProgressEvent{
+			Timestamp:    listener.now().UTC(),
+			Status:       update.Status,
+			ResourceID:   update.Resource.String(),
+			ResourceType: output.FormatResourceTypeForDisplay(update.Resource),
+			ResourceName: output.FormatResourceNameForDisplay(update.Resource),
+
+			Stage:           update.Stage,
+			PercentComplete: update.PercentComplete,
+			Message:         update.Message,
+		})
 	}
 }
 
@@ -161,6 +302,15 @@ func (listener *InteractiveListener) Run() {
 
 		case clients.StatusCompleted:
 			listener.updateEntry(line, output.ProgressCompleted, output.FormatResourceForProgressDisplay(update.Resource))
+
+		case clients.StatusStarted:
+			// Show the stage reported by the resource provider next to the spinner. The stage is escaped because
+			// the entry is used as a format string.
+			format := output.FormatResourceForProgressDisplay(update.Resource)
+			if stage := formatStage(update); stage != "" {
+				format += " " + strings.ReplaceAll(stage, "%", "%%")
+			}
+			listener.updateEntry(line, "", format)
 		}
 	}
 
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseProgressMode(t *testing.T) {
+	mode, err := ParseProgressMode("")
+	require.NoError(t, err)
+	require.Equal(t, ProgressModeAuto, mode)
+
+	mode, err = ParseProgressMode("JSON")
+	require.NoError(t, err)
+	require.Equal(t, ProgressModeJSON, mode)
+
+	mode, err = ParseProgressMode("plain")
+	require.NoError(t, err)
+	require.Equal(t, ProgressModePlain, mode)
+
+	_, err = ParseProgressMode("fancy")
+	require.EqualError(t, err, `unsupported progress mode "fancy", supported modes are: auto, plain, json`)
+}
+
+func Test_NewProgressListener(t *testing.T) {
+	progressChan := make(chan clients.ResourceProgress)
+
+	require.IsType(t, &PlainListener{}, NewProgressListener(progressChan, ProgressModePlain))
+	require.IsType(t, &JSONListener{}, NewProgressListener(progressChan, ProgressModeJSON))
+
+	// Tests don't run with a terminal attached.
+	require.IsType(t, &PlainListener{}, NewProgressListener(progressChan, ProgressModeAuto))
+}
+
+func sendProgress() chan clients.ResourceProgress {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend")
+
+	progressChan := make(chan clients.ResourceProgress, 3)
+	progressChan <- clients.ResourceProgress{Resource: id, Status: clients.StatusStarted}
+	progressChan <- clients.ResourceProgress{Resource: id, Status: clients.StatusStarted, Stage: "Deploying", PercentComplete: to.Ptr(30), Message: "Deploying 2 output resources"}
+	progressChan <- clients.ResourceProgress{Resource: id, Status: clients.StatusCompleted}
+	close(progressChan)
+
+	return progressChan
+}
+
+func fixedTime() time.Time {
+	return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+}
+
+func Test_PlainListener(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	listener := &PlainListener{progressChan: sendProgress(), writer: buffer, now: fixedTime}
+	listener.Run()
+
+	expected := "2024-01-02T03:04:05Z Started    frontend        Applications.Core/containers\n" +
+		"2024-01-02T03:04:05Z Started    frontend        Applications.Core/containers Deploying (30%): Deploying 2 output resources\n" +
+		"2024-01-02T03:04:05Z Completed  frontend        Applications.Core/containers\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_JSONListener(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	listener := &JSONListener{progressChan: sendProgress(), writer: buffer, now: fixedTime}
+	listener.Run()
+
+	expected := `{"timestamp":"2024-01-02T03:04:05Z","status":"Started","resourceId":"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend","resourceType":"Applications.Core/containers","resourceName":"frontend"}` + "\n" +
+		`{"timestamp":"2024-01-02T03:04:05Z","status":"Started","resourceId":"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend","resourceType":"Applications.Core/containers","resourceName":"frontend","stage":"Deploying","percentComplete":30,"message":"Deploying 2 output resources"}` + "\n" +
+		`{"timestamp":"2024-01-02T03:04:05Z","status":"Completed","resourceId":"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend","resourceType":"Applications.Core/containers","resourceName":"frontend"}` + "\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_formatStage(t *testing.T) {
+	require.Equal(t, "", formatStage(clients.ResourceProgress{Status: clients.StatusStarted}))
+	require.Equal(t, "Rendering", formatStage(clients.ResourceProgress{Stage: "Rendering"}))
+	require.Equal(t, "Deploying (30%)", formatStage(clients.ResourceProgress{Stage: "Deploying", PercentComplete: to.Ptr(30)}))
+}
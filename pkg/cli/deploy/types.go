@@ -29,9 +29,13 @@ type Interface interface {
 	// DeployWithProgress runs a deployment and displays progress to the user. This is intended to be used
 	// from the CLI and thus logs to the console.
 	DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error)
+
+	// WhatIfWithProgress previews the changes the deployment of a template would make and displays them to the user,
+	// without deploying the template. This is intended to be used from the CLI and thus logs to the console.
+	WhatIfWithProgress(ctx context.Context, options Options) (clients.WhatIfResult, error)
 }
 
-// Options contains options to be used with DeployWithProgress.
+// Options contains options to be used with DeployWithProgress and WhatIfWithProgress.
 type Options struct {
 	// ConnectionFactory is used to create the deployment client.
 	ConnectionFactory connections.Factory
@@ -53,6 +57,9 @@ type Options struct {
 
 	// CompleteText is a message displayed on the console when deployment completes.
 	CompletionText string
+
+	// Progress controls how the progress of the deployment is displayed. Defaults to ProgressModeAuto.
+	Progress ProgressMode
 }
 
 var _ Interface = (*Impl)(nil)
@@ -67,3 +74,9 @@ type Impl struct {
 func (*Impl) DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error) {
 	return DeployWithProgress(ctx, options)
 }
+
+// WhatIfWithProgress previews the changes the deployment of a template would make and displays them to the user,
+// without deploying the template. This is intended to be used from the CLI and thus logs to the console.
+func (*Impl) WhatIfWithProgress(ctx context.Context, options Options) (clients.WhatIfResult, error) {
+	return WhatIfWithProgress(ctx, options)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/output"
+)
+
+// whatIfSymbols are the symbols displayed in front of the resources for each kind of change, in the same format as
+// the what-if operation of Azure deployments.
+var whatIfSymbols = map[clients.WhatIfChangeType]string{
+	clients.WhatIfChangeCreate:      "+",
+	clients.WhatIfChangeModify:      "~",
+	clients.WhatIfChangeDelete:      "-",
+	clients.WhatIfChangeDeploy:      "!",
+	clients.WhatIfChangeNoChange:    "=",
+	clients.WhatIfChangeIgnore:      "*",
+	clients.WhatIfChangeUnsupported: "x",
+}
+
+// whatIfColors are the colors of the resources for each kind of change. Resources are displayed without color when
+// the output is not a terminal.
+var whatIfColors = map[clients.WhatIfChangeType]*color.Color{
+	clients.WhatIfChangeCreate:      color.New(color.FgGreen),
+	clients.WhatIfChangeModify:      color.New(color.FgMagenta),
+	clients.WhatIfChangeDelete:      color.New(color.FgRed),
+	clients.WhatIfChangeDeploy:      color.New(color.FgBlue),
+	clients.WhatIfChangeNoChange:    color.New(color.Reset),
+	clients.WhatIfChangeIgnore:      color.New(color.Faint),
+	clients.WhatIfChangeUnsupported: color.New(color.Faint),
+}
+
+// WhatIfWithProgress previews the changes the deployment of a template would make and displays them to the user,
+// without deploying the template. This is intended to be used from the CLI and thus logs to the console.
+func WhatIfWithProgress(ctx context.Context, options Options) (clients.WhatIfResult, error) {
+	deploymentClient, err := options.ConnectionFactory.CreateDeploymentClient(ctx, options.Workspace)
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	step := output.BeginStep("%s", options.ProgressText)
+	output.LogInfo("")
+
+	result, err := deploymentClient.WhatIf(ctx, clients.DeploymentOptions{
+		Template:   options.Template,
+		Parameters: options.Parameters,
+		Providers:  options.Providers,
+	})
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	output.CompleteStep(step)
+
+	// The changes were computed, a failure to display them is not a failure of the command.
+	_ = WriteWhatIfResult(os.Stdout, result)
+	output.LogInfo("")
+	output.LogInfo("%s", options.CompletionText)
+
+	return result, nil
+}
+
+// WriteWhatIfResult writes the changes of a what-if operation, grouped by kind of change and sorted by resource, and a
+// summary of the number of changes of each kind.
+func WriteWhatIfResult(w io.Writer, result clients.WhatIfResult) error {
+	changes := []clients.WhatIfChange{}
+	for _, change := range result.Changes {
+		if change.ChangeType == clients.WhatIfChangeIgnore || !output.ShowResource(change.Resource) {
+			continue
+		}
+
+		// Changes of a kind unknown to this version of the CLI are displayed as unsupported.
+		if _, ok := whatIfSymbols[change.ChangeType]; !ok {
+			change.ChangeType = clients.WhatIfChangeUnsupported
+		}
+		changes = append(changes, change)
+	}
+
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(w, "The deployment would not change any resources.")
+		return err
+	}
+
+	order := whatIfChangeOrder()
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].ChangeType != changes[j].ChangeType {
+			return order[changes[i].ChangeType] < order[changes[j].ChangeType]
+		}
+		return strings.ToLower(changes[i].Resource.String()) < strings.ToLower(changes[j].Resource.String())
+	})
+
+	_, err := fmt.Fprintln(w, "Resource changes:")
+	if err != nil {
+		return err
+	}
+
+	counts := map[clients.WhatIfChangeType]int{}
+	for _, change := range changes {
+		counts[change.ChangeType]++
+
+		c := whatIfColors[change.ChangeType]
+		symbol := whatIfSymbols[change.ChangeType]
+		_, err = c.Fprintf(w, "  %s %s\n", symbol, output.FormatResourceForDisplay(change.Resource))
+		if err != nil {
+			return err
+		}
+
+		for _, property := range change.Properties {
+			_, err = c.Fprintf(w, "      %s %s\n", symbol, property)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	summary := []string{}
+	for _, item := range []struct {
+		changeType  clients.WhatIfChangeType
+		description string
+	}{
+		{clients.WhatIfChangeCreate, "to create"},
+		{clients.WhatIfChangeModify, "to modify"},
+		{clients.WhatIfChangeDelete, "to delete"},
+		{clients.WhatIfChangeDeploy, "to deploy"},
+		{clients.WhatIfChangeNoChange, "no change"},
+		{clients.WhatIfChangeUnsupported, "unsupported"},
+	} {
+		if counts[item.changeType] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[item.changeType], item.description))
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "\nResource changes: %s.\n", strings.Join(summary, ", "))
+	return err
+}
+
+// whatIfChangeOrder returns the order in which the kinds of changes are displayed.
+func whatIfChangeOrder() map[clients.WhatIfChangeType]int {
+	return map[clients.WhatIfChangeType]int{
+		clients.WhatIfChangeDelete:      1,
+		clients.WhatIfChangeCreate:      2,
+		clients.WhatIfChangeModify:      3,
+		clients.WhatIfChangeDeploy:      4,
+		clients.WhatIfChangeNoChange:    5,
+		clients.WhatIfChangeUnsupported: 6,
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/output"
+	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WriteWhatIfResult(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	t.Cleanup(func() { color.NoColor = noColor })
+
+	frontend := ucpresources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend")
+	backend := ucpresources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/backend")
+	cache := ucpresources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/cache")
+	gateway := ucpresources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway")
+	app := ucpresources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app")
+
+	t.Run("changes", func(t *testing.T) {
+		result := clients.WhatIfResult{
+			Changes: []clients.WhatIfChange{
+				{Resource: frontend, ChangeType: clients.WhatIfChangeModify, Properties: []string{"properties.container.image"}},
+				{Resource: app, ChangeType: clients.WhatIfChangeNoChange},
+				{Resource: gateway, ChangeType: clients.WhatIfChangeIgnore},
+				{Resource: cache, ChangeType: clients.WhatIfChangeCreate},
+				{Resource: backend, ChangeType: clients.WhatIfChangeCreate},
+				{Resource: gateway, ChangeType: "Unknown"},
+			},
+		}
+
+		buf := &bytes.Buffer{}
+		err := WriteWhatIfResult(buf, result)
+		require.NoError(t, err)
+
+		expected := "Resource changes:\n" +
+			"  + " + output.FormatResourceForDisplay(backend) + "\n" +
+			"  + " + output.FormatResourceForDisplay(cache) + "\n" +
+			"  ~ " + output.FormatResourceForDisplay(frontend) + "\n" +
+			"      ~ properties.container.image\n" +
+			"  = " + output.FormatResourceForDisplay(app) + "\n" +
+			"  x " + output.FormatResourceForDisplay(gateway) + "\n" +
+			"\n" +
+			"Resource changes: 2 to create, 1 to modify, 1 no change, 1 unsupported.\n"
+		require.Equal(t, expected, buf.String())
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := WriteWhatIfResult(buf, clients.WhatIfResult{Changes: []clients.WhatIfChange{{Resource: gateway, ChangeType: clients.WhatIfChangeIgnore}}})
+		require.NoError(t, err)
+		require.Equal(t, "The deployment would not change any resources.\n", buf.String())
+	})
+}
@@ -29,7 +29,9 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/to"
 	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
 
 const (
@@ -46,11 +48,21 @@ const (
 	deploymentPollInterval = time.Second * 5
 )
 
+// OperationStatusesClient lists the async operation statuses of a resource, the most recent operation first.
+type OperationStatusesClient interface {
+	List(ctx context.Context, resourceID ucpresources.ID, apiVersion string) ([]v1.AsyncOperationStatus, error)
+}
+
 type ResourceDeploymentClient struct {
 	RadiusResourceGroup string
 	Client              sdkclients.ResourceDeploymentsClient
 	OperationsClient    *sdkclients.ResourceDeploymentOperationsClient
-	Tags                map[string]*string
+
+	// OperationStatusesClient is used to report the progress of the operations on Radius resources. Progress
+	// is only reported at the resource level when it is nil.
+	OperationStatusesClient OperationStatusesClient
+
+	Tags map[string]*string
 }
 
 var _ clients.DeploymentClient = (*ResourceDeploymentClient)(nil)
@@ -87,14 +99,93 @@ func (dc *ResourceDeploymentClient) Deploy(ctx context.Context, options clients.
 
 	summary, err := dc.waitForCompletion(ctx, poller)
 	if err != nil {
-		return clients.DeploymentResult{}, err
+		// Report the status of each resource so the caller can show which resources failed.
+		return clients.DeploymentResult{Operations: dc.listDeploymentOperations(ctx, name)}, err
 	}
 
+	summary.Operations = dc.listDeploymentOperations(ctx, name)
 	return summary, nil
 }
 
+// WhatIf previews the changes the deployment of the template would make to resources, without deploying it. Changes
+// to resources which cannot be identified are not reported.
+func (dc *ResourceDeploymentClient) WhatIf(ctx context.Context, options clients.DeploymentOptions) (clients.WhatIfResult, error) {
+	name := fmt.Sprintf("rad-whatif-%v", uuid.New().String())
+	poller, err := dc.Client.WhatIf(ctx, dc.newDeployment(options), dc.deploymentResourceID(name), sdkclients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &sdkclients.PollUntilDoneOptions{Frequency: deploymentPollInterval})
+	if err != nil {
+		return clients.WhatIfResult{}, err
+	}
+
+	results := clients.WhatIfResult{Changes: []clients.WhatIfChange{}}
+	if resp.Properties == nil {
+		return results, nil
+	}
+
+	for _, change := range resp.Properties.Changes {
+		if result, ok := newWhatIfChange(change); ok {
+			results.Changes = append(results.Changes, result)
+		}
+	}
+
+	return results, nil
+}
+
+// newWhatIfChange converts an ARM what-if change to a WhatIfChange. It returns false if the change does not identify
+// a resource.
+func newWhatIfChange(change *armresources.WhatIfChange) (clients.WhatIfChange, bool) {
+	if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+		return clients.WhatIfChange{}, false
+	}
+
+	// We might see scopes here as well as resources, so using the general Parse function.
+	id, err := ucpresources.Parse(*change.ResourceID)
+	if err != nil {
+		return clients.WhatIfChange{}, false
+	}
+
+	result := clients.WhatIfChange{
+		Resource:   id,
+		ChangeType: clients.WhatIfChangeType(*change.ChangeType),
+	}
+
+	// Changes to the items of arrays are reported as a change to the array.
+	for _, property := range change.Delta {
+		if property != nil && to.String(property.Path) != "" {
+			result.Properties = append(result.Properties, *property.Path)
+		}
+	}
+
+	return result, true
+}
+
 func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name string, options clients.DeploymentOptions) (sdkclients.Poller[sdkclients.ClientCreateOrUpdateResponse], error) {
-	var resourceId string
+	poller, err := dc.Client.CreateOrUpdate(ctx, dc.newDeployment(options), dc.deploymentResourceID(name), sdkclients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return poller, nil
+}
+
+// newDeployment creates the incremental deployment of the template and parameters of the options.
+func (dc *ResourceDeploymentClient) newDeployment(options clients.DeploymentOptions) sdkclients.Deployment {
+	return sdkclients.Deployment{
+		Properties: &sdkclients.DeploymentProperties{
+			Template:       options.Template,
+			Parameters:     options.Parameters,
+			ProviderConfig: dc.GetProviderConfigs(options),
+			Mode:           armresources.DeploymentModeIncremental,
+		},
+	}
+}
+
+// deploymentResourceID returns the resource ID of the deployment with the given name in the Radius resource group.
+func (dc *ResourceDeploymentClient) deploymentResourceID(name string) string {
 	scopes := []ucpresources.ScopeSegment{
 		{
 			Type: "radius",
@@ -112,25 +203,7 @@ func (dc *ResourceDeploymentClient) startDeployment(ctx context.Context, name st
 		},
 	}
 
-	resourceId = ucpresources.MakeUCPID(scopes, types, nil)
-	providerConfig := dc.GetProviderConfigs(options)
-
-	poller, err := dc.Client.CreateOrUpdate(ctx,
-		sdkclients.Deployment{
-			Properties: &sdkclients.DeploymentProperties{
-				Template:       options.Template,
-				Parameters:     options.Parameters,
-				ProviderConfig: providerConfig,
-				Mode:           armresources.DeploymentModeIncremental,
-			},
-		},
-		resourceId,
-		sdkclients.DeploymentsClientAPIVersion)
-	if err != nil {
-		return nil, err
-	}
-
-	return poller, nil
+	return ucpresources.MakeUCPID(scopes, types, nil)
 }
 
 // GetProviderConfigs() creates a default provider config and then updates it with any provider scopes passed in the DeploymentOptions.
@@ -221,8 +294,8 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 	// Also nothing listens to errors if we report them here. It's just a convenient way to degrade gracefully
 	// in the event of an issue.
 
-	// We need to track the status so we can report the deltas
-	status := map[string]clients.ResourceStatus{}
+	// We need to track the progress so we can report the deltas
+	reported := map[string]clients.ResourceProgress{}
 
 	// Now loop forever for updates. We're relying on cancellation of the context to terminate.
 	for ctx.Err() == nil {
@@ -259,21 +332,24 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 				}()
 			}
 
-			current := status[id.String()]
-
-			next := clients.StatusStarted
+			next := clients.ResourceProgress{
+				Resource: id,
+				Status:   clients.StatusStarted,
+			}
 			if v1.ProvisioningStateSucceeded == provisioningState {
-				next = clients.StatusCompleted
+				next.Status = clients.StatusCompleted
 			} else if provisioningState.IsTerminal() {
-				next = clients.StatusFailed
+				next.Status = clients.StatusFailed
+			} else if progress := dc.getOperationProgress(ctx, id); progress != nil {
+				next.Stage = progress.Stage
+				next.PercentComplete = progress.PercentComplete
+				next.Message = progress.Message
 			}
 
-			if current != next && progressChan != nil {
-				status[id.String()] = next
-				progressChan <- clients.ResourceProgress{
-					Resource: id,
-					Status:   next,
-				}
+			current, found := reported[id.String()]
+			if (!found || progressChanged(current, next)) && progressChan != nil {
+				reported[id.String()] = next
+				progressChan <- next
 			}
 		}
 	}
@@ -281,6 +357,28 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 	return nil
 }
 
+// getOperationProgress returns the progress of the running operation on a Radius resource as reported by its resource
+// provider, or nil if there is none. Errors are ignored because the progress is informational.
+func (dc *ResourceDeploymentClient) getOperationProgress(ctx context.Context, id ucpresources.ID) *v1.OperationProgress {
+	if dc.OperationStatusesClient == nil || !id.IsResource() || id.FindScope(resources_radius.PlaneTypeRadius) == "" {
+		return nil
+	}
+
+	statuses, err := dc.OperationStatusesClient.List(ctx, id, sdkclients.OperationStatusesClientAPIVersion)
+	if err != nil || len(statuses) == 0 || statuses[0].Status.IsTerminal() {
+		return nil
+	}
+
+	return statuses[0].Progress
+}
+
+// progressChanged returns true if the status, stage or percent complete of a resource has changed.
+func progressChanged(current clients.ResourceProgress, next clients.ResourceProgress) bool {
+	return current.Status != next.Status ||
+		current.Stage != next.Stage ||
+		to.Int(current.PercentComplete) != to.Int(next.PercentComplete)
+}
+
 func (dc *ResourceDeploymentClient) listOperations(ctx context.Context, name string) ([]*armresources.DeploymentOperation, error) {
 	var resourceId string
 
@@ -305,3 +403,62 @@ func (dc *ResourceDeploymentClient) listOperations(ctx context.Context, name str
 
 	return ops.Value, nil
 }
+
+// listDeploymentOperations returns the status of each resource in the deployment, replacing nested modules with the
+// resources they contain. Errors are ignored because the status of the resources is informational.
+func (dc *ResourceDeploymentClient) listDeploymentOperations(ctx context.Context, name string) []clients.DeploymentOperation {
+	operations, err := dc.listOperations(ctx, name)
+	if err != nil {
+		return nil
+	}
+
+	results := []clients.DeploymentOperation{}
+	for _, operation := range operations {
+		result, ok := newDeploymentOperation(operation)
+		if !ok {
+			continue
+		}
+
+		if strings.EqualFold(result.Resource.Type(), NestedModuleType) {
+			nested := dc.listDeploymentOperations(ctx, result.Resource.Name())
+			if len(nested) > 0 {
+				results = append(results, nested...)
+				continue
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// newDeploymentOperation converts an ARM deployment operation to a DeploymentOperation. It returns false if the
+// operation does not target a resource.
+func newDeploymentOperation(operation *armresources.DeploymentOperation) (clients.DeploymentOperation, bool) {
+	if operation == nil || operation.Properties == nil || operation.Properties.TargetResource == nil || operation.Properties.TargetResource.ID == nil {
+		return clients.DeploymentOperation{}, false
+	}
+
+	// We might see scopes here as well as resources, so using the general Parse function.
+	id, err := ucpresources.Parse(*operation.Properties.TargetResource.ID)
+	if err != nil {
+		return clients.DeploymentOperation{}, false
+	}
+
+	result := clients.DeploymentOperation{
+		Resource:          id,
+		ProvisioningState: to.String(operation.Properties.ProvisioningState),
+		Timestamp:         operation.Properties.Timestamp,
+		Duration:          to.String(operation.Properties.Duration),
+	}
+
+	if message := operation.Properties.StatusMessage; message != nil && message.Error != nil {
+		result.Error = to.String(message.Error.Message)
+		if result.Error == "" {
+			result.Error = to.String(message.Error.Code)
+		}
+	}
+
+	return result, true
+}
@@ -17,10 +17,17 @@ limitations under the License.
 package deployment
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/to"
+	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
 )
 
@@ -89,3 +96,166 @@ func Test_GetProviderConfigsWithAzProvider(t *testing.T) {
 	providerConfig := resourceDeploymentClient.GetProviderConfigs(options)
 	require.Equal(t, providerConfig, expectedConfig)
 }
+
+func Test_newDeploymentOperation(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/containers/frontend"
+	timestamp := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Succeeded", func(t *testing.T) {
+		operation := &armresources.DeploymentOperation{
+			Properties: &armresources.DeploymentOperationProperties{
+				ProvisioningState: to.Ptr("Succeeded"),
+				TargetResource:    &armresources.TargetResource{ID: to.Ptr(resourceID)},
+				Timestamp:         &timestamp,
+				Duration:          to.Ptr("PT5S"),
+			},
+		}
+
+		result, ok := newDeploymentOperation(operation)
+		require.True(t, ok)
+		require.Equal(t, clients.DeploymentOperation{
+			Resource:          ucpresources.MustParse(resourceID),
+			ProvisioningState: "Succeeded",
+			Timestamp:         &timestamp,
+			Duration:          "PT5S",
+		}, result)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		operation := &armresources.DeploymentOperation{
+			Properties: &armresources.DeploymentOperationProperties{
+				ProvisioningState: to.Ptr("Failed"),
+				TargetResource:    &armresources.TargetResource{ID: to.Ptr(resourceID)},
+				StatusMessage: &armresources.StatusMessage{
+					Error: &armresources.ErrorResponse{
+						Code:    to.Ptr("BadRequest"),
+						Message: to.Ptr("the image is invalid"),
+					},
+				},
+			},
+		}
+
+		result, ok := newDeploymentOperation(operation)
+		require.True(t, ok)
+		require.Equal(t, "Failed", result.ProvisioningState)
+		require.Equal(t, "the image is invalid", result.Error)
+	})
+
+	t.Run("No target resource", func(t *testing.T) {
+		operation := &armresources.DeploymentOperation{
+			Properties: &armresources.DeploymentOperationProperties{
+				ProvisioningState: to.Ptr("Succeeded"),
+			},
+		}
+
+		_, ok := newDeploymentOperation(operation)
+		require.False(t, ok)
+	})
+}
+
+func Test_newWhatIfChange(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/containers/frontend"
+
+	t.Run("Modify", func(t *testing.T) {
+		change := &armresources.WhatIfChange{
+			ResourceID: to.Ptr(resourceID),
+			ChangeType: to.Ptr(armresources.ChangeTypeModify),
+			Delta: []*armresources.WhatIfPropertyChange{
+				{Path: to.Ptr("properties.container.image"), PropertyChangeType: to.Ptr(armresources.PropertyChangeTypeModify)},
+				{Path: to.Ptr("properties.container.ports"), PropertyChangeType: to.Ptr(armresources.PropertyChangeTypeArray)},
+			},
+		}
+
+		result, ok := newWhatIfChange(change)
+		require.True(t, ok)
+		require.Equal(t, clients.WhatIfChange{
+			Resource:   ucpresources.MustParse(resourceID),
+			ChangeType: clients.WhatIfChangeModify,
+			Properties: []string{"properties.container.image", "properties.container.ports"},
+		}, result)
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		change := &armresources.WhatIfChange{
+			ResourceID: to.Ptr(resourceID),
+			ChangeType: to.Ptr(armresources.ChangeTypeCreate),
+		}
+
+		result, ok := newWhatIfChange(change)
+		require.True(t, ok)
+		require.Equal(t, clients.WhatIfChangeCreate, result.ChangeType)
+		require.Empty(t, result.Properties)
+	})
+
+	t.Run("Invalid resource ID", func(t *testing.T) {
+		change := &armresources.WhatIfChange{
+			ResourceID: to.Ptr("not-a-resource-id"),
+			ChangeType: to.Ptr(armresources.ChangeTypeCreate),
+		}
+
+		_, ok := newWhatIfChange(change)
+		require.False(t, ok)
+	})
+
+	t.Run("No change type", func(t *testing.T) {
+		_, ok := newWhatIfChange(&armresources.WhatIfChange{ResourceID: to.Ptr(resourceID)})
+		require.False(t, ok)
+	})
+}
+
+type fakeOperationStatusesClient struct {
+	statuses []v1.AsyncOperationStatus
+	err      error
+}
+
+func (c *fakeOperationStatusesClient) List(ctx context.Context, resourceID ucpresources.ID, apiVersion string) ([]v1.AsyncOperationStatus, error) {
+	return c.statuses, c.err
+}
+
+func Test_getOperationProgress(t *testing.T) {
+	ctx := context.Background()
+	id := ucpresources.MustParse("/planes/radius/local/resourceGroups/testrg/providers/Applications.Core/containers/frontend")
+	progress := &v1.OperationProgress{Stage: "Deploying", PercentComplete: to.Ptr(30)}
+
+	t.Run("running operation", func(t *testing.T) {
+		dc := ResourceDeploymentClient{OperationStatusesClient: &fakeOperationStatusesClient{
+			statuses: []v1.AsyncOperationStatus{{Status: v1.ProvisioningStateUpdating, Progress: progress}},
+		}}
+		require.Equal(t, progress, dc.getOperationProgress(ctx, id))
+	})
+
+	t.Run("completed operation", func(t *testing.T) {
+		dc := ResourceDeploymentClient{OperationStatusesClient: &fakeOperationStatusesClient{
+			statuses: []v1.AsyncOperationStatus{{Status: v1.ProvisioningStateSucceeded, Progress: progress}},
+		}}
+		require.Nil(t, dc.getOperationProgress(ctx, id))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		dc := ResourceDeploymentClient{OperationStatusesClient: &fakeOperationStatusesClient{err: errors.New("failed")}}
+		require.Nil(t, dc.getOperationProgress(ctx, id))
+	})
+
+	t.Run("non-radius resource", func(t *testing.T) {
+		dc := ResourceDeploymentClient{OperationStatusesClient: &fakeOperationStatusesClient{
+			statuses: []v1.AsyncOperationStatus{{Status: v1.ProvisioningStateUpdating, Progress: progress}},
+		}}
+		azureID := ucpresources.MustParse("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/account")
+		require.Nil(t, dc.getOperationProgress(ctx, azureID))
+	})
+
+	t.Run("no client", func(t *testing.T) {
+		dc := ResourceDeploymentClient{}
+		require.Nil(t, dc.getOperationProgress(ctx, id))
+	})
+}
+
+func Test_progressChanged(t *testing.T) {
+	started := clients.ResourceProgress{Status: clients.StatusStarted}
+	require.False(t, progressChanged(started, started))
+	require.True(t, progressChanged(started, clients.ResourceProgress{Status: clients.StatusCompleted}))
+	require.True(t, progressChanged(started, clients.ResourceProgress{Status: clients.StatusStarted, Stage: "Rendering"}))
+	require.True(t, progressChanged(
+		clients.ResourceProgress{Status: clients.StatusStarted, Stage: "Deploying", PercentComplete: to.Ptr(30)},
+		clients.ResourceProgress{Status: clients.StatusStarted, Stage: "Deploying", PercentComplete: to.Ptr(40)}))
+}
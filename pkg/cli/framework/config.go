@@ -60,6 +60,10 @@ type ConfigFileInterface interface {
 	SetDefaultWorkspace(ctx context.Context, config *viper.Viper, name string) error
 	DeleteWorkspace(ctx context.Context, config *viper.Viper, name string) error
 	EditWorkspaces(ctx context.Context, config *viper.Viper, workspace *workspaces.Workspace) error
+	PurgeWorkspace(ctx context.Context, config *viper.Viper, name string) (bool, error)
+	RepairWorkspaces(ctx context.Context, config *viper.Viper) ([]cli.WorkspaceEntryError, error)
+	SetTelemetryEnabled(ctx context.Context, config *viper.Viper, enabled bool) error
+	SetBicepSection(ctx context.Context, config *viper.Viper, section cli.BicepSection) error
 }
 
 var _ ConfigFileInterface = (*ConfigFileInterfaceImpl)(nil)
@@ -89,6 +93,66 @@ func (i *ConfigFileInterfaceImpl) DeleteWorkspace(ctx context.Context, config *v
 	})
 }
 
+// PurgeWorkspace removes the entry of the named workspace from the configuration file without validating the other
+// workspace entries, so that it can be used on a config file that contains invalid entries. It returns false if the
+// workspace was not found.
+func (i *ConfigFileInterfaceImpl) PurgeWorkspace(ctx context.Context, config *viper.Viper, name string) (bool, error) {
+	found := false
+	err := cli.SaveConfigOnLock(ctx, config, func(v *viper.Viper) error {
+		var err error
+		found, err = cli.PurgeWorkspace(v, name)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// RepairWorkspaces removes the invalid workspace entries from the configuration file and returns the problems that
+// were fixed. The configuration file is only written when problems are found.
+func (i *ConfigFileInterfaceImpl) RepairWorkspaces(ctx context.Context, config *viper.Viper) ([]cli.WorkspaceEntryError, error) {
+	if len(cli.FindWorkspaceProblems(config)) == 0 {
+		return nil, nil
+	}
+
+	var problems []cli.WorkspaceEntryError
+	err := cli.SaveConfigOnLock(ctx, config, func(v *viper.Viper) error {
+		problems = cli.RepairWorkspaceSection(v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return problems, nil
+}
+
+// SetTelemetryEnabled edits the configuration file to opt in to or out of anonymous usage reporting. It returns an
+// error if the configuration file could not be updated.
+func (i *ConfigFileInterfaceImpl) SetTelemetryEnabled(ctx context.Context, config *viper.Viper, enabled bool) error {
+	return cli.SaveConfigOnLock(ctx, config, func(v *viper.Viper) error {
+		section, err := cli.ReadTelemetrySection(v)
+		if err != nil {
+			return err
+		}
+
+		section.Enabled = enabled
+		cli.UpdateTelemetrySection(v, section)
+		return nil
+	})
+}
+
+// SetBicepSection edits the configuration file to pin or unpin the version of Bicep downloaded by the CLI. It returns an
+// error if the configuration file could not be updated.
+func (i *ConfigFileInterfaceImpl) SetBicepSection(ctx context.Context, config *viper.Viper, section cli.BicepSection) error {
+	return cli.SaveConfigOnLock(ctx, config, func(v *viper.Viper) error {
+		cli.UpdateBicepSection(v, section)
+		return nil
+	})
+}
+
 // Edits and updates the rad config file with the specified sections to edit
 //
 
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	cli "github.com/radius-project/radius/pkg/cli"
 	workspaces "github.com/radius-project/radius/pkg/cli/workspaces"
 	viper "github.com/spf13/viper"
 	gomock "go.uber.org/mock/gomock"
@@ -155,6 +156,122 @@ func (c *MockConfigFileInterfaceEditWorkspacesCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// PurgeWorkspace mocks base method.
+func (m *MockConfigFileInterface) PurgeWorkspace(arg0 context.Context, arg1 *viper.Viper, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeWorkspace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeWorkspace indicates an expected call of PurgeWorkspace.
+func (mr *MockConfigFileInterfaceMockRecorder) PurgeWorkspace(arg0, arg1, arg2 any) *MockConfigFileInterfacePurgeWorkspaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWorkspace", reflect.TypeOf((*MockConfigFileInterface)(nil).PurgeWorkspace), arg0, arg1, arg2)
+	return &MockConfigFileInterfacePurgeWorkspaceCall{Call: call}
+}
+
+// MockConfigFileInterfacePurgeWorkspaceCall wrap *gomock.Call
+type MockConfigFileInterfacePurgeWorkspaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigFileInterfacePurgeWorkspaceCall) Return(arg0 bool, arg1 error) *MockConfigFileInterfacePurgeWorkspaceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigFileInterfacePurgeWorkspaceCall) Do(f func(context.Context, *viper.Viper, string) (bool, error)) *MockConfigFileInterfacePurgeWorkspaceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigFileInterfacePurgeWorkspaceCall) DoAndReturn(f func(context.Context, *viper.Viper, string) (bool, error)) *MockConfigFileInterfacePurgeWorkspaceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RepairWorkspaces mocks base method.
+func (m *MockConfigFileInterface) RepairWorkspaces(arg0 context.Context, arg1 *viper.Viper) ([]cli.WorkspaceEntryError, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairWorkspaces", arg0, arg1)
+	ret0, _ := ret[0].([]cli.WorkspaceEntryError)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepairWorkspaces indicates an expected call of RepairWorkspaces.
+func (mr *MockConfigFileInterfaceMockRecorder) RepairWorkspaces(arg0, arg1 any) *MockConfigFileInterfaceRepairWorkspacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairWorkspaces", reflect.TypeOf((*MockConfigFileInterface)(nil).RepairWorkspaces), arg0, arg1)
+	return &MockConfigFileInterfaceRepairWorkspacesCall{Call: call}
+}
+
+// MockConfigFileInterfaceRepairWorkspacesCall wrap *gomock.Call
+type MockConfigFileInterfaceRepairWorkspacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigFileInterfaceRepairWorkspacesCall) Return(arg0 []cli.WorkspaceEntryError, arg1 error) *MockConfigFileInterfaceRepairWorkspacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigFileInterfaceRepairWorkspacesCall) Do(f func(context.Context, *viper.Viper) ([]cli.WorkspaceEntryError, error)) *MockConfigFileInterfaceRepairWorkspacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigFileInterfaceRepairWorkspacesCall) DoAndReturn(f func(context.Context, *viper.Viper) ([]cli.WorkspaceEntryError, error)) *MockConfigFileInterfaceRepairWorkspacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetBicepSection mocks base method.
+func (m *MockConfigFileInterface) SetBicepSection(arg0 context.Context, arg1 *viper.Viper, arg2 cli.BicepSection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBicepSection", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBicepSection indicates an expected call of SetBicepSection.
+func (mr *MockConfigFileInterfaceMockRecorder) SetBicepSection(arg0, arg1, arg2 any) *MockConfigFileInterfaceSetBicepSectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBicepSection", reflect.TypeOf((*MockConfigFileInterface)(nil).SetBicepSection), arg0, arg1, arg2)
+	return &MockConfigFileInterfaceSetBicepSectionCall{Call: call}
+}
+
+// MockConfigFileInterfaceSetBicepSectionCall wrap *gomock.Call
+type MockConfigFileInterfaceSetBicepSectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigFileInterfaceSetBicepSectionCall) Return(arg0 error) *MockConfigFileInterfaceSetBicepSectionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigFileInterfaceSetBicepSectionCall) Do(f func(context.Context, *viper.Viper, cli.BicepSection) error) *MockConfigFileInterfaceSetBicepSectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigFileInterfaceSetBicepSectionCall) DoAndReturn(f func(context.Context, *viper.Viper, cli.BicepSection) error) *MockConfigFileInterfaceSetBicepSectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetDefaultWorkspace mocks base method.
 func (m *MockConfigFileInterface) SetDefaultWorkspace(arg0 context.Context, arg1 *viper.Viper, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -192,3 +309,41 @@ func (c *MockConfigFileInterfaceSetDefaultWorkspaceCall) DoAndReturn(f func(cont
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// SetTelemetryEnabled mocks base method.
+func (m *MockConfigFileInterface) SetTelemetryEnabled(arg0 context.Context, arg1 *viper.Viper, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTelemetryEnabled", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTelemetryEnabled indicates an expected call of SetTelemetryEnabled.
+func (mr *MockConfigFileInterfaceMockRecorder) SetTelemetryEnabled(arg0, arg1, arg2 any) *MockConfigFileInterfaceSetTelemetryEnabledCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTelemetryEnabled", reflect.TypeOf((*MockConfigFileInterface)(nil).SetTelemetryEnabled), arg0, arg1, arg2)
+	return &MockConfigFileInterfaceSetTelemetryEnabledCall{Call: call}
+}
+
+// MockConfigFileInterfaceSetTelemetryEnabledCall wrap *gomock.Call
+type MockConfigFileInterfaceSetTelemetryEnabledCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockConfigFileInterfaceSetTelemetryEnabledCall) Return(arg0 error) *MockConfigFileInterfaceSetTelemetryEnabledCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockConfigFileInterfaceSetTelemetryEnabledCall) Do(f func(context.Context, *viper.Viper, bool) error) *MockConfigFileInterfaceSetTelemetryEnabledCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockConfigFileInterfaceSetTelemetryEnabledCall) DoAndReturn(f func(context.Context, *viper.Viper, bool) error) *MockConfigFileInterfaceSetTelemetryEnabledCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -33,6 +33,10 @@ import (
 const (
 	ContourChartDefaultVersion = "11.1.1"
 	DaprChartDefaultVersion    = "1.14.4"
+
+	// DevRecipesIndexValue is the Helm value of the Radius chart that points an installation at a custom dev recipe
+	// index. The value is read back by the CLI when registering dev recipes.
+	DevRecipesIndexValue = "global.devRecipes.index"
 )
 
 type CLIClusterOptions struct {
@@ -136,6 +140,33 @@ func Install(ctx context.Context, clusterOptions ClusterOptions, kubeContext str
 	}
 }
 
+// ValidateInstall validates the values set by the user for the Radius Helm chart against the values schema of the chart,
+// without installing the chart.
+func ValidateInstall(ctx context.Context, clusterOptions ClusterOptions, kubeContext string) error {
+	return ValidateHelmChartValues(clusterOptions.Radius, kubeContext)
+}
+
+// RenderInstall renders the manifests of the Helm charts that Install would apply to the cluster, without contacting
+// the cluster. The manifests of the Radius, Dapr and Contour charts are returned as a single multi-document YAML.
+func RenderInstall(ctx context.Context, clusterOptions ClusterOptions, kubeContext string) (string, error) {
+	radiusManifest, err := RenderHelmChart(clusterOptions.Radius, kubeContext)
+	if err != nil {
+		return "", err
+	}
+
+	daprManifest, err := RenderHelmChart(clusterOptions.Dapr, kubeContext)
+	if err != nil {
+		return "", err
+	}
+
+	contourManifest, err := RenderContourHelmChart(clusterOptions.Contour)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{radiusManifest, daprManifest, contourManifest}, "\n"), nil
+}
+
 // UninstallOnCluster retrieves the Helm configuration and runs the Contour and Radius Helm uninstall commands to remove
 // the Helm releases from the cluster.
 func UninstallOnCluster(kubeContext string, clusterOptions ClusterOptions) error {
@@ -181,9 +212,9 @@ func UninstallOnCluster(kubeContext string, clusterOptions ClusterOptions) error
 }
 
 // queryRelease checks to see if a release is deployed to a namespace for a given kubecontext.
-// If the release is found, it returns true and the version of the release. If the release is not found, it returns false.
-// If an error occurs, it returns an error.
-func queryRelease(kubeContext, namespace, releaseName string) (bool, string, error) {
+// If the release is found, it returns true, the version of the release and the values supplied to the release. If the
+// release is not found, it returns false. If an error occurs, it returns an error.
+func queryRelease(kubeContext, namespace, releaseName string) (bool, string, map[string]any, error) {
 	var helmOutput strings.Builder
 
 	flags := genericclioptions.ConfigFlags{
@@ -193,39 +224,62 @@ func queryRelease(kubeContext, namespace, releaseName string) (bool, string, err
 
 	helmConf, err := HelmConfig(&helmOutput, &flags)
 	if err != nil {
-		return false, "", fmt.Errorf("failed to get helm config, err: %w, helm output: %s", err, helmOutput.String())
+		return false, "", nil, fmt.Errorf("failed to get helm config, err: %w, helm output: %s", err, helmOutput.String())
 	}
 	histClient := helmaction.NewHistory(helmConf)
 	histClient.Max = 1 // Only need to check if at least 1 exists
 
 	releases, err := histClient.Run(releaseName)
 	if errors.Is(err, driver.ErrReleaseNotFound) {
-		return false, "", nil
+		return false, "", nil, nil
 	} else if err != nil {
-		return false, "", err
+		return false, "", nil, err
 	} else if len(releases) == 0 {
-		return false, "", nil
+		return false, "", nil, nil
 	}
 
-	return true, releases[0].Chart.Metadata.Version, nil
+	return true, releases[0].Chart.Metadata.Version, releases[0].Config, nil
 }
 
 // CheckRadiusInstall checks if the Radius release is installed in the given kubeContext and returns an InstallState object
 // with the version of the release if installed, or an error if an error occurs while checking.
 func CheckRadiusInstall(kubeContext string) (InstallState, error) {
 	// Check if Radius is installed
-	radiusInstalled, radiusVersion, err := queryRelease(kubeContext, RadiusSystemNamespace, radiusReleaseName)
+	radiusInstalled, radiusVersion, radiusValues, err := queryRelease(kubeContext, RadiusSystemNamespace, radiusReleaseName)
 	if err != nil {
 		return InstallState{}, err
 	}
 
 	// Check is Dapr is installed
-	daprInstalled, daprVersion, err := queryRelease(kubeContext, DaprSystemNamespace, daprReleaseName)
+	daprInstalled, daprVersion, _, err := queryRelease(kubeContext, DaprSystemNamespace, daprReleaseName)
 	if err != nil {
 		return InstallState{}, err
 	}
 
-	return InstallState{RadiusInstalled: radiusInstalled, RadiusVersion: radiusVersion, DaprInstalled: daprInstalled, DaprVersion: daprVersion}, nil
+	return InstallState{
+		RadiusInstalled: radiusInstalled,
+		RadiusVersion:   radiusVersion,
+		DevRecipesIndex: readStringValue(radiusValues, DevRecipesIndexValue),
+		DaprInstalled:   daprInstalled,
+		DaprVersion:     daprVersion,
+	}, nil
+}
+
+// readStringValue reads the string value at the given dotted path (e.g. "global.devRecipes.index") from Helm values.
+// It returns an empty string if the value is not set.
+func readStringValue(values map[string]any, path string) string {
+	var current any = values
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return ""
+		}
+
+		current = m[key]
+	}
+
+	value, _ := current.(string)
+	return value
 }
 
 // InstallState represents the state of the Radius helm chart installation on a Kubernetes cluster.
@@ -236,6 +290,10 @@ type InstallState struct {
 	// RadiusVersion is the version of the Radius helm chart installed on the cluster. Will be blank if Radius is not installed.
 	RadiusVersion string
 
+	// DevRecipesIndex is the custom dev recipe index configured for the installation. Will be blank if the installation
+	// uses the dev recipes built into the release.
+	DevRecipesIndex string
+
 	// DaprInstalled denotes whether the Dapr helm chart is installed on the cluster.
 	DaprInstalled bool
 
@@ -255,6 +313,12 @@ type Interface interface {
 
 	// UninstallRadius uninstalls Radius from the cluster based on the specified Kubernetes context. Will succeed regardless of whether Radius is installed.
 	UninstallRadius(ctx context.Context, clusterOptions ClusterOptions, kubeContext string) error
+
+	// ValidateRadiusValues validates the values set by the user for the Radius Helm chart against the values schema of the chart.
+	ValidateRadiusValues(ctx context.Context, clusterOptions ClusterOptions, kubeContext string) error
+
+	// RenderRadius renders the manifests that InstallRadius would apply to the cluster, without modifying the cluster.
+	RenderRadius(ctx context.Context, clusterOptions ClusterOptions, kubeContext string) (string, error)
 }
 
 type Impl struct {
@@ -274,3 +338,13 @@ func (i *Impl) InstallRadius(ctx context.Context, clusterOptions ClusterOptions,
 func (i *Impl) UninstallRadius(ctx context.Context, clusterOptions ClusterOptions, kubeContext string) error {
 	return UninstallOnCluster(kubeContext, clusterOptions)
 }
+
+// ValidateRadiusValues validates the values set by the user for the Radius Helm chart, and returns an error if they are invalid.
+func (i *Impl) ValidateRadiusValues(ctx context.Context, clusterOptions ClusterOptions, kubeContext string) error {
+	return ValidateInstall(ctx, clusterOptions, kubeContext)
+}
+
+// RenderRadius renders the manifests of the Helm charts installed by InstallRadius, and returns an error if it fails.
+func (i *Impl) RenderRadius(ctx context.Context, clusterOptions ClusterOptions, kubeContext string) (string, error) {
+	return RenderInstall(ctx, clusterOptions, kubeContext)
+}
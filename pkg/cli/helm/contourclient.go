@@ -89,6 +89,35 @@ func ApplyContourHelmChart(options ContourOptions, kubeContext string) error {
 	return err
 }
 
+// RenderContourHelmChart renders the manifests of the Contour Helm chart with the given options, without installing
+// the chart. It returns an error if the chart cannot be downloaded or rendered.
+func RenderContourHelmChart(options ContourOptions) (string, error) {
+	// For capturing output from helm.
+	var helmOutput strings.Builder
+
+	namespace := RadiusSystemNamespace
+	flags := genericclioptions.ConfigFlags{
+		Namespace: &namespace,
+	}
+
+	helmConf, err := HelmConfig(&helmOutput, &flags)
+	if err != nil {
+		return "", fmt.Errorf("failed to get helm config, err: %w, helm output: %s", err, helmOutput.String())
+	}
+
+	helmChart, err := helmChartFromContainerRegistry(options.ChartVersion, helmConf, contourHelmRepo, contourReleaseName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get contour chart, err: %w, helm output: %s", err, helmOutput.String())
+	}
+
+	err = AddContourValues(helmChart, options)
+	if err != nil {
+		return "", err
+	}
+
+	return renderHelmChart(helmChart, contourReleaseName, RadiusSystemNamespace)
+}
+
 // // AddContourValues adds values to the helm chart to enable host networking for the Envoy pod, and sets the default
 // LoadBalancer service ports to 8080 and 8443 so that they don't conflict with Envoy while using Host Networking. It
 // returns an error if any of the nodes in the chart values are not found.
@@ -25,10 +25,12 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/output"
 	helm "helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	"helm.sh/helm/v3/pkg/strvals"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -89,13 +91,7 @@ func ApplyHelmChart(options ChartOptions, kubeContext string) (bool, error) {
 		return false, fmt.Errorf("failed to get Helm config, err: %w, Helm output: %s", err, helmOutput.String())
 	}
 
-	var helmChart *chart.Chart
-	if options.ChartPath == "" {
-		helmChart, err = helmChartFromContainerRegistry(options.ChartVersion, helmConf, options.ChartRepo, options.ReleaseName)
-	} else {
-		helmChart, err = loader.Load(options.ChartPath)
-	}
-
+	helmChart, err := loadHelmChart(helmConf, options)
 	if err != nil {
 		return false, fmt.Errorf("failed to load Helm chart, err: %w, Helm output: %s", err, helmOutput.String())
 	}
@@ -105,6 +101,12 @@ func ApplyHelmChart(options ChartOptions, kubeContext string) (bool, error) {
 		return false, fmt.Errorf("failed to add Radius values, err: %w, Helm output: %s", err, helmOutput.String())
 	}
 
+	// Validate the values before installing so that invalid values fail fast instead of failing every install retry.
+	err = ValidateValues(helmChart)
+	if err != nil {
+		return false, err
+	}
+
 	// https://helm.sh/docs/chart_best_practices/custom_resource_definitions/#method-1-let-helm-do-it-for-you
 	// TODO: Apply CRDs because Helm doesn't upgrade CRDs for you.
 	// https://github.com/radius-project/radius/issues/712
@@ -134,6 +136,83 @@ func ApplyHelmChart(options ChartOptions, kubeContext string) (bool, error) {
 	return alreadyInstalled, err
 }
 
+// ValidateHelmChartValues loads the helm chart and validates the values set by the user against the values schema of
+// the chart, without installing the chart. It returns an error if the values are invalid.
+func ValidateHelmChartValues(options ChartOptions, kubeContext string) error {
+	_, err := loadHelmChartWithValues(options, kubeContext)
+	return err
+}
+
+// RenderHelmChart loads the helm chart and renders the manifests that would be applied to the cluster when installing
+// the chart with the values set by the user. The manifests are rendered on the client and the cluster is not modified.
+func RenderHelmChart(options ChartOptions, kubeContext string) (string, error) {
+	helmChart, err := loadHelmChartWithValues(options, kubeContext)
+	if err != nil {
+		return "", err
+	}
+
+	return renderHelmChart(helmChart, options.ReleaseName, options.Namespace)
+}
+
+// loadHelmChartWithValues loads the helm chart, adds the values set by the user and validates them.
+func loadHelmChartWithValues(options ChartOptions, kubeContext string) (*chart.Chart, error) {
+	// For capturing output from helm.
+	var helmOutput strings.Builder
+
+	flags := genericclioptions.ConfigFlags{
+		Namespace: &options.Namespace,
+		Context:   &kubeContext,
+	}
+
+	helmConf, err := HelmConfig(&helmOutput, &flags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Helm config, err: %w, Helm output: %s", err, helmOutput.String())
+	}
+
+	helmChart, err := loadHelmChart(helmConf, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Helm chart, err: %w, Helm output: %s", err, helmOutput.String())
+	}
+
+	err = AddValues(helmChart, &options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add Radius values, err: %w, Helm output: %s", err, helmOutput.String())
+	}
+
+	err = ValidateValues(helmChart)
+	if err != nil {
+		return nil, err
+	}
+
+	return helmChart, nil
+}
+
+// loadHelmChart loads the helm chart from the chart path if specified, otherwise it downloads the chart from the
+// chart repository.
+func loadHelmChart(helmConf *helm.Configuration, options ChartOptions) (*chart.Chart, error) {
+	if options.ChartPath != "" {
+		return loader.Load(options.ChartPath)
+	}
+
+	return helmChartFromContainerRegistry(options.ChartVersion, helmConf, options.ChartRepo, options.ReleaseName)
+}
+
+// ValidateValues validates the values of the helm chart, including the values added by AddValues, against the values
+// schema of the chart and its dependencies. Charts without a values schema are not validated.
+func ValidateValues(helmChart *chart.Chart) error {
+	values, err := chartutil.CoalesceValues(helmChart, helmChart.Values)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "The values of the %s Helm chart are invalid.", helmChart.Name())
+	}
+
+	err = chartutil.ValidateAgainstSchema(helmChart, values)
+	if err != nil {
+		return clierrors.Message("The values of the %s Helm chart do not match the values schema of the chart:\n%s", helmChart.Name(), strings.TrimSpace(err.Error()))
+	}
+
+	return nil
+}
+
 // AddValues parses the --set arguments in order and adds them to the helm chart values, returning an error if any of
 // the arguments are invalid.
 func AddValues(helmChart *chart.Chart, options *ChartOptions) error {
@@ -185,6 +264,27 @@ func runHelmUpgrade(helmConf *helm.Configuration, releaseName string, helmChart
 	return runUpgrade(installClient, options.ReleaseName, helmChart)
 }
 
+// renderHelmChart renders the manifests of the helm chart, including its CRDs, on the client, the same way as
+// `helm template` does.
+func renderHelmChart(helmChart *chart.Chart, releaseName string, namespace string) (string, error) {
+	// The client-only install replaces the Kubernetes client and the release storage of the configuration, so a new
+	// configuration is used.
+	installClient := helm.NewInstall(&helm.Configuration{Log: func(format string, v ...any) {}})
+	installClient.ReleaseName = releaseName
+	installClient.Namespace = namespace
+	installClient.DryRun = true
+	installClient.ClientOnly = true
+	installClient.Replace = true
+	installClient.IncludeCRDs = true
+
+	release, err := installClient.Run(helmChart, helmChart.Values)
+	if err != nil {
+		return "", fmt.Errorf("failed to render Helm chart %s, err: %w", helmChart.Name(), err)
+	}
+
+	return release.Manifest, nil
+}
+
 // RunRadiusHelmUninstall attempts to uninstall Radius from the Radius system namespace
 // using a helm configuration, and returns an error if the uninstall fails.
 func RunHelmUninstall(helmConf *helm.Configuration, options ChartOptions) error {
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
 )
 
 func Test_AddValues(t *testing.T) {
@@ -97,3 +98,65 @@ func Test_AddRadiusValuesOverrideWithSet(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, prometheus["path"], "path")
 }
+
+func Test_ValidateValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		setArgs []string
+		err     string
+	}{
+		{
+			name: "default values",
+		},
+		{
+			name:    "valid values",
+			setArgs: []string{"global.prometheus.port=8080,rp.terraform.binary=opentofu,rp.tag=123"},
+		},
+		{
+			name:    "invalid type",
+			setArgs: []string{"global.prometheus.port=metrics"},
+			err:     "global.prometheus.port",
+		},
+		{
+			name:    "invalid enum value",
+			setArgs: []string{"dynamicrp.terraform.binary=tofu"},
+			err:     "dynamicrp.terraform.binary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			helmChart, err := loader.Load("../../../deploy/Chart")
+			require.NoError(t, err)
+
+			err = AddValues(helmChart, &ChartOptions{SetArgs: tt.setArgs})
+			require.NoError(t, err)
+
+			err = ValidateValues(helmChart)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "The values of the radius Helm chart do not match the values schema of the chart")
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func Test_RenderHelmChart(t *testing.T) {
+	options := ChartOptions{
+		ReleaseName: radiusReleaseName,
+		Namespace:   RadiusSystemNamespace,
+		ChartPath:   "../../../deploy/Chart",
+		SetArgs:     []string{"rp.tag=test-tag"},
+	}
+
+	manifest, err := RenderHelmChart(options, "")
+	require.NoError(t, err)
+	require.Contains(t, manifest, "image: \"ghcr.io/radius-project/applications-rp:test-tag\"")
+	require.Contains(t, manifest, "namespace: \"radius-system\"")
+
+	options.SetArgs = []string{"global.prometheus.enabled=yes"}
+	_, err = RenderHelmChart(options, "")
+	require.ErrorContains(t, err, "global.prometheus.enabled")
+}
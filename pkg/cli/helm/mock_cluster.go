@@ -117,6 +117,45 @@ func (c *MockInterfaceInstallRadiusCall) DoAndReturn(f func(context.Context, Clu
 	return c
 }
 
+// RenderRadius mocks base method.
+func (m *MockInterface) RenderRadius(arg0 context.Context, arg1 ClusterOptions, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderRadius", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderRadius indicates an expected call of RenderRadius.
+func (mr *MockInterfaceMockRecorder) RenderRadius(arg0, arg1, arg2 any) *MockInterfaceRenderRadiusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderRadius", reflect.TypeOf((*MockInterface)(nil).RenderRadius), arg0, arg1, arg2)
+	return &MockInterfaceRenderRadiusCall{Call: call}
+}
+
+// MockInterfaceRenderRadiusCall wrap *gomock.Call
+type MockInterfaceRenderRadiusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceRenderRadiusCall) Return(arg0 string, arg1 error) *MockInterfaceRenderRadiusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceRenderRadiusCall) Do(f func(context.Context, ClusterOptions, string) (string, error)) *MockInterfaceRenderRadiusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceRenderRadiusCall) DoAndReturn(f func(context.Context, ClusterOptions, string) (string, error)) *MockInterfaceRenderRadiusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UninstallRadius mocks base method.
 func (m *MockInterface) UninstallRadius(arg0 context.Context, arg1 ClusterOptions, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -154,3 +193,41 @@ func (c *MockInterfaceUninstallRadiusCall) DoAndReturn(f func(context.Context, C
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ValidateRadiusValues mocks base method.
+func (m *MockInterface) ValidateRadiusValues(arg0 context.Context, arg1 ClusterOptions, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRadiusValues", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateRadiusValues indicates an expected call of ValidateRadiusValues.
+func (mr *MockInterfaceMockRecorder) ValidateRadiusValues(arg0, arg1, arg2 any) *MockInterfaceValidateRadiusValuesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRadiusValues", reflect.TypeOf((*MockInterface)(nil).ValidateRadiusValues), arg0, arg1, arg2)
+	return &MockInterfaceValidateRadiusValuesCall{Call: call}
+}
+
+// MockInterfaceValidateRadiusValuesCall wrap *gomock.Call
+type MockInterfaceValidateRadiusValuesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceValidateRadiusValuesCall) Return(arg0 error) *MockInterfaceValidateRadiusValuesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceValidateRadiusValuesCall) Do(f func(context.Context, ClusterOptions, string) error) *MockInterfaceValidateRadiusValuesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceValidateRadiusValuesCall) DoAndReturn(f func(context.Context, ClusterOptions, string) error) *MockInterfaceValidateRadiusValuesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
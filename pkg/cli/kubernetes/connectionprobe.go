@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/to"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// connectionProbeImage is the image of the job which runs a connection probe.
+	connectionProbeImage = "busybox:1.36"
+
+	// connectionProbePrefix is the prefix of the name of the job which runs a connection probe.
+	connectionProbePrefix = "rad-connection-probe-"
+
+	// defaultConnectionProbeTimeout is the default timeout of a connection probe.
+	defaultConnectionProbeTimeout = 5 * time.Second
+
+	// connectionProbeStartTimeout is the time allowed for the job of a connection probe to be scheduled and started,
+	// in addition to the timeout of the probe itself.
+	connectionProbeStartTimeout = 60 * time.Second
+
+	// connectionProbePollInterval is the interval at which the status of the job of a connection probe is checked.
+	connectionProbePollInterval = time.Second
+)
+
+// ConnectionProbeOptions describes a reachability check of a connection from within the cluster.
+type ConnectionProbeOptions struct {
+	// Namespace is the namespace the probe runs in. The probe runs in the namespace of the resource which owns the
+	// connection, so the network policies of the namespace apply to the probe.
+	Namespace string
+
+	// Host is the host name or IP address probed with a TCP connection.
+	Host string
+
+	// Port is the port probed with a TCP connection.
+	Port int
+
+	// URL is the URL probed with an HTTP request. If set, Host and Port are ignored.
+	URL string
+
+	// Timeout is the timeout of the connection attempt. Defaults to 5 seconds.
+	Timeout time.Duration
+}
+
+// ConnectionProbeResult is the result of a reachability check of a connection.
+type ConnectionProbeResult struct {
+	// Reachable is true if the connection could be established from within the cluster.
+	Reachable bool
+
+	// Target is the target of the probe, e.g. 'redis.default:6379' or 'http://frontend.default:3000'.
+	Target string
+}
+
+// ProbeConnection checks whether a TCP or HTTP target is reachable from within the cluster by running a short-lived
+// job in the given namespace. The job is deleted once the probe completes.
+func (i *Impl) ProbeConnection(ctx context.Context, kubeContext string, options ConnectionProbeOptions) (*ConnectionProbeResult, error) {
+	client, _, err := NewClientset(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return probeConnection(ctx, client, options)
+}
+
+func probeConnection(ctx context.Context, client k8s.Interface, options ConnectionProbeOptions) (*ConnectionProbeResult, error) {
+	if options.Namespace == "" {
+		return nil, errors.New("namespace is required")
+	}
+
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = defaultConnectionProbeTimeout
+	}
+	seconds := strconv.Itoa(int(timeout.Seconds()))
+
+	var target string
+	var command []string
+	if options.URL != "" {
+		target = options.URL
+		command = []string{"wget", "-q", "--spider", "-T", seconds, options.URL}
+	} else if options.Host != "" && options.Port > 0 {
+		target = fmt.Sprintf("%s:%d", options.Host, options.Port)
+		command = []string{"nc", "-z", "-w", seconds, options.Host, strconv.Itoa(options.Port)}
+	} else {
+		return nil, errors.New("either a URL, or a host and a port are required")
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionProbePrefix + utilrand.String(8),
+			Namespace: options.Namespace,
+			Labels: map[string]string{
+				k8slabels.LabelManagedBy: LabelManagedByRad,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            to.Ptr(int32(0)),
+			ActiveDeadlineSeconds:   to.Ptr(int64((timeout + connectionProbeStartTimeout).Seconds())),
+			TTLSecondsAfterFinished: to.Ptr(int32(60)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						k8slabels.LabelManagedBy: LabelManagedByRad,
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    "probe",
+							Image:   connectionProbeImage,
+							Command: command,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	job, err := client.BatchV1().Jobs(options.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		// The job is deleted in the background, so the probe does not wait for the deletion of the pod.
+		propagation := metav1.DeletePropagationBackground
+		_ = client.BatchV1().Jobs(options.Namespace).Delete(context.Background(), job.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	}()
+
+	result := &ConnectionProbeResult{Target: target}
+	err = wait.PollUntilContextTimeout(ctx, connectionProbePollInterval, timeout+connectionProbeStartTimeout, true, func(ctx context.Context) (bool, error) {
+		current, err := client.BatchV1().Jobs(options.Namespace).Get(ctx, job.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status.Succeeded > 0 {
+			result.Reachable = true
+			return true, nil
+		} else if current.Status.Failed > 0 {
+			result.Reachable = false
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe %s: %w", target, err)
+	}
+
+	return result, nil
+}
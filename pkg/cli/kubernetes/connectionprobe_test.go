@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newConnectionProbeClient creates a fake client which completes the probe jobs immediately.
+func newConnectionProbeClient(succeeded bool, created *[]*batchv1.Job) *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		job := action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+		*created = append(*created, job.DeepCopy())
+		if succeeded {
+			job.Status.Succeeded = 1
+		} else {
+			job.Status.Failed = 1
+		}
+		return false, job, nil
+	})
+
+	return client
+}
+
+func Test_probeConnection(t *testing.T) {
+	t.Run("tcp reachable", func(t *testing.T) {
+		created := []*batchv1.Job{}
+		client := newConnectionProbeClient(true, &created)
+
+		result, err := probeConnection(context.Background(), client, ConnectionProbeOptions{Namespace: "default-myapp", Host: "redis.default-myapp", Port: 6379})
+		require.NoError(t, err)
+		require.Equal(t, &ConnectionProbeResult{Reachable: true, Target: "redis.default-myapp:6379"}, result)
+
+		require.Len(t, created, 1)
+		require.Equal(t, "default-myapp", created[0].Namespace)
+		require.Equal(t, LabelManagedByRad, created[0].Labels["app.kubernetes.io/managed-by"])
+		require.Equal(t, []string{"nc", "-z", "-w", "5", "redis.default-myapp", "6379"}, created[0].Spec.Template.Spec.Containers[0].Command)
+
+		// The job is deleted once the probe completes.
+		jobs, err := client.BatchV1().Jobs("default-myapp").List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Empty(t, jobs.Items)
+	})
+
+	t.Run("http unreachable", func(t *testing.T) {
+		created := []*batchv1.Job{}
+		client := newConnectionProbeClient(false, &created)
+
+		result, err := probeConnection(context.Background(), client, ConnectionProbeOptions{Namespace: "default-myapp", URL: "http://frontend.default-myapp:3000"})
+		require.NoError(t, err)
+		require.Equal(t, &ConnectionProbeResult{Reachable: false, Target: "http://frontend.default-myapp:3000"}, result)
+
+		require.Len(t, created, 1)
+		require.Equal(t, []string{"wget", "-q", "--spider", "-T", "5", "http://frontend.default-myapp:3000"}, created[0].Spec.Template.Spec.Containers[0].Command)
+	})
+
+	t.Run("missing target", func(t *testing.T) {
+		client := fake.NewSimpleClientset()
+
+		result, err := probeConnection(context.Background(), client, ConnectionProbeOptions{Namespace: "default-myapp", Host: "redis"})
+		require.EqualError(t, err, "either a URL, or a host and a port are required")
+		require.Nil(t, result)
+	})
+
+	t.Run("missing namespace", func(t *testing.T) {
+		client := fake.NewSimpleClientset()
+
+		result, err := probeConnection(context.Background(), client, ConnectionProbeOptions{Host: "redis", Port: 6379})
+		require.EqualError(t, err, "namespace is required")
+		require.Nil(t, result)
+	})
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// deploymentResourceType is the type of Kubernetes deployments in the IDs of output resources.
+const deploymentResourceType = "apps/Deployment"
+
+// FindDeployment returns the ID of the Kubernetes deployment in the output resources of a Radius container, given the
+// properties of the container.
+func FindDeployment(properties map[string]any) (resources.ID, bool) {
+	status, _ := properties["status"].(map[string]any)
+	outputResources, _ := status["outputResources"].([]any)
+	for _, outputResource := range outputResources {
+		outputResource, _ := outputResource.(map[string]any)
+		id, err := resources.ParseResource(fmt.Sprint(outputResource["id"]))
+		if err == nil && strings.EqualFold(id.Type(), deploymentResourceType) && id.FindScope("namespaces") != "" {
+			return id, true
+		}
+	}
+
+	return resources.ID{}, false
+}
+
+// DeploymentPodSelector returns the label selector of the pods of the Kubernetes deployment with the given ID. The
+// error of the Kubernetes API is returned as is if the deployment does not exist.
+func DeploymentPodSelector(ctx context.Context, client k8s.Interface, deploymentID resources.ID) (labels.Selector, error) {
+	deployment, err := client.AppsV1().Deployments(deploymentID.FindScope("namespaces")).Get(ctx, deploymentID.Name(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if deployment.Spec.Selector == nil {
+		return nil, fmt.Errorf("the Kubernetes deployment %q does not have a pod selector", deploymentID.Name())
+	}
+
+	return metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+func Test_FindDeployment(t *testing.T) {
+	properties := map[string]any{
+		"status": map[string]any{
+			"outputResources": []any{
+				map[string]any{"id": "/planes/kubernetes/local/namespaces/test-ns/providers/core/Service/frontend"},
+				map[string]any{"id": "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/frontend"},
+			},
+		},
+	}
+
+	id, ok := FindDeployment(properties)
+	require.True(t, ok)
+	require.Equal(t, "/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/frontend", id.String())
+
+	_, ok = FindDeployment(map[string]any{})
+	require.False(t, ok)
+}
+
+func Test_DeploymentPodSelector(t *testing.T) {
+	client := k8sfake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "test-ns"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"radapp.io/resource": "frontend"}},
+		},
+	})
+
+	selector, err := DeploymentPodSelector(context.Background(), client, resources.MustParse("/planes/kubernetes/local/namespaces/test-ns/providers/apps/Deployment/frontend"))
+	require.NoError(t, err)
+	require.Equal(t, "radapp.io/resource=frontend", selector.String())
+
+	_, err = DeploymentPodSelector(context.Background(), client, resources.MustParse("/planes/kubernetes/local/namespaces/other-ns/providers/apps/Deployment/frontend"))
+	require.True(t, apierrors.IsNotFound(err))
+}
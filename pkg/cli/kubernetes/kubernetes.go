@@ -189,6 +189,12 @@ func GetContextFromConfigFileIfExists(configFilePath, context string) (string, e
 type Interface interface {
 	GetKubeContext() (*api.Config, error)
 	DeleteNamespace(string) error
+	ListRadiusManagedObjects(ctx context.Context, kubeContext string) ([]ManagedObject, error)
+	DeleteRadiusManagedObject(ctx context.Context, kubeContext string, object ManagedObject) error
+	CreateScopedCredential(ctx context.Context, kubeContext string, options ScopedCredentialOptions) (*ScopedCredential, error)
+	ProbeConnection(ctx context.Context, kubeContext string, options ConnectionProbeOptions) (*ConnectionProbeResult, error)
+	ListQueueMessages(ctx context.Context, kubeContext string) ([]QueueMessage, error)
+	GetControlPlaneLogs(ctx context.Context, kubeContext string, options ControlPlaneLogsOptions) ([]ControlPlaneLogLine, error)
 }
 
 type Impl struct {
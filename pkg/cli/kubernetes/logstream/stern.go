@@ -38,12 +38,15 @@ import (
 // our log messages. For example, we can't use the Radius container name because stern does not provide that to us.
 const outputFormat = "{{color .PodColor .PodName}} {{color .ContainerColor .ContainerName}} {{.Message}}\n"
 
+// defaultSince is the maximum age of the streamed logs when the options do not specify it.
+const defaultSince = 48 * time.Hour
+
 // Impl is the implementation of logstream.Interface.
 type Impl struct {
 }
 
 // Stream opens a log stream and writes the application's log to the provided writer.
-// This function will block until the context is cancelled.
+// This function will block until the context is cancelled when following logs.
 //
 
 // Stream() configures and runs Stern, a library for streaming logs from Kubernetes pods, with custom filters and output formats
@@ -70,9 +73,9 @@ func (i *Impl) Stream(ctx context.Context, options Options) error {
 		EphemeralContainers: true,
 
 		// Fields used to configure the lifetime of the command
-		Since:     48 * time.Hour,
+		Since:     defaultSince,
 		TailLines: nil,
-		Follow:    true,
+		Follow:    options.Follow,
 
 		// Fields used to configure output
 		Timestamps: false,
@@ -84,20 +87,28 @@ func (i *Impl) Stream(ctx context.Context, options Options) error {
 		MaxLogRequests: 50,
 	}
 
+	if options.Since > 0 {
+		cfg.Since = options.Since
+	}
+
 	// This is the only Radius-specific customization we make.
 	//
 	// We use the `radapp.io/application` label to include pods that are part of an application.
 	// This can include the user's Radius containers as well as any Kubernetes resources that are labeled
 	// as part of the application (eg: something created with a recipe).
-	req, err := labels.NewRequirement(kubernetes.LabelRadiusApplication, selection.Equals, []string{options.ApplicationName})
-	if err != nil {
-		return err
+	if options.LabelSelector != nil {
+		cfg.LabelSelector = options.LabelSelector
+	} else {
+		req, err := labels.NewRequirement(kubernetes.LabelRadiusApplication, selection.Equals, []string{options.ApplicationName})
+		if err != nil {
+			return err
+		}
+
+		cfg.LabelSelector = labels.NewSelector().Add(*req)
 	}
 
-	cfg.LabelSelector = labels.NewSelector().Add(*req)
-
-	// This will block until the context is cancelled.
-	err = stern.Run(ctx, options.KubeClient, &cfg)
+	// This will block until the context is cancelled when following logs.
+	err := stern.Run(ctx, options.KubeClient, &cfg)
 	if err != nil {
 		// Not returning the error and just logging is intentional!
 		// We don't want the process to exit if there's an error streaming logs.
@@ -19,7 +19,9 @@ package logstream
 import (
 	"context"
 	"io"
+	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -31,6 +33,16 @@ type Options struct {
 	// Namespace is the kubernetes namespace of the application.
 	Namespace string
 
+	// LabelSelector selects the pods to stream logs from. Defaults to the pods of the application.
+	LabelSelector labels.Selector
+
+	// Since is the maximum age of the logs to stream. Defaults to 48 hours.
+	Since time.Duration
+
+	// Follow keeps streaming new logs until the context is cancelled. When false, the available logs are written and
+	// Stream returns.
+	Follow bool
+
 	// KubeClient is the kubernetes client to use for connection.
 	KubeClient kubernetes.Interface
 
@@ -43,6 +55,6 @@ type Options struct {
 // Interface is the interface type for streaming application logs.
 type Interface interface {
 	// Stream opens a log stream and writes the application's log to the provided writer.
-	// This function will block until the context is cancelled.
+	// This function will block until the context is cancelled when following logs.
 	Stream(ctx context.Context, options Options) error
 }
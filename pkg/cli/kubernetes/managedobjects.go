@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// managedObjectResources is the list of Kubernetes resource types that Radius creates for the resources it manages.
+var managedObjectResources = []schema.GroupVersionResource{
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "", Version: "v1", Resource: "services"},
+	{Group: "", Version: "v1", Resource: "serviceaccounts"},
+	{Group: "", Version: "v1", Resource: "secrets"},
+	{Group: "", Version: "v1", Resource: "configmaps"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"},
+	{Group: "projectcontour.io", Version: "v1", Resource: "httpproxies"},
+	{Group: "dapr.io", Version: "v1alpha1", Resource: "components"},
+	{Group: "secrets-store.csi.x-k8s.io", Version: "v1", Resource: "secretproviderclasses"},
+}
+
+// ManagedObject is a Kubernetes object that is labeled as managed by Radius.
+type ManagedObject struct {
+	// Resource is the Kubernetes resource type of the object.
+	Resource schema.GroupVersionResource
+
+	// Kind is the Kubernetes kind of the object.
+	Kind string
+
+	// Namespace is the namespace of the object.
+	Namespace string
+
+	// Name is the name of the object.
+	Name string
+
+	// Application is the value of the Radius application label of the object.
+	Application string
+
+	// RadiusResource is the value of the Radius resource name label of the object.
+	RadiusResource string
+
+	// RadiusResourceType is the Radius resource type of the object, converted from its label value.
+	RadiusResourceType string
+}
+
+// ListRadiusManagedObjects lists the Kubernetes objects in all namespaces of the cluster that are labeled as managed
+// by Radius.
+func (i *Impl) ListRadiusManagedObjects(ctx context.Context, kubeContext string) ([]ManagedObject, error) {
+	client, err := NewDynamicClient(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return listManagedObjects(ctx, client)
+}
+
+// DeleteRadiusManagedObject deletes a Kubernetes object that is labeled as managed by Radius.
+func (i *Impl) DeleteRadiusManagedObject(ctx context.Context, kubeContext string, object ManagedObject) error {
+	client, err := NewDynamicClient(kubeContext)
+	if err != nil {
+		return err
+	}
+
+	return deleteManagedObject(ctx, client, object)
+}
+
+func listManagedObjects(ctx context.Context, client dynamic.Interface) ([]ManagedObject, error) {
+	// Only objects that carry the Radius resource labels can be traced back to a Radius resource. Namespaces
+	// created by Radius are labeled as managed but are not owned by a single resource.
+	selector := labels.SelectorFromSet(labels.Set{k8slabels.LabelManagedBy: k8slabels.LabelManagedByRadiusRP}).String()
+
+	objects := []ManagedObject{}
+	for _, gvr := range managedObjectResources {
+		list, err := client.Resource(gvr).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if apierrors.IsNotFound(err) {
+			// The CRD for this resource type is not installed in the cluster.
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, item := range list.Items {
+			objectLabels := item.GetLabels()
+			resourceName := objectLabels[k8slabels.LabelRadiusResource]
+			resourceType := objectLabels[k8slabels.LabelRadiusResourceType]
+			if resourceName == "" || resourceType == "" {
+				continue
+			}
+
+			objects = append(objects, ManagedObject{
+				Resource:           gvr,
+				Kind:               item.GetKind(),
+				Namespace:          item.GetNamespace(),
+				Name:               item.GetName(),
+				Application:        objectLabels[k8slabels.LabelRadiusApplication],
+				RadiusResource:     resourceName,
+				RadiusResourceType: k8slabels.ConvertLabelToResourceType(resourceType),
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+func deleteManagedObject(ctx context.Context, client dynamic.Interface, object ManagedObject) error {
+	policy := metav1.DeletePropagationBackground
+	err := client.Resource(object.Resource).Namespace(object.Namespace).Delete(ctx, object.Name, metav1.DeleteOptions{PropagationPolicy: &policy})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
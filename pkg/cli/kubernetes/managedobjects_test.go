@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func newManagedObjectsClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	listKinds := map[schema.GroupVersionResource]string{}
+	for _, gvr := range managedObjectResources {
+		listKinds[gvr] = gvr.Resource + "List"
+	}
+
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+func newObject(apiVersion string, kind string, namespace string, name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func Test_listManagedObjects(t *testing.T) {
+	labels := k8slabels.MakeDescriptiveLabels("myapp", "frontend", "Applications.Core/containers")
+	client := newManagedObjectsClient(
+		newObject("apps/v1", "Deployment", "default-myapp", "frontend", labels),
+		newObject("v1", "Service", "default-myapp", "frontend", labels),
+		// Not labeled as managed by Radius.
+		newObject("v1", "Secret", "default-myapp", "other", map[string]string{}),
+		// Labeled as managed by Radius but not owned by a Radius resource.
+		newObject("v1", "ConfigMap", "default-myapp", "shared", map[string]string{k8slabels.LabelManagedBy: k8slabels.LabelManagedByRadiusRP}),
+	)
+
+	// Simulate a cluster where Dapr is not installed.
+	client.PrependReactor("list", "components", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Group: "dapr.io", Resource: "components"}, "")
+	})
+
+	objects, err := listManagedObjects(context.Background(), client)
+	require.NoError(t, err)
+
+	expected := []ManagedObject{
+		{
+			Resource:           schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+			Kind:               "Deployment",
+			Namespace:          "default-myapp",
+			Name:               "frontend",
+			Application:        "myapp",
+			RadiusResource:     "frontend",
+			RadiusResourceType: "applications.core/containers",
+		},
+		{
+			Resource:           schema.GroupVersionResource{Version: "v1", Resource: "services"},
+			Kind:               "Service",
+			Namespace:          "default-myapp",
+			Name:               "frontend",
+			Application:        "myapp",
+			RadiusResource:     "frontend",
+			RadiusResourceType: "applications.core/containers",
+		},
+	}
+	require.Equal(t, expected, objects)
+}
+
+func Test_deleteManagedObject(t *testing.T) {
+	labels := k8slabels.MakeDescriptiveLabels("myapp", "frontend", "Applications.Core/containers")
+	client := newManagedObjectsClient(newObject("apps/v1", "Deployment", "default-myapp", "frontend", labels))
+
+	object := ManagedObject{
+		Resource:  schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		Namespace: "default-myapp",
+		Name:      "frontend",
+	}
+
+	err := deleteManagedObject(context.Background(), client, object)
+	require.NoError(t, err)
+
+	_, err = client.Resource(object.Resource).Namespace(object.Namespace).Get(context.Background(), object.Name, metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err), "expected not found error but got %v", err)
+
+	// Deleting an object that no longer exists is not an error.
+	err = deleteManagedObject(context.Background(), client, object)
+	require.NoError(t, err)
+}
@@ -10,6 +10,7 @@
 package kubernetes
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -39,6 +40,45 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// CreateScopedCredential mocks base method.
+func (m *MockInterface) CreateScopedCredential(arg0 context.Context, arg1 string, arg2 ScopedCredentialOptions) (*ScopedCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScopedCredential", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*ScopedCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScopedCredential indicates an expected call of CreateScopedCredential.
+func (mr *MockInterfaceMockRecorder) CreateScopedCredential(arg0, arg1, arg2 any) *MockInterfaceCreateScopedCredentialCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScopedCredential", reflect.TypeOf((*MockInterface)(nil).CreateScopedCredential), arg0, arg1, arg2)
+	return &MockInterfaceCreateScopedCredentialCall{Call: call}
+}
+
+// MockInterfaceCreateScopedCredentialCall wrap *gomock.Call
+type MockInterfaceCreateScopedCredentialCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceCreateScopedCredentialCall) Return(arg0 *ScopedCredential, arg1 error) *MockInterfaceCreateScopedCredentialCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceCreateScopedCredentialCall) Do(f func(context.Context, string, ScopedCredentialOptions) (*ScopedCredential, error)) *MockInterfaceCreateScopedCredentialCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceCreateScopedCredentialCall) DoAndReturn(f func(context.Context, string, ScopedCredentialOptions) (*ScopedCredential, error)) *MockInterfaceCreateScopedCredentialCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteNamespace mocks base method.
 func (m *MockInterface) DeleteNamespace(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -77,6 +117,83 @@ func (c *MockInterfaceDeleteNamespaceCall) DoAndReturn(f func(string) error) *Mo
 	return c
 }
 
+// DeleteRadiusManagedObject mocks base method.
+func (m *MockInterface) DeleteRadiusManagedObject(arg0 context.Context, arg1 string, arg2 ManagedObject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRadiusManagedObject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRadiusManagedObject indicates an expected call of DeleteRadiusManagedObject.
+func (mr *MockInterfaceMockRecorder) DeleteRadiusManagedObject(arg0, arg1, arg2 any) *MockInterfaceDeleteRadiusManagedObjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRadiusManagedObject", reflect.TypeOf((*MockInterface)(nil).DeleteRadiusManagedObject), arg0, arg1, arg2)
+	return &MockInterfaceDeleteRadiusManagedObjectCall{Call: call}
+}
+
+// MockInterfaceDeleteRadiusManagedObjectCall wrap *gomock.Call
+type MockInterfaceDeleteRadiusManagedObjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceDeleteRadiusManagedObjectCall) Return(arg0 error) *MockInterfaceDeleteRadiusManagedObjectCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceDeleteRadiusManagedObjectCall) Do(f func(context.Context, string, ManagedObject) error) *MockInterfaceDeleteRadiusManagedObjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceDeleteRadiusManagedObjectCall) DoAndReturn(f func(context.Context, string, ManagedObject) error) *MockInterfaceDeleteRadiusManagedObjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetControlPlaneLogs mocks base method.
+func (m *MockInterface) GetControlPlaneLogs(arg0 context.Context, arg1 string, arg2 ControlPlaneLogsOptions) ([]ControlPlaneLogLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetControlPlaneLogs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]ControlPlaneLogLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetControlPlaneLogs indicates an expected call of GetControlPlaneLogs.
+func (mr *MockInterfaceMockRecorder) GetControlPlaneLogs(arg0, arg1, arg2 any) *MockInterfaceGetControlPlaneLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControlPlaneLogs", reflect.TypeOf((*MockInterface)(nil).GetControlPlaneLogs), arg0, arg1, arg2)
+	return &MockInterfaceGetControlPlaneLogsCall{Call: call}
+}
+
+// MockInterfaceGetControlPlaneLogsCall wrap *gomock.Call
+type MockInterfaceGetControlPlaneLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceGetControlPlaneLogsCall) Return(arg0 []ControlPlaneLogLine, arg1 error) *MockInterfaceGetControlPlaneLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceGetControlPlaneLogsCall) Do(f func(context.Context, string, ControlPlaneLogsOptions) ([]ControlPlaneLogLine, error)) *MockInterfaceGetControlPlaneLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceGetControlPlaneLogsCall) DoAndReturn(f func(context.Context, string, ControlPlaneLogsOptions) ([]ControlPlaneLogLine, error)) *MockInterfaceGetControlPlaneLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetKubeContext mocks base method.
 func (m *MockInterface) GetKubeContext() (*api.Config, error) {
 	m.ctrl.T.Helper()
@@ -115,3 +232,120 @@ func (c *MockInterfaceGetKubeContextCall) DoAndReturn(f func() (*api.Config, err
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ListQueueMessages mocks base method.
+func (m *MockInterface) ListQueueMessages(arg0 context.Context, arg1 string) ([]QueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueueMessages", arg0, arg1)
+	ret0, _ := ret[0].([]QueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMessages indicates an expected call of ListQueueMessages.
+func (mr *MockInterfaceMockRecorder) ListQueueMessages(arg0, arg1 any) *MockInterfaceListQueueMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMessages", reflect.TypeOf((*MockInterface)(nil).ListQueueMessages), arg0, arg1)
+	return &MockInterfaceListQueueMessagesCall{Call: call}
+}
+
+// MockInterfaceListQueueMessagesCall wrap *gomock.Call
+type MockInterfaceListQueueMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceListQueueMessagesCall) Return(arg0 []QueueMessage, arg1 error) *MockInterfaceListQueueMessagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceListQueueMessagesCall) Do(f func(context.Context, string) ([]QueueMessage, error)) *MockInterfaceListQueueMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceListQueueMessagesCall) DoAndReturn(f func(context.Context, string) ([]QueueMessage, error)) *MockInterfaceListQueueMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRadiusManagedObjects mocks base method.
+func (m *MockInterface) ListRadiusManagedObjects(arg0 context.Context, arg1 string) ([]ManagedObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRadiusManagedObjects", arg0, arg1)
+	ret0, _ := ret[0].([]ManagedObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRadiusManagedObjects indicates an expected call of ListRadiusManagedObjects.
+func (mr *MockInterfaceMockRecorder) ListRadiusManagedObjects(arg0, arg1 any) *MockInterfaceListRadiusManagedObjectsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRadiusManagedObjects", reflect.TypeOf((*MockInterface)(nil).ListRadiusManagedObjects), arg0, arg1)
+	return &MockInterfaceListRadiusManagedObjectsCall{Call: call}
+}
+
+// MockInterfaceListRadiusManagedObjectsCall wrap *gomock.Call
+type MockInterfaceListRadiusManagedObjectsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceListRadiusManagedObjectsCall) Return(arg0 []ManagedObject, arg1 error) *MockInterfaceListRadiusManagedObjectsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceListRadiusManagedObjectsCall) Do(f func(context.Context, string) ([]ManagedObject, error)) *MockInterfaceListRadiusManagedObjectsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceListRadiusManagedObjectsCall) DoAndReturn(f func(context.Context, string) ([]ManagedObject, error)) *MockInterfaceListRadiusManagedObjectsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProbeConnection mocks base method.
+func (m *MockInterface) ProbeConnection(arg0 context.Context, arg1 string, arg2 ConnectionProbeOptions) (*ConnectionProbeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProbeConnection", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*ConnectionProbeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProbeConnection indicates an expected call of ProbeConnection.
+func (mr *MockInterfaceMockRecorder) ProbeConnection(arg0, arg1, arg2 any) *MockInterfaceProbeConnectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProbeConnection", reflect.TypeOf((*MockInterface)(nil).ProbeConnection), arg0, arg1, arg2)
+	return &MockInterfaceProbeConnectionCall{Call: call}
+}
+
+// MockInterfaceProbeConnectionCall wrap *gomock.Call
+type MockInterfaceProbeConnectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceProbeConnectionCall) Return(arg0 *ConnectionProbeResult, arg1 error) *MockInterfaceProbeConnectionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceProbeConnectionCall) Do(f func(context.Context, string, ConnectionProbeOptions) (*ConnectionProbeResult, error)) *MockInterfaceProbeConnectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceProbeConnectionCall) DoAndReturn(f func(context.Context, string, ConnectionProbeOptions) (*ConnectionProbeResult, error)) *MockInterfaceProbeConnectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/helm"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/to"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// queueNameLabel is the label of a queue message which holds the name of its queue.
+	queueNameLabel = "ucp.dev/queuename"
+
+	// queueNextVisibleAtLabel is the label of a queue message which holds the time, in nanoseconds since the epoch,
+	// at which the message becomes visible to the workers again.
+	queueNextVisibleAtLabel = "ucp.dev/nextvisibleat"
+)
+
+// queueMessageResource is the Kubernetes resource type of the messages of the queues of the Radius control plane.
+var queueMessageResource = schema.GroupVersionResource{Group: "ucp.dev", Version: "v1alpha1", Resource: "queuemessages"}
+
+// QueueMessage is a message of a queue of asynchronous operations of the Radius control plane.
+type QueueMessage struct {
+	// Name is the name of the message.
+	Name string
+
+	// Queue is the name of the queue, e.g. 'applications.core'.
+	Queue string
+
+	// DequeueCount is the number of times the message was dequeued by a worker.
+	DequeueCount int
+
+	// EnqueueAt is the time when the message was enqueued.
+	EnqueueAt time.Time
+
+	// ExpireAt is the time when the message expires.
+	ExpireAt time.Time
+
+	// NextVisibleAt is the time when the message becomes visible to the workers again. A message leased by a worker
+	// is invisible until its lock expires.
+	NextVisibleAt time.Time
+
+	// Data is the JSON payload of the message.
+	Data []byte
+}
+
+// ControlPlaneLogsOptions describes the log lines of the control plane to collect.
+type ControlPlaneLogsOptions struct {
+	// Since is the duration before now from which the logs are collected.
+	Since time.Duration
+
+	// Contains is the list of strings of which a log line must contain at least one to be collected.
+	Contains []string
+}
+
+// ControlPlaneLogLine is a log line of a container of the Radius control plane.
+type ControlPlaneLogLine struct {
+	// Pod is the name of the pod which wrote the line.
+	Pod string
+
+	// Container is the name of the container which wrote the line.
+	Container string
+
+	// Time is the time when the line was written.
+	Time time.Time
+
+	// Line is the content of the line, which is a JSON object for the structured logs of the control plane.
+	Line string
+}
+
+// Fields returns the fields of a structured log line, or nil if the line is not a JSON object.
+func (l ControlPlaneLogLine) Fields() map[string]any {
+	fields := map[string]any{}
+	if err := json.Unmarshal([]byte(l.Line), &fields); err != nil {
+		return nil
+	}
+
+	return fields
+}
+
+// ListQueueMessages lists the messages of the queues of asynchronous operations of the Radius control plane.
+func (i *Impl) ListQueueMessages(ctx context.Context, kubeContext string) ([]QueueMessage, error) {
+	client, err := NewDynamicClient(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return listQueueMessages(ctx, client)
+}
+
+// GetControlPlaneLogs collects the log lines of the containers of the Radius control plane which match the options.
+func (i *Impl) GetControlPlaneLogs(ctx context.Context, kubeContext string, options ControlPlaneLogsOptions) ([]ControlPlaneLogLine, error) {
+	client, _, err := NewClientset(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return getControlPlaneLogs(ctx, client, options)
+}
+
+func listQueueMessages(ctx context.Context, client dynamic.Interface) ([]QueueMessage, error) {
+	list, err := client.Resource(queueMessageResource).Namespace(helm.RadiusSystemNamespace).List(ctx, metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		// The queues of the control plane are not backed by the Kubernetes API server.
+		return []QueueMessage{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	messages := []QueueMessage{}
+	for _, item := range list.Items {
+		message := QueueMessage{
+			Name:  item.GetName(),
+			Queue: item.GetLabels()[queueNameLabel],
+		}
+
+		if nsec, err := strconv.ParseInt(item.GetLabels()[queueNextVisibleAtLabel], 10, 64); err == nil {
+			message.NextVisibleAt = time.Unix(0, nsec)
+		}
+		if count, ok, _ := unstructured.NestedInt64(item.Object, "spec", "dequeueCount"); ok {
+			message.DequeueCount = int(count)
+		}
+		message.EnqueueAt = nestedTime(item.Object, "spec", "enqueueAt")
+		message.ExpireAt = nestedTime(item.Object, "spec", "expireAt")
+
+		if data, ok, _ := unstructured.NestedFieldNoCopy(item.Object, "spec", "data"); ok {
+			message.Data, err = json.Marshal(data)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func nestedTime(object map[string]any, fields ...string) time.Time {
+	value, _, _ := unstructured.NestedString(object, fields...)
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+func getControlPlaneLogs(ctx context.Context, client k8s.Interface, options ControlPlaneLogsOptions) ([]ControlPlaneLogLine, error) {
+	pods, err := client.CoreV1().Pods(helm.RadiusSystemNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", k8slabels.LabelPartOf, k8slabels.ControlPlanePartOfLabelValue),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []ControlPlaneLogLine{}
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			logOptions := &corev1.PodLogOptions{
+				Container:  container.Name,
+				Timestamps: true,
+			}
+			if options.Since > 0 {
+				logOptions.SinceSeconds = to.Ptr(int64(options.Since.Seconds()))
+			}
+
+			stream, err := client.CoreV1().Pods(helm.RadiusSystemNamespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the logs of container %s of pod %s: %w", container.Name, pod.Name, err)
+			}
+
+			matched, err := filterLogLines(stream, pod.Name, container.Name, options.Contains)
+			stream.Close()
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, matched...)
+		}
+	}
+
+	return lines, nil
+}
+
+// filterLogLines reads log lines prefixed with their timestamp and returns the lines which contain any of the given
+// strings.
+func filterLogLines(reader io.Reader, pod string, container string, contains []string) ([]ControlPlaneLogLine, error) {
+	lines := []ControlPlaneLogLine{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if !containsAny(text, contains) {
+			continue
+		}
+
+		line := ControlPlaneLogLine{Pod: pod, Container: container, Line: text}
+		if timestamp, rest, ok := strings.Cut(text, " "); ok {
+			if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+				line.Time = t
+				line.Line = rest
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+func containsAny(text string, values []string) bool {
+	for _, value := range values {
+		if value != "" && strings.Contains(text, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/helm"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_listQueueMessages(t *testing.T) {
+	message := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "ucp.dev/v1alpha1",
+			"kind":       "QueueMessage",
+			"metadata": map[string]any{
+				"name":      "applications.core-1",
+				"namespace": helm.RadiusSystemNamespace,
+				"labels": map[string]any{
+					queueNameLabel:          "applications.core",
+					queueNextVisibleAtLabel: "1700000300000000000",
+				},
+			},
+			"spec": map[string]any{
+				"dequeueCount": int64(2),
+				"enqueueAt":    "2023-11-14T22:13:20Z",
+				"expireAt":     "2023-11-15T22:13:20Z",
+				"contentType":  "application/json",
+				"data": map[string]any{
+					"asyncOperationID": "e4a6f5d0-0000-0000-0000-000000000000",
+					"operationType":    "APPLICATIONS.CORE/CONTAINERS|PUT",
+				},
+			},
+		},
+	}
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		queueMessageResource: "QueueMessageList",
+	}, message)
+
+	messages, err := listQueueMessages(context.Background(), client)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+
+	require.Equal(t, "applications.core-1", messages[0].Name)
+	require.Equal(t, "applications.core", messages[0].Queue)
+	require.Equal(t, 2, messages[0].DequeueCount)
+	require.Equal(t, time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC), messages[0].EnqueueAt)
+	require.Equal(t, time.Date(2023, 11, 15, 22, 13, 20, 0, time.UTC), messages[0].ExpireAt)
+	require.True(t, time.Unix(0, 1700000300000000000).Equal(messages[0].NextVisibleAt))
+	require.JSONEq(t, `{"asyncOperationID":"e4a6f5d0-0000-0000-0000-000000000000","operationType":"APPLICATIONS.CORE/CONTAINERS|PUT"}`, string(messages[0].Data))
+}
+
+func Test_getControlPlaneLogs(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "applications-rp-0",
+				Namespace: helm.RadiusSystemNamespace,
+				Labels:    map[string]string{k8slabels.LabelPartOf: k8slabels.ControlPlanePartOfLabelValue},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "applications-rp"}}},
+		},
+		// Not part of the control plane.
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: helm.RadiusSystemNamespace},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "other"}}},
+		},
+	)
+
+	// The fake clientset returns 'fake logs' as the logs of every container.
+	lines, err := getControlPlaneLogs(context.Background(), client, ControlPlaneLogsOptions{Since: time.Hour, Contains: []string{"fake"}})
+	require.NoError(t, err)
+	require.Equal(t, []ControlPlaneLogLine{{Pod: "applications-rp-0", Container: "applications-rp", Line: "fake logs"}}, lines)
+
+	lines, err = getControlPlaneLogs(context.Background(), client, ControlPlaneLogsOptions{Contains: []string{"operation-id"}})
+	require.NoError(t, err)
+	require.Empty(t, lines)
+}
+
+func Test_filterLogLines(t *testing.T) {
+	logs := strings.Join([]string{
+		`2023-11-14T22:13:21.123456789Z {"message":"Start processing operation.","operationId":"op-1"}`,
+		`2023-11-14T22:13:22Z {"message":"Start processing operation.","operationId":"op-2"}`,
+		`2023-11-14T22:13:23Z {"message":"Operation returned","traceId":"trace-1"}`,
+		`not a timestamp op-1`,
+	}, "\n")
+
+	lines, err := filterLogLines(strings.NewReader(logs), "pod", "container", []string{"op-1", "trace-1"})
+	require.NoError(t, err)
+
+	expected := []ControlPlaneLogLine{
+		{Pod: "pod", Container: "container", Time: time.Date(2023, 11, 14, 22, 13, 21, 123456789, time.UTC), Line: `{"message":"Start processing operation.","operationId":"op-1"}`},
+		{Pod: "pod", Container: "container", Time: time.Date(2023, 11, 14, 22, 13, 23, 0, time.UTC), Line: `{"message":"Operation returned","traceId":"trace-1"}`},
+		{Pod: "pod", Container: "container", Line: "not a timestamp op-1"},
+	}
+	require.Equal(t, expected, lines)
+
+	require.Equal(t, map[string]any{"message": "Operation returned", "traceId": "trace-1"}, lines[1].Fields())
+	require.Nil(t, lines[2].Fields())
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// LabelManagedByRad is the value of the managed-by label for the objects created by the rad CLI.
+	LabelManagedByRad = "rad"
+)
+
+// ScopedCredentialOptions describes the service account and the role bindings used to issue a scoped credential.
+type ScopedCredentialOptions struct {
+	// Name is the name of the service account and the role bindings.
+	Name string
+
+	// Namespace is the namespace of the service account.
+	Namespace string
+
+	// Namespaces are the namespaces the service account is granted access to.
+	Namespaces []string
+
+	// ClusterRole is the name of the cluster role bound to the service account in each namespace, e.g. 'edit' or 'view'.
+	ClusterRole string
+
+	// Duration is the requested lifetime of the token.
+	Duration time.Duration
+}
+
+// ScopedCredential is a service account token and the connection information of the cluster which issued it.
+type ScopedCredential struct {
+	// Server is the address of the Kubernetes API server.
+	Server string
+
+	// CertificateAuthorityData is the PEM encoded certificate authority of the Kubernetes API server.
+	CertificateAuthorityData []byte
+
+	// InsecureSkipTLSVerify skips the validation of the server certificate.
+	InsecureSkipTLSVerify bool
+
+	// Token is the bearer token of the service account.
+	Token string
+
+	// ExpirationTimestamp is the time when the token expires.
+	ExpirationTimestamp time.Time
+}
+
+// CreateScopedCredential creates or updates a service account which is granted the cluster role only in the given
+// namespaces and returns a token of the service account.
+func (i *Impl) CreateScopedCredential(ctx context.Context, kubeContext string, options ScopedCredentialOptions) (*ScopedCredential, error) {
+	client, config, err := NewClientset(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return createScopedCredential(ctx, client, config, options)
+}
+
+func createScopedCredential(ctx context.Context, client k8s.Interface, config *rest.Config, options ScopedCredentialOptions) (*ScopedCredential, error) {
+	if options.Name == "" || options.Namespace == "" || options.ClusterRole == "" {
+		return nil, errors.New("name, namespace and cluster role are required")
+	}
+
+	labels := map[string]string{
+		k8slabels.LabelManagedBy: LabelManagedByRad,
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      options.Name,
+			Namespace: options.Namespace,
+			Labels:    labels,
+		},
+	}
+	_, err := client.CoreV1().ServiceAccounts(options.Namespace).Create(ctx, serviceAccount, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	for _, namespace := range options.Namespaces {
+		roleBinding := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      options.Name,
+				Namespace: namespace,
+				Labels:    labels,
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     options.ClusterRole,
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					Kind:      rbacv1.ServiceAccountKind,
+					Name:      options.Name,
+					Namespace: options.Namespace,
+				},
+			},
+		}
+
+		if err := applyRoleBinding(ctx, client, roleBinding); err != nil {
+			return nil, err
+		}
+	}
+
+	request := &authenticationv1.TokenRequest{}
+	if options.Duration > 0 {
+		seconds := int64(options.Duration.Seconds())
+		request.Spec.ExpirationSeconds = &seconds
+	}
+
+	token, err := client.CoreV1().ServiceAccounts(options.Namespace).CreateToken(ctx, options.Name, request, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	caData := config.TLSClientConfig.CAData
+	if len(caData) == 0 && config.TLSClientConfig.CAFile != "" {
+		caData, err = os.ReadFile(config.TLSClientConfig.CAFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ScopedCredential{
+		Server:                   config.Host,
+		CertificateAuthorityData: caData,
+		InsecureSkipTLSVerify:    config.TLSClientConfig.Insecure,
+		Token:                    token.Status.Token,
+		ExpirationTimestamp:      token.Status.ExpirationTimestamp.Time,
+	}, nil
+}
+
+// applyRoleBinding creates the role binding or replaces the role binding with the same name. The role reference of
+// a role binding is immutable, so the existing role binding is deleted when it refers to a different role.
+func applyRoleBinding(ctx context.Context, client k8s.Interface, roleBinding *rbacv1.RoleBinding) error {
+	bindings := client.RbacV1().RoleBindings(roleBinding.Namespace)
+
+	existing, err := bindings.Get(ctx, roleBinding.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = bindings.Create(ctx, roleBinding, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if existing.RoleRef != roleBinding.RoleRef {
+		if err := bindings.Delete(ctx, roleBinding.Name, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		_, err = bindings.Create(ctx, roleBinding, metav1.CreateOptions{})
+		return err
+	}
+
+	existing.Subjects = roleBinding.Subjects
+	existing.Labels = roleBinding.Labels
+	_, err = bindings.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
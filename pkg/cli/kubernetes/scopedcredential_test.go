@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newScopedCredentialClient(objects ...runtime.Object) *fake.Clientset {
+	client := fake.NewSimpleClientset(objects...)
+	client.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+
+		request := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		expiration := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC).Add(time.Duration(*request.Spec.ExpirationSeconds) * time.Second)
+		request.Status = authenticationv1.TokenRequestStatus{
+			Token:               "test-token",
+			ExpirationTimestamp: metav1.NewTime(expiration),
+		}
+		return true, request, nil
+	})
+
+	return client
+}
+
+func Test_createScopedCredential(t *testing.T) {
+	config := &rest.Config{
+		Host: "https://kubernetes.example.com",
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: []byte("ca-data"),
+		},
+	}
+	options := ScopedCredentialOptions{
+		Name:        "rad-env-default",
+		Namespace:   "default",
+		Namespaces:  []string{"default", "default-myapp"},
+		ClusterRole: "edit",
+		Duration:    time.Hour,
+	}
+
+	t.Run("creates service account and role bindings", func(t *testing.T) {
+		client := newScopedCredentialClient()
+
+		credential, err := createScopedCredential(context.Background(), client, config, options)
+		require.NoError(t, err)
+		require.Equal(t, &ScopedCredential{
+			Server:                   "https://kubernetes.example.com",
+			CertificateAuthorityData: []byte("ca-data"),
+			Token:                    "test-token",
+			ExpirationTimestamp:      time.Date(2023, 10, 1, 13, 0, 0, 0, time.UTC),
+		}, credential)
+
+		serviceAccount, err := client.CoreV1().ServiceAccounts("default").Get(context.Background(), "rad-env-default", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, LabelManagedByRad, serviceAccount.Labels["app.kubernetes.io/managed-by"])
+
+		for _, namespace := range options.Namespaces {
+			roleBinding, err := client.RbacV1().RoleBindings(namespace).Get(context.Background(), "rad-env-default", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, "edit", roleBinding.RoleRef.Name)
+			require.Equal(t, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "rad-env-default", Namespace: "default"}}, roleBinding.Subjects)
+		}
+	})
+
+	t.Run("replaces role binding with different role", func(t *testing.T) {
+		existing := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "rad-env-default", Namespace: "default"},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "admin"},
+		}
+		client := newScopedCredentialClient(existing)
+
+		_, err := createScopedCredential(context.Background(), client, config, options)
+		require.NoError(t, err)
+
+		roleBinding, err := client.RbacV1().RoleBindings("default").Get(context.Background(), "rad-env-default", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, "edit", roleBinding.RoleRef.Name)
+	})
+
+	t.Run("missing options", func(t *testing.T) {
+		_, err := createScopedCredential(context.Background(), newScopedCredentialClient(), config, ScopedCredentialOptions{Name: "test"})
+		require.EqualError(t, err, "name, namespace and cluster role are required")
+	})
+}
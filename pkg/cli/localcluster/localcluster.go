@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localcluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// Provider is the tool used to create a local Kubernetes cluster.
+type Provider string
+
+const (
+	// ProviderKind creates the cluster using kind (https://kind.sigs.k8s.io).
+	ProviderKind Provider = "kind"
+
+	// ProviderK3d creates the cluster using k3d (https://k3d.io).
+	ProviderK3d Provider = "k3d"
+
+	// DefaultClusterName is the name of the local cluster when no name is specified.
+	DefaultClusterName = "radius"
+
+	// DefaultRegistryPort is the port on the host used by the local container registry.
+	DefaultRegistryPort = 5001
+
+	// DefaultIngressPort is the port on the host used to reach the ingress of the cluster.
+	DefaultIngressPort = 8080
+)
+
+// Options are the options used to create a local cluster.
+type Options struct {
+	// Provider is the tool used to create the cluster.
+	Provider Provider
+
+	// ClusterName is the name of the cluster.
+	ClusterName string
+
+	// RegistryPort is the port on the host used by the local container registry.
+	RegistryPort int
+
+	// IngressPort is the port on the host used to reach the ingress of the cluster.
+	IngressPort int
+}
+
+// Result is the result of creating a local cluster.
+type Result struct {
+	// KubeContext is the name of the kubeconfig context for the cluster.
+	KubeContext string
+
+	// Registry is the address of the local container registry from the host.
+	Registry string
+
+	// Created is true if the cluster was created, and false if a cluster with the same name already existed.
+	Created bool
+}
+
+//go:generate mockgen -typed -destination=./mock_localcluster.go -package=localcluster -self_package github.com/radius-project/radius/pkg/cli/localcluster github.com/radius-project/radius/pkg/cli/localcluster Interface
+
+// Interface is the interface for creating local Kubernetes clusters.
+type Interface interface {
+	// Create creates a local cluster with a container registry and ingress configured. Create does not fail if the
+	// cluster already exists.
+	Create(ctx context.Context, options Options) (Result, error)
+}
+
+// commandRunner runs an executable with the given arguments and stdin, and returns the combined output.
+type commandRunner func(ctx context.Context, stdin io.Reader, name string, args ...string) (string, error)
+
+// Impl is the implementation of Interface that shells out to the kind, k3d, and docker executables.
+type Impl struct {
+	run commandRunner
+}
+
+var _ Interface = (*Impl)(nil)
+
+// NewImpl creates a new Impl.
+func NewImpl() *Impl {
+	return &Impl{run: runCommand}
+}
+
+// KubeContextName returns the name of the kubeconfig context created by the provider for the cluster.
+func KubeContextName(provider Provider, clusterName string) string {
+	return fmt.Sprintf("%s-%s", provider, clusterName)
+}
+
+// Create creates a local cluster with a container registry and ingress configured.
+func (i *Impl) Create(ctx context.Context, options Options) (Result, error) {
+	if options.ClusterName == "" {
+		options.ClusterName = DefaultClusterName
+	}
+	if options.RegistryPort == 0 {
+		options.RegistryPort = DefaultRegistryPort
+	}
+	if options.IngressPort == 0 {
+		options.IngressPort = DefaultIngressPort
+	}
+
+	switch options.Provider {
+	case ProviderKind:
+		return i.createKind(ctx, options)
+	case ProviderK3d:
+		return i.createK3d(ctx, options)
+	default:
+		return Result{}, fmt.Errorf("unsupported local cluster provider %q", options.Provider)
+	}
+}
+
+func (i *Impl) createKind(ctx context.Context, options Options) (Result, error) {
+	result := Result{
+		KubeContext: KubeContextName(ProviderKind, options.ClusterName),
+		Registry:    fmt.Sprintf("localhost:%d", options.RegistryPort),
+	}
+
+	registryName := registryContainerName(options.ClusterName)
+	running, err := i.run(ctx, nil, "docker", "inspect", "-f", "{{.State.Running}}", registryName)
+	if err != nil || strings.TrimSpace(running) != "true" {
+		_, err = i.run(ctx, nil, "docker", "run", "-d", "--restart=always",
+			"-p", fmt.Sprintf("127.0.0.1:%d:5000", options.RegistryPort),
+			"--network", "bridge", "--name", registryName, "registry:2")
+		if err != nil {
+			return Result{}, fmt.Errorf("failed to start the local registry: %w", err)
+		}
+	}
+
+	exists, err := i.clusterExists(ctx, options, "kind", "get", "clusters")
+	if err != nil {
+		return Result{}, err
+	}
+
+	if !exists {
+		config := kindClusterConfig(options, registryName)
+		_, err = i.run(ctx, strings.NewReader(config), "kind", "create", "cluster", "--name", options.ClusterName, "--config", "-", "--wait", "5m")
+		if err != nil {
+			return Result{}, fmt.Errorf("failed to create kind cluster: %w", err)
+		}
+		result.Created = true
+	}
+
+	// Connecting the registry fails if it is already connected to the network, which is expected when the cluster
+	// already existed.
+	output, err := i.run(ctx, nil, "docker", "network", "connect", "kind", registryName)
+	if err != nil && !strings.Contains(output, "already exists") {
+		return Result{}, fmt.Errorf("failed to connect the local registry to the kind network: %w", err)
+	}
+
+	return result, nil
+}
+
+func (i *Impl) createK3d(ctx context.Context, options Options) (Result, error) {
+	result := Result{
+		KubeContext: KubeContextName(ProviderK3d, options.ClusterName),
+		Registry:    fmt.Sprintf("localhost:%d", options.RegistryPort),
+	}
+
+	exists, err := i.clusterExists(ctx, options, "k3d", "cluster", "list", "--no-headers")
+	if err != nil {
+		return Result{}, err
+	}
+
+	if exists {
+		return result, nil
+	}
+
+	// Traefik is disabled because Radius installs Contour as the ingress controller. The k3d load balancer exposes
+	// the Contour service on the ingress port of the host.
+	_, err = i.run(ctx, nil, "k3d", "cluster", "create", options.ClusterName,
+		"--registry-create", fmt.Sprintf("%s:0.0.0.0:%d", registryContainerName(options.ClusterName), options.RegistryPort),
+		"-p", fmt.Sprintf("%d:80@loadbalancer", options.IngressPort),
+		"--k3s-arg", "--disable=traefik@server:0",
+		"--wait")
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create k3d cluster: %w", err)
+	}
+	result.Created = true
+
+	return result, nil
+}
+
+func (i *Impl) clusterExists(ctx context.Context, options Options, name string, args ...string) (bool, error) {
+	output, err := i.run(ctx, nil, name, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to list %s clusters: %w", options.Provider, err)
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[0] == options.ClusterName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// kindClusterConfig returns the kind cluster configuration. The configuration maps the ingress port of the host to
+// port 80 of the node, where Contour listens using host networking, and configures containerd to pull images pushed
+// to the local registry.
+func kindClusterConfig(options Options, registryName string) string {
+	return fmt.Sprintf(`kind: Cluster
+apiVersion: kind.x-k8s.io/v1alpha4
+containerdConfigPatches:
+- |-
+  [plugins."io.containerd.grpc.v1.cri".registry.mirrors."localhost:%[1]d"]
+    endpoint = ["http://%[2]s:5000"]
+nodes:
+- role: control-plane
+  kubeadmConfigPatches:
+  - |
+    kind: InitConfiguration
+    nodeRegistration:
+      kubeletExtraArgs:
+        node-labels: "ingress-ready=true"
+  extraPortMappings:
+  - containerPort: 80
+    hostPort: %[3]d
+    protocol: TCP
+`, options.RegistryPort, registryName, options.IngressPort)
+}
+
+func registryContainerName(clusterName string) string {
+	return clusterName + "-registry"
+}
+
+func runCommand(ctx context.Context, stdin io.Reader, name string, args ...string) (string, error) {
+	if _, err := exec.LookPath(name); err != nil {
+		return "", fmt.Errorf("%q must be installed and available on the PATH: %w", name, err)
+	}
+
+	output := bytes.Buffer{}
+	c := exec.CommandContext(ctx, name, args...)
+	c.Stdin = stdin
+	c.Stdout = &output
+	c.Stderr = &output
+
+	err := c.Run()
+	if err != nil {
+		return output.String(), fmt.Errorf("failed executing %q: %w, output: %s", name+" "+strings.Join(args, " "), err, output.String())
+	}
+
+	return output.String(), nil
+}
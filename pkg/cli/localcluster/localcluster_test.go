@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localcluster
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRunner struct {
+	commands []string
+	outputs  map[string]string
+	errors   map[string]error
+}
+
+func (f *fakeRunner) run(ctx context.Context, stdin io.Reader, name string, args ...string) (string, error) {
+	command := strings.Join(append([]string{name}, args...), " ")
+	f.commands = append(f.commands, command)
+	return f.outputs[command], f.errors[command]
+}
+
+func Test_Create_Kind(t *testing.T) {
+	t.Run("creates registry and cluster", func(t *testing.T) {
+		runner := &fakeRunner{
+			outputs: map[string]string{"kind get clusters": "other\n"},
+			errors:  map[string]error{"docker inspect -f {{.State.Running}} radius-registry": errors.New("not found")},
+		}
+		impl := &Impl{run: runner.run}
+
+		result, err := impl.Create(context.Background(), Options{Provider: ProviderKind})
+		require.NoError(t, err)
+		require.Equal(t, Result{KubeContext: "kind-radius", Registry: "localhost:5001", Created: true}, result)
+		require.Equal(t, []string{
+			"docker inspect -f {{.State.Running}} radius-registry",
+			"docker run -d --restart=always -p 127.0.0.1:5001:5000 --network bridge --name radius-registry registry:2",
+			"kind get clusters",
+			"kind create cluster --name radius --config - --wait 5m",
+			"docker network connect kind radius-registry",
+		}, runner.commands)
+	})
+
+	t.Run("existing cluster", func(t *testing.T) {
+		runner := &fakeRunner{
+			outputs: map[string]string{
+				"docker inspect -f {{.State.Running}} test-registry": "true\n",
+				"kind get clusters":                         "test\n",
+				"docker network connect kind test-registry": "endpoint with name test-registry already exists in network kind",
+			},
+			errors: map[string]error{"docker network connect kind test-registry": errors.New("exit status 1")},
+		}
+		impl := &Impl{run: runner.run}
+
+		result, err := impl.Create(context.Background(), Options{Provider: ProviderKind, ClusterName: "test"})
+		require.NoError(t, err)
+		require.Equal(t, Result{KubeContext: "kind-test", Registry: "localhost:5001"}, result)
+		require.Equal(t, []string{
+			"docker inspect -f {{.State.Running}} test-registry",
+			"kind get clusters",
+			"docker network connect kind test-registry",
+		}, runner.commands)
+	})
+}
+
+func Test_Create_K3d(t *testing.T) {
+	t.Run("creates cluster", func(t *testing.T) {
+		runner := &fakeRunner{}
+		impl := &Impl{run: runner.run}
+
+		result, err := impl.Create(context.Background(), Options{Provider: ProviderK3d, IngressPort: 9090})
+		require.NoError(t, err)
+		require.Equal(t, Result{KubeContext: "k3d-radius", Registry: "localhost:5001", Created: true}, result)
+		require.Equal(t, []string{
+			"k3d cluster list --no-headers",
+			"k3d cluster create radius --registry-create radius-registry:0.0.0.0:5001 -p 9090:80@loadbalancer --k3s-arg --disable=traefik@server:0 --wait",
+		}, runner.commands)
+	})
+
+	t.Run("existing cluster", func(t *testing.T) {
+		runner := &fakeRunner{
+			outputs: map[string]string{"k3d cluster list --no-headers": "radius   1/1       0/0      true\n"},
+		}
+		impl := &Impl{run: runner.run}
+
+		result, err := impl.Create(context.Background(), Options{Provider: ProviderK3d})
+		require.NoError(t, err)
+		require.False(t, result.Created)
+		require.Equal(t, []string{"k3d cluster list --no-headers"}, runner.commands)
+	})
+}
+
+func Test_Create_UnsupportedProvider(t *testing.T) {
+	impl := &Impl{run: (&fakeRunner{}).run}
+
+	_, err := impl.Create(context.Background(), Options{Provider: "minikube"})
+	require.EqualError(t, err, `unsupported local cluster provider "minikube"`)
+}
+
+func Test_kindClusterConfig(t *testing.T) {
+	config := kindClusterConfig(Options{RegistryPort: 5001, IngressPort: 8080}, "radius-registry")
+	require.Contains(t, config, `[plugins."io.containerd.grpc.v1.cri".registry.mirrors."localhost:5001"]`)
+	require.Contains(t, config, `endpoint = ["http://radius-registry:5000"]`)
+	require.Contains(t, config, "hostPort: 8080")
+}
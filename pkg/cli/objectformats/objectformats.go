@@ -73,3 +73,48 @@ func GetGenericResourceTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetResourceGraphTableFormat returns the table format for the dependencies and the dependents of a resource.
+func GetResourceGraphTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RELATIONSHIP",
+				JSONPath: "{ .Relationship }",
+			},
+			{
+				Heading:  "KIND",
+				JSONPath: "{ .Kind }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+		},
+	}
+}
+
+// GetResourceRevisionTableFormat returns the fields to output from a resource revision object.
+// This function should be used with the Go type GenericResourceRevision.
+func GetResourceRevisionTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "REVISION",
+				JSONPath: "{ .Revision }",
+			},
+			{
+				Heading:  "TIMESTAMP",
+				JSONPath: "{ .Timestamp }",
+			},
+			{
+				Heading:  "ETAG",
+				JSONPath: "{ .Etag }",
+			},
+		},
+	}
+}
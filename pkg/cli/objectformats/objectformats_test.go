@@ -19,6 +19,7 @@ package objectformats
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -62,3 +63,18 @@ func Test_GetGenericResourceTableFormat(t *testing.T) {
 	expected := "RESOURCE  TYPE       GROUP       STATE\ntest      test-type  test-group  Updating\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_GetResourceRevisionTableFormat(t *testing.T) {
+	obj := generated.GenericResourceRevision{
+		Revision:  to.Ptr(int32(2)),
+		Etag:      to.Ptr("etag-2"),
+		Timestamp: to.Ptr(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)),
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetResourceRevisionTableFormat())
+	require.NoError(t, err)
+
+	expected := "REVISION  TIMESTAMP                      ETAG\n2         2023-10-01 12:00:00 +0000 UTC  etag-2\n"
+	require.Equal(t, expected, buffer.String())
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	recipe_types "github.com/radius-project/radius/pkg/recipes"
+	rp_util "github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/version"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+const (
+	// RecipeRepositoryPrefix is the prefix for the repository path.
+	RecipeRepositoryPrefix = "ghcr.io/radius-project/recipes/local-dev/"
+)
+
+type DevRecipe struct {
+	// NormalizedName is the normalized name of the recipe.
+	//
+	// For example, "mongodatabases".
+	NormalizedName string
+
+	// ResourceType is the resource type of the recipe.
+	//
+	// For example, "Applications.Datastores/mongoDatabases".
+	ResourceType string
+
+	// RepoPath is the repository path of the recipe.
+	//
+	// For example, "ghcr.io/radius-project/recipes/local-dev/mongodatabases".
+	RepoPath string
+}
+
+// AvailableDevRecipes returns the list of available dev recipes.
+//
+// If we want to add a new recipe, we need to add it here.
+func AvailableDevRecipes() []DevRecipe {
+	return []DevRecipe{
+		{
+			"mongodatabases",
+			ds_ctrl.MongoDatabasesResourceType,
+			RecipeRepositoryPrefix + "mongodatabases",
+		},
+		{
+			"rediscaches",
+			ds_ctrl.RedisCachesResourceType,
+			RecipeRepositoryPrefix + "rediscaches",
+		},
+		{
+			"sqldatabases",
+			ds_ctrl.SqlDatabasesResourceType,
+			RecipeRepositoryPrefix + "sqldatabases",
+		},
+		{
+			"rabbitmqqueues",
+			msg_ctrl.RabbitMQQueuesResourceType,
+			RecipeRepositoryPrefix + "rabbitmqqueues",
+		},
+		{
+			"pubsubbrokers",
+			dapr_ctrl.DaprPubSubBrokersResourceType,
+			RecipeRepositoryPrefix + "pubsubbrokers",
+		},
+		{
+			"secretstores",
+			dapr_ctrl.DaprSecretStoresResourceType,
+			RecipeRepositoryPrefix + "secretstores",
+		},
+		{
+			"statestores",
+			dapr_ctrl.DaprStateStoresResourceType,
+			RecipeRepositoryPrefix + "statestores",
+		},
+	}
+}
+
+// DevRecipeIndex is the document stored in a custom dev recipe index. A dev recipe index is an OCI artifact that
+// lets an organization ship its own set of local-dev recipes instead of the ones built into the release.
+//
+// For example:
+//
+//	{
+//	  "recipes": {
+//	    "Applications.Datastores/redisCaches": {
+//	      "default": {
+//	        "templateKind": "bicep",
+//	        "templatePath": "myregistry.azurecr.io/recipes/redis:1.0"
+//	      }
+//	    }
+//	  }
+//	}
+type DevRecipeIndex struct {
+	// Recipes is a map of resource types to a map of recipe names to recipe definitions.
+	Recipes map[string]map[string]DevRecipeIndexEntry `json:"recipes"`
+}
+
+// DevRecipeIndexEntry is the definition of a recipe in a custom dev recipe index.
+type DevRecipeIndexEntry struct {
+	// TemplateKind is the kind of the recipe template, either "bicep" or "terraform".
+	TemplateKind string `json:"templateKind"`
+
+	// TemplatePath is the path of the recipe template.
+	TemplatePath string `json:"templatePath"`
+
+	// TemplateVersion is the version of the template. Only used for Terraform recipes.
+	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// PlainHTTP connects to the registry using HTTP (not-HTTPS). Only used for Bicep recipes.
+	PlainHTTP bool `json:"plainHttp,omitempty"`
+
+	// Driver is the name of the external recipe driver executing the recipe. Only used for external recipes.
+	Driver string `json:"driver,omitempty"`
+}
+
+//go:generate mockgen -typed -destination=./mock_devrecipeclient.go -package=setup -self_package github.com/radius-project/radius/pkg/cli/setup github.com/radius-project/radius/pkg/cli/setup DevRecipeClient
+type DevRecipeClient interface {
+	// GetDevRecipes returns the dev recipes to register in an environment. If index is empty the dev recipes built into
+	// the release are used, otherwise the dev recipes are read from the custom dev recipe index at the given OCI reference.
+	GetDevRecipes(ctx context.Context, index string) (map[string]map[string]corerp.RecipePropertiesClassification, error)
+}
+
+type devRecipeClient struct {
+}
+
+// NewDevRecipeClient creates a new DevRecipeClient object and returns it.
+func NewDevRecipeClient() DevRecipeClient {
+	return &devRecipeClient{}
+}
+
+// GetDevRecipes is a function that queries a registry for recipes with a specific tag and returns a map of recipes.
+// When a custom dev recipe index is specified, the recipes are read from the index instead. If an error occurs, an
+// error is returned.
+func (drc *devRecipeClient) GetDevRecipes(ctx context.Context, index string) (map[string]map[string]corerp.RecipePropertiesClassification, error) {
+	if index != "" {
+		return drc.getIndexRecipes(ctx, index)
+	}
+
+	// The tag will be the major.minor version of the release.
+	tag := version.Channel()
+	if version.IsEdgeChannel() {
+		tag = "latest"
+	}
+
+	validDevRecipes := map[string]map[string]corerp.RecipePropertiesClassification{}
+	for _, devRecipe := range AvailableDevRecipes() {
+		repo, err := remote.NewRepository(devRecipe.RepoPath)
+		if err != nil {
+			continue
+		}
+
+		// The descriptor and the ReadCloser that are returned by FetchReference are not used.
+		// If the tag does not exist, Not Found error is returned from the FetchReference function.
+		_, _, err = repo.FetchReference(ctx, tag)
+		if err == nil {
+			validDevRecipes[devRecipe.ResourceType] = getRecipeProperties(devRecipe, tag)
+		}
+	}
+
+	return validDevRecipes, nil
+}
+
+// getRecipeProperties returns the recipe properties for a specific recipe.
+func getRecipeProperties(devRecipe DevRecipe, tag string) map[string]corerp.RecipePropertiesClassification {
+	recipeName := "default"
+
+	return map[string]corerp.RecipePropertiesClassification{
+		recipeName: &corerp.BicepRecipeProperties{
+			TemplateKind: to.Ptr(recipe_types.TemplateKindBicep),
+			TemplatePath: to.Ptr(devRecipe.RepoPath + ":" + tag),
+		},
+	}
+}
+
+// getIndexRecipes reads the custom dev recipe index at the given OCI reference and returns its recipes.
+func (drc *devRecipeClient) getIndexRecipes(ctx context.Context, index string) (map[string]map[string]corerp.RecipePropertiesClassification, error) {
+	data := map[string]any{}
+	err := rp_util.ReadFromRegistry(ctx, recipe_types.EnvironmentDefinition{TemplatePath: index}, &data, auth.DefaultClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dev recipe index %q: %w", index, err)
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	recipes, err := ParseDevRecipeIndex(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dev recipe index %q: %w", index, err)
+	}
+
+	return recipes, nil
+}
+
+// ParseDevRecipeIndex parses and validates a custom dev recipe index document and returns its recipes in the format
+// used by environments.
+func ParseDevRecipeIndex(b []byte) (map[string]map[string]corerp.RecipePropertiesClassification, error) {
+	index := DevRecipeIndex{}
+	err := json.Unmarshal(b, &index)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(index.Recipes) == 0 {
+		return nil, fmt.Errorf("the index does not contain any recipes")
+	}
+
+	problems := []string{}
+	recipes := map[string]map[string]corerp.RecipePropertiesClassification{}
+	for resourceType, entries := range index.Recipes {
+		if !strings.Contains(resourceType, "/") {
+			problems = append(problems, fmt.Sprintf("%q is not a valid resource type", resourceType))
+			continue
+		}
+
+		recipes[resourceType] = map[string]corerp.RecipePropertiesClassification{}
+		for name, entry := range entries {
+			if entry.TemplatePath == "" {
+				problems = append(problems, fmt.Sprintf("recipe %q for %q must specify a templatePath", name, resourceType))
+				continue
+			}
+
+			switch entry.TemplateKind {
+			case recipe_types.TemplateKindBicep:
+				properties := &corerp.BicepRecipeProperties{
+					TemplateKind: to.Ptr(recipe_types.TemplateKindBicep),
+					TemplatePath: to.Ptr(entry.TemplatePath),
+				}
+				if entry.PlainHTTP {
+					properties.PlainHTTP = to.Ptr(true)
+				}
+				recipes[resourceType][name] = properties
+			case recipe_types.TemplateKindTerraform:
+				properties := &corerp.TerraformRecipeProperties{
+					TemplateKind: to.Ptr(recipe_types.TemplateKindTerraform),
+					TemplatePath: to.Ptr(entry.TemplatePath),
+				}
+				if entry.TemplateVersion != "" {
+					properties.TemplateVersion = to.Ptr(entry.TemplateVersion)
+				}
+				recipes[resourceType][name] = properties
+			case recipe_types.TemplateKindExternal:
+				if entry.Driver == "" {
+					problems = append(problems, fmt.Sprintf("recipe %q for %q must specify a driver", name, resourceType))
+					continue
+				}
+				recipes[resourceType][name] = &corerp.ExternalRecipeProperties{
+					TemplateKind: to.Ptr(recipe_types.TemplateKindExternal),
+					TemplatePath: to.Ptr(entry.TemplatePath),
+					Driver:       to.Ptr(entry.Driver),
+				}
+			default:
+				problems = append(problems, fmt.Sprintf("recipe %q for %q has unsupported templateKind %q, must be one of %v", name, resourceType, entry.TemplateKind, recipe_types.SupportedTemplateKind))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("%s", strings.Join(problems, ", "))
+	}
+
+	return recipes, nil
+}
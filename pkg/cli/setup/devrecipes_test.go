@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"testing"
+
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getRecipeProperties(t *testing.T) {
+	type args struct {
+		devRecipe DevRecipe
+		tag       string
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]corerp.RecipePropertiesClassification
+	}{
+		{
+			"Mongo Database Dev Recipe",
+			args{
+				DevRecipe{
+					"mongodatabases",
+					ds_ctrl.MongoDatabasesResourceType,
+					RecipeRepositoryPrefix + "mongodatabases",
+				},
+				"0.20",
+			},
+			map[string]corerp.RecipePropertiesClassification{
+				"default": &corerp.BicepRecipeProperties{
+					TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+					TemplatePath: to.Ptr(RecipeRepositoryPrefix + "mongodatabases:0.20"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getRecipeProperties(tt.args.devRecipe, tt.args.tag)
+			require.Equal(t, tt.want, got, "getRecipeProperties() = %v, want %v", got, tt.want)
+		})
+	}
+}
+
+func Test_ParseDevRecipeIndex(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		index := `{
+  "recipes": {
+    "Applications.Datastores/redisCaches": {
+      "default": {
+        "templateKind": "bicep",
+        "templatePath": "myregistry.azurecr.io/recipes/redis:1.0",
+        "plainHttp": true
+      }
+    },
+    "Applications.Datastores/sqlDatabases": {
+      "default": {
+        "templateKind": "terraform",
+        "templatePath": "Azure/sql/azurerm",
+        "templateVersion": "1.0.0"
+      }
+    }
+  }
+}`
+
+		got, err := ParseDevRecipeIndex([]byte(index))
+		require.NoError(t, err)
+
+		expected := map[string]map[string]corerp.RecipePropertiesClassification{
+			ds_ctrl.RedisCachesResourceType: {
+				"default": &corerp.BicepRecipeProperties{
+					TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+					TemplatePath: to.Ptr("myregistry.azurecr.io/recipes/redis:1.0"),
+					PlainHTTP:    to.Ptr(true),
+				},
+			},
+			ds_ctrl.SqlDatabasesResourceType: {
+				"default": &corerp.TerraformRecipeProperties{
+					TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+					TemplatePath:    to.Ptr("Azure/sql/azurerm"),
+					TemplateVersion: to.Ptr("1.0.0"),
+				},
+			},
+		}
+		require.Equal(t, expected, got)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := ParseDevRecipeIndex([]byte(`{"recipes": {}}`))
+		require.EqualError(t, err, "the index does not contain any recipes")
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParseDevRecipeIndex([]byte(`{`))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid entries", func(t *testing.T) {
+		index := `{
+  "recipes": {
+    "redisCaches": {
+      "default": {"templateKind": "bicep", "templatePath": "myregistry.azurecr.io/recipes/redis:1.0"}
+    },
+    "Applications.Datastores/sqlDatabases": {
+      "default": {"templateKind": "pulumi", "templatePath": "myregistry.azurecr.io/recipes/sql:1.0"},
+      "other": {"templateKind": "bicep"}
+    }
+  }
+}`
+
+		_, err := ParseDevRecipeIndex([]byte(index))
+		require.EqualError(t, err, `"redisCaches" is not a valid resource type, `+
+			`recipe "default" for "Applications.Datastores/sqlDatabases" has unsupported templateKind "pulumi", must be one of [bicep terraform external], `+
+			`recipe "other" for "Applications.Datastores/sqlDatabases" must specify a templatePath`)
+	})
+}
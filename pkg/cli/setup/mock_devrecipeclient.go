@@ -1,13 +1,13 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/radius-project/radius/pkg/cli/cmd/radinit (interfaces: DevRecipeClient)
+// Source: github.com/radius-project/radius/pkg/cli/setup (interfaces: DevRecipeClient)
 //
 // Generated by this command:
 //
-//	mockgen -typed -destination=./mock_devrecipeclient.go -package=radinit -self_package github.com/radius-project/radius/pkg/cli/cmd/radinit github.com/radius-project/radius/pkg/cli/cmd/radinit DevRecipeClient
+//	mockgen -typed -destination=./mock_devrecipeclient.go -package=setup -self_package github.com/radius-project/radius/pkg/cli/setup github.com/radius-project/radius/pkg/cli/setup DevRecipeClient
 //
 
-// Package radinit is a generated GoMock package.
-package radinit
+// Package setup is a generated GoMock package.
+package setup
 
 import (
 	context "context"
@@ -41,18 +41,18 @@ func (m *MockDevRecipeClient) EXPECT() *MockDevRecipeClientMockRecorder {
 }
 
 // GetDevRecipes mocks base method.
-func (m *MockDevRecipeClient) GetDevRecipes(arg0 context.Context) (map[string]map[string]v20231001preview.RecipePropertiesClassification, error) {
+func (m *MockDevRecipeClient) GetDevRecipes(arg0 context.Context, arg1 string) (map[string]map[string]v20231001preview.RecipePropertiesClassification, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDevRecipes", arg0)
+	ret := m.ctrl.Call(m, "GetDevRecipes", arg0, arg1)
 	ret0, _ := ret[0].(map[string]map[string]v20231001preview.RecipePropertiesClassification)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDevRecipes indicates an expected call of GetDevRecipes.
-func (mr *MockDevRecipeClientMockRecorder) GetDevRecipes(arg0 any) *MockDevRecipeClientGetDevRecipesCall {
+func (mr *MockDevRecipeClientMockRecorder) GetDevRecipes(arg0, arg1 any) *MockDevRecipeClientGetDevRecipesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevRecipes", reflect.TypeOf((*MockDevRecipeClient)(nil).GetDevRecipes), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevRecipes", reflect.TypeOf((*MockDevRecipeClient)(nil).GetDevRecipes), arg0, arg1)
 	return &MockDevRecipeClientGetDevRecipesCall{Call: call}
 }
 
@@ -68,13 +68,13 @@ func (c *MockDevRecipeClientGetDevRecipesCall) Return(arg0 map[string]map[string
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDevRecipeClientGetDevRecipesCall) Do(f func(context.Context) (map[string]map[string]v20231001preview.RecipePropertiesClassification, error)) *MockDevRecipeClientGetDevRecipesCall {
+func (c *MockDevRecipeClientGetDevRecipesCall) Do(f func(context.Context, string) (map[string]map[string]v20231001preview.RecipePropertiesClassification, error)) *MockDevRecipeClientGetDevRecipesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDevRecipeClientGetDevRecipesCall) DoAndReturn(f func(context.Context) (map[string]map[string]v20231001preview.RecipePropertiesClassification, error)) *MockDevRecipeClientGetDevRecipesCall {
+func (c *MockDevRecipeClientGetDevRecipesCall) DoAndReturn(f func(context.Context, string) (map[string]map[string]v20231001preview.RecipePropertiesClassification, error)) *MockDevRecipeClientGetDevRecipesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
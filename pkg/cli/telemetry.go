@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"github.com/spf13/viper"
+)
+
+const (
+	// TelemetryKey is the key used for the telemetry section of the config.
+	TelemetryKey string = "telemetry"
+)
+
+// TelemetrySection is the section of the config that controls the anonymous usage reporting of the CLI. Telemetry is
+// disabled unless the user opts in.
+type TelemetrySection struct {
+	// Enabled is true if the user has opted in to anonymous usage reporting.
+	Enabled bool `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+
+	// Endpoint is the URL that batches of usage events are uploaded to. Events are kept in the local queue until an
+	// endpoint is configured.
+	Endpoint string `json:"endpoint,omitempty" mapstructure:"endpoint" yaml:"endpoint,omitempty"`
+}
+
+// ReadTelemetrySection reads the TelemetrySection from the given viper instance. If the section is not present, a
+// section with telemetry disabled is returned.
+func ReadTelemetrySection(v *viper.Viper) (TelemetrySection, error) {
+	section := TelemetrySection{}
+	if v == nil || !v.IsSet(TelemetryKey) {
+		return section, nil
+	}
+
+	err := v.UnmarshalKey(TelemetryKey, &section)
+	if err != nil {
+		return TelemetrySection{}, err
+	}
+
+	return section, nil
+}
+
+// UpdateTelemetrySection updates the TelemetryKey in the given viper instance with the given TelemetrySection.
+func UpdateTelemetrySection(v *viper.Viper, section TelemetrySection) {
+	v.Set(TelemetryKey, section)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telemetry
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gofrs/flock"
+)
+
+const (
+	// MaxQueuedEvents is the maximum number of events kept in the local queue. The oldest events are dropped when
+	// the queue is full, for example when no endpoint is configured or uploads keep failing.
+	MaxQueuedEvents = 500
+)
+
+// Queue is a local file-based queue of usage events. Each line of the file is a JSON-encoded event.
+type Queue struct {
+	// Path is the path of the queue file.
+	Path string
+}
+
+// Append adds the event to the queue and returns the number of queued events.
+func (q *Queue) Append(event Event) (int, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	events, err := q.read()
+	if err != nil {
+		return 0, err
+	}
+
+	events = append(events, event)
+	if len(events) > MaxQueuedEvents {
+		events = events[len(events)-MaxQueuedEvents:]
+	}
+
+	err = q.write(events)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(events), nil
+}
+
+// Read returns the queued events, ordered from oldest to newest.
+func (q *Queue) Read() ([]Event, error) {
+	unlock, err := q.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return q.read()
+}
+
+// Clear removes all events from the queue.
+func (q *Queue) Clear() error {
+	unlock, err := q.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = os.Remove(q.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (q *Queue) lock() (func(), error) {
+	err := os.MkdirAll(filepath.Dir(q.Path), 0755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory for telemetry queue: %w", err)
+	}
+
+	fileLock := flock.New(q.Path + ".lock")
+	err = fileLock.Lock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock on '%s': %w", q.Path, err)
+	}
+
+	return func() { _ = fileLock.Unlock() }, nil
+}
+
+func (q *Queue) read() ([]Event, error) {
+	b, err := os.ReadFile(q.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Event{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	events := []Event{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		event := Event{}
+		// Skip lines that cannot be decoded, for example a line truncated by an interrupted write.
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	return events, scanner.Err()
+}
+
+func (q *Queue) write(events []Event) error {
+	buf := bytes.Buffer{}
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(q.Path, buf.Bytes(), 0644)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telemetry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Queue(t *testing.T) {
+	queue := &Queue{Path: filepath.Join(t.TempDir(), "telemetry", "events.jsonl")}
+
+	events, err := queue.Read()
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	count, err := queue.Append(Event{Command: "rad deploy"})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = queue.Append(Event{Command: "rad run"})
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	events, err = queue.Read()
+	require.NoError(t, err)
+	require.Equal(t, []Event{{Command: "rad deploy"}, {Command: "rad run"}}, events)
+
+	err = queue.Clear()
+	require.NoError(t, err)
+
+	events, err = queue.Read()
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// Clearing an empty queue is not an error.
+	err = queue.Clear()
+	require.NoError(t, err)
+}
+
+func Test_Queue_DropsOldestEvents(t *testing.T) {
+	queue := &Queue{Path: filepath.Join(t.TempDir(), "events.jsonl")}
+
+	for i := 0; i < MaxQueuedEvents+5; i++ {
+		_, err := queue.Append(Event{DurationMs: int64(i)})
+		require.NoError(t, err)
+	}
+
+	events, err := queue.Read()
+	require.NoError(t, err)
+	require.Len(t, events, MaxQueuedEvents)
+	require.Equal(t, int64(5), events[0].DurationMs)
+}
+
+func Test_Queue_SkipsInvalidLines(t *testing.T) {
+	queue := &Queue{Path: filepath.Join(t.TempDir(), "events.jsonl")}
+
+	err := os.WriteFile(queue.Path, []byte("{\"command\":\"rad deploy\"}\n{\"command\":\n"), 0644)
+	require.NoError(t, err)
+
+	events, err := queue.Read()
+	require.NoError(t, err)
+	require.Equal(t, []Event{{Command: "rad deploy"}}, events)
+}
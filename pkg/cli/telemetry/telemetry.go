@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/version"
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultBatchSize is the number of queued events that triggers an upload.
+	DefaultBatchSize = 20
+
+	// uploadTimeout is the maximum time spent uploading a batch of events, so that telemetry never slows down the CLI
+	// noticeably.
+	uploadTimeout = 3 * time.Second
+)
+
+// Event is an anonymous usage event for a single invocation of the CLI. Events never contain arguments, flag values,
+// names of resources, or any other identifiers.
+type Event struct {
+	// Command is the path of the command that was run, for example "rad resource show".
+	Command string `json:"command"`
+
+	// DurationMs is the duration of the command in milliseconds.
+	DurationMs int64 `json:"durationMs"`
+
+	// Success is true if the command completed without an error.
+	Success bool `json:"success"`
+
+	// Version is the version of the CLI.
+	Version string `json:"version"`
+
+	// OS is the operating system of the CLI.
+	OS string `json:"os"`
+
+	// Arch is the architecture of the CLI.
+	Arch string `json:"arch"`
+
+	// Timestamp is the time at which the command completed.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewEvent creates an event for the command path with the given duration and result.
+func NewEvent(command string, duration time.Duration, success bool) Event {
+	return Event{
+		Command:    command,
+		DurationMs: duration.Milliseconds(),
+		Success:    success,
+		Version:    version.Version(),
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Timestamp:  time.Now().UTC(),
+	}
+}
+
+// QueuePath returns the path of the local event queue, which is stored next to the config file.
+func QueuePath(config *viper.Viper) (string, error) {
+	configFilePath, err := cli.GetConfigFilePath(config)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(filepath.Dir(configFilePath), "telemetry", "events.jsonl"), nil
+}
+
+// Recorder records usage events in the local queue and uploads them in batches.
+type Recorder struct {
+	// Queue is the local event queue.
+	Queue *Queue
+
+	// HTTPClient is the client used to upload events.
+	HTTPClient *http.Client
+
+	// BatchSize is the number of queued events that triggers an upload.
+	BatchSize int
+}
+
+// NewRecorder creates a Recorder that uses the local event queue of the config.
+func NewRecorder(config *viper.Viper) (*Recorder, error) {
+	path, err := QueuePath(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		Queue:      &Queue{Path: path},
+		HTTPClient: &http.Client{Timeout: uploadTimeout},
+		BatchSize:  DefaultBatchSize,
+	}, nil
+}
+
+// Record adds the event to the local queue when telemetry is enabled. Once the queue holds a full batch of events
+// and an endpoint is configured, the queued events are uploaded and removed from the queue.
+func (r *Recorder) Record(ctx context.Context, section cli.TelemetrySection, event Event) error {
+	if !section.Enabled {
+		return nil
+	}
+
+	count, err := r.Queue.Append(event)
+	if err != nil {
+		return err
+	}
+
+	if section.Endpoint == "" || count < r.BatchSize {
+		return nil
+	}
+
+	return r.Flush(ctx, section.Endpoint)
+}
+
+// Flush uploads all queued events to the endpoint and removes them from the queue.
+func (r *Recorder) Flush(ctx context.Context, endpoint string) error {
+	events, err := r.Queue.Read()
+	if err != nil {
+		return err
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	err = r.upload(ctx, endpoint, events)
+	if err != nil {
+		return err
+	}
+
+	return r.Queue.Clear()
+}
+
+func (r *Recorder) upload(ctx context.Context, endpoint string, events []Event) error {
+	b, err := json.Marshal(map[string]any{"events": events})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, uploadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload telemetry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to upload telemetry: unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewEvent(t *testing.T) {
+	event := NewEvent("rad deploy", 1500*time.Millisecond, true)
+	require.Equal(t, "rad deploy", event.Command)
+	require.Equal(t, int64(1500), event.DurationMs)
+	require.True(t, event.Success)
+	require.NotEmpty(t, event.Version)
+	require.NotEmpty(t, event.OS)
+	require.NotEmpty(t, event.Arch)
+}
+
+func Test_QueuePath(t *testing.T) {
+	config := viper.New()
+	config.SetConfigFile(filepath.Join("home", ".rad", "config.yaml"))
+
+	path, err := QueuePath(config)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("home", ".rad", "telemetry", "events.jsonl"), path)
+}
+
+func Test_Recorder_Record(t *testing.T) {
+	uploads := [][]Event{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			Events []Event `json:"events"`
+		}{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		uploads = append(uploads, body.Events)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	newRecorder := func(t *testing.T) *Recorder {
+		return &Recorder{
+			Queue:      &Queue{Path: filepath.Join(t.TempDir(), "events.jsonl")},
+			HTTPClient: server.Client(),
+			BatchSize:  2,
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		recorder := newRecorder(t)
+
+		err := recorder.Record(context.Background(), cli.TelemetrySection{Endpoint: server.URL}, Event{Command: "rad deploy"})
+		require.NoError(t, err)
+
+		events, err := recorder.Queue.Read()
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("no endpoint", func(t *testing.T) {
+		recorder := newRecorder(t)
+
+		for i := 0; i < 3; i++ {
+			err := recorder.Record(context.Background(), cli.TelemetrySection{Enabled: true}, Event{Command: "rad deploy"})
+			require.NoError(t, err)
+		}
+
+		events, err := recorder.Queue.Read()
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+	})
+
+	t.Run("uploads batch", func(t *testing.T) {
+		uploads = [][]Event{}
+		recorder := newRecorder(t)
+		section := cli.TelemetrySection{Enabled: true, Endpoint: server.URL}
+
+		err := recorder.Record(context.Background(), section, Event{Command: "rad deploy"})
+		require.NoError(t, err)
+		require.Empty(t, uploads)
+
+		err = recorder.Record(context.Background(), section, Event{Command: "rad run"})
+		require.NoError(t, err)
+		require.Equal(t, [][]Event{{{Command: "rad deploy"}, {Command: "rad run"}}}, uploads)
+
+		events, err := recorder.Queue.Read()
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("failed upload keeps events", func(t *testing.T) {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer failing.Close()
+
+		recorder := newRecorder(t)
+		section := cli.TelemetrySection{Enabled: true, Endpoint: failing.URL}
+
+		err := recorder.Record(context.Background(), section, Event{Command: "rad deploy"})
+		require.NoError(t, err)
+
+		err = recorder.Record(context.Background(), section, Event{Command: "rad run"})
+		require.EqualError(t, err, "failed to upload telemetry: unexpected status code 500")
+
+		events, err := recorder.Queue.Read()
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+	})
+}
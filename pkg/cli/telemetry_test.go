@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TelemetrySection(t *testing.T) {
+	t.Run("missing section is disabled", func(t *testing.T) {
+		section, err := ReadTelemetrySection(viper.New())
+		require.NoError(t, err)
+		require.Equal(t, TelemetrySection{}, section)
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		configFilePath := filepath.Join(t.TempDir(), "config.yaml")
+		v := viper.New()
+		v.SetConfigFile(configFilePath)
+
+		UpdateTelemetrySection(v, TelemetrySection{Enabled: true, Endpoint: "https://example.com/events"})
+		section, err := ReadTelemetrySection(v)
+		require.NoError(t, err)
+		require.Equal(t, TelemetrySection{Enabled: true, Endpoint: "https://example.com/events"}, section)
+
+		err = SaveConfig(v)
+		require.NoError(t, err)
+
+		v, err = LoadConfig(configFilePath)
+		require.NoError(t, err)
+
+		section, err = ReadTelemetrySection(v)
+		require.NoError(t, err)
+		require.Equal(t, TelemetrySection{Enabled: true, Endpoint: "https://example.com/events"}, section)
+	})
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Masterminds/semver"
+)
+
+const (
+	// DefaultReleaseFeedURL is the URL of the feed listing the releases of Radius. It is the same feed used by the
+	// install scripts.
+	DefaultReleaseFeedURL = "https://api.github.com/repos/radius-project/radius/releases"
+
+	// installScriptURL is the URL of the install script of the rad CLI for Linux and macOS.
+	installScriptURL = "https://raw.githubusercontent.com/radius-project/radius/main/deploy/install.sh"
+
+	// installPowerShellScriptURL is the URL of the install script of the rad CLI for Windows.
+	installPowerShellScriptURL = "https://raw.githubusercontent.com/radius-project/radius/main/deploy/install.ps1"
+
+	// requestTimeout is the maximum time spent querying the release feed.
+	requestTimeout = 10 * time.Second
+)
+
+// release is an entry of the release feed.
+type release struct {
+	TagName    string `json:"tag_name"`
+	Draft      bool   `json:"draft"`
+	Prerelease bool   `json:"prerelease"`
+}
+
+// Feed queries the release feed of Radius.
+type Feed struct {
+	// URL is the URL of the release feed.
+	URL string
+
+	// HTTPClient is the client used to query the release feed.
+	HTTPClient *http.Client
+}
+
+// NewFeed creates a Feed for the default release feed.
+func NewFeed() *Feed {
+	return &Feed{
+		URL:        DefaultReleaseFeedURL,
+		HTTPClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// LatestVersion returns the most recent stable release of Radius. Drafts, pre-releases and tags that are not valid
+// semver are ignored.
+func (f *Feed) LatestVersion(ctx context.Context) (*semver.Version, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the release feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to query the release feed: unexpected status code %d", resp.StatusCode)
+	}
+
+	releases := []release{}
+	err = json.NewDecoder(resp.Body).Decode(&releases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the release feed: %w", err)
+	}
+
+	var latest *semver.Version
+	for _, r := range releases {
+		if r.Draft || r.Prerelease {
+			continue
+		}
+
+		v, err := semver.NewVersion(r.TagName)
+		if err != nil || v.Prerelease() != "" {
+			continue
+		}
+
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+		}
+	}
+
+	if latest == nil {
+		return nil, errors.New("the release feed does not contain a stable release")
+	}
+
+	return latest, nil
+}
+
+// CLIUpgradeCommand returns the command that installs the given version of the rad CLI on the operating system,
+// which uses the values of runtime.GOOS.
+func CLIUpgradeCommand(version *semver.Version, goos string) string {
+	if goos == "windows" {
+		return fmt.Sprintf(`$script=iwr -useb %s; $block=[ScriptBlock]::Create($script); invoke-command -ScriptBlock $block -ArgumentList %s`, installPowerShellScriptURL, version.String())
+	}
+
+	return fmt.Sprintf("curl -fsSL %q | /bin/bash -s %s", installScriptURL, version.String())
+}
+
+// ControlPlaneUpgradeCommand returns the command that upgrades the Radius control plane in the Kubernetes context
+// to the version of the rad CLI running the command. An empty context denotes the current Kubernetes context.
+func ControlPlaneUpgradeCommand(kubeContext string) string {
+	if kubeContext == "" {
+		return "rad install kubernetes --reinstall"
+	}
+
+	return fmt.Sprintf("rad install kubernetes --reinstall --kubecontext %s", kubeContext)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Masterminds/semver"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFeed(t *testing.T, statusCode int, body string) *Feed {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return &Feed{URL: server.URL, HTTPClient: server.Client()}
+}
+
+func Test_LatestVersion(t *testing.T) {
+	t.Run("latest stable release", func(t *testing.T) {
+		feed := newTestFeed(t, http.StatusOK, `[
+			{"tag_name": "v0.44.0-rc1", "prerelease": true},
+			{"tag_name": "v0.43.0-rc2"},
+			{"tag_name": "v0.45.0", "draft": true},
+			{"tag_name": "v0.42.1"},
+			{"tag_name": "v0.43.0"},
+			{"tag_name": "edge"}
+		]`)
+
+		latest, err := feed.LatestVersion(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "0.43.0", latest.String())
+	})
+
+	t.Run("no stable release", func(t *testing.T) {
+		feed := newTestFeed(t, http.StatusOK, `[{"tag_name": "v0.44.0-rc1", "prerelease": true}]`)
+
+		_, err := feed.LatestVersion(context.Background())
+		require.ErrorContains(t, err, "does not contain a stable release")
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		feed := newTestFeed(t, http.StatusForbidden, `{"message": "rate limit exceeded"}`)
+
+		_, err := feed.LatestVersion(context.Background())
+		require.ErrorContains(t, err, "unexpected status code 403")
+	})
+}
+
+func Test_CLIUpgradeCommand(t *testing.T) {
+	version := semver.MustParse("0.43.0")
+
+	require.Equal(t, `curl -fsSL "https://raw.githubusercontent.com/radius-project/radius/main/deploy/install.sh" | /bin/bash -s 0.43.0`, CLIUpgradeCommand(version, "linux"))
+	require.Contains(t, CLIUpgradeCommand(version, "windows"), "install.ps1")
+	require.Contains(t, CLIUpgradeCommand(version, "windows"), "-ArgumentList 0.43.0")
+}
+
+func Test_ControlPlaneUpgradeCommand(t *testing.T) {
+	require.Equal(t, "rad install kubernetes --reinstall", ControlPlaneUpgradeCommand(""))
+	require.Equal(t, "rad install kubernetes --reinstall --kubecontext kind-kind", ControlPlaneUpgradeCommand("kind-kind"))
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaces
+
+import (
+	"github.com/Masterminds/semver"
+)
+
+// Compatibility records the compatibility of the rad CLI with the Radius installation of a workspace. It is
+// recorded when the workspace is created so that commands can detect version skew without querying the cluster.
+type Compatibility struct {
+	// RadiusVersion is the version of Radius installed on the control plane of the workspace.
+	RadiusVersion string `json:"radiusVersion,omitempty" mapstructure:"radiusVersion" yaml:"radiusVersion,omitempty"`
+
+	// CLIVersion is the version of the rad CLI that verified the Radius installation.
+	CLIVersion string `json:"cliVersion,omitempty" mapstructure:"cliVersion" yaml:"cliVersion,omitempty"`
+
+	// Compatible denotes whether the features of the rad CLI are supported by the Radius installation.
+	Compatible bool `json:"compatible" mapstructure:"compatible" yaml:"compatible"`
+}
+
+// NewCompatibility creates the compatibility record for the given Radius and rad CLI versions.
+func NewCompatibility(radiusVersion string, cliVersion string) *Compatibility {
+	return &Compatibility{
+		RadiusVersion: radiusVersion,
+		CLIVersion:    cliVersion,
+		Compatible:    IsCompatibleVersion(radiusVersion, cliVersion),
+	}
+}
+
+// IsCompatibleVersion returns true if the rad CLI version is compatible with the Radius version, which is the case
+// when both versions have the same major and minor version. Versions which are not valid semver are never compatible.
+func IsCompatibleVersion(radiusVersion string, cliVersion string) bool {
+	radius, err := semver.NewVersion(radiusVersion)
+	if err != nil {
+		return false
+	}
+
+	cli, err := semver.NewVersion(cliVersion)
+	if err != nil {
+		return false
+	}
+
+	return radius.Major() == cli.Major() && radius.Minor() == cli.Minor()
+}
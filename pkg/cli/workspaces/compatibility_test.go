@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaces
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsCompatibleVersion(t *testing.T) {
+	tests := []struct {
+		radiusVersion string
+		cliVersion    string
+		expected      bool
+	}{
+		{radiusVersion: "0.40.0", cliVersion: "0.40.0", expected: true},
+		{radiusVersion: "0.40.0", cliVersion: "0.40.2", expected: true},
+		{radiusVersion: "v0.40.1", cliVersion: "0.40.0-rc1", expected: true},
+		{radiusVersion: "0.39.0", cliVersion: "0.40.0", expected: false},
+		{radiusVersion: "1.40.0", cliVersion: "0.40.0", expected: false},
+		{radiusVersion: "", cliVersion: "0.40.0", expected: false},
+		{radiusVersion: "0.40.0", cliVersion: "edge", expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.radiusVersion+"/"+tc.cliVersion, func(t *testing.T) {
+			require.Equal(t, tc.expected, IsCompatibleVersion(tc.radiusVersion, tc.cliVersion))
+		})
+	}
+}
+
+func Test_NewCompatibility(t *testing.T) {
+	compatibility := NewCompatibility("0.40.0", "0.40.1")
+	require.Equal(t, &Compatibility{RadiusVersion: "0.40.0", CLIVersion: "0.40.1", Compatible: true}, compatibility)
+}
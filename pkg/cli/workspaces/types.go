@@ -51,6 +51,9 @@ type Workspace struct {
 
 	// Scope represents the default scope used for deployments of Radius resources. This field is optional.
 	Scope string `json:"scope,omitempty" mapstructure:"scope" yaml:"scope,omitempty"`
+
+	// Compatibility records the compatibility of the rad CLI with the Radius installation of the workspace. This field is optional.
+	Compatibility *Compatibility `json:"compatibility,omitempty" mapstructure:"compatibility" yaml:"compatibility,omitempty"`
 }
 
 // IsNamedWorkspace returns true for workspaces stored in per-user configuration. These workspaces have names that can
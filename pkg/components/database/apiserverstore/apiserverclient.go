@@ -26,8 +26,9 @@ limitations under the License.
 // consider the possibility of multiple resources being present when reading.
 //
 // Each Kubernetes Resource object stores a list of UCP resources. Since we use SHA1 to generate hashes,
-// we expect collisions to be extremely rare. The only case we need to be concerned about is when collisions
-// cause the total size of the Kubernetes Resource object to be larger than the 8mb limit of Kubernetes.
+// we expect collisions to be extremely rare. The size of a Kubernetes object is limited by etcd (1.5MiB by
+// default), so we gzip-compress the data of large entries and reject writes that would exceed the limit
+// with a clear error instead of relying on the API Server to fail the request.
 //
 // This scheme allows us to perform O(1) reads and writes for key-based lookups while still handling
 // collisions.
@@ -41,10 +42,13 @@ limitations under the License.
 package apiserverstore
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/sha1"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"unicode"
 
@@ -80,8 +84,29 @@ const (
 	// RetryCount is the number of retries we will make on optimistic concurrency failures. The need for retries is **rare** because
 	// it only happens on concurrent operations to the same UCP resource or on a hash collision.
 	RetryCount = 10
+
+	// QueryChunkSize is the maximum number of Kubernetes objects we request from the API Server in a single
+	// list call. Queries are read in chunks so we never need to hold the entire collection in memory at once.
+	QueryChunkSize = 250
+
+	// CompressionThreshold is the size in bytes of an entry's data above which the data will be stored compressed.
+	CompressionThreshold = 32 * 1024
+
+	// MaxObjectSize is the maximum size in bytes of a Kubernetes object that we will write. This matches the default
+	// request size limit of etcd.
+	MaxObjectSize = 1536 * 1024
+
+	// ContentTypeGzipJSON is the content type of an entry that stores its data as gzip-compressed JSON. Entries
+	// without a content type store their data as plain JSON.
+	ContentTypeGzipJSON = "application/json+gzip"
 )
 
+// compressedData is the format used to store the data of a compressed entry. The CRD requires data to be an object
+// so the compressed bytes are wrapped rather than stored directly.
+type compressedData struct {
+	Gzip []byte `json:"gzip"`
+}
+
 // NewAPIServerClient creates a new APIServerClient object which is used to interact with the API server.
 func NewAPIServerClient(client runtimeclient.Client, namespace string) *APIServerClient {
 	return &APIServerClient{client: client, namespace: namespace}
@@ -115,14 +140,57 @@ func (c *APIServerClient) Query(ctx context.Context, query database.Query, optio
 		return nil, err
 	}
 
-	rs := ucpv1alpha1.ResourceList{}
-	err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
-	if err != nil {
-		return nil, err
+	config := database.NewQueryConfig(options...)
+
+	limit := int64(QueryChunkSize)
+	if config.MaxQueryItemCount > 0 && config.MaxQueryItemCount < QueryChunkSize {
+		limit = int64(config.MaxQueryItemCount)
 	}
 
 	results := database.ObjectQueryResult{}
-	for _, resource := range rs.Items {
+	continueToken := config.PaginationToken
+	for {
+		rs := ucpv1alpha1.ResourceList{}
+		err = c.client.List(ctx, &rs,
+			runtimeclient.InNamespace(c.namespace),
+			runtimeclient.MatchingLabelsSelector{Selector: selector},
+			runtimeclient.Limit(limit),
+			runtimeclient.Continue(continueToken))
+		if err != nil && continueToken != "" && apierrors.IsResourceExpired(err) {
+			return nil, &database.ErrInvalid{Message: "invalid argument. 'query.PaginationToken' has expired."}
+		} else if err != nil && continueToken != "" && apierrors.IsBadRequest(err) {
+			return nil, &database.ErrInvalid{Message: "invalid argument. 'query.PaginationToken' is invalid."}
+		} else if err != nil {
+			return nil, err
+		}
+
+		items, err := queryItems(ctx, rs.Items, query)
+		if err != nil {
+			return nil, err
+		}
+		results.Items = append(results.Items, items...)
+
+		// The continue token returned by the API Server encodes the resource version of the list, so following
+		// it gives us a consistent view of the collection across chunks. We can only stop on a chunk boundary
+		// so the result may contain slightly more items than requested.
+		continueToken = rs.Continue
+		if continueToken == "" {
+			break
+		}
+
+		if config.MaxQueryItemCount > 0 && len(results.Items) >= config.MaxQueryItemCount {
+			results.PaginationToken = continueToken
+			break
+		}
+	}
+
+	return &results, nil
+}
+
+// queryItems returns the entries of the given Kubernetes objects that match the query.
+func queryItems(ctx context.Context, items []ucpv1alpha1.Resource, query database.Query) ([]database.Object, error) {
+	results := []database.Object{}
+	for _, resource := range items {
 		for _, entry := range resource.Entries {
 			id, err := resources.Parse(entry.ID)
 			if err != nil {
@@ -146,12 +214,12 @@ func (c *APIServerClient) Query(ctx context.Context, query database.Query, optio
 					continue
 				}
 
-				results.Items = append(results.Items, *converted)
+				results = append(results, *converted)
 			}
 		}
 	}
 
-	return &results, nil
+	return results, nil
 }
 
 // Get retrieves an object from the store given its ID, or returns an error if the object does not exist or if an error occurs.
@@ -327,12 +395,19 @@ func (c *APIServerClient) Save(ctx context.Context, obj *database.Object, option
 
 		resource.Labels = assignLabels(&resource)
 
+		err = validateSize(&resource, obj.ID)
+		if err != nil {
+			return false, err
+		}
+
 		c.synchronize()
 
 		if found {
 			err = c.client.Update(ctx, &resource)
 			if err != nil && apierrors.IsConflict(err) {
 				return true, err // Retry this!
+			} else if err != nil && apierrors.IsRequestEntityTooLargeError(err) {
+				return false, &database.ErrInvalid{Message: fmt.Sprintf("resource %q is too large to store: %s", obj.ID, err.Error())}
 			} else if err != nil {
 				return false, err
 			}
@@ -342,6 +417,8 @@ func (c *APIServerClient) Save(ctx context.Context, obj *database.Object, option
 				return true, err // Retry this!
 			} else if err != nil && apierrors.IsAlreadyExists(err) {
 				return true, err // Retry this!
+			} else if err != nil && apierrors.IsRequestEntityTooLargeError(err) {
+				return false, &database.ErrInvalid{Message: fmt.Sprintf("resource %q is too large to store: %s", obj.ID, err.Error())}
 			} else if err != nil {
 				return false, err
 			}
@@ -525,8 +602,13 @@ func findIndex(resource *ucpv1alpha1.Resource, id resources.ID) *int {
 }
 
 func readEntry(entry *ucpv1alpha1.ResourceEntry) (*database.Object, error) {
+	raw, err := entryData(entry)
+	if err != nil {
+		return nil, err
+	}
+
 	var data any
-	err := json.Unmarshal(entry.Data.Raw, &data)
+	err = json.Unmarshal(raw, &data)
 	if err != nil {
 		return nil, err
 	}
@@ -542,6 +624,36 @@ func readEntry(entry *ucpv1alpha1.ResourceEntry) (*database.Object, error) {
 	return &obj, nil
 }
 
+// entryData returns the JSON data of an entry, decompressing it if necessary.
+func entryData(entry *ucpv1alpha1.ResourceEntry) ([]byte, error) {
+	if entry.Data == nil {
+		return []byte("null"), nil
+	}
+
+	if entry.ContentType != ContentTypeGzipJSON {
+		return entry.Data.Raw, nil
+	}
+
+	compressed := compressedData{}
+	err := json.Unmarshal(entry.Data.Raw, &compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compressed data for resource %q: %w", entry.ID, err)
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed.Gzip))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress data for resource %q: %w", entry.ID, err)
+	}
+	defer reader.Close()
+
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress data for resource %q: %w", entry.ID, err)
+	}
+
+	return raw, nil
+}
+
 func read(resource *ucpv1alpha1.Resource, id resources.ID) (*database.Object, error) {
 	for _, entry := range resource.Entries {
 		if strings.EqualFold(entry.ID, id.String()) {
@@ -564,5 +676,46 @@ func convert(obj *database.Object) (*ucpv1alpha1.ResourceEntry, error) {
 		Data: &runtime.RawExtension{Raw: raw},
 	}
 
+	if len(raw) > CompressionThreshold {
+		compressed, err := compress(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		resource.ContentType = ContentTypeGzipJSON
+		resource.Data = &runtime.RawExtension{Raw: compressed}
+	}
+
 	return &resource, nil
 }
+
+// compress gzip-compresses the given data and wraps it in the format stored in the data of an entry.
+func compress(raw []byte) ([]byte, error) {
+	buf := bytes.Buffer{}
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(compressedData{Gzip: buf.Bytes()})
+}
+
+// validateSize returns an error if the Kubernetes object is too large to be stored.
+func validateSize(resource *ucpv1alpha1.Resource, id string) error {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+
+	if len(b) > MaxObjectSize {
+		return &database.ErrInvalid{Message: fmt.Sprintf("resource %q is too large to store: the stored object is %d bytes, which exceeds the limit of %d bytes", id, len(b), MaxObjectSize)}
+	}
+
+	return nil
+}
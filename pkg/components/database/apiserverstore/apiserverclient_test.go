@@ -17,6 +17,8 @@ limitations under the License.
 package apiserverstore
 
 import (
+	"context"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -857,3 +859,168 @@ func Test_CreateLabelSelector_ScopeQuery(t *testing.T) {
 	set = assignLabels(&resource)
 	require.True(t, selector.Matches(set))
 }
+
+func Test_Convert_CompressesLargeData(t *testing.T) {
+	data := map[string]any{
+		"value": strings.Repeat("a", CompressionThreshold+1),
+	}
+	obj := database.Object{
+		Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app"},
+		Data:     data,
+	}
+
+	entry, err := convert(&obj)
+	require.NoError(t, err)
+	require.Equal(t, ContentTypeGzipJSON, entry.ContentType)
+	require.Equal(t, etag.New(shared.MarshalOrPanic(data)), entry.ETag)
+	require.Less(t, len(entry.Data.Raw), CompressionThreshold)
+
+	read, err := readEntry(entry)
+	require.NoError(t, err)
+	require.Equal(t, obj.ID, read.ID)
+	require.Equal(t, entry.ETag, read.ETag)
+	require.Equal(t, map[string]any{"value": data["value"]}, read.Data)
+}
+
+func Test_Convert_SmallDataIsNotCompressed(t *testing.T) {
+	data := map[string]any{
+		"value": "a",
+	}
+	obj := database.Object{
+		Metadata: database.Metadata{ID: "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app"},
+		Data:     data,
+	}
+
+	entry, err := convert(&obj)
+	require.NoError(t, err)
+	require.Empty(t, entry.ContentType)
+	require.Equal(t, shared.MarshalOrPanic(data), entry.Data.Raw)
+}
+
+func Test_ReadEntry_InvalidCompressedData(t *testing.T) {
+	entry := ucpv1alpha1.ResourceEntry{
+		ID:          "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app",
+		ContentType: ContentTypeGzipJSON,
+		Data:        &runtime.RawExtension{Raw: []byte(`{"gzip":"bm90IGd6aXA="}`)},
+	}
+
+	_, err := readEntry(&entry)
+	require.ErrorContains(t, err, "failed to decompress data for resource")
+}
+
+func Test_ValidateSize(t *testing.T) {
+	id := "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app"
+
+	t.Run("valid", func(t *testing.T) {
+		resource := ucpv1alpha1.Resource{
+			Entries: []ucpv1alpha1.ResourceEntry{
+				{ID: id, Data: &runtime.RawExtension{Raw: []byte(`{"value":"a"}`)}},
+			},
+		}
+
+		require.NoError(t, validateSize(&resource, id))
+	})
+
+	t.Run("too_large", func(t *testing.T) {
+		raw := shared.MarshalOrPanic(map[string]any{"value": strings.Repeat("a", MaxObjectSize)})
+		resource := ucpv1alpha1.Resource{
+			Entries: []ucpv1alpha1.ResourceEntry{
+				{ID: id, Data: &runtime.RawExtension{Raw: raw}},
+			},
+		}
+
+		err := validateSize(&resource, id)
+		require.ErrorIs(t, err, &database.ErrInvalid{})
+		require.ErrorContains(t, err, "is too large to store")
+	})
+}
+
+// pagedClient is a fake client that returns the configured pages from List, following the continue token.
+type pagedClient struct {
+	runtimeclient.Client
+	pages     map[string]ucpv1alpha1.ResourceList
+	limits    []int64
+	listError error
+}
+
+func (c *pagedClient) List(ctx context.Context, list runtimeclient.ObjectList, opts ...runtimeclient.ListOption) error {
+	if c.listError != nil {
+		return c.listError
+	}
+
+	options := runtimeclient.ListOptions{}
+	options.ApplyOptions(opts)
+	c.limits = append(c.limits, options.Limit)
+
+	page, ok := c.pages[options.Continue]
+	if !ok {
+		return fmt.Errorf("unexpected continue token %q", options.Continue)
+	}
+
+	page.DeepCopyInto(list.(*ucpv1alpha1.ResourceList))
+	return nil
+}
+
+func Test_Query_Pagination(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	entry := func(name string) ucpv1alpha1.Resource {
+		return ucpv1alpha1.Resource{
+			Entries: []ucpv1alpha1.ResourceEntry{
+				{
+					ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/" + name,
+					Data: &runtime.RawExtension{Raw: []byte(`{"name":"` + name + `"}`)},
+				},
+			},
+		}
+	}
+
+	pages := map[string]ucpv1alpha1.ResourceList{
+		"": {
+			ListMeta: metav1.ListMeta{Continue: "token-1"},
+			Items:    []ucpv1alpha1.Resource{entry("app1"), entry("app2")},
+		},
+		"token-1": {
+			ListMeta: metav1.ListMeta{Continue: "token-2"},
+			Items:    []ucpv1alpha1.Resource{entry("app3"), entry("app4")},
+		},
+		"token-2": {
+			Items: []ucpv1alpha1.Resource{entry("app5")},
+		},
+	}
+
+	query := database.Query{
+		RootScope:    "/planes/radius/local/resourceGroups/cool-group",
+		ResourceType: "Applications.Core/applications",
+	}
+
+	t.Run("all_chunks", func(t *testing.T) {
+		client := &pagedClient{pages: pages}
+		result, err := NewAPIServerClient(client, "radius-test").Query(ctx, query)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 5)
+		require.Empty(t, result.PaginationToken)
+		require.Equal(t, []int64{QueryChunkSize, QueryChunkSize, QueryChunkSize}, client.limits)
+	})
+
+	t.Run("max_item_count", func(t *testing.T) {
+		client := &pagedClient{pages: pages}
+		result, err := NewAPIServerClient(client, "radius-test").Query(ctx, query, database.WithMaxQueryItemCount(2))
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		require.Equal(t, "token-1", result.PaginationToken)
+		require.Equal(t, []int64{2}, client.limits)
+
+		result, err = NewAPIServerClient(client, "radius-test").Query(ctx, query, database.WithMaxQueryItemCount(2), database.WithPaginationToken(result.PaginationToken))
+		require.NoError(t, err)
+		require.Len(t, result.Items, 2)
+		require.Equal(t, "token-2", result.PaginationToken)
+	})
+
+	t.Run("expired_token", func(t *testing.T) {
+		client := &pagedClient{listError: apierrors.NewResourceExpired("continue token expired")}
+		_, err := NewAPIServerClient(client, "radius-test").Query(ctx, query, database.WithPaginationToken("token-1"))
+		require.ErrorIs(t, err, &database.ErrInvalid{})
+		require.ErrorContains(t, err, "has expired")
+	})
+}
@@ -20,6 +20,7 @@ import (
 	context "context"
 	"errors"
 	"fmt"
+	"os"
 	"regexp"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -35,6 +36,9 @@ import (
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// envVarRegex matches a PostgreSQL URL which refers to an environment variable, eg: ${DATABASE_URL}.
+var envVarRegex = regexp.MustCompile(`^\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}$`)
+
 type databaseClientFactoryFunc func(ctx context.Context, options Options) (store.Client, error)
 
 var databaseClientFactory = map[DatabaseProviderType]databaseClientFactoryFunc{
@@ -91,11 +95,13 @@ func initPostgreSQLClient(ctx context.Context, opt Options) (store.Client, error
 	}
 
 	url := opt.PostgreSQL.URL
-	regex := regexp.MustCompile(`$\{([a-zA-Z_]+)\}`)
-	matches := regex.FindSubmatch([]byte(opt.PostgreSQL.URL))
+	matches := envVarRegex.FindStringSubmatch(opt.PostgreSQL.URL)
 	if len(matches) > 1 {
-		// Extract the captured expression.
-		url = string(matches[1])
+		// The URL is read from the environment variable, eg: a secret mounted into the container.
+		url = os.Getenv(matches[1])
+		if url == "" {
+			return nil, fmt.Errorf("failed to initialize PostgreSQL client: environment variable %q is not set", matches[1])
+		}
 	}
 
 	pool, err := pgxpool.New(ctx, url)
@@ -103,5 +109,13 @@ func initPostgreSQLClient(ctx context.Context, opt Options) (store.Client, error
 		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
 	}
 
+	if !opt.PostgreSQL.SkipMigrations {
+		err = postgres.Migrate(ctx, pool)
+		if err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
+		}
+	}
+
 	return postgres.NewPostgresClient(pool), nil
 }
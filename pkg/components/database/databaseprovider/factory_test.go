@@ -0,0 +1,36 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_initPostgreSQLClient_Invalid(t *testing.T) {
+	_, err := initPostgreSQLClient(context.Background(), Options{Provider: TypePostgreSQL})
+	require.EqualError(t, err, "failed to initialize PostgreSQL client: URL is required")
+
+	t.Setenv("RADIUS_TEST_DATABASE_URL", "")
+	_, err = initPostgreSQLClient(context.Background(), Options{
+		Provider:   TypePostgreSQL,
+		PostgreSQL: PostgreSQLOptions{URL: "${RADIUS_TEST_DATABASE_URL}"},
+	})
+	require.EqualError(t, err, `failed to initialize PostgreSQL client: environment variable "RADIUS_TEST_DATABASE_URL" is not set`)
+}
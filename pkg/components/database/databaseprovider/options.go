@@ -56,4 +56,8 @@ type PostgreSQLOptions struct {
 	// In place of the actual URL, you can substitute an environment variable by using the format:
 	// 	${ENV_VAR_NAME}
 	URL string `yaml:"url"`
+
+	// SkipMigrations disables applying the schema migrations when the client is initialized. Use this when the
+	// schema is managed separately, eg: when the database user is not allowed to create tables.
+	SkipMigrations bool `yaml:"skipMigrations,omitempty"`
 }
@@ -135,6 +135,7 @@ func ConvertScopeIDToResourceID(parsed resources.ID) (resources.ID, error) {
 	// Cases we have to handle:
 	// - /planes/azure/<name>
 	// - /planes/aws/<name>
+	// - /planes/gcp/<name>
 	// - /planes/radius/<name>
 	// - /planes/radius/<name>/resourceGroups/<name>
 	scopes := parsed.ScopeSegments()
@@ -153,6 +154,11 @@ func ConvertScopeIDToResourceID(parsed resources.ID) (resources.ID, error) {
 			return resources.MustParse(fmt.Sprintf("/planes/providers/System.AWS/planes/%s", scopes[0].Name)), nil
 		}
 
+	case "gcp":
+		if len(scopes) == 1 {
+			return resources.MustParse(fmt.Sprintf("/planes/providers/System.GCP/planes/%s", scopes[0].Name)), nil
+		}
+
 	case "radius":
 		if len(scopes) == 1 {
 			return resources.MustParse(fmt.Sprintf("/planes/providers/System.Radius/planes/%s", scopes[0].Name)), nil
@@ -182,6 +188,8 @@ func ConvertScopeTypeToResourceType(resourceType string) (string, error) {
 		return "System.Aws/planes", nil
 	case "azure":
 		return "System.Azure/planes", nil
+	case "gcp":
+		return "System.GCP/planes", nil
 	case "radius":
 		return "System.Radius/planes", nil
 	case "resourcegroups":
@@ -323,6 +323,11 @@ func Test_ConvertScopeIDToResourceID(t *testing.T) {
 			Expected: "/planes/providers/System.AWS/planes/my-plane",
 			IsError:  false,
 		},
+		{
+			Input:    "/planes/gcp/my-plane",
+			Expected: "/planes/providers/System.GCP/planes/my-plane",
+			IsError:  false,
+		},
 		{
 			Input:    "/planes/radius/my-plane",
 			Expected: "/planes/providers/System.Radius/planes/my-plane",
@@ -383,6 +388,11 @@ func Test_ConvertScopeTypeToResourceType(t *testing.T) {
 			Expected: "System.Azure/planes",
 			IsError:  false,
 		},
+		{
+			Input:    "gcp",
+			Expected: "System.GCP/planes",
+			IsError:  false,
+		},
 		{
 			Input:    "radius",
 			Expected: "System.Radius/planes",
@@ -18,8 +18,10 @@ package inmemory
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -176,11 +178,32 @@ func (c *Client) Query(ctx context.Context, query database.Query, options ...dat
 		return nil, &database.ErrInvalid{Message: fmt.Sprintf("invalid argument. Query is invalid: %s", err.Error())}
 	}
 
+	config := database.NewQueryConfig(options...)
+
+	// The pagination token is the key of the last entry of the previous page.
+	lastKey := ""
+	if config.PaginationToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(config.PaginationToken)
+		if err != nil {
+			return nil, &database.ErrInvalid{Message: "invalid argument. 'query.PaginationToken' is invalid."}
+		}
+		lastKey = string(decoded)
+	}
+
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
+	// Entries are returned in order of their keys so that pages are stable.
+	keys := maps.Keys(c.resources)
+	sort.Strings(keys)
+
 	result := &database.ObjectQueryResult{}
-	for _, entry := range c.resources {
+	for _, key := range keys {
+		if key <= lastKey {
+			continue
+		}
+
+		entry := c.resources[key]
 		// Check root scope.
 		if query.ScopeRecursive && !strings.HasPrefix(entry.rootScope, databaseutil.NormalizePart(query.RootScope)) {
 			continue
@@ -217,7 +240,13 @@ func (c *Client) Query(ctx context.Context, query database.Query, options ...dat
 			return nil, err
 		}
 
+		if config.MaxQueryItemCount > 0 && len(result.Items) == config.MaxQueryItemCount {
+			result.PaginationToken = base64.StdEncoding.EncodeToString([]byte(lastKey))
+			break
+		}
+
 		result.Items = append(result.Items, *copy)
+		lastKey = key
 	}
 
 	return result, nil
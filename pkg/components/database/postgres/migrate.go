@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// migrationsLockID is the key of the advisory lock used to serialize migrations when multiple replicas start at the
+// same time.
+const migrationsLockID = 7369736
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// migration is a versioned change to the database schema.
+//
+// Migrations are stored in the migrations directory and named <version>_<description>.sql, eg:
+// 0001_create_resources.sql. Migrations are applied in order of their version and must be idempotent, because a
+// migration may be retried if applying it failed.
+type migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// Migrate applies the schema migrations that have not yet been applied to the database. The applied migrations are
+// recorded in the schema_migrations table.
+func Migrate(ctx context.Context, api PostgresAPI) error {
+	migrations, err := loadMigrations(migrationsFS)
+	if err != nil {
+		return err
+	}
+
+	return migrate(ctx, api, migrations)
+}
+
+func migrate(ctx context.Context, api PostgresAPI, migrations []migration) error {
+	sql := `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version INTEGER PRIMARY KEY NOT NULL,
+	name TEXT NOT NULL,
+	applied_at TIMESTAMP (6) WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);`
+	_, err := api.Exec(ctx, sql)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	applied := []int32{}
+	err = api.QueryRow(ctx, "SELECT COALESCE(array_agg(version), '{}') FROM schema_migrations").Scan(&applied)
+	if err != nil {
+		return fmt.Errorf("failed to query applied migrations: %w", err)
+	}
+
+	for _, m := range migrations {
+		if slices.Contains(applied, int32(m.Version)) {
+			continue
+		}
+
+		// Statements sent together without arguments run in a single implicit transaction, so the migration and
+		// the record of it are applied atomically. The advisory lock is released when the transaction ends.
+		sql := fmt.Sprintf(`
+SELECT pg_advisory_xact_lock(%d);
+%s
+INSERT INTO schema_migrations (version, name) VALUES (%d, '%s') ON CONFLICT (version) DO NOTHING;`, migrationsLockID, m.SQL, m.Version, m.Name)
+
+		_, err := api.Exec(ctx, sql)
+		if err != nil {
+			return fmt.Errorf("failed to apply migration %04d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func loadMigrations(fsys fs.FS) ([]migration, error) {
+	files, err := fs.Glob(fsys, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := []migration{}
+	for _, file := range files {
+		version, name, ok := strings.Cut(strings.TrimSuffix(path.Base(file), ".sql"), "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name %q, expected <version>_<description>.sql", file)
+		}
+
+		v, err := strconv.Atoi(version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %q, expected <version>_<description>.sql", file)
+		}
+
+		b, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		migrations = append(migrations, migration{Version: v, Name: name, SQL: string(b)})
+	}
+
+	slices.SortFunc(migrations, func(a migration, b migration) int { return a.Version - b.Version })
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version == migrations[i-1].Version {
+			return nil, fmt.Errorf("duplicate migration version %d", migrations[i].Version)
+		}
+	}
+
+	return migrations, nil
+}
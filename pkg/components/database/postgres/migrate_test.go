@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+var _ PostgresAPI = (*fakeAPI)(nil)
+
+// fakeAPI records the statements executed by the migrations.
+type fakeAPI struct {
+	applied []int32
+	execs   []string
+	err     error
+}
+
+func (f *fakeAPI) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	f.execs = append(f.execs, sql)
+	return pgconn.CommandTag{}, f.err
+}
+
+func (f *fakeAPI) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeAPI) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return fakeRow{applied: f.applied}
+}
+
+type fakeRow struct {
+	applied []int32
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	*(dest[0].(*[]int32)) = r.applied
+	return nil
+}
+
+func Test_loadMigrations(t *testing.T) {
+	migrations, err := loadMigrations(migrationsFS)
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	require.Equal(t, 1, migrations[0].Version)
+	require.Equal(t, "create_resources", migrations[0].Name)
+	require.Contains(t, migrations[0].SQL, "CREATE TABLE IF NOT EXISTS resources")
+
+	fsys := fstest.MapFS{
+		"migrations/0002_second.sql": {Data: []byte("SELECT 2;")},
+		"migrations/0001_first.sql":  {Data: []byte("SELECT 1;")},
+	}
+	migrations, err = loadMigrations(fsys)
+	require.NoError(t, err)
+	require.Equal(t, []migration{{Version: 1, Name: "first", SQL: "SELECT 1;"}, {Version: 2, Name: "second", SQL: "SELECT 2;"}}, migrations)
+}
+
+func Test_loadMigrations_Invalid(t *testing.T) {
+	_, err := loadMigrations(fstest.MapFS{"migrations/first.sql": {Data: []byte("SELECT 1;")}})
+	require.EqualError(t, err, `invalid migration file name "migrations/first.sql", expected <version>_<description>.sql`)
+
+	_, err = loadMigrations(fstest.MapFS{
+		"migrations/0001_first.sql": {Data: []byte("SELECT 1;")},
+		"migrations/1_again.sql":    {Data: []byte("SELECT 1;")},
+	})
+	require.EqualError(t, err, "duplicate migration version 1")
+}
+
+func Test_migrate(t *testing.T) {
+	migrations := []migration{{Version: 1, Name: "first", SQL: "SELECT 1;"}, {Version: 2, Name: "second", SQL: "SELECT 2;"}}
+
+	t.Run("skips applied migrations", func(t *testing.T) {
+		api := &fakeAPI{applied: []int32{1}}
+		err := migrate(context.Background(), api, migrations)
+		require.NoError(t, err)
+
+		// The schema_migrations table is created, then only the second migration is applied.
+		require.Len(t, api.execs, 2)
+		require.Contains(t, api.execs[0], "CREATE TABLE IF NOT EXISTS schema_migrations")
+		require.Contains(t, api.execs[1], "SELECT 2;")
+		require.Contains(t, api.execs[1], "INSERT INTO schema_migrations (version, name) VALUES (2, 'second')")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		api := &fakeAPI{err: errors.New("oops")}
+		err := migrate(context.Background(), api, migrations)
+		require.EqualError(t, err, "failed to create schema_migrations table: oops")
+	})
+}
@@ -1,5 +1,5 @@
 -- 'resources' is used to store all of our resources. See comments below for an explanation of the columns.
-CREATE TABLE resources (
+CREATE TABLE IF NOT EXISTS resources (
     -- example: "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/my-app"
     --
     -- We use columns to break out the most important components of the resource id for optimal querying.
@@ -31,7 +31,7 @@ CREATE TABLE resources (
 
     -- routing_scope used by queries to list resources when they are child resources.
     routing_scope TEXT NOT NULL,
-    
+
     -- etag used for optimistic concurrency control.
     etag TEXT NOT NULL,
 
@@ -63,4 +63,4 @@ CREATE TABLE resources (
 -- The index only contains resource_type and root_scope because these are usually specified exactly.
 -- We don't really benefit from routing_scope being in the index because it's always used with LIKE.
 -- We don't benefit from created_at being in the index because it's used for sorting.
-CREATE INDEX idx_resource_query ON resources (resource_type, root_scope);
+CREATE INDEX IF NOT EXISTS idx_resource_query ON resources (resource_type, root_scope);
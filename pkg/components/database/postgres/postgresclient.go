@@ -26,9 +26,9 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
-	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/components/database/databaseutil"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/util/etag"
 )
@@ -283,8 +283,8 @@ LIMIT $6`
 		return nil, err
 	}
 
-	if len(result.Items) < config.MaxQueryItemCount && config.MaxQueryItemCount > 0 {
-		// No more rows, so no need for pagination.
+	if config.MaxQueryItemCount <= 0 || len(result.Items) < config.MaxQueryItemCount {
+		// No limit was requested or there are no more rows, so no need for pagination.
 		return &result, nil
 	}
 
@@ -45,6 +45,9 @@ func Test_PostgresClient(t *testing.T) {
 	pool, err := pgxpool.New(ctx, url)
 	require.NoError(t, err)
 
+	err = Migrate(ctx, pool)
+	require.NoError(t, err)
+
 	logger := postgresLogger{t: t, pool: pool}
 	client := NewPostgresClient(&logger)
 
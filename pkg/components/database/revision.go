@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// revisionHistoryType is the child resource type used to store the revision history of a resource.
+	revisionHistoryType = "revisionHistory"
+
+	// revisionHistoryName is the name of the child resource used to store the revision history of a resource.
+	revisionHistoryName = "default"
+)
+
+// Revision is a prior revision of a resource.
+type Revision struct {
+	// Revision is the sequence number of the revision. Revision numbers start at 1 and increase for each update
+	// of the resource.
+	Revision int `json:"revision"`
+
+	// ETag is the ETag of the resource at this revision.
+	ETag ETag `json:"etag,omitempty"`
+
+	// Timestamp is the time at which the revision was replaced by an update.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Data is the payload of the resource at this revision.
+	Data any `json:"data"`
+}
+
+// RevisionHistory is the bounded list of prior revisions of a resource, ordered from oldest to newest.
+type RevisionHistory struct {
+	Revisions []Revision `json:"revisions"`
+}
+
+// RevisionHistoryID returns the id of the object storing the revision history of the resource.
+func RevisionHistoryID(id string) (string, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	if !parsed.IsResource() {
+		return "", &ErrInvalid{Message: fmt.Sprintf("revision history is only supported for resources, got %q", id)}
+	}
+
+	return parsed.Append(resources.TypeSegment{Type: revisionHistoryType, Name: revisionHistoryName}).String(), nil
+}
+
+// GetRevisionHistory gets the revision history of the resource. An empty history is returned if no revisions
+// have been recorded.
+func GetRevisionHistory(ctx context.Context, client Client, id string) (*RevisionHistory, error) {
+	history, _, err := getRevisionHistory(ctx, client, id)
+	return history, err
+}
+
+// SaveRevision records the data of the resource as its newest prior revision. At most limit revisions are kept and
+// older revisions are discarded. SaveRevision does nothing if limit is not positive.
+func SaveRevision(ctx context.Context, client Client, id string, etag ETag, data any, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	history, historyETag, err := getRevisionHistory(ctx, client, id)
+	if err != nil {
+		return err
+	}
+
+	next := 1
+	if len(history.Revisions) > 0 {
+		next = history.Revisions[len(history.Revisions)-1].Revision + 1
+	}
+
+	history.Revisions = append(history.Revisions, Revision{
+		Revision:  next,
+		ETag:      etag,
+		Timestamp: time.Now().UTC(),
+		Data:      data,
+	})
+	if len(history.Revisions) > limit {
+		history.Revisions = history.Revisions[len(history.Revisions)-limit:]
+	}
+
+	historyID, err := RevisionHistoryID(id)
+	if err != nil {
+		return err
+	}
+
+	obj := &Object{
+		Metadata: Metadata{ID: historyID},
+		Data:     history,
+	}
+	return client.Save(ctx, obj, WithETag(historyETag))
+}
+
+func getRevisionHistory(ctx context.Context, client Client, id string) (*RevisionHistory, ETag, error) {
+	historyID, err := RevisionHistoryID(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	obj, err := client.Get(ctx, historyID)
+	if errors.Is(err, &ErrNotFound{}) {
+		return &RevisionHistory{Revisions: []Revision{}}, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	history := &RevisionHistory{}
+	if err := obj.As(history); err != nil {
+		return nil, "", err
+	}
+
+	return history, obj.ETag, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+
+func Test_RevisionHistoryID(t *testing.T) {
+	id, err := database.RevisionHistoryID(testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, testResourceID+"/revisionHistory/default", id)
+
+	_, err = database.RevisionHistoryID("/planes/radius/local/resourceGroups/test-rg")
+	require.ErrorIs(t, err, &database.ErrInvalid{})
+
+	_, err = database.RevisionHistoryID("invalid")
+	require.Error(t, err)
+}
+
+func Test_SaveRevision(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty history", func(t *testing.T) {
+		client := inmemory.NewClient()
+
+		history, err := database.GetRevisionHistory(ctx, client, testResourceID)
+		require.NoError(t, err)
+		require.Empty(t, history.Revisions)
+	})
+
+	t.Run("limit is not positive", func(t *testing.T) {
+		client := inmemory.NewClient()
+
+		err := database.SaveRevision(ctx, client, testResourceID, "etag-0", map[string]any{"image": "v0"}, 0)
+		require.NoError(t, err)
+
+		history, err := database.GetRevisionHistory(ctx, client, testResourceID)
+		require.NoError(t, err)
+		require.Empty(t, history.Revisions)
+	})
+
+	t.Run("keeps newest revisions", func(t *testing.T) {
+		client := inmemory.NewClient()
+
+		for i := 0; i < 5; i++ {
+			err := database.SaveRevision(ctx, client, testResourceID, fmt.Sprintf("etag-%d", i), map[string]any{"image": fmt.Sprintf("v%d", i)}, 3)
+			require.NoError(t, err)
+		}
+
+		history, err := database.GetRevisionHistory(ctx, client, testResourceID)
+		require.NoError(t, err)
+		require.Len(t, history.Revisions, 3)
+
+		for i, revision := range history.Revisions {
+			require.Equal(t, i+3, revision.Revision)
+			require.Equal(t, fmt.Sprintf("etag-%d", i+2), revision.ETag)
+			require.Equal(t, map[string]any{"image": fmt.Sprintf("v%d", i+2)}, revision.Data)
+			require.False(t, revision.Timestamp.IsZero())
+		}
+	})
+}
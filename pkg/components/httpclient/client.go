@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+)
+
+const (
+	// CABundleEnvVar is the environment variable that holds the path to a PEM encoded bundle of additional
+	// certificate authorities to trust for outbound HTTPS connections.
+	CABundleEnvVar = "RADIUS_CA_BUNDLE"
+)
+
+var (
+	defaultTransport     *http.Transport
+	defaultTransportErr  error
+	defaultTransportOnce sync.Once
+)
+
+// DefaultTransport returns the shared transport for outbound requests. The transport is created on first use from
+// the environment and reused for all subsequent calls so that connections are pooled.
+func DefaultTransport() (*http.Transport, error) {
+	defaultTransportOnce.Do(func() {
+		defaultTransport, defaultTransportErr = NewTransport()
+	})
+
+	return defaultTransport, defaultTransportErr
+}
+
+// NewClient creates an HTTP client that uses the shared transport for outbound requests.
+func NewClient() (*http.Client, error) {
+	transport, err := DefaultTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// NewTransport creates a transport based on http.DefaultTransport that uses the proxy configured by ConfigureProxy
+// and trusts the certificate authorities of the bundle configured by CABundleEnvVar in addition to the system
+// certificate pool. The bundle is optional; an error is returned if it is configured but cannot be loaded.
+func NewTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = Proxy
+
+	bundlePath := os.Getenv(CABundleEnvVar)
+	if bundlePath == "" {
+		return transport, nil
+	}
+
+	pool, err := loadCertPool(bundlePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.RootCAs = pool
+
+	return transport, nil
+}
+
+// loadCertPool returns a copy of the system certificate pool with the certificates of the bundle added.
+func loadCertPool(bundlePath string) (*x509.CertPool, error) {
+	bundle, err := os.ReadFile(bundlePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle %q: %w", bundlePath, err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("failed to parse CA bundle %q: no certificates found", bundlePath)
+	}
+
+	return pool, nil
+}
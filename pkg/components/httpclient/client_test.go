@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeCABundle writes the certificate of the test server to a PEM file and returns its path.
+func writeCABundle(t *testing.T, server *httptest.Server) string {
+	path := filepath.Join(t.TempDir(), "bundle.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(path, data, 0644))
+	return path
+}
+
+func Test_NewTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("no bundle", func(t *testing.T) {
+		t.Setenv(CABundleEnvVar, "")
+
+		transport, err := NewTransport()
+		require.NoError(t, err)
+		require.NotNil(t, transport.Proxy)
+
+		// The test server uses a self-signed certificate that is not trusted without the bundle.
+		_, err = (&http.Client{Transport: transport}).Get(server.URL)
+		require.Error(t, err)
+	})
+
+	t.Run("bundle", func(t *testing.T) {
+		t.Setenv(CABundleEnvVar, writeCABundle(t, server))
+
+		transport, err := NewTransport()
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("bundle not found", func(t *testing.T) {
+		t.Setenv(CABundleEnvVar, filepath.Join(t.TempDir(), "missing.pem"))
+
+		_, err := NewTransport()
+		require.ErrorContains(t, err, "failed to read CA bundle")
+	})
+
+	t.Run("bundle without certificates", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bundle.pem")
+		require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0644))
+		t.Setenv(CABundleEnvVar, path)
+
+		_, err := NewTransport()
+		require.ErrorContains(t, err, "no certificates found")
+	})
+}
@@ -0,0 +1,21 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// httpclient creates the HTTP clients and transports used by the Radius control-plane services for outbound
+// requests. The transports honor the proxy configured by the host options of the service, which defaults to the
+// proxy settings of the environment (HTTP_PROXY, HTTPS_PROXY, NO_PROXY), and trust the certificate authorities in the bundle referenced by the RADIUS_CA_BUNDLE environment variable in
+// addition to the system certificate pool.
+package httpclient
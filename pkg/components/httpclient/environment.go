@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpclient
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// caBundleFileName is the name of the combined CA bundle written for child processes.
+	caBundleFileName = "ca-bundle.pem"
+)
+
+// systemCABundleFiles are the well-known locations of the system CA bundle on Linux distributions.
+var systemCABundleFiles = []string{
+	"/etc/ssl/certs/ca-certificates.crt",                // Debian/Ubuntu/Gentoo etc.
+	"/etc/pki/tls/certs/ca-bundle.crt",                  // Fedora/RHEL 6
+	"/etc/ssl/ca-bundle.pem",                            // OpenSUSE
+	"/etc/pki/tls/cacert.pem",                           // OpenELEC
+	"/etc/pki/ca-trust/extracted/pem/tls-ca-bundle.pem", // CentOS/RHEL 7
+	"/etc/ssl/cert.pem",                                 // Alpine Linux
+}
+
+// ProcessEnvironment returns the environment variables that make child processes such as Terraform and Git use the
+// same proxy and trust the same certificate authorities as the transports created by this package. Child processes
+// replace the system certificate pool with the bundle they are given, so the system bundle and the bundle configured
+// by CABundleEnvVar are combined into a single file in dir. It returns nil if neither a proxy nor a bundle is
+// configured.
+func ProcessEnvironment(dir string) (map[string]string, error) {
+	env := proxyEnvironment()
+
+	bundlePath := os.Getenv(CABundleEnvVar)
+	if bundlePath == "" {
+		return env, nil
+	}
+
+	bundle, err := os.ReadFile(bundlePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle %q: %w", bundlePath, err)
+	}
+
+	combined := []byte{}
+	for _, file := range systemCABundleFiles {
+		system, err := os.ReadFile(file)
+		if err == nil {
+			combined = append(combined, system...)
+			combined = append(combined, '\n')
+			break
+		}
+	}
+	combined = append(combined, bundle...)
+
+	combinedPath := filepath.Join(dir, caBundleFileName)
+	if err := os.WriteFile(combinedPath, combined, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write CA bundle %q: %w", combinedPath, err)
+	}
+
+	if env == nil {
+		env = map[string]string{}
+	}
+
+	// Used by Terraform and other Go programs.
+	env["SSL_CERT_FILE"] = combinedPath
+	// Used by Git when cloning modules over HTTPS.
+	env["GIT_SSL_CAINFO"] = combinedPath
+
+	return env, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newCertificatePEM creates a self-signed certificate that is not trusted by anything.
+func newCertificatePEM(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func Test_ProcessEnvironment(t *testing.T) {
+	t.Run("no bundle", func(t *testing.T) {
+		setProxyEnvironment(t, "", "", "")
+		t.Setenv(CABundleEnvVar, "")
+
+		env, err := ProcessEnvironment(t.TempDir())
+		require.NoError(t, err)
+		require.Nil(t, env)
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		setProxyEnvironment(t, "", "", "")
+		t.Setenv(CABundleEnvVar, "")
+		ConfigureProxy(ProxyOptions{HTTPSProxy: "http://proxy:3128"})
+
+		env, err := ProcessEnvironment(t.TempDir())
+		require.NoError(t, err)
+		require.Equal(t, "http://proxy:3128", env["HTTPS_PROXY"])
+		require.Equal(t, "http://proxy:3128", env["https_proxy"])
+		require.Contains(t, env["NO_PROXY"], ".svc")
+		require.NotContains(t, env, "SSL_CERT_FILE")
+	})
+
+	t.Run("bundle", func(t *testing.T) {
+		setProxyEnvironment(t, "", "", "")
+		bundle := newCertificatePEM(t)
+		bundlePath := filepath.Join(t.TempDir(), "bundle.pem")
+		require.NoError(t, os.WriteFile(bundlePath, bundle, 0644))
+		t.Setenv(CABundleEnvVar, bundlePath)
+
+		dir := t.TempDir()
+		env, err := ProcessEnvironment(dir)
+		require.NoError(t, err)
+
+		expectedPath := filepath.Join(dir, caBundleFileName)
+		require.Equal(t, map[string]string{
+			"SSL_CERT_FILE":  expectedPath,
+			"GIT_SSL_CAINFO": expectedPath,
+		}, env)
+
+		combined, err := os.ReadFile(expectedPath)
+		require.NoError(t, err)
+		require.Contains(t, string(combined), string(bundle))
+	})
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpclient
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// DefaultNoProxy is the list of destinations that are never sent through the proxy: the loopback interface and
+// the services of the cluster.
+var DefaultNoProxy = []string{"localhost", "127.0.0.1", "::1", ".svc", ".cluster.local"}
+
+// ProxyOptions is the configuration of the proxy used for outbound requests. Settings that are not configured are
+// read from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+type ProxyOptions struct {
+	// HTTPProxy is the URL of the proxy used for HTTP requests.
+	HTTPProxy string `yaml:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy used for HTTPS requests.
+	HTTPSProxy string `yaml:"httpsProxy,omitempty"`
+
+	// NoProxy is the list of destinations that bypass the proxy, in addition to DefaultNoProxy and to the destinations
+	// of the NO_PROXY environment variable. Each entry is a host name, a domain suffix such as ".example.com", an IP
+	// address or a CIDR range, optionally followed by a port.
+	NoProxy []string `yaml:"noProxy,omitempty"`
+}
+
+var (
+	proxyMutex  sync.Mutex
+	proxyConfig *httpproxy.Config
+	proxyFunc   func(*url.URL) (*url.URL, error)
+)
+
+// ConfigureProxy sets the proxy used by the transports created by this package and by the child processes using
+// ProcessEnvironment. It can be called again when the configuration changes; the new settings apply to the
+// requests sent afterwards.
+func ConfigureProxy(options ProxyOptions) {
+	config := newProxyConfig(options)
+
+	proxyMutex.Lock()
+	defer proxyMutex.Unlock()
+
+	proxyConfig = config
+	proxyFunc = config.ProxyFunc()
+}
+
+// Proxy returns the URL of the proxy to use for a request, or nil if the request bypasses the proxy. It is the
+// Proxy function of the transports created by this package.
+func Proxy(req *http.Request) (*url.URL, error) {
+	_, fn := currentProxy()
+	return fn(req.URL)
+}
+
+// currentProxy returns the proxy configuration set by ConfigureProxy, or the configuration of the environment if the
+// proxy was not configured.
+func currentProxy() (*httpproxy.Config, func(*url.URL) (*url.URL, error)) {
+	proxyMutex.Lock()
+	defer proxyMutex.Unlock()
+
+	if proxyConfig == nil {
+		proxyConfig = newProxyConfig(ProxyOptions{})
+		proxyFunc = proxyConfig.ProxyFunc()
+	}
+
+	return proxyConfig, proxyFunc
+}
+
+// newProxyConfig merges the options with the proxy settings of the environment.
+func newProxyConfig(options ProxyOptions) *httpproxy.Config {
+	config := httpproxy.FromEnvironment()
+	if options.HTTPProxy != "" {
+		config.HTTPProxy = options.HTTPProxy
+	}
+	if options.HTTPSProxy != "" {
+		config.HTTPSProxy = options.HTTPSProxy
+	}
+
+	noProxy := append([]string{}, DefaultNoProxy...)
+	if config.NoProxy != "" {
+		noProxy = append(noProxy, config.NoProxy)
+	}
+	noProxy = append(noProxy, options.NoProxy...)
+	config.NoProxy = strings.Join(noProxy, ",")
+
+	return config
+}
+
+// proxyEnvironment returns the environment variables that make child processes use the configured proxy, or nil if
+// no proxy is configured. Both the upper and lower case variables are set because programs differ in which ones
+// they read.
+func proxyEnvironment() map[string]string {
+	config, _ := currentProxy()
+	if config.HTTPProxy == "" && config.HTTPSProxy == "" {
+		return nil
+	}
+
+	env := map[string]string{}
+	set := func(name string, value string) {
+		if value != "" {
+			env[strings.ToUpper(name)] = value
+			env[strings.ToLower(name)] = value
+		}
+	}
+	set("HTTP_PROXY", config.HTTPProxy)
+	set("HTTPS_PROXY", config.HTTPSProxy)
+	set("NO_PROXY", config.NoProxy)
+
+	return env
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpclient
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// setProxyEnvironment sets the proxy environment variables and resets the proxy configuration so that it is read
+// again from the environment.
+func setProxyEnvironment(t *testing.T, httpProxy string, httpsProxy string, noProxy string) {
+	for _, name := range []string{"HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "NO_PROXY", "no_proxy", "REQUEST_METHOD"} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("HTTP_PROXY", httpProxy)
+	t.Setenv("HTTPS_PROXY", httpsProxy)
+	t.Setenv("NO_PROXY", noProxy)
+
+	resetProxy := func() {
+		proxyMutex.Lock()
+		defer proxyMutex.Unlock()
+		proxyConfig = nil
+		proxyFunc = nil
+	}
+	resetProxy()
+	t.Cleanup(resetProxy)
+}
+
+func proxyURL(t *testing.T, target string) string {
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	require.NoError(t, err)
+
+	proxy, err := Proxy(req)
+	require.NoError(t, err)
+	if proxy == nil {
+		return ""
+	}
+
+	return proxy.String()
+}
+
+func Test_Proxy(t *testing.T) {
+	t.Run("no proxy", func(t *testing.T) {
+		setProxyEnvironment(t, "", "", "")
+
+		require.Equal(t, "", proxyURL(t, "https://example.com"))
+		require.Nil(t, proxyEnvironment())
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		setProxyEnvironment(t, "http://env-proxy:3128", "http://env-proxy:3129", "internal.example.com")
+
+		require.Equal(t, "http://env-proxy:3128", proxyURL(t, "http://example.com"))
+		require.Equal(t, "http://env-proxy:3129", proxyURL(t, "https://example.com"))
+		require.Equal(t, "", proxyURL(t, "https://internal.example.com"))
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		setProxyEnvironment(t, "http://env-proxy:3128", "", "internal.example.com")
+		ConfigureProxy(ProxyOptions{
+			HTTPSProxy: "http://proxy:3128",
+			NoProxy:    []string{".corp.example.com", "10.0.0.0/8"},
+		})
+
+		require.Equal(t, "http://env-proxy:3128", proxyURL(t, "http://example.com"))
+		require.Equal(t, "http://proxy:3128", proxyURL(t, "https://example.com"))
+
+		// Destinations bypassing the proxy: the environment, the options and the defaults.
+		require.Equal(t, "", proxyURL(t, "https://internal.example.com"))
+		require.Equal(t, "", proxyURL(t, "https://registry.corp.example.com"))
+		require.Equal(t, "", proxyURL(t, "https://10.1.2.3"))
+		require.Equal(t, "", proxyURL(t, "http://applications-rp.radius-system.svc:5443"))
+		require.Equal(t, "", proxyURL(t, "http://ucp.radius-system.svc.cluster.local"))
+		require.Equal(t, "", proxyURL(t, "http://localhost:8080"))
+
+		env := proxyEnvironment()
+		require.Equal(t, "http://env-proxy:3128", env["HTTP_PROXY"])
+		require.Equal(t, "http://env-proxy:3128", env["http_proxy"])
+		require.Equal(t, "http://proxy:3128", env["HTTPS_PROXY"])
+		require.Equal(t, "localhost,127.0.0.1,::1,.svc,.cluster.local,internal.example.com,.corp.example.com,10.0.0.0/8", env["NO_PROXY"])
+	})
+}
@@ -40,8 +40,20 @@ const (
 	// ExtendedAsyncOperationCount is the metric name for extended async operation count.
 	ExtendedAsyncOperationCount = "asyncoperation.extended.operation"
 
+	// RequeuedAsyncOperationCount is the metric name for requeued async operation count.
+	RequeuedAsyncOperationCount = "asyncoperation.requeued.operation"
+
 	// AsyncOperationDuration is the metric name for async operation duration.
 	AsnycOperationDuration = "asyncoperation.duration"
+
+	// AsyncOperationMessageAge is the metric name for the age of async operation messages when they are dequeued.
+	AsyncOperationMessageAge = "asyncoperation.message.age"
+)
+
+var (
+	// durationBucketBoundaries are the histogram bucket boundaries in milliseconds for async operations, which
+	// can take from less than a second to the operation timeout.
+	durationBucketBoundaries = []float64{100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000, 120000, 300000, 600000, 1200000, 3600000}
 )
 
 type asyncOperationMetrics struct {
@@ -77,7 +89,17 @@ func (a *asyncOperationMetrics) Init() error {
 		return err
 	}
 
-	a.valueRecorders[AsnycOperationDuration], err = meter.Float64Histogram(AsnycOperationDuration)
+	a.counters[RequeuedAsyncOperationCount], err = meter.Int64Counter(RequeuedAsyncOperationCount)
+	if err != nil {
+		return err
+	}
+
+	a.valueRecorders[AsnycOperationDuration], err = meter.Float64Histogram(AsnycOperationDuration, metric.WithExplicitBucketBoundaries(durationBucketBoundaries...))
+	if err != nil {
+		return err
+	}
+
+	a.valueRecorders[AsyncOperationMessageAge], err = meter.Float64Histogram(AsyncOperationMessageAge, metric.WithExplicitBucketBoundaries(durationBucketBoundaries...))
 	if err != nil {
 		return err
 	}
@@ -115,6 +137,24 @@ func (a *asyncOperationMetrics) RecordExtendedAsyncOperation(ctx context.Context
 	}
 }
 
+// RecordRequeuedAsyncOperation increments the RequeuedAsyncOperationCount metric for the given request. It should
+// be called when an async operation is requeued to be processed again.
+func (a *asyncOperationMetrics) RecordRequeuedAsyncOperation(ctx context.Context, req *ctrl.Request) {
+	if a.counters[RequeuedAsyncOperationCount] != nil {
+		a.counters[RequeuedAsyncOperationCount].Add(ctx, 1, metric.WithAttributes(newAsyncOperationCommonAttributes(req, nil)...))
+	}
+}
+
+// RecordAsyncOperationMessageAge records the time in milliseconds between enqueuing and dequeuing an async operation
+// message. It should be called when the message of an async operation is dequeued.
+func (a *asyncOperationMetrics) RecordAsyncOperationMessageAge(ctx context.Context, req *ctrl.Request, enqueueAt time.Time) {
+	// Queue clients that do not track the enqueue time report a zero time, which is not a meaningful age.
+	if a.valueRecorders[AsyncOperationMessageAge] != nil && !enqueueAt.IsZero() {
+		age := float64(time.Since(enqueueAt)) / float64(time.Millisecond)
+		a.valueRecorders[AsyncOperationMessageAge].Record(ctx, age, metric.WithAttributes(newAsyncOperationCommonAttributes(req, nil)...))
+	}
+}
+
 // RecordAsyncOperationDuration records the duration of an asynchronous operation in milliseconds.
 func (a *asyncOperationMetrics) RecordAsyncOperationDuration(ctx context.Context, req *ctrl.Request, startTime time.Time) {
 	if a.valueRecorders[AsnycOperationDuration] != nil {
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// awsRequestCount is the metric name for the number of requests to the AWS APIs.
+	awsRequestCount = "aws.request.count"
+
+	// awsRequestThrottledCount is the metric name for the number of requests to the AWS APIs which were throttled.
+	awsRequestThrottledCount = "aws.request.throttled.count"
+)
+
+type awsRequestMetrics struct {
+	counters map[string]metric.Int64Counter
+}
+
+func newAWSRequestMetrics() *awsRequestMetrics {
+	return &awsRequestMetrics{
+		counters: make(map[string]metric.Int64Counter),
+	}
+}
+
+// Init initializes the AWS request metrics.
+func (m *awsRequestMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("aws-request-metrics")
+
+	var err error
+	m.counters[awsRequestCount], err = meter.Int64Counter(awsRequestCount)
+	if err != nil {
+		return err
+	}
+
+	m.counters[awsRequestThrottledCount], err = meter.Int64Counter(awsRequestThrottledCount)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordRequest records a request to an AWS API, and whether the request was throttled. The throttle rate of an
+// account and region is the ratio of the throttled requests to all requests.
+func (m *awsRequestMetrics) RecordRequest(ctx context.Context, operation string, account string, region string, throttled bool) {
+	attrs := []attribute.KeyValue{
+		operationTypeAttrKey.String(operation),
+		awsAccountAttrKey.String(account),
+		awsRegionAttrKey.String(region),
+	}
+
+	if m.counters[awsRequestCount] != nil {
+		m.counters[awsRequestCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	if throttled && m.counters[awsRequestThrottledCount] != nil {
+		m.counters[awsRequestThrottledCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
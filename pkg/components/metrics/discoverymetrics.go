@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// discoveryRequestCount is the metric name for the number of requests to the discovery endpoints.
+	discoveryRequestCount = "discovery.request.count"
+
+	// discoveryRequestDuration is the metric name for the duration of the requests to the discovery endpoints.
+	discoveryRequestDuration = "discovery.request.duration"
+)
+
+type discoveryRequestMetrics struct {
+	counters       map[string]metric.Int64Counter
+	valueRecorders map[string]metric.Float64Histogram
+}
+
+func newDiscoveryRequestMetrics() *discoveryRequestMetrics {
+	return &discoveryRequestMetrics{
+		counters:       make(map[string]metric.Int64Counter),
+		valueRecorders: make(map[string]metric.Float64Histogram),
+	}
+}
+
+// Init initializes the discovery request metrics.
+func (m *discoveryRequestMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("discovery-request-metrics")
+
+	var err error
+	m.counters[discoveryRequestCount], err = meter.Int64Counter(discoveryRequestCount)
+	if err != nil {
+		return err
+	}
+
+	m.valueRecorders[discoveryRequestDuration], err = meter.Float64Histogram(discoveryRequestDuration)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordRequest records the count and the duration of a request to the discovery endpoint. The endpoint and the caller
+// must be low-cardinality values, such as the route path and the product name of the user agent.
+func (m *discoveryRequestMetrics) RecordRequest(ctx context.Context, startTime time.Time, endpoint string, caller string, statusCode int) {
+	attrs := []attribute.KeyValue{
+		endpointAttrKey.String(endpoint),
+		callerAttrKey.String(caller),
+		statusCodeAttrKey.Int(statusCode),
+	}
+
+	if m.counters[discoveryRequestCount] != nil {
+		m.counters[discoveryRequestCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	if m.valueRecorders[discoveryRequestDuration] != nil {
+		elapsedTime := float64(time.Since(startTime)) / float64(time.Millisecond)
+		m.valueRecorders[discoveryRequestDuration].Record(ctx, elapsedTime, metric.WithAttributes(attrs...))
+	}
+}
@@ -22,6 +22,15 @@ var (
 
 	// DefaultRecipeEngineMetrics holds recipe engine metrics definitions.
 	DefaultRecipeEngineMetrics = newRecipeEngineMetrics()
+
+	// DefaultQueueMetrics holds queue metrics definitions.
+	DefaultQueueMetrics = newQueueMetrics()
+
+	// DefaultDiscoveryRequestMetrics holds the metrics definitions of the requests to the discovery endpoints.
+	DefaultDiscoveryRequestMetrics = newDiscoveryRequestMetrics()
+
+	// DefaultAWSRequestMetrics holds the metrics definitions of the requests to the AWS APIs.
+	DefaultAWSRequestMetrics = newAWSRequestMetrics()
 )
 
 // InitMetrics initializes metrics for Radius.
@@ -34,5 +43,17 @@ func InitMetrics() error {
 		return err
 	}
 
+	if err := DefaultQueueMetrics.Init(); err != nil {
+		return err
+	}
+
+	if err := DefaultDiscoveryRequestMetrics.Init(); err != nil {
+		return err
+	}
+
+	if err := DefaultAWSRequestMetrics.Init(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// QueueDepth is the metric name for the number of messages in the queue.
+	QueueDepth = "queue.depth"
+)
+
+type queueMetrics struct {
+	gauges map[string]metric.Int64ObservableGauge
+
+	// queues is the map of the queue name to the queues whose depth is observed.
+	queues map[string]queue.Measurer
+	lock   sync.Mutex
+}
+
+func newQueueMetrics() *queueMetrics {
+	return &queueMetrics{
+		gauges: make(map[string]metric.Int64ObservableGauge),
+		queues: make(map[string]queue.Measurer),
+	}
+}
+
+// Init initializes the gauges for queueMetrics and returns an error if any of the initialization fails.
+func (q *queueMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("queue-metrics")
+
+	var err error
+	q.gauges[QueueDepth], err = meter.Int64ObservableGauge(QueueDepth, metric.WithInt64Callback(q.observeQueueDepth))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RegisterQueue registers the queue to observe its depth. Queue clients which do not implement queue.Measurer
+// are ignored.
+func (q *queueMetrics) RegisterQueue(client queue.Client) {
+	measurer, ok := client.(queue.Measurer)
+	if !ok {
+		return
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.queues[measurer.Name()] = measurer
+}
+
+// observeQueueDepth observes the number of messages of each registered queue.
+func (q *queueMetrics) observeQueueDepth(ctx context.Context, observer metric.Int64Observer) error {
+	q.lock.Lock()
+	queues := maps.Clone(q.queues)
+	q.lock.Unlock()
+
+	for name, measurer := range queues {
+		length, err := measurer.Len(ctx)
+		if err != nil {
+			// Skip the observation so that a transient failure does not report an empty queue.
+			ucplog.FromContextOrDiscard(ctx).Error(err, "failed to get the queue depth", "queueName", name)
+			continue
+		}
+
+		observer.Observe(int64(length), metric.WithAttributes(queueNameAttrKey.String(normalizeAttrValue(name))))
+	}
+
+	return nil
+}
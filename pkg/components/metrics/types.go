@@ -33,6 +33,9 @@ const (
 	// operationErrorCodeAttrKey is the attribute name for the operation error code.
 	operationErrorCodeAttrKey = attribute.Key("operation_error_code")
 
+	// queueNameAttrKey is the attribute name for the queue name.
+	queueNameAttrKey = attribute.Key("queue_name")
+
 	// recipeNameAttrKey is the attribute name for the recipe name.
 	recipeNameAttrKey = attribute.Key("recipe_name")
 
@@ -42,6 +45,21 @@ const (
 	// recipeTemplatePathAttrKey is the attribute name for the recipe template path.
 	recipeTemplatePathAttrKey = attribute.Key("recipe_template_path")
 
+	// endpointAttrKey is the attribute name for the endpoint of a request.
+	endpointAttrKey = attribute.Key("endpoint")
+
+	// callerAttrKey is the attribute name for the caller of a request.
+	callerAttrKey = attribute.Key("caller")
+
+	// statusCodeAttrKey is the attribute name for the status code of a response.
+	statusCodeAttrKey = attribute.Key("status_code")
+
+	// awsAccountAttrKey is the attribute name for the AWS account.
+	awsAccountAttrKey = attribute.Key("aws_account")
+
+	// awsRegionAttrKey is the attribute name for the AWS region.
+	awsRegionAttrKey = attribute.Key("aws_region")
+
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
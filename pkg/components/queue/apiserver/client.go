@@ -43,6 +43,18 @@ limitations under the License.
 // fetched message, then Update() API would return conflict error by optimistic concurrency and retry to query new message
 // and update it again until the conflict is resolved.
 //
+// Enqueue with a deduplication key creates QueueMessage CR with the name derived from the key instead of the unique id.
+// Therefore, Create() returns AlreadyExists error while the message with the same key is in the queue and the message
+// is not enqueued again. The key is stored in `ucp.dev/deduplicationkey` annotation.
+//
+//         applications.core.dedup.d0a8e2ab6f2fc07b4bd5e1de0d1fab0e
+//         ----------------- ----- --------------------------------
+//              name                  hash of deduplication key
+//
+// FinishMessage deletes the message only if DequeueCount of the message matches DequeueCount of the leased message.
+// It returns ErrDequeuedMessage if the other client leased the message after the lock expired and ErrInvalidMessage
+// if the message has already been deleted.
+//
 // How to handle clock skew - Dequeue operation in this implementation relies on system clock. If Client A and B
 // run in the different physical node A and B respectively, client B in node B could deqeueue the same message in the clock skew
 // window before client A in node A leased the message. When Client A calls ExtendMessage, it always fetches message with id first
@@ -55,6 +67,7 @@ package apiserver
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strconv"
@@ -62,6 +75,7 @@ import (
 
 	v1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/components/queue"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -75,12 +89,15 @@ const (
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// AnnotationDeduplicationKey is the annotation representing the deduplication key of message.
+	AnnotationDeduplicationKey = "ucp.dev/deduplicationkey"
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
 )
 
 var _ queue.Client = (*Client)(nil)
+var _ queue.Measurer = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -118,11 +135,12 @@ func getTimeFromString(s string) time.Time {
 
 func copyMessage(msg *queue.Message, queueMessage *v1alpha1.QueueMessage) {
 	msg.Metadata = queue.Metadata{
-		ID:            queueMessage.Name,
-		DequeueCount:  queueMessage.Spec.DequeueCount,
-		EnqueueAt:     queueMessage.Spec.EnqueueAt.Time,
-		ExpireAt:      queueMessage.Spec.ExpireAt.Time,
-		NextVisibleAt: getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
+		ID:               queueMessage.Name,
+		DeduplicationKey: queueMessage.Annotations[AnnotationDeduplicationKey],
+		DequeueCount:     queueMessage.Spec.DequeueCount,
+		EnqueueAt:        queueMessage.Spec.EnqueueAt.Time,
+		ExpireAt:         queueMessage.Spec.ExpireAt.Time,
+		NextVisibleAt:    getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
 	}
 	msg.ContentType = queue.JSONContentType
 	msg.Data = make([]byte, len(queueMessage.Spec.Data.Raw))
@@ -154,6 +172,12 @@ func (c *Client) generateID() (string, error) {
 	return fmt.Sprintf("%s.%10d.%32x", c.opts.Name, time.Now().Unix(), b), nil
 }
 
+// generateDeduplicationID generates the id of the message from the deduplication key.
+func (c *Client) generateDeduplicationID(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("%s.dedup.%32x", c.opts.Name, h[:16])
+}
+
 func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
 	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
 		return queue.ErrEmptyMessage
@@ -164,15 +188,26 @@ func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...que
 	}
 
 	now := time.Now()
-	id, err := c.generateID()
-	if err != nil {
-		return err
+	cfg := queue.NewEnqueueConfig(options...)
+
+	var id string
+	var annotations map[string]string
+	if cfg.DeduplicationKey != "" {
+		id = c.generateDeduplicationID(cfg.DeduplicationKey)
+		annotations = map[string]string{AnnotationDeduplicationKey: cfg.DeduplicationKey}
+	} else {
+		var err error
+		id, err = c.generateID()
+		if err != nil {
+			return err
+		}
 	}
 
 	resource := &v1alpha1.QueueMessage{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      id,
-			Namespace: c.opts.Namespace,
+			Name:        id,
+			Namespace:   c.opts.Namespace,
+			Annotations: annotations,
 			Labels: map[string]string{
 				LabelNextVisibleAt: int64toa(now.UnixNano()),
 				LabelQueueName:     c.opts.Name,
@@ -187,7 +222,13 @@ func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...que
 		},
 	}
 
-	return c.client.Create(ctx, resource)
+	err := c.client.Create(ctx, resource)
+	if apierrors.IsAlreadyExists(err) && cfg.DeduplicationKey != "" {
+		// The message with the same deduplication key is already in the queue.
+		return nil
+	}
+
+	return err
 }
 
 func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error) {
@@ -329,6 +370,11 @@ func (c *Client) FinishMessage(ctx context.Context, msg *queue.Message) error {
 			return getErr
 		}
 
+		// Ensure that it doesn't delete the message that another client leased after the message lock expired.
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return queue.ErrDequeuedMessage
+		}
+
 		options := &runtimeclient.DeleteOptions{
 			Preconditions: &metav1.Preconditions{
 				UID:             &result.UID,
@@ -338,6 +384,10 @@ func (c *Client) FinishMessage(ctx context.Context, msg *queue.Message) error {
 		return c.client.Delete(ctx, result, options)
 	})
 
+	if apierrors.IsNotFound(retryErr) {
+		return queue.ErrInvalidMessage
+	}
+
 	return retryErr
 }
 
@@ -355,3 +405,22 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
 	copyMessage(msg, result)
 	return nil
 }
+
+// Name returns the name of the queue.
+func (c *Client) Name() string {
+	return c.opts.Name
+}
+
+// Len returns the number of messages in the queue, including the messages leased by clients.
+func (c *Client) Len(ctx context.Context) (int, error) {
+	ql := &v1alpha1.QueueMessageList{}
+	err := c.client.List(
+		ctx, ql,
+		runtimeclient.InNamespace(c.opts.Namespace),
+		runtimeclient.MatchingLabels{LabelQueueName: c.opts.Name})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(ql.Items), nil
+}
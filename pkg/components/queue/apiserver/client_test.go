@@ -66,6 +66,9 @@ func TestCopyMessage(t *testing.T) {
 				LabelNextVisibleAt: int64toa(now.UnixNano()),
 				LabelQueueName:     "applications.core",
 			},
+			Annotations: map[string]string{
+				AnnotationDeduplicationKey: "operation-id",
+			},
 		},
 		Spec: v1alpha1.QueueMessageSpec{
 			DequeueCount: 2,
@@ -79,6 +82,7 @@ func TestCopyMessage(t *testing.T) {
 	copyMessage(msg, queueM)
 
 	require.Equal(t, queueM.ObjectMeta.Name, msg.ID)
+	require.Equal(t, "operation-id", msg.DeduplicationKey)
 	require.Equal(t, queue.JSONContentType, msg.ContentType)
 	require.Equal(t, queueM.Spec.DequeueCount, msg.DequeueCount)
 	require.Equal(t, queueM.Spec.Data.Raw, msg.Data)
@@ -96,6 +100,16 @@ func TestGenerateID(t *testing.T) {
 	require.Equal(t, 61, len(id))
 }
 
+func TestGenerateDeduplicationID(t *testing.T) {
+	cli, err := New(nil, Options{Name: "applications.core", Namespace: "test"})
+	require.NoError(t, err)
+
+	id := cli.generateDeduplicationID("operation-id")
+	require.Equal(t, 56, len(id))
+	require.Equal(t, id, cli.generateDeduplicationID("operation-id"))
+	require.NotEqual(t, id, cli.generateDeduplicationID("another-operation-id"))
+}
+
 func TestClient(t *testing.T) {
 	rc, env, err := kubeenv.StartEnvironment([]string{filepath.Join("..", "..", "..", "..", "deploy", "Chart", "crds", "ucpd")})
 
@@ -45,19 +45,30 @@ var (
 
 // Client is an interface to implement queue operations.
 type Client interface {
-	// Enqueue enqueues message to queue.
+	// Enqueue enqueues message to queue. If the message has the deduplication key and the queue already has the
+	// unfinished message with the same key, the message is not enqueued again.
 	Enqueue(ctx context.Context, msg *Message, opts ...EnqueueOptions) error
 
 	// Dequeue dequeues message from queue.
 	Dequeue(ctx context.Context, cfg QueueClientConfig) (*Message, error)
 
-	// FinishMessage finishes or deletes the message in the queue.
+	// FinishMessage finishes or deletes the message in the queue. It returns ErrDequeuedMessage if the message
+	// has been leased by the other client and ErrInvalidMessage if the message has already been finished.
 	FinishMessage(ctx context.Context, msg *Message) error
 
 	// ExtendMessage extends the message lock.
 	ExtendMessage(ctx context.Context, msg *Message) error
 }
 
+// Measurer is implemented by queue clients which can report the number of messages in the queue.
+type Measurer interface {
+	// Name returns the name of the queue.
+	Name() string
+
+	// Len returns the number of messages in the queue, including the messages leased by clients.
+	Len(ctx context.Context) (int, error)
+}
+
 // StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
 func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-chan *Message, error) {
 	log := ucplog.FromContextOrDiscard(ctx)
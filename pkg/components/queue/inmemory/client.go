@@ -25,9 +25,16 @@ import (
 
 var namedQueue = &sync.Map{}
 var _ queue.Client = (*Client)(nil)
+var _ queue.Measurer = (*Client)(nil)
+
+const (
+	// defaultQueueName is the name of the default global queue.
+	defaultQueueName = "default"
+)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
+	name  string
 	queue *InmemQueue
 }
 
@@ -38,6 +45,7 @@ func New(queue *InmemQueue) *Client {
 	}
 
 	return &Client{
+		name:  defaultQueueName,
 		queue: queue,
 	}
 }
@@ -46,6 +54,7 @@ func New(queue *InmemQueue) *Client {
 func NewNamedQueue(name string) *Client {
 	inmemq, _ := namedQueue.LoadOrStore(name, NewInMemQueue(messageLockDuration))
 	return &Client{
+		name:  name,
 		queue: inmemq.(*InmemQueue),
 	}
 }
@@ -55,6 +64,9 @@ func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...que
 	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
 		return queue.ErrEmptyMessage
 	}
+
+	cfg := queue.NewEnqueueConfig(options...)
+	msg.DeduplicationKey = cfg.DeduplicationKey
 	c.queue.Enqueue(msg)
 	return nil
 }
@@ -89,3 +101,13 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
 	}
 	return err
 }
+
+// Name returns the name of the queue.
+func (c *Client) Name() string {
+	return c.name
+}
+
+// Len returns the number of messages in the in-memory queue.
+func (c *Client) Len(ctx context.Context) (int, error) {
+	return c.queue.Len(), nil
+}
@@ -43,6 +43,33 @@ func TestNamedQueue(t *testing.T) {
 	require.Equal(t, "test1", string(cli3.queue.Dequeue().Data))
 }
 
+func TestMeasurer(t *testing.T) {
+	cli := NewNamedQueue("measuredqueue")
+	require.Equal(t, "measuredqueue", cli.Name())
+
+	err := cli.Enqueue(context.Background(), &queue.Message{Data: []byte("test1")})
+	require.NoError(t, err)
+	err = cli.Enqueue(context.Background(), &queue.Message{Data: []byte("test2")})
+	require.NoError(t, err)
+
+	// Leased messages are still counted until they are finished.
+	msg, err := cli.Dequeue(context.Background(), queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	length, err := cli.Len(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, length)
+
+	err = cli.FinishMessage(context.Background(), msg)
+	require.NoError(t, err)
+
+	length, err = cli.Len(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, length)
+
+	require.Equal(t, defaultQueueName, New(NewInMemQueue(messageLockDuration)).Name())
+}
+
 func TestClient(t *testing.T) {
 	inmem := NewInMemQueue(sharedtest.TestMessageLockTime)
 	cli := New(inmem)
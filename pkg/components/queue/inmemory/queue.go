@@ -65,12 +65,22 @@ func (q *InmemQueue) DeleteAll() {
 	_ = q.v.Init()
 }
 
+// Enqueue adds the message to the queue. The message is ignored if the queue already has the message with the same
+// deduplication key.
 func (q *InmemQueue) Enqueue(msg *queue.Message) {
 	q.updateQueue()
 
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 
+	if msg.DeduplicationKey != "" {
+		for e := q.v.Front(); e != nil; e = e.Next() {
+			if e.Value.(*element).val.DeduplicationKey == msg.DeduplicationKey {
+				return
+			}
+		}
+	}
+
 	msg.Metadata.ID = uuid.NewString()
 	msg.Metadata.DequeueCount = 0
 	msg.Metadata.EnqueueAt = time.Now().UTC()
@@ -89,7 +99,10 @@ func (q *InmemQueue) Dequeue() *queue.Message {
 			elem.val.DequeueCount++
 			elem.val.NextVisibleAt = time.Now().Add(q.lockDuration)
 			elem.visible = false
-			found = elem.val
+
+			// Return the copy of the message so that the client holds the lease of this dequeue.
+			leased := *elem.val
+			found = &leased
 			return true
 		}
 		return false
@@ -98,22 +111,24 @@ func (q *InmemQueue) Dequeue() *queue.Message {
 	return found
 }
 
+// Complete removes the message from the queue. It returns ErrDequeuedMessage if the message has been dequeued again
+// after msg was dequeued.
 func (q *InmemQueue) Complete(msg *queue.Message) error {
-	found := false
+	var err error = queue.ErrInvalidMessage
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		if elem.val.ID == msg.ID {
-			found = true
-			q.v.Remove(e)
+			if elem.val.DequeueCount != msg.DequeueCount {
+				err = queue.ErrDequeuedMessage
+			} else {
+				err = nil
+				q.v.Remove(e)
+			}
 			return true
 		}
 		return false
 	})
 
-	if !found {
-		return queue.ErrInvalidMessage
-	}
-
-	return nil
+	return err
 }
 
 func (q *InmemQueue) Extend(msg *queue.Message) error {
@@ -75,8 +75,8 @@ func TestExpiry(t *testing.T) {
 	require.Equal(t, []byte("test"), msg.Data)
 	require.Equal(t, 1, msg.DequeueCount)
 
-	// Override expiry to the current time.
-	msg.ExpireAt = time.Now().UTC()
+	// Override expiry of the queued message to the current time.
+	q.v.Front().Value.(*element).val.ExpireAt = time.Now().UTC()
 	time.Sleep(10 * time.Millisecond)
 
 	msg2 := q.Dequeue()
@@ -101,3 +101,55 @@ func TestComplete(t *testing.T) {
 	msg2 := q.Dequeue()
 	require.Nil(t, msg2)
 }
+
+func TestComplete_DequeuedMessage(t *testing.T) {
+	q := NewInMemQueue(2 * time.Millisecond)
+
+	q.Enqueue(&queue.Message{
+		Data: []byte("test"),
+	})
+
+	msg1 := q.Dequeue()
+	require.Equal(t, 1, msg1.DequeueCount)
+
+	// Message Lock duration is 2 ms, after 10 ms, mesage will be dequeued again.
+	time.Sleep(10 * time.Millisecond)
+
+	msg2 := q.Dequeue()
+	require.Equal(t, msg1.ID, msg2.ID)
+	require.Equal(t, 2, msg2.DequeueCount)
+
+	err := q.Complete(msg1)
+	require.ErrorIs(t, err, queue.ErrDequeuedMessage)
+
+	err = q.Complete(msg2)
+	require.NoError(t, err)
+}
+
+func TestEnqueue_DeduplicationKey(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+
+	q.Enqueue(&queue.Message{
+		Metadata: queue.Metadata{DeduplicationKey: "key"},
+		Data:     []byte("test1"),
+	})
+	q.Enqueue(&queue.Message{
+		Metadata: queue.Metadata{DeduplicationKey: "key"},
+		Data:     []byte("test2"),
+	})
+	require.Equal(t, 1, q.Len())
+
+	msg := q.Dequeue()
+	require.Equal(t, []byte("test1"), msg.Data)
+	require.Equal(t, "key", msg.DeduplicationKey)
+
+	err := q.Complete(msg)
+	require.NoError(t, err)
+
+	// The key can be reused once the message is completed.
+	q.Enqueue(&queue.Message{
+		Metadata: queue.Metadata{DeduplicationKey: "key"},
+		Data:     []byte("test3"),
+	})
+	require.Equal(t, 1, q.Len())
+}
@@ -38,6 +38,8 @@ type Message struct {
 type Metadata struct {
 	// ID represents the unique id of message.
 	ID string
+	// DeduplicationKey represents the key to detect duplicated messages, such as the operation ID.
+	DeduplicationKey string
 	// DequeueCount represents the number of dequeue.
 	DequeueCount int
 	// EnqueueAt represents the time when enqueuing the message
@@ -46,6 +48,9 @@ type Metadata struct {
 	ExpireAt time.Time
 	// NextVisibleAt represents the next visible time after dequeuing the message.
 	NextVisibleAt time.Time
+	// LockToken represents the token of the lease of the dequeued message, for the queues which identify the lease
+	// separately from the message, such as the lock token of Azure Service Bus or the receipt handle of AWS SQS.
+	LockToken string
 }
 
 // NewMessage creates Message.
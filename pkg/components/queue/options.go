@@ -21,6 +21,8 @@ import "time"
 type (
 	// EnqueueOptions applies an option to Enqueue().
 	EnqueueOptions interface {
+		// ApplyEnqueueOption applies EnqueueOptions to EnqueueConfig.
+		ApplyEnqueueOption(EnqueueConfig) EnqueueConfig
 		// A private method to prevent users implementing the
 		// interface and so future additions to it will not
 		// violate compatibility.
@@ -37,6 +39,43 @@ type (
 	}
 )
 
+// EnqueueConfig is a configuration for Enqueue().
+type EnqueueConfig struct {
+	// DeduplicationKey is the key to detect duplicated messages. Enqueue does not add a message to the queue
+	// when the queue already has a message with the same key which is not finished yet.
+	DeduplicationKey string
+}
+
+type enqueueOptions struct {
+	fn func(EnqueueConfig) EnqueueConfig
+}
+
+// ApplyEnqueueOption applies the configuration to Enqueue().
+func (q *enqueueOptions) ApplyEnqueueOption(cfg EnqueueConfig) EnqueueConfig {
+	return q.fn(cfg)
+}
+
+// WithDeduplicationKey sets the deduplication key of the message, such as the operation ID.
+func WithDeduplicationKey(key string) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.DeduplicationKey = key
+			return cfg
+		},
+	}
+}
+
+func (q enqueueOptions) private() {}
+
+// NewEnqueueConfig returns new enqueue config for Enqueue().
+func NewEnqueueConfig(opts ...EnqueueOptions) EnqueueConfig {
+	cfg := EnqueueConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyEnqueueOption(cfg)
+	}
+	return cfg
+}
+
 // QueueClientConfig is a configuration for queue client APIs.
 type QueueClientConfig struct {
 	// DequeueIntervalDuration is the time duration between 2 successive dequeue attempts on the queue
@@ -21,10 +21,15 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/components/database/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/components/queue/apiserver"
 	qinmem "github.com/radius-project/radius/pkg/components/queue/inmemory"
+	"github.com/radius-project/radius/pkg/components/queue/servicebus"
+	qsqs "github.com/radius-project/radius/pkg/components/queue/sqs"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -34,8 +39,10 @@ import (
 type factoryFunc func(context.Context, QueueProviderOptions) (queue.Client, error)
 
 var clientFactory = map[QueueProviderType]factoryFunc{
-	TypeInmemory:  initInMemory,
-	TypeAPIServer: initAPIServer,
+	TypeInmemory:   initInMemory,
+	TypeAPIServer:  initAPIServer,
+	TypeServiceBus: initServiceBus,
+	TypeSQS:        initSQS,
 }
 
 func initInMemory(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
@@ -79,3 +86,43 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		Namespace: opt.APIServer.Namespace,
 	})
 }
+
+func initServiceBus(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	if opt.ServiceBus.Endpoint == "" {
+		return nil, errors.New("failed to initialize Service Bus client: endpoint is required")
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Service Bus client: %w", err)
+	}
+
+	return servicebus.New(cred, servicebus.Options{
+		Name:                opt.Name,
+		Endpoint:            opt.ServiceBus.Endpoint,
+		QueueName:           opt.ServiceBus.QueueName,
+		MessageLockDuration: opt.ServiceBus.LockDuration,
+	}, nil)
+}
+
+func initSQS(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	if opt.SQS.QueueURL == "" {
+		return nil, errors.New("failed to initialize SQS client: queueUrl is required")
+	}
+
+	optFns := []func(*config.LoadOptions) error{}
+	if opt.SQS.Region != "" {
+		optFns = append(optFns, config.WithRegion(opt.SQS.Region))
+	}
+
+	awscfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize SQS client: %w", err)
+	}
+
+	return qsqs.New(sqs.NewFromConfig(awscfg), qsqs.Options{
+		Name:                opt.Name,
+		QueueURL:            opt.SQS.QueueURL,
+		MessageLockDuration: opt.SQS.VisibilityTimeout,
+	})
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package queueprovider
 
+import "time"
+
 // QueueProviderOptions represents the queueprovider options.
 type QueueProviderOptions struct {
 	// Provider configures the queue provider.
@@ -29,6 +31,10 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+	// ServiceBus configures options for the Azure Service Bus queue. (Optional)
+	ServiceBus ServiceBusOptions `yaml:"serviceBus,omitempty"`
+	// SQS configures options for the AWS SQS queue. (Optional)
+	SQS SQSOptions `yaml:"sqs,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
@@ -44,3 +50,26 @@ type APIServerOptions struct {
 	// Namespace configures the Kubernetes namespace used for data-storage. The namespace must already exist.
 	Namespace string `yaml:"namespace"`
 }
+
+// ServiceBusOptions represents the options of the Azure Service Bus queue. The queue is accessed with the default
+// Azure credential, such as the workload identity of the control plane.
+type ServiceBusOptions struct {
+	// Endpoint configures the endpoint of the Service Bus namespace, such as https://<namespace>.servicebus.windows.net/.
+	Endpoint string `yaml:"endpoint"`
+	// QueueName configures the name of the queue. The queue must already exist. Defaults to the name of the queue provider.
+	QueueName string `yaml:"queueName,omitempty"`
+	// LockDuration configures the lock duration of the queue. It is used when Service Bus does not return the expiry of
+	// the lock, and should match the lock duration configured on the queue.
+	LockDuration time.Duration `yaml:"lockDuration,omitempty"`
+}
+
+// SQSOptions represents the options of the AWS SQS queue. The queue is accessed with the default AWS credential chain,
+// such as the IRSA credential of the control plane.
+type SQSOptions struct {
+	// QueueURL configures the URL of the queue. The queue must already exist. Use a FIFO queue to deduplicate messages.
+	QueueURL string `yaml:"queueUrl"`
+	// Region configures the AWS region of the queue.
+	Region string `yaml:"region,omitempty"`
+	// VisibilityTimeout configures the visibility timeout of the dequeued messages.
+	VisibilityTimeout time.Duration `yaml:"visibilityTimeout,omitempty"`
+}
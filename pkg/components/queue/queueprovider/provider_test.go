@@ -47,3 +47,17 @@ func TestGetClient_InvalidQueue(t *testing.T) {
 	_, err := p.GetClient(context.TODO())
 	require.ErrorIs(t, ErrUnsupportedQueueProvider, err)
 }
+
+func TestGetClient_MissingRequiredOptions(t *testing.T) {
+	for _, provider := range []QueueProviderType{TypeAPIServer, TypeServiceBus, TypeSQS} {
+		t.Run(string(provider), func(t *testing.T) {
+			p := New(QueueProviderOptions{
+				Name:     "Applications.Core",
+				Provider: provider,
+			})
+
+			_, err := p.GetClient(context.TODO())
+			require.Error(t, err)
+		})
+	}
+}
@@ -25,4 +25,8 @@ const (
 
 	// TypeAPIServer represents the Kubernetes APIServer provider.
 	TypeAPIServer QueueProviderType = "apiserver"
+	// TypeServiceBus represents the Azure Service Bus queue provider.
+	TypeServiceBus QueueProviderType = "servicebus"
+	// TypeSQS represents the AWS SQS queue provider.
+	TypeSQS QueueProviderType = "sqs"
 )
@@ -0,0 +1,384 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servicebus is the queue implementation backed by an Azure Service Bus queue, for the installations of the
+// control plane on Azure. The client uses the azservicebus SDK:
+//
+//  1. Enqueue: sends the message to the queue. The deduplication key is used as the id of the message, so the message is
+//     not enqueued again within the duplicate detection window of the queue, if duplicate detection is enabled.
+//  2. Dequeue: receives the message in peek-lock mode. The message is invisible to the other clients until the lock
+//     expires. The received message is kept by the client, keyed by its lock token, to settle it later.
+//  3. FinishMessage: completes the message, which deletes it from the queue.
+//  4. ExtendMessage: renews the lock of the message.
+//  5. Len: reads the number of active messages from the runtime properties of the queue.
+//
+// The lock of a message can only be renewed or completed by the client that received it. The other clients get
+// queue.ErrDequeuedMessage, as if the lock had expired.
+package servicebus
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus/admin"
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/components/queue"
+)
+
+const (
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+
+	// defaultReceiveTimeout is the time to wait for a message before reporting that the queue is empty.
+	defaultReceiveTimeout = time.Duration(1) * time.Second
+)
+
+var _ queue.Client = (*Client)(nil)
+var _ queue.Measurer = (*Client)(nil)
+
+// Sender is the subset of the operations of the Service Bus sender used by the queue.
+type Sender interface {
+	SendMessage(ctx context.Context, message *azservicebus.Message, options *azservicebus.SendMessageOptions) error
+}
+
+// Receiver is the subset of the operations of the Service Bus receiver used by the queue.
+type Receiver interface {
+	ReceiveMessages(ctx context.Context, maxMessages int, options *azservicebus.ReceiveMessagesOptions) ([]*azservicebus.ReceivedMessage, error)
+	CompleteMessage(ctx context.Context, message *azservicebus.ReceivedMessage, options *azservicebus.CompleteMessageOptions) error
+	RenewMessageLock(ctx context.Context, message *azservicebus.ReceivedMessage, options *azservicebus.RenewMessageLockOptions) error
+}
+
+// Administrator is the subset of the operations of the Service Bus administration client used by the queue.
+type Administrator interface {
+	GetQueueRuntimeProperties(ctx context.Context, queueName string, options *admin.GetQueueRuntimePropertiesOptions) (*admin.GetQueueRuntimePropertiesResponse, error)
+}
+
+var _ = Sender(&azservicebus.Sender{})
+var _ = Receiver(&azservicebus.Receiver{})
+var _ = Administrator(&admin.Client{})
+
+// Client is the queue client backed by an Azure Service Bus queue.
+type Client struct {
+	sender   Sender
+	receiver Receiver
+	admin    Administrator
+
+	// receiveMu serializes the calls to ReceiveMessages, which cannot be called concurrently.
+	receiveMu sync.Mutex
+
+	// mu guards locked.
+	mu sync.Mutex
+	// locked holds the received messages which are not completed yet, keyed by their lock token.
+	locked map[string]*azservicebus.ReceivedMessage
+
+	opts Options
+}
+
+// Options is the options to create Service Bus queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+	// Endpoint represents the endpoint of the Service Bus namespace, e.g. 'https://radius.servicebus.windows.net'.
+	Endpoint string
+	// QueueName represents the name of the Service Bus queue. Defaults to Name.
+	QueueName string
+
+	// MessageLockDuration represents the lock duration of the Service Bus queue. It is used when Service Bus does not
+	// return the expiry of the lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the time to live of the messages.
+	ExpiryDuration time.Duration
+	// ReceiveTimeout represents the time to wait for a message before reporting that the queue is empty.
+	ReceiveTimeout time.Duration
+}
+
+// New creates the queue client backed by the Service Bus queue.
+func New(credential azcore.TokenCredential, options Options, clientOptions *azservicebus.ClientOptions) (*Client, error) {
+	if options.Name == "" || options.Endpoint == "" {
+		return nil, errors.New("Name and Endpoint are required")
+	}
+
+	if options.QueueName == "" {
+		options.QueueName = options.Name
+	}
+
+	client, err := azservicebus.NewClient(namespace(options.Endpoint), credential, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := client.NewSender(options.QueueName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := client.NewReceiverForQueue(options.QueueName, &azservicebus.ReceiverOptions{ReceiveMode: azservicebus.ReceiveModePeekLock})
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient, err := admin.NewClient(namespace(options.Endpoint), credential, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(sender, receiver, adminClient, options), nil
+}
+
+func newClient(sender Sender, receiver Receiver, admin Administrator, options Options) *Client {
+	if options.QueueName == "" {
+		options.QueueName = options.Name
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	if options.ReceiveTimeout == time.Duration(0) {
+		options.ReceiveTimeout = defaultReceiveTimeout
+	}
+
+	return &Client{
+		sender:   sender,
+		receiver: receiver,
+		admin:    admin,
+		locked:   map[string]*azservicebus.ReceivedMessage{},
+		opts:     options,
+	}
+}
+
+// namespace returns the fully qualified namespace of Service Bus from the endpoint, which can be either a URL or
+// the host name of the namespace.
+func namespace(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+
+	return u.Host
+}
+
+func generateID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", b), nil
+}
+
+func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return queue.ErrEmptyMessage
+	}
+
+	if msg.ContentType != queue.JSONContentType {
+		return queue.ErrUnsupportedContentType
+	}
+
+	cfg := queue.NewEnqueueConfig(options...)
+	id := cfg.DeduplicationKey
+	if id == "" {
+		var err error
+		id, err = generateID()
+		if err != nil {
+			return err
+		}
+	}
+
+	contentType := queue.JSONContentType
+	return c.sender.SendMessage(ctx, &azservicebus.Message{
+		MessageID:   &id,
+		ContentType: &contentType,
+		Body:        msg.Data,
+		TimeToLive:  &c.opts.ExpiryDuration,
+	}, nil)
+}
+
+func (c *Client) Dequeue(ctx context.Context, opts queue.QueueClientConfig) (*queue.Message, error) {
+	c.receiveMu.Lock()
+	defer c.receiveMu.Unlock()
+
+	// ReceiveMessages waits until a message is received, the dequeuer polls the queue.
+	receiveCtx, cancel := context.WithTimeout(ctx, c.opts.ReceiveTimeout)
+	defer cancel()
+
+	messages, err := c.receiver.ReceiveMessages(receiveCtx, 1, nil)
+	if err != nil {
+		if ctx.Err() == nil && receiveCtx.Err() != nil {
+			return nil, queue.ErrMessageNotFound
+		}
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return nil, queue.ErrMessageNotFound
+	}
+
+	received := messages[0]
+	token := lockToken(received)
+
+	c.mu.Lock()
+	c.removeExpiredLocks()
+	c.locked[token] = received
+	c.mu.Unlock()
+
+	msg := &queue.Message{
+		Metadata: queue.Metadata{
+			ID:            received.MessageID,
+			DequeueCount:  int(received.DeliveryCount),
+			EnqueueAt:     timeOrZero(received.EnqueuedTime),
+			ExpireAt:      timeOrZero(received.ExpiresAt),
+			NextVisibleAt: c.lockedUntil(received),
+			LockToken:     token,
+		},
+		ContentType: queue.JSONContentType,
+		Data:        received.Body,
+	}
+
+	return msg, nil
+}
+
+func (c *Client) FinishMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	received, err := c.lockedMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := c.receiver.CompleteMessage(ctx, received, nil); err != nil {
+		return c.checkLockError(msg, err)
+	}
+
+	c.mu.Lock()
+	delete(c.locked, msg.LockToken)
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	received, err := c.lockedMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := c.receiver.RenewMessageLock(ctx, received, nil); err != nil {
+		return c.checkLockError(msg, err)
+	}
+
+	msg.NextVisibleAt = c.lockedUntil(received)
+	return nil
+}
+
+// Name returns the name of the queue.
+func (c *Client) Name() string {
+	return c.opts.Name
+}
+
+// Len returns the number of active messages in the queue, including the messages locked by clients. The scheduled and
+// dead-lettered messages are not counted.
+func (c *Client) Len(ctx context.Context) (int, error) {
+	resp, err := c.admin.GetQueueRuntimeProperties(ctx, c.opts.QueueName, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	// Service Bus returns no properties if the queue does not exist.
+	if resp == nil {
+		return 0, fmt.Errorf("queue %q is not found", c.opts.QueueName)
+	}
+
+	return int(resp.ActiveMessageCount), nil
+}
+
+// lockedMessage returns the received message of the given message. It returns queue.ErrDequeuedMessage if the message
+// was not received by this client or its lock was lost.
+func (c *Client) lockedMessage(msg *queue.Message) (*azservicebus.ReceivedMessage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	received, ok := c.locked[msg.LockToken]
+	if !ok || received.MessageID != msg.ID {
+		return nil, queue.ErrDequeuedMessage
+	}
+
+	return received, nil
+}
+
+// checkLockError checks the error of the operations on a locked message. Service Bus reports a lost lock when the lock
+// of the message has expired, in which case the message may have been received by the other client.
+func (c *Client) checkLockError(msg *queue.Message, err error) error {
+	var sbErr *azservicebus.Error
+	if errors.As(err, &sbErr) && sbErr.Code == azservicebus.CodeLockLost {
+		c.mu.Lock()
+		delete(c.locked, msg.LockToken)
+		c.mu.Unlock()
+		return queue.ErrDequeuedMessage
+	}
+
+	return err
+}
+
+// removeExpiredLocks removes the messages whose lock has expired, since they cannot be settled anymore. The caller
+// must hold mu.
+func (c *Client) removeExpiredLocks() {
+	now := time.Now()
+	for token, received := range c.locked {
+		if received.LockedUntil != nil && received.LockedUntil.Before(now) {
+			delete(c.locked, token)
+		}
+	}
+}
+
+// lockedUntil returns the expiry of the lock of the received message, or the lock duration of the queue from now if
+// Service Bus did not return it.
+func (c *Client) lockedUntil(received *azservicebus.ReceivedMessage) time.Time {
+	if received.LockedUntil == nil {
+		return time.Now().Add(c.opts.MessageLockDuration)
+	}
+
+	return *received.LockedUntil
+}
+
+func lockToken(received *azservicebus.ReceivedMessage) string {
+	return uuid.UUID(received.LockToken).String()
+}
+
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
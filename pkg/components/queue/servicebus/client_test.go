@@ -0,0 +1,320 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicebus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus/admin"
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCredential struct{}
+
+func (fakeCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// fakeServiceBus is a fake of a Service Bus queue with a single lock per message.
+type fakeServiceBus struct {
+	mu       sync.Mutex
+	messages []*azservicebus.ReceivedMessage
+	locked   map[string]bool
+
+	// receiveErr is returned by ReceiveMessages if set.
+	receiveErr error
+	// deleted is true if the queue has been deleted.
+	deleted bool
+}
+
+func (f *fakeServiceBus) SendMessage(ctx context.Context, message *azservicebus.Message, options *azservicebus.SendMessageOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, m := range f.messages {
+		if m.MessageID == *message.MessageID {
+			// Duplicate detection.
+			return nil
+		}
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(*message.TimeToLive)
+	f.messages = append(f.messages, &azservicebus.ReceivedMessage{
+		MessageID:    *message.MessageID,
+		ContentType:  message.ContentType,
+		Body:         message.Body,
+		TimeToLive:   message.TimeToLive,
+		EnqueuedTime: &now,
+		ExpiresAt:    &expiresAt,
+	})
+	return nil
+}
+
+func (f *fakeServiceBus) ReceiveMessages(ctx context.Context, maxMessages int, options *azservicebus.ReceiveMessagesOptions) ([]*azservicebus.ReceivedMessage, error) {
+	f.mu.Lock()
+	if f.receiveErr != nil {
+		f.mu.Unlock()
+		return nil, f.receiveErr
+	}
+
+	for _, m := range f.messages {
+		if f.locked[m.MessageID] {
+			continue
+		}
+		f.locked[m.MessageID] = true
+		m.DeliveryCount++
+		m.LockToken = uuid.New()
+		lockedUntil := time.Now().Add(time.Minute)
+		m.LockedUntil = &lockedUntil
+		f.mu.Unlock()
+
+		// Return a copy, like the messages received from the service.
+		received := *m
+		return []*azservicebus.ReceivedMessage{&received}, nil
+	}
+	f.mu.Unlock()
+
+	// The queue is empty, wait for a message until the context is done.
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (f *fakeServiceBus) CompleteMessage(ctx context.Context, message *azservicebus.ReceivedMessage, options *azservicebus.CompleteMessageOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, m := range f.messages {
+		if f.locked[m.MessageID] && m.MessageID == message.MessageID && m.LockToken == message.LockToken {
+			f.messages = append(f.messages[:i], f.messages[i+1:]...)
+			delete(f.locked, m.MessageID)
+			return nil
+		}
+	}
+
+	return &azservicebus.Error{Code: azservicebus.CodeLockLost}
+}
+
+func (f *fakeServiceBus) RenewMessageLock(ctx context.Context, message *azservicebus.ReceivedMessage, options *azservicebus.RenewMessageLockOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, m := range f.messages {
+		if f.locked[m.MessageID] && m.MessageID == message.MessageID && m.LockToken == message.LockToken {
+			lockedUntil := time.Now().Add(2 * time.Minute)
+			message.LockedUntil = &lockedUntil
+			return nil
+		}
+	}
+
+	return &azservicebus.Error{Code: azservicebus.CodeLockLost}
+}
+
+func (f *fakeServiceBus) GetQueueRuntimeProperties(ctx context.Context, queueName string, options *admin.GetQueueRuntimePropertiesOptions) (*admin.GetQueueRuntimePropertiesResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.deleted {
+		return nil, nil
+	}
+
+	return &admin.GetQueueRuntimePropertiesResponse{
+		QueueName: queueName,
+		QueueRuntimeProperties: admin.QueueRuntimeProperties{
+			TotalMessageCount:  int64(len(f.messages)),
+			ActiveMessageCount: int32(len(f.messages)),
+		},
+	}, nil
+}
+
+// expireLocks releases the locks of all messages, as if they had expired.
+func (f *fakeServiceBus) expireLocks() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.locked = map[string]bool{}
+}
+
+func newTestClient() (*Client, *fakeServiceBus) {
+	fake := &fakeServiceBus{locked: map[string]bool{}}
+	client := newClient(fake, fake, fake, Options{Name: "applications.core", ReceiveTimeout: 10 * time.Millisecond})
+	return client, fake
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(fakeCredential{}, Options{Name: "applications.core"}, nil)
+	require.Error(t, err)
+
+	client, err := New(fakeCredential{}, Options{Name: "applications.core", Endpoint: "https://radius.servicebus.windows.net/"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "applications.core", client.opts.QueueName)
+	require.Equal(t, defaultMessageLockDuration, client.opts.MessageLockDuration)
+	require.Equal(t, defaultExpiryDuration, client.opts.ExpiryDuration)
+	require.Equal(t, defaultReceiveTimeout, client.opts.ReceiveTimeout)
+}
+
+func TestLen(t *testing.T) {
+	ctx := context.Background()
+	client, fake := newTestClient()
+	require.Equal(t, "applications.core", client.Name())
+
+	for _, data := range []string{`{"id": "1"}`, `{"id": "2"}`} {
+		err := client.Enqueue(ctx, queue.NewMessage(data))
+		require.NoError(t, err)
+	}
+
+	// The locked message is still counted.
+	_, err := client.Dequeue(ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	count, err := client.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	fake.deleted = true
+	_, err = client.Len(ctx)
+	require.ErrorContains(t, err, "queue \"applications.core\" is not found")
+}
+
+func TestNamespace(t *testing.T) {
+	require.Equal(t, "radius.servicebus.windows.net", namespace("https://radius.servicebus.windows.net/"))
+	require.Equal(t, "radius.servicebus.windows.net", namespace("radius.servicebus.windows.net"))
+}
+
+func TestGenerateID(t *testing.T) {
+	id, err := generateID()
+	require.NoError(t, err)
+	require.Len(t, id, 32)
+	require.NotContains(t, id, " ")
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nil message", func(t *testing.T) {
+		client, _ := newTestClient()
+		require.ErrorIs(t, client.Enqueue(ctx, nil), queue.ErrEmptyMessage)
+		require.ErrorIs(t, client.Enqueue(ctx, &queue.Message{Data: []byte("{}")}), queue.ErrUnsupportedContentType)
+		require.ErrorIs(t, client.FinishMessage(ctx, nil), queue.ErrEmptyMessage)
+		require.ErrorIs(t, client.ExtendMessage(ctx, nil), queue.ErrEmptyMessage)
+	})
+
+	t.Run("enqueue, dequeue and finish message", func(t *testing.T) {
+		client, fake := newTestClient()
+
+		err := client.Enqueue(ctx, queue.NewMessage(map[string]string{"operation": "1"}))
+		require.NoError(t, err)
+		require.Len(t, fake.messages, 1)
+		require.Equal(t, defaultExpiryDuration, *fake.messages[0].TimeToLive)
+		require.Equal(t, queue.JSONContentType, *fake.messages[0].ContentType)
+
+		msg, err := client.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"operation":"1"}`, string(msg.Data))
+		require.Equal(t, queue.JSONContentType, msg.ContentType)
+		require.Equal(t, 1, msg.DequeueCount)
+		require.Equal(t, uuid.UUID(fake.messages[0].LockToken).String(), msg.LockToken)
+		require.Equal(t, *fake.messages[0].LockedUntil, msg.NextVisibleAt)
+		require.Equal(t, msg.EnqueueAt.Add(defaultExpiryDuration), msg.ExpireAt)
+
+		// The message is locked.
+		_, err = client.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+		err = client.ExtendMessage(ctx, msg)
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(2*time.Minute), msg.NextVisibleAt, 5*time.Second)
+
+		err = client.FinishMessage(ctx, msg)
+		require.NoError(t, err)
+		require.Empty(t, fake.messages)
+		require.Empty(t, client.locked)
+
+		// The lock is lost once the message is finished.
+		require.ErrorIs(t, client.FinishMessage(ctx, msg), queue.ErrDequeuedMessage)
+		require.ErrorIs(t, client.ExtendMessage(ctx, msg), queue.ErrDequeuedMessage)
+	})
+
+	t.Run("enqueue messages with deduplication key", func(t *testing.T) {
+		client, fake := newTestClient()
+
+		for i := 0; i < 2; i++ {
+			err := client.Enqueue(ctx, queue.NewMessage("{}"), queue.WithDeduplicationKey("operation-1"))
+			require.NoError(t, err)
+		}
+		require.Len(t, fake.messages, 1)
+		require.Equal(t, "operation-1", fake.messages[0].MessageID)
+	})
+
+	t.Run("lock lost", func(t *testing.T) {
+		client, fake := newTestClient()
+
+		err := client.Enqueue(ctx, queue.NewMessage("{}"))
+		require.NoError(t, err)
+
+		first, err := client.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		// The lock expires and the message is received again.
+		fake.expireLocks()
+		second, err := client.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, first.ID, second.ID)
+		require.Equal(t, 2, second.DequeueCount)
+		require.NotEqual(t, first.LockToken, second.LockToken)
+
+		require.ErrorIs(t, client.ExtendMessage(ctx, first), queue.ErrDequeuedMessage)
+		require.ErrorIs(t, client.FinishMessage(ctx, first), queue.ErrDequeuedMessage)
+		require.NotContains(t, client.locked, first.LockToken)
+
+		require.NoError(t, client.FinishMessage(ctx, second))
+	})
+
+	t.Run("message received by another client", func(t *testing.T) {
+		client, _ := newTestClient()
+
+		msg := &queue.Message{Metadata: queue.Metadata{ID: "operation-1", LockToken: uuid.NewString()}}
+		require.ErrorIs(t, client.FinishMessage(ctx, msg), queue.ErrDequeuedMessage)
+		require.ErrorIs(t, client.ExtendMessage(ctx, msg), queue.ErrDequeuedMessage)
+	})
+
+	t.Run("receive error", func(t *testing.T) {
+		client, fake := newTestClient()
+		fake.receiveErr = &azservicebus.Error{Code: azservicebus.CodeUnauthorizedAccess}
+
+		_, err := client.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, fake.receiveErr)
+	})
+
+	t.Run("dequeue cancelled", func(t *testing.T) {
+		client, _ := newTestClient()
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := client.Dequeue(cancelled, queue.QueueClientConfig{})
+		require.True(t, errors.Is(err, context.Canceled))
+	})
+}
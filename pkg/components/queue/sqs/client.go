@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqs is the queue implementation backed by an AWS SQS queue, for the installations of the control plane on AWS.
+//
+//  1. Enqueue: sends the message to the queue. On FIFO queues, the hash of the deduplication key is used as the
+//     deduplication id of the message, so the message is not enqueued again within the deduplication interval of SQS.
+//     Standard queues do not support deduplication, the deduplication key is ignored.
+//  2. Dequeue: receives the message with the visibility timeout set to MessageLockDuration. The message is invisible
+//     to the other clients until the visibility timeout expires. The receipt handle is kept as the lock token of the
+//     message. Dequeue long polls the queue for up to receiveWaitTime, so an empty queue is not polled continuously.
+//  3. FinishMessage: deletes the message from the queue.
+//  4. ExtendMessage: changes the visibility timeout of the message.
+package sqs
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/radius-project/radius/pkg/components/queue"
+)
+
+const (
+	// fifoSuffix is the suffix of the names of FIFO queues.
+	fifoSuffix = ".fifo"
+
+	// deduplicationKeyAttribute is the message attribute holding the deduplication key of the message.
+	deduplicationKeyAttribute = "DeduplicationKey"
+
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+
+	// receiveWaitTime is the time Dequeue waits for a message to arrive in an empty queue. It is the maximum wait
+	// time of the long polling of SQS.
+	receiveWaitTime = time.Duration(20) * time.Second
+)
+
+var _ queue.Client = (*Client)(nil)
+var _ queue.Measurer = (*Client)(nil)
+
+// SQSClient is the subset of the operations of the SQS client used by the queue.
+//
+//go:generate mockgen -typed -destination=./mock_sqsclient.go -package=sqs -self_package github.com/radius-project/radius/pkg/components/queue/sqs github.com/radius-project/radius/pkg/components/queue/sqs SQSClient
+type SQSClient interface {
+	SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)
+	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
+	DeleteMessage(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)
+	ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error)
+	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+}
+
+var _ = SQSClient(&sqs.Client{})
+
+// Client is the queue client backed by an AWS SQS queue.
+type Client struct {
+	client SQSClient
+
+	opts Options
+}
+
+// Options is the options to create SQS queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+	// QueueURL represents the URL of the SQS queue.
+	QueueURL string
+
+	// MessageLockDuration represents the visibility timeout of the dequeued messages.
+	MessageLockDuration time.Duration
+}
+
+// New creates the queue client backed by the SQS queue.
+func New(client SQSClient, options Options) (*Client, error) {
+	if options.Name == "" || options.QueueURL == "" {
+		return nil, errors.New("Name and QueueURL are required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	return &Client{client: client, opts: options}, nil
+}
+
+func (c *Client) isFIFO() bool {
+	return strings.HasSuffix(c.opts.QueueURL, fifoSuffix)
+}
+
+// generateDeduplicationID generates the deduplication id of the message from the deduplication key. The deduplication
+// id is limited to 128 characters.
+func generateDeduplicationID(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("%x", h)
+}
+
+func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return queue.ErrEmptyMessage
+	}
+
+	if msg.ContentType != queue.JSONContentType {
+		return queue.ErrUnsupportedContentType
+	}
+
+	cfg := queue.NewEnqueueConfig(options...)
+	input := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(c.opts.QueueURL),
+		MessageBody: aws.String(string(msg.Data)),
+	}
+
+	if cfg.DeduplicationKey != "" {
+		input.MessageAttributes = map[string]types.MessageAttributeValue{
+			deduplicationKeyAttribute: {DataType: aws.String("String"), StringValue: aws.String(cfg.DeduplicationKey)},
+		}
+	}
+
+	if c.isFIFO() {
+		// Messages of different groups are delivered in parallel. Each message has its own group, the queue does not
+		// need to preserve the order of the messages.
+		id := generateDeduplicationID(cfg.DeduplicationKey)
+		if cfg.DeduplicationKey == "" {
+			id = generateDeduplicationID(fmt.Sprintf("%s.%d", c.opts.Name, time.Now().UnixNano()))
+		}
+		input.MessageDeduplicationId = aws.String(id)
+		input.MessageGroupId = aws.String(id)
+	}
+
+	_, err := c.client.SendMessage(ctx, input)
+	return err
+}
+
+func (c *Client) Dequeue(ctx context.Context, opts queue.QueueClientConfig) (*queue.Message, error) {
+	now := time.Now()
+	output, err := c.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(c.opts.QueueURL),
+		MaxNumberOfMessages: 1,
+		VisibilityTimeout:   int32(c.opts.MessageLockDuration.Seconds()),
+		WaitTimeSeconds:     int32(receiveWaitTime.Seconds()),
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{
+			types.MessageSystemAttributeNameApproximateReceiveCount,
+			types.MessageSystemAttributeNameSentTimestamp,
+		},
+		MessageAttributeNames: []string{deduplicationKeyAttribute},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Messages) == 0 {
+		return nil, queue.ErrMessageNotFound
+	}
+
+	message := output.Messages[0]
+	msg := &queue.Message{
+		Metadata: queue.Metadata{
+			ID:            aws.ToString(message.MessageId),
+			NextVisibleAt: now.Add(c.opts.MessageLockDuration),
+			LockToken:     aws.ToString(message.ReceiptHandle),
+		},
+		ContentType: queue.JSONContentType,
+		Data:        []byte(aws.ToString(message.Body)),
+	}
+
+	if count, err := strconv.Atoi(message.Attributes[string(types.MessageSystemAttributeNameApproximateReceiveCount)]); err == nil {
+		msg.DequeueCount = count
+	}
+	if msec, err := strconv.ParseInt(message.Attributes[string(types.MessageSystemAttributeNameSentTimestamp)], 10, 64); err == nil {
+		msg.EnqueueAt = time.UnixMilli(msec)
+	}
+	if attribute, ok := message.MessageAttributes[deduplicationKeyAttribute]; ok {
+		msg.DeduplicationKey = aws.ToString(attribute.StringValue)
+	}
+
+	return msg, nil
+}
+
+func (c *Client) FinishMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	_, err := c.client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(c.opts.QueueURL),
+		ReceiptHandle: aws.String(msg.LockToken),
+	})
+
+	return convertError(err)
+}
+
+func (c *Client) ExtendMessage(ctx context.Context, msg *queue.Message) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	_, err := c.client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          aws.String(c.opts.QueueURL),
+		ReceiptHandle:     aws.String(msg.LockToken),
+		VisibilityTimeout: int32(c.opts.MessageLockDuration.Seconds()),
+	})
+	if err != nil {
+		return convertError(err)
+	}
+
+	msg.NextVisibleAt = now.Add(c.opts.MessageLockDuration)
+	return nil
+}
+
+// convertError converts the errors of the operations on a received message. The receipt handle is invalid once the
+// message is deleted, and the message is no longer in flight once its visibility timeout expires.
+func convertError(err error) error {
+	var invalid *types.ReceiptHandleIsInvalid
+	var notInflight *types.MessageNotInflight
+	if errors.As(err, &invalid) {
+		return queue.ErrInvalidMessage
+	} else if errors.As(err, &notInflight) {
+		return queue.ErrDequeuedMessage
+	}
+
+	return err
+}
+
+// Name returns the name of the queue.
+func (c *Client) Name() string {
+	return c.opts.Name
+}
+
+// Len returns the approximate number of messages in the queue, including the messages leased by clients.
+func (c *Client) Len(ctx context.Context) (int, error) {
+	output, err := c.client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: aws.String(c.opts.QueueURL),
+		AttributeNames: []types.QueueAttributeName{
+			types.QueueAttributeNameApproximateNumberOfMessages,
+			types.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, name := range []types.QueueAttributeName{types.QueueAttributeNameApproximateNumberOfMessages, types.QueueAttributeNameApproximateNumberOfMessagesNotVisible} {
+		count, err := strconv.Atoi(output.Attributes[string(name)])
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse the %s attribute of the queue: %w", name, err)
+		}
+		total += count
+	}
+
+	return total, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/radius-project/radius/pkg/components/queue"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testQueueURL     = "https://sqs.us-west-2.amazonaws.com/123456789012/radius"
+	testFIFOQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/radius.fifo"
+)
+
+func newTestClient(t *testing.T, queueURL string) (*Client, *MockSQSClient) {
+	ctrl := gomock.NewController(t)
+	mock := NewMockSQSClient(ctrl)
+	cli, err := New(mock, Options{Name: "radius", QueueURL: queueURL, MessageLockDuration: time.Minute})
+	require.NoError(t, err)
+	return cli, mock
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, Options{Name: "radius"})
+	require.Error(t, err)
+
+	cli, err := New(nil, Options{Name: "radius", QueueURL: testQueueURL})
+	require.NoError(t, err)
+	require.Equal(t, defaultMessageLockDuration, cli.opts.MessageLockDuration)
+	require.Equal(t, "radius", cli.Name())
+}
+
+func TestEnqueue(t *testing.T) {
+	t.Run("empty message", func(t *testing.T) {
+		cli, _ := newTestClient(t, testQueueURL)
+		err := cli.Enqueue(context.Background(), &queue.Message{})
+		require.ErrorIs(t, err, queue.ErrEmptyMessage)
+	})
+
+	t.Run("standard queue", func(t *testing.T) {
+		cli, mock := newTestClient(t, testQueueURL)
+		mock.EXPECT().SendMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, input *sqs.SendMessageInput, _ ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+				require.Equal(t, testQueueURL, aws.ToString(input.QueueUrl))
+				require.Equal(t, "{}", aws.ToString(input.MessageBody))
+				require.Nil(t, input.MessageDeduplicationId)
+				require.Nil(t, input.MessageGroupId)
+				require.Equal(t, "operation", aws.ToString(input.MessageAttributes[deduplicationKeyAttribute].StringValue))
+				return &sqs.SendMessageOutput{}, nil
+			})
+
+		err := cli.Enqueue(context.Background(), queue.NewMessage("{}"), queue.WithDeduplicationKey("operation"))
+		require.NoError(t, err)
+	})
+
+	t.Run("fifo queue", func(t *testing.T) {
+		cli, mock := newTestClient(t, testFIFOQueueURL)
+		mock.EXPECT().SendMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, input *sqs.SendMessageInput, _ ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+				require.Equal(t, generateDeduplicationID("operation"), aws.ToString(input.MessageDeduplicationId))
+				require.Equal(t, generateDeduplicationID("operation"), aws.ToString(input.MessageGroupId))
+				return &sqs.SendMessageOutput{}, nil
+			})
+
+		err := cli.Enqueue(context.Background(), queue.NewMessage("{}"), queue.WithDeduplicationKey("operation"))
+		require.NoError(t, err)
+	})
+}
+
+func TestDequeue(t *testing.T) {
+	t.Run("no message", func(t *testing.T) {
+		cli, mock := newTestClient(t, testQueueURL)
+		mock.EXPECT().ReceiveMessage(gomock.Any(), gomock.Any()).Return(&sqs.ReceiveMessageOutput{}, nil)
+
+		_, err := cli.Dequeue(context.Background(), queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+	})
+
+	t.Run("message", func(t *testing.T) {
+		cli, mock := newTestClient(t, testQueueURL)
+		sent := time.UnixMilli(time.Now().Add(-time.Minute).UnixMilli())
+		mock.EXPECT().ReceiveMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, input *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+				require.Equal(t, int32(1), input.MaxNumberOfMessages)
+				require.Equal(t, int32(60), input.VisibilityTimeout)
+				require.Equal(t, int32(20), input.WaitTimeSeconds)
+				return &sqs.ReceiveMessageOutput{
+					Messages: []types.Message{
+						{
+							MessageId:     aws.String("id"),
+							ReceiptHandle: aws.String("handle"),
+							Body:          aws.String("{}"),
+							Attributes: map[string]string{
+								string(types.MessageSystemAttributeNameApproximateReceiveCount): "2",
+								string(types.MessageSystemAttributeNameSentTimestamp):           strconv.FormatInt(sent.UnixMilli(), 10),
+							},
+							MessageAttributes: map[string]types.MessageAttributeValue{
+								deduplicationKeyAttribute: {DataType: aws.String("String"), StringValue: aws.String("operation")},
+							},
+						},
+					},
+				}, nil
+			})
+
+		msg, err := cli.Dequeue(context.Background(), queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, "id", msg.ID)
+		require.Equal(t, "handle", msg.LockToken)
+		require.Equal(t, "operation", msg.DeduplicationKey)
+		require.Equal(t, 2, msg.DequeueCount)
+		require.True(t, sent.Equal(msg.EnqueueAt))
+		require.Equal(t, []byte("{}"), msg.Data)
+		require.True(t, msg.NextVisibleAt.After(time.Now()))
+	})
+}
+
+func TestFinishMessage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cli, mock := newTestClient(t, testQueueURL)
+		mock.EXPECT().DeleteMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, input *sqs.DeleteMessageInput, _ ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error) {
+				require.Equal(t, "handle", aws.ToString(input.ReceiptHandle))
+				return &sqs.DeleteMessageOutput{}, nil
+			})
+
+		err := cli.FinishMessage(context.Background(), &queue.Message{Metadata: queue.Metadata{LockToken: "handle"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid receipt handle", func(t *testing.T) {
+		cli, mock := newTestClient(t, testQueueURL)
+		mock.EXPECT().DeleteMessage(gomock.Any(), gomock.Any()).Return(nil, &types.ReceiptHandleIsInvalid{})
+
+		err := cli.FinishMessage(context.Background(), &queue.Message{Metadata: queue.Metadata{LockToken: "handle"}})
+		require.ErrorIs(t, err, queue.ErrInvalidMessage)
+	})
+}
+
+func TestExtendMessage(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cli, mock := newTestClient(t, testQueueURL)
+		mock.EXPECT().ChangeMessageVisibility(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, input *sqs.ChangeMessageVisibilityInput, _ ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+				require.Equal(t, "handle", aws.ToString(input.ReceiptHandle))
+				require.Equal(t, int32(60), input.VisibilityTimeout)
+				return &sqs.ChangeMessageVisibilityOutput{}, nil
+			})
+
+		msg := &queue.Message{Metadata: queue.Metadata{LockToken: "handle"}}
+		err := cli.ExtendMessage(context.Background(), msg)
+		require.NoError(t, err)
+		require.True(t, msg.NextVisibleAt.After(time.Now()))
+	})
+
+	t.Run("message not in flight", func(t *testing.T) {
+		cli, mock := newTestClient(t, testQueueURL)
+		mock.EXPECT().ChangeMessageVisibility(gomock.Any(), gomock.Any()).Return(nil, &types.MessageNotInflight{})
+
+		err := cli.ExtendMessage(context.Background(), &queue.Message{Metadata: queue.Metadata{LockToken: "handle"}})
+		require.ErrorIs(t, err, queue.ErrDequeuedMessage)
+	})
+}
+
+func TestLen(t *testing.T) {
+	cli, mock := newTestClient(t, testQueueURL)
+	mock.EXPECT().GetQueueAttributes(gomock.Any(), gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+		Attributes: map[string]string{
+			string(types.QueueAttributeNameApproximateNumberOfMessages):           "3",
+			string(types.QueueAttributeNameApproximateNumberOfMessagesNotVisible): "2",
+		},
+	}, nil)
+
+	count, err := cli.Len(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+}
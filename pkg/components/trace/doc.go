@@ -26,6 +26,10 @@ In addition, trace package introduces the below helpers:
 * StartCustomSpan(ctx, spanName, tracerName, attr, spanKind) starts a new span with the given names and attributes.
 * StartProducerSpan(ctx, spanName, tracerName) starts a new Producer span with the given names.
 * StartConsumerSpan(ctx, spanName, tracerName) starts a new Consumer span with the given names.
+* AddRetryEvent(ctx, attempt, reason, err) adds a retry event to the current span.
+* AddStateTransitionEvent(ctx, from, to) adds a state transition event to the current span.
+* StartProcessSpan(ctx, tracerName, executable, command) starts a new span for the execution of an external process.
+* EndProcessSpan(span, err, stderr) records the exit code and the truncated standard error of the process and ends the span.
 
 
 # Examples
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ProcessStderrEventName is the name of the span event for the standard error of an external process.
+	ProcessStderrEventName string = "process.stderr"
+
+	// MaxStderrLength is the maximum number of bytes of the standard error of an external process recorded on a span.
+	// The end of the standard error is kept because that is where processes report their errors.
+	MaxStderrLength = 4096
+
+	truncatedStderrPrefix = "[truncated] "
+)
+
+var (
+	// ProcessExitCodeKey is the attribute key for the exit code of an external process.
+	ProcessExitCodeKey = attribute.Key("radius.process.exit_code")
+	// ProcessStderrKey is the attribute key for the truncated standard error of an external process.
+	ProcessStderrKey = attribute.Key("radius.process.stderr")
+)
+
+// StartProcessSpan starts a span for the execution of an external process, such as "terraform init" or "rad-bicep build".
+// The span is named after the executable and the command, the arguments are not recorded because they may contain secrets.
+func StartProcessSpan(ctx context.Context, tracerName string, executable string, command string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		semconv.ProcessExecutableName(executable),
+		semconv.ProcessCommand(command),
+	}
+	return StartCustomSpan(ctx, executable+" "+command, tracerName, attrs, trace.WithSpanKind(trace.SpanKindInternal))
+}
+
+// EndProcessSpan records the result of the execution of an external process and ends the span. err is the error returned by
+// the execution, the exit code is recorded when the process ran to completion. stderr is added as a span event, truncated to
+// the last MaxStderrLength bytes.
+func EndProcessSpan(span trace.Span, err error, stderr string) {
+	defer span.End()
+	if !span.IsRecording() {
+		return
+	}
+
+	var exitErr *exec.ExitError
+	if err == nil {
+		span.SetAttributes(ProcessExitCodeKey.Int(0))
+	} else if errors.As(err, &exitErr) {
+		span.SetAttributes(ProcessExitCodeKey.Int(exitErr.ExitCode()))
+	}
+
+	if stderr != "" {
+		span.AddEvent(ProcessStderrEventName, trace.WithAttributes(ProcessStderrKey.String(truncateStderr(stderr))))
+	}
+
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.AddEvent(semconv.ExceptionEventName, trace.WithAttributes(semconv.ExceptionMessage(err.Error())))
+	} else {
+		span.SetStatus(otelcodes.Ok, "")
+	}
+}
+
+// truncateStderr returns the last MaxStderrLength bytes of stderr as valid UTF-8.
+func truncateStderr(stderr string) string {
+	if len(stderr) <= MaxStderrLength {
+		return stderr
+	}
+
+	return truncatedStderrPrefix + strings.ToValidUTF8(stderr[len(stderr)-MaxStderrLength:], "")
+}
+
+// StderrBuffer is an io.Writer which captures the standard error of an external process for EndProcessSpan. Only the last
+// MaxStderrLength bytes are kept, so the output of a long running process is not held in memory.
+type StderrBuffer struct {
+	mu        sync.Mutex
+	buf       []byte
+	truncated bool
+}
+
+// Write appends p to the buffer and drops the oldest bytes beyond MaxStderrLength.
+func (b *StderrBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if over := len(b.buf) - MaxStderrLength; over > 0 {
+		copy(b.buf, b.buf[over:])
+		b.buf = b.buf[:MaxStderrLength]
+		b.truncated = true
+	}
+
+	return len(p), nil
+}
+
+// String returns the captured standard error as valid UTF-8.
+func (b *StderrBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stderr := strings.ToValidUTF8(string(b.buf), "")
+	if b.truncated {
+		return truncatedStderrPrefix + stderr
+	}
+
+	return stderr
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+func useRecordingTracerProvider(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return recorder
+}
+
+func TestProcessSpan(t *testing.T) {
+	t.Run("failed process", func(t *testing.T) {
+		recorder := useRecordingTracerProvider(t)
+
+		ctx, span := StartProcessSpan(context.Background(), BackendTracerName, "sh", "-c")
+		stderr := &StderrBuffer{}
+		cmd := exec.CommandContext(ctx, "sh", "-c", "echo 'Error: invalid configuration' >&2; exit 3")
+		cmd.Stderr = stderr
+		err := cmd.Run()
+		require.Error(t, err)
+		EndProcessSpan(span, err, stderr.String())
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 1)
+		require.Equal(t, "sh -c", ended[0].Name())
+		require.Contains(t, ended[0].Attributes(), semconv.ProcessExecutableName("sh"))
+		require.Contains(t, ended[0].Attributes(), semconv.ProcessCommand("-c"))
+		require.Contains(t, ended[0].Attributes(), ProcessExitCodeKey.Int(3))
+		require.Equal(t, otelcodes.Error, ended[0].Status().Code)
+
+		events := ended[0].Events()
+		require.Len(t, events, 2)
+		require.Equal(t, ProcessStderrEventName, events[0].Name)
+		require.Contains(t, events[0].Attributes, ProcessStderrKey.String("Error: invalid configuration\n"))
+		require.Equal(t, semconv.ExceptionEventName, events[1].Name)
+	})
+
+	t.Run("successful process", func(t *testing.T) {
+		recorder := useRecordingTracerProvider(t)
+
+		_, span := StartProcessSpan(context.Background(), BackendTracerName, "terraform", "init")
+		EndProcessSpan(span, nil, "")
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 1)
+		require.Equal(t, "terraform init", ended[0].Name())
+		require.Contains(t, ended[0].Attributes(), ProcessExitCodeKey.Int(0))
+		require.Equal(t, otelcodes.Ok, ended[0].Status().Code)
+		require.Empty(t, ended[0].Events())
+	})
+
+	t.Run("long stderr is truncated", func(t *testing.T) {
+		recorder := useRecordingTracerProvider(t)
+
+		_, span := StartProcessSpan(context.Background(), BackendTracerName, "terraform", "apply")
+		EndProcessSpan(span, nil, strings.Repeat("a", MaxStderrLength)+"Error: quota exceeded")
+
+		stderr := recorder.Ended()[0].Events()[0].Attributes[0].Value.AsString()
+		require.Equal(t, truncatedStderrPrefix+strings.Repeat("a", MaxStderrLength-21)+"Error: quota exceeded", stderr)
+	})
+}
+
+func TestStderrBuffer(t *testing.T) {
+	b := &StderrBuffer{}
+	_, _ = b.Write([]byte("first line\n"))
+	require.Equal(t, "first line\n", b.String())
+
+	_, _ = b.Write([]byte(strings.Repeat("x", MaxStderrLength-5)))
+	n, err := b.Write([]byte("last line\n"))
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+	require.Equal(t, truncatedStderrPrefix+strings.Repeat("x", MaxStderrLength-10)+"last line\n", b.String())
+}
@@ -24,6 +24,10 @@ type Options struct {
 	ServiceName string `yaml:"serviceName,omitempty"`
 	// Zipkin represents zipkin options.
 	Zipkin *ZipkinOptions `yaml:"zipkin,omitempty"`
+	// OTLP represents the OpenTelemetry protocol (OTLP) exporter options.
+	OTLP *OTLPOptions `yaml:"otlp,omitempty"`
+	// Sampler represents the sampling options. All traces are sampled when not set.
+	Sampler *SamplerOptions `yaml:"sampler,omitempty"`
 }
 
 // ZipkinOptions represents zipkin trace provider options.
@@ -31,3 +35,65 @@ type ZipkinOptions struct {
 	// URL represents the url of zipkin endpoint.
 	URL string `yaml:"url"`
 }
+
+const (
+	// OTLPProtocolGRPC sends traces using OTLP over gRPC.
+	OTLPProtocolGRPC = "grpc"
+	// OTLPProtocolHTTP sends traces using OTLP over HTTP with protobuf payloads.
+	OTLPProtocolHTTP = "http/protobuf"
+)
+
+// OTLPOptions represents OTLP trace exporter options. Options which are not set fall back to the standard
+// OTEL_EXPORTER_OTLP_* environment variables, which can be used to provide secrets such as authentication headers.
+type OTLPOptions struct {
+	// Protocol represents the protocol used to send traces, either "grpc" or "http/protobuf". Defaults to "grpc".
+	Protocol string `yaml:"protocol,omitempty"`
+	// Endpoint represents the host and port of the collector, e.g. "otel-collector:4317".
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// URLPath represents the URL path of the collector when using "http/protobuf". Defaults to "/v1/traces".
+	URLPath string `yaml:"urlPath,omitempty"`
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool `yaml:"insecure,omitempty"`
+	// TLS represents the TLS options for the connection to the collector.
+	TLS *TLSOptions `yaml:"tls,omitempty"`
+	// Headers represents the headers sent with each export request, e.g. for authentication.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// Compression represents the compression of the export requests, either "gzip" or "none". Defaults to "none".
+	Compression string `yaml:"compression,omitempty"`
+}
+
+// TLSOptions represents TLS options for the connection to the collector.
+type TLSOptions struct {
+	// CAFile represents the path to the CA certificate used to verify the collector. The system CAs are used when not set.
+	CAFile string `yaml:"caFile,omitempty"`
+	// CertFile represents the path to the client certificate for mutual TLS.
+	CertFile string `yaml:"certFile,omitempty"`
+	// KeyFile represents the path to the client key for mutual TLS.
+	KeyFile string `yaml:"keyFile,omitempty"`
+}
+
+const (
+	// SamplerAlwaysOn samples all traces.
+	SamplerAlwaysOn = "always_on"
+	// SamplerAlwaysOff samples no traces.
+	SamplerAlwaysOff = "always_off"
+	// SamplerTraceIDRatio samples the given ratio of traces.
+	SamplerTraceIDRatio = "traceidratio"
+	// SamplerParentBasedAlwaysOn follows the sampling decision of the parent span, and samples all root spans.
+	SamplerParentBasedAlwaysOn = "parentbased_always_on"
+	// SamplerParentBasedAlwaysOff follows the sampling decision of the parent span, and samples no root spans.
+	SamplerParentBasedAlwaysOff = "parentbased_always_off"
+	// SamplerParentBasedTraceIDRatio follows the sampling decision of the parent span, and samples the given ratio of
+	// root spans.
+	SamplerParentBasedTraceIDRatio = "parentbased_traceidratio"
+)
+
+// SamplerOptions represents the sampling options. The sampler types match the values of the standard
+// OTEL_TRACES_SAMPLER environment variable.
+type SamplerOptions struct {
+	// Type represents the sampler type. Defaults to "parentbased_always_on".
+	Type string `yaml:"type,omitempty"`
+	// Ratio represents the ratio of traces sampled by the "traceidratio" and "parentbased_traceidratio" samplers,
+	// between 0 and 1. Defaults to 1.
+	Ratio *float64 `yaml:"ratio,omitempty"`
+}
@@ -18,13 +18,22 @@ package traceservice
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/zipkin"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"google.golang.org/grpc/credentials"
 )
 
 // Service implements the hosting.Service interface for the tracer.
@@ -39,7 +48,7 @@ func (s *Service) Name() string {
 
 // Run runs the tracer service.
 func (s *Service) Run(ctx context.Context) error {
-	shutdown, err := initTracer(s.Options)
+	shutdown, err := initTracer(ctx, s.Options)
 	if err != nil {
 		return err
 	}
@@ -49,9 +58,14 @@ func (s *Service) Run(ctx context.Context) error {
 }
 
 // initTracer configures a tracer provider with the given options.
-func initTracer(opts *Options) (func(context.Context) error, error) {
+func initTracer(ctx context.Context, opts *Options) (func(context.Context) error, error) {
+	sampler, err := newSampler(opts.Sampler)
+	if err != nil {
+		return nil, err
+	}
+
 	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSampler(sampler),
 		sdktrace.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String(opts.ServiceName),
@@ -68,7 +82,144 @@ func initTracer(opts *Options) (func(context.Context) error, error) {
 
 	}
 
+	if opts.OTLP != nil {
+		exporter, err := newOTLPExporter(ctx, opts.OTLP)
+		if err != nil {
+			return nil, err
+		}
+
+		tp.RegisterSpanProcessor(sdktrace.NewBatchSpanProcessor(exporter))
+	}
+
 	otel.SetTracerProvider(tp)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}))
 	return tp.Shutdown, nil
 }
+
+// newSampler creates the sampler for the given options. All traces are sampled when no options are given.
+func newSampler(opts *SamplerOptions) (sdktrace.Sampler, error) {
+	if opts == nil {
+		return sdktrace.AlwaysSample(), nil
+	}
+
+	ratio := 1.0
+	if opts.Ratio != nil {
+		ratio = *opts.Ratio
+	}
+	if ratio < 0 || ratio > 1 {
+		return nil, fmt.Errorf("sampler ratio must be between 0 and 1, got %v", ratio)
+	}
+
+	switch strings.ToLower(opts.Type) {
+	case SamplerAlwaysOn:
+		return sdktrace.AlwaysSample(), nil
+	case SamplerAlwaysOff:
+		return sdktrace.NeverSample(), nil
+	case SamplerTraceIDRatio:
+		return sdktrace.TraceIDRatioBased(ratio), nil
+	case SamplerParentBasedAlwaysOn, "":
+		return sdktrace.ParentBased(sdktrace.AlwaysSample()), nil
+	case SamplerParentBasedAlwaysOff:
+		return sdktrace.ParentBased(sdktrace.NeverSample()), nil
+	case SamplerParentBasedTraceIDRatio:
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio)), nil
+	default:
+		return nil, fmt.Errorf("unsupported sampler type %q", opts.Type)
+	}
+}
+
+// newOTLPExporter creates an OTLP trace exporter for the given options.
+func newOTLPExporter(ctx context.Context, opts *OTLPOptions) (sdktrace.SpanExporter, error) {
+	var tlsConfig *tls.Config
+	if opts.TLS != nil {
+		if opts.Insecure {
+			return nil, errors.New("otlp tls options cannot be used with insecure")
+		}
+
+		var err error
+		tlsConfig, err = newTLSConfig(opts.TLS)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Compression != "" && opts.Compression != "gzip" && opts.Compression != "none" {
+		return nil, fmt.Errorf("unsupported otlp compression %q", opts.Compression)
+	}
+
+	switch opts.Protocol {
+	case OTLPProtocolGRPC, "":
+		options := []otlptracegrpc.Option{}
+		if opts.Endpoint != "" {
+			options = append(options, otlptracegrpc.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		if tlsConfig != nil {
+			options = append(options, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		}
+		if len(opts.Headers) > 0 {
+			options = append(options, otlptracegrpc.WithHeaders(opts.Headers))
+		}
+		if opts.Compression == "gzip" {
+			options = append(options, otlptracegrpc.WithCompressor("gzip"))
+		}
+
+		return otlptracegrpc.New(ctx, options...)
+
+	case OTLPProtocolHTTP:
+		options := []otlptracehttp.Option{}
+		if opts.Endpoint != "" {
+			options = append(options, otlptracehttp.WithEndpoint(opts.Endpoint))
+		}
+		if opts.URLPath != "" {
+			options = append(options, otlptracehttp.WithURLPath(opts.URLPath))
+		}
+		if opts.Insecure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+		if tlsConfig != nil {
+			options = append(options, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		}
+		if len(opts.Headers) > 0 {
+			options = append(options, otlptracehttp.WithHeaders(opts.Headers))
+		}
+		if opts.Compression == "gzip" {
+			options = append(options, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		}
+
+		return otlptracehttp.New(ctx, options...)
+
+	default:
+		return nil, fmt.Errorf("unsupported otlp protocol %q, must be %q or %q", opts.Protocol, OTLPProtocolGRPC, OTLPProtocolHTTP)
+	}
+}
+
+// newTLSConfig creates the TLS configuration for the connection to the collector.
+func newTLSConfig(opts *TLSOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if opts.CAFile != "" {
+		ca, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read otlp ca file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse otlp ca file %s", opts.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load otlp client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
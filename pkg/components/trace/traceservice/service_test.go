@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package traceservice
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newSampler(t *testing.T) {
+	ratio := 0.25
+	invalidRatio := 1.5
+
+	tests := []struct {
+		name        string
+		opts        *SamplerOptions
+		description string
+		err         string
+	}{
+		{
+			name:        "default",
+			opts:        nil,
+			description: "AlwaysOnSampler",
+		},
+		{
+			name:        "default type",
+			opts:        &SamplerOptions{},
+			description: "ParentBased{root:AlwaysOnSampler,remoteParentSampled:AlwaysOnSampler,remoteParentNotSampled:AlwaysOffSampler,localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler}",
+		},
+		{
+			name:        "always off",
+			opts:        &SamplerOptions{Type: SamplerAlwaysOff},
+			description: "AlwaysOffSampler",
+		},
+		{
+			name:        "ratio",
+			opts:        &SamplerOptions{Type: SamplerTraceIDRatio, Ratio: &ratio},
+			description: "TraceIDRatioBased{0.25}",
+		},
+		{
+			name:        "parent based ratio",
+			opts:        &SamplerOptions{Type: SamplerParentBasedTraceIDRatio, Ratio: &ratio},
+			description: "ParentBased{root:TraceIDRatioBased{0.25},remoteParentSampled:AlwaysOnSampler,remoteParentNotSampled:AlwaysOffSampler,localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler}",
+		},
+		{
+			name: "invalid ratio",
+			opts: &SamplerOptions{Type: SamplerTraceIDRatio, Ratio: &invalidRatio},
+			err:  "sampler ratio must be between 0 and 1, got 1.5",
+		},
+		{
+			name: "invalid type",
+			opts: &SamplerOptions{Type: "sometimes"},
+			err:  "unsupported sampler type \"sometimes\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampler, err := newSampler(tt.opts)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.description, sampler.Description())
+		})
+	}
+}
+
+func Test_newOTLPExporter(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *OTLPOptions
+		err  string
+	}{
+		{
+			name: "grpc",
+			opts: &OTLPOptions{Endpoint: "localhost:4317", Insecure: true, Headers: map[string]string{"authorization": "Bearer token"}, Compression: "gzip"},
+		},
+		{
+			name: "http",
+			opts: &OTLPOptions{Protocol: OTLPProtocolHTTP, Endpoint: "localhost:4318", URLPath: "/custom/v1/traces", TLS: &TLSOptions{}},
+		},
+		{
+			name: "invalid protocol",
+			opts: &OTLPOptions{Protocol: "thrift"},
+			err:  "unsupported otlp protocol \"thrift\", must be \"grpc\" or \"http/protobuf\"",
+		},
+		{
+			name: "invalid compression",
+			opts: &OTLPOptions{Compression: "zstd"},
+			err:  "unsupported otlp compression \"zstd\"",
+		},
+		{
+			name: "tls with insecure",
+			opts: &OTLPOptions{Insecure: true, TLS: &TLSOptions{}},
+			err:  "otlp tls options cannot be used with insecure",
+		},
+		{
+			name: "missing ca file",
+			opts: &OTLPOptions{TLS: &TLSOptions{CAFile: filepath.Join(t.TempDir(), "ca.crt")}},
+			err:  "failed to read otlp ca file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter, err := newOTLPExporter(context.Background(), tt.opts)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, exporter.Shutdown(context.Background()))
+		})
+	}
+}
@@ -34,6 +34,22 @@ const (
 	BackendTracerName string = "radius-backend-tracer"
 
 	traceparentHeaderKey string = "traceparent"
+
+	// RetryEventName is the name of the span event for a retry attempt.
+	RetryEventName string = "retry"
+	// StateTransitionEventName is the name of the span event for a state transition.
+	StateTransitionEventName string = "state_transition"
+)
+
+var (
+	// RetryAttemptKey is the attribute key for the number of the retry attempt.
+	RetryAttemptKey = attribute.Key("radius.retry.attempt")
+	// RetryReasonKey is the attribute key for the reason of the retry.
+	RetryReasonKey = attribute.Key("radius.retry.reason")
+	// StateFromKey is the attribute key for the previous state of the state transition.
+	StateFromKey = attribute.Key("radius.state.from")
+	// StateToKey is the attribute key for the new state of the state transition.
+	StateToKey = attribute.Key("radius.state.to")
 )
 
 // StartProducerSpan creates a new span with SpanKindProducer for enqueuing async operations. It creates the span
@@ -84,6 +100,41 @@ func SetAsyncResultStatus(result ctrl.Result, span trace.Span) {
 	}
 }
 
+// AddRetryEvent adds a retry event to the span in the context. attempt is the number of the attempt which is being retried,
+// reason describes why the operation is retried, and err is the error of the failed attempt if any.
+func AddRetryEvent(ctx context.Context, attempt int, reason string, err error, attrs ...attribute.KeyValue) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	attrs = append([]attribute.KeyValue{
+		RetryAttemptKey.Int(attempt),
+		RetryReasonKey.String(reason),
+	}, attrs...)
+	if err != nil {
+		attrs = append(attrs, semconv.ExceptionMessage(err.Error()))
+	}
+
+	span.AddEvent(RetryEventName, trace.WithAttributes(attrs...))
+}
+
+// AddStateTransitionEvent adds a state transition event, such as a change of the provisioning state, to the span in the
+// context. from is empty if the previous state is unknown.
+func AddStateTransitionEvent(ctx context.Context, from string, to string, attrs ...attribute.KeyValue) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	attrs = append([]attribute.KeyValue{
+		StateFromKey.String(from),
+		StateToKey.String(to),
+	}, attrs...)
+
+	span.AddEvent(StateTransitionEventName, trace.WithAttributes(attrs...))
+}
+
 // ExtractTraceparent extracts the traceparent header from the context.
 // Retrieve the current span context from context and serialize it to its w3c string representation using propagator.
 // ref: https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/trace/semantic_conventions/messaging.md
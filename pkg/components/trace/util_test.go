@@ -18,11 +18,16 @@ package trace
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 )
 
 func TestExtractTraceparent(t *testing.T) {
@@ -42,3 +47,63 @@ func TestExtractTraceparent(t *testing.T) {
 		})
 	}
 }
+
+func startRecordingSpan(t *testing.T) (context.Context, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+	})
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "test-span")
+	t.Cleanup(func() {
+		span.End()
+	})
+
+	return ctx, recorder
+}
+
+func TestAddRetryEvent(t *testing.T) {
+	ctx, recorder := startRecordingSpan(t)
+
+	AddRetryEvent(ctx, 2, "requeued", errors.New("conflict"), attribute.String("resource", "test"))
+	AddRetryEvent(ctx, 3, "requeued", nil)
+
+	span := recorder.Started()[0].(sdktrace.ReadWriteSpan)
+	events := span.Events()
+	require.Len(t, events, 2)
+
+	require.Equal(t, RetryEventName, events[0].Name)
+	require.Equal(t, []attribute.KeyValue{
+		RetryAttemptKey.Int(2),
+		RetryReasonKey.String("requeued"),
+		attribute.String("resource", "test"),
+		semconv.ExceptionMessage("conflict"),
+	}, events[0].Attributes)
+
+	require.Equal(t, []attribute.KeyValue{
+		RetryAttemptKey.Int(3),
+		RetryReasonKey.String("requeued"),
+	}, events[1].Attributes)
+}
+
+func TestAddStateTransitionEvent(t *testing.T) {
+	ctx, recorder := startRecordingSpan(t)
+
+	AddStateTransitionEvent(ctx, "Accepted", "Updating")
+
+	span := recorder.Started()[0].(sdktrace.ReadWriteSpan)
+	events := span.Events()
+	require.Len(t, events, 1)
+	require.Equal(t, StateTransitionEventName, events[0].Name)
+	require.Equal(t, []attribute.KeyValue{
+		StateFromKey.String("Accepted"),
+		StateToKey.String("Updating"),
+	}, events[0].Attributes)
+}
+
+func TestAddEvent_NoRecordingSpan(t *testing.T) {
+	// The events are ignored if there is no span in the context.
+	AddRetryEvent(context.Background(), 1, "requeued", nil)
+	AddStateTransitionEvent(context.Background(), "Accepted", "Updating")
+}
@@ -43,6 +43,9 @@ type DeploymentTemplateStatus struct {
 	// OutputResources is a list of the resourceIDs that were created by the template on the last deployment.
 	OutputResources []string `json:"outputResources,omitempty"`
 
+	// Resources is the provisioning state of each of the resources created by the template on the last deployment.
+	Resources []ResourceStatus `json:"resources,omitempty"`
+
 	// Operation tracks the status of an in-progress provisioning operation.
 	Operation *ResourceOperation `json:"operation,omitempty"`
 
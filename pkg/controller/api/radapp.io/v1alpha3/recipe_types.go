@@ -85,6 +85,10 @@ type RecipeStatus struct {
 	// +kubebuilder:validation:Optional
 	Resource string `json:"resource,omitempty"`
 
+	// Resources is the provisioning state of the resource managed by this Recipe.
+	// +kubebuilder:validation:Optional
+	Resources []ResourceStatus `json:"resources,omitempty"`
+
 	// Operation tracks the status of an in-progress provisioning operation.
 	// +kubebuilder:validation:Optional
 	Operation *ResourceOperation `json:"operation,omitempty"`
@@ -107,6 +111,21 @@ type ResourceOperation struct {
 	OperationKind OperationKind `json:"operationKind,omitempty"`
 }
 
+// ResourceStatus describes the provisioning state of a Radius resource.
+type ResourceStatus struct {
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// ID is the resource ID of the resource.
+	ID string `json:"id,omitempty"`
+
+	// State is the provisioning state of the resource, e.g. 'Succeeded', 'Failed' or 'Updating'.
+	State string `json:"state,omitempty"`
+
+	// Message is a human readable description of the provisioning state, e.g. the reason of a failure.
+	Message string `json:"message,omitempty"`
+}
+
 // OperationKind is the type of operation being performed.
 type OperationKind string
 
@@ -53,4 +53,10 @@ const (
 
 	// DeploymentResourceFinalizer is the name of the finalizer added to DeploymentResources.
 	DeploymentResourceFinalizer = "radapp.io/deployment-resource-finalizer"
+
+	// resourceStateUnknown is the provisioning state reported for a resource whose provisioning state cannot be fetched.
+	resourceStateUnknown = "Unknown"
+
+	// radiusPlaneName is the name of the Radius plane where resource types are registered.
+	radiusPlaneName = "local"
 )
@@ -151,6 +151,7 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 
 			deploymentTemplate.Status.Operation = nil
 			deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseFailed
+			deploymentTemplate.Status.Resources = fetchResourceStatuses(ctx, r.Radius, deploymentTemplate.Status.OutputResources)
 			err = r.Client.Status().Update(ctx, deploymentTemplate)
 			if err != nil {
 				return ctrl.Result{}, err
@@ -251,6 +252,7 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 		// If we get here, the operation was a success. Update the status and continue.
 		deploymentTemplate.Status.Operation = nil
 		deploymentTemplate.Status.OutputResources = outputResources
+		deploymentTemplate.Status.Resources = fetchResourceStatuses(ctx, r.Radius, outputResources)
 		deploymentTemplate.Status.StatusHash = hash
 		err = r.Client.Status().Update(ctx, deploymentTemplate)
 		if err != nil {
@@ -0,0 +1,243 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// radiusImportProvider is the name of the Bicep extension provider of Radius resources in ARM JSON templates.
+	radiusImportProvider = "Radius"
+)
+
+// SetupWebhookWithManager sets up the webhook for the DeploymentTemplate type with the provided manager.
+// Returns an error if there was a problem setting up the webhook.
+func (r *DeploymentTemplateWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&radappiov1alpha3.DeploymentTemplate{}).
+		WithValidator(r).
+		Complete()
+}
+
+// DeploymentTemplateWebhook implements the validating webhook functions for the DeploymentTemplate type.
+type DeploymentTemplateWebhook struct {
+	// Radius is the client used to look up the resource types registered with Radius. When nil, the resource
+	// types used by the template are not validated.
+	Radius RadiusClient
+}
+
+// armTemplate is the subset of an ARM JSON template that is validated by the webhook.
+type armTemplate struct {
+	Imports    map[string]armTemplateImport `json:"imports,omitempty"`
+	Parameters map[string]map[string]any    `json:"parameters,omitempty"`
+
+	// Resources is a map of symbolic names to resources for templates using symbolic names, or an array of
+	// resources otherwise.
+	Resources any `json:"resources,omitempty"`
+}
+
+// armTemplateImport is an extension provider imported by an ARM JSON template.
+type armTemplateImport struct {
+	Provider string `json:"provider,omitempty"`
+}
+
+// ValidateCreate validates the creation of a DeploymentTemplate object.
+func (r *DeploymentTemplateWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	deploymentTemplate, ok := obj.(*radappiov1alpha3.DeploymentTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a DeploymentTemplate but got a %T", obj)
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("Validating Create DeploymentTemplate", "name", deploymentTemplate.Name)
+	return r.validateDeploymentTemplate(ctx, deploymentTemplate)
+}
+
+// ValidateUpdate validates the update of a DeploymentTemplate object.
+func (r *DeploymentTemplateWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	deploymentTemplate, ok := newObj.(*radappiov1alpha3.DeploymentTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a DeploymentTemplate but got a %T", newObj)
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("Validating Update DeploymentTemplate", "name", deploymentTemplate.Name)
+	return r.validateDeploymentTemplate(ctx, deploymentTemplate)
+}
+
+// ValidateDelete validates the deletion of a DeploymentTemplate object.
+func (r *DeploymentTemplateWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	_, ok := obj.(*radappiov1alpha3.DeploymentTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a DeploymentTemplate but got a %T", obj)
+	}
+
+	// currently there is no validation when deleting DeploymentTemplate
+	return nil, nil
+}
+
+// validateDeploymentTemplate validates the template, parameters and provider config of a DeploymentTemplate object.
+func (r *DeploymentTemplateWebhook) validateDeploymentTemplate(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	var errList field.ErrorList
+	specPath := field.NewPath("spec")
+
+	template := armTemplate{}
+	err := json.Unmarshal([]byte(deploymentTemplate.Spec.Template), &template)
+	if err != nil {
+		errList = append(errList, field.Invalid(specPath.Child("template"), field.OmitValueType{}, fmt.Sprintf("must be a valid ARM JSON template: %s", err)))
+	} else {
+		errList = append(errList, validateTemplateParameters(specPath.Child("parameters"), template, deploymentTemplate.Spec.Parameters)...)
+
+		resourceWarnings, resourceErrList := r.validateTemplateResources(ctx, specPath.Child("template").Child("resources"), template)
+		warnings = append(warnings, resourceWarnings...)
+		errList = append(errList, resourceErrList...)
+	}
+
+	scope, err := ParseDeploymentScopeFromProviderConfig(deploymentTemplate.Spec.ProviderConfig)
+	if err != nil {
+		errList = append(errList, field.Invalid(specPath.Child("providerConfig"), deploymentTemplate.Spec.ProviderConfig, err.Error()))
+	} else if scope == "" {
+		errList = append(errList, field.Required(specPath.Child("providerConfig"), "providerConfig.deployments.value.scope must be set"))
+	}
+
+	if len(errList) > 0 {
+		return warnings, apierrors.NewInvalid(
+			schema.GroupKind{Group: "radapp.io", Kind: "DeploymentTemplate"},
+			deploymentTemplate.Name,
+			errList)
+	}
+
+	return warnings, nil
+}
+
+// validateTemplateParameters validates that the parameters required by the template are set, and that every
+// parameter set is declared by the template.
+func validateTemplateParameters(flPath *field.Path, template armTemplate, parameters map[string]string) field.ErrorList {
+	var errList field.ErrorList
+
+	for _, name := range slices.Sorted(maps.Keys(template.Parameters)) {
+		if _, ok := parameters[name]; ok {
+			continue
+		}
+
+		if _, ok := template.Parameters[name]["defaultValue"]; ok {
+			continue
+		}
+
+		if nullable, ok := template.Parameters[name]["nullable"].(bool); ok && nullable {
+			continue
+		}
+
+		errList = append(errList, field.Required(flPath.Key(name), "parameter is required by the template"))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(parameters)) {
+		if _, ok := template.Parameters[name]; !ok {
+			errList = append(errList, field.Invalid(flPath.Key(name), parameters[name], "parameter is not declared by the template"))
+		}
+	}
+
+	return errList
+}
+
+// validateTemplateResources validates that the Radius resources of the template use resource types registered
+// with Radius.
+//
+// Admission must not depend on Radius being available, so the resource types which cannot be looked up are not
+// validated and a warning is returned instead. The deployment of the template reports them during reconciliation.
+func (r *DeploymentTemplateWebhook) validateTemplateResources(ctx context.Context, flPath *field.Path, template armTemplate) (admission.Warnings, field.ErrorList) {
+	if r.Radius == nil {
+		return nil, nil
+	}
+
+	var warnings admission.Warnings
+	var errList field.ErrorList
+
+	// Resource types are looked up once even when used by multiple resources.
+	registered := map[string]bool{}
+	for _, resource := range templateResources(flPath, template.Resources) {
+		importName, _ := resource.properties["import"].(string)
+		if imported, ok := template.Imports[importName]; !ok || !strings.EqualFold(imported.Provider, radiusImportProvider) {
+			continue
+		}
+
+		resourceType, _ := resource.properties["type"].(string)
+		resourceType, _, _ = strings.Cut(resourceType, "@")
+
+		key := strings.ToLower(resourceType)
+		if _, ok := registered[key]; !ok {
+			found, err := lookupResourceType(ctx, r.Radius, resourceType)
+			if err != nil {
+				ucplog.FromContextOrDiscard(ctx).Error(err, "Failed to look up resource type", "resourceType", resourceType)
+				warnings = append(warnings, fmt.Sprintf("resource type %q was not validated: failed to look up the resource type", resourceType))
+
+				// Resource types which cannot be looked up are treated as registered.
+				registered[key] = true
+				continue
+			}
+
+			registered[key] = found != nil
+		}
+
+		if !registered[key] {
+			errList = append(errList, field.Invalid(resource.path.Child("type"), resource.properties["type"], "resource type is not registered with Radius"))
+		}
+	}
+
+	return warnings, errList
+}
+
+// templateResource is a resource declared by an ARM JSON template.
+type templateResource struct {
+	path       *field.Path
+	properties map[string]any
+}
+
+// templateResources returns the resources of the template in a stable order.
+func templateResources(flPath *field.Path, resources any) []templateResource {
+	result := []templateResource{}
+	switch v := resources.(type) {
+	case map[string]any:
+		for _, name := range slices.Sorted(maps.Keys(v)) {
+			if properties, ok := v[name].(map[string]any); ok {
+				result = append(result, templateResource{path: flPath.Key(name), properties: properties})
+			}
+		}
+	case []any:
+		for i, resource := range v {
+			if properties, ok := resource.(map[string]any); ok {
+				result = append(result, templateResource{path: flPath.Index(i), properties: properties})
+			}
+		}
+	}
+
+	return result
+}
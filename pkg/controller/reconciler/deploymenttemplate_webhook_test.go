@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"errors"
+	"testing"
+
+	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	parameterizedTemplate = `{
+  "parameters": {
+    "name": {"type": "string"},
+    "replicas": {"type": "int", "defaultValue": 1},
+    "tag": {"type": "string", "nullable": true}
+  },
+  "resources": {}
+}`
+)
+
+func Test_DeploymentTemplateWebhook_Validate(t *testing.T) {
+	radius := NewMockRadiusClient()
+	registerResourceType(radius, "Applications.Core/environments")
+
+	providerConfig, err := sdkclients.NewDefaultProviderConfig("default").String()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		template       string
+		parameters     map[string]string
+		providerConfig string
+		expectedError  string
+	}{
+		{
+			name:           "valid empty template",
+			template:       "{}",
+			providerConfig: providerConfig,
+		},
+		{
+			name:           "valid template with registered resource types",
+			template:       readFileIntoTemplate(t, "deploymenttemplate-withresources.json"),
+			providerConfig: providerConfig,
+		},
+		{
+			name:           "valid template with required parameters",
+			template:       parameterizedTemplate,
+			parameters:     map[string]string{"name": "test", "replicas": "2"},
+			providerConfig: providerConfig,
+		},
+		{
+			name:           "invalid template",
+			template:       "{",
+			providerConfig: providerConfig,
+			expectedError:  "DeploymentTemplate.radapp.io \"test-deploymenttemplate\" is invalid: spec.template: Invalid value: must be a valid ARM JSON template: unexpected end of JSON input",
+		},
+		{
+			name:           "missing required parameter and undeclared parameter",
+			template:       parameterizedTemplate,
+			parameters:     map[string]string{"other": "value"},
+			providerConfig: providerConfig,
+			expectedError:  "DeploymentTemplate.radapp.io \"test-deploymenttemplate\" is invalid: [spec.parameters[name]: Required value: parameter is required by the template, spec.parameters[other]: Invalid value: \"value\": parameter is not declared by the template]",
+		},
+		{
+			name:           "unregistered resource type",
+			template:       `{"imports": {"Radius": {"provider": "Radius"}}, "resources": {"db": {"import": "Radius", "type": "MyCompany.Resources/unknown@2023-10-01-preview"}}}`,
+			providerConfig: providerConfig,
+			expectedError:  "DeploymentTemplate.radapp.io \"test-deploymenttemplate\" is invalid: spec.template.resources[db].type: Invalid value: \"MyCompany.Resources/unknown@2023-10-01-preview\": resource type is not registered with Radius",
+		},
+		{
+			name:           "resource type of other providers is not validated",
+			template:       `{"imports": {"AWS": {"provider": "AWS"}}, "resources": {"bucket": {"import": "AWS", "type": "AWS.S3/Bucket@default"}}}`,
+			providerConfig: providerConfig,
+		},
+		{
+			name:           "missing deployments scope",
+			template:       "{}",
+			providerConfig: "{}",
+			expectedError:  "DeploymentTemplate.radapp.io \"test-deploymenttemplate\" is invalid: spec.providerConfig: Invalid value: \"{}\": providerConfig.Deployments is nil",
+		},
+	}
+	for _, tr := range tests {
+		t.Run(tr.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			name := types.NamespacedName{Namespace: defaultNamespace, Name: "test-deploymenttemplate"}
+			deploymentTemplate := makeDeploymentTemplate(name, tr.template, tr.providerConfig, tr.parameters)
+			deploymentTemplateWebhook := &DeploymentTemplateWebhook{Radius: radius}
+
+			_, err := deploymentTemplateWebhook.ValidateCreate(ctx, deploymentTemplate)
+			if tr.expectedError != "" {
+				require.True(t, apierrors.IsInvalid(err))
+				require.EqualError(t, err, tr.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, err = deploymentTemplateWebhook.ValidateUpdate(ctx, nil, deploymentTemplate)
+			if tr.expectedError != "" {
+				require.EqualError(t, err, tr.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, err = deploymentTemplateWebhook.ValidateDelete(ctx, deploymentTemplate)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_DeploymentTemplateWebhook_WithoutRadius(t *testing.T) {
+	ctx := testcontext.New(t)
+	providerConfig, err := sdkclients.NewDefaultProviderConfig("default").String()
+	require.NoError(t, err)
+
+	// Resource types are not validated when Radius is not available.
+	name := types.NamespacedName{Namespace: defaultNamespace, Name: "test-deploymenttemplate"}
+	template := `{"imports": {"Radius": {"provider": "Radius"}}, "resources": [{"import": "Radius", "type": "MyCompany.Resources/unknown@2023-10-01-preview"}]}`
+	_, err = (&DeploymentTemplateWebhook{}).ValidateCreate(ctx, makeDeploymentTemplate(name, template, providerConfig, nil))
+	require.NoError(t, err)
+}
+
+func Test_DeploymentTemplateWebhook_RadiusUnavailable(t *testing.T) {
+	ctx := testcontext.New(t)
+	providerConfig, err := sdkclients.NewDefaultProviderConfig("default").String()
+	require.NoError(t, err)
+
+	radius := NewMockRadiusClient()
+	radius.Update(func() {
+		radius.resourceTypesErr = errors.New("connection refused")
+	})
+
+	// Resource types which cannot be looked up are admitted with a warning.
+	name := types.NamespacedName{Namespace: defaultNamespace, Name: "test-deploymenttemplate"}
+	template := `{"imports": {"Radius": {"provider": "Radius"}}, "resources": [{"import": "Radius", "type": "MyCompany.Resources/unknown@2023-10-01-preview"}]}`
+	warnings, err := (&DeploymentTemplateWebhook{Radius: radius}).ValidateCreate(ctx, makeDeploymentTemplate(name, template, providerConfig, nil))
+	require.NoError(t, err)
+	require.Equal(t, admission.Warnings{"resource type \"MyCompany.Resources/unknown\" was not validated: failed to look up the resource type"}, warnings)
+}
@@ -37,12 +37,13 @@ import (
 
 func NewMockRadiusClient() *mockRadiusClient {
 	return &mockRadiusClient{
-		applications: map[string]corerpv20231001preview.ApplicationResource{},
-		containers:   map[string]corerpv20231001preview.ContainerResource{},
-		environments: map[string]corerpv20231001preview.EnvironmentResource{},
-		groups:       map[string]ucpv20231001preview.ResourceGroupResource{},
-		resources:    map[string]generated.GenericResource{},
-		operations:   map[string]*sdkclients.OperationState{},
+		applications:  map[string]corerpv20231001preview.ApplicationResource{},
+		containers:    map[string]corerpv20231001preview.ContainerResource{},
+		environments:  map[string]corerpv20231001preview.EnvironmentResource{},
+		groups:        map[string]ucpv20231001preview.ResourceGroupResource{},
+		resources:     map[string]generated.GenericResource{},
+		resourceTypes: map[string]ucpv20231001preview.ResourceTypeResource{},
+		operations:    map[string]*sdkclients.OperationState{},
 
 		lock: &sync.Mutex{},
 	}
@@ -51,12 +52,16 @@ func NewMockRadiusClient() *mockRadiusClient {
 var _ RadiusClient = (*mockRadiusClient)(nil)
 
 type mockRadiusClient struct {
-	applications map[string]corerpv20231001preview.ApplicationResource
-	containers   map[string]corerpv20231001preview.ContainerResource
-	environments map[string]corerpv20231001preview.EnvironmentResource
-	groups       map[string]ucpv20231001preview.ResourceGroupResource
-	resources    map[string]generated.GenericResource
-	operations   map[string]*sdkclients.OperationState
+	applications  map[string]corerpv20231001preview.ApplicationResource
+	containers    map[string]corerpv20231001preview.ContainerResource
+	environments  map[string]corerpv20231001preview.EnvironmentResource
+	groups        map[string]ucpv20231001preview.ResourceGroupResource
+	resources     map[string]generated.GenericResource
+	resourceTypes map[string]ucpv20231001preview.ResourceTypeResource
+	operations    map[string]*sdkclients.OperationState
+
+	// resourceTypesErr is returned by the resource types client when set.
+	resourceTypesErr error
 
 	lock *sync.Mutex
 }
@@ -95,6 +100,10 @@ func (rc *mockRadiusClient) Resources(scope string, resourceType string) Resourc
 	return &mockResourceClient{mock: rc, scope: scope, resourceType: resourceType}
 }
 
+func (rc *mockRadiusClient) ResourceTypes(planeName string) ResourceTypeClient {
+	return &mockResourceTypeClient{mock: rc, planeName: planeName}
+}
+
 func (rc *mockRadiusClient) CompleteOperation(operationID string, update func(state *sdkclients.OperationState)) {
 	rc.lock.Lock()
 	defer rc.lock.Unlock()
@@ -410,6 +419,36 @@ func (rc *mockResourceClient) ListSecrets(ctx context.Context, resourceName stri
 	return generated.GenericResourcesClientListSecretsResponse{Value: secrets}, nil
 }
 
+var _ ResourceTypeClient = (*mockResourceTypeClient)(nil)
+
+type mockResourceTypeClient struct {
+	mock      *mockRadiusClient
+	planeName string
+}
+
+func (rtc *mockResourceTypeClient) id(resourceProviderName string, resourceTypeName string) string {
+	return "/planes/radius/" + rtc.planeName + "/providers/System.Resources/resourceProviders/" + resourceProviderName + "/resourceTypes/" + resourceTypeName
+}
+
+func (rtc *mockResourceTypeClient) Get(ctx context.Context, resourceProviderName string, resourceTypeName string, options *ucpv20231001preview.ResourceTypesClientGetOptions) (ucpv20231001preview.ResourceTypesClientGetResponse, error) {
+	id := rtc.id(resourceProviderName, resourceTypeName)
+
+	rtc.mock.lock.Lock()
+	defer rtc.mock.lock.Unlock()
+
+	if rtc.mock.resourceTypesErr != nil {
+		return ucpv20231001preview.ResourceTypesClientGetResponse{}, rtc.mock.resourceTypesErr
+	}
+
+	resourceType, ok := rtc.mock.resourceTypes[strings.ToLower(id)]
+	if !ok {
+		err := &azcore.ResponseError{ErrorCode: v1.CodeNotFound, StatusCode: http.StatusNotFound}
+		return ucpv20231001preview.ResourceTypesClientGetResponse{}, err
+	}
+
+	return ucpv20231001preview.ResourceTypesClientGetResponse{ResourceTypeResource: resourceType}, nil
+}
+
 var _ sdkclients.Poller[corerpv20231001preview.ContainersClientCreateOrUpdateResponse] = (*mockRadiusClientPoller[corerpv20231001preview.ContainersClientCreateOrUpdateResponse])(nil)
 
 type mockRadiusClientPoller[T any] struct {
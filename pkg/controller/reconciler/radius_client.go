@@ -34,6 +34,7 @@ type RadiusClient interface {
 	Environments(scope string) EnvironmentClient
 	Groups(scope string) ResourceGroupClient
 	Resources(scope string, resourceType string) ResourceClient
+	ResourceTypes(planeName string) ResourceTypeClient
 }
 
 type ApplicationClient interface {
@@ -68,6 +69,10 @@ type ResourceClient interface {
 	ListSecrets(ctx context.Context, resourceName string) (generated.GenericResourcesClientListSecretsResponse, error)
 }
 
+type ResourceTypeClient interface {
+	Get(ctx context.Context, resourceProviderName string, resourceTypeName string, options *ucpv20231001preview.ResourceTypesClientGetOptions) (ucpv20231001preview.ResourceTypesClientGetResponse, error)
+}
+
 type RadiusClientImpl struct {
 	connection sdk.Connection
 }
@@ -123,6 +128,15 @@ func (c *RadiusClientImpl) Resources(scope string, resourceType string) Resource
 	return &ResourceClientImpl{inner: gc}
 }
 
+func (c *RadiusClientImpl) ResourceTypes(planeName string) ResourceTypeClient {
+	rtc, err := ucpv20231001preview.NewResourceTypesClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		panic("failed to create client: " + err.Error())
+	}
+
+	return &ResourceTypeClientImpl{inner: rtc, planeName: planeName}
+}
+
 var _ ApplicationClient = (*ApplicationClientImpl)(nil)
 
 type ApplicationClientImpl struct {
@@ -240,3 +254,14 @@ func (rc *ResourceClientImpl) Get(ctx context.Context, resourceName string) (gen
 func (rc *ResourceClientImpl) ListSecrets(ctx context.Context, resourceName string) (generated.GenericResourcesClientListSecretsResponse, error) {
 	return rc.inner.ListSecrets(ctx, resourceName, nil)
 }
+
+var _ ResourceTypeClient = (*ResourceTypeClientImpl)(nil)
+
+type ResourceTypeClientImpl struct {
+	inner     *ucpv20231001preview.ResourceTypesClient
+	planeName string
+}
+
+func (rtc *ResourceTypeClientImpl) Get(ctx context.Context, resourceProviderName string, resourceTypeName string, options *ucpv20231001preview.ResourceTypesClientGetOptions) (ucpv20231001preview.ResourceTypesClientGetResponse, error) {
+	return rtc.inner.Get(ctx, rtc.planeName, resourceProviderName, resourceTypeName, options)
+}
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/go-logr/logr"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
@@ -144,6 +145,14 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 
 			recipe.Status.Operation = nil
 			recipe.Status.Phrase = radappiov1alpha3.PhraseFailed
+			recipe.Status.Resources = []radappiov1alpha3.ResourceStatus{
+				{
+					Name:    recipe.Name,
+					ID:      recipe.Status.Scope + "/providers/" + recipe.Spec.Type + "/" + recipe.Name,
+					State:   string(v1.ProvisioningStateFailed),
+					Message: err.Error(),
+				},
+			}
 
 			err = r.Client.Status().Update(ctx, recipe)
 			if err != nil {
@@ -299,6 +308,11 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 		return ctrl.Result{}, fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
 	}
 
+	recipe.Status.Resources = nil
+	if recipe.Status.Resource != "" {
+		recipe.Status.Resources = fetchResourceStatuses(ctx, r.Radius, []string{recipe.Status.Resource})
+	}
+
 	recipe.Status.Phrase = radappiov1alpha3.PhraseReady
 	err = r.Client.Status().Update(ctx, recipe)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	portableresources "github.com/radius-project/radius/pkg/rp/portableresources"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -43,7 +44,11 @@ func (r *RecipeWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
 }
 
 // RecipeWebhook implements the validating webhook functions for the Recipe type.
-type RecipeWebhook struct{}
+type RecipeWebhook struct {
+	// Radius is the client used to look up the resource types registered with Radius. When nil, only the
+	// built-in portable resource types are accepted.
+	Radius RadiusClient
+}
 
 // ValidateCreate validates the creation of a Recipe object.
 func (r *RecipeWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
@@ -93,14 +98,28 @@ func (r *RecipeWebhook) validateRecipeType(ctx context.Context, recipe *radappio
 	validResourceTypes := strings.Join(portableresources.GetValidPortableResourceTypes(), ", ")
 
 	logger.Info("Validating Recipe Type %s in Recipe %s", recipe.Spec.Type, recipe.Name)
-	if !portableresources.IsValidPortableResourceType(recipe.Spec.Type) {
-		errList = append(errList, field.Invalid(flPath, recipe.Spec.Type, fmt.Sprintf("allowed values are: %s", validResourceTypes)))
+	if portableresources.IsValidPortableResourceType(recipe.Spec.Type) {
+		return nil, nil
+	}
+
+	if r.Radius != nil {
+		// Resource types registered with Radius can be provisioned by Recipes when they declare support for it.
+		resourceType, err := lookupResourceType(ctx, r.Radius, recipe.Spec.Type)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up resource type %q: %w", recipe.Spec.Type, err)
+		}
 
-		return nil, apierrors.NewInvalid(
-			schema.GroupKind{Group: "radapp.io", Kind: "Recipe"},
-			recipe.Name,
-			errList)
+		if resourceType != nil && hasCapability(resourceType, datamodel.CapabilitySupportsRecipes) {
+			return nil, nil
+		}
+
+		validResourceTypes += ", or a resource type registered with Radius that supports recipes"
 	}
 
-	return nil, nil
+	errList = append(errList, field.Invalid(flPath, recipe.Spec.Type, fmt.Sprintf("allowed values are: %s", validResourceTypes)))
+
+	return nil, apierrors.NewInvalid(
+		schema.GroupKind{Group: "radapp.io", Kind: "Recipe"},
+		recipe.Name,
+		errList)
 }
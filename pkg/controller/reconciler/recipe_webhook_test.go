@@ -246,6 +246,64 @@ func Test_Webhook_ValidateFunctions(t *testing.T) {
 	}
 }
 
+// Test_Webhook_ValidateRegisteredResourceType tests that recipes for resource types registered with Radius are
+// accepted when the resource type supports recipes.
+func Test_Webhook_ValidateRegisteredResourceType(t *testing.T) {
+	radius := NewMockRadiusClient()
+	registerResourceType(radius, "MyCompany.Resources/postgresDatabases", "SupportsRecipes")
+	registerResourceType(radius, "MyCompany.Resources/webServices")
+
+	validResourceTypes := strings.Join(portableresources.GetValidPortableResourceTypes(), ", ") + ", or a resource type registered with Radius that supports recipes"
+
+	tests := []struct {
+		name     string
+		typeName string
+		wantErr  bool
+	}{
+		{
+			name:     "built-in portable resource type",
+			typeName: validResourceType,
+			wantErr:  false,
+		},
+		{
+			name:     "registered resource type supporting recipes",
+			typeName: "MyCompany.Resources/postgresDatabases",
+			wantErr:  false,
+		},
+		{
+			name:     "registered resource type not supporting recipes",
+			typeName: "MyCompany.Resources/webServices",
+			wantErr:  true,
+		},
+		{
+			name:     "unregistered resource type",
+			typeName: "MyCompany.Resources/unknown",
+			wantErr:  true,
+		},
+		{
+			name:     "malformed resource type",
+			typeName: "unknown",
+			wantErr:  true,
+		},
+	}
+	for _, tr := range tests {
+		t.Run(tr.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			namespace := types.NamespacedName{Namespace: defaultNamespace, Name: "recipe"}
+			recipeWebhook := &RecipeWebhook{Radius: radius}
+
+			_, err := recipeWebhook.ValidateCreate(ctx, makeRecipe(namespace, tr.typeName))
+			if tr.wantErr {
+				expectedError := fmt.Sprintf("Recipe.radapp.io \"recipe\" is invalid: spec.type: Invalid value: \"%s\": allowed values are: %s", tr.typeName, validResourceTypes)
+				require.True(t, apierrors.IsInvalid(err))
+				require.EqualError(t, err, expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 // setupWebhookTest sets up a webhook test environment.
 func setupWebhookTest(t *testing.T) (*mockRadiusClient, client.Client) {
 	SkipWithoutEnvironment(t)
@@ -18,6 +18,7 @@ package reconciler
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,6 +56,18 @@ func createEnvironment(radius *mockRadiusClient, resourceGroup, name string) {
 	})
 }
 
+func registerResourceType(radius *mockRadiusClient, resourceType string, capabilities ...string) {
+	resourceProviderName, resourceTypeName, _ := strings.Cut(resourceType, "/")
+	id := fmt.Sprintf("/planes/radius/%s/providers/System.Resources/resourceProviders/%s/resourceTypes/%s", radiusPlaneName, resourceProviderName, resourceTypeName)
+	radius.Update(func() {
+		radius.resourceTypes[strings.ToLower(id)] = ucpv20231001preview.ResourceTypeResource{
+			ID:         to.Ptr(id),
+			Name:       to.Ptr(resourceTypeName),
+			Properties: &ucpv20231001preview.ResourceTypeProperties{Capabilities: to.SliceOfPtrs(capabilities...)},
+		}
+	})
+}
+
 func makeRecipe(name types.NamespacedName, resourceType string) *radappiov1alpha3.Recipe {
 	return &radappiov1alpha3.Recipe{
 		ObjectMeta: ctrl.ObjectMeta{
@@ -24,6 +24,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/to"
@@ -223,6 +224,29 @@ func fetchResource(ctx context.Context, radius RadiusClient, resourceID string)
 	return radius.Resources(id.RootScope(), id.Type()).Get(ctx, id.Name())
 }
 
+// fetchResourceStatuses returns the provisioning state of each of the given resources. The provisioning state of a
+// resource which cannot be fetched is reported as unknown, with the error as message.
+func fetchResourceStatuses(ctx context.Context, radius RadiusClient, resourceIDs []string) []radappiov1alpha3.ResourceStatus {
+	statuses := []radappiov1alpha3.ResourceStatus{}
+	for _, resourceID := range resourceIDs {
+		status := radappiov1alpha3.ResourceStatus{Name: resourceID, ID: resourceID, State: resourceStateUnknown}
+		if id, err := resources.Parse(resourceID); err == nil {
+			status.Name = id.Name()
+		}
+
+		response, err := fetchResource(ctx, radius, resourceID)
+		if err != nil {
+			status.Message = err.Error()
+		} else if state, ok := response.Properties["provisioningState"].(string); ok && state != "" {
+			status.State = state
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
 func deleteContainer(ctx context.Context, radius RadiusClient, containerID string) (sdkclients.Poller[corerpv20231001preview.ContainersClientDeleteResponse], error) {
 	id, err := resources.Parse(containerID)
 	if err != nil {
@@ -300,3 +324,36 @@ func convertToARMJSONParameters(parameters map[string]string) map[string]map[str
 	}
 	return armJSONParameters
 }
+
+// lookupResourceType looks up a resource type (eg. 'Applications.Core/extenders') registered with the resource
+// providers of the Radius plane. A nil resource type is returned if the resource type is not registered.
+func lookupResourceType(ctx context.Context, radius RadiusClient, resourceType string) (*ucpv20231001preview.ResourceTypeResource, error) {
+	resourceProviderName, resourceTypeName, ok := strings.Cut(resourceType, "/")
+	if !ok || resourceProviderName == "" || resourceTypeName == "" {
+		return nil, nil
+	}
+
+	response, err := radius.ResourceTypes(radiusPlaneName).Get(ctx, resourceProviderName, resourceTypeName, nil)
+	if clients.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &response.ResourceTypeResource, nil
+}
+
+// hasCapability returns true if the resource type has the given capability.
+func hasCapability(resourceType *ucpv20231001preview.ResourceTypeResource, capability string) bool {
+	if resourceType.Properties == nil {
+		return false
+	}
+
+	for _, c := range resourceType.Properties.Capabilities {
+		if c != nil && strings.EqualFold(*c, capability) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,8 +1,11 @@
 package reconciler
 
 import (
+	"context"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	"github.com/stretchr/testify/require"
 )
 
@@ -86,3 +89,21 @@ func TestConvertToARMJSONParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchResourceStatuses(t *testing.T) {
+	redisID := "/planes/radius/local/resourceGroups/default-app/providers/Applications.Datastores/redisCaches/redis"
+	containerID := "/planes/radius/local/resourceGroups/default-app/providers/Applications.Core/containers/frontend"
+	missingID := "/planes/radius/local/resourceGroups/default-app/providers/Applications.Core/containers/missing"
+
+	radius := NewMockRadiusClient()
+	radius.resources[redisID] = generated.GenericResource{Properties: map[string]any{"provisioningState": "Succeeded"}}
+	radius.resources[containerID] = generated.GenericResource{Properties: map[string]any{"provisioningState": "Failed"}}
+
+	statuses := fetchResourceStatuses(context.Background(), radius, []string{redisID, containerID, missingID})
+	require.Len(t, statuses, 3)
+	require.Equal(t, radappiov1alpha3.ResourceStatus{Name: "redis", ID: redisID, State: "Succeeded"}, statuses[0])
+	require.Equal(t, radappiov1alpha3.ResourceStatus{Name: "frontend", ID: containerID, State: "Failed"}, statuses[1])
+	require.Equal(t, "missing", statuses[2].Name)
+	require.Equal(t, resourceStateUnknown, statuses[2].State)
+	require.NotEmpty(t, statuses[2].Message)
+}
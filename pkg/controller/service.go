@@ -143,10 +143,17 @@ func (s *Service) Run(ctx context.Context) error {
 	if s.TLSCertDir == "" {
 		logger.Info("Webhooks will be skipped. TLS certificates not present.")
 	} else {
-		logger.Info("Registering validating webhook.")
-		if err = (&reconciler.RecipeWebhook{}).SetupWebhookWithManager(mgr); err != nil {
+		logger.Info("Registering validating webhooks.")
+		if err = (&reconciler.RecipeWebhook{
+			Radius: reconciler.NewRadiusClient(s.Options.UCPConnection),
+		}).SetupWebhookWithManager(mgr); err != nil {
 			return fmt.Errorf("failed to create recipe-webhook: %w", err)
 		}
+		if err = (&reconciler.DeploymentTemplateWebhook{
+			Radius: reconciler.NewRadiusClient(s.Options.UCPConnection),
+		}).SetupWebhookWithManager(mgr); err != nil {
+			return fmt.Errorf("failed to create deploymenttemplate-webhook: %w", err)
+		}
 	}
 
 	logger.Info("Registering health checks.")
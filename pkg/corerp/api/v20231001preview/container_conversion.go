@@ -48,6 +48,16 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 				disableDefaultEnvVars = to.Bool(val.DisableDefaultEnvVars)
 			}
 
+			format, err := toConnectionStringFormatDataModel(val.Format)
+			if err != nil {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Connection %s: %s", key, err.Error()))
+			}
+
+			readiness, err := toConnectionReadinessDataModel(val.Readiness)
+			if err != nil {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Connection %s: %s", key, err.Error()))
+			}
+
 			connections[key] = datamodel.ConnectionProperties{
 				Source:                to.String(val.Source),
 				DisableDefaultEnvVars: &disableDefaultEnvVars,
@@ -55,6 +65,9 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 					Kind:  kind,
 					Roles: roles,
 				},
+				Secrets:   toConnectionSecretsDataModel(val.Secrets),
+				Format:    format,
+				Readiness: readiness,
 			}
 		}
 	}
@@ -107,6 +120,11 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 		return nil, err
 	}
 
+	autoscaling, err := toContainerAutoscalingDataModel(src.Properties.Autoscaling)
+	if err != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Autoscaling: %s", err.Error()))
+	}
+
 	converted := &datamodel.ContainerResource{
 		BaseResource: v1.BaseResource{
 			TrackedResource: v1.TrackedResource{
@@ -125,6 +143,7 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			BasicResourceProperties: rpv1.BasicResourceProperties{
 				Application: to.String(src.Properties.Application),
 			},
+			Autoscaling: autoscaling,
 			Connections: connections,
 			Container: datamodel.Container{
 				Image:           to.String(src.Properties.Container.Image),
@@ -248,6 +267,9 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 				Kind:  kind,
 				Roles: roles,
 			},
+			Secrets:   fromConnectionSecretsDataModel(val.Secrets),
+			Format:    fromConnectionStringFormatDataModel(val.Format),
+			Readiness: fromConnectionReadinessDataModel(val.Readiness),
 		}
 	}
 
@@ -310,9 +332,11 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &ContainerProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(c.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(c.Properties.Status.Health),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(c.InternalMetadata.AsyncProvisioningState),
 		Application:       to.Ptr(c.Properties.Application),
+		Autoscaling:       fromContainerAutoscalingDataModel(c.Properties.Autoscaling),
 		Connections:       connections,
 		Container: &Container{
 			Image:           to.Ptr(c.Properties.Container.Image),
@@ -515,6 +539,8 @@ func toVolumePropertiesDataModel(h VolumeClassification) datamodel.VolumePropert
 			Ephemeral: &datamodel.EphemeralVolume{
 				VolumeBase:   toVolumeBaseDataModel(*c.GetVolume()),
 				ManagedStore: toManagedStoreDataModel(c.ManagedStore),
+				SizeLimit:    to.String(c.SizeLimit),
+				StorageClass: to.String(c.StorageClass),
 			},
 		}
 	case *PersistentVolume:
@@ -534,11 +560,18 @@ func toVolumePropertiesDataModel(h VolumeClassification) datamodel.VolumePropert
 func fromVolumePropertiesDataModel(v datamodel.VolumeProperties) VolumeClassification {
 	switch v.Kind {
 	case datamodel.Ephemeral:
-		return &EphemeralVolume{
+		ephemeral := &EphemeralVolume{
 			Kind:         (*string)(&v.Kind),
 			MountPath:    &v.Ephemeral.MountPath,
 			ManagedStore: fromManagedStoreDataModel(v.Ephemeral.ManagedStore),
 		}
+		if v.Ephemeral.SizeLimit != "" {
+			ephemeral.SizeLimit = to.Ptr(v.Ephemeral.SizeLimit)
+		}
+		if v.Ephemeral.StorageClass != "" {
+			ephemeral.StorageClass = to.Ptr(v.Ephemeral.StorageClass)
+		}
+		return ephemeral
 	case datamodel.Persistent:
 		return &PersistentVolume{
 			Kind:       (*string)(&v.Kind),
@@ -663,6 +696,220 @@ func fromContainerResourceProvisioningDataModel(r datamodel.ContainerResourcePro
 	}
 }
 
+func toConnectionSecretsDataModel(s *ConnectionSecretsProperties) *datamodel.ConnectionSecretsProperties {
+	if s == nil {
+		return nil
+	}
+
+	secrets := &datamodel.ConnectionSecretsProperties{
+		Materialization: datamodel.ConnectionSecretsMaterializationDeploy,
+	}
+	if s.Materialization != nil && *s.Materialization == ConnectionSecretsMaterializationRuntime {
+		secrets.Materialization = datamodel.ConnectionSecretsMaterializationRuntime
+	}
+	if s.RefreshIntervalSeconds != nil {
+		secrets.RefreshIntervalSeconds = *s.RefreshIntervalSeconds
+	}
+	secrets.SecretName = to.String(s.SecretName)
+	if len(s.Keys) > 0 {
+		secrets.Keys = to.StringMap(s.Keys)
+	}
+
+	return secrets
+}
+
+func fromConnectionSecretsDataModel(s *datamodel.ConnectionSecretsProperties) *ConnectionSecretsProperties {
+	if s == nil {
+		return nil
+	}
+
+	secrets := &ConnectionSecretsProperties{}
+	switch s.Materialization {
+	case datamodel.ConnectionSecretsMaterializationRuntime:
+		secrets.Materialization = to.Ptr(ConnectionSecretsMaterializationRuntime)
+	default:
+		secrets.Materialization = to.Ptr(ConnectionSecretsMaterializationDeploy)
+	}
+	if s.RefreshIntervalSeconds > 0 {
+		secrets.RefreshIntervalSeconds = to.Ptr(s.RefreshIntervalSeconds)
+	}
+	if s.SecretName != "" {
+		secrets.SecretName = to.Ptr(s.SecretName)
+	}
+	if len(s.Keys) > 0 {
+		secrets.Keys = *to.StringMapPtr(s.Keys)
+	}
+
+	return secrets
+}
+
+func toConnectionStringFormatDataModel(f *ConnectionStringFormat) (datamodel.ConnectionStringFormat, error) {
+	if f == nil {
+		return "", nil
+	}
+
+	switch *f {
+	case ConnectionStringFormatAdonet:
+		return datamodel.ConnectionStringFormatADONET, nil
+	case ConnectionStringFormatJdbc:
+		return datamodel.ConnectionStringFormatJDBC, nil
+	case ConnectionStringFormatURI:
+		return datamodel.ConnectionStringFormatURI, nil
+	case ConnectionStringFormatStackexchange:
+		return datamodel.ConnectionStringFormatStackExchange, nil
+	default:
+		return "", fmt.Errorf("unsupported connection string format %q, must be one of %v", *f, PossibleConnectionStringFormatValues())
+	}
+}
+
+func fromConnectionStringFormatDataModel(f datamodel.ConnectionStringFormat) *ConnectionStringFormat {
+	switch f {
+	case datamodel.ConnectionStringFormatADONET:
+		return to.Ptr(ConnectionStringFormatAdonet)
+	case datamodel.ConnectionStringFormatJDBC:
+		return to.Ptr(ConnectionStringFormatJdbc)
+	case datamodel.ConnectionStringFormatURI:
+		return to.Ptr(ConnectionStringFormatURI)
+	case datamodel.ConnectionStringFormatStackExchange:
+		return to.Ptr(ConnectionStringFormatStackexchange)
+	default:
+		return nil
+	}
+}
+
+func toConnectionReadinessDataModel(r *ConnectionReadinessProperties) (*datamodel.ConnectionReadinessProperties, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	readiness := &datamodel.ConnectionReadinessProperties{
+		WaitFor: datamodel.ConnectionReadinessConditionNone,
+	}
+	if r.WaitFor != nil {
+		switch *r.WaitFor {
+		case ConnectionReadinessConditionNone:
+			readiness.WaitFor = datamodel.ConnectionReadinessConditionNone
+		case ConnectionReadinessConditionProvisioned:
+			readiness.WaitFor = datamodel.ConnectionReadinessConditionProvisioned
+		case ConnectionReadinessConditionHealthy:
+			readiness.WaitFor = datamodel.ConnectionReadinessConditionHealthy
+		default:
+			return nil, fmt.Errorf("unsupported readiness condition %q, must be one of %v", *r.WaitFor, PossibleConnectionReadinessConditionValues())
+		}
+	}
+	if r.TimeoutSeconds != nil {
+		readiness.TimeoutSeconds = *r.TimeoutSeconds
+	}
+
+	return readiness, nil
+}
+
+func fromConnectionReadinessDataModel(r *datamodel.ConnectionReadinessProperties) *ConnectionReadinessProperties {
+	if r == nil {
+		return nil
+	}
+
+	readiness := &ConnectionReadinessProperties{}
+	switch r.WaitFor {
+	case datamodel.ConnectionReadinessConditionProvisioned:
+		readiness.WaitFor = to.Ptr(ConnectionReadinessConditionProvisioned)
+	case datamodel.ConnectionReadinessConditionHealthy:
+		readiness.WaitFor = to.Ptr(ConnectionReadinessConditionHealthy)
+	default:
+		readiness.WaitFor = to.Ptr(ConnectionReadinessConditionNone)
+	}
+	if r.TimeoutSeconds > 0 {
+		readiness.TimeoutSeconds = to.Ptr(r.TimeoutSeconds)
+	}
+
+	return readiness
+}
+
+func toContainerAutoscalingDataModel(a *ContainerAutoscalingProperties) (*datamodel.ContainerAutoscalingProperties, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	autoscaling := &datamodel.ContainerAutoscalingProperties{
+		MinReplicas:             to.Int32(a.MinReplicas),
+		MaxReplicas:             to.Int32(a.MaxReplicas),
+		TargetCPUUtilization:    to.Int32(a.TargetCPUUtilization),
+		TargetMemoryUtilization: to.Int32(a.TargetMemoryUtilization),
+	}
+
+	for _, m := range a.Metrics {
+		if m == nil {
+			continue
+		}
+
+		metric := datamodel.ContainerAutoscalingMetric{
+			Name:               to.String(m.Name),
+			Selector:           to.StringMap(m.Selector),
+			TargetAverageValue: to.String(m.TargetAverageValue),
+			TargetValue:        to.String(m.TargetValue),
+		}
+		if len(metric.Selector) == 0 {
+			metric.Selector = nil
+		}
+
+		if m.Kind == nil {
+			return nil, fmt.Errorf("the kind of metric %q is required", metric.Name)
+		}
+
+		switch *m.Kind {
+		case ContainerAutoscalingMetricKindPods:
+			metric.Kind = datamodel.ContainerAutoscalingMetricKindPods
+		case ContainerAutoscalingMetricKindExternal:
+			metric.Kind = datamodel.ContainerAutoscalingMetricKindExternal
+		default:
+			return nil, fmt.Errorf("unsupported metric kind %q, must be one of %v", *m.Kind, PossibleContainerAutoscalingMetricKindValues())
+		}
+
+		autoscaling.Metrics = append(autoscaling.Metrics, metric)
+	}
+
+	return autoscaling, nil
+}
+
+func fromContainerAutoscalingDataModel(a *datamodel.ContainerAutoscalingProperties) *ContainerAutoscalingProperties {
+	if a == nil {
+		return nil
+	}
+
+	autoscaling := &ContainerAutoscalingProperties{
+		MaxReplicas: to.Ptr(a.MaxReplicas),
+	}
+	if a.MinReplicas > 0 {
+		autoscaling.MinReplicas = to.Ptr(a.MinReplicas)
+	}
+	if a.TargetCPUUtilization > 0 {
+		autoscaling.TargetCPUUtilization = to.Ptr(a.TargetCPUUtilization)
+	}
+	if a.TargetMemoryUtilization > 0 {
+		autoscaling.TargetMemoryUtilization = to.Ptr(a.TargetMemoryUtilization)
+	}
+
+	for _, m := range a.Metrics {
+		metric := &ContainerAutoscalingMetric{
+			Kind: to.Ptr(ContainerAutoscalingMetricKind(m.Kind)),
+			Name: to.Ptr(m.Name),
+		}
+		if len(m.Selector) > 0 {
+			metric.Selector = *to.StringMapPtr(m.Selector)
+		}
+		if m.TargetAverageValue != "" {
+			metric.TargetAverageValue = to.Ptr(m.TargetAverageValue)
+		}
+		if m.TargetValue != "" {
+			metric.TargetValue = to.Ptr(m.TargetValue)
+		}
+
+		autoscaling.Metrics = append(autoscaling.Metrics, metric)
+	}
+
+	return autoscaling
+}
+
 func toRestartPolicyDataModel(rp *RestartPolicy) string {
 	if rp == nil {
 		return ""
@@ -61,6 +61,46 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 			err:      nil,
 			emptyExt: true,
 		},
+		{
+			filename: "containerresource-connection-secrets.json",
+			err:      nil,
+			emptyExt: true,
+		},
+		{
+			filename: "containerresource-connection-secrets-mapping.json",
+			err:      nil,
+			emptyExt: true,
+		},
+		{
+			filename: "containerresource-connection-format.json",
+			err:      nil,
+			emptyExt: true,
+		},
+		{
+			filename: "containerresource-connection-format-invalid.json",
+			err:      v1.NewClientErrInvalidRequest("Connection orders: unsupported connection string format \"odbc\", must be one of [adonet jdbc stackexchange uri]"),
+			emptyExt: false,
+		},
+		{
+			filename: "containerresource-connection-readiness.json",
+			err:      nil,
+			emptyExt: true,
+		},
+		{
+			filename: "containerresource-connection-readiness-invalid.json",
+			err:      v1.NewClientErrInvalidRequest("Connection orders: unsupported readiness condition \"started\", must be one of [healthy none provisioned]"),
+			emptyExt: false,
+		},
+		{
+			filename: "containerresource-autoscaling.json",
+			err:      nil,
+			emptyExt: true,
+		},
+		{
+			filename: "containerresource-autoscaling-invalid.json",
+			err:      v1.NewClientErrInvalidRequest("Autoscaling: unsupported metric kind \"object\", must be one of [external pods]"),
+			emptyExt: false,
+		},
 		{
 			filename: "containerresource-nil-env-variables.json",
 			err:      v1.NewClientErrInvalidRequest("Environment variable DB_USER has neither value nor secret value"),
@@ -96,6 +136,63 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 					return
 				}
 
+				if tt.filename == "containerresource-connection-format.json" {
+					require.Equal(t, datamodel.ConnectionStringFormatJDBC, ct.Properties.Connections["orders"].Format)
+					return
+				}
+
+				if tt.filename == "containerresource-connection-readiness.json" {
+					val := ct.Properties.Connections["orders"]
+					require.Equal(t, &datamodel.ConnectionReadinessProperties{
+						WaitFor:        datamodel.ConnectionReadinessConditionHealthy,
+						TimeoutSeconds: 600,
+					}, val.Readiness)
+					require.Equal(t, datamodel.ConnectionReadinessConditionHealthy, val.ReadinessCondition())
+					return
+				}
+
+				if tt.filename == "containerresource-autoscaling.json" {
+					require.Equal(t, &datamodel.ContainerAutoscalingProperties{
+						MinReplicas:          2,
+						MaxReplicas:          10,
+						TargetCPUUtilization: 70,
+						Metrics: []datamodel.ContainerAutoscalingMetric{
+							{
+								Kind:               datamodel.ContainerAutoscalingMetricKindPods,
+								Name:               "http_requests_per_second",
+								TargetAverageValue: "100",
+							},
+							{
+								Kind:        datamodel.ContainerAutoscalingMetricKindExternal,
+								Name:        "queue_messages_ready",
+								Selector:    map[string]string{"queue": "orders"},
+								TargetValue: "30",
+							},
+						},
+					}, ct.Properties.Autoscaling)
+					return
+				}
+
+				if tt.filename == "containerresource-connection-secrets-mapping.json" {
+					require.Equal(t, &datamodel.ConnectionSecretsProperties{
+						Materialization: datamodel.ConnectionSecretsMaterializationDeploy,
+						SecretName:      "inventory-credentials",
+						Keys:            map[string]string{"password": "redis-password"},
+					}, ct.Properties.Connections["inventory"].Secrets)
+					return
+				}
+
+				if tt.filename == "containerresource-connection-secrets.json" {
+					val, ok := ct.Properties.Connections["inventory"]
+					require.True(t, ok)
+					require.Equal(t, &datamodel.ConnectionSecretsProperties{
+						Materialization:        datamodel.ConnectionSecretsMaterializationRuntime,
+						RefreshIntervalSeconds: 300,
+					}, val.Secrets)
+					require.True(t, val.IsRuntimeMaterialized())
+					return
+				}
+
 				if tt.filename == "containerresource.json" {
 					require.Equal(t, map[string]datamodel.EnvironmentVariable{
 						"DB_USER": {
@@ -169,6 +266,21 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 		{
 			filename: "containerresourcedatamodel-manual.json",
 		},
+		{
+			filename: "containerresourcedatamodel-connection-secrets.json",
+		},
+		{
+			filename: "containerresourcedatamodel-connection-secrets-mapping.json",
+		},
+		{
+			filename: "containerresourcedatamodel-connection-format.json",
+		},
+		{
+			filename: "containerresourcedatamodel-connection-readiness.json",
+		},
+		{
+			filename: "containerresourcedatamodel-autoscaling.json",
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -197,6 +309,52 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 					return
 				}
 
+				if tt.filename == "containerresourcedatamodel-connection-format.json" {
+					require.Equal(t, to.Ptr(ConnectionStringFormatJdbc), versioned.Properties.Connections["orders"].Format)
+					return
+				}
+
+				if tt.filename == "containerresourcedatamodel-connection-readiness.json" {
+					require.Equal(t, &ConnectionReadinessProperties{
+						WaitFor:        to.Ptr(ConnectionReadinessConditionProvisioned),
+						TimeoutSeconds: to.Ptr[int32](600),
+					}, versioned.Properties.Connections["orders"].Readiness)
+					return
+				}
+
+				if tt.filename == "containerresourcedatamodel-autoscaling.json" {
+					require.Equal(t, &ContainerAutoscalingProperties{
+						MaxReplicas:             to.Ptr[int32](5),
+						TargetMemoryUtilization: to.Ptr[int32](80),
+						Metrics: []*ContainerAutoscalingMetric{
+							{
+								Kind:               to.Ptr(ContainerAutoscalingMetricKindExternal),
+								Name:               to.Ptr("queue_messages_ready"),
+								Selector:           map[string]*string{"queue": to.Ptr("orders")},
+								TargetAverageValue: to.Ptr("10"),
+							},
+						},
+					}, versioned.Properties.Autoscaling)
+					return
+				}
+
+				if tt.filename == "containerresourcedatamodel-connection-secrets-mapping.json" {
+					require.Equal(t, &ConnectionSecretsProperties{
+						Materialization: to.Ptr(ConnectionSecretsMaterializationDeploy),
+						SecretName:      to.Ptr("inventory-credentials"),
+						Keys:            map[string]*string{"password": to.Ptr("redis-password")},
+					}, versioned.Properties.Connections["inventory"].Secrets)
+					return
+				}
+
+				if tt.filename == "containerresourcedatamodel-connection-secrets.json" {
+					require.Equal(t, &ConnectionSecretsProperties{
+						Materialization:        to.Ptr(ConnectionSecretsMaterializationRuntime),
+						RefreshIntervalSeconds: to.Ptr[int32](300),
+					}, versioned.Properties.Connections["inventory"].Secrets)
+					return
+				}
+
 				if tt.filename == "containerresourcedatamodel.json" {
 					require.Equal(t, map[string]datamodel.EnvironmentVariable{
 						"DB_USER": {
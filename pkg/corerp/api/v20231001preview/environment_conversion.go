@@ -17,6 +17,7 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -29,10 +30,15 @@ import (
 	rp_util "github.com/radius-project/radius/pkg/rp/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 const (
 	EnvironmentComputeKindKubernetes = "kubernetes"
+	EnvironmentComputeKindACI        = "aci"
 	invalidLocalModulePathFmt        = "local module paths are not supported with Terraform Recipes. The 'templatePath' '%s' was detected as a local module path because it begins with '/' or './' or '../'."
 )
 
@@ -102,6 +108,11 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 				Scope: to.String(src.Properties.Providers.Aws.Scope),
 			}
 		}
+		if src.Properties.Providers.Gcp != nil {
+			converted.Properties.Providers.GCP = datamodel.ProvidersGCP{
+				Scope: to.String(src.Properties.Providers.Gcp.Scope),
+			}
+		}
 	}
 
 	if src.Properties.Simulated != nil && *src.Properties.Simulated {
@@ -116,6 +127,14 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Extensions = extensions
 	}
 
+	converted.Properties.DeploymentPolicy = toDeploymentPolicyDataModel(src.Properties.DeploymentPolicy)
+	quota, err := toEnvironmentQuotaDataModel(src.Properties.Quota)
+	if err != nil {
+		return nil, err
+	}
+	converted.Properties.Quota = quota
+	converted.Properties.ImagePolicy = toImagePolicyDataModel(src.Properties.ImagePolicy)
+
 	return converted, nil
 }
 
@@ -165,6 +184,11 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 				Scope: to.Ptr(env.Properties.Providers.AWS.Scope),
 			}
 		}
+		if env.Properties.Providers.GCP != (datamodel.ProvidersGCP{}) {
+			dst.Properties.Providers.Gcp = &ProvidersGcp{
+				Scope: to.Ptr(env.Properties.Providers.GCP.Scope),
+			}
+		}
 	}
 
 	if env.Properties.Simulated {
@@ -179,9 +203,154 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Extensions = extensions
 	}
 
+	dst.Properties.DeploymentPolicy = fromDeploymentPolicyDataModel(env.Properties.DeploymentPolicy)
+	dst.Properties.Quota = fromEnvironmentQuotaDataModel(env.Properties.Quota)
+	dst.Properties.ImagePolicy = fromImagePolicyDataModel(env.Properties.ImagePolicy)
+
 	return nil
 }
 
+func toDeploymentPolicyDataModel(policy *DeploymentPolicy) datamodel.DeploymentPolicy {
+	if policy == nil {
+		return datamodel.DeploymentPolicy{}
+	}
+
+	converted := datamodel.DeploymentPolicy{
+		Freeze:        to.Bool(policy.Freeze),
+		Message:       to.String(policy.Message),
+		AllowOverride: to.Bool(policy.AllowOverride),
+	}
+	for _, window := range policy.Windows {
+		if window == nil {
+			continue
+		}
+
+		converted.Windows = append(converted.Windows, datamodel.DeploymentWindow{
+			Schedule: to.String(window.Schedule),
+			Duration: to.String(window.Duration),
+			TimeZone: to.String(window.TimeZone),
+		})
+	}
+
+	return converted
+}
+
+func fromDeploymentPolicyDataModel(policy datamodel.DeploymentPolicy) *DeploymentPolicy {
+	if reflect.DeepEqual(policy, datamodel.DeploymentPolicy{}) {
+		return nil
+	}
+
+	converted := &DeploymentPolicy{}
+	if policy.Freeze {
+		converted.Freeze = to.Ptr(policy.Freeze)
+	}
+	if policy.Message != "" {
+		converted.Message = to.Ptr(policy.Message)
+	}
+	if policy.AllowOverride {
+		converted.AllowOverride = to.Ptr(policy.AllowOverride)
+	}
+	for _, window := range policy.Windows {
+		w := &DeploymentWindow{
+			Schedule: to.Ptr(window.Schedule),
+			Duration: to.Ptr(window.Duration),
+		}
+		if window.TimeZone != "" {
+			w.TimeZone = to.Ptr(window.TimeZone)
+		}
+		converted.Windows = append(converted.Windows, w)
+	}
+
+	return converted
+}
+
+func toEnvironmentQuotaDataModel(quota *EnvironmentQuota) (*datamodel.EnvironmentQuota, error) {
+	if quota == nil {
+		return nil, nil
+	}
+
+	converted := &datamodel.EnvironmentQuota{
+		MaxContainers:             quota.MaxContainers,
+		MaxRecipeExecutionsPerDay: quota.MaxRecipeExecutionsPerDay,
+		MaxCloudResources:         quota.MaxCloudResources,
+		MaxContainerCPU:           to.String(quota.MaxContainerCPU),
+		MaxContainerMemory:        to.String(quota.MaxContainerMemory),
+	}
+
+	if converted.MaxContainerCPU != "" {
+		if _, err := resource.ParseQuantity(converted.MaxContainerCPU); err != nil {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.quota.maxContainerCpu", ValidValue: "a Kubernetes quantity, such as '2' or '500m'"}
+		}
+	}
+
+	if converted.MaxContainerMemory != "" {
+		if _, err := resource.ParseQuantity(converted.MaxContainerMemory); err != nil {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.quota.maxContainerMemory", ValidValue: "a Kubernetes quantity, such as '1Gi'"}
+		}
+	}
+
+	return converted, nil
+}
+
+func fromEnvironmentQuotaDataModel(quota *datamodel.EnvironmentQuota) *EnvironmentQuota {
+	if quota == nil {
+		return nil
+	}
+
+	converted := &EnvironmentQuota{
+		MaxContainers:             quota.MaxContainers,
+		MaxRecipeExecutionsPerDay: quota.MaxRecipeExecutionsPerDay,
+		MaxCloudResources:         quota.MaxCloudResources,
+	}
+	if quota.MaxContainerCPU != "" {
+		converted.MaxContainerCPU = to.Ptr(quota.MaxContainerCPU)
+	}
+	if quota.MaxContainerMemory != "" {
+		converted.MaxContainerMemory = to.Ptr(quota.MaxContainerMemory)
+	}
+
+	return converted
+}
+
+func toImagePolicyDataModel(policy *ImagePolicy) *datamodel.ImagePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	converted := &datamodel.ImagePolicy{
+		AllowedRegistries: stringSlice(policy.AllowedRegistries),
+	}
+	if policy.SignatureVerification != nil {
+		converted.SignatureVerification = &datamodel.ImageSignatureVerification{
+			PublicKeys:           stringSlice(policy.SignatureVerification.PublicKeys),
+			RequiredAttestations: stringSlice(policy.SignatureVerification.RequiredAttestations),
+		}
+	}
+
+	return converted
+}
+
+func fromImagePolicyDataModel(policy *datamodel.ImagePolicy) *ImagePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	converted := &ImagePolicy{}
+	if len(policy.AllowedRegistries) > 0 {
+		converted.AllowedRegistries = to.SliceOfPtrs(policy.AllowedRegistries...)
+	}
+	if policy.SignatureVerification != nil {
+		converted.SignatureVerification = &ImageSignatureVerification{
+			PublicKeys: to.SliceOfPtrs(policy.SignatureVerification.PublicKeys...),
+		}
+		if len(policy.SignatureVerification.RequiredAttestations) > 0 {
+			converted.SignatureVerification.RequiredAttestations = to.SliceOfPtrs(policy.SignatureVerification.RequiredAttestations...)
+		}
+	}
+
+	return converted
+}
+
 func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeConfigProperties {
 	if config != nil {
 		recipeConfig := datamodel.RecipeConfigProperties{}
@@ -189,6 +358,16 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 			recipeConfig.Terraform = datamodel.TerraformConfigProperties{}
 			if config.Terraform.Authentication != nil {
 				recipeConfig.Terraform.Authentication = datamodel.AuthConfig{}
+				if config.Terraform.Authentication.Archive != nil {
+					archiveConfig := map[string]datamodel.ArchiveSecretConfig{}
+					for k, v := range config.Terraform.Authentication.Archive {
+						archiveConfig[k] = datamodel.ArchiveSecretConfig{
+							Secret: to.String(v.Secret),
+						}
+					}
+					recipeConfig.Terraform.Authentication.Archive = archiveConfig
+				}
+
 				gitConfig := config.Terraform.Authentication.Git
 				if gitConfig != nil {
 					recipeConfig.Terraform.Authentication.Git = datamodel.GitAuthConfig{}
@@ -205,6 +384,14 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 			}
 
 			recipeConfig.Terraform.Providers = toRecipeConfigTerraformProvidersDatamodel(config)
+
+			if config.Terraform.Backend != nil {
+				recipeConfig.Terraform.Backend = &datamodel.TerraformBackendConfig{
+					Type:    datamodel.TerraformBackendType(to.String((*string)(config.Terraform.Backend.Type))),
+					Config:  config.Terraform.Backend.Config,
+					Secrets: toSecretReferenceDatamodel(config.Terraform.Backend.Secrets),
+				}
+			}
 		}
 
 		if config.Bicep != nil {
@@ -223,6 +410,13 @@ func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeCon
 		recipeConfig.Env = toRecipeConfigEnvDatamodel(config)
 		recipeConfig.EnvSecrets = toSecretReferenceDatamodel(config.EnvSecrets)
 
+		if config.Policy != nil {
+			recipeConfig.Policy = datamodel.RecipePolicyProperties{
+				AllowedSources: stringSlice(config.Policy.AllowedSources),
+				DeniedSources:  stringSlice(config.Policy.DeniedSources),
+			}
+		}
+
 		return recipeConfig
 	}
 
@@ -236,6 +430,15 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 			recipeConfig.Terraform = &TerraformConfigProperties{}
 			if !reflect.DeepEqual(config.Terraform.Authentication, datamodel.AuthConfig{}) {
 				recipeConfig.Terraform.Authentication = &AuthConfig{}
+				if config.Terraform.Authentication.Archive != nil {
+					recipeConfig.Terraform.Authentication.Archive = map[string]*ArchiveSecretConfig{}
+					for k, v := range config.Terraform.Authentication.Archive {
+						recipeConfig.Terraform.Authentication.Archive[k] = &ArchiveSecretConfig{
+							Secret: to.Ptr(v.Secret),
+						}
+					}
+				}
+
 				if !reflect.DeepEqual(config.Terraform.Authentication.Git, datamodel.GitAuthConfig{}) {
 					recipeConfig.Terraform.Authentication.Git = &GitAuthConfig{}
 					if config.Terraform.Authentication.Git.PAT != nil {
@@ -250,6 +453,14 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 			}
 
 			recipeConfig.Terraform.Providers = fromRecipeConfigTerraformProvidersDatamodel(config)
+
+			if config.Terraform.Backend != nil {
+				recipeConfig.Terraform.Backend = &TerraformBackendConfig{
+					Type:    to.Ptr(TerraformBackendType(config.Terraform.Backend.Type)),
+					Config:  config.Terraform.Backend.Config,
+					Secrets: fromSecretReferenceDatamodel(config.Terraform.Backend.Secrets),
+				}
+			}
 		}
 
 		if !reflect.DeepEqual(config.Bicep, datamodel.BicepConfigProperties{}) {
@@ -267,12 +478,34 @@ func fromRecipeConfigDatamodel(config datamodel.RecipeConfigProperties) *RecipeC
 		recipeConfig.Env = fromRecipeConfigEnvDatamodel(config)
 		recipeConfig.EnvSecrets = fromSecretReferenceDatamodel(config.EnvSecrets)
 
+		if !reflect.DeepEqual(config.Policy, datamodel.RecipePolicyProperties{}) {
+			recipeConfig.Policy = &RecipePolicyProperties{}
+			if len(config.Policy.AllowedSources) > 0 {
+				recipeConfig.Policy.AllowedSources = to.SliceOfPtrs(config.Policy.AllowedSources...)
+			}
+			if len(config.Policy.DeniedSources) > 0 {
+				recipeConfig.Policy.DeniedSources = to.SliceOfPtrs(config.Policy.DeniedSources...)
+			}
+		}
+
 		return recipeConfig
 	}
 
 	return nil
 }
 
+// validNamespaceValue describes the valid values of the namespace of an environment, including a suggested namespace
+// derived from the invalid namespace if there is one.
+func validNamespaceValue(err error) string {
+	validValue := "63 characters or less"
+	namespaceErr := &kubernetes.NamespaceError{}
+	if errors.As(err, &namespaceErr) && namespaceErr.Suggestion != "" {
+		validValue += fmt.Sprintf(" (%d characters given), such as %q", len(namespaceErr.Namespace), namespaceErr.Suggestion)
+	}
+
+	return validValue
+}
+
 func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.EnvironmentCompute, error) {
 	switch v := h.(type) {
 	case *KubernetesCompute:
@@ -281,17 +514,8 @@ func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.En
 			return nil, err
 		}
 
-		if !kubernetes.IsValidObjectName(to.String(v.Namespace)) {
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"}
-		}
-
-		var identity *rpv1.IdentitySettings
-		if v.Identity != nil {
-			identity = &rpv1.IdentitySettings{
-				Kind:       toIdentityKindDataModel(v.Identity.Kind),
-				Resource:   to.String(v.Identity.Resource),
-				OIDCIssuer: to.String(v.Identity.OidcIssuer),
-			}
+		if err := kubernetes.ValidateNamespace(to.String(v.Namespace)); err != nil {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: validNamespaceValue(err)}
 		}
 
 		return &rpv1.EnvironmentCompute{
@@ -300,13 +524,57 @@ func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.En
 				ResourceID: to.String(v.ResourceID),
 				Namespace:  to.String(v.Namespace),
 			},
-			Identity: identity,
+			Identity: toIdentitySettingsDataModel(v.Identity),
+		}, nil
+	case *AzureContainerInstanceCompute:
+		k, err := toEnvironmentComputeKindDataModel(*v.Kind)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.ResourceGroup != nil {
+			id, err := resources.ParseScope(*v.ResourceGroup)
+			if err != nil || id.FindScope(resources_azure.ScopeResourceGroups) == "" {
+				return nil, &v1.ErrModelConversion{PropertyName: "$.properties.compute.resourceGroup", ValidValue: "an Azure resource group ID"}
+			}
+		}
+
+		return &rpv1.EnvironmentCompute{
+			Kind: k,
+			ACICompute: rpv1.ACIComputeProperties{
+				ResourceGroup: to.String(v.ResourceGroup),
+			},
+			Identity: toIdentitySettingsDataModel(v.Identity),
 		}, nil
 	default:
 		return nil, v1.ErrInvalidModelConversion
 	}
 }
 
+func toIdentitySettingsDataModel(identity *IdentitySettings) *rpv1.IdentitySettings {
+	if identity == nil {
+		return nil
+	}
+
+	return &rpv1.IdentitySettings{
+		Kind:       toIdentityKindDataModel(identity.Kind),
+		Resource:   to.String(identity.Resource),
+		OIDCIssuer: to.String(identity.OidcIssuer),
+	}
+}
+
+func fromIdentitySettingsDataModel(identity *rpv1.IdentitySettings) *IdentitySettings {
+	if identity == nil {
+		return nil
+	}
+
+	return &IdentitySettings{
+		Kind:       fromIdentityKind(identity.Kind),
+		Resource:   toStringPtr(identity.Resource),
+		OidcIssuer: toStringPtr(identity.OIDCIssuer),
+	}
+}
+
 func fromEnvironmentComputeDataModel(envCompute *rpv1.EnvironmentCompute) EnvironmentComputeClassification {
 	if envCompute == nil {
 		return nil
@@ -314,23 +582,21 @@ func fromEnvironmentComputeDataModel(envCompute *rpv1.EnvironmentCompute) Enviro
 
 	switch envCompute.Kind {
 	case rpv1.KubernetesComputeKind:
-		var identity *IdentitySettings
-		if envCompute.Identity != nil {
-			identity = &IdentitySettings{
-				Kind:       fromIdentityKind(envCompute.Identity.Kind),
-				Resource:   toStringPtr(envCompute.Identity.Resource),
-				OidcIssuer: toStringPtr(envCompute.Identity.OIDCIssuer),
-			}
-		}
 		compute := &KubernetesCompute{
 			Kind:      fromEnvironmentComputeKind(envCompute.Kind),
 			Namespace: to.Ptr(envCompute.KubernetesCompute.Namespace),
-			Identity:  identity,
+			Identity:  fromIdentitySettingsDataModel(envCompute.Identity),
 		}
 		if envCompute.KubernetesCompute.ResourceID != "" {
 			compute.ResourceID = to.Ptr(envCompute.KubernetesCompute.ResourceID)
 		}
 		return compute
+	case rpv1.ACIComputeKind:
+		return &AzureContainerInstanceCompute{
+			Kind:          fromEnvironmentComputeKind(envCompute.Kind),
+			ResourceGroup: toStringPtr(envCompute.ACICompute.ResourceGroup),
+			Identity:      fromIdentitySettingsDataModel(envCompute.Identity),
+		}
 	default:
 		return nil
 	}
@@ -340,8 +606,10 @@ func toEnvironmentComputeKindDataModel(kind string) (rpv1.EnvironmentComputeKind
 	switch kind {
 	case EnvironmentComputeKindKubernetes:
 		return rpv1.KubernetesComputeKind, nil
+	case EnvironmentComputeKindACI:
+		return rpv1.ACIComputeKind, nil
 	default:
-		return rpv1.UnknownComputeKind, &v1.ErrModelConversion{PropertyName: "$.properties.compute.kind", ValidValue: "[kubernetes]"}
+		return rpv1.UnknownComputeKind, &v1.ErrModelConversion{PropertyName: "$.properties.compute.kind", ValidValue: "[kubernetes aci]"}
 	}
 }
 
@@ -350,8 +618,10 @@ func fromEnvironmentComputeKind(kind rpv1.EnvironmentComputeKind) *string {
 	switch kind {
 	case rpv1.KubernetesComputeKind:
 		k = EnvironmentComputeKindKubernetes
+	case rpv1.ACIComputeKind:
+		k = EnvironmentComputeKindACI
 	default:
-		k = EnvironmentComputeKindKubernetes // 2023-10-01-preview supports only kubernetes.
+		k = EnvironmentComputeKindKubernetes // Kubernetes is the default compute kind.
 	}
 
 	return &k
@@ -367,6 +637,18 @@ func fromEnvExtensionClassificationDataModel(e datamodel.Extension) ExtensionCla
 			Annotations: *to.StringMapPtr(ann),
 			Labels:      *to.StringMapPtr(lbl),
 		}
+	case datamodel.CostAttribution:
+		if e.CostAttribution == nil {
+			return nil
+		}
+		converted := &CostAttributionExtension{
+			Kind: to.Ptr(string(e.Kind)),
+			Tags: *to.StringMapPtr(e.CostAttribution.Tags),
+		}
+		if e.CostAttribution.LabelPrefix != "" {
+			converted.LabelPrefix = to.Ptr(e.CostAttribution.LabelPrefix)
+		}
+		return converted
 	}
 
 	return nil
@@ -383,6 +665,14 @@ func toEnvExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Labels:      to.StringMap(c.Labels),
 			},
 		}
+	case *CostAttributionExtension:
+		return datamodel.Extension{
+			Kind: datamodel.CostAttribution,
+			CostAttribution: &datamodel.CostAttributionExtension{
+				LabelPrefix: to.String(c.LabelPrefix),
+				Tags:        to.StringMap(c.Tags),
+			},
+		}
 	}
 
 	return datamodel.Extension{}
@@ -398,17 +688,30 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			}
 		}
 		return datamodel.EnvironmentRecipeProperties{
-			TemplateKind:    types.TemplateKindTerraform,
-			TemplateVersion: to.String(c.TemplateVersion),
-			TemplatePath:    to.String(c.TemplatePath),
-			Parameters:      c.Parameters,
+			TemplateKind:               types.TemplateKindTerraform,
+			TemplateVersion:            to.String(c.TemplateVersion),
+			TemplatePath:               to.String(c.TemplatePath),
+			Parameters:                 c.Parameters,
+			AllowedOutputResourceTypes: stringSlice(c.AllowedOutputResourceTypes),
 		}, nil
 	case *BicepRecipeProperties:
 		return datamodel.EnvironmentRecipeProperties{
-			TemplateKind: types.TemplateKindBicep,
-			TemplatePath: to.String(c.TemplatePath),
-			PlainHTTP:    to.Bool(c.PlainHTTP),
-			Parameters:   c.Parameters,
+			TemplateKind:               types.TemplateKindBicep,
+			TemplatePath:               to.String(c.TemplatePath),
+			PlainHTTP:                  to.Bool(c.PlainHTTP),
+			Parameters:                 c.Parameters,
+			AllowedOutputResourceTypes: stringSlice(c.AllowedOutputResourceTypes),
+		}, nil
+	case *ExternalRecipeProperties:
+		if to.String(c.Driver) == "" {
+			return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest("the 'driver' of an external recipe is required")
+		}
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:               types.TemplateKindExternal,
+			TemplatePath:               to.String(c.TemplatePath),
+			Driver:                     to.String(c.Driver),
+			Parameters:                 c.Parameters,
+			AllowedOutputResourceTypes: stringSlice(c.AllowedOutputResourceTypes),
 		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
@@ -418,17 +721,27 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 	switch e.TemplateKind {
 	case types.TemplateKindTerraform:
 		return &TerraformRecipeProperties{
-			TemplateKind:    to.Ptr(e.TemplateKind),
-			TemplateVersion: to.Ptr(e.TemplateVersion),
-			TemplatePath:    to.Ptr(e.TemplatePath),
-			Parameters:      e.Parameters,
+			TemplateKind:               to.Ptr(e.TemplateKind),
+			TemplateVersion:            to.Ptr(e.TemplateVersion),
+			TemplatePath:               to.Ptr(e.TemplatePath),
+			Parameters:                 e.Parameters,
+			AllowedOutputResourceTypes: stringPtrSlice(e.AllowedOutputResourceTypes),
 		}
 	case types.TemplateKindBicep:
 		return &BicepRecipeProperties{
-			TemplateKind: to.Ptr(e.TemplateKind),
-			TemplatePath: to.Ptr(e.TemplatePath),
-			Parameters:   e.Parameters,
-			PlainHTTP:    to.Ptr(e.PlainHTTP),
+			TemplateKind:               to.Ptr(e.TemplateKind),
+			TemplatePath:               to.Ptr(e.TemplatePath),
+			Parameters:                 e.Parameters,
+			PlainHTTP:                  to.Ptr(e.PlainHTTP),
+			AllowedOutputResourceTypes: stringPtrSlice(e.AllowedOutputResourceTypes),
+		}
+	case types.TemplateKindExternal:
+		return &ExternalRecipeProperties{
+			TemplateKind:               to.Ptr(e.TemplateKind),
+			TemplatePath:               to.Ptr(e.TemplatePath),
+			Driver:                     to.Ptr(e.Driver),
+			Parameters:                 e.Parameters,
+			AllowedOutputResourceTypes: stringPtrSlice(e.AllowedOutputResourceTypes),
 		}
 	}
 
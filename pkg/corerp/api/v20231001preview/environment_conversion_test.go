@@ -33,6 +33,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testImagePolicyPublicKey is the public key of the image policy of the environment testdata.
+const testImagePolicyPublicKey = "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEmfhz5ujwTLx18jdbb67u1uLpsGJz\nigsmla+qeCt03Ajgs6clxAN02NX+Da1YA+ukWH/5O6hdowWGcDCNr/HxKw==\n-----END PUBLIC KEY-----\n"
+
 func TestConvertVersionedToDataModel(t *testing.T) {
 	conversionTests := []struct {
 		filename string
@@ -127,10 +130,18 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 						AWS: datamodel.ProvidersAWS{
 							Scope: "/planes/aws/aws/accounts/140313373712/regions/us-west-2",
 						},
+						GCP: datamodel.ProvidersGCP{
+							Scope: "/planes/gcp/gcp/projects/my-project/regions/us-central1",
+						},
 					},
 					RecipeConfig: datamodel.RecipeConfigProperties{
 						Terraform: datamodel.TerraformConfigProperties{
 							Authentication: datamodel.AuthConfig{
+								Archive: map[string]datamodel.ArchiveSecretConfig{
+									"mybucket.s3.amazonaws.com": {
+										Secret: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/s3",
+									},
+								},
 								Git: datamodel.GitAuthConfig{
 									PAT: map[string]datamodel.SecretConfig{
 										"dev.azure.com": {
@@ -177,6 +188,10 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								Key:    "envKey1",
 							},
 						},
+						Policy: datamodel.RecipePolicyProperties{
+							AllowedSources: []string{"ghcr.io/sampleregistry", "registry.terraform.io/Azure", "example.com"},
+							DeniedSources:  []string{"ghcr.io/sampleregistry/radius/recipes/deprecated"},
+						},
 					},
 					Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
 						ds_ctrl.MongoDatabasesResourceType: {
@@ -213,6 +228,26 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 						},
 					},
 					Extensions: getTestKubernetesMetadataExtensions(),
+					DeploymentPolicy: datamodel.DeploymentPolicy{
+						Windows: []datamodel.DeploymentWindow{
+							{Schedule: "0 9 * * 1-5", Duration: "8h", TimeZone: "Europe/Berlin"},
+						},
+						Message:       "Production deployments follow the change control process.",
+						AllowOverride: true,
+					},
+					Quota: &datamodel.EnvironmentQuota{
+						MaxContainers:      to.Ptr(int32(20)),
+						MaxCloudResources:  to.Ptr(int32(5)),
+						MaxContainerCPU:    "2",
+						MaxContainerMemory: "4Gi",
+					},
+					ImagePolicy: &datamodel.ImagePolicy{
+						AllowedRegistries: []string{"ghcr.io/radius-project", "docker.io/library"},
+						SignatureVerification: &datamodel.ImageSignatureVerification{
+							PublicKeys:           []string{testImagePolicyPublicKey},
+							RequiredAttestations: []string{"https://slsa.dev/provenance/v1"},
+						},
+					},
 				},
 			},
 			err: nil,
@@ -330,13 +365,53 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-aci.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "aci",
+						ACICompute: rpv1.ACIComputeProperties{
+							ResourceGroup: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/aci-rg",
+						},
+					},
+					Providers: datamodel.Providers{
+						Azure: datamodel.ProvidersAzure{
+							Scope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg",
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-invalid-aci-resourcegroup.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.resourceGroup", ValidValue: "an Azure resource group ID"},
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
 		},
 		{
 			filename: "environmentresource-invalid-namespace.json",
-			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less (152 characters given), such as \"radiuslongnamespaceradiuslongnamespaceradiuslongnamespaceradius\""},
+		},
+		{
+			filename: "environmentresource-invalid-quota.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.quota.maxContainerMemory", ValidValue: "a Kubernetes quantity, such as '1Gi'"},
 		},
 		{
 			filename: "environmentresource-invalid-resourcetype.json",
@@ -344,11 +419,11 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"external\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"external\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
@@ -424,6 +499,7 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 				require.Equal(t, map[string]any{"throughput": float64(400)}, versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["cosmos-recipe"].GetRecipeProperties().Parameters)
 				require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup", string(*versioned.Properties.Providers.Azure.Scope))
 				require.Equal(t, "/planes/aws/aws/accounts/140313373712/regions/us-west-2", string(*versioned.Properties.Providers.Aws.Scope))
+				require.Equal(t, "/planes/gcp/gcp/projects/my-project/regions/us-central1", string(*versioned.Properties.Providers.Gcp.Scope))
 				require.Equal(t, "kubernetesMetadata", *versioned.Properties.Extensions[0].GetExtension().Kind)
 				require.Equal(t, 1, len(versioned.Properties.Extensions))
 				recipeDetails := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"]
@@ -432,6 +508,7 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 					require.Equal(t, "Azure/cosmosdb/azurerm", string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplatePath))
 					require.Equal(t, recipes.TemplateKindTerraform, string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplateKind))
 					require.Equal(t, baseSecretStorePath+"github", string(*versioned.Properties.RecipeConfig.Terraform.Authentication.Git.Pat["dev.azure.com"].Secret))
+					require.Equal(t, baseSecretStorePath+"s3", string(*versioned.Properties.RecipeConfig.Terraform.Authentication.Archive["mybucket.s3.amazonaws.com"].Secret))
 					require.Equal(t, baseSecretStorePath+"acr-secret", string(*versioned.Properties.RecipeConfig.Bicep.Authentication["test.azurecr.io"].Secret))
 					switch c := recipeDetails.(type) {
 					case *TerraformRecipeProperties:
@@ -457,6 +534,33 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 					require.True(t, ok)
 					require.Equal(t, envSecretRef, to.Ptr(SecretReference{Source: to.Ptr(baseSecretStorePath + "envSecretStore1"), Key: to.Ptr("envKey1")}))
 					require.Equal(t, 1, len(envSecretIDs))
+
+					require.Equal(t, &DeploymentPolicy{
+						Windows: []*DeploymentWindow{
+							{Schedule: to.Ptr("0 9 * * 1-5"), Duration: to.Ptr("8h"), TimeZone: to.Ptr("Europe/Berlin")},
+						},
+						Message:       to.Ptr("Production deployments follow the change control process."),
+						AllowOverride: to.Ptr(true),
+					}, versioned.Properties.DeploymentPolicy)
+
+					require.Equal(t, &EnvironmentQuota{
+						MaxContainers:      to.Ptr(int32(20)),
+						MaxCloudResources:  to.Ptr(int32(5)),
+						MaxContainerCPU:    to.Ptr("2"),
+						MaxContainerMemory: to.Ptr("4Gi"),
+					}, versioned.Properties.Quota)
+
+					require.Equal(t, &ImagePolicy{
+						AllowedRegistries: to.SliceOfPtrs("ghcr.io/radius-project", "docker.io/library"),
+						SignatureVerification: &ImageSignatureVerification{
+							PublicKeys:           to.SliceOfPtrs(testImagePolicyPublicKey),
+							RequiredAttestations: to.SliceOfPtrs("https://slsa.dev/provenance/v1"),
+						},
+					}, versioned.Properties.ImagePolicy)
+
+					policy := versioned.Properties.RecipeConfig.Policy
+					require.Equal(t, to.SliceOfPtrs("ghcr.io/sampleregistry", "registry.terraform.io/Azure", "example.com"), policy.AllowedSources)
+					require.Equal(t, to.SliceOfPtrs("ghcr.io/sampleregistry/radius/recipes/deprecated"), policy.DeniedSources)
 				}
 
 				if tt.filename == "environmentresourcedatamodelemptyext.json" {
@@ -549,7 +653,8 @@ func TestToEnvironmentComputeKindDataModel(t *testing.T) {
 		err       error
 	}{
 		{EnvironmentComputeKindKubernetes, rpv1.KubernetesComputeKind, nil},
-		{"", rpv1.UnknownComputeKind, &v1.ErrModelConversion{PropertyName: "$.properties.compute.kind", ValidValue: "[kubernetes]"}},
+		{EnvironmentComputeKindACI, rpv1.ACIComputeKind, nil},
+		{"", rpv1.UnknownComputeKind, &v1.ErrModelConversion{PropertyName: "$.properties.compute.kind", ValidValue: "[kubernetes aci]"}},
 	}
 
 	for _, tt := range kindTests {
@@ -567,6 +672,7 @@ func TestFromEnvironmentComputeKindDataModel(t *testing.T) {
 		versioned string
 	}{
 		{rpv1.KubernetesComputeKind, EnvironmentComputeKindKubernetes},
+		{rpv1.ACIComputeKind, EnvironmentComputeKindACI},
 		{rpv1.UnknownComputeKind, EnvironmentComputeKindKubernetes},
 	}
 
@@ -576,6 +682,20 @@ func TestFromEnvironmentComputeKindDataModel(t *testing.T) {
 	}
 }
 
+func TestFromEnvironmentComputeDataModel_ACI(t *testing.T) {
+	compute := fromEnvironmentComputeDataModel(&rpv1.EnvironmentCompute{
+		Kind: rpv1.ACIComputeKind,
+		ACICompute: rpv1.ACIComputeProperties{
+			ResourceGroup: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/aci-rg",
+		},
+	})
+
+	require.Equal(t, &AzureContainerInstanceCompute{
+		Kind:          to.Ptr(EnvironmentComputeKindACI),
+		ResourceGroup: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/aci-rg"),
+	}, compute)
+}
+
 func getTestKubernetesMetadataExtensions() []datamodel.Extension {
 	extensions := []datamodel.Extension{
 		{
@@ -1108,3 +1228,124 @@ func Test_fromSecretReferenceDatamodel(t *testing.T) {
 		})
 	}
 }
+
+func Test_CostAttributionExtension(t *testing.T) {
+	versioned := &CostAttributionExtension{
+		Kind:        to.Ptr("costAttribution"),
+		LabelPrefix: to.Ptr("billing.example.com/"),
+		Tags: map[string]*string{
+			"owner": to.Ptr("team-a"),
+		},
+	}
+
+	expected := datamodel.Extension{
+		Kind: datamodel.CostAttribution,
+		CostAttribution: &datamodel.CostAttributionExtension{
+			LabelPrefix: "billing.example.com/",
+			Tags: map[string]string{
+				"owner": "team-a",
+			},
+		},
+	}
+
+	dm := toEnvExtensionDataModel(versioned)
+	require.Equal(t, expected, dm)
+
+	result := fromEnvExtensionClassificationDataModel(dm)
+	require.Equal(t, versioned, result)
+
+	t.Run("round trips through json", func(t *testing.T) {
+		b, err := json.Marshal(&EnvironmentProperties{Extensions: []ExtensionClassification{versioned}})
+		require.NoError(t, err)
+
+		props := &EnvironmentProperties{}
+		err = json.Unmarshal(b, props)
+		require.NoError(t, err)
+		require.Equal(t, []ExtensionClassification{versioned}, props.Extensions)
+	})
+
+	t.Run("default label prefix", func(t *testing.T) {
+		result := fromEnvExtensionClassificationDataModel(datamodel.Extension{
+			Kind:            datamodel.CostAttribution,
+			CostAttribution: &datamodel.CostAttributionExtension{},
+		})
+		require.Equal(t, &CostAttributionExtension{Kind: to.Ptr("costAttribution"), Tags: map[string]*string{}}, result)
+	})
+}
+
+func Test_RecipeConfigTerraformBackend(t *testing.T) {
+	versioned := &RecipeConfigProperties{
+		Terraform: &TerraformConfigProperties{
+			Backend: &TerraformBackendConfig{
+				Type: to.Ptr(TerraformBackendTypeS3),
+				Config: map[string]any{
+					"bucket": "tfstate",
+					"region": "us-west-2",
+				},
+				Secrets: map[string]*SecretReference{
+					"secret_key": {
+						Source: to.Ptr("/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/aws"),
+						Key:    to.Ptr("secretAccessKey"),
+					},
+				},
+			},
+		},
+	}
+
+	expected := datamodel.RecipeConfigProperties{
+		Terraform: datamodel.TerraformConfigProperties{
+			Backend: &datamodel.TerraformBackendConfig{
+				Type: datamodel.TerraformBackendS3,
+				Config: map[string]any{
+					"bucket": "tfstate",
+					"region": "us-west-2",
+				},
+				Secrets: map[string]datamodel.SecretReference{
+					"secret_key": {
+						Source: "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/aws",
+						Key:    "secretAccessKey",
+					},
+				},
+			},
+		},
+	}
+
+	converted := toRecipeConfigDatamodel(versioned)
+	require.Equal(t, expected, converted)
+	require.Equal(t, versioned.Terraform, fromRecipeConfigDatamodel(converted).Terraform)
+}
+
+func Test_ExternalRecipeProperties(t *testing.T) {
+	versioned := &ExternalRecipeProperties{
+		TemplateKind: to.Ptr(recipes.TemplateKindExternal),
+		TemplatePath: to.Ptr("redis"),
+		Driver:       to.Ptr("pulumi"),
+		Parameters: map[string]any{
+			"size": "small",
+		},
+		AllowedOutputResourceTypes: to.SliceOfPtrs("Microsoft.Cache/*", "apps/Deployment"),
+	}
+
+	expected := datamodel.EnvironmentRecipeProperties{
+		TemplateKind: recipes.TemplateKindExternal,
+		TemplatePath: "redis",
+		Driver:       "pulumi",
+		Parameters: map[string]any{
+			"size": "small",
+		},
+		AllowedOutputResourceTypes: []string{"Microsoft.Cache/*", "apps/Deployment"},
+	}
+
+	converted, err := toEnvironmentRecipeProperties(versioned)
+	require.NoError(t, err)
+	require.Equal(t, expected, converted)
+	require.Equal(t, versioned, fromRecipePropertiesClassificationDatamodel(converted))
+
+	t.Run("missing driver", func(t *testing.T) {
+		_, err := toEnvironmentRecipeProperties(&ExternalRecipeProperties{
+			TemplateKind: to.Ptr(recipes.TemplateKindExternal),
+			TemplatePath: to.Ptr("redis"),
+		})
+		require.Equal(t, v1.NewClientErrInvalidRequest("the 'driver' of an external recipe is required"), err)
+	})
+}
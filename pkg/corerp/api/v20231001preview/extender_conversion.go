@@ -78,6 +78,7 @@ func (dst *ExtenderResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &ExtenderProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(extender.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(extender.Properties.Status.Health),
 			Recipe:          fromRecipeStatus(extender.Properties.Status.Recipe),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(extender.InternalMetadata.AsyncProvisioningState),
@@ -142,17 +143,14 @@ func fromRecipeDataModel(r portableresources.ResourceRecipe) *Recipe {
 	}
 }
 
+// toRecipeDataModel converts the recipe of a request to the data model. The default recipe name is applied by the
+// frontend defaulters of the resource type.
 func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
 	if r == nil {
-		return portableresources.ResourceRecipe{
-			Name: portableresources.DefaultRecipeName,
-		}
+		return portableresources.ResourceRecipe{}
 	}
-	recipe := portableresources.ResourceRecipe{}
-	if r.Name == nil {
-		recipe.Name = portableresources.DefaultRecipeName
-	} else {
-		recipe.Name = to.String(r.Name)
+	recipe := portableresources.ResourceRecipe{
+		Name: to.String(r.Name),
 	}
 	if r.Parameters != nil {
 		recipe.Parameters = r.Parameters
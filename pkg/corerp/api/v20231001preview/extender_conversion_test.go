@@ -62,7 +62,7 @@ func TestExtender_ConvertVersionedToDataModel(t *testing.T) {
 					AdditionalProperties: map[string]any{"fromNumber": "222-222-2222"},
 					ResourceProvisioning: portableresources.ResourceProvisioningManual,
 					Secrets:              map[string]any{"accountSid": "sid", "authToken": "token"},
-					ResourceRecipe:       portableresources.ResourceRecipe{Name: "default"},
+					ResourceRecipe:       portableresources.ResourceRecipe{},
 				},
 			},
 		},
@@ -91,7 +91,7 @@ func TestExtender_ConvertVersionedToDataModel(t *testing.T) {
 					},
 					AdditionalProperties: map[string]any{"fromNumber": "222-222-2222"},
 					ResourceProvisioning: portableresources.ResourceProvisioningManual,
-					ResourceRecipe:       portableresources.ResourceRecipe{Name: "default"},
+					ResourceRecipe:       portableresources.ResourceRecipe{},
 				},
 			},
 		},
@@ -46,10 +46,15 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 	if src.Properties.Routes != nil {
 		for _, r := range src.Properties.Routes {
 			s := datamodel.GatewayRoute{
-				Destination:      to.String(r.Destination),
-				Path:             to.String(r.Path),
-				ReplacePrefix:    to.String(r.ReplacePrefix),
-				EnableWebsockets: to.Bool(r.EnableWebsockets),
+				Destination:        to.String(r.Destination),
+				Path:               to.String(r.Path),
+				ReplacePrefix:      to.String(r.ReplacePrefix),
+				EnableWebsockets:   to.Bool(r.EnableWebsockets),
+				Protocol:           toGatewayRouteProtocolDataModel(r.Protocol),
+				TimeoutPolicy:      toGatewayRouteTimeoutPolicyDataModel(r.TimeoutPolicy),
+				Destinations:       toGatewayRouteDestinationsDataModel(r.Destinations),
+				LoadBalancerPolicy: toGatewayRouteLoadBalancerPolicyDataModel(r.LoadBalancerPolicy),
+				Security:           toGatewaySecurityDataModel(r.Security),
 			}
 			routes = append(routes, s)
 		}
@@ -84,6 +89,7 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 			Hostname: hostname,
 			TLS:      tls,
 			Routes:   routes,
+			Security: toGatewaySecurityDataModel(src.Properties.Security),
 			URL:      to.String(src.Properties.URL),
 		},
 	}
@@ -111,10 +117,15 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 	if g.Properties.Routes != nil {
 		for _, r := range g.Properties.Routes {
 			s := &GatewayRoute{
-				Destination:      to.Ptr(r.Destination),
-				Path:             to.Ptr(r.Path),
-				ReplacePrefix:    to.Ptr(r.ReplacePrefix),
-				EnableWebsockets: to.Ptr(r.EnableWebsockets),
+				Destination:        to.Ptr(r.Destination),
+				Path:               to.Ptr(r.Path),
+				ReplacePrefix:      to.Ptr(r.ReplacePrefix),
+				EnableWebsockets:   to.Ptr(r.EnableWebsockets),
+				Protocol:           fromGatewayRouteProtocolDataModel(r.Protocol),
+				TimeoutPolicy:      fromGatewayRouteTimeoutPolicyDataModel(r.TimeoutPolicy),
+				Destinations:       fromGatewayRouteDestinationsDataModel(r.Destinations),
+				LoadBalancerPolicy: fromGatewayRouteLoadBalancerPolicyDataModel(r.LoadBalancerPolicy),
+				Security:           fromGatewaySecurityDataModel(r.Security),
 			}
 			routes = append(routes, s)
 		}
@@ -137,11 +148,13 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &GatewayProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(g.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(g.Properties.Status.Health),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(g.InternalMetadata.AsyncProvisioningState),
 		Application:       to.Ptr(g.Properties.Application),
 		Hostname:          hostname,
 		Routes:            routes,
+		Security:          fromGatewaySecurityDataModel(g.Properties.Security),
 		TLS:               tls,
 		URL:               to.Ptr(g.Properties.URL),
 	}
@@ -177,3 +190,143 @@ func fromTLSMinVersionDataModel(tlsMinVersion datamodel.MinimumTLSProtocolVersio
 
 	return &t
 }
+
+func toGatewayRouteProtocolDataModel(protocol *GatewayRouteProtocol) datamodel.GatewayRouteProtocol {
+	if protocol == nil {
+		return ""
+	}
+
+	return datamodel.GatewayRouteProtocol(*protocol)
+}
+
+func fromGatewayRouteProtocolDataModel(protocol datamodel.GatewayRouteProtocol) *GatewayRouteProtocol {
+	if protocol == "" {
+		return nil
+	}
+
+	return to.Ptr(GatewayRouteProtocol(protocol))
+}
+
+func toGatewayRouteTimeoutPolicyDataModel(policy *GatewayRouteTimeoutPolicy) *datamodel.GatewayRouteTimeoutPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &datamodel.GatewayRouteTimeoutPolicy{
+		Response: to.String(policy.Response),
+		Idle:     to.String(policy.Idle),
+	}
+}
+
+func fromGatewayRouteTimeoutPolicyDataModel(policy *datamodel.GatewayRouteTimeoutPolicy) *GatewayRouteTimeoutPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &GatewayRouteTimeoutPolicy{
+		Response: to.Ptr(policy.Response),
+		Idle:     to.Ptr(policy.Idle),
+	}
+}
+
+func toGatewayRouteDestinationsDataModel(destinations []*GatewayRouteDestination) []datamodel.GatewayRouteDestination {
+	if destinations == nil {
+		return nil
+	}
+
+	converted := []datamodel.GatewayRouteDestination{}
+	for _, d := range destinations {
+		if d == nil {
+			continue
+		}
+
+		converted = append(converted, datamodel.GatewayRouteDestination{
+			Destination: to.String(d.Destination),
+			Weight:      to.Int32(d.Weight),
+		})
+	}
+
+	return converted
+}
+
+func fromGatewayRouteDestinationsDataModel(destinations []datamodel.GatewayRouteDestination) []*GatewayRouteDestination {
+	if destinations == nil {
+		return nil
+	}
+
+	converted := []*GatewayRouteDestination{}
+	for _, d := range destinations {
+		converted = append(converted, &GatewayRouteDestination{
+			Destination: to.Ptr(d.Destination),
+			Weight:      to.Ptr(d.Weight),
+		})
+	}
+
+	return converted
+}
+
+func toGatewayRouteLoadBalancerPolicyDataModel(policy *GatewayRouteLoadBalancerPolicy) *datamodel.GatewayRouteLoadBalancerPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	converted := &datamodel.GatewayRouteLoadBalancerPolicy{}
+	if policy.Strategy != nil {
+		converted.Strategy = datamodel.GatewayRouteLoadBalancerStrategy(*policy.Strategy)
+	}
+
+	return converted
+}
+
+func fromGatewayRouteLoadBalancerPolicyDataModel(policy *datamodel.GatewayRouteLoadBalancerPolicy) *GatewayRouteLoadBalancerPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	converted := &GatewayRouteLoadBalancerPolicy{}
+	if policy.Strategy != "" {
+		converted.Strategy = to.Ptr(GatewayRouteLoadBalancerStrategy(policy.Strategy))
+	}
+
+	return converted
+}
+
+func toGatewaySecurityDataModel(security *GatewaySecurity) *datamodel.GatewaySecurity {
+	if security == nil {
+		return nil
+	}
+
+	converted := &datamodel.GatewaySecurity{
+		IPAllowList: stringSlice(security.IPAllowList),
+		IPDenyList:  stringSlice(security.IPDenyList),
+	}
+
+	if security.BasicAuth != nil {
+		converted.BasicAuth = &datamodel.GatewayBasicAuth{
+			SecretStore: to.String(security.BasicAuth.SecretStore),
+			Realm:       to.String(security.BasicAuth.Realm),
+		}
+	}
+
+	return converted
+}
+
+func fromGatewaySecurityDataModel(security *datamodel.GatewaySecurity) *GatewaySecurity {
+	if security == nil {
+		return nil
+	}
+
+	converted := &GatewaySecurity{
+		IPAllowList: stringPtrSlice(security.IPAllowList),
+		IPDenyList:  stringPtrSlice(security.IPDenyList),
+	}
+
+	if security.BasicAuth != nil {
+		converted.BasicAuth = &GatewayBasicAuth{
+			SecretStore: to.Ptr(security.BasicAuth.SecretStore),
+			Realm:       to.Ptr(security.BasicAuth.Realm),
+		}
+	}
+
+	return converted
+}
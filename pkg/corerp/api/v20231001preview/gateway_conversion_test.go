@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
 
@@ -195,6 +196,142 @@ func TestGatewayEnableWebsocketsConvertDataModelToVersioned(t *testing.T) {
 	require.Equal(t, true, *versioned.Properties.TLS.SSLPassthrough)
 }
 
+func TestGatewayProtocolConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-protocol.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, datamodel.GatewayRouteProtocolGRPC, gw.Properties.Routes[0].Protocol)
+	require.Equal(t, &datamodel.GatewayRouteTimeoutPolicy{Response: "30s", Idle: "infinity"}, gw.Properties.Routes[0].TimeoutPolicy)
+	require.Equal(t, datamodel.GatewayRouteProtocolGRPCWeb, gw.Properties.Routes[1].Protocol)
+	require.Nil(t, gw.Properties.Routes[1].TimeoutPolicy)
+}
+
+func TestGatewayProtocolConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-protocol.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, GatewayRouteProtocolGrpc, *versioned.Properties.Routes[0].Protocol)
+	require.Equal(t, &GatewayRouteTimeoutPolicy{Response: to.Ptr("30s"), Idle: to.Ptr("infinity")}, versioned.Properties.Routes[0].TimeoutPolicy)
+	require.Equal(t, GatewayRouteProtocolGrpcWeb, *versioned.Properties.Routes[1].Protocol)
+	require.Nil(t, versioned.Properties.Routes[1].TimeoutPolicy)
+}
+
+func TestGatewayTrafficSplitConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-trafficsplit.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, []datamodel.GatewayRouteDestination{
+		{Destination: "http://blue:3000", Weight: 90},
+		{Destination: "http://green:3000", Weight: 10},
+	}, gw.Properties.Routes[0].Destinations)
+	require.Equal(t, &datamodel.GatewayRouteLoadBalancerPolicy{Strategy: datamodel.GatewayRouteLoadBalancerStrategyCookie}, gw.Properties.Routes[0].LoadBalancerPolicy)
+	require.Nil(t, gw.Properties.Routes[1].Destinations)
+	require.Equal(t, &datamodel.GatewayRouteLoadBalancerPolicy{Strategy: datamodel.GatewayRouteLoadBalancerStrategyLeastRequest}, gw.Properties.Routes[1].LoadBalancerPolicy)
+}
+
+func TestGatewayTrafficSplitConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-trafficsplit.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, []*GatewayRouteDestination{
+		{Destination: to.Ptr("http://blue:3000"), Weight: to.Ptr[int32](90)},
+		{Destination: to.Ptr("http://green:3000"), Weight: to.Ptr[int32](10)},
+	}, versioned.Properties.Routes[0].Destinations)
+	require.Equal(t, GatewayRouteLoadBalancerStrategyCookie, *versioned.Properties.Routes[0].LoadBalancerPolicy.Strategy)
+	require.Nil(t, versioned.Properties.Routes[1].Destinations)
+	require.Equal(t, GatewayRouteLoadBalancerStrategyLeastRequest, *versioned.Properties.Routes[1].LoadBalancerPolicy.Strategy)
+}
+
+func TestGatewaySecurityConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-security.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, &datamodel.GatewaySecurity{
+		IPAllowList: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		BasicAuth: &datamodel.GatewayBasicAuth{
+			SecretStore: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/credentials",
+			Realm:       "staging",
+		},
+	}, gw.Properties.Security)
+	require.Nil(t, gw.Properties.Routes[0].Security)
+	require.Equal(t, &datamodel.GatewaySecurity{
+		IPDenyList: []string{"192.168.0.1"},
+		BasicAuth: &datamodel.GatewayBasicAuth{
+			SecretStore: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/othercredentials",
+		},
+	}, gw.Properties.Routes[1].Security)
+}
+
+func TestGatewaySecurityConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-security.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &GatewaySecurity{
+		IPAllowList: []*string{to.Ptr("10.0.0.0/8"), to.Ptr("192.168.0.0/16")},
+		BasicAuth: &GatewayBasicAuth{
+			SecretStore: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/credentials"),
+			Realm:       to.Ptr("staging"),
+		},
+	}, versioned.Properties.Security)
+	require.Nil(t, versioned.Properties.Routes[0].Security)
+	require.Equal(t, []*string{to.Ptr("192.168.0.1")}, versioned.Properties.Routes[1].Security.IPDenyList)
+	require.Nil(t, versioned.Properties.Routes[1].Security.IPAllowList)
+}
+
 func TestGatewayTLSTerminationConvertVersionedToDataModel(t *testing.T) {
 	// arrange
 	rawPayload := testutil.ReadFixture("gatewayresource-with-tlstermination.json")
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ConvertTo converts from the versioned recipe plan request to version-agnostic datamodel.
+func (src *RecipePlan) ConvertTo() (v1.DataModelInterface, error) {
+	return &datamodel.RecipePlanRequest{
+		Recipe: datamodel.Recipe{
+			Name:         to.String(src.Name),
+			ResourceType: to.String(src.ResourceType),
+		},
+		ResourceID: to.String(src.Resource),
+		Parameters: src.Parameters,
+	}, nil
+}
+
+// ConvertTo returns an error as it does not support converting the recipe plan response to a version-agnostic object.
+func (src *RecipePlanResponse) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting the recipe plan response to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned recipe plan response.
+func (dst *RecipePlanResponse) ConvertFrom(src v1.DataModelInterface) error {
+	plan, ok := src.(*datamodel.RecipePlanResult)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.TemplateKind = to.Ptr(plan.TemplateKind)
+	dst.TemplatePath = to.Ptr(plan.TemplatePath)
+	if plan.TemplateVersion != "" {
+		dst.TemplateVersion = to.Ptr(plan.TemplateVersion)
+	}
+	dst.Resource = to.Ptr(plan.ResourceID)
+
+	dst.Changes = []*RecipeResourceChange{}
+	for _, change := range plan.Changes {
+		dst.Changes = append(dst.Changes, &RecipeResourceChange{
+			Action:     to.Ptr(RecipeResourceChangeAction(change.Action)),
+			Type:       to.Ptr(change.Type),
+			Name:       to.Ptr(change.Name),
+			Address:    to.Ptr(change.Address),
+			Properties: stringPtrSlice(change.Properties),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipePlanConvertVersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipeplanresource.json")
+	r := &RecipePlan{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	expected := &datamodel.RecipePlanRequest{
+		Recipe: datamodel.Recipe{
+			ResourceType: "Applications.Datastores/redisCaches",
+			Name:         "default",
+		},
+		ResourceID: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/cache",
+		Parameters: map[string]any{
+			"sku": "Standard",
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func TestRecipePlanResponseConvertVersionedToDataModel(t *testing.T) {
+	r := &RecipePlanResponse{}
+
+	// act
+	_, err := r.ConvertTo()
+
+	require.ErrorContains(t, err, "converting the recipe plan response to a version-agnostic object is not supported")
+}
+
+func TestRecipePlanResponseConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("recipeplandatamodel.json")
+	r := &datamodel.RecipePlanResult{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &RecipePlanResponse{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	expected := &RecipePlanResponse{
+		TemplateKind:    to.Ptr("terraform"),
+		TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+		TemplateVersion: to.Ptr("1.1.0"),
+		Resource:        to.Ptr("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/cache"),
+		Changes: []*RecipeResourceChange{
+			{
+				Action:  to.Ptr(RecipeResourceChangeActionCreate),
+				Type:    to.Ptr("azurerm_redis_cache"),
+				Name:    to.Ptr("redis"),
+				Address: to.Ptr("module.default.azurerm_redis_cache.redis"),
+			},
+			{
+				Action:     to.Ptr(RecipeResourceChangeActionUpdate),
+				Type:       to.Ptr("azurerm_resource_group"),
+				Name:       to.Ptr("rg"),
+				Address:    to.Ptr("module.default.azurerm_resource_group.rg"),
+				Properties: []*string{to.Ptr("tags")},
+			},
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func TestRecipePlanResponseConvertDataModelToVersioned_InvalidModel(t *testing.T) {
+	versioned := &RecipePlanResponse{}
+	err := versioned.ConvertFrom(&datamodel.Environment{})
+	require.Error(t, err)
+}
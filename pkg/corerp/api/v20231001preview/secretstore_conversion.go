@@ -43,9 +43,10 @@ func (src *SecretStoreResource) ConvertTo() (v1.DataModelInterface, error) {
 			BasicResourceProperties: rpv1.BasicResourceProperties{
 				Application: to.String(src.Properties.Application),
 			},
-			Resource: to.String(src.Properties.Resource),
-			Type:     toSecretStoreDataTypeDataModel(src.Properties.Type),
-			Data:     toSecretValuePropertiesDataModel(src.Properties.Data),
+			Resource:    to.String(src.Properties.Resource),
+			Type:        toSecretStoreDataTypeDataModel(src.Properties.Type),
+			Data:        toSecretValuePropertiesDataModel(src.Properties.Data),
+			Destination: toSecretStoreDestinationDataModel(src.Properties.Destination),
 		},
 	}
 	return converted, nil
@@ -67,12 +68,14 @@ func (dst *SecretStoreResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &SecretStoreProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(ss.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(ss.Properties.Status.Health),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(ss.InternalMetadata.AsyncProvisioningState),
 		Application:       to.Ptr(ss.Properties.Application),
 		Type:              fromSecretStoreDataTypeDataModel(ss.Properties.Type),
 		Resource:          to.Ptr(ss.Properties.Resource),
 		Data:              fromSecretStoreDataPropertiesDataModel(ss.Properties.Data),
+		Destination:       fromSecretStoreDestinationDataModel(ss.Properties.Destination),
 	}
 
 	return nil
@@ -160,6 +163,13 @@ func toSecretValuePropertiesDataModel(src map[string]*SecretValueProperties) map
 				Version: to.String(v.ValueFrom.Version),
 			}
 		}
+
+		if v.Generator != nil {
+			dst[k].Generator = &datamodel.SecretValueGenerator{
+				Length:       to.Int32(v.Generator.Length),
+				CharacterSet: toSecretValueCharacterSetDataModel(v.Generator.CharacterSet),
+			}
+		}
 	}
 	return dst
 }
@@ -186,6 +196,57 @@ func fromSecretStoreDataPropertiesDataModel(src map[string]*datamodel.SecretStor
 				Version: to.Ptr(v.ValueFrom.Version),
 			}
 		}
+
+		if v.Generator != nil {
+			dst[k].Generator = &SecretValueGenerator{
+				CharacterSet: fromSecretValueCharacterSetDataModel(v.Generator.CharacterSet),
+			}
+			if v.Generator.Length != 0 {
+				dst[k].Generator.Length = to.Ptr(v.Generator.Length)
+			}
+		}
+	}
+	return dst
+}
+
+// toSecretValueCharacterSetDataModel keeps unknown character sets so that they are rejected by the validation of the
+// secret store.
+func toSecretValueCharacterSetDataModel(src *SecretValueCharacterSet) datamodel.SecretValueCharacterSet {
+	if src == nil {
+		return datamodel.SecretValueCharacterSetNone
+	}
+	return datamodel.SecretValueCharacterSet(*src)
+}
+
+func fromSecretValueCharacterSetDataModel(src datamodel.SecretValueCharacterSet) *SecretValueCharacterSet {
+	if src == datamodel.SecretValueCharacterSetNone {
+		return nil
 	}
+	return to.Ptr(SecretValueCharacterSet(src))
+}
+
+func toSecretStoreDestinationDataModel(src *SecretStoreDestination) *datamodel.SecretStoreDestination {
+	if src == nil {
+		return nil
+	}
+
+	return &datamodel.SecretStoreDestination{
+		Resource: to.String(src.Resource),
+		Prefix:   to.String(src.Prefix),
+	}
+}
+
+func fromSecretStoreDestinationDataModel(src *datamodel.SecretStoreDestination) *SecretStoreDestination {
+	if src == nil {
+		return nil
+	}
+
+	dst := &SecretStoreDestination{
+		Resource: to.Ptr(src.Resource),
+	}
+	if src.Prefix != "" {
+		dst.Prefix = to.Ptr(src.Prefix)
+	}
+
 	return dst
 }
@@ -85,6 +85,46 @@ func TestSecretStoreConvertVersionedToDataModel(t *testing.T) {
 
 		require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.KeyVault/vaults/vault0", ct.Properties.Resource)
 	})
+
+	t.Run("with destination", func(t *testing.T) {
+		// arrange
+		rawPayload := testutil.ReadFixture("secretstore-versioned-destination.json")
+		r := &SecretStoreResource{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		// act
+		dm, err := r.ConvertTo()
+
+		// assert
+		require.NoError(t, err)
+		ct := dm.(*datamodel.SecretStore)
+		require.Equal(t, &datamodel.SecretStoreDestination{
+			Resource: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.KeyVault/vaults/vault0",
+			Prefix:   "myapp",
+		}, ct.Properties.Destination)
+	})
+
+	t.Run("with generator", func(t *testing.T) {
+		// arrange
+		rawPayload := testutil.ReadFixture("secretstore-versioned-generator.json")
+		r := &SecretStoreResource{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		// act
+		dm, err := r.ConvertTo()
+
+		// assert
+		require.NoError(t, err)
+		ct := dm.(*datamodel.SecretStore)
+		require.Nil(t, ct.Properties.Data["username"].Generator)
+		require.Nil(t, ct.Properties.Data["password"].Value)
+		require.Equal(t, &datamodel.SecretValueGenerator{
+			Length:       24,
+			CharacterSet: datamodel.SecretValueCharacterSetSymbols,
+		}, ct.Properties.Data["password"].Generator)
+	})
 }
 
 func TestSecretStoreConvertDataModelToVersioned(t *testing.T) {
@@ -142,6 +182,43 @@ func TestSecretStoreConvertDataModelToVersioned(t *testing.T) {
 
 		require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.KeyVault/vaults/vault0", to.String(versioned.Properties.Resource))
 	})
+
+	t.Run("with destination", func(t *testing.T) {
+		// arrange
+		rawPayload := testutil.ReadFixture("secretstore-datamodel-destination.json")
+		r := &datamodel.SecretStore{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		// act
+		versioned := &SecretStoreResource{}
+		err = versioned.ConvertFrom(r)
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, &SecretStoreDestination{
+			Resource: to.Ptr("/planes/aws/aws/accounts/000000000000/regions/us-west-2"),
+		}, versioned.Properties.Destination)
+	})
+
+	t.Run("with generator", func(t *testing.T) {
+		// arrange
+		rawPayload := testutil.ReadFixture("secretstore-datamodel-generator.json")
+		r := &datamodel.SecretStore{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		// act
+		versioned := &SecretStoreResource{}
+		err = versioned.ConvertFrom(r)
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, &SecretValueGenerator{
+			Length:       to.Ptr[int32](32),
+			CharacterSet: to.Ptr(SecretValueCharacterSetAlphanumeric),
+		}, versioned.Properties.Data["password"].Generator)
+	})
 }
 
 func TestSecretStoreConvertFromValidation(t *testing.T) {
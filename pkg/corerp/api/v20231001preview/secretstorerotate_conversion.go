@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ConvertTo converts from the versioned secret store rotate request to version-agnostic datamodel.
+func (src *SecretStoreRotateRequest) ConvertTo() (v1.DataModelInterface, error) {
+	dst := &datamodel.SecretStoreRotateRequest{}
+	for _, key := range src.Keys {
+		if key != nil {
+			dst.Keys = append(dst.Keys, *key)
+		}
+	}
+	return dst, nil
+}
+
+// ConvertTo returns an error as it does not support converting the secret store rotate result to a version-agnostic object.
+func (src *SecretStoreRotateResult) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting the secret store rotate result to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned secret store rotate result.
+func (dst *SecretStoreRotateResult) ConvertFrom(src v1.DataModelInterface) error {
+	result, ok := src.(*datamodel.SecretStoreRotateResult)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.RotatedKeys = to.SliceOfPtrs(result.RotatedKeys...)
+	dst.RestartedContainers = to.SliceOfPtrs(result.RestartedContainers...)
+
+	return nil
+}
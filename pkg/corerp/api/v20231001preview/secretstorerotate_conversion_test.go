@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretStoreRotateRequestConvertVersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("secretstorerotaterequest.json")
+	r := &SecretStoreRotateRequest{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &datamodel.SecretStoreRotateRequest{Keys: []string{"password", "token"}}, dm)
+}
+
+func TestSecretStoreRotateResultConvertVersionedToDataModel(t *testing.T) {
+	r := &SecretStoreRotateResult{}
+
+	// act
+	_, err := r.ConvertTo()
+
+	require.ErrorContains(t, err, "converting the secret store rotate result to a version-agnostic object is not supported")
+}
+
+func TestSecretStoreRotateResultConvertDataModelToVersioned(t *testing.T) {
+	t.Run("rotated", func(t *testing.T) {
+		r := &datamodel.SecretStoreRotateResult{
+			RotatedKeys:         []string{"password"},
+			RestartedContainers: []string{"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/frontend"},
+		}
+
+		// act
+		versioned := &SecretStoreRotateResult{}
+		err := versioned.ConvertFrom(r)
+
+		// assert
+		require.NoError(t, err)
+		expected := &SecretStoreRotateResult{
+			RotatedKeys:         []*string{to.Ptr("password")},
+			RestartedContainers: []*string{to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/frontend")},
+		}
+		require.Equal(t, expected, versioned)
+	})
+
+	t.Run("no restarted containers", func(t *testing.T) {
+		r := &datamodel.SecretStoreRotateResult{RotatedKeys: []string{"password"}}
+
+		// act
+		versioned := &SecretStoreRotateResult{}
+		err := versioned.ConvertFrom(r)
+
+		// assert
+		require.NoError(t, err)
+		require.Equal(t, []*string{}, versioned.RestartedContainers)
+	})
+}
+
+func TestSecretStoreRotateResultConvertDataModelToVersioned_InvalidModel(t *testing.T) {
+	versioned := &SecretStoreRotateResult{}
+	err := versioned.ConvertFrom(&datamodel.SecretStore{})
+	require.Error(t, err)
+}
@@ -113,6 +113,13 @@ func stringSlice(s []*string) []string {
 	return r
 }
 
+func stringPtrSlice(s []string) []*string {
+	if s == nil {
+		return nil
+	}
+	return to.SliceOfPtrs(s...)
+}
+
 func isValidTemplateKind(templateKind string) bool {
 	return slices.Contains(recipes.SupportedTemplateKind, templateKind)
 }
@@ -136,3 +143,23 @@ func toOutputResourcesDataModel(outputResources []rpv1.OutputResource) []*Output
 	}
 	return outResources
 }
+
+func fromResourceHealth(health *rpv1.ResourceHealth) *ResourceHealth {
+	if health == nil {
+		return nil
+	}
+
+	result := &ResourceHealth{
+		State: to.Ptr(ResourceHealthState(health.State)),
+	}
+
+	// We will not serialize the following fields if they are empty.
+	if health.Message != "" {
+		result.Message = to.Ptr(health.Message)
+	}
+	if !health.LastTransitionTime.IsZero() {
+		result.LastTransitionTime = to.Ptr(health.LastTransitionTime)
+	}
+
+	return result
+}
@@ -96,6 +96,7 @@ func (dst *VolumeResource) ConvertFrom(src v1.DataModelInterface) error {
 		p := &AzureKeyVaultVolumeProperties{
 			Status: &ResourceStatus{
 				OutputResources: toOutputResourcesDataModel(resource.Properties.Status.OutputResources),
+				Health:          fromResourceHealth(resource.Properties.Status.Health),
 			},
 			Kind:              to.Ptr(resource.Properties.Kind),
 			Application:       to.Ptr(resource.Properties.Application),
@@ -198,6 +198,65 @@ func (client *ApplicationsClient) getHandleResponse(resp *http.Response) (Applic
 	return result, nil
 }
 
+// GetDevProfile - Gets the dev profile of the application, which describes the images, namespaces and label selectors of its
+// containers.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - applicationName - The application name
+//   - body - The content of the action request
+//   - options - ApplicationsClientGetDevProfileOptions contains the optional parameters for the ApplicationsClient.GetDevProfile method.
+func (client *ApplicationsClient) GetDevProfile(ctx context.Context, applicationName string, body map[string]any, options *ApplicationsClientGetDevProfileOptions) (ApplicationsClientGetDevProfileResponse, error) {
+	var err error
+	ctx, endSpan := runtime.StartSpan(ctx, "ApplicationsClient.GetDevProfile", client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.getDevProfileCreateRequest(ctx, applicationName, body, options)
+	if err != nil {
+		return ApplicationsClientGetDevProfileResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ApplicationsClientGetDevProfileResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ApplicationsClientGetDevProfileResponse{}, err
+	}
+	resp, err := client.getDevProfileHandleResponse(httpResp)
+	return resp, err
+}
+
+// getDevProfileCreateRequest creates the GetDevProfile request.
+func (client *ApplicationsClient) getDevProfileCreateRequest(ctx context.Context, applicationName string, body map[string]any, _ *ApplicationsClientGetDevProfileOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/applications/{applicationName}/getDevProfile"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if applicationName == "" {
+		return nil, errors.New("parameter applicationName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{applicationName}", url.PathEscape(applicationName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+;	return req, nil
+}
+
+// getDevProfileHandleResponse handles the GetDevProfile response.
+func (client *ApplicationsClient) getDevProfileHandleResponse(resp *http.Response) (ApplicationsClientGetDevProfileResponse, error) {
+	result := ApplicationsClientGetDevProfileResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ApplicationDevProfileResponse); err != nil {
+		return ApplicationsClientGetDevProfileResponse{}, err
+	}
+	return result, nil
+}
+
 // GetGraph - Gets the application graph and resources.
 // If the operation fails it returns an *azcore.ResponseError type.
 //
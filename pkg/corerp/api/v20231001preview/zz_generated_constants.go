@@ -62,6 +62,94 @@ func PossibleCertificateTypesValues() []CertificateTypes {
 	}
 }
 
+// ConnectionReadinessCondition - The condition a connected resource must meet before the container is deployed
+type ConnectionReadinessCondition string
+
+const (
+// ConnectionReadinessConditionHealthy - The container is deployed once the connected resource is provisioned and accepts
+// TCP connections on its host and port
+	ConnectionReadinessConditionHealthy ConnectionReadinessCondition = "healthy"
+// ConnectionReadinessConditionNone - The container is deployed without waiting for the connected resource
+	ConnectionReadinessConditionNone ConnectionReadinessCondition = "none"
+// ConnectionReadinessConditionProvisioned - The container is deployed once the provisioning state of the connected resource
+// is Succeeded
+	ConnectionReadinessConditionProvisioned ConnectionReadinessCondition = "provisioned"
+)
+
+// PossibleConnectionReadinessConditionValues returns the possible values for the ConnectionReadinessCondition const type.
+func PossibleConnectionReadinessConditionValues() []ConnectionReadinessCondition {
+	return []ConnectionReadinessCondition{	
+		ConnectionReadinessConditionHealthy,
+		ConnectionReadinessConditionNone,
+		ConnectionReadinessConditionProvisioned,
+	}
+}
+
+// ConnectionSecretsMaterialization - Specifies when the values of a connection are materialized
+type ConnectionSecretsMaterialization string
+
+const (
+// ConnectionSecretsMaterializationDeploy - The values are stored in a Kubernetes secret when the container is deployed and
+// exposed as environment variables
+	ConnectionSecretsMaterializationDeploy ConnectionSecretsMaterialization = "deploy"
+// ConnectionSecretsMaterializationRuntime - The values are fetched from the Radius control plane by an init container when
+// the pod starts and written to files in the container
+	ConnectionSecretsMaterializationRuntime ConnectionSecretsMaterialization = "runtime"
+)
+
+// PossibleConnectionSecretsMaterializationValues returns the possible values for the ConnectionSecretsMaterialization const type.
+func PossibleConnectionSecretsMaterializationValues() []ConnectionSecretsMaterialization {
+	return []ConnectionSecretsMaterialization{	
+		ConnectionSecretsMaterializationDeploy,
+		ConnectionSecretsMaterializationRuntime,
+	}
+}
+
+// ConnectionStringFormat - The format of the connection string of a connection to a datastore resource
+type ConnectionStringFormat string
+
+const (
+// ConnectionStringFormatAdonet - ADO.NET connection string, supported by Applications.Datastores/sqlDatabases
+	ConnectionStringFormatAdonet ConnectionStringFormat = "adonet"
+// ConnectionStringFormatJdbc - JDBC URL, supported by Applications.Datastores/sqlDatabases and Applications.Datastores/mongoDatabases
+	ConnectionStringFormatJdbc ConnectionStringFormat = "jdbc"
+// ConnectionStringFormatStackexchange - StackExchange.Redis configuration string, supported by Applications.Datastores/redisCaches
+	ConnectionStringFormatStackexchange ConnectionStringFormat = "stackexchange"
+// ConnectionStringFormatURI - URI, supported by Applications.Datastores/sqlDatabases, Applications.Datastores/mongoDatabases
+// and Applications.Datastores/redisCaches
+	ConnectionStringFormatURI ConnectionStringFormat = "uri"
+)
+
+// PossibleConnectionStringFormatValues returns the possible values for the ConnectionStringFormat const type.
+func PossibleConnectionStringFormatValues() []ConnectionStringFormat {
+	return []ConnectionStringFormat{	
+		ConnectionStringFormatAdonet,
+		ConnectionStringFormatJdbc,
+		ConnectionStringFormatStackexchange,
+		ConnectionStringFormatURI,
+	}
+}
+
+// ContainerAutoscalingMetricKind - The kind of a custom metric used to scale a container
+type ContainerAutoscalingMetricKind string
+
+const (
+// ContainerAutoscalingMetricKindExternal - A metric which is not related to the container, such as the length of a queue
+// of a cloud service
+	ContainerAutoscalingMetricKindExternal ContainerAutoscalingMetricKind = "external"
+// ContainerAutoscalingMetricKindPods - A metric which describes each replica of the container, such as the number of requests
+// per second it serves
+	ContainerAutoscalingMetricKindPods ContainerAutoscalingMetricKind = "pods"
+)
+
+// PossibleContainerAutoscalingMetricKindValues returns the possible values for the ContainerAutoscalingMetricKind const type.
+func PossibleContainerAutoscalingMetricKindValues() []ContainerAutoscalingMetricKind {
+	return []ContainerAutoscalingMetricKind{	
+		ContainerAutoscalingMetricKindExternal,
+		ContainerAutoscalingMetricKindPods,
+	}
+}
+
 // ContainerResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values
 // are 'internal', where Radius manages the lifecycle of the resource internally, and 'manual', where a user
 // manages the resource.
@@ -139,6 +227,54 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// GatewayRouteLoadBalancerStrategy - The strategy used to balance the requests of a Gateway route between the replicas of
+// the service.
+type GatewayRouteLoadBalancerStrategy string
+
+const (
+// GatewayRouteLoadBalancerStrategyCookie - Session affinity. The requests from a client are sent to the same replica using
+// a cookie.
+	GatewayRouteLoadBalancerStrategyCookie GatewayRouteLoadBalancerStrategy = "cookie"
+// GatewayRouteLoadBalancerStrategyLeastRequest - The requests are sent to the replica with the least active requests.
+	GatewayRouteLoadBalancerStrategyLeastRequest GatewayRouteLoadBalancerStrategy = "leastRequest"
+// GatewayRouteLoadBalancerStrategyRoundRobin - The requests are distributed to the replicas in turn.
+	GatewayRouteLoadBalancerStrategyRoundRobin GatewayRouteLoadBalancerStrategy = "roundRobin"
+)
+
+// PossibleGatewayRouteLoadBalancerStrategyValues returns the possible values for the GatewayRouteLoadBalancerStrategy const type.
+func PossibleGatewayRouteLoadBalancerStrategyValues() []GatewayRouteLoadBalancerStrategy {
+	return []GatewayRouteLoadBalancerStrategy{	
+		GatewayRouteLoadBalancerStrategyCookie,
+		GatewayRouteLoadBalancerStrategyLeastRequest,
+		GatewayRouteLoadBalancerStrategyRoundRobin,
+	}
+}
+
+// GatewayRouteProtocol - The protocol used by the service of a Gateway route.
+type GatewayRouteProtocol string
+
+const (
+// GatewayRouteProtocolGrpc - gRPC traffic. The service is reached over HTTP/2.
+	GatewayRouteProtocolGrpc GatewayRouteProtocol = "grpc"
+// GatewayRouteProtocolGrpcWeb - gRPC-Web traffic from browsers. The requests are translated to gRPC and the service is reached
+// over HTTP/2.
+	GatewayRouteProtocolGrpcWeb GatewayRouteProtocol = "grpc-web"
+// GatewayRouteProtocolHTTP - HTTP/1.1 traffic.
+	GatewayRouteProtocolHTTP GatewayRouteProtocol = "http"
+// GatewayRouteProtocolWebsocket - HTTP traffic which can be upgraded to WebSocket connections.
+	GatewayRouteProtocolWebsocket GatewayRouteProtocol = "websocket"
+)
+
+// PossibleGatewayRouteProtocolValues returns the possible values for the GatewayRouteProtocol const type.
+func PossibleGatewayRouteProtocolValues() []GatewayRouteProtocol {
+	return []GatewayRouteProtocol{	
+		GatewayRouteProtocolGrpc,
+		GatewayRouteProtocolGrpcWeb,
+		GatewayRouteProtocolHTTP,
+		GatewayRouteProtocolWebsocket,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -286,6 +422,60 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RecipeResourceChangeAction - The action the deployment of a recipe would take on a resource.
+type RecipeResourceChangeAction string
+
+const (
+// RecipeResourceChangeActionCreate - The resource would be created
+	RecipeResourceChangeActionCreate RecipeResourceChangeAction = "create"
+// RecipeResourceChangeActionDelete - The resource would be deleted
+	RecipeResourceChangeActionDelete RecipeResourceChangeAction = "delete"
+// RecipeResourceChangeActionNoChange - The resource would not change
+	RecipeResourceChangeActionNoChange RecipeResourceChangeAction = "noChange"
+// RecipeResourceChangeActionRead - The resource would only be read, for example a Terraform data source
+	RecipeResourceChangeActionRead RecipeResourceChangeAction = "read"
+// RecipeResourceChangeActionReplace - The resource would be deleted and created again
+	RecipeResourceChangeActionReplace RecipeResourceChangeAction = "replace"
+// RecipeResourceChangeActionUnsupported - The changes to the resource cannot be determined
+	RecipeResourceChangeActionUnsupported RecipeResourceChangeAction = "unsupported"
+// RecipeResourceChangeActionUpdate - The resource would be updated in place
+	RecipeResourceChangeActionUpdate RecipeResourceChangeAction = "update"
+)
+
+// PossibleRecipeResourceChangeActionValues returns the possible values for the RecipeResourceChangeAction const type.
+func PossibleRecipeResourceChangeActionValues() []RecipeResourceChangeAction {
+	return []RecipeResourceChangeAction{	
+		RecipeResourceChangeActionCreate,
+		RecipeResourceChangeActionDelete,
+		RecipeResourceChangeActionNoChange,
+		RecipeResourceChangeActionRead,
+		RecipeResourceChangeActionReplace,
+		RecipeResourceChangeActionUnsupported,
+		RecipeResourceChangeActionUpdate,
+	}
+}
+
+// ResourceHealthState - The health state of a resource.
+type ResourceHealthState string
+
+const (
+// ResourceHealthStateHealthy - The resource is healthy
+	ResourceHealthStateHealthy ResourceHealthState = "Healthy"
+// ResourceHealthStateUnhealthy - The resource is unhealthy
+	ResourceHealthStateUnhealthy ResourceHealthState = "Unhealthy"
+// ResourceHealthStateUnknown - The health of the resource could not be determined
+	ResourceHealthStateUnknown ResourceHealthState = "Unknown"
+)
+
+// PossibleResourceHealthStateValues returns the possible values for the ResourceHealthState const type.
+func PossibleResourceHealthStateValues() []ResourceHealthState {
+	return []ResourceHealthState{	
+		ResourceHealthStateHealthy,
+		ResourceHealthStateUnhealthy,
+		ResourceHealthStateUnknown,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
@@ -327,6 +517,27 @@ func PossibleRestartPolicyValues() []RestartPolicy {
 	}
 }
 
+// SecretReferenceKind - The kind of use of a secret by a resource
+type SecretReferenceKind string
+
+const (
+// SecretReferenceKindData - The secret is provided as data of a secret store
+	SecretReferenceKindData SecretReferenceKind = "data"
+// SecretReferenceKindEnvironmentVariable - The secret is consumed as an environment variable of a container
+	SecretReferenceKindEnvironmentVariable SecretReferenceKind = "environmentVariable"
+// SecretReferenceKindVolume - The secret is consumed through a volume mounted into a container
+	SecretReferenceKindVolume SecretReferenceKind = "volume"
+)
+
+// PossibleSecretReferenceKindValues returns the possible values for the SecretReferenceKind const type.
+func PossibleSecretReferenceKindValues() []SecretReferenceKind {
+	return []SecretReferenceKind{	
+		SecretReferenceKindData,
+		SecretReferenceKindEnvironmentVariable,
+		SecretReferenceKindVolume,
+	}
+}
+
 // SecretStoreDataType - The type of SecretStore data
 type SecretStoreDataType string
 
@@ -357,6 +568,27 @@ func PossibleSecretStoreDataTypeValues() []SecretStoreDataType {
 	}
 }
 
+// SecretValueCharacterSet - The set of characters of a generated secret value
+type SecretValueCharacterSet string
+
+const (
+// SecretValueCharacterSetAlphanumeric - Letters and digits
+	SecretValueCharacterSetAlphanumeric SecretValueCharacterSet = "alphanumeric"
+// SecretValueCharacterSetHex - Lowercase hexadecimal digits
+	SecretValueCharacterSetHex SecretValueCharacterSet = "hex"
+// SecretValueCharacterSetSymbols - Letters, digits and symbols
+	SecretValueCharacterSetSymbols SecretValueCharacterSet = "symbols"
+)
+
+// PossibleSecretValueCharacterSetValues returns the possible values for the SecretValueCharacterSet const type.
+func PossibleSecretValueCharacterSetValues() []SecretValueCharacterSet {
+	return []SecretValueCharacterSet{	
+		SecretValueCharacterSetAlphanumeric,
+		SecretValueCharacterSetHex,
+		SecretValueCharacterSetSymbols,
+	}
+}
+
 // SecretValueEncoding - The type of SecretValue Encoding
 type SecretValueEncoding string
 
@@ -393,6 +625,30 @@ func PossibleTLSMinVersionValues() []TLSMinVersion {
 	}
 }
 
+// TerraformBackendType - The type of the backend where Terraform stores the state of Terraform Recipes.
+type TerraformBackendType string
+
+const (
+// TerraformBackendTypeAzurerm - Azure Blob Storage container
+	TerraformBackendTypeAzurerm TerraformBackendType = "azurerm"
+// TerraformBackendTypeGcs - Google Cloud Storage bucket
+	TerraformBackendTypeGcs TerraformBackendType = "gcs"
+// TerraformBackendTypeKubernetes - Kubernetes secret in the Radius namespace
+	TerraformBackendTypeKubernetes TerraformBackendType = "kubernetes"
+// TerraformBackendTypeS3 - Amazon S3 bucket
+	TerraformBackendTypeS3 TerraformBackendType = "s3"
+)
+
+// PossibleTerraformBackendTypeValues returns the possible values for the TerraformBackendType const type.
+func PossibleTerraformBackendTypeValues() []TerraformBackendType {
+	return []TerraformBackendType{	
+		TerraformBackendTypeAzurerm,
+		TerraformBackendTypeGcs,
+		TerraformBackendTypeKubernetes,
+		TerraformBackendTypeS3,
+	}
+}
+
 // VolumePermission - The persistent volume permission
 type VolumePermission string
 
@@ -232,6 +232,65 @@ func (client *ContainersClient) getHandleResponse(resp *http.Response) (Containe
 	return result, nil
 }
 
+// ListSecretReferences - Lists the secrets used by a container, without their values.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - containerName - Container name
+//   - body - The content of the action request
+//   - options - ContainersClientListSecretReferencesOptions contains the optional parameters for the ContainersClient.ListSecretReferences
+//     method.
+func (client *ContainersClient) ListSecretReferences(ctx context.Context, containerName string, body map[string]any, options *ContainersClientListSecretReferencesOptions) (ContainersClientListSecretReferencesResponse, error) {
+	var err error
+	ctx, endSpan := runtime.StartSpan(ctx, "ContainersClient.ListSecretReferences", client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.listSecretReferencesCreateRequest(ctx, containerName, body, options)
+	if err != nil {
+		return ContainersClientListSecretReferencesResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ContainersClientListSecretReferencesResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ContainersClientListSecretReferencesResponse{}, err
+	}
+	resp, err := client.listSecretReferencesHandleResponse(httpResp)
+	return resp, err
+}
+
+// listSecretReferencesCreateRequest creates the ListSecretReferences request.
+func (client *ContainersClient) listSecretReferencesCreateRequest(ctx context.Context, containerName string, body map[string]any, _ *ContainersClientListSecretReferencesOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/containers/{containerName}/listSecretReferences"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if containerName == "" {
+		return nil, errors.New("parameter containerName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{containerName}", url.PathEscape(containerName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+;	return req, nil
+}
+
+// listSecretReferencesHandleResponse handles the ListSecretReferences response.
+func (client *ContainersClient) listSecretReferencesHandleResponse(resp *http.Response) (ContainersClientListSecretReferencesResponse, error) {
+	result := ContainersClientListSecretReferencesResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ListSecretReferencesResult); err != nil {
+		return ContainersClientListSecretReferencesResponse{}, err
+	}
+	return result, nil
+}
+
 // NewListByScopePager - List ContainerResource resources by Scope
 //
 // Generated from API version 2023-10-01-preview
@@ -308,6 +308,66 @@ func (client *EnvironmentsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// PlanRecipe - Computes the changes the deployment of a recipe would make to the resources of the recipe, without deploying
+// the recipe.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientPlanRecipeOptions contains the optional parameters for the EnvironmentsClient.PlanRecipe
+//     method.
+func (client *EnvironmentsClient) PlanRecipe(ctx context.Context, environmentName string, body RecipePlan, options *EnvironmentsClientPlanRecipeOptions) (EnvironmentsClientPlanRecipeResponse, error) {
+	var err error
+	ctx, endSpan := runtime.StartSpan(ctx, "EnvironmentsClient.PlanRecipe", client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.planRecipeCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	resp, err := client.planRecipeHandleResponse(httpResp)
+	return resp, err
+}
+
+// planRecipeCreateRequest creates the PlanRecipe request.
+func (client *EnvironmentsClient) planRecipeCreateRequest(ctx context.Context, environmentName string, body RecipePlan, _ *EnvironmentsClientPlanRecipeOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/planRecipe"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+;	return req, nil
+}
+
+// planRecipeHandleResponse handles the PlanRecipe response.
+func (client *EnvironmentsClient) planRecipeHandleResponse(resp *http.Response) (EnvironmentsClientPlanRecipeResponse, error) {
+	result := EnvironmentsClientPlanRecipeResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResponse); err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a EnvironmentResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -6,6 +6,37 @@ package v20231001preview
 
 import "time"
 
+// ApplicationDevProfileContainer - Describes how to locate the Kubernetes workload of a container resource.
+type ApplicationDevProfileContainer struct {
+// REQUIRED; The name of the container in the pod template of the deployment.
+	ContainerName *string
+
+// REQUIRED; The name of the Kubernetes deployment.
+	Deployment *string
+
+// REQUIRED; The resource ID of the container resource.
+	ID *string
+
+// REQUIRED; The container image.
+	Image *string
+
+// REQUIRED; The labels which select the pods of the deployment.
+	LabelSelector map[string]*string
+
+// REQUIRED; The name of the container resource.
+	Name *string
+
+// REQUIRED; The Kubernetes namespace of the deployment.
+	Namespace *string
+}
+
+// ApplicationDevProfileResponse - Describes the containers of an application for inner-loop development tools such as Tilt
+// or Skaffold, which sync code changes into the running containers.
+type ApplicationDevProfileResponse struct {
+// REQUIRED; The containers of the application.
+	Containers []*ApplicationDevProfileContainer
+}
+
 // ApplicationGraphConnection - Describes the connection between two resources.
 type ApplicationGraphConnection struct {
 // REQUIRED; The direction of the connection. 'Outbound' indicates this connection specifies the ID of the destination and
@@ -26,6 +57,12 @@ type ApplicationGraphOutputResource struct {
 
 // REQUIRED; The resource type.
 	Type *string
+
+// The provider of the resource, for example 'azure', 'aws' or 'kubernetes'.
+	Provider *string
+
+// The region of the resource, if the resource ID specifies one.
+	Region *string
 }
 
 // ApplicationGraphResource - Describes a resource in the application graph.
@@ -121,12 +158,50 @@ type ApplicationResourceUpdate struct {
 	Type *string
 }
 
-// AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git.
+// ArchiveSecretConfig - Secret configuration used to authenticate to the source of Terraform modules packaged as archives.
+type ArchiveSecretConfig struct {
+// The ID of an Applications.Core/SecretStore resource containing the credentials used to download the archive. Amazon S3
+// sources use the 'accessKeyId' and 'secretAccessKey' secrets. Azure Blob Storage sources use the 'sasToken' secret. Other
+// HTTP sources use the 'username' and 'password' secrets for basic authentication.
+	Secret *string
+}
+
+// AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git,
+// archives.
 type AuthConfig struct {
+// Authentication information used to access private Terraform modules packaged as archives on object storage or HTTP
+// servers, which is a map of archive source hostname to secret config that contains credential information.
+	Archive map[string]*ArchiveSecretConfig
+
 // Authentication information used to access private Terraform modules from Git repository sources.
 	Git *GitAuthConfig
 }
 
+// AzureContainerInstanceCompute - The Azure Container Instances compute configuration
+type AzureContainerInstanceCompute struct {
+// REQUIRED; Discriminator property for EnvironmentCompute.
+	Kind *string
+
+// Configuration for supported external identity providers
+	Identity *IdentitySettings
+
+// The resource ID of the Azure resource group to deploy the container groups to. Defaults to the resource group of the Azure
+// provider scope of the environment.
+	ResourceGroup *string
+
+// The resource id of the compute resource for application environment.
+	ResourceID *string
+}
+
+// GetEnvironmentCompute implements the EnvironmentComputeClassification interface for type AzureContainerInstanceCompute.
+func (a *AzureContainerInstanceCompute) GetEnvironmentCompute() *EnvironmentCompute {
+	return &EnvironmentCompute{
+		Identity: a.Identity,
+		Kind: a.Kind,
+		ResourceID: a.ResourceID,
+	}
+}
+
 // AzureKeyVaultVolumeProperties - Represents Azure Key Vault Volume properties
 type AzureKeyVaultVolumeProperties struct {
 // REQUIRED; Fully qualified resource ID for the application
@@ -203,6 +278,11 @@ type BicepRecipeProperties struct {
 // REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+// The resource types of the output resources the recipe is allowed to create, for example 'Microsoft.Cache/redis' or 'apps/Deployment'.
+// A trailing '/*' allows all the resource types of a namespace. The recipe fails if it creates resources of other types.
+// All resource types are allowed when empty.
+	AllowedOutputResourceTypes []*string
+
 // Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
@@ -214,6 +294,7 @@ type BicepRecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type BicepRecipeProperties.
 func (b *BicepRecipeProperties) GetRecipeProperties() *RecipeProperties {
 	return &RecipeProperties{
+		AllowedOutputResourceTypes: b.AllowedOutputResourceTypes,
 		Parameters: b.Parameters,
 		TemplateKind: b.TemplateKind,
 		TemplatePath: b.TemplatePath,
@@ -249,8 +330,46 @@ type ConnectionProperties struct {
 // default environment variable override
 	DisableDefaultEnvVars *bool
 
+// The format of the connection string provided to the container in the CONNECTION_<NAME>_CONNECTIONSTRING environment variable.
+// Only applies to connections to datastore resources. Defaults to the connection string computed by the datastore.
+	Format *ConnectionStringFormat
+
 // iam properties
 	Iam *IamProperties
+
+// Specifies whether the container waits for the connected resource to be ready before it is deployed
+	Readiness *ConnectionReadinessProperties
+
+// Specifies how the values of the connection are provided to the container
+	Secrets *ConnectionSecretsProperties
+}
+
+// ConnectionReadinessProperties - Specifies whether the container waits for a connected resource to be ready before it is
+// deployed
+type ConnectionReadinessProperties struct {
+// The maximum time in seconds to wait for the connected resource to be ready. Defaults to 300 seconds.
+	TimeoutSeconds *int32
+
+// The condition the connected resource must meet before the container is deployed. Defaults to 'none'.
+	WaitFor *ConnectionReadinessCondition
+}
+
+// ConnectionSecretsProperties - Specifies how the values of a connection are provided to the container
+type ConnectionSecretsProperties struct {
+// Maps the names of the values of the connection, such as 'password' or 'connectionString', to the keys of the Kubernetes
+// secret that stores them. Values that are not mapped are stored with the key CONNECTION_<NAME>_<VALUE>.
+	Keys map[string]*string
+
+// Specifies when the values of the connection are materialized. Defaults to 'deploy'.
+	Materialization *ConnectionSecretsMaterialization
+
+// The interval in seconds at which the values of the connection are refreshed when they are materialized at runtime. The
+// values are not refreshed if not specified.
+	RefreshIntervalSeconds *int32
+
+// The name of the Kubernetes secret that stores the values of the connection when they are materialized at deploy time.
+// Defaults to the secret of the container.
+	SecretName *string
 }
 
 // Container - Definition of a container
@@ -286,6 +405,42 @@ type Container struct {
 	WorkingDir *string
 }
 
+// ContainerAutoscalingMetric - Specifies a custom metric used to scale a container
+type ContainerAutoscalingMetric struct {
+// REQUIRED; The kind of the metric
+	Kind *ContainerAutoscalingMetricKind
+
+// REQUIRED; The name of the metric
+	Name *string
+
+// The labels which select the metric
+	Selector map[string]*string
+
+// The target average value of the metric across the replicas, as a Kubernetes quantity. Required for 'pods' metrics.
+	TargetAverageValue *string
+
+// The target value of the metric, as a Kubernetes quantity. Only applies to 'external' metrics.
+	TargetValue *string
+}
+
+// ContainerAutoscalingProperties - Specifies how the container is scaled horizontally by a Kubernetes HorizontalPodAutoscaler
+type ContainerAutoscalingProperties struct {
+// REQUIRED; The maximum number of replicas
+	MaxReplicas *int32
+
+// The custom metrics used to scale the container
+	Metrics []*ContainerAutoscalingMetric
+
+// The minimum number of replicas. Defaults to 1.
+	MinReplicas *int32
+
+// The target average CPU utilization of the replicas, as a percentage of the CPU they request
+	TargetCPUUtilization *int32
+
+// The target average memory utilization of the replicas, as a percentage of the memory they request
+	TargetMemoryUtilization *int32
+}
+
 // ContainerPortProperties - Specifies a listening port for the container
 type ContainerPortProperties struct {
 // REQUIRED; The listening port number
@@ -310,6 +465,9 @@ type ContainerProperties struct {
 // REQUIRED; Definition of a container.
 	Container *Container
 
+// Specifies how the container is scaled horizontally based on its resource utilization and custom metrics.
+	Autoscaling *ContainerAutoscalingProperties
+
 // Specifies a connection to another resource.
 	Connections map[string]*ConnectionProperties
 
@@ -393,6 +551,26 @@ type ContainerResourceUpdate struct {
 	Type *string
 }
 
+// CostAttributionExtension - Cost attribution extension of an environment resource. Applies cost attribution labels to the
+// Kubernetes resources and recipe outputs of the environment so that cost tools can attribute spend to applications.
+type CostAttributionExtension struct {
+// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+// The prefix of the cost attribution label keys applied to Kubernetes resources. Defaults to 'cost.radapp.io/'.
+	LabelPrefix *string
+
+// Additional cost attribution values, such as owner, team or cost center, applied as labels and tags.
+	Tags map[string]*string
+}
+
+// GetExtension implements the ExtensionClassification interface for type CostAttributionExtension.
+func (c *CostAttributionExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: c.Kind,
+	}
+}
+
 // DaprSidecarExtension - Specifies the resource should have a Dapr sidecar injected
 type DaprSidecarExtension struct {
 // REQUIRED; The Dapr appId. Specifies the identifier used by Dapr for service invocation.
@@ -418,6 +596,36 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// DeploymentPolicy - Policy restricting when resources can be deployed to the environment. Deployments of the environment
+// itself are always allowed, so the policy can be changed at any time.
+type DeploymentPolicy struct {
+// Allows deployments which set the 'radapp.io/deployment-policy-override' tag to bypass the policy. The value of the tag
+// should describe the reason of the override.
+	AllowOverride *bool
+
+// Rejects all deployments to the environment when true, for example during a maintenance freeze.
+	Freeze *bool
+
+// Message included in the error returned when a deployment is rejected, for example a link to the change control process.
+	Message *string
+
+// Windows in which deployments are allowed. When empty, deployments are allowed at any time unless the environment is frozen.
+	Windows []*DeploymentWindow
+}
+
+// DeploymentWindow - A recurring window in which deployments are allowed.
+type DeploymentWindow struct {
+// REQUIRED; Duration of the window, for example '8h' or '90m'.
+	Duration *string
+
+// REQUIRED; Cron expression with five fields (minute, hour, day of month, month, day of week) of the start of the window,
+// for example '0 9 * * 1-5'.
+	Schedule *string
+
+// IANA time zone of the schedule, for example 'Europe/Berlin'. Defaults to UTC.
+	TimeZone *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 // REQUIRED; Discriminator property for EnvironmentCompute.
@@ -438,12 +646,22 @@ type EnvironmentProperties struct {
 // REQUIRED; The compute resource used by application environment.
 	Compute EnvironmentComputeClassification
 
+// Policy restricting when resources can be deployed to the environment, such as deployment windows or a maintenance freeze.
+	DeploymentPolicy *DeploymentPolicy
+
 // The environment extension.
 	Extensions []ExtensionClassification
 
+// Policy restricting the container images which can be deployed to the environment. Containers with non-compliant images
+// are rejected when they are created or updated.
+	ImagePolicy *ImagePolicy
+
 // Cloud providers configuration for the environment.
 	Providers *Providers
 
+// Quota limiting the resources which can be deployed to the environment.
+	Quota *EnvironmentQuota
+
 // Configuration for Recipes. Defines how each type of Recipe should be configured and run.
 	RecipeConfig *RecipeConfigProperties
 
@@ -457,6 +675,28 @@ type EnvironmentProperties struct {
 	ProvisioningState *ProvisioningState
 }
 
+// EnvironmentQuota - Quota limiting the resources which can be deployed to the environment. Limits which are not set are
+// not enforced.
+type EnvironmentQuota struct {
+// Maximum number of Azure, AWS and GCP resources deployed by Radius in the environment. Deployments which execute a recipe
+// are rejected once the limit has been reached.
+	MaxCloudResources *int32
+
+// Maximum CPU a container of the environment can request or be limited to, as a Kubernetes quantity such as '2' or '500m'.
+// It applies to the containers of the PodSpec patch and of the base manifest of the Kubernetes runtime.
+	MaxContainerCPU *string
+
+// Maximum memory a container of the environment can request or be limited to, as a Kubernetes quantity such as '1Gi'. It
+// applies to the containers of the PodSpec patch and of the base manifest of the Kubernetes runtime.
+	MaxContainerMemory *string
+
+// Maximum number of containers in the environment.
+	MaxContainers *int32
+
+// Maximum number of recipe executions per day (UTC) in the environment.
+	MaxRecipeExecutionsPerDay *int32
+}
+
 // EnvironmentResource - The environment resource
 type EnvironmentResource struct {
 // REQUIRED; The geo-location where the resource lives
@@ -533,6 +773,14 @@ type EphemeralVolume struct {
 
 // The path where the volume is mounted
 	MountPath *string
+
+// The size limit of the ephemeral volume, for example '1Gi'. Required when storageClass is specified, in which case it is
+// the requested size of the volume.
+	SizeLimit *string
+
+// The storage class used to provision the ephemeral volume. When specified, the volume is backed by a PersistentVolumeClaim
+// that is created and deleted with the container instead of an emptyDir.
+	StorageClass *string
 }
 
 // GetVolume implements the VolumeClassification interface for type EphemeralVolume.
@@ -693,9 +941,49 @@ type Extension struct {
 	Kind *string
 }
 
+// ExternalRecipeProperties - Represents the properties of a recipe executed by an external recipe driver registered by the
+// operator of Radius. The template path and parameters are passed to the driver, which deploys the recipe.
+type ExternalRecipeProperties struct {
+// REQUIRED; The name of the external recipe driver that executes the recipe.
+	Driver *string
+
+// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+// The resource types of the output resources the recipe is allowed to create, for example 'Microsoft.Cache/redis' or 'apps/Deployment'.
+// A trailing '/*' allows all the resource types of a namespace. The recipe fails if it creates resources of other types.
+// All resource types are allowed when empty.
+	AllowedOutputResourceTypes []*string
+
+// Key/value parameters to pass to the recipe template at deployment.
+	Parameters map[string]any
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type ExternalRecipeProperties.
+func (e *ExternalRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		AllowedOutputResourceTypes: e.AllowedOutputResourceTypes,
+		Parameters: e.Parameters,
+		TemplateKind: e.TemplateKind,
+		TemplatePath: e.TemplatePath,
+	}
+}
+
 // GetExtension implements the ExtensionClassification interface for type Extension.
 func (e *Extension) GetExtension() *Extension { return e }
 
+// GatewayBasicAuth - HTTP basic authentication of a Gateway or a Gateway route.
+type GatewayBasicAuth struct {
+// REQUIRED; The resource id of the secret store of type 'basicAuthentication' holding the username and password.
+	SecretStore *string
+
+// The realm sent to the clients in the WWW-Authenticate header. Defaults to the Gateway name.
+	Realm *string
+}
+
 // GatewayHostname - Declare hostname information for the Gateway. Leaving the hostname empty auto-assigns one: mygateway.myapp.PUBLICHOSTNAMEORIP.nip.io.
 type GatewayHostname struct {
 // Specify a fully-qualified domain name: myapp.mydomain.com. Mutually exclusive with 'prefix' and will take priority if both
@@ -724,6 +1012,9 @@ type GatewayProperties struct {
 // Sets Gateway to not be exposed externally (no public IP address associated). Defaults to false (exposed to internet).
 	Internal *bool
 
+// Access restrictions of the Gateway, such as source IP filtering and basic authentication.
+	Security *GatewaySecurity
+
 // TLS configuration for the Gateway.
 	TLS *GatewayTLS
 
@@ -794,15 +1085,69 @@ type GatewayRoute struct {
 // The URL or id of the service to route to. Ex - 'http://myservice'.
 	Destination *string
 
+// The weighted destinations to split the traffic of the route between, e.g. for canary or blue-green deployments. Cannot
+// be used with 'destination'.
+	Destinations []*GatewayRouteDestination
+
 // Enables websocket support for the route. Defaults to false.
 	EnableWebsockets *bool
 
+// The load balancing policy used to distribute the requests between the replicas of the service.
+	LoadBalancerPolicy *GatewayRouteLoadBalancerPolicy
+
 // The path to match the incoming request path on. Ex - /myservice.
 	Path *string
 
+// The protocol used by the service of the route. Defaults to 'http'.
+	Protocol *GatewayRouteProtocol
+
 // Optionally update the prefix when sending the request to the service. Ex - replacePrefix: '/' and path: '/myservice' will
 // transform '/myservice/myroute' to '/myroute'
 	ReplacePrefix *string
+
+// Access restrictions of the route. Overrides the security of the Gateway for the route.
+	Security *GatewaySecurity
+
+// The timeouts of the route.
+	TimeoutPolicy *GatewayRouteTimeoutPolicy
+}
+
+// GatewayRouteDestination - A weighted destination of a Gateway route.
+type GatewayRouteDestination struct {
+// REQUIRED; The URL or id of the service to route to. Ex - 'http://myservice'.
+	Destination *string
+
+// The percentage of the traffic of the route sent to the destination. The traffic is split evenly if no weights are specified.
+	Weight *int32
+}
+
+// GatewayRouteLoadBalancerPolicy - Load balancing policy of a Gateway route.
+type GatewayRouteLoadBalancerPolicy struct {
+// The strategy used to balance the requests. Defaults to 'roundRobin'.
+	Strategy *GatewayRouteLoadBalancerStrategy
+}
+
+// GatewayRouteTimeoutPolicy - Timeout configuration for a Gateway route. Timeouts are durations such as '30s' or '1m30s',
+// or 'infinity' to disable the timeout.
+type GatewayRouteTimeoutPolicy struct {
+// The timeout for a request or stream without activity. Defaults to 5m.
+	Idle *string
+
+// The timeout for receiving the response from the service. Defaults to 15s.
+	Response *string
+}
+
+// GatewaySecurity - Access restrictions of a Gateway or a Gateway route.
+type GatewaySecurity struct {
+// Requires the requests to use HTTP basic authentication.
+	BasicAuth *GatewayBasicAuth
+
+// The source IP addresses or CIDR ranges allowed to reach the Gateway, e.g. '10.0.0.0/8'. All other addresses are denied.
+// Cannot be used with 'ipDenyList'.
+	IPAllowList []*string
+
+// The source IP addresses or CIDR ranges denied from reaching the Gateway, e.g. '10.0.0.0/8'. Cannot be used with 'ipAllowList'.
+	IPDenyList []*string
 }
 
 // GatewayTLS - TLS configuration definition for Gateway resource.
@@ -903,6 +1248,27 @@ type IdentitySettings struct {
 	Resource *string
 }
 
+// ImagePolicy - Policy restricting the container images which can be deployed to the environment.
+type ImagePolicy struct {
+// Registries and repository prefixes images are allowed to be pulled from, such as 'ghcr.io/myorg' or 'myregistry.azurecr.io'.
+// Images of Docker Hub are matched as 'docker.io/library/nginx'. When empty, images can be pulled from any registry.
+	AllowedRegistries []*string
+
+// Requires images to be signed with cosign. When set, the signature of each image is verified against the public keys before
+// the container is deployed.
+	SignatureVerification *ImageSignatureVerification
+}
+
+// ImageSignatureVerification - Cosign signature verification of container images.
+type ImageSignatureVerification struct {
+// REQUIRED; PEM encoded public keys (ECDSA, RSA or Ed25519) trusted to sign images. An image is accepted when it has a signature
+// made by one of the keys.
+	PublicKeys []*string
+
+// In-toto predicate types of the attestations images must have, signed by one of the public keys, such as 'https://slsa.dev/provenance/v1'.
+	RequiredAttestations []*string
+}
+
 // KeyObjectProperties - Represents key object properties
 type KeyObjectProperties struct {
 // REQUIRED; The name of the key
@@ -984,6 +1350,12 @@ type KubernetesRuntimeProperties struct {
 	Pod map[string]any
 }
 
+// ListSecretReferencesResult - The list of secrets used by a resource, without their values.
+type ListSecretReferencesResult struct {
+// REQUIRED; The secrets used by the resource.
+	Value []*SecretReferenceMetadata
+}
+
 // ManualScalingExtension - ManualScaling Extension
 type ManualScalingExtension struct {
 // REQUIRED; Discriminator property for Extension.
@@ -1104,6 +1476,9 @@ type Providers struct {
 
 // The Azure cloud provider configuration.
 	Azure *ProvidersAzure
+
+// The GCP cloud provider configuration.
+	Gcp *ProvidersGcp
 }
 
 // ProvidersAws - The AWS cloud provider definition.
@@ -1118,6 +1493,12 @@ type ProvidersAzure struct {
 	Scope *string
 }
 
+// ProvidersGcp - The GCP cloud provider definition.
+type ProvidersGcp struct {
+// REQUIRED; Target scope for GCP resources to be deployed into. For example: '/planes/gcp/gcp/projects/my-project/regions/us-central1'.
+	Scope *string
+}
+
 // Recipe - The recipe used to automatically deploy underlying infrastructure for a portable resource
 type Recipe struct {
 // REQUIRED; The name of the recipe within the environment to use
@@ -1140,6 +1521,10 @@ type RecipeConfigProperties struct {
 // resource.
 	EnvSecrets map[string]*SecretReference
 
+// Policy restricting the template sources of the recipes registered to the environment. The policy is enforced when recipes
+// are registered and when they are executed.
+	Policy *RecipePolicyProperties
+
 // Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as part of Recipe deployment.
 	Terraform *TerraformConfigProperties
 }
@@ -1173,7 +1558,51 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePlan - Represents the request body of the planRecipe action.
+type RecipePlan struct {
+// REQUIRED; The name of the recipe registered to the environment.
+	Name *string
+
+// REQUIRED; Type of the resource this recipe can be consumed by. For example: 'Applications.Datastores/mongoDatabases'.
+	ResourceType *string
+
+// The key/value parameters to pass to the recipe template. They override the parameters set by the environment.
+	Parameters map[string]any
+
+// The ID of the resource the recipe is planned for. The changes are computed against the resources previously deployed by
+// the recipe for this resource. Defaults to a resource of the resource type named after the recipe in the scope of the environment.
+	Resource *string
+}
+
+// RecipePlanResponse - The changes the deployment of a recipe would make, computed without deploying the recipe.
+type RecipePlanResponse struct {
+// REQUIRED; The changes to the resources deployed by the recipe.
+	Changes []*RecipeResourceChange
+
+// REQUIRED; The ID of the resource the recipe is planned for.
+	Resource *string
+
+// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+	TemplateKind *string
+
+// REQUIRED; The path to the template provided by the recipe.
+	TemplatePath *string
+
+// The version of the template to deploy.
+	TemplateVersion *string
+}
+
+// RecipePolicyProperties - Policy restricting the template sources of recipes. A source is a prefix of the template path,
+// such as a registry ('ghcr.io/myorg'), a Git host ('github.com/myorg'), or a module prefix ('registry.terraform.io/Azure').
+type RecipePolicyProperties struct {
+// Template sources that recipes are allowed to use. When empty, all sources that are not denied are allowed.
+	AllowedSources []*string
+
+// Template sources that recipes are not allowed to use. Denied sources take precedence over allowed sources.
+	DeniedSources []*string
+}
+
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, external.
 type RecipeProperties struct {
 // REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1181,6 +1610,11 @@ type RecipeProperties struct {
 // REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+// The resource types of the output resources the recipe is allowed to create, for example 'Microsoft.Cache/redis' or 'apps/Deployment'.
+// A trailing '/*' allows all the resource types of a namespace. The recipe fails if it creates resources of other types.
+// All resource types are allowed when empty.
+	AllowedOutputResourceTypes []*string
+
 // Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 }
@@ -1188,6 +1622,24 @@ type RecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
+// RecipeResourceChange - The change the deployment of a recipe would make to a resource.
+type RecipeResourceChange struct {
+// REQUIRED; The action that would be taken on the resource.
+	Action *RecipeResourceChangeAction
+
+// REQUIRED; The address of the resource in the Terraform configuration, or the resource ID of Bicep resources.
+	Address *string
+
+// REQUIRED; The name of the resource.
+	Name *string
+
+// REQUIRED; The type of the resource. For example: 'azurerm_redis_cache' or 'Microsoft.Cache/redis'.
+	Type *string
+
+// The names of the top-level properties changed by an update. Only the names are reported because the values can be sensitive.
+	Properties []*string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 // REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -1222,6 +1674,18 @@ type Resource struct {
 	Type *string
 }
 
+// ResourceHealth - Health of a resource.
+type ResourceHealth struct {
+// REQUIRED; The health state of the resource.
+	State *ResourceHealthState
+
+// The time at which the health state or message last changed.
+	LastTransitionTime *time.Time
+
+// A human-readable message describing the health of the resource.
+	Message *string
+}
+
 // ResourceReference - Describes a reference to an existing resource
 type ResourceReference struct {
 // REQUIRED; Resource id of an existing resource
@@ -1236,6 +1700,9 @@ type ResourceStatus struct {
 // Properties of an output resource
 	OutputResources []*OutputResource
 
+// READ-ONLY; The health of the resource reported by the health checks registered for the resource type
+	Health *ResourceHealth
+
 // READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
@@ -1279,6 +1746,32 @@ type SecretReference struct {
 	Source *string
 }
 
+// SecretReferenceMetadata - Describes a secret used by a resource, without its value.
+type SecretReferenceMetadata struct {
+// REQUIRED; The kind of use of the secret by the resource.
+	Kind *SecretReferenceKind
+
+// REQUIRED; The name of the secret, e.g. the key of the secret in its secret store.
+	Name *string
+
+// REQUIRED; The source of the secret, e.g. the ID of an Applications.Core/secretStores resource or the name of a Kubernetes
+// secret.
+	Source *string
+
+// The target of the secret in the resource, e.g. the name of the environment variable or the mount path of the volume.
+	Target *string
+}
+
+// SecretStoreDestination - The external secret manager that the secret values of a SecretStore are synced to
+type SecretStoreDestination struct {
+// REQUIRED; The resource ID of the external secret manager. This is the resource ID of an Azure Key Vault or the scope of
+// an AWS account and region, such as '/planes/aws/aws/accounts/{accountId}/regions/{region}', for AWS Secrets Manager.
+	Resource *string
+
+// The prefix of the names of the secrets created in the external secret manager. Defaults to the name of the SecretStore.
+	Prefix *string
+}
+
 // SecretStoreListSecretsResult - The list of secrets
 type SecretStoreListSecretsResult struct {
 // REQUIRED; An object to represent key-value type secrets
@@ -1296,6 +1789,9 @@ type SecretStoreProperties struct {
 // Fully qualified resource ID for the application
 	Application *string
 
+// The external secret manager that the secret values are synced to.
+	Destination *SecretStoreDestination
+
 // Fully qualified resource ID for the environment that the application is linked to
 	Environment *string
 
@@ -1364,11 +1860,39 @@ type SecretStoreResourceUpdate struct {
 	Type *string
 }
 
+// SecretStoreRotateRequest - Represents the request body of the rotate action.
+type SecretStoreRotateRequest struct {
+// The keys of the secrets to rotate. Defaults to all the keys that have a generator.
+	Keys []*string
+}
+
+// SecretStoreRotateResult - The result of the rotation of the secrets of a secret store.
+type SecretStoreRotateResult struct {
+// REQUIRED; The resource IDs of the containers restarted to use the rotated secrets.
+	RestartedContainers []*string
+
+// REQUIRED; The keys of the rotated secrets.
+	RotatedKeys []*string
+}
+
+// SecretValueGenerator - The generator of a secret value
+type SecretValueGenerator struct {
+// The set of characters of the generated value.
+	CharacterSet *SecretValueCharacterSet
+
+// The length of the generated value.
+	Length *int32
+}
+
 // SecretValueProperties - The properties of SecretValue
 type SecretValueProperties struct {
 // The encoding of value
 	Encoding *SecretValueEncoding
 
+// The generator of the secret value. When set, the value is generated when the secret store is created and when the secret
+// store is rotated.
+	Generator *SecretValueGenerator
+
 // The value of secret.
 	Value *string
 
@@ -1429,12 +1953,31 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformBackendConfig - Configuration of the backend where Terraform stores the state of Terraform Recipes.
+type TerraformBackendConfig struct {
+// REQUIRED; The type of the backend.
+	Type *TerraformBackendType
+
+// Non-sensitive settings of the backend, such as the bucket, container or region. The state key of each recipe is generated
+// by Radius.
+	Config map[string]any
+
+// Sensitive settings of the backend, such as access keys. The keys are the names of the settings and the values are references
+// to secrets stored in Applications.Core/SecretStores resources.
+	Secrets map[string]*SecretReference
+}
+
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
 // part of Recipe deployment.
 type TerraformConfigProperties struct {
-// Authentication information used to access private Terraform module sources. Supported module sources: Git.
+// Authentication information used to access private Terraform module sources. Supported module sources: Git, archives.
 	Authentication *AuthConfig
 
+// Configuration of the backend where Terraform stores the state of Terraform Recipes. By default, the state is stored in
+// a Kubernetes secret in the Radius namespace. For more information, please see:
+// https://developer.hashicorp.com/terraform/language/settings/backends/configuration.
+	Backend *TerraformBackendConfig
+
 // Configuration for Terraform Recipe Providers. Controls how Terraform interacts with cloud providers, SaaS providers, and
 // other APIs. For more information, please see:
 // https://developer.hashicorp.com/terraform/language/providers/configuration.
@@ -1449,6 +1992,11 @@ type TerraformRecipeProperties struct {
 // REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+// The resource types of the output resources the recipe is allowed to create, for example 'Microsoft.Cache/redis' or 'apps/Deployment'.
+// A trailing '/*' allows all the resource types of a namespace. The recipe fails if it creates resources of other types.
+// All resource types are allowed when empty.
+	AllowedOutputResourceTypes []*string
+
 // Key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
@@ -1460,6 +2008,7 @@ type TerraformRecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type TerraformRecipeProperties.
 func (t *TerraformRecipeProperties) GetRecipeProperties() *RecipeProperties {
 	return &RecipeProperties{
+		AllowedOutputResourceTypes: t.AllowedOutputResourceTypes,
 		Parameters: t.Parameters,
 		TemplateKind: t.TemplateKind,
 		TemplatePath: t.TemplatePath,
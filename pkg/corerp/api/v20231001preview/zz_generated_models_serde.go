@@ -11,6 +11,84 @@ import (
 	"reflect"
 )
 
+// MarshalJSON implements the json.Marshaller interface for type ApplicationDevProfileContainer.
+func (a ApplicationDevProfileContainer) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "containerName", a.ContainerName)
+	populate(objectMap, "deployment", a.Deployment)
+	populate(objectMap, "id", a.ID)
+	populate(objectMap, "image", a.Image)
+	populate(objectMap, "labelSelector", a.LabelSelector)
+	populate(objectMap, "name", a.Name)
+	populate(objectMap, "namespace", a.Namespace)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ApplicationDevProfileContainer.
+func (a *ApplicationDevProfileContainer) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "containerName":
+				err = unpopulate(val, "ContainerName", &a.ContainerName)
+			delete(rawMsg, key)
+		case "deployment":
+				err = unpopulate(val, "Deployment", &a.Deployment)
+			delete(rawMsg, key)
+		case "id":
+				err = unpopulate(val, "ID", &a.ID)
+			delete(rawMsg, key)
+		case "image":
+				err = unpopulate(val, "Image", &a.Image)
+			delete(rawMsg, key)
+		case "labelSelector":
+				err = unpopulate(val, "LabelSelector", &a.LabelSelector)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &a.Name)
+			delete(rawMsg, key)
+		case "namespace":
+				err = unpopulate(val, "Namespace", &a.Namespace)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ApplicationDevProfileResponse.
+func (a ApplicationDevProfileResponse) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "containers", a.Containers)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ApplicationDevProfileResponse.
+func (a *ApplicationDevProfileResponse) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "containers":
+				err = unpopulate(val, "Containers", &a.Containers)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ApplicationGraphConnection.
 func (a ApplicationGraphConnection) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -47,6 +125,8 @@ func (a ApplicationGraphOutputResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "id", a.ID)
 	populate(objectMap, "name", a.Name)
+	populate(objectMap, "provider", a.Provider)
+	populate(objectMap, "region", a.Region)
 	populate(objectMap, "type", a.Type)
 	return json.Marshal(objectMap)
 }
@@ -66,6 +146,12 @@ func (a *ApplicationGraphOutputResource) UnmarshalJSON(data []byte) error {
 		case "name":
 				err = unpopulate(val, "Name", &a.Name)
 			delete(rawMsg, key)
+		case "provider":
+				err = unpopulate(val, "Provider", &a.Provider)
+			delete(rawMsg, key)
+		case "region":
+				err = unpopulate(val, "Region", &a.Region)
+			delete(rawMsg, key)
 		case "type":
 				err = unpopulate(val, "Type", &a.Type)
 			delete(rawMsg, key)
@@ -315,9 +401,37 @@ func (a *ApplicationResourceUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ArchiveSecretConfig.
+func (a ArchiveSecretConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secret", a.Secret)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ArchiveSecretConfig.
+func (a *ArchiveSecretConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secret":
+				err = unpopulate(val, "Secret", &a.Secret)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AuthConfig.
 func (a AuthConfig) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "archive", a.Archive)
 	populate(objectMap, "git", a.Git)
 	return json.Marshal(objectMap)
 }
@@ -331,6 +445,9 @@ func (a *AuthConfig) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "archive":
+				err = unpopulate(val, "Archive", &a.Archive)
+			delete(rawMsg, key)
 		case "git":
 				err = unpopulate(val, "Git", &a.Git)
 			delete(rawMsg, key)
@@ -342,6 +459,45 @@ func (a *AuthConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AzureContainerInstanceCompute.
+func (a AzureContainerInstanceCompute) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "identity", a.Identity)
+	objectMap["kind"] = "aci"
+	populate(objectMap, "resourceGroup", a.ResourceGroup)
+	populate(objectMap, "resourceId", a.ResourceID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AzureContainerInstanceCompute.
+func (a *AzureContainerInstanceCompute) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "identity":
+				err = unpopulate(val, "Identity", &a.Identity)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "resourceGroup":
+				err = unpopulate(val, "ResourceGroup", &a.ResourceGroup)
+			delete(rawMsg, key)
+		case "resourceId":
+				err = unpopulate(val, "ResourceID", &a.ResourceID)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureKeyVaultVolumeProperties.
 func (a AzureKeyVaultVolumeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -474,6 +630,7 @@ func (b *BicepConfigProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type BicepRecipeProperties.
 func (b BicepRecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "allowedOutputResourceTypes", b.AllowedOutputResourceTypes)
 	populate(objectMap, "parameters", b.Parameters)
 	populate(objectMap, "plainHttp", b.PlainHTTP)
 	objectMap["templateKind"] = "bicep"
@@ -490,6 +647,9 @@ func (b *BicepRecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "allowedOutputResourceTypes":
+				err = unpopulate(val, "AllowedOutputResourceTypes", &b.AllowedOutputResourceTypes)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &b.Parameters)
 			delete(rawMsg, key)
@@ -561,7 +721,10 @@ func (c *CertificateObjectProperties) UnmarshalJSON(data []byte) error {
 func (c ConnectionProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "disableDefaultEnvVars", c.DisableDefaultEnvVars)
+	populate(objectMap, "format", c.Format)
 	populate(objectMap, "iam", c.Iam)
+	populate(objectMap, "readiness", c.Readiness)
+	populate(objectMap, "secrets", c.Secrets)
 	populate(objectMap, "source", c.Source)
 	return json.Marshal(objectMap)
 }
@@ -578,9 +741,18 @@ func (c *ConnectionProperties) UnmarshalJSON(data []byte) error {
 		case "disableDefaultEnvVars":
 				err = unpopulate(val, "DisableDefaultEnvVars", &c.DisableDefaultEnvVars)
 			delete(rawMsg, key)
+		case "format":
+				err = unpopulate(val, "Format", &c.Format)
+			delete(rawMsg, key)
 		case "iam":
 				err = unpopulate(val, "Iam", &c.Iam)
 			delete(rawMsg, key)
+		case "readiness":
+				err = unpopulate(val, "Readiness", &c.Readiness)
+			delete(rawMsg, key)
+		case "secrets":
+				err = unpopulate(val, "Secrets", &c.Secrets)
+			delete(rawMsg, key)
 		case "source":
 				err = unpopulate(val, "Source", &c.Source)
 			delete(rawMsg, key)
@@ -592,6 +764,76 @@ func (c *ConnectionProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ConnectionReadinessProperties.
+func (c ConnectionReadinessProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "timeoutSeconds", c.TimeoutSeconds)
+	populate(objectMap, "waitFor", c.WaitFor)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ConnectionReadinessProperties.
+func (c *ConnectionReadinessProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "timeoutSeconds":
+				err = unpopulate(val, "TimeoutSeconds", &c.TimeoutSeconds)
+			delete(rawMsg, key)
+		case "waitFor":
+				err = unpopulate(val, "WaitFor", &c.WaitFor)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ConnectionSecretsProperties.
+func (c ConnectionSecretsProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "keys", c.Keys)
+	populate(objectMap, "materialization", c.Materialization)
+	populate(objectMap, "refreshIntervalSeconds", c.RefreshIntervalSeconds)
+	populate(objectMap, "secretName", c.SecretName)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ConnectionSecretsProperties.
+func (c *ConnectionSecretsProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "keys":
+				err = unpopulate(val, "Keys", &c.Keys)
+			delete(rawMsg, key)
+		case "materialization":
+				err = unpopulate(val, "Materialization", &c.Materialization)
+			delete(rawMsg, key)
+		case "refreshIntervalSeconds":
+				err = unpopulate(val, "RefreshIntervalSeconds", &c.RefreshIntervalSeconds)
+			delete(rawMsg, key)
+		case "secretName":
+				err = unpopulate(val, "SecretName", &c.SecretName)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Container.
 func (c Container) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -655,6 +897,92 @@ func (c *Container) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ContainerAutoscalingMetric.
+func (c ContainerAutoscalingMetric) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", c.Kind)
+	populate(objectMap, "name", c.Name)
+	populate(objectMap, "selector", c.Selector)
+	populate(objectMap, "targetAverageValue", c.TargetAverageValue)
+	populate(objectMap, "targetValue", c.TargetValue)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerAutoscalingMetric.
+func (c *ContainerAutoscalingMetric) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &c.Kind)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &c.Name)
+			delete(rawMsg, key)
+		case "selector":
+				err = unpopulate(val, "Selector", &c.Selector)
+			delete(rawMsg, key)
+		case "targetAverageValue":
+				err = unpopulate(val, "TargetAverageValue", &c.TargetAverageValue)
+			delete(rawMsg, key)
+		case "targetValue":
+				err = unpopulate(val, "TargetValue", &c.TargetValue)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ContainerAutoscalingProperties.
+func (c ContainerAutoscalingProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "maxReplicas", c.MaxReplicas)
+	populate(objectMap, "metrics", c.Metrics)
+	populate(objectMap, "minReplicas", c.MinReplicas)
+	populate(objectMap, "targetCpuUtilization", c.TargetCPUUtilization)
+	populate(objectMap, "targetMemoryUtilization", c.TargetMemoryUtilization)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ContainerAutoscalingProperties.
+func (c *ContainerAutoscalingProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "maxReplicas":
+				err = unpopulate(val, "MaxReplicas", &c.MaxReplicas)
+			delete(rawMsg, key)
+		case "metrics":
+				err = unpopulate(val, "Metrics", &c.Metrics)
+			delete(rawMsg, key)
+		case "minReplicas":
+				err = unpopulate(val, "MinReplicas", &c.MinReplicas)
+			delete(rawMsg, key)
+		case "targetCpuUtilization":
+				err = unpopulate(val, "TargetCPUUtilization", &c.TargetCPUUtilization)
+			delete(rawMsg, key)
+		case "targetMemoryUtilization":
+				err = unpopulate(val, "TargetMemoryUtilization", &c.TargetMemoryUtilization)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ContainerPortProperties.
 func (c ContainerPortProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -698,6 +1026,7 @@ func (c *ContainerPortProperties) UnmarshalJSON(data []byte) error {
 func (c ContainerProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "application", c.Application)
+	populate(objectMap, "autoscaling", c.Autoscaling)
 	populate(objectMap, "connections", c.Connections)
 	populate(objectMap, "container", c.Container)
 	populate(objectMap, "environment", c.Environment)
@@ -724,6 +1053,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "application":
 				err = unpopulate(val, "Application", &c.Application)
 			delete(rawMsg, key)
+		case "autoscaling":
+				err = unpopulate(val, "Autoscaling", &c.Autoscaling)
+			delete(rawMsg, key)
 		case "connections":
 				err = unpopulate(val, "Connections", &c.Connections)
 			delete(rawMsg, key)
@@ -890,6 +1222,41 @@ func (c *ContainerResourceUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type CostAttributionExtension.
+func (c CostAttributionExtension) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	objectMap["kind"] = "costAttribution"
+	populate(objectMap, "labelPrefix", c.LabelPrefix)
+	populate(objectMap, "tags", c.Tags)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type CostAttributionExtension.
+func (c *CostAttributionExtension) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &c.Kind)
+			delete(rawMsg, key)
+		case "labelPrefix":
+				err = unpopulate(val, "LabelPrefix", &c.LabelPrefix)
+			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &c.Tags)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type DaprSidecarExtension.
 func (d DaprSidecarExtension) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -933,32 +1300,106 @@ func (d *DaprSidecarExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type EnvironmentCompute.
-func (e EnvironmentCompute) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type DeploymentPolicy.
+func (d DeploymentPolicy) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "identity", e.Identity)
-	objectMap["kind"] = e.Kind
-	populate(objectMap, "resourceId", e.ResourceID)
+	populate(objectMap, "allowOverride", d.AllowOverride)
+	populate(objectMap, "freeze", d.Freeze)
+	populate(objectMap, "message", d.Message)
+	populate(objectMap, "windows", d.Windows)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentCompute.
-func (e *EnvironmentCompute) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type DeploymentPolicy.
+func (d *DeploymentPolicy) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
 	}
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "identity":
-				err = unpopulate(val, "Identity", &e.Identity)
+		case "allowOverride":
+				err = unpopulate(val, "AllowOverride", &d.AllowOverride)
 			delete(rawMsg, key)
-		case "kind":
-				err = unpopulate(val, "Kind", &e.Kind)
+		case "freeze":
+				err = unpopulate(val, "Freeze", &d.Freeze)
 			delete(rawMsg, key)
-		case "resourceId":
-				err = unpopulate(val, "ResourceID", &e.ResourceID)
+		case "message":
+				err = unpopulate(val, "Message", &d.Message)
+			delete(rawMsg, key)
+		case "windows":
+				err = unpopulate(val, "Windows", &d.Windows)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type DeploymentWindow.
+func (d DeploymentWindow) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "duration", d.Duration)
+	populate(objectMap, "schedule", d.Schedule)
+	populate(objectMap, "timeZone", d.TimeZone)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type DeploymentWindow.
+func (d *DeploymentWindow) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", d, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "duration":
+				err = unpopulate(val, "Duration", &d.Duration)
+			delete(rawMsg, key)
+		case "schedule":
+				err = unpopulate(val, "Schedule", &d.Schedule)
+			delete(rawMsg, key)
+		case "timeZone":
+				err = unpopulate(val, "TimeZone", &d.TimeZone)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", d, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentCompute.
+func (e EnvironmentCompute) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "identity", e.Identity)
+	objectMap["kind"] = e.Kind
+	populate(objectMap, "resourceId", e.ResourceID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentCompute.
+func (e *EnvironmentCompute) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "identity":
+				err = unpopulate(val, "Identity", &e.Identity)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &e.Kind)
+			delete(rawMsg, key)
+		case "resourceId":
+				err = unpopulate(val, "ResourceID", &e.ResourceID)
 			delete(rawMsg, key)
 		}
 		if err != nil {
@@ -972,9 +1413,12 @@ func (e *EnvironmentCompute) UnmarshalJSON(data []byte) error {
 func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
+	populate(objectMap, "deploymentPolicy", e.DeploymentPolicy)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "imagePolicy", e.ImagePolicy)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
+	populate(objectMap, "quota", e.Quota)
 	populate(objectMap, "recipeConfig", e.RecipeConfig)
 	populate(objectMap, "recipes", e.Recipes)
 	populate(objectMap, "simulated", e.Simulated)
@@ -993,15 +1437,24 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "compute":
 			e.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "deploymentPolicy":
+				err = unpopulate(val, "DeploymentPolicy", &e.DeploymentPolicy)
+			delete(rawMsg, key)
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "imagePolicy":
+				err = unpopulate(val, "ImagePolicy", &e.ImagePolicy)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &e.ProvisioningState)
 			delete(rawMsg, key)
+		case "quota":
+				err = unpopulate(val, "Quota", &e.Quota)
+			delete(rawMsg, key)
 		case "recipeConfig":
 				err = unpopulate(val, "RecipeConfig", &e.RecipeConfig)
 			delete(rawMsg, key)
@@ -1030,6 +1483,49 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type EnvironmentQuota.
+func (e EnvironmentQuota) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "maxCloudResources", e.MaxCloudResources)
+	populate(objectMap, "maxContainerCpu", e.MaxContainerCPU)
+	populate(objectMap, "maxContainerMemory", e.MaxContainerMemory)
+	populate(objectMap, "maxContainers", e.MaxContainers)
+	populate(objectMap, "maxRecipeExecutionsPerDay", e.MaxRecipeExecutionsPerDay)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentQuota.
+func (e *EnvironmentQuota) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "maxCloudResources":
+				err = unpopulate(val, "MaxCloudResources", &e.MaxCloudResources)
+			delete(rawMsg, key)
+		case "maxContainerCpu":
+				err = unpopulate(val, "MaxContainerCPU", &e.MaxContainerCPU)
+			delete(rawMsg, key)
+		case "maxContainerMemory":
+				err = unpopulate(val, "MaxContainerMemory", &e.MaxContainerMemory)
+			delete(rawMsg, key)
+		case "maxContainers":
+				err = unpopulate(val, "MaxContainers", &e.MaxContainers)
+			delete(rawMsg, key)
+		case "maxRecipeExecutionsPerDay":
+				err = unpopulate(val, "MaxRecipeExecutionsPerDay", &e.MaxRecipeExecutionsPerDay)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type EnvironmentResource.
 func (e EnvironmentResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1219,6 +1715,8 @@ func (e EphemeralVolume) MarshalJSON() ([]byte, error) {
 	objectMap["kind"] = "ephemeral"
 	populate(objectMap, "managedStore", e.ManagedStore)
 	populate(objectMap, "mountPath", e.MountPath)
+	populate(objectMap, "sizeLimit", e.SizeLimit)
+	populate(objectMap, "storageClass", e.StorageClass)
 	return json.Marshal(objectMap)
 }
 
@@ -1240,6 +1738,12 @@ func (e *EphemeralVolume) UnmarshalJSON(data []byte) error {
 		case "mountPath":
 				err = unpopulate(val, "MountPath", &e.MountPath)
 			delete(rawMsg, key)
+		case "sizeLimit":
+				err = unpopulate(val, "SizeLimit", &e.SizeLimit)
+			delete(rawMsg, key)
+		case "storageClass":
+				err = unpopulate(val, "StorageClass", &e.StorageClass)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", e, err)
@@ -1614,6 +2118,80 @@ func (e *Extension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ExternalRecipeProperties.
+func (e ExternalRecipeProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "allowedOutputResourceTypes", e.AllowedOutputResourceTypes)
+	populate(objectMap, "driver", e.Driver)
+	populate(objectMap, "parameters", e.Parameters)
+	objectMap["templateKind"] = "external"
+	populate(objectMap, "templatePath", e.TemplatePath)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ExternalRecipeProperties.
+func (e *ExternalRecipeProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", e, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "allowedOutputResourceTypes":
+				err = unpopulate(val, "AllowedOutputResourceTypes", &e.AllowedOutputResourceTypes)
+			delete(rawMsg, key)
+		case "driver":
+				err = unpopulate(val, "Driver", &e.Driver)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &e.Parameters)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &e.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &e.TemplatePath)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", e, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayBasicAuth.
+func (g GatewayBasicAuth) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "realm", g.Realm)
+	populate(objectMap, "secretStore", g.SecretStore)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayBasicAuth.
+func (g *GatewayBasicAuth) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "realm":
+				err = unpopulate(val, "Realm", &g.Realm)
+			delete(rawMsg, key)
+		case "secretStore":
+				err = unpopulate(val, "SecretStore", &g.SecretStore)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GatewayHostname.
 func (g GatewayHostname) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1654,6 +2232,7 @@ func (g GatewayProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "internal", g.Internal)
 	populate(objectMap, "provisioningState", g.ProvisioningState)
 	populate(objectMap, "routes", g.Routes)
+	populate(objectMap, "security", g.Security)
 	populate(objectMap, "status", g.Status)
 	populate(objectMap, "tls", g.TLS)
 	populate(objectMap, "url", g.URL)
@@ -1687,6 +2266,9 @@ func (g *GatewayProperties) UnmarshalJSON(data []byte) error {
 		case "routes":
 				err = unpopulate(val, "Routes", &g.Routes)
 			delete(rawMsg, key)
+		case "security":
+				err = unpopulate(val, "Security", &g.Security)
+			delete(rawMsg, key)
 		case "status":
 				err = unpopulate(val, "Status", &g.Status)
 			delete(rawMsg, key)
@@ -1833,9 +2415,14 @@ func (g *GatewayResourceUpdate) UnmarshalJSON(data []byte) error {
 func (g GatewayRoute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "destinations", g.Destinations)
 	populate(objectMap, "enableWebsockets", g.EnableWebsockets)
+	populate(objectMap, "loadBalancerPolicy", g.LoadBalancerPolicy)
 	populate(objectMap, "path", g.Path)
+	populate(objectMap, "protocol", g.Protocol)
 	populate(objectMap, "replacePrefix", g.ReplacePrefix)
+	populate(objectMap, "security", g.Security)
+	populate(objectMap, "timeoutPolicy", g.TimeoutPolicy)
 	return json.Marshal(objectMap)
 }
 
@@ -1851,15 +2438,154 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 		case "destination":
 				err = unpopulate(val, "Destination", &g.Destination)
 			delete(rawMsg, key)
+		case "destinations":
+				err = unpopulate(val, "Destinations", &g.Destinations)
+			delete(rawMsg, key)
 		case "enableWebsockets":
 				err = unpopulate(val, "EnableWebsockets", &g.EnableWebsockets)
 			delete(rawMsg, key)
+		case "loadBalancerPolicy":
+				err = unpopulate(val, "LoadBalancerPolicy", &g.LoadBalancerPolicy)
+			delete(rawMsg, key)
 		case "path":
 				err = unpopulate(val, "Path", &g.Path)
 			delete(rawMsg, key)
+		case "protocol":
+				err = unpopulate(val, "Protocol", &g.Protocol)
+			delete(rawMsg, key)
 		case "replacePrefix":
 				err = unpopulate(val, "ReplacePrefix", &g.ReplacePrefix)
 			delete(rawMsg, key)
+		case "security":
+				err = unpopulate(val, "Security", &g.Security)
+			delete(rawMsg, key)
+		case "timeoutPolicy":
+				err = unpopulate(val, "TimeoutPolicy", &g.TimeoutPolicy)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteDestination.
+func (g GatewayRouteDestination) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "weight", g.Weight)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteDestination.
+func (g *GatewayRouteDestination) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "destination":
+				err = unpopulate(val, "Destination", &g.Destination)
+			delete(rawMsg, key)
+		case "weight":
+				err = unpopulate(val, "Weight", &g.Weight)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteLoadBalancerPolicy.
+func (g GatewayRouteLoadBalancerPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "strategy", g.Strategy)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteLoadBalancerPolicy.
+func (g *GatewayRouteLoadBalancerPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "strategy":
+				err = unpopulate(val, "Strategy", &g.Strategy)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteTimeoutPolicy.
+func (g GatewayRouteTimeoutPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "idle", g.Idle)
+	populate(objectMap, "response", g.Response)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteTimeoutPolicy.
+func (g *GatewayRouteTimeoutPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "idle":
+				err = unpopulate(val, "Idle", &g.Idle)
+			delete(rawMsg, key)
+		case "response":
+				err = unpopulate(val, "Response", &g.Response)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewaySecurity.
+func (g GatewaySecurity) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "basicAuth", g.BasicAuth)
+	populate(objectMap, "ipAllowList", g.IPAllowList)
+	populate(objectMap, "ipDenyList", g.IPDenyList)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewaySecurity.
+func (g *GatewaySecurity) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "basicAuth":
+				err = unpopulate(val, "BasicAuth", &g.BasicAuth)
+			delete(rawMsg, key)
+		case "ipAllowList":
+				err = unpopulate(val, "IPAllowList", &g.IPAllowList)
+			delete(rawMsg, key)
+		case "ipDenyList":
+				err = unpopulate(val, "IPDenyList", &g.IPDenyList)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", g, err)
@@ -2094,56 +2820,118 @@ func (i *IdentitySettings) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type KeyObjectProperties.
-func (k KeyObjectProperties) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type ImagePolicy.
+func (i ImagePolicy) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "alias", k.Alias)
-	populate(objectMap, "name", k.Name)
-	populate(objectMap, "version", k.Version)
+	populate(objectMap, "allowedRegistries", i.AllowedRegistries)
+	populate(objectMap, "signatureVerification", i.SignatureVerification)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type KeyObjectProperties.
-func (k *KeyObjectProperties) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type ImagePolicy.
+func (i *ImagePolicy) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		return fmt.Errorf("unmarshalling type %T: %v", i, err)
 	}
 	for key, val := range rawMsg {
 		var err error
 		switch key {
-		case "alias":
-				err = unpopulate(val, "Alias", &k.Alias)
-			delete(rawMsg, key)
-		case "name":
-				err = unpopulate(val, "Name", &k.Name)
+		case "allowedRegistries":
+				err = unpopulate(val, "AllowedRegistries", &i.AllowedRegistries)
 			delete(rawMsg, key)
-		case "version":
-				err = unpopulate(val, "Version", &k.Version)
+		case "signatureVerification":
+				err = unpopulate(val, "SignatureVerification", &i.SignatureVerification)
 			delete(rawMsg, key)
 		}
 		if err != nil {
-			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+			return fmt.Errorf("unmarshalling type %T: %v", i, err)
 		}
 	}
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaller interface for type KubernetesCompute.
-func (k KubernetesCompute) MarshalJSON() ([]byte, error) {
+// MarshalJSON implements the json.Marshaller interface for type ImageSignatureVerification.
+func (i ImageSignatureVerification) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
-	populate(objectMap, "identity", k.Identity)
-	objectMap["kind"] = "kubernetes"
-	populate(objectMap, "namespace", k.Namespace)
-	populate(objectMap, "resourceId", k.ResourceID)
+	populate(objectMap, "publicKeys", i.PublicKeys)
+	populate(objectMap, "requiredAttestations", i.RequiredAttestations)
 	return json.Marshal(objectMap)
 }
 
-// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesCompute.
-func (k *KubernetesCompute) UnmarshalJSON(data []byte) error {
+// UnmarshalJSON implements the json.Unmarshaller interface for type ImageSignatureVerification.
+func (i *ImageSignatureVerification) UnmarshalJSON(data []byte) error {
 	var rawMsg map[string]json.RawMessage
 	if err := json.Unmarshal(data, &rawMsg); err != nil {
-		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		return fmt.Errorf("unmarshalling type %T: %v", i, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "publicKeys":
+				err = unpopulate(val, "PublicKeys", &i.PublicKeys)
+			delete(rawMsg, key)
+		case "requiredAttestations":
+				err = unpopulate(val, "RequiredAttestations", &i.RequiredAttestations)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KeyObjectProperties.
+func (k KeyObjectProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "alias", k.Alias)
+	populate(objectMap, "name", k.Name)
+	populate(objectMap, "version", k.Version)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KeyObjectProperties.
+func (k *KeyObjectProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "alias":
+				err = unpopulate(val, "Alias", &k.Alias)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &k.Name)
+			delete(rawMsg, key)
+		case "version":
+				err = unpopulate(val, "Version", &k.Version)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesCompute.
+func (k KubernetesCompute) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "identity", k.Identity)
+	objectMap["kind"] = "kubernetes"
+	populate(objectMap, "namespace", k.Namespace)
+	populate(objectMap, "resourceId", k.ResourceID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesCompute.
+func (k *KubernetesCompute) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
 	}
 	for key, val := range rawMsg {
 		var err error
@@ -2265,6 +3053,33 @@ func (k *KubernetesRuntimeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ListSecretReferencesResult.
+func (l ListSecretReferencesResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "value", l.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ListSecretReferencesResult.
+func (l *ListSecretReferencesResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", l, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "value":
+				err = unpopulate(val, "Value", &l.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", l, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ManualScalingExtension.
 func (m ManualScalingExtension) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2530,6 +3345,7 @@ func (p Providers) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "aws", p.Aws)
 	populate(objectMap, "azure", p.Azure)
+	populate(objectMap, "gcp", p.Gcp)
 	return json.Marshal(objectMap)
 }
 
@@ -2548,6 +3364,9 @@ func (p *Providers) UnmarshalJSON(data []byte) error {
 		case "azure":
 				err = unpopulate(val, "Azure", &p.Azure)
 			delete(rawMsg, key)
+		case "gcp":
+				err = unpopulate(val, "Gcp", &p.Gcp)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", p, err)
@@ -2610,6 +3429,33 @@ func (p *ProvidersAzure) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ProvidersGcp.
+func (p ProvidersGcp) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "scope", p.Scope)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ProvidersGcp.
+func (p *ProvidersGcp) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", p, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "scope":
+				err = unpopulate(val, "Scope", &p.Scope)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", p, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Recipe.
 func (r Recipe) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2647,6 +3493,7 @@ func (r RecipeConfigProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "bicep", r.Bicep)
 	populate(objectMap, "env", r.Env)
 	populate(objectMap, "envSecrets", r.EnvSecrets)
+	populate(objectMap, "policy", r.Policy)
 	populate(objectMap, "terraform", r.Terraform)
 	return json.Marshal(objectMap)
 }
@@ -2669,6 +3516,9 @@ func (r *RecipeConfigProperties) UnmarshalJSON(data []byte) error {
 		case "envSecrets":
 				err = unpopulate(val, "EnvSecrets", &r.EnvSecrets)
 			delete(rawMsg, key)
+		case "policy":
+				err = unpopulate(val, "Policy", &r.Policy)
+			delete(rawMsg, key)
 		case "terraform":
 				err = unpopulate(val, "Terraform", &r.Terraform)
 			delete(rawMsg, key)
@@ -2754,9 +3604,123 @@ func (r *RecipeGetMetadataResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipePlan.
+func (r RecipePlan) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "parameters", r.Parameters)
+	populate(objectMap, "resource", r.Resource)
+	populate(objectMap, "resourceType", r.ResourceType)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlan.
+func (r *RecipePlan) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "name":
+				err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "parameters":
+				err = unpopulate(val, "Parameters", &r.Parameters)
+			delete(rawMsg, key)
+		case "resource":
+				err = unpopulate(val, "Resource", &r.Resource)
+			delete(rawMsg, key)
+		case "resourceType":
+				err = unpopulate(val, "ResourceType", &r.ResourceType)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipePlanResponse.
+func (r RecipePlanResponse) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "changes", r.Changes)
+	populate(objectMap, "resource", r.Resource)
+	populate(objectMap, "templateKind", r.TemplateKind)
+	populate(objectMap, "templatePath", r.TemplatePath)
+	populate(objectMap, "templateVersion", r.TemplateVersion)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePlanResponse.
+func (r *RecipePlanResponse) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "changes":
+				err = unpopulate(val, "Changes", &r.Changes)
+			delete(rawMsg, key)
+		case "resource":
+				err = unpopulate(val, "Resource", &r.Resource)
+			delete(rawMsg, key)
+		case "templateKind":
+				err = unpopulate(val, "TemplateKind", &r.TemplateKind)
+			delete(rawMsg, key)
+		case "templatePath":
+				err = unpopulate(val, "TemplatePath", &r.TemplatePath)
+			delete(rawMsg, key)
+		case "templateVersion":
+				err = unpopulate(val, "TemplateVersion", &r.TemplateVersion)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type RecipePolicyProperties.
+func (r RecipePolicyProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "allowedSources", r.AllowedSources)
+	populate(objectMap, "deniedSources", r.DeniedSources)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipePolicyProperties.
+func (r *RecipePolicyProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "allowedSources":
+				err = unpopulate(val, "AllowedSources", &r.AllowedSources)
+			delete(rawMsg, key)
+		case "deniedSources":
+				err = unpopulate(val, "DeniedSources", &r.DeniedSources)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeProperties.
 func (r RecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "allowedOutputResourceTypes", r.AllowedOutputResourceTypes)
 	populate(objectMap, "parameters", r.Parameters)
 	objectMap["templateKind"] = r.TemplateKind
 	populate(objectMap, "templatePath", r.TemplatePath)
@@ -2772,6 +3736,9 @@ func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "allowedOutputResourceTypes":
+				err = unpopulate(val, "AllowedOutputResourceTypes", &r.AllowedOutputResourceTypes)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &r.Parameters)
 			delete(rawMsg, key)
@@ -2789,6 +3756,49 @@ func (r *RecipeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipeResourceChange.
+func (r RecipeResourceChange) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "action", r.Action)
+	populate(objectMap, "address", r.Address)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "properties", r.Properties)
+	populate(objectMap, "type", r.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeResourceChange.
+func (r *RecipeResourceChange) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "action":
+				err = unpopulate(val, "Action", &r.Action)
+			delete(rawMsg, key)
+		case "address":
+				err = unpopulate(val, "Address", &r.Address)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "properties":
+				err = unpopulate(val, "Properties", &r.Properties)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &r.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type RecipeStatus.
 func (r RecipeStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2890,6 +3900,41 @@ func (r *Resource) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ResourceHealth.
+func (r ResourceHealth) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateDateTimeRFC3339(objectMap, "lastTransitionTime", r.LastTransitionTime)
+	populate(objectMap, "message", r.Message)
+	populate(objectMap, "state", r.State)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceHealth.
+func (r *ResourceHealth) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "lastTransitionTime":
+				err = unpopulateDateTimeRFC3339(val, "LastTransitionTime", &r.LastTransitionTime)
+			delete(rawMsg, key)
+		case "message":
+				err = unpopulate(val, "Message", &r.Message)
+			delete(rawMsg, key)
+		case "state":
+				err = unpopulate(val, "State", &r.State)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ResourceReference.
 func (r ResourceReference) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2921,6 +3966,7 @@ func (r *ResourceReference) UnmarshalJSON(data []byte) error {
 func (r ResourceStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", r.Compute)
+	populate(objectMap, "health", r.Health)
 	populate(objectMap, "outputResources", r.OutputResources)
 	populate(objectMap, "recipe", r.Recipe)
 	return json.Marshal(objectMap)
@@ -2938,6 +3984,9 @@ func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
 		case "compute":
 			r.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "health":
+				err = unpopulate(val, "Health", &r.Health)
+			delete(rawMsg, key)
 		case "outputResources":
 				err = unpopulate(val, "OutputResources", &r.OutputResources)
 			delete(rawMsg, key)
@@ -3076,6 +4125,76 @@ func (s *SecretReference) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type SecretReferenceMetadata.
+func (s SecretReferenceMetadata) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", s.Kind)
+	populate(objectMap, "name", s.Name)
+	populate(objectMap, "source", s.Source)
+	populate(objectMap, "target", s.Target)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretReferenceMetadata.
+func (s *SecretReferenceMetadata) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &s.Kind)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &s.Name)
+			delete(rawMsg, key)
+		case "source":
+				err = unpopulate(val, "Source", &s.Source)
+			delete(rawMsg, key)
+		case "target":
+				err = unpopulate(val, "Target", &s.Target)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretStoreDestination.
+func (s SecretStoreDestination) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "prefix", s.Prefix)
+	populate(objectMap, "resource", s.Resource)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretStoreDestination.
+func (s *SecretStoreDestination) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "prefix":
+				err = unpopulate(val, "Prefix", &s.Prefix)
+			delete(rawMsg, key)
+		case "resource":
+				err = unpopulate(val, "Resource", &s.Resource)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type SecretStoreListSecretsResult.
 func (s SecretStoreListSecretsResult) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3112,6 +4231,7 @@ func (s SecretStoreProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "application", s.Application)
 	populate(objectMap, "data", s.Data)
+	populate(objectMap, "destination", s.Destination)
 	populate(objectMap, "environment", s.Environment)
 	populate(objectMap, "provisioningState", s.ProvisioningState)
 	populate(objectMap, "resource", s.Resource)
@@ -3135,6 +4255,9 @@ func (s *SecretStoreProperties) UnmarshalJSON(data []byte) error {
 		case "data":
 				err = unpopulate(val, "Data", &s.Data)
 			delete(rawMsg, key)
+		case "destination":
+				err = unpopulate(val, "Destination", &s.Destination)
+			delete(rawMsg, key)
 		case "environment":
 				err = unpopulate(val, "Environment", &s.Environment)
 			delete(rawMsg, key)
@@ -3283,10 +4406,100 @@ func (s *SecretStoreResourceUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type SecretStoreRotateRequest.
+func (s SecretStoreRotateRequest) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "keys", s.Keys)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretStoreRotateRequest.
+func (s *SecretStoreRotateRequest) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "keys":
+				err = unpopulate(val, "Keys", &s.Keys)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretStoreRotateResult.
+func (s SecretStoreRotateResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "restartedContainers", s.RestartedContainers)
+	populate(objectMap, "rotatedKeys", s.RotatedKeys)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretStoreRotateResult.
+func (s *SecretStoreRotateResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "restartedContainers":
+				err = unpopulate(val, "RestartedContainers", &s.RestartedContainers)
+			delete(rawMsg, key)
+		case "rotatedKeys":
+				err = unpopulate(val, "RotatedKeys", &s.RotatedKeys)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type SecretValueGenerator.
+func (s SecretValueGenerator) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "characterSet", s.CharacterSet)
+	populate(objectMap, "length", s.Length)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type SecretValueGenerator.
+func (s *SecretValueGenerator) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", s, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "characterSet":
+				err = unpopulate(val, "CharacterSet", &s.CharacterSet)
+			delete(rawMsg, key)
+		case "length":
+				err = unpopulate(val, "Length", &s.Length)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", s, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type SecretValueProperties.
 func (s SecretValueProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "encoding", s.Encoding)
+	populate(objectMap, "generator", s.Generator)
 	populate(objectMap, "value", s.Value)
 	populate(objectMap, "valueFrom", s.ValueFrom)
 	return json.Marshal(objectMap)
@@ -3304,6 +4517,9 @@ func (s *SecretValueProperties) UnmarshalJSON(data []byte) error {
 		case "encoding":
 				err = unpopulate(val, "Encoding", &s.Encoding)
 			delete(rawMsg, key)
+		case "generator":
+				err = unpopulate(val, "Generator", &s.Generator)
+			delete(rawMsg, key)
 		case "value":
 				err = unpopulate(val, "Value", &s.Value)
 			delete(rawMsg, key)
@@ -3412,10 +4628,46 @@ func (t *TCPHealthProbeProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type TerraformBackendConfig.
+func (t TerraformBackendConfig) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "config", t.Config)
+	populate(objectMap, "secrets", t.Secrets)
+	populate(objectMap, "type", t.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type TerraformBackendConfig.
+func (t *TerraformBackendConfig) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", t, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "config":
+				err = unpopulate(val, "Config", &t.Config)
+			delete(rawMsg, key)
+		case "secrets":
+				err = unpopulate(val, "Secrets", &t.Secrets)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &t.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", t, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type TerraformConfigProperties.
 func (t TerraformConfigProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "authentication", t.Authentication)
+	populate(objectMap, "backend", t.Backend)
 	populate(objectMap, "providers", t.Providers)
 	return json.Marshal(objectMap)
 }
@@ -3432,6 +4684,9 @@ func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
 		case "authentication":
 				err = unpopulate(val, "Authentication", &t.Authentication)
 			delete(rawMsg, key)
+		case "backend":
+				err = unpopulate(val, "Backend", &t.Backend)
+			delete(rawMsg, key)
 		case "providers":
 				err = unpopulate(val, "Providers", &t.Providers)
 			delete(rawMsg, key)
@@ -3446,6 +4701,7 @@ func (t *TerraformConfigProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type TerraformRecipeProperties.
 func (t TerraformRecipeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "allowedOutputResourceTypes", t.AllowedOutputResourceTypes)
 	populate(objectMap, "parameters", t.Parameters)
 	objectMap["templateKind"] = "terraform"
 	populate(objectMap, "templatePath", t.TemplatePath)
@@ -3462,6 +4718,9 @@ func (t *TerraformRecipeProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "allowedOutputResourceTypes":
+				err = unpopulate(val, "AllowedOutputResourceTypes", &t.AllowedOutputResourceTypes)
+			delete(rawMsg, key)
 		case "parameters":
 				err = unpopulate(val, "Parameters", &t.Parameters)
 			delete(rawMsg, key)
@@ -14,6 +14,11 @@ type ApplicationsClientDeleteOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ApplicationsClientGetDevProfileOptions contains the optional parameters for the ApplicationsClient.GetDevProfile method.
+type ApplicationsClientGetDevProfileOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ApplicationsClientGetGraphOptions contains the optional parameters for the ApplicationsClient.GetGraph method.
 type ApplicationsClientGetGraphOptions struct {
 	// placeholder for future optional parameters
@@ -63,6 +68,12 @@ type ContainersClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ContainersClientListSecretReferencesOptions contains the optional parameters for the ContainersClient.ListSecretReferences
+// method.
+type ContainersClientListSecretReferencesOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientCreateOrUpdateOptions contains the optional parameters for the EnvironmentsClient.CreateOrUpdate method.
 type EnvironmentsClientCreateOrUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -88,6 +99,11 @@ type EnvironmentsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientPlanRecipeOptions contains the optional parameters for the EnvironmentsClient.PlanRecipe method.
+type EnvironmentsClientPlanRecipeOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientUpdateOptions contains the optional parameters for the EnvironmentsClient.Update method.
 type EnvironmentsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -189,11 +205,22 @@ type SecretStoresClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// SecretStoresClientListSecretReferencesOptions contains the optional parameters for the SecretStoresClient.ListSecretReferences
+// method.
+type SecretStoresClientListSecretReferencesOptions struct {
+	// placeholder for future optional parameters
+}
+
 // SecretStoresClientListSecretsOptions contains the optional parameters for the SecretStoresClient.ListSecrets method.
 type SecretStoresClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// SecretStoresClientRotateOptions contains the optional parameters for the SecretStoresClient.Rotate method.
+type SecretStoresClientRotateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // VolumesClientBeginCreateOrUpdateOptions contains the optional parameters for the VolumesClient.BeginCreateOrUpdate method.
 type VolumesClientBeginCreateOrUpdateOptions struct {
 // Resumes the long-running operation from the provided token.
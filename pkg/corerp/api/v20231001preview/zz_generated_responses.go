@@ -15,6 +15,13 @@ type ApplicationsClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// ApplicationsClientGetDevProfileResponse contains the response from method ApplicationsClient.GetDevProfile.
+type ApplicationsClientGetDevProfileResponse struct {
+// Describes the containers of an application for inner-loop development tools such as Tilt or Skaffold, which sync code
+// changes into the running containers.
+	ApplicationDevProfileResponse
+}
+
 // ApplicationsClientGetGraphResponse contains the response from method ApplicationsClient.GetGraph.
 type ApplicationsClientGetGraphResponse struct {
 // Describes the application architecture and its dependencies.
@@ -62,6 +69,12 @@ type ContainersClientListByScopeResponse struct {
 	ContainerResourceListResult
 }
 
+// ContainersClientListSecretReferencesResponse contains the response from method ContainersClient.ListSecretReferences.
+type ContainersClientListSecretReferencesResponse struct {
+// The list of secrets used by a resource, without their values.
+	ListSecretReferencesResult
+}
+
 // ContainersClientUpdateResponse contains the response from method ContainersClient.BeginUpdate.
 type ContainersClientUpdateResponse struct {
 // Concrete tracked resource types can be created by aliasing this type using a specific property type.
@@ -97,6 +110,12 @@ type EnvironmentsClientListByScopeResponse struct {
 	EnvironmentResourceListResult
 }
 
+// EnvironmentsClientPlanRecipeResponse contains the response from method EnvironmentsClient.PlanRecipe.
+type EnvironmentsClientPlanRecipeResponse struct {
+// The changes the deployment of a recipe would make, computed without deploying the recipe.
+	RecipePlanResponse
+}
+
 // EnvironmentsClientUpdateResponse contains the response from method EnvironmentsClient.Update.
 type EnvironmentsClientUpdateResponse struct {
 // The environment resource
@@ -196,12 +215,24 @@ type SecretStoresClientListByScopeResponse struct {
 	SecretStoreResourceListResult
 }
 
+// SecretStoresClientListSecretReferencesResponse contains the response from method SecretStoresClient.ListSecretReferences.
+type SecretStoresClientListSecretReferencesResponse struct {
+// The list of secrets used by a resource, without their values.
+	ListSecretReferencesResult
+}
+
 // SecretStoresClientListSecretsResponse contains the response from method SecretStoresClient.ListSecrets.
 type SecretStoresClientListSecretsResponse struct {
 // The list of secrets
 	SecretStoreListSecretsResult
 }
 
+// SecretStoresClientRotateResponse contains the response from method SecretStoresClient.Rotate.
+type SecretStoresClientRotateResponse struct {
+// The result of the rotation of the secrets of a secret store.
+	SecretStoreRotateResult
+}
+
 // SecretStoresClientUpdateResponse contains the response from method SecretStoresClient.BeginUpdate.
 type SecretStoresClientUpdateResponse struct {
 // Concrete tracked resource types can be created by aliasing this type using a specific property type.
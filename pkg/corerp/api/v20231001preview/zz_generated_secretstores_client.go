@@ -284,6 +284,65 @@ func (client *SecretStoresClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// ListSecretReferences - Lists the secrets provided by a secret store, without their values.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - secretStoreName - SecretStore name
+//   - body - The content of the action request
+//   - options - SecretStoresClientListSecretReferencesOptions contains the optional parameters for the SecretStoresClient.ListSecretReferences
+//     method.
+func (client *SecretStoresClient) ListSecretReferences(ctx context.Context, secretStoreName string, body map[string]any, options *SecretStoresClientListSecretReferencesOptions) (SecretStoresClientListSecretReferencesResponse, error) {
+	var err error
+	ctx, endSpan := runtime.StartSpan(ctx, "SecretStoresClient.ListSecretReferences", client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.listSecretReferencesCreateRequest(ctx, secretStoreName, body, options)
+	if err != nil {
+		return SecretStoresClientListSecretReferencesResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return SecretStoresClientListSecretReferencesResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return SecretStoresClientListSecretReferencesResponse{}, err
+	}
+	resp, err := client.listSecretReferencesHandleResponse(httpResp)
+	return resp, err
+}
+
+// listSecretReferencesCreateRequest creates the ListSecretReferences request.
+func (client *SecretStoresClient) listSecretReferencesCreateRequest(ctx context.Context, secretStoreName string, body map[string]any, _ *SecretStoresClientListSecretReferencesOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/secretStores/{secretStoreName}/listSecretReferences"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if secretStoreName == "" {
+		return nil, errors.New("parameter secretStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{secretStoreName}", url.PathEscape(secretStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+;	return req, nil
+}
+
+// listSecretReferencesHandleResponse handles the ListSecretReferences response.
+func (client *SecretStoresClient) listSecretReferencesHandleResponse(resp *http.Response) (SecretStoresClientListSecretReferencesResponse, error) {
+	result := SecretStoresClientListSecretReferencesResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ListSecretReferencesResult); err != nil {
+		return SecretStoresClientListSecretReferencesResponse{}, err
+	}
+	return result, nil
+}
+
 // ListSecrets - List the secrets of a secret stores.
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -343,6 +402,65 @@ func (client *SecretStoresClient) listSecretsHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Rotate - Generates new values for the secrets of a secret store that have a generator, and restarts the containers that
+// reference the secrets.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - secretStoreName - SecretStore name
+//   - body - The content of the action request
+//   - options - SecretStoresClientRotateOptions contains the optional parameters for the SecretStoresClient.Rotate method.
+func (client *SecretStoresClient) Rotate(ctx context.Context, secretStoreName string, body SecretStoreRotateRequest, options *SecretStoresClientRotateOptions) (SecretStoresClientRotateResponse, error) {
+	var err error
+	ctx, endSpan := runtime.StartSpan(ctx, "SecretStoresClient.Rotate", client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.rotateCreateRequest(ctx, secretStoreName, body, options)
+	if err != nil {
+		return SecretStoresClientRotateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return SecretStoresClientRotateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return SecretStoresClientRotateResponse{}, err
+	}
+	resp, err := client.rotateHandleResponse(httpResp)
+	return resp, err
+}
+
+// rotateCreateRequest creates the Rotate request.
+func (client *SecretStoresClient) rotateCreateRequest(ctx context.Context, secretStoreName string, body SecretStoreRotateRequest, _ *SecretStoresClientRotateOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/secretStores/{secretStoreName}/rotate"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if secretStoreName == "" {
+		return nil, errors.New("parameter secretStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{secretStoreName}", url.PathEscape(secretStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+;	return req, nil
+}
+
+// rotateHandleResponse handles the Rotate response.
+func (client *SecretStoresClient) rotateHandleResponse(resp *http.Response) (SecretStoresClientRotateResponse, error) {
+	result := SecretStoresClientRotateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.SecretStoreRotateResult); err != nil {
+		return SecretStoresClientRotateResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a SecretStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -34,6 +34,24 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
+const (
+	// StageWaitingForConnections is the progress stage reported while waiting for the connected resources of a container
+	// to be ready.
+	StageWaitingForConnections = "WaitingForConnections"
+
+	// StageRendering is the progress stage reported while the resource is rendered into output resources.
+	StageRendering = "Rendering"
+
+	// StageDeploying is the progress stage reported while the output resources are deployed.
+	StageDeploying = "Deploying"
+
+	// StageCleaningUp is the progress stage reported while output resources which are no longer needed are deleted.
+	StageCleaningUp = "CleaningUp"
+
+	// StageSaving is the progress stage reported while the resource is saved.
+	StageSaving = "Saving"
+)
+
 var _ ctrl.Controller = (*CreateOrUpdateResource)(nil)
 
 // CreateOrUpdateResource is the async operation controller to create or update Applications.Core/Containers resource.
@@ -93,11 +111,21 @@ func (c *CreateOrUpdateResource) Run(ctx context.Context, request *ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
+	// Containers are deployed only once the connected resources which the connections wait for are ready.
+	if container, ok := dataModel.(*datamodel.ContainerResource); ok {
+		err = c.waitForConnections(ctx, container)
+		if err != nil {
+			return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeDependencyNotReady, Message: err.Error(), Target: request.ResourceID}), nil
+		}
+	}
+
+	ctrl.ReportProgress(ctx, StageRendering, 10, "Rendering the output resources")
 	rendererOutput, err := c.DeploymentProcessor().Render(ctx, id, dataModel)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	ctrl.ReportProgress(ctx, StageDeploying, 30, fmt.Sprintf("Deploying %d output resources", len(rendererOutput.Resources)))
 	deploymentOutput, err := c.DeploymentProcessor().Deploy(ctx, id, rendererOutput)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -116,12 +144,14 @@ func (c *CreateOrUpdateResource) Run(ctx context.Context, request *ctrl.Request)
 	}
 	if !isNewResource {
 		diff := rpv1.GetGCOutputResources(deploymentDataModel.OutputResources(), oldOutputResources)
+		ctrl.ReportProgress(ctx, StageCleaningUp, 80, fmt.Sprintf("Deleting %d output resources which are no longer needed", len(diff)))
 		err = c.DeploymentProcessor().Delete(ctx, id, diff)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 	}
 
+	ctrl.ReportProgress(ctx, StageSaving, 90, "Saving the resource")
 	nr := &database.Object{
 		Metadata: database.Metadata{
 			ID: request.ResourceID,
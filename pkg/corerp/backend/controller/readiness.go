@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultReadinessTimeout is the maximum time to wait for a connected resource to be ready when the connection
+	// does not specify a timeout.
+	DefaultReadinessTimeout = 5 * time.Minute
+
+	// healthCheckTimeout is the timeout of a single TCP connection attempt to a connected resource.
+	healthCheckTimeout = 5 * time.Second
+)
+
+// readinessPollInterval is the interval at which the readiness of a connected resource is checked.
+var readinessPollInterval = 5 * time.Second
+
+// connectedResource is the part of a connected resource used to determine whether it is ready.
+type connectedResource struct {
+	v1.BaseResource
+
+	Properties struct {
+		// Host is the host name of datastores and messaging resources.
+		Host string `json:"host,omitempty"`
+
+		// Server is the host name of SQL databases.
+		Server string `json:"server,omitempty"`
+
+		// Port is the port of the resource.
+		Port int32 `json:"port,omitempty"`
+	} `json:"properties"`
+}
+
+// address returns the host and port of the connected resource, or an empty string if the resource does not have both.
+func (r *connectedResource) address() string {
+	host := r.Properties.Host
+	if host == "" {
+		host = r.Properties.Server
+	}
+
+	if host == "" || r.Properties.Port == 0 {
+		return ""
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(r.Properties.Port)))
+}
+
+// waitForConnections waits until the resources of the connections of the container that opt in to readiness gating
+// are ready. It returns an error if a connected resource failed or is not ready before the timeout of the connection.
+func (c *CreateOrUpdateResource) waitForConnections(ctx context.Context, container *datamodel.ContainerResource) error {
+	names := []string{}
+	for name, conn := range container.Properties.Connections {
+		if conn.ReadinessCondition() != datamodel.ConnectionReadinessConditionNone {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		conn := container.Properties.Connections[name]
+		id, err := resources.ParseResource(conn.Source)
+		if err != nil {
+			return fmt.Errorf("connection %q: the readiness of the source %q cannot be checked because it is not a resource ID", name, conn.Source)
+		}
+
+		timeout := DefaultReadinessTimeout
+		if conn.Readiness.TimeoutSeconds > 0 {
+			timeout = time.Duration(conn.Readiness.TimeoutSeconds) * time.Second
+		}
+
+		ctrl.ReportProgress(ctx, StageWaitingForConnections, 5, fmt.Sprintf("Waiting for connection %q to be %s", name, conn.ReadinessCondition()))
+		err = c.waitForConnection(ctx, id, conn.ReadinessCondition(), timeout)
+		if err != nil {
+			return fmt.Errorf("connection %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForConnection polls the connected resource until it meets the readiness condition or the timeout expires.
+func (c *CreateOrUpdateResource) waitForConnection(ctx context.Context, id resources.ID, condition datamodel.ConnectionReadinessCondition, timeout time.Duration) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		reason, err := c.checkConnection(ctx, id, condition)
+		if err != nil {
+			return err
+		} else if reason == "" {
+			return nil
+		}
+
+		logger.Info(fmt.Sprintf("Connected resource %s is not %s: %s", id.String(), condition, reason))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the resource %q is not %s after %s: %s", id.String(), condition, timeout, reason)
+		case <-time.After(readinessPollInterval):
+		}
+	}
+}
+
+// checkConnection checks whether the connected resource meets the readiness condition. It returns the reason the resource
+// is not ready, or an empty string if it is ready. An error is returned if the resource can never become ready.
+func (c *CreateOrUpdateResource) checkConnection(ctx context.Context, id resources.ID, condition datamodel.ConnectionReadinessCondition) (string, error) {
+	obj, err := c.DatabaseClient().Get(ctx, id.String())
+	if errors.Is(&database.ErrNotFound{ID: id.String()}, err) {
+		return "the resource does not exist", nil
+	} else if err != nil {
+		// Errors reading the resource are transient, the resource is checked again until the timeout expires.
+		return err.Error(), nil
+	}
+
+	resource := &connectedResource{}
+	if err := obj.As(resource); err != nil {
+		return "", err
+	}
+
+	// Resources created by synchronous operations do not have a provisioning state.
+	switch state := resource.ProvisioningState(); state {
+	case v1.ProvisioningStateSucceeded, "":
+	case v1.ProvisioningStateFailed, v1.ProvisioningStateCanceled:
+		return "", fmt.Errorf("the resource %q is in the %s provisioning state", id.String(), state)
+	default:
+		return fmt.Sprintf("the provisioning state is %s", state), nil
+	}
+
+	if condition != datamodel.ConnectionReadinessConditionHealthy {
+		return "", nil
+	}
+
+	address := resource.address()
+	if address == "" {
+		return "", fmt.Errorf("the health of the resource %q cannot be checked because it does not have a host and port", id.String())
+	}
+
+	dialer := net.Dialer{Timeout: healthCheckTimeout}
+	tcpConn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Sprintf("unable to connect to %s: %v", address, err), nil
+	}
+	_ = tcpConn.Close()
+
+	return "", nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	deployment "github.com/radius-project/radius/pkg/corerp/backend/deployment"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+const (
+	testContainerID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/frontend"
+	testRedisID     = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/cache"
+)
+
+func saveConnectedResource(t *testing.T, databaseClient database.Client, state v1.ProvisioningState, host string, port int32) {
+	err := databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: testRedisID},
+		Data: map[string]any{
+			"id":                testRedisID,
+			"provisioningState": string(state),
+			"properties": map[string]any{
+				"host": host,
+				"port": port,
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func newReadinessContainer(condition datamodel.ConnectionReadinessCondition, source string) *datamodel.ContainerResource {
+	container := &datamodel.ContainerResource{}
+	container.ID = testContainerID
+	container.Properties.Connections = map[string]datamodel.ConnectionProperties{
+		"cache": {
+			Source:    source,
+			Readiness: &datamodel.ConnectionReadinessProperties{WaitFor: condition, TimeoutSeconds: 1},
+		},
+		"other": {
+			Source: "http://other:3000",
+		},
+	}
+	container.Properties.Container.Image = "nginx"
+
+	return container
+}
+
+func Test_WaitForConnections(t *testing.T) {
+	readinessPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { readinessPollInterval = 5 * time.Second })
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	port := int32(listener.Addr().(*net.TCPAddr).Port)
+
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := int32(closedListener.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, closedListener.Close())
+
+	tests := []struct {
+		desc      string
+		state     v1.ProvisioningState
+		host      string
+		port      int32
+		condition datamodel.ConnectionReadinessCondition
+		source    string
+		err       string
+	}{
+		{
+			desc:      "provisioned",
+			state:     v1.ProvisioningStateSucceeded,
+			condition: datamodel.ConnectionReadinessConditionProvisioned,
+		},
+		{
+			desc:      "healthy",
+			state:     v1.ProvisioningStateSucceeded,
+			host:      "127.0.0.1",
+			port:      port,
+			condition: datamodel.ConnectionReadinessConditionHealthy,
+		},
+		{
+			desc:      "not provisioned",
+			state:     v1.ProvisioningStateUpdating,
+			condition: datamodel.ConnectionReadinessConditionProvisioned,
+			err:       "the provisioning state is Updating",
+		},
+		{
+			desc:      "not healthy",
+			state:     v1.ProvisioningStateSucceeded,
+			host:      "127.0.0.1",
+			port:      closedPort,
+			condition: datamodel.ConnectionReadinessConditionHealthy,
+			err:       "unable to connect to 127.0.0.1",
+		},
+		{
+			desc:      "failed",
+			state:     v1.ProvisioningStateFailed,
+			condition: datamodel.ConnectionReadinessConditionProvisioned,
+			err:       "is in the Failed provisioning state",
+		},
+		{
+			desc:      "no address",
+			state:     v1.ProvisioningStateSucceeded,
+			condition: datamodel.ConnectionReadinessConditionHealthy,
+			err:       "does not have a host and port",
+		},
+		{
+			desc:      "not found",
+			condition: datamodel.ConnectionReadinessConditionProvisioned,
+			err:       "the resource does not exist",
+		},
+		{
+			desc:      "source is not a resource",
+			condition: datamodel.ConnectionReadinessConditionProvisioned,
+			source:    "http://cache:6379",
+			err:       "is not a resource ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			databaseClient := inmemory.NewClient()
+			if tt.state != "" {
+				saveConnectedResource(t, databaseClient, tt.state, tt.host, tt.port)
+			}
+
+			source := testRedisID
+			if tt.source != "" {
+				source = tt.source
+			}
+
+			c := &CreateOrUpdateResource{ctrl.NewBaseAsyncController(ctrl.Options{DatabaseClient: databaseClient})}
+			err := c.waitForConnections(context.Background(), newReadinessContainer(tt.condition, source))
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "connection \"cache\"")
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func Test_CreateOrUpdateResourceRun_ConnectionNotReady(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+	saveConnectedResource(t, databaseClient, v1.ProvisioningStateFailed, "", 0)
+
+	container := newReadinessContainer(datamodel.ConnectionReadinessConditionProvisioned, testRedisID)
+	err := databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: testContainerID}, Data: container})
+	require.NoError(t, err)
+
+	// The container is not rendered nor deployed when a connected resource is not ready.
+	mdp := deployment.NewMockDeploymentProcessor(gomock.NewController(t))
+
+	c, err := NewCreateOrUpdateResource(ctrl.Options{
+		DatabaseClient: databaseClient,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return mdp
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := c.Run(context.Background(), &ctrl.Request{
+		OperationID:      uuid.New(),
+		OperationType:    "APPLICATIONS.CORE/CONTAINERS|PUT",
+		ResourceID:       testContainerID,
+		CorrelationID:    uuid.NewString(),
+		OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, res.ProvisioningState())
+	require.Equal(t, v1.CodeDependencyNotReady, res.Error.Code)
+	require.Contains(t, res.Error.Message, "is in the Failed provisioning state")
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// applyCostAttributionLabels adds the cost attribution labels configured by the environment's costAttribution
+// extension to every Kubernetes object in the output resources. It does nothing when the extension is not configured.
+func applyCostAttributionLabels(outputResources []rpv1.OutputResource, ext *corerp_dm.CostAttributionExtension, application string, environment string, resource string) {
+	if ext == nil {
+		return
+	}
+
+	labels := kubernetes.MakeCostAttributionLabels(ext.LabelPrefix, application, environment, resource, ext.Tags)
+	for _, or := range outputResources {
+		if or.GetResourceType().Provider != resourcemodel.ProviderKubernetes || or.CreateResource == nil {
+			continue
+		}
+
+		obj, ok := or.CreateResource.Data.(metav1.Object)
+		if !ok {
+			continue
+		}
+
+		obj.SetLabels(mergeLabels(obj.GetLabels(), labels))
+
+		// Cost tools attribute spend using the labels of pods, so the labels are also applied to the pod template.
+		if dep, ok := obj.(*appsv1.Deployment); ok {
+			dep.Spec.Template.Labels = mergeLabels(dep.Spec.Template.Labels, labels)
+		}
+	}
+}
+
+func mergeLabels(existing map[string]string, labels map[string]string) map[string]string {
+	merged := map[string]string{}
+	for key, value := range existing {
+		merged[key] = value
+	}
+
+	for key, value := range labels {
+		merged[key] = value
+	}
+
+	return merged
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_applyCostAttributionLabels(t *testing.T) {
+	newOutputResources := func() []rpv1.OutputResource {
+		deployment := &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "default", Labels: map[string]string{"app": "frontend"}},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "frontend"}},
+				},
+			},
+		}
+		service := &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "default"},
+		}
+
+		return []rpv1.OutputResource{
+			rpv1.NewKubernetesOutputResource("Deployment", deployment, deployment.ObjectMeta),
+			rpv1.NewKubernetesOutputResource("Service", service, service.ObjectMeta),
+			{
+				LocalID: "AzureRoleAssignment",
+				CreateResource: &rpv1.Resource{
+					ResourceType: resourcemodel.ResourceType{Type: "Microsoft.Authorization/roleAssignments", Provider: resourcemodel.ProviderAzure},
+					Data:         map[string]string{"role": "reader"},
+				},
+			},
+		}
+	}
+
+	t.Run("extension not configured", func(t *testing.T) {
+		outputResources := newOutputResources()
+		applyCostAttributionLabels(outputResources, nil, "myapp", "prod", "frontend")
+
+		require.Equal(t, map[string]string{"app": "frontend"}, outputResources[0].CreateResource.Data.(*appsv1.Deployment).Labels)
+		require.Empty(t, outputResources[1].CreateResource.Data.(*corev1.Service).Labels)
+	})
+
+	t.Run("extension configured", func(t *testing.T) {
+		outputResources := newOutputResources()
+		ext := &corerp_dm.CostAttributionExtension{
+			Tags: map[string]string{"owner": "team-a"},
+		}
+		applyCostAttributionLabels(outputResources, ext, "myapp", "prod", "frontend")
+
+		expected := map[string]string{
+			"cost.radapp.io/application": "myapp",
+			"cost.radapp.io/environment": "prod",
+			"cost.radapp.io/resource":    "frontend",
+			"cost.radapp.io/owner":       "team-a",
+		}
+
+		withApp := map[string]string{"app": "frontend"}
+		for k, v := range expected {
+			withApp[k] = v
+		}
+
+		deployment := outputResources[0].CreateResource.Data.(*appsv1.Deployment)
+		require.Equal(t, withApp, deployment.Labels)
+		require.Equal(t, withApp, deployment.Spec.Template.Labels)
+		require.Equal(t, expected, outputResources[1].CreateResource.Data.(*corev1.Service).Labels)
+		require.Equal(t, map[string]string{"role": "reader"}, outputResources[2].CreateResource.Data)
+	})
+}
@@ -18,6 +18,7 @@ package deployment
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net"
@@ -42,6 +43,7 @@ import (
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/go-openapi/jsonpointer"
@@ -143,6 +145,8 @@ func (dp *deploymentProcessor) Render(ctx context.Context, resourceID resources.
 		}
 	}
 
+	applyCostAttributionLabels(rendererOutput.Resources, envOptions.CostAttribution, app.Name, env.Name, resourceID.Name())
+
 	rendererOutput.RadiusResource = resource
 
 	return rendererOutput, nil
@@ -374,15 +378,80 @@ func (dp *deploymentProcessor) FetchSecrets(ctx context.Context, dependency Reso
 		secretValues[k] = secretReference.Value
 	}
 
+	// The username and password of basicAuthentication secret stores are only kept in their Kubernetes secret,
+	// they are needed to render the basic authentication of gateways.
+	if secretStore, ok := dependency.Resource.(*corerp_dm.SecretStore); ok && secretStore.Properties.Type == corerp_dm.SecretTypeBasicAuthentication {
+		values, err := dp.fetchSecretStoreValues(ctx, secretStore, dependency.OutputResources)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range values {
+			secretValues[k] = v
+		}
+	}
+
 	return secretValues, nil
 }
 
+// fetchSecretStoreValues reads the values of the secret store from the Kubernetes secret in its output resources.
+func (dp *deploymentProcessor) fetchSecretStoreValues(ctx context.Context, secretStore *corerp_dm.SecretStore, outputResources []rpv1.OutputResource) (map[string]any, error) {
+	if dp.k8sClient == nil {
+		return nil, nil
+	}
+
+	var secretID *resources.ID
+	for _, outputResource := range outputResources {
+		if outputResource.LocalID == rpv1.LocalIDSecret {
+			secretID = &outputResource.ID
+			break
+		}
+	}
+
+	if secretID == nil {
+		return nil, nil
+	}
+
+	_, _, namespace, name := resources_kubernetes.ToParts(*secretID)
+	secret := &corev1.Secret{}
+	err := dp.k8sClient.Get(ctx, controller_runtime.ObjectKey{Namespace: namespace, Name: name}, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the secret of secret store %q: %w", secretStore.ID, err)
+	}
+
+	values := map[string]any{}
+	for k, d := range secretStore.Properties.Data {
+		key := k
+		if d.ValueFrom != nil {
+			key = d.ValueFrom.Name
+		}
+
+		val, ok := secret.Data[key]
+		if !ok {
+			return nil, fmt.Errorf("cannot find %s key from the secret of secret store %q", key, secretStore.ID)
+		}
+
+		// Raw values are stored base64-encoded in the secret.
+		if d.Encoding == corerp_dm.SecretValueEncodingRaw {
+			val, err = base64.StdEncoding.DecodeString(string(val))
+			if err != nil {
+				return nil, fmt.Errorf("%s is the invalid base64 encoded value: %w", key, err)
+			}
+		}
+
+		values[k] = string(val)
+	}
+
+	return values, nil
+}
+
 // TODO: Revisit to remove the corerp_dm.Environment dependency.
 func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm.Environment) (renderers.EnvironmentOptions, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	publicEndpointOverride := os.Getenv("RADIUS_PUBLIC_ENDPOINT_OVERRIDE")
 
 	envOpts := renderers.EnvironmentOptions{
+		Compute:        &env.Properties.Compute,
 		CloudProviders: &env.Properties.Providers,
 	}
 
@@ -396,6 +465,9 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		}
 		envOpts.Namespace = kubeProp.Namespace
 
+	case rpv1.ACIComputeKind:
+		// Container groups are deployed to the resource group of the environment, Kubernetes namespaces are not used.
+
 	default:
 		return renderers.EnvironmentOptions{}, fmt.Errorf("%s is unsupported", env.Properties.Compute.Kind)
 	}
@@ -416,6 +488,11 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
 	}
 
+	// Get Environment CostAttribution Info
+	if envExt := corerp_dm.FindExtension(env.Properties.Extensions, corerp_dm.CostAttribution); envExt != nil && envExt.CostAttribution != nil {
+		envOpts.CostAttribution = envExt.CostAttribution
+	}
+
 	if publicEndpointOverride != "" {
 		// Check if publicEndpointOverride contains a scheme,
 		// and if so, throw an error to the user
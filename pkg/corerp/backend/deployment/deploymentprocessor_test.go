@@ -46,6 +46,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 type SharedMocks struct {
@@ -1074,4 +1077,45 @@ func Test_fetchSecrets(t *testing.T) {
 		require.Equal(t, 1, len(secretValues))
 		require.Equal(t, secret, secretValues[pr_renderers.ConnectionStringValue])
 	})
+
+	t.Run("Get username and password of basicAuthentication secret store", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "credentials",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"username": []byte("admin"),
+				"pass":     []byte("cGFzc3dvcmQ="),
+			},
+		}).Build()
+		dp := deploymentProcessor{mocks.model, nil, client, nil}
+
+		secretStore := &datamodel.SecretStore{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: "/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/secretStores/credentials",
+				},
+			},
+			Properties: &datamodel.SecretStoreProperties{
+				Type: datamodel.SecretTypeBasicAuthentication,
+				Data: map[string]*datamodel.SecretStoreDataValue{
+					"username": {Encoding: datamodel.SecretValueEncodingBase64},
+					"password": {Encoding: datamodel.SecretValueEncodingRaw, ValueFrom: &datamodel.SecretStoreDataValueFrom{Name: "pass"}},
+				},
+			},
+		}
+
+		secretValues, err := dp.FetchSecrets(ctx, ResourceData{
+			Resource: secretStore,
+			OutputResources: []rpv1.OutputResource{
+				{
+					LocalID: rpv1.LocalIDSecret,
+					ID:      resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", resources_kubernetes.KindSecret, "default", "credentials"),
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"username": "admin", "password": "password"}, secretValues)
+	})
 }
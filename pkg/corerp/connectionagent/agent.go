@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectionagent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultOutputDirectory is the directory where the values of the connections are written by default.
+	DefaultOutputDirectory = "/var/run/radius/connections"
+
+	// EnvFileName is the name of the file containing the values of all connections in the dotenv format.
+	EnvFileName = "connections.env"
+)
+
+// Agent fetches the values of the connections of a container from the Radius control plane and writes them
+// to files in a directory shared with the container.
+//
+// Each value is written to a file named after the environment variable that would be used for the value when
+// the connection is materialized at deployment time, for example CONNECTION_REDIS_HOST. All values are also
+// written to a single file in the dotenv format that can be sourced by a shell.
+type Agent struct {
+	// Client is the client used to fetch the connected resources.
+	Client ResourceClient
+
+	// Connections maps the name of each connection to the resource ID of its source.
+	Connections map[string]string
+
+	// OutputDirectory is the directory where the values are written.
+	OutputDirectory string
+}
+
+// Run fetches the values of the connections and writes them to the output directory. If refreshInterval is
+// greater than zero Run keeps refreshing the values until the context is cancelled. Failures to refresh the
+// values are logged and the last values written are kept.
+func (a *Agent) Run(ctx context.Context, refreshInterval time.Duration) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	err := a.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	if refreshInterval <= 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			err := a.Fetch(ctx)
+			if err != nil {
+				logger.Error(err, "Failed to refresh connection values")
+			}
+		}
+	}
+}
+
+// Fetch fetches the values of the connections and writes them to the output directory.
+func (a *Agent) Fetch(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	values := map[string]string{}
+	for name, resourceID := range a.Connections {
+		resource, err := a.Client.Get(ctx, resourceID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch resource %s: %w", resourceID, err)
+		}
+
+		secrets, err := a.Client.ListSecrets(ctx, resourceID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch secrets for resource %s: %w", resourceID, err)
+		}
+
+		for k, v := range connectionValues(resource) {
+			values[envVarName(name, k)] = v
+		}
+
+		for k, v := range secrets {
+			values[envVarName(name, k)] = v
+		}
+	}
+
+	err := a.write(values)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Wrote connection values", "count", len(values), "directory", a.OutputDirectory)
+	return nil
+}
+
+// write writes the values to the output directory. Each file is replaced atomically so the container never
+// reads a partially written value.
+func (a *Agent) write(values map[string]string) error {
+	err := os.MkdirAll(a.OutputDirectory, 0755)
+	if err != nil {
+		return fmt.Errorf("failed to create output directory %s: %w", a.OutputDirectory, err)
+	}
+
+	keys := []string{}
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	envFile := strings.Builder{}
+	for _, k := range keys {
+		err := writeFileAtomic(filepath.Join(a.OutputDirectory, k), []byte(values[k]))
+		if err != nil {
+			return err
+		}
+
+		envFile.WriteString(fmt.Sprintf("%s=%s\n", k, shellQuote(values[k])))
+	}
+
+	return writeFileAtomic(filepath.Join(a.OutputDirectory, EnvFileName), []byte(envFile.String()))
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file for %s: %w", path, err)
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write file %s: %w", path, err)
+	}
+
+	err = os.Chmod(file.Name(), 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write file %s: %w", path, err)
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return fmt.Errorf("failed to write file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// envVarName returns the name of the environment variable for a value of a connection.
+func envVarName(connectionName string, key string) string {
+	return fmt.Sprintf("CONNECTION_%s_%s", strings.ToUpper(connectionName), strings.ToUpper(key))
+}
+
+// shellQuote quotes a value so that it can be safely read by a POSIX shell.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// connectionValues returns the scalar properties of a resource that are exposed as connection values.
+func connectionValues(resource generated.GenericResource) map[string]string {
+	values := map[string]string{}
+
+	for k, v := range resource.Properties {
+		switch k {
+		case "application", "environment", "provisioningState", "resourceProvisioning", "recipe", "resources", "status":
+			continue
+		}
+
+		if v == nil {
+			continue
+		}
+
+		// Ignore composite types. Values are scalars.
+		switch reflect.TypeOf(v).Kind() {
+		case reflect.Map, reflect.Slice, reflect.Struct:
+			continue
+		}
+
+		switch v := v.(type) {
+		case string:
+			values[k] = v
+		case bool:
+			values[k] = fmt.Sprintf("%t", v)
+		case float64:
+			values[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return values
+}
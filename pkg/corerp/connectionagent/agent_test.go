@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectionagent
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/stretchr/testify/require"
+)
+
+const redisID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+
+type fakeResourceClient struct {
+	resources map[string]generated.GenericResource
+	secrets   map[string]map[string]string
+	err       error
+}
+
+func (c *fakeResourceClient) Get(ctx context.Context, resourceID string) (generated.GenericResource, error) {
+	if c.err != nil {
+		return generated.GenericResource{}, c.err
+	}
+
+	return c.resources[resourceID], nil
+}
+
+func (c *fakeResourceClient) ListSecrets(ctx context.Context, resourceID string) (map[string]string, error) {
+	return c.secrets[resourceID], nil
+}
+
+func Test_Fetch(t *testing.T) {
+	client := &fakeResourceClient{
+		resources: map[string]generated.GenericResource{
+			redisID: {
+				Properties: map[string]any{
+					"application":       "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+					"provisioningState": "Succeeded",
+					"host":              "redis.example.com",
+					"port":              float64(6379),
+					"tls":               true,
+					"status":            map[string]any{"outputResources": []any{}},
+				},
+			},
+		},
+		secrets: map[string]map[string]string{
+			redisID: {"password": "it's-a-secret"},
+		},
+	}
+
+	outputDirectory := t.TempDir()
+	agent := &Agent{
+		Client:          client,
+		Connections:     map[string]string{"redis": redisID},
+		OutputDirectory: outputDirectory,
+	}
+
+	err := agent.Fetch(context.Background())
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		"CONNECTION_REDIS_HOST":     "redis.example.com",
+		"CONNECTION_REDIS_PORT":     "6379",
+		"CONNECTION_REDIS_TLS":      "true",
+		"CONNECTION_REDIS_PASSWORD": "it's-a-secret",
+	}
+	for name, value := range expected {
+		b, err := os.ReadFile(filepath.Join(outputDirectory, name))
+		require.NoError(t, err)
+		require.Equal(t, value, string(b))
+	}
+
+	entries, err := os.ReadDir(outputDirectory)
+	require.NoError(t, err)
+	require.Len(t, entries, len(expected)+1)
+
+	b, err := os.ReadFile(filepath.Join(outputDirectory, EnvFileName))
+	require.NoError(t, err)
+	require.Equal(t, `CONNECTION_REDIS_HOST='redis.example.com'
+CONNECTION_REDIS_PASSWORD='it'\''s-a-secret'
+CONNECTION_REDIS_PORT='6379'
+CONNECTION_REDIS_TLS='true'
+`, string(b))
+}
+
+func Test_Fetch_Error(t *testing.T) {
+	agent := &Agent{
+		Client:          &fakeResourceClient{err: errors.New("not available")},
+		Connections:     map[string]string{"redis": redisID},
+		OutputDirectory: t.TempDir(),
+	}
+
+	err := agent.Run(context.Background(), 0)
+	require.ErrorContains(t, err, "failed to fetch resource "+redisID+": not available")
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectionagent
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ResourceClient is the client used by the agent to fetch the connected resources.
+type ResourceClient interface {
+	// Get returns the resource with the given ID.
+	Get(ctx context.Context, resourceID string) (generated.GenericResource, error)
+
+	// ListSecrets returns the secrets of the resource with the given ID. Resources without secrets return an empty map.
+	ListSecrets(ctx context.Context, resourceID string) (map[string]string, error)
+}
+
+var _ ResourceClient = (*UCPResourceClient)(nil)
+
+// UCPResourceClient is the ResourceClient implementation using a connection to UCP.
+type UCPResourceClient struct {
+	// Connection is the connection to UCP.
+	Connection sdk.Connection
+}
+
+// NewUCPResourceClient creates a new UCPResourceClient.
+func NewUCPResourceClient(connection sdk.Connection) *UCPResourceClient {
+	return &UCPResourceClient{Connection: connection}
+}
+
+// Get returns the resource with the given ID.
+func (c *UCPResourceClient) Get(ctx context.Context, resourceID string) (generated.GenericResource, error) {
+	id, client, err := c.client(resourceID)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	response, err := client.Get(ctx, id.Name(), nil)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return response.GenericResource, nil
+}
+
+// ListSecrets returns the secrets of the resource with the given ID. Resources without secrets return an empty map.
+func (c *UCPResourceClient) ListSecrets(ctx context.Context, resourceID string) (map[string]string, error) {
+	id, client, err := c.client(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.ListSecrets(ctx, id.Name(), nil)
+	responseError := &azcore.ResponseError{}
+	if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+		// This is fine. The resource doesn't have any secrets.
+		return map[string]string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	secrets := map[string]string{}
+	for k, v := range response.Value {
+		if v != nil {
+			secrets[k] = *v
+		}
+	}
+
+	return secrets, nil
+}
+
+func (c *UCPResourceClient) client(resourceID string) (resources.ID, *generated.GenericResourcesClient, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return resources.ID{}, nil, err
+	}
+
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.Connection))
+	if err != nil {
+		return resources.ID{}, nil, err
+	}
+
+	return id, client, nil
+}
@@ -71,6 +71,7 @@ func (conn ConnectionProperties) GetDisableDefaultEnvVars() bool {
 // ContainerProperties represents the properties of Container.
 type ContainerProperties struct {
 	rpv1.BasicResourceProperties
+	Autoscaling          *ContainerAutoscalingProperties `json:"autoscaling,omitempty"`
 	Connections          map[string]ConnectionProperties `json:"connections,omitempty"`
 	Container            Container                       `json:"container,omitempty"`
 	Extensions           []Extension                     `json:"extensions,omitempty"`
@@ -92,6 +93,55 @@ const (
 	ContainerResourceProvisioningManual ContainerResourceProvisioning = "manual"
 )
 
+// ContainerAutoscalingProperties specifies how the container is scaled horizontally by a Kubernetes HorizontalPodAutoscaler.
+type ContainerAutoscalingProperties struct {
+	// MinReplicas is the minimum number of replicas. The default of 1 is used if zero.
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas.
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilization is the target average CPU utilization of the replicas, as a percentage of the CPU they request.
+	TargetCPUUtilization int32 `json:"targetCpuUtilization,omitempty"`
+
+	// TargetMemoryUtilization is the target average memory utilization of the replicas, as a percentage of the memory they request.
+	TargetMemoryUtilization int32 `json:"targetMemoryUtilization,omitempty"`
+
+	// Metrics are the custom metrics used to scale the container.
+	Metrics []ContainerAutoscalingMetric `json:"metrics,omitempty"`
+}
+
+// ContainerAutoscalingMetricKind specifies the kind of a custom metric used to scale a container.
+type ContainerAutoscalingMetricKind string
+
+const (
+	// ContainerAutoscalingMetricKindPods is a metric which describes each replica of the container, such as the number of
+	// requests per second it serves.
+	ContainerAutoscalingMetricKindPods ContainerAutoscalingMetricKind = "pods"
+
+	// ContainerAutoscalingMetricKindExternal is a metric which is not related to the container, such as the length of a
+	// queue of a cloud service.
+	ContainerAutoscalingMetricKindExternal ContainerAutoscalingMetricKind = "external"
+)
+
+// ContainerAutoscalingMetric specifies a custom metric used to scale a container.
+type ContainerAutoscalingMetric struct {
+	// Kind is the kind of the metric.
+	Kind ContainerAutoscalingMetricKind `json:"kind"`
+
+	// Name is the name of the metric.
+	Name string `json:"name"`
+
+	// Selector contains the labels which select the metric.
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// TargetAverageValue is the target average value of the metric across the replicas, as a Kubernetes quantity.
+	TargetAverageValue string `json:"targetAverageValue,omitempty"`
+
+	// TargetValue is the target value of an external metric, as a Kubernetes quantity.
+	TargetValue string `json:"targetValue,omitempty"`
+}
+
 // KubernetesRuntime represents the Kubernetes runtime configuration.
 type KubernetesRuntime struct {
 	// Base represents the Kubernetes resource definition in the serialized YAML format
@@ -109,9 +159,99 @@ type RuntimeProperties struct {
 
 // ConnectionProperties represents the properties of Connection.
 type ConnectionProperties struct {
-	Source                string        `json:"source,omitempty"`
-	DisableDefaultEnvVars *bool         `json:"disableDefaultEnvVars,omitempty"`
-	IAM                   IAMProperties `json:"iam,omitempty"`
+	Source                string                         `json:"source,omitempty"`
+	DisableDefaultEnvVars *bool                          `json:"disableDefaultEnvVars,omitempty"`
+	IAM                   IAMProperties                  `json:"iam,omitempty"`
+	Secrets               *ConnectionSecretsProperties   `json:"secrets,omitempty"`
+	Format                ConnectionStringFormat         `json:"format,omitempty"`
+	Readiness             *ConnectionReadinessProperties `json:"readiness,omitempty"`
+}
+
+// ConnectionStringFormat specifies the format of the connection string of a connection to a datastore resource.
+type ConnectionStringFormat string
+
+const (
+	// ConnectionStringFormatADONET is the ADO.NET connection string format, e.g. 'Server=tcp:server,1433;Database=db'.
+	ConnectionStringFormatADONET ConnectionStringFormat = "adonet"
+
+	// ConnectionStringFormatJDBC is the JDBC URL format, e.g. 'jdbc:sqlserver://server:1433;databaseName=db'.
+	ConnectionStringFormatJDBC ConnectionStringFormat = "jdbc"
+
+	// ConnectionStringFormatURI is the URI format, e.g. 'redis://host:6379'.
+	ConnectionStringFormatURI ConnectionStringFormat = "uri"
+
+	// ConnectionStringFormatStackExchange is the configuration string format of StackExchange.Redis, e.g. 'host:6379,ssl=True'.
+	ConnectionStringFormatStackExchange ConnectionStringFormat = "stackexchange"
+)
+
+// ConnectionSecretsMaterialization specifies when the values of a connection are materialized.
+type ConnectionSecretsMaterialization string
+
+const (
+	// ConnectionSecretsMaterializationDeploy specifies that the values of the connection are stored in a Kubernetes secret when the
+	// container is deployed.
+	ConnectionSecretsMaterializationDeploy ConnectionSecretsMaterialization = "deploy"
+
+	// ConnectionSecretsMaterializationRuntime specifies that the values of the connection are fetched from the Radius control plane
+	// when the pod starts.
+	ConnectionSecretsMaterializationRuntime ConnectionSecretsMaterialization = "runtime"
+)
+
+// ConnectionSecretsProperties represents how the values of a connection are provided to the container.
+type ConnectionSecretsProperties struct {
+	// Materialization specifies when the values of the connection are materialized.
+	Materialization ConnectionSecretsMaterialization `json:"materialization,omitempty"`
+
+	// RefreshIntervalSeconds is the interval at which the values are refreshed when materialized at runtime.
+	// The values are not refreshed if zero.
+	RefreshIntervalSeconds int32 `json:"refreshIntervalSeconds,omitempty"`
+
+	// SecretName is the name of the Kubernetes secret that stores the values when they are materialized at deploy time.
+	// The values are stored in the secret of the container if empty.
+	SecretName string `json:"secretName,omitempty"`
+
+	// Keys maps the names of the values of the connection to the keys of the Kubernetes secret that stores them.
+	// Values that are not mapped are stored with the name of their environment variable as the key.
+	Keys map[string]string `json:"keys,omitempty"`
+}
+
+// IsRuntimeMaterialized returns true if the values of the connection are fetched from the Radius control plane when the pod starts.
+func (conn ConnectionProperties) IsRuntimeMaterialized() bool {
+	return conn.Secrets != nil && conn.Secrets.Materialization == ConnectionSecretsMaterializationRuntime
+}
+
+// ConnectionReadinessCondition specifies the condition a connected resource must meet before the container is deployed.
+type ConnectionReadinessCondition string
+
+const (
+	// ConnectionReadinessConditionNone specifies that the container is deployed without waiting for the connected resource.
+	ConnectionReadinessConditionNone ConnectionReadinessCondition = "none"
+
+	// ConnectionReadinessConditionProvisioned specifies that the container is deployed once the provisioning state of the
+	// connected resource is Succeeded.
+	ConnectionReadinessConditionProvisioned ConnectionReadinessCondition = "provisioned"
+
+	// ConnectionReadinessConditionHealthy specifies that the container is deployed once the connected resource is provisioned
+	// and accepts TCP connections on its host and port.
+	ConnectionReadinessConditionHealthy ConnectionReadinessCondition = "healthy"
+)
+
+// ConnectionReadinessProperties specifies whether the container waits for a connected resource to be ready before it is deployed.
+type ConnectionReadinessProperties struct {
+	// WaitFor is the condition the connected resource must meet before the container is deployed.
+	WaitFor ConnectionReadinessCondition `json:"waitFor,omitempty"`
+
+	// TimeoutSeconds is the maximum time to wait for the connected resource to be ready. The default timeout is used if zero.
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ReadinessCondition returns the condition the connected resource must meet before the container is deployed.
+func (conn ConnectionProperties) ReadinessCondition() ConnectionReadinessCondition {
+	if conn.Readiness == nil || conn.Readiness.WaitFor == "" {
+		return ConnectionReadinessConditionNone
+	}
+
+	return conn.Readiness.WaitFor
 }
 
 // Container - Definition of a container.
@@ -144,7 +284,8 @@ type EnvironmentVariableReference struct {
 
 // EnvironmentVariableSecretReference - Environment variable secret reference for the container
 type EnvironmentVariableSecretReference struct {
-	// Source is either the resource id of a radius Applications.Core/secretStore resource or a kubernetes secret reference.
+	// Source is either the resource id of a radius Applications.Core/secretStore resource, the resource id of an
+	// Applications.Dapr/secretStores resource or a kubernetes secret reference.
 	Source string `json:"source"`
 	Key    string `json:"key"`
 }
@@ -194,6 +335,14 @@ type VolumeBase struct {
 type EphemeralVolume struct {
 	VolumeBase
 	ManagedStore ManagedStore `json:"managedStore,omitempty"`
+
+	// SizeLimit is the size limit of the volume as a Kubernetes quantity, for example "1Gi". When StorageClass is
+	// specified this is the requested size of the volume.
+	SizeLimit string `json:"sizeLimit,omitempty"`
+
+	// StorageClass is the storage class used to provision the volume. When specified, the volume is a generic
+	// ephemeral volume backed by a PersistentVolumeClaim that shares the lifecycle of the pod instead of an emptyDir.
+	StorageClass string `json:"storageClass,omitempty"`
 }
 
 // PersistentVolume - Specifies a persistent volume for a container
@@ -65,3 +65,37 @@ func SecretStoreModelFromVersioned(content []byte, version string) (*datamodel.S
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
 }
+
+// SecretStoreRotateRequestFromVersioned converts versioned secret store rotate request model to datamodel.
+func SecretStoreRotateRequestFromVersioned(content []byte, version string) (*datamodel.SecretStoreRotateRequest, error) {
+	switch version {
+	case v20231001preview.Version:
+		am := &v20231001preview.SecretStoreRotateRequest{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.SecretStoreRotateRequest), nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// SecretStoreRotateResultToVersioned converts version agnostic secret store rotate result datamodel to versioned model.
+func SecretStoreRotateResultToVersioned(model *datamodel.SecretStoreRotateResult, version string) (v1.VersionedModelInterface, error) {
+	switch version {
+	case v20231001preview.Version:
+		versioned := &v20231001preview.SecretStoreRotateResult{}
+		if err := versioned.ConvertFrom(model); err != nil {
+			return nil, err
+		}
+		return versioned, nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
@@ -97,3 +97,66 @@ func TestSecretStoreModelFromVersioned(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretStoreRotateRequestFromVersioned(t *testing.T) {
+	testset := []struct {
+		versionedModelFile string
+		apiVersion         string
+		err                error
+	}{
+		{
+			"../../api/v20231001preview/testdata/secretstorerotaterequest.json",
+			"2023-10-01-preview",
+			nil,
+		},
+		{
+			"",
+			"unsupported",
+			v1.ErrUnsupportedAPIVersion,
+		},
+	}
+
+	for _, tc := range testset {
+		t.Run(tc.apiVersion, func(t *testing.T) {
+			c := loadTestData(tc.versionedModelFile)
+			_, err := SecretStoreRotateRequestFromVersioned(c, tc.apiVersion)
+			if tc.err != nil {
+				require.ErrorAs(t, tc.err, &err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSecretStoreRotateResultToVersioned(t *testing.T) {
+	testset := []struct {
+		apiVersion   string
+		apiModelType any
+		err          error
+	}{
+		{
+			"2023-10-01-preview",
+			&v20231001preview.SecretStoreRotateResult{},
+			nil,
+		},
+		{
+			"unsupported",
+			nil,
+			v1.ErrUnsupportedAPIVersion,
+		},
+	}
+
+	for _, tc := range testset {
+		t.Run(tc.apiVersion, func(t *testing.T) {
+			dm := &datamodel.SecretStoreRotateResult{RotatedKeys: []string{"password"}}
+			am, err := SecretStoreRotateResultToVersioned(dm, tc.apiVersion)
+			if tc.err != nil {
+				require.ErrorAs(t, tc.err, &err)
+			} else {
+				require.NoError(t, err)
+				require.IsType(t, tc.apiModelType, am)
+			}
+		})
+	}
+}
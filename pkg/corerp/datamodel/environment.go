@@ -44,6 +44,83 @@ type EnvironmentProperties struct {
 	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
 	Extensions   []Extension                                       `json:"extensions,omitempty"`
 	Simulated    bool                                              `json:"simulated,omitempty"`
+
+	// DeploymentPolicy restricts when resources can be deployed to the environment.
+	DeploymentPolicy DeploymentPolicy `json:"deploymentPolicy,omitempty"`
+
+	// Quota limits the resources which can be deployed to the environment.
+	Quota *EnvironmentQuota `json:"quota,omitempty"`
+
+	// ImagePolicy restricts the container images which can be deployed to the environment.
+	ImagePolicy *ImagePolicy `json:"imagePolicy,omitempty"`
+}
+
+// ImagePolicy restricts the container images which can be deployed to the environment.
+type ImagePolicy struct {
+	// AllowedRegistries are the registries and repository prefixes images are allowed to be pulled from. When empty,
+	// images can be pulled from any registry.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+
+	// SignatureVerification requires images to be signed with cosign. Nil if signatures are not verified.
+	SignatureVerification *ImageSignatureVerification `json:"signatureVerification,omitempty"`
+}
+
+// ImageSignatureVerification is the cosign signature verification of container images.
+type ImageSignatureVerification struct {
+	// PublicKeys are the PEM encoded public keys trusted to sign images.
+	PublicKeys []string `json:"publicKeys"`
+
+	// RequiredAttestations are the in-toto predicate types of the attestations images must have.
+	RequiredAttestations []string `json:"requiredAttestations,omitempty"`
+}
+
+// EnvironmentQuota limits the resources which can be deployed to the environment. A nil limit is not enforced.
+type EnvironmentQuota struct {
+	// MaxContainers is the maximum number of containers in the environment.
+	MaxContainers *int32 `json:"maxContainers,omitempty"`
+
+	// MaxRecipeExecutionsPerDay is the maximum number of recipe executions per day (UTC) in the environment.
+	MaxRecipeExecutionsPerDay *int32 `json:"maxRecipeExecutionsPerDay,omitempty"`
+
+	// MaxCloudResources is the maximum number of Azure, AWS and GCP resources deployed by Radius in the environment.
+	MaxCloudResources *int32 `json:"maxCloudResources,omitempty"`
+
+	// MaxContainerCPU is the maximum CPU a container of the environment can request or be limited to, as a Kubernetes
+	// quantity such as "2" or "500m".
+	MaxContainerCPU string `json:"maxContainerCpu,omitempty"`
+
+	// MaxContainerMemory is the maximum memory a container of the environment can request or be limited to, as a
+	// Kubernetes quantity such as "1Gi".
+	MaxContainerMemory string `json:"maxContainerMemory,omitempty"`
+}
+
+// DeploymentPolicy restricts when resources can be deployed to the environment. Deployments of the environment itself
+// are always allowed, so the policy can be changed at any time.
+type DeploymentPolicy struct {
+	// Freeze rejects all deployments to the environment when true.
+	Freeze bool `json:"freeze,omitempty"`
+
+	// Windows are the windows in which deployments are allowed. When empty, deployments are allowed at any time unless
+	// the environment is frozen.
+	Windows []DeploymentWindow `json:"windows,omitempty"`
+
+	// Message is included in the error returned when a deployment is rejected.
+	Message string `json:"message,omitempty"`
+
+	// AllowOverride allows deployments which set the deployment policy override tag to bypass the policy.
+	AllowOverride bool `json:"allowOverride,omitempty"`
+}
+
+// DeploymentWindow is a recurring window in which deployments are allowed.
+type DeploymentWindow struct {
+	// Schedule is the cron expression of the start of the window.
+	Schedule string `json:"schedule"`
+
+	// Duration is the duration of the window, for example '8h'.
+	Duration string `json:"duration"`
+
+	// TimeZone is the IANA time zone of the schedule. Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
@@ -53,6 +130,11 @@ type EnvironmentRecipeProperties struct {
 	TemplateVersion string         `json:"templateVersion,omitempty"`
 	Parameters      map[string]any `json:"parameters,omitempty"`
 	PlainHTTP       bool           `json:"plainHttp,omitempty"`
+	Driver          string         `json:"driver,omitempty"`
+
+	// AllowedOutputResourceTypes are the resource types of the output resources the recipe is allowed to create.
+	// All resource types are allowed when empty.
+	AllowedOutputResourceTypes []string `json:"allowedOutputResourceTypes,omitempty"`
 }
 
 // Recipe represents input properties for recipe getMetadata api.
@@ -74,12 +156,95 @@ func (e *EnvironmentRecipeProperties) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
-// Providers represents configs for providers for the environment, eg azure,aws
+// RecipePlanRequest represents input properties for recipe planRecipe api.
+type RecipePlanRequest struct {
+	Recipe
+
+	// ResourceID is the ID of the resource the recipe is planned for. The changes are computed against the resources
+	// previously deployed by the recipe for this resource.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// Parameters are the parameters passed to the recipe. They override the parameters set by the environment.
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlanRequest instance.
+func (e *RecipePlanRequest) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipePlanResult represents the changes the deployment of a recipe would make.
+type RecipePlanResult struct {
+	TemplateKind    string `json:"templateKind"`
+	TemplatePath    string `json:"templatePath"`
+	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// ResourceID is the ID of the resource the recipe is planned for.
+	ResourceID string `json:"resourceId"`
+
+	// Changes are the changes to the resources deployed by the recipe.
+	Changes []RecipeResourceChange `json:"changes"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlanResult instance.
+func (e *RecipePlanResult) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipeResourceChangeAction is the action the deployment of a recipe would take on a resource.
+type RecipeResourceChangeAction string
+
+const (
+	// RecipeResourceChangeActionCreate specifies that the resource would be created.
+	RecipeResourceChangeActionCreate RecipeResourceChangeAction = "create"
+
+	// RecipeResourceChangeActionUpdate specifies that the resource would be updated in place.
+	RecipeResourceChangeActionUpdate RecipeResourceChangeAction = "update"
+
+	// RecipeResourceChangeActionReplace specifies that the resource would be deleted and created again.
+	RecipeResourceChangeActionReplace RecipeResourceChangeAction = "replace"
+
+	// RecipeResourceChangeActionDelete specifies that the resource would be deleted.
+	RecipeResourceChangeActionDelete RecipeResourceChangeAction = "delete"
+
+	// RecipeResourceChangeActionRead specifies that the resource would only be read, for example a Terraform data source.
+	RecipeResourceChangeActionRead RecipeResourceChangeAction = "read"
+
+	// RecipeResourceChangeActionNoChange specifies that the resource would not change.
+	RecipeResourceChangeActionNoChange RecipeResourceChangeAction = "noChange"
+
+	// RecipeResourceChangeActionUnsupported specifies that the changes to the resource cannot be determined.
+	RecipeResourceChangeActionUnsupported RecipeResourceChangeAction = "unsupported"
+)
+
+// RecipeResourceChange represents the change the deployment of a recipe would make to a resource.
+type RecipeResourceChange struct {
+	// Action is the action that would be taken on the resource.
+	Action RecipeResourceChangeAction `json:"action"`
+
+	// Type is the type of the resource, for example 'azurerm_redis_cache' or 'Microsoft.Cache/redis'.
+	Type string `json:"type"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// Address identifies the resource: the address of the resource in the Terraform configuration, or the resource ID
+	// of Bicep resources.
+	Address string `json:"address"`
+
+	// Properties are the names of the top-level properties changed by an update. Only the names are reported because
+	// the values can be sensitive.
+	Properties []string `json:"properties,omitempty"`
+}
+
+// Providers represents configs for providers for the environment, eg azure,aws,gcp
 type Providers struct {
 	// Azure provider information
 	Azure ProvidersAzure `json:"azure,omitempty"`
 	// AWS provider information
 	AWS ProvidersAWS `json:"aws,omitempty"`
+	// GCP provider information
+	GCP ProvidersGCP `json:"gcp,omitempty"`
 }
 
 // ProvidersAzure represents the azure provider configs
@@ -93,3 +258,9 @@ type ProvidersAWS struct {
 	// Scope is the target level for deploying the aws resources
 	Scope string `json:"scope,omitempty"`
 }
+
+// ProvidersGCP represents the gcp provider configs
+type ProvidersGCP struct {
+	// Scope is the target level for deploying the gcp resources
+	Scope string `json:"scope,omitempty"`
+}
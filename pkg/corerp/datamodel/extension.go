@@ -24,15 +24,17 @@ const (
 	DaprSidecar                  ExtensionKind = "daprSidecar"
 	KubernetesMetadata           ExtensionKind = "kubernetesMetadata"
 	KubernetesNamespaceExtension ExtensionKind = "kubernetesNamespace"
+	CostAttribution              ExtensionKind = "costAttribution"
 )
 
 // Extension of a resource.
 type Extension struct {
-	Kind                ExtensionKind           `json:"kind,omitempty"`
-	ManualScaling       *ManualScalingExtension `json:"manualScaling,omitempty"`
-	DaprSidecar         *DaprSidecarExtension   `json:"daprSidecar,omitempty"`
-	KubernetesMetadata  *KubeMetadataExtension  `json:"kubernetesMetadata,omitempty"`
-	KubernetesNamespace *KubeNamespaceExtension `json:"kubernetesNamespace,omitempty"`
+	Kind                ExtensionKind             `json:"kind,omitempty"`
+	ManualScaling       *ManualScalingExtension   `json:"manualScaling,omitempty"`
+	DaprSidecar         *DaprSidecarExtension     `json:"daprSidecar,omitempty"`
+	KubernetesMetadata  *KubeMetadataExtension    `json:"kubernetesMetadata,omitempty"`
+	KubernetesNamespace *KubeNamespaceExtension   `json:"kubernetesNamespace,omitempty"`
+	CostAttribution     *CostAttributionExtension `json:"costAttribution,omitempty"`
 }
 
 // KubeMetadataExtension represents the extension of kubernetes resource.
@@ -46,6 +48,15 @@ type KubeNamespaceExtension struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// CostAttributionExtension represents the extension that applies cost attribution labels and tags to the resources
+// output for an environment.
+type CostAttributionExtension struct {
+	// LabelPrefix is the prefix of the cost attribution label keys. The default prefix is used when it is empty.
+	LabelPrefix string `json:"labelPrefix,omitempty"`
+	// Tags are additional cost attribution values, such as owner or cost center.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
 // FindExtension searches a slice of Extensions for one with a matching ExtensionKind.
 func FindExtension(exts []Extension, kind ExtensionKind) *Extension {
 	for _, ext := range exts {
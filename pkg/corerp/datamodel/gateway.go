@@ -67,15 +67,97 @@ type GatewayProperties struct {
 	Hostname *GatewayPropertiesHostname `json:"hostname,omitempty"`
 	TLS      *GatewayPropertiesTLS      `json:"tls,omitempty"`
 	Routes   []GatewayRoute             `json:"routes,omitempty"`
+	Security *GatewaySecurity           `json:"security,omitempty"`
 	URL      string                     `json:"url,omitempty"`
 }
 
 // GatewayRoute represents the route attached to Gateway.
 type GatewayRoute struct {
-	Destination      string `json:"destination,omitempty"`
-	Path             string `json:"path,omitempty"`
-	ReplacePrefix    string `json:"replacePrefix,omitempty"`
-	EnableWebsockets bool   `json:"enableWebsockets,omitempty"`
+	Destination        string                          `json:"destination,omitempty"`
+	Destinations       []GatewayRouteDestination       `json:"destinations,omitempty"`
+	Path               string                          `json:"path,omitempty"`
+	ReplacePrefix      string                          `json:"replacePrefix,omitempty"`
+	EnableWebsockets   bool                            `json:"enableWebsockets,omitempty"`
+	Protocol           GatewayRouteProtocol            `json:"protocol,omitempty"`
+	TimeoutPolicy      *GatewayRouteTimeoutPolicy      `json:"timeoutPolicy,omitempty"`
+	LoadBalancerPolicy *GatewayRouteLoadBalancerPolicy `json:"loadBalancerPolicy,omitempty"`
+	// Security overrides the security of the Gateway for the route.
+	Security *GatewaySecurity `json:"security,omitempty"`
+}
+
+// GatewayRouteDestination represents a weighted destination of a Gateway route. The traffic of a route with multiple
+// destinations is split between the destinations by their weights.
+type GatewayRouteDestination struct {
+	// Destination is the URL or id of the service to route to.
+	Destination string `json:"destination,omitempty"`
+	// Weight is the percentage of the traffic of the route sent to the destination. The traffic is split evenly if
+	// no weights are specified.
+	Weight int32 `json:"weight,omitempty"`
+}
+
+// GatewayRouteProtocol represents the protocol used by the service of a Gateway route.
+type GatewayRouteProtocol string
+
+const (
+	// GatewayRouteProtocolHTTP is HTTP/1.1 traffic. This is the default.
+	GatewayRouteProtocolHTTP GatewayRouteProtocol = "http"
+	// GatewayRouteProtocolWebSocket is HTTP traffic which can be upgraded to WebSocket connections.
+	GatewayRouteProtocolWebSocket GatewayRouteProtocol = "websocket"
+	// GatewayRouteProtocolGRPC is gRPC traffic, sent to the service over HTTP/2.
+	GatewayRouteProtocolGRPC GatewayRouteProtocol = "grpc"
+	// GatewayRouteProtocolGRPCWeb is gRPC-Web traffic from browsers, translated to gRPC and sent to the service over HTTP/2.
+	GatewayRouteProtocolGRPCWeb GatewayRouteProtocol = "grpc-web"
+)
+
+// IsGRPC returns true if the service of the route is reached over gRPC.
+func (p GatewayRouteProtocol) IsGRPC() bool {
+	return p == GatewayRouteProtocolGRPC || p == GatewayRouteProtocolGRPCWeb
+}
+
+// GatewayRouteTimeoutPolicy represents the timeouts of a Gateway route. The timeouts are durations such as "30s" or
+// "1m30s", or "infinity" to disable the timeout.
+type GatewayRouteTimeoutPolicy struct {
+	// Response is the timeout for receiving the response from the service.
+	Response string `json:"response,omitempty"`
+	// Idle is the timeout for a request or stream without activity.
+	Idle string `json:"idle,omitempty"`
+}
+
+// GatewayRouteLoadBalancerPolicy represents the load balancing policy of a Gateway route.
+type GatewayRouteLoadBalancerPolicy struct {
+	// Strategy is the strategy used to balance the requests between the replicas of the service.
+	Strategy GatewayRouteLoadBalancerStrategy `json:"strategy,omitempty"`
+}
+
+// GatewayRouteLoadBalancerStrategy represents the strategy used to balance the requests of a Gateway route.
+type GatewayRouteLoadBalancerStrategy string
+
+const (
+	// GatewayRouteLoadBalancerStrategyRoundRobin distributes the requests to the replicas in turn. This is the default.
+	GatewayRouteLoadBalancerStrategyRoundRobin GatewayRouteLoadBalancerStrategy = "roundRobin"
+	// GatewayRouteLoadBalancerStrategyCookie sends the requests from a client to the same replica using a cookie.
+	GatewayRouteLoadBalancerStrategyCookie GatewayRouteLoadBalancerStrategy = "cookie"
+	// GatewayRouteLoadBalancerStrategyLeastRequest sends the requests to the replica with the least active requests.
+	GatewayRouteLoadBalancerStrategyLeastRequest GatewayRouteLoadBalancerStrategy = "leastRequest"
+)
+
+// GatewaySecurity represents the access restrictions of a Gateway or a Gateway route.
+type GatewaySecurity struct {
+	// IPAllowList is the list of source IP addresses or CIDR ranges allowed to reach the Gateway. All other
+	// addresses are denied.
+	IPAllowList []string `json:"ipAllowList,omitempty"`
+	// IPDenyList is the list of source IP addresses or CIDR ranges denied from reaching the Gateway.
+	IPDenyList []string `json:"ipDenyList,omitempty"`
+	// BasicAuth requires the requests to use HTTP basic authentication.
+	BasicAuth *GatewayBasicAuth `json:"basicAuth,omitempty"`
+}
+
+// GatewayBasicAuth represents the HTTP basic authentication of a Gateway or a Gateway route.
+type GatewayBasicAuth struct {
+	// SecretStore is the id of the secretStore of type basicAuthentication holding the username and password.
+	SecretStore string `json:"secretStore,omitempty"`
+	// Realm is the realm sent to the clients in the WWW-Authenticate header. Defaults to the Gateway name.
+	Realm string `json:"realm,omitempty"`
 }
 
 // GatewayPropertiesHostname - Declare hostname information for the Gateway.
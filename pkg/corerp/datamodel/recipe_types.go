@@ -16,6 +16,11 @@ limitations under the License.
 
 package datamodel
 
+import (
+	"fmt"
+	"slices"
+)
+
 // RecipeConfigProperties - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
 type RecipeConfigProperties struct {
 	// Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as part of Recipe deployment.
@@ -30,16 +35,111 @@ type RecipeConfigProperties struct {
 	// EnvSecrets represents the environment secrets for the recipe.
 	// The keys of the map are the names of the secrets, and the values are the references to the secrets.
 	EnvSecrets map[string]SecretReference `json:"envSecrets,omitempty"`
+
+	// Policy restricts the template sources of the recipes registered to the environment.
+	Policy RecipePolicyProperties `json:"policy,omitempty"`
+}
+
+// RecipePolicyProperties - Policy restricting the template sources of recipes. A source is a prefix of the template path,
+// such as a registry ('ghcr.io/myorg'), a Git host ('github.com/myorg'), or a module prefix ('registry.terraform.io/Azure').
+type RecipePolicyProperties struct {
+	// AllowedSources are the template sources that recipes are allowed to use. When empty, all sources that are not
+	// denied are allowed.
+	AllowedSources []string `json:"allowedSources,omitempty"`
+
+	// DeniedSources are the template sources that recipes are not allowed to use. Denied sources take precedence over
+	// allowed sources.
+	DeniedSources []string `json:"deniedSources,omitempty"`
 }
 
 // TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
 // part of Recipe deployment.
 type TerraformConfigProperties struct {
-	// Authentication information used to access private Terraform module sources. Supported module sources: Git.
+	// Authentication information used to access private Terraform module sources. Supported module sources: Git, archives.
 	Authentication AuthConfig `json:"authentication,omitempty"`
 
 	// Providers specifies the Terraform provider configurations. Controls how Terraform interacts with cloud providers, SaaS providers, and other APIs: https://developer.hashicorp.com/terraform/language/providers/configuration.// Providers specifies the Terraform provider configurations.
 	Providers map[string][]ProviderConfigProperties `json:"providers,omitempty"`
+
+	// Backend specifies where Terraform stores the state of Terraform Recipes. When not set, the state is stored in a
+	// Kubernetes secret in the Radius namespace.
+	Backend *TerraformBackendConfig `json:"backend,omitempty"`
+}
+
+// TerraformBackendType represents the type of the backend where Terraform stores state.
+type TerraformBackendType string
+
+const (
+	// TerraformBackendKubernetes stores the state in a Kubernetes secret in the Radius namespace.
+	TerraformBackendKubernetes TerraformBackendType = "kubernetes"
+	// TerraformBackendS3 stores the state in an Amazon S3 bucket.
+	TerraformBackendS3 TerraformBackendType = "s3"
+	// TerraformBackendAzureRM stores the state in an Azure Blob Storage container.
+	TerraformBackendAzureRM TerraformBackendType = "azurerm"
+	// TerraformBackendGCS stores the state in a Google Cloud Storage bucket.
+	TerraformBackendGCS TerraformBackendType = "gcs"
+)
+
+// terraformBackendStateKeys are the settings of each remote backend which identify the state of a recipe. These are
+// generated by Radius so that every recipe deployment uses its own state.
+var terraformBackendStateKeys = map[TerraformBackendType]string{
+	TerraformBackendS3:      "key",
+	TerraformBackendAzureRM: "key",
+	TerraformBackendGCS:     "prefix",
+}
+
+// TerraformBackendConfig - Configuration of the backend where Terraform stores the state of Terraform Recipes.
+type TerraformBackendConfig struct {
+	// Type is the type of the backend.
+	Type TerraformBackendType `json:"type"`
+
+	// Config represents the non-sensitive settings of the backend, such as the bucket, container or region.
+	Config map[string]any `json:"config,omitempty"`
+
+	// Secrets represents the sensitive settings of the backend. The keys of the map are the names of the settings.
+	Secrets map[string]SecretReference `json:"secrets,omitempty"`
+}
+
+// StateKey returns the name of the backend setting which identifies the state of a recipe, or an empty string if
+// the backend does not use one.
+func (b *TerraformBackendConfig) StateKey() string {
+	return terraformBackendStateKeys[b.Type]
+}
+
+// Validate checks that the backend type is supported, that the Kubernetes backend is not given any settings, and that
+// the setting which identifies the state of a recipe is not set, since it is generated by Radius.
+func (b *TerraformBackendConfig) Validate() error {
+	if b == nil {
+		return nil
+	}
+
+	supported := []TerraformBackendType{TerraformBackendKubernetes, TerraformBackendS3, TerraformBackendAzureRM, TerraformBackendGCS}
+	if !slices.Contains(supported, b.Type) {
+		return fmt.Errorf("the Terraform backend type %q is not supported, supported types are %v", b.Type, supported)
+	}
+
+	if b.Type == TerraformBackendKubernetes {
+		if len(b.Config) > 0 || len(b.Secrets) > 0 {
+			return fmt.Errorf("the %q Terraform backend does not support config or secrets", b.Type)
+		}
+
+		return nil
+	}
+
+	stateKey := b.StateKey()
+	_, inConfig := b.Config[stateKey]
+	_, inSecrets := b.Secrets[stateKey]
+	if inConfig || inSecrets {
+		return fmt.Errorf("the %q setting of the %q Terraform backend is generated by Radius and must not be set", stateKey, b.Type)
+	}
+
+	for name := range b.Secrets {
+		if _, ok := b.Config[name]; ok {
+			return fmt.Errorf("the %q setting of the %q Terraform backend must not be set in both config and secrets", name, b.Type)
+		}
+	}
+
+	return nil
 }
 
 // BicepConfigProperties - Configuration for Bicep Recipes. Controls how Bicep plans and applies templates as part of Recipe
@@ -57,12 +157,24 @@ type RegistrySecretConfig struct {
 	Secret string
 }
 
-// AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git.
+// AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git, archives.
 type AuthConfig struct {
+	// Authentication information used to access private Terraform modules packaged as archives on object storage or HTTP servers,
+	// which is a map of archive source hostname to secret config that contains credential information.
+	Archive map[string]ArchiveSecretConfig `json:"archive,omitempty"`
+
 	// Authentication information used to access private Terraform modules from Git repository sources.
 	Git GitAuthConfig `json:"git,omitempty"`
 }
 
+// ArchiveSecretConfig - Secret configuration used to authenticate to the source of Terraform modules packaged as archives.
+type ArchiveSecretConfig struct {
+	// The ID of an Applications.Core/SecretStore resource containing the credentials used to download the archive. Amazon S3
+	// sources use the 'accessKeyId' and 'secretAccessKey' secrets. Azure Blob Storage sources use the 'sasToken' secret. Other
+	// HTTP sources use the 'username' and 'password' secrets for basic authentication.
+	Secret string `json:"secret,omitempty"`
+}
+
 // GitAuthConfig - Authentication information used to access private Terraform modules from Git repository sources.
 type GitAuthConfig struct {
 	// Personal Access Token (PAT) configuration used to authenticate to Git platforms.
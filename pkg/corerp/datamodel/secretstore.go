@@ -99,6 +99,18 @@ type SecretStoreProperties struct {
 
 	// Resource is the resource id of an external secret store.
 	Resource string `json:"resource,omitempty"`
+
+	// Destination is the external secret manager that the secret values are synced to.
+	Destination *SecretStoreDestination `json:"destination,omitempty"`
+}
+
+// SecretStoreDestination represents the external secret manager that the secret values of a secret store are synced to.
+type SecretStoreDestination struct {
+	// Resource is the resource ID of the external secret manager. This is an Azure Key Vault ID or an AWS account and
+	// region scope.
+	Resource string `json:"resource"`
+	// Prefix is the prefix of the names of the secrets created in the external secret manager.
+	Prefix string `json:"prefix,omitempty"`
 }
 
 // SecretStoreDataValue represents the value of the secret store data.
@@ -109,6 +121,30 @@ type SecretStoreDataValue struct {
 	Value *string `json:"value,omitempty"`
 	// ValueFrom is the value from of the secret store data.
 	ValueFrom *SecretStoreDataValueFrom `json:"valueFrom,omitempty"`
+	// Generator is the generator of the value. The value is generated when the secret store is created and rotated.
+	Generator *SecretValueGenerator `json:"generator,omitempty"`
+}
+
+// SecretValueCharacterSet is the set of characters of a generated secret value.
+type SecretValueCharacterSet string
+
+const (
+	// SecretValueCharacterSetNone is the undefined character set.
+	SecretValueCharacterSetNone SecretValueCharacterSet = ""
+	// SecretValueCharacterSetAlphanumeric is the set of letters and digits.
+	SecretValueCharacterSetAlphanumeric SecretValueCharacterSet = "alphanumeric"
+	// SecretValueCharacterSetHex is the set of lowercase hexadecimal digits.
+	SecretValueCharacterSetHex SecretValueCharacterSet = "hex"
+	// SecretValueCharacterSetSymbols is the set of letters, digits and symbols.
+	SecretValueCharacterSetSymbols SecretValueCharacterSet = "symbols"
+)
+
+// SecretValueGenerator represents the generator of a secret value.
+type SecretValueGenerator struct {
+	// Length is the length of the generated value.
+	Length int32 `json:"length,omitempty"`
+	// CharacterSet is the set of characters of the generated value.
+	CharacterSet SecretValueCharacterSet `json:"characterSet,omitempty"`
 }
 
 // SecretStoreDataValueFrom represents the secret reference in the secret store.
@@ -147,3 +183,27 @@ func (s *SecretStoreListSecrets) OutputResources() []rpv1.OutputResource {
 func (s *SecretStoreListSecrets) ResourceMetadata() *rpv1.BasicResourceProperties {
 	return nil
 }
+
+// SecretStoreRotateRequest represents the input of the rotate action of a secret store.
+type SecretStoreRotateRequest struct {
+	// Keys are the keys of the secrets to rotate. Defaults to all the keys that have a generator.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// ResourceTypeName returns the resource type name of the SecretStoreRotateRequest instance.
+func (s *SecretStoreRotateRequest) ResourceTypeName() string {
+	return "Applications.Core/secretStores"
+}
+
+// SecretStoreRotateResult represents the result of the rotate action of a secret store.
+type SecretStoreRotateResult struct {
+	// RotatedKeys are the keys of the rotated secrets.
+	RotatedKeys []string `json:"rotatedKeys"`
+	// RestartedContainers are the resource IDs of the containers restarted to use the rotated secrets.
+	RestartedContainers []string `json:"restartedContainers"`
+}
+
+// ResourceTypeName returns the resource type name of the SecretStoreRotateResult instance.
+func (s *SecretStoreRotateResult) ResourceTypeName() string {
+	return "Applications.Core/secretStores"
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	cntr_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/containers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+var _ ctrl.Controller = (*GetDevProfile)(nil)
+
+// GetDevProfile is the controller implementation to get the dev profile of an application. The dev profile describes
+// where the containers of the application run, so that inner-loop development tools such as Tilt or Skaffold can sync
+// code changes into them.
+type GetDevProfile struct {
+	ctrl.Operation[*datamodel.Application, datamodel.Application]
+	connection sdk.Connection
+}
+
+// NewGetDevProfile creates a new instance of the GetDevProfile controller.
+func NewGetDevProfile(opts ctrl.Options, connection sdk.Connection) (ctrl.Controller, error) {
+	return &GetDevProfile{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Application]{
+				RequestConverter:  converter.ApplicationDataModelFromVersioned,
+				ResponseConverter: converter.ApplicationDataModelToVersioned,
+			},
+		),
+		connection,
+	}, nil
+}
+
+func (ctrl *GetDevProfile) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	sCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for getDevProfile has name of the operation as suffix which should be removed to get the resource id.
+	applicationID := sCtx.ResourceID.Truncate()
+	applicationResource, _, err := ctrl.GetResource(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if applicationResource == nil {
+		return rest.NewNotFoundResponse(sCtx.ResourceID), nil
+	}
+
+	clientOptions := sdk.NewClientOptions(ctrl.connection)
+	containers, err := listAllResourcesOfTypeInApplication(ctx, applicationID, cntr_ctrl.ResourceTypeName, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := computeDevProfile(applicationID.Name(), applicationNamespace(applicationResource), containers)
+	return rest.NewOKResponse(profile), nil
+}
+
+// applicationNamespace returns the Kubernetes namespace of the application, or an empty string if the application
+// does not run on Kubernetes.
+func applicationNamespace(application *datamodel.Application) string {
+	compute := application.Properties.Status.Compute
+	if compute == nil || compute.Kind != rpv1.KubernetesComputeKind {
+		return ""
+	}
+
+	return compute.KubernetesCompute.Namespace
+}
+
+// computeDevProfile builds the dev profile of an application from its container resources.
+//
+// The namespace and name of the deployment are read from the output resources of the container. Containers that have
+// not been deployed yet use the application namespace and the name the container renderer gives the deployment.
+func computeDevProfile(applicationName string, namespace string, containers []generated.GenericResource) *corerpv20231001preview.ApplicationDevProfileResponse {
+	// This is the wire format returned by the API for the container properties we need.
+	type containerWireFormat struct {
+		Container struct {
+			Image string `json:"image"`
+		} `json:"container"`
+		Status struct {
+			OutputResources []struct {
+				ID string `json:"id"`
+			} `json:"outputResources"`
+		} `json:"status"`
+	}
+
+	entries := []*corerpv20231001preview.ApplicationDevProfileContainer{}
+	for _, container := range containers {
+		name := to.String(container.Name)
+
+		data := containerWireFormat{}
+		err := toStronglyTypedData(container.Properties, &data)
+		if err != nil {
+			continue
+		}
+
+		// The container renderer names the deployment and its container after the container resource.
+		entry := &corerpv20231001preview.ApplicationDevProfileContainer{
+			ID:            container.ID,
+			Name:          container.Name,
+			Image:         to.Ptr(data.Container.Image),
+			Namespace:     to.Ptr(namespace),
+			Deployment:    to.Ptr(kubernetes.NormalizeResourceName(name)),
+			ContainerName: to.Ptr(kubernetes.NormalizeResourceName(name)),
+			LabelSelector: *to.StringMapPtr(kubernetes.MakeSelectorLabels(applicationName, name)),
+		}
+
+		for _, outputResource := range data.Status.OutputResources {
+			id, err := resources.ParseResource(outputResource.ID)
+			if err != nil || !strings.EqualFold(id.Type(), resources_kubernetes.ResourceTypeDeployment) {
+				continue
+			}
+
+			_, _, deploymentNamespace, deploymentName := resources_kubernetes.ToParts(id)
+			entry.Namespace = to.Ptr(deploymentNamespace)
+			entry.Deployment = to.Ptr(deploymentName)
+			break
+		}
+
+		entries = append(entries, entry)
+	}
+
+	// Produce a stable output
+	sort.Slice(entries, func(i, j int) bool {
+		return to.String(entries[i].ID) < to.String(entries[j].ID)
+	})
+
+	return &corerpv20231001preview.ApplicationDevProfileResponse{Containers: entries}
+}
@@ -31,8 +31,10 @@ import (
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/resourcemodel"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_aws "github.com/radius-project/radius/pkg/ucp/resources/aws"
 )
 
 var (
@@ -394,11 +396,37 @@ func applicationGraphResourceFromID(id string) *corerpv20231001preview.Applicati
 
 // outputResourceEntryFromID creates a outputResourceEntry from a resource ID.
 func outputResourceEntryFromID(id resources.ID) corerpv20231001preview.ApplicationGraphOutputResource {
-	return corerpv20231001preview.ApplicationGraphOutputResource{
+	entry := corerpv20231001preview.ApplicationGraphOutputResource{
 		ID:   to.Ptr(id.String()),
 		Name: to.Ptr(id.Name()),
 		Type: to.Ptr(id.Type()),
 	}
+
+	if provider := providerFromID(id); provider != "" {
+		entry.Provider = to.Ptr(provider)
+	}
+
+	// Only AWS resource IDs include the region. The region of other resources is not known without
+	// looking them up.
+	if region := id.FindScope(resources_aws.ScopeRegions); region != "" {
+		entry.Region = to.Ptr(region)
+	}
+
+	return entry
+}
+
+// providerFromID returns the provider of the resource ID, or an empty string if it cannot be determined.
+func providerFromID(id resources.ID) string {
+	if len(id.ScopeSegments()) == 0 {
+		return ""
+	}
+
+	if id.IsUCPQualified() {
+		return strings.ToLower(id.ScopeSegments()[0].Type)
+	}
+
+	// Resource IDs that are not UCP qualified are ARM resource IDs.
+	return resourcemodel.ProviderAzure
 }
 
 // outputResourcesFromAPIData processes the generic resource representation returned by the Radius API
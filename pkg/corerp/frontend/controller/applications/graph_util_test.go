@@ -137,6 +137,12 @@ func Test_computeGraph(t *testing.T) {
 			envResourceDataFile: "",
 			expectedDataFile:    "graph-app-gw-out.json",
 		},
+		{
+			name:                "with output resources",
+			appResourceDataFile: "graph-app-outputresources-in.json",
+			envResourceDataFile: "",
+			expectedDataFile:    "graph-app-outputresources-out.json",
+		},
 	}
 
 	for _, tt := range tests {
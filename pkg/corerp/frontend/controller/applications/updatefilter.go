@@ -18,6 +18,7 @@ package applications
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -45,13 +46,23 @@ const (
 // | namespace       | namespace override | env-scoped resource namespace | app-scoped resource namespace |
 // | in Environments | in Applications    |                               |                               |
 // +-----------------+--------------------+-------------------------------+-------------------------------+
-// | envNS           | UNDEFINED          | envNS                         | envNS-{appName}               |
+// | envNS           | UNDEFINED          | envNS                         | envNS-{appName} (*)           |
 // | envNS           | appNS              | envNS                         | appNS                         |
 // +-----------------+--------------------+-------------------------------+-------------------------------+
+//
+// (*) Truncated and suffixed with a hash when longer than the maximum name length configured for the resource provider
+// (63 characters by default). See kubernetes.NamingStrategy.
+
+// NewCreateAppScopedNamespace creates the filter which checks if a namespace already exists for the application and
+// creates one if it doesn't, returning an error if a conflict is found. The namespace derived from the environment
+// namespace and the application name is generated with the given naming strategy.
+func NewCreateAppScopedNamespace(naming kubernetes.NamingStrategy) controller.UpdateFilter[datamodel.Application] {
+	return func(ctx context.Context, newResource, oldResource *datamodel.Application, opt *controller.Options) (rest.Response, error) {
+		return createAppScopedNamespace(ctx, newResource, oldResource, opt, naming)
+	}
+}
 
-// CreateAppScopedNamespace checks if a namespace already exists for the application and creates one if it doesn't,
-// returning an error if a conflict is found.
-func CreateAppScopedNamespace(ctx context.Context, newResource, oldResource *datamodel.Application, opt *controller.Options) (rest.Response, error) {
+func createAppScopedNamespace(ctx context.Context, newResource, oldResource *datamodel.Application, opt *controller.Options, naming kubernetes.NamingStrategy) (rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -64,18 +75,19 @@ func CreateAppScopedNamespace(ctx context.Context, newResource, oldResource *dat
 	} else {
 		// Construct namespace using the namespace specified by environment resource.
 		envNamespace, err := rp_kube.FindNamespaceByEnvID(ctx, opt.DatabaseClient, newResource.Properties.Environment)
-		if err != nil {
+		if errors.Is(err, rp_kube.ErrNonKubernetesEnvironment) {
+			// Applications in environments without Kubernetes compute, such as Azure Container Instances, do not
+			// have a Kubernetes namespace.
+			newResource.Properties.Status.Compute = nil
+			return nil, nil
+		} else if err != nil {
 			return rest.NewBadRequestResponse(fmt.Sprintf("Environment %s could not be constructed: %s",
 				newResource.Properties.Environment, err.Error())), nil
 		}
 
-		namespace := fmt.Sprintf("%s-%s", envNamespace, serviceCtx.ResourceID.Name())
-		if !kubernetes.IsValidObjectName(namespace) {
-			return rest.NewBadRequestResponse(fmt.Sprintf("Application namespace '%s' could not be created: the combination of application and environment names is too long.",
-				namespace)), nil
-		}
-
-		kubeNamespace = kubernetes.NormalizeResourceName(namespace)
+		// Long combinations of environment namespace and application name are truncated with a hash suffix. Collisions
+		// with the namespaces of other environments and applications are detected below.
+		kubeNamespace = naming.MakeName(envNamespace, serviceCtx.ResourceID.Name())
 	}
 
 	// Check if another environment resource is using namespace
@@ -110,8 +122,13 @@ func CreateAppScopedNamespace(ctx context.Context, newResource, oldResource *dat
 		}
 	}
 
-	if !kubernetes.IsValidObjectName(kubeNamespace) {
-		return rest.NewBadRequestResponse(fmt.Sprintf("'%s' is the invalid namespace. This must be at most 63 alphanumeric characters or '-'. Please specify a valid namespace using 'kubernetesNamespace' extension in '$.properties.extensions[*]'.", kubeNamespace)), nil
+	if err := kubernetes.ValidateNamespace(kubeNamespace); err != nil {
+		if ext != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("The 'kubernetesNamespace' extension of application %s is invalid: %s.", serviceCtx.ResourceID.Name(), err.Error())), nil
+		}
+
+		// The namespace of the environment is invalid, the namespace of the application must be overridden.
+		return rest.NewBadRequestResponse(fmt.Sprintf("The namespace derived from environment %s for application %s is invalid: %s. Please specify a valid namespace for the application using 'kubernetesNamespace' extension in '$.properties.extensions[*]'.", newResource.Properties.Environment, serviceCtx.ResourceID.Name(), err.Error())), nil
 	}
 
 	if oldResource != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/k8sutil"
@@ -93,7 +94,7 @@ func TestCreateAppScopedNamespace_valid_namespace(t *testing.T) {
 		armctx := &v1.ARMRequestContext{ResourceID: id}
 		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
 
-		resp, err := CreateAppScopedNamespace(ctx, newResource, old, &opts)
+		resp, err := NewCreateAppScopedNamespace(kubernetes.DefaultNamingStrategy)(ctx, newResource, old, &opts)
 		require.NoError(t, err)
 		require.Nil(t, resp)
 
@@ -139,26 +140,74 @@ func TestCreateAppScopedNamespace_valid_namespace(t *testing.T) {
 		armctx := &v1.ARMRequestContext{ResourceID: id}
 		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
 
-		resp, err := CreateAppScopedNamespace(ctx, newResource, nil, &opts)
+		resp, err := NewCreateAppScopedNamespace(kubernetes.DefaultNamingStrategy)(ctx, newResource, nil, &opts)
 		require.NoError(t, err)
 		require.Nil(t, resp)
 
 		require.Equal(t, "default-app0", newResource.Properties.Status.Compute.KubernetesCompute.Namespace)
 	})
-}
 
-func TestCreateAppScopedNamespace_invalid_property(t *testing.T) {
-	tCtx := rpctest.NewControllerContext(t)
+	t.Run("generate long namespace with environment", func(t *testing.T) {
+		longAppID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/applications/this-is-a-very-long-application-name-that-is-invalid"
+		longEnvID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/this-is-a-very-long-environment-name-that-is-invalid"
 
-	opts := ctrl.Options{
-		DatabaseClient: tCtx.MockSC,
-		KubeClient:     k8sutil.NewFakeKubeClient(nil),
-	}
+		tCtx.MockSC.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+				return &database.ObjectQueryResult{
+					Items: []database.Object{},
+				}, nil
+			}).Times(2)
+
+		envdm := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				Compute: rpv1.EnvironmentCompute{
+					Kind: rpv1.KubernetesComputeKind,
+				},
+			},
+		}
 
-	t.Run("generated namespace is invalid", func(t *testing.T) {
+		tCtx.MockSC.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(rpctest.FakeStoreObject(envdm), nil)
+
+		newResource := &datamodel.Application{
+			Properties: datamodel.ApplicationProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: longEnvID,
+				},
+			},
+		}
+
+		id, err := resources.ParseResource(longAppID)
+		require.NoError(t, err)
+		armctx := &v1.ARMRequestContext{ResourceID: id}
+		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
+
+		resp, err := NewCreateAppScopedNamespace(kubernetes.DefaultNamingStrategy)(ctx, newResource, nil, &opts)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		namespace := newResource.Properties.Status.Compute.KubernetesCompute.Namespace
+		require.Equal(t, kubernetes.DefaultNamingStrategy.MakeName("this-is-a-very-long-environment-name-that-is-invalid", "this-is-a-very-long-application-name-that-is-invalid"), namespace)
+		require.Len(t, namespace, 63)
+	})
+
+	t.Run("generate namespace with configured maximum length", func(t *testing.T) {
 		longAppID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/applications/this-is-a-very-long-application-name-that-is-invalid"
 		longEnvID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/this-is-a-very-long-environment-name-that-is-invalid"
 
+		tCtx.MockSC.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+				return &database.ObjectQueryResult{
+					Items: []database.Object{},
+				}, nil
+			}).Times(2)
+
 		envdm := &datamodel.Environment{
 			Properties: datamodel.EnvironmentProperties{
 				Compute: rpv1.EnvironmentCompute{
@@ -185,13 +234,60 @@ func TestCreateAppScopedNamespace_invalid_property(t *testing.T) {
 		armctx := &v1.ARMRequestContext{ResourceID: id}
 		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
 
-		resp, err := CreateAppScopedNamespace(ctx, newResource, nil, &opts)
+		naming, err := kubernetes.NewNamingStrategy(40)
 		require.NoError(t, err)
-		res := resp.(*rest.BadRequestResponse)
 
-		require.Equal(t, "Application namespace 'this-is-a-very-long-environment-name-that-is-invalid-this-is-a-very-long-application-name-that-is-invalid' could not be created: the combination of application and environment names is too long.", res.Body.Error.Message)
+		resp, err := NewCreateAppScopedNamespace(naming)(ctx, newResource, nil, &opts)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		namespace := newResource.Properties.Status.Compute.KubernetesCompute.Namespace
+		require.Equal(t, naming.MakeName("this-is-a-very-long-environment-name-that-is-invalid", "this-is-a-very-long-application-name-that-is-invalid"), namespace)
+		require.Len(t, namespace, 40)
 	})
 
+	t.Run("environment without Kubernetes compute", func(t *testing.T) {
+		envdm := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				Compute: rpv1.EnvironmentCompute{
+					Kind: rpv1.ACIComputeKind,
+				},
+			},
+		}
+
+		tCtx.MockSC.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(rpctest.FakeStoreObject(envdm), nil)
+
+		newResource := &datamodel.Application{
+			Properties: datamodel.ApplicationProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: testEnvID,
+				},
+			},
+		}
+
+		id, err := resources.ParseResource(testAppID)
+		require.NoError(t, err)
+		armctx := &v1.ARMRequestContext{ResourceID: id}
+		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
+
+		resp, err := NewCreateAppScopedNamespace(kubernetes.DefaultNamingStrategy)(ctx, newResource, nil, &opts)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Nil(t, newResource.Properties.Status.Compute)
+	})
+}
+
+func TestCreateAppScopedNamespace_invalid_property(t *testing.T) {
+	tCtx := rpctest.NewControllerContext(t)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.MockSC,
+		KubeClient:     k8sutil.NewFakeKubeClient(nil),
+	}
+
 	t.Run("invalid namespace", func(t *testing.T) {
 		tCtx.MockSC.EXPECT().
 			Query(gomock.Any(), gomock.Any()).
@@ -220,10 +316,10 @@ func TestCreateAppScopedNamespace_invalid_property(t *testing.T) {
 		armctx := &v1.ARMRequestContext{ResourceID: id}
 		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
 
-		resp, err := CreateAppScopedNamespace(ctx, newResource, nil, &opts)
+		resp, err := NewCreateAppScopedNamespace(kubernetes.DefaultNamingStrategy)(ctx, newResource, nil, &opts)
 		require.NoError(t, err)
 		res := resp.(*rest.BadRequestResponse)
-		require.Equal(t, res.Body.Error.Message, "'invalid-nameinvalid-nameinvalid-nameinvalid-nameinvalid-nameinvalid-name' is the invalid namespace. This must be at most 63 alphanumeric characters or '-'. Please specify a valid namespace using 'kubernetesNamespace' extension in '$.properties.extensions[*]'.")
+		require.Equal(t, res.Body.Error.Message, "The 'kubernetesNamespace' extension of application app0 is invalid: namespace \"invalid-nameinvalid-nameinvalid-nameinvalid-nameinvalid-nameinvalid-name\" (72 characters) is not a valid Kubernetes namespace: must be no more than 63 characters, consider using \"invalid-nameinvalid-nameinvalid-nameinvalid-nameinvalid-nameinv\".")
 	})
 
 	t.Run("conflicted namespace in environment resource", func(t *testing.T) {
@@ -265,7 +361,7 @@ func TestCreateAppScopedNamespace_invalid_property(t *testing.T) {
 		armctx := &v1.ARMRequestContext{ResourceID: id}
 		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
 
-		resp, err := CreateAppScopedNamespace(ctx, newResource, nil, &opts)
+		resp, err := NewCreateAppScopedNamespace(kubernetes.DefaultNamingStrategy)(ctx, newResource, nil, &opts)
 		require.NoError(t, err)
 		res := resp.(*rest.ConflictResponse)
 		require.Equal(t, res.Body.Error.Message, "Environment env0 with the same namespace (testns) already exists")
@@ -303,7 +399,7 @@ func TestCreateAppScopedNamespace_invalid_property(t *testing.T) {
 		armctx := &v1.ARMRequestContext{ResourceID: id}
 		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
 
-		resp, err := CreateAppScopedNamespace(ctx, newResource, nil, &opts)
+		resp, err := NewCreateAppScopedNamespace(kubernetes.DefaultNamingStrategy)(ctx, newResource, nil, &opts)
 		require.NoError(t, err)
 		res := resp.(*rest.ConflictResponse)
 		require.Equal(t, res.Body.Error.Message, "Application /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/applications/app0 with the same namespace (testns) already exists")
@@ -354,7 +450,7 @@ func TestCreateAppScopedNamespace_invalid_property(t *testing.T) {
 		armctx := &v1.ARMRequestContext{ResourceID: id}
 		ctx := v1.WithARMRequestContext(tCtx.Ctx, armctx)
 
-		resp, err := CreateAppScopedNamespace(ctx, newResource, old, &opts)
+		resp, err := NewCreateAppScopedNamespace(kubernetes.DefaultNamingStrategy)(ctx, newResource, old, &opts)
 		require.NoError(t, err)
 		res := resp.(*rest.BadRequestResponse)
 		require.Equal(t, res.Body.Error.Message, "Updating an application's Kubernetes namespace from 'default-app0' to 'differentname' requires the application to be deleted and redeployed. Please delete your application and try again.")
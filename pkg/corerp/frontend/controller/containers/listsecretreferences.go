@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package containers
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// volumeResourceType is the type of the volume resources which mount secrets, keys and certificates into a container.
+	volumeResourceType = "Applications.Core/volumes"
+)
+
+// ListSecretReferences is the controller implementing the listSecretReferences custom action for Applications.Core/containers.
+type ListSecretReferences struct {
+	ctrl.Operation[*datamodel.ContainerResource, datamodel.ContainerResource]
+}
+
+// NewListSecretReferences creates a new controller for listing the secrets referenced by a container.
+func NewListSecretReferences(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListSecretReferences{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.ContainerResource]{
+				RequestConverter:  converter.ContainerDataModelFromVersioned,
+				ResponseConverter: converter.ContainerDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run returns the secrets consumed by the container through environment variables and volumes. Only the names and the
+// sources of the secrets are returned, the values are never read.
+func (l *ListSecretReferences) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := l.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	return rest.NewOKResponse(listSecretReferences(resource)), nil
+}
+
+// listSecretReferences builds the secret references of a container. Environment variables reference a key of a secret
+// store or of a Kubernetes secret, and persistent volumes mount the secrets of a volume resource.
+func listSecretReferences(resource *datamodel.ContainerResource) *corerpv20231001preview.ListSecretReferencesResult {
+	references := []*corerpv20231001preview.SecretReferenceMetadata{}
+	for name, env := range resource.Properties.Container.Env {
+		if env.ValueFrom == nil || env.ValueFrom.SecretRef == nil {
+			continue
+		}
+
+		references = append(references, &corerpv20231001preview.SecretReferenceMetadata{
+			Name:   to.Ptr(env.ValueFrom.SecretRef.Key),
+			Source: to.Ptr(env.ValueFrom.SecretRef.Source),
+			Kind:   to.Ptr(corerpv20231001preview.SecretReferenceKindEnvironmentVariable),
+			Target: to.Ptr(name),
+		})
+	}
+
+	for name, volume := range resource.Properties.Container.Volumes {
+		if volume.Persistent == nil {
+			continue
+		}
+
+		id, err := resources.ParseResource(volume.Persistent.Source)
+		if err != nil || !strings.EqualFold(id.Type(), volumeResourceType) {
+			continue
+		}
+
+		references = append(references, &corerpv20231001preview.SecretReferenceMetadata{
+			Name:   to.Ptr(name),
+			Source: to.Ptr(volume.Persistent.Source),
+			Kind:   to.Ptr(corerpv20231001preview.SecretReferenceKindVolume),
+			Target: to.Ptr(volume.Persistent.MountPath),
+		})
+	}
+
+	// Produce a stable output
+	sort.Slice(references, func(i, j int) bool {
+		if *references[i].Kind != *references[j].Kind {
+			return *references[i].Kind < *references[j].Kind
+		}
+		return *references[i].Target < *references[j].Target
+	})
+
+	return &corerpv20231001preview.ListSecretReferencesResult{Value: references}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package containers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	secretStoreID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/secret0"
+	volumeID      = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/volumes/keyvault"
+)
+
+func TestListSecretReferences_20231001Preview(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	req, err := rpctest.NewHTTPRequestWithContent(
+		context.Background(),
+		v1.OperationPost.HTTPMethod(),
+		"http://localhost:8080/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/ctr0/listsecretreferences?api-version=2023-10-01-preview", nil)
+	require.NoError(t, err)
+
+	t.Run("not found the resource", func(t *testing.T) {
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{})
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListSecretReferences(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 404, w.Result().StatusCode)
+	})
+
+	t.Run("return secret references", func(t *testing.T) {
+		container := &datamodel.ContainerResource{
+			Properties: datamodel.ContainerProperties{
+				Container: datamodel.Container{
+					Image: "test-image",
+					Env: map[string]datamodel.EnvironmentVariable{
+						"LOG_LEVEL": {Value: to.Ptr("debug")},
+						"DB_USER": {
+							ValueFrom: &datamodel.EnvironmentVariableReference{
+								SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "username"},
+							},
+						},
+						"DB_PASSWORD": {
+							ValueFrom: &datamodel.EnvironmentVariableReference{
+								SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: "default/db-secret", Key: "password"},
+							},
+						},
+					},
+					Volumes: map[string]datamodel.VolumeProperties{
+						"cache": {
+							Kind:      datamodel.Ephemeral,
+							Ephemeral: &datamodel.EphemeralVolume{VolumeBase: datamodel.VolumeBase{MountPath: "/cache"}},
+						},
+						"certs": {
+							Kind: datamodel.Persistent,
+							Persistent: &datamodel.PersistentVolume{
+								VolumeBase: datamodel.VolumeBase{MountPath: "/var/certs"},
+								Source:     volumeID,
+							},
+						},
+					},
+				},
+			},
+		}
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return &database.Object{
+					Metadata: database.Metadata{ID: id, ETag: "etag"},
+					Data:     container,
+				}, nil
+			})
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListSecretReferences(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.ListSecretReferencesResult{}
+		err = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.NoError(t, err)
+
+		expected := &v20231001preview.ListSecretReferencesResult{
+			Value: []*v20231001preview.SecretReferenceMetadata{
+				{
+					Name:   to.Ptr("password"),
+					Source: to.Ptr("default/db-secret"),
+					Kind:   to.Ptr(v20231001preview.SecretReferenceKindEnvironmentVariable),
+					Target: to.Ptr("DB_PASSWORD"),
+				},
+				{
+					Name:   to.Ptr("username"),
+					Source: to.Ptr(secretStoreID),
+					Kind:   to.Ptr(v20231001preview.SecretReferenceKindEnvironmentVariable),
+					Target: to.Ptr("DB_USER"),
+				},
+				{
+					Name:   to.Ptr("certs"),
+					Source: to.Ptr(volumeID),
+					Kind:   to.Ptr(v20231001preview.SecretReferenceKindVolume),
+					Target: to.Ptr("/var/certs"),
+				},
+			},
+		}
+		require.Equal(t, expected, actualOutput)
+	})
+}
@@ -16,6 +16,14 @@ limitations under the License.
 
 package containers
 
+import (
+	"time"
+)
+
 const (
 	ResourceTypeName = "Applications.Core/containers"
+
+	// AsyncCreateOrUpdateContainerTimeout is the timeout for async create or update container. It bounds the time spent
+	// waiting for the connected resources and the Kubernetes deployment of the container to be ready.
+	AsyncCreateOrUpdateContainerTimeout = time.Duration(30) * time.Minute
 )
@@ -23,6 +23,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -52,6 +53,14 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateEphemeralVolumes(newResource.Properties.Container.Volumes); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err}), nil
+	}
+
+	if err := validateAutoscaling(newResource.Properties.Autoscaling, newResource.Properties.Extensions); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err}), nil
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -72,6 +81,114 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 	return nil, nil
 }
 
+// validateEphemeralVolumes validates the size limit and storage class of the ephemeral volumes. A storage class
+// requires a size limit to request the storage from and cannot be used with a memory-backed volume.
+func validateEphemeralVolumes(volumes map[string]datamodel.VolumeProperties) *v1.ErrorDetails {
+	for name, volume := range volumes {
+		if volume.Kind != datamodel.Ephemeral || volume.Ephemeral == nil {
+			continue
+		}
+
+		target := fmt.Sprintf("$.properties.container.volumes['%s'].sizeLimit", name)
+		if volume.Ephemeral.SizeLimit != "" {
+			if _, err := resource.ParseQuantity(volume.Ephemeral.SizeLimit); err != nil {
+				return &v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target,
+					Message: fmt.Sprintf("Invalid sizeLimit '%s' for volume '%s': %s.", volume.Ephemeral.SizeLimit, name, err.Error()),
+				}
+			}
+		}
+
+		if volume.Ephemeral.StorageClass == "" {
+			continue
+		}
+
+		target = fmt.Sprintf("$.properties.container.volumes['%s'].storageClass", name)
+		if volume.Ephemeral.SizeLimit == "" {
+			return &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target,
+				Message: fmt.Sprintf("sizeLimit is required when storageClass is specified for volume '%s'.", name),
+			}
+		}
+
+		if volume.Ephemeral.ManagedStore == datamodel.ManagedStoreMemory {
+			return &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target,
+				Message: fmt.Sprintf("storageClass cannot be used with managedStore 'memory' for volume '%s'.", name),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAutoscaling validates the replica bounds and the metrics of the autoscaling properties. Autoscaling cannot
+// be used with the manualScaling extension because both set the number of replicas.
+func validateAutoscaling(autoscaling *datamodel.ContainerAutoscalingProperties, extensions []datamodel.Extension) *v1.ErrorDetails {
+	if autoscaling == nil {
+		return nil
+	}
+
+	for _, ext := range extensions {
+		if ext.Kind == datamodel.ManualScaling {
+			return &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.autoscaling",
+				Message: "autoscaling cannot be used with the manualScaling extension.",
+			}
+		}
+	}
+
+	if autoscaling.MinReplicas > autoscaling.MaxReplicas {
+		return &v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  "$.properties.autoscaling.minReplicas",
+			Message: fmt.Sprintf("minReplicas %d must not be greater than maxReplicas %d.", autoscaling.MinReplicas, autoscaling.MaxReplicas),
+		}
+	}
+
+	for i, metric := range autoscaling.Metrics {
+		target := fmt.Sprintf("$.properties.autoscaling.metrics[%d]", i)
+		switch metric.Kind {
+		case datamodel.ContainerAutoscalingMetricKindPods:
+			if metric.TargetValue != "" || metric.TargetAverageValue == "" {
+				return &v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target,
+					Message: fmt.Sprintf("pods metric '%s' requires targetAverageValue and does not support targetValue.", metric.Name),
+				}
+			}
+		case datamodel.ContainerAutoscalingMetricKindExternal:
+			if (metric.TargetValue == "") == (metric.TargetAverageValue == "") {
+				return &v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target,
+					Message: fmt.Sprintf("external metric '%s' requires exactly one of targetValue and targetAverageValue.", metric.Name),
+				}
+			}
+		}
+
+		for property, value := range map[string]string{"targetValue": metric.TargetValue, "targetAverageValue": metric.TargetAverageValue} {
+			if value == "" {
+				continue
+			}
+
+			if _, err := resource.ParseQuantity(value); err != nil {
+				return &v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  fmt.Sprintf("%s.%s", target, property),
+					Message: fmt.Sprintf("Invalid %s '%s' for metric '%s': %s.", property, value, metric.Name, err.Error()),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 // validatePodSpec is doing only syntactic validation for PodSpec by deserialzing the given JSON patch
 // to PodSpec object at this time. The semantic validation will be done when Radius applies the
 // patched object to Kubernetes API server.
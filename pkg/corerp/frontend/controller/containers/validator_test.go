@@ -444,3 +444,166 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEphemeralVolumes(t *testing.T) {
+	ephemeral := func(v datamodel.EphemeralVolume) map[string]datamodel.VolumeProperties {
+		v.MountPath = "/tmp"
+		return map[string]datamodel.VolumeProperties{
+			"scratch": {Kind: datamodel.Ephemeral, Ephemeral: &v},
+		}
+	}
+
+	volumeTests := []struct {
+		name    string
+		volumes map[string]datamodel.VolumeProperties
+		err     *v1.ErrorDetails
+	}{
+		{
+			name:    "no volumes",
+			volumes: nil,
+		},
+		{
+			name:    "emptyDir with size limit",
+			volumes: ephemeral(datamodel.EphemeralVolume{ManagedStore: datamodel.ManagedStoreDisk, SizeLimit: "1Gi"}),
+		},
+		{
+			name:    "generic ephemeral volume",
+			volumes: ephemeral(datamodel.EphemeralVolume{ManagedStore: datamodel.ManagedStoreDisk, SizeLimit: "10Gi", StorageClass: "fast"}),
+		},
+		{
+			name:    "invalid size limit",
+			volumes: ephemeral(datamodel.EphemeralVolume{SizeLimit: "lots"}),
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.volumes['scratch'].sizeLimit",
+				Message: "Invalid sizeLimit 'lots' for volume 'scratch': quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.",
+			},
+		},
+		{
+			name:    "storage class without size limit",
+			volumes: ephemeral(datamodel.EphemeralVolume{StorageClass: "fast"}),
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.volumes['scratch'].storageClass",
+				Message: "sizeLimit is required when storageClass is specified for volume 'scratch'.",
+			},
+		},
+		{
+			name:    "storage class with memory managed store",
+			volumes: ephemeral(datamodel.EphemeralVolume{ManagedStore: datamodel.ManagedStoreMemory, SizeLimit: "1Gi", StorageClass: "fast"}),
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.volumes['scratch'].storageClass",
+				Message: "storageClass cannot be used with managedStore 'memory' for volume 'scratch'.",
+			},
+		},
+	}
+
+	for _, tc := range volumeTests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateEphemeralVolumes(tc.volumes)
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestValidateAutoscaling(t *testing.T) {
+	autoscalingTests := []struct {
+		name        string
+		autoscaling *datamodel.ContainerAutoscalingProperties
+		extensions  []datamodel.Extension
+		err         *v1.ErrorDetails
+	}{
+		{
+			name:        "no autoscaling",
+			autoscaling: nil,
+			extensions:  []datamodel.Extension{{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{}}},
+		},
+		{
+			name: "cpu utilization",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{
+				MinReplicas:          2,
+				MaxReplicas:          10,
+				TargetCPUUtilization: 70,
+			},
+		},
+		{
+			name: "pods and external metrics",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{
+				MaxReplicas: 10,
+				Metrics: []datamodel.ContainerAutoscalingMetric{
+					{Kind: datamodel.ContainerAutoscalingMetricKindPods, Name: "http_requests_per_second", TargetAverageValue: "100"},
+					{Kind: datamodel.ContainerAutoscalingMetricKindExternal, Name: "queue_messages_ready", TargetValue: "30"},
+				},
+			},
+		},
+		{
+			name:        "manual scaling extension",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{MaxReplicas: 10},
+			extensions:  []datamodel.Extension{{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{}}},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.autoscaling",
+				Message: "autoscaling cannot be used with the manualScaling extension.",
+			},
+		},
+		{
+			name:        "min replicas greater than max replicas",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{MinReplicas: 5, MaxReplicas: 3},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.autoscaling.minReplicas",
+				Message: "minReplicas 5 must not be greater than maxReplicas 3.",
+			},
+		},
+		{
+			name: "pods metric with target value",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{
+				MaxReplicas: 10,
+				Metrics: []datamodel.ContainerAutoscalingMetric{
+					{Kind: datamodel.ContainerAutoscalingMetricKindPods, Name: "http_requests_per_second", TargetValue: "100"},
+				},
+			},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.autoscaling.metrics[0]",
+				Message: "pods metric 'http_requests_per_second' requires targetAverageValue and does not support targetValue.",
+			},
+		},
+		{
+			name: "external metric with both targets",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{
+				MaxReplicas: 10,
+				Metrics: []datamodel.ContainerAutoscalingMetric{
+					{Kind: datamodel.ContainerAutoscalingMetricKindExternal, Name: "queue_messages_ready", TargetValue: "30", TargetAverageValue: "10"},
+				},
+			},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.autoscaling.metrics[0]",
+				Message: "external metric 'queue_messages_ready' requires exactly one of targetValue and targetAverageValue.",
+			},
+		},
+		{
+			name: "invalid quantity",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{
+				MaxReplicas: 10,
+				Metrics: []datamodel.ContainerAutoscalingMetric{
+					{Kind: datamodel.ContainerAutoscalingMetricKindExternal, Name: "queue_messages_ready", TargetValue: "lots"},
+				},
+			},
+			err: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.autoscaling.metrics[0].targetValue",
+				Message: "Invalid targetValue 'lots' for metric 'queue_messages_ready': quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.",
+			},
+		},
+	}
+
+	for _, tc := range autoscalingTests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAutoscaling(tc.autoscaling, tc.extensions)
+			require.Equal(t, tc.err, err)
+		})
+	}
+}
@@ -27,6 +27,10 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/corerp/frontend/controller/util"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/rp/deploymentpolicy"
+	"github.com/radius-project/radius/pkg/rp/imagepolicy"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
 var _ ctrl.Controller = (*CreateOrUpdateEnvironment)(nil)
@@ -65,27 +69,59 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		return r, err
 	}
 
+	if err := e.ApplyDefaults(ctx, newResource, old); err != nil {
+		return nil, err
+	}
+
 	if err := newResource.Properties.Compute.Identity.Validate(); err != nil {
 		return rest.NewBadRequestResponse(err.Error()), nil
 	}
 
-	// Create Query filter to query kubernetes namespace used by the other environment resources.
-	namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
-	result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.DatabaseClient())
-	if err != nil {
-		return nil, err
+	if err := newResource.Properties.RecipeConfig.Terraform.Backend.Validate(); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	// Recipes must only reference the template sources permitted by the environment recipe policy, and only allow valid
+	// output resource types.
+	for _, resourceTypeRecipes := range newResource.Properties.Recipes {
+		for _, recipe := range resourceTypeRecipes {
+			if err := recipes.ValidateTemplateSource(newResource.Properties.RecipeConfig.Policy, recipe.TemplateKind, recipe.TemplatePath); err != nil {
+				return rest.NewBadRequestResponse(err.Error()), nil
+			}
+			if err := recipes.ValidateAllowedOutputResourceTypes(recipe.AllowedOutputResourceTypes); err != nil {
+				return rest.NewBadRequestResponse(err.Error()), nil
+			}
+		}
+	}
+
+	if err := deploymentpolicy.Validate(newResource.Properties.DeploymentPolicy); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The deployment policy of the environment is invalid: %s.", err.Error())), nil
+	}
+
+	if err := imagepolicy.Validate(newResource.Properties.ImagePolicy); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The image policy of the environment is invalid: %s.", err.Error())), nil
 	}
 
-	if len(result.Items) > 0 {
-		env := &datamodel.Environment{}
-		if err := result.Items[0].As(env); err != nil {
+	// Kubernetes namespaces are only used by environments with Kubernetes compute.
+	if newResource.Properties.Compute.Kind == rpv1.KubernetesComputeKind {
+		// Create Query filter to query kubernetes namespace used by the other environment resources.
+		namespace := newResource.Properties.Compute.KubernetesCompute.Namespace
+		result, err := util.FindResources(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type(), "properties.compute.kubernetes.namespace", namespace, e.DatabaseClient())
+		if err != nil {
 			return nil, err
 		}
 
-		// If a different resource has the same namespace, return a conflict
-		// Otherwise, continue and update the resource
-		if old == nil || env.ID != old.ID {
-			return rest.NewConflictResponse(fmt.Sprintf("Environment %s with the same namespace (%s) already exists", env.ID, namespace)), nil
+		if len(result.Items) > 0 {
+			env := &datamodel.Environment{}
+			if err := result.Items[0].As(env); err != nil {
+				return nil, err
+			}
+
+			// If a different resource has the same namespace, return a conflict
+			// Otherwise, continue and update the resource
+			if old == nil || env.ID != old.ID {
+				return rest.NewConflictResponse(fmt.Sprintf("Environment %s with the same namespace (%s) already exists", env.ID, namespace)), nil
+			}
 		}
 	}
 
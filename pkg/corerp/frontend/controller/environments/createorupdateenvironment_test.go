@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -408,4 +409,290 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
 		})
 	}
+
+	recipePolicyCases := []struct {
+		desc               string
+		policy             *v20231001preview.RecipePolicyProperties
+		expectedStatusCode int
+		shouldFail         bool
+	}{
+		{"recipe-policy-allowed", &v20231001preview.RecipePolicyProperties{AllowedSources: to.SliceOfPtrs("ghcr.io/radius-project")}, 200, false},
+		{"recipe-policy-not-allowed", &v20231001preview.RecipePolicyProperties{AllowedSources: to.SliceOfPtrs("myregistry.azurecr.io")}, 400, true},
+		{"recipe-policy-denied", &v20231001preview.RecipePolicyProperties{DeniedSources: to.SliceOfPtrs("ghcr.io/radius-project/dev")}, 400, true},
+	}
+
+	for _, tt := range recipePolicyCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			envInput, envDataModel, _ := getTestModels20231001preview()
+			envInput.Properties.RecipeConfig = &v20231001preview.RecipeConfigProperties{Policy: tt.policy}
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			databaseClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+					return nil, &database.ErrNotFound{ID: id}
+				})
+
+			if !tt.shouldFail {
+				databaseClient.
+					EXPECT().
+					Query(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+						return &database.ObjectQueryResult{
+							Items: []database.Object{},
+						}, nil
+					})
+
+				databaseClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+						obj.ETag = "new-resource-etag"
+						obj.Data = envDataModel
+						return nil
+					})
+			}
+
+			opts := ctrl.Options{
+				DatabaseClient: databaseClient,
+			}
+
+			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
+		})
+	}
+
+	terraformBackendCases := []struct {
+		desc               string
+		backend            *v20231001preview.TerraformBackendConfig
+		expectedStatusCode int
+		shouldFail         bool
+	}{
+		{
+			"terraform-backend-s3",
+			&v20231001preview.TerraformBackendConfig{
+				Type:   to.Ptr(v20231001preview.TerraformBackendTypeS3),
+				Config: map[string]any{"bucket": "tfstate", "region": "us-west-2"},
+			},
+			200,
+			false,
+		},
+		{
+			"terraform-backend-unsupported-type",
+			&v20231001preview.TerraformBackendConfig{Type: to.Ptr(v20231001preview.TerraformBackendType("consul"))},
+			400,
+			true,
+		},
+		{
+			"terraform-backend-kubernetes-with-config",
+			&v20231001preview.TerraformBackendConfig{
+				Type:   to.Ptr(v20231001preview.TerraformBackendTypeKubernetes),
+				Config: map[string]any{"namespace": "default"},
+			},
+			400,
+			true,
+		},
+		{
+			"terraform-backend-state-key-set",
+			&v20231001preview.TerraformBackendConfig{
+				Type:   to.Ptr(v20231001preview.TerraformBackendTypeGcs),
+				Config: map[string]any{"bucket": "tfstate", "prefix": "state"},
+			},
+			400,
+			true,
+		},
+	}
+
+	for _, tt := range terraformBackendCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			envInput, envDataModel, _ := getTestModels20231001preview()
+			envInput.Properties.RecipeConfig = &v20231001preview.RecipeConfigProperties{
+				Terraform: &v20231001preview.TerraformConfigProperties{Backend: tt.backend},
+			}
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			databaseClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+					return nil, &database.ErrNotFound{ID: id}
+				})
+
+			if !tt.shouldFail {
+				databaseClient.
+					EXPECT().
+					Query(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+						return &database.ObjectQueryResult{
+							Items: []database.Object{},
+						}, nil
+					})
+
+				databaseClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+						obj.ETag = "new-resource-etag"
+						obj.Data = envDataModel
+						return nil
+					})
+			}
+
+			opts := ctrl.Options{
+				DatabaseClient: databaseClient,
+			}
+
+			ctl, err := NewCreateOrUpdateEnvironment(opts)
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatusCode, w.Result().StatusCode)
+		})
+	}
+
+	t.Run("invalid-allowed-output-resource-types", func(t *testing.T) {
+		envInput, _, _ := getTestModels20231001preview()
+		envInput.Properties.Recipes = map[string]map[string]v20231001preview.RecipePropertiesClassification{
+			"Applications.Datastores/redisCaches": {
+				"default": &v20231001preview.BicepRecipeProperties{
+					TemplateKind:               to.Ptr("bicep"),
+					TemplatePath:               to.Ptr("ghcr.io/myorg/recipes/redis:1.0"),
+					AllowedOutputResourceTypes: to.SliceOfPtrs("Microsoft.Cache"),
+				},
+			},
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return nil, &database.ErrNotFound{ID: id}
+			})
+
+		ctl, err := NewCreateOrUpdateEnvironment(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 400, w.Result().StatusCode)
+	})
+
+	t.Run("aci-compute-skips-namespace-check", func(t *testing.T) {
+		envInput, envDataModel, _ := getTestModels20231001preview()
+		envInput.Properties.Compute = &v20231001preview.AzureContainerInstanceCompute{
+			Kind:          to.Ptr("aci"),
+			ResourceGroup: to.Ptr("/subscriptions/test-sub/resourceGroups/aci-group"),
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return nil, &database.ErrNotFound{ID: id}
+			})
+
+		// The namespace of the environment is not checked for conflicts, so no query is made.
+		databaseClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+				obj.ETag = "new-resource-etag"
+				obj.Data = envDataModel
+				return nil
+			})
+
+		opts := ctrl.Options{
+			DatabaseClient: databaseClient,
+		}
+
+		ctl, err := NewCreateOrUpdateEnvironment(opts)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+	})
+
+	t.Run("invalid-deployment-policy", func(t *testing.T) {
+		envInput, _, _ := getTestModels20231001preview()
+		envInput.Properties.DeploymentPolicy = &v20231001preview.DeploymentPolicy{
+			Windows: []*v20231001preview.DeploymentWindow{
+				{Schedule: to.Ptr("0 9 * * MON"), Duration: to.Ptr("8h")},
+			},
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return nil, &database.ErrNotFound{ID: id}
+			})
+
+		opts := ctrl.Options{
+			DatabaseClient: databaseClient,
+		}
+
+		ctl, err := NewCreateOrUpdateEnvironment(opts)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 400, w.Result().StatusCode)
+	})
+
+	t.Run("invalid-image-policy", func(t *testing.T) {
+		envInput, _, _ := getTestModels20231001preview()
+		envInput.Properties.ImagePolicy = &v20231001preview.ImagePolicy{
+			SignatureVerification: &v20231001preview.ImageSignatureVerification{
+				PublicKeys: to.SliceOfPtrs("not a key"),
+			},
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodPut, testHeaderfile, envInput)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return nil, &database.ErrNotFound{ID: id}
+			})
+
+		opts := ctrl.Options{
+			DatabaseClient: databaseClient,
+		}
+
+		ctl, err := NewCreateOrUpdateEnvironment(opts)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 400, w.Result().StatusCode)
+		require.Contains(t, w.Body.String(), "The image policy of the environment is invalid: the public key at index 0 is not PEM encoded.")
+	})
 }
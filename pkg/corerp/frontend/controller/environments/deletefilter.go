@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateNoApplications rejects the deletion of an environment while applications still reference it. The error
+// details list the blocking applications so that they can be deleted first.
+func ValidateNoApplications(ctx context.Context, oldResource *datamodel.Environment, options *controller.Options) (rest.Response, error) {
+	envID, err := resources.ParseResource(oldResource.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Applications can reference an environment in a different resource group, so query the whole plane.
+	result, err := options.DatabaseClient.Query(ctx, database.Query{
+		RootScope:      envID.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.ApplicationResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blocking := []string{}
+	for _, item := range result.Items {
+		app := &datamodel.Application{}
+		if err := item.As(app); err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(app.Properties.Environment, envID.String()) {
+			blocking = append(blocking, app.ID)
+		}
+	}
+
+	if len(blocking) == 0 {
+		return nil, nil
+	}
+
+	sort.Strings(blocking)
+
+	names := []string{}
+	details := []*v1.ErrorDetails{}
+	for _, id := range blocking {
+		name := id
+		if parsed, err := resources.ParseResource(id); err == nil {
+			name = parsed.Name()
+		}
+		names = append(names, name)
+		details = append(details, &v1.ErrorDetails{
+			Code:    v1.CodeConflict,
+			Message: fmt.Sprintf("Application '%s' uses environment '%s'.", name, envID.Name()),
+			Target:  id,
+		})
+	}
+
+	return &rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeConflict,
+				Message: fmt.Sprintf("Environment '%s' cannot be deleted because it is used by application(s): %s. Delete the application(s) before deleting the environment.", envID.Name(), strings.Join(names, ", ")),
+				Target:  envID.String(),
+				Details: details,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testDeleteEnvID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+)
+
+func makeTestApplication(name string, environment string) database.Object {
+	app := &datamodel.Application{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/" + name,
+				Name: name,
+				Type: datamodel.ApplicationResourceType,
+			},
+		},
+		Properties: datamodel.ApplicationProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Environment: environment,
+			},
+		},
+	}
+
+	return *rpctest.FakeStoreObject(app)
+}
+
+func TestValidateNoApplications(t *testing.T) {
+	env := &datamodel.Environment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   testDeleteEnvID,
+				Name: "env0",
+				Type: ResourceTypeName,
+			},
+		},
+	}
+
+	t.Run("no applications", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+				require.Equal(t, "/planes/radius/local", query.RootScope)
+				require.True(t, query.ScopeRecursive)
+				require.Equal(t, datamodel.ApplicationResourceType, query.ResourceType)
+
+				return &database.ObjectQueryResult{
+					Items: []database.Object{
+						makeTestApplication("other-app", "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env1"),
+					},
+				}, nil
+			})
+
+		resp, err := ValidateNoApplications(context.Background(), env, &ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("applications use the environment", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&database.ObjectQueryResult{
+				Items: []database.Object{
+					makeTestApplication("app1", testDeleteEnvID),
+					makeTestApplication("other-app", "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env1"),
+					makeTestApplication("app0", "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env0"),
+				},
+			}, nil)
+
+		resp, err := ValidateNoApplications(context.Background(), env, &ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		conflict, ok := resp.(*rest.ConflictResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeConflict, conflict.Body.Error.Code)
+		require.Equal(t, "Environment 'env0' cannot be deleted because it is used by application(s): app0, app1. Delete the application(s) before deleting the environment.", conflict.Body.Error.Message)
+		require.Len(t, conflict.Body.Error.Details, 2)
+		require.Equal(t, "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app0", conflict.Body.Error.Details[0].Target)
+		require.Equal(t, "Application 'app0' uses environment 'env0'.", conflict.Body.Error.Details[0].Message)
+		require.Equal(t, "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app1", conflict.Body.Error.Details[1].Target)
+	})
+
+	t.Run("query fails", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("query failed"))
+
+		_, err := ValidateNoApplications(context.Background(), env, &ctrl.Options{DatabaseClient: databaseClient})
+		require.Error(t, err)
+	})
+}
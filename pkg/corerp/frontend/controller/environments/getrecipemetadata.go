@@ -112,6 +112,7 @@ func (r *GetRecipeMetadata) GetRecipeMetadataFromRegistry(ctx context.Context, r
 		TemplateVersion: recipeProperties.TemplateVersion,
 		ResourceType:    recipeDataModel.ResourceType,
 		PlainHTTP:       recipeProperties.PlainHTTP,
+		ExternalDriver:  recipeProperties.Driver,
 	}
 
 	recipeParameters = make(map[string]any)
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ ctrl.Controller = (*PlanRecipe)(nil)
+
+// PlanRecipe is the controller implementation to compute the changes the deployment of a recipe would make, without
+// deploying the recipe.
+type PlanRecipe struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine.Engine
+}
+
+// NewPlanRecipe creates a new controller for planning a recipe of an environment.
+func NewPlanRecipe(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipe{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// Run runs terraform plan or a Bicep what-if operation for the recipe of the environment and returns the changes the
+// deployment of the recipe would make to its resources. The changes are computed against the resources previously
+// deployed by the recipe for the resource of the request.
+func (r *PlanRecipe) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	planRequest, err := converter.RecipePlanRequestDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipeProperties datamodel.EnvironmentRecipeProperties
+	recipe, exists := resource.Properties.Recipes[planRequest.ResourceType]
+	if exists {
+		recipeProperties, exists = recipe[planRequest.Name]
+	}
+	if !exists {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Either recipe with name %q or resource type %q not found on environment with id %q", planRequest.Name, planRequest.ResourceType, serviceCtx.ResourceID)), nil
+	}
+
+	resourceID, err := planResourceID(serviceCtx.ResourceID, planRequest)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	plan, err := r.Engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          planRequest.Name,
+				EnvironmentID: resource.ID,
+				ResourceID:    resourceID,
+				Parameters:    planRequest.Parameters,
+			},
+		},
+	})
+	if err != nil {
+		recipeError := &recipes.RecipeError{}
+		if errors.As(err, &recipeError) {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: &recipeError.ErrorDetails}), nil
+		}
+		return nil, err
+	}
+
+	result := datamodel.RecipePlanResult{
+		TemplateKind:    recipeProperties.TemplateKind,
+		TemplatePath:    recipeProperties.TemplatePath,
+		TemplateVersion: recipeProperties.TemplateVersion,
+		ResourceID:      resourceID,
+		Changes:         plan.Changes,
+	}
+
+	versioned, err := converter.RecipePlanResultDataModelToVersioned(&result, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
+
+// planResourceID returns the ID of the resource the recipe is planned for. When the request does not specify a resource,
+// it is a resource of the resource type of the recipe named after the recipe in the scope of the environment.
+func planResourceID(environmentID resources.ID, planRequest *datamodel.RecipePlanRequest) (string, error) {
+	if planRequest.ResourceID == "" {
+		return environmentID.RootScope() + "/providers/" + planRequest.ResourceType + "/" + planRequest.Name, nil
+	}
+
+	id, err := resources.ParseResource(planRequest.ResourceID)
+	if err != nil {
+		return "", fmt.Errorf("the resource %q is not a valid resource ID", planRequest.ResourceID)
+	}
+	if !strings.EqualFold(id.Type(), planRequest.ResourceType) {
+		return "", fmt.Errorf("the resource %q is not of the resource type %q of the recipe", planRequest.ResourceID, planRequest.ResourceType)
+	}
+
+	return id.String(), nil
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPlanRecipeRun_20231001Preview(t *testing.T) {
+	const (
+		envID       = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.core/environments/env0"
+		mongoID     = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Datastores/mongoDatabases/mongo-terraform"
+		existingID  = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/mongoDatabases/db"
+		mongoDBType = "Applications.Datastores/mongoDatabases"
+	)
+
+	rawDataModel := testutil.ReadFixture("environmentgetrecipemetadata20231001preview_datamodel.json")
+	envDataModel := &datamodel.Environment{}
+	require.NoError(t, json.Unmarshal(rawDataModel, envDataModel))
+
+	changes := []datamodel.RecipeResourceChange{
+		{
+			Action:  datamodel.RecipeResourceChangeActionCreate,
+			Type:    "azurerm_cosmosdb_account",
+			Name:    "db",
+			Address: "module.default.azurerm_cosmosdb_account.db",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		input          *v20231001preview.RecipePlan
+		expectedRecipe *recipes.ResourceMetadata
+		engineErr      error
+		statusCode     int
+		expectedOutput *v20231001preview.RecipePlanResponse
+		errorCode      string
+	}{
+		{
+			name: "default resource",
+			input: &v20231001preview.RecipePlan{
+				Name:         to.Ptr("mongo-terraform"),
+				ResourceType: to.Ptr(mongoDBType),
+				Parameters:   map[string]any{"throughput": float64(400)},
+			},
+			expectedRecipe: &recipes.ResourceMetadata{
+				Name:          "mongo-terraform",
+				EnvironmentID: envID,
+				ResourceID:    mongoID,
+				Parameters:    map[string]any{"throughput": float64(400)},
+			},
+			statusCode: 200,
+			expectedOutput: &v20231001preview.RecipePlanResponse{
+				TemplateKind:    to.Ptr("terraform"),
+				TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
+				TemplateVersion: to.Ptr("1.1.0"),
+				Resource:        to.Ptr(mongoID),
+				Changes: []*v20231001preview.RecipeResourceChange{
+					{
+						Action:  to.Ptr(v20231001preview.RecipeResourceChangeActionCreate),
+						Type:    to.Ptr("azurerm_cosmosdb_account"),
+						Name:    to.Ptr("db"),
+						Address: to.Ptr("module.default.azurerm_cosmosdb_account.db"),
+					},
+				},
+			},
+		},
+		{
+			name: "existing resource",
+			input: &v20231001preview.RecipePlan{
+				Name:         to.Ptr("mongo-terraform"),
+				ResourceType: to.Ptr(mongoDBType),
+				Resource:     to.Ptr(existingID),
+			},
+			expectedRecipe: &recipes.ResourceMetadata{
+				Name:          "mongo-terraform",
+				EnvironmentID: envID,
+				ResourceID:    existingID,
+			},
+			statusCode: 200,
+			expectedOutput: &v20231001preview.RecipePlanResponse{
+				TemplateKind:    to.Ptr("terraform"),
+				TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
+				TemplateVersion: to.Ptr("1.1.0"),
+				Resource:        to.Ptr(existingID),
+				Changes: []*v20231001preview.RecipeResourceChange{
+					{
+						Action:  to.Ptr(v20231001preview.RecipeResourceChangeActionCreate),
+						Type:    to.Ptr("azurerm_cosmosdb_account"),
+						Name:    to.Ptr("db"),
+						Address: to.Ptr("module.default.azurerm_cosmosdb_account.db"),
+					},
+				},
+			},
+		},
+		{
+			name: "resource of another type",
+			input: &v20231001preview.RecipePlan{
+				Name:         to.Ptr("mongo-terraform"),
+				ResourceType: to.Ptr(mongoDBType),
+				Resource:     to.Ptr("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/cache"),
+			},
+			statusCode: 400,
+			errorCode:  v1.CodeInvalid,
+		},
+		{
+			name: "recipe not found",
+			input: &v20231001preview.RecipePlan{
+				Name:         to.Ptr("not-existing"),
+				ResourceType: to.Ptr(mongoDBType),
+			},
+			statusCode: 404,
+			errorCode:  v1.CodeNotFound,
+		},
+		{
+			name: "plan not supported",
+			input: &v20231001preview.RecipePlan{
+				Name:         to.Ptr("mongo-terraform"),
+				ResourceType: to.Ptr(mongoDBType),
+			},
+			expectedRecipe: &recipes.ResourceMetadata{
+				Name:          "mongo-terraform",
+				EnvironmentID: envID,
+				ResourceID:    mongoID,
+			},
+			engineErr:  recipes.NewRecipeError(recipes.RecipePlanNotSupported, "driver `external` does not support planning recipes", "setupError", nil),
+			statusCode: 400,
+			errorCode:  recipes.RecipePlanNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			databaseClient := database.NewMockClient(mctrl)
+			mEngine := engine.NewMockEngine(mctrl)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfileplanrecipe, tt.input)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			databaseClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				Return(&database.Object{
+					Metadata: database.Metadata{ID: envID, ETag: "etag"},
+					Data:     envDataModel,
+				}, nil)
+
+			if tt.expectedRecipe != nil {
+				mEngine.EXPECT().
+					Plan(ctx, engine.PlanOptions{BaseOptions: engine.BaseOptions{Recipe: *tt.expectedRecipe}}).
+					Return(&recipes.RecipePlan{Changes: changes}, tt.engineErr)
+			}
+
+			ctl, err := NewPlanRecipe(ctrl.Options{DatabaseClient: databaseClient}, mEngine)
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.expectedOutput != nil {
+				actualOutput := &v20231001preview.RecipePlanResponse{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), actualOutput))
+				require.Equal(t, tt.expectedOutput, actualOutput)
+			} else {
+				armerr := v1.ErrorResponse{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &armerr))
+				require.Equal(t, tt.errorCode, armerr.Error.Code)
+			}
+		})
+	}
+}
@@ -18,15 +18,22 @@ package gateways
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"regexp"
 
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
-// ValidateAndMutateRequest checks if the TLS configuration is valid and sets the TLS protocol version to 1.2 if it is not
-// specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both configured or if TLS
-// protocol version is set but certificateFrom is not.
+// timeoutPattern matches the durations accepted by the Contour HTTPProxy timeout policy.
+var timeoutPattern = regexp.MustCompile(`^(((\d*(\.\d*)?h)|(\d*(\.\d*)?m)|(\d*(\.\d*)?s)|(\d*(\.\d*)?ms)|(\d*(\.\d*)?us)|(\d*(\.\d*)?µs)|(\d*(\.\d*)?ns))+|infinity|infinite)$`)
+
+// ValidateAndMutateRequest checks if the TLS configuration and the routes are valid and sets the TLS protocol version to
+// 1.2 if it is not specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both
+// configured, if TLS protocol version is set but certificateFrom is not, if a route has an invalid protocol, timeout,
+// load balancing or traffic splitting configuration, or if the Gateway or a route has an invalid security configuration.
 func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *datamodel.Gateway, options *controller.Options) (rest.Response, error) {
 	if newResource.Properties.TLS != nil {
 		// If SSL Passthrough and TLS termination are both configured, then report an error
@@ -45,5 +52,139 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		}
 	}
 
+	sslPassthrough := newResource.Properties.TLS != nil && newResource.Properties.TLS.SSLPassthrough
+	if resp := validateSecurity("$.properties.security", newResource.Properties.Security, sslPassthrough); resp != nil {
+		return resp, nil
+	}
+
+	for i, route := range newResource.Properties.Routes {
+		if resp := validateSecurity(fmt.Sprintf("$.properties.routes[%d].security", i), route.Security, sslPassthrough); resp != nil {
+			return resp, nil
+		}
+
+		// Websocket upgrades are HTTP/1.1 only, so they can't be combined with gRPC.
+		if route.EnableWebsockets && route.Protocol.IsGRPC() {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.routes[%d].enableWebsockets cannot be true when $.properties.routes[%d].protocol is '%s'.", i, i, route.Protocol)), nil
+		}
+
+		if resp := validateRouteDestinations(i, &route); resp != nil {
+			return resp, nil
+		}
+
+		if route.LoadBalancerPolicy != nil && route.LoadBalancerPolicy.Strategy != "" && !isValidLoadBalancerStrategy(route.LoadBalancerPolicy.Strategy) {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.routes[%d].loadBalancerPolicy.strategy must be one of 'roundRobin', 'cookie' or 'leastRequest'.", i)), nil
+		}
+
+		if route.TimeoutPolicy == nil {
+			continue
+		}
+
+		timeouts := []struct {
+			name  string
+			value string
+		}{
+			{"response", route.TimeoutPolicy.Response},
+			{"idle", route.TimeoutPolicy.Idle},
+		}
+		for _, timeout := range timeouts {
+			if timeout.value != "" && !timeoutPattern.MatchString(timeout.value) {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.routes[%d].timeoutPolicy.%s must be a duration such as '30s' or '1m30s', or 'infinity'.", i, timeout.name)), nil
+			}
+		}
+	}
+
 	return nil, nil
 }
+
+// validateRouteDestinations checks that the route has either a destination or weighted destinations to split the
+// traffic between. The weights are percentages, so they must add up to 100 unless none of them are specified.
+func validateRouteDestinations(i int, route *datamodel.GatewayRoute) rest.Response {
+	if len(route.Destinations) == 0 {
+		return nil
+	}
+
+	if route.Destination != "" {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Only one of $.properties.routes[%d].destination and $.properties.routes[%d].destinations can be specified at a time.", i, i))
+	}
+
+	if len(route.Destinations) < 2 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.routes[%d].destinations must have at least two destinations.", i))
+	}
+
+	total := int32(0)
+	for j, destination := range route.Destinations {
+		if destination.Destination == "" {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.routes[%d].destinations[%d].destination is required.", i, j))
+		}
+
+		if destination.Weight < 0 || destination.Weight > 100 {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.routes[%d].destinations[%d].weight must be between 0 and 100.", i, j))
+		}
+
+		total += destination.Weight
+	}
+
+	if total != 0 && total != 100 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The weights of $.properties.routes[%d].destinations must add up to 100.", i))
+	}
+
+	return nil
+}
+
+// validateSecurity checks that the security configuration at the given path filters on valid IP addresses or CIDR ranges
+// and references a secretStore for basic authentication. Requests can't be filtered or authenticated with SSL
+// Passthrough because the gateway doesn't decrypt them.
+func validateSecurity(path string, security *datamodel.GatewaySecurity, sslPassthrough bool) rest.Response {
+	if security == nil {
+		return nil
+	}
+
+	if sslPassthrough {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s cannot be specified when $.properties.tls.sslPassthrough is true.", path))
+	}
+
+	if len(security.IPAllowList) > 0 && len(security.IPDenyList) > 0 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Only one of %s.ipAllowList and %s.ipDenyList can be specified at a time.", path, path))
+	}
+
+	lists := []struct {
+		name   string
+		values []string
+	}{
+		{"ipAllowList", security.IPAllowList},
+		{"ipDenyList", security.IPDenyList},
+	}
+	for _, list := range lists {
+		for j, value := range list.values {
+			if !isValidIPOrCIDR(value) {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.%s[%d] must be an IP address or a CIDR range such as '10.0.0.0/8'.", path, list.name, j))
+			}
+		}
+	}
+
+	if security.BasicAuth != nil && security.BasicAuth.SecretStore == "" {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.basicAuth.secretStore is required.", path))
+	}
+
+	return nil
+}
+
+func isValidIPOrCIDR(value string) bool {
+	if net.ParseIP(value) != nil {
+		return true
+	}
+
+	_, _, err := net.ParseCIDR(value)
+	return err == nil
+}
+
+func isValidLoadBalancerStrategy(strategy datamodel.GatewayRouteLoadBalancerStrategy) bool {
+	switch strategy {
+	case datamodel.GatewayRouteLoadBalancerStrategyRoundRobin,
+		datamodel.GatewayRouteLoadBalancerStrategyCookie,
+		datamodel.GatewayRouteLoadBalancerStrategyLeastRequest:
+		return true
+	}
+
+	return false
+}
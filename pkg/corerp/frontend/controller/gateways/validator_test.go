@@ -108,6 +108,253 @@ func TestValidateAndMutateRequest_Gateway(t *testing.T) {
 			},
 			resp: nil,
 		},
+		{
+			desc: "valid route protocol and timeouts",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:   "http://backend:50051",
+							Protocol:      datamodel.GatewayRouteProtocolGRPC,
+							TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Response: "1m30s", Idle: "infinity"},
+						},
+					},
+				},
+			},
+			oldResource: nil,
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:   "http://backend:50051",
+							Protocol:      datamodel.GatewayRouteProtocolGRPC,
+							TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Response: "1m30s", Idle: "infinity"},
+						},
+					},
+				},
+			},
+			resp: nil,
+		},
+		{
+			desc: "cannot enable websockets for gRPC routes",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:      "http://backend:50051",
+							Protocol:         datamodel.GatewayRouteProtocolGRPCWeb,
+							EnableWebsockets: true,
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].enableWebsockets cannot be true when $.properties.routes[0].protocol is 'grpc-web'."),
+		},
+		{
+			desc: "invalid route timeout",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "http://frontend:3000",
+						},
+						{
+							Destination:   "http://backend:3000",
+							TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Response: "30s", Idle: "ten minutes"},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[1].timeoutPolicy.idle must be a duration such as '30s' or '1m30s', or 'infinity'."),
+		},
+		{
+			desc: "valid traffic split and load balancer policy",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "http://frontend-blue:3000", Weight: 90},
+								{Destination: "http://frontend-green:3000", Weight: 10},
+							},
+							LoadBalancerPolicy: &datamodel.GatewayRouteLoadBalancerPolicy{Strategy: datamodel.GatewayRouteLoadBalancerStrategyCookie},
+						},
+						{
+							Path: "/api",
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "http://backend-blue:3000"},
+								{Destination: "http://backend-green:3000"},
+							},
+						},
+					},
+				},
+			},
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "http://frontend-blue:3000", Weight: 90},
+								{Destination: "http://frontend-green:3000", Weight: 10},
+							},
+							LoadBalancerPolicy: &datamodel.GatewayRouteLoadBalancerPolicy{Strategy: datamodel.GatewayRouteLoadBalancerStrategyCookie},
+						},
+						{
+							Path: "/api",
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "http://backend-blue:3000"},
+								{Destination: "http://backend-green:3000"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "cannot specify destination and destinations",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "http://frontend:3000",
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "http://frontend-blue:3000"},
+								{Destination: "http://frontend-green:3000"},
+							},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Only one of $.properties.routes[0].destination and $.properties.routes[0].destinations can be specified at a time."),
+		},
+		{
+			desc: "traffic split with a single destination",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "http://frontend-blue:3000", Weight: 100},
+							},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].destinations must have at least two destinations."),
+		},
+		{
+			desc: "traffic split weights do not add up to 100",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destinations: []datamodel.GatewayRouteDestination{
+								{Destination: "http://frontend-blue:3000", Weight: 80},
+								{Destination: "http://frontend-green:3000", Weight: 10},
+							},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("The weights of $.properties.routes[0].destinations must add up to 100."),
+		},
+		{
+			desc: "invalid load balancer strategy",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination:        "http://frontend:3000",
+							LoadBalancerPolicy: &datamodel.GatewayRouteLoadBalancerPolicy{Strategy: "random"},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].loadBalancerPolicy.strategy must be one of 'roundRobin', 'cookie' or 'leastRequest'."),
+		},
+		{
+			desc: "valid security",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Security: &datamodel.GatewaySecurity{
+						IPAllowList: []string{"10.0.0.0/8", "192.168.1.10", "2001:db8::/32"},
+						BasicAuth:   &datamodel.GatewayBasicAuth{SecretStore: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/credentials"},
+					},
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "http://frontend:3000",
+							Security:    &datamodel.GatewaySecurity{IPDenyList: []string{"10.0.0.1"}},
+						},
+					},
+				},
+			},
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Security: &datamodel.GatewaySecurity{
+						IPAllowList: []string{"10.0.0.0/8", "192.168.1.10", "2001:db8::/32"},
+						BasicAuth:   &datamodel.GatewayBasicAuth{SecretStore: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/credentials"},
+					},
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "http://frontend:3000",
+							Security:    &datamodel.GatewaySecurity{IPDenyList: []string{"10.0.0.1"}},
+						},
+					},
+				},
+			},
+			resp: nil,
+		},
+		{
+			desc: "specify both IP allow list and deny list",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Security: &datamodel.GatewaySecurity{
+						IPAllowList: []string{"10.0.0.0/8"},
+						IPDenyList:  []string{"10.0.0.1"},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Only one of $.properties.security.ipAllowList and $.properties.security.ipDenyList can be specified at a time."),
+		},
+		{
+			desc: "invalid IP address",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Destination: "http://frontend:3000",
+							Security:    &datamodel.GatewaySecurity{IPDenyList: []string{"10.0.0.1", "10.0.0.0/33"}},
+						},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].security.ipDenyList[1] must be an IP address or a CIDR range such as '10.0.0.0/8'."),
+		},
+		{
+			desc: "basic auth without secretStore",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Security: &datamodel.GatewaySecurity{
+						BasicAuth: &datamodel.GatewayBasicAuth{Realm: "staging"},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.security.basicAuth.secretStore is required."),
+		},
+		{
+			desc: "security with SSL Passthrough",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						SSLPassthrough: true,
+					},
+					Security: &datamodel.GatewaySecurity{
+						IPAllowList: []string{"10.0.0.0/8"},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.security cannot be specified when $.properties.tls.sslPassthrough is true."),
+		},
 	}
 
 	for _, tc := range requestTests {
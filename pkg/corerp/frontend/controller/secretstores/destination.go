@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_aws "github.com/radius-project/radius/pkg/ucp/resources/aws"
+)
+
+const (
+	// keyVaultResourceType is the resource type of an Azure Key Vault destination.
+	keyVaultResourceType = "Microsoft.KeyVault/vaults"
+
+	// keyVaultSecretResourceType is the resource type of a secret in Azure Key Vault.
+	keyVaultSecretResourceType = "secrets"
+
+	// awsSecretResourceType is the resource type of a secret in AWS Secrets Manager.
+	awsSecretResourceType = "AWS.SecretsManager/Secret"
+)
+
+// invalidSecretNameChars matches the characters that cannot be used in the name of an external secret. Azure Key Vault
+// only allows alphanumerics and '-', which is also a valid subset for AWS Secrets Manager.
+var invalidSecretNameChars = regexp.MustCompile(`[^a-zA-Z0-9-]`)
+
+// DestinationClient is the client used to write secrets to an external secret manager. This is implemented by
+// processors.ResourceClient.
+type DestinationClient interface {
+	// CreateOrUpdate creates or updates a resource by id with the given properties.
+	CreateOrUpdate(ctx context.Context, id string, properties map[string]any) error
+}
+
+// NewSyncToDestination creates an update filter that writes the secret values of a secret store to the external secret
+// manager configured in $.properties.destination. This filter must run before UpsertSecret, which removes the secret
+// values from the resource before it is stored.
+//
+// Secrets written to the destination are not deleted when the secret store is deleted.
+func NewSyncToDestination(client DestinationClient) controller.UpdateFilter[datamodel.SecretStore] {
+	return func(ctx context.Context, newResource *datamodel.SecretStore, oldResource *datamodel.SecretStore, options *controller.Options) (rest.Response, error) {
+		values := map[string]string{}
+		for k, secret := range newResource.Properties.Data {
+			if val := to.String(secret.Value); val != "" {
+				values[k] = val
+			}
+		}
+
+		return syncToDestination(ctx, client, newResource, values)
+	}
+}
+
+// syncToDestination writes the given secret values, keyed by the keys of the secret store, to the external secret manager
+// configured in $.properties.destination of the secret store. This is a no-op when no destination is configured.
+func syncToDestination(ctx context.Context, client DestinationClient, resource *datamodel.SecretStore, values map[string]string) (rest.Response, error) {
+	destination := resource.Properties.Destination
+	if destination == nil {
+		return nil, nil
+	}
+
+	prefix := destination.Prefix
+	if prefix == "" {
+		prefix = resource.Name
+	}
+
+	// The destination is either a resource (Azure Key Vault) or a scope (AWS account and region).
+	id, err := resources.Parse(destination.Resource)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.destination.resource '%s' is not a valid resource id.", destination.Resource)), nil
+	}
+
+	for k, val := range values {
+		secretID, properties, err := makeDestinationSecret(id, secretName(prefix, k), val)
+		if err != nil {
+			return rest.NewBadRequestResponse(err.Error()), nil
+		}
+
+		if err := client.CreateOrUpdate(ctx, secretID, properties); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// makeDestinationSecret returns the resource ID and properties of the secret with the given name in the destination.
+func makeDestinationSecret(destination resources.ID, name string, value string) (string, map[string]any, error) {
+	if destination.IsResource() && strings.EqualFold(destination.Type(), keyVaultResourceType) {
+		id := destination.Append(resources.TypeSegment{Type: keyVaultSecretResourceType, Name: name})
+		return id.String(), map[string]any{"value": value}, nil
+	}
+
+	if destination.IsScope() && strings.HasPrefix(strings.ToLower(destination.PlaneNamespace()), "aws/") &&
+		destination.FindScope(resources_aws.ScopeAccounts) != "" && destination.FindScope(resources_aws.ScopeRegions) != "" {
+		id := resources.MakeUCPID(destination.ScopeSegments(), []resources.TypeSegment{{Type: awsSecretResourceType, Name: name}}, nil)
+		return id, map[string]any{"Name": name, "SecretString": value}, nil
+	}
+
+	return "", nil, fmt.Errorf("$.properties.destination.resource '%s' is not supported. It must be an Azure Key Vault or an AWS account and region scope.", destination.String())
+}
+
+// secretName returns the name of the external secret for the given key.
+func secretName(prefix string, key string) string {
+	return invalidSecretNameChars.ReplaceAllString(fmt.Sprintf("%s-%s", prefix, key), "-")
+}
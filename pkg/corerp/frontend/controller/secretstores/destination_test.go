@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKeyVaultID = "/planes/azure/azurecloud/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.KeyVault/vaults/vault0"
+	testAWSScope   = "/planes/aws/aws/accounts/000000000000/regions/us-west-2"
+)
+
+type fakeDestinationClient struct {
+	resources map[string]map[string]any
+	err       error
+}
+
+func (c *fakeDestinationClient) CreateOrUpdate(ctx context.Context, id string, properties map[string]any) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	c.resources[id] = properties
+	return nil
+}
+
+func TestSyncToDestination(t *testing.T) {
+	tests := []struct {
+		name        string
+		destination *datamodel.SecretStoreDestination
+		expected    map[string]map[string]any
+		response    string
+	}{
+		{
+			name:        "no destination",
+			destination: nil,
+			expected:    map[string]map[string]any{},
+		},
+		{
+			name:        "azure key vault",
+			destination: &datamodel.SecretStoreDestination{Resource: testKeyVaultID, Prefix: "myapp"},
+			expected: map[string]map[string]any{
+				testKeyVaultID + "/secrets/myapp-tls-crt": {"value": "test-certificate"},
+				testKeyVaultID + "/secrets/myapp-tls-key": {"value": "test-key"},
+			},
+		},
+		{
+			name:        "aws secrets manager with default prefix",
+			destination: &datamodel.SecretStoreDestination{Resource: testAWSScope},
+			expected: map[string]map[string]any{
+				testAWSScope + "/providers/AWS.SecretsManager/Secret/secret0-tls-crt": {"Name": "secret0-tls-crt", "SecretString": "test-certificate"},
+				testAWSScope + "/providers/AWS.SecretsManager/Secret/secret0-tls-key": {"Name": "secret0-tls-key", "SecretString": "test-key"},
+			},
+		},
+		{
+			name:        "invalid resource id",
+			destination: &datamodel.SecretStoreDestination{Resource: "vault0"},
+			response:    "$.properties.destination.resource 'vault0' is not a valid resource id.",
+		},
+		{
+			name:        "unsupported destination",
+			destination: &datamodel.SecretStoreDestination{Resource: testAppID},
+			response:    "$.properties.destination.resource '" + testAppID + "' is not supported. It must be an Azure Key Vault or an AWS account and region scope.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakeDestinationClient{resources: map[string]map[string]any{}}
+
+			newResource := testutil.MustGetTestData[datamodel.SecretStore](testFileCertValue)
+			newResource.Properties.Data["tls.crt"].Value = to.Ptr("test-certificate")
+			newResource.Properties.Data["tls.key"].Value = to.Ptr("test-key")
+			newResource.Properties.Destination = tc.destination
+
+			resp, err := NewSyncToDestination(client)(context.Background(), newResource, nil, &controller.Options{})
+			require.NoError(t, err)
+
+			if tc.response != "" {
+				r := resp.(*rest.BadRequestResponse)
+				require.Equal(t, tc.response, r.Body.Error.Message)
+				return
+			}
+
+			require.Nil(t, resp)
+			require.Equal(t, tc.expected, client.resources)
+
+			// Secret values are still present for UpsertSecret.
+			require.Equal(t, "test-key", to.String(newResource.Properties.Data["tls.key"].Value))
+		})
+	}
+
+	t.Run("create fails", func(t *testing.T) {
+		client := &fakeDestinationClient{err: errors.New("failed")}
+
+		newResource := testutil.MustGetTestData[datamodel.SecretStore](testFileCertValue)
+		newResource.Properties.Destination = &datamodel.SecretStoreDestination{Resource: testKeyVaultID}
+
+		_, err := NewSyncToDestination(client)(context.Background(), newResource, nil, &controller.Options{})
+		require.Error(t, err)
+	})
+}
+
+func TestSecretName(t *testing.T) {
+	require.Equal(t, "myapp-tls-crt", secretName("myapp", "tls.crt"))
+	require.Equal(t, "my-app-user-name", secretName("my_app", "user name"))
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+const (
+	// defaultGeneratedValueLength is the length of a generated secret value when the generator does not specify it.
+	defaultGeneratedValueLength = 32
+
+	// minGeneratedValueLength is the minimum length of a generated secret value.
+	minGeneratedValueLength = 8
+
+	// maxGeneratedValueLength is the maximum length of a generated secret value.
+	maxGeneratedValueLength = 1024
+
+	alphanumericCharacters = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	hexCharacters          = "0123456789abcdef"
+	symbolCharacters       = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+)
+
+// generatorCharacters maps the character sets of a generator to the characters of the generated values.
+var generatorCharacters = map[datamodel.SecretValueCharacterSet]string{
+	datamodel.SecretValueCharacterSetAlphanumeric: alphanumericCharacters,
+	datamodel.SecretValueCharacterSetHex:          hexCharacters,
+	datamodel.SecretValueCharacterSetSymbols:      alphanumericCharacters + symbolCharacters,
+}
+
+// getOrDefaultGenerator applies the default length and character set of a generator and validates them.
+func getOrDefaultGenerator(t datamodel.SecretType, e datamodel.SecretValueEncoding, g *datamodel.SecretValueGenerator) error {
+	if t == datamodel.SecretTypeCert {
+		return fmt.Errorf("%s type doesn't support generated values", datamodel.SecretTypeCert)
+	}
+
+	// Generated values are text, so they are stored like raw values.
+	if e != datamodel.SecretValueEncodingRaw {
+		return fmt.Errorf("generated values must use %s encoding", datamodel.SecretValueEncodingRaw)
+	}
+
+	if g.Length == 0 {
+		g.Length = defaultGeneratedValueLength
+	}
+	if g.Length < minGeneratedValueLength || g.Length > maxGeneratedValueLength {
+		return fmt.Errorf("length must be between %d and %d, got %d", minGeneratedValueLength, maxGeneratedValueLength, g.Length)
+	}
+
+	if g.CharacterSet == datamodel.SecretValueCharacterSetNone {
+		g.CharacterSet = datamodel.SecretValueCharacterSetAlphanumeric
+	}
+	if _, ok := generatorCharacters[g.CharacterSet]; !ok {
+		return fmt.Errorf("'%s' is not a valid character set", g.CharacterSet)
+	}
+
+	return nil
+}
+
+// generateSecretValue generates a random value with a cryptographically secure random number generator.
+func generateSecretValue(g *datamodel.SecretValueGenerator) (string, error) {
+	characters, ok := generatorCharacters[g.CharacterSet]
+	if !ok {
+		return "", fmt.Errorf("'%s' is not a valid character set", g.CharacterSet)
+	}
+
+	limit := big.NewInt(int64(len(characters)))
+	var sb strings.Builder
+	sb.Grow(int(g.Length))
+	for i := int32(0); i < g.Length; i++ {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate the secret value: %w", err)
+		}
+		sb.WriteByte(characters[n.Int64()])
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrDefaultGenerator(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        datamodel.SecretType
+		e        datamodel.SecretValueEncoding
+		in       datamodel.SecretValueGenerator
+		expected datamodel.SecretValueGenerator
+		err      string
+	}{
+		{
+			name:     "defaults",
+			t:        datamodel.SecretTypeGeneric,
+			e:        datamodel.SecretValueEncodingRaw,
+			expected: datamodel.SecretValueGenerator{Length: 32, CharacterSet: datamodel.SecretValueCharacterSetAlphanumeric},
+		},
+		{
+			name:     "custom",
+			t:        datamodel.SecretTypeBasicAuthentication,
+			e:        datamodel.SecretValueEncodingRaw,
+			in:       datamodel.SecretValueGenerator{Length: 64, CharacterSet: datamodel.SecretValueCharacterSetSymbols},
+			expected: datamodel.SecretValueGenerator{Length: 64, CharacterSet: datamodel.SecretValueCharacterSetSymbols},
+		},
+		{
+			name: "certificate type",
+			t:    datamodel.SecretTypeCert,
+			e:    datamodel.SecretValueEncodingBase64,
+			err:  "certificate type doesn't support generated values",
+		},
+		{
+			name: "too long",
+			t:    datamodel.SecretTypeGeneric,
+			e:    datamodel.SecretValueEncodingRaw,
+			in:   datamodel.SecretValueGenerator{Length: 2048},
+			err:  "length must be between 8 and 1024, got 2048",
+		},
+		{
+			name: "invalid character set",
+			t:    datamodel.SecretTypeGeneric,
+			e:    datamodel.SecretValueEncodingRaw,
+			in:   datamodel.SecretValueGenerator{CharacterSet: "emoji"},
+			err:  "'emoji' is not a valid character set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := tc.in
+			err := getOrDefaultGenerator(tc.t, tc.e, &g)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, g)
+		})
+	}
+}
+
+func TestGenerateSecretValue(t *testing.T) {
+	for characterSet, characters := range generatorCharacters {
+		t.Run(string(characterSet), func(t *testing.T) {
+			val, err := generateSecretValue(&datamodel.SecretValueGenerator{Length: 128, CharacterSet: characterSet})
+			require.NoError(t, err)
+			require.Len(t, val, 128)
+			for _, c := range val {
+				require.True(t, strings.ContainsRune(characters, c), "unexpected character %q", c)
+			}
+
+			other, err := generateSecretValue(&datamodel.SecretValueGenerator{Length: 128, CharacterSet: characterSet})
+			require.NoError(t, err)
+			require.NotEqual(t, val, other)
+		})
+	}
+
+	t.Run("invalid character set", func(t *testing.T) {
+		_, err := generateSecretValue(&datamodel.SecretValueGenerator{Length: 8, CharacterSet: "emoji"})
+		require.Error(t, err)
+	})
+}
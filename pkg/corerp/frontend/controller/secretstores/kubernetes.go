@@ -104,6 +104,11 @@ func ValidateAndMutateRequest(ctx context.Context, newResource *datamodel.Secret
 		}
 	}
 
+	oldData := map[string]*datamodel.SecretStoreDataValue{}
+	if oldResource != nil {
+		oldData = oldResource.Properties.Data
+	}
+
 	refResourceID := newResource.Properties.Resource
 	if _, _, err := fromResourceID(refResourceID); err != nil {
 		return nil, err
@@ -121,6 +126,23 @@ func ValidateAndMutateRequest(ctx context.Context, newResource *datamodel.Secret
 			return rest.NewBadRequestResponse(fmt.Sprintf("'%s' encoding is not valid: %q", k, err)), nil
 		}
 
+		if secret.Generator != nil {
+			if err := getOrDefaultGenerator(newResource.Properties.Type, secret.Encoding, secret.Generator); err != nil {
+				return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.data[%s].generator is not valid: %s", k, err.Error())), nil
+			}
+
+			// The value is generated only when the key is added, so that redeploying the secret store does not
+			// change its value. The value is then changed by rotating the secret store.
+			_, existing := oldData[k]
+			if secret.Value == nil && !existing {
+				val, err := generateSecretValue(secret.Generator)
+				if err != nil {
+					return nil, err
+				}
+				secret.Value = &val
+			}
+		}
+
 		if refResourceID == "" && secret.Value == nil {
 			return rest.NewBadRequestResponse(fmt.Sprintf("$.properties.data[%s].Value must be given to create the secret.", k)), nil
 		}
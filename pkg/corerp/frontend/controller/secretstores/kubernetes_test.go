@@ -343,6 +343,63 @@ func TestValidateAndMutateRequest(t *testing.T) {
 				require.True(t, r.Body.Error.Message == "$.properties.data must contain 'password' key for basicAuthentication type.")
 			},
 		},
+		{
+			name:     "new resource generates value",
+			testFile: testFileGenericValue,
+			modifyResource: func(newResource, oldResource *datamodel.SecretStore) {
+				newResource.Properties.Data["password"] = &datamodel.SecretStoreDataValue{
+					Generator: &datamodel.SecretValueGenerator{},
+				}
+			},
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				require.Nil(t, resp)
+				password := newResource.Properties.Data["password"]
+				require.Equal(t, datamodel.SecretValueEncodingRaw, password.Encoding)
+				require.Equal(t, &datamodel.SecretValueGenerator{Length: 32, CharacterSet: datamodel.SecretValueCharacterSetAlphanumeric}, password.Generator)
+				require.Len(t, *password.Value, 32)
+			},
+		},
+		{
+			name:        "update the existing resource does not regenerate value",
+			testFile:    testFileGenericValue,
+			oldResource: testutil.MustGetTestData[datamodel.SecretStore](testFileGenericValue),
+			modifyResource: func(newResource, oldResource *datamodel.SecretStore) {
+				oldResource.Properties.Resource = "default/secret0"
+				newResource.Properties.Data["tls.crt"] = &datamodel.SecretStoreDataValue{
+					Generator: &datamodel.SecretValueGenerator{Length: 16, CharacterSet: datamodel.SecretValueCharacterSetHex},
+				}
+			},
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				require.Nil(t, resp)
+				require.Nil(t, newResource.Properties.Data["tls.crt"].Value)
+			},
+		},
+		{
+			name:     "generator with base64 encoding",
+			testFile: testFileGenericValue,
+			modifyResource: func(newResource, oldResource *datamodel.SecretStore) {
+				newResource.Properties.Data["tls.key"].Generator = &datamodel.SecretValueGenerator{}
+			},
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				r := resp.(*rest.BadRequestResponse)
+				require.Equal(t, "$.properties.data[tls.key].generator is not valid: generated values must use raw encoding", r.Body.Error.Message)
+			},
+		},
+		{
+			name:     "generator with invalid length",
+			testFile: testFileGenericValue,
+			modifyResource: func(newResource, oldResource *datamodel.SecretStore) {
+				newResource.Properties.Data["tls.crt"].Generator = &datamodel.SecretValueGenerator{Length: 4}
+			},
+			assertions: func(t *testing.T, resp rest.Response, err error, newResource, oldResource *datamodel.SecretStore) {
+				require.NoError(t, err)
+				r := resp.(*rest.BadRequestResponse)
+				require.Equal(t, "$.properties.data[tls.crt].generator is not valid: length must be between 8 and 1024, got 4", r.Body.Error.Message)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ListSecretReferences is the controller implementing the listSecretReferences custom action for Applications.Core/secretStores.
+type ListSecretReferences struct {
+	ctrl.Operation[*datamodel.SecretStore, datamodel.SecretStore]
+}
+
+// NewListSecretReferences creates a new controller for listing the secrets provided by the secret store.
+func NewListSecretReferences(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListSecretReferences{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.SecretStore]{
+				RequestConverter:  converter.SecretStoreModelFromVersioned,
+				ResponseConverter: converter.SecretStoreModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run returns the keys of the secret store and the secret they are read from. Unlike listSecrets, the values of the
+// secrets are never read, so this action does not require access to the secret.
+func (l *ListSecretReferences) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := l.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	return rest.NewOKResponse(listSecretReferences(resource)), nil
+}
+
+// listSecretReferences builds the secret references of a secret store. The keys are read from the referenced secret
+// when $.properties.resource is set, otherwise from the secret managed by the secret store.
+func listSecretReferences(resource *datamodel.SecretStore) *corerpv20231001preview.ListSecretReferencesResult {
+	source := resource.Properties.Resource
+	if source == "" {
+		source = resource.ID
+	}
+
+	references := []*corerpv20231001preview.SecretReferenceMetadata{}
+	for k := range resource.Properties.Data {
+		references = append(references, &corerpv20231001preview.SecretReferenceMetadata{
+			Name:   to.Ptr(k),
+			Source: to.Ptr(source),
+			Kind:   to.Ptr(corerpv20231001preview.SecretReferenceKindData),
+		})
+	}
+
+	// Produce a stable output
+	sort.Slice(references, func(i, j int) bool {
+		return *references[i].Name < *references[j].Name
+	})
+
+	return &corerpv20231001preview.ListSecretReferencesResult{Value: references}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListSecretReferences_20231001Preview(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+	req, err := rpctest.NewHTTPRequestWithContent(
+		context.Background(),
+		v1.OperationPost.HTTPMethod(),
+		"http://localhost:8080/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/secret0/listsecretreferences?api-version=2023-10-01-preview", nil)
+	require.NoError(t, err)
+
+	t.Run("not found the resource", func(t *testing.T) {
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{})
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListSecretReferences(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 404, w.Result().StatusCode)
+	})
+
+	t.Run("return secret references without values", func(t *testing.T) {
+		secretdm := testutil.MustGetTestData[datamodel.SecretStore](testFileCertValueFrom)
+		databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return &database.Object{
+					Metadata: database.Metadata{ID: id, ETag: "etag"},
+					Data:     secretdm,
+				}, nil
+			})
+		ctx := rpctest.NewARMRequestContext(req)
+
+		// No Kubernetes client is configured, the referenced secret is never read.
+		ctl, err := NewListSecretReferences(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.ListSecretReferencesResult{}
+		err = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.NoError(t, err)
+
+		expected := &v20231001preview.ListSecretReferencesResult{
+			Value: []*v20231001preview.SecretReferenceMetadata{
+				{
+					Name:   to.Ptr("tls.crt"),
+					Source: to.Ptr("default/letsencrypt-prod"),
+					Kind:   to.Ptr(v20231001preview.SecretReferenceKindData),
+				},
+				{
+					Name:   to.Ptr("tls.key"),
+					Source: to.Ptr("default/letsencrypt-prod"),
+					Kind:   to.Ptr(v20231001preview.SecretReferenceKindData),
+				},
+			},
+		}
+		require.Equal(t, expected, actualOutput)
+	})
+}
+
+func Test_listSecretReferences_ManagedSecret(t *testing.T) {
+	secretdm := testutil.MustGetTestData[datamodel.SecretStore](testFileCertValue)
+
+	result := listSecretReferences(secretdm)
+	require.NotEmpty(t, result.Value)
+	for _, reference := range result.Value {
+		require.Equal(t, secretdm.ID, *reference.Source)
+		require.Nil(t, reference.Target)
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ ctrl.Controller = (*RotateSecrets)(nil)
+
+// RotateSecrets is the controller implementing the rotate custom action for Applications.Core/secretStores.
+type RotateSecrets struct {
+	ctrl.Operation[*datamodel.SecretStore, datamodel.SecretStore]
+
+	destinationClient DestinationClient
+}
+
+// NewRotateSecrets creates a new controller for rotating the secrets of the secret store. The client is used to write
+// the rotated secrets to the destination of the secret store.
+func NewRotateSecrets(opts ctrl.Options, client DestinationClient) (ctrl.Controller, error) {
+	return &RotateSecrets{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.SecretStore]{
+				RequestConverter:  converter.SecretStoreModelFromVersioned,
+				ResponseConverter: converter.SecretStoreModelToVersioned,
+			},
+		),
+		client,
+	}, nil
+}
+
+// Run generates new values for the requested keys of the secret store, writes them to the Kubernetes secret and to the
+// destination of the secret store, and restarts the containers that reference the secret store in their environment
+// variables so that they use the new values.
+func (r *RotateSecrets) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	rotateRequest, err := converter.SecretStoreRotateRequestFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := rotationKeys(resource, rotateRequest.Keys)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	ksecret, err := getSecretFromOutputResources(resource.Properties.Status.OutputResources, r.Options())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret from output resource: %w", err)
+	}
+
+	if ksecret == nil {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("The secret '%s' of the secret store was not found.", resource.Properties.Resource)), nil
+	}
+
+	values := map[string]string{}
+	for _, k := range keys {
+		val, err := generateSecretValue(resource.Properties.Data[k].Generator)
+		if err != nil {
+			return nil, err
+		}
+		values[k] = val
+
+		// Generated values use raw encoding and Kubernetes secret data expects base64 encoded value.
+		ksecret.Data[k] = []byte(base64.StdEncoding.EncodeToString([]byte(val)))
+	}
+
+	if err := r.Options().KubeClient.Update(ctx, ksecret); err != nil {
+		return nil, err
+	}
+
+	resp, err := syncToDestination(ctx, r.destinationClient, resource, values)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	restarted, err := r.restartContainers(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &datamodel.SecretStoreRotateResult{
+		RotatedKeys:         keys,
+		RestartedContainers: restarted,
+	}
+
+	versioned, err := converter.SecretStoreRotateResultToVersioned(result, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
+
+// rotationKeys returns the sorted keys to rotate. When no keys are requested, all the keys that have a generator are
+// rotated.
+func rotationKeys(resource *datamodel.SecretStore, requested []string) ([]string, error) {
+	keys := []string{}
+	if len(requested) == 0 {
+		for k, secret := range resource.Properties.Data {
+			if secret.Generator != nil {
+				keys = append(keys, k)
+			}
+		}
+
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("secret store '%s' does not have keys with a generator.", resource.Name)
+		}
+	} else {
+		for _, k := range requested {
+			secret, ok := resource.Properties.Data[k]
+			if !ok {
+				return nil, fmt.Errorf("secret store '%s' does not have key '%s'.", resource.Name, k)
+			}
+			if secret.Generator == nil {
+				return nil, fmt.Errorf("key '%s' of secret store '%s' does not have a generator.", k, resource.Name)
+			}
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// restartContainers triggers a rolling restart of the Deployments of the containers that reference the secret store in
+// their environment variables, and returns the sorted IDs of the restarted containers.
+func (r *RotateSecrets) restartContainers(ctx context.Context, secretStoreID resources.ID) ([]string, error) {
+	// Containers can reference a secret store in a different resource group, so query the whole plane.
+	result, err := r.DatabaseClient().Query(ctx, database.Query{
+		RootScope:      secretStoreID.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.ContainerResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	restartedAt := time.Now().UTC().Format(time.RFC3339)
+	restarted := []string{}
+	for _, item := range result.Items {
+		container := &datamodel.ContainerResource{}
+		if err := item.As(container); err != nil {
+			return nil, err
+		}
+
+		if !referencesSecretStore(container, secretStoreID.String()) {
+			continue
+		}
+
+		ok, err := r.restartDeployments(ctx, container.Properties.Status.OutputResources, restartedAt)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			restarted = append(restarted, container.ID)
+		}
+	}
+
+	sort.Strings(restarted)
+	return restarted, nil
+}
+
+// restartDeployments sets the restart annotation on the pod template of the Deployments in the output resources. It
+// returns false when none of the Deployments exist.
+func (r *RotateSecrets) restartDeployments(ctx context.Context, outputResources []rpv1.OutputResource, restartedAt string) (bool, error) {
+	restarted := false
+	for _, outputResource := range outputResources {
+		if outputResource.LocalID != rpv1.LocalIDDeployment {
+			continue
+		}
+
+		_, _, ns, name := resources_kubernetes.ToParts(outputResource.ID)
+		deployment := &appsv1.Deployment{}
+		err := r.Options().KubeClient.Get(ctx, runtimeclient.ObjectKey{Namespace: ns, Name: name}, deployment)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+
+		patch := runtimeclient.MergeFrom(deployment.DeepCopy())
+		if deployment.Spec.Template.Annotations == nil {
+			deployment.Spec.Template.Annotations = map[string]string{}
+		}
+		deployment.Spec.Template.Annotations[kubernetes.AnnotationRestartedAt] = restartedAt
+		if err := r.Options().KubeClient.Patch(ctx, deployment, patch); err != nil {
+			return false, err
+		}
+		restarted = true
+	}
+
+	return restarted, nil
+}
+
+// referencesSecretStore returns true if an environment variable of the container is read from the secret store.
+func referencesSecretStore(container *datamodel.ContainerResource, secretStoreID string) bool {
+	for _, env := range container.Properties.Container.Env {
+		if env.ValueFrom == nil || env.ValueFrom.SecretRef == nil {
+			continue
+		}
+
+		if strings.EqualFold(env.ValueFrom.SecretRef.Source, secretStoreID) {
+			return true
+		}
+	}
+
+	return false
+}
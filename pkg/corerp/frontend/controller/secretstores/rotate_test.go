@@ -0,0 +1,274 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/test/k8sutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testRotateSecretStoreID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/secret0"
+	testRotateURL           = "http://localhost:8080" + testRotateSecretStoreID + "/rotate?api-version=2023-10-01-preview"
+)
+
+func makeRotateSecretStore() *datamodel.SecretStore {
+	return &datamodel.SecretStore{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   testRotateSecretStoreID,
+				Name: "secret0",
+				Type: ResourceTypeName,
+			},
+		},
+		Properties: &datamodel.SecretStoreProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Status: rpv1.ResourceStatus{
+					OutputResources: []rpv1.OutputResource{
+						{
+							LocalID: rpv1.LocalIDSecret,
+							ID:      resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", resources_kubernetes.KindSecret, "default", "secret0"),
+						},
+					},
+				},
+			},
+			Type:     datamodel.SecretTypeGeneric,
+			Resource: "default/secret0",
+			Data: map[string]*datamodel.SecretStoreDataValue{
+				"username": {Encoding: datamodel.SecretValueEncodingRaw},
+				"password": {
+					Encoding:  datamodel.SecretValueEncodingRaw,
+					Generator: &datamodel.SecretValueGenerator{Length: 16, CharacterSet: datamodel.SecretValueCharacterSetHex},
+				},
+			},
+		},
+	}
+}
+
+func makeRotateContainer(name string, source string) database.Object {
+	container := &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/" + name,
+				Name: name,
+				Type: datamodel.ContainerResourceType,
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Status: rpv1.ResourceStatus{
+					OutputResources: []rpv1.OutputResource{
+						{
+							LocalID: rpv1.LocalIDDeployment,
+							ID:      resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "apps", resources_kubernetes.KindDeployment, "default", name),
+						},
+					},
+				},
+			},
+			Container: datamodel.Container{
+				Env: map[string]datamodel.EnvironmentVariable{
+					"PASSWORD": {
+						ValueFrom: &datamodel.EnvironmentVariableReference{
+							SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: source, Key: "password"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return *rpctest.FakeStoreObject(container)
+}
+
+func makeRotateObjects() []runtimeclient.Object {
+	return []runtimeclient.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret0", Namespace: "default"},
+			Data: map[string][]byte{
+				"username": []byte(base64.StdEncoding.EncodeToString([]byte("admin"))),
+				"password": []byte(base64.StdEncoding.EncodeToString([]byte("old-password"))),
+			},
+		},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "default"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "default"}},
+	}
+}
+
+func runRotate(t *testing.T, opts ctrl.Options, client DestinationClient, body string) *httptest.ResponseRecorder {
+	req, err := rpctest.NewHTTPRequestWithContent(context.Background(), v1.OperationPost.HTTPMethod(), testRotateURL, []byte(body))
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	ctl, err := NewRotateSecrets(opts, client)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	_ = resp.Apply(ctx, w, req)
+	return w
+}
+
+func TestRotateSecrets_20231001Preview(t *testing.T) {
+	t.Run("not found the resource", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{})
+
+		w := runRotate(t, ctrl.Options{DatabaseClient: databaseClient}, nil, "{}")
+		require.Equal(t, 404, w.Result().StatusCode)
+	})
+
+	t.Run("rotate secrets and restart containers", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(rpctest.FakeStoreObject(makeRotateSecretStore()), nil)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+				require.Equal(t, "/planes/radius/local", query.RootScope)
+				require.True(t, query.ScopeRecursive)
+				require.Equal(t, datamodel.ContainerResourceType, query.ResourceType)
+
+				return &database.ObjectQueryResult{
+					Items: []database.Object{
+						makeRotateContainer("worker", "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/secretstores/secret0"),
+						makeRotateContainer("frontend", testRotateSecretStoreID),
+						makeRotateContainer("other", "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/other"),
+					},
+				}, nil
+			})
+
+		kubeClient := k8sutil.NewFakeKubeClient(nil, makeRotateObjects()...)
+		w := runRotate(t, ctrl.Options{DatabaseClient: databaseClient, KubeClient: kubeClient}, nil, "{}")
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actual := &v20231001preview.SecretStoreRotateResult{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, []*string{to.Ptr("password")}, actual.RotatedKeys)
+		require.Equal(t, []*string{
+			to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/frontend"),
+			to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/worker"),
+		}, actual.RestartedContainers)
+
+		ksecret := &corev1.Secret{}
+		require.NoError(t, kubeClient.Get(context.Background(), runtimeclient.ObjectKey{Namespace: "default", Name: "secret0"}, ksecret))
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte("admin")), string(ksecret.Data["username"]))
+		password, err := base64.StdEncoding.DecodeString(string(ksecret.Data["password"]))
+		require.NoError(t, err)
+		require.Len(t, password, 16)
+		require.NotEqual(t, "old-password", string(password))
+
+		for _, name := range []string{"frontend", "worker"} {
+			deployment := &appsv1.Deployment{}
+			require.NoError(t, kubeClient.Get(context.Background(), runtimeclient.ObjectKey{Namespace: "default", Name: name}, deployment))
+			require.NotEmpty(t, deployment.Spec.Template.Annotations[kubernetes.AnnotationRestartedAt])
+		}
+	})
+
+	t.Run("sync rotated secrets to destination", func(t *testing.T) {
+		secretStore := makeRotateSecretStore()
+		secretStore.Properties.Destination = &datamodel.SecretStoreDestination{Resource: testKeyVaultID}
+
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(rpctest.FakeStoreObject(secretStore), nil)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&database.ObjectQueryResult{}, nil)
+
+		kubeClient := k8sutil.NewFakeKubeClient(nil, makeRotateObjects()...)
+		destinationClient := &fakeDestinationClient{resources: map[string]map[string]any{}}
+		w := runRotate(t, ctrl.Options{DatabaseClient: databaseClient, KubeClient: kubeClient}, destinationClient, `{"keys": ["password"]}`)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		ksecret := &corev1.Secret{}
+		require.NoError(t, kubeClient.Get(context.Background(), runtimeclient.ObjectKey{Namespace: "default", Name: "secret0"}, ksecret))
+		password, err := base64.StdEncoding.DecodeString(string(ksecret.Data["password"]))
+		require.NoError(t, err)
+		require.Equal(t, map[string]map[string]any{
+			testKeyVaultID + "/secrets/secret0-password": {"value": string(password)},
+		}, destinationClient.resources)
+	})
+
+	t.Run("key without generator", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(mctrl)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(rpctest.FakeStoreObject(makeRotateSecretStore()), nil)
+
+		w := runRotate(t, ctrl.Options{DatabaseClient: databaseClient}, nil, `{"keys": ["username"]}`)
+		require.Equal(t, 400, w.Result().StatusCode)
+
+		actual := &v1.ErrorResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+		require.Equal(t, "key 'username' of secret store 'secret0' does not have a generator.", actual.Error.Message)
+	})
+}
+
+func TestRotationKeys(t *testing.T) {
+	t.Run("all keys with a generator", func(t *testing.T) {
+		secretStore := makeRotateSecretStore()
+		secretStore.Properties.Data["token"] = &datamodel.SecretStoreDataValue{Generator: &datamodel.SecretValueGenerator{}}
+
+		keys, err := rotationKeys(secretStore, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"password", "token"}, keys)
+	})
+
+	t.Run("no keys with a generator", func(t *testing.T) {
+		secretStore := makeRotateSecretStore()
+		delete(secretStore.Properties.Data, "password")
+
+		_, err := rotationKeys(secretStore, nil)
+		require.EqualError(t, err, "secret store 'secret0' does not have keys with a generator.")
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := rotationKeys(makeRotateSecretStore(), []string{"token"})
+		require.EqualError(t, err, "secret store 'secret0' does not have key 'token'.")
+	})
+}
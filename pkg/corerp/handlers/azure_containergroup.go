@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/radius-project/radius/pkg/azure/armauth"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/logging"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// ContainerGroupAPIVersion is the API version of Microsoft.ContainerInstance/containerGroups used to deploy
+	// container groups.
+	ContainerGroupAPIVersion = "2023-05-01"
+
+	// ContainerGroupIPAddressKey is the key of the public IP address of the container group in the properties
+	// returned by the handler.
+	ContainerGroupIPAddressKey = "containergroupipaddress"
+)
+
+// NewAzureContainerGroupHandler creates a new ResourceHandler for Azure Container Instances container groups.
+func NewAzureContainerGroupHandler(arm *armauth.ArmConfig) ResourceHandler {
+	return &azureContainerGroupHandler{arm: arm}
+}
+
+type azureContainerGroupHandler struct {
+	arm *armauth.ArmConfig
+}
+
+// Put creates or updates a container group and waits for the deployment to complete. The container group is deployed
+// to the location of its resource group when the rendered resource does not specify a location.
+func (handler *azureContainerGroupHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	containerGroup, ok := options.Resource.CreateResource.Data.(*armresources.GenericResource)
+	if !ok {
+		return nil, fmt.Errorf("invalid required properties for resource")
+	}
+
+	id := options.Resource.ID
+	subscriptionID := id.FindScope(resources_azure.ScopeSubscriptions)
+	if containerGroup.Location == nil {
+		location, err := clientv2.GetResourceGroupLocation(ctx, subscriptionID, id.FindScope(resources_azure.ScopeResourceGroups), &handler.arm.ClientOptions)
+		if err != nil {
+			return nil, err
+		}
+		containerGroup.Location = location
+	}
+
+	client, err := clientv2.NewGenericResourceClient(subscriptionID, &handler.arm.ClientOptions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	poller, err := client.BeginCreateOrUpdateByID(ctx, id.String(), ContainerGroupAPIVersion, *containerGroup, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create container group: %w", err)
+	}
+
+	resp, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create container group: %w", err)
+	}
+
+	properties := map[string]string{}
+	if ip := containerGroupIPAddress(resp.GenericResource); ip != "" {
+		properties[ContainerGroupIPAddressKey] = ip
+	}
+
+	logger.Info("Created container group", logging.LogFieldLocalID, rpv1.LocalIDContainerGroup, "id", id.String())
+	return properties, nil
+}
+
+// Delete deletes a container group and waits for the deletion to complete.
+func (handler *azureContainerGroupHandler) Delete(ctx context.Context, options *DeleteOptions) error {
+	id := options.Resource.ID
+	client, err := clientv2.NewGenericResourceClient(id.FindScope(resources_azure.ScopeSubscriptions), &handler.arm.ClientOptions, nil)
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginDeleteByID(ctx, id.String(), ContainerGroupAPIVersion, nil)
+	if clientv2.Is404Error(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to delete container group: %w", err)
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	if err != nil && !clientv2.Is404Error(err) {
+		return fmt.Errorf("failed to delete container group: %w", err)
+	}
+
+	return nil
+}
+
+// containerGroupIPAddress returns the public IP address of a container group, or an empty string if the container
+// group does not have a public IP address.
+func containerGroupIPAddress(resource armresources.GenericResource) string {
+	properties, ok := resource.Properties.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	ipAddress, ok := properties["ipAddress"].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	ip, _ := ipAddress["ip"].(string)
+	return ip
+}
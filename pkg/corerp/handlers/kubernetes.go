@@ -18,6 +18,8 @@ package handlers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -31,6 +33,8 @@ import (
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -97,16 +101,42 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		ResourceName:            item.GetName(),
 	}
 
-	err = kubeutil.PatchNamespace(ctx, handler.client, item.GetNamespace())
+	// Record the hash of the rendered object with the object, so that unchanged objects are not applied again
+	// on every deployment unless the live object has drifted.
+	hash, err := hashRenderedObject(&item)
 	if err != nil {
 		return nil, err
 	}
 
-	err = handler.client.Patch(ctx, &item, client.Apply, &client.PatchOptions{FieldManager: kubernetes.FieldManager})
+	annotations := item.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[kubernetes.AnnotationRenderedHash] = hash
+	item.SetAnnotations(annotations)
+
+	changed, err := handler.isChanged(ctx, &item, hash)
 	if err != nil {
 		return nil, err
 	}
 
+	if changed {
+		// Cluster scoped resources, such as cluster role bindings, have no namespace.
+		if item.GetNamespace() != "" {
+			err = kubeutil.PatchNamespace(ctx, handler.client, item.GetNamespace())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = handler.client.Patch(ctx, &item, client.Apply, &client.PatchOptions{FieldManager: kubernetes.FieldManager})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		logger.Info(fmt.Sprintf("Skipping apply of unchanged %s %s in namespace %s", item.GetKind(), item.GetName(), item.GetNamespace()))
+	}
+
 	groupVersion, err := schema.ParseGroupVersion(item.GetAPIVersion())
 	if err != nil {
 		return nil, err
@@ -143,6 +173,62 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 	}
 }
 
+// isChanged returns true if the rendered object needs to be applied. The rendered hash recorded with the live object
+// is only a hint: the objects which are not found, have no hash or have a different hash are applied, and the objects
+// with the same hash are compared with the result of a server-side dry-run apply of the rendered object, so that the
+// changes made to the live object outside of Radius are still reverted.
+//
+// Readiness of the object is still monitored when it is unchanged, since the previous deployment may have failed.
+func (handler *kubernetesHandler) isChanged(ctx context.Context, item *unstructured.Unstructured, hash string) (bool, error) {
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(item.GroupVersionKind())
+	err := handler.client.Get(ctx, client.ObjectKeyFromObject(item), live)
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	// Applying the objects without the hash, such as the objects applied before the hash was recorded, records it.
+	if live.GetAnnotations()[kubernetes.AnnotationRenderedHash] != hash {
+		return true, nil
+	}
+
+	dryRun := item.DeepCopy()
+	err = handler.client.Patch(ctx, dryRun, client.Apply, &client.PatchOptions{FieldManager: kubernetes.FieldManager, DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		return false, err
+	}
+
+	return !equality.Semantic.DeepEqual(comparableObject(dryRun), comparableObject(live)), nil
+}
+
+// hashRenderedObject hashes the rendered object to produce a deterministic hash.
+func hashRenderedObject(item *unstructured.Unstructured) (string, error) {
+	// Maps are marshalled with sorted keys.
+	b, err := json.Marshal(item.Object)
+	if err != nil {
+		return "", fmt.Errorf("could not hash object %s %s: %w", item.GetKind(), item.GetName(), err)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
+
+// comparableObject returns the content of the object without the fields populated by the server and the rendered hash.
+func comparableObject(item *unstructured.Unstructured) map[string]any {
+	obj := item.DeepCopy()
+	for _, field := range []string{"resourceVersion", "managedFields", "generation", "creationTimestamp", "uid"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", kubernetes.AnnotationRenderedHash)
+	if len(obj.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	return obj.Object
+}
+
 // Delete decodes the identity data from the DeleteOptions, creates an unstructured object from the identity data,
 // and then attempts to delete the object from the Kubernetes cluster, returning an error if one occurs.
 func (handler *kubernetesHandler) Delete(ctx context.Context, options *DeleteOptions) error {
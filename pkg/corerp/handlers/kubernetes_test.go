@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
@@ -29,10 +30,12 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestPut(t *testing.T) {
@@ -90,6 +93,34 @@ func TestPut(t *testing.T) {
 				"resourcename":         "test-deployment",
 			},
 		},
+		{
+			name: "cluster scoped resource",
+			in: &PutOptions{
+				Resource: &rpv1.OutputResource{
+					CreateResource: &rpv1.Resource{
+						ResourceType: resourcemodel.ResourceType{
+							Provider: resourcemodel.ProviderKubernetes,
+							Type:     "rbac.authorization.k8s.io/ClusterRoleBinding",
+						},
+						Data: &rbacv1.ClusterRoleBinding{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "ClusterRoleBinding",
+								APIVersion: "rbac.authorization.k8s.io/v1",
+							},
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "test-cluster-role-binding",
+							},
+						},
+					},
+				},
+			},
+			out: map[string]string{
+				"kubernetesapiversion": "rbac.authorization.k8s.io/v1",
+				"kuberneteskind":       "ClusterRoleBinding",
+				"kubernetesnamespace":  "",
+				"resourcename":         "test-cluster-role-binding",
+			},
+		},
 	}
 
 	for _, tc := range putTests {
@@ -120,6 +151,108 @@ func TestPut(t *testing.T) {
 	}
 }
 
+func TestPut_RenderedHash(t *testing.T) {
+	newSecret := func(value string) *corev1.Secret {
+		return &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-secret",
+				Namespace: "test-namespace",
+			},
+			Data: map[string][]byte{
+				"key": []byte(value),
+			},
+		}
+	}
+
+	newPutOptions := func(secret *corev1.Secret) *PutOptions {
+		return &PutOptions{
+			Resource: &rpv1.OutputResource{
+				CreateResource: &rpv1.Resource{
+					ResourceType: resourcemodel.ResourceType{
+						Provider: resourcemodel.ProviderKubernetes,
+						Type:     "core/Secret",
+					},
+					Data: secret,
+				},
+			},
+		}
+	}
+
+	getLive := func(t *testing.T, handler *kubernetesHandler) *corev1.Secret {
+		live := &corev1.Secret{}
+		err := handler.client.Get(context.Background(), client.ObjectKey{Namespace: "test-namespace", Name: "test-secret"}, live)
+		require.NoError(t, err)
+		return live
+	}
+
+	t.Run("unchanged resource is not applied again", func(t *testing.T) {
+		handler := &kubernetesHandler{client: k8sutil.NewFakeKubeClient(nil)}
+
+		_, err := handler.Put(context.Background(), newPutOptions(newSecret("value")))
+		require.NoError(t, err)
+		live := getLive(t, handler)
+		require.NotEmpty(t, live.Annotations[kubernetes.AnnotationRenderedHash])
+
+		_, err = handler.Put(context.Background(), newPutOptions(newSecret("value")))
+		require.NoError(t, err)
+		require.Equal(t, live.ResourceVersion, getLive(t, handler).ResourceVersion)
+	})
+
+	t.Run("changed resource is applied", func(t *testing.T) {
+		handler := &kubernetesHandler{client: k8sutil.NewFakeKubeClient(nil)}
+
+		_, err := handler.Put(context.Background(), newPutOptions(newSecret("value")))
+		require.NoError(t, err)
+		live := getLive(t, handler)
+
+		_, err = handler.Put(context.Background(), newPutOptions(newSecret("changed")))
+		require.NoError(t, err)
+		updated := getLive(t, handler)
+		require.NotEqual(t, live.ResourceVersion, updated.ResourceVersion)
+		require.NotEqual(t, live.Annotations[kubernetes.AnnotationRenderedHash], updated.Annotations[kubernetes.AnnotationRenderedHash])
+		require.Equal(t, []byte("changed"), updated.Data["key"])
+	})
+
+	t.Run("drifted resource is applied", func(t *testing.T) {
+		handler := &kubernetesHandler{client: k8sutil.NewFakeKubeClient(nil)}
+
+		_, err := handler.Put(context.Background(), newPutOptions(newSecret("value")))
+		require.NoError(t, err)
+		live := getLive(t, handler)
+
+		live.Data["key"] = []byte("edited")
+		err = handler.client.Update(context.Background(), live)
+		require.NoError(t, err)
+		edited := getLive(t, handler)
+
+		_, err = handler.Put(context.Background(), newPutOptions(newSecret("value")))
+		require.NoError(t, err)
+		reverted := getLive(t, handler)
+		require.NotEqual(t, edited.ResourceVersion, reverted.ResourceVersion)
+		require.Equal(t, []byte("value"), reverted.Data["key"])
+	})
+
+	t.Run("resource without hash is applied to record the hash", func(t *testing.T) {
+		handler := &kubernetesHandler{client: k8sutil.NewFakeKubeClient(nil, newSecret("value"))}
+		live := getLive(t, handler)
+		require.Empty(t, live.Annotations[kubernetes.AnnotationRenderedHash])
+
+		_, err := handler.Put(context.Background(), newPutOptions(newSecret("value")))
+		require.NoError(t, err)
+		recorded := getLive(t, handler)
+		require.NotEmpty(t, recorded.Annotations[kubernetes.AnnotationRenderedHash])
+		require.Equal(t, []byte("value"), recorded.Data["key"])
+
+		_, err = handler.Put(context.Background(), newPutOptions(newSecret("value")))
+		require.NoError(t, err)
+		require.Equal(t, recorded.ResourceVersion, getLive(t, handler).ResourceVersion)
+	})
+}
+
 func TestDelete(t *testing.T) {
 	ctx := context.Background()
 	// Create first deployment that will be watched
@@ -23,12 +23,14 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/handlers"
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
+	"github.com/radius-project/radius/pkg/corerp/renderers/container/aci"
 	azcontainer "github.com/radius-project/radius/pkg/corerp/renderers/container/azure"
 	"github.com/radius-project/radius/pkg/corerp/renderers/daprextension"
 	"github.com/radius-project/radius/pkg/corerp/renderers/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/kubernetesmetadata"
 	"github.com/radius-project/radius/pkg/corerp/renderers/manualscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/volume"
+	kubeutil "github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
@@ -48,7 +50,7 @@ const (
 
 // NewApplicationModel configures RBAC support on connections based on connection kind, configures the providers supported by the appmodel,
 // registers the renderers and handlers for various resources, and checks for duplicate registrations.
-func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sClientSet kubernetes.Interface, discoveryClient discovery.ServerResourcesInterface, k8sDynamicClientSet dynamic.Interface) (ApplicationModel, error) {
+func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sClientSet kubernetes.Interface, discoveryClient discovery.ServerResourcesInterface, k8sDynamicClientSet dynamic.Interface, connectionAgentImage string, naming kubeutil.NamingStrategy) (ApplicationModel, error) {
 	// Configure RBAC support on connections based connection kind.
 	// Role names can be user input or default roles assigned by Radius.
 	// Leave RoleNames field empty if no default roles are supported for a connection kind.
@@ -86,11 +88,14 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 	radiusResourceModel := []RadiusResourceModel{
 		{
 			ResourceType: container.ResourceType,
-			Renderer: &kubernetesmetadata.Renderer{
-				Inner: &manualscale.Renderer{
-					Inner: &daprextension.Renderer{
-						Inner: &container.Renderer{
-							RoleAssignmentMap: roleAssignmentMap,
+			Renderer: &aci.Renderer{
+				Inner: &kubernetesmetadata.Renderer{
+					Inner: &manualscale.Renderer{
+						Inner: &daprextension.Renderer{
+							Inner: &container.Renderer{
+								RoleAssignmentMap:    roleAssignmentMap,
+								ConnectionAgentImage: connectionAgentImage,
+							},
 						},
 					},
 				},
@@ -98,7 +103,7 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 		},
 		{
 			ResourceType: gateway.ResourceType,
-			Renderer:     &gateway.Renderer{},
+			Renderer:     &gateway.Renderer{NamingStrategy: naming},
 		},
 		{
 			ResourceType: volume.ResourceType,
@@ -154,6 +159,13 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 			},
 			ResourceHandler: handlers.NewAzureRoleAssignmentHandler(arm),
 		},
+		{
+			ResourceType: resourcemodel.ResourceType{
+				Type:     resources_azure.ResourceTypeContainerInstanceContainerGroup,
+				Provider: resourcemodel.ProviderAzure,
+			},
+			ResourceHandler: handlers.NewAzureContainerGroupHandler(arm),
+		},
 	}
 	err := checkForDuplicateRegistrations(radiusResourceModel, outputResourceModel)
 	if err != nil {
@@ -0,0 +1,332 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aci
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/corerp/renderers/container"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+)
+
+const (
+	// defaultCPU is the number of CPU cores requested by a container.
+	defaultCPU = 1.0
+
+	// defaultMemoryInGB is the memory in GB requested by a container.
+	defaultMemoryInGB = 1.5
+
+	// TagRadiusApplication is the tag of the container group which holds the name of the Radius application. Azure tag
+	// names cannot contain '/', so the Kubernetes labels of Radius are not used.
+	TagRadiusApplication = "radius-application"
+
+	// TagRadiusResource is the tag of the container group which holds the name of the Radius resource.
+	TagRadiusResource = "radius-resource"
+)
+
+// Renderer is the renderers.Renderer implementation for containers deployed to Azure Container Instances. Containers
+// are rendered to a container group when the environment uses the aci compute kind, otherwise rendering is delegated
+// to the inner renderer.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render renders the container to an Azure Container Instances container group with a single container when the
+// environment uses the aci compute kind. The container group is deployed to the resource group of the environment
+// compute, or to the resource group of the Azure provider scope of the environment.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	compute := options.Environment.Compute
+	if compute == nil || compute.Kind != rpv1.ACIComputeKind {
+		return r.Inner.Render(ctx, dm, options)
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	properties := resource.Properties
+	appID, err := resources.ParseResource(properties.Application)
+	if err != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s ", err.Error()))
+	}
+
+	if err := validate(resource); err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resourceGroup, err := resourceGroupID(options.Environment)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	env, err := environmentVariables(resource, options.Dependencies)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	name := kubernetes.NormalizeResourceName(appID.Name() + "-" + resource.Name)
+	id, err := resources.ParseResource(fmt.Sprintf("%s/providers/%s/%s", resourceGroup, resources_azure.ResourceTypeContainerInstanceContainerGroup, name))
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	outputResource := rpv1.OutputResource{
+		LocalID:       rpv1.LocalIDContainerGroup,
+		ID:            id,
+		RadiusManaged: to.Ptr(true),
+		CreateResource: &rpv1.Resource{
+			Data: &armresources.GenericResource{
+				Tags: map[string]*string{
+					TagRadiusApplication: to.Ptr(appID.Name()),
+					TagRadiusResource:    to.Ptr(resource.Name),
+				},
+				Properties: makeContainerGroupProperties(resource, env),
+			},
+			ResourceType: resourcemodel.ResourceType{
+				Type:     resources_azure.ResourceTypeContainerInstanceContainerGroup,
+				Provider: resourcemodel.ProviderAzure,
+			},
+		},
+	}
+
+	return renderers.RendererOutput{
+		Resources:      []rpv1.OutputResource{outputResource},
+		ComputedValues: map[string]rpv1.ComputedValueReference{},
+	}, nil
+}
+
+// validate returns an error if the container uses features which are only supported by Kubernetes compute, or if the
+// connection string format of a connection is not supported for its source.
+func validate(resource *datamodel.ContainerResource) error {
+	properties := resource.Properties
+	unsupported := []string{}
+	if len(properties.Container.Volumes) > 0 {
+		unsupported = append(unsupported, "volumes")
+	}
+	if properties.Runtimes != nil && properties.Runtimes.Kubernetes != nil {
+		unsupported = append(unsupported, "Kubernetes runtime")
+	}
+	if properties.Identity != nil {
+		unsupported = append(unsupported, "identity")
+	}
+	if properties.ResourceProvisioning == datamodel.ContainerResourceProvisioningManual {
+		unsupported = append(unsupported, "manual resource provisioning")
+	}
+	for _, e := range properties.Extensions {
+		switch e.Kind {
+		case datamodel.DaprSidecar:
+			unsupported = append(unsupported, "Dapr sidecar extension")
+		case datamodel.ManualScaling:
+			unsupported = append(unsupported, "manual scaling extension")
+		}
+	}
+	for _, connection := range properties.Connections {
+		if connection.IAM.Kind != "" {
+			unsupported = append(unsupported, "connection IAM")
+			break
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("container %q uses features which are not supported by Azure Container Instances compute: %s", resource.Name, strings.Join(unsupported, ", ")))
+	}
+
+	for name, connection := range properties.Connections {
+		if err := container.ValidateConnectionStringFormat(connection); err != nil {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid connection %s: %s", name, err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// resourceGroupID returns the resource ID of the resource group the container groups of the environment are deployed to.
+func resourceGroupID(options renderers.EnvironmentOptions) (string, error) {
+	scope := options.Compute.ACICompute.ResourceGroup
+	if scope == "" && options.CloudProviders != nil {
+		scope = options.CloudProviders.Azure.Scope
+	}
+
+	id, err := resources.ParseScope(scope)
+	if err != nil || id.FindScope(resources_azure.ScopeSubscriptions) == "" || id.FindScope(resources_azure.ScopeResourceGroups) == "" {
+		return "", v1.NewClientErrInvalidRequest("the environment must specify a resource group for Azure Container Instances compute, either as '$.properties.compute.resourceGroup' or as the Azure provider scope")
+	}
+
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.FindScope(resources_azure.ScopeSubscriptions), id.FindScope(resources_azure.ScopeResourceGroups)), nil
+}
+
+// environmentVariable is the environment variable of a container of a container group.
+type environmentVariable struct {
+	Value  string
+	Secure bool
+}
+
+// environmentVariables returns the environment variables of the container, including the environment variables of
+// its connections. The values of connections are passed as secure values, so they are not returned by the Azure API.
+func environmentVariables(resource *datamodel.ContainerResource, dependencies map[string]renderers.RendererDependency) (map[string]environmentVariable, error) {
+	env := map[string]environmentVariable{}
+	for name, connection := range resource.Properties.Connections {
+		if connection.GetDisableDefaultEnvVars() || connection.Source == "" {
+			continue
+		}
+
+		prefix := fmt.Sprintf("CONNECTION_%s_", strings.ToUpper(name))
+		if u, err := url.Parse(connection.Source); err == nil && u.Scheme != "" && u.Host != "" {
+			env[prefix+"SCHEME"] = environmentVariable{Value: u.Scheme}
+			env[prefix+"HOSTNAME"] = environmentVariable{Value: u.Hostname()}
+			env[prefix+"PORT"] = environmentVariable{Value: u.Port()}
+			continue
+		}
+
+		for key, value := range dependencies[connection.Source].ComputedValues {
+			switch v := value.(type) {
+			case string:
+				env[prefix+strings.ToUpper(key)] = environmentVariable{Value: v, Secure: true}
+			case float64:
+				env[prefix+strings.ToUpper(key)] = environmentVariable{Value: strconv.Itoa(int(v)), Secure: true}
+			case int:
+				env[prefix+strings.ToUpper(key)] = environmentVariable{Value: strconv.Itoa(v), Secure: true}
+			}
+		}
+
+		if connection.Format != "" {
+			id, err := resources.ParseResource(connection.Source)
+			if err != nil {
+				return nil, err
+			}
+
+			connectionString, err := container.FormatConnectionString(id.Type(), connection.Format, dependencies[connection.Source].ComputedValues)
+			if err != nil {
+				return nil, err
+			}
+			env[prefix+"CONNECTIONSTRING"] = environmentVariable{Value: connectionString, Secure: true}
+		}
+	}
+
+	// Environment variables of the container override the environment variables of the connections.
+	for name, value := range resource.Properties.Container.Env {
+		if value.Value == nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %q of container %q must specify a value, secret references are not supported by Azure Container Instances compute", name, resource.Name))
+		}
+		env[name] = environmentVariable{Value: *value.Value}
+	}
+
+	return env, nil
+}
+
+// makeContainerGroupProperties builds the properties of the container group, following the schema of the
+// Microsoft.ContainerInstance/containerGroups resource type.
+func makeContainerGroupProperties(resource *datamodel.ContainerResource, env map[string]environmentVariable) map[string]any {
+	container := resource.Properties.Container
+
+	names := []string{}
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	environmentVariables := []any{}
+	for _, name := range names {
+		if env[name].Secure {
+			environmentVariables = append(environmentVariables, map[string]any{"name": name, "secureValue": env[name].Value})
+		} else {
+			environmentVariables = append(environmentVariables, map[string]any{"name": name, "value": env[name].Value})
+		}
+	}
+
+	portNames := []string{}
+	for name := range container.Ports {
+		portNames = append(portNames, name)
+	}
+	sort.Strings(portNames)
+
+	ports := []any{}
+	for _, name := range portNames {
+		port := container.Ports[name]
+		protocol := "TCP"
+		if strings.EqualFold(string(port.Protocol), "UDP") {
+			protocol = "UDP"
+		}
+		ports = append(ports, map[string]any{"port": port.ContainerPort, "protocol": protocol})
+	}
+
+	// Container Instances does not distinguish the entrypoint from its arguments.
+	command := append(append([]string{}, container.Command...), container.Args...)
+
+	containerProperties := map[string]any{
+		"image":                container.Image,
+		"environmentVariables": environmentVariables,
+		"ports":                ports,
+		"resources": map[string]any{
+			"requests": map[string]any{
+				"cpu":        defaultCPU,
+				"memoryInGB": defaultMemoryInGB,
+			},
+		},
+	}
+	if len(command) > 0 {
+		containerProperties["command"] = command
+	}
+
+	restartPolicy := "Always"
+	if resource.Properties.RestartPolicy != "" {
+		restartPolicy = resource.Properties.RestartPolicy
+	}
+
+	properties := map[string]any{
+		"osType":        "Linux",
+		"restartPolicy": restartPolicy,
+		"containers": []any{
+			map[string]any{
+				"name":       kubernetes.NormalizeResourceName(resource.Name),
+				"properties": containerProperties,
+			},
+		},
+	}
+
+	// Ports are exposed on a public IP address, containers in different container groups cannot reach each other
+	// through a private network.
+	if len(ports) > 0 {
+		properties["ipAddress"] = map[string]any{
+			"type":  "Public",
+			"ports": ports,
+		}
+	}
+
+	return properties
+}
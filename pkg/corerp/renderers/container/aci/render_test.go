@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aci
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	applicationID = "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	redisID       = "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+	resourceGroup = "/subscriptions/test-sub-id/resourceGroups/aci-group"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+	rendered bool
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	r.rendered = true
+	return renderers.RendererOutput{}, nil
+}
+
+func makeResource(properties datamodel.ContainerProperties) *datamodel.ContainerResource {
+	properties.Application = applicationID
+	return &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+				Name: "frontend",
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func makeOptions(compute rpv1.EnvironmentCompute, azureScope string) renderers.RenderOptions {
+	return renderers.RenderOptions{
+		Environment: renderers.EnvironmentOptions{
+			Compute: &compute,
+			CloudProviders: &datamodel.Providers{
+				Azure: datamodel.ProvidersAzure{Scope: azureScope},
+			},
+		},
+		Dependencies: map[string]renderers.RendererDependency{
+			redisID: {
+				ComputedValues: map[string]any{
+					"host": "redis.example.com",
+					"port": float64(6379),
+				},
+			},
+		},
+	}
+}
+
+func Test_Render_KubernetesCompute(t *testing.T) {
+	inner := &noop{}
+	renderer := &Renderer{Inner: inner}
+
+	options := makeOptions(rpv1.EnvironmentCompute{Kind: rpv1.KubernetesComputeKind}, "")
+	_, err := renderer.Render(context.Background(), makeResource(datamodel.ContainerProperties{}), options)
+	require.NoError(t, err)
+	require.True(t, inner.rendered)
+}
+
+func Test_Render_ACICompute(t *testing.T) {
+	inner := &noop{}
+	renderer := &Renderer{Inner: inner}
+
+	resource := makeResource(datamodel.ContainerProperties{
+		Connections: map[string]datamodel.ConnectionProperties{
+			"redis":   {Source: redisID},
+			"backend": {Source: "http://backend:3000"},
+		},
+		Container: datamodel.Container{
+			Image:   "ghcr.io/radius-project/frontend:latest",
+			Command: []string{"node"},
+			Args:    []string{"server.js"},
+			Env: map[string]datamodel.EnvironmentVariable{
+				"LOG_LEVEL": {Value: to.Ptr("debug")},
+			},
+			Ports: map[string]datamodel.ContainerPort{
+				"web": {ContainerPort: 3000},
+			},
+		},
+	})
+
+	options := makeOptions(rpv1.EnvironmentCompute{Kind: rpv1.ACIComputeKind, ACICompute: rpv1.ACIComputeProperties{ResourceGroup: resourceGroup}}, "")
+	output, err := renderer.Render(context.Background(), resource, options)
+	require.NoError(t, err)
+	require.False(t, inner.rendered)
+	require.Len(t, output.Resources, 1)
+
+	outputResource := output.Resources[0]
+	require.Equal(t, rpv1.LocalIDContainerGroup, outputResource.LocalID)
+	require.Equal(t, "/subscriptions/test-sub-id/resourceGroups/aci-group/providers/Microsoft.ContainerInstance/containerGroups/test-app-frontend", outputResource.ID.String())
+	require.Equal(t, resourcemodel.ResourceType{
+		Type:     resources_azure.ResourceTypeContainerInstanceContainerGroup,
+		Provider: resourcemodel.ProviderAzure,
+	}, outputResource.CreateResource.ResourceType)
+
+	containerGroup, ok := outputResource.CreateResource.Data.(*armresources.GenericResource)
+	require.True(t, ok)
+	require.Equal(t, map[string]*string{
+		TagRadiusApplication: to.Ptr("test-app"),
+		TagRadiusResource:    to.Ptr("frontend"),
+	}, containerGroup.Tags)
+
+	ports := []any{map[string]any{"port": int32(3000), "protocol": "TCP"}}
+	expected := map[string]any{
+		"osType":        "Linux",
+		"restartPolicy": "Always",
+		"containers": []any{
+			map[string]any{
+				"name": "frontend",
+				"properties": map[string]any{
+					"image":   "ghcr.io/radius-project/frontend:latest",
+					"command": []string{"node", "server.js"},
+					"environmentVariables": []any{
+						map[string]any{"name": "CONNECTION_BACKEND_HOSTNAME", "value": "backend"},
+						map[string]any{"name": "CONNECTION_BACKEND_PORT", "value": "3000"},
+						map[string]any{"name": "CONNECTION_BACKEND_SCHEME", "value": "http"},
+						map[string]any{"name": "CONNECTION_REDIS_HOST", "secureValue": "redis.example.com"},
+						map[string]any{"name": "CONNECTION_REDIS_PORT", "secureValue": "6379"},
+						map[string]any{"name": "LOG_LEVEL", "value": "debug"},
+					},
+					"ports": ports,
+					"resources": map[string]any{
+						"requests": map[string]any{
+							"cpu":        defaultCPU,
+							"memoryInGB": defaultMemoryInGB,
+						},
+					},
+				},
+			},
+		},
+		"ipAddress": map[string]any{
+			"type":  "Public",
+			"ports": ports,
+		},
+	}
+	require.Equal(t, expected, containerGroup.Properties)
+}
+
+func Test_Render_ACICompute_AzureProviderScope(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+	resource := makeResource(datamodel.ContainerProperties{
+		Container: datamodel.Container{Image: "busybox"},
+	})
+
+	options := makeOptions(rpv1.EnvironmentCompute{Kind: rpv1.ACIComputeKind}, "/subscriptions/test-sub-id/resourceGroups/provider-group")
+	output, err := renderer.Render(context.Background(), resource, options)
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+	require.Equal(t, "/subscriptions/test-sub-id/resourceGroups/provider-group/providers/Microsoft.ContainerInstance/containerGroups/test-app-frontend", output.Resources[0].ID.String())
+
+	containerGroup := output.Resources[0].CreateResource.Data.(*armresources.GenericResource)
+	properties := containerGroup.Properties.(map[string]any)
+	require.NotContains(t, properties, "ipAddress")
+}
+
+func Test_Render_ACICompute_ConnectionStringFormat(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+	resource := makeResource(datamodel.ContainerProperties{
+		Connections: map[string]datamodel.ConnectionProperties{
+			"redis": {Source: redisID, Format: datamodel.ConnectionStringFormatURI},
+		},
+		Container: datamodel.Container{Image: "busybox"},
+	})
+
+	options := makeOptions(rpv1.EnvironmentCompute{Kind: rpv1.ACIComputeKind}, resourceGroup)
+	output, err := renderer.Render(context.Background(), resource, options)
+	require.NoError(t, err)
+
+	containerGroup := output.Resources[0].CreateResource.Data.(*armresources.GenericResource)
+	properties := containerGroup.Properties.(map[string]any)
+	containers := properties["containers"].([]any)
+	env := containers[0].(map[string]any)["properties"].(map[string]any)["environmentVariables"].([]any)
+	require.Contains(t, env, map[string]any{"name": "CONNECTION_REDIS_CONNECTIONSTRING", "secureValue": "redis://redis.example.com:6379"})
+}
+
+func Test_Render_ACICompute_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties datamodel.ContainerProperties
+		azureScope string
+		err        string
+	}{
+		{
+			name:       "missing resource group",
+			properties: datamodel.ContainerProperties{},
+			err:        "the environment must specify a resource group for Azure Container Instances compute, either as '$.properties.compute.resourceGroup' or as the Azure provider scope",
+		},
+		{
+			name: "unsupported features",
+			properties: datamodel.ContainerProperties{
+				Container: datamodel.Container{
+					Volumes: map[string]datamodel.VolumeProperties{"data": {Kind: datamodel.Ephemeral}},
+				},
+				Extensions: []datamodel.Extension{{Kind: datamodel.DaprSidecar}},
+			},
+			azureScope: resourceGroup,
+			err:        "container \"frontend\" uses features which are not supported by Azure Container Instances compute: volumes, Dapr sidecar extension",
+		},
+		{
+			name: "unsupported connection string format",
+			properties: datamodel.ContainerProperties{
+				Connections: map[string]datamodel.ConnectionProperties{
+					"redis": {Source: redisID, Format: datamodel.ConnectionStringFormatJDBC},
+				},
+			},
+			azureScope: resourceGroup,
+			err:        "invalid connection redis: connection string format \"jdbc\" is not supported for resources of type Applications.Datastores/redisCaches, supported formats are: stackexchange, uri",
+		},
+		{
+			name: "secret reference",
+			properties: datamodel.ContainerProperties{
+				Container: datamodel.Container{
+					Env: map[string]datamodel.EnvironmentVariable{
+						"PASSWORD": {ValueFrom: &datamodel.EnvironmentVariableReference{}},
+					},
+				},
+			},
+			azureScope: resourceGroup,
+			err:        "environment variable \"PASSWORD\" of container \"frontend\" must specify a value, secret references are not supported by Azure Container Instances compute",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := &Renderer{Inner: &noop{}}
+			options := makeOptions(rpv1.EnvironmentCompute{Kind: rpv1.ACIComputeKind}, tt.azureScope)
+
+			_, err := renderer.Render(context.Background(), makeResource(tt.properties), options)
+			require.Equal(t, v1.NewClientErrInvalidRequest(tt.err), err)
+		})
+	}
+}
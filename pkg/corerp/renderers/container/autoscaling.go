@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// makeHorizontalPodAutoscaler creates the HorizontalPodAutoscaler which scales the deployment of the container
+// between the minimum and maximum number of replicas.
+func makeHorizontalPodAutoscaler(deployment *appsv1.Deployment, applicationName string, resource *datamodel.ContainerResource) (rpv1.OutputResource, error) {
+	autoscaling := resource.Properties.Autoscaling
+
+	minReplicas := autoscaling.MinReplicas
+	if minReplicas == 0 {
+		minReplicas = 1
+	}
+
+	metrics, err := makeAutoscalingMetrics(autoscaling)
+	if err != nil {
+		return rpv1.OutputResource{}, err
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			Labels:    kubernetes.MakeDescriptiveLabels(applicationName, resource.Name, resource.ResourceTypeName()),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+			},
+			MinReplicas: to.Ptr(minReplicas),
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+
+	output := rpv1.NewKubernetesOutputResource(rpv1.LocalIDHorizontalPodAutoscaler, hpa, hpa.ObjectMeta)
+	output.CreateResource.Dependencies = []string{rpv1.LocalIDDeployment}
+	return output, nil
+}
+
+// makeAutoscalingMetrics converts the utilization targets and the custom metrics of the container to the metrics of
+// the HorizontalPodAutoscaler. Kubernetes scales on the CPU utilization by default if no metrics are specified.
+func makeAutoscalingMetrics(autoscaling *datamodel.ContainerAutoscalingProperties) ([]autoscalingv2.MetricSpec, error) {
+	metrics := []autoscalingv2.MetricSpec{}
+
+	if autoscaling.TargetCPUUtilization > 0 {
+		metrics = append(metrics, makeUtilizationMetric(corev1.ResourceCPU, autoscaling.TargetCPUUtilization))
+	}
+	if autoscaling.TargetMemoryUtilization > 0 {
+		metrics = append(metrics, makeUtilizationMetric(corev1.ResourceMemory, autoscaling.TargetMemoryUtilization))
+	}
+
+	for _, m := range autoscaling.Metrics {
+		target := autoscalingv2.MetricTarget{}
+		if m.TargetAverageValue != "" {
+			q, err := resource.ParseQuantity(m.TargetAverageValue)
+			if err != nil {
+				return nil, fmt.Errorf("invalid target average value %q of metric %q: %w", m.TargetAverageValue, m.Name, err)
+			}
+			target.Type = autoscalingv2.AverageValueMetricType
+			target.AverageValue = &q
+		} else {
+			q, err := resource.ParseQuantity(m.TargetValue)
+			if err != nil {
+				return nil, fmt.Errorf("invalid target value %q of metric %q: %w", m.TargetValue, m.Name, err)
+			}
+			target.Type = autoscalingv2.ValueMetricType
+			target.Value = &q
+		}
+
+		identifier := autoscalingv2.MetricIdentifier{Name: m.Name}
+		if len(m.Selector) > 0 {
+			identifier.Selector = &metav1.LabelSelector{MatchLabels: m.Selector}
+		}
+
+		switch m.Kind {
+		case datamodel.ContainerAutoscalingMetricKindPods:
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{Metric: identifier, Target: target},
+			})
+		case datamodel.ContainerAutoscalingMetricKindExternal:
+			metrics = append(metrics, autoscalingv2.MetricSpec{
+				Type:     autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{Metric: identifier, Target: target},
+			})
+		default:
+			return nil, fmt.Errorf("unsupported kind %q of metric %q", m.Kind, m.Name)
+		}
+	}
+
+	return metrics, nil
+}
+
+func makeUtilizationMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: to.Ptr(utilization),
+			},
+		},
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_makeAutoscalingMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling *datamodel.ContainerAutoscalingProperties
+		expected    []autoscalingv2.MetricSpec
+		err         string
+	}{
+		{
+			name:        "no metrics",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{MaxReplicas: 3},
+			expected:    []autoscalingv2.MetricSpec{},
+		},
+		{
+			name: "cpu and memory utilization",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{
+				MaxReplicas:             3,
+				TargetCPUUtilization:    70,
+				TargetMemoryUtilization: 80,
+			},
+			expected: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   corev1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: to.Ptr[int32](70)},
+					},
+				},
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   corev1.ResourceMemory,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: to.Ptr[int32](80)},
+					},
+				},
+			},
+		},
+		{
+			name: "pods and external metrics",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{
+				MaxReplicas: 3,
+				Metrics: []datamodel.ContainerAutoscalingMetric{
+					{Kind: datamodel.ContainerAutoscalingMetricKindPods, Name: "http_requests_per_second", TargetAverageValue: "100"},
+					{Kind: datamodel.ContainerAutoscalingMetricKindExternal, Name: "queue_messages_ready", Selector: map[string]string{"queue": "orders"}, TargetValue: "30"},
+				},
+			},
+			expected: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "http_requests_per_second"},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: to.Ptr(resource.MustParse("100"))},
+					},
+				},
+				{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{
+							Name:     "queue_messages_ready",
+							Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "orders"}},
+						},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: to.Ptr(resource.MustParse("30"))},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid quantity",
+			autoscaling: &datamodel.ContainerAutoscalingProperties{
+				MaxReplicas: 3,
+				Metrics: []datamodel.ContainerAutoscalingMetric{
+					{Kind: datamodel.ContainerAutoscalingMetricKindExternal, Name: "queue_messages_ready", TargetValue: "lots"},
+				},
+			},
+			err: `invalid target value "lots" of metric "queue_messages_ready"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			metrics, err := makeAutoscalingMetrics(tc.autoscaling)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, metrics)
+		})
+	}
+}
+
+func Test_Render_Autoscaling(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Autoscaling: &datamodel.ContainerAutoscalingProperties{
+			MaxReplicas:          5,
+			TargetCPUUtilization: 70,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+	}
+	resource := makeResource(properties)
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Nil(t, deployment.Spec.Replicas)
+
+	var hpaResource *rpv1.OutputResource
+	for i := range output.Resources {
+		if output.Resources[i].LocalID == rpv1.LocalIDHorizontalPodAutoscaler {
+			hpaResource = &output.Resources[i]
+		}
+	}
+	require.NotNil(t, hpaResource)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, hpaResource.CreateResource.Dependencies)
+
+	hpa := hpaResource.CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.Equal(t, "HorizontalPodAutoscaler", hpa.Kind)
+	require.Equal(t, "autoscaling/v2", hpa.APIVersion)
+	require.Equal(t, deployment.Name, hpa.Name)
+	require.Equal(t, kubernetes.MakeDescriptiveLabels(applicationName, resourceName, ResourceType), hpa.Labels)
+	require.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		Kind:       "Deployment",
+		Name:       deployment.Name,
+		APIVersion: appsv1.SchemeGroupVersion.String(),
+	}, hpa.Spec.ScaleTargetRef)
+	require.Equal(t, to.Ptr[int32](1), hpa.Spec.MinReplicas)
+	require.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	require.Len(t, hpa.Spec.Metrics, 1)
+}
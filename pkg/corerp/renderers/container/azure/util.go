@@ -30,7 +30,7 @@ const (
 // auto-provision per-container managed identity in the resource group
 // which is specified by environment resource. In this case,
 // RP uses application name as prefix to avoid the name conflict in the same
-// resource group.
+// resource group. Names that are too long are truncated with a hash suffix.
 func MakeResourceName(prefix, name, separator string) string {
 	if name == "" {
 		panic("name is empty.")
@@ -38,5 +38,5 @@ func MakeResourceName(prefix, name, separator string) string {
 	if prefix != "" {
 		prefix += separator
 	}
-	return kubernetes.NormalizeResourceName(prefix + name)
+	return kubernetes.DefaultNamingStrategy.MakeName(prefix + name)
 }
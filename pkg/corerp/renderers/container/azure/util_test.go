@@ -43,6 +43,11 @@ func TestMakeResourceName(t *testing.T) {
 			"Resource",
 			"app-resource",
 		},
+		{
+			"this-is-a-very-long-application-name",
+			"this-is-a-very-long-container-name",
+			"this-is-a-very-long-application-name-this-is-a-very-lo-30462fa6",
+		},
 	}
 
 	for _, tt := range nameTests {
@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/radius-project/radius/pkg/corerp/connectionagent"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// DefaultConnectionAgentImage is the image of the agent used to fetch the values of connections materialized at runtime.
+	DefaultConnectionAgentImage = "ghcr.io/radius-project/connection-agent:latest"
+
+	// ConnectionAgentClusterRoleName is the name of the cluster role granting access to the Radius control plane
+	// to the agent. The cluster role is installed with Radius.
+	ConnectionAgentClusterRoleName = "radius-connection-agent"
+
+	connectionAgentVolumeName        = "radius-connections"
+	connectionAgentInitContainerName = "radius-connection-agent"
+	connectionAgentSidecarName       = "radius-connection-agent-refresh"
+)
+
+// runtimeConnections returns the source of each connection whose values are materialized at runtime, keyed by the
+// connection name. Connections to URLs are excluded since their values are known at deployment time.
+func runtimeConnections(resource *datamodel.ContainerResource) map[string]string {
+	connections := map[string]string{}
+	for name, conn := range resource.Properties.Connections {
+		if conn.IsRuntimeMaterialized() && conn.Source != "" && !isURL(conn.Source) {
+			connections[name] = conn.Source
+		}
+	}
+
+	return connections
+}
+
+// addConnectionAgent adds the agent fetching the values of the connections materialized at runtime to the pod.
+//
+// An init container writes the values to a memory backed volume mounted in the container before the container
+// starts. If any of the connections is refreshed, a sidecar keeps the values up to date using the shortest
+// refresh interval of these connections.
+func (r Renderer) addConnectionAgent(resource *datamodel.ContainerResource, connections map[string]string, podSpec *corev1.PodSpec, container *corev1.Container) {
+	image := r.ConnectionAgentImage
+	if image == "" {
+		image = DefaultConnectionAgentImage
+	}
+
+	names := []string{}
+	for name := range connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args := []string{fmt.Sprintf("--output-dir=%s", connectionagent.DefaultOutputDirectory)}
+	refreshArgs := []string{}
+	var refreshInterval int32
+	for _, name := range names {
+		arg := fmt.Sprintf("--connection=%s=%s", name, connections[name])
+		args = append(args, arg)
+
+		secrets := resource.Properties.Connections[name].Secrets
+		if secrets.RefreshIntervalSeconds > 0 {
+			refreshArgs = append(refreshArgs, arg)
+			if refreshInterval == 0 || secrets.RefreshIntervalSeconds < refreshInterval {
+				refreshInterval = secrets.RefreshIntervalSeconds
+			}
+		}
+	}
+
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: connectionAgentVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium: corev1.StorageMediumMemory,
+			},
+		},
+	})
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      connectionAgentVolumeName,
+		MountPath: connectionagent.DefaultOutputDirectory,
+		ReadOnly:  true,
+	})
+
+	agentVolumeMounts := []corev1.VolumeMount{
+		{
+			Name:      connectionAgentVolumeName,
+			MountPath: connectionagent.DefaultOutputDirectory,
+		},
+	}
+
+	podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+		Name:         connectionAgentInitContainerName,
+		Image:        image,
+		Args:         args,
+		VolumeMounts: agentVolumeMounts,
+	})
+
+	if refreshInterval > 0 {
+		// An init container with the Always restart policy is a sidecar running for the lifetime of the pod.
+		podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+			Name:          connectionAgentSidecarName,
+			Image:         image,
+			Args:          append(append([]string{fmt.Sprintf("--output-dir=%s", connectionagent.DefaultOutputDirectory)}, refreshArgs...), fmt.Sprintf("--refresh-interval=%ds", refreshInterval)),
+			VolumeMounts:  agentVolumeMounts,
+			RestartPolicy: to.Ptr(corev1.ContainerRestartPolicyAlways),
+		})
+	}
+}
+
+// makeConnectionAgentClusterRoleBinding grants the service account of the pod access to the Radius control plane
+// so that the agent can fetch the values of the connections.
+func makeConnectionAgentClusterRoleBinding(appName, name, saName, namespace string, resource *datamodel.ContainerResource) *rpv1.OutputResource {
+	labels := kubernetes.MakeDescriptiveLabels(appName, resource.Name, resource.Type)
+
+	binding := &rbacv1.ClusterRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRoleBinding",
+			APIVersion: "rbac.authorization.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			// Cluster role bindings are not namespaced, so the namespace is part of the name to make it unique.
+			Name:   kubernetes.NormalizeResourceName(fmt.Sprintf("%s-%s-%s", ConnectionAgentClusterRoleName, namespace, name)),
+			Labels: labels,
+		},
+		RoleRef: rbacv1.RoleRef{
+			Kind:     "ClusterRole",
+			Name:     ConnectionAgentClusterRoleName,
+			APIGroup: "rbac.authorization.k8s.io",
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      saName,
+				Namespace: namespace,
+			},
+		},
+	}
+
+	or := rpv1.NewKubernetesOutputResource(rpv1.LocalIDKubernetesClusterRoleBinding, binding, binding.ObjectMeta)
+	return &or
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// daprHTTPPort is the port of the HTTP API of the Dapr sidecar. Radius does not override the default port of Dapr.
+	daprHTTPPort = 3500
+
+	// daprComponentNameKey is the computed value of a Dapr resource which holds the name of its Dapr component.
+	daprComponentNameKey = "componentName"
+)
+
+// daprSecretReference is a reference to a secret of a Dapr secret store, as recorded in the pod annotations.
+type daprSecretReference struct {
+	// SecretStore is the name of the Dapr component of the secret store.
+	SecretStore string `json:"secretStore"`
+
+	// Key is the name of the secret in the secret store.
+	Key string `json:"key"`
+}
+
+// isDaprSecretStoreReference returns true if the source of a secret reference is the resource ID of an
+// Applications.Dapr/secretStores resource.
+func isDaprSecretStoreReference(source string) bool {
+	if !strings.HasPrefix(source, "/") {
+		return false
+	}
+
+	id, err := resources.ParseResource(source)
+	return err == nil && strings.EqualFold(id.Type(), dapr_ctrl.DaprSecretStoresResourceType)
+}
+
+// makeDaprSecretEnvVar builds the environment variable for a secret of a Dapr secret store. Dapr secrets cannot be
+// projected into the pod by Kubernetes, so the value of the environment variable is the URL of the secret in the
+// secrets API of the Dapr sidecar, which the application reads at runtime.
+func makeDaprSecretEnvVar(name string, ref datamodel.EnvironmentVariableSecretReference, dependencies map[string]renderers.RendererDependency) (corev1.EnvVar, daprSecretReference, error) {
+	dependency, ok := dependencies[ref.Source]
+	if !ok {
+		return corev1.EnvVar{}, daprSecretReference{}, fmt.Errorf("failed to find source in dependencies: %s", ref.Source)
+	}
+
+	component, _ := dependency.ComputedValues[daprComponentNameKey].(string)
+	if component == "" {
+		return corev1.EnvVar{}, daprSecretReference{}, fmt.Errorf("the Dapr secret store %s has no component name", ref.Source)
+	}
+
+	value := fmt.Sprintf("http://localhost:%d/v1.0/secrets/%s/%s", daprHTTPPort, url.PathEscape(component), url.PathEscape(ref.Key))
+	return corev1.EnvVar{Name: name, Value: value}, daprSecretReference{SecretStore: component, Key: ref.Key}, nil
+}
+
+// hasDaprSidecar returns true if the container has the Dapr sidecar extension.
+func hasDaprSidecar(resource *datamodel.ContainerResource) bool {
+	for _, extension := range resource.Properties.Extensions {
+		if extension.Kind == datamodel.DaprSidecar {
+			return true
+		}
+	}
+
+	return false
+}
+
+// daprSecretReferencesAnnotation serializes the references to Dapr secrets, keyed by the name of the environment
+// variable, as the value of the pod annotation.
+func daprSecretReferencesAnnotation(references map[string]daprSecretReference) (string, error) {
+	// json.Marshal sorts the keys of maps, so the annotation is stable.
+	b, err := json.Marshal(references)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
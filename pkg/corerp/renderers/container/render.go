@@ -20,8 +20,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -29,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -71,6 +74,10 @@ type Renderer struct {
 	// RoleAssignmentMap is an optional map of connection kind -> []Role Assignment. Used to configure managed
 	// identity permissions for cloud resources. This will be nil in environments that don't support role assignments.
 	RoleAssignmentMap map[datamodel.IAMKind]RoleAssignmentData
+
+	// ConnectionAgentImage is the image of the agent fetching the values of connections materialized at runtime.
+	// DefaultConnectionAgentImage is used if empty.
+	ConnectionAgentImage string
 }
 
 // GetDependencyIDs parses the connections, ports, environment variables, and volumes of a container resource to return the Radius and Azure
@@ -179,7 +186,11 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	var needsServiceGeneration = false
 
 	// check if connections are valid
-	for _, connection := range properties.Connections {
+	for name, connection := range properties.Connections {
+		if err := ValidateConnectionStringFormat(connection); err != nil {
+			return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid connection %s: %s", name, err.Error()))
+		}
+
 		// if source is a URL, it is valid (example: 'http://containerx:3000').
 		if isURL(connection.Source) {
 			continue
@@ -247,7 +258,7 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	// If there are secrets we'll use a Kubernetes secret to hold them. This is already referenced
 	// by the deployment.
 	if len(secretData) > 0 {
-		outputResources = append(outputResources, r.makeSecret(*resource, appId.Name(), secretData, options))
+		outputResources = append(outputResources, r.makeSecret(*resource, appId.Name(), rpv1.LocalIDSecret, kubernetes.NormalizeResourceName(resource.Name), secretData, options))
 	}
 
 	var servicePorts []corev1.ServicePort
@@ -373,18 +384,39 @@ func (r Renderer) makeDeployment(
 	// We build the environment variable list in a stable order for testability
 	// For the values that come from connections we back them with secretData. We'll extract the values
 	// and return them.
-	env, secretData, err := getEnvVarsAndSecretData(resource, dependencies)
+	env, secrets, err := getEnvVarsAndSecretData(resource, dependencies)
 	if err != nil {
 		return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to obtain environment variables and secret data: %w", err)
 	}
 
+	// The values of connections are stored in the secret of the container, unless the connections map them to other secrets.
+	secretData := secrets[normalizedName]
+	if secretData == nil {
+		secretData = map[string][]byte{}
+	}
+	delete(secrets, normalizedName)
+
+	daprSecrets := map[string]daprSecretReference{}
 	for k, v := range properties.Container.Env {
+		if v.ValueFrom != nil && v.ValueFrom.SecretRef != nil && isDaprSecretStoreReference(v.ValueFrom.SecretRef.Source) {
+			env[k], daprSecrets[k], err = makeDaprSecretEnvVar(k, *v.ValueFrom.SecretRef, dependencies)
+			if err != nil {
+				return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to convert environment variable: %w", err)
+			}
+			continue
+		}
+
 		env[k], err = convertEnvVar(k, v, options)
 		if err != nil {
 			return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to convert environment variable: %w", err)
 		}
 	}
 
+	// Secrets of Dapr secret stores are read at runtime through the Dapr sidecar.
+	if len(daprSecrets) > 0 && !hasDaprSidecar(resource) {
+		return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("container %q references secrets of Dapr secret stores and must use the Dapr sidecar extension", resource.Name))
+	}
+
 	// Append in sorted order
 	for _, key := range getSortedKeys(env) {
 		container.Env = append(container.Env, env[key])
@@ -583,6 +615,15 @@ func (r Renderer) makeDeployment(
 	outputResources = append(outputResources, *roleBinding)
 	deps = append(deps, rpv1.LocalIDKubernetesRoleBinding)
 
+	// Connections materialized at runtime are fetched from the Radius control plane by an agent running in the pod.
+	if connections := runtimeConnections(resource); len(connections) > 0 {
+		r.addConnectionAgent(resource, connections, podSpec, container)
+
+		clusterRoleBinding := makeConnectionAgentClusterRoleBinding(applicationName, kubeIdentityName, podSpec.ServiceAccountName, options.Environment.Namespace, resource)
+		outputResources = append(outputResources, *clusterRoleBinding)
+		deps = append(deps, rpv1.LocalIDKubernetesClusterRoleBinding)
+	}
+
 	deployment.Spec.Template.ObjectMeta = mergeObjectMeta(deployment.Spec.Template.ObjectMeta, metav1.ObjectMeta{
 		Labels: podLabels,
 	})
@@ -614,12 +655,39 @@ func (r Renderer) makeDeployment(
 	// The solution to this is to embed the hash of the secret as an annotation in the deployment. This way when the
 	// secret changes we also change the content of the deployment and thus trigger a new revision. This is a very
 	// common solution to this problem, and not a bizarre workaround that we invented.
-	if len(secretData) > 0 {
-		hash := kubernetes.HashSecretData(secretData)
+	hashData := secretData
+	if len(secrets) > 0 {
+		hashData = maps.Clone(secretData)
+	}
+	for _, secretName := range slices.Sorted(maps.Keys(secrets)) {
+		secret := r.makeSecret(*resource, applicationName, rpv1.NewLocalID(rpv1.LocalIDSecret, secretName), secretName, secrets[secretName], options)
+		outputResources = append(outputResources, secret)
+		deps = append(deps, secret.LocalID)
+
+		// Secret keys cannot contain '/', so the keys of the secrets do not collide.
+		for key, value := range secrets[secretName] {
+			hashData[secretName+"/"+key] = value
+		}
+	}
+
+	if len(hashData) > 0 {
+		hash := kubernetes.HashSecretData(hashData)
 		deployment.Spec.Template.ObjectMeta.Annotations[kubernetes.AnnotationSecretHash] = hash
+	}
+	if len(secretData) > 0 {
 		deps = append(deps, rpv1.LocalIDSecret)
 	}
 
+	// The references to Dapr secrets are recorded on the pod, so they can be resolved through the Dapr secrets API
+	// without knowing the container resource.
+	if len(daprSecrets) > 0 {
+		annotation, err := daprSecretReferencesAnnotation(daprSecrets)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+		deployment.Spec.Template.ObjectMeta.Annotations[kubernetes.AnnotationDaprSecretReferences] = annotation
+	}
+
 	// Patching Runtimes.Kubernetes.Pod to the PodSpec in deployment resource.
 	if properties.Runtimes != nil && properties.Runtimes.Kubernetes != nil && properties.Runtimes.Kubernetes.Pod != "" {
 		patchedPodSpec, err := patchPodSpec(podSpec, []byte(properties.Runtimes.Kubernetes.Pod))
@@ -629,10 +697,25 @@ func (r Renderer) makeDeployment(
 		deployment.Spec.Template.Spec = *patchedPodSpec
 	}
 
+	// The HorizontalPodAutoscaler owns the number of replicas of an autoscaled container, so the deployment must not
+	// set it. Otherwise every deployment of the container would reset the number of replicas.
+	if properties.Autoscaling != nil {
+		deployment.Spec.Replicas = nil
+	}
+
 	deploymentOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, deployment, deployment.ObjectMeta)
 	deploymentOutput.CreateResource.Dependencies = deps
 
 	outputResources = append(outputResources, deploymentOutput)
+
+	if properties.Autoscaling != nil {
+		hpa, err := makeHorizontalPodAutoscaler(deployment, applicationName, resource)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(err.Error())
+		}
+		outputResources = append(outputResources, hpa)
+	}
+
 	return outputResources, secretData, nil
 }
 
@@ -697,14 +780,15 @@ func convertEnvVar(key string, env datamodel.EnvironmentVariable, options render
 	}
 }
 
-func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies map[string]renderers.RendererDependency) (map[string]corev1.EnvVar, map[string][]byte, error) {
+func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies map[string]renderers.RendererDependency) (map[string]corev1.EnvVar, map[string]map[string][]byte, error) {
 	env := map[string]corev1.EnvVar{}
-	secretData := map[string][]byte{}
+	secrets := connectionSecrets{}
 	properties := resource.Properties
 
 	// Take each connection and create environment variables for each part
-	// We'll store each value in a secret named with the same name as the resource.
-	// We'll use the environment variable names as keys.
+	// We'll store each value in a secret named with the same name as the resource, unless the connection
+	// maps its values to another secret.
+	// We'll use the environment variable names as keys, unless the connection maps them to other keys.
 	// Float is used by the JSON serializer
 	for name, con := range properties.Connections {
 		properties := dependencies[con.Source]
@@ -714,12 +798,22 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies
 				continue
 			}
 
+			err := validateConnectionSecretsMapping(name, con)
+			if err != nil {
+				return map[string]corev1.EnvVar{}, map[string]map[string][]byte{}, err
+			}
+
+			// The values of connections materialized at runtime are fetched by the connection agent when the pod starts.
+			if con.IsRuntimeMaterialized() && !isURL(source) {
+				continue
+			}
+
 			// handles case where container has source field structured as a URL.
 			if isURL(source) {
 				// parse source into scheme, hostname, and port.
 				scheme, hostname, port, err := parseURL(source)
 				if err != nil {
-					return map[string]corev1.EnvVar{}, map[string][]byte{}, fmt.Errorf("failed to parse source URL: %w", err)
+					return map[string]corev1.EnvVar{}, map[string]map[string][]byte{}, fmt.Errorf("failed to parse source URL: %w", err)
 				}
 
 				schemeKey := fmt.Sprintf("%s_%s_%s", "CONNECTION", strings.ToUpper(name), "SCHEME")
@@ -735,32 +829,149 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies
 
 			// handles case where container has source field structured as a resourceID.
 			for key, value := range properties.ComputedValues {
-				name := fmt.Sprintf("%s_%s_%s", "CONNECTION", strings.ToUpper(name), strings.ToUpper(key))
+				envName := fmt.Sprintf("%s_%s_%s", "CONNECTION", strings.ToUpper(name), strings.ToUpper(key))
 
-				source := corev1.EnvVarSource{
-					SecretKeyRef: &corev1.SecretKeySelector{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: kubernetes.NormalizeResourceName(resource.Name),
-						},
-						Key: name,
-					},
-				}
+				var data []byte
 				switch v := value.(type) {
 				case string:
-					secretData[name] = []byte(v)
-					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
+					data = []byte(v)
 				case float64:
-					secretData[name] = []byte(strconv.Itoa(int(v)))
-					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
+					data = []byte(strconv.Itoa(int(v)))
 				case int:
-					secretData[name] = []byte(strconv.Itoa(v))
-					env[name] = corev1.EnvVar{Name: name, ValueFrom: &source}
+					data = []byte(strconv.Itoa(v))
+				default:
+					continue
+				}
+
+				env[envName], err = secrets.set(resource, name, con, key, envName, data)
+				if err != nil {
+					return map[string]corev1.EnvVar{}, map[string]map[string][]byte{}, err
 				}
 			}
+
+			// The connection string computed by the datastore is replaced by the one in the format of the connection.
+			if con.Format != "" {
+				id, err := resources.ParseResource(source)
+				if err != nil {
+					return map[string]corev1.EnvVar{}, map[string]map[string][]byte{}, err
+				}
+
+				connectionString, err := FormatConnectionString(id.Type(), con.Format, properties.ComputedValues)
+				if err != nil {
+					return map[string]corev1.EnvVar{}, map[string]map[string][]byte{}, err
+				}
+
+				envName := fmt.Sprintf("%s_%s_%s", "CONNECTION", strings.ToUpper(name), "CONNECTIONSTRING")
+				env[envName], err = secrets.set(resource, name, con, "connectionString", envName, []byte(connectionString))
+				if err != nil {
+					return map[string]corev1.EnvVar{}, map[string]map[string][]byte{}, err
+				}
+			}
+		}
+	}
+
+	return env, secrets.data(), nil
+}
+
+// connectionSecrets collects the values of connections stored in Kubernetes secrets.
+type connectionSecrets map[string]map[string]connectionSecretValue
+
+// connectionSecretValue is a value of a connection stored in a Kubernetes secret.
+type connectionSecretValue struct {
+	// envName is the name of the environment variable that references the value.
+	envName string
+	data    []byte
+}
+
+// set stores the value of a connection in the Kubernetes secret and key the connection maps it to, and returns the
+// environment variable that references it. It returns an error if another value is already stored with the same key.
+func (s connectionSecrets) set(resource *datamodel.ContainerResource, connName string, con datamodel.ConnectionProperties, valueName string, envName string, data []byte) (corev1.EnvVar, error) {
+	secretName, key := connectionSecretKey(resource, con, valueName, envName)
+
+	if s[secretName] == nil {
+		s[secretName] = map[string]connectionSecretValue{}
+	}
+	if existing, ok := s[secretName][key]; ok && existing.envName != envName {
+		return corev1.EnvVar{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %q: the value %q is mapped to the key %q of the secret %q, which already stores %s", connName, valueName, key, secretName, existing.envName))
+	}
+	s[secretName][key] = connectionSecretValue{envName: envName, data: data}
+
+	return corev1.EnvVar{
+		Name: envName,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: key,
+			},
+		},
+	}, nil
+}
+
+// data returns the data of the Kubernetes secrets keyed by secret name.
+func (s connectionSecrets) data() map[string]map[string][]byte {
+	result := map[string]map[string][]byte{}
+	for secretName, values := range s {
+		result[secretName] = map[string][]byte{}
+		for key, value := range values {
+			result[secretName][key] = value.data
+		}
+	}
+
+	return result
+}
+
+// connectionSecretKey returns the name of the Kubernetes secret and the key that store a value of a connection. Values are
+// stored in the secret of the container with the name of their environment variable as the key, unless the connection
+// maps them to another secret or key. Mapped keys are matched with the name of the value case-insensitively.
+func connectionSecretKey(resource *datamodel.ContainerResource, con datamodel.ConnectionProperties, valueName string, envName string) (string, string) {
+	secretName := kubernetes.NormalizeResourceName(resource.Name)
+	key := envName
+	if con.Secrets == nil {
+		return secretName, key
+	}
+
+	if con.Secrets.SecretName != "" {
+		secretName = con.Secrets.SecretName
+	}
+	for name, mapped := range con.Secrets.Keys {
+		if strings.EqualFold(name, valueName) {
+			key = mapped
+			break
+		}
+	}
+
+	return secretName, key
+}
+
+// validateConnectionSecretsMapping validates the name of the Kubernetes secret and the keys the connection maps its values to.
+func validateConnectionSecretsMapping(connName string, con datamodel.ConnectionProperties) error {
+	if con.Secrets == nil || (con.Secrets.SecretName == "" && len(con.Secrets.Keys) == 0) {
+		return nil
+	}
+
+	if con.IsRuntimeMaterialized() {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %q: the secret name and keys cannot be specified when the values are materialized at runtime", connName))
+	}
+
+	if isURL(con.Source) {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %q: the secret name and keys cannot be specified for a URL source because its values are not stored in a secret", connName))
+	}
+
+	if con.Secrets.SecretName != "" {
+		if errs := validation.IsDNS1123Subdomain(con.Secrets.SecretName); len(errs) > 0 {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %q: invalid secret name %q: %s", connName, con.Secrets.SecretName, strings.Join(errs, ", ")))
+		}
+	}
+
+	for name, key := range con.Secrets.Keys {
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("connection %q: invalid secret key %q for the value %q: %s", connName, key, name, strings.Join(errs, ", ")))
 		}
 	}
 
-	return env, secretData, nil
+	return nil
 }
 
 func (r Renderer) makeHealthProbe(p datamodel.HealthProbeProperties) (*corev1.Probe, error) {
@@ -846,14 +1057,14 @@ func (r Renderer) setContainerHealthProbeConfig(probeSpec *corev1.Probe, config
 	}
 }
 
-func (r Renderer) makeSecret(resource datamodel.ContainerResource, applicationName string, secrets map[string][]byte, options renderers.RenderOptions) rpv1.OutputResource {
+func (r Renderer) makeSecret(resource datamodel.ContainerResource, applicationName string, localID string, name string, secrets map[string][]byte, options renderers.RenderOptions) rpv1.OutputResource {
 	secret := corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Secret",
 			APIVersion: corev1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      kubernetes.NormalizeResourceName(resource.Name),
+			Name:      name,
 			Namespace: options.Environment.Namespace,
 			Labels:    kubernetes.MakeDescriptiveLabels(applicationName, resource.Name, resource.ResourceTypeName()),
 		},
@@ -861,7 +1072,7 @@ func (r Renderer) makeSecret(resource datamodel.ContainerResource, applicationNa
 		Data: secrets,
 	}
 
-	output := rpv1.NewKubernetesOutputResource(rpv1.LocalIDSecret, &secret, secret.ObjectMeta)
+	output := rpv1.NewKubernetesOutputResource(localID, &secret, secret.ObjectMeta)
 	return output
 }
 
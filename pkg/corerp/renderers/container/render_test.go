@@ -41,6 +41,7 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -728,6 +729,281 @@ func Test_Render_Connections(t *testing.T) {
 	require.Len(t, output.Resources, 5)
 }
 
+func Test_Render_Connections_ConnectionStringFormat(t *testing.T) {
+	sqlID := makeRadiusResourceID(t, "Applications.Datastores/sqlDatabases", "sql")
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"sql": {
+				Source: sqlID.String(),
+				Format: datamodel.ConnectionStringFormatJDBC,
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{
+		sqlID.String(): {
+			ResourceID: sqlID,
+			ComputedValues: map[string]any{
+				"server":           "sql.example.com",
+				"port":             float64(1433),
+				"database":         "orders",
+				"connectionString": "Data Source=tcp:sql.example.com,1433;Initial Catalog=orders;;;Encrypt=True;TrustServerCertificate=True",
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	secret, _ := kubernetes.FindSecret(output.Resources)
+	require.NotNil(t, secret)
+	require.Equal(t, "jdbc:sqlserver://sql.example.com:1433;databaseName=orders;encrypt=true;trustServerCertificate=true", string(secret.Data["CONNECTION_SQL_CONNECTIONSTRING"]))
+	require.Equal(t, "sql.example.com", string(secret.Data["CONNECTION_SQL_SERVER"]))
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Contains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+		Name: "CONNECTION_SQL_CONNECTIONSTRING",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: "CONNECTION_SQL_CONNECTIONSTRING",
+			},
+		},
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		properties.Connections["sql"] = datamodel.ConnectionProperties{
+			Source: sqlID.String(),
+			Format: datamodel.ConnectionStringFormatStackExchange,
+		}
+		_, err := renderer.Render(ctx, makeResource(properties), renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.Equal(t, apiv1.NewClientErrInvalidRequest("invalid connection sql: connection string format \"stackexchange\" is not supported for resources of type Applications.Datastores/sqlDatabases, supported formats are: adonet, jdbc, uri"), err)
+	})
+}
+
+func Test_Render_Connections_SecretsMapping(t *testing.T) {
+	redisID := makeRadiusResourceID(t, "Applications.Datastores/redisCaches", "cache")
+	sqlID := makeRadiusResourceID(t, "Applications.Datastores/sqlDatabases", "sql")
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"redis": {
+				Source: redisID.String(),
+				Secrets: &datamodel.ConnectionSecretsProperties{
+					SecretName: "redis-credentials",
+					Keys: map[string]string{
+						"password": "redis-password",
+					},
+				},
+			},
+			"sql": {
+				Source: sqlID.String(),
+				Format: datamodel.ConnectionStringFormatJDBC,
+				Secrets: &datamodel.ConnectionSecretsProperties{
+					Keys: map[string]string{
+						"connectionString": "DB_URL",
+					},
+				},
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{
+		redisID.String(): {
+			ResourceID: redisID,
+			ComputedValues: map[string]any{
+				"host":     "redis.example.com",
+				"port":     float64(6379),
+				"password": "p@ssw0rd",
+			},
+		},
+		sqlID.String(): {
+			ResourceID: sqlID,
+			ComputedValues: map[string]any{
+				"server":   "sql.example.com",
+				"port":     float64(1433),
+				"database": "orders",
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	redisLocalID := rpv1.NewLocalID(rpv1.LocalIDSecret, "redis-credentials")
+	secrets := map[string]*corev1.Secret{}
+	for _, r := range output.Resources {
+		if secret, ok := r.CreateResource.Data.(*corev1.Secret); ok {
+			secrets[r.LocalID] = secret
+		}
+	}
+	require.Len(t, secrets, 2)
+
+	redisSecret := secrets[redisLocalID]
+	require.NotNil(t, redisSecret)
+	require.Equal(t, "redis-credentials", redisSecret.Name)
+	require.Equal(t, "default", redisSecret.Namespace)
+	require.Equal(t, map[string][]byte{
+		"redis-password":        []byte("p@ssw0rd"),
+		"CONNECTION_REDIS_HOST": []byte("redis.example.com"),
+		"CONNECTION_REDIS_PORT": []byte("6379"),
+	}, redisSecret.Data)
+
+	containerSecret := secrets[rpv1.LocalIDSecret]
+	require.NotNil(t, containerSecret)
+	require.Equal(t, secretName, containerSecret.Name)
+	require.Equal(t, "jdbc:sqlserver://sql.example.com:1433;databaseName=orders;encrypt=true;trustServerCertificate=true", string(containerSecret.Data["DB_URL"]))
+	require.NotContains(t, containerSecret.Data, "CONNECTION_SQL_CONNECTIONSTRING")
+	require.NotContains(t, containerSecret.Data, "CONNECTION_REDIS_PASSWORD")
+
+	deployment, deploymentOutput := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Contains(t, deploymentOutput.CreateResource.Dependencies, redisLocalID)
+	require.Contains(t, deploymentOutput.CreateResource.Dependencies, rpv1.LocalIDSecret)
+	require.Contains(t, deployment.Spec.Template.Annotations, kubernetes.AnnotationSecretHash)
+	require.Contains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+		Name: "CONNECTION_REDIS_PASSWORD",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "redis-credentials",
+				},
+				Key: "redis-password",
+			},
+		},
+	})
+	require.Contains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+		Name: "CONNECTION_SQL_CONNECTIONSTRING",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secretName,
+				},
+				Key: "DB_URL",
+			},
+		},
+	})
+
+	t.Run("duplicate key", func(t *testing.T) {
+		properties.Connections["redis"] = datamodel.ConnectionProperties{
+			Source: redisID.String(),
+			Secrets: &datamodel.ConnectionSecretsProperties{
+				Keys: map[string]string{
+					"host": "DB_URL",
+				},
+			},
+		}
+		_, err := renderer.Render(ctx, makeResource(properties), renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.ErrorContains(t, err, "is mapped to the key \"DB_URL\" of the secret \"test-container\"")
+	})
+
+	t.Run("invalid secret name", func(t *testing.T) {
+		properties.Connections["redis"] = datamodel.ConnectionProperties{
+			Source: redisID.String(),
+			Secrets: &datamodel.ConnectionSecretsProperties{
+				SecretName: "Redis_Credentials",
+			},
+		}
+		_, err := renderer.Render(ctx, makeResource(properties), renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.ErrorContains(t, err, "connection \"redis\": invalid secret name \"Redis_Credentials\"")
+	})
+
+	t.Run("runtime materialization", func(t *testing.T) {
+		properties.Connections["redis"] = datamodel.ConnectionProperties{
+			Source: redisID.String(),
+			Secrets: &datamodel.ConnectionSecretsProperties{
+				Materialization: datamodel.ConnectionSecretsMaterializationRuntime,
+				SecretName:      "redis-credentials",
+			},
+		}
+		_, err := renderer.Render(ctx, makeResource(properties), renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.ErrorContains(t, err, "connection \"redis\": the secret name and keys cannot be specified when the values are materialized at runtime")
+	})
+}
+
+func Test_Render_DaprSecretStoreReference(t *testing.T) {
+	secretStoreID := makeRadiusResourceID(t, "Applications.Dapr/secretStores", "vault")
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Env: map[string]datamodel.EnvironmentVariable{
+				"DB_PASSWORD": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{
+							Source: secretStoreID.String(),
+							Key:    "db-password",
+						},
+					},
+				},
+			},
+		},
+		Extensions: []datamodel.Extension{
+			{
+				Kind:        datamodel.DaprSidecar,
+				DaprSidecar: &datamodel.DaprSidecarExtension{AppID: "frontend"},
+			},
+		},
+	}
+	dependencies := map[string]renderers.RendererDependency{
+		secretStoreID.String(): {
+			ResourceID:     secretStoreID,
+			ComputedValues: map[string]any{"componentName": "vault"},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, makeResource(properties), renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	secret, _ := kubernetes.FindSecret(output.Resources)
+	require.Nil(t, secret)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Contains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+		Name:  "DB_PASSWORD",
+		Value: "http://localhost:3500/v1.0/secrets/vault/db-password",
+	})
+	require.Equal(t, `{"DB_PASSWORD":{"secretStore":"vault","key":"db-password"}}`, deployment.Spec.Template.Annotations[kubernetes.AnnotationDaprSecretReferences])
+
+	t.Run("without Dapr sidecar", func(t *testing.T) {
+		withoutSidecar := properties
+		withoutSidecar.Extensions = nil
+		_, err := renderer.Render(ctx, makeResource(withoutSidecar), renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.Equal(t, apiv1.NewClientErrInvalidRequest("container \"test-container\" references secrets of Dapr secret stores and must use the Dapr sidecar extension"), err)
+	})
+
+	t.Run("missing component name", func(t *testing.T) {
+		missing := map[string]renderers.RendererDependency{
+			secretStoreID.String(): {ResourceID: secretStoreID, ComputedValues: map[string]any{}},
+		}
+		_, err := renderer.Render(ctx, makeResource(properties), renderers.RenderOptions{Dependencies: missing, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.ErrorContains(t, err, "has no component name")
+	})
+}
+
 func Test_RenderConnections_DisableDefaultEnvVars(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		BasicResourceProperties: rpv1.BasicResourceProperties{
@@ -840,6 +1116,122 @@ func Test_Render_Connections_SecretsGetHashed(t *testing.T) {
 	require.NotEqual(t, hash1, hash2)
 }
 
+func Test_Render_Connections_RuntimeMaterialization(t *testing.T) {
+	sourceA := makeRadiusResourceID(t, "SomeProvider/ResourceType", "A").String()
+	sourceB := makeRadiusResourceID(t, "SomeProvider/ResourceType", "B").String()
+
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"A": {
+				Source: sourceA,
+				Secrets: &datamodel.ConnectionSecretsProperties{
+					Materialization: datamodel.ConnectionSecretsMaterializationRuntime,
+				},
+			},
+			"B": {
+				Source: sourceB,
+				Secrets: &datamodel.ConnectionSecretsProperties{
+					Materialization:        datamodel.ConnectionSecretsMaterializationRuntime,
+					RefreshIntervalSeconds: 60,
+				},
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+		},
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{
+		sourceA: {
+			ResourceID: makeRadiusResourceID(t, "SomeProvider/ResourceType", "A"),
+			ComputedValues: map[string]any{
+				"ComputedKey1": "ComputedValue1",
+			},
+		},
+		sourceB: {
+			ResourceID: makeRadiusResourceID(t, "SomeProvider/ResourceType", "B"),
+			ComputedValues: map[string]any{
+				"ComputedKey2": "ComputedValue2",
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{ConnectionAgentImage: "connection-agent:test"}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.NotContains(t, deployment.Spec.Template.Annotations, kubernetes.AnnotationSecretHash)
+
+	podSpec := deployment.Spec.Template.Spec
+	container := podSpec.Containers[0]
+	require.Nil(t, container.Env)
+	require.Equal(t, []corev1.VolumeMount{
+		{
+			Name:      "radius-connections",
+			MountPath: "/var/run/radius/connections",
+			ReadOnly:  true,
+		},
+	}, container.VolumeMounts)
+	require.Equal(t, []corev1.Volume{
+		{
+			Name: "radius-connections",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{
+					Medium: corev1.StorageMediumMemory,
+				},
+			},
+		},
+	}, podSpec.Volumes)
+
+	agentVolumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "radius-connections",
+			MountPath: "/var/run/radius/connections",
+		},
+	}
+	require.Equal(t, []corev1.Container{
+		{
+			Name:  "radius-connection-agent",
+			Image: "connection-agent:test",
+			Args: []string{
+				"--output-dir=/var/run/radius/connections",
+				"--connection=A=" + sourceA,
+				"--connection=B=" + sourceB,
+			},
+			VolumeMounts: agentVolumeMounts,
+		},
+		{
+			Name:  "radius-connection-agent-refresh",
+			Image: "connection-agent:test",
+			Args: []string{
+				"--output-dir=/var/run/radius/connections",
+				"--connection=B=" + sourceB,
+				"--refresh-interval=60s",
+			},
+			VolumeMounts:  agentVolumeMounts,
+			RestartPolicy: to.Ptr(corev1.ContainerRestartPolicyAlways),
+		},
+	}, podSpec.InitContainers)
+
+	var clusterRoleBinding *rbacv1.ClusterRoleBinding
+	for _, r := range output.Resources {
+		require.NotEqual(t, rpv1.LocalIDSecret, r.LocalID)
+		if r.LocalID == rpv1.LocalIDKubernetesClusterRoleBinding {
+			clusterRoleBinding = r.CreateResource.Data.(*rbacv1.ClusterRoleBinding)
+		}
+	}
+	require.NotNil(t, clusterRoleBinding)
+	require.Equal(t, "radius-connection-agent-default-test-container", clusterRoleBinding.Name)
+	require.Equal(t, ConnectionAgentClusterRoleName, clusterRoleBinding.RoleRef.Name)
+	require.Equal(t, []rbacv1.Subject{{Kind: "ServiceAccount", Name: "test-container", Namespace: "default"}}, clusterRoleBinding.Subjects)
+}
+
 func Test_Render_ConnectionWithRoleAssignment(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		BasicResourceProperties: rpv1.BasicResourceProperties{
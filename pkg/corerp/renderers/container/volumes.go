@@ -17,9 +17,12 @@ limitations under the License.
 package container
 
 import (
+	"fmt"
+
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // Create the volume specs for Pod.
@@ -27,11 +30,43 @@ func makeEphemeralVolume(volumeName string, volume *datamodel.EphemeralVolume) (
 	// Make volume spec
 	volumeSpec := corev1.Volume{}
 	volumeSpec.Name = volumeName
-	volumeSpec.VolumeSource.EmptyDir = &corev1.EmptyDirVolumeSource{}
-	if volume != nil && volume.ManagedStore == datamodel.ManagedStoreMemory {
-		volumeSpec.VolumeSource.EmptyDir.Medium = corev1.StorageMediumMemory
+
+	var sizeLimit *resource.Quantity
+	if volume.SizeLimit != "" {
+		q, err := resource.ParseQuantity(volume.SizeLimit)
+		if err != nil {
+			return corev1.Volume{}, corev1.VolumeMount{}, fmt.Errorf("invalid size limit %q: %w", volume.SizeLimit, err)
+		}
+		sizeLimit = &q
+	}
+
+	if volume.StorageClass != "" {
+		// Generic ephemeral volumes are provisioned by the storage class and deleted with the pod.
+		if sizeLimit == nil {
+			return corev1.Volume{}, corev1.VolumeMount{}, fmt.Errorf("size limit is required when storage class %q is specified", volume.StorageClass)
+		}
+
+		storageClass := volume.StorageClass
+		volumeSpec.VolumeSource.Ephemeral = &corev1.EphemeralVolumeSource{
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: &storageClass,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: *sizeLimit,
+						},
+					},
+				},
+			},
+		}
 	} else {
-		volumeSpec.VolumeSource.EmptyDir.Medium = corev1.StorageMediumDefault
+		volumeSpec.VolumeSource.EmptyDir = &corev1.EmptyDirVolumeSource{SizeLimit: sizeLimit}
+		if volume.ManagedStore == datamodel.ManagedStoreMemory {
+			volumeSpec.VolumeSource.EmptyDir.Medium = corev1.StorageMediumMemory
+		} else {
+			volumeSpec.VolumeSource.EmptyDir.Medium = corev1.StorageMediumDefault
+		}
 	}
 
 	// Make volumeMount spec
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func Test_makeEphemeralVolume(t *testing.T) {
+	const volumeName = "scratch"
+	quantity := resource.MustParse("2Gi")
+
+	tests := []struct {
+		name     string
+		volume   *datamodel.EphemeralVolume
+		expected corev1.Volume
+		err      string
+	}{
+		{
+			name: "disk emptyDir",
+			volume: &datamodel.EphemeralVolume{
+				VolumeBase:   datamodel.VolumeBase{MountPath: "/scratch"},
+				ManagedStore: datamodel.ManagedStoreDisk,
+			},
+			expected: corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumDefault},
+				},
+			},
+		},
+		{
+			name: "memory emptyDir with size limit",
+			volume: &datamodel.EphemeralVolume{
+				VolumeBase:   datamodel.VolumeBase{MountPath: "/scratch"},
+				ManagedStore: datamodel.ManagedStoreMemory,
+				SizeLimit:    "2Gi",
+			},
+			expected: corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory, SizeLimit: &quantity},
+				},
+			},
+		},
+		{
+			name: "generic ephemeral volume",
+			volume: &datamodel.EphemeralVolume{
+				VolumeBase:   datamodel.VolumeBase{MountPath: "/scratch"},
+				ManagedStore: datamodel.ManagedStoreDisk,
+				SizeLimit:    "2Gi",
+				StorageClass: "fast",
+			},
+			expected: corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					Ephemeral: &corev1.EphemeralVolumeSource{
+						VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								StorageClassName: to.Ptr("fast"),
+								Resources: corev1.VolumeResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceStorage: quantity},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid size limit",
+			volume: &datamodel.EphemeralVolume{
+				VolumeBase: datamodel.VolumeBase{MountPath: "/scratch"},
+				SizeLimit:  "lots",
+			},
+			err: "invalid size limit \"lots\"",
+		},
+		{
+			name: "storage class without size limit",
+			volume: &datamodel.EphemeralVolume{
+				VolumeBase:   datamodel.VolumeBase{MountPath: "/scratch"},
+				StorageClass: "fast",
+			},
+			err: "size limit is required when storage class \"fast\" is specified",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			volume, mount, err := makeEphemeralVolume(volumeName, tc.volume)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, volume)
+			require.Equal(t, corev1.VolumeMount{Name: volumeName, MountPath: "/scratch"}, mount)
+		})
+	}
+}
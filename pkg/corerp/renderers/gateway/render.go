@@ -18,10 +18,12 @@ package gateway
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -33,14 +35,33 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 )
 
 const secretStoreNotFound = "secretStore resource %s not found"
 const invalidSecretStoreResource = "certificateFrom must reference a secretStore resource"
+const invalidBasicAuthSecretStoreResource = "basicAuth.secretStore must reference a secretStore resource with type basicAuthentication"
+
+// ipFilterSource is the Contour IP filter source matching the address of the client, accounting for the PROXY
+// protocol and the X-Forwarded-For header.
+const ipFilterSource = contourv1.IPFilterSourceRemote
+
+// upstreamProtocolH2C is the Contour service protocol for cleartext HTTP/2, which is required to reach gRPC services.
+const upstreamProtocolH2C = "h2c"
+
+// loadBalancerStrategies maps the load balancing strategies of the Gateway routes to the Contour strategies.
+var loadBalancerStrategies = map[datamodel.GatewayRouteLoadBalancerStrategy]string{
+	datamodel.GatewayRouteLoadBalancerStrategyRoundRobin:   "RoundRobin",
+	datamodel.GatewayRouteLoadBalancerStrategyCookie:       "Cookie",
+	datamodel.GatewayRouteLoadBalancerStrategyLeastRequest: "WeightedLeastRequest",
+}
 
 type Renderer struct {
+	// NamingStrategy generates the names of the HTTPProxy objects of the routes. Names are not truncated if the
+	// maximum length of the strategy is not set.
+	NamingStrategy kubernetes.NamingStrategy
 }
 
 // GetDependencyIDs parses the gateway data model to get the secretStore resource ID
@@ -62,6 +83,27 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 		radiusResourceIDs = append(radiusResourceIDs, resourceID)
 	}
 
+	// Get secretStore resource IDs from the basicAuth properties of the gateway and the routes
+	securities := []*datamodel.GatewaySecurity{gtwyProperties.Security}
+	for _, route := range gtwyProperties.Routes {
+		securities = append(securities, route.Security)
+	}
+
+	seen := map[string]bool{}
+	for _, security := range securities {
+		if security == nil || security.BasicAuth == nil || seen[security.BasicAuth.SecretStore] {
+			continue
+		}
+
+		resourceID, err := resources.ParseResource(security.BasicAuth.SecretStore)
+		if err != nil {
+			return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+		}
+
+		seen[security.BasicAuth.SecretStore] = true
+		radiusResourceIDs = append(radiusResourceIDs, resourceID)
+	}
+
 	return radiusResourceIDs, azureResourceIDs, nil
 }
 
@@ -73,6 +115,12 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	if !ok {
 		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
 	}
+
+	// Gateways are implemented with Contour HTTPProxy resources, which require Kubernetes compute.
+	if options.Environment.Compute != nil && options.Environment.Compute.Kind == rpv1.ACIComputeKind {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest("gateways are not supported by Azure Container Instances compute")
+	}
+
 	appId, err := resources.ParseResource(gateway.Properties.Application)
 	if err != nil {
 		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s. id: %s", err.Error(), gateway.Properties.Application))
@@ -91,7 +139,7 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
 	}
 
-	gatewayObject, err := MakeRootHTTPProxy(ctx, options, gateway, gateway.Name, applicationName, hostname)
+	gatewayObject, err := MakeRootHTTPProxy(ctx, options, r.NamingStrategy, gateway, gateway.Name, applicationName, hostname)
 	if err != nil {
 		return renderers.RendererOutput{}, err
 	}
@@ -104,7 +152,7 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		},
 	}
 
-	httpProxyObjects, err := MakeRoutesHTTPProxies(ctx, options, *gateway, &gateway.Properties, gatewayName, gatewayObject, applicationName)
+	httpProxyObjects, err := MakeRoutesHTTPProxies(ctx, options, r.NamingStrategy, *gateway, &gateway.Properties, gatewayName, gatewayObject, applicationName)
 	if err != nil {
 		return renderers.RendererOutput{}, err
 	}
@@ -118,7 +166,7 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 // MakeRootHTTPProxy validates the Gateway resource and its dependencies, and creates a Contour HTTPProxy resource
 // to act as the Gateway.
-func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
+func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, naming kubernetes.NamingStrategy, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
 	includes := []contourv1.Include{}
 	dependencies := options.Dependencies
 
@@ -197,12 +245,20 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
 		}
 
+		if sslPassthrough && route.TimeoutPolicy != nil {
+			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `timeoutPolicy` in routes with sslPassthrough set to true")
+		}
+
+		if sslPassthrough && (len(route.Destinations) > 0 || route.LoadBalancerPolicy != nil) {
+			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `destinations` or `loadBalancerPolicy` in routes with sslPassthrough set to true")
+		}
+
 		routeName, err := getRouteName(&route)
 		if err != nil {
 			return rpv1.OutputResource{}, err
 		}
 
-		routeResourceName := kubernetes.NormalizeResourceName(routeName)
+		routeResourceName := naming.MakeName(routeName)
 		prefix := route.Path
 
 		if sslPassthrough {
@@ -231,6 +287,11 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		TLS:  contourTLSConfig,
 	}
 
+	if gateway.Properties.Security != nil {
+		virtualHost.IPAllowFilterPolicy = makeIPFilterPolicy(gateway.Properties.Security.IPAllowList)
+		virtualHost.IPDenyFilterPolicy = makeIPFilterPolicy(gateway.Properties.Security.IPDenyList)
+	}
+
 	var tcpProxy *contourv1.TCPProxy
 	if sslPassthrough {
 		virtualHost.TLS = &contourv1.TLS{
@@ -252,7 +313,7 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		tcpProxy = &contourv1.TCPProxy{
 			Services: []contourv1.Service{
 				{
-					Name: kubernetes.NormalizeResourceName(routeName),
+					Name: naming.MakeName(routeName),
 					Port: int(port),
 				},
 			},
@@ -286,27 +347,15 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 
 // MakeRoutesHTTPProxies creates HTTPProxy objects for each route in the gateway and returns them as OutputResources. It returns
 // an error if it fails to get the route name.
-func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
+func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, naming kubernetes.NamingStrategy, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
 	dependencies := options.Dependencies
 	objects := make(map[string]*contourv1.HTTPProxy)
+	// firstRoutes holds the first route for each destination, which defines the protocol and timeouts of the HTTPProxy.
+	firstRoutes := make(map[string]datamodel.GatewayRoute)
+	// names detects routes with different destinations that are given the same HTTPProxy name after truncation.
+	names := kubernetes.NewNameRegistry()
 
 	for _, route := range gateway.Routes {
-		port := renderers.DefaultPort
-
-		if isURL(route.Destination) {
-			_, _, urlPort, err := parseURL(route.Destination)
-			if err != nil {
-				return []rpv1.OutputResource{}, err
-			}
-			port = urlPort
-		} else {
-			routeProperties := dependencies[route.Destination]
-			routePort, ok := routeProperties.ComputedValues["port"].(float64)
-			if ok {
-				port = int32(routePort)
-			}
-		}
-
 		routeName, err := getRouteName(&route)
 		if err != nil {
 			return []rpv1.OutputResource{}, err
@@ -314,7 +363,15 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 
 		// Create unique localID for dependency graph
 		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpProxy, routeName)
-		routeResourceName := kubernetes.NormalizeResourceName(routeName)
+		routeResourceName := naming.MakeName(routeName)
+		if err := names.Register(routeResourceName, routeName); err != nil {
+			return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(err.Error())
+		}
+
+		services, err := makeRouteServices(&route, dependencies)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
 
 		var pathRewritePolicy *contourv1.PathRewritePolicy
 		if route.ReplacePrefix != "" {
@@ -330,11 +387,24 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 
 		// If this route already exists, append to it
 		if object, exists := objects[localID]; exists {
+			first := firstRoutes[localID]
+			if routeProtocol(&first) != routeProtocol(&route) || !reflect.DeepEqual(first.TimeoutPolicy, route.TimeoutPolicy) {
+				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("routes with the destination %q must use the same protocol and timeoutPolicy", route.Destination))
+			}
+
+			if !reflect.DeepEqual(first.LoadBalancerPolicy, route.LoadBalancerPolicy) || !reflect.DeepEqual(first.Destinations, route.Destinations) {
+				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("routes with the destinations %q must use the same loadBalancerPolicy and weights", routeName))
+			}
+
+			if !reflect.DeepEqual(first.Security, route.Security) {
+				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("routes with the destinations %q must use the same security", routeName))
+			}
+
 			if pathRewritePolicy != nil {
 			outer:
 				for i := range object.Spec.Routes {
 					for _, service := range object.Spec.Routes[i].Services {
-						if service.Name == routeResourceName {
+						if service.Name == services[0].Name {
 							if object.Spec.Routes[i].PathRewritePolicy == nil {
 								object.Spec.Routes[i].PathRewritePolicy = pathRewritePolicy
 							} else {
@@ -348,6 +418,39 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 			continue
 		}
 
+		var timeoutPolicy *contourv1.TimeoutPolicy
+		if route.TimeoutPolicy != nil {
+			timeoutPolicy = &contourv1.TimeoutPolicy{
+				Response: route.TimeoutPolicy.Response,
+				Idle:     route.TimeoutPolicy.Idle,
+			}
+		}
+
+		var loadBalancerPolicy *contourv1.LoadBalancerPolicy
+		if route.LoadBalancerPolicy != nil && route.LoadBalancerPolicy.Strategy != "" {
+			loadBalancerPolicy = &contourv1.LoadBalancerPolicy{
+				Strategy: loadBalancerStrategies[route.LoadBalancerPolicy.Strategy],
+			}
+		}
+
+		contourRoute := contourv1.Route{
+			Services:           services,
+			PathRewritePolicy:  pathRewritePolicy,
+			EnableWebsockets:   route.EnableWebsockets || route.Protocol == datamodel.GatewayRouteProtocolWebSocket,
+			TimeoutPolicy:      timeoutPolicy,
+			LoadBalancerPolicy: loadBalancerPolicy,
+		}
+
+		if route.Security != nil {
+			contourRoute.IPAllowFilterPolicy = makeIPFilterPolicy(route.Security.IPAllowList)
+			contourRoute.IPDenyFilterPolicy = makeIPFilterPolicy(route.Security.IPDenyList)
+		}
+
+		contourRoutes, err := makeBasicAuthRoutes(contourRoute, getBasicAuth(gateway, &route), resource.Name, dependencies)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
 		httpProxyObject := &contourv1.HTTPProxy{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       "HTTPProxy",
@@ -360,22 +463,12 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 				Annotations: renderers.GetAnnotations(options),
 			},
 			Spec: contourv1.HTTPProxySpec{
-				Routes: []contourv1.Route{
-					{
-						Services: []contourv1.Service{
-							{
-								Name: routeResourceName,
-								Port: int(port),
-							},
-						},
-						PathRewritePolicy: pathRewritePolicy,
-						EnableWebsockets:  route.EnableWebsockets,
-					},
-				},
+				Routes: contourRoutes,
 			},
 		}
 
 		objects[localID] = httpProxyObject
+		firstRoutes[localID] = route
 
 		// Add the route as a dependency of the root http proxy to ensure that the route is created before the root http proxy
 		gatewayOutPutResource.CreateResource.Dependencies = append(gatewayOutPutResource.CreateResource.Dependencies, localID)
@@ -389,8 +482,187 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// makeIPFilterPolicy creates the Contour IP filter rules for the given IP addresses and CIDR ranges.
+func makeIPFilterPolicy(cidrs []string) []contourv1.IPFilterPolicy {
+	if len(cidrs) == 0 {
+		return nil
+	}
+
+	policy := []contourv1.IPFilterPolicy{}
+	for _, cidr := range cidrs {
+		policy = append(policy, contourv1.IPFilterPolicy{
+			Source: ipFilterSource,
+			CIDR:   cidr,
+		})
+	}
+
+	return policy
+}
+
+// getBasicAuth returns the basic authentication of the route, which overrides the basic authentication of the Gateway.
+func getBasicAuth(gateway *datamodel.GatewayProperties, route *datamodel.GatewayRoute) *datamodel.GatewayBasicAuth {
+	if route.Security != nil && route.Security.BasicAuth != nil {
+		return route.Security.BasicAuth
+	}
+
+	if gateway.Security != nil {
+		return gateway.Security.BasicAuth
+	}
+
+	return nil
+}
+
+// makeBasicAuthRoutes protects the route with basic authentication. Contour doesn't support basic authentication, so the
+// route only matches the requests with the Authorization header of the credentials, and the other requests are matched by
+// a route responding with 401 Unauthorized and asking the clients for the credentials.
+func makeBasicAuthRoutes(route contourv1.Route, basicAuth *datamodel.GatewayBasicAuth, gatewayName string, dependencies map[string]renderers.RendererDependency) ([]contourv1.Route, error) {
+	if basicAuth == nil {
+		return []contourv1.Route{route}, nil
+	}
+
+	authorization, err := getBasicAuthorization(basicAuth.SecretStore, dependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	realm := basicAuth.Realm
+	if realm == "" {
+		realm = gatewayName
+	}
+
+	route.Conditions = []contourv1.MatchCondition{
+		{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:  "Authorization",
+				Exact: authorization,
+			},
+		},
+	}
+
+	unauthorized := contourv1.Route{
+		DirectResponsePolicy: &contourv1.HTTPDirectResponsePolicy{
+			StatusCode: 401,
+			Body:       "Unauthorized",
+		},
+		ResponseHeadersPolicy: &contourv1.HeadersPolicy{
+			Set: []contourv1.HeaderValue{
+				{
+					Name:  "WWW-Authenticate",
+					Value: fmt.Sprintf("Basic realm=%q", realm),
+				},
+			},
+		},
+		IPAllowFilterPolicy: route.IPAllowFilterPolicy,
+		IPDenyFilterPolicy:  route.IPDenyFilterPolicy,
+	}
+
+	return []contourv1.Route{route, unauthorized}, nil
+}
+
+// getBasicAuthorization returns the Authorization header sent by the clients with the username and password of the
+// secretStore.
+func getBasicAuthorization(secretStoreResourceId string, dependencies map[string]renderers.RendererDependency) (string, error) {
+	secretStoreResource, ok := dependencies[secretStoreResourceId]
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	secretStore, ok := secretStoreResource.Resource.(*datamodel.SecretStore)
+	if !ok || secretStore.Properties.Type != datamodel.SecretTypeBasicAuthentication {
+		return "", v1.NewClientErrInvalidRequest(invalidBasicAuthSecretStoreResource)
+	}
+
+	username, _ := secretStoreResource.ComputedValues["username"].(string)
+	password, _ := secretStoreResource.ComputedValues["password"].(string)
+	if username == "" || password == "" {
+		return "", v1.NewClientErrInvalidRequest(invalidBasicAuthSecretStoreResource + " with username and password")
+	}
+
+	credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return "Basic " + credentials, nil
+}
+
+// routeProtocol returns the protocol of the route, which defaults to HTTP.
+func routeProtocol(route *datamodel.GatewayRoute) datamodel.GatewayRouteProtocol {
+	if route.Protocol == "" {
+		return datamodel.GatewayRouteProtocolHTTP
+	}
+
+	return route.Protocol
+}
+
+// makeRouteServices creates the Contour services for the destinations of the route. The services of a route with
+// multiple destinations are weighted to split the traffic between them.
+func makeRouteServices(route *datamodel.GatewayRoute, dependencies map[string]renderers.RendererDependency) ([]contourv1.Service, error) {
+	services := []contourv1.Service{}
+	for _, destination := range routeDestinations(route) {
+		port := renderers.DefaultPort
+
+		if isURL(destination.Destination) {
+			_, _, urlPort, err := parseURL(destination.Destination)
+			if err != nil {
+				return nil, err
+			}
+			port = urlPort
+		} else {
+			routeProperties := dependencies[destination.Destination]
+			routePort, ok := routeProperties.ComputedValues["port"].(float64)
+			if ok {
+				port = int32(routePort)
+			}
+		}
+
+		name, err := getDestinationName(destination.Destination)
+		if err != nil {
+			return nil, err
+		}
+
+		// The service name must match the name of the Kubernetes service of the container, which does not depend on
+		// the configured naming strategy.
+		service := contourv1.Service{
+			Name:   kubernetes.DefaultNamingStrategy.MakeName(name),
+			Port:   int(port),
+			Weight: int64(destination.Weight),
+		}
+
+		// gRPC needs HTTP/2 to the service. Contour enables the gRPC-Web filter for all virtual hosts, so gRPC-Web
+		// requests are translated to gRPC by Envoy and only need the same upstream protocol.
+		if route.Protocol.IsGRPC() {
+			service.Protocol = to.Ptr(upstreamProtocolH2C)
+		}
+
+		services = append(services, service)
+	}
+
+	return services, nil
+}
+
+// routeDestinations returns the weighted destinations of the route, or its single destination.
+func routeDestinations(route *datamodel.GatewayRoute) []datamodel.GatewayRouteDestination {
+	if len(route.Destinations) > 0 {
+		return route.Destinations
+	}
+
+	return []datamodel.GatewayRouteDestination{{Destination: route.Destination}}
+}
+
+// getRouteName returns the name of the route, which is the hostname of its destination. The hostnames of the
+// destinations are joined for the route splitting the traffic between multiple destinations.
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
-	u, err := url.Parse(route.Destination)
+	names := []string{}
+	for _, destination := range routeDestinations(route) {
+		name, err := getDestinationName(destination.Destination)
+		if err != nil {
+			return "", err
+		}
+		names = append(names, name)
+	}
+
+	return strings.Join(names, "-"), nil
+}
+
+func getDestinationName(destination string) (string, error) {
+	u, err := url.Parse(destination)
 	if err != nil {
 		return "", v1.NewClientErrInvalidRequest(err.Error())
 	}
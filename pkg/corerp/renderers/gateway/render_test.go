@@ -623,6 +623,31 @@ func Test_Render_Fails_WithNoRoute(t *testing.T) {
 	require.Empty(t, output.ComputedValues)
 }
 
+func Test_Render_Fails_WithACICompute(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: "http://frontend:3000",
+			},
+		},
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	environmentOptions.Compute = &rpv1.EnvironmentCompute{Kind: rpv1.ACIComputeKind}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, err.(*v1.ErrClientRP).Code, v1.CodeInvalid)
+	require.Equal(t, err.(*v1.ErrClientRP).Message, "gateways are not supported by Azure Container Instances compute")
+	require.Len(t, output.Resources, 0)
+}
+
 func Test_Render_FQDNOverride(t *testing.T) {
 	r := &Renderer{}
 
@@ -781,6 +806,431 @@ func TestRender_SingleRoute_EnableWebsockets(t *testing.T) {
 	validateContourHTTPRoute(t, output.Resources, "A", expectedHTTPRouteSpec, "")
 }
 
+func Test_Render_Routes_WithProtocolAndTimeoutPolicy(t *testing.T) {
+	r := &Renderer{}
+
+	routes := []datamodel.GatewayRoute{
+		{
+			Destination:   "http://A:50051",
+			Path:          "/grpc",
+			Protocol:      datamodel.GatewayRouteProtocolGRPC,
+			TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Response: "infinity", Idle: "10m"},
+		},
+		{
+			Destination: "http://B:8080",
+			Path:        "/grpc-web",
+			Protocol:    datamodel.GatewayRouteProtocolGRPCWeb,
+		},
+		{
+			Destination: "http://C",
+			Path:        "/ws",
+			Protocol:    datamodel.GatewayRouteProtocolWebSocket,
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 4)
+
+	expectedGRPCRouteSpec := createExpectedHTTPRouteSpec("A", 50051, nil, false)
+	expectedGRPCRouteSpec.Routes[0].Services[0].Protocol = to.Ptr("h2c")
+	expectedGRPCRouteSpec.Routes[0].TimeoutPolicy = &contourv1.TimeoutPolicy{Response: "infinity", Idle: "10m"}
+	validateContourHTTPRoute(t, output.Resources, "A", expectedGRPCRouteSpec, "")
+
+	expectedGRPCWebRouteSpec := createExpectedHTTPRouteSpec("B", 8080, nil, false)
+	expectedGRPCWebRouteSpec.Routes[0].Services[0].Protocol = to.Ptr("h2c")
+	validateContourHTTPRoute(t, output.Resources, "B", expectedGRPCWebRouteSpec, "")
+
+	expectedWebsocketRouteSpec := createExpectedHTTPRouteSpec("C", 80, nil, true)
+	validateContourHTTPRoute(t, output.Resources, "C", expectedWebsocketRouteSpec, "")
+}
+
+func Test_Render_Fails_RoutesWithSameDestinationAndDifferentProtocols(t *testing.T) {
+	r := &Renderer{}
+
+	routes := []datamodel.GatewayRoute{
+		{
+			Destination: "http://A",
+			Path:        "/api",
+		},
+		{
+			Destination: "http://A",
+			Path:        "/grpc",
+			Protocol:    datamodel.GatewayRouteProtocolGRPC,
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+	require.Equal(t, "routes with the destination \"http://A\" must use the same protocol and timeoutPolicy", err.(*v1.ErrClientRP).Message)
+}
+
+func Test_Render_Routes_WithTrafficSplitAndLoadBalancerPolicy(t *testing.T) {
+	r := &Renderer{}
+
+	routes := []datamodel.GatewayRoute{
+		{
+			Path: "/",
+			Destinations: []datamodel.GatewayRouteDestination{
+				{Destination: "http://blue:3000", Weight: 90},
+				{Destination: "http://green:3000", Weight: 10},
+			},
+			LoadBalancerPolicy: &datamodel.GatewayRouteLoadBalancerPolicy{Strategy: datamodel.GatewayRouteLoadBalancerStrategyCookie},
+		},
+		{
+			Destination:        "http://api",
+			Path:               "/api",
+			LoadBalancerPolicy: &datamodel.GatewayRouteLoadBalancerPolicy{Strategy: datamodel.GatewayRouteLoadBalancerStrategyLeastRequest},
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP),
+		},
+		Includes: []contourv1.Include{
+			{
+				Name:       "blue-green",
+				Conditions: []contourv1.MatchCondition{{Prefix: "/"}},
+			},
+			{
+				Name:       "api",
+				Conditions: []contourv1.MatchCondition{{Prefix: "/api"}},
+			},
+		},
+	}
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+
+	expectedSplitRouteSpec := contourv1.HTTPProxySpec{
+		Routes: []contourv1.Route{
+			{
+				Services: []contourv1.Service{
+					{Name: "blue", Port: 3000, Weight: 90},
+					{Name: "green", Port: 3000, Weight: 10},
+				},
+				LoadBalancerPolicy: &contourv1.LoadBalancerPolicy{Strategy: "Cookie"},
+			},
+		},
+	}
+	validateContourHTTPRoute(t, output.Resources, "blue-green", expectedSplitRouteSpec, "")
+
+	expectedAPIRouteSpec := createExpectedHTTPRouteSpec("api", 80, nil, false)
+	expectedAPIRouteSpec.Routes[0].LoadBalancerPolicy = &contourv1.LoadBalancerPolicy{Strategy: "WeightedLeastRequest"}
+	validateContourHTTPRoute(t, output.Resources, "api", expectedAPIRouteSpec, "")
+}
+
+func Test_Render_Routes_WithSecurity(t *testing.T) {
+	r := &Renderer{}
+
+	credentialsID := makeSecretStoreResourceID("credentials")
+	adminCredentialsID := makeSecretStoreResourceID("admincredentials")
+	routes := []datamodel.GatewayRoute{
+		{
+			Destination: "http://frontend",
+			Path:        "/",
+		},
+		{
+			Destination: "http://admin",
+			Path:        "/admin",
+			Security: &datamodel.GatewaySecurity{
+				IPAllowList: []string{"10.0.0.1"},
+				BasicAuth:   &datamodel.GatewayBasicAuth{SecretStore: adminCredentialsID, Realm: "admin"},
+			},
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+		Security: &datamodel.GatewaySecurity{
+			IPAllowList: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			BasicAuth:   &datamodel.GatewayBasicAuth{SecretStore: credentialsID},
+		},
+	}
+	resource := makeResource(properties)
+
+	radiusResourceIDs, _, err := r.GetDependencyIDs(context.Background(), resource)
+	require.NoError(t, err)
+	require.Equal(t, []resources.ID{resources.MustParse(credentialsID), resources.MustParse(adminCredentialsID)}, radiusResourceIDs)
+
+	makeDependency := func(id, username, password string) renderers.RendererDependency {
+		return renderers.RendererDependency{
+			ResourceID: resources.MustParse(id),
+			Resource: makeSecretStoreResource(datamodel.SecretStoreProperties{
+				Type: datamodel.SecretTypeBasicAuthentication,
+				Data: map[string]*datamodel.SecretStoreDataValue{
+					"username": {},
+					"password": {},
+				},
+			}),
+			ComputedValues: map[string]any{
+				"username": username,
+				"password": password,
+			},
+		}
+	}
+	dependencies := map[string]renderers.RendererDependency{
+		credentialsID:      makeDependency(credentialsID, "user", "secret"),
+		adminCredentialsID: makeDependency(adminCredentialsID, "admin", "topsecret"),
+	}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP),
+			IPAllowFilterPolicy: []contourv1.IPFilterPolicy{
+				{Source: contourv1.IPFilterSourceRemote, CIDR: "10.0.0.0/8"},
+				{Source: contourv1.IPFilterSourceRemote, CIDR: "192.168.0.0/16"},
+			},
+		},
+		Includes: []contourv1.Include{
+			{
+				Name:       "frontend",
+				Conditions: []contourv1.MatchCondition{{Prefix: "/"}},
+			},
+			{
+				Name:       "admin",
+				Conditions: []contourv1.MatchCondition{{Prefix: "/admin"}},
+			},
+		},
+	}
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+
+	// "user:secret"
+	expectedFrontendRouteSpec := createExpectedHTTPRouteSpec("frontend", 80, nil, false)
+	expectedFrontendRouteSpec.Routes[0].Conditions = []contourv1.MatchCondition{
+		{Header: &contourv1.HeaderMatchCondition{Name: "Authorization", Exact: "Basic dXNlcjpzZWNyZXQ="}},
+	}
+	expectedFrontendRouteSpec.Routes = append(expectedFrontendRouteSpec.Routes, contourv1.Route{
+		DirectResponsePolicy: &contourv1.HTTPDirectResponsePolicy{StatusCode: 401, Body: "Unauthorized"},
+		ResponseHeadersPolicy: &contourv1.HeadersPolicy{
+			Set: []contourv1.HeaderValue{{Name: "WWW-Authenticate", Value: `Basic realm="test-gateway"`}},
+		},
+	})
+	validateContourHTTPRoute(t, output.Resources, "frontend", expectedFrontendRouteSpec, "")
+
+	// "admin:topsecret"
+	ipAllowFilterPolicy := []contourv1.IPFilterPolicy{{Source: contourv1.IPFilterSourceRemote, CIDR: "10.0.0.1"}}
+	expectedAdminRouteSpec := createExpectedHTTPRouteSpec("admin", 80, nil, false)
+	expectedAdminRouteSpec.Routes[0].Conditions = []contourv1.MatchCondition{
+		{Header: &contourv1.HeaderMatchCondition{Name: "Authorization", Exact: "Basic YWRtaW46dG9wc2VjcmV0"}},
+	}
+	expectedAdminRouteSpec.Routes[0].IPAllowFilterPolicy = ipAllowFilterPolicy
+	expectedAdminRouteSpec.Routes = append(expectedAdminRouteSpec.Routes, contourv1.Route{
+		DirectResponsePolicy: &contourv1.HTTPDirectResponsePolicy{StatusCode: 401, Body: "Unauthorized"},
+		ResponseHeadersPolicy: &contourv1.HeadersPolicy{
+			Set: []contourv1.HeaderValue{{Name: "WWW-Authenticate", Value: `Basic realm="admin"`}},
+		},
+		IPAllowFilterPolicy: ipAllowFilterPolicy,
+	})
+	validateContourHTTPRoute(t, output.Resources, "admin", expectedAdminRouteSpec, "")
+}
+
+func Test_Render_Fails_BasicAuthWithInvalidSecretStore(t *testing.T) {
+	r := &Renderer{}
+
+	secretStoreID := makeSecretStoreResourceID("credentials")
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: "http://frontend",
+				Path:        "/",
+			},
+		},
+		Security: &datamodel.GatewaySecurity{
+			BasicAuth: &datamodel.GatewayBasicAuth{SecretStore: secretStoreID},
+		},
+	}
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	tests := []struct {
+		desc         string
+		dependencies map[string]renderers.RendererDependency
+		message      string
+	}{
+		{
+			desc:         "missing secretStore",
+			dependencies: map[string]renderers.RendererDependency{},
+			message:      "secretStore resource " + secretStoreID + " not found",
+		},
+		{
+			desc: "generic secretStore",
+			dependencies: map[string]renderers.RendererDependency{
+				secretStoreID: {
+					Resource: makeSecretStoreResource(datamodel.SecretStoreProperties{Type: datamodel.SecretTypeGeneric}),
+				},
+			},
+			message: "basicAuth.secretStore must reference a secretStore resource with type basicAuthentication",
+		},
+		{
+			desc: "missing password",
+			dependencies: map[string]renderers.RendererDependency{
+				secretStoreID: {
+					Resource:       makeSecretStoreResource(datamodel.SecretStoreProperties{Type: datamodel.SecretTypeBasicAuthentication}),
+					ComputedValues: map[string]any{"username": "user"},
+				},
+			},
+			message: "basicAuth.secretStore must reference a secretStore resource with type basicAuthentication with username and password",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: tc.dependencies, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.message, err.(*v1.ErrClientRP).Message)
+		})
+	}
+}
+
+func Test_Render_Fails_RoutesWithSameDestinationsAndDifferentWeights(t *testing.T) {
+	r := &Renderer{}
+
+	routes := []datamodel.GatewayRoute{
+		{
+			Path: "/",
+			Destinations: []datamodel.GatewayRouteDestination{
+				{Destination: "http://blue", Weight: 90},
+				{Destination: "http://green", Weight: 10},
+			},
+		},
+		{
+			Path: "/api",
+			Destinations: []datamodel.GatewayRouteDestination{
+				{Destination: "http://blue", Weight: 50},
+				{Destination: "http://green", Weight: 50},
+			},
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+	require.Equal(t, "routes with the destinations \"blue-green\" must use the same loadBalancerPolicy and weights", err.(*v1.ErrClientRP).Message)
+}
+
+func Test_Render_Fails_RoutesWithCollidingNames(t *testing.T) {
+	r := &Renderer{NamingStrategy: kubernetes.DefaultNamingStrategy}
+
+	longName := "this-is-a-very-long-destination-name-that-is-longer-than-the-maximum-length"
+	truncatedName := kubernetes.DefaultNamingStrategy.MakeName(longName)
+
+	routes := []datamodel.GatewayRoute{
+		{
+			Destination: "http://" + longName,
+			Path:        "/a",
+		},
+		{
+			Destination: "http://" + truncatedName,
+			Path:        "/b",
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.Error(t, err)
+	require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+	require.Equal(t, fmt.Sprintf("name %q generated for %q collides with the name generated for %q", truncatedName, truncatedName, longName), err.(*v1.ErrClientRP).Message)
+}
+
+func Test_Render_RouteNamesWithNamingStrategy(t *testing.T) {
+	naming, err := kubernetes.NewNamingStrategy(20)
+	require.NoError(t, err)
+	r := &Renderer{NamingStrategy: naming}
+
+	destinationName := "this-is-a-long-destination-name"
+	routes := []datamodel.GatewayRoute{
+		{
+			Destination: "http://" + destinationName,
+			Path:        "/",
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+
+	routeName := naming.MakeName(destinationName)
+	require.Len(t, routeName, 20)
+
+	httpRoute, _ := kubernetes.FindContourHTTPProxyByLocalID(output.Resources, fmt.Sprintf("%s-%s", rpv1.LocalIDHttpProxy, destinationName))
+	require.NotNil(t, httpRoute)
+	require.Equal(t, routeName, httpRoute.Name)
+
+	// The root HTTPProxy includes the route by its generated name, while the service keeps the name of the container.
+	gateway, _ := kubernetes.FindContourHTTPProxy(output.Resources)
+	require.NotNil(t, gateway)
+	require.Equal(t, routeName, gateway.Spec.Includes[0].Name)
+	require.Equal(t, destinationName, httpRoute.Spec.Routes[0].Services[0].Name)
+}
+
 func Test_Render_SSLPassthrough(t *testing.T) {
 	r := &Renderer{}
 
@@ -62,6 +62,8 @@ type RendererDependency struct {
 
 // EnvironmentOptions represents the options for the linked environment resource.
 type EnvironmentOptions struct {
+	// Compute represents the compute of the environment.
+	Compute *rpv1.EnvironmentCompute
 	// Namespace represents the Kubernetes namespace.
 	Namespace string
 	// Providers represents the cloud provider's configurations.
@@ -72,6 +74,8 @@ type EnvironmentOptions struct {
 	Identity *rpv1.IdentitySettings
 	// KubernetesMetadata represents the Environment KubernetesMetadata extension.
 	KubernetesMetadata *datamodel.KubeMetadataExtension
+	// CostAttribution represents the Environment CostAttribution extension.
+	CostAttribution *datamodel.CostAttributionExtension
 	// Simulated represents whether the environment is a simulated environment.
 	Simulated bool
 }
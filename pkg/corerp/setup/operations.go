@@ -69,6 +69,16 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/environments/planrecipe/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "environments",
+			Operation:   "Plan recipe",
+			Description: "Compute the changes the deployment of a recipe would make.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Core/environments/join/action",
 		Display: &v1.OperationDisplayProperties{
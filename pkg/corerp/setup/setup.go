@@ -22,6 +22,7 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	backend_ctrl "github.com/radius-project/radius/pkg/corerp/backend/controller"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
@@ -41,6 +42,9 @@ import (
 const (
 	// AsyncOperationRetryAfter is polling interval for async create/update or delete resource operations.
 	AsyncOperationRetryAfter = time.Duration(5) * time.Second
+
+	// RevisionHistoryLimit is the maximum number of prior revisions kept for each resource.
+	RevisionHistoryLimit = 10
 )
 
 // SetupNamespace builds the namespace for core resource provider.
@@ -57,29 +61,42 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.Environment]{
 			APIController: env_ctrl.NewCreateOrUpdateEnvironment,
 		},
+		Delete: builder.Operation[datamodel.Environment]{
+			DeleteFilters: []apictrl.DeleteFilter[datamodel.Environment]{
+				env_ctrl.ValidateNoApplications,
+			},
+		},
 		Custom: map[string]builder.Operation[datamodel.Environment]{
 			"getmetadata": {
 				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
 					return env_ctrl.NewGetRecipeMetadata(opt, recipeControllerConfig.Engine)
 				},
 			},
+			"planrecipe": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewPlanRecipe(opt, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
 	_ = ns.AddResource("applications", &builder.ResourceOption[*datamodel.Application, datamodel.Application]{
-		RequestConverter:  converter.ApplicationDataModelFromVersioned,
-		ResponseConverter: converter.ApplicationDataModelToVersioned,
+		RequestConverter:     converter.ApplicationDataModelFromVersioned,
+		ResponseConverter:    converter.ApplicationDataModelToVersioned,
+		RevisionHistoryLimit: RevisionHistoryLimit,
 
 		Put: builder.Operation[datamodel.Application]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Application]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Application],
-				app_ctrl.CreateAppScopedNamespace,
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.Application],
+				app_ctrl.NewCreateAppScopedNamespace(recipeControllerConfig.NamingStrategy),
 			},
 		},
 		Patch: builder.Operation[datamodel.Application]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Application]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Application],
-				app_ctrl.CreateAppScopedNamespace,
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.Application],
+				app_ctrl.NewCreateAppScopedNamespace(recipeControllerConfig.NamingStrategy),
 			},
 		},
 		Custom: map[string]builder.Operation[datamodel.Application]{
@@ -88,42 +105,64 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return app_ctrl.NewGetGraph(opt, *recipeControllerConfig.UCPConnection)
 				},
 			},
+			"getDevProfile": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return app_ctrl.NewGetDevProfile(opt, *recipeControllerConfig.UCPConnection)
+				},
+			},
 		},
 	})
 
 	_ = ns.AddResource("containers", &builder.ResourceOption[*datamodel.ContainerResource, datamodel.ContainerResource]{
-		RequestConverter:  converter.ContainerDataModelFromVersioned,
-		ResponseConverter: converter.ContainerDataModelToVersioned,
+		RequestConverter:     converter.ContainerDataModelFromVersioned,
+		ResponseConverter:    converter.ContainerDataModelToVersioned,
+		RevisionHistoryLimit: RevisionHistoryLimit,
 
 		Put: builder.Operation[datamodel.ContainerResource]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.ContainerResource]{
 				rp_frontend.PrepareRadiusResource[*datamodel.ContainerResource],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.ContainerResource],
+				rp_frontend.ValidateQuota[*datamodel.ContainerResource],
+				rp_frontend.ValidateImagePolicy,
 				ctr_ctrl.ValidateAndMutateRequest,
 			},
 			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
+			AsyncOperationTimeout:    ctr_ctrl.AsyncCreateOrUpdateContainerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
 		Patch: builder.Operation[datamodel.ContainerResource]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.ContainerResource]{
 				rp_frontend.PrepareRadiusResource[*datamodel.ContainerResource],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.ContainerResource],
+				rp_frontend.ValidateQuota[*datamodel.ContainerResource],
+				rp_frontend.ValidateImagePolicy,
 				ctr_ctrl.ValidateAndMutateRequest,
 			},
 			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
+			AsyncOperationTimeout:    ctr_ctrl.AsyncCreateOrUpdateContainerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
 		Delete: builder.Operation[datamodel.ContainerResource]{
 			AsyncJobController:       backend_ctrl.NewDeleteResource,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
+		Custom: map[string]builder.Operation[datamodel.ContainerResource]{
+			"listsecretreferences": {
+				APIController: ctr_ctrl.NewListSecretReferences,
+			},
+		},
 	})
 
 	_ = ns.AddResource("gateways", &builder.ResourceOption[*datamodel.Gateway, datamodel.Gateway]{
-		RequestConverter:  converter.GatewayDataModelFromVersioned,
-		ResponseConverter: converter.GatewayDataModelToVersioned,
+		RequestConverter:     converter.GatewayDataModelFromVersioned,
+		ResponseConverter:    converter.GatewayDataModelToVersioned,
+		RevisionHistoryLimit: RevisionHistoryLimit,
 
 		Put: builder.Operation[datamodel.Gateway]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Gateway]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Gateway],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.Gateway],
+				rp_frontend.ValidateQuota[*datamodel.Gateway],
 				gw_ctrl.ValidateAndMutateRequest,
 			},
 			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
@@ -132,6 +171,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.Gateway]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Gateway]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Gateway],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.Gateway],
+				rp_frontend.ValidateQuota[*datamodel.Gateway],
 				gw_ctrl.ValidateAndMutateRequest,
 			},
 			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
@@ -144,12 +185,15 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	})
 
 	_ = ns.AddResource("volumes", &builder.ResourceOption[*datamodel.VolumeResource, datamodel.VolumeResource]{
-		RequestConverter:  converter.VolumeResourceModelFromVersioned,
-		ResponseConverter: converter.VolumeResourceModelToVersioned,
+		RequestConverter:     converter.VolumeResourceModelFromVersioned,
+		ResponseConverter:    converter.VolumeResourceModelToVersioned,
+		RevisionHistoryLimit: RevisionHistoryLimit,
 
 		Put: builder.Operation[datamodel.VolumeResource]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.VolumeResource]{
 				rp_frontend.PrepareRadiusResource[*datamodel.VolumeResource],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.VolumeResource],
+				rp_frontend.ValidateQuota[*datamodel.VolumeResource],
 				vol_ctrl.ValidateRequest,
 			},
 			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
@@ -158,6 +202,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.VolumeResource]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.VolumeResource]{
 				rp_frontend.PrepareRadiusResource[*datamodel.VolumeResource],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.VolumeResource],
+				rp_frontend.ValidateQuota[*datamodel.VolumeResource],
 				vol_ctrl.ValidateRequest,
 			},
 			AsyncJobController:       backend_ctrl.NewCreateOrUpdateResource,
@@ -176,14 +222,20 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Put: builder.Operation[datamodel.SecretStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SecretStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.SecretStore],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.SecretStore],
+				rp_frontend.ValidateQuota[*datamodel.SecretStore],
 				secret_ctrl.ValidateAndMutateRequest,
+				secret_ctrl.NewSyncToDestination(recipeControllerConfig.ResourceClient),
 				secret_ctrl.UpsertSecret,
 			},
 		},
 		Patch: builder.Operation[datamodel.SecretStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SecretStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.SecretStore],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.SecretStore],
+				rp_frontend.ValidateQuota[*datamodel.SecretStore],
 				secret_ctrl.ValidateAndMutateRequest,
+				secret_ctrl.NewSyncToDestination(recipeControllerConfig.ResourceClient),
 				secret_ctrl.UpsertSecret,
 			},
 		},
@@ -196,16 +248,30 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: secret_ctrl.NewListSecrets,
 			},
+			"listsecretreferences": {
+				APIController: secret_ctrl.NewListSecretReferences,
+			},
+			"rotate": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return secret_ctrl.NewRotateSecrets(opt, recipeControllerConfig.ResourceClient)
+				},
+			},
 		},
 	})
 
 	_ = ns.AddResource("extenders", &builder.ResourceOption[*datamodel.Extender, datamodel.Extender]{
-		RequestConverter:  converter.ExtenderDataModelFromVersioned,
-		ResponseConverter: converter.ExtenderDataModelToVersioned,
+		RequestConverter:     converter.ExtenderDataModelFromVersioned,
+		ResponseConverter:    converter.ExtenderDataModelToVersioned,
+		RevisionHistoryLimit: RevisionHistoryLimit,
+		Defaulters: []apictrl.Defaulter[datamodel.Extender]{
+			rp_frontend.DefaultRecipeName[*datamodel.Extender],
+		},
 
 		Put: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.Extender],
+				rp_frontend.ValidateQuota[*datamodel.Extender],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -216,6 +282,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
 				rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.Extender],
+				rp_frontend.ValidateQuota[*datamodel.Extender],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -237,6 +305,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		},
 	})
 
+	ns.SetAPIVersions(v20231001preview.Version)
+
 	// Optional
 	ns.SetAvailableOperations(operationList)
 
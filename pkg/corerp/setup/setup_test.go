@@ -91,6 +91,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.core/containers/ctr0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName, Method: "ACTIONLISTSECRETREFERENCES"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/containers/ctr0/listsecretreferences",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/environments",
@@ -119,6 +123,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONGETMETADATA"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/getmetadata",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONPLANRECIPE"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/planrecipe",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: gtwy_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/gateways",
@@ -171,6 +179,14 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: secret_ctrl.ResourceTypeName, Method: "ACTIONLISTSECRETS"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/secretstores/secret0/listsecrets",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: secret_ctrl.ResourceTypeName, Method: "ACTIONLISTSECRETREFERENCES"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/secretstores/secret0/listsecretreferences",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: secret_ctrl.ResourceTypeName, Method: "ACTIONROTATE"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/secretstores/secret0/rotate",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: vol_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/volumes",
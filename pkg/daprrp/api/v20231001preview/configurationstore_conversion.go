@@ -85,6 +85,8 @@ func (src *DaprConfigurationStoreResource) ConvertTo() (v1.DataModelInterface, e
 		converted.Properties.Metadata = toMetadataDataModel(src.Properties.Metadata)
 		converted.Properties.Type = to.String(src.Properties.Type)
 		converted.Properties.Version = to.String(src.Properties.Version)
+		msgs = append(msgs, validateScopes(src.Properties.Scopes)...)
+		converted.Properties.Scopes = toScopesDataModel(src.Properties.Scopes)
 	} else {
 		if src.Properties.Metadata != nil && (!reflect.ValueOf(src.Properties.Metadata).IsZero()) {
 			msgs = append(msgs, "metadata cannot be specified when resourceProvisioning is set to recipe (default)")
@@ -95,6 +97,9 @@ func (src *DaprConfigurationStoreResource) ConvertTo() (v1.DataModelInterface, e
 		if src.Properties.Version != nil && (!reflect.ValueOf(*src.Properties.Version).IsZero()) {
 			msgs = append(msgs, "version cannot be specified when resourceProvisioning is set to recipe (default)")
 		}
+		if len(src.Properties.Scopes) > 0 {
+			msgs = append(msgs, "scopes cannot be specified when resourceProvisioning is set to recipe (default)")
+		}
 
 		converted.Properties.Recipe = toRecipeDataModel(src.Properties.Recipe)
 	}
@@ -132,6 +137,7 @@ func (dst *DaprConfigurationStoreResource) ConvertFrom(src v1.DataModelInterface
 		ProvisioningState:    fromProvisioningStateDataModel(daprConfigstore.InternalMetadata.AsyncProvisioningState),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprConfigstore.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(daprConfigstore.Properties.Status.Health),
 			Recipe:          fromRecipeStatus(daprConfigstore.Properties.Status.Recipe),
 		},
 		Auth: fromAuthDataModel(daprConfigstore.Properties.Auth),
@@ -141,6 +147,7 @@ func (dst *DaprConfigurationStoreResource) ConvertFrom(src v1.DataModelInterface
 		dst.Properties.Metadata = fromMetadataDataModel(daprConfigstore.Properties.Metadata)
 		dst.Properties.Type = to.Ptr(daprConfigstore.Properties.Type)
 		dst.Properties.Version = to.Ptr(daprConfigstore.Properties.Version)
+		dst.Properties.Scopes = fromScopesDataModel(daprConfigstore.Properties.Scopes)
 	} else {
 		dst.Properties.Recipe = fromRecipeDataModel(daprConfigstore.Properties.Recipe)
 	}
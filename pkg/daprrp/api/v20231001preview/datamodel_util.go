@@ -18,11 +18,14 @@ package v20231001preview
 
 import (
 	"fmt"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 func toProvisioningStateDataModel(state *ProvisioningState) v1.ProvisioningState {
@@ -126,17 +129,14 @@ func fromSystemDataModel(s v1.SystemData) *SystemData {
 	}
 }
 
+// toRecipeDataModel converts the recipe of a request to the data model. The default recipe name is applied by the
+// frontend defaulters of the resource type.
 func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
 	if r == nil {
-		return portableresources.ResourceRecipe{
-			Name: portableresources.DefaultRecipeName,
-		}
+		return portableresources.ResourceRecipe{}
 	}
-	recipe := portableresources.ResourceRecipe{}
-	if r.Name == nil {
-		recipe.Name = portableresources.DefaultRecipeName
-	} else {
-		recipe.Name = to.String(r.Name)
+	recipe := portableresources.ResourceRecipe{
+		Name: to.String(r.Name),
 	}
 	if r.Parameters != nil {
 		recipe.Parameters = r.Parameters
@@ -213,6 +213,38 @@ func fromAuthDataModel(auth *rpv1.DaprComponentAuth) *DaprResourceAuth {
 	}
 }
 
+// validateScopes returns a validation message for each scope which is not the resource ID of a container.
+func validateScopes(scopes []*string) []string {
+	msgs := []string{}
+	for _, scope := range scopes {
+		id, err := resources.ParseResource(to.String(scope))
+		if err != nil || !strings.EqualFold(id.Type(), corerp_dm.ContainerResourceType) {
+			msgs = append(msgs, fmt.Sprintf("scope %q must be the resource ID of an %s resource", to.String(scope), corerp_dm.ContainerResourceType))
+		}
+	}
+	return msgs
+}
+
+func toScopesDataModel(scopes []*string) []string {
+	if scopes == nil {
+		return nil
+	}
+
+	converted := make([]string, len(scopes))
+	for i, scope := range scopes {
+		converted[i] = to.String(scope)
+	}
+	return converted
+}
+
+func fromScopesDataModel(scopes []string) []*string {
+	if scopes == nil {
+		return nil
+	}
+
+	return to.SliceOfPtrs(scopes...)
+}
+
 func toResourcesDataModel(r []*ResourceReference) []*portableresources.ResourceReference {
 	if r == nil {
 		return nil
@@ -258,3 +290,23 @@ func toOutputResources(outputResources []rpv1.OutputResource) []*OutputResource
 	}
 	return outResources
 }
+
+func fromResourceHealth(health *rpv1.ResourceHealth) *ResourceHealth {
+	if health == nil {
+		return nil
+	}
+
+	result := &ResourceHealth{
+		State: to.Ptr(ResourceHealthState(health.State)),
+	}
+
+	// We will not serialize the following fields if they are empty.
+	if health.Message != "" {
+		result.Message = to.Ptr(health.Message)
+	}
+	if !health.LastTransitionTime.IsZero() {
+		result.LastTransitionTime = to.Ptr(health.LastTransitionTime)
+	}
+
+	return result
+}
@@ -256,9 +256,7 @@ func TestToRecipeDataModel(t *testing.T) {
 	}{
 		{
 			nil,
-			portableresources.ResourceRecipe{
-				Name: portableresources.DefaultRecipeName,
-			},
+			portableresources.ResourceRecipe{},
 		},
 		{
 			&Recipe{
@@ -281,7 +279,6 @@ func TestToRecipeDataModel(t *testing.T) {
 				},
 			},
 			portableresources.ResourceRecipe{
-				Name: portableresources.DefaultRecipeName,
 				Parameters: map[string]any{
 					"foo": "bar",
 				},
@@ -83,6 +83,8 @@ func (src *DaprPubSubBrokerResource) ConvertTo() (v1.DataModelInterface, error)
 		converted.Properties.Metadata = toMetadataDataModel(src.Properties.Metadata)
 		converted.Properties.Type = to.String(src.Properties.Type)
 		converted.Properties.Version = to.String(src.Properties.Version)
+		msgs = append(msgs, validateScopes(src.Properties.Scopes)...)
+		converted.Properties.Scopes = toScopesDataModel(src.Properties.Scopes)
 	} else {
 		if src.Properties.Metadata != nil && (!reflect.ValueOf(src.Properties.Metadata).IsZero()) {
 			msgs = append(msgs, "metadata cannot be specified when resourceProvisioning is set to recipe (default)")
@@ -93,6 +95,9 @@ func (src *DaprPubSubBrokerResource) ConvertTo() (v1.DataModelInterface, error)
 		if src.Properties.Version != nil && (!reflect.ValueOf(*src.Properties.Version).IsZero()) {
 			msgs = append(msgs, "version cannot be specified when resourceProvisioning is set to recipe (default)")
 		}
+		if len(src.Properties.Scopes) > 0 {
+			msgs = append(msgs, "scopes cannot be specified when resourceProvisioning is set to recipe (default)")
+		}
 
 		converted.Properties.Recipe = toRecipeDataModel(src.Properties.Recipe)
 	}
@@ -130,6 +135,7 @@ func (dst *DaprPubSubBrokerResource) ConvertFrom(src v1.DataModelInterface) erro
 		ProvisioningState:    fromProvisioningStateDataModel(daprPubSub.InternalMetadata.AsyncProvisioningState),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprPubSub.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(daprPubSub.Properties.Status.Health),
 			Recipe:          fromRecipeStatus(daprPubSub.Properties.Status.Recipe),
 		},
 		Auth: fromAuthDataModel(daprPubSub.Properties.Auth),
@@ -139,6 +145,7 @@ func (dst *DaprPubSubBrokerResource) ConvertFrom(src v1.DataModelInterface) erro
 		dst.Properties.Metadata = fromMetadataDataModel(daprPubSub.Properties.Metadata)
 		dst.Properties.Type = to.Ptr(daprPubSub.Properties.Type)
 		dst.Properties.Version = to.Ptr(daprPubSub.Properties.Version)
+		dst.Properties.Scopes = fromScopesDataModel(daprPubSub.Properties.Scopes)
 	} else {
 		dst.Properties.Recipe = fromRecipeDataModel(daprPubSub.Properties.Recipe)
 	}
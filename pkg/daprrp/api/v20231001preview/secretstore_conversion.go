@@ -76,6 +76,8 @@ func (src *DaprSecretStoreResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Metadata = toMetadataDataModel(src.Properties.Metadata)
 		converted.Properties.Type = to.String(src.Properties.Type)
 		converted.Properties.Version = to.String(src.Properties.Version)
+		msgs = append(msgs, validateScopes(src.Properties.Scopes)...)
+		converted.Properties.Scopes = toScopesDataModel(src.Properties.Scopes)
 	} else {
 		if src.Properties.Metadata != nil && (!reflect.ValueOf(src.Properties.Metadata).IsZero()) {
 			msgs = append(msgs, "metadata cannot be specified when resourceProvisioning is set to recipe (default)")
@@ -86,6 +88,9 @@ func (src *DaprSecretStoreResource) ConvertTo() (v1.DataModelInterface, error) {
 		if src.Properties.Version != nil && (!reflect.ValueOf(*src.Properties.Version).IsZero()) {
 			msgs = append(msgs, "version cannot be specified when resourceProvisioning is set to recipe (default)")
 		}
+		if len(src.Properties.Scopes) > 0 {
+			msgs = append(msgs, "scopes cannot be specified when resourceProvisioning is set to recipe (default)")
+		}
 
 		converted.Properties.Recipe = toRecipeDataModel(src.Properties.Recipe)
 	}
@@ -124,6 +129,7 @@ func (dst *DaprSecretStoreResource) ConvertFrom(src v1.DataModelInterface) error
 		ComponentName:        to.Ptr(daprSecretStore.Properties.ComponentName),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprSecretStore.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(daprSecretStore.Properties.Status.Health),
 			Recipe:          fromRecipeStatus(daprSecretStore.Properties.Status.Recipe),
 		},
 	}
@@ -131,6 +137,7 @@ func (dst *DaprSecretStoreResource) ConvertFrom(src v1.DataModelInterface) error
 		dst.Properties.Metadata = fromMetadataDataModel(daprSecretStore.Properties.Metadata)
 		dst.Properties.Type = to.Ptr(daprSecretStore.Properties.Type)
 		dst.Properties.Version = to.Ptr(daprSecretStore.Properties.Version)
+		dst.Properties.Scopes = fromScopesDataModel(daprSecretStore.Properties.Scopes)
 	} else {
 		dst.Properties.Recipe = fromRecipeDataModel(daprSecretStore.Properties.Recipe)
 	}
@@ -68,6 +68,11 @@ func (src *DaprStateStoreResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Metadata = toMetadataDataModel(src.Properties.Metadata)
 		converted.Properties.Type = to.String(src.Properties.Type)
 		converted.Properties.Version = to.String(src.Properties.Version)
+		msgs = append(msgs, validateScopes(src.Properties.Scopes)...)
+		converted.Properties.Scopes = toScopesDataModel(src.Properties.Scopes)
+		msgs = append(msgs, validateActorStateStore(src.Properties)...)
+		converted.Properties.ActorStateStore = to.Bool(src.Properties.ActorStateStore)
+		converted.Properties.TTLInSeconds = src.Properties.TTLInSeconds
 	} else {
 		if src.Properties.Metadata != nil && (!reflect.ValueOf(src.Properties.Metadata).IsZero()) {
 			msgs = append(msgs, "metadata cannot be specified when resourceProvisioning is set to recipe (default)")
@@ -78,6 +83,15 @@ func (src *DaprStateStoreResource) ConvertTo() (v1.DataModelInterface, error) {
 		if src.Properties.Version != nil && (!reflect.ValueOf(*src.Properties.Version).IsZero()) {
 			msgs = append(msgs, "version cannot be specified when resourceProvisioning is set to recipe (default)")
 		}
+		if len(src.Properties.Scopes) > 0 {
+			msgs = append(msgs, "scopes cannot be specified when resourceProvisioning is set to recipe (default)")
+		}
+		if to.Bool(src.Properties.ActorStateStore) {
+			msgs = append(msgs, "actorStateStore cannot be specified when resourceProvisioning is set to recipe (default)")
+		}
+		if src.Properties.TTLInSeconds != nil {
+			msgs = append(msgs, "ttlInSeconds cannot be specified when resourceProvisioning is set to recipe (default)")
+		}
 
 		converted.Properties.Recipe = toRecipeDataModel(src.Properties.Recipe)
 	}
@@ -108,6 +122,7 @@ func (dst *DaprStateStoreResource) ConvertFrom(src v1.DataModelInterface) error
 	dst.Properties = &DaprStateStoreProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprStateStore.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(daprStateStore.Properties.Status.Health),
 			Recipe:          fromRecipeStatus(daprStateStore.Properties.Status.Recipe),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(daprStateStore.InternalMetadata.AsyncProvisioningState),
@@ -122,10 +137,32 @@ func (dst *DaprStateStoreResource) ConvertFrom(src v1.DataModelInterface) error
 	if daprStateStore.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
 		dst.Properties.Type = to.Ptr(daprStateStore.Properties.Type)
 		dst.Properties.Version = to.Ptr(daprStateStore.Properties.Version)
+		dst.Properties.Scopes = fromScopesDataModel(daprStateStore.Properties.Scopes)
 		dst.Properties.Metadata = fromMetadataDataModel(daprStateStore.Properties.Metadata)
+		dst.Properties.TTLInSeconds = daprStateStore.Properties.TTLInSeconds
+		if daprStateStore.Properties.ActorStateStore {
+			dst.Properties.ActorStateStore = to.Ptr(true)
+		}
 	} else {
 		dst.Properties.Recipe = fromRecipeDataModel(daprStateStore.Properties.Recipe)
 	}
 
 	return nil
 }
+
+// validateActorStateStore validates that the actor state store properties are not also specified in the metadata, since
+// they are rendered as the metadata of the Dapr component.
+func validateActorStateStore(properties *DaprStateStoreProperties) []string {
+	msgs := []string{}
+	if _, ok := properties.Metadata[datamodel.ActorStateStoreMetadataKey]; ok && to.Bool(properties.ActorStateStore) {
+		msgs = append(msgs, "actorStateStore cannot be specified in both the properties and the metadata")
+	}
+	if _, ok := properties.Metadata[datamodel.TTLInSecondsMetadataKey]; ok && properties.TTLInSeconds != nil {
+		msgs = append(msgs, "ttlInSeconds cannot be specified in both the properties and the metadata")
+	}
+	if properties.TTLInSeconds != nil && *properties.TTLInSeconds < -1 {
+		msgs = append(msgs, "ttlInSeconds must be greater than or equal to -1")
+	}
+
+	return msgs
+}
@@ -34,6 +34,7 @@ import (
 func TestDaprStateStore_ConvertVersionedToDataModel(t *testing.T) {
 	testset := []string{
 		"statestore_values_resource.json",
+		"statestore_actor_resource.json",
 		"statestore_recipe_resource.json",
 	}
 
@@ -74,7 +75,7 @@ func TestDaprStateStore_ConvertVersionedToDataModel(t *testing.T) {
 					},
 				},
 			}
-			if payload == "statestore_values_resource.json" {
+			if payload == "statestore_values_resource.json" || payload == "statestore_actor_resource.json" {
 				expected.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
 				expected.Properties.Type = "state.zookeeper"
 				expected.Properties.Version = "v1"
@@ -84,6 +85,7 @@ func TestDaprStateStore_ConvertVersionedToDataModel(t *testing.T) {
 					},
 				}
 				expected.Properties.Auth = &rpv1.DaprComponentAuth{SecretStore: "test-secret-store"}
+				expected.Properties.Scopes = []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/frontend"}
 				expected.Properties.Resources = []*portableresources.ResourceReference{
 					{
 						ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.Sql/servers/testServer/databases/testDatabase",
@@ -93,6 +95,10 @@ func TestDaprStateStore_ConvertVersionedToDataModel(t *testing.T) {
 				expected.Properties.ResourceProvisioning = portableresources.ResourceProvisioningRecipe
 				expected.Properties.Recipe.Name = "recipe-test"
 			}
+			if payload == "statestore_actor_resource.json" {
+				expected.Properties.ActorStateStore = true
+				expected.Properties.TTLInSeconds = to.Ptr(int32(3600))
+			}
 
 			require.Equal(t, expected, convertedResource)
 		})
@@ -106,7 +112,10 @@ func TestDaprStateStore_ConvertVersionedToDataModel_Invalid(t *testing.T) {
 		message string
 	}{
 		{"statestore_invalidvalues_resource.json", &v1.ErrClientRP{}, "code BadRequest: err error(s) found:\n\trecipe details cannot be specified when resourceProvisioning is set to manual\n\tmetadata must be specified when resourceProvisioning is set to manual\n\ttype must be specified when resourceProvisioning is set to manual\n\tversion must be specified when resourceProvisioning is set to manual"},
-		{"statestore_invalidrecipe_resource.json", &v1.ErrClientRP{}, "code BadRequest: err error(s) found:\n\tmetadata cannot be specified when resourceProvisioning is set to recipe (default)\n\ttype cannot be specified when resourceProvisioning is set to recipe (default)\n\tversion cannot be specified when resourceProvisioning is set to recipe (default)"},
+		{"statestore_invalidrecipe_resource.json", &v1.ErrClientRP{}, "code BadRequest: err error(s) found:\n\tmetadata cannot be specified when resourceProvisioning is set to recipe (default)\n\ttype cannot be specified when resourceProvisioning is set to recipe (default)\n\tversion cannot be specified when resourceProvisioning is set to recipe (default)\n\tscopes cannot be specified when resourceProvisioning is set to recipe (default)"},
+		{"statestore_invalidactor_resource.json", &v1.ErrClientRP{}, "code BadRequest: err error(s) found:\n\tactorStateStore cannot be specified in both the properties and the metadata\n\tttlInSeconds cannot be specified in both the properties and the metadata\n\tttlInSeconds must be greater than or equal to -1"},
+		{"statestore_invalidactorrecipe_resource.json", &v1.ErrClientRP{}, "code BadRequest: err error(s) found:\n\tactorStateStore cannot be specified when resourceProvisioning is set to recipe (default)\n\tttlInSeconds cannot be specified when resourceProvisioning is set to recipe (default)"},
+		{"statestore_invalidscopes_resource.json", &v1.ErrClientRP{}, "code BadRequest: err error(s) found:\n\tscope \"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/applications/testApplication\" must be the resource ID of an Applications.Core/containers resource"},
 	}
 
 	for _, test := range testset {
@@ -128,6 +137,7 @@ func TestDaprStateStore_ConvertVersionedToDataModel_Invalid(t *testing.T) {
 func TestDaprStateStore_ConvertDataModelToVersioned(t *testing.T) {
 	testset := []string{
 		"statestore_values_resourcedatamodel.json",
+		"statestore_actor_resourcedatamodel.json",
 		"statestore_recipe_resourcedatamodel.json",
 	}
 
@@ -163,10 +173,11 @@ func TestDaprStateStore_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			}
 
-			if payload == "statestore_values_resourcedatamodel.json" {
+			if payload == "statestore_values_resourcedatamodel.json" || payload == "statestore_actor_resourcedatamodel.json" {
 				expected.Properties.ResourceProvisioning = to.Ptr(ResourceProvisioningManual)
 				expected.Properties.Type = to.Ptr("state.zookeeper")
 				expected.Properties.Version = to.Ptr("v1")
+				expected.Properties.Scopes = []*string{to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/frontend")}
 				expected.Properties.Metadata = map[string]*MetadataValue{
 					"foo": {
 						Value: to.Ptr("bar"),
@@ -185,6 +196,10 @@ func TestDaprStateStore_ConvertDataModelToVersioned(t *testing.T) {
 				}
 				expected.Properties.Status = resourcetypeutil.MustPopulateResourceStatusWithRecipe(&ResourceStatus{})
 			}
+			if payload == "statestore_actor_resourcedatamodel.json" {
+				expected.Properties.ActorStateStore = to.Ptr(true)
+				expected.Properties.TTLInSeconds = to.Ptr(int32(3600))
+			}
 
 			require.Equal(t, expected, versionedResource)
 		})
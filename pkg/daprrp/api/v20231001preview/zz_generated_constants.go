@@ -116,6 +116,27 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// ResourceHealthState - The health state of a resource.
+type ResourceHealthState string
+
+const (
+// ResourceHealthStateHealthy - The resource is healthy
+	ResourceHealthStateHealthy ResourceHealthState = "Healthy"
+// ResourceHealthStateUnhealthy - The resource is unhealthy
+	ResourceHealthStateUnhealthy ResourceHealthState = "Unhealthy"
+// ResourceHealthStateUnknown - The health of the resource could not be determined
+	ResourceHealthStateUnknown ResourceHealthState = "Unknown"
+)
+
+// PossibleResourceHealthStateValues returns the possible values for the ResourceHealthState const type.
+func PossibleResourceHealthStateValues() []ResourceHealthState {
+	return []ResourceHealthState{	
+		ResourceHealthStateHealthy,
+		ResourceHealthStateUnhealthy,
+		ResourceHealthStateUnknown,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
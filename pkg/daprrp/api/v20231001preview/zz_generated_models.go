@@ -6,6 +6,31 @@ package v20231001preview
 
 import "time"
 
+// AzureContainerInstanceCompute - The Azure Container Instances compute configuration
+type AzureContainerInstanceCompute struct {
+// REQUIRED; Discriminator property for EnvironmentCompute.
+	Kind *string
+
+// Configuration for supported external identity providers
+	Identity *IdentitySettings
+
+// The resource ID of the Azure resource group to deploy the container groups to. Defaults to the resource group of the Azure
+// provider scope of the environment.
+	ResourceGroup *string
+
+// The resource id of the compute resource for application environment.
+	ResourceID *string
+}
+
+// GetEnvironmentCompute implements the EnvironmentComputeClassification interface for type AzureContainerInstanceCompute.
+func (a *AzureContainerInstanceCompute) GetEnvironmentCompute() *EnvironmentCompute {
+	return &EnvironmentCompute{
+		Identity: a.Identity,
+		Kind: a.Kind,
+		ResourceID: a.ResourceID,
+	}
+}
+
 // AzureResourceManagerCommonTypesTrackedResourceUpdate - The resource model definition for an Azure Resource Manager tracked
 // top level resource which has 'tags' and a 'location'
 type AzureResourceManagerCommonTypesTrackedResourceUpdate struct {
@@ -48,6 +73,10 @@ type DaprConfigurationStoreProperties struct {
 // A collection of references to resources associated with the configuration store
 	Resources []*ResourceReference
 
+// The resource IDs of the containers the Dapr component is scoped to. The component is available to all containers in
+// the namespace if not specified.
+	Scopes []*string
+
 // Dapr component type which must matches the format used by Dapr Kubernetes configuration format
 	Type *string
 
@@ -139,6 +168,10 @@ type DaprPubSubBrokerProperties struct {
 // A collection of references to resources associated with the pubSubBroker
 	Resources []*ResourceReference
 
+// The resource IDs of the containers the Dapr component is scoped to. The component is available to all containers in
+// the namespace if not specified.
+	Scopes []*string
+
 // Dapr component type which must matches the format used by Dapr Kubernetes configuration format
 	Type *string
 
@@ -230,6 +263,10 @@ type DaprSecretStoreProperties struct {
 // Specifies how the underlying service/resource is provisioned and managed.
 	ResourceProvisioning *ResourceProvisioning
 
+// The resource IDs of the containers the Dapr component is scoped to. The component is available to all containers in
+// the namespace if not specified.
+	Scopes []*string
+
 // Dapr component type which must matches the format used by Dapr Kubernetes configuration format
 	Type *string
 
@@ -303,6 +340,10 @@ type DaprStateStoreProperties struct {
 // REQUIRED; Fully qualified resource ID for the environment that the portable resource is linked to
 	Environment *string
 
+// Specifies whether the state store is the actor state store of the Dapr sidecars. An application can have only one actor
+// state store. Can only be specified when resourceProvisioning is set to manual.
+	ActorStateStore *bool
+
 // Fully qualified resource ID for the application that the portable resource is consumed by (if applicable)
 	Application *string
 
@@ -321,6 +362,14 @@ type DaprStateStoreProperties struct {
 // A collection of references to resources associated with the state store
 	Resources []*ResourceReference
 
+// The resource IDs of the containers the Dapr component is scoped to. The component is available to all containers in
+// the namespace if not specified.
+	Scopes []*string
+
+// The default time-to-live in seconds of the state saved in the state store. Use -1 for state that never expires. Can only
+// be specified when resourceProvisioning is set to manual.
+	TTLInSeconds *int32
+
 // Dapr component type which must matches the format used by Dapr Kubernetes configuration format
 	Type *string
 
@@ -497,6 +546,10 @@ type NonRedundantDaprResourceProperties struct {
 // The metadata for Dapr resource which must match the values specified in Dapr component spec
 	Metadata map[string]*MetadataValue
 
+// The resource IDs of the containers the Dapr component is scoped to. The component is available to all containers in
+// the namespace if not specified.
+	Scopes []*string
+
 // Dapr component type which must matches the format used by Dapr Kubernetes configuration format
 	Type *string
 
@@ -607,6 +660,18 @@ type Resource struct {
 	Type *string
 }
 
+// ResourceHealth - Health of a resource.
+type ResourceHealth struct {
+// REQUIRED; The health state of the resource.
+	State *ResourceHealthState
+
+// The time at which the health state or message last changed.
+	LastTransitionTime *time.Time
+
+// A human-readable message describing the health of the resource.
+	Message *string
+}
+
 // ResourceReference - Describes a reference to an existing resource
 type ResourceReference struct {
 // REQUIRED; Resource id of an existing resource
@@ -621,6 +686,9 @@ type ResourceStatus struct {
 // Properties of an output resource
 	OutputResources []*OutputResource
 
+// READ-ONLY; The health of the resource reported by the health checks registered for the resource type
+	Health *ResourceHealth
+
 // READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
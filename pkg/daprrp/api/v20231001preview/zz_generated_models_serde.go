@@ -11,6 +11,45 @@ import (
 	"reflect"
 )
 
+// MarshalJSON implements the json.Marshaller interface for type AzureContainerInstanceCompute.
+func (a AzureContainerInstanceCompute) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "identity", a.Identity)
+	objectMap["kind"] = "aci"
+	populate(objectMap, "resourceGroup", a.ResourceGroup)
+	populate(objectMap, "resourceId", a.ResourceID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AzureContainerInstanceCompute.
+func (a *AzureContainerInstanceCompute) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "identity":
+				err = unpopulate(val, "Identity", &a.Identity)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "resourceGroup":
+				err = unpopulate(val, "ResourceGroup", &a.ResourceGroup)
+			delete(rawMsg, key)
+		case "resourceId":
+				err = unpopulate(val, "ResourceID", &a.ResourceID)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureResourceManagerCommonTypesTrackedResourceUpdate.
 func (a AzureResourceManagerCommonTypesTrackedResourceUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -66,6 +105,7 @@ func (d DaprConfigurationStoreProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "recipe", d.Recipe)
 	populate(objectMap, "resourceProvisioning", d.ResourceProvisioning)
 	populate(objectMap, "resources", d.Resources)
+	populate(objectMap, "scopes", d.Scopes)
 	populate(objectMap, "status", d.Status)
 	populate(objectMap, "type", d.Type)
 	populate(objectMap, "version", d.Version)
@@ -108,6 +148,9 @@ func (d *DaprConfigurationStoreProperties) UnmarshalJSON(data []byte) error {
 		case "resources":
 				err = unpopulate(val, "Resources", &d.Resources)
 			delete(rawMsg, key)
+		case "scopes":
+				err = unpopulate(val, "Scopes", &d.Scopes)
+			delete(rawMsg, key)
 		case "status":
 				err = unpopulate(val, "Status", &d.Status)
 			delete(rawMsg, key)
@@ -262,6 +305,7 @@ func (d DaprPubSubBrokerProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "recipe", d.Recipe)
 	populate(objectMap, "resourceProvisioning", d.ResourceProvisioning)
 	populate(objectMap, "resources", d.Resources)
+	populate(objectMap, "scopes", d.Scopes)
 	populate(objectMap, "status", d.Status)
 	populate(objectMap, "type", d.Type)
 	populate(objectMap, "version", d.Version)
@@ -304,6 +348,9 @@ func (d *DaprPubSubBrokerProperties) UnmarshalJSON(data []byte) error {
 		case "resources":
 				err = unpopulate(val, "Resources", &d.Resources)
 			delete(rawMsg, key)
+		case "scopes":
+				err = unpopulate(val, "Scopes", &d.Scopes)
+			delete(rawMsg, key)
 		case "status":
 				err = unpopulate(val, "Status", &d.Status)
 			delete(rawMsg, key)
@@ -483,6 +530,7 @@ func (d DaprSecretStoreProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "provisioningState", d.ProvisioningState)
 	populate(objectMap, "recipe", d.Recipe)
 	populate(objectMap, "resourceProvisioning", d.ResourceProvisioning)
+	populate(objectMap, "scopes", d.Scopes)
 	populate(objectMap, "status", d.Status)
 	populate(objectMap, "type", d.Type)
 	populate(objectMap, "version", d.Version)
@@ -519,6 +567,9 @@ func (d *DaprSecretStoreProperties) UnmarshalJSON(data []byte) error {
 		case "resourceProvisioning":
 				err = unpopulate(val, "ResourceProvisioning", &d.ResourceProvisioning)
 			delete(rawMsg, key)
+		case "scopes":
+				err = unpopulate(val, "Scopes", &d.Scopes)
+			delete(rawMsg, key)
 		case "status":
 				err = unpopulate(val, "Status", &d.Status)
 			delete(rawMsg, key)
@@ -664,6 +715,7 @@ func (d *DaprSecretStoreResourceUpdate) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type DaprStateStoreProperties.
 func (d DaprStateStoreProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "actorStateStore", d.ActorStateStore)
 	populate(objectMap, "application", d.Application)
 	populate(objectMap, "auth", d.Auth)
 	populate(objectMap, "componentName", d.ComponentName)
@@ -673,7 +725,9 @@ func (d DaprStateStoreProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "recipe", d.Recipe)
 	populate(objectMap, "resourceProvisioning", d.ResourceProvisioning)
 	populate(objectMap, "resources", d.Resources)
+	populate(objectMap, "scopes", d.Scopes)
 	populate(objectMap, "status", d.Status)
+	populate(objectMap, "ttlInSeconds", d.TTLInSeconds)
 	populate(objectMap, "type", d.Type)
 	populate(objectMap, "version", d.Version)
 	return json.Marshal(objectMap)
@@ -688,6 +742,9 @@ func (d *DaprStateStoreProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "actorStateStore":
+				err = unpopulate(val, "ActorStateStore", &d.ActorStateStore)
+			delete(rawMsg, key)
 		case "application":
 				err = unpopulate(val, "Application", &d.Application)
 			delete(rawMsg, key)
@@ -715,9 +772,15 @@ func (d *DaprStateStoreProperties) UnmarshalJSON(data []byte) error {
 		case "resources":
 				err = unpopulate(val, "Resources", &d.Resources)
 			delete(rawMsg, key)
+		case "scopes":
+				err = unpopulate(val, "Scopes", &d.Scopes)
+			delete(rawMsg, key)
 		case "status":
 				err = unpopulate(val, "Status", &d.Status)
 			delete(rawMsg, key)
+		case "ttlInSeconds":
+				err = unpopulate(val, "TTLInSeconds", &d.TTLInSeconds)
+			delete(rawMsg, key)
 		case "type":
 				err = unpopulate(val, "Type", &d.Type)
 			delete(rawMsg, key)
@@ -1134,6 +1197,7 @@ func (n NonRedundantDaprResourceProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "componentName", n.ComponentName)
 	populate(objectMap, "metadata", n.Metadata)
+	populate(objectMap, "scopes", n.Scopes)
 	populate(objectMap, "type", n.Type)
 	populate(objectMap, "version", n.Version)
 	return json.Marshal(objectMap)
@@ -1154,6 +1218,9 @@ func (n *NonRedundantDaprResourceProperties) UnmarshalJSON(data []byte) error {
 		case "metadata":
 				err = unpopulate(val, "Metadata", &n.Metadata)
 			delete(rawMsg, key)
+		case "scopes":
+				err = unpopulate(val, "Scopes", &n.Scopes)
+			delete(rawMsg, key)
 		case "type":
 				err = unpopulate(val, "Type", &n.Type)
 			delete(rawMsg, key)
@@ -1421,6 +1488,41 @@ func (r *Resource) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ResourceHealth.
+func (r ResourceHealth) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateDateTimeRFC3339(objectMap, "lastTransitionTime", r.LastTransitionTime)
+	populate(objectMap, "message", r.Message)
+	populate(objectMap, "state", r.State)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceHealth.
+func (r *ResourceHealth) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "lastTransitionTime":
+				err = unpopulateDateTimeRFC3339(val, "LastTransitionTime", &r.LastTransitionTime)
+			delete(rawMsg, key)
+		case "message":
+				err = unpopulate(val, "Message", &r.Message)
+			delete(rawMsg, key)
+		case "state":
+				err = unpopulate(val, "State", &r.State)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ResourceReference.
 func (r ResourceReference) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1452,6 +1554,7 @@ func (r *ResourceReference) UnmarshalJSON(data []byte) error {
 func (r ResourceStatus) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", r.Compute)
+	populate(objectMap, "health", r.Health)
 	populate(objectMap, "outputResources", r.OutputResources)
 	populate(objectMap, "recipe", r.Recipe)
 	return json.Marshal(objectMap)
@@ -1469,6 +1572,9 @@ func (r *ResourceStatus) UnmarshalJSON(data []byte) error {
 		case "compute":
 			r.Compute, err = unmarshalEnvironmentComputeClassification(val)
 			delete(rawMsg, key)
+		case "health":
+				err = unpopulate(val, "Health", &r.Health)
+			delete(rawMsg, key)
 		case "outputResources":
 				err = unpopulate(val, "OutputResources", &r.OutputResources)
 			delete(rawMsg, key)
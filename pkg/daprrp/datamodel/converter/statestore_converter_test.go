@@ -81,7 +81,7 @@ func TestDaprStateStoreDataModelFromVersioned(t *testing.T) {
 		{
 			"../../api/v20231001preview/testdata/statestore_invalidrecipe_resource.json",
 			"2023-10-01-preview",
-			&v1.ErrClientRP{Code: v1.CodeInvalid, Message: "error(s) found:\n\tmetadata cannot be specified when resourceProvisioning is set to recipe (default)\n\ttype cannot be specified when resourceProvisioning is set to recipe (default)\n\tversion cannot be specified when resourceProvisioning is set to recipe (default)"},
+			&v1.ErrClientRP{Code: v1.CodeInvalid, Message: "error(s) found:\n\tmetadata cannot be specified when resourceProvisioning is set to recipe (default)\n\ttype cannot be specified when resourceProvisioning is set to recipe (default)\n\tversion cannot be specified when resourceProvisioning is set to recipe (default)\n\tscopes cannot be specified when resourceProvisioning is set to recipe (default)"},
 		},
 		{
 			"../../api/v20231001preview/testdata/statestore_invalidvalues_resource.json",
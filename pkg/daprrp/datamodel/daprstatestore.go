@@ -17,6 +17,8 @@ limitations under the License.
 package datamodel
 
 import (
+	"strings"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -24,6 +26,14 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
+const (
+	// ActorStateStoreMetadataKey is the metadata of the Dapr component which makes a state store the actor state store.
+	ActorStateStoreMetadataKey = "actorStateStore"
+
+	// TTLInSecondsMetadataKey is the metadata of the Dapr component which sets the default time-to-live of the state.
+	TTLInSecondsMetadataKey = "ttlInSeconds"
+)
+
 // DaprStateStore represents DaprStateStore portable resource.
 type DaprStateStore struct {
 	v1.BaseResource
@@ -76,4 +86,19 @@ type DaprStateStoreProperties struct {
 	Version              string                                      `json:"version,omitempty"`
 	// Authentication information for the Dapr Pub/Sub Broker resource, mainly secret store name.
 	Auth *rpv1.DaprComponentAuth `json:"auth,omitempty"`
+	// ActorStateStore specifies whether the state store is the actor state store of the Dapr sidecars.
+	ActorStateStore bool `json:"actorStateStore,omitempty"`
+	// TTLInSeconds is the default time-to-live in seconds of the state saved in the state store.
+	TTLInSeconds *int32 `json:"ttlInSeconds,omitempty"`
+}
+
+// IsActorStateStore returns true if the state store is the actor state store of the Dapr sidecars, either by the
+// actorStateStore property or by the actorStateStore metadata of the Dapr component.
+func (r *DaprStateStore) IsActorStateStore() bool {
+	if r.Properties.ActorStateStore {
+		return true
+	}
+
+	metadata, ok := r.Properties.Metadata[ActorStateStoreMetadataKey]
+	return ok && metadata != nil && strings.EqualFold(metadata.Value, "true")
 }
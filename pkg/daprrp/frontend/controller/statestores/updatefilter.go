@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statestores
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+)
+
+const (
+	applicationQuery = "properties.application"
+)
+
+// ValidateActorStateStore validates that the application of an actor state store does not have another actor state
+// store, since the Dapr sidecars of an application can use only one actor state store. State stores which are not
+// application scoped are not validated.
+func ValidateActorStateStore(ctx context.Context, newResource, oldResource *datamodel.DaprStateStore, options *controller.Options) (rest.Response, error) {
+	if !newResource.IsActorStateStore() || newResource.Properties.Application == "" {
+		return nil, nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	result, err := options.DatabaseClient.Query(ctx, database.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: serviceCtx.ResourceID.Type(),
+		Filters: []database.QueryFilter{
+			{
+				Field: applicationQuery,
+				Value: newResource.Properties.Application,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range result.Items {
+		stateStore := &datamodel.DaprStateStore{}
+		if err := item.As(stateStore); err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(stateStore.ID, serviceCtx.ResourceID.String()) || !stateStore.IsActorStateStore() {
+			continue
+		}
+
+		return rest.NewConflictResponse(fmt.Sprintf("Application %s already has the actor state store %s. An application can have only one actor state store.", newResource.Properties.Application, stateStore.ID)), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statestores
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/daprrp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testAppID        = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/test-app"
+	testStateStoreID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Dapr/stateStores/actors"
+	testOtherStoreID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Dapr/stateStores/other"
+)
+
+func newStateStore(id string, actorStateStore bool, metadata map[string]*rpv1.DaprComponentMetadataValue) *datamodel.DaprStateStore {
+	stateStore := &datamodel.DaprStateStore{
+		Properties: datamodel.DaprStateStoreProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: testAppID,
+			},
+			Metadata:        metadata,
+			ActorStateStore: actorStateStore,
+		},
+	}
+	stateStore.ID = id
+	return stateStore
+}
+
+func TestValidateActorStateStore(t *testing.T) {
+	armCtx := &v1.ARMRequestContext{
+		ResourceID: resources.MustParse(testStateStoreID),
+	}
+	ctx := v1.WithARMRequestContext(context.Background(), armCtx)
+
+	t.Run("not an actor state store", func(t *testing.T) {
+		tCtx := rpctest.NewControllerContext(t)
+
+		resp, err := ValidateActorStateStore(ctx, newStateStore(testStateStoreID, false, nil), nil, &ctrl.Options{DatabaseClient: tCtx.MockSC})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("only actor state store", func(t *testing.T) {
+		tCtx := rpctest.NewControllerContext(t)
+		tCtx.MockSC.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-rg", query.RootScope)
+				require.Equal(t, []database.QueryFilter{{Field: "properties.application", Value: testAppID}}, query.Filters)
+				return &database.ObjectQueryResult{
+					Items: []database.Object{
+						*rpctest.FakeStoreObject(newStateStore(testStateStoreID, true, nil)),
+						*rpctest.FakeStoreObject(newStateStore(testOtherStoreID, false, nil)),
+					},
+				}, nil
+			}).Times(1)
+
+		resp, err := ValidateActorStateStore(ctx, newStateStore(testStateStoreID, true, nil), nil, &ctrl.Options{DatabaseClient: tCtx.MockSC})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("another actor state store", func(t *testing.T) {
+		tCtx := rpctest.NewControllerContext(t)
+		tCtx.MockSC.
+			EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&database.ObjectQueryResult{
+				Items: []database.Object{
+					*rpctest.FakeStoreObject(newStateStore(testOtherStoreID, false, map[string]*rpv1.DaprComponentMetadataValue{
+						datamodel.ActorStateStoreMetadataKey: {Value: "true"},
+					})),
+				},
+			}, nil).Times(1)
+
+		resp, err := ValidateActorStateStore(ctx, newStateStore(testStateStoreID, true, nil), nil, &ctrl.Options{DatabaseClient: tCtx.MockSC})
+		require.NoError(t, err)
+		require.IsType(t, &rest.ConflictResponse{}, resp)
+		require.Equal(t, "Application "+testAppID+" already has the actor state store "+testOtherStoreID+". An application can have only one actor state store.", resp.(*rest.ConflictResponse).Body.Error.Message)
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers/dapr"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 
@@ -37,7 +38,8 @@ import (
 )
 
 type Processor struct {
-	Client runtime_client.Client
+	Client        runtime_client.Client
+	UCPConnection sdk.Connection
 }
 
 // Process validates resource properties, and applies output values from the recipe output. If the resource is being
@@ -72,11 +74,17 @@ func (p *Processor) Process(ctx context.Context, resource *datamodel.DaprSecretS
 		}
 	}
 
+	scopes, err := dapr.ResolveScopes(ctx, p.UCPConnection, resource.Properties.Scopes)
+	if err != nil {
+		return err
+	}
+
 	component, err := dapr.ConstructDaprGeneric(
 		dapr.DaprGeneric{
 			Metadata: resource.Properties.Metadata,
 			Type:     to.Ptr(resource.Properties.Type),
 			Version:  to.Ptr(resource.Properties.Version),
+			Scopes:   scopes,
 		},
 		options.RuntimeConfiguration.Kubernetes.Namespace,
 		resource.Properties.ComponentName,
@@ -18,6 +18,8 @@ package statestores
 
 import (
 	"context"
+	"maps"
+	"strconv"
 
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
@@ -28,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers/dapr"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 
@@ -37,7 +40,8 @@ import (
 )
 
 type Processor struct {
-	Client runtime_client.Client
+	Client        runtime_client.Client
+	UCPConnection sdk.Connection
 }
 
 // Process validates resource properties, and applies output values from the recipe output. If the resource is being
@@ -75,12 +79,18 @@ func (p *Processor) Process(ctx context.Context, resource *datamodel.DaprStateSt
 		}
 	}
 
+	scopes, err := dapr.ResolveScopes(ctx, p.UCPConnection, resource.Properties.Scopes)
+	if err != nil {
+		return err
+	}
+
 	component, err := dapr.ConstructDaprGeneric(
 		dapr.DaprGeneric{
 			Auth:     resource.Properties.Auth,
-			Metadata: resource.Properties.Metadata,
+			Metadata: componentMetadata(resource.Properties),
 			Type:     to.Ptr(resource.Properties.Type),
 			Version:  to.Ptr(resource.Properties.Version),
+			Scopes:   scopes,
 		},
 		options.RuntimeConfiguration.Kubernetes.Namespace,
 		resource.Properties.ComponentName,
@@ -113,6 +123,29 @@ func (p *Processor) Process(ctx context.Context, resource *datamodel.DaprStateSt
 	return nil
 }
 
+// componentMetadata returns the metadata of the Dapr component, including the metadata rendered from the actor state store
+// properties of the resource.
+func componentMetadata(properties datamodel.DaprStateStoreProperties) map[string]*rpv1.DaprComponentMetadataValue {
+	if !properties.ActorStateStore && properties.TTLInSeconds == nil {
+		return properties.Metadata
+	}
+
+	metadata := maps.Clone(properties.Metadata)
+	if metadata == nil {
+		metadata = map[string]*rpv1.DaprComponentMetadataValue{}
+	}
+
+	if properties.ActorStateStore {
+		metadata[datamodel.ActorStateStoreMetadataKey] = &rpv1.DaprComponentMetadataValue{Value: "true"}
+	}
+
+	if properties.TTLInSeconds != nil {
+		metadata[datamodel.TTLInSecondsMetadataKey] = &rpv1.DaprComponentMetadataValue{Value: strconv.Itoa(int(*properties.TTLInSeconds))}
+	}
+
+	return metadata
+}
+
 // Delete implements the processors.Processor interface for DaprStateStore resources. If the resource is being
 // provisioned manually, it deletes the Dapr component in Kubernetes.
 func (p *Processor) Delete(ctx context.Context, resource *datamodel.DaprStateStore, options processors.Options) error {
@@ -18,9 +18,14 @@ package statestores
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/kubernetes"
@@ -29,6 +34,7 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources/renderers/dapr"
 	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/assert"
@@ -367,6 +373,156 @@ func Test_Process(t *testing.T) {
 		require.Equal(t, []unstructured.Unstructured{*generated}, components.Items)
 	})
 
+	t.Run("success - manual actor state store", func(t *testing.T) {
+		processor := Processor{
+			Client: k8sutil.NewFakeKubeClient(scheme.Scheme, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}),
+		}
+
+		metadata := map[string]*rpv1.DaprComponentMetadataValue{
+			"config": {
+				Value: "extrasecure",
+			},
+		}
+		resource := &datamodel.DaprStateStore{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					Name: "some-other-name",
+				},
+			},
+			Properties: datamodel.DaprStateStoreProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: applicationID,
+					Environment: envID,
+				},
+				BasicDaprResourceProperties: rpv1.BasicDaprResourceProperties{
+					ComponentName: componentName,
+				},
+				ResourceProvisioning: portableresources.ResourceProvisioningManual,
+				Metadata:             metadata,
+				Type:                 "state.redis",
+				Version:              "v1",
+				ActorStateStore:      true,
+				TTLInSeconds:         to.Ptr(int32(3600)),
+			},
+		}
+
+		options := processors.Options{
+			RuntimeConfiguration: recipes.RuntimeConfiguration{
+				Kubernetes: &recipes.KubernetesRuntime{
+					Namespace: "test-namespace",
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		components := unstructured.UnstructuredList{}
+		components.SetAPIVersion("dapr.io/v1alpha1")
+		components.SetKind("Component")
+		err = processor.Client.List(context.Background(), &components, &client.ListOptions{Namespace: options.RuntimeConfiguration.Kubernetes.Namespace})
+		require.NoError(t, err)
+		require.Len(t, components.Items, 1)
+
+		expectedMetadata := []any{
+			map[string]any{
+				"name":  "actorStateStore",
+				"value": "true",
+			},
+			map[string]any{
+				"name":  "config",
+				"value": "extrasecure",
+			},
+			map[string]any{
+				"name":  "ttlInSeconds",
+				"value": "3600",
+			},
+		}
+		require.Equal(t, expectedMetadata, components.Items[0].Object["spec"].(map[string]any)["metadata"])
+
+		// The metadata of the resource is not modified.
+		require.Len(t, resource.Properties.Metadata, 1)
+	})
+
+	t.Run("success - manual with scopes", func(t *testing.T) {
+		const frontendID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/frontend"
+		const backendID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/backend"
+
+		// Only the frontend container is served by UCP, the backend container has not been deployed yet.
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.EqualFold(r.URL.Path, frontendID) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(corerp.ContainerResource{
+				ID: to.Ptr(frontendID),
+				Properties: &corerp.ContainerProperties{
+					Extensions: []corerp.ExtensionClassification{
+						&corerp.DaprSidecarExtension{Kind: to.Ptr("daprSidecar"), AppID: to.Ptr("frontend-app")},
+					},
+				},
+			})
+		}))
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		processor := Processor{
+			Client:        k8sutil.NewFakeKubeClient(scheme.Scheme, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}),
+			UCPConnection: connection,
+		}
+
+		resource := &datamodel.DaprStateStore{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					Name: "some-other-name",
+				},
+			},
+			Properties: datamodel.DaprStateStoreProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: applicationID,
+					Environment: envID,
+				},
+				BasicDaprResourceProperties: rpv1.BasicDaprResourceProperties{
+					ComponentName: componentName,
+					Scopes:        []string{frontendID, backendID},
+				},
+				ResourceProvisioning: portableresources.ResourceProvisioningManual,
+				Metadata: map[string]*rpv1.DaprComponentMetadataValue{
+					"config": {
+						Value: "extrasecure",
+					},
+				},
+				Type:    "state.redis",
+				Version: "v1",
+			},
+		}
+
+		options := processors.Options{
+			RuntimeConfiguration: recipes.RuntimeConfiguration{
+				Kubernetes: &recipes.KubernetesRuntime{
+					Namespace: "test-namespace",
+				},
+			},
+		}
+
+		err = processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		components := unstructured.UnstructuredList{}
+		components.SetAPIVersion("dapr.io/v1alpha1")
+		components.SetKind("Component")
+		err = processor.Client.List(context.Background(), &components, &client.ListOptions{Namespace: options.RuntimeConfiguration.Kubernetes.Namespace})
+		require.NoError(t, err)
+		require.Len(t, components.Items, 1)
+
+		// The backend container has not been deployed yet, so its app ID defaults to the container name.
+		require.Equal(t, []any{"frontend-app", "backend"}, components.Items[0].Object["scopes"])
+	})
+
 	t.Run("success - recipe with value overrides", func(t *testing.T) {
 		processor := Processor{
 			Client: k8sutil.NewFakeKubeClient(scheme.Scheme),
@@ -22,11 +22,13 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/daprrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel"
 	"github.com/radius-project/radius/pkg/daprrp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	statestore_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller/statestores"
 	configurationstores_proc "github.com/radius-project/radius/pkg/daprrp/processors/configurationstores"
 	pubsub_proc "github.com/radius-project/radius/pkg/daprrp/processors/pubsubbrokers"
 	secretstore_proc "github.com/radius-project/radius/pkg/daprrp/processors/secretstores"
@@ -47,13 +49,18 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	_ = ns.AddResource("pubSubBrokers", &builder.ResourceOption[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker]{
 		RequestConverter:  converter.PubSubBrokerDataModelFromVersioned,
 		ResponseConverter: converter.PubSubBrokerDataModelToVersioned,
+		Defaulters: []apictrl.Defaulter[datamodel.DaprPubSubBroker]{
+			rp_frontend.DefaultRecipeName[*datamodel.DaprPubSubBroker],
+		},
 
 		Put: builder.Operation[datamodel.DaprPubSubBroker]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprPubSubBroker]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprPubSubBroker],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.DaprPubSubBroker],
+				rp_frontend.ValidateQuota[*datamodel.DaprPubSubBroker],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, &pubsub_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, &pubsub_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -61,16 +68,18 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.DaprPubSubBroker]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprPubSubBroker]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprPubSubBroker],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.DaprPubSubBroker],
+				rp_frontend.ValidateQuota[*datamodel.DaprPubSubBroker],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, &pubsub_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, &pubsub_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
 		Delete: builder.Operation[datamodel.DaprPubSubBroker]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewDeleteResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, &pubsub_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewDeleteResource[*datamodel.DaprPubSubBroker, datamodel.DaprPubSubBroker](options, &pubsub_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprPubSubBrokerTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -80,13 +89,19 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	_ = ns.AddResource("stateStores", &builder.ResourceOption[*datamodel.DaprStateStore, datamodel.DaprStateStore]{
 		RequestConverter:  converter.StateStoreDataModelFromVersioned,
 		ResponseConverter: converter.StateStoreDataModelToVersioned,
+		Defaulters: []apictrl.Defaulter[datamodel.DaprStateStore]{
+			rp_frontend.DefaultRecipeName[*datamodel.DaprStateStore],
+		},
 
 		Put: builder.Operation[datamodel.DaprStateStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprStateStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprStateStore],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.DaprStateStore],
+				rp_frontend.ValidateQuota[*datamodel.DaprStateStore],
+				statestore_ctrl.ValidateActorStateStore,
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, &statestore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, &statestore_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -94,16 +109,19 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.DaprStateStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprStateStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprStateStore],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.DaprStateStore],
+				rp_frontend.ValidateQuota[*datamodel.DaprStateStore],
+				statestore_ctrl.ValidateActorStateStore,
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, &statestore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, &statestore_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
 		Delete: builder.Operation[datamodel.DaprStateStore]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewDeleteResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, &statestore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewDeleteResource[*datamodel.DaprStateStore, datamodel.DaprStateStore](options, &statestore_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprStateStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -113,13 +131,18 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	_ = ns.AddResource("secretStores", &builder.ResourceOption[*datamodel.DaprSecretStore, datamodel.DaprSecretStore]{
 		RequestConverter:  converter.SecretStoreDataModelFromVersioned,
 		ResponseConverter: converter.SecretStoreDataModelToVersioned,
+		Defaulters: []apictrl.Defaulter[datamodel.DaprSecretStore]{
+			rp_frontend.DefaultRecipeName[*datamodel.DaprSecretStore],
+		},
 
 		Put: builder.Operation[datamodel.DaprSecretStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprSecretStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprSecretStore],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.DaprSecretStore],
+				rp_frontend.ValidateQuota[*datamodel.DaprSecretStore],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, &secretstore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, &secretstore_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -127,16 +150,18 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.DaprSecretStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprSecretStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprSecretStore],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.DaprSecretStore],
+				rp_frontend.ValidateQuota[*datamodel.DaprSecretStore],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, &secretstore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, &secretstore_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
 		Delete: builder.Operation[datamodel.DaprSecretStore]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewDeleteResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, &secretstore_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewDeleteResource[*datamodel.DaprSecretStore, datamodel.DaprSecretStore](options, &secretstore_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprSecretStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -146,13 +171,18 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	_ = ns.AddResource("configurationStores", &builder.ResourceOption[*datamodel.DaprConfigurationStore, datamodel.DaprConfigurationStore]{
 		RequestConverter:  converter.ConfigurationStoreDataModelFromVersioned,
 		ResponseConverter: converter.ConfigurationStoreDataModelToVersioned,
+		Defaulters: []apictrl.Defaulter[datamodel.DaprConfigurationStore]{
+			rp_frontend.DefaultRecipeName[*datamodel.DaprConfigurationStore],
+		},
 
 		Put: builder.Operation[datamodel.DaprConfigurationStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprConfigurationStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprConfigurationStore],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.DaprConfigurationStore],
+				rp_frontend.ValidateQuota[*datamodel.DaprConfigurationStore],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprConfigurationStore, datamodel.DaprConfigurationStore](options, &configurationstores_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprConfigurationStore, datamodel.DaprConfigurationStore](options, &configurationstores_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprConfigurationStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
@@ -160,22 +190,26 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.DaprConfigurationStore]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.DaprConfigurationStore]{
 				rp_frontend.PrepareRadiusResource[*datamodel.DaprConfigurationStore],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.DaprConfigurationStore],
+				rp_frontend.ValidateQuota[*datamodel.DaprConfigurationStore],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprConfigurationStore, datamodel.DaprConfigurationStore](options, &configurationstores_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.DaprConfigurationStore, datamodel.DaprConfigurationStore](options, &configurationstores_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncCreateOrUpdateDaprConfigurationStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
 		Delete: builder.Operation[datamodel.DaprConfigurationStore]{
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
-				return pr_ctrl.NewDeleteResource[*datamodel.DaprConfigurationStore, datamodel.DaprConfigurationStore](options, &configurationstores_proc.Processor{Client: options.KubeClient}, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader)
+				return pr_ctrl.NewDeleteResource[*datamodel.DaprConfigurationStore, datamodel.DaprConfigurationStore](options, &configurationstores_proc.Processor{Client: options.KubeClient, UCPConnection: *recipeControllerConfig.UCPConnection}, recipeControllerConfig.Engine, recipeControllerConfig.ConfigLoader)
 			},
 			AsyncOperationTimeout:    dapr_ctrl.AsyncDeleteDaprConfigurationStoreTimeout,
 			AsyncOperationRetryAfter: AsyncOperationRetryAfter,
 		},
 	})
 
+	ns.SetAPIVersions(v20231001preview.Version)
+
 	// Optional
 	ns.SetAvailableOperations(operationList)
 
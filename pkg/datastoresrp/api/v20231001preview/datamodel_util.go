@@ -115,17 +115,14 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 	return status
 }
 
+// toRecipeDataModel converts the recipe of a request to the data model. The default recipe name is applied by the
+// frontend defaulters of the resource type.
 func toRecipeDataModel(r *Recipe) portableresources.ResourceRecipe {
 	if r == nil {
-		return portableresources.ResourceRecipe{
-			Name: portableresources.DefaultRecipeName,
-		}
+		return portableresources.ResourceRecipe{}
 	}
-	recipe := portableresources.ResourceRecipe{}
-	if r.Name == nil {
-		recipe.Name = portableresources.DefaultRecipeName
-	} else {
-		recipe.Name = to.String(r.Name)
+	recipe := portableresources.ResourceRecipe{
+		Name: to.String(r.Name),
 	}
 	if r.Parameters != nil {
 		recipe.Parameters = r.Parameters
@@ -196,3 +193,23 @@ func toOutputResources(outputResources []rpv1.OutputResource) []*OutputResource
 	}
 	return outResources
 }
+
+func fromResourceHealth(health *rpv1.ResourceHealth) *ResourceHealth {
+	if health == nil {
+		return nil
+	}
+
+	result := &ResourceHealth{
+		State: to.Ptr(ResourceHealthState(health.State)),
+	}
+
+	// We will not serialize the following fields if they are empty.
+	if health.Message != "" {
+		result.Message = to.Ptr(health.Message)
+	}
+	if !health.LastTransitionTime.IsZero() {
+		result.LastTransitionTime = to.Ptr(health.LastTransitionTime)
+	}
+
+	return result
+}
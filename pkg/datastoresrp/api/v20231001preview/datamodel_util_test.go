@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -249,6 +250,35 @@ func Test_fromRecipeStatus(t *testing.T) {
 	}
 }
 
+func Test_fromResourceHealth(t *testing.T) {
+	lastTransitionTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		health   *rpv1.ResourceHealth
+		expected *ResourceHealth
+	}{
+		{&rpv1.ResourceHealth{
+			State:              rpv1.HealthStateUnhealthy,
+			Message:            "connection refused",
+			LastTransitionTime: lastTransitionTime,
+		}, &ResourceHealth{
+			State:              to.Ptr(ResourceHealthStateUnhealthy),
+			Message:            to.Ptr("connection refused"),
+			LastTransitionTime: to.Ptr(lastTransitionTime),
+		}},
+		{nil, nil},
+		{&rpv1.ResourceHealth{
+			State: rpv1.HealthStateHealthy,
+		}, &ResourceHealth{
+			State: to.Ptr(ResourceHealthStateHealthy),
+		}},
+	}
+
+	for _, tt := range testCases {
+		health := fromResourceHealth(tt.health)
+		require.Equal(t, tt.expected, health)
+	}
+}
+
 func TestToRecipeDataModel(t *testing.T) {
 	testset := []struct {
 		versioned *Recipe
@@ -256,9 +286,7 @@ func TestToRecipeDataModel(t *testing.T) {
 	}{
 		{
 			nil,
-			portableresources.ResourceRecipe{
-				Name: portableresources.DefaultRecipeName,
-			},
+			portableresources.ResourceRecipe{},
 		},
 		{
 			&Recipe{
@@ -281,7 +309,6 @@ func TestToRecipeDataModel(t *testing.T) {
 				},
 			},
 			portableresources.ResourceRecipe{
-				Name: portableresources.DefaultRecipeName,
 				Parameters: map[string]any{
 					"foo": "bar",
 				},
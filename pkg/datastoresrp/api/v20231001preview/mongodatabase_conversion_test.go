@@ -126,7 +126,7 @@ func TestMongoDatabase_ConvertVersionedToDataModel(t *testing.T) {
 					ResourceProvisioning: portableresources.ResourceProvisioningRecipe,
 					Host:                 "mynewhost.com",
 					Port:                 10256,
-					Recipe:               portableresources.ResourceRecipe{Name: portableresources.DefaultRecipeName, Parameters: nil},
+					Recipe:               portableresources.ResourceRecipe{Name: "", Parameters: nil},
 				},
 			},
 		},
@@ -160,7 +160,7 @@ func TestMongoDatabase_ConvertVersionedToDataModel(t *testing.T) {
 					Username:             "testUser",
 					Secrets: datamodel.MongoDatabaseSecrets{
 						Password:         "testPassword",
-						ConnectionString: "test-connection-string",
+						ConnectionString: "mongodb://testAccount.mongo.cosmos.azure.com:10255/test-database",
 					},
 				},
 			},
@@ -202,6 +202,11 @@ func TestMongoDatabase_ConvertVersionedToDataModel_InvalidRequest(t *testing.T)
 			errType: &v1.ErrClientRP{},
 			message: "code BadRequest: err multiple errors were found:\n\thost must be specified when resourceProvisioning is set to manual\n\tport must be specified when resourceProvisioning is set to manual\n\tdatabase must be specified when resourceProvisioning is set to manual",
 		},
+		{
+			payload: "mongodatabaseresource-invalidformat.json",
+			errType: &v1.ErrClientRP{},
+			message: "code BadRequest: err multiple errors were found:\n\thost must be a host name or IP address without a scheme, got \"mongodb://testAccount.mongo.cosmos.azure.com\"\n\tport must be between 1 and 65535, got 70000\n\tsecrets.connectionString must be a valid URI with the scheme mongodb or mongodb+srv",
+		},
 	}
 	for _, test := range testset {
 		t.Run(test.payload, func(t *testing.T) {
@@ -17,6 +17,8 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"fmt"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -63,6 +65,10 @@ func (src *RedisCacheResource) ConvertTo() (v1.DataModelInterface, error) {
 	converted.Properties.Port = to.Int32(v.Port)
 	converted.Properties.TLS = to.Bool(v.TLS)
 	converted.Properties.Username = to.String(v.Username)
+	converted.Properties.Topology, err = toRedisTopologyDataModel(v.Topology)
+	if err != nil {
+		return nil, err
+	}
 	if v.Secrets != nil {
 		converted.Properties.Secrets = datamodel.RedisCacheSecrets{
 			ConnectionString: to.String(v.Secrets.ConnectionString),
@@ -99,8 +105,10 @@ func (dst *RedisCacheResource) ConvertFrom(src v1.DataModelInterface) error {
 		Port:                 to.Ptr(redis.Properties.Port),
 		TLS:                  to.Ptr(redis.Properties.TLS),
 		Username:             to.Ptr(redis.Properties.Username),
+		Topology:             fromRedisTopologyDataModel(redis.Properties.Topology),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(redis.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(redis.Properties.Status.Health),
 			Recipe:          fromRecipeStatus(redis.Properties.Status.Recipe),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(redis.InternalMetadata.AsyncProvisioningState),
@@ -111,6 +119,55 @@ func (dst *RedisCacheResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+func toRedisTopologyDataModel(topology *RedisTopology) (datamodel.RedisTopology, error) {
+	if topology == nil {
+		return datamodel.RedisTopology{}, nil
+	}
+
+	converted := datamodel.RedisTopology{
+		MasterName: to.String(topology.MasterName),
+	}
+	for _, endpoint := range topology.Endpoints {
+		if endpoint != nil {
+			converted.Endpoints = append(converted.Endpoints, *endpoint)
+		}
+	}
+
+	if topology.Mode != nil {
+		switch *topology.Mode {
+		case RedisTopologyModeStandalone:
+			converted.Mode = datamodel.RedisTopologyModeStandalone
+		case RedisTopologyModeCluster:
+			converted.Mode = datamodel.RedisTopologyModeCluster
+		case RedisTopologyModeSentinel:
+			converted.Mode = datamodel.RedisTopologyModeSentinel
+		default:
+			return datamodel.RedisTopology{}, &v1.ErrModelConversion{PropertyName: "$.properties.topology.mode", ValidValue: fmt.Sprintf("one of %s", PossibleRedisTopologyModeValues())}
+		}
+	}
+
+	return converted, nil
+}
+
+func fromRedisTopologyDataModel(topology datamodel.RedisTopology) *RedisTopology {
+	if topology.IsEmpty() {
+		return nil
+	}
+
+	converted := &RedisTopology{}
+	if len(topology.Endpoints) > 0 {
+		converted.Endpoints = to.SliceOfPtrs(topology.Endpoints...)
+	}
+	if topology.Mode != "" {
+		converted.Mode = to.Ptr(RedisTopologyMode(topology.Mode))
+	}
+	if topology.MasterName != "" {
+		converted.MasterName = to.Ptr(topology.MasterName)
+	}
+
+	return converted
+}
+
 // ConvertFrom converts from version-agnostic datamodel to the versioned Redis cacheSecrets instance
 // and returns an error if the conversion fails.
 func (dst *RedisCacheSecrets) ConvertFrom(src v1.DataModelInterface) error {
@@ -45,7 +45,7 @@ func TestRedisCache_ConvertVersionedToDataModel(t *testing.T) {
 		expected *datamodel.RedisCache
 	}{
 		{
-			desc: "redis cache with default recipe",
+			desc: "redis cache without recipe name",
 			file: "rediscacheresource_defaultrecipe.json",
 			expected: &datamodel.RedisCache{
 				BaseResource: createBaseResource(),
@@ -56,7 +56,7 @@ func TestRedisCache_ConvertVersionedToDataModel(t *testing.T) {
 					Port:                    0,
 					TLS:                     false,
 					Username:                "",
-					Recipe:                  portableresources.ResourceRecipe{Name: "default"},
+					Recipe:                  portableresources.ResourceRecipe{},
 				},
 			},
 		},
@@ -108,7 +108,7 @@ func TestRedisCache_ConvertVersionedToDataModel(t *testing.T) {
 					Secrets: datamodel.RedisCacheSecrets{
 						Password:         "testPassword",
 						ConnectionString: "test-connection-string",
-						URL:              "test-url",
+						URL:              "rediss://myrediscache.redis.cache.windows.net:10255",
 					},
 				},
 			},
@@ -128,6 +128,22 @@ func TestRedisCache_ConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "redis cache manual with sentinel topology",
+			file: "rediscacheresource_manual_sentinel.json",
+			expected: &datamodel.RedisCache{
+				BaseResource: createBaseResource(),
+				Properties: datamodel.RedisCacheProperties{
+					BasicResourceProperties: createBasicResourceProperties(),
+					ResourceProvisioning:    portableresources.ResourceProvisioningManual,
+					Topology: datamodel.RedisTopology{
+						Mode:       datamodel.RedisTopologyModeSentinel,
+						Endpoints:  []string{"sentinel-0.redis:26379", "sentinel-1.redis:26379"},
+						MasterName: "mymaster",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testset {
@@ -262,6 +278,35 @@ func TestRedisCache_ConvertDataModelToVersioned(t *testing.T) {
 				Type: to.Ptr(ds_ctrl.RedisCachesResourceType),
 			},
 		},
+		{
+			desc: "redis cache manual with cluster topology",
+			file: "rediscacheresourcedatamodel_manual_cluster.json",
+			expected: &RedisCacheResource{
+				Location: to.Ptr(""),
+				Properties: &RedisCacheProperties{
+					Environment:          to.Ptr(EnvironmentID),
+					Application:          to.Ptr(ApplicationID),
+					ResourceProvisioning: to.Ptr(ResourceProvisioningManual),
+					Host:                 to.Ptr("redis-0.redis"),
+					Port:                 to.Ptr(int32(6379)),
+					ProvisioningState:    to.Ptr(ProvisioningStateAccepted),
+					Recipe:               &Recipe{Name: to.Ptr(""), Parameters: nil},
+					Username:             to.Ptr(""),
+					TLS:                  to.Ptr(false),
+					Topology: &RedisTopology{
+						Mode:      to.Ptr(RedisTopologyModeCluster),
+						Endpoints: to.SliceOfPtrs("redis-0.redis:6379", "redis-1.redis:6379", "redis-2.redis:6379"),
+					},
+					Status: resourcetypeutil.MustPopulateResourceStatus(&ResourceStatus{}),
+				},
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				ID:   to.Ptr(RedisID),
+				Name: to.Ptr("redis0"),
+				Type: to.Ptr(ds_ctrl.RedisCachesResourceType),
+			},
+		},
 	}
 
 	for _, tc := range testset1 {
@@ -284,7 +329,7 @@ func TestRedisCache_ConvertDataModelToVersioned(t *testing.T) {
 }
 
 func TestRedisCache_ConvertVersionedToDataModel_InvalidRequest(t *testing.T) {
-	testset := []string{"rediscacheresource-invalid.json", "rediscacheresource-invalid2.json"}
+	testset := []string{"rediscacheresource-invalid.json", "rediscacheresource-invalid2.json", "rediscacheresource-invalidformat.json", "rediscacheresource-invalidtopology.json"}
 	for _, payload := range testset {
 		// arrange
 		rawPayload := testutil.ReadFixture(payload)
@@ -301,6 +346,16 @@ func TestRedisCache_ConvertVersionedToDataModel_InvalidRequest(t *testing.T) {
 			_, err = versionedResource.ConvertTo()
 			require.Equal(t, &expectedErr, err)
 		}
+		if payload == "rediscacheresource-invalidtopology.json" {
+			expectedErr := v1.ErrClientRP{Code: "BadRequest", Message: "multiple errors were found:\n\ttopology.endpoints[0] must be in the form host:port, got \"sentinel-0.redis\"\n\ttopology.endpoints[1] port must be between 1 and 65535, got 70000\n\ttopology.masterName must be specified when topology.mode is sentinel"}
+			_, err = versionedResource.ConvertTo()
+			require.Equal(t, &expectedErr, err)
+		}
+		if payload == "rediscacheresource-invalidformat.json" {
+			expectedErr := v1.ErrClientRP{Code: "BadRequest", Message: "multiple errors were found:\n\thost must be a valid host name or IP address, got \"my rediscache\"\n\tport must be between 1 and 65535, got 70000\n\tsecrets.url must be a valid URI with the scheme redis or rediss"}
+			_, err = versionedResource.ConvertTo()
+			require.Equal(t, &expectedErr, err)
+		}
 	}
 }
 
@@ -99,6 +99,7 @@ func (dst *SQLDatabaseResource) ConvertFrom(src v1.DataModelInterface) error {
 		Port:                 to.Ptr(sql.Properties.Port),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(sql.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(sql.Properties.Status.Health),
 			Recipe:          fromRecipeStatus(sql.Properties.Status.Recipe),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(sql.InternalMetadata.AsyncProvisioningState),
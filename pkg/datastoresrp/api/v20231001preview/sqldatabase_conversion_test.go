@@ -233,6 +233,11 @@ func TestSqlDatabase_ConvertVersionedToDataModel_InvalidRequest(t *testing.T) {
 			&v1.ErrClientRP{},
 			"code BadRequest: err multiple errors were found:\n\tserver must be specified when resourceProvisioning is set to manual\n\tport must be specified when resourceProvisioning is set to manual\n\tdatabase must be specified when resourceProvisioning is set to manual",
 		},
+		{
+			"sqldatabaseresource-invalidformat.json",
+			&v1.ErrClientRP{},
+			"code BadRequest: err multiple errors were found:\n\tserver must be a valid host name or IP address, got \"testAccount1.sql.cosmos.azure.com:1433\"\n\tport must be between 1 and 65535, got -1",
+		},
 		{
 			"sqldatabase_invalid_resourceprovisioning_resource.json",
 			&v1.ErrModelConversion{},
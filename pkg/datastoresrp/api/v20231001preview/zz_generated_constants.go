@@ -116,6 +116,48 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RedisTopologyMode - The topology mode of a Redis deployment
+type RedisTopologyMode string
+
+const (
+// RedisTopologyModeCluster - A Redis Cluster of multiple shards
+	RedisTopologyModeCluster RedisTopologyMode = "cluster"
+// RedisTopologyModeSentinel - Redis servers monitored by Redis Sentinel
+	RedisTopologyModeSentinel RedisTopologyMode = "sentinel"
+// RedisTopologyModeStandalone - A single Redis server
+	RedisTopologyModeStandalone RedisTopologyMode = "standalone"
+)
+
+// PossibleRedisTopologyModeValues returns the possible values for the RedisTopologyMode const type.
+func PossibleRedisTopologyModeValues() []RedisTopologyMode {
+	return []RedisTopologyMode{	
+		RedisTopologyModeCluster,
+		RedisTopologyModeSentinel,
+		RedisTopologyModeStandalone,
+	}
+}
+
+// ResourceHealthState - The health state of a resource.
+type ResourceHealthState string
+
+const (
+// ResourceHealthStateHealthy - The resource is healthy
+	ResourceHealthStateHealthy ResourceHealthState = "Healthy"
+// ResourceHealthStateUnhealthy - The resource is unhealthy
+	ResourceHealthStateUnhealthy ResourceHealthState = "Unhealthy"
+// ResourceHealthStateUnknown - The health of the resource could not be determined
+	ResourceHealthStateUnknown ResourceHealthState = "Unknown"
+)
+
+// PossibleResourceHealthStateValues returns the possible values for the ResourceHealthState const type.
+func PossibleResourceHealthStateValues() []ResourceHealthState {
+	return []ResourceHealthState{	
+		ResourceHealthStateHealthy,
+		ResourceHealthStateUnhealthy,
+		ResourceHealthStateUnknown,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
@@ -70,19 +70,27 @@ func (mongoSecrets MongoDatabaseSecrets) IsEmpty() bool {
 	return mongoSecrets == MongoDatabaseSecrets{}
 }
 
-// VerifyInputs checks if the manual resource provisioning fields are set and returns an error if any of them are missing.
+// VerifyInputs checks if the manual resource provisioning fields are set and well-formed, and returns an error if any of
+// them are missing or invalid.
 func (r *MongoDatabase) VerifyInputs() error {
 	msgs := []string{}
 	if r.Properties.ResourceProvisioning != "" && r.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
 		if r.Properties.Host == "" {
 			msgs = append(msgs, "host must be specified when resourceProvisioning is set to manual")
+		} else if msg := portableresources.ValidateHost(r.Properties.Host); msg != "" {
+			msgs = append(msgs, "host "+msg)
 		}
 		if r.Properties.Port == 0 {
 			msgs = append(msgs, "port must be specified when resourceProvisioning is set to manual")
+		} else if msg := portableresources.ValidatePort(r.Properties.Port); msg != "" {
+			msgs = append(msgs, "port "+msg)
 		}
 		if r.Properties.Database == "" {
 			msgs = append(msgs, "database must be specified when resourceProvisioning is set to manual")
 		}
+		if msg := portableresources.ValidateURI(r.Properties.Secrets.ConnectionString, "mongodb", "mongodb+srv"); msg != "" {
+			msgs = append(msgs, "secrets.connectionString "+msg)
+		}
 	}
 
 	if len(msgs) == 1 {
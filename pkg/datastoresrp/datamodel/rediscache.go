@@ -18,6 +18,8 @@ package datamodel
 
 import (
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -73,19 +75,30 @@ func (redisSecrets *RedisCacheSecrets) IsEmpty() bool {
 	return redisSecrets == nil || *redisSecrets == RedisCacheSecrets{}
 }
 
-// VerifyInputs checks if the required fields are set when the resourceProvisioning is set to manual
+// VerifyInputs checks if the required fields are set and well-formed when the resourceProvisioning is set to manual
 // and returns an error if not.
 func (r *RedisCache) VerifyInputs() error {
 	msgs := []string{}
 	if r.Properties.ResourceProvisioning != "" && r.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
-		if r.Properties.Host == "" {
+		// The host and port default to the first endpoint of cluster and sentinel topologies.
+		distributed := r.Properties.Topology.IsDistributed() && len(r.Properties.Topology.Endpoints) > 0
+		if r.Properties.Host == "" && !distributed {
 			msgs = append(msgs, "host must be specified when resourceProvisioning is set to manual")
+		} else if msg := portableresources.ValidateHost(r.Properties.Host); msg != "" {
+			msgs = append(msgs, "host "+msg)
 		}
-		if r.Properties.Port == 0 {
+		if r.Properties.Port == 0 && !distributed {
 			msgs = append(msgs, "port must be specified when resourceProvisioning is set to manual")
+		} else if msg := portableresources.ValidatePort(r.Properties.Port); msg != "" {
+			msgs = append(msgs, "port "+msg)
+		}
+		if msg := portableresources.ValidateURI(r.Properties.Secrets.URL, "redis", "rediss"); msg != "" {
+			msgs = append(msgs, "secrets.url "+msg)
 		}
 	}
 
+	msgs = append(msgs, r.Properties.Topology.Validate()...)
+
 	if len(msgs) == 1 {
 		return &v1.ErrClientRP{
 			Code:    v1.CodeInvalid,
@@ -115,6 +128,9 @@ type RedisCacheProperties struct {
 	// Specifies whether to enable non-SSL or SSL connections
 	TLS bool `json:"tls,omitempty"`
 
+	// The topology of the Redis deployment
+	Topology RedisTopology `json:"topology,omitempty"`
+
 	// The recipe used to automatically deploy underlying infrastructure for the Redis caches link
 	Recipe portableresources.ResourceRecipe `json:"recipe,omitempty"`
 
@@ -128,6 +144,89 @@ type RedisCacheProperties struct {
 	Resources []*portableresources.ResourceReference `json:"resources,omitempty"`
 }
 
+// RedisTopologyMode is the topology mode of a Redis deployment.
+type RedisTopologyMode string
+
+const (
+	// RedisTopologyModeStandalone is a single Redis server.
+	RedisTopologyModeStandalone RedisTopologyMode = "standalone"
+
+	// RedisTopologyModeCluster is a Redis Cluster of multiple shards.
+	RedisTopologyModeCluster RedisTopologyMode = "cluster"
+
+	// RedisTopologyModeSentinel is Redis servers monitored by Redis Sentinel.
+	RedisTopologyModeSentinel RedisTopologyMode = "sentinel"
+)
+
+// RedisTopology represents the topology of a Redis deployment.
+type RedisTopology struct {
+	// The topology mode. The empty value is the standalone topology.
+	Mode RedisTopologyMode `json:"mode,omitempty"`
+
+	// The endpoints of the cluster nodes or of the sentinels, in the form host:port
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// The name of the master monitored by the sentinels
+	MasterName string `json:"masterName,omitempty"`
+}
+
+// IsEmpty checks if the RedisTopology instance is empty or not.
+func (t *RedisTopology) IsEmpty() bool {
+	return t == nil || (t.Mode == "" && len(t.Endpoints) == 0 && t.MasterName == "")
+}
+
+// IsDistributed returns true if the topology is a cluster or sentinel topology with multiple endpoints.
+func (t *RedisTopology) IsDistributed() bool {
+	return t != nil && (t.Mode == RedisTopologyModeCluster || t.Mode == RedisTopologyModeSentinel)
+}
+
+// Validate checks that the endpoints and master name are consistent with the topology mode and returns the validation
+// messages.
+func (t *RedisTopology) Validate() []string {
+	if t.IsEmpty() {
+		return nil
+	}
+
+	msgs := []string{}
+	switch t.Mode {
+	case "", RedisTopologyModeStandalone:
+		if len(t.Endpoints) > 0 {
+			msgs = append(msgs, "topology.endpoints can only be specified when topology.mode is cluster or sentinel")
+		}
+	case RedisTopologyModeCluster, RedisTopologyModeSentinel:
+		if len(t.Endpoints) == 0 {
+			msgs = append(msgs, fmt.Sprintf("topology.endpoints must be specified when topology.mode is %s", t.Mode))
+		}
+	default:
+		msgs = append(msgs, fmt.Sprintf("topology.mode must be one of standalone, cluster or sentinel, got %q", t.Mode))
+	}
+
+	for i, endpoint := range t.Endpoints {
+		host, port, err := net.SplitHostPort(endpoint)
+		if err != nil || host == "" {
+			msgs = append(msgs, fmt.Sprintf("topology.endpoints[%d] must be in the form host:port, got %q", i, endpoint))
+			continue
+		}
+
+		if msg := portableresources.ValidateHost(host); msg != "" {
+			msgs = append(msgs, fmt.Sprintf("topology.endpoints[%d] host %s", i, msg))
+		}
+		if p, err := strconv.ParseInt(port, 10, 32); err != nil || p == 0 {
+			msgs = append(msgs, fmt.Sprintf("topology.endpoints[%d] port must be a number between 1 and 65535, got %q", i, port))
+		} else if msg := portableresources.ValidatePort(int32(p)); msg != "" {
+			msgs = append(msgs, fmt.Sprintf("topology.endpoints[%d] port %s", i, msg))
+		}
+	}
+
+	if t.Mode == RedisTopologyModeSentinel && t.MasterName == "" {
+		msgs = append(msgs, "topology.masterName must be specified when topology.mode is sentinel")
+	} else if t.Mode != RedisTopologyModeSentinel && t.MasterName != "" {
+		msgs = append(msgs, "topology.masterName can only be specified when topology.mode is sentinel")
+	}
+
+	return msgs
+}
+
 // Secrets values consisting of secrets provided for the resource
 type RedisCacheSecrets struct {
 	ConnectionString string `json:"connectionString"`
@@ -98,16 +98,20 @@ type SqlDatabaseSecrets struct {
 // VerifyInputs checks that the inputs for manual resource provisioning are all provided
 //
 
-// VerifyInputs checks if the required fields are set when the resourceProvisioning is set to manual and returns an error
-// if any of the required fields are not set.
+// VerifyInputs checks if the required fields are set and well-formed when the resourceProvisioning is set to manual and
+// returns an error if any of the required fields are not set or invalid.
 func (sql *SqlDatabase) VerifyInputs() error {
 	msgs := []string{}
 	if sql.Properties.ResourceProvisioning != "" && sql.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
 		if sql.Properties.Server == "" {
 			msgs = append(msgs, "server must be specified when resourceProvisioning is set to manual")
+		} else if msg := portableresources.ValidateHost(sql.Properties.Server); msg != "" {
+			msgs = append(msgs, "server "+msg)
 		}
 		if sql.Properties.Port == 0 {
 			msgs = append(msgs, "port must be specified when resourceProvisioning is set to manual")
+		} else if msg := portableresources.ValidatePort(sql.Properties.Port); msg != "" {
+			msgs = append(msgs, "port "+msg)
 		}
 		if sql.Properties.Database == "" {
 			msgs = append(msgs, "database must be specified when resourceProvisioning is set to manual")
@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
 // Processor is a processor for MongoDB resources.
@@ -35,12 +36,12 @@ func (p *Processor) Process(ctx context.Context, resource *datamodel.MongoDataba
 	validator := processors.NewValidator(&resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.Properties.Status.Recipe)
 
 	validator.AddResourcesField(&resource.Properties.Resources)
-	validator.AddRequiredStringField(renderers.Host, &resource.Properties.Host)
-	validator.AddRequiredInt32Field(renderers.Port, &resource.Properties.Port)
+	validator.AddRequiredHostField(renderers.Host, &resource.Properties.Host)
+	validator.AddRequiredPortField(renderers.Port, &resource.Properties.Port)
 	validator.AddRequiredStringField(renderers.DatabaseNameValue, &resource.Properties.Database)
 	validator.AddOptionalStringField(renderers.UsernameStringValue, &resource.Properties.Username)
 	validator.AddOptionalSecretField(renderers.PasswordStringHolder, &resource.Properties.Secrets.Password)
-	validator.AddComputedSecretField(renderers.ConnectionStringValue, &resource.Properties.Secrets.ConnectionString, func() (string, *processors.ValidationError) {
+	validator.AddComputedURISecretField(renderers.ConnectionStringValue, &resource.Properties.Secrets.ConnectionString, []string{"mongodb", "mongodb+srv"}, func() (string, *processors.ValidationError) {
 		return p.computeConnectionString(resource), nil
 	})
 
@@ -57,6 +58,12 @@ func (p *Processor) Delete(ctx context.Context, resource *datamodel.MongoDatabas
 	return nil
 }
 
+// CheckHealth implements the processors.HealthChecker interface for MongoDatabase resources. The resource is healthy when its
+// Azure output resources are provisioned.
+func (p *Processor) CheckHealth(ctx context.Context, resource *datamodel.MongoDatabase, options processors.HealthOptions) (rpv1.ResourceHealth, error) {
+	return processors.CheckOutputResourcesHealth(ctx, options.ResourceClient, resource.Properties.Status.OutputResources)
+}
+
 func (p *Processor) computeConnectionString(resource *datamodel.MongoDatabase) string {
 	connectionString := "mongodb://"
 
@@ -234,4 +234,49 @@ the connection value "host" should be provided by the recipe, set '.properties.h
 the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually
 the connection value "database" should be provided by the recipe, set '.properties.database' to provide a value manually`, err.Error())
 	})
+
+	t.Run("failure - invalid values", func(t *testing.T) {
+		resource := &datamodel.MongoDatabase{
+			Properties: datamodel.MongoDatabaseProperties{
+				Host:     "mongodb://" + host,
+				Database: database,
+				Secrets: datamodel.MongoDatabaseSecrets{
+					ConnectionString: "test.mongo.cosmos.azure.com:10255",
+				},
+			},
+		}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Values: map[string]any{
+					"port": 70000,
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, `validation returned multiple errors:
+
+the connection value "host" must be a host name or IP address without a scheme, got "mongodb://test.mongo.cosmos.azure.com"
+the connection value "port" provided by the recipe must be between 1 and 65535, got 70000`, err.Error())
+	})
+
+	t.Run("failure - invalid connection string", func(t *testing.T) {
+		resource := &datamodel.MongoDatabase{
+			Properties: datamodel.MongoDatabaseProperties{
+				Host:     host,
+				Port:     port,
+				Database: database,
+				Secrets: datamodel.MongoDatabaseSecrets{
+					ConnectionString: "test.mongo.cosmos.azure.com:10255",
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, processors.Options{})
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, `the connection secret "connectionString" must be a valid URI with the scheme mongodb or mongodb+srv`, err.Error())
+	})
 }
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rediscaches
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/rp/health"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// CheckHealth implements the processors.HealthChecker interface for RedisCache resources. The Redis cache is healthy
+// when its Azure output resources are provisioned and the Redis server responds to a PING command.
+func (p *Processor) CheckHealth(ctx context.Context, resource *datamodel.RedisCache, options processors.HealthOptions) (rpv1.ResourceHealth, error) {
+	result, err := processors.CheckOutputResourcesHealth(ctx, options.ResourceClient, resource.Properties.Status.OutputResources)
+	if err != nil || result.State != rpv1.HealthStateHealthy {
+		return result, err
+	}
+
+	if resource.Properties.Host == "" || resource.Properties.Port == 0 {
+		return result, nil
+	}
+
+	return p.ping(ctx, resource.Properties.Host, resource.Properties.Port, resource.Properties.TLS), nil
+}
+
+// ping sends a PING command to the Redis server. A server which requires authentication is reachable and therefore
+// considered healthy.
+func (p *Processor) ping(ctx context.Context, host string, port int32, useTLS bool) rpv1.ResourceHealth {
+	address := net.JoinHostPort(host, strconv.Itoa(int(port)))
+
+	dial := p.dial
+	if dial == nil {
+		dial = dialRedis
+	}
+
+	conn, err := dial(ctx, address, host, useTLS)
+	if err != nil {
+		return health.Unhealthy(fmt.Sprintf("failed to connect to %s: %s", address, err.Error()))
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	_, err = conn.Write([]byte("PING\r\n"))
+	if err != nil {
+		return health.Unhealthy(fmt.Sprintf("failed to send PING to %s: %s", address, err.Error()))
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return health.Unhealthy(fmt.Sprintf("failed to read PING reply from %s: %s", address, err.Error()))
+	}
+
+	reply = strings.TrimSpace(reply)
+	if reply == "+PONG" || strings.HasPrefix(reply, "-NOAUTH") || strings.HasPrefix(reply, "-NOPERM") {
+		return health.Healthy("")
+	}
+
+	return health.Unhealthy(fmt.Sprintf("unexpected PING reply from %s: %q", address, reply))
+}
+
+func dialRedis(ctx context.Context, address string, serverName string, useTLS bool) (net.Conn, error) {
+	if useTLS {
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: serverName, MinVersion: tls.VersionTLS12}}
+		return dialer.DialContext(ctx, "tcp", address)
+	}
+
+	dialer := &net.Dialer{}
+	return dialer.DialContext(ctx, "tcp", address)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rediscaches
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// startRedis starts a fake Redis server which replies to every command with the given reply.
+func startRedis(t *testing.T, reply string) (string, int32) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			_, _ = bufio.NewReader(conn).ReadString('\n')
+			_, _ = conn.Write([]byte(reply))
+			_ = conn.Close()
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return host, int32(p)
+}
+
+func newRedisCache(host string, port int32) *datamodel.RedisCache {
+	resource := &datamodel.RedisCache{}
+	resource.Properties.Host = host
+	resource.Properties.Port = port
+	return resource
+}
+
+func Test_CheckHealth(t *testing.T) {
+	processor := Processor{}
+	options := processors.HealthOptions{}
+
+	t.Run("healthy - PONG", func(t *testing.T) {
+		host, port := startRedis(t, "+PONG\r\n")
+
+		result, err := processor.CheckHealth(context.Background(), newRedisCache(host, port), options)
+		require.NoError(t, err)
+		require.Equal(t, rpv1.HealthStateHealthy, result.State)
+	})
+
+	t.Run("healthy - authentication required", func(t *testing.T) {
+		host, port := startRedis(t, "-NOAUTH Authentication required.\r\n")
+
+		result, err := processor.CheckHealth(context.Background(), newRedisCache(host, port), options)
+		require.NoError(t, err)
+		require.Equal(t, rpv1.HealthStateHealthy, result.State)
+	})
+
+	t.Run("unhealthy - unexpected reply", func(t *testing.T) {
+		host, port := startRedis(t, "-LOADING Redis is loading the dataset in memory\r\n")
+
+		result, err := processor.CheckHealth(context.Background(), newRedisCache(host, port), options)
+		require.NoError(t, err)
+		require.Equal(t, rpv1.HealthStateUnhealthy, result.State)
+		require.Contains(t, result.Message, "unexpected PING reply")
+	})
+
+	t.Run("unhealthy - connection failed", func(t *testing.T) {
+		processor := Processor{
+			dial: func(ctx context.Context, address string, serverName string, useTLS bool) (net.Conn, error) {
+				require.Equal(t, "myredis.redis.cache.windows.net:6380", address)
+				require.True(t, useTLS)
+				return nil, errors.New("connection refused")
+			},
+		}
+
+		resource := newRedisCache("myredis.redis.cache.windows.net", RedisSSLPort)
+		resource.Properties.TLS = true
+
+		result, err := processor.CheckHealth(context.Background(), resource, options)
+		require.NoError(t, err)
+		require.Equal(t, rpv1.HealthStateUnhealthy, result.State)
+		require.Equal(t, "failed to connect to myredis.redis.cache.windows.net:6380: connection refused", result.Message)
+	})
+
+	t.Run("unhealthy - output resource failed", func(t *testing.T) {
+		const azureRedisResourceID = "/subscriptions/0000/resourceGroups/test-group/providers/Microsoft.Cache/redis/myredis"
+		id, err := resources.ParseResource(azureRedisResourceID)
+		require.NoError(t, err)
+
+		client := processors.NewMockResourceClient(gomock.NewController(t))
+		client.EXPECT().GetProvisioningState(gomock.Any(), azureRedisResourceID).Return("Failed", nil)
+
+		resource := newRedisCache("myredis.redis.cache.windows.net", RedisSSLPort)
+		resource.Properties.Status.OutputResources = []rpv1.OutputResource{{ID: id}}
+
+		result, err := processor.CheckHealth(context.Background(), resource, processors.HealthOptions{ResourceClient: client})
+		require.NoError(t, err)
+		require.Equal(t, rpv1.HealthStateUnhealthy, result.State)
+		require.Contains(t, result.Message, `has provisioning state "Failed"`)
+	})
+}
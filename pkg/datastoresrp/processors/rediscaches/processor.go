@@ -19,10 +19,15 @@ package rediscaches
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
 const (
@@ -31,20 +36,36 @@ const (
 
 	// RedisSSLPort is the default port for Redis SSL connections.
 	RedisSSLPort = 6380
+
+	// TopologyValue is the name of the connection value of the topology mode of cluster and sentinel topologies.
+	TopologyValue = "topology"
+
+	// EndpointsValue is the name of the connection value of the comma-separated endpoints of cluster and sentinel topologies.
+	EndpointsValue = "endpoints"
+
+	// MasterNameValue is the name of the connection value of the master name of sentinel topologies.
+	MasterNameValue = "masterName"
 )
 
 // Processor is a processor for RedisCache resources.
 type Processor struct {
+	// dial is used to connect to the Redis server when checking its health. Override this for testing.
+	dial func(ctx context.Context, address string, serverName string, useTLS bool) (net.Conn, error)
 }
 
 // Process implements the processors.Processor interface for RedisCache resources. It validates the input parameters and computes
 // the connection string and connection URI for the RedisCache resource, and applies the values from the RecipeOutput.
 func (p *Processor) Process(ctx context.Context, resource *datamodel.RedisCache, options processors.Options) error {
+	err := p.bindTopology(resource, options.RecipeOutput)
+	if err != nil {
+		return err
+	}
+
 	validator := processors.NewValidator(&resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.Properties.Status.Recipe)
 
 	validator.AddResourcesField(&resource.Properties.Resources)
-	validator.AddRequiredStringField(renderers.Host, &resource.Properties.Host)
-	validator.AddRequiredInt32Field(renderers.Port, &resource.Properties.Port)
+	validator.AddRequiredHostField(renderers.Host, &resource.Properties.Host)
+	validator.AddRequiredPortField(renderers.Port, &resource.Properties.Port)
 	validator.AddOptionalStringField(renderers.UsernameStringValue, &resource.Properties.Username)
 	validator.AddComputedBoolField(renderers.TLS, &resource.Properties.TLS, func() (bool, *processors.ValidationError) {
 		return p.computeSSL(resource), nil
@@ -53,11 +74,19 @@ func (p *Processor) Process(ctx context.Context, resource *datamodel.RedisCache,
 	validator.AddComputedSecretField(renderers.ConnectionStringValue, &resource.Properties.Secrets.ConnectionString, func() (string, *processors.ValidationError) {
 		return p.computeConnectionString(resource), nil
 	})
-	validator.AddComputedSecretField(renderers.ConnectionURIValue, &resource.Properties.Secrets.URL, func() (string, *processors.ValidationError) {
+	validator.AddComputedURISecretField(renderers.ConnectionURIValue, &resource.Properties.Secrets.URL, []string{"redis", "rediss"}, func() (string, *processors.ValidationError) {
 		return p.computeConnectionURI(resource), nil
 	})
 
-	err := validator.SetAndValidate(options.RecipeOutput)
+	if topology := resource.Properties.Topology; topology.IsDistributed() {
+		validator.AddOptionalAnyField(TopologyValue, string(topology.Mode))
+		validator.AddOptionalAnyField(EndpointsValue, strings.Join(topology.Endpoints, ","))
+		if topology.MasterName != "" {
+			validator.AddOptionalAnyField(MasterNameValue, topology.MasterName)
+		}
+	}
+
+	err = validator.SetAndValidate(options.RecipeOutput)
 	if err != nil {
 		return err
 	}
@@ -70,12 +99,104 @@ func (p *Processor) Delete(ctx context.Context, resource *datamodel.RedisCache,
 	return nil
 }
 
+// bindTopology sets the topology of the Redis cache from the values provided by the recipe, unless the topology is set
+// on the resource, and validates it. The host and port default to the first endpoint of cluster and sentinel topologies.
+func (p *Processor) bindTopology(resource *datamodel.RedisCache, output *recipes.RecipeOutput) error {
+	topology := &resource.Properties.Topology
+
+	msgs := []string{}
+	if output != nil && topology.IsEmpty() {
+		if value, ok := output.Values[TopologyValue]; ok {
+			mode, ok := value.(string)
+			if !ok {
+				msgs = append(msgs, fmt.Sprintf("the connection value %q provided by the recipe is expected to be a string, got %T", TopologyValue, value))
+			}
+			topology.Mode = datamodel.RedisTopologyMode(mode)
+		}
+
+		if value, ok := output.Values[EndpointsValue]; ok {
+			endpoints, ok := convertToStringSlice(value)
+			if !ok {
+				msgs = append(msgs, fmt.Sprintf("the connection value %q provided by the recipe is expected to be a list of strings, got %T", EndpointsValue, value))
+			}
+			topology.Endpoints = endpoints
+		}
+
+		if value, ok := output.Values[MasterNameValue]; ok {
+			masterName, ok := value.(string)
+			if !ok {
+				msgs = append(msgs, fmt.Sprintf("the connection value %q provided by the recipe is expected to be a string, got %T", MasterNameValue, value))
+			}
+			topology.MasterName = masterName
+		}
+	}
+
+	if len(msgs) == 0 {
+		msgs = topology.Validate()
+	}
+
+	if len(msgs) == 1 {
+		return &processors.ValidationError{Message: msgs[0]}
+	} else if len(msgs) > 1 {
+		return &processors.ValidationError{Message: fmt.Sprintf("validation returned multiple errors:\n\n%v", strings.Join(msgs, "\n"))}
+	}
+
+	if !topology.IsDistributed() {
+		return nil
+	}
+
+	// The host and port provided by the recipe take precedence over the first endpoint.
+	if output != nil {
+		if _, ok := output.Values[renderers.Host]; ok {
+			return nil
+		}
+	}
+
+	host, port, _ := net.SplitHostPort(topology.Endpoints[0])
+	if resource.Properties.Host == "" {
+		resource.Properties.Host = host
+	}
+	if resource.Properties.Port == 0 {
+		converted, _ := strconv.ParseInt(port, 10, 32)
+		resource.Properties.Port = int32(converted)
+	}
+
+	return nil
+}
+
+func convertToStringSlice(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return v, true
+	case []any:
+		converted := []string{}
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			converted = append(converted, s)
+		}
+		return converted, true
+	default:
+		return nil, false
+	}
+}
+
 func (p *Processor) computeSSL(resource *datamodel.RedisCache) bool {
 	return resource.Properties.Port == RedisSSLPort
 }
 
 func (p *Processor) computeConnectionString(resource *datamodel.RedisCache) string {
-	connectionString := fmt.Sprintf("%s:%v,abortConnect=False", resource.Properties.Host, resource.Properties.Port)
+	address := fmt.Sprintf("%s:%v", resource.Properties.Host, resource.Properties.Port)
+	if resource.Properties.Topology.IsDistributed() {
+		address = strings.Join(resource.Properties.Topology.Endpoints, ",")
+	}
+
+	connectionString := address + ",abortConnect=False"
+	if resource.Properties.Topology.Mode == datamodel.RedisTopologyModeSentinel {
+		connectionString = connectionString + ",serviceName=" + resource.Properties.Topology.MasterName
+	}
 	if resource.Properties.TLS {
 		connectionString = connectionString + ",ssl=True"
 	}
@@ -92,6 +213,9 @@ func (p *Processor) computeConnectionString(resource *datamodel.RedisCache) stri
 
 func (p *Processor) computeConnectionURI(resource *datamodel.RedisCache) string {
 	// Redis connection URIs are of the form: redis://[username:password@]host[:port][/db-number][?option=value]
+	//
+	// The additional endpoints of cluster and sentinel topologies are passed as addr options, and the master name of
+	// sentinel topologies as the master_name option.
 	connectionURI := "redis://"
 	if resource.Properties.TLS {
 		connectionURI = "rediss://"
@@ -104,6 +228,18 @@ func (p *Processor) computeConnectionURI(resource *datamodel.RedisCache) string
 		connectionURI += ":" + resource.Properties.Secrets.Password + "@"
 	}
 
-	connectionURI = fmt.Sprintf("%s%s:%v/0?", connectionURI, resource.Properties.Host, resource.Properties.Port)
+	address := fmt.Sprintf("%s:%v", resource.Properties.Host, resource.Properties.Port)
+	options := url.Values{}
+	if topology := resource.Properties.Topology; topology.IsDistributed() {
+		address = topology.Endpoints[0]
+		for _, endpoint := range topology.Endpoints[1:] {
+			options.Add("addr", endpoint)
+		}
+		if topology.Mode == datamodel.RedisTopologyModeSentinel {
+			options.Set("master_name", topology.MasterName)
+		}
+	}
+
+	connectionURI = fmt.Sprintf("%s%s/0?%s", connectionURI, address, options.Encode())
 	return connectionURI
 }
@@ -182,7 +182,7 @@ func Test_Process(t *testing.T) {
 				Secrets: map[string]any{
 					"password":         "asdf",
 					"connectionString": "asdf",
-					"url":              "asdf",
+					"url":              connectionURI,
 				},
 			},
 		}
@@ -195,7 +195,7 @@ func Test_Process(t *testing.T) {
 		require.Equal(t, username, resource.Properties.Username)
 		require.Equal(t, password, resource.Properties.Secrets.Password)
 		require.Equal(t, connectionString, resource.Properties.Secrets.ConnectionString)
-		require.Equal(t, "asdf", resource.Properties.Secrets.URL)
+		require.Equal(t, connectionURI, resource.Properties.Secrets.URL)
 
 		expectedValues := map[string]any{
 			"host":     host,
@@ -211,7 +211,7 @@ func Test_Process(t *testing.T) {
 				Value: connectionString,
 			},
 			"url": {
-				Value: "asdf",
+				Value: connectionURI,
 			},
 		}
 
@@ -234,6 +234,96 @@ func Test_Process(t *testing.T) {
 		require.Equal(t, expectedOutputResources, resource.Properties.Status.OutputResources)
 	})
 
+	t.Run("success - manual cluster topology", func(t *testing.T) {
+		resource := &datamodel.RedisCache{
+			Properties: datamodel.RedisCacheProperties{
+				Topology: datamodel.RedisTopology{
+					Mode:      datamodel.RedisTopologyModeCluster,
+					Endpoints: []string{"redis-0.redis:6379", "redis-1.redis:6379", "redis-2.redis:6379"},
+				},
+			},
+		}
+		err := processor.Process(context.Background(), resource, processors.Options{})
+		require.NoError(t, err)
+
+		require.Equal(t, "redis-0.redis", resource.Properties.Host)
+		require.Equal(t, int32(RedisNonSSLPort), resource.Properties.Port)
+
+		expectedValues := map[string]any{
+			"host":      "redis-0.redis",
+			"port":      int32(RedisNonSSLPort),
+			"tls":       false,
+			"topology":  "cluster",
+			"endpoints": "redis-0.redis:6379,redis-1.redis:6379,redis-2.redis:6379",
+		}
+		expectedSecrets := map[string]rpv1.SecretValueReference{
+			"connectionString": {
+				Value: "redis-0.redis:6379,redis-1.redis:6379,redis-2.redis:6379,abortConnect=False",
+			},
+			"url": {
+				Value: "redis://redis-0.redis:6379/0?addr=redis-1.redis%3A6379&addr=redis-2.redis%3A6379",
+			},
+		}
+
+		require.Equal(t, expectedValues, resource.ComputedValues)
+		require.Equal(t, expectedSecrets, resource.SecretValues)
+	})
+
+	t.Run("success - recipe sentinel topology", func(t *testing.T) {
+		resource := &datamodel.RedisCache{}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Values: map[string]any{
+					"topology":   "sentinel",
+					"endpoints":  []any{"sentinel-0.redis:26379", "sentinel-1.redis:26379"},
+					"masterName": "mymaster",
+				},
+				Secrets: map[string]any{
+					"password": password,
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.NoError(t, err)
+
+		require.Equal(t, datamodel.RedisTopology{
+			Mode:       datamodel.RedisTopologyModeSentinel,
+			Endpoints:  []string{"sentinel-0.redis:26379", "sentinel-1.redis:26379"},
+			MasterName: "mymaster",
+		}, resource.Properties.Topology)
+		require.Equal(t, "sentinel-0.redis", resource.Properties.Host)
+		require.Equal(t, int32(26379), resource.Properties.Port)
+		require.Equal(t, "sentinel-0.redis:26379,sentinel-1.redis:26379,abortConnect=False,serviceName=mymaster,password=testpassword", resource.Properties.Secrets.ConnectionString)
+		require.Equal(t, "redis://:testpassword@sentinel-0.redis:26379/0?addr=sentinel-1.redis%3A26379&master_name=mymaster", resource.Properties.Secrets.URL)
+		require.Equal(t, "sentinel", resource.ComputedValues["topology"])
+		require.Equal(t, "mymaster", resource.ComputedValues["masterName"])
+	})
+
+	t.Run("failure - invalid topology", func(t *testing.T) {
+		resource := &datamodel.RedisCache{}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Values: map[string]any{
+					"topology":  "sentinel",
+					"endpoints": []any{"sentinel-0.redis:26379"},
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, "topology.masterName must be specified when topology.mode is sentinel", err.Error())
+
+		resource = &datamodel.RedisCache{}
+		options.RecipeOutput.Values["endpoints"] = "sentinel-0.redis:26379"
+
+		err = processor.Process(context.Background(), resource, options)
+		require.Error(t, err)
+		require.Equal(t, `the connection value "endpoints" provided by the recipe is expected to be a list of strings, got string`, err.Error())
+	})
+
 	t.Run("failure - missing required values", func(t *testing.T) {
 		resource := &datamodel.RedisCache{}
 		options := processors.Options{RecipeOutput: &recipes.RecipeOutput{}}
@@ -246,4 +336,47 @@ func Test_Process(t *testing.T) {
 the connection value "host" should be provided by the recipe, set '.properties.host' to provide a value manually
 the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually`, err.Error())
 	})
+
+	t.Run("failure - invalid values", func(t *testing.T) {
+		resource := &datamodel.RedisCache{
+			Properties: datamodel.RedisCacheProperties{
+				Host: "myredis.redis.cache.windows.net:6380",
+			},
+		}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Values: map[string]any{
+					"port": 0x10000,
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, `validation returned multiple errors:
+
+the connection value "host" must be a valid host name or IP address, got "myredis.redis.cache.windows.net:6380"
+the connection value "port" provided by the recipe must be between 1 and 65535, got 65536`, err.Error())
+	})
+
+	t.Run("failure - invalid url", func(t *testing.T) {
+		resource := &datamodel.RedisCache{}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Values: map[string]any{
+					"host": host,
+					"port": RedisSSLPort,
+				},
+				Secrets: map[string]any{
+					"url": "https://myredis.redis.cache.windows.net",
+				},
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, options)
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, `the connection secret "url" provided by the recipe must be a valid URI with the scheme redis or rediss`, err.Error())
+	})
 }
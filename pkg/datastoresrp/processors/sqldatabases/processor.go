@@ -12,6 +12,7 @@ import (
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/portableresources/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
 // Processor is a processor for SQL database resources.
@@ -25,8 +26,8 @@ func (p *Processor) Process(ctx context.Context, resource *datamodel.SqlDatabase
 
 	validator.AddResourcesField(&resource.Properties.Resources)
 	validator.AddRequiredStringField(renderers.DatabaseNameValue, &resource.Properties.Database)
-	validator.AddRequiredStringField(renderers.ServerNameValue, &resource.Properties.Server)
-	validator.AddRequiredInt32Field(renderers.Port, &resource.Properties.Port)
+	validator.AddRequiredHostField(renderers.ServerNameValue, &resource.Properties.Server)
+	validator.AddRequiredPortField(renderers.Port, &resource.Properties.Port)
 	validator.AddOptionalStringField(renderers.UsernameStringValue, &resource.Properties.Username)
 	validator.AddOptionalSecretField(renderers.PasswordStringHolder, &resource.Properties.Secrets.Password)
 	validator.AddComputedSecretField(renderers.ConnectionStringValue, &resource.Properties.Secrets.ConnectionString, func() (string, *processors.ValidationError) {
@@ -46,6 +47,12 @@ func (p *Processor) Delete(ctx context.Context, resource *datamodel.SqlDatabase,
 	return nil
 }
 
+// CheckHealth implements the processors.HealthChecker interface for SQLDatabase resources. The resource is healthy when its
+// Azure output resources are provisioned.
+func (p *Processor) CheckHealth(ctx context.Context, resource *datamodel.SqlDatabase, options processors.HealthOptions) (rpv1.ResourceHealth, error) {
+	return processors.CheckOutputResourcesHealth(ctx, options.ResourceClient, resource.Properties.Status.OutputResources)
+}
+
 func (p *Processor) computeConnectionString(resource *datamodel.SqlDatabase) string {
 	var username, password string
 	if resource.Properties.Username != "" {
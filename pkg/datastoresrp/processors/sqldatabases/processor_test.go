@@ -221,4 +221,22 @@ the connection value "server" should be provided by the recipe, set '.properties
 the connection value "port" should be provided by the recipe, set '.properties.port' to provide a value manually`, err.Error())
 
 	})
+
+	t.Run("failure - invalid values", func(t *testing.T) {
+		resource := &datamodel.SqlDatabase{
+			Properties: datamodel.SqlDatabaseProperties{
+				Database: database,
+				Server:   "tcp:sql.server,1433",
+				Port:     -1,
+			},
+		}
+
+		err := processor.Process(context.Background(), resource, processors.Options{})
+		require.Error(t, err)
+		require.IsType(t, &processors.ValidationError{}, err)
+		require.Equal(t, `validation returned multiple errors:
+
+the connection value "server" must be a valid host name or IP address, got "tcp:sql.server,1433"
+the connection value "port" must be between 1 and 65535, got -1`, err.Error())
+	})
 }
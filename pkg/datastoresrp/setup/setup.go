@@ -22,6 +22,7 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/datastoresrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	"github.com/radius-project/radius/pkg/datastoresrp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
@@ -34,6 +35,7 @@ import (
 	rds_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/rediscaches"
 	sql_proc "github.com/radius-project/radius/pkg/datastoresrp/processors/sqldatabases"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 )
 
@@ -49,10 +51,15 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	_ = ns.AddResource("redisCaches", &builder.ResourceOption[*datamodel.RedisCache, datamodel.RedisCache]{
 		RequestConverter:  converter.RedisCacheDataModelFromVersioned,
 		ResponseConverter: converter.RedisCacheDataModelToVersioned,
+		Defaulters: []apictrl.Defaulter[datamodel.RedisCache]{
+			rp_frontend.DefaultRecipeName[*datamodel.RedisCache],
+		},
 
 		Put: builder.Operation[datamodel.RedisCache]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RedisCache],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.RedisCache],
+				rp_frontend.ValidateQuota[*datamodel.RedisCache],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RedisCache, datamodel.RedisCache](options, &rds_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -63,6 +70,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.RedisCache]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RedisCache],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.RedisCache],
+				rp_frontend.ValidateQuota[*datamodel.RedisCache],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RedisCache, datamodel.RedisCache](options, &rds_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -87,10 +96,15 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	_ = ns.AddResource("mongoDatabases", &builder.ResourceOption[*datamodel.MongoDatabase, datamodel.MongoDatabase]{
 		RequestConverter:  converter.MongoDatabaseDataModelFromVersioned,
 		ResponseConverter: converter.MongoDatabaseDataModelToVersioned,
+		Defaulters: []apictrl.Defaulter[datamodel.MongoDatabase]{
+			rp_frontend.DefaultRecipeName[*datamodel.MongoDatabase],
+		},
 
 		Put: builder.Operation[datamodel.MongoDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.MongoDatabase],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.MongoDatabase],
+				rp_frontend.ValidateQuota[*datamodel.MongoDatabase],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](options, &mongo_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -101,6 +115,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.MongoDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.MongoDatabase],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.MongoDatabase],
+				rp_frontend.ValidateQuota[*datamodel.MongoDatabase],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.MongoDatabase, datamodel.MongoDatabase](options, &mongo_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -125,10 +141,15 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	_ = ns.AddResource("sqlDatabases", &builder.ResourceOption[*datamodel.SqlDatabase, datamodel.SqlDatabase]{
 		RequestConverter:  converter.SqlDatabaseDataModelFromVersioned,
 		ResponseConverter: converter.SqlDatabaseDataModelToVersioned,
+		Defaulters: []apictrl.Defaulter[datamodel.SqlDatabase]{
+			rp_frontend.DefaultRecipeName[*datamodel.SqlDatabase],
+		},
 
 		Put: builder.Operation[datamodel.SqlDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.SqlDatabase],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.SqlDatabase],
+				rp_frontend.ValidateQuota[*datamodel.SqlDatabase],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](options, &sql_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -139,6 +160,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.SqlDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
 				rp_frontend.PrepareRadiusResource[*datamodel.SqlDatabase],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.SqlDatabase],
+				rp_frontend.ValidateQuota[*datamodel.SqlDatabase],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.SqlDatabase, datamodel.SqlDatabase](options, &sql_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -160,8 +183,16 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		},
 	})
 
+	ns.SetAPIVersions(v20231001preview.Version)
+
 	// Optional
 	ns.SetAvailableOperations(operationList)
 
+	if recipeControllerConfig.HealthRegistry != nil {
+		recipeControllerConfig.HealthRegistry.Register(ds_ctrl.RedisCachesResourceType, processors.NewHealthEvaluator[*datamodel.RedisCache](&rds_proc.Processor{}, recipeControllerConfig.ResourceClient))
+		recipeControllerConfig.HealthRegistry.Register(ds_ctrl.MongoDatabasesResourceType, processors.NewHealthEvaluator[*datamodel.MongoDatabase](&mongo_proc.Processor{}, recipeControllerConfig.ResourceClient))
+		recipeControllerConfig.HealthRegistry.Register(ds_ctrl.SqlDatabasesResourceType, processors.NewHealthEvaluator[*datamodel.SqlDatabase](&sql_proc.Processor{}, recipeControllerConfig.ResourceClient))
+	}
+
 	return ns
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/rp/health"
 )
 
 var handlerTests = []rpctest.HandlerTestSpec{
@@ -143,3 +144,11 @@ func TestRouter(t *testing.T) {
 		return r, nsBuilder.ApplyAPIHandlers(ctx, r, options, validator)
 	})
 }
+
+func TestHealthEvaluators(t *testing.T) {
+	cfg := &controllerconfig.RecipeControllerConfig{HealthRegistry: health.NewRegistry()}
+	_ = SetupNamespace(cfg)
+
+	expected := []string{ds_ctrl.MongoDatabasesResourceType, ds_ctrl.RedisCachesResourceType, ds_ctrl.SqlDatabasesResourceType}
+	require.Equal(t, expected, cfg.HealthRegistry.ResourceTypes())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	oai_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+)
+
+// Handler implements a custom action for a user-defined resource type.
+type Handler interface {
+	// Invoke runs the action on the resource. The request is the decoded body of the POST request and has already
+	// been validated against the request schema of the action. The result is validated against the response schema
+	// before it is returned to the caller.
+	//
+	// Returning a *v1.ErrClientRP reports a bad request to the caller, any other error is reported as an internal error.
+	Invoke(ctx context.Context, resource *datamodel.DynamicResource, request map[string]any) (map[string]any, error)
+}
+
+// HandlerFunc is an adapter to allow the use of ordinary functions as Handlers.
+type HandlerFunc func(ctx context.Context, resource *datamodel.DynamicResource, request map[string]any) (map[string]any, error)
+
+// Invoke calls f(ctx, resource, request).
+func (f HandlerFunc) Invoke(ctx context.Context, resource *datamodel.DynamicResource, request map[string]any) (map[string]any, error) {
+	return f(ctx, resource, request)
+}
+
+// Action describes a custom action of a user-defined resource type.
+type Action struct {
+	// Name is the name of the action, eg: "listCredentials". Action names are matched case-insensitively.
+	Name string
+
+	// RequestSchema is the OpenAPI schema of the request body. If nil, the request body is not validated.
+	RequestSchema *spec.Schema
+
+	// ResponseSchema is the OpenAPI schema of the response body. If nil, the response body is not validated.
+	ResponseSchema *spec.Schema
+
+	// Handler implements the action.
+	Handler Handler
+}
+
+// ValidateRequest validates the request body against the request schema of the action.
+func (a *Action) ValidateRequest(request map[string]any) error {
+	if err := validateSchema(a.RequestSchema, request); err != nil {
+		return fmt.Errorf("request for action %q is invalid: %w", a.Name, err)
+	}
+
+	return nil
+}
+
+// ValidateResponse validates the response body against the response schema of the action.
+func (a *Action) ValidateResponse(response map[string]any) error {
+	if err := validateSchema(a.ResponseSchema, response); err != nil {
+		return fmt.Errorf("response for action %q is invalid: %w", a.Name, err)
+	}
+
+	return nil
+}
+
+func validateSchema(schema *spec.Schema, value map[string]any) error {
+	if schema == nil {
+		return nil
+	}
+
+	err := validate.AgainstSchema(schema, value, strfmt.Default)
+
+	// Report each validation failure rather than the multi-line summary of the composite error.
+	var composite *oai_errors.CompositeError
+	if errors.As(err, &composite) {
+		messages := []string{}
+		for _, err := range composite.Errors {
+			messages = append(messages, err.Error())
+		}
+		return errors.New(strings.Join(messages, ", "))
+	}
+
+	return err
+}
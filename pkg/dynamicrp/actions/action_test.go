@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+)
+
+func objectSchema(required ...string) *spec.Schema {
+	schema := spec.MapProperty(nil)
+	schema.Properties = map[string]spec.Schema{}
+	for _, name := range required {
+		schema.Properties[name] = *spec.StringProperty()
+	}
+	schema.Required = required
+	schema.AdditionalProperties = &spec.SchemaOrBool{Allows: false}
+	return schema
+}
+
+func Test_Action_ValidateRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  *spec.Schema
+		request map[string]any
+		err     string
+	}{
+		{
+			name:    "no schema",
+			request: map[string]any{"anything": 1},
+		},
+		{
+			name:    "valid",
+			schema:  objectSchema("username"),
+			request: map[string]any{"username": "admin"},
+		},
+		{
+			name:    "missing required property",
+			schema:  objectSchema("username"),
+			request: map[string]any{},
+			err:     `request for action "test" is invalid: .username in body is required`,
+		},
+		{
+			name:    "wrong type",
+			schema:  objectSchema("username"),
+			request: map[string]any{"username": 3},
+			err:     `request for action "test" is invalid: username in body must be of type string: "integer"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := Action{Name: "test", RequestSchema: tt.schema}
+			err := action.ValidateRequest(tt.request)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func Test_Action_ValidateResponse(t *testing.T) {
+	action := Action{Name: "test", ResponseSchema: objectSchema("password")}
+
+	require.NoError(t, action.ValidateResponse(map[string]any{"password": "secret"}))
+	require.ErrorContains(t, action.ValidateResponse(map[string]any{"password": "secret", "extra": "value"}), `response for action "test" is invalid`)
+}
+
+func Test_HandlerFunc(t *testing.T) {
+	handler := HandlerFunc(func(ctx context.Context, resource *datamodel.DynamicResource, request map[string]any) (map[string]any, error) {
+		return map[string]any{"name": resource.Name, "request": request}, nil
+	})
+
+	resource := &datamodel.DynamicResource{}
+	resource.Name = "my-resource"
+
+	response, err := handler.Invoke(context.Background(), resource, map[string]any{"force": true})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "my-resource", "request": map[string]any{"force": true}}, response)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"strings"
+	"sync"
+)
+
+// Registry holds the custom actions registered for each user-defined resource type.
+type Registry struct {
+	mu      sync.RWMutex
+	actions map[string]map[string]Action
+}
+
+// NewRegistry creates a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		actions: map[string]map[string]Action{},
+	}
+}
+
+// Register adds actions for the given fully-qualified resource type, eg: "Applications.Test/exampleResources".
+// Resource types and action names are matched case-insensitively. Registering an action with the same name as an
+// existing action replaces it.
+func (r *Registry) Register(resourceType string, actions ...Action) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := strings.ToLower(resourceType)
+	if _, ok := r.actions[key]; !ok {
+		r.actions[key] = map[string]Action{}
+	}
+
+	for _, action := range actions {
+		r.actions[key][strings.ToLower(action.Name)] = action
+	}
+}
+
+// Action returns the action registered for the given resource type and action name.
+func (r *Registry) Action(resourceType string, name string) (Action, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	action, ok := r.actions[strings.ToLower(resourceType)][strings.ToLower(name)]
+	return action, ok
+}
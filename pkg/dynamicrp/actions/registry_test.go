@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Registry(t *testing.T) {
+	registry := NewRegistry()
+
+	_, ok := registry.Action("Applications.Test/exampleResources", "listCredentials")
+	require.False(t, ok)
+
+	registry.Register("Applications.Test/exampleResources", Action{Name: "listCredentials"}, Action{Name: "restart"})
+	registry.Register("applications.test/exampleresources", Action{Name: "RESTART", RequestSchema: objectSchema("force")})
+
+	action, ok := registry.Action("APPLICATIONS.TEST/EXAMPLERESOURCES", "listcredentials")
+	require.True(t, ok)
+	require.Equal(t, "listCredentials", action.Name)
+
+	action, ok = registry.Action("Applications.Test/exampleResources", "restart")
+	require.True(t, ok)
+	require.Equal(t, "RESTART", action.Name)
+	require.NotNil(t, action.RequestSchema)
+
+	_, ok = registry.Action("Applications.Test/otherResources", "restart")
+	require.False(t, ok)
+}
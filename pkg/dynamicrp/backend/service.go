@@ -33,15 +33,25 @@ type Service struct {
 	recipes engine.Engine
 }
 
-// NewService creates a new service to run the dynamic-rp backend.
+// NewService creates a new service to run the dynamic-rp backend. The worker settings are updated when the
+// configuration file changes if the configuration file is watched.
 func NewService(options *dynamicrp.Options) *Service {
-	return &Service{
+	w := &Service{
 		options: options,
 		Service: worker.Service{
 			// Will be initialized later
 		},
 		recipes: nil, // Will be initialized later
 	}
+
+	if options.ConfigWatcher != nil {
+		options.ConfigWatcher.OnChange(func(ctx context.Context, config *dynamicrp.Config) error {
+			w.UpdateOptions(worker.OptionsFromConfig(&config.Worker))
+			return nil
+		})
+	}
+
+	return w
 }
 
 // Name returns the name of the service used for logging.
@@ -51,12 +61,7 @@ func (w *Service) Name() string {
 
 // Run runs the service.
 func (w *Service) Run(ctx context.Context) error {
-	if w.options.Config.Worker.MaxOperationConcurrency != nil {
-		w.Service.Options.MaxOperationConcurrency = *w.options.Config.Worker.MaxOperationConcurrency
-	}
-	if w.options.Config.Worker.MaxOperationRetryCount != nil {
-		w.Service.Options.MaxOperationRetryCount = *w.options.Config.Worker.MaxOperationRetryCount
-	}
+	w.Service.UpdateOptions(worker.OptionsFromConfig(&w.options.Config.Worker))
 
 	e, err := w.options.RecipeEngine()
 	if err != nil {
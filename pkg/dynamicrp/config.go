@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
@@ -45,6 +46,12 @@ type Config struct {
 	// Environment is the configuration for the hosting environment.
 	Environment hostoptions.EnvironmentOptions `yaml:"environment"`
 
+	// ExternalRecipeDrivers configures the external recipe drivers, keyed by the name referenced by recipes.
+	ExternalRecipeDrivers map[string]hostoptions.ExternalRecipeDriverOptions `yaml:"externalRecipeDrivers"`
+
+	// HealthChecks is the configuration for the periodic evaluation of resource health.
+	HealthChecks hostoptions.HealthCheckOptions `yaml:"healthChecks"`
+
 	// Kubernetes is the configuration for the Kubernetes client.
 	Kubernetes kubernetesclientprovider.Options `yaml:"kubernetes"`
 
@@ -57,6 +64,9 @@ type Config struct {
 	// Profiler is the configuration for the profiler endpoint.
 	Profiler profilerservice.Options `yaml:"profilerProvider"`
 
+	// Proxy is the configuration of the proxy used for outbound requests.
+	Proxy httpclient.ProxyOptions `yaml:"proxy"`
+
 	// Queue is the configuration for the message queue.
 	Queue queueprovider.QueueProviderOptions `yaml:"queueProvider"`
 
@@ -79,13 +89,19 @@ type Config struct {
 	Worker hostoptions.WorkerServerOptions `yaml:"workerServer"`
 }
 
-// LoadConfig loads a Config from bytes.
+// LoadConfig loads a Config from bytes. References to environment variables and files in the values are resolved by
+// hostoptions.ResolveConfig.
 func LoadConfig(bs []byte) (*Config, error) {
+	bs, err := hostoptions.ResolveConfig(bs)
+	if err != nil {
+		return nil, err
+	}
+
 	decoder := yaml.NewDecoder(bytes.NewBuffer(bs))
 	decoder.KnownFields(true)
 
 	config := Config{}
-	err := decoder.Decode(&config)
+	err = decoder.Decode(&config)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/actions"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+)
+
+var _ controller.Controller = (*ActionController)(nil)
+
+// ActionController is the controller implementation for custom actions on dynamic resources.
+//
+// The action is dispatched to the handler registered for the resource type and action name. The request and
+// response bodies are validated against the schemas of the action.
+type ActionController struct {
+	controller.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+
+	registry *actions.Registry
+}
+
+// NewActionController creates a new ActionController.
+func NewActionController(opts controller.Options, registry *actions.Registry) (controller.Controller, error) {
+	return &ActionController{
+		Operation: controller.NewOperation(opts, dynamicResourceOptions),
+		registry:  registry,
+	}, nil
+}
+
+// Run invokes the custom action on the resource.
+func (c *ActionController) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for actions has name of the action as suffix, the resource id of the request context has
+	// already been truncated to the resource.
+	// route id format: /planes/radius/local/resourceGroups/<resource_group>/providers/<namespace>/<type>/<resource_name>/<action_name>
+	actionName := path.Base(req.URL.Path)
+	resourceID := serviceCtx.ResourceID
+
+	action, ok := c.registry.Action(resourceID.Type(), actionName)
+	if !ok {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("The action %q is not supported by resource type %q.", actionName, resourceID.Type())), nil
+	}
+
+	resource, _, err := c.GetResource(ctx, resourceID)
+	if errors.Is(&database.ErrNotFound{ID: resourceID.String()}, err) {
+		return rest.NewNotFoundResponse(resourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(resourceID), nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	// Actions may be invoked without a body, eg: listCredentials.
+	request := map[string]any{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &request); err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("The request body for action %q must be a JSON object.", actionName)), nil
+		}
+	}
+
+	if err := action.ValidateRequest(request); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeHTTPRequestPayloadAPISpecValidationFailed,
+				Message: err.Error(),
+			},
+		}), nil
+	}
+
+	response, err := action.Handler.Invoke(ctx, resource, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// An invalid response is a bug in the handler rather than the caller, so report it as an internal error.
+	if err := action.ValidateResponse(response); err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(response), nil
+}
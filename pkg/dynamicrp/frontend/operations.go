@@ -27,6 +27,9 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
+// customActionPrefix is the prefix of the operation method for custom actions, eg: ACTIONLISTCREDENTIALS.
+const customActionPrefix = "ACTION"
+
 // dynamicOperationHandler returns an http.Handler that can instantiate and run a controller.Controller for a dynamic resource.
 //
 // Usually when we register a route, we know up-front the resource type that will be handled by the controller.
@@ -58,11 +61,28 @@ func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.O
 			return
 		}
 
-		operationType := v1.OperationType{Type: strings.ToUpper(id.Type()), Method: method}
+		resourceType := id.Type()
+		operationMethod := method
+
+		// Custom actions are routed as POST requests on the resource with the action name as the last segment of the
+		// URL. The action name is part of the operation type, and not the resource type.
+		//
+		// For example:
+		//
+		// URL: /planes/radius/myplane/resourceGroups/my-rg/providers/Applications.Example/customService/my-service/listCredentials
+		// Resource Type: Applications.Example/customService
+		// Operation Type: APPLICATIONS.EXAMPLE/CUSTOMSERVICE|ACTIONLISTCREDENTIALS
+		if method == v1.OperationPost {
+			segments := id.TypeSegments()
+			resourceType = id.Truncate().Type()
+			operationMethod = v1.OperationMethod(customActionPrefix + strings.ToUpper(segments[len(segments)-1].Type))
+		}
+
+		operationType := v1.OperationType{Type: strings.ToUpper(resourceType), Method: operationMethod}
 
 		// Copy the options and initalize them dynamically for this type.
 		opts := baseOptions
-		opts.ResourceType = id.Type()
+		opts.ResourceType = resourceType
 
 		// Special case the operation status and operation result types.
 		//
@@ -68,6 +68,9 @@ func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Option
 			r.Get("/{resourceName}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetResourceController))
 			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions, makePutResourceController))
 			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions, makeDeleteResourceController))
+
+			// Custom actions, eg: POST .../{resourceName}/listCredentials
+			r.Post("/{resourceName}/{actionName}", dynamicOperationHandler(v1.OperationPost, controllerOptions, s.makeActionController))
 		})
 	})
 
@@ -104,6 +107,10 @@ func makeDeleteResourceController(opts controller.Options) (controller.Controlle
 	return defaultoperation.NewDefaultAsyncDelete(opts, dynamicResourceOptions)
 }
 
+func (s *Service) makeActionController(opts controller.Options) (controller.Controller, error) {
+	return NewActionController(opts, s.options.ActionRegistry)
+}
+
 func makeGetOperationResultController(opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationResult(opts)
 }
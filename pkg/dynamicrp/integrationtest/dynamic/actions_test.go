@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/spec"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/dynamicrp/actions"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/testhost"
+)
+
+// This test covers the custom actions of a dynamic resource.
+func Test_Dynamic_Resource_Actions(t *testing.T) {
+	requestSchema := spec.MapProperty(nil)
+	requestSchema.Properties = map[string]spec.Schema{"username": *spec.StringProperty()}
+	requestSchema.Required = []string{"username"}
+
+	listCredentials := actions.Action{
+		Name:          "listCredentials",
+		RequestSchema: requestSchema,
+		Handler: actions.HandlerFunc(func(ctx context.Context, resource *datamodel.DynamicResource, request map[string]any) (map[string]any, error) {
+			return map[string]any{
+				"username": request["username"],
+				"password": resource.Properties["foo"],
+			}, nil
+		}),
+	}
+
+	_, ucp := testhost.Start(t, testhost.TestHostOptionFunc(func(options *dynamicrp.Options) {
+		options.ActionRegistry.Register(resourceProviderNamespace+"/"+resourceTypeName, listCredentials)
+	}))
+
+	// Setup a resource provider (Applications.Test/exampleResources)
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createResourceType(ucp)
+	createAPIVersion(ucp)
+	createLocation(ucp)
+
+	// Setup a resource group where we can interact with the new resource type.
+	createResourceGroup(ucp)
+
+	listCredentialsURL := exampleResourceID + "/listCredentials?api-version=" + apiVersion
+
+	// The resource doesn't exist yet, so we should get a 404.
+	response := ucp.MakeTypedRequest(http.MethodPost, listCredentialsURL, map[string]any{"username": "admin"})
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+
+	resource := map[string]any{
+		"properties": map[string]any{
+			"foo": "bar",
+		},
+	}
+
+	response = ucp.MakeTypedRequest(http.MethodPut, exampleResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	// Invoke the action.
+	response = ucp.MakeTypedRequest(http.MethodPost, listCredentialsURL, map[string]any{"username": "admin"})
+	response.EqualsValue(http.StatusOK, map[string]any{"username": "admin", "password": "bar"})
+
+	// The request body is validated against the schema of the action.
+	response = ucp.MakeTypedRequest(http.MethodPost, listCredentialsURL, map[string]any{})
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeHTTPRequestPayloadAPISpecValidationFailed)
+
+	// Unknown actions are not found.
+	response = ucp.MakeTypedRequest(http.MethodPost, exampleResourceID+"/restart?api-version="+apiVersion, map[string]any{})
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+}
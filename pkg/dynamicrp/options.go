@@ -23,17 +23,20 @@ import (
 	"strconv"
 
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/dynamicrp/actions"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/rp/health"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
@@ -45,12 +48,22 @@ import (
 // For testability, all fields on this struct MUST be constructed from the NewOptions function without any
 // additional initialization required.
 type Options struct {
+	// ActionRegistry holds the custom actions registered by the handlers of user-defined resource types.
+	ActionRegistry *actions.Registry
+
 	// Config is the configuration for the server.
 	Config *Config
 
+	// ConfigWatcher watches the configuration file for changes to the settings which can be changed at runtime.
+	// This will default to nil, implying the configuration file is not watched, and is set by the server command.
+	ConfigWatcher *hostoptions.ConfigWatcher[Config]
+
 	// DatabaseProvider provides access to the database.
 	DatabaseProvider *databaseprovider.DatabaseProvider
 
+	// HealthRegistry holds the health evaluators registered by the handlers of user-defined resource types.
+	HealthRegistry *health.Registry
+
 	// KubernetesProvider provides access to the Kubernetes clients.
 	KubernetesProvider *kubernetesclientprovider.KubernetesClientProvider
 
@@ -75,7 +88,7 @@ type RecipeOptions struct {
 	// ConfigurationLoader is the loader for recipe configurations.
 	ConfigurationLoader configloader.ConfigurationLoader
 
-	// Drivers is a map of recipe driver names to driver constructors. If nil, the default drivers are used (Bicep, Terraform) will
+	// Drivers is a map of recipe driver names to driver constructors. If nil, the default drivers (Bicep, Terraform, External) will
 	// be used.
 	Drivers map[string]func(options *Options) (driver.Driver, error)
 
@@ -93,6 +106,8 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 	options.QueueProvider = queueprovider.New(config.Queue)
 	options.SecretProvider = secretprovider.NewSecretProvider(config.Secrets)
 	options.DatabaseProvider = databaseprovider.FromOptions(config.Database)
+	options.HealthRegistry = health.NewRegistry()
+	options.ActionRegistry = actions.NewRegistry()
 
 	databaseClient, err := options.DatabaseProvider.GetClient(ctx)
 	if err != nil {
@@ -137,6 +152,7 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 		o.Recipes.Drivers = map[string]func(options *Options) (driver.Driver, error){
 			recipes.TemplateKindBicep:     bicepDriver,
 			recipes.TemplateKindTerraform: terraformDriver,
+			recipes.TemplateKindExternal:  externalDriver,
 		}
 	}
 
@@ -207,6 +223,22 @@ func terraformDriver(options *Options) (driver.Driver, error) {
 		options.UCP,
 		options.SecretProvider,
 		driver.TerraformOptions{
-			Path: options.Config.Terraform.Path,
+			Path:      options.Config.Terraform.Path,
+			Binary:    options.Config.Terraform.Binary,
+			Version:   options.Config.Terraform.Version,
+			SourceURL: options.Config.Terraform.SourceURL,
 		}, *options.KubernetesProvider), nil
 }
+
+func externalDriver(options *Options) (driver.Driver, error) {
+	drivers := map[string]driver.ExternalOptions{}
+	for name, external := range options.Config.ExternalRecipeDrivers {
+		drivers[name] = driver.ExternalOptions{
+			URL:       external.URL,
+			TokenFile: external.TokenFile,
+			Timeout:   external.Timeout,
+		}
+	}
+
+	return driver.NewExternalDriver(drivers)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/radius-project/radius/pkg/dynamicrp"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend"
 	"github.com/radius-project/radius/pkg/dynamicrp/frontend"
+	"github.com/radius-project/radius/pkg/rp/health"
 )
 
 // NewServer initializes a host for UCP based on the provided options.
@@ -48,6 +49,19 @@ func NewServer(options *dynamicrp.Options) (*hosting.Host, error) {
 	services = append(services, frontend.NewService(options))
 	services = append(services, backend.NewService(options))
 
+	// Health evaluators registered for user-defined resource types are run periodically via a service.
+	if options.Config.HealthChecks.Enabled {
+		services = append(services, health.NewService(options.DatabaseProvider, options.HealthRegistry, health.Options{
+			Interval: options.Config.HealthChecks.Interval,
+			Timeout:  options.Config.HealthChecks.Timeout,
+		}))
+	}
+
+	// Settings which can be changed at runtime are reloaded when the configuration file changes.
+	if options.ConfigWatcher != nil {
+		services = append(services, options.ConfigWatcher)
+	}
+
 	return &hosting.Host{
 		Services: services,
 	}, nil
@@ -40,10 +40,33 @@ const (
 	ControlPlanePartOfLabelValue = "radius"
 
 	AnnotationSecretHash = "radapp.io/secret-hash"
-	RadiusDevPrefix      = "radapp.io/"
+
+	// AnnotationRenderedHash is the annotation of an output resource which holds the hash of its rendered object. The
+	// object is not applied again until the rendered object changes or the live object drifts from it.
+	AnnotationRenderedHash = "radapp.io/rendered-hash"
+
+	// AnnotationRestartedAt is the annotation of a pod template which holds the time Radius last restarted the pods of a
+	// workload, for example after rotating the secrets the pods use. Changing it triggers a rolling restart.
+	AnnotationRestartedAt = "radapp.io/restarted-at"
+
+	RadiusDevPrefix = "radapp.io/"
+
+	// AnnotationDaprSecretReferences is the annotation of a pod which holds the references to the secrets of Dapr
+	// secret stores, keyed by the name of the environment variable which holds the URL of the secret.
+	AnnotationDaprSecretReferences = "radapp.io/dapr-secret-references"
 
 	// AnnotationIdentityType is the annotation for supported identity.
 	AnnotationIdentityType = "radapp.io/identity-type"
+
+	// DefaultCostAttributionLabelPrefix is the default prefix of the cost attribution label keys.
+	DefaultCostAttributionLabelPrefix = "cost.radapp.io/"
+
+	// CostAttributionApplication is the cost attribution key for the application name.
+	CostAttributionApplication = "application"
+	// CostAttributionEnvironment is the cost attribution key for the environment name.
+	CostAttributionEnvironment = "environment"
+	// CostAttributionResource is the cost attribution key for the resource name.
+	CostAttributionResource = "resource"
 )
 
 // NOTE: the difference between descriptive labels and selector labels
@@ -92,6 +115,38 @@ func MakeDescriptiveDaprLabels(application string, resource string, resourceType
 	}
 }
 
+// MakeCostAttributionLabels returns the labels used by cost tools, such as OpenCost, to attribute the spend of a
+// Kubernetes object to a Radius application, environment and resource. The label keys are the given prefix (or
+// DefaultCostAttributionLabelPrefix if it is empty) followed by the attribution key. Additional tags, such as an
+// owner or cost center, are added with the same prefix and cannot override the application, environment and
+// resource labels. Empty names are omitted.
+func MakeCostAttributionLabels(prefix string, application string, environment string, resource string, tags map[string]string) map[string]string {
+	if prefix == "" {
+		prefix = DefaultCostAttributionLabelPrefix
+	}
+
+	labels := map[string]string{}
+	for key, value := range tags {
+		labels[prefix+key] = value
+	}
+
+	names := map[string]string{
+		CostAttributionApplication: application,
+		CostAttributionEnvironment: environment,
+		CostAttributionResource:    resource,
+	}
+	for key, name := range names {
+		if name == "" {
+			delete(labels, prefix+key)
+			continue
+		}
+
+		labels[prefix+key] = NormalizeResourceName(name)
+	}
+
+	return labels
+}
+
 // MakeSelectorLabels returns a map of labels suitable for a Kubernetes selector to identify a labeled Radius-managed
 // Kubernetes object.
 //
@@ -111,10 +166,13 @@ func MakeSelectorLabels(application string, resource string) map[string]string {
 
 // NormalizeResourceName normalizes resource name used for kubernetes resource name scoped in namespace.
 // All name will be validated by swagger validation so that it does not get non-RFC1035 compliant characters.
-// Therefore, this function will lowercase the name without allowed character validation.
+// Therefore, this function will lowercase the name without allowed character validation. Names that are too long
+// are truncated with a hash suffix using DefaultNamingStrategy. Such names used to be rejected, so the names of
+// existing objects do not change. The limit is the length of an RFC 1123 label regardless of the configured naming
+// strategy, since the names are also used as label values and service names.
 // https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#rfc-1035-label-names
 func NormalizeResourceName(name string) string {
-	normalized := strings.ToLower(name)
+	normalized := DefaultNamingStrategy.MakeName(name)
 	if normalized == "" {
 		return normalized
 	}
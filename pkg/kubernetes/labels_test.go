@@ -97,6 +97,10 @@ func TestNormalizeResoureName(t *testing.T) {
 			in:  "",
 			out: "",
 		},
+		{
+			in:  "this-is-a-very-long-resource-name-that-is-longer-than-the-maximum-length",
+			out: "this-is-a-very-long-resource-name-that-is-longer-than-ba2cfc9a",
+		},
 	}
 
 	for _, tt := range nameTests {
@@ -149,3 +153,57 @@ func TestNormalizeResourceNameDapr(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeCostAttributionLabels(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		application string
+		environment string
+		resource    string
+		tags        map[string]string
+		want        map[string]string
+	}{
+		{
+			name:        "default prefix",
+			application: "MyApp",
+			environment: "prod",
+			resource:    "frontend",
+			want: map[string]string{
+				"cost.radapp.io/application": "myapp",
+				"cost.radapp.io/environment": "prod",
+				"cost.radapp.io/resource":    "frontend",
+			},
+		},
+		{
+			name:        "custom prefix with tags",
+			prefix:      "billing.example.com/",
+			application: "myapp",
+			environment: "prod",
+			resource:    "frontend",
+			tags:        map[string]string{"owner": "team-a", "application": "spoofed"},
+			want: map[string]string{
+				"billing.example.com/application": "myapp",
+				"billing.example.com/environment": "prod",
+				"billing.example.com/resource":    "frontend",
+				"billing.example.com/owner":       "team-a",
+			},
+		},
+		{
+			name:        "environment-scoped resource",
+			environment: "prod",
+			resource:    "cache",
+			tags:        map[string]string{"application": "spoofed"},
+			want: map[string]string{
+				"cost.radapp.io/environment": "prod",
+				"cost.radapp.io/resource":    "cache",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeCostAttributionLabels(tt.prefix, tt.application, tt.environment, tt.resource, tt.tags)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// NameSeparator is the separator used to join the parts of a generated name.
+	NameSeparator = "-"
+
+	// DefaultNameHashLength is the default length of the hash suffix appended to truncated names.
+	DefaultNameHashLength = 8
+)
+
+// DefaultNamingStrategy is the naming strategy used for Kubernetes namespaces and object names when no maximum length
+// is configured. Names are limited to the length of an RFC 1123 label.
+var DefaultNamingStrategy = NamingStrategy{
+	MaxLength:  validation.DNS1123LabelMaxLength,
+	HashLength: DefaultNameHashLength,
+}
+
+// NamingStrategy generates Kubernetes object names from multiple parts, such as an environment namespace and an
+// application name. Names that exceed MaxLength are truncated and suffixed with a hash of the full name so that
+// distinct inputs still produce distinct names.
+type NamingStrategy struct {
+	// MaxLength is the maximum length of a generated name.
+	MaxLength int
+
+	// HashLength is the length of the hash suffix appended to truncated names.
+	HashLength int
+}
+
+// NewNamingStrategy creates the naming strategy limiting names to maxLength characters, for example from the
+// configuration of a resource provider. DefaultNamingStrategy is returned if maxLength is zero. Since the strategy is
+// used for namespaces, maxLength cannot exceed the length of an RFC 1123 label.
+func NewNamingStrategy(maxLength int) (NamingStrategy, error) {
+	if maxLength == 0 {
+		return DefaultNamingStrategy, nil
+	}
+
+	if maxLength < 0 || maxLength > validation.DNS1123LabelMaxLength {
+		return NamingStrategy{}, fmt.Errorf("maximum name length must be between 1 and %d, got %d", validation.DNS1123LabelMaxLength, maxLength)
+	}
+
+	return NamingStrategy{MaxLength: maxLength, HashLength: DefaultNameHashLength}, nil
+}
+
+// MakeName joins the non-empty parts with NameSeparator and lowercases the result. If the name is longer than
+// MaxLength, it is truncated and suffixed with a hash of the full name. The same parts always produce the same name.
+//
+// MakeName does not validate the characters of the name. Callers should use IsValidObjectName when the parts are not
+// already validated.
+func (s NamingStrategy) MakeName(parts ...string) string {
+	nonEmpty := []string{}
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	name := strings.ToLower(strings.Join(nonEmpty, NameSeparator))
+	if s.MaxLength <= 0 || len(name) <= s.MaxLength {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	hash := hex.EncodeToString(sum[:])
+	hashLength := min(s.HashLength, len(hash), s.MaxLength)
+	if hashLength <= 0 {
+		return name[:s.MaxLength]
+	}
+	hash = hash[:hashLength]
+
+	prefixLength := s.MaxLength - hashLength - len(NameSeparator)
+	if prefixLength <= 0 {
+		return hash
+	}
+
+	// Trim trailing separators so the name does not contain a doubled separator or end with an invalid character.
+	prefix := strings.TrimRight(name[:prefixLength], NameSeparator+".")
+	if prefix == "" {
+		return hash
+	}
+
+	return prefix + NameSeparator + hash
+}
+
+// NameCollisionError is returned by NameRegistry when the same name is generated for different sources.
+type NameCollisionError struct {
+	// Name is the generated name.
+	Name string
+
+	// Source is the source that the name was registered for.
+	Source string
+
+	// Existing is the source that previously registered the name.
+	Existing string
+}
+
+// Error returns a string describing the name collision.
+func (e *NameCollisionError) Error() string {
+	return fmt.Sprintf("name %q generated for %q collides with the name generated for %q", e.Name, e.Source, e.Existing)
+}
+
+// NameRegistry detects collisions between names generated for different sources, for example when two long names
+// are truncated to the same value.
+type NameRegistry struct {
+	names map[string]string
+}
+
+// NewNameRegistry creates a new empty NameRegistry.
+func NewNameRegistry() *NameRegistry {
+	return &NameRegistry{names: map[string]string{}}
+}
+
+// Register records that name was generated for source. It returns a *NameCollisionError if the name was already
+// registered for a different source. Registering the same name for the same source is allowed.
+func (r *NameRegistry) Register(name string, source string) error {
+	if existing, ok := r.names[name]; ok && existing != source {
+		return &NameCollisionError{Name: name, Source: source, Existing: existing}
+	}
+
+	r.names[name] = source
+	return nil
+}
+
+// NamespaceError is returned by ValidateNamespace when a namespace is not a valid Kubernetes namespace name, for
+// example when a namespace derived from the names of Radius resources is too long.
+type NamespaceError struct {
+	// Namespace is the invalid namespace.
+	Namespace string
+
+	// Reasons describes why the namespace is invalid.
+	Reasons []string
+
+	// Suggestion is a valid namespace derived from Namespace. Empty if no valid namespace can be derived.
+	Suggestion string
+}
+
+// Error returns a string describing the invalid namespace and the suggested namespace.
+func (e *NamespaceError) Error() string {
+	message := fmt.Sprintf("namespace %q (%d characters) is not a valid Kubernetes namespace: %s", e.Namespace, len(e.Namespace), strings.Join(e.Reasons, "; "))
+	if e.Suggestion != "" {
+		message += fmt.Sprintf(", consider using %q", e.Suggestion)
+	}
+
+	return message
+}
+
+// ValidateNamespace returns a *NamespaceError if the namespace is not a valid RFC 1123 label, which is the format of
+// Kubernetes namespace names.
+func ValidateNamespace(namespace string) error {
+	reasons := validation.IsDNS1123Label(namespace)
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	return &NamespaceError{
+		Namespace:  namespace,
+		Reasons:    reasons,
+		Suggestion: SuggestNamespace(namespace),
+	}
+}
+
+// SuggestNamespace returns a valid namespace derived from the given name by lowercasing it, replacing invalid
+// characters with NameSeparator and truncating it to the maximum length of a namespace. Unlike MakeName, no hash is
+// appended, so the suggestion stays readable. It returns an empty string if no valid namespace can be derived.
+func SuggestNamespace(name string) string {
+	b := strings.Builder{}
+	for _, c := range strings.ToLower(name) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		} else {
+			b.WriteString(NameSeparator)
+		}
+	}
+
+	suggestion := strings.Trim(b.String(), NameSeparator)
+	if len(suggestion) > validation.DNS1123LabelMaxLength {
+		suggestion = strings.TrimRight(suggestion[:validation.DNS1123LabelMaxLength], NameSeparator)
+	}
+
+	if len(validation.IsDNS1123Label(suggestion)) > 0 {
+		return ""
+	}
+
+	return suggestion
+}
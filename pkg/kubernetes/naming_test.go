@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamingStrategy_MakeName(t *testing.T) {
+	longEnv := "this-is-a-very-long-environment-name-that-is-invalid"
+	longApp := "this-is-a-very-long-application-name-that-is-invalid"
+
+	t.Run("short name is joined and lowercased", func(t *testing.T) {
+		require.Equal(t, "default-app0", DefaultNamingStrategy.MakeName("default", "App0"))
+	})
+
+	t.Run("empty parts are skipped", func(t *testing.T) {
+		require.Equal(t, "app0", DefaultNamingStrategy.MakeName("", "app0"))
+	})
+
+	t.Run("long name is truncated with hash", func(t *testing.T) {
+		name := DefaultNamingStrategy.MakeName(longEnv, longApp)
+		require.Len(t, name, 63)
+		require.True(t, IsValidObjectName(name))
+		require.True(t, strings.HasPrefix(name, "this-is-a-very-long-environment-name-that-is-invalid-t-"))
+
+		// The name is stable.
+		require.Equal(t, name, DefaultNamingStrategy.MakeName(longEnv, longApp))
+
+		// Names with the same prefix are different.
+		require.NotEqual(t, name, DefaultNamingStrategy.MakeName(longEnv, longApp+"2"))
+	})
+
+	t.Run("trailing separator is trimmed", func(t *testing.T) {
+		s := NamingStrategy{MaxLength: 12, HashLength: 4}
+		name := s.MakeName("abcdefg", "hijklmnop")
+		require.Len(t, name, 12)
+		require.True(t, strings.HasPrefix(name, "abcdefg-"))
+
+		name = s.MakeName("abcdef", "hijklmnop")
+		require.True(t, strings.HasPrefix(name, "abcdef-"))
+		require.NotContains(t, name, "--")
+	})
+
+	t.Run("configurable max length", func(t *testing.T) {
+		s := NamingStrategy{MaxLength: 20, HashLength: 6}
+		name := s.MakeName(longEnv, longApp)
+		require.Len(t, name, 20)
+		require.True(t, IsValidObjectName(name))
+	})
+
+	t.Run("max length shorter than hash", func(t *testing.T) {
+		s := NamingStrategy{MaxLength: 4, HashLength: 8}
+		require.Len(t, s.MakeName(longEnv), 4)
+	})
+
+	t.Run("no max length", func(t *testing.T) {
+		s := NamingStrategy{}
+		require.Equal(t, longEnv+"-"+longApp, s.MakeName(longEnv, longApp))
+	})
+}
+
+func TestNewNamingStrategy(t *testing.T) {
+	s, err := NewNamingStrategy(0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultNamingStrategy, s)
+
+	s, err = NewNamingStrategy(40)
+	require.NoError(t, err)
+	require.Equal(t, NamingStrategy{MaxLength: 40, HashLength: DefaultNameHashLength}, s)
+
+	_, err = NewNamingStrategy(64)
+	require.EqualError(t, err, "maximum name length must be between 1 and 63, got 64")
+
+	_, err = NewNamingStrategy(-1)
+	require.EqualError(t, err, "maximum name length must be between 1 and 63, got -1")
+}
+
+func TestNameRegistry(t *testing.T) {
+	r := NewNameRegistry()
+	require.NoError(t, r.Register("name", "source-a"))
+	require.NoError(t, r.Register("name", "source-a"))
+	require.NoError(t, r.Register("other", "source-b"))
+
+	err := r.Register("name", "source-b")
+	require.Error(t, err)
+	require.IsType(t, &NameCollisionError{}, err)
+	require.Equal(t, "name \"name\" generated for \"source-b\" collides with the name generated for \"source-a\"", err.Error())
+}
+
+func TestValidateNamespace(t *testing.T) {
+	require.NoError(t, ValidateNamespace("default-app0"))
+
+	err := ValidateNamespace(strings.Repeat("namespace", 8))
+	namespaceErr := &NamespaceError{}
+	require.ErrorAs(t, err, &namespaceErr)
+	require.Equal(t, strings.Repeat("namespace", 7), namespaceErr.Suggestion)
+	require.Equal(t, "namespace \""+strings.Repeat("namespace", 8)+"\" (72 characters) is not a valid Kubernetes namespace: must be no more than 63 characters, consider using \""+strings.Repeat("namespace", 7)+"\"", err.Error())
+
+	err = ValidateNamespace("")
+	require.ErrorAs(t, err, &namespaceErr)
+	require.Empty(t, namespaceErr.Suggestion)
+}
+
+func TestSuggestNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "default", expected: "default"},
+		{name: "My_Environment", expected: "my-environment"},
+		{name: "-env.", expected: "env"},
+		{name: strings.Repeat("a", 62) + "-b", expected: strings.Repeat("a", 62)},
+		{name: "___", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, SuggestNamespace(tt.name))
+		})
+	}
+}
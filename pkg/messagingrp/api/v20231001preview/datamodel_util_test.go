@@ -157,9 +157,7 @@ func TestToRecipeDataModel(t *testing.T) {
 	}{
 		{
 			nil,
-			portableresources.ResourceRecipe{
-				Name: portableresources.DefaultRecipeName,
-			},
+			portableresources.ResourceRecipe{},
 		},
 		{
 			&Recipe{
@@ -182,7 +180,6 @@ func TestToRecipeDataModel(t *testing.T) {
 				},
 			},
 			portableresources.ResourceRecipe{
-				Name: portableresources.DefaultRecipeName,
 				Parameters: map[string]any{
 					"foo": "bar",
 				},
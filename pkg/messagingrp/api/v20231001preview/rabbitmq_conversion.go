@@ -65,17 +65,18 @@ func (src *RabbitMQQueueResource) ConvertTo() (v1.DataModelInterface, error) {
 	converted.Properties.Queue = to.String(properties.Queue)
 	converted.Properties.VHost = to.String(properties.VHost)
 	converted.Properties.TLS = to.Bool(properties.TLS)
-	err = converted.VerifyInputs()
-	if err != nil {
-		return nil, err
-	}
-
 	if src.Properties.Secrets != nil {
 		converted.Properties.Secrets = datamodel.RabbitMQSecrets{
 			URI:      to.String(src.Properties.Secrets.URI),
 			Password: to.String(properties.Secrets.Password),
 		}
 	}
+
+	err = converted.VerifyInputs()
+	if err != nil {
+		return nil, err
+	}
+
 	return converted, nil
 }
 
@@ -96,6 +97,7 @@ func (dst *RabbitMQQueueResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &RabbitMQQueueProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(rabbitmq.Properties.Status.OutputResources),
+			Health:          fromResourceHealth(rabbitmq.Properties.Status.Health),
 			Recipe:          fromRecipeStatus(rabbitmq.Properties.Status.Recipe),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(rabbitmq.InternalMetadata.AsyncProvisioningState),
@@ -71,7 +71,7 @@ func TestRabbitMQQueue_ConvertVersionedToDataModel(t *testing.T) {
 					Username:             "test-user",
 					TLS:                  true,
 					Secrets: datamodel.RabbitMQSecrets{
-						URI:      "connection://string",
+						URI:      "amqps://test-host:5672/test-vhost",
 						Password: "password",
 					},
 				},
@@ -235,6 +235,11 @@ func TestRabbitMQQueue_ConvertVersionedToDataModel_InvalidRequest(t *testing.T)
 			&v1.ErrClientRP{},
 			"code Bad Request: err queue is required when resourceProvisioning is manual",
 		},
+		{
+			"rabbitmq_invalidformat_resource.json",
+			&v1.ErrClientRP{},
+			"code BadRequest: err multiple errors were found:\n\thost must be a host name or IP address without a scheme, got \"amqp://myrabbitmq\"\n\tport must be between 1 and 65535, got 70000\n\tsecrets.uri must be a valid URI with the scheme amqp or amqps",
+		},
 		{
 			"rabbitmq_invalid_resourceprovisioning_resource.json",
 			&v1.ErrModelConversion{},
@@ -6,6 +6,31 @@ package v20231001preview
 
 import "time"
 
+// AzureContainerInstanceCompute - The Azure Container Instances compute configuration
+type AzureContainerInstanceCompute struct {
+// REQUIRED; Discriminator property for EnvironmentCompute.
+	Kind *string
+
+// Configuration for supported external identity providers
+	Identity *IdentitySettings
+
+// The resource ID of the Azure resource group to deploy the container groups to. Defaults to the resource group of the Azure
+// provider scope of the environment.
+	ResourceGroup *string
+
+// The resource id of the compute resource for application environment.
+	ResourceID *string
+}
+
+// GetEnvironmentCompute implements the EnvironmentComputeClassification interface for type AzureContainerInstanceCompute.
+func (a *AzureContainerInstanceCompute) GetEnvironmentCompute() *EnvironmentCompute {
+	return &EnvironmentCompute{
+		Identity: a.Identity,
+		Kind: a.Kind,
+		ResourceID: a.ResourceID,
+	}
+}
+
 // AzureResourceManagerCommonTypesTrackedResourceUpdate - The resource model definition for an Azure Resource Manager tracked
 // top level resource which has 'tags' and a 'location'
 type AzureResourceManagerCommonTypesTrackedResourceUpdate struct {
@@ -325,6 +350,18 @@ type Resource struct {
 	Type *string
 }
 
+// ResourceHealth - Health of a resource.
+type ResourceHealth struct {
+// REQUIRED; The health state of the resource.
+	State *ResourceHealthState
+
+// The time at which the health state or message last changed.
+	LastTransitionTime *time.Time
+
+// A human-readable message describing the health of the resource.
+	Message *string
+}
+
 // ResourceReference - Describes a reference to an existing resource
 type ResourceReference struct {
 // REQUIRED; Resource id of an existing resource
@@ -339,6 +376,9 @@ type ResourceStatus struct {
 // Properties of an output resource
 	OutputResources []*OutputResource
 
+// READ-ONLY; The health of the resource reported by the health checks registered for the resource type
+	Health *ResourceHealth
+
 // READ-ONLY; The recipe data at the time of deployment
 	Recipe *RecipeStatus
 }
@@ -94,7 +94,8 @@ func (r *RabbitMQQueue) Recipe() *portableresources.ResourceRecipe {
 	return &r.Properties.Recipe
 }
 
-// VerifyInputs checks if the queue is provided when resourceProvisioning is set to manual and returns an error if not.
+// VerifyInputs checks if the queue, host and port are provided and well-formed when resourceProvisioning is set to manual
+// and returns an error if not.
 func (r *RabbitMQQueue) VerifyInputs() error {
 	properties := r.Properties
 	msgs := []string{}
@@ -104,13 +105,20 @@ func (r *RabbitMQQueue) VerifyInputs() error {
 		}
 		if properties.Host == "" {
 			msgs = append(msgs, "host must be specified when resourceProvisioning is set to manual")
+		} else if msg := portableresources.ValidateHost(properties.Host); msg != "" {
+			msgs = append(msgs, "host "+msg)
 		}
 		if properties.Port == 0 {
 			msgs = append(msgs, "port must be specified when resourceProvisioning is set to manual")
+		} else if msg := portableresources.ValidatePort(properties.Port); msg != "" {
+			msgs = append(msgs, "port "+msg)
 		}
 		if properties.Username == "" && properties.Secrets.Password != "" {
 			msgs = append(msgs, "username must be provided with password")
 		}
+		if msg := portableresources.ValidateURI(properties.Secrets.URI, "amqp", "amqps"); msg != "" {
+			msgs = append(msgs, "secrets.uri "+msg)
+		}
 	}
 	if len(msgs) == 1 {
 		return &v1.ErrClientRP{
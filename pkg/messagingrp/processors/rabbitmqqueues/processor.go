@@ -41,15 +41,15 @@ func (p *Processor) Process(ctx context.Context, resource *msg_dm.RabbitMQQueue,
 	validator := processors.NewValidator(&resource.ComputedValues, &resource.SecretValues, &resource.Properties.Status.OutputResources, resource.ResourceMetadata().Status.Recipe)
 	validator.AddResourcesField(&resource.Properties.Resources)
 	validator.AddRequiredStringField(Queue, &resource.Properties.Queue)
-	validator.AddRequiredStringField(renderers.Host, &resource.Properties.Host)
+	validator.AddRequiredHostField(renderers.Host, &resource.Properties.Host)
 	validator.AddOptionalStringField(renderers.VHost, &resource.Properties.VHost)
-	validator.AddRequiredInt32Field(renderers.Port, &resource.Properties.Port)
+	validator.AddRequiredPortField(renderers.Port, &resource.Properties.Port)
 	validator.AddOptionalStringField(renderers.UsernameStringValue, &resource.Properties.Username)
 	validator.AddOptionalSecretField(renderers.PasswordStringHolder, &resource.Properties.Secrets.Password)
 	validator.AddComputedBoolField(renderers.TLS, &resource.Properties.TLS, func() (bool, *processors.ValidationError) {
 		return p.computeSSL(resource), nil
 	})
-	validator.AddComputedSecretField(renderers.URI, &resource.Properties.Secrets.URI, func() (string, *processors.ValidationError) {
+	validator.AddComputedURISecretField(renderers.URI, &resource.Properties.Secrets.URI, []string{"amqp", "amqps"}, func() (string, *processors.ValidationError) {
 		return p.computeURI(resource), nil
 	})
 
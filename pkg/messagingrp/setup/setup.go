@@ -19,6 +19,7 @@ import (
 	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/messagingrp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	"github.com/radius-project/radius/pkg/messagingrp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
@@ -42,10 +43,15 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	_ = ns.AddResource("rabbitMQQueues", &builder.ResourceOption[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue]{
 		RequestConverter:  converter.RabbitMQQueueDataModelFromVersioned,
 		ResponseConverter: converter.RabbitMQQueueDataModelToVersioned,
+		Defaulters: []apictrl.Defaulter[datamodel.RabbitMQQueue]{
+			rp_frontend.DefaultRecipeName[*datamodel.RabbitMQQueue],
+		},
 
 		Put: builder.Operation[datamodel.RabbitMQQueue]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RabbitMQQueue],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.RabbitMQQueue],
+				rp_frontend.ValidateQuota[*datamodel.RabbitMQQueue],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](options, &rmq_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -56,6 +62,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		Patch: builder.Operation[datamodel.RabbitMQQueue]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
 				rp_frontend.PrepareRadiusResource[*datamodel.RabbitMQQueue],
+				rp_frontend.ValidateDeploymentPolicy[*datamodel.RabbitMQQueue],
+				rp_frontend.ValidateQuota[*datamodel.RabbitMQQueue],
 			},
 			AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
 				return pr_ctrl.NewCreateOrUpdateResource[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue](options, &rmq_proc.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
@@ -77,6 +85,8 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 		},
 	})
 
+	ns.SetAPIVersions(v20231001preview.Version)
+
 	// Optional
 	ns.SetAvailableOperations(operationList)
 
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// unknownCaller is the caller of the requests without a user agent.
+	unknownCaller = "unknown"
+
+	// maxCallerLength is the maximum length of the caller to keep the cardinality of the metrics low.
+	maxCallerLength = 64
+)
+
+// SampledRequestLogger is the middleware to record the count and the latency of the requests to the given paths, such as
+// the discovery endpoints called constantly by the Kubernetes API Server. Logging every request to these paths would
+// flood the logs, so only one of every sampleRate requests is logged. Logging is disabled if sampleRate is not positive.
+// The requests to the other paths are passed through.
+func SampledRequestLogger(paths []string, sampleRate int) func(http.Handler) http.Handler {
+	sampled := map[string]*atomic.Int64{}
+	for _, path := range paths {
+		sampled[strings.TrimSuffix(path, "/")] = &atomic.Int64{}
+	}
+
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			endpoint := strings.TrimSuffix(r.URL.Path, "/")
+			count, ok := sampled[endpoint]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			startTime := time.Now()
+			recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+
+			caller := getCaller(r.UserAgent())
+			metrics.DefaultDiscoveryRequestMetrics.RecordRequest(r.Context(), startTime, endpoint, caller, recorder.statusCode)
+
+			n := count.Add(1)
+			if sampleRate > 0 && (n-1)%int64(sampleRate) == 0 {
+				logger := ucplog.FromContextOrDiscard(r.Context())
+				logger.Info("Served sampled request", "endpoint", endpoint, "caller", caller, "statusCode", recorder.statusCode,
+					"duration", time.Since(startTime).String(), "requestCount", n, "sampleRate", sampleRate)
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// getCaller returns the product name of the user agent, e.g. "kube-apiserver" for
+// "kube-apiserver/v1.30.0 (linux/amd64) kubernetes/7c48c2b".
+func getCaller(userAgent string) string {
+	caller, _, _ := strings.Cut(strings.TrimSpace(userAgent), " ")
+	caller, _, _ = strings.Cut(caller, "/")
+	if caller == "" {
+		return unknownCaller
+	}
+
+	if len(caller) > maxCallerLength {
+		caller = caller[:maxCallerLength]
+	}
+
+	return caller
+}
+
+// statusRecorder records the status code of the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+// WriteHeader records the status code and writes it to the response.
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampledRequestLogger(t *testing.T) {
+	logs := []string{}
+	logger := funcr.New(func(prefix, args string) {
+		logs = append(logs, args)
+	}, funcr.Options{})
+	ctx := logr.NewContext(context.Background(), logger)
+
+	handler := SampledRequestLogger([]string{"/openapi/v2", "/apis/api.ucp.dev/v1alpha3"}, 3)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/openapi/v2" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	serve := func(path string) int {
+		w := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+		require.NoError(t, err)
+		req.Header.Set("User-Agent", "kube-apiserver/v1.30.0 (linux/amd64) kubernetes/7c48c2b")
+		handler.ServeHTTP(w, req)
+		return w.Result().StatusCode
+	}
+
+	for i := 0; i < 4; i++ {
+		require.Equal(t, http.StatusOK, serve("/apis/api.ucp.dev/v1alpha3"))
+	}
+	require.Equal(t, http.StatusNotFound, serve("/openapi/v2"))
+	require.Equal(t, http.StatusOK, serve("/planes/radius/local"))
+
+	// The first and the fourth requests to the discovery document and the first request to the OpenAPI document are logged.
+	require.Len(t, logs, 3)
+	require.True(t, strings.Contains(logs[0], `"endpoint"="/apis/api.ucp.dev/v1alpha3"`), logs[0])
+	require.True(t, strings.Contains(logs[0], `"caller"="kube-apiserver"`), logs[0])
+	require.True(t, strings.Contains(logs[1], `"requestCount"=4`), logs[1])
+	require.True(t, strings.Contains(logs[2], `"endpoint"="/openapi/v2"`), logs[2])
+	require.True(t, strings.Contains(logs[2], `"statusCode"=404`), logs[2])
+}
+
+func TestSampledRequestLogger_LoggingDisabled(t *testing.T) {
+	logs := []string{}
+	logger := funcr.New(func(prefix, args string) {
+		logs = append(logs, args)
+	}, funcr.Options{})
+	ctx := logr.NewContext(context.Background(), logger)
+
+	handler := SampledRequestLogger([]string{"/openapi/v2"}, 0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	w := httptest.NewRecorder()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/openapi/v2", nil)
+	require.NoError(t, err)
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	require.Empty(t, logs)
+}
+
+func TestGetCaller(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		expected  string
+	}{
+		{"kube-apiserver/v1.30.0 (linux/amd64) kubernetes/7c48c2b", "kube-apiserver"},
+		{"kubectl/v1.30.0", "kubectl"},
+		{"Go-http-client/1.1", "Go-http-client"},
+		{"curl", "curl"},
+		{"", "unknown"},
+		{strings.Repeat("a", 100), strings.Repeat("a", 64)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.userAgent, func(t *testing.T) {
+			require.Equal(t, tc.expected, getCaller(tc.userAgent))
+		})
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
@@ -29,10 +31,27 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/recipes/util"
+	rp_util "github.com/radius-project/radius/pkg/rp/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/quota"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
+const (
+	// StageLoadingConfiguration is the progress stage reported while the runtime configuration is loaded.
+	StageLoadingConfiguration = "LoadingConfiguration"
+
+	// StageExecutingRecipe is the progress stage reported while the recipe of the resource is executed.
+	StageExecutingRecipe = "ExecutingRecipe"
+
+	// StageProcessing is the progress stage reported while the output of the recipe is processed.
+	StageProcessing = "Processing"
+
+	// StageSaving is the progress stage reported while the resource is saved.
+	StageSaving = "Saving"
+)
+
 // CreateOrUpdateResource is the async operation controller to create or update portable resources.
 type CreateOrUpdateResource[P interface {
 	*T
@@ -86,6 +105,7 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 	previousOutputResources := c.copyOutputResources(data)
 
 	// Load configuration
+	ctrl.ReportProgress(ctx, StageLoadingConfiguration, 5, "Loading the runtime configuration")
 	metadata := recipes.ResourceMetadata{EnvironmentID: data.ResourceMetadata().Environment, ApplicationID: data.ResourceMetadata().Application, ResourceID: data.GetBaseResource().ID}
 	config, err := c.configurationLoader.LoadConfiguration(ctx, metadata)
 	if err != nil {
@@ -95,7 +115,13 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 	// Now we're ready to process recipes (if needed).
 	recipeDataModel := any(data).(datamodel.RecipeDataModel)
 	recipeOutput, err := c.executeRecipeIfNeeded(ctx, data, previousOutputResources, config.Simulated)
-	if err != nil {
+	if errors.Is(err, &quota.ExceededError{}) {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeQuotaExceeded,
+			Message: fmt.Sprintf("The recipe of the resource was not executed: %s.", err.Error()),
+			Target:  req.ResourceID,
+		}), nil
+	} else if err != nil {
 		if recipeError, ok := err.(*recipes.RecipeError); ok {
 			logger.Error(err, fmt.Sprintf("failed to execute recipe. Encountered error while processing %s ", recipeError.ErrorDetails.Target))
 			// Set the deployment status to the recipe error code.
@@ -120,6 +146,7 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		logger.Info("The recipe was executed in simulation mode. No resources were deployed.")
 	} else {
 		// Now we're ready to process the resource. This will handle the updates to any user-visible state.
+		ctrl.ReportProgress(ctx, StageProcessing, 80, "Processing the recipe output")
 		err = c.processor.Process(ctx, data, processors.Options{RecipeOutput: recipeOutput, RuntimeConfiguration: config.Runtime})
 		if err != nil {
 			return ctrl.Result{}, err
@@ -129,6 +156,8 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		recipeDataModel.Recipe().DeploymentStatus = util.Success
 	}
 
+	ctrl.ReportProgress(ctx, StageSaving, 90, "Saving the resource")
+
 	update := &database.Object{
 		Metadata: database.Metadata{
 			ID: req.ResourceID,
@@ -170,6 +199,17 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		ResourceID:    data.GetBaseResource().ID,
 	}
 
+	// The execution is recorded against the recipe executions per day quotas before the recipe runs, so that concurrent
+	// deployments cannot exceed the quotas.
+	resourceID, err := resources.ParseResource(data.GetBaseResource().ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp_util.RecordRecipeExecution(ctx, c.DatabaseClient(), resourceID, data.ResourceMetadata(), time.Now()); err != nil {
+		return nil, err
+	}
+
+	ctrl.ReportProgress(ctx, StageExecutingRecipe, 10, fmt.Sprintf("Executing recipe %q", input.Name))
 	return c.engine.Execute(ctx, engine.ExecuteOptions{
 		BaseOptions: engine.BaseOptions{
 			Recipe: request,
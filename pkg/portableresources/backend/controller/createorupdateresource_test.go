@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
@@ -30,6 +32,8 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
@@ -38,6 +42,8 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/quota"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -128,6 +134,29 @@ var oldOutputResourceResourceID = "/subscriptions/test-sub/resourceGroups/test-r
 var newOutputResourceResourceID = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Systems.Test/testResources/test2"
 var newOutputResource = rpv1.OutputResource{ID: resources.MustParse(newOutputResourceResourceID)}
 
+// expectRecipeExecutionRecorded sets up the mock database for recording the recipe execution against the quotas of the
+// resource group and the environment of the test resource, which have no quota.
+func expectRecipeExecutionRecorded(msc *database.MockClient) {
+	for _, scope := range []string{"/planes/radius/local/resourceGroups/radius-test-rg", TestEnvironmentID} {
+		msc.EXPECT().
+			Get(gomock.Any(), scope).
+			Return(nil, &database.ErrNotFound{ID: scope}).
+			Times(1)
+		msc.EXPECT().
+			Get(gomock.Any(), gomock.Cond(func(id string) bool { return isRecipeExecutionsID(scope, id) })).
+			Return(nil, &database.ErrNotFound{}).
+			Times(1)
+		msc.EXPECT().
+			Save(gomock.Any(), gomock.Cond(func(obj *database.Object) bool { return isRecipeExecutionsID(scope, obj.ID) }), gomock.Any()).
+			Return(nil).
+			Times(1)
+	}
+}
+
+func isRecipeExecutionsID(scope string, id string) bool {
+	return strings.HasPrefix(id, scope+"/") && strings.Contains(id, "recipeExecutions")
+}
+
 func TestCreateOrUpdateResource_Run(t *testing.T) {
 	setupTest := func() (*database.MockClient, *engine.MockEngine, *processors.MockResourceClient, *configloader.MockConfigurationLoader) {
 		mctrl := gomock.NewController(t)
@@ -370,6 +399,10 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 					Times(1)
 			}
 
+			if stillPassing {
+				expectRecipeExecutionRecorded(msc)
+			}
+
 			if stillPassing && tt.recipeErr != nil {
 				stillPassing = false
 				eng.EXPECT().
@@ -437,3 +470,56 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateResource_Run_RecipeQuotaExceeded(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	eng := engine.NewMockEngine(mctrl)
+	cfg := configloader.NewMockConfigurationLoader(mctrl)
+	databaseClient := inmemory.NewClient()
+
+	resource := &TestResource{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: TestResourceID, Name: "tr", Type: TestResourceType}},
+		Properties: TestResourceProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{Environment: TestEnvironmentID},
+			Recipe:                  portableresources.ResourceRecipe{Name: "test-recipe"},
+		},
+	}
+	env := &cdm.Environment{
+		Properties: cdm.EnvironmentProperties{Quota: &cdm.EnvironmentQuota{MaxRecipeExecutionsPerDay: to.Ptr(int32(0))}},
+	}
+	for id, data := range map[string]any{TestResourceID: resource, TestEnvironmentID: env} {
+		err := databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: data})
+		require.NoError(t, err)
+	}
+
+	cfg.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		Return(&recipes.Configuration{}, nil).
+		Times(1)
+
+	// The recipe is not executed once the quota has been reached.
+	eng.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+
+	genCtrl, err := NewCreateOrUpdateResource(ctrl.Options{DatabaseClient: databaseClient}, successProcessorReference, eng, nil, cfg)
+	require.NoError(t, err)
+
+	req := &ctrl.Request{
+		OperationID:      uuid.New(),
+		OperationType:    "APPLICATIONS.TEST/TESTRESOURCES|PUT",
+		ResourceID:       TestResourceID,
+		CorrelationID:    uuid.NewString(),
+		OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+	}
+	res, err := genCtrl.Run(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, res.ProvisioningState())
+	require.Equal(t, v1.CodeQuotaExceeded, res.Error.Code)
+	require.Equal(t, TestResourceID, res.Error.Target)
+
+	// The execution recorded for the resource group is released.
+	for _, scope := range []string{"/planes/radius/local/resourceGroups/radius-test-rg", TestEnvironmentID} {
+		usage, err := quota.Calculate(context.Background(), databaseClient, resources.MustParse(scope), time.Now())
+		require.NoError(t, err)
+		require.Equal(t, int32(0), usage.RecipeExecutionsToday)
+	}
+}
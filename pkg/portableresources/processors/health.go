@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package processors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	"github.com/radius-project/radius/pkg/rp/health"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// HealthChecker is an optional interface implemented by resource processors which can report the health of the
+// resources they process. CheckHealth is called periodically for resources which were provisioned successfully.
+type HealthChecker[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] interface {
+	// CheckHealth returns the health of the resource. Returning an error reports the health of the resource as unknown.
+	CheckHealth(ctx context.Context, resource P, options HealthOptions) (rpv1.ResourceHealth, error)
+}
+
+// HealthOptions defines the options passed to the health checker.
+type HealthOptions struct {
+	// ResourceClient is a client used for interacting with the output resources of the resource.
+	ResourceClient ResourceClient
+}
+
+// NewHealthEvaluator creates a health evaluator which runs the health checker of a resource processor.
+func NewHealthEvaluator[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](checker HealthChecker[P, T], client ResourceClient) health.Evaluator {
+	return health.NewTypedEvaluator(func(ctx context.Context, resource *T) (rpv1.ResourceHealth, error) {
+		return checker.CheckHealth(ctx, P(resource), HealthOptions{ResourceClient: client})
+	})
+}
+
+// CheckOutputResourcesHealth checks the provisioning state of the Azure output resources. The resource is unhealthy
+// if any of the Azure output resources no longer exists or has a provisioning state other than 'Succeeded'.
+func CheckOutputResourcesHealth(ctx context.Context, client ResourceClient, outputResources []rpv1.OutputResource) (rpv1.ResourceHealth, error) {
+	messages := []string{}
+	for _, outputResource := range outputResources {
+		if outputResource.GetResourceType().Provider != resourcemodel.ProviderAzure {
+			continue
+		}
+
+		state, err := client.GetProvisioningState(ctx, outputResource.ID.String())
+		if clients.Is404Error(err) {
+			messages = append(messages, fmt.Sprintf("resource %q was not found", outputResource.ID.String()))
+			continue
+		} else if err != nil {
+			return rpv1.ResourceHealth{}, err
+		}
+
+		// Resources which do not report a provisioning state are considered healthy.
+		if state != "" && !strings.EqualFold(state, "Succeeded") {
+			messages = append(messages, fmt.Sprintf("resource %q has provisioning state %q", outputResource.ID.String(), state))
+		}
+	}
+
+	if len(messages) > 0 {
+		return health.Unhealthy(strings.Join(messages, "; ")), nil
+	}
+
+	return health.Healthy(""), nil
+}
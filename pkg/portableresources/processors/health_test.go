@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package processors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/rp/health"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func mustParseResource(t *testing.T, id string) resources.ID {
+	parsed, err := resources.ParseResource(id)
+	require.NoError(t, err)
+	return parsed
+}
+
+func Test_CheckOutputResourcesHealth(t *testing.T) {
+	outputResources := []rpv1.OutputResource{
+		{ID: mustParseResource(t, AzureUCPResourceID)},
+		{ID: mustParseResource(t, KubernetesCoreGroupResourceID)},
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		client := NewMockResourceClient(gomock.NewController(t))
+		client.EXPECT().GetProvisioningState(gomock.Any(), AzureUCPResourceID).Return("Succeeded", nil)
+
+		result, err := CheckOutputResourcesHealth(context.Background(), client, outputResources)
+		require.NoError(t, err)
+		require.Equal(t, health.Healthy(""), result)
+	})
+
+	t.Run("unhealthy - provisioning state", func(t *testing.T) {
+		client := NewMockResourceClient(gomock.NewController(t))
+		client.EXPECT().GetProvisioningState(gomock.Any(), AzureUCPResourceID).Return("Failed", nil)
+
+		result, err := CheckOutputResourcesHealth(context.Background(), client, outputResources)
+		require.NoError(t, err)
+		require.Equal(t, health.Unhealthy(`resource "`+AzureUCPResourceID+`" has provisioning state "Failed"`), result)
+	})
+
+	t.Run("unhealthy - not found", func(t *testing.T) {
+		client := NewMockResourceClient(gomock.NewController(t))
+		client.EXPECT().GetProvisioningState(gomock.Any(), AzureUCPResourceID).Return("", &azcore.ResponseError{StatusCode: http.StatusNotFound, ErrorCode: v1.CodeNotFound})
+
+		result, err := CheckOutputResourcesHealth(context.Background(), client, outputResources)
+		require.NoError(t, err)
+		require.Equal(t, health.Unhealthy(`resource "`+AzureUCPResourceID+`" was not found`), result)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		client := NewMockResourceClient(gomock.NewController(t))
+		client.EXPECT().GetProvisioningState(gomock.Any(), AzureUCPResourceID).Return("", errors.New("connection refused"))
+
+		_, err := CheckOutputResourcesHealth(context.Background(), client, outputResources)
+		require.EqualError(t, err, "connection refused")
+	})
+}
+
+type testResource struct {
+	v1.BaseResource
+
+	Properties struct {
+		rpv1.BasicResourceProperties
+	} `json:"properties"`
+}
+
+func (r *testResource) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
+	return nil
+}
+
+func (r *testResource) OutputResources() []rpv1.OutputResource {
+	return r.Properties.Status.OutputResources
+}
+
+func (r *testResource) ResourceMetadata() *rpv1.BasicResourceProperties {
+	return &r.Properties.BasicResourceProperties
+}
+
+type testHealthChecker struct{}
+
+func (c *testHealthChecker) CheckHealth(ctx context.Context, resource *testResource, options HealthOptions) (rpv1.ResourceHealth, error) {
+	return CheckOutputResourcesHealth(ctx, options.ResourceClient, resource.Properties.Status.OutputResources)
+}
+
+func Test_NewHealthEvaluator(t *testing.T) {
+	client := NewMockResourceClient(gomock.NewController(t))
+	client.EXPECT().GetProvisioningState(gomock.Any(), AzureUCPResourceID).Return("Deleting", nil)
+
+	evaluator := NewHealthEvaluator[*testResource](&testHealthChecker{}, client)
+	result, err := evaluator.Evaluate(context.Background(), &database.Object{
+		Data: map[string]any{
+			"properties": map[string]any{
+				"status": map[string]any{
+					"outputResources": []any{
+						map[string]any{"id": AzureUCPResourceID},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, rpv1.HealthStateUnhealthy, result.State)
+}
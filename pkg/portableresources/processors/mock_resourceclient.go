@@ -39,6 +39,44 @@ func (m *MockResourceClient) EXPECT() *MockResourceClientMockRecorder {
 	return m.recorder
 }
 
+// CreateOrUpdate mocks base method.
+func (m *MockResourceClient) CreateOrUpdate(arg0 context.Context, arg1 string, arg2 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockResourceClientMockRecorder) CreateOrUpdate(arg0, arg1, arg2 any) *MockResourceClientCreateOrUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockResourceClient)(nil).CreateOrUpdate), arg0, arg1, arg2)
+	return &MockResourceClientCreateOrUpdateCall{Call: call}
+}
+
+// MockResourceClientCreateOrUpdateCall wrap *gomock.Call
+type MockResourceClientCreateOrUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceClientCreateOrUpdateCall) Return(arg0 error) *MockResourceClientCreateOrUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceClientCreateOrUpdateCall) Do(f func(context.Context, string, map[string]any) error) *MockResourceClientCreateOrUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, map[string]any) error) *MockResourceClientCreateOrUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Delete mocks base method.
 func (m *MockResourceClient) Delete(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -76,3 +114,42 @@ func (c *MockResourceClientDeleteCall) DoAndReturn(f func(context.Context, strin
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetProvisioningState mocks base method.
+func (m *MockResourceClient) GetProvisioningState(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvisioningState", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvisioningState indicates an expected call of GetProvisioningState.
+func (mr *MockResourceClientMockRecorder) GetProvisioningState(arg0, arg1 any) *MockResourceClientGetProvisioningStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvisioningState", reflect.TypeOf((*MockResourceClient)(nil).GetProvisioningState), arg0, arg1)
+	return &MockResourceClientGetProvisioningStateCall{Call: call}
+}
+
+// MockResourceClientGetProvisioningStateCall wrap *gomock.Call
+type MockResourceClientGetProvisioningStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceClientGetProvisioningStateCall) Return(arg0 string, arg1 error) *MockResourceClientGetProvisioningStateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceClientGetProvisioningStateCall) Do(f func(context.Context, string) (string, error)) *MockResourceClientGetProvisioningStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceClientGetProvisioningStateCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockResourceClientGetProvisioningStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -86,6 +86,54 @@ func (c *resourceClient) Delete(ctx context.Context, id string) error {
 	}
 }
 
+// CreateOrUpdate attempts to create or update a resource, either through UCP or Azure, depending on the resource type.
+func (c *resourceClient) CreateOrUpdate(ctx context.Context, id string, properties map[string]any) error {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return err
+	}
+
+	attributes := []attribute.KeyValue{{Key: attribute.Key(ucplog.LogFieldTargetResourceID), Value: attribute.StringValue(id)}}
+	ctx, span := trace.StartCustomSpan(ctx, "resourceclient.CreateOrUpdate", trace.BackendTracerName, attributes)
+	defer span.End()
+
+	ns := strings.ToLower(parsed.PlaneNamespace())
+
+	if !parsed.IsUCPQualified() || strings.HasPrefix(ns, "azure/") {
+		err = c.createOrUpdateAzureResource(ctx, parsed, properties)
+	} else if strings.HasPrefix(ns, "kubernetes/") {
+		err = fmt.Errorf("resources of type %q are not supported", parsed.Type())
+	} else {
+		err = c.createOrUpdateUCPResource(ctx, parsed, properties)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create or update resource %q: %w", parsed.String(), err)
+	}
+
+	return nil
+}
+
+// GetProvisioningState gets the provisioning state of an Azure resource. Other resource types are not supported.
+func (c *resourceClient) GetProvisioningState(ctx context.Context, id string) (string, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return "", err
+	}
+
+	ns := strings.ToLower(parsed.PlaneNamespace())
+	if parsed.IsUCPQualified() && !strings.HasPrefix(ns, "azure/") {
+		return "", fmt.Errorf("resources of type %q are not supported", parsed.Type())
+	}
+
+	state, err := c.getAzureProvisioningState(ctx, parsed)
+	if err != nil {
+		return "", fmt.Errorf("failed to get resource %q: %w", parsed.String(), err)
+	}
+
+	return state, nil
+}
+
 func (c *resourceClient) wrapError(id resources.ID, err error) error {
 	if err != nil {
 		return &ResourceError{Inner: err, ID: id.String()}
@@ -136,6 +184,71 @@ func (c *resourceClient) deleteAzureResource(ctx context.Context, id resources.I
 	return nil
 }
 
+func (c *resourceClient) createOrUpdateAzureResource(ctx context.Context, id resources.ID, properties map[string]any) error {
+	var err error
+	if id.IsUCPQualified() {
+		id, err = resources.ParseResource(resources.MakeRelativeID(id.ScopeSegments()[1:], id.TypeSegments(), id.ExtensionSegments()))
+		if err != nil {
+			return err
+		}
+	}
+
+	apiVersion, err := c.lookupARMAPIVersion(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	client, err := clientv2.NewGenericResourceClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginCreateOrUpdateByID(ctx, id.String(), apiVersion, armresources.GenericResource{Properties: properties}, &armresources.ClientBeginCreateOrUpdateByIDOptions{})
+	if err != nil {
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
+func (c *resourceClient) getAzureProvisioningState(ctx context.Context, id resources.ID) (string, error) {
+	var err error
+	if id.IsUCPQualified() {
+		id, err = resources.ParseResource(resources.MakeRelativeID(id.ScopeSegments()[1:], id.TypeSegments(), id.ExtensionSegments()))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	apiVersion, err := c.lookupARMAPIVersion(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := clientv2.NewGenericResourceClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.GetByID(ctx, id.String(), apiVersion, &armresources.ClientGetByIDOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	if response.Properties == nil {
+		return "", nil
+	}
+
+	properties, ok := response.Properties.(map[string]any)
+	if !ok {
+		return "", nil
+	}
+
+	state, _ := properties["provisioningState"].(string)
+	return state, nil
+}
+
 func (c *resourceClient) lookupARMAPIVersion(ctx context.Context, id resources.ID) (string, error) {
 	client, err := clientv2.NewProvidersClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
 	if err != nil {
@@ -204,6 +317,22 @@ func (c *resourceClient) deleteUCPResource(ctx context.Context, id resources.ID)
 	return nil
 }
 
+func (c *resourceClient) createOrUpdateUCPResource(ctx context.Context, id resources.ID, properties map[string]any) error {
+	// NOTE: see deleteUCPResource for the handling of API versions.
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginCreateOrUpdate(ctx, id.Name(), generated.GenericResource{Properties: properties}, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
 func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resources.ID) error {
 	apiVersion, err := c.lookupKubernetesAPIVersion(id)
 	if err != nil {
@@ -360,6 +360,152 @@ func Test_Delete_UCP(t *testing.T) {
 	})
 }
 
+func Test_CreateOrUpdate_InvalidResourceID(t *testing.T) {
+	c := NewResourceClient(nil, nil, nil)
+	err := c.CreateOrUpdate(context.Background(), "invalid", map[string]any{})
+	require.Error(t, err)
+}
+
+func Test_CreateOrUpdate_ARM(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handlePutSuccess(t, "value"))
+		mux.HandleFunc(ARMProviderPath, handleJSONResponse(t, armresources.Provider{
+			Namespace: to.Ptr("Microsoft.Compute"),
+			ResourceTypes: []*armresources.ProviderResourceType{
+				{
+					ResourceType:      to.Ptr("virtualMachines"),
+					DefaultAPIVersion: to.Ptr(ARMAPIVersion),
+				},
+			},
+		}, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		err := c.CreateOrUpdate(context.Background(), AzureUCPResourceID, map[string]any{"value": "test"})
+		require.NoError(t, err)
+	})
+
+	t.Run("failure - lookup API Version - provider not found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMProviderPath, handleNotFound(t))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		err := c.CreateOrUpdate(context.Background(), ARMResourceID, map[string]any{"value": "test"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to create or update resource")
+	})
+}
+
+func Test_CreateOrUpdate_Kubernetes(t *testing.T) {
+	c := NewResourceClient(nil, nil, nil)
+	err := c.CreateOrUpdate(context.Background(), KubernetesCoreGroupResourceID, map[string]any{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "resources of type \"core/Secret\" are not supported")
+}
+
+func Test_CreateOrUpdate_UCP(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handlePutSuccess(t, "Name"))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil)
+
+		err = c.CreateOrUpdate(context.Background(), AWSResourceID, map[string]any{"Name": "test-stream"})
+		require.NoError(t, err)
+	})
+
+	t.Run("failure - create fails", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleJSONResponse(t, v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code: v1.CodeConflict,
+			},
+		}, 409))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil)
+
+		err = c.CreateOrUpdate(context.Background(), AWSResourceID, map[string]any{"Name": "test-stream"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to create or update resource")
+	})
+}
+
+func Test_GetProvisioningState_ARM(t *testing.T) {
+	provider := armresources.Provider{
+		Namespace: to.Ptr("Microsoft.Compute"),
+		ResourceTypes: []*armresources.ProviderResourceType{
+			{
+				ResourceType:      to.Ptr("virtualMachines"),
+				DefaultAPIVersion: to.Ptr(ARMAPIVersion),
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleJSONResponse(t, map[string]any{
+			"id":         ARMResourceID,
+			"properties": map[string]any{"provisioningState": "Failed"},
+		}, 200))
+		mux.HandleFunc(ARMProviderPath, handleJSONResponse(t, provider, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		state, err := c.GetProvisioningState(context.Background(), AzureUCPResourceID)
+		require.NoError(t, err)
+		require.Equal(t, "Failed", state)
+	})
+
+	t.Run("failure - resource not found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleNotFound(t))
+		mux.HandleFunc(ARMProviderPath, handleJSONResponse(t, provider, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		_, err := c.GetProvisioningState(context.Background(), ARMResourceID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to get resource")
+	})
+}
+
+func Test_GetProvisioningState_Unsupported(t *testing.T) {
+	c := NewResourceClient(nil, nil, nil)
+	_, err := c.GetProvisioningState(context.Background(), AWSResourceID)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "resources of type \"AWS.Kinesis/Streams\" are not supported")
+}
+
 func newArmOptions(url string) *armauth.ArmConfig {
 	return &armauth.ArmConfig{
 		ClientOptions: clientv2.Options{
@@ -398,6 +544,28 @@ func handleDeleteSuccess() func(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handlePutSuccess returns a handler that verifies the request is a PUT containing the given property and
+// echoes the request body back.
+func handlePutSuccess(t *testing.T, property string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		require.Equal(t, http.MethodPut, r.Method)
+
+		body := map[string]any{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+
+		properties, ok := body["properties"].(map[string]any)
+		require.True(t, ok)
+		require.Contains(t, properties, property)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		err = json.NewEncoder(w).Encode(body)
+		require.NoError(t, err)
+	}
+}
+
 func handleJSONResponse(t *testing.T, response any, statusCode int) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		defer r.Body.Close()
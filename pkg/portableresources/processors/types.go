@@ -66,6 +66,16 @@ type ResourceClient interface {
 	//
 	// If the API version is omitted, then an attempt will be made to look up the API version.
 	Delete(ctx context.Context, id string) error
+
+	// CreateOrUpdate creates or updates a resource by id with the given properties.
+	//
+	// The API version is looked up for Azure resources. Kubernetes resources are not supported.
+	CreateOrUpdate(ctx context.Context, id string, properties map[string]any) error
+
+	// GetProvisioningState gets the provisioning state of a resource by id.
+	//
+	// The API version is looked up for Azure resources. Other resource types are not supported.
+	GetProvisioningState(ctx context.Context, id string) (string, error)
 }
 
 // ResourceError represents an error that occurred while processing a resource.
@@ -81,34 +81,44 @@ func (v *Validator) AddResourcesField(ref *[]*portableresources.ResourceReferenc
 
 // AddRequiredInt32Field registers a field containing a required int32 connection value. The zero value will be treated as an "unset" value.
 func (v *Validator) AddRequiredInt32Field(name string, ref *int32) {
-	v.fields = append(v.fields, bind(v, name, ref, true, false, "int32", convertToInt32, nil))
+	v.fields = append(v.fields, bind(v, name, ref, true, false, "int32", convertToInt32, nil, nil))
 }
 
 // AddOptionalInt32Field registers a field containing an optional int32 connection value. The zero value will be treated as an "unset" value.
 func (v *Validator) AddOptionalInt32Field(name string, ref *int32) {
-	v.fields = append(v.fields, bind(v, name, ref, false, false, "int32", convertToInt32, nil))
+	v.fields = append(v.fields, bind(v, name, ref, false, false, "int32", convertToInt32, nil, nil))
+}
+
+// AddRequiredPortField registers a field containing a required int32 connection value that must be a valid TCP port. The zero value will be treated as an "unset" value.
+func (v *Validator) AddRequiredPortField(name string, ref *int32) {
+	v.fields = append(v.fields, bind(v, name, ref, true, false, "int32", convertToInt32, portableresources.ValidatePort, nil))
+}
+
+// AddRequiredHostField registers a field containing a required string connection value that must be a host name or IP address. The empty string will be treated as an "unset" value.
+func (v *Validator) AddRequiredHostField(name string, ref *string) {
+	v.fields = append(v.fields, bind(v, name, ref, true, false, "string", convertToString, portableresources.ValidateHost, nil))
 }
 
 // AddRequiredStringField registers a field containing a required string connection value. The empty string will be treated as an "unset" value.
 func (v *Validator) AddRequiredStringField(name string, ref *string) {
-	v.fields = append(v.fields, bind(v, name, ref, true, false, "string", convertToString, nil))
+	v.fields = append(v.fields, bind(v, name, ref, true, false, "string", convertToString, nil, nil))
 }
 
 // AddOptionalStringField registers a field containing an optional string connection value. The empty string will be treated as an "unset" value.
 func (v *Validator) AddOptionalStringField(name string, ref *string) {
-	v.fields = append(v.fields, bind(v, name, ref, false, false, "string", convertToString, nil))
+	v.fields = append(v.fields, bind(v, name, ref, false, false, "string", convertToString, nil, nil))
 }
 
 // AddRequiredSecretField registers a field containing a required string connection secret. The empty string will be treated as an "unset" value.
 func (v *Validator) AddRequiredSecretField(name string, ref *string) {
 	// Note: secrets are always strings
-	v.fields = append(v.fields, bind(v, name, ref, true, true, "string", convertToString, nil))
+	v.fields = append(v.fields, bind(v, name, ref, true, true, "string", convertToString, nil, nil))
 }
 
 // AddRequiredSecretField registers a field containing an optional string connection secret. The empty string will be treated as an "unset" value.
 func (v *Validator) AddOptionalSecretField(name string, ref *string) {
 	// Note: secrets are always strings
-	v.fields = append(v.fields, bind(v, name, ref, false, true, "string", convertToString, nil))
+	v.fields = append(v.fields, bind(v, name, ref, false, true, "string", convertToString, nil, nil))
 }
 
 // AddOptionalAnyField registers a field containing any property value. The empty property will be treated as an "unset" value.
@@ -124,7 +134,7 @@ func (v *Validator) AddOptionalAnyField(name string, ref any) {
 // The compute function will not be called if a validation error has previously occurred.
 func (v *Validator) AddComputedStringField(name string, ref *string, compute func() (string, *ValidationError)) {
 	// Note: secrets are always strings
-	v.computedFields = append(v.computedFields, bind(v, name, ref, false, false, "string", convertToString, compute))
+	v.computedFields = append(v.computedFields, bind(v, name, ref, false, false, "string", convertToString, nil, compute))
 }
 
 // AddComputedBoolField registers a field containing a computed boolean connection value. The false value will be treated as an "unset" value.
@@ -135,7 +145,7 @@ func (v *Validator) AddComputedStringField(name string, ref *string, compute fun
 // The compute function will not be called if a validation error has previously occurred.
 func (v *Validator) AddComputedBoolField(name string, ref *bool, compute func() (bool, *ValidationError)) {
 	// Note: secrets are always strings
-	v.computedFields = append(v.computedFields, bind(v, name, ref, false, false, "bool", convertToBool, compute))
+	v.computedFields = append(v.computedFields, bind(v, name, ref, false, false, "bool", convertToBool, nil, compute))
 }
 
 // AddComputedSecretField registers a field containing a computed string connection secret. The empty string will be treated as an "unset" value.
@@ -146,7 +156,19 @@ func (v *Validator) AddComputedBoolField(name string, ref *bool, compute func()
 // The compute function will not be called if a validation error has previously occurred.
 func (v *Validator) AddComputedSecretField(name string, ref *string, compute func() (string, *ValidationError)) {
 	// Note: secrets are always strings
-	v.computedFields = append(v.computedFields, bind(v, name, ref, false, true, "string", convertToString, compute))
+	v.computedFields = append(v.computedFields, bind(v, name, ref, false, true, "string", convertToString, nil, compute))
+}
+
+// AddComputedURISecretField registers a field containing a computed string connection secret that must be a URI with one of
+// the given schemes. The empty string will be treated as an "unset" value.
+//
+// The URI is validated when it is set or provided by the recipe. The compute function will be called if the secret is not
+// already set or provided by the recipe, and will not be called if a validation error has previously occurred.
+func (v *Validator) AddComputedURISecretField(name string, ref *string, schemes []string, compute func() (string, *ValidationError)) {
+	validate := func(uri string) string {
+		return portableresources.ValidateURI(uri, schemes...)
+	}
+	v.computedFields = append(v.computedFields, bind(v, name, ref, false, true, "string", convertToString, validate, compute))
 }
 
 // SetAndValidate will bind fields from the recipe output, populate output resources, and populate connection values/secrets.
@@ -209,7 +231,7 @@ func (v *Validator) SetAndValidate(output *recipes.RecipeOutput) error {
 	return nil
 }
 
-func bind[T any](v *Validator, name string, ref *T, required bool, secret bool, typeName string, convert func(value any) (T, bool), compute func() (T, *ValidationError)) func(output *recipes.RecipeOutput) string {
+func bind[T any](v *Validator, name string, ref *T, required bool, secret bool, typeName string, convert func(value any) (T, bool), validate func(value T) string, compute func() (T, *ValidationError)) func(output *recipes.RecipeOutput) string {
 	return func(output *recipes.RecipeOutput) string {
 		valueKind := kindConnectionValue
 		propertyPath := fmt.Sprintf(".properties.%v", name)
@@ -221,6 +243,12 @@ func bind[T any](v *Validator, name string, ref *T, required bool, secret bool,
 		existing := *ref
 		if !reflect.ValueOf(existing).IsZero() {
 			// Field is already set
+			if validate != nil {
+				if msg := validate(existing); msg != "" {
+					return v.buildInvalidValueError(name, false, valueKind, msg)
+				}
+			}
+
 			v.recordValue(name, *ref, secret)
 			return ""
 		}
@@ -264,6 +292,12 @@ func bind[T any](v *Validator, name string, ref *T, required bool, secret bool,
 			return v.buildTypeMismatchError(name, typeName, valueKind, value)
 		}
 
+		if validate != nil {
+			if msg := validate(converted); msg != "" {
+				return v.buildInvalidValueError(name, true, valueKind, msg)
+			}
+		}
+
 		*ref = converted
 		v.recordValue(name, converted, secret)
 		return ""
@@ -323,3 +357,11 @@ func (v *Validator) buildRequiredValueError(name string, recipe bool, valueKind
 
 	return fmt.Sprintf("the %v %q must be provided when not using a recipe. Set '%v' to provide a value manually", valueKind, name, propertyPath)
 }
+
+func (v *Validator) buildInvalidValueError(name string, recipe bool, valueKind string, msg string) string {
+	if recipe {
+		return fmt.Sprintf("the %v %q provided by the recipe %s", valueKind, name, msg)
+	}
+
+	return fmt.Sprintf("the %v %q %s", valueKind, name, msg)
+}
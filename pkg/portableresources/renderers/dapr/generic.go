@@ -31,6 +31,9 @@ type DaprGeneric struct {
 	Version  *string
 	Metadata map[string]*rpv1.DaprComponentMetadataValue
 	Auth     *rpv1.DaprComponentAuth
+	// Scopes is the list of Dapr app IDs the component is scoped to. The component is available to all
+	// apps in the namespace if empty.
+	Scopes []string
 }
 
 // Validate checks if the required fields of a DaprGeneric struct are set and returns an error if any of them are not.
@@ -108,5 +111,13 @@ func ConstructDaprGeneric(daprGeneric DaprGeneric, namespace string, componentNa
 			"secretStore": daprGeneric.Auth.SecretStore,
 		}
 	}
+
+	if len(daprGeneric.Scopes) > 0 {
+		scopes := []any{}
+		for _, scope := range daprGeneric.Scopes {
+			scopes = append(scopes, scope)
+		}
+		item.Object["scopes"] = scopes
+	}
 	return item, nil
 }
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dapr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ResolveScopes resolves the resource IDs of the containers a Dapr component is scoped to into the Dapr app IDs
+// of those containers. The containers are read through the Applications.Core API of UCP, because they are owned
+// by another resource provider.
+//
+// The app ID of a container is the appId of its Dapr sidecar extension. If the appId is not specified, or the
+// container has not been deployed yet, Dapr uses the name of the Kubernetes deployment, which is the normalized
+// name of the container.
+//
+// Scopes that are not container resource IDs are reported as a processors.ValidationError, and failures to read
+// the containers as a processors.ResourceError, so that the Dapr resource processors can return the error as is.
+func ResolveScopes(ctx context.Context, connection sdk.Connection, scopes []string) ([]string, error) {
+	appIDs := []string{}
+	for _, scope := range scopes {
+		id, err := resources.ParseResource(scope)
+		if err != nil {
+			return nil, &processors.ValidationError{Message: fmt.Sprintf("scope %q must be a valid resource ID", scope)}
+		}
+
+		if !strings.EqualFold(id.Type(), corerp_dm.ContainerResourceType) {
+			return nil, &processors.ValidationError{Message: fmt.Sprintf("scope %q must be the resource ID of an %s resource", scope, corerp_dm.ContainerResourceType)}
+		}
+
+		appID, err := containerAppID(ctx, connection, id)
+		if err != nil {
+			return nil, &processors.ResourceError{ID: scope, Inner: err}
+		}
+
+		if !slices.Contains(appIDs, appID) {
+			appIDs = append(appIDs, appID)
+		}
+	}
+
+	return appIDs, nil
+}
+
+// containerAppID returns the Dapr app ID of the container, either from its Dapr sidecar extension or the default app
+// ID derived from the name of the container. The default app ID of a name longer than 63 characters is truncated with
+// a hash suffix, like the name of the Kubernetes deployment of the container. Such names were rejected before, so the
+// app IDs of existing containers do not change.
+func containerAppID(ctx context.Context, connection sdk.Connection, id resources.ID) (string, error) {
+	defaultAppID := kubernetes.NormalizeResourceName(id.Name())
+
+	client, err := corerp.NewContainersClient(id.RootScope(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(connection))
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.Get(ctx, id.Name(), nil)
+	if clientv2.Is404Error(err) {
+		return defaultAppID, nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get container %q: %w", id.String(), err)
+	}
+
+	if response.Properties == nil {
+		return defaultAppID, nil
+	}
+
+	for _, extension := range response.Properties.Extensions {
+		if sidecar, ok := extension.(*corerp.DaprSidecarExtension); ok && to.String(sidecar.AppID) != "" {
+			return to.String(sidecar.AppID), nil
+		}
+	}
+
+	return defaultAppID, nil
+}
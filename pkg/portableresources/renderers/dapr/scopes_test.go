@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dapr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	frontendID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/frontend"
+	backendID  = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/backend"
+	workerID   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/Worker"
+	brokenID   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/broken"
+)
+
+func Test_ResolveScopes(t *testing.T) {
+	ctx := testcontext.New(t)
+	connection := newContainerServer(t, map[string]*corerp.ContainerResource{
+		frontendID: {
+			ID: to.Ptr(frontendID),
+			Properties: &corerp.ContainerProperties{
+				Extensions: []corerp.ExtensionClassification{
+					&corerp.DaprSidecarExtension{Kind: to.Ptr("daprSidecar"), AppID: to.Ptr("frontend-app")},
+				},
+			},
+		},
+		backendID: {
+			ID: to.Ptr(backendID),
+			Properties: &corerp.ContainerProperties{
+				Extensions: []corerp.ExtensionClassification{
+					&corerp.ManualScalingExtension{Kind: to.Ptr("manualScaling"), Replicas: to.Ptr(int32(2))},
+				},
+			},
+		},
+	})
+
+	tests := []struct {
+		name     string
+		scopes   []string
+		expected []string
+		err      string
+		// validation is true if the error should be reported to the user as a validation error.
+		validation bool
+	}{
+		{
+			name:     "no scopes",
+			scopes:   nil,
+			expected: []string{},
+		},
+		{
+			name:     "dapr sidecar app ID",
+			scopes:   []string{frontendID},
+			expected: []string{"frontend-app"},
+		},
+		{
+			name:     "container without app ID",
+			scopes:   []string{backendID},
+			expected: []string{"backend"},
+		},
+		{
+			name:     "container not deployed yet",
+			scopes:   []string{workerID},
+			expected: []string{"worker"},
+		},
+		{
+			name:     "duplicate scopes",
+			scopes:   []string{frontendID, backendID, frontendID},
+			expected: []string{"frontend-app", "backend"},
+		},
+		{
+			name:   "container cannot be read",
+			scopes: []string{brokenID},
+			err:    "failed to get container \"" + brokenID + "\"",
+		},
+		{
+			name:       "invalid resource type",
+			scopes:     []string{"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/gateways/gateway"},
+			err:        "scope \"/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/gateways/gateway\" must be the resource ID of an Applications.Core/containers resource",
+			validation: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			appIDs, err := ResolveScopes(ctx, connection, tc.scopes)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+
+				validationErr := &processors.ValidationError{}
+				require.Equal(t, tc.validation, errors.As(err, &validationErr))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, appIDs)
+		})
+	}
+}
+
+func Test_ConstructDaprGeneric_Scopes(t *testing.T) {
+	daprGeneric := DaprGeneric{
+		Type:     to.Ptr("state.redis"),
+		Version:  to.Ptr("v1"),
+		Metadata: nil,
+		Scopes:   []string{"frontend-app", "backend"},
+	}
+
+	item, err := ConstructDaprGeneric(daprGeneric, "default", "statestore", "app", "statestore", "Applications.Dapr/stateStores")
+	require.NoError(t, err)
+	require.Equal(t, []any{"frontend-app", "backend"}, item.Object["scopes"])
+
+	daprGeneric.Scopes = nil
+	item, err = ConstructDaprGeneric(daprGeneric, "default", "statestore", "app", "statestore", "Applications.Dapr/stateStores")
+	require.NoError(t, err)
+	require.NotContains(t, item.Object, "scopes")
+}
+
+// newContainerServer starts a fake UCP endpoint which serves the given Applications.Core containers. Other containers
+// are not found, except the broken container which cannot be read.
+func newContainerServer(t *testing.T, containers map[string]*corerp.ContainerResource) sdk.Connection {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.URL.Path, brokenID) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		for id, container := range containers {
+			if strings.EqualFold(r.URL.Path, id) {
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(container))
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	return connection
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portableresources
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// maxHostLength is the maximum length of a DNS host name.
+	maxHostLength = 253
+
+	// maxPort is the largest valid TCP port.
+	maxPort = 65535
+)
+
+var (
+	// hostNameRegex matches DNS host names. Underscores are accepted because some providers use them in host names.
+	hostNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]*[a-zA-Z0-9_])?(\.[a-zA-Z0-9_]([a-zA-Z0-9_-]*[a-zA-Z0-9_])?)*\.?$`)
+)
+
+// The functions in this file validate the format of the connection values of portable resources. Each function returns
+// an empty string when the value is valid or unset, or a description of the problem which completes a sentence
+// starting with the name of the value, for example "port must be between 1 and 65535, got 70000".
+
+// ValidateHost validates that the value is a host name or an IP address.
+func ValidateHost(host string) string {
+	if host == "" {
+		return ""
+	}
+
+	if strings.Contains(host, "://") {
+		return fmt.Sprintf("must be a host name or IP address without a scheme, got %q", host)
+	}
+
+	if net.ParseIP(host) != nil {
+		return ""
+	}
+
+	if len(host) > maxHostLength || !hostNameRegex.MatchString(host) {
+		return fmt.Sprintf("must be a valid host name or IP address, got %q", host)
+	}
+
+	return ""
+}
+
+// ValidatePort validates that the value is a valid TCP port.
+func ValidatePort(port int32) string {
+	if port == 0 {
+		return ""
+	}
+
+	if port < 1 || port > maxPort {
+		return fmt.Sprintf("must be between 1 and %d, got %d", maxPort, port)
+	}
+
+	return ""
+}
+
+// ValidateURI validates that the value is an absolute URI with a host and one of the given schemes. The value is
+// not included in the description because URIs of connections commonly contain credentials.
+func ValidateURI(uri string, schemes ...string) string {
+	if uri == "" {
+		return ""
+	}
+
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Host == "" || !slices.Contains(schemes, strings.ToLower(parsed.Scheme)) {
+		return fmt.Sprintf("must be a valid URI with the scheme %s", strings.Join(schemes, " or "))
+	}
+
+	return ""
+}
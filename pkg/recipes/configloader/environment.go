@@ -120,6 +120,10 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		config.Simulated = true
 	}
 
+	if ext := datamodel.FindExtension(envDatamodel.Properties.Extensions, datamodel.CostAttribution); ext != nil && ext.CostAttribution != nil {
+		config.CostAttribution = ext.CostAttribution
+	}
+
 	return &config, nil
 }
 
@@ -163,6 +167,11 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 		Parameters:   found.GetRecipeProperties().Parameters,
 		TemplatePath: *found.GetRecipeProperties().TemplatePath,
 	}
+	for _, resourceType := range found.GetRecipeProperties().AllowedOutputResourceTypes {
+		if resourceType != nil {
+			definition.AllowedOutputResourceTypes = append(definition.AllowedOutputResourceTypes, *resourceType)
+		}
+	}
 	switch c := found.(type) {
 	case *v20231001preview.TerraformRecipeProperties:
 		definition.TemplateVersion = *c.TemplateVersion
@@ -170,7 +179,36 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 		if c.PlainHTTP != nil {
 			definition.PlainHTTP = *c.PlainHTTP
 		}
+	case *v20231001preview.ExternalRecipeProperties:
+		if c.Driver != nil {
+			definition.ExternalDriver = *c.Driver
+		}
+	}
+
+	if err := recipes.ValidateTemplateSource(getRecipePolicy(environment), definition.Driver, definition.TemplatePath); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeSourceNotAllowed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
 
 	return definition, nil
 }
+
+// getRecipePolicy returns the recipe policy configured on the environment.
+func getRecipePolicy(environment *v20231001preview.EnvironmentResource) datamodel.RecipePolicyProperties {
+	policy := datamodel.RecipePolicyProperties{}
+	if environment.Properties.RecipeConfig == nil || environment.Properties.RecipeConfig.Policy == nil {
+		return policy
+	}
+
+	for _, source := range environment.Properties.RecipeConfig.Policy.AllowedSources {
+		if source != nil {
+			policy.AllowedSources = append(policy.AllowedSources, *source)
+		}
+	}
+	for _, source := range environment.Properties.RecipeConfig.Policy.DeniedSources {
+		if source != nil {
+			policy.DeniedSources = append(policy.DeniedSources, *source)
+		}
+	}
+
+	return policy
+}
@@ -208,6 +208,36 @@ func TestGetConfiguration(t *testing.T) {
 				Simulated: true,
 			},
 		},
+		{
+			name: "env with cost attribution",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					Extensions: []model.ExtensionClassification{
+						&model.CostAttributionExtension{
+							Kind: to.Ptr("costAttribution"),
+							Tags: map[string]*string{"owner": to.Ptr("team-a")},
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            "default",
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				CostAttribution: &datamodel.CostAttributionExtension{
+					Tags: map[string]string{"owner": "team-a"},
+				},
+			},
+		},
 		{
 			name: "invalid app resource",
 			envResource: &model.EnvironmentResource{
@@ -298,9 +328,10 @@ func TestGetRecipeDefinition(t *testing.T) {
 						},
 					},
 					"mongo": &model.BicepRecipeProperties{
-						TemplateKind: to.Ptr(recipes.TemplateKindBicep),
-						TemplatePath: to.Ptr("localhost:8000/recipes/mongodatabases:1.0"),
-						PlainHTTP:    to.Ptr(true),
+						TemplateKind:               to.Ptr(recipes.TemplateKindBicep),
+						TemplatePath:               to.Ptr("localhost:8000/recipes/mongodatabases:1.0"),
+						PlainHTTP:                  to.Ptr(true),
+						AllowedOutputResourceTypes: to.SliceOfPtrs("apps/Deployment", "core/Service"),
 					},
 					terraformRecipe: &model.TerraformRecipeProperties{
 						TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
@@ -355,11 +386,12 @@ func TestGetRecipeDefinition(t *testing.T) {
 			ResourceID:    mongoResourceID,
 		}
 		expected := recipes.EnvironmentDefinition{
-			Name:         "mongo",
-			Driver:       recipes.TemplateKindBicep,
-			ResourceType: "Applications.Datastores/mongoDatabases",
-			TemplatePath: "localhost:8000/recipes/mongodatabases:1.0",
-			PlainHTTP:    true,
+			Name:                       "mongo",
+			Driver:                     recipes.TemplateKindBicep,
+			ResourceType:               "Applications.Datastores/mongoDatabases",
+			TemplatePath:               "localhost:8000/recipes/mongodatabases:1.0",
+			PlainHTTP:                  true,
+			AllowedOutputResourceTypes: []string{"apps/Deployment", "core/Service"},
 		}
 		recipeDef, err := getRecipeDefinition(&envResource, &metadata)
 		require.NoError(t, err)
@@ -378,6 +410,24 @@ func TestGetRecipeDefinition(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, recipeDef, &expected)
 	})
+	t.Run("template source denied by recipe policy", func(t *testing.T) {
+		envResourcePolicy := envResource
+		envResourcePolicy.Properties = &model.EnvironmentProperties{
+			Recipes: envResource.Properties.Recipes,
+			RecipeConfig: &model.RecipeConfigProperties{
+				Policy: &model.RecipePolicyProperties{
+					AllowedSources: to.SliceOfPtrs("ghcr.io/radius-project"),
+				},
+			},
+		}
+		recipeMetadata.Name = terraformRecipe
+		_, err := getRecipeDefinition(&envResourcePolicy, &recipeMetadata)
+		require.Error(t, err)
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeSourceNotAllowed, recipeError.ErrorDetails.Code)
+		require.Contains(t, err.Error(), "is not in the allowed sources of the environment recipe policy")
+	})
 	t.Run("no recipes registered to the environment", func(t *testing.T) {
 		envResourceNilRecipe := envResource
 		envResourceNilRecipe.Properties.Recipes = nil
@@ -18,20 +18,31 @@ package controllerconfig
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/rp/health"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 )
 
+const (
+	// recipeRetryMaxAttempts is the maximum number of executions of a recipe which fails because of a transient cloud error.
+	recipeRetryMaxAttempts = 3
+
+	// recipeRetryDelay is the delay before the first retry of a recipe execution.
+	recipeRetryDelay = 15 * time.Second
+)
+
 // RecipeControllerConfig is the configuration for the controllers which uses recipe.
 type RecipeControllerConfig struct {
 	// Kubernetes provides access to the Kubernetes clients.
@@ -51,6 +62,13 @@ type RecipeControllerConfig struct {
 
 	// UCPConnection is the connection to UCP
 	UCPConnection *sdk.Connection
+
+	// HealthRegistry holds the health evaluators registered by the resource processors.
+	HealthRegistry *health.Registry
+
+	// NamingStrategy generates the Kubernetes names which must fit the maximum name length, such as the namespaces of
+	// applications.
+	NamingStrategy kubernetes.NamingStrategy
 }
 
 // New creates a new RecipeControllerConfig instance with the given host options.
@@ -61,6 +79,12 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 	cfg.Kubernetes = kubernetesclientprovider.FromConfig(options.K8sConfig)
 
 	cfg.UCPConnection = &options.UCPConnection
+	cfg.HealthRegistry = health.NewRegistry()
+
+	cfg.NamingStrategy, err = kubernetes.NewNamingStrategy(options.Config.Naming.MaxNameLength)
+	if err != nil {
+		return nil, err
+	}
 
 	cfg.ResourceClient = processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.Kubernetes)
 	clientOptions := sdk.NewClientOptions(options.UCPConnection)
@@ -92,6 +116,20 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	externalDrivers := map[string]driver.ExternalOptions{}
+	for name, external := range options.Config.ExternalRecipeDrivers {
+		externalDrivers[name] = driver.ExternalOptions{
+			URL:       external.URL,
+			TokenFile: external.TokenFile,
+			Timeout:   external.Timeout,
+		}
+	}
+
+	externalDriver, err := driver.NewExternalDriver(externalDrivers)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
@@ -108,8 +146,16 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 			),
 			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, secretprovider.NewSecretProvider(options.Config.SecretProvider),
 				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
+					Path:      options.Config.Terraform.Path,
+					Binary:    options.Config.Terraform.Binary,
+					Version:   options.Config.Terraform.Version,
+					SourceURL: options.Config.Terraform.SourceURL,
 				}, *cfg.Kubernetes),
+			recipes.TemplateKindExternal: externalDriver,
+		},
+		Retry: engine.RetryOptions{
+			MaxAttempts: recipeRetryMaxAttempts,
+			Delay:       recipeRetryDelay,
 		},
 	})
 
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-getter"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// s3ForcedGetter is the prefix of Terraform module sources downloaded from Amazon S3 buckets.
+	s3ForcedGetter = "s3::"
+
+	// azureBlobHostSuffix is the host suffix of Azure Blob Storage accounts.
+	azureBlobHostSuffix = ".blob.core.windows.net"
+
+	// redactedCredential replaces the credentials of an archive module source in error messages.
+	redactedCredential = "REDACTED"
+)
+
+// archiveExtensions are the extensions of the archives that Terraform extracts when downloading a module source.
+var archiveExtensions = []string{".zip", ".tar.gz", ".tgz", ".tar.bz2", ".tbz2", ".tar.xz", ".txz"}
+
+// archiveSource is the module source of a Terraform module packaged as an archive, with credential information added.
+type archiveSource struct {
+	// moduleSource is the module source including the credentials. It is empty if no credentials are configured
+	// for the template path of the recipe.
+	moduleSource string
+
+	// credentials are the values added to the module source, which must not be exposed in error messages.
+	credentials []string
+}
+
+// redact replaces the credentials of the archive module source in the given message.
+func (s archiveSource) redact(message string) string {
+	for _, credential := range s.credentials {
+		if credential != "" {
+			message = strings.ReplaceAll(message, credential, redactedCredential)
+		}
+	}
+
+	return message
+}
+
+// GetArchiveURL returns the URL of a Terraform module source packaged as an archive and whether the archive is stored in
+// an Amazon S3 bucket. It returns a nil URL if the template path is not an archive module source.
+// s3::https://s3.amazonaws.com/bucket/module.zip -> https://s3.amazonaws.com/bucket/module.zip, true
+// https://example.com/module.tar.gz//modules/redis -> https://example.com/module.tar.gz//modules/redis, false
+func GetArchiveURL(templatePath string) (*url.URL, bool, error) {
+	isS3 := strings.HasPrefix(templatePath, s3ForcedGetter)
+	archiveURL := strings.TrimPrefix(templatePath, s3ForcedGetter)
+	if !isS3 && !strings.HasPrefix(archiveURL, "https://") && !strings.HasPrefix(archiveURL, "http://") {
+		return nil, false, nil
+	}
+
+	url, err := url.Parse(archiveURL)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse archive url %s : %w", archiveURL, err)
+	}
+
+	// Terraform module sources in S3 buckets are always archives. HTTP sources are archives if the path has an
+	// archive extension or the archive type is set with the 'archive' query parameter.
+	if !isS3 && !isArchivePath(url.Path) && !url.Query().Has("archive") {
+		return nil, false, nil
+	}
+
+	return url, isS3, nil
+}
+
+// isArchivePath returns true if the path, excluding the module subdirectory, has an archive extension.
+func isArchivePath(path string) bool {
+	path, _ = getter.SourceDirSubdir(path)
+	path = strings.ToLower(path)
+	for _, ext := range archiveExtensions {
+		if strings.HasSuffix(path, ext) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getArchiveSecretKeys returns the keys of the secrets used to download the archive of the given template path.
+func getArchiveSecretKeys(templatePath string) ([]string, error) {
+	url, isS3, err := GetArchiveURL(templatePath)
+	if err != nil || url == nil {
+		return nil, err
+	}
+
+	switch {
+	case isS3:
+		return []string{ArchiveSecretKey_AccessKeyID, ArchiveSecretKey_SecretAccessKey}, nil
+	case strings.HasSuffix(url.Hostname(), azureBlobHostSuffix):
+		return []string{ArchiveSecretKey_SASToken}, nil
+	default:
+		return []string{ArchiveSecretKey_Username, ArchiveSecretKey_Password}, nil
+	}
+}
+
+// addSecretsToArchiveSource returns the module source of a Terraform module packaged as an archive with the credentials
+// added in the format expected by the getter of the source:
+// - Amazon S3: s3::https://s3.amazonaws.com/bucket/module.zip?aws_access_key_id=<accessKeyId>&aws_access_key_secret=<secretAccessKey>
+// - Azure Blob Storage: https://<account>.blob.core.windows.net/container/module.zip?<sasToken>
+// - HTTP: https://<username>:<password>@example.com/module.zip
+func addSecretsToArchiveSource(secrets map[string]string, templatePath string) (archiveSource, error) {
+	archiveURL, isS3, err := GetArchiveURL(templatePath)
+	if err != nil || archiveURL == nil || len(secrets) == 0 {
+		return archiveSource{}, err
+	}
+
+	source := archiveSource{}
+	switch {
+	case isS3:
+		query := archiveURL.Query()
+		query.Set("aws_access_key_id", secrets[ArchiveSecretKey_AccessKeyID])
+		query.Set("aws_access_key_secret", secrets[ArchiveSecretKey_SecretAccessKey])
+		archiveURL.RawQuery = query.Encode()
+		source.moduleSource = s3ForcedGetter + archiveURL.String()
+		source.addCredentials(secrets[ArchiveSecretKey_AccessKeyID], secrets[ArchiveSecretKey_SecretAccessKey])
+
+	case strings.HasSuffix(archiveURL.Hostname(), azureBlobHostSuffix):
+		sasToken, err := url.ParseQuery(strings.TrimPrefix(secrets[ArchiveSecretKey_SASToken], "?"))
+		if err != nil {
+			return archiveSource{}, fmt.Errorf("failed to parse the SAS token of archive url %s : %w", archiveURL.Redacted(), err)
+		}
+
+		query := archiveURL.Query()
+		for key, values := range sasToken {
+			query[key] = values
+		}
+		archiveURL.RawQuery = query.Encode()
+		source.moduleSource = archiveURL.String()
+		source.addCredentials(sasToken.Get("sig"))
+
+	default:
+		archiveURL.User = url.UserPassword(secrets[ArchiveSecretKey_Username], secrets[ArchiveSecretKey_Password])
+		source.moduleSource = archiveURL.String()
+		source.addCredentials(secrets[ArchiveSecretKey_Password])
+	}
+
+	return source, nil
+}
+
+// addCredentials adds the given credential values, including their URL encoded forms, to the credentials to redact.
+func (s *archiveSource) addCredentials(credentials ...string) {
+	for _, credential := range credentials {
+		s.credentials = append(s.credentials, credential, url.QueryEscape(credential), url.PathEscape(credential))
+	}
+}
+
+// getArchiveSourceIfApplicable returns the module source with credential information for a Terraform module packaged as
+// an archive, if the environment has authentication configured for the host of the archive.
+// It is a wrapper function to addSecretsToArchiveSource()
+func getArchiveSourceIfApplicable(envConfig recipes.Configuration, secretData map[string]recipes.SecretData, templatePath string) (archiveSource, error) {
+	secretStoreID, err := GetArchiveSecretStoreID(envConfig, templatePath)
+	if err != nil {
+		return archiveSource{}, err
+	}
+
+	if secretStoreID == "" || secretData == nil {
+		return archiveSource{}, nil
+	}
+
+	secrets, ok := secretData[secretStoreID]
+	if !ok {
+		return archiveSource{}, fmt.Errorf("secrets not found for secret store ID %q", secretStoreID)
+	}
+
+	return addSecretsToArchiveSource(secrets.Data, templatePath)
+}
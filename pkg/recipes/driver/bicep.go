@@ -20,16 +20,20 @@ import (
 	"context"
 	"fmt"
 	reflect "reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 	"oras.land/oras-go/v2/registry/remote"
 
 	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/components/trace"
 	coredm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
@@ -53,6 +57,7 @@ const (
 )
 
 var _ Driver = (*bicepDriver)(nil)
+var _ DriverWithPlan = (*bicepDriver)(nil)
 
 // NewBicepDriver creates a new bicep driver instance with the given ARM client options, deployment client, resource client, and options.
 func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient clients.ResourceDeploymentsClient, client processors.ResourceClient, options BicepOptions) Driver {
@@ -93,15 +98,9 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 		return nil, err
 	}
 
-	registryClient := d.RegistryClient
-	// Get ORAS authentication client if secrets are found for the registry.
-	if !reflect.DeepEqual(secrets, recipes.SecretData{}) {
-		authClient, err := getRegistryAuthClient(ctx, secrets, opts.Definition.TemplatePath)
-		if err != nil {
-			return nil, err
-		}
-
-		registryClient = authClient
+	registryClient, err := d.getRegistryClient(ctx, secrets, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
 	}
 
 	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
@@ -130,7 +129,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
 
-	// Provider config will specify the Azure and AWS scopes (if provided).
+	// Provider config will specify the Azure, AWS and GCP scopes (if provided).
 	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
 
 	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
@@ -140,6 +139,9 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	if providerConfig.Az != nil {
 		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
 	}
+	if providerConfig.GCP != nil {
+		logger.Info("using GCP provider", "deploymentID", deploymentID, "scope", providerConfig.GCP.Value.Scope)
+	}
 
 	poller, err := d.DeploymentClient.CreateOrUpdate(
 		ctx,
@@ -228,6 +230,7 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 				if err != nil {
 					if attempt <= d.options.DeleteRetryCount {
 						logger.V(ucplog.LevelInfo).Error(err, "attempt failed", "delay", d.options.DeleteRetryDelaySeconds)
+						trace.AddRetryEvent(ctx, attempt+1, "delete output resource failed", err, attribute.String("resource.id", id))
 						time.Sleep(time.Duration(d.options.DeleteRetryDelaySeconds) * time.Second)
 						continue
 					}
@@ -277,15 +280,9 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (
 		return nil, err
 	}
 
-	registryClient := d.RegistryClient
-	// Get ORAS authentication client if secrets are found for the registry.
-	if !reflect.DeepEqual(secrets, recipes.SecretData{}) {
-		authClient, err := getRegistryAuthClient(ctx, secrets, opts.Definition.TemplatePath)
-		if err != nil {
-			return nil, err
-		}
-
-		registryClient = authClient
+	registryClient, err := d.getRegistryClient(ctx, secrets, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
 	}
 
 	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
@@ -296,6 +293,136 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (
 	return recipeData, nil
 }
 
+// Plan fetches recipe contents from container registry, creates the recipe parameters and a provider config like Execute,
+// and runs a what-if operation of the bicep template for the recipe using UCP deployment client. It returns the changes the
+// deployment of the recipe would make without deploying the recipe.
+func (d *bicepDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	recipeData := make(map[string]any)
+	secrets, err := util.GetRegistrySecrets(opts.Configuration, opts.Definition.TemplatePath, opts.Secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	registryClient, err := d.getRegistryClient(ctx, secrets, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+
+	logger.Info("running what-if of bicep template for recipe", "deploymentID", deploymentID)
+	poller, err := d.DeploymentClient.WhatIf(
+		ctx,
+		clients.Deployment{
+			Properties: &clients.DeploymentProperties{
+				Mode:           armresources.DeploymentModeIncremental,
+				ProviderConfig: &providerConfig,
+				Parameters:     parameters,
+				Template:       recipeData,
+			},
+		},
+		deploymentID.String(),
+		clients.DeploymentsClientAPIVersion,
+	)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &clients.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	if resp.Properties == nil {
+		return &recipes.RecipePlan{Changes: []coredm.RecipeResourceChange{}}, nil
+	}
+
+	return &recipes.RecipePlan{Changes: whatIfChanges(resp.Properties.Changes)}, nil
+}
+
+// whatIfChanges converts the changes of a what-if operation to the changes of a recipe plan. Ignored resources, which
+// are not managed by the template, are skipped.
+func whatIfChanges(whatIfChanges []*armresources.WhatIfChange) []coredm.RecipeResourceChange {
+	changes := []coredm.RecipeResourceChange{}
+	for _, wc := range whatIfChanges {
+		if wc == nil || wc.ResourceID == nil || wc.ChangeType == nil {
+			continue
+		}
+
+		change := coredm.RecipeResourceChange{
+			Name:    *wc.ResourceID,
+			Address: *wc.ResourceID,
+		}
+
+		if id, err := resources.Parse(*wc.ResourceID); err == nil && id.IsResource() {
+			change.Type = id.Type()
+			change.Name = id.Name()
+		}
+
+		switch *wc.ChangeType {
+		case armresources.ChangeTypeCreate:
+			change.Action = coredm.RecipeResourceChangeActionCreate
+		case armresources.ChangeTypeModify, armresources.ChangeTypeDeploy:
+			change.Action = coredm.RecipeResourceChangeActionUpdate
+			change.Properties = whatIfChangedProperties(wc.Delta)
+		case armresources.ChangeTypeDelete:
+			change.Action = coredm.RecipeResourceChangeActionDelete
+		case armresources.ChangeTypeNoChange:
+			change.Action = coredm.RecipeResourceChangeActionNoChange
+		case armresources.ChangeTypeIgnore:
+			continue
+		default:
+			change.Action = coredm.RecipeResourceChangeActionUnsupported
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// whatIfChangedProperties returns the sorted names of the top-level properties changed by the property changes of a
+// what-if operation.
+func whatIfChangedProperties(delta []*armresources.WhatIfPropertyChange) []string {
+	properties := []string{}
+	for _, pc := range delta {
+		if pc == nil || pc.Path == nil {
+			continue
+		}
+
+		name, _, _ := strings.Cut(*pc.Path, ".")
+		name, _, _ = strings.Cut(name, "[")
+		if name != "" && !slices.Contains(properties, name) {
+			properties = append(properties, name)
+		}
+	}
+	slices.Sort(properties)
+
+	return properties
+}
+
 func hasContextParameter(recipeData map[string]any) bool {
 	parametersAny, ok := recipeData[recipeParameters]
 	if !ok {
@@ -364,6 +491,15 @@ func newProviderConfig(resourceGroup string, envProviders coredm.Providers) clie
 		}
 	}
 
+	if envProviders.GCP != (coredm.ProvidersGCP{}) {
+		config.GCP = &clients.GCP{
+			Type: clients.ProviderTypeGCP,
+			Value: clients.Value{
+				Scope: envProviders.GCP.Scope,
+			},
+		}
+	}
+
 	return config
 }
 
@@ -447,6 +583,20 @@ func (d *bicepDriver) FindSecretIDs(ctx context.Context, envConfig recipes.Confi
 	return secretStoreIDResourceKeys, err
 }
 
+// getRegistryClient returns the client used to read recipes from the registry. It returns an ORAS authentication
+// client if secrets are found for the registry, and a client for anonymous access otherwise.
+func (d *bicepDriver) getRegistryClient(ctx context.Context, secrets recipes.SecretData, templatePath string) (remote.Client, error) {
+	if !reflect.DeepEqual(secrets, recipes.SecretData{}) {
+		return getRegistryAuthClient(ctx, secrets, templatePath)
+	}
+
+	if d.RegistryClient != nil {
+		return d.RegistryClient, nil
+	}
+
+	return authclient.NewAnonymousClient()
+}
+
 func getRegistryAuthClient(ctx context.Context, secrets recipes.SecretData, templatePath string) (remote.Client, error) {
 	newRegistryClient, err := authclient.GetNewRegistryAuthClient(secrets)
 	if err != nil {
@@ -244,9 +244,11 @@ func Test_createProviderConfig_defaults(t *testing.T) {
 func Test_createProviderConfig_hasProviders(t *testing.T) {
 	aws := "/planes/aws/aws/accounts/000/regions/cool-region"
 	azure := "/subscriptions/000/resourceGroups/cool-azure-group"
+	gcp := "/planes/gcp/gcp/projects/cool-project/regions/us-central1"
 	providers := corerp_datamodel.Providers{
 		Azure: corerp_datamodel.ProvidersAzure{Scope: azure},
 		AWS:   corerp_datamodel.ProvidersAWS{Scope: aws},
+		GCP:   corerp_datamodel.ProvidersGCP{Scope: gcp},
 	}
 
 	expected := clients.NewDefaultProviderConfig("test-rg")
@@ -258,6 +260,10 @@ func Test_createProviderConfig_hasProviders(t *testing.T) {
 		Type:  clients.ProviderTypeAWS,
 		Value: clients.Value{Scope: aws},
 	}
+	expected.GCP = &clients.GCP{
+		Type:  clients.ProviderTypeGCP,
+		Value: clients.Value{Scope: gcp},
+	}
 	actual := newProviderConfig("test-rg", providers)
 	require.Equal(t, expected, actual)
 }
@@ -586,3 +592,71 @@ func Test_Bicep_Delete_Success_AfterRetry(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_Bicep_WhatIfChanges(t *testing.T) {
+	whatIfResult := []*armresources.WhatIfChange{
+		{
+			ChangeType: to.Ptr(armresources.ChangeTypeCreate),
+			ResourceID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/cache"),
+		},
+		{
+			ChangeType: to.Ptr(armresources.ChangeTypeModify),
+			ResourceID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/storage"),
+			Delta: []*armresources.WhatIfPropertyChange{
+				{Path: to.Ptr("tags.env")},
+				{Path: to.Ptr("properties.minimumTlsVersion")},
+				{Path: to.Ptr("properties.networkAcls[0].action")},
+			},
+		},
+		{
+			ChangeType: to.Ptr(armresources.ChangeTypeDelete),
+			ResourceID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"),
+		},
+		{
+			ChangeType: to.Ptr(armresources.ChangeTypeNoChange),
+			ResourceID: to.Ptr("/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/bucket"),
+		},
+		{
+			ChangeType: to.Ptr(armresources.ChangeTypeIgnore),
+			ResourceID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/other"),
+		},
+		{
+			ChangeType: to.Ptr(armresources.ChangeTypeUnsupported),
+			ResourceID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg"),
+		},
+	}
+
+	expected := []corerp_datamodel.RecipeResourceChange{
+		{
+			Action:  corerp_datamodel.RecipeResourceChangeActionCreate,
+			Type:    "Microsoft.Cache/redis",
+			Name:    "cache",
+			Address: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/cache",
+		},
+		{
+			Action:     corerp_datamodel.RecipeResourceChangeActionUpdate,
+			Type:       "Microsoft.Storage/storageAccounts",
+			Name:       "storage",
+			Address:    "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/storage",
+			Properties: []string{"properties", "tags"},
+		},
+		{
+			Action:  corerp_datamodel.RecipeResourceChangeActionDelete,
+			Type:    "core/Service",
+			Name:    "redis",
+			Address: "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+		},
+		{
+			Action:  corerp_datamodel.RecipeResourceChangeActionNoChange,
+			Type:    "AWS.S3/Bucket",
+			Name:    "bucket",
+			Address: "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/bucket",
+		},
+		{
+			Action:  corerp_datamodel.RecipeResourceChangeActionUnsupported,
+			Name:    "/subscriptions/test-sub/resourceGroups/test-rg",
+			Address: "/subscriptions/test-sub/resourceGroups/test-rg",
+		},
+	}
+	require.Equal(t, expected, whatIfChanges(whatIfResult))
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/httpclient"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// ExternalExecutePath is the path of the endpoint of an external recipe driver which deploys a recipe.
+	ExternalExecutePath = "/execute"
+
+	// ExternalDeletePath is the path of the endpoint of an external recipe driver which deletes the resources of a recipe.
+	ExternalDeletePath = "/delete"
+
+	// ExternalMetadataPath is the path of the endpoint of an external recipe driver which returns the metadata of a recipe.
+	ExternalMetadataPath = "/metadata"
+
+	// defaultExternalTimeout is the default timeout of a request to an external recipe driver.
+	defaultExternalTimeout = 30 * time.Minute
+
+	// maxExternalErrorLength is the maximum length of an error response of an external recipe driver included in the
+	// error message of the recipe.
+	maxExternalErrorLength = 4096
+)
+
+var _ Driver = (*externalDriver)(nil)
+
+// ExternalOptions represents the options of an external recipe driver.
+type ExternalOptions struct {
+	// URL is the base URL of the endpoint implementing the external recipe driver.
+	URL string
+
+	// TokenFile is the path to a file containing a token sent as a bearer token in the Authorization header of each
+	// request. The file is read for every request so that the token can be rotated. No token is sent when not set.
+	TokenFile string
+
+	// Timeout is the timeout of a request to the endpoint. Defaults to 30 minutes.
+	Timeout time.Duration
+}
+
+// ExternalRecipe is the recipe sent to an external recipe driver.
+type ExternalRecipe struct {
+	// Name is the name of the recipe registered to the environment.
+	Name string `json:"name"`
+
+	// ResourceType is the type of the resource this recipe is consumed by.
+	ResourceType string `json:"resourceType"`
+
+	// TemplatePath is the template path of the recipe, its meaning is defined by the external recipe driver.
+	TemplatePath string `json:"templatePath"`
+
+	// Parameters are the parameters of the recipe. Parameters set by the resource take precedence over
+	// parameters set by the environment.
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// ExternalExecuteRequest is the request body sent to an external recipe driver to deploy a recipe.
+type ExternalExecuteRequest struct {
+	// Recipe is the recipe to deploy.
+	Recipe ExternalRecipe `json:"recipe"`
+
+	// Context is the recipe context, describing the resource, application and environment of the recipe.
+	Context *recipecontext.Context `json:"context"`
+
+	// PreviousResources are the IDs of the resources deployed by the previous deployment of the recipe. The external
+	// recipe driver is responsible for deleting the previous resources which are no longer needed.
+	PreviousResources []string `json:"previousResources,omitempty"`
+}
+
+// ExternalDeleteRequest is the request body sent to an external recipe driver to delete the resources of a recipe.
+type ExternalDeleteRequest struct {
+	// Recipe is the recipe to delete.
+	Recipe ExternalRecipe `json:"recipe"`
+
+	// Context is the recipe context, describing the resource, application and environment of the recipe.
+	Context *recipecontext.Context `json:"context"`
+
+	// Resources are the IDs of the resources deployed by the recipe.
+	Resources []string `json:"resources,omitempty"`
+}
+
+// ExternalMetadataRequest is the request body sent to an external recipe driver to get the metadata of a recipe.
+type ExternalMetadataRequest struct {
+	// Recipe is the recipe to get the metadata of.
+	Recipe ExternalRecipe `json:"recipe"`
+}
+
+// NewExternalDriver creates a new instance of driver to execute recipes using external recipe drivers. drivers is
+// a map of the name of the external recipe driver, referenced by recipes, to its options.
+//
+// An external recipe driver is an HTTP endpoint receiving JSON POST requests on the following paths:
+//   - /execute receives an ExternalExecuteRequest and returns the recipe output: an object with the 'resources',
+//     'values' and 'secrets' properties, the same as the 'result' output of Bicep and Terraform recipes.
+//   - /delete receives an ExternalDeleteRequest and returns no content.
+//   - /metadata receives an ExternalMetadataRequest and returns an object with the 'parameters' of the recipe.
+//
+// Any response with a status code other than 2xx is treated as a failure.
+func NewExternalDriver(drivers map[string]ExternalOptions) (Driver, error) {
+	client, err := httpclient.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &externalDriver{drivers: drivers, client: client}, nil
+}
+
+// externalDriver represents a driver to execute recipes by calling external recipe drivers.
+type externalDriver struct {
+	drivers map[string]ExternalOptions
+	client  *http.Client
+}
+
+// Execute sends the recipe to the external recipe driver for deployment and returns the recipe output.
+func (d *externalDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	request := ExternalExecuteRequest{
+		Recipe:            externalRecipe(opts.Recipe, opts.Definition),
+		Context:           recipeContext,
+		PreviousResources: opts.PrevState,
+	}
+
+	result := map[string]any{}
+	err = d.call(ctx, opts.Definition.ExternalDriver, ExternalExecutePath, request, &result)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	output := &recipes.RecipeOutput{}
+	err = output.PrepareRecipeResponse(result)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the output of external recipe driver %q: %s", opts.Definition.ExternalDriver, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	output.Status = &rpv1.RecipeStatus{
+		TemplateKind: recipes.TemplateKindExternal,
+		TemplatePath: opts.Definition.TemplatePath,
+	}
+
+	return output, nil
+}
+
+// Delete requests the external recipe driver to delete the output resources of the recipe.
+func (d *externalDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	request := ExternalDeleteRequest{
+		Recipe:  externalRecipe(opts.Recipe, opts.Definition),
+		Context: recipeContext,
+	}
+	for _, outputResource := range opts.OutputResources {
+		request.Resources = append(request.Resources, outputResource.ID.String())
+	}
+
+	err = d.call(ctx, opts.Definition.ExternalDriver, ExternalDeletePath, request, nil)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata requests the parameters of the recipe from the external recipe driver.
+func (d *externalDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	request := ExternalMetadataRequest{
+		Recipe: externalRecipe(opts.Recipe, opts.Definition),
+	}
+
+	metadata := map[string]any{}
+	err := d.call(ctx, opts.Definition.ExternalDriver, ExternalMetadataPath, request, &metadata)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	parameters, ok := metadata[recipeParameters].(map[string]any)
+	if !ok {
+		parameters = map[string]any{}
+	}
+
+	return map[string]any{
+		recipeParameters: parameters,
+	}, nil
+}
+
+// call sends the request to the given path of the external recipe driver and decodes the response into result.
+func (d *externalDriver) call(ctx context.Context, name string, path string, request any, result any) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	options, ok := d.drivers[name]
+	if !ok {
+		return fmt.Errorf("external recipe driver %q is not registered", name)
+	}
+
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = defaultExternalTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	url := strings.TrimSuffix(options.URL, "/") + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request to external recipe driver %q: %w", name, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	if options.TokenFile != "" {
+		token, err := os.ReadFile(options.TokenFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the token of external recipe driver %q: %w", name, err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	logger.Info(fmt.Sprintf("Calling external recipe driver %q at %q", name, url))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call external recipe driver %q: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxExternalErrorLength))
+		return fmt.Errorf("external recipe driver %q returned status code %d: %s", name, resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(result)
+	if err != nil {
+		return fmt.Errorf("failed to read the response of external recipe driver %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// externalRecipe creates the recipe sent to the external recipe driver, merging the parameters of the environment and the resource.
+func externalRecipe(recipe recipes.ResourceMetadata, definition recipes.EnvironmentDefinition) ExternalRecipe {
+	parameters := map[string]any{}
+	maps.Copy(parameters, definition.Parameters)
+	maps.Copy(parameters, recipe.Parameters)
+
+	return ExternalRecipe{
+		Name:         definition.Name,
+		ResourceType: definition.ResourceType,
+		TemplatePath: definition.TemplatePath,
+		Parameters:   parameters,
+	}
+}
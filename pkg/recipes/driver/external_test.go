@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	externalTestResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+	externalTestEnvID      = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+)
+
+func externalTestOptions() BaseOptions {
+	return BaseOptions{
+		Configuration: recipes.Configuration{
+			Runtime: recipes.RuntimeConfiguration{
+				Kubernetes: &recipes.KubernetesRuntime{
+					Namespace:            "default",
+					EnvironmentNamespace: "env0",
+				},
+			},
+		},
+		Recipe: recipes.ResourceMetadata{
+			Name:          "default",
+			ResourceID:    externalTestResourceID,
+			EnvironmentID: externalTestEnvID,
+			Parameters: map[string]any{
+				"port": 6380,
+			},
+		},
+		Definition: recipes.EnvironmentDefinition{
+			Name:           "default",
+			Driver:         recipes.TemplateKindExternal,
+			ExternalDriver: "test",
+			ResourceType:   "Applications.Datastores/redisCaches",
+			TemplatePath:   "redis",
+			Parameters: map[string]any{
+				"port": 6379,
+				"size": "small",
+			},
+		},
+	}
+}
+
+// newExternalTestServer starts a test server recording the requests sent to it and replying with the given status and body.
+func newExternalTestServer(t *testing.T, status int, body string) (*httptest.Server, map[string]*http.Request, map[string]map[string]any) {
+	requests := map[string]*http.Request{}
+	bodies := map[string]map[string]any{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path] = r
+		decoded := map[string]any{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&decoded))
+		bodies[r.URL.Path] = decoded
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests, bodies
+}
+
+func Test_External_Execute(t *testing.T) {
+	server, requests, bodies := newExternalTestServer(t, http.StatusOK, `{
+		"resources": ["/planes/kubernetes/local/namespaces/env0/providers/core/Service/redis"],
+		"values": {"host": "redis.env0.svc.cluster.local", "port": 6380},
+		"secrets": {"password": "secret"}
+	}`)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("test-token\n"), 0600))
+
+	d, err := NewExternalDriver(map[string]ExternalOptions{"test": {URL: server.URL + "/", TokenFile: tokenFile}})
+	require.NoError(t, err)
+
+	opts := externalTestOptions()
+	result, err := d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: opts, PrevState: []string{"/planes/kubernetes/local/namespaces/env0/providers/core/Service/old"}})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Resources: []string{"/planes/kubernetes/local/namespaces/env0/providers/core/Service/redis"},
+		Values:    map[string]any{"host": "redis.env0.svc.cluster.local", "port": float64(6380)},
+		Secrets:   map[string]any{"password": "secret"},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindExternal,
+			TemplatePath: "redis",
+		},
+	}
+	require.Equal(t, expected, result)
+
+	req := requests[ExternalExecutePath]
+	require.NotNil(t, req)
+	require.Equal(t, http.MethodPost, req.Method)
+	require.Equal(t, "Bearer test-token", req.Header.Get("Authorization"))
+
+	body := bodies[ExternalExecutePath]
+	recipe := body["recipe"].(map[string]any)
+	require.Equal(t, "default", recipe["name"])
+	require.Equal(t, "redis", recipe["templatePath"])
+	require.Equal(t, map[string]any{"port": float64(6380), "size": "small"}, recipe["parameters"])
+	require.Equal(t, []any{"/planes/kubernetes/local/namespaces/env0/providers/core/Service/old"}, body["previousResources"])
+	require.NotNil(t, body["context"])
+}
+
+func Test_External_Execute_Failures(t *testing.T) {
+	t.Run("error status", func(t *testing.T) {
+		server, _, _ := newExternalTestServer(t, http.StatusBadRequest, "invalid recipe")
+		d, err := NewExternalDriver(map[string]ExternalOptions{"test": {URL: server.URL}})
+		require.NoError(t, err)
+
+		_, err = d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: externalTestOptions()})
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+		require.Equal(t, `external recipe driver "test" returned status code 400: invalid recipe`, recipeError.ErrorDetails.Message)
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		server, _, _ := newExternalTestServer(t, http.StatusOK, `{"values": {"host": "redis"}, "unknown": true}`)
+		d, err := NewExternalDriver(map[string]ExternalOptions{"test": {URL: server.URL}})
+		require.NoError(t, err)
+
+		_, err = d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: externalTestOptions()})
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+	})
+
+	t.Run("unregistered driver", func(t *testing.T) {
+		d, err := NewExternalDriver(map[string]ExternalOptions{})
+		require.NoError(t, err)
+
+		_, err = d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: externalTestOptions()})
+		recipeError, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, `external recipe driver "test" is not registered`, recipeError.ErrorDetails.Message)
+	})
+
+	t.Run("missing token file", func(t *testing.T) {
+		server, _, _ := newExternalTestServer(t, http.StatusOK, `{}`)
+		d, err := NewExternalDriver(map[string]ExternalOptions{"test": {URL: server.URL, TokenFile: filepath.Join(t.TempDir(), "missing")}})
+		require.NoError(t, err)
+
+		_, err = d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: externalTestOptions()})
+		require.ErrorContains(t, err, `failed to read the token of external recipe driver "test"`)
+	})
+}
+
+func Test_External_Delete(t *testing.T) {
+	server, requests, bodies := newExternalTestServer(t, http.StatusNoContent, "")
+	d, err := NewExternalDriver(map[string]ExternalOptions{"test": {URL: server.URL}})
+	require.NoError(t, err)
+
+	err = d.Delete(testcontext.New(t), DeleteOptions{
+		BaseOptions: externalTestOptions(),
+		OutputResources: []rpv1.OutputResource{
+			{ID: resources.MustParse("/planes/kubernetes/local/namespaces/env0/providers/core/Service/redis")},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, requests[ExternalDeletePath])
+	require.Empty(t, requests[ExternalDeletePath].Header.Get("Authorization"))
+	require.Equal(t, []any{"/planes/kubernetes/local/namespaces/env0/providers/core/Service/redis"}, bodies[ExternalDeletePath]["resources"])
+}
+
+func Test_External_Delete_Failure(t *testing.T) {
+	server, _, _ := newExternalTestServer(t, http.StatusInternalServerError, "boom")
+	d, err := NewExternalDriver(map[string]ExternalOptions{"test": {URL: server.URL}})
+	require.NoError(t, err)
+
+	err = d.Delete(testcontext.New(t), DeleteOptions{BaseOptions: externalTestOptions()})
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeletionFailed, recipeError.ErrorDetails.Code)
+}
+
+func Test_External_GetRecipeMetadata(t *testing.T) {
+	server, requests, _ := newExternalTestServer(t, http.StatusOK, `{"parameters": {"size": {"type": "string"}}}`)
+	d, err := NewExternalDriver(map[string]ExternalOptions{"test": {URL: server.URL}})
+	require.NoError(t, err)
+
+	metadata, err := d.GetRecipeMetadata(testcontext.New(t), externalTestOptions())
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"parameters": map[string]any{"size": map[string]any{"type": "string"}}}, metadata)
+	require.NotNil(t, requests[ExternalMetadataPath])
+}
@@ -29,6 +29,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"golang.org/x/exp/slices"
 
@@ -46,6 +47,7 @@ import (
 )
 
 var _ Driver = (*terraformDriver)(nil)
+var _ DriverWithPlan = (*terraformDriver)(nil)
 
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
 func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *secretprovider.SecretProvider, options TerraformOptions, kubernetesClients kubernetesclientprovider.KubernetesClientProvider) Driver {
@@ -59,6 +61,16 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *secretprovider.S
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// Binary is the Terraform compatible binary used to execute recipes, either "terraform" or "opentofu".
+	// Defaults to "terraform".
+	Binary string
+
+	// Version is the version of the binary to install. Defaults to the latest version.
+	Version string
+
+	// SourceURL is an optional base URL to download the binary from instead of the official release site.
+	SourceURL string
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -85,33 +97,30 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 		}
 	}()
 
-	// Get the secret store ID associated with the git private terraform repository source.
-	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
-	if err != nil {
-		return nil, err
-	}
-
-	// Add credential information to .gitconfig for module source of type git if applicable.
-	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	// Add credential information to the module source of type git or archive if applicable.
+	archive, unsetGitConfig, err := addModuleSourceCredentials(opts.BaseOptions, requestDirPath)
 	if err != nil {
 		return nil, err
 	}
 
 	tfState, err := d.terraformExecutor.Deploy(ctx, terraform.Options{
-		RootDir:        requestDirPath,
-		EnvConfig:      &opts.Configuration,
-		ResourceRecipe: &opts.Recipe,
-		EnvRecipe:      &opts.Definition,
-		Secrets:        opts.Secrets,
+		RootDir:         requestDirPath,
+		Install:         d.installOptions(),
+		EnvConfig:       &opts.Configuration,
+		ResourceRecipe:  &opts.Recipe,
+		EnvRecipe:       &opts.Definition,
+		ModuleSource:    archive.moduleSource,
+		Secrets:         opts.Secrets,
+		OnApplyProgress: applyProgressReporter(ctx),
 	})
 
-	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	unsetError := unsetGitConfig()
 	if unsetError != nil {
 		return nil, unsetError
 	}
 
 	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, archive.redact(err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
 	recipeOutputs, err := d.prepareRecipeResponse(ctx, opts.BaseOptions.Definition, tfState)
@@ -122,6 +131,29 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 	return recipeOutputs, nil
 }
 
+// applyProgressReporter returns a callback which translates Terraform apply progress into async operation
+// progress updates. It returns nil if the context does not belong to an async operation.
+func applyProgressReporter(ctx context.Context) terraform.ApplyProgressFunc {
+	reporter := v1.OperationProgressReporterFromContext(ctx)
+	if reporter == nil {
+		return nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	return func(progress terraform.ApplyProgress) {
+		err := reporter(ctx, v1.OperationProgress{
+			CurrentStep: progress.CurrentResource,
+			InProgress:  progress.Applying,
+			Completed:   progress.Applied,
+			Failed:      progress.Errored,
+		})
+		if err != nil {
+			// Progress is informational only, failing to report it should not fail the recipe deployment.
+			logger.Error(err, "Failed to report Terraform apply progress")
+		}
+	}
+}
+
 // Delete returns an error if called as it is not yet implemented.
 func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -136,37 +168,141 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 		}
 	}()
 
-	// Get the secret store ID associated with the git private terraform repository source.
-	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
-	if err != nil {
-		return err
-	}
-
-	// Add credential information to .gitconfig for module source of type git if applicable.
-	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	// Add credential information to the module source of type git or archive if applicable.
+	archive, unsetGitConfig, err := addModuleSourceCredentials(opts.BaseOptions, requestDirPath)
 	if err != nil {
 		return err
 	}
 
 	err = d.terraformExecutor.Delete(ctx, terraform.Options{
 		RootDir:        requestDirPath,
+		Install:        d.installOptions(),
 		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
+		ModuleSource:   archive.moduleSource,
 	})
 
-	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	unsetError := unsetGitConfig()
 	if unsetError != nil {
 		return unsetError
 	}
 
 	if err != nil {
-		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, archive.redact(err.Error()), "", recipes.GetErrorDetails(err))
 	}
 
 	return nil
 }
 
+// Plan creates a unique directory for each execution of terraform and runs terraform plan for the recipe. It returns the
+// changes the deployment of the recipe would make to the resources of the recipe without deploying the recipe.
+func (d *terraformDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	// Add credential information to the module source of type git or archive if applicable.
+	archive, unsetGitConfig, err := addModuleSourceCredentials(opts.BaseOptions, requestDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		Install:        d.installOptions(),
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+		ModuleSource:   archive.moduleSource,
+		Secrets:        opts.Secrets,
+	})
+
+	unsetError := unsetGitConfig()
+	if unsetError != nil {
+		return nil, unsetError
+	}
+
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, archive.redact(err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return &recipes.RecipePlan{Changes: terraformPlanChanges(tfPlan)}, nil
+}
+
+// terraformPlanChanges converts the resource changes of a Terraform plan to the changes of a recipe plan.
+func terraformPlanChanges(tfPlan *tfjson.Plan) []datamodel.RecipeResourceChange {
+	changes := []datamodel.RecipeResourceChange{}
+	if tfPlan == nil {
+		return changes
+	}
+
+	for _, rc := range tfPlan.ResourceChanges {
+		if rc == nil || rc.Change == nil {
+			continue
+		}
+
+		change := datamodel.RecipeResourceChange{
+			Type:    rc.Type,
+			Name:    rc.Name,
+			Address: rc.Address,
+		}
+
+		switch actions := rc.Change.Actions; {
+		case actions.Create():
+			change.Action = datamodel.RecipeResourceChangeActionCreate
+		case actions.Replace():
+			change.Action = datamodel.RecipeResourceChangeActionReplace
+			change.Properties = changedProperties(rc.Change.Before, rc.Change.After)
+		case actions.Update():
+			change.Action = datamodel.RecipeResourceChangeActionUpdate
+			change.Properties = changedProperties(rc.Change.Before, rc.Change.After)
+		case actions.Delete():
+			change.Action = datamodel.RecipeResourceChangeActionDelete
+		case actions.Read():
+			change.Action = datamodel.RecipeResourceChangeActionRead
+		case actions.NoOp():
+			change.Action = datamodel.RecipeResourceChangeActionNoChange
+		default:
+			change.Action = datamodel.RecipeResourceChangeActionUnsupported
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// changedProperties returns the sorted names of the top-level attributes which differ between the values of a
+// resource before and after a change.
+func changedProperties(before, after any) []string {
+	beforeValues, _ := before.(map[string]any)
+	afterValues, _ := after.(map[string]any)
+
+	properties := []string{}
+	for name, value := range afterValues {
+		if !reflect.DeepEqual(beforeValues[name], value) {
+			properties = append(properties, name)
+		}
+	}
+	for name := range beforeValues {
+		if _, ok := afterValues[name]; !ok {
+			properties = append(properties, name)
+		}
+	}
+	slices.Sort(properties)
+
+	return properties
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
@@ -218,6 +354,15 @@ func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition
 	return recipeResponse, nil
 }
 
+// installOptions returns the options to install the Terraform compatible binary configured for the driver.
+func (d *terraformDriver) installOptions() terraform.InstallOptions {
+	return terraform.InstallOptions{
+		Binary:    d.options.Binary,
+		Version:   d.options.Version,
+		SourceURL: d.options.SourceURL,
+	}
+}
+
 // createExecutionDirectory creates a unique directory for each execution of terraform.
 func (d *terraformDriver) createExecutionDirectory(ctx context.Context, recipe recipes.ResourceMetadata, definition recipes.EnvironmentDefinition) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -262,36 +407,61 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts BaseOption
 		}
 	}()
 
-	// Get the secret store ID associated with the git private terraform repository source.
-	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
-	if err != nil {
-		return nil, err
-	}
-
-	// Add credential information to .gitconfig for module source of type git if applicable.
-	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	// Add credential information to the module source of type git or archive if applicable.
+	archive, unsetGitConfig, err := addModuleSourceCredentials(opts, requestDirPath)
 	if err != nil {
 		return nil, err
 	}
 
 	recipeData, err := d.terraformExecutor.GetRecipeMetadata(ctx, terraform.Options{
 		RootDir:        requestDirPath,
+		Install:        d.installOptions(),
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
+		ModuleSource:   archive.moduleSource,
 	})
 
-	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	unsetError := unsetGitConfig()
 	if unsetError != nil {
 		return nil, unsetError
 	}
 
 	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, archive.redact(err.Error()), "", recipes.GetErrorDetails(err))
 	}
 
 	return recipeData, nil
 }
 
+// addModuleSourceCredentials adds the credential information of the private git repository or the archive the
+// Terraform module is downloaded from, if applicable. It returns the archive module source and a function which removes
+// the git credentials from the global Git configuration once the module source is no longer needed.
+func addModuleSourceCredentials(opts BaseOptions, requestDirPath string) (archiveSource, func() error, error) {
+	// Get the secret store ID associated with the git private terraform repository source.
+	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
+	if err != nil {
+		return archiveSource{}, nil, err
+	}
+
+	// Add credential information to .gitconfig for module source of type git if applicable.
+	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if err != nil {
+		return archiveSource{}, nil, err
+	}
+
+	unsetGitConfig := func() error {
+		return unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	}
+
+	// Add credential information to the module source of type archive if applicable.
+	archive, err := getArchiveSourceIfApplicable(opts.Configuration, opts.Secrets, opts.Definition.TemplatePath)
+	if err != nil {
+		return archiveSource{}, nil, errors.Join(err, unsetGitConfig())
+	}
+
+	return archive, unsetGitConfig, nil
+}
+
 // FindSecretIDs is used to retrieve a map of secretStoreIDs and corresponding secret keys.
 // associated with the given environment configuration and environment definition.
 func (d *terraformDriver) FindSecretIDs(ctx context.Context, envConfig recipes.Configuration, definition recipes.EnvironmentDefinition) (secretStoreIDResourceKeys map[string][]string, err error) {
@@ -307,6 +477,20 @@ func (d *terraformDriver) FindSecretIDs(ctx context.Context, envConfig recipes.C
 		secretStoreIDResourceKeys[secretStoreID] = []string{PrivateRegistrySecretKey_Pat, PrivateRegistrySecretKey_Username}
 	}
 
+	// Get the secret store ID associated with the archive terraform module source.
+	archiveSecretStoreID, err := GetArchiveSecretStoreID(envConfig, definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if archiveSecretStoreID != "" {
+		keys, err := getArchiveSecretKeys(definition.TemplatePath)
+		if err != nil {
+			return nil, err
+		}
+		secretStoreIDResourceKeys[archiveSecretStoreID] = keys
+	}
+
 	// Get the secret IDs and associated keys in provider configuration and environment variables
 	providerSecretIDs := terraform.GetProviderEnvSecretIDs(envConfig)
 
@@ -30,6 +30,11 @@ const (
 	TerraformKubernetesProvider       = "registry.terraform.io/hashicorp/kubernetes"
 	PrivateRegistrySecretKey_Pat      = "pat"
 	PrivateRegistrySecretKey_Username = "username"
+	ArchiveSecretKey_Username         = "username"
+	ArchiveSecretKey_Password         = "password"
+	ArchiveSecretKey_SASToken         = "sasToken"
+	ArchiveSecretKey_AccessKeyID      = "accessKeyId"
+	ArchiveSecretKey_SecretAccessKey  = "secretAccessKey"
 )
 
 // Driver is an interface to implement recipe deployment and recipe resources deletion.
@@ -57,6 +62,16 @@ type DriverWithSecrets interface {
 	FindSecretIDs(ctx context.Context, config recipes.Configuration, definition recipes.EnvironmentDefinition) (secretIDs map[string][]string, err error)
 }
 
+// DriverWithPlan is an optional interface and used when the driver can preview the changes a recipe deployment would make
+// without deploying the recipe.
+type DriverWithPlan interface {
+	// Driver is an interface to implement recipe deployment and recipe resources deletion.
+	Driver
+
+	// Plan fetches the recipe contents and returns the changes deploying the recipe would make to the resources of the recipe.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
+}
+
 // BaseOptions is the base options for the driver operations.
 type BaseOptions struct {
 	// Configuration is the configuration for the recipe.
@@ -113,3 +128,14 @@ func GetPrivateGitRepoSecretStoreID(envConfig recipes.Configuration, templatePat
 	}
 	return "", nil
 }
+
+// GetArchiveSecretStoreID returns secretstore resource ID associated with the source of a terraform module packaged as an archive.
+func GetArchiveSecretStoreID(envConfig recipes.Configuration, templatePath string) (string, error) {
+	url, _, err := GetArchiveURL(templatePath)
+	if err != nil || url == nil {
+		return "", err
+	}
+
+	// get the secret store id associated with the host of the archive source.
+	return envConfig.RecipeConfig.Terraform.Authentication.Archive[strings.TrimPrefix(url.Hostname(), "www.")].Secret, nil
+}
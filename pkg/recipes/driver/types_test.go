@@ -78,3 +78,58 @@ func Test_GetPrivateGitRepoSecretStoreID(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetArchiveSecretStoreID(t *testing.T) {
+	envConfig := recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Authentication: datamodel.AuthConfig{
+					Archive: map[string]datamodel.ArchiveSecretConfig{
+						"mybucket.s3.amazonaws.com": {
+							Secret: "secret-store1",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		desc                string
+		templatePath        string
+		expectedSecretStore string
+		expectedErr         bool
+	}{
+		{
+			desc:                "success",
+			templatePath:        "s3::https://mybucket.s3.amazonaws.com/module.zip",
+			expectedSecretStore: "secret-store1",
+		},
+		{
+			desc:                "no authentication for host",
+			templatePath:        "https://example.com/module.zip",
+			expectedSecretStore: "",
+		},
+		{
+			desc:                "not an archive source",
+			templatePath:        "Azure/cosmosdb/azurerm",
+			expectedSecretStore: "",
+		},
+		{
+			desc:         "invalid template path",
+			templatePath: "s3::https://mybu  cket.s3.amazonaws.com/module.zip",
+			expectedErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ss, err := GetArchiveSecretStoreID(envConfig, tt.templatePath)
+			if !tt.expectedErr {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedSecretStore, ss)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -27,6 +27,8 @@ import (
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -42,6 +44,19 @@ type Options struct {
 	ConfigurationLoader configloader.ConfigurationLoader
 	SecretsLoader       configloader.SecretsLoader
 	Drivers             map[string]recipedriver.Driver
+
+	// Retry configures the retries of recipe executions which failed because of a transient cloud error.
+	Retry RetryOptions
+}
+
+// RetryOptions configures the retries of recipe executions which failed because of a transient cloud error.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of executions of a recipe, including the first one. Executions are not
+	// retried if MaxAttempts is less than 2.
+	MaxAttempts int
+
+	// Delay is the delay before the first retry. The delay grows linearly with the number of attempts.
+	Delay time.Duration
 }
 
 type engine struct {
@@ -96,7 +111,7 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, nil, err
 	}
 
-	res, err := driver.Execute(ctx, recipedriver.ExecuteOptions{
+	res, err := e.executeWithRetry(ctx, driver, recipedriver.ExecuteOptions{
 		BaseOptions: recipedriver.BaseOptions{
 			Configuration: *configuration,
 			Recipe:        recipe,
@@ -109,9 +124,83 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, definition, err
 	}
 
+	err = e.validateOutputResourceTypes(ctx, driver, configuration, recipe, definition, secrets, res, prevState)
+	if err != nil {
+		return nil, definition, err
+	}
+
 	return res, definition, nil
 }
 
+// validateOutputResourceTypes validates the output resources of the recipe against the allowed output resource types
+// of the recipe. When the recipe is deployed for the first time, the output resources are deleted before reporting the
+// violation. The output resources of a recipe which was already deployed are not deleted, because they could be the
+// resources of the previous deployment which are still in use.
+func (e *engine) validateOutputResourceTypes(ctx context.Context, driver recipedriver.Driver, configuration *recipes.Configuration, recipe recipes.ResourceMetadata, definition *recipes.EnvironmentDefinition, secrets map[string]recipes.SecretData, res *recipes.RecipeOutput, prevState []string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if res == nil {
+		return nil
+	}
+
+	err := recipes.ValidateOutputResourceTypes(definition.AllowedOutputResourceTypes, res.Resources)
+	if err == nil {
+		return nil
+	}
+
+	message := err.Error()
+	if len(prevState) == 0 {
+		outputResources := []rpv1.OutputResource{}
+		for _, id := range res.Resources {
+			parsed, parseErr := resources.ParseResource(id)
+			if parseErr != nil {
+				continue
+			}
+			outputResources = append(outputResources, rpv1.OutputResource{ID: parsed, RadiusManaged: to.Ptr(true)})
+		}
+
+		logger.Info("recipe created output resources of types which are not allowed, deleting the output resources", "error", message)
+		deleteErr := driver.Delete(ctx, recipedriver.DeleteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *configuration,
+				Recipe:        recipe,
+				Definition:    *definition,
+				Secrets:       secrets,
+			},
+			OutputResources: outputResources,
+		})
+		if deleteErr != nil {
+			message = fmt.Sprintf("%s. Failed to delete the output resources of the recipe: %s", message, deleteErr.Error())
+		}
+	}
+
+	return recipes.NewRecipeError(recipes.RecipeOutputResourceTypeNotAllowed, message, util.ExecutionError, recipes.GetErrorDetails(err))
+}
+
+// executeWithRetry executes the recipe and retries the whole execution when it fails because of a transient cloud
+// error. Recipe executions are idempotent: Bicep recipes are deployed in incremental mode and Terraform recipes are
+// applied with the state of the previous attempt, so a retry converges to the same resources. Errors reported after
+// the deployment completed are not transient and never retried, see recipes.IsTransientError.
+func (e *engine) executeWithRetry(ctx context.Context, driver recipedriver.Driver, opts recipedriver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	retry := e.options.Retry
+
+	for attempt := 1; ; attempt++ {
+		res, err := driver.Execute(ctx, opts)
+		if err == nil || attempt >= retry.MaxAttempts || !recipes.IsTransientError(err) {
+			return res, err
+		}
+
+		delay := retry.Delay * time.Duration(attempt)
+		logger.Info("recipe execution failed with a transient error, retrying", "attempt", attempt, "maxAttempts", retry.MaxAttempts, "delay", delay.String(), "error", err.Error())
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+	}
+}
+
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
 func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
@@ -210,6 +299,49 @@ func (e *engine) getRecipeMetadataCore(ctx context.Context, opts GetRecipeMetada
 	})
 }
 
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then computes the changes the deployment of the recipe would make using
+// the driver. It returns an error if the driver does not support planning.
+func (e *engine) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, opts.Recipe)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Nothing is deployed in a simulated environment, so the deployment of the recipe would not make any changes.
+	if configuration.Simulated {
+		logger.Info("simulated environment enabled, skipping plan")
+		return &recipes.RecipePlan{}, nil
+	}
+
+	definition, driver, err := e.getDriver(ctx, opts.Recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	driverWithPlan, ok := driver.(recipedriver.DriverWithPlan)
+	if !ok {
+		err := fmt.Errorf("driver `%s` does not support planning recipes", definition.Driver)
+		return nil, recipes.NewRecipeError(recipes.RecipePlanNotSupported, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return driverWithPlan.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        opts.Recipe,
+			Definition:    *definition,
+			Secrets:       secrets,
+		},
+	})
+}
+
 func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
 	// Load Recipe Definition from the environment.
 	definition, err := e.options.ConfigurationLoader.LoadRecipe(ctx, &recipeMetadata)
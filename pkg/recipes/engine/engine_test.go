@@ -17,15 +17,20 @@ limitations under the License.
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -238,6 +243,87 @@ func Test_Engine_Execute_Failure(t *testing.T) {
 	require.Equal(t, err.Error(), "failed to execute recipe")
 }
 
+func Test_Engine_Execute_TransientFailure(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	recipeResult := &recipes.RecipeOutput{
+		Resources: []string{"mongoStorageAccount", "mongoDatabase"},
+	}
+	transientErr := recipes.NewRecipeError(recipes.RecipeDeploymentFailed, "failed to deploy recipe", util.ExecutionError, &v1.ErrorDetails{Code: "TooManyRequests", Message: "rate limit exceeded"})
+	permanentErr := recipes.NewRecipeError(recipes.RecipeDeploymentFailed, "failed to deploy recipe", util.ExecutionError, &v1.ErrorDetails{Code: "InvalidTemplate", Message: "invalid template"})
+
+	tests := []struct {
+		name     string
+		errors   []error
+		result   *recipes.RecipeOutput
+		expected error
+	}{
+		{
+			name:   "retries transient errors",
+			errors: []error{transientErr, transientErr, nil},
+			result: recipeResult,
+		},
+		{
+			name:     "fails after the maximum number of attempts",
+			errors:   []error{transientErr, transientErr, transientErr},
+			expected: transientErr,
+		},
+		{
+			name:     "does not retry other errors",
+			errors:   []error{permanentErr},
+			expected: permanentErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			engine, configLoader, driver, _, _ := setup(t)
+			engine.options.Retry = RetryOptions{MaxAttempts: 3, Delay: time.Millisecond}
+
+			configLoader.EXPECT().
+				LoadConfiguration(ctx, recipeMetadata).
+				Times(1).
+				Return(envConfig, nil)
+			configLoader.EXPECT().
+				LoadRecipe(ctx, &recipeMetadata).
+				Times(1).
+				Return(recipeDefinition, nil)
+
+			calls := []any{}
+			for _, err := range tt.errors {
+				var result *recipes.RecipeOutput
+				if err == nil {
+					result = tt.result
+				}
+				calls = append(calls, driver.EXPECT().
+					Execute(ctx, gomock.Any()).
+					Times(1).
+					Return(result, err))
+			}
+			gomock.InOrder(calls...)
+
+			result, err := engine.Execute(ctx, ExecuteOptions{
+				BaseOptions: BaseOptions{
+					Recipe: recipeMetadata,
+				},
+			})
+			require.Equal(t, tt.expected, err)
+			require.Equal(t, tt.result, result)
+		})
+	}
+}
+
 func Test_Engine_Terraform_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -1204,3 +1290,176 @@ func Test_Engine_Delete_With_Secrets_Success(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_Engine_Execute_OutputResourceTypeNotAllowed(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	recipeDefinition.AllowedOutputResourceTypes = []string{"Microsoft.DocumentDB/*"}
+
+	accountID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.DocumentDB/accounts/test-account"
+	storageID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/test-storage"
+	recipeResult := &recipes.RecipeOutput{
+		Resources: []string{accountID, storageID},
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		prevState []string
+		rollback  bool
+	}{
+		{
+			name:     "first deployment is rolled back",
+			rollback: true,
+		},
+		{
+			name:      "update is not rolled back",
+			prevState: []string{accountID},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			engine, configLoader, driver, _, _ := setup(t)
+
+			configLoader.EXPECT().
+				LoadConfiguration(ctx, recipeMetadata).
+				Times(1).
+				Return(envConfig, nil)
+			configLoader.EXPECT().
+				LoadRecipe(ctx, &recipeMetadata).
+				Times(1).
+				Return(&recipeDefinition, nil)
+			driver.EXPECT().
+				Execute(ctx, gomock.Any()).
+				Times(1).
+				Return(recipeResult, nil)
+
+			if tc.rollback {
+				driver.EXPECT().
+					Delete(ctx, recipedriver.DeleteOptions{
+						BaseOptions: recipedriver.BaseOptions{
+							Configuration: *envConfig,
+							Recipe:        recipeMetadata,
+							Definition:    recipeDefinition,
+						},
+						OutputResources: []rpv1.OutputResource{
+							{ID: resources.MustParse(accountID), RadiusManaged: to.Ptr(true)},
+							{ID: resources.MustParse(storageID), RadiusManaged: to.Ptr(true)},
+						},
+					}).
+					Times(1).
+					Return(nil)
+			}
+
+			result, err := engine.Execute(ctx, ExecuteOptions{
+				BaseOptions: BaseOptions{
+					Recipe: recipeMetadata,
+				},
+				PreviousState: tc.prevState,
+			})
+			require.Nil(t, result)
+
+			recipeError, ok := err.(*recipes.RecipeError)
+			require.True(t, ok)
+			require.Equal(t, recipes.RecipeOutputResourceTypeNotAllowed, recipeError.ErrorDetails.Code)
+			require.Contains(t, recipeError.ErrorDetails.Message, storageID)
+			require.NotContains(t, recipeError.ErrorDetails.Message, accountID)
+		})
+	}
+}
+
+// planDriver is a driver which supports planning recipes and records the options it is called with.
+type planDriver struct {
+	recipedriver.Driver
+	opts recipedriver.ExecuteOptions
+	plan *recipes.RecipePlan
+}
+
+func (d *planDriver) Plan(ctx context.Context, opts recipedriver.ExecuteOptions) (*recipes.RecipePlan, error) {
+	d.opts = opts
+	return d.plan, nil
+}
+
+func Test_Engine_Plan(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+		Parameters: map[string]any{
+			"size": "small",
+		},
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		engine, configLoader, _, _, _ := setup(t)
+		driver := &planDriver{
+			plan: &recipes.RecipePlan{
+				Changes: []datamodel.RecipeResourceChange{
+					{Action: datamodel.RecipeResourceChangeActionCreate, Type: "azurerm_redis_cache", Name: "redis", Address: "azurerm_redis_cache.redis"},
+				},
+			},
+		}
+		engine.options.Drivers["plan"] = driver
+		recipeDefinition := &recipes.EnvironmentDefinition{
+			Driver:       "plan",
+			TemplatePath: "Azure/redis/azurerm",
+			ResourceType: "Applications.Datastores/redisCaches",
+		}
+
+		configLoader.EXPECT().LoadConfiguration(ctx, recipeMetadata).Times(1).Return(envConfig, nil)
+		configLoader.EXPECT().LoadRecipe(ctx, &recipeMetadata).Times(1).Return(recipeDefinition, nil)
+
+		plan, err := engine.Plan(ctx, PlanOptions{BaseOptions: BaseOptions{Recipe: recipeMetadata}})
+		require.NoError(t, err)
+		require.Equal(t, driver.plan, plan)
+		require.Equal(t, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    *recipeDefinition,
+			},
+		}, driver.opts)
+	})
+
+	t.Run("simulated environment", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		engine, configLoader, _, _, _ := setup(t)
+
+		configLoader.EXPECT().LoadConfiguration(ctx, recipeMetadata).Times(1).Return(&recipes.Configuration{Simulated: true}, nil)
+
+		plan, err := engine.Plan(ctx, PlanOptions{BaseOptions: BaseOptions{Recipe: recipeMetadata}})
+		require.NoError(t, err)
+		require.Empty(t, plan.Changes)
+	})
+
+	t.Run("driver does not support plan", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		engine, configLoader, _, _, _ := setup(t)
+		recipeDefinition := &recipes.EnvironmentDefinition{
+			Driver:       recipes.TemplateKindBicep,
+			TemplatePath: "ghcr.io/radius-project/dev/recipes/redis:1.0",
+			ResourceType: "Applications.Datastores/redisCaches",
+		}
+
+		configLoader.EXPECT().LoadConfiguration(ctx, recipeMetadata).Times(1).Return(envConfig, nil)
+		configLoader.EXPECT().LoadRecipe(ctx, &recipeMetadata).Times(1).Return(recipeDefinition, nil)
+
+		_, err := engine.Plan(ctx, PlanOptions{BaseOptions: BaseOptions{Recipe: recipeMetadata}})
+		require.Equal(t, "code RecipePlanNotSupported: err driver `bicep` does not support planning recipes", err.Error())
+	})
+}
@@ -35,6 +35,10 @@ type Engine interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to compute the changes the deployment
+	// of the recipe would make without deploying it.
+	Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -64,3 +68,8 @@ type GetRecipeMetadataOptions struct {
 	BaseOptions
 	RecipeDefinition recipes.EnvironmentDefinition
 }
+
+// PlanOptions is the options for the Plan method.
+type PlanOptions struct {
+	BaseOptions
+}
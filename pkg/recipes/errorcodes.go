@@ -47,6 +47,18 @@ const (
 	// Used for errors with recipe configuration
 	RecipeConfigurationFailure = "RecipeConfigurationFailure"
 
+	// Used for errors when the recipe template source is not permitted by the environment recipe policy.
+	RecipeSourceNotAllowed = "RecipeSourceNotAllowed"
+
+	// Used for errors when a recipe creates output resources of types which are not allowed by the recipe.
+	RecipeOutputResourceTypeNotAllowed = "RecipeOutputResourceTypeNotAllowed"
+
+	// Used for errors encountered while planning a recipe deployment.
+	RecipePlanFailed = "RecipePlanFailed"
+
+	// Used for errors when the driver of a recipe does not support planning a recipe deployment.
+	RecipePlanNotSupported = "RecipePlanNotSupported"
+
 	// Used for errors encountered while loading recipe secrets.
 	LoadSecretsFailed = "LoadSecretsFailed"
 )
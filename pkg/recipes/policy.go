@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// terraformRegistryHost is the host of the public Terraform registry. Terraform module sources written in the
+	// registry shorthand ('namespace/name/provider') are resolved against this host.
+	terraformRegistryHost = "registry.terraform.io"
+)
+
+// ValidateTemplateSource checks the template path of a recipe against the allow/deny lists of the environment recipe policy.
+// Denied sources take precedence over allowed sources. When the policy has allowed sources, the template path must match
+// one of them. A source matches a template path when it is a prefix of the path ending at a path, tag, or digest boundary.
+func ValidateTemplateSource(policy datamodel.RecipePolicyProperties, templateKind string, templatePath string) error {
+	if len(policy.AllowedSources) == 0 && len(policy.DeniedSources) == 0 {
+		return nil
+	}
+
+	source := normalizeTemplatePath(templateKind, templatePath)
+	for _, rule := range policy.DeniedSources {
+		if matchesSource(source, rule) {
+			return fmt.Errorf("recipe template source %q is denied by the environment recipe policy (denied source %q)", templatePath, rule)
+		}
+	}
+
+	if len(policy.AllowedSources) == 0 {
+		return nil
+	}
+
+	for _, rule := range policy.AllowedSources {
+		if matchesSource(source, rule) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("recipe template source %q is not in the allowed sources of the environment recipe policy", templatePath)
+}
+
+// ValidateAllowedOutputResourceTypes checks the format of the allowed output resource types of a recipe. A resource type
+// is a namespace followed by one or more type segments, such as 'Microsoft.Cache/redis' or 'apps/Deployment'. The last
+// segment can be '*' to allow all the resource types of a namespace, such as 'Microsoft.Cache/*'.
+func ValidateAllowedOutputResourceTypes(allowed []string) error {
+	for _, rule := range allowed {
+		segments := strings.Split(rule, "/")
+		valid := len(segments) >= 2
+		for i, segment := range segments {
+			if segment == "" || (strings.Contains(segment, "*") && (segment != "*" || i != len(segments)-1 || i == 0)) {
+				valid = false
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf("allowed output resource type %q is invalid, it must be a resource type such as 'Microsoft.Cache/redis' or a namespace wildcard such as 'Microsoft.Cache/*'", rule)
+		}
+	}
+
+	return nil
+}
+
+// ValidateOutputResourceTypes checks the output resources created by a recipe against the allowed output resource types
+// of the recipe. All resource types are allowed when the allowed output resource types are empty. The error lists the
+// IDs of all the output resources which are not allowed.
+func ValidateOutputResourceTypes(allowed []string, outputResources []string) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	violations := []string{}
+	for _, id := range outputResources {
+		parsed, err := resources.ParseResource(id)
+		if err != nil || !matchesResourceType(parsed.Type(), allowed) {
+			violations = append(violations, id)
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	sort.Strings(violations)
+	return fmt.Errorf("recipe created output resources of types which are not allowed by the recipe (allowed types: %s): %s", strings.Join(allowed, ", "), strings.Join(violations, ", "))
+}
+
+// matchesResourceType returns true if the resource type matches one of the allowed resource types, ignoring case.
+func matchesResourceType(resourceType string, allowed []string) bool {
+	for _, rule := range allowed {
+		if namespace, ok := strings.CutSuffix(rule, "/*"); ok {
+			if strings.HasPrefix(strings.ToLower(resourceType), strings.ToLower(namespace)+"/") {
+				return true
+			}
+		} else if strings.EqualFold(resourceType, rule) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesSource returns true if the normalized template path starts with the given policy rule.
+func matchesSource(source string, rule string) bool {
+	rule = strings.TrimSuffix(normalizeSource(rule), "/")
+	if rule == "" {
+		return false
+	}
+
+	if !strings.HasPrefix(source, rule) {
+		return false
+	}
+
+	if len(source) == len(rule) {
+		return true
+	}
+
+	switch source[len(rule)] {
+	case '/', ':', '@':
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeTemplatePath normalizes a recipe template path so that it can be compared with the sources of a recipe policy.
+func normalizeTemplatePath(templateKind string, templatePath string) string {
+	source := normalizeSource(templatePath)
+	if templateKind != TemplateKindTerraform {
+		return source
+	}
+
+	// Terraform registry shorthand ('Azure/cosmosdb/azurerm') does not include the registry host.
+	segments := strings.Split(source, "/")
+	if len(segments) >= 3 && !strings.ContainsAny(segments[0], ".:") {
+		return terraformRegistryHost + "/" + source
+	}
+
+	return source
+}
+
+// normalizeSource removes the parts of a template path or policy rule that do not identify the source, such as the
+// scheme, the forced getter, credentials and query parameters.
+func normalizeSource(source string) string {
+	source = strings.ToLower(strings.TrimSpace(source))
+
+	// Bicep registry reference, e.g. 'br:ghcr.io/myorg/recipe:1.0'.
+	source = strings.TrimPrefix(source, "br:")
+
+	// Terraform forced getter, e.g. 'git::https://github.com/myorg/recipe.git'.
+	if i := strings.Index(source, "::"); i >= 0 {
+		source = source[i+2:]
+	}
+
+	scheme := false
+	if i := strings.Index(source, "://"); i >= 0 {
+		source = source[i+3:]
+		scheme = true
+	}
+
+	if i := strings.IndexAny(source, "?#"); i >= 0 {
+		source = source[:i]
+	}
+
+	// Strip the credentials from the host, e.g. 'git@github.com:myorg/recipe.git'.
+	host, rest, found := strings.Cut(source, "/")
+	if i := strings.LastIndex(host, "@"); i >= 0 {
+		host = host[i+1:]
+
+		// SCP-like Git address, where the path follows the host after a colon.
+		if !scheme {
+			host = strings.Replace(host, ":", "/", 1)
+		}
+	}
+
+	source = host
+	if found {
+		source = host + "/" + rest
+	}
+
+	// Terraform subdirectories, e.g. 'github.com/myorg/recipes//modules/redis'.
+	for strings.Contains(source, "//") {
+		source = strings.ReplaceAll(source, "//", "/")
+	}
+
+	return source
+}
@@ -20,10 +20,12 @@ import (
 	"fmt"
 
 	coredm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_aws "github.com/radius-project/radius/pkg/ucp/resources/aws"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	resources_gcp "github.com/radius-project/radius/pkg/ucp/resources/gcp"
 )
 
 var (
@@ -105,5 +107,45 @@ func New(metadata *recipes.ResourceMetadata, config *recipes.Configuration) (*Co
 		}
 	}
 
+	if providers.GCP != (coredm.ProvidersGCP{}) {
+		p, err := resources.ParseScope(providers.GCP.Scope)
+		if err != nil {
+			return nil, fmt.Errorf(ErrParseFormat, "GCP scope", providers.GCP.Scope, err)
+		}
+		recipeContext.GCP = &ProviderGCP{
+			Project: p.FindScope(resources_gcp.ScopeProjects),
+			Region:  p.FindScope(resources_gcp.ScopeRegions),
+		}
+	}
+
+	if config.CostAttribution != nil {
+		recipeContext.CostAttribution = makeCostAttributionTags(config.CostAttribution, recipeContext.Application.Name, recipeContext.Environment.Name, recipeContext.Resource.Name)
+	}
+
 	return &recipeContext, nil
 }
+
+// makeCostAttributionTags returns the cost attribution tags for the resources output by a recipe. Unlike
+// Kubernetes labels, the tag keys are not prefixed because cloud providers restrict the characters of tag keys.
+func makeCostAttributionTags(ext *coredm.CostAttributionExtension, application string, environment string, resource string) map[string]string {
+	tags := map[string]string{}
+	for key, value := range ext.Tags {
+		tags[key] = value
+	}
+
+	names := map[string]string{
+		kubernetes.CostAttributionApplication: application,
+		kubernetes.CostAttributionEnvironment: environment,
+		kubernetes.CostAttributionResource:    resource,
+	}
+	for key, name := range names {
+		if name == "" {
+			delete(tags, key)
+			continue
+		}
+
+		tags[key] = name
+	}
+
+	return tags
+}
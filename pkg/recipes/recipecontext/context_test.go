@@ -55,6 +55,9 @@ func TestNewContext(t *testing.T) {
 					AWS: coredm.ProvidersAWS{
 						Scope: "/planes/aws/aws/accounts/1234567890/regions/us-west-2",
 					},
+					GCP: coredm.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/projects/my-project/regions/us-central1",
+					},
 				},
 			},
 			out: &Context{
@@ -93,6 +96,10 @@ func TestNewContext(t *testing.T) {
 					Region:  "us-west-2",
 					Account: "1234567890",
 				},
+				GCP: &ProviderGCP{
+					Project: "my-project",
+					Region:  "us-central1",
+				},
 			},
 		},
 		{
@@ -181,6 +188,53 @@ func TestNewContext(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "with cost attribution",
+			metadata: testMetadata,
+			providers: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            "radius-test-app",
+						EnvironmentNamespace: "radius-test-env",
+					},
+				},
+				CostAttribution: &coredm.CostAttributionExtension{
+					Tags: map[string]string{
+						"owner":       "team-a",
+						"environment": "spoofed",
+					},
+				},
+			},
+			out: &Context{
+				Resource: Resource{
+					ResourceInfo: ResourceInfo{
+						ID:   "/planes/radius/local/resourceGroups/testGroup/providers/applications.datastores/mongodatabases/mongo0",
+						Name: "mongo0",
+					},
+					Type: "applications.datastores/mongodatabases",
+				},
+				Application: ResourceInfo{
+					Name: "testApplication",
+					ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/testApplication",
+				},
+				Environment: ResourceInfo{
+					Name: "env0",
+					ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env0",
+				},
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            "radius-test-app",
+						EnvironmentNamespace: "radius-test-env",
+					},
+				},
+				CostAttribution: map[string]string{
+					"application": "testApplication",
+					"environment": "env0",
+					"resource":    "mongo0",
+					"owner":       "team-a",
+				},
+			},
+		},
 	}
 
 	for _, tc := range ctxTests {
@@ -287,6 +341,28 @@ func TestNewContext_failures(t *testing.T) {
 			},
 			err: "failed to parse AWS scope: \"invalid-aws\" while building the recipe context parameter 'invalid-aws' is not a valid resource id",
 		},
+		{
+			name: "invalid gcp scope",
+			metadata: &recipes.ResourceMetadata{
+				ResourceID:    "/planes/radius/local/resourceGroups/testGroup/providers/applications.datastores/mongodatabases/mongo0",
+				EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env0",
+				ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/testApplication",
+			},
+			providers: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            "radius-test-app",
+						EnvironmentNamespace: "radius-test-env",
+					},
+				},
+				Providers: coredm.Providers{
+					GCP: coredm.ProvidersGCP{
+						Scope: "invalid-gcp",
+					},
+				},
+			},
+			err: "failed to parse GCP scope: \"invalid-gcp\" while building the recipe context parameter 'invalid-gcp' is not a valid resource id",
+		},
 	}
 
 	for _, tc := range tests {
@@ -41,6 +41,11 @@ type Context struct {
 	Azure *ProviderAzure `json:"azure,omitempty"`
 	// AWS represents AWS provider scope.
 	AWS *ProviderAWS `json:"aws,omitempty"`
+	// GCP represents GCP provider scope.
+	GCP *ProviderGCP `json:"gcp,omitempty"`
+	// CostAttribution represents the cost attribution tags configured by the environment. Recipe template authors
+	// can apply these tags to cloud resources so that cost tools can attribute spend to the application.
+	CostAttribution map[string]string `json:"costAttribution,omitempty"`
 }
 
 // Resource contains the information needed to deploy a recipe.
@@ -91,3 +96,11 @@ type ProviderAWS struct {
 	// Account represents the account id of the AWS account.
 	Account string `json:"account"`
 }
+
+// ProviderGCP contains GCP project provider scope for recipe context.
+type ProviderGCP struct {
+	// Project represents the id of the GCP project.
+	Project string `json:"project"`
+	// Region represents the region of the GCP project.
+	Region string `json:"region"`
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// Binary is the flavor of the Terraform compatible binary used to execute recipes.
+type Binary string
+
+const (
+	// BinaryTerraform is the HashiCorp Terraform binary. This is the default.
+	BinaryTerraform Binary = "terraform"
+
+	// BinaryOpenTofu is the OpenTofu binary, an open source fork of Terraform.
+	BinaryOpenTofu Binary = "opentofu"
+)
+
+// Feature is a capability of the binary that Radius relies on and that is only available in some versions.
+type Feature string
+
+const (
+	// FeatureApplyJSONProgress is the machine readable output of apply, used to report per-resource progress.
+	FeatureApplyJSONProgress Feature = "apply-json-progress"
+)
+
+var (
+	// minimumVersions is the minimum version of each binary that Radius supports.
+	minimumVersions = map[Binary]*version.Version{
+		BinaryOpenTofu: version.Must(version.NewVersion("1.6.0")),
+	}
+
+	// featureVersions is the minimum version of each binary that supports a feature.
+	featureVersions = map[Feature]map[Binary]*version.Version{
+		FeatureApplyJSONProgress: {
+			BinaryTerraform: version.Must(version.NewVersion("0.15.3")),
+			BinaryOpenTofu:  version.Must(version.NewVersion("1.6.0")),
+		},
+	}
+
+	// versionOutputPrefixes is the prefix of the first line of the 'version' command output of each binary.
+	versionOutputPrefixes = map[Binary]string{
+		BinaryTerraform: "Terraform v",
+		BinaryOpenTofu:  "OpenTofu v",
+	}
+)
+
+// ParseBinary parses the configured binary name. An empty value selects Terraform.
+func ParseBinary(value string) (Binary, error) {
+	switch Binary(strings.ToLower(strings.TrimSpace(value))) {
+	case "", BinaryTerraform:
+		return BinaryTerraform, nil
+	case BinaryOpenTofu, "tofu":
+		return BinaryOpenTofu, nil
+	default:
+		return "", fmt.Errorf("unsupported terraform binary %q, supported values are %q and %q", value, BinaryTerraform, BinaryOpenTofu)
+	}
+}
+
+// DisplayName returns the product name of the binary.
+func (b Binary) DisplayName() string {
+	if b == BinaryOpenTofu {
+		return "OpenTofu"
+	}
+
+	return "Terraform"
+}
+
+// Supports returns true if the given version of the binary supports the feature.
+func (b Binary) Supports(feature Feature, v *version.Version) bool {
+	minimum, ok := featureVersions[feature][b]
+	if !ok {
+		return false
+	}
+
+	return v != nil && v.GreaterThanOrEqual(minimum)
+}
+
+// checkMinimumVersion returns an error if the given version of the binary is older than the minimum version supported by Radius.
+func (b Binary) checkMinimumVersion(v *version.Version) error {
+	minimum, ok := minimumVersions[b]
+	if !ok {
+		return nil
+	}
+
+	if v == nil || v.LessThan(minimum) {
+		return fmt.Errorf("%s version %s is not supported, the minimum supported version is %s", b.DisplayName(), v, minimum)
+	}
+
+	return nil
+}
+
+// validateBinary runs the 'version' command of the installed executable and verifies that it is the
+// expected binary. This guards against a misconfigured download source serving a different product.
+func validateBinary(ctx context.Context, execPath string, binary Binary) error {
+	out, err := exec.CommandContext(ctx, execPath, "version").Output()
+	if err != nil {
+		return fmt.Errorf("failed to run %q to validate the %s installation: %w", execPath, binary.DisplayName(), err)
+	}
+
+	firstLine, _, _ := strings.Cut(strings.TrimSpace(string(out)), "\n")
+	if !strings.HasPrefix(firstLine, versionOutputPrefixes[binary]) {
+		return fmt.Errorf("the executable %q is not a %s binary, version output: %q", execPath, binary.DisplayName(), firstLine)
+	}
+
+	return nil
+}
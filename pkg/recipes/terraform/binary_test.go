@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBinary(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Binary
+		err      string
+	}{
+		{value: "", expected: BinaryTerraform},
+		{value: "terraform", expected: BinaryTerraform},
+		{value: "OpenTofu", expected: BinaryOpenTofu},
+		{value: "tofu", expected: BinaryOpenTofu},
+		{value: "pulumi", err: `unsupported terraform binary "pulumi", supported values are "terraform" and "opentofu"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			binary, err := ParseBinary(tc.value)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, binary)
+		})
+	}
+}
+
+func TestBinary_Supports(t *testing.T) {
+	tests := []struct {
+		name     string
+		binary   Binary
+		version  string
+		expected bool
+	}{
+		{name: "terraform supported", binary: BinaryTerraform, version: "1.5.7", expected: true},
+		{name: "terraform too old", binary: BinaryTerraform, version: "0.14.0", expected: false},
+		{name: "opentofu supported", binary: BinaryOpenTofu, version: "1.8.5", expected: true},
+		{name: "opentofu too old", binary: BinaryOpenTofu, version: "1.5.0", expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v := version.Must(version.NewVersion(tc.version))
+			require.Equal(t, tc.expected, tc.binary.Supports(FeatureApplyJSONProgress, v))
+		})
+	}
+
+	require.False(t, BinaryTerraform.Supports(FeatureApplyJSONProgress, nil))
+	require.False(t, BinaryTerraform.Supports("unknown", version.Must(version.NewVersion("1.5.7"))))
+}
+
+func TestBinary_CheckMinimumVersion(t *testing.T) {
+	require.NoError(t, BinaryTerraform.checkMinimumVersion(version.Must(version.NewVersion("0.12.0"))))
+	require.NoError(t, BinaryOpenTofu.checkMinimumVersion(version.Must(version.NewVersion("1.6.0"))))
+	require.EqualError(t, BinaryOpenTofu.checkMinimumVersion(version.Must(version.NewVersion("1.6.0-alpha1"))),
+		"OpenTofu version 1.6.0-alpha1 is not supported, the minimum supported version is 1.6.0")
+}
+
+func TestValidateBinary(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake binary is a shell script")
+	}
+
+	tests := []struct {
+		name   string
+		output string
+		binary Binary
+		err    bool
+	}{
+		{name: "terraform", output: "Terraform v1.9.8\non linux_amd64", binary: BinaryTerraform},
+		{name: "opentofu", output: "OpenTofu v1.8.5\non linux_amd64", binary: BinaryOpenTofu},
+		{name: "terraform expected opentofu", output: "Terraform v1.9.8\non linux_amd64", binary: BinaryOpenTofu, err: true},
+		{name: "opentofu expected terraform", output: "OpenTofu v1.8.5\non linux_amd64", binary: BinaryTerraform, err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			execPath := filepath.Join(t.TempDir(), "fake")
+			script := "#!/bin/sh\nprintf '" + tc.output + "\\n'\n"
+			require.NoError(t, os.WriteFile(execPath, []byte(script), 0755))
+
+			err := validateBinary(context.Background(), execPath, tc.binary)
+			if tc.err {
+				require.ErrorContains(t, err, "is not a "+tc.binary.DisplayName()+" binary")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// RemoteStateKeyPrefix is the prefix of the state key generated for each recipe in a remote backend.
+	RemoteStateKeyPrefix = "radius"
+)
+
+var _ Backend = (*remoteBackend)(nil)
+
+// remoteBackend stores the Terraform state in a remote storage service, such as an Amazon S3 bucket, an Azure Blob
+// Storage container or a Google Cloud Storage bucket.
+type remoteBackend struct {
+	backendType datamodel.TerraformBackendType
+	stateKey    string
+	config      map[string]any
+}
+
+// NewRemoteBackend creates a new remote backend of the given type. config contains the settings of the backend,
+// including the values of any secrets.
+func NewRemoteBackend(backend *datamodel.TerraformBackendConfig, config map[string]any) Backend {
+	return &remoteBackend{backendType: backend.Type, stateKey: backend.StateKey(), config: config}
+}
+
+// BuildBackend generates the Terraform backend configuration for the remote backend. The state of each recipe
+// is stored under a key derived from the resource, environment and application of the recipe.
+// https://developer.hashicorp.com/terraform/language/settings/backends/configuration
+func (p *remoteBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	suffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := maps.Clone(p.config)
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	// The gcs backend uses a prefix for the state of a workspace, while the other backends use the full path of the state file.
+	if p.backendType == datamodel.TerraformBackendGCS {
+		config[p.stateKey] = fmt.Sprintf("%s/%s", RemoteStateKeyPrefix, suffix)
+	} else {
+		config[p.stateKey] = fmt.Sprintf("%s/%s.tfstate", RemoteStateKeyPrefix, suffix)
+	}
+
+	return map[string]any{
+		string(p.backendType): config,
+	}, nil
+}
+
+// ValidateBackendExists always returns true for remote backends. The state is created by Terraform in the remote
+// storage as needed, and running Terraform against a missing state is not an error.
+func (p *remoteBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RemoteBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	suffix, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	tests := []struct {
+		backendType datamodel.TerraformBackendType
+		expected    map[string]any
+	}{
+		{
+			backendType: datamodel.TerraformBackendS3,
+			expected: map[string]any{
+				"s3": map[string]any{"bucket": "tfstate", "key": "radius/" + suffix + ".tfstate"},
+			},
+		},
+		{
+			backendType: datamodel.TerraformBackendAzureRM,
+			expected: map[string]any{
+				"azurerm": map[string]any{"bucket": "tfstate", "key": "radius/" + suffix + ".tfstate"},
+			},
+		},
+		{
+			backendType: datamodel.TerraformBackendGCS,
+			expected: map[string]any{
+				"gcs": map[string]any{"bucket": "tfstate", "prefix": "radius/" + suffix},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.backendType), func(t *testing.T) {
+			config := map[string]any{"bucket": "tfstate"}
+			backend := NewRemoteBackend(&datamodel.TerraformBackendConfig{Type: tt.backendType}, config)
+
+			actual, err := backend.BuildBackend(&resourceRecipe)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+
+			// The settings given to the backend must not be modified.
+			require.Equal(t, map[string]any{"bucket": "tfstate"}, config)
+		})
+	}
+}
+
+func Test_RemoteBackend_ValidateBackendExists(t *testing.T) {
+	backend := NewRemoteBackend(&datamodel.TerraformBackendConfig{Type: datamodel.TerraformBackendS3}, nil)
+
+	exists, err := backend.ValidateBackendExists(testcontext.New(t), "unused")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
@@ -42,15 +42,18 @@ const (
 func New(ctx context.Context, moduleName string, envRecipe *recipes.EnvironmentDefinition, resourceRecipe *recipes.ResourceMetadata) (*TerraformConfig, error) {
 	// Resource parameter gets precedence over environment level parameter,
 	// if same parameter is defined in both environment and resource recipe metadata.
-	moduleData := newModuleConfig(envRecipe.TemplatePath, envRecipe.TemplateVersion, envRecipe.Parameters, resourceRecipe.Parameters)
+	moduleData := newModuleConfig(envRecipe.TemplatePath, envRecipe.TemplateVersion)
 
-	return &TerraformConfig{
+	cfg := &TerraformConfig{
 		Terraform: nil,
 		Provider:  nil,
 		Module: map[string]TFModuleConfig{
 			moduleName: moduleData,
 		},
-	}, nil
+	}
+	cfg.setModuleVariables(moduleData, envRecipe.Parameters, resourceRecipe.Parameters)
+
+	return cfg, nil
 }
 
 // getMainConfigFilePath returns the path of the Terraform main config file.
@@ -58,23 +61,21 @@ func getMainConfigFilePath(workingDir string) string {
 	return fmt.Sprintf("%s/%s", workingDir, mainConfigFileName)
 }
 
-// Save writes the Terraform config to main.tf.json file in the working directory.
-// This overwrites the existing file if it exists.
-func (cfg *TerraformConfig) Save(ctx context.Context, workingDir string) error {
-	logger := ucplog.FromContextOrDiscard(ctx)
-
-	// Write the JSON data to a file in the working directory.
-	// JSON configuration syntax for Terraform requires the file to be named with .tf.json suffix.
-	// https://developer.hashicorp.com/terraform/language/syntax/json
+// getVariablesFilePath returns the path of the Terraform variable definitions file.
+func getVariablesFilePath(workingDir string) string {
+	return fmt.Sprintf("%s/%s", workingDir, variablesFileName)
+}
 
+// encodeJSON encodes the value to indented JSON without escaping the HTML characters.
+func encodeJSON(v any) ([]byte, error) {
 	// Create a buffer to write the JSON to
 	buf := &bytes.Buffer{}
 	enc := json.NewEncoder(buf)
 	enc.SetEscapeHTML(false)
 	enc.SetIndent("", "  ") // Indent with 2 spaces to make the JSON file human-readable and consistent with codebase.
 
-	// Encode the Terraform config to JSON. JSON encoding is being used to ensure that special characters
-	// in the original text are preserved when writing to the file.
+	// JSON encoding is being used to ensure that special characters in the original text are preserved when writing
+	// to the file.
 	// For example, when writing this text to file with JSON encoding (using enc.Encode(cfg)),
 	//   the special characters in the following text will be preserved:
 	//	"required_providers": {
@@ -91,12 +92,44 @@ func (cfg *TerraformConfig) Save(ctx context.Context, workingDir string) error {
 	//				"version": "\u003e= 2.0"
 	//			},
 	//		}
-	if err := enc.Encode(cfg); err != nil {
-		return fmt.Errorf("error marshalling JSON: %w", err)
+	if err := enc.Encode(v); err != nil {
+		return nil, fmt.Errorf("error marshalling JSON: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Save writes the Terraform config to main.tf.json file in the working directory.
+// This overwrites the existing file if it exists.
+func (cfg *TerraformConfig) Save(ctx context.Context, workingDir string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Write the JSON data to a file in the working directory.
+	// JSON configuration syntax for Terraform requires the file to be named with .tf.json suffix.
+	// https://developer.hashicorp.com/terraform/language/syntax/json
+	data, err := encodeJSON(cfg)
+	if err != nil {
+		return err
 	}
 
 	logger.Info(fmt.Sprintf("Writing Terraform JSON config to file: %s", getMainConfigFilePath(workingDir)))
-	if err := os.WriteFile(getMainConfigFilePath(workingDir), buf.Bytes(), modeConfigFile); err != nil {
+	if err := os.WriteFile(getMainConfigFilePath(workingDir), data, modeConfigFile); err != nil {
+		return fmt.Errorf("error creating file: %w", err)
+	}
+
+	if cfg == nil || len(cfg.variableValues) == 0 {
+		return nil
+	}
+
+	// The values of the variables are written to the variable definitions file with their full structure, e.g. maps of
+	// objects, so that Terraform converts them to the types of the module variables.
+	data, err = encodeJSON(cfg.variableValues)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("Writing Terraform variables to file: %s", getVariablesFilePath(workingDir)))
+	if err := os.WriteFile(getVariablesFilePath(workingDir), data, modeConfigFile); err != nil {
 		return fmt.Errorf("error creating file: %w", err)
 	}
 
@@ -193,16 +226,36 @@ func (cfg *TerraformConfig) AddRecipeContext(ctx context.Context, moduleName str
 	}
 
 	if recipeCtx != nil {
-		mod.SetParams(RecipeParams{recipecontext.RecipeContextParamKey: recipeCtx})
+		cfg.setModuleVariables(mod, RecipeParams{recipecontext.RecipeContextParamKey: recipeCtx})
 	}
 
 	return nil
 }
 
-// newModuleConfig creates a new TFModuleConfig object with the given module source and version
-// and also populates RecipeParams in TF module config. If same parameter key exists across params
-// then the last map specified gets precedence.
-func newModuleConfig(moduleSource string, moduleVersion string, params ...RecipeParams) TFModuleConfig {
+// setModuleVariables passes the parameters to the module through the input variables of the root module: a variable
+// is declared for each parameter, the module argument references the variable, and the value of the variable is saved
+// to the variable definitions file by Save. If same parameter key exists across params then the last map specified
+// gets precedence.
+func (cfg *TerraformConfig) setModuleVariables(mod TFModuleConfig, params ...RecipeParams) {
+	for _, param := range params {
+		for key, value := range param {
+			if cfg.Variable == nil {
+				cfg.Variable = map[string]TFVariableConfig{}
+			}
+			if cfg.variableValues == nil {
+				cfg.variableValues = RecipeParams{}
+			}
+
+			cfg.Variable[key] = TFVariableConfig{}
+			cfg.variableValues[key] = value
+			mod.SetParams(RecipeParams{key: fmt.Sprintf("${var.%s}", key)})
+		}
+	}
+}
+
+// newModuleConfig creates a new TFModuleConfig object with the given module source and version. The recipe parameters
+// are added to the module config by setModuleVariables.
+func newModuleConfig(moduleSource string, moduleVersion string) TFModuleConfig {
 	moduleConfig := TFModuleConfig{
 		moduleSourceKey: moduleSource,
 	}
@@ -213,11 +266,6 @@ func newModuleConfig(moduleSource string, moduleVersion string, params ...Recipe
 		moduleConfig[moduleVersionKey] = moduleVersion
 	}
 
-	// Populate recipe parameters
-	for _, param := range params {
-		moduleConfig.SetParams(param)
-	}
-
 	return moduleConfig
 }
 
@@ -989,3 +989,101 @@ func Test_Save_InvalidWorkingDir(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, fmt.Sprintf("error creating file: open %s/main.tf.json: no such file or directory", testDir), err.Error())
 }
+
+func Test_Save_Variables(t *testing.T) {
+	envRecipe := recipes.EnvironmentDefinition{
+		Name:            testRecipeName,
+		TemplatePath:    testTemplatePath,
+		TemplateVersion: testTemplateVersion,
+		Parameters: map[string]any{
+			"tags": map[string]any{
+				"team": "radius",
+			},
+			"sku": "C",
+		},
+	}
+	resourceRecipe := recipes.ResourceMetadata{
+		Name: testRecipeName,
+		Parameters: map[string]any{
+			"sku": "P",
+			"databases": map[string]any{
+				"orders": map[string]any{
+					"collation": "en_US.utf8",
+					"replicas":  float64(3),
+					"enabled":   true,
+					"users":     []any{"reader", "writer"},
+				},
+			},
+			"firewall_rules": []any{
+				map[string]any{"name": "allow-all", "start_ip": "0.0.0.0", "end_ip": "255.255.255.255"},
+			},
+			"capacity":       float64(2),
+			"public_access":  false,
+			"expression":     "${var.sku}",
+			"optional_value": nil,
+		},
+	}
+
+	ctx := testcontext.New(t)
+	workingDir := t.TempDir()
+
+	tfconfig, err := New(ctx, testRecipeName, &envRecipe, &resourceRecipe)
+	require.NoError(t, err)
+	err = tfconfig.AddRecipeContext(ctx, testRecipeName, getTestRecipeContext())
+	require.NoError(t, err)
+
+	err = tfconfig.Save(ctx, workingDir)
+	require.NoError(t, err)
+
+	// The module arguments reference the input variables of the root module.
+	expectedArgs := []string{"capacity", "context", "databases", "expression", "firewall_rules", "optional_value", "public_access", "sku", "tags"}
+	require.Len(t, tfconfig.Variable, len(expectedArgs))
+	for _, arg := range expectedArgs {
+		require.Equal(t, TFVariableConfig{}, tfconfig.Variable[arg])
+		require.Equal(t, fmt.Sprintf("${var.%s}", arg), tfconfig.Module[testRecipeName][arg])
+	}
+
+	// The values of the variables keep their structure and types.
+	actualBytes, err := os.ReadFile(getVariablesFilePath(workingDir))
+	require.NoError(t, err)
+
+	actual := map[string]any{}
+	err = json.Unmarshal(actualBytes, &actual)
+	require.NoError(t, err)
+
+	expectedContextBytes, err := json.Marshal(getTestRecipeContext())
+	require.NoError(t, err)
+	expectedContext := map[string]any{}
+	err = json.Unmarshal(expectedContextBytes, &expectedContext)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"tags":           envRecipe.Parameters["tags"],
+		"sku":            "P",
+		"databases":      resourceRecipe.Parameters["databases"],
+		"firewall_rules": resourceRecipe.Parameters["firewall_rules"],
+		"capacity":       float64(2),
+		"public_access":  false,
+		"expression":     "${var.sku}",
+		"optional_value": nil,
+		"context":        expectedContext,
+	}
+	require.Equal(t, expected, actual)
+}
+
+func Test_Save_NoVariables(t *testing.T) {
+	workingDir := t.TempDir()
+	envRecipe := recipes.EnvironmentDefinition{
+		Name:         testRecipeName,
+		TemplatePath: testTemplatePath,
+	}
+
+	tfconfig, err := New(context.Background(), testRecipeName, &envRecipe, &recipes.ResourceMetadata{Name: testRecipeName})
+	require.NoError(t, err)
+
+	err = tfconfig.Save(testcontext.New(t), workingDir)
+	require.NoError(t, err)
+
+	_, err = os.Stat(getVariablesFilePath(workingDir))
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/secret"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testGCPServiceAccountKeyCredentials = ucp_credentials.GCPCredential{
+		Kind: ucp_datamodel.GCPServiceAccountKeyCredentialKind,
+		ServiceAccountKey: &ucp_datamodel.GCPServiceAccountKeyCredentialProperties{
+			ServiceAccountKey: `{"type":"service_account","project_id":"my-project"}`,
+		},
+	}
+	testGCPWorkloadIdentityCredentials = ucp_credentials.GCPCredential{
+		Kind: ucp_datamodel.GCPWorkloadIdentityCredentialKind,
+		WorkloadIdentity: &ucp_datamodel.GCPWorkloadIdentityCredentialProperties{
+			ServiceAccountEmail: "radius@my-project.iam.gserviceaccount.com",
+		},
+	}
+)
+
+type mockGCPCredentialsProvider struct {
+	testCredential *ucp_credentials.GCPCredential
+	err            error
+}
+
+// Fetch returns mock GCP credentials for testing.
+func (p *mockGCPCredentialsProvider) Fetch(ctx context.Context, planeName, name string) (*ucp_credentials.GCPCredential, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	if p.testCredential == nil {
+		return nil, &secret.ErrNotFound{}
+	}
+
+	return p.testCredential, nil
+}
+
+func TestGCPProvider_ParseScope(t *testing.T) {
+	tests := []struct {
+		desc            string
+		envConfig       *recipes.Configuration
+		expectedProject string
+		expectedRegion  string
+		expectedErrMsg  string
+	}{
+		{
+			desc: "valid config scope",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/projects/my-project/regions/us-central1",
+					},
+				},
+			},
+			expectedProject: "my-project",
+			expectedRegion:  "us-central1",
+		},
+		{
+			desc: "scope without region",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/projects/my-project",
+					},
+				},
+			},
+			expectedProject: "my-project",
+		},
+		{
+			desc:      "nil config - no error",
+			envConfig: nil,
+		},
+		{
+			desc: "missing GCP provider config - no error",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{},
+			},
+		},
+		{
+			desc: "missing project segment - error",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/regions/us-central1",
+					},
+				},
+			},
+			expectedErrMsg: "invalid GCP provider scope \"/planes/gcp/gcp/regions/us-central1\" is configured on the Environment, project is required in the scope",
+		},
+		{
+			desc: "invalid scope - error",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "invalid",
+					},
+				},
+			},
+			expectedErrMsg: "invalid GCP provider scope \"invalid\" is configured on the Environment, error parsing: 'invalid' is not a valid resource id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p := &gcpProvider{}
+			project, region, err := p.parseScope(testcontext.New(t), tt.envConfig)
+			if tt.expectedErrMsg != "" {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedProject, project)
+				require.Equal(t, tt.expectedRegion, region)
+			}
+		})
+	}
+}
+
+func TestGCPProvider_FetchCredentials(t *testing.T) {
+	tests := []struct {
+		desc     string
+		provider *mockGCPCredentialsProvider
+		expected *ucp_credentials.GCPCredential
+		err      string
+	}{
+		{
+			desc:     "service account key credentials",
+			provider: &mockGCPCredentialsProvider{testCredential: &testGCPServiceAccountKeyCredentials},
+			expected: &testGCPServiceAccountKeyCredentials,
+		},
+		{
+			desc:     "workload identity credentials",
+			provider: &mockGCPCredentialsProvider{testCredential: &testGCPWorkloadIdentityCredentials},
+			expected: &testGCPWorkloadIdentityCredentials,
+		},
+		{
+			desc:     "credentials not registered",
+			provider: &mockGCPCredentialsProvider{},
+			expected: nil,
+		},
+		{
+			desc: "empty service account key",
+			provider: &mockGCPCredentialsProvider{testCredential: &ucp_credentials.GCPCredential{
+				Kind:              ucp_datamodel.GCPServiceAccountKeyCredentialKind,
+				ServiceAccountKey: &ucp_datamodel.GCPServiceAccountKeyCredentialProperties{},
+			}},
+			expected: nil,
+		},
+		{
+			desc:     "fetch error",
+			provider: &mockGCPCredentialsProvider{err: errors.New("failed to fetch credential")},
+			err:      "failed to fetch credential",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			credentials, err := fetchGCPCredentials(testcontext.New(t), tt.provider)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, credentials)
+		})
+	}
+}
+
+func TestGCPProvider_generateProviderConfigMap(t *testing.T) {
+	tests := []struct {
+		desc           string
+		project        string
+		region         string
+		credentials    *ucp_credentials.GCPCredential
+		expectedConfig map[string]any
+	}{
+		{
+			desc:        "service account key credential config",
+			project:     "my-project",
+			region:      "us-central1",
+			credentials: &testGCPServiceAccountKeyCredentials,
+			expectedConfig: map[string]any{
+				gcpProjectParam:     "my-project",
+				gcpRegionParam:      "us-central1",
+				gcpCredentialsParam: testGCPServiceAccountKeyCredentials.ServiceAccountKey.ServiceAccountKey,
+			},
+		},
+		{
+			desc:        "workload identity credential config",
+			project:     "my-project",
+			credentials: &testGCPWorkloadIdentityCredentials,
+			expectedConfig: map[string]any{
+				gcpProjectParam:                   "my-project",
+				gcpImpersonateServiceAccountParam: testGCPWorkloadIdentityCredentials.WorkloadIdentity.ServiceAccountEmail,
+			},
+		},
+		{
+			desc:    "missing credentials",
+			project: "my-project",
+			expectedConfig: map[string]any{
+				gcpProjectParam: "my-project",
+			},
+		},
+		{
+			desc: "invalid workload identity credentials",
+			credentials: &ucp_credentials.GCPCredential{
+				Kind:             ucp_datamodel.GCPWorkloadIdentityCredentialKind,
+				WorkloadIdentity: &ucp_datamodel.GCPWorkloadIdentityCredentialProperties{},
+			},
+			expectedConfig: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p := &gcpProvider{}
+			config := p.generateProviderConfigMap(tt.credentials, tt.project, tt.region)
+			require.Equal(t, tt.expectedConfig, config)
+		})
+	}
+}
@@ -43,6 +43,7 @@ func GetUCPConfiguredTerraformProviders(ucpConn sdk.Connection, secretProvider *
 	return map[string]Provider{
 		AWSProviderName:        NewAWSProvider(ucpConn, secretProvider),
 		AzureProviderName:      NewAzureProvider(ucpConn, secretProvider),
+		GCPProviderName:        NewGCPProvider(ucpConn, secretProvider),
 		KubernetesProviderName: &kubernetesProvider{},
 	}
 }
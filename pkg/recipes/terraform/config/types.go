@@ -23,11 +23,19 @@ const (
 	moduleVersionKey = "version"
 
 	mainConfigFileName = "main.tf.json"
+
+	// variablesFileName is the name of the file with the values of the input variables of the root module.
+	// Terraform loads this file automatically from the working directory.
+	// https://developer.hashicorp.com/terraform/language/values/variables#variable-definitions-tfvars-files
+	variablesFileName = "terraform.tfvars.json"
 )
 
 // TFModuleConfig is the type of Terraform module configuration.
 type TFModuleConfig map[string]any
 
+// TFVariableConfig is the type of Terraform input variable declaration. An empty declaration accepts a value of any type.
+type TFVariableConfig map[string]any
+
 // RecipeParams is the map of recipe parameters and its values.
 type RecipeParams map[string]any
 
@@ -82,6 +90,16 @@ type TerraformConfig struct {
 	// https://developer.hashicorp.com/terraform/language/modules/syntax
 	Module map[string]TFModuleConfig `json:"module"`
 
+	// Variable is the declaration of the input variables of the root module. Recipe parameters are passed to the module
+	// through these variables, and their values are written to terraform.tfvars.json by Save. Unlike module arguments
+	// in main.tf.json, the values in terraform.tfvars.json are not interpreted as Terraform expressions, so nested objects
+	// and lists keep their types and strings are passed as is.
+	// https://developer.hashicorp.com/terraform/language/values/variables
+	Variable map[string]TFVariableConfig `json:"variable,omitempty"`
+
+	// variableValues are the values of the input variables of the root module.
+	variableValues RecipeParams
+
 	// Output is the Terraform output configuration.
 	// https://developer.hashicorp.com/terraform/language/values/outputs
 	Output map[string]any `json:"output,omitempty"`
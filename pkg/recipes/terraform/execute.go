@@ -20,16 +20,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	install "github.com/hashicorp/hc-install"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
@@ -70,7 +74,7 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	installation, err := Install(ctx, i, options.RootDir, options.Install)
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -81,6 +85,7 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 	if err != nil {
 		return nil, err
 	}
+	tf := installation.Terraform
 
 	// Create Terraform config in the working directory
 	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
@@ -88,22 +93,32 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
-	if options.EnvConfig != nil {
-		// Set environment variables for the Terraform process.
-		err = e.setEnvironmentVariables(tf, options)
-		if err != nil {
-			return nil, err
-		}
+	// Set environment variables for the Terraform process.
+	err = e.setEnvironmentVariables(tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report apply progress only if the installed binary can stream apply events.
+	onApplyProgress := options.OnApplyProgress
+	if onApplyProgress != nil && !installation.Supports(FeatureApplyJSONProgress) {
+		logger.Info(fmt.Sprintf("%s version %s does not support apply progress reporting", installation.Binary.DisplayName(), installation.Version))
+		onApplyProgress = nil
 	}
 
 	// Run TF Init and Apply in the working directory
-	state, err := initAndApply(ctx, tf)
+	state, err := initAndApply(ctx, tf, onApplyProgress)
 	if err != nil {
 		return nil, err
 	}
 
+	// Remote backends don't need to be validated, the state is stored in the remote storage by Terraform.
+	if kubernetesBackendSuffix == "" {
+		return state, nil
+	}
+
 	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
+	// The Kubernetes secret backend is created by Terraform as a part of Terraform apply.
 	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
 	if err != nil {
 		return nil, fmt.Errorf("error getting kubernetes client: %w", err)
@@ -126,7 +141,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	installation, err := Install(ctx, i, options.RootDir, options.Install)
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -137,6 +152,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	if err != nil {
 		return err
 	}
+	tf := installation.Terraform
 
 	// Create Terraform config in the working directory
 	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
@@ -144,6 +160,17 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 		return err
 	}
 
+	// Set the CA bundle environment variables for the Terraform process.
+	err = setProcessEnvironmentVariables(tf)
+	if err != nil {
+		return err
+	}
+
+	// The state in remote backends is managed by Terraform, running destroy against a missing state is a no-op.
+	if kubernetesBackendSuffix == "" {
+		return initAndDestroy(ctx, tf)
+	}
+
 	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
 	// If the state file source has been deleted or wasn't created due to a failure during apply then
 	// terraform initialization will fail due to missing backend source.
@@ -185,7 +212,7 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	installation, err := Install(ctx, i, options.RootDir, options.Install)
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -196,12 +223,19 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 	if err != nil {
 		return nil, err
 	}
+	tf := installation.Terraform
 
 	_, err = getTerraformConfig(ctx, tf.WorkingDir(), options)
 	if err != nil {
 		return nil, err
 	}
 
+	// Set the CA bundle environment variables for the Terraform process.
+	err = setProcessEnvironmentVariables(tf)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := downloadAndInspect(ctx, tf, options)
 	if err != nil {
 		return nil, err
@@ -212,18 +246,65 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 	}, nil
 }
 
-// setEnvironmentVariables sets environment variables for the Terraform process by reading values from the recipe configuration.
-// Terraform process will use environment variables as input for the recipe deployment.
-func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options) error {
-	if options.EnvConfig == nil {
-		return nil
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and plan
+// in the working directory, returning the plan or an error if any of these steps fail. The plan is computed against
+// the state of the previous deployment of the recipe, if any.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Install Terraform
+	i := install.NewInstaller()
+	installation, err := Install(ctx, i, options.RootDir, options.Install)
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+	tf := installation.Terraform
+
+	// Create Terraform config in the working directory
+	_, err = e.generateConfig(ctx, tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set environment variables for the Terraform process.
+	err = e.setEnvironmentVariables(tf, options)
+	if err != nil {
+		return nil, err
 	}
 
+	return initAndPlan(ctx, tf)
+}
+
+// setEnvironmentVariables sets environment variables for the Terraform process by reading values from the recipe configuration.
+// Terraform process will use environment variables as input for the recipe deployment. The proxy and the CA bundle configured
+// for outbound requests are passed to Terraform and Git so that module downloads use the same proxy and trust the same
+// certificate authorities as Radius.
+func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options) error {
 	// Populate envVars with the environment variables from current process
 	envVars := splitEnvVar(os.Environ())
-	recipeConfig := &options.EnvConfig.RecipeConfig
 	var envVarUpdate bool
 
+	processEnvVars, err := httpclient.ProcessEnvironment(tf.WorkingDir())
+	if err != nil {
+		return err
+	}
+	if len(processEnvVars) > 0 {
+		envVarUpdate = true
+		maps.Copy(envVars, processEnvVars)
+	}
+
+	if options.EnvConfig == nil {
+		return setEnv(tf, envVars, envVarUpdate)
+	}
+
+	recipeConfig := &options.EnvConfig.RecipeConfig
 	if len(recipeConfig.Env.AdditionalProperties) > 0 {
 		envVarUpdate = true
 		for key, value := range recipeConfig.Env.AdditionalProperties {
@@ -247,11 +328,30 @@ func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options)
 		}
 	}
 
-	// Set the environment variables for the Terraform process
-	if envVarUpdate {
-		if err := tf.SetEnv(envVars); err != nil {
-			return fmt.Errorf("failed to set environment variables: %w", err)
-		}
+	return setEnv(tf, envVars, envVarUpdate)
+}
+
+// setProcessEnvironmentVariables sets the proxy and CA bundle environment variables for the Terraform process. It is used by
+// the operations that download modules without the environment variables of the recipe configuration.
+func setProcessEnvironmentVariables(tf *tfexec.Terraform) error {
+	processEnvVars, err := httpclient.ProcessEnvironment(tf.WorkingDir())
+	if err != nil {
+		return err
+	}
+
+	envVars := splitEnvVar(os.Environ())
+	maps.Copy(envVars, processEnvVars)
+	return setEnv(tf, envVars, len(processEnvVars) > 0)
+}
+
+// setEnv sets the environment variables for the Terraform process if they were updated.
+func setEnv(tf *tfexec.Terraform, envVars map[string]string, envVarUpdate bool) error {
+	if !envVarUpdate {
+		return nil
+	}
+
+	if err := tf.SetEnv(envVars); err != nil {
+		return fmt.Errorf("failed to set environment variables: %w", err)
 	}
 
 	return nil
@@ -292,18 +392,18 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		return "", err
 	}
 
-	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
+	backend, err := e.getBackend(options)
 	if err != nil {
-		return "", fmt.Errorf("error getting kubernetes client: %w", err)
+		return "", err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(kubernetesClient))
+	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backend)
 	if err != nil {
 		return "", err
 	}
 
 	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
+	// This is only set for the backend of type kubernetes, remote backends return an empty suffix.
 	var secretSuffix string
 	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
 		backendMap := backendDetails.(map[string]any)
@@ -343,6 +443,46 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 	return secretSuffix, nil
 }
 
+// getBackend returns the backend where Terraform stores the state of the recipe, as configured on the environment.
+// The Kubernetes backend is used when the environment doesn't configure a backend. The secrets of a remote backend
+// are read from the secrets loaded for the recipe and added to its settings.
+func (e *executor) getBackend(options Options) (backends.Backend, error) {
+	var backend *dm.TerraformBackendConfig
+	if options.EnvConfig != nil {
+		backend = options.EnvConfig.RecipeConfig.Terraform.Backend
+	}
+
+	if backend == nil || backend.Type == dm.TerraformBackendKubernetes {
+		kubernetesClient, err := e.kubernetesClients.ClientGoClient()
+		if err != nil {
+			return nil, fmt.Errorf("error getting kubernetes client: %w", err)
+		}
+
+		return backends.NewKubernetesBackend(kubernetesClient), nil
+	}
+
+	config := maps.Clone(backend.Config)
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	for name, secretReference := range backend.Secrets {
+		secretData, ok := options.Secrets[secretReference.Source]
+		if !ok {
+			return nil, fmt.Errorf("missing secret source: %s", secretReference.Source)
+		}
+
+		secretValue, ok := secretData.Data[secretReference.Key]
+		if !ok {
+			return nil, fmt.Errorf("missing secret key in secret store id: %s", secretReference.Source)
+		}
+
+		config[name] = secretValue
+	}
+
+	return backends.NewRemoteBackend(backend, config), nil
+}
+
 // getTerraformConfig initializes the Terraform json config with provided module source and saves it
 func getTerraformConfig(ctx context.Context, workingDir string, options Options) (*config.TerraformConfig, error) {
 	// Generate Terraform json config in the working directory
@@ -355,8 +495,15 @@ func getTerraformConfig(ctx context.Context, workingDir string, options Options)
 		return nil, ErrRecipeNameEmpty
 	}
 
+	envRecipe := options.EnvRecipe
+	if options.ModuleSource != "" {
+		definition := *options.EnvRecipe
+		definition.TemplatePath = options.ModuleSource
+		envRecipe = &definition
+	}
+
 	// Create Terraform configuration containing module information with the given recipe parameters.
-	tfConfig, err := config.New(ctx, localModuleName, options.EnvRecipe, options.ResourceRecipe)
+	tfConfig, err := config.New(ctx, localModuleName, envRecipe, options.ResourceRecipe)
 	if err != nil {
 		return nil, err
 	}
@@ -370,14 +517,15 @@ func getTerraformConfig(ctx context.Context, workingDir string, options Options)
 	return tfConfig, nil
 }
 
-// initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, error) {
+// initAndApply runs Terraform init and apply in the provided working directory. If onProgress is set,
+// apply is run with JSON output and the resource level progress is reported to onProgress.
+func initAndApply(ctx context.Context, tf *tfexec.Terraform, onProgress ApplyProgressFunc) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := runTraced(ctx, tf, "init", func(ctx context.Context) error { return tf.Init(ctx) }); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -388,7 +536,11 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 
 	// Apply Terraform configuration
 	logger.Info("Running Terraform apply")
-	if err := tf.Apply(ctx); err != nil {
+	if onProgress != nil {
+		if err := applyJSON(ctx, tf, onProgress); err != nil {
+			return nil, err
+		}
+	} else if err := runTraced(ctx, tf, "apply", func(ctx context.Context) error { return tf.Apply(ctx) }); err != nil {
 		return nil, fmt.Errorf("terraform apply failure: %w", err)
 	}
 
@@ -397,6 +549,54 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 	return tf.Show(ctx)
 }
 
+// applyJSON runs Terraform apply with JSON output and reports the resource level progress to onProgress.
+// With JSON output Terraform writes its error diagnostics to stdout, so they are added to the returned error.
+func applyJSON(ctx context.Context, tf *tfexec.Terraform, onProgress ApplyProgressFunc) error {
+	w := newProgressWriter(onProgress)
+	err := runTraced(ctx, tf, "apply", func(ctx context.Context) error { return tf.ApplyJSON(ctx, w) })
+	w.Close()
+
+	if err != nil {
+		if diagErr := w.Err(); diagErr != nil {
+			return fmt.Errorf("terraform apply failure: %w: %w", diagErr, err)
+		}
+		return fmt.Errorf("terraform apply failure: %w", err)
+	}
+
+	return nil
+}
+
+// initAndPlan runs Terraform init and plan in the provided working directory and returns the plan.
+func initAndPlan(ctx context.Context, tf *tfexec.Terraform) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := runTraced(ctx, tf, "init", func(ctx context.Context) error { return tf.Init(ctx) }); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+
+	// The state is not locked, so that planning does not block a concurrent deployment of the recipe.
+	logger.Info("Running Terraform plan")
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	err := runTraced(ctx, tf, "plan", func(ctx context.Context) error {
+		_, err := tf.Plan(ctx, tfexec.Out(planFile), tfexec.Lock(false))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	logger.Info("Fetching Terraform plan")
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
 func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -404,7 +604,7 @@ func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := runTraced(ctx, tf, "init", func(ctx context.Context) error { return tf.Init(ctx) }); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -414,7 +614,7 @@ func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
 
 	// Destroy Terraform configuration
 	logger.Info("Running Terraform destroy")
-	if err := tf.Destroy(ctx); err != nil {
+	if err := runTraced(ctx, tf, "destroy", func(ctx context.Context) error { return tf.Destroy(ctx) }); err != nil {
 		return fmt.Errorf("terraform destroy failure: %w", err)
 	}
 
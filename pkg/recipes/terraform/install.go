@@ -19,16 +19,20 @@ package terraform
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	install "github.com/hashicorp/hc-install"
 	"github.com/hashicorp/hc-install/product"
 	"github.com/hashicorp/hc-install/releases"
 	"github.com/hashicorp/hc-install/src"
 	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/components/trace"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -39,33 +43,84 @@ const (
 	installVerificationRetryDelaySecs = 3
 )
 
-// Install installs Terraform under /install in the provided Terraform root directory for the resource. It installs
-// the latest version of Terraform and returns the path to the installed Terraform executable. It returns an error
-// if the directory creation or Terraform installation fails.
-func Install(ctx context.Context, installer *install.Installer, tfDir string) (*tfexec.Terraform, error) {
+// InstallOptions represents the options to select and download the Terraform compatible binary.
+type InstallOptions struct {
+	// Binary is the binary to install, either "terraform" or "opentofu". Defaults to "terraform".
+	Binary string
+
+	// Version is the version of the binary to install. Defaults to the latest version of Terraform
+	// or DefaultOpenTofuVersion for OpenTofu.
+	Version string
+
+	// SourceURL is an optional base URL to download the binary from instead of the official release site,
+	// for example an internal mirror.
+	SourceURL string
+}
+
+// Installation represents an installed and verified Terraform compatible binary.
+type Installation struct {
+	// Terraform is the terraform-exec instance configured with the installed executable.
+	Terraform *tfexec.Terraform
+
+	// Binary is the installed binary.
+	Binary Binary
+
+	// Version is the version reported by the installed binary.
+	Version *version.Version
+}
+
+// Supports returns true if the installed binary supports the feature.
+func (i *Installation) Supports(feature Feature) bool {
+	return i.Binary.Supports(feature, i.Version)
+}
+
+// Install installs Terraform, or OpenTofu if selected in the options, under /install in the provided Terraform root
+// directory for the resource. It installs the configured version of the binary, or the latest version of Terraform if
+// none is configured, and verifies that the installed executable is the expected binary. It returns an error if the
+// directory creation, installation or verification fails.
+func Install(ctx context.Context, installer *install.Installer, tfDir string, options InstallOptions) (*Installation, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	binary, err := ParseBinary(options.Binary)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create Terraform installation directory
 	installDir := filepath.Join(tfDir, installSubDir)
 	if err := os.MkdirAll(installDir, 0755); err != nil {
 		return nil, fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
 	}
 
-	logger.Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
+	logger.Info(fmt.Sprintf("Installing %s in the directory: %q", binary.DisplayName(), installDir))
+
+	versionAttr := options.Version
+	if versionAttr == "" {
+		versionAttr = "latest"
+	}
 
 	installStartTime := time.Now()
 	// Re-visit this: consider checking if an existing installation of same version of Terraform is available.
 	// For initial iteration we will always install Terraform for every execution of the recipe driver.
-	execPath, err := installer.Ensure(ctx, []src.Source{
-		&releases.LatestVersion{
-			Product:    product.Terraform,
-			InstallDir: installDir,
-		},
-	})
+	var execPath string
+	if binary == BinaryOpenTofu {
+		// OpenTofu is downloaded with the shared client so that the proxy and CA bundle configuration is honored.
+		var client *http.Client
+		client, err = httpclient.NewClient()
+		if err == nil {
+			execPath, err = installOpenTofu(ctx, client, installDir, options.Version, options.SourceURL)
+		}
+	} else {
+		var source src.Source
+		source, err = terraformSource(installDir, options)
+		if err == nil {
+			execPath, err = installer.Ensure(ctx, []src.Source{source})
+		}
+	}
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 			[]attribute.KeyValue{
-				metrics.TerraformVersionAttrKey.String("latest"),
+				metrics.TerraformVersionAttrKey.String(versionAttr),
 				metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 			},
 		)
@@ -74,12 +129,12 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 		[]attribute.KeyValue{
-			metrics.TerraformVersionAttrKey.String("latest"),
+			metrics.TerraformVersionAttrKey.String(versionAttr),
 			metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 		},
 	)
 
-	logger.Info(fmt.Sprintf("Terraform latest version installed to: %q", execPath))
+	logger.Info(fmt.Sprintf("%s %s version installed to: %q", binary.DisplayName(), versionAttr, execPath))
 
 	// Create a new instance of tfexec.Terraform with current Terraform installation path
 	tf, err := NewTerraform(ctx, tfDir, execPath)
@@ -88,12 +143,13 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 	}
 
 	// Verify Terraform installation is complete before proceeding
+	var tfVersion *version.Version
 	for attempt := 0; attempt <= installVerificationRetryCount; attempt++ {
-		_, _, err = tf.Version(ctx, false)
+		tfVersion, _, err = tf.Version(ctx, false)
 		if err == nil {
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 				},
 			)
@@ -101,9 +157,10 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 		}
 		if attempt < installVerificationRetryCount {
 			logger.Info(fmt.Sprintf("Failed to verify Terraform installation completion: %s. Retrying after %d seconds", err.Error(), installVerificationRetryDelaySecs))
+			trace.AddRetryEvent(ctx, attempt+1, "verify terraform installation failed", err)
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 				},
 			)
@@ -113,8 +170,43 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 		return nil, fmt.Errorf("failed to verify Terraform installation completion after %d attempts. Error: %s", installVerificationRetryCount, err.Error())
 	}
 
+	// Verify the installed executable is the selected binary and that its version is supported.
+	if err := validateBinary(ctx, execPath, binary); err != nil {
+		return nil, err
+	}
+	if err := binary.checkMinimumVersion(tfVersion); err != nil {
+		return nil, err
+	}
+
 	// Configure Terraform logs once Terraform installation is complete
 	configureTerraformLogs(ctx, tf)
 
-	return tf, nil
+	return &Installation{
+		Terraform: tf,
+		Binary:    binary,
+		Version:   tfVersion,
+	}, nil
+}
+
+// terraformSource returns the hc-install source to install Terraform with the given options.
+func terraformSource(installDir string, options InstallOptions) (src.Source, error) {
+	if options.Version != "" {
+		v, err := version.NewVersion(options.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid terraform version %q: %w", options.Version, err)
+		}
+
+		return &releases.ExactVersion{
+			Product:    product.Terraform,
+			Version:    v,
+			InstallDir: installDir,
+			ApiBaseURL: options.SourceURL,
+		}, nil
+	}
+
+	return &releases.LatestVersion{
+		Product:    product.Terraform,
+		InstallDir: installDir,
+		ApiBaseURL: options.SourceURL,
+	}, nil
 }
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultOpenTofuVersion is the version of OpenTofu installed when no version is configured.
+	DefaultOpenTofuVersion = "1.8.5"
+
+	// DefaultOpenTofuSourceURL is the base URL of the OpenTofu release artifacts.
+	DefaultOpenTofuSourceURL = "https://github.com/opentofu/opentofu/releases/download"
+
+	openTofuExecutable = "tofu"
+)
+
+// installOpenTofu downloads the OpenTofu release archive for the current platform from sourceURL, verifies its checksum
+// against the published SHA256SUMS file and extracts the executable into installDir. It returns the path to the executable.
+func installOpenTofu(ctx context.Context, client *http.Client, installDir, tofuVersion, sourceURL string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if tofuVersion == "" {
+		tofuVersion = DefaultOpenTofuVersion
+	}
+	tofuVersion = strings.TrimPrefix(tofuVersion, "v")
+
+	if sourceURL == "" {
+		sourceURL = DefaultOpenTofuSourceURL
+	}
+	releaseURL := fmt.Sprintf("%s/v%s", strings.TrimSuffix(sourceURL, "/"), tofuVersion)
+
+	archiveName := fmt.Sprintf("tofu_%s_%s_%s.zip", tofuVersion, runtime.GOOS, runtime.GOARCH)
+	checksums, err := downloadOpenTofuChecksums(ctx, client, fmt.Sprintf("%s/tofu_%s_SHA256SUMS", releaseURL, tofuVersion))
+	if err != nil {
+		return "", err
+	}
+
+	expected, ok := checksums[archiveName]
+	if !ok {
+		return "", fmt.Errorf("checksum for %q is not published in the OpenTofu release %s", archiveName, tofuVersion)
+	}
+
+	archivePath := filepath.Join(installDir, archiveName)
+	defer os.Remove(archivePath)
+
+	logger.Info(fmt.Sprintf("Downloading OpenTofu %s from %q", tofuVersion, releaseURL))
+	actual, err := downloadFile(ctx, client, fmt.Sprintf("%s/%s", releaseURL, archiveName), archivePath)
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.EqualFold(actual, expected) {
+		return "", fmt.Errorf("checksum mismatch for %q: expected %s, got %s", archiveName, expected, actual)
+	}
+
+	return extractOpenTofu(archivePath, installDir)
+}
+
+// downloadOpenTofuChecksums downloads the SHA256SUMS file of an OpenTofu release and returns a map of file name to checksum.
+func downloadOpenTofuChecksums(ctx context.Context, client *http.Client, url string) (map[string]string, error) {
+	body, err := get(ctx, client, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		checksums[fields[1]] = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read OpenTofu checksums from %q: %w", url, err)
+	}
+
+	return checksums, nil
+}
+
+// downloadFile downloads url to path and returns the hex encoded SHA256 checksum of the content.
+func downloadFile(ctx context.Context, client *http.Client, url, path string) (string, error) {
+	body, err := get(ctx, client, url)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %q: %w", path, err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, hash), body); err != nil {
+		return "", fmt.Errorf("failed to download %q: %w", url, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// get sends a GET request to url and returns the response body if the request succeeds.
+func get(ctx context.Context, client *http.Client, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %q: %w", url, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to download %q: unexpected status code %d", url, resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// extractOpenTofu extracts the OpenTofu executable from the release archive into installDir.
+func extractOpenTofu(archivePath, installDir string) (string, error) {
+	r, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open OpenTofu archive %q: %w", archivePath, err)
+	}
+	defer r.Close()
+
+	name := openTofuExecutable
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+
+	for _, file := range r.File {
+		if file.Name != name {
+			continue
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			return "", fmt.Errorf("failed to read %q from OpenTofu archive: %w", name, err)
+		}
+		defer src.Close()
+
+		execPath := filepath.Join(installDir, name)
+		dst, err := os.OpenFile(execPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+		if err != nil {
+			return "", fmt.Errorf("failed to create %q: %w", execPath, err)
+		}
+		defer dst.Close()
+
+		if _, err := io.Copy(dst, src); err != nil {
+			return "", fmt.Errorf("failed to extract %q: %w", execPath, err)
+		}
+
+		return execPath, nil
+	}
+
+	return "", fmt.Errorf("the OpenTofu archive %q does not contain the %q executable", archivePath, name)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newOpenTofuReleaseServer(t *testing.T, archive []byte, checksum string) *httptest.Server {
+	archiveName := fmt.Sprintf("tofu_1.8.5_%s_%s.zip", runtime.GOOS, runtime.GOARCH)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1.8.5/tofu_1.8.5_SHA256SUMS", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s  %s\n%s  tofu_1.8.5_other_arch.zip\n", checksum, archiveName, checksum)
+	})
+	mux.HandleFunc("/v1.8.5/"+archiveName, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func makeOpenTofuArchive(t *testing.T, name string, content []byte) []byte {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	f, err := w.Create(name)
+	require.NoError(t, err)
+	_, err = f.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestInstallOpenTofu(t *testing.T) {
+	name := openTofuExecutable
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+
+	t.Run("success", func(t *testing.T) {
+		archive := makeOpenTofuArchive(t, name, []byte("tofu binary"))
+		sum := sha256.Sum256(archive)
+		server := newOpenTofuReleaseServer(t, archive, hex.EncodeToString(sum[:]))
+
+		installDir := t.TempDir()
+		execPath, err := installOpenTofu(context.Background(), server.Client(), installDir, "v1.8.5", server.URL+"/")
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(execPath)
+		require.NoError(t, err)
+		require.Equal(t, "tofu binary", string(content))
+
+		// Only the executable remains in the install directory.
+		entries, err := os.ReadDir(installDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		archive := makeOpenTofuArchive(t, name, []byte("tofu binary"))
+		server := newOpenTofuReleaseServer(t, archive, "0000")
+
+		_, err := installOpenTofu(context.Background(), server.Client(), t.TempDir(), "1.8.5", server.URL)
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("missing executable", func(t *testing.T) {
+		archive := makeOpenTofuArchive(t, "README.md", []byte("readme"))
+		sum := sha256.Sum256(archive)
+		server := newOpenTofuReleaseServer(t, archive, hex.EncodeToString(sum[:]))
+
+		_, err := installOpenTofu(context.Background(), server.Client(), t.TempDir(), "1.8.5", server.URL)
+		require.ErrorContains(t, err, "does not contain")
+	})
+
+	t.Run("release not found", func(t *testing.T) {
+		server := newOpenTofuReleaseServer(t, nil, "0000")
+
+		_, err := installOpenTofu(context.Background(), server.Client(), t.TempDir(), "1.9.0", server.URL)
+		require.ErrorContains(t, err, "unexpected status code 404")
+	})
+}
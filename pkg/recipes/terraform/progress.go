@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+)
+
+const (
+	// Terraform machine readable UI message types emitted by `terraform apply -json`.
+	// https://developer.hashicorp.com/terraform/internals/machine-readable-ui
+	applyStartMessageType    = "apply_start"
+	applyProgressMessageType = "apply_progress"
+	applyCompleteMessageType = "apply_complete"
+	applyErroredMessageType  = "apply_errored"
+	diagnosticMessageType    = "diagnostic"
+
+	// diagnosticLevelError is the level of diagnostic messages which describe why Terraform failed.
+	diagnosticLevelError = "error"
+)
+
+// ApplyProgress represents the resource level progress of a Terraform apply. Resources are counted
+// regardless of the action Terraform takes on them (create, update, delete or replace).
+type ApplyProgress struct {
+	// CurrentResource is the address of the resource Terraform most recently reported progress for.
+	CurrentResource string
+
+	// Applying is the number of resources for which apply has started but not yet finished.
+	Applying int
+
+	// Applied is the number of resources which have been applied successfully.
+	Applied int
+
+	// Errored is the number of resources which have failed to apply.
+	Errored int
+}
+
+// ApplyProgressFunc is called with the updated progress when Terraform reports a resource level event
+// that changes the progress.
+type ApplyProgressFunc func(progress ApplyProgress)
+
+// applyMessage is the subset of a Terraform machine readable UI message used to track apply progress.
+type applyMessage struct {
+	Level string `json:"@level"`
+	Type  string `json:"type"`
+	Hook  struct {
+		Resource struct {
+			Addr string `json:"addr"`
+		} `json:"resource"`
+	} `json:"hook"`
+	Diagnostic struct {
+		Summary string `json:"summary"`
+		Detail  string `json:"detail"`
+	} `json:"diagnostic"`
+}
+
+// progressWriter is an io.Writer which parses the JSON stream written by `terraform apply -json`,
+// reports the resource level progress to the callback and collects error diagnostics.
+//
+// The callback is called from a separate goroutine so that a slow callback never blocks the Terraform
+// output pipe. If the progress changes while the callback is running, only the latest progress is
+// reported once the callback returns. Close must be called once Terraform has exited.
+type progressWriter struct {
+	mu          sync.Mutex
+	buf         bytes.Buffer
+	progress    ApplyProgress
+	diagnostics []string
+
+	onUpdate ApplyProgressFunc
+	notify   chan struct{}
+	done     chan struct{}
+}
+
+// newProgressWriter creates a progressWriter and starts the goroutine which calls onUpdate.
+func newProgressWriter(onUpdate ApplyProgressFunc) *progressWriter {
+	w := &progressWriter{
+		onUpdate: onUpdate,
+		notify:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+
+	go w.report()
+	return w
+}
+
+// Write implements the io.Writer interface. Terraform writes one JSON message per line, and a line
+// can be split across multiple writes, so incomplete lines are buffered until the newline is received.
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf.Write(p)
+	changed := false
+	for {
+		line, err := w.buf.ReadBytes('\n')
+		if err != nil {
+			// Put the incomplete line back so it can be completed by the next write.
+			w.buf.Reset()
+			w.buf.Write(line)
+			break
+		}
+
+		changed = w.handleLine(line) || changed
+	}
+
+	if changed {
+		// Wake up the reporting goroutine without blocking. If a notification is already pending
+		// the reporting goroutine will pick up the latest progress anyway.
+		select {
+		case w.notify <- struct{}{}:
+		default:
+		}
+	}
+
+	return len(p), nil
+}
+
+// handleLine updates the progress and diagnostics from a single line of Terraform output, and returns
+// true if the progress changed. Lines which are not apply or error diagnostic messages are ignored.
+func (w *progressWriter) handleLine(line []byte) bool {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return false
+	}
+
+	msg := applyMessage{}
+	if err := json.Unmarshal(line, &msg); err != nil {
+		return false
+	}
+
+	previous := w.progress
+	switch msg.Type {
+	case applyStartMessageType:
+		w.progress.Applying++
+	case applyProgressMessageType:
+		// Progress messages are emitted periodically for resources which are still being applied and
+		// only refresh the current resource.
+	case applyCompleteMessageType:
+		w.progress.Applying = max(w.progress.Applying-1, 0)
+		w.progress.Applied++
+	case applyErroredMessageType:
+		w.progress.Applying = max(w.progress.Applying-1, 0)
+		w.progress.Errored++
+	case diagnosticMessageType:
+		if msg.Level == diagnosticLevelError {
+			w.diagnostics = append(w.diagnostics, formatDiagnostic(msg.Diagnostic.Summary, msg.Diagnostic.Detail))
+		}
+		return false
+	default:
+		return false
+	}
+
+	w.progress.CurrentResource = msg.Hook.Resource.Addr
+	return w.progress != previous
+}
+
+// report calls onUpdate with the latest progress every time it is notified, until the writer is closed.
+func (w *progressWriter) report() {
+	defer close(w.done)
+
+	var last ApplyProgress
+	for range w.notify {
+		w.mu.Lock()
+		progress := w.progress
+		w.mu.Unlock()
+
+		if progress == last {
+			continue
+		}
+
+		last = progress
+		if w.onUpdate != nil {
+			w.onUpdate(progress)
+		}
+	}
+}
+
+// Close stops reporting progress and waits for the pending progress update to be reported.
+func (w *progressWriter) Close() {
+	close(w.notify)
+	<-w.done
+}
+
+// Err returns an error containing the error diagnostics reported by Terraform, or nil if there were none.
+func (w *progressWriter) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.diagnostics) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(w.diagnostics, "; "))
+}
+
+// formatDiagnostic formats the summary and detail of a Terraform diagnostic as a single message.
+func formatDiagnostic(summary, detail string) string {
+	if detail == "" {
+		return summary
+	}
+
+	return summary + ": " + detail
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testVersionMessage       = `{"@level":"info","@message":"Terraform 1.6.0","@module":"terraform.ui","terraform":"1.6.0","type":"version","ui":"1.2"}`
+	testApplyStartPet        = `{"@level":"info","@message":"random_pet.pet: Creating...","hook":{"resource":{"addr":"random_pet.pet","resource_type":"random_pet"},"action":"create"},"type":"apply_start"}`
+	testApplyStartBucket     = `{"@level":"info","@message":"aws_s3_bucket.bucket: Creating...","hook":{"resource":{"addr":"aws_s3_bucket.bucket","resource_type":"aws_s3_bucket"},"action":"create"},"type":"apply_start"}`
+	testApplyProgressBucket  = `{"@level":"info","@message":"aws_s3_bucket.bucket: Still creating... [10s elapsed]","hook":{"resource":{"addr":"aws_s3_bucket.bucket"},"action":"create","elapsed_seconds":10},"type":"apply_progress"}`
+	testApplyCompletePet     = `{"@level":"info","@message":"random_pet.pet: Creation complete after 0s","hook":{"resource":{"addr":"random_pet.pet"},"action":"create","id_key":"id","id_value":"pet"},"type":"apply_complete"}`
+	testApplyErroredBucket   = `{"@level":"info","@message":"aws_s3_bucket.bucket: Creation errored after 12s","hook":{"resource":{"addr":"aws_s3_bucket.bucket"},"action":"create","elapsed_seconds":12},"type":"apply_errored"}`
+	testApplyStartDeletePet  = `{"@level":"info","@message":"random_pet.old: Destroying...","hook":{"resource":{"addr":"random_pet.old"},"action":"delete"},"type":"apply_start"}`
+	testApplyCompleteDelete  = `{"@level":"info","@message":"random_pet.old: Destruction complete after 0s","hook":{"resource":{"addr":"random_pet.old"},"action":"delete"},"type":"apply_complete"}`
+	testChangeSummaryMessage = `{"@level":"info","@message":"Apply complete! Resources: 1 added, 0 changed, 0 destroyed.","changes":{"add":1,"change":0,"remove":0,"operation":"apply"},"type":"change_summary"}`
+	testErrorDiagnostic      = `{"@level":"error","@message":"Error: creating S3 Bucket","diagnostic":{"severity":"error","summary":"creating S3 Bucket (bucket)","detail":"BucketAlreadyExists"},"type":"diagnostic"}`
+	testWarningDiagnostic    = `{"@level":"warn","@message":"Warning: deprecated attribute","diagnostic":{"severity":"warning","summary":"Deprecated attribute","detail":"acl is deprecated"},"type":"diagnostic"}`
+)
+
+func Test_ProgressWriter_HandleLine(t *testing.T) {
+	w := &progressWriter{}
+
+	tests := []struct {
+		line     string
+		changed  bool
+		expected ApplyProgress
+	}{
+		{line: testVersionMessage, changed: false, expected: ApplyProgress{}},
+		{line: testApplyStartPet, changed: true, expected: ApplyProgress{CurrentResource: "random_pet.pet", Applying: 1}},
+		{line: testApplyStartBucket, changed: true, expected: ApplyProgress{CurrentResource: "aws_s3_bucket.bucket", Applying: 2}},
+		{line: testApplyProgressBucket, changed: false, expected: ApplyProgress{CurrentResource: "aws_s3_bucket.bucket", Applying: 2}},
+		{line: testApplyCompletePet, changed: true, expected: ApplyProgress{CurrentResource: "random_pet.pet", Applying: 1, Applied: 1}},
+		{line: testApplyProgressBucket, changed: true, expected: ApplyProgress{CurrentResource: "aws_s3_bucket.bucket", Applying: 1, Applied: 1}},
+		{line: testApplyErroredBucket, changed: true, expected: ApplyProgress{CurrentResource: "aws_s3_bucket.bucket", Applying: 0, Applied: 1, Errored: 1}},
+		{line: testApplyStartDeletePet, changed: true, expected: ApplyProgress{CurrentResource: "random_pet.old", Applying: 1, Applied: 1, Errored: 1}},
+		{line: testApplyCompleteDelete, changed: true, expected: ApplyProgress{CurrentResource: "random_pet.old", Applying: 0, Applied: 2, Errored: 1}},
+		{line: testChangeSummaryMessage, changed: false, expected: ApplyProgress{CurrentResource: "random_pet.old", Applying: 0, Applied: 2, Errored: 1}},
+		{line: "not a json message", changed: false, expected: ApplyProgress{CurrentResource: "random_pet.old", Applying: 0, Applied: 2, Errored: 1}},
+	}
+
+	for _, tc := range tests {
+		changed := w.handleLine([]byte(tc.line))
+		require.Equal(t, tc.changed, changed, tc.line)
+		require.Equal(t, tc.expected, w.progress, tc.line)
+	}
+}
+
+func Test_ProgressWriter_ReportsLatestProgress(t *testing.T) {
+	reported := []ApplyProgress{}
+	w := newProgressWriter(func(progress ApplyProgress) {
+		reported = append(reported, progress)
+	})
+
+	for _, line := range []string{testVersionMessage, testApplyStartPet, testApplyStartBucket, testApplyCompletePet, testApplyErroredBucket} {
+		n, err := w.Write([]byte(line + "\n"))
+		require.NoError(t, err)
+		require.Equal(t, len(line)+1, n)
+	}
+	w.Close()
+
+	// Updates can be coalesced, but the latest progress must always be reported.
+	require.NotEmpty(t, reported)
+	require.Equal(t, ApplyProgress{CurrentResource: "aws_s3_bucket.bucket", Applied: 1, Errored: 1}, reported[len(reported)-1])
+	require.NoError(t, w.Err())
+}
+
+func Test_ProgressWriter_PartialWrites(t *testing.T) {
+	w := &progressWriter{notify: make(chan struct{}, 1)}
+
+	// A single message split across multiple writes, followed by two messages in one write.
+	_, err := w.Write([]byte(testApplyStartPet[:20]))
+	require.NoError(t, err)
+	require.Equal(t, ApplyProgress{}, w.progress)
+
+	_, err = w.Write([]byte(testApplyStartPet[20:] + "\n"))
+	require.NoError(t, err)
+	require.Equal(t, ApplyProgress{CurrentResource: "random_pet.pet", Applying: 1}, w.progress)
+
+	_, err = w.Write([]byte(testApplyCompletePet + "\n" + testApplyStartBucket))
+	require.NoError(t, err)
+	require.Equal(t, ApplyProgress{CurrentResource: "random_pet.pet", Applied: 1}, w.progress)
+
+	_, err = w.Write([]byte("\n"))
+	require.NoError(t, err)
+	require.Equal(t, ApplyProgress{CurrentResource: "aws_s3_bucket.bucket", Applying: 1, Applied: 1}, w.progress)
+}
+
+func Test_ProgressWriter_SlowCallbackDoesNotBlockWrite(t *testing.T) {
+	release := make(chan struct{})
+	w := newProgressWriter(func(progress ApplyProgress) {
+		<-release
+	})
+
+	writesDone := make(chan struct{})
+	go func() {
+		defer close(writesDone)
+		for _, line := range []string{testApplyStartPet, testApplyStartBucket, testApplyCompletePet, testApplyErroredBucket} {
+			_, _ = w.Write([]byte(line + "\n"))
+		}
+	}()
+
+	select {
+	case <-writesDone:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "writes were blocked by the progress callback")
+	}
+
+	close(release)
+	w.Close()
+}
+
+func Test_ProgressWriter_ErrorDiagnostics(t *testing.T) {
+	w := newProgressWriter(nil)
+
+	for _, line := range []string{testApplyStartBucket, testWarningDiagnostic, testApplyErroredBucket, testErrorDiagnostic} {
+		_, err := w.Write([]byte(line + "\n"))
+		require.NoError(t, err)
+	}
+	w.Close()
+
+	err := w.Err()
+	require.EqualError(t, err, "creating S3 Bucket (bucket): BucketAlreadyExists")
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/components/trace"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// runTraced runs a Terraform command, such as init or apply, in a span which records the duration, the exit code and the
+// truncated standard error of the Terraform process, so slow recipe executions can be broken down. The standard error is
+// still streamed to the Radius logs.
+func runTraced(ctx context.Context, tf *tfexec.Terraform, command string, run func(ctx context.Context) error) error {
+	ctx, span := trace.StartProcessSpan(ctx, trace.BackendTracerName, filepath.Base(tf.ExecPath()), command)
+
+	stderr := &trace.StderrBuffer{}
+	tf.SetStderr(io.MultiWriter(&tfLogWrapper{logger: ucplog.FromContextOrDiscard(ctx), isStdErr: true}, stderr))
+
+	err := run(ctx)
+	trace.EndProcessSpan(span, err, stderr.String())
+	return err
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/components/trace"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test_RunTraced(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	dir := t.TempDir()
+	execPath := filepath.Join(dir, "terraform")
+	err := os.WriteFile(execPath, []byte("#!/bin/sh\necho 'Error: Failed to download module' >&2\nexit 1\n"), 0755)
+	require.NoError(t, err)
+
+	tf, err := tfexec.NewTerraform(dir, execPath)
+	require.NoError(t, err)
+
+	err = runTraced(context.Background(), tf, "get", func(ctx context.Context) error { return tf.Get(ctx) })
+	require.ErrorContains(t, err, "Failed to download module")
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "terraform get", ended[0].Name())
+	require.Contains(t, ended[0].Attributes(), trace.ProcessExitCodeKey.Int(1))
+
+	events := ended[0].Events()
+	require.Equal(t, trace.ProcessStderrEventName, events[0].Name)
+	require.Contains(t, events[0].Attributes, trace.ProcessStderrKey.String("Error: Failed to download module\n"))
+}
@@ -34,6 +34,9 @@ import (
 const (
 	executionSubDir                = "deploy"
 	workingDirFileMode fs.FileMode = 0700
+
+	// planFileName is the name of the file in the working directory which stores the output of terraform plan.
+	planFileName = "radius.tfplan"
 )
 
 //go:generate mockgen -typed -destination=./mock_executor.go -package=terraform -self_package github.com/radius-project/radius/pkg/recipes/terraform github.com/radius-project/radius/pkg/recipes/terraform TerraformExecutor
@@ -47,6 +50,10 @@ type TerraformExecutor interface {
 
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec,
+	// returning the changes that apply would make without making them.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
 }
 
 // Options represents the options required to build inputs to interact with Terraform.
@@ -54,18 +61,29 @@ type Options struct {
 	// RootDir is the root directory of where Terraform is installed and executed for a specific recipe deployment/deletion request.
 	RootDir string
 
+	// Install is the installation level configuration of the Terraform compatible binary, such as Terraform or OpenTofu.
+	Install InstallOptions
+
 	// EnvConfig is the kubernetes runtime and cloud provider configuration for the Radius Environment in which the application consuming the terraform recipe will be deployed.
 	EnvConfig *recipes.Configuration
 
 	// EnvRecipe is the recipe metadata associated with the Radius Environment in which the application consuming the terraform recipe will be deployed.
 	EnvRecipe *recipes.EnvironmentDefinition
 
+	// ModuleSource overrides the template path of the environment recipe as the module source in the generated Terraform
+	// configuration. It is used for module sources which include credentials, so that they are not written to the logs.
+	ModuleSource string
+
 	// ResourceRecipe is recipe metadata associated with the Radius resource deploying the Terraform recipe.
 	ResourceRecipe *recipes.ResourceMetadata
 
 	// Secrets represents a map of secrets required for recipe execution.
 	// The map's key represents the secretStoreIDs while the value represents the secret data.
 	Secrets map[string]recipes.SecretData
+
+	// OnApplyProgress is an optional callback which receives the resource level progress of Terraform apply.
+	// When set, apply is run with JSON output so that the progress can be parsed from the event stream.
+	OnApplyProgress ApplyProgressFunc
 }
 
 // NewTerraform creates a working directory for Terraform execution and new Terraform executor with Terraform logs enabled.
@@ -96,8 +114,8 @@ func createWorkingDir(ctx context.Context, tfDir string) (string, error) {
 	return workingDir, nil
 }
 
-// GetProviderEnvSecretIDs parses the envConfig to extract secret IDs configured in providers configuration and environment variables
-// and returns a map of secret store IDs and corresponding slice of keys.
+// GetProviderEnvSecretIDs parses the envConfig to extract secret IDs configured in providers configuration, environment variables
+// and the Terraform backend, and returns a map of secret store IDs and corresponding slice of keys.
 func GetProviderEnvSecretIDs(envConfig recipes.Configuration) map[string][]string {
 	providerSecretIDs := make(map[string][]string)
 	var mu sync.Mutex
@@ -108,6 +126,11 @@ func GetProviderEnvSecretIDs(envConfig recipes.Configuration) map[string][]strin
 	// Extract secrets from environment variables
 	extractEnvSecretIDs(envConfig.RecipeConfig.EnvSecrets, providerSecretIDs, &mu)
 
+	// Extract secrets from Terraform backend configuration
+	if backend := envConfig.RecipeConfig.Terraform.Backend; backend != nil {
+		extractEnvSecretIDs(backend.Secrets, providerSecretIDs, &mu)
+	}
+
 	return providerSecretIDs
 }
 
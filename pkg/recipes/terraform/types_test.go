@@ -162,6 +162,24 @@ func TestGetProviderEnvSecretIDs(t *testing.T) {
 				"my-env-secret-source-id": {"secret-key-env"},
 			},
 		},
+		{
+			name: "backend secret populated",
+			envConfig: recipes.Configuration{
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Backend: &datamodel.TerraformBackendConfig{
+							Type: datamodel.TerraformBackendS3,
+							Secrets: map[string]datamodel.SecretReference{
+								"secret_key": {Source: "my-backend-secret-source-id", Key: "secret-key-backend"},
+							},
+						},
+					},
+				},
+			},
+			want: map[string][]string{
+				"my-backend-secret-source-id": {"secret-key-backend"},
+			},
+		},
 		{
 			name: "secrets are declared nil",
 			envConfig: recipes.Configuration{
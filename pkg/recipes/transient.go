@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+// transientErrorCodes are the error codes returned by Azure, AWS and UCP for failures which are expected to succeed
+// when the operation is retried: conflicts with an operation still in progress (such as a slow delete), throttling, and
+// eventual consistency of newly created identities.
+var transientErrorCodes = map[string]bool{
+	"AnotherOperationInProgress": true,
+	"Conflict":                   true,
+	"OperationAborted":           true,
+	"PrincipalNotFound":          true,
+	"RequestLimitExceeded":       true,
+	"RetryableError":             true,
+	"ServiceUnavailable":         true,
+	"Throttled":                  true,
+	"ThrottlingException":        true,
+	"TooManyRequests":            true,
+}
+
+// transientErrorMessage matches the messages of transient failures reported as plain text, for example by the
+// Terraform providers which include the HTTP status code or the error code of the cloud provider in the message.
+var transientErrorMessage = regexp.MustCompile(`(?i)(status ?code[ :=]*"?(409|429|503)\b|TooManyRequests|Throttling|RequestLimitExceeded|AnotherOperationInProgress|PrincipalNotFound|OperationAborted|conflicting conditional operation)`)
+
+// IsTransientError returns true if the recipe execution failed because of a transient cloud error and can be retried.
+//
+// Only failures of the deployment itself are transient. Recipe errors which are reported before the deployment
+// starts, or after the deployment succeeded (such as invalid outputs or the deletion of obsolete resources), are never
+// transient, so retrying the execution does not repeat side effects which already completed.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	recipeError := &RecipeError{}
+	if errors.As(err, &recipeError) {
+		if recipeError.ErrorDetails.Code != RecipeDeploymentFailed || recipeError.DeploymentStatus != util.ExecutionError {
+			return false
+		}
+
+		return isTransientErrorDetails(&recipeError.ErrorDetails)
+	}
+
+	responseError := &azcore.ResponseError{}
+	if errors.As(err, &responseError) {
+		switch responseError.StatusCode {
+		case http.StatusConflict, http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			return true
+		}
+		return transientErrorCodes[responseError.ErrorCode]
+	}
+
+	return transientErrorMessage.MatchString(err.Error())
+}
+
+// isTransientErrorDetails returns true if the error details, or any of the nested details, describe a transient failure.
+// Deployment errors are reported as a generic error with the failures of the individual resources as details.
+func isTransientErrorDetails(details *v1.ErrorDetails) bool {
+	if details == nil {
+		return false
+	}
+
+	if transientErrorCodes[details.Code] || transientErrorMessage.MatchString(details.Message) {
+		return true
+	}
+
+	for _, detail := range details.Details {
+		if isTransientErrorDetails(detail) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{
+			name:      "nil",
+			err:       nil,
+			transient: false,
+		},
+		{
+			name:      "deployment conflict in nested details",
+			err:       NewRecipeError(RecipeDeploymentFailed, "failed to deploy recipe", util.ExecutionError, &v1.ErrorDetails{Code: "DeploymentFailed", Details: []*v1.ErrorDetails{{Code: "Conflict", Message: "the resource is being deleted"}}}),
+			transient: true,
+		},
+		{
+			name:      "terraform throttling message",
+			err:       NewRecipeError(RecipeDeploymentFailed, "creating S3 Bucket: operation error S3: CreateBucket, https response error StatusCode: 429, ThrottlingException", util.ExecutionError),
+			transient: true,
+		},
+		{
+			name:      "terraform eventual consistency message",
+			err:       NewRecipeError(RecipeDeploymentFailed, "authorization.RoleAssignmentsClient#Create: Code=\"PrincipalNotFound\"", util.ExecutionError),
+			transient: true,
+		},
+		{
+			name:      "permanent deployment error",
+			err:       NewRecipeError(RecipeDeploymentFailed, "failed to deploy recipe", util.ExecutionError, &v1.ErrorDetails{Code: "InvalidTemplate", Message: "invalid template"}),
+			transient: false,
+		},
+		{
+			name:      "setup error",
+			err:       NewRecipeError(RecipeDeploymentFailed, "TooManyRequests", util.RecipeSetupError),
+			transient: false,
+		},
+		{
+			name:      "garbage collection error",
+			err:       NewRecipeError(RecipeGarbageCollectionFailed, "TooManyRequests", util.ExecutionError),
+			transient: false,
+		},
+		{
+			name:      "wrapped recipe error",
+			err:       fmt.Errorf("failed: %w", NewRecipeError(RecipeDeploymentFailed, "failed", util.ExecutionError, &v1.ErrorDetails{Code: "TooManyRequests"})),
+			transient: true,
+		},
+		{
+			name:      "azure response error",
+			err:       &azcore.ResponseError{StatusCode: http.StatusTooManyRequests},
+			transient: true,
+		},
+		{
+			name:      "azure not found",
+			err:       &azcore.ResponseError{StatusCode: http.StatusNotFound, ErrorCode: "NotFound"},
+			transient: false,
+		},
+		{
+			name:      "other error",
+			err:       errors.New("failed to execute recipe"),
+			transient: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.transient, IsTransientError(tt.err))
+		})
+	}
+}
@@ -32,6 +32,8 @@ type Configuration struct {
 	Providers datamodel.Providers
 	// Simulated represents whether the environment is simulated or not.
 	Simulated bool
+	// CostAttribution represents the cost attribution configuration of the environment.
+	CostAttribution *datamodel.CostAttributionExtension
 
 	RecipeConfig datamodel.RecipeConfigProperties
 }
@@ -65,6 +67,10 @@ type EnvironmentDefinition struct {
 	TemplateVersion string
 	// Allows insecure connections to registry without SSL check.
 	PlainHTTP bool
+	// ExternalDriver represents the name of the external recipe driver which executes the recipe. Only used for external recipes.
+	ExternalDriver string
+	// AllowedOutputResourceTypes represents the resource types of the output resources the recipe is allowed to create. All resource types are allowed when empty.
+	AllowedOutputResourceTypes []string
 }
 
 // ResourceMetadata represents recipe details provided while creating a portable resource.
@@ -85,12 +91,15 @@ const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
 
+	// TemplateKindExternal is the template kind of recipes executed by an external recipe driver registered by the operator.
+	TemplateKindExternal = "external"
+
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindExternal}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -108,6 +117,12 @@ type RecipeOutput struct {
 	Status *rpv1.RecipeStatus
 }
 
+// RecipePlan represents the changes the deployment of a recipe would make, computed without deploying the recipe.
+type RecipePlan struct {
+	// Changes represents the changes to the resources deployed by the recipe.
+	Changes []datamodel.RecipeResourceChange
+}
+
 // SecretData represents secrets data and includes secret type and a map of secret keys to their values.
 type SecretData struct {
 	Type string            `json:"type"`
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploymentpolicy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+const (
+	// OverrideTag is the tag of a resource which bypasses the deployment policy of its environment, if the policy
+	// allows overrides. The value of the tag should describe the reason of the override.
+	OverrideTag = "radapp.io/deployment-policy-override"
+
+	// maxWindowDuration is the maximum duration of a deployment window.
+	maxWindowDuration = 7 * 24 * time.Hour
+)
+
+// window is a parsed deployment window.
+type window struct {
+	schedule *schedule
+	duration time.Duration
+	location *time.Location
+}
+
+// RejectedError is returned by Check when a deployment is not allowed by the deployment policy.
+type RejectedError struct {
+	// Reason describes why the deployment is rejected.
+	Reason string
+
+	// NextWindow is the start of the next deployment window. Nil if the environment is frozen or no window starts
+	// within the next seven days.
+	NextWindow *time.Time
+
+	// Message is the message of the deployment policy.
+	Message string
+}
+
+// Error returns a string describing why the deployment is rejected and when it can be retried.
+func (e *RejectedError) Error() string {
+	message := e.Reason
+	if e.NextWindow != nil {
+		message += fmt.Sprintf(", the next deployment window opens at %s", e.NextWindow.Format(time.RFC3339))
+	}
+	if e.Message != "" {
+		message += ": " + e.Message
+	}
+
+	return message
+}
+
+// Validate returns an error if a deployment window of the policy has an invalid schedule, duration or time zone.
+func Validate(policy datamodel.DeploymentPolicy) error {
+	_, err := parseWindows(policy.Windows)
+	return err
+}
+
+// Check returns a *RejectedError if a deployment at the given time is not allowed by the policy. The tags are the tags
+// of the deployed resource, which can override the policy if the policy allows it. It returns an error if the policy is
+// invalid.
+func Check(policy datamodel.DeploymentPolicy, now time.Time, tags map[string]string) error {
+	if IsOverridden(policy, tags) {
+		return nil
+	}
+
+	if policy.Freeze {
+		return &RejectedError{Reason: "deployments are frozen", Message: policy.Message}
+	}
+
+	windows, err := parseWindows(policy.Windows)
+	if err != nil {
+		return err
+	}
+
+	if len(windows) == 0 {
+		return nil
+	}
+
+	var next *time.Time
+	for _, w := range windows {
+		if w.isOpen(now) {
+			return nil
+		}
+
+		if start := w.next(now); start != nil && (next == nil || start.Before(*next)) {
+			next = start
+		}
+	}
+
+	return &RejectedError{Reason: "deployments are only allowed within the deployment windows", NextWindow: next, Message: policy.Message}
+}
+
+// IsOverridden returns true if the policy restricts deployments and is bypassed by the tags of the deployed resource.
+func IsOverridden(policy datamodel.DeploymentPolicy, tags map[string]string) bool {
+	return policy.AllowOverride && strings.TrimSpace(tags[OverrideTag]) != "" && (policy.Freeze || len(policy.Windows) > 0)
+}
+
+func parseWindows(windows []datamodel.DeploymentWindow) ([]window, error) {
+	parsed := []window{}
+	for i, w := range windows {
+		s, err := parseSchedule(w.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("deployment window %d: %w", i, err)
+		}
+
+		duration, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("deployment window %d: invalid duration %q: %w", i, w.Duration, err)
+		}
+		if duration < time.Minute || duration > maxWindowDuration {
+			return nil, fmt.Errorf("deployment window %d: duration %q must be between 1m and %s", i, w.Duration, maxWindowDuration)
+		}
+
+		location := time.UTC
+		if w.TimeZone != "" {
+			location, err = time.LoadLocation(w.TimeZone)
+			if err != nil {
+				return nil, fmt.Errorf("deployment window %d: invalid time zone %q: %w", i, w.TimeZone, err)
+			}
+		}
+
+		parsed = append(parsed, window{schedule: s, duration: duration, location: location})
+	}
+
+	return parsed, nil
+}
+
+// isOpen returns true if a window starts within the duration of the window before now.
+func (w window) isOpen(now time.Time) bool {
+	now = now.In(w.location)
+	start := now.Truncate(time.Minute)
+	for ; !start.Before(now.Add(-w.duration)); start = start.Add(-time.Minute) {
+		if w.schedule.matches(start) && now.Before(start.Add(w.duration)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// next returns the start of the next window after now, or nil if no window starts within maxWindowDuration.
+func (w window) next(now time.Time) *time.Time {
+	now = now.In(w.location)
+	start := now.Truncate(time.Minute).Add(time.Minute)
+	for end := now.Add(maxWindowDuration); start.Before(end); start = start.Add(time.Minute) {
+		if w.schedule.matches(start) {
+			return &start
+		}
+	}
+
+	return nil
+}
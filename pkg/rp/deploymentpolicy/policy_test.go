@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploymentpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(datamodel.DeploymentPolicy{}))
+	require.NoError(t, Validate(datamodel.DeploymentPolicy{
+		Windows: []datamodel.DeploymentWindow{{Schedule: "0 9 * * 1-5", Duration: "8h", TimeZone: "UTC"}},
+	}))
+
+	tests := []struct {
+		name   string
+		window datamodel.DeploymentWindow
+		err    string
+	}{
+		{
+			name:   "invalid schedule",
+			window: datamodel.DeploymentWindow{Schedule: "0 9", Duration: "8h"},
+			err:    "deployment window 0: schedule \"0 9\" must have 5 fields (minute, hour, day of month, month, day of week), got 2",
+		},
+		{
+			name:   "invalid duration",
+			window: datamodel.DeploymentWindow{Schedule: "0 9 * * *", Duration: "8 hours"},
+			err:    "deployment window 0: invalid duration \"8 hours\": time: unknown unit \" hours\" in duration \"8 hours\"",
+		},
+		{
+			name:   "duration too long",
+			window: datamodel.DeploymentWindow{Schedule: "0 9 * * *", Duration: "200h"},
+			err:    "deployment window 0: duration \"200h\" must be between 1m and 168h0m0s",
+		},
+		{
+			name:   "invalid time zone",
+			window: datamodel.DeploymentWindow{Schedule: "0 9 * * *", Duration: "8h", TimeZone: "Mars/Olympus_Mons"},
+			err:    "deployment window 0: invalid time zone \"Mars/Olympus_Mons\": unknown time zone Mars/Olympus_Mons",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(datamodel.DeploymentPolicy{Windows: []datamodel.DeploymentWindow{tt.window}})
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	// Monday 2024-01-01 10:00 UTC
+	now := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+	businessHours := datamodel.DeploymentWindow{Schedule: "0 9 * * 1-5", Duration: "8h"}
+	override := map[string]string{OverrideTag: "hotfix for incident 42"}
+
+	t.Run("no policy", func(t *testing.T) {
+		require.NoError(t, Check(datamodel.DeploymentPolicy{}, now, nil))
+	})
+
+	t.Run("frozen", func(t *testing.T) {
+		policy := datamodel.DeploymentPolicy{Freeze: true, Message: "see the change calendar"}
+		err := Check(policy, now, nil)
+		require.Equal(t, &RejectedError{Reason: "deployments are frozen", Message: "see the change calendar"}, err)
+		require.EqualError(t, err, "deployments are frozen: see the change calendar")
+	})
+
+	t.Run("inside window", func(t *testing.T) {
+		policy := datamodel.DeploymentPolicy{Windows: []datamodel.DeploymentWindow{businessHours}}
+		require.NoError(t, Check(policy, now, nil))
+		require.NoError(t, Check(policy, now.Add(-time.Hour), nil))
+		require.NoError(t, Check(policy, now.Add(6*time.Hour+59*time.Minute), nil))
+	})
+
+	t.Run("outside window", func(t *testing.T) {
+		policy := datamodel.DeploymentPolicy{Windows: []datamodel.DeploymentWindow{businessHours}}
+
+		err := Check(policy, now.Add(7*time.Hour), nil)
+		rejected := &RejectedError{}
+		require.ErrorAs(t, err, &rejected)
+		require.Equal(t, time.Date(2024, time.January, 2, 9, 0, 0, 0, time.UTC), *rejected.NextWindow)
+		require.EqualError(t, err, "deployments are only allowed within the deployment windows, the next deployment window opens at 2024-01-02T09:00:00Z")
+
+		// Saturday
+		err = Check(policy, now.AddDate(0, 0, 5), nil)
+		require.ErrorAs(t, err, &rejected)
+		require.Equal(t, time.Date(2024, time.January, 8, 9, 0, 0, 0, time.UTC), *rejected.NextWindow)
+	})
+
+	t.Run("window in time zone", func(t *testing.T) {
+		policy := datamodel.DeploymentPolicy{Windows: []datamodel.DeploymentWindow{{Schedule: "0 9 * * *", Duration: "1h", TimeZone: "America/New_York"}}}
+
+		// 09:30 in New York is 14:30 UTC in January.
+		require.NoError(t, Check(policy, time.Date(2024, time.January, 1, 14, 30, 0, 0, time.UTC), nil))
+		require.Error(t, Check(policy, time.Date(2024, time.January, 1, 9, 30, 0, 0, time.UTC), nil))
+	})
+
+	t.Run("override", func(t *testing.T) {
+		policy := datamodel.DeploymentPolicy{Freeze: true, AllowOverride: true}
+		require.NoError(t, Check(policy, now, override))
+		require.True(t, IsOverridden(policy, override))
+		require.Error(t, Check(policy, now, map[string]string{OverrideTag: " "}))
+	})
+
+	t.Run("override not allowed", func(t *testing.T) {
+		policy := datamodel.DeploymentPolicy{Freeze: true}
+		require.Error(t, Check(policy, now, override))
+		require.False(t, IsOverridden(policy, override))
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		policy := datamodel.DeploymentPolicy{Windows: []datamodel.DeploymentWindow{{Schedule: "0 9 * * *", Duration: "0s"}}}
+		err := Check(policy, now, nil)
+		require.Error(t, err)
+		require.NotErrorAs(t, err, new(*RejectedError))
+	})
+}
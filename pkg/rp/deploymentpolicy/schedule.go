@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploymentpolicy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// field describes the range of values of a field of a cron expression.
+type field struct {
+	name string
+	min  int
+	max  int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// schedule is a parsed cron expression with five fields: minute, hour, day of month, month and day of week. Each field is
+// a bit set of the values which match.
+type schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// dayOfMonthAny and dayOfWeekAny are set if the field is '*'. Following cron, a time matches if either the day of
+	// month or the day of week matches when both fields are restricted.
+	dayOfMonthAny bool
+	dayOfWeekAny  bool
+}
+
+// parseSchedule parses a cron expression with five fields. Each field is '*', a value, a range 'a-b', or a list of
+// those separated by ',', optionally followed by a step '/n'.
+func parseSchedule(expression string) (*schedule, error) {
+	parts := strings.Fields(expression)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("schedule %q must have 5 fields (minute, hour, day of month, month, day of week), got %d", expression, len(parts))
+	}
+
+	values := make([]uint64, len(fields))
+	for i, part := range parts {
+		value, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q is invalid: %w", expression, err)
+		}
+		values[i] = value
+	}
+
+	// Sunday is either 0 or 7.
+	dayOfWeek := values[4]
+	if dayOfWeek&(1<<7) != 0 {
+		dayOfWeek |= 1
+	}
+
+	return &schedule{
+		minute:        values[0],
+		hour:          values[1],
+		dayOfMonth:    values[2],
+		month:         values[3],
+		dayOfWeek:     dayOfWeek,
+		dayOfMonthAny: parts[2] == "*",
+		dayOfWeekAny:  parts[4] == "*",
+	}, nil
+}
+
+func parseField(value string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		rangeExpression, stepExpression, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepExpression)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q of the %s field", stepExpression, f.name)
+			}
+		}
+
+		start, end := f.min, f.max
+		if rangeExpression != "*" {
+			first, last, isRange := strings.Cut(rangeExpression, "-")
+
+			var err error
+			start, err = parseValue(first, f)
+			if err != nil {
+				return 0, err
+			}
+
+			end = start
+			if isRange {
+				end, err = parseValue(last, f)
+				if err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				// 'a/n' means every n-th value starting at a.
+				end = f.max
+			}
+
+			if start > end {
+				return 0, fmt.Errorf("invalid range %q of the %s field", rangeExpression, f.name)
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, f field) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < f.min || i > f.max {
+		return 0, fmt.Errorf("invalid value %q of the %s field, must be between %d and %d", value, f.name, f.min, f.max)
+	}
+
+	return i, nil
+}
+
+// matches returns true if the minute of t matches the schedule.
+func (s *schedule) matches(t time.Time) bool {
+	if s.minute&(1<<t.Minute()) == 0 || s.hour&(1<<t.Hour()) == 0 || s.month&(1<<int(t.Month())) == 0 {
+		return false
+	}
+
+	dayOfMonth := s.dayOfMonth&(1<<t.Day()) != 0
+	dayOfWeek := s.dayOfWeek&(1<<int(t.Weekday())) != 0
+	if s.dayOfMonthAny || s.dayOfWeekAny {
+		return dayOfMonth && dayOfWeek
+	}
+
+	return dayOfMonth || dayOfWeek
+}
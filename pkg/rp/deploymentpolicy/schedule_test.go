@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploymentpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSchedule(t *testing.T) {
+	// Monday
+	monday := time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		schedule string
+		matches  []time.Time
+		misses   []time.Time
+	}{
+		{
+			schedule: "* * * * *",
+			matches:  []time.Time{monday, monday.Add(time.Minute)},
+		},
+		{
+			schedule: "0 9 * * 1-5",
+			matches:  []time.Time{monday, monday.AddDate(0, 0, 4)},
+			misses:   []time.Time{monday.Add(time.Minute), monday.AddDate(0, 0, 5), monday.AddDate(0, 0, 6)},
+		},
+		{
+			schedule: "*/15 8-10 * * *",
+			matches:  []time.Time{monday, monday.Add(15 * time.Minute), monday.Add(-time.Hour)},
+			misses:   []time.Time{monday.Add(5 * time.Minute), monday.Add(2 * time.Hour)},
+		},
+		{
+			schedule: "30 22 1,15 * *",
+			matches:  []time.Time{time.Date(2024, time.March, 15, 22, 30, 0, 0, time.UTC)},
+			misses:   []time.Time{time.Date(2024, time.March, 16, 22, 30, 0, 0, time.UTC)},
+		},
+		{
+			// Sunday is either 0 or 7.
+			schedule: "0 0 * * 7",
+			matches:  []time.Time{time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)},
+			misses:   []time.Time{time.Date(2024, time.January, 6, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			// The day of month or the day of week matches when both are restricted.
+			schedule: "0 9 13 * 5",
+			matches:  []time.Time{time.Date(2024, time.January, 5, 9, 0, 0, 0, time.UTC), time.Date(2024, time.January, 13, 9, 0, 0, 0, time.UTC)},
+			misses:   []time.Time{time.Date(2024, time.January, 6, 9, 0, 0, 0, time.UTC)},
+		},
+		{
+			schedule: "0 9/4 * 6 *",
+			matches:  []time.Time{time.Date(2024, time.June, 3, 13, 0, 0, 0, time.UTC)},
+			misses:   []time.Time{time.Date(2024, time.June, 3, 8, 0, 0, 0, time.UTC), time.Date(2024, time.July, 3, 13, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			s, err := parseSchedule(tt.schedule)
+			require.NoError(t, err)
+
+			for _, m := range tt.matches {
+				require.True(t, s.matches(m), "expected %s to match", m)
+			}
+			for _, m := range tt.misses {
+				require.False(t, s.matches(m), "expected %s not to match", m)
+			}
+		})
+	}
+}
+
+func Test_parseSchedule_Invalid(t *testing.T) {
+	tests := []struct {
+		schedule string
+		err      string
+	}{
+		{schedule: "0 9 * *", err: "schedule \"0 9 * *\" must have 5 fields (minute, hour, day of month, month, day of week), got 4"},
+		{schedule: "60 9 * * *", err: "schedule \"60 9 * * *\" is invalid: invalid value \"60\" of the minute field, must be between 0 and 59"},
+		{schedule: "0 9 0 * *", err: "schedule \"0 9 0 * *\" is invalid: invalid value \"0\" of the day of month field, must be between 1 and 31"},
+		{schedule: "0 17-9 * * *", err: "schedule \"0 17-9 * * *\" is invalid: invalid range \"17-9\" of the hour field"},
+		{schedule: "*/0 * * * *", err: "schedule \"*/0 * * * *\" is invalid: invalid step \"0\" of the minute field"},
+		{schedule: "0 9 * * MON", err: "schedule \"0 9 * * MON\" is invalid: invalid value \"MON\" of the day of week field, must be between 0 and 7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			_, err := parseSchedule(tt.schedule)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/portableresources"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+)
+
+// DefaultRecipeName sets the name of the recipe of a portable resource to the default recipe name if the resource
+// is provisioned by a recipe and no recipe name is specified. Resources with manual provisioning return a nil recipe
+// from Recipe() and are left unchanged.
+func DefaultRecipeName[P interface {
+	*T
+	pr_dm.RecipeDataModel
+}, T any](ctx context.Context, newResource *T, oldResource *T, options *controller.Options) error {
+	recipe := P(newResource).Recipe()
+	if recipe != nil && recipe.Name == "" {
+		recipe.Name = portableresources.DefaultRecipeName
+	}
+
+	return nil
+}
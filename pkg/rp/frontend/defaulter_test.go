@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	daprrp_dm "github.com/radius-project/radius/pkg/daprrp/datamodel"
+	"github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRecipeName(t *testing.T) {
+	t.Run("recipe name not specified", func(t *testing.T) {
+		newResource := &datamodel.RedisCache{}
+		err := DefaultRecipeName(newTestARMContext(), newResource, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Equal(t, portableresources.DefaultRecipeName, newResource.Properties.Recipe.Name)
+	})
+
+	t.Run("recipe name specified", func(t *testing.T) {
+		newResource := &datamodel.RedisCache{}
+		newResource.Properties.Recipe.Name = "cosmosdb"
+		err := DefaultRecipeName(newTestARMContext(), newResource, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Equal(t, "cosmosdb", newResource.Properties.Recipe.Name)
+	})
+
+	t.Run("manual provisioning", func(t *testing.T) {
+		newResource := &datamodel.RedisCache{}
+		newResource.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+		err := DefaultRecipeName(newTestARMContext(), newResource, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Empty(t, newResource.Properties.Recipe.Name)
+	})
+
+	t.Run("manual provisioning with recipe properties", func(t *testing.T) {
+		redis := &datamodel.RedisCache{}
+		redis.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+		err := DefaultRecipeName(newTestARMContext(), redis, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Empty(t, redis.Properties.Recipe.Name)
+
+		extender := &corerp_dm.Extender{}
+		extender.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+		err = DefaultRecipeName(newTestARMContext(), extender, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Empty(t, extender.Properties.ResourceRecipe.Name)
+
+		stateStore := &daprrp_dm.DaprStateStore{}
+		stateStore.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+		err = DefaultRecipeName(newTestARMContext(), stateStore, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Empty(t, stateStore.Properties.Recipe.Name)
+
+		queue := &msg_dm.RabbitMQQueue{}
+		queue.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+		err = DefaultRecipeName(newTestARMContext(), queue, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Empty(t, queue.Properties.Recipe.Name)
+	})
+}
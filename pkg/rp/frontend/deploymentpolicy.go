@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/rp/deploymentpolicy"
+	rp_util "github.com/radius-project/radius/pkg/rp/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ValidateDeploymentPolicy rejects the deployment of a resource when the deployment policy of its environment does not
+// allow deployments at the current time. The environment of application-scoped resources is found through their
+// application.
+func ValidateDeploymentPolicy[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](ctx context.Context, newResource *T, oldResource *T, options *controller.Options) (rest.Response, error) {
+	environmentID, err := rp_util.FindEnvironmentID(ctx, options.DatabaseClient, P(newResource).ResourceMetadata())
+	if err != nil || environmentID == "" {
+		return nil, err
+	}
+
+	env := &cdm.Environment{}
+	obj, err := options.DatabaseClient.Get(ctx, environmentID)
+	if errors.Is(err, &database.ErrNotFound{ID: environmentID}) {
+		// The environment is validated when the resource is deployed.
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := obj.As(env); err != nil {
+		return nil, err
+	}
+
+	policy := env.Properties.DeploymentPolicy
+	tags := P(newResource).GetBaseResource().Tags
+	err = deploymentpolicy.Check(policy, time.Now(), tags)
+	rejected := &deploymentpolicy.RejectedError{}
+	if errors.As(err, &rejected) {
+		return rest.NewConflictResponse(fmt.Sprintf("The deployment to environment %s was rejected: %s", env.Name, rejected.Error())), nil
+	} else if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The deployment policy of environment %s is invalid: %s.", env.Name, err.Error())), nil
+	}
+
+	if deploymentpolicy.IsOverridden(policy, tags) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		ucplog.FromContextOrDiscard(ctx).Info("Deployment policy of environment overridden", "environment", environmentID, "resource", serviceCtx.ResourceID.String(), "reason", tags[deploymentpolicy.OverrideTag])
+	}
+
+	return nil, nil
+}
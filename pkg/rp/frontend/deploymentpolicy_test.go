@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/rp/deploymentpolicy"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testEnvironmentID = "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/environments/env0"
+	testApplicationID = "/planes/radius/local/resourceGroups/testGroup/providers/Applications.Core/applications/app0"
+)
+
+func newTestEnvironment(policy cdm.DeploymentPolicy) *database.Object {
+	return &database.Object{
+		Data: &cdm.Environment{
+			BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: testEnvironmentID, Name: "env0"}},
+			Properties:   cdm.EnvironmentProperties{DeploymentPolicy: policy},
+		},
+	}
+}
+
+func TestValidateDeploymentPolicy(t *testing.T) {
+	frozen := cdm.DeploymentPolicy{Freeze: true, AllowOverride: true, Message: "release in progress"}
+
+	t.Run("no environment", func(t *testing.T) {
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{}}
+		resp, err := ValidateDeploymentPolicy(newTestARMContext(), newResource, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().Get(gomock.Any(), testEnvironmentID).Return(nil, &database.ErrNotFound{ID: testEnvironmentID})
+
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+		}}
+		resp, err := ValidateDeploymentPolicy(newTestARMContext(), newResource, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("no policy", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().Get(gomock.Any(), testEnvironmentID).Return(newTestEnvironment(cdm.DeploymentPolicy{}), nil)
+
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+		}}
+		resp, err := ValidateDeploymentPolicy(newTestARMContext(), newResource, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("frozen", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().Get(gomock.Any(), testEnvironmentID).Return(newTestEnvironment(frozen), nil)
+
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+		}}
+		resp, err := ValidateDeploymentPolicy(newTestARMContext(), newResource, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewConflictResponse("The deployment to environment env0 was rejected: deployments are frozen: release in progress"), resp)
+	})
+
+	t.Run("frozen environment of application", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().Get(gomock.Any(), testApplicationID).Return(&database.Object{
+			Data: &cdm.Application{
+				Properties: cdm.ApplicationProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+				},
+			},
+		}, nil)
+		databaseClient.EXPECT().Get(gomock.Any(), testEnvironmentID).Return(newTestEnvironment(frozen), nil)
+
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{Application: testApplicationID},
+		}}
+		resp, err := ValidateDeploymentPolicy(newTestARMContext(), newResource, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.IsType(t, &rest.ConflictResponse{}, resp)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().Get(gomock.Any(), testEnvironmentID).Return(newTestEnvironment(frozen), nil)
+
+		newResource := &TestResourceDataModel{
+			BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{
+				Tags: map[string]string{deploymentpolicy.OverrideTag: "hotfix"},
+			}},
+			Properties: &TestResourceDataModelProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+			},
+		}
+		resp, err := ValidateDeploymentPolicy(newTestARMContext(), newResource, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		policy := cdm.DeploymentPolicy{Windows: []cdm.DeploymentWindow{{Schedule: "invalid", Duration: "1h"}}}
+		databaseClient.EXPECT().Get(gomock.Any(), testEnvironmentID).Return(newTestEnvironment(policy), nil)
+
+		newResource := &TestResourceDataModel{Properties: &TestResourceDataModelProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+		}}
+		resp, err := ValidateDeploymentPolicy(newTestARMContext(), newResource, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, resp)
+	})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/rp/imagepolicy"
+	rp_util "github.com/radius-project/radius/pkg/rp/util"
+)
+
+// imageVerifier verifies the signatures of the images of containers. It is replaced in tests.
+var imageVerifier imagepolicy.Verifier = &imagepolicy.CosignVerifier{}
+
+// ValidateImagePolicy rejects a container resource with 403 Forbidden when one of its images is not allowed by the image
+// policy of its environment. The image of the container and the images of the containers of the PodSpec patch and of
+// the base manifest of the Kubernetes runtime are checked. Images which cannot be verified are rejected.
+func ValidateImagePolicy(ctx context.Context, newResource *cdm.ContainerResource, oldResource *cdm.ContainerResource, options *controller.Options) (rest.Response, error) {
+	environmentID, err := rp_util.FindEnvironmentID(ctx, options.DatabaseClient, newResource.ResourceMetadata())
+	if err != nil || environmentID == "" {
+		return nil, err
+	}
+
+	env := &cdm.Environment{}
+	obj, err := options.DatabaseClient.Get(ctx, environmentID)
+	if errors.Is(err, &database.ErrNotFound{ID: environmentID}) {
+		// The environment is validated when the resource is deployed.
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := obj.As(env); err != nil {
+		return nil, err
+	}
+
+	policy := env.Properties.ImagePolicy
+	if policy == nil {
+		return nil, nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	for _, image := range containerImages(newResource) {
+		err := imagepolicy.CheckRegistry(policy, image)
+		if err == nil && policy.SignatureVerification != nil {
+			err = imageVerifier.Verify(ctx, image, policy.SignatureVerification)
+		}
+		if err == nil {
+			continue
+		}
+
+		rejected := &imagepolicy.RejectedError{}
+		if !errors.As(err, &rejected) {
+			// Images are rejected when their signature cannot be verified, for example when the registry is unavailable.
+			rejected = &imagepolicy.RejectedError{Image: image, Reason: fmt.Sprintf("the signature of the image cannot be verified: %s", err.Error())}
+		}
+
+		message := fmt.Sprintf("The deployment to environment %s was rejected: %s.", env.Name, rejected.Error())
+		return rest.NewDisallowedByPolicyResponse(serviceCtx.ResourceID.String(), message), nil
+	}
+
+	return nil, nil
+}
+
+// containerImages returns the distinct images of a container resource, including the images of the containers of the
+// PodSpec patch and of the base manifest of the Kubernetes runtime.
+func containerImages(container *cdm.ContainerResource) []string {
+	images := []string{}
+	seen := map[string]bool{}
+	add := func(image string) {
+		if image != "" && !seen[image] {
+			seen[image] = true
+			images = append(images, image)
+		}
+	}
+
+	add(container.Properties.Container.Image)
+	for _, podSpec := range containerPodSpecs(container) {
+		for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+			for _, c := range containers {
+				add(c.Image)
+			}
+		}
+	}
+
+	return images
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/rp/imagepolicy"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeImageVerifier rejects the images which are not in its list of signed images.
+type fakeImageVerifier struct {
+	signed   map[string]bool
+	err      error
+	verified []string
+}
+
+func (v *fakeImageVerifier) Verify(ctx context.Context, image string, verification *cdm.ImageSignatureVerification) error {
+	v.verified = append(v.verified, image)
+	if v.err != nil {
+		return v.err
+	} else if !v.signed[image] {
+		return &imagepolicy.RejectedError{Image: image, Reason: "the image is not signed by a trusted key"}
+	}
+
+	return nil
+}
+
+func TestValidateImagePolicy(t *testing.T) {
+	newEnvironment := func(policy *cdm.ImagePolicy) *database.Object {
+		return &database.Object{
+			Data: &cdm.Environment{
+				BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: testEnvironmentID, Name: "env0"}},
+				Properties:   cdm.EnvironmentProperties{ImagePolicy: policy},
+			},
+		}
+	}
+
+	newContainer := func(image string) *cdm.ContainerResource {
+		container := &cdm.ContainerResource{Properties: cdm.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+		}}
+		container.Properties.Container.Image = image
+		container.Properties.Runtimes = &cdm.RuntimeProperties{Kubernetes: &cdm.KubernetesRuntime{
+			Pod: `{"initContainers":[{"name":"init","image":"ghcr.io/myorg/init:1.0"}],"containers":[{"name":"sidecar","image":"ghcr.io/myorg/sidecar:1.0"}]}`,
+		}}
+		return container
+	}
+
+	registries := &cdm.ImagePolicy{AllowedRegistries: []string{"ghcr.io/myorg"}}
+	signatures := &cdm.ImagePolicy{SignatureVerification: &cdm.ImageSignatureVerification{PublicKeys: []string{"key"}}}
+
+	tests := []struct {
+		name     string
+		policy   *cdm.ImagePolicy
+		image    string
+		verifier *fakeImageVerifier
+		message  string
+	}{
+		{
+			name:   "no policy",
+			policy: nil,
+			image:  "nginx",
+		},
+		{
+			name:   "allowed registry",
+			policy: registries,
+			image:  "ghcr.io/myorg/app:1.0",
+		},
+		{
+			name:    "registry not allowed",
+			policy:  registries,
+			image:   "nginx",
+			message: "The deployment to environment env0 was rejected: the image \"nginx\" is not allowed by the image policy: the repository \"docker.io/library/nginx\" is not in the allowed registries ghcr.io/myorg.",
+		},
+		{
+			name:     "signed",
+			policy:   signatures,
+			image:    "ghcr.io/myorg/app:1.0",
+			verifier: &fakeImageVerifier{signed: map[string]bool{"ghcr.io/myorg/app:1.0": true, "ghcr.io/myorg/init:1.0": true, "ghcr.io/myorg/sidecar:1.0": true}},
+		},
+		{
+			name:     "sidecar not signed",
+			policy:   signatures,
+			image:    "ghcr.io/myorg/app:1.0",
+			verifier: &fakeImageVerifier{signed: map[string]bool{"ghcr.io/myorg/app:1.0": true, "ghcr.io/myorg/init:1.0": true}},
+			message:  "The deployment to environment env0 was rejected: the image \"ghcr.io/myorg/sidecar:1.0\" is not allowed by the image policy: the image is not signed by a trusted key.",
+		},
+		{
+			name:     "verification failure",
+			policy:   signatures,
+			image:    "ghcr.io/myorg/app:1.0",
+			verifier: &fakeImageVerifier{err: errors.New("registry unavailable")},
+			message:  "The deployment to environment env0 was rejected: the image \"ghcr.io/myorg/app:1.0\" is not allowed by the image policy: the signature of the image cannot be verified: registry unavailable.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := tt.verifier
+			if verifier == nil {
+				verifier = &fakeImageVerifier{}
+			}
+			imageVerifier = verifier
+			t.Cleanup(func() { imageVerifier = &imagepolicy.CosignVerifier{} })
+
+			databaseClient := database.NewMockClient(gomock.NewController(t))
+			databaseClient.EXPECT().Get(gomock.Any(), testEnvironmentID).Return(newEnvironment(tt.policy), nil)
+
+			resp, err := ValidateImagePolicy(newQuotaTestContext(testContainerID), newContainer(tt.image), nil, &controller.Options{DatabaseClient: databaseClient})
+			require.NoError(t, err)
+			if tt.message == "" {
+				require.Nil(t, resp)
+			} else {
+				require.Equal(t, rest.NewDisallowedByPolicyResponse(testContainerID, tt.message), resp)
+			}
+
+			if tt.policy == nil || tt.policy.SignatureVerification == nil {
+				require.Empty(t, verifier.verified)
+			}
+		})
+	}
+
+	t.Run("no environment", func(t *testing.T) {
+		resp, err := ValidateImagePolicy(newQuotaTestContext(testContainerID), &cdm.ContainerResource{}, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+}
+
+func TestContainerImages(t *testing.T) {
+	container := &cdm.ContainerResource{}
+	container.Properties.Container.Image = "ghcr.io/myorg/app:1.0"
+	container.Properties.Runtimes = &cdm.RuntimeProperties{Kubernetes: &cdm.KubernetesRuntime{
+		Pod: `{"containers":[{"name":"app"},{"name":"sidecar","image":"ghcr.io/myorg/sidecar:1.0"}]}`,
+		Base: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: ghcr.io/myorg/init:1.0
+      containers:
+      - name: app
+        image: ghcr.io/myorg/app:1.0
+`,
+	}}
+
+	require.Equal(t, []string{"ghcr.io/myorg/app:1.0", "ghcr.io/myorg/sidecar:1.0", "ghcr.io/myorg/init:1.0"}, containerImages(container))
+}
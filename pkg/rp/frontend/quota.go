@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	rp_util "github.com/radius-project/radius/pkg/rp/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/quota"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateQuota rejects the deployment of a resource with 403 Forbidden when it would exceed the quota of its
+// resource group or of its environment. The CPU and memory of the containers of a container resource are checked
+// against the per container limits of the environment. A new container is reserved against the containers quotas, so
+// that concurrent deployments cannot exceed them before the containers are tracked. Deployments which execute a recipe
+// are rejected early once the recipe executions per day quota has been reached; the executions are recorded by the
+// backend when the recipe runs.
+func ValidateQuota[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](ctx context.Context, newResource *T, oldResource *T, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	request := quota.Request{
+		NewContainer: oldResource == nil && strings.EqualFold(serviceCtx.ResourceID.Type(), quota.ContainerResourceType),
+	}
+	if recipeResource, ok := any(P(newResource)).(pr_dm.RecipeDataModel); ok && recipeResource.Recipe() != nil {
+		request.RecipeExecution = true
+	}
+	if container, ok := any(P(newResource)).(*cdm.ContainerResource); ok {
+		request.ContainerCPU, request.ContainerMemory = containerResources(container)
+	}
+	if request.IsEmpty() {
+		return nil, nil
+	}
+
+	resourceGroupID, err := resources.ParseScope(serviceCtx.ResourceID.RootScope())
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := rp_util.GetResourceGroupQuotaLimits(ctx, options.DatabaseClient, resourceGroupID.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkQuota(ctx, options.DatabaseClient, resourceGroupID, limits, request); errors.Is(err, &quota.ExceededError{}) {
+		message := fmt.Sprintf("The deployment to resource group %s was rejected: %s.", resourceGroupID.Name(), err.Error())
+		return rest.NewQuotaExceededResponse(serviceCtx.ResourceID.String(), message), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	environmentID, err := rp_util.FindEnvironmentID(ctx, options.DatabaseClient, P(newResource).ResourceMetadata())
+	if err != nil {
+		return nil, err
+	}
+	if environmentID != "" {
+		envID, err := resources.ParseResource(environmentID)
+		if err != nil {
+			return nil, err
+		}
+
+		limits, err := rp_util.GetEnvironmentQuotaLimits(ctx, options.DatabaseClient, environmentID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkQuota(ctx, options.DatabaseClient, envID, limits, request); errors.Is(err, &quota.ExceededError{}) {
+			// The container is not deployed, so the container reserved in the resource group is released.
+			if request.NewContainer {
+				if err := quota.ReleaseContainer(ctx, options.DatabaseClient, resourceGroupID, serviceCtx.ResourceID.String()); err != nil {
+					return nil, err
+				}
+			}
+
+			message := fmt.Sprintf("The deployment to environment %s was rejected: %s.", envID.Name(), err.Error())
+			return rest.NewQuotaExceededResponse(serviceCtx.ResourceID.String(), message), nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// checkQuota checks the request against the limits of a resource group or an environment, and reserves the new
+// container of the request against the containers limit. The usage is only calculated when the scope has limits.
+func checkQuota(ctx context.Context, databaseClient database.Client, scope resources.ID, limits quota.Limits, request quota.Request) error {
+	if limits.IsEmpty() {
+		return nil
+	}
+
+	now := time.Now()
+	usage, err := quota.Calculate(ctx, databaseClient, scope, now)
+	if err != nil {
+		return err
+	}
+
+	// The containers limit is checked when the container is reserved.
+	newContainer := request.NewContainer
+	request.NewContainer = false
+	if err := quota.Check(limits, usage, request); err != nil {
+		return err
+	}
+
+	if !newContainer || limits.MaxContainers == nil {
+		return nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	return quota.ReserveContainer(ctx, databaseClient, scope, serviceCtx.ResourceID.String(), *limits.MaxContainers, now)
+}
+
+// containerResources returns the largest CPU and memory requested by or limiting the containers of the PodSpec patch
+// and of the base manifest of the Kubernetes runtime of a container resource.
+func containerResources(container *cdm.ContainerResource) (*resource.Quantity, *resource.Quantity) {
+	var cpu, memory *resource.Quantity
+	for _, podSpec := range containerPodSpecs(container) {
+		for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+			for _, c := range containers {
+				for _, list := range []corev1.ResourceList{c.Resources.Requests, c.Resources.Limits} {
+					cpu = maxQuantity(cpu, list, corev1.ResourceCPU)
+					memory = maxQuantity(memory, list, corev1.ResourceMemory)
+				}
+			}
+		}
+	}
+
+	return cpu, memory
+}
+
+// containerPodSpecs returns the PodSpec patch and the PodSpec of the base manifest of the Kubernetes runtime of a
+// container resource. Invalid patches and manifests are ignored, they are rejected by the validation of the container
+// resource.
+func containerPodSpecs(container *cdm.ContainerResource) []*corev1.PodSpec {
+	runtimes := container.Properties.Runtimes
+	if runtimes == nil || runtimes.Kubernetes == nil {
+		return nil
+	}
+
+	podSpecs := []*corev1.PodSpec{}
+	if runtimes.Kubernetes.Pod != "" {
+		podSpec := &corev1.PodSpec{}
+		if err := json.Unmarshal([]byte(runtimes.Kubernetes.Pod), podSpec); err == nil {
+			podSpecs = append(podSpecs, podSpec)
+		}
+	}
+
+	if runtimes.Kubernetes.Base != "" {
+		manifest, err := kubeutil.ParseManifest([]byte(runtimes.Kubernetes.Base))
+		if err == nil {
+			if deployment, ok := manifest.GetFirst(appsv1.SchemeGroupVersion.WithKind("Deployment")).(*appsv1.Deployment); ok {
+				podSpecs = append(podSpecs, &deployment.Spec.Template.Spec)
+			}
+		}
+	}
+
+	return podSpecs
+}
+
+// maxQuantity returns the greater of the current quantity and the quantity of the resource in the list.
+func maxQuantity(current *resource.Quantity, list corev1.ResourceList, name corev1.ResourceName) *resource.Quantity {
+	q, ok := list[name]
+	if !ok || (current != nil && q.Cmp(*current) <= 0) {
+		return current
+	}
+
+	return &q
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	dsrp_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/quota"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceGroupID = "/planes/radius/local/resourceGroups/testGroup"
+	testContainerID     = testResourceGroupID + "/providers/Applications.Core/containers/container0"
+	testRedisCacheID    = testResourceGroupID + "/providers/Applications.Datastores/redisCaches/redis0"
+)
+
+func newQuotaTestContext(id string) context.Context {
+	return v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{ResourceID: resources.MustParse(id)})
+}
+
+func saveQuotaTestObject(t *testing.T, databaseClient database.Client, id string, data any) {
+	err := databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: data})
+	require.NoError(t, err)
+}
+
+func saveQuotaTestContainer(t *testing.T, databaseClient database.Client, id string) {
+	parsed := resources.MustParse(id)
+	tracked := ucp_dm.GenericResourceFromID(parsed, trackedresource.IDFor(parsed))
+	tracked.Properties.Environment = testEnvironmentID
+	saveQuotaTestObject(t, databaseClient, tracked.ID, tracked)
+}
+
+func TestValidateQuota(t *testing.T) {
+	newContainer := func() *cdm.ContainerResource {
+		return &cdm.ContainerResource{Properties: cdm.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{Environment: testEnvironmentID},
+		}}
+	}
+
+	newRedisCache := func() *dsrp_dm.RedisCache {
+		redis := &dsrp_dm.RedisCache{}
+		redis.Properties.Environment = testEnvironmentID
+		redis.Properties.Recipe.Name = portableresources.DefaultRecipeName
+		return redis
+	}
+
+	t.Run("no quota", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveQuotaTestContainer(t, databaseClient, testResourceGroupID+"/providers/Applications.Core/containers/existing")
+
+		resp, err := ValidateQuota(newQuotaTestContext(testContainerID), newContainer(), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("resource group containers quota reached", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveQuotaTestObject(t, databaseClient, testResourceGroupID, &ucp_dm.ResourceGroup{
+			Properties: ucp_dm.ResourceGroupProperties{Quota: &ucp_dm.ResourceQuota{MaxContainers: to.Ptr(int32(1))}},
+		})
+		saveQuotaTestContainer(t, databaseClient, testResourceGroupID+"/providers/Applications.Core/containers/existing")
+
+		resp, err := ValidateQuota(newQuotaTestContext(testContainerID), newContainer(), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		expected := rest.NewQuotaExceededResponse(testContainerID, "The deployment to resource group testGroup was rejected: the quota of 1 containers has been reached.")
+		require.Equal(t, expected, resp)
+
+		// Updating an existing container does not consume the quota.
+		resp, err = ValidateQuota(newQuotaTestContext(testContainerID), newContainer(), newContainer(), &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("environment containers quota reached", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveQuotaTestObject(t, databaseClient, testEnvironmentID, &cdm.Environment{
+			Properties: cdm.EnvironmentProperties{Quota: &cdm.EnvironmentQuota{MaxContainers: to.Ptr(int32(1))}},
+		})
+		saveQuotaTestContainer(t, databaseClient, testResourceGroupID+"/providers/Applications.Core/containers/existing")
+
+		resp, err := ValidateQuota(newQuotaTestContext(testContainerID), newContainer(), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		expected := rest.NewQuotaExceededResponse(testContainerID, "The deployment to environment env0 was rejected: the quota of 1 containers has been reached.")
+		require.Equal(t, expected, resp)
+	})
+
+	t.Run("containers quota reserved by untracked containers", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveQuotaTestObject(t, databaseClient, testResourceGroupID, &ucp_dm.ResourceGroup{
+			Properties: ucp_dm.ResourceGroupProperties{Quota: &ucp_dm.ResourceQuota{MaxContainers: to.Ptr(int32(2))}},
+		})
+		saveQuotaTestObject(t, databaseClient, testEnvironmentID, &cdm.Environment{
+			Properties: cdm.EnvironmentProperties{Quota: &cdm.EnvironmentQuota{MaxContainers: to.Ptr(int32(1))}},
+		})
+
+		resp, err := ValidateQuota(newQuotaTestContext(testContainerID), newContainer(), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		// The first container is not tracked yet, but its reservation counts against the quota.
+		otherContainerID := testResourceGroupID + "/providers/Applications.Core/containers/container1"
+		resp, err = ValidateQuota(newQuotaTestContext(otherContainerID), newContainer(), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		expected := rest.NewQuotaExceededResponse(otherContainerID, "The deployment to environment env0 was rejected: the quota of 1 containers has been reached.")
+		require.Equal(t, expected, resp)
+
+		// The container rejected by the environment quota is released from the resource group quota.
+		thirdContainerID := testResourceGroupID + "/providers/Applications.Core/containers/container2"
+		err = quota.ReserveContainer(context.Background(), databaseClient, resources.MustParse(testResourceGroupID), thirdContainerID, 2, time.Now())
+		require.NoError(t, err)
+	})
+
+	t.Run("environment container resources quota exceeded", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveQuotaTestObject(t, databaseClient, testEnvironmentID, &cdm.Environment{
+			Properties: cdm.EnvironmentProperties{Quota: &cdm.EnvironmentQuota{MaxContainerCPU: "1", MaxContainerMemory: "1Gi"}},
+		})
+
+		container := newContainer()
+		container.Properties.Runtimes = &cdm.RuntimeProperties{Kubernetes: &cdm.KubernetesRuntime{
+			Pod: `{"containers":[{"name":"container0","resources":{"requests":{"cpu":"500m","memory":"512Mi"},"limits":{"cpu":"1","memory":"1Gi"}}}]}`,
+		}}
+		resp, err := ValidateQuota(newQuotaTestContext(testContainerID), container, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		// Updating an existing container is checked against the per container limits.
+		container.Properties.Runtimes.Kubernetes.Pod = `{"containers":[{"name":"sidecar","resources":{"limits":{"cpu":"1500m"}}}]}`
+		resp, err = ValidateQuota(newQuotaTestContext(testContainerID), container, newContainer(), &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		expected := rest.NewQuotaExceededResponse(testContainerID, "The deployment to environment env0 was rejected: the quota of 1 CPU per container is exceeded by a container requesting 1500m.")
+		require.Equal(t, expected, resp)
+
+		container.Properties.Runtimes.Kubernetes.Pod = ""
+		container.Properties.Runtimes.Kubernetes.Base = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: container0
+spec:
+  template:
+    spec:
+      containers:
+      - name: container0
+        resources:
+          requests:
+            memory: 2Gi
+`
+		resp, err = ValidateQuota(newQuotaTestContext(testContainerID), container, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		expected = rest.NewQuotaExceededResponse(testContainerID, "The deployment to environment env0 was rejected: the quota of 1Gi memory per container is exceeded by a container requesting 2Gi.")
+		require.Equal(t, expected, resp)
+	})
+
+	t.Run("recipe executions quota reached", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveQuotaTestObject(t, databaseClient, testEnvironmentID, &cdm.Environment{
+			Properties: cdm.EnvironmentProperties{Quota: &cdm.EnvironmentQuota{MaxRecipeExecutionsPerDay: to.Ptr(int32(2))}},
+		})
+
+		// Recipe executions are recorded by the backend, not by the validation.
+		for i := 0; i < 2; i++ {
+			resp, err := ValidateQuota(newQuotaTestContext(testRedisCacheID), newRedisCache(), nil, &controller.Options{DatabaseClient: databaseClient})
+			require.NoError(t, err)
+			require.Nil(t, resp)
+		}
+
+		envID := resources.MustParse(testEnvironmentID)
+		usage, err := quota.Calculate(context.Background(), databaseClient, envID, time.Now())
+		require.NoError(t, err)
+		require.Equal(t, int32(0), usage.RecipeExecutionsToday)
+
+		for i := 0; i < 2; i++ {
+			err := quota.RecordRecipeExecution(context.Background(), databaseClient, envID, nil, time.Now())
+			require.NoError(t, err)
+		}
+
+		resp, err := ValidateQuota(newQuotaTestContext(testRedisCacheID), newRedisCache(), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		expected := rest.NewQuotaExceededResponse(testRedisCacheID, "The deployment to environment env0 was rejected: the quota of 2 recipe executions per day has been reached.")
+		require.Equal(t, expected, resp)
+	})
+
+	t.Run("manual provisioning", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveQuotaTestObject(t, databaseClient, testEnvironmentID, &cdm.Environment{
+			Properties: cdm.EnvironmentProperties{Quota: &cdm.EnvironmentQuota{MaxRecipeExecutionsPerDay: to.Ptr(int32(0))}},
+		})
+
+		redis := newRedisCache()
+		redis.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+		resp, err := ValidateQuota(newQuotaTestContext(testRedisCacheID), redis, nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// Evaluator evaluates the health of a resource. Evaluators are registered per resource type by resource processors
+// and handlers and are executed periodically by the health Service.
+type Evaluator interface {
+	// Evaluate returns the health of the resource stored in the database object. Returning an error reports the
+	// resource as HealthStateUnknown.
+	Evaluate(ctx context.Context, resource *database.Object) (rpv1.ResourceHealth, error)
+}
+
+// EvaluatorFunc is an adapter to allow the use of ordinary functions as Evaluators.
+type EvaluatorFunc func(ctx context.Context, resource *database.Object) (rpv1.ResourceHealth, error)
+
+// Evaluate calls f(ctx, resource).
+func (f EvaluatorFunc) Evaluate(ctx context.Context, resource *database.Object) (rpv1.ResourceHealth, error) {
+	return f(ctx, resource)
+}
+
+// NewTypedEvaluator creates an Evaluator which decodes the database object into the datamodel type T before
+// calling fn.
+func NewTypedEvaluator[T any](fn func(ctx context.Context, resource *T) (rpv1.ResourceHealth, error)) Evaluator {
+	return EvaluatorFunc(func(ctx context.Context, obj *database.Object) (rpv1.ResourceHealth, error) {
+		resource := new(T)
+		if err := obj.As(resource); err != nil {
+			return rpv1.ResourceHealth{}, fmt.Errorf("failed to decode resource %q: %w", obj.ID, err)
+		}
+
+		return fn(ctx, resource)
+	})
+}
+
+// Healthy returns a healthy ResourceHealth with the given message.
+func Healthy(message string) rpv1.ResourceHealth {
+	return rpv1.ResourceHealth{State: rpv1.HealthStateHealthy, Message: message}
+}
+
+// Unhealthy returns an unhealthy ResourceHealth with the given message.
+func Unhealthy(message string) rpv1.ResourceHealth {
+	return rpv1.ResourceHealth{State: rpv1.HealthStateUnhealthy, Message: message}
+}
+
+// Unknown returns a ResourceHealth in the unknown state with the given message.
+func Unknown(message string) rpv1.ResourceHealth {
+	return rpv1.ResourceHealth{State: rpv1.HealthStateUnknown, Message: message}
+}
+
+// severity ranks health states so that the worst state reported by the evaluators of a resource wins.
+func severity(state rpv1.HealthState) int {
+	switch state {
+	case rpv1.HealthStateHealthy:
+		return 0
+	case rpv1.HealthStateUnhealthy:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// Evaluate runs all of the evaluators against the resource and combines the results. The worst state reported by
+// any evaluator wins, and the messages of the evaluators reporting that state are joined together.
+func Evaluate(ctx context.Context, resource *database.Object, evaluators []Evaluator) rpv1.ResourceHealth {
+	result := Healthy("")
+	messages := []string{}
+	for _, evaluator := range evaluators {
+		health, err := evaluator.Evaluate(ctx, resource)
+		if err != nil {
+			health = Unknown(err.Error())
+		}
+
+		if severity(health.State) > severity(result.State) {
+			result.State = health.State
+			messages = []string{}
+		} else if severity(health.State) < severity(result.State) {
+			continue
+		}
+
+		if health.Message != "" {
+			messages = append(messages, health.Message)
+		}
+	}
+
+	result.Message = strings.Join(messages, "; ")
+	return result
+}
+
+// transition returns the health to store for a resource given the previously stored health. The transition time is
+// preserved when neither the state nor the message has changed.
+func transition(previous *rpv1.ResourceHealth, current rpv1.ResourceHealth, now time.Time) (rpv1.ResourceHealth, bool) {
+	if previous != nil && previous.State == current.State && previous.Message == current.Message {
+		return *previous, false
+	}
+
+	current.LastTransitionTime = now
+	return current, true
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func constant(health rpv1.ResourceHealth, err error) Evaluator {
+	return EvaluatorFunc(func(ctx context.Context, resource *database.Object) (rpv1.ResourceHealth, error) {
+		return health, err
+	})
+}
+
+func Test_Evaluate(t *testing.T) {
+	tests := []struct {
+		name       string
+		evaluators []Evaluator
+		expected   rpv1.ResourceHealth
+	}{
+		{
+			name:     "no evaluators",
+			expected: Healthy(""),
+		},
+		{
+			name:       "healthy",
+			evaluators: []Evaluator{constant(Healthy("ping succeeded"), nil), constant(Healthy(""), nil)},
+			expected:   Healthy("ping succeeded"),
+		},
+		{
+			name:       "error is unknown",
+			evaluators: []Evaluator{constant(Healthy("ping succeeded"), nil), constant(rpv1.ResourceHealth{}, errors.New("timed out"))},
+			expected:   Unknown("timed out"),
+		},
+		{
+			name: "worst state wins",
+			evaluators: []Evaluator{
+				constant(Unhealthy("connection refused"), nil),
+				constant(Unknown("timed out"), nil),
+				constant(Unhealthy("provisioning state is Failed"), nil),
+				constant(Healthy(""), nil),
+			},
+			expected: Unhealthy("connection refused; provisioning state is Failed"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := Evaluate(context.Background(), &database.Object{}, tc.evaluators)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_NewTypedEvaluator(t *testing.T) {
+	type resource struct {
+		Properties struct {
+			Host string `json:"host"`
+		} `json:"properties"`
+	}
+
+	evaluator := NewTypedEvaluator(func(ctx context.Context, r *resource) (rpv1.ResourceHealth, error) {
+		return Healthy(r.Properties.Host), nil
+	})
+
+	health, err := evaluator.Evaluate(context.Background(), &database.Object{Data: map[string]any{"properties": map[string]any{"host": "redis"}}})
+	require.NoError(t, err)
+	require.Equal(t, Healthy("redis"), health)
+
+	_, err = evaluator.Evaluate(context.Background(), &database.Object{Metadata: database.Metadata{ID: "test"}, Data: "invalid"})
+	require.ErrorContains(t, err, `failed to decode resource "test"`)
+}
+
+func Test_Transition(t *testing.T) {
+	before := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := before.Add(time.Minute)
+
+	health, changed := transition(nil, Healthy(""), now)
+	require.True(t, changed)
+	require.Equal(t, now, health.LastTransitionTime)
+
+	previous := &rpv1.ResourceHealth{State: rpv1.HealthStateHealthy, LastTransitionTime: before}
+	health, changed = transition(previous, Healthy(""), now)
+	require.False(t, changed)
+	require.Equal(t, before, health.LastTransitionTime)
+
+	health, changed = transition(previous, Unhealthy("connection refused"), now)
+	require.True(t, changed)
+	require.Equal(t, rpv1.ResourceHealth{State: rpv1.HealthStateUnhealthy, Message: "connection refused", LastTransitionTime: now}, health)
+}
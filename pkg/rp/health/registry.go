@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Registry holds the health evaluators registered for each resource type.
+type Registry struct {
+	mu         sync.RWMutex
+	evaluators map[string][]Evaluator
+	types      map[string]string
+}
+
+// NewRegistry creates a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		evaluators: map[string][]Evaluator{},
+		types:      map[string]string{},
+	}
+}
+
+// Register adds evaluators for the given fully-qualified resource type, eg: "Applications.Datastores/redisCaches".
+// Resource types are matched case-insensitively. Registering the same type multiple times appends evaluators.
+func (r *Registry) Register(resourceType string, evaluators ...Evaluator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := strings.ToLower(resourceType)
+	if _, ok := r.types[key]; !ok {
+		r.types[key] = resourceType
+	}
+	r.evaluators[key] = append(r.evaluators[key], evaluators...)
+}
+
+// Evaluators returns the evaluators registered for the given resource type.
+func (r *Registry) Evaluators(resourceType string) []Evaluator {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.evaluators[strings.ToLower(resourceType)])
+}
+
+// ResourceTypes returns the resource types with registered evaluators, sorted for a stable evaluation order.
+func (r *Registry) ResourceTypes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := []string{}
+	for key, resourceType := range r.types {
+		if len(r.evaluators[key]) > 0 {
+			types = append(types, resourceType)
+		}
+	}
+
+	slices.Sort(types)
+	return types
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"testing"
+
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Registry(t *testing.T) {
+	registry := NewRegistry()
+	require.Empty(t, registry.ResourceTypes())
+	require.Empty(t, registry.Evaluators("Applications.Datastores/redisCaches"))
+
+	registry.Register("Applications.Datastores/redisCaches", constant(Healthy(""), nil))
+	registry.Register("applications.datastores/rediscaches", constant(rpv1.ResourceHealth{}, nil))
+	registry.Register("Applications.Datastores/mongoDatabases", constant(Healthy(""), nil))
+	registry.Register("Applications.Datastores/sqlDatabases")
+
+	require.Equal(t, []string{"Applications.Datastores/mongoDatabases", "Applications.Datastores/redisCaches"}, registry.ResourceTypes())
+	require.Len(t, registry.Evaluators("APPLICATIONS.DATASTORES/REDISCACHES"), 2)
+	require.Len(t, registry.Evaluators("Applications.Datastores/mongoDatabases"), 1)
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default interval between two health evaluations.
+	DefaultInterval = 30 * time.Second
+
+	// DefaultTimeout is the default timeout for evaluating the health of a single resource.
+	DefaultTimeout = 10 * time.Second
+
+	// rootScope is the root scope of the resources evaluated by the health service.
+	rootScope = "/planes/radius"
+)
+
+// Options configures the health Service.
+type Options struct {
+	// Interval is the interval between two health evaluations. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// Timeout is the timeout for evaluating the health of a single resource. Defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Service periodically runs the health evaluators of the registry against the provisioned resources of each
+// registered resource type and stores the result in the 'properties.status.health' of the resource.
+type Service struct {
+	databaseProvider *databaseprovider.DatabaseProvider
+	registry         *Registry
+	options          Options
+
+	// now returns the current time. Override this for testing.
+	now func() time.Time
+}
+
+// NewService creates a new health Service.
+func NewService(databaseProvider *databaseprovider.DatabaseProvider, registry *Registry, options Options) *Service {
+	if options.Interval <= 0 {
+		options.Interval = DefaultInterval
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+
+	return &Service{
+		databaseProvider: databaseProvider,
+		registry:         registry,
+		options:          options,
+		now:              time.Now,
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service) Name() string {
+	return "health"
+}
+
+// Run evaluates the health of the resources at every interval until the context is cancelled.
+func (s *Service) Run(ctx context.Context) error {
+	client, err := s.databaseProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.evaluateAll(ctx, client)
+		}
+	}
+}
+
+// storedResource is the subset of a stored resource used by the health service.
+type storedResource struct {
+	v1.BaseResource
+
+	Properties struct {
+		Status struct {
+			Health *rpv1.ResourceHealth `json:"health,omitempty"`
+		} `json:"status"`
+	} `json:"properties"`
+}
+
+func (s *Service) evaluateAll(ctx context.Context, client database.Client) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	for _, resourceType := range s.registry.ResourceTypes() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		err := s.evaluateType(ctx, client, resourceType)
+		if err != nil {
+			logger.Error(err, "Failed to evaluate the health of resources", "resourceType", resourceType)
+		}
+	}
+}
+
+func (s *Service) evaluateType(ctx context.Context, client database.Client, resourceType string) error {
+	evaluators := s.registry.Evaluators(resourceType)
+	query := database.Query{
+		RootScope:      rootScope,
+		ScopeRecursive: true,
+		ResourceType:   resourceType,
+	}
+
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return err
+		}
+
+		for i := range result.Items {
+			err := s.evaluateResource(ctx, client, &result.Items[i], evaluators)
+			if err != nil {
+				ucplog.FromContextOrDiscard(ctx).Error(err, "Failed to update the health of resource", ucplog.LogFieldResourceID, result.Items[i].ID)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+func (s *Service) evaluateResource(ctx context.Context, client database.Client, obj *database.Object, evaluators []Evaluator) error {
+	resource := storedResource{}
+	if err := obj.As(&resource); err != nil {
+		return err
+	}
+
+	// Resources which are being updated or deleted, or which failed to deploy are not evaluated. Their health
+	// will be evaluated once they are provisioned successfully.
+	if resource.ProvisioningState() != v1.ProvisioningStateSucceeded {
+		return nil
+	}
+
+	evaluateCtx, cancel := context.WithTimeout(ctx, s.options.Timeout)
+	defer cancel()
+
+	health, changed := transition(resource.Properties.Status.Health, Evaluate(evaluateCtx, obj, evaluators), s.now().UTC())
+	if !changed {
+		return nil
+	}
+
+	data, err := setHealth(obj.Data, health)
+	if err != nil {
+		return err
+	}
+
+	// The resource may have been updated since it was read. Saving with the etag ensures that the health does not
+	// overwrite the update, the health will be evaluated again at the next interval.
+	err = client.Save(ctx, &database.Object{Metadata: obj.Metadata, Data: data}, database.WithETag(obj.ETag))
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		return nil
+	}
+
+	return err
+}
+
+// setHealth returns a copy of the resource data with 'properties.status.health' set to health.
+func setHealth(in any, health rpv1.ResourceHealth) (map[string]any, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]any{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+
+	properties, ok := data["properties"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("resource does not have properties")
+	}
+
+	status, ok := properties["status"].(map[string]any)
+	if !ok {
+		status = map[string]any{}
+		properties["status"] = status
+	}
+
+	status["health"] = health
+	return data, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	redisID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+	updatingID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/updating"
+	redisType  = "Applications.Datastores/redisCaches"
+)
+
+func saveResource(t *testing.T, client database.Client, id string, provisioningState string) {
+	err := client.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data: map[string]any{
+			"id":                id,
+			"type":              redisType,
+			"provisioningState": provisioningState,
+			"properties": map[string]any{
+				"host": "redis",
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func getHealth(t *testing.T, client database.Client, id string) (*rpv1.ResourceHealth, string) {
+	obj, err := client.Get(context.Background(), id)
+	require.NoError(t, err)
+
+	resource := storedResource{}
+	require.NoError(t, obj.As(&resource))
+	return resource.Properties.Status.Health, obj.ETag
+}
+
+func Test_Service_EvaluateAll(t *testing.T) {
+	client := inmemory.NewClient()
+	saveResource(t, client, redisID, "Succeeded")
+	saveResource(t, client, updatingID, "Updating")
+
+	health := Healthy("")
+	registry := NewRegistry()
+	registry.Register(redisType, EvaluatorFunc(func(ctx context.Context, resource *database.Object) (rpv1.ResourceHealth, error) {
+		return health, nil
+	}))
+
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	service := NewService(nil, registry, Options{})
+	service.now = func() time.Time { return first }
+
+	service.evaluateAll(context.Background(), client)
+
+	actual, etag := getHealth(t, client, redisID)
+	require.Equal(t, &rpv1.ResourceHealth{State: rpv1.HealthStateHealthy, LastTransitionTime: first}, actual)
+
+	actual, _ = getHealth(t, client, updatingID)
+	require.Nil(t, actual)
+
+	t.Run("unchanged health is not saved", func(t *testing.T) {
+		service.now = func() time.Time { return first.Add(time.Minute) }
+		service.evaluateAll(context.Background(), client)
+
+		actual, actualETag := getHealth(t, client, redisID)
+		require.Equal(t, first, actual.LastTransitionTime)
+		require.Equal(t, etag, actualETag)
+	})
+
+	t.Run("changed health is saved", func(t *testing.T) {
+		second := first.Add(2 * time.Minute)
+		service.now = func() time.Time { return second }
+		health = Unhealthy("connection refused")
+		service.evaluateAll(context.Background(), client)
+
+		actual, _ := getHealth(t, client, redisID)
+		require.Equal(t, &rpv1.ResourceHealth{State: rpv1.HealthStateUnhealthy, Message: "connection refused", LastTransitionTime: second}, actual)
+
+		obj, err := client.Get(context.Background(), redisID)
+		require.NoError(t, err)
+		require.Equal(t, "redis", obj.Data.(map[string]any)["properties"].(map[string]any)["host"])
+	})
+}
+
+func Test_Service_Defaults(t *testing.T) {
+	service := NewService(nil, NewRegistry(), Options{})
+	require.Equal(t, DefaultInterval, service.options.Interval)
+	require.Equal(t, DefaultTimeout, service.options.Timeout)
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	dockerParser "github.com/novln/docker-parser"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/rp/util/authclient"
+)
+
+const (
+	// SimpleSigningMediaType is the media type of the layers of cosign signatures.
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	// SignatureAnnotation is the annotation of a cosign signature layer containing the base64 encoded signature of the
+	// layer.
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// DSSEMediaType is the media type of the layers of cosign attestations.
+	DSSEMediaType = "application/vnd.dsse.envelope.v1+json"
+
+	// InTotoPayloadType is the payload type of the DSSE envelopes of in-toto attestations.
+	InTotoPayloadType = "application/vnd.in-toto+json"
+)
+
+// Verifier verifies the signatures and attestations of container images.
+type Verifier interface {
+	// Verify returns a *RejectedError if the image is not signed by one of the public keys of the verification, or does
+	// not have a signed attestation of each required predicate type.
+	Verify(ctx context.Context, image string, verification *datamodel.ImageSignatureVerification) error
+}
+
+var _ Verifier = (*CosignVerifier)(nil)
+
+// CosignVerifier verifies the signatures and attestations created by cosign with a key pair. The signatures and
+// attestations are read from the registry of the image, using the tags cosign pushes them to.
+type CosignVerifier struct {
+	// NewRepository creates a client of the repository of an image, such as 'ghcr.io/myorg/app'. Defaults to an
+	// anonymous client of the registry.
+	NewRepository func(repository string) (oras.ReadOnlyTarget, error)
+}
+
+// simpleSigningPayload is the part of the payload of a cosign signature identifying the signed image.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// dsseEnvelope is a DSSE envelope of a cosign attestation.
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+	Signatures  []struct {
+		Sig string `json:"sig"`
+	} `json:"signatures"`
+}
+
+// inTotoStatement is the part of an in-toto statement identifying the attested image and the type of the predicate.
+type inTotoStatement struct {
+	PredicateType string `json:"predicateType"`
+	Subject       []struct {
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+}
+
+// Verify returns a *RejectedError if the image is not signed by one of the public keys of the verification, or does not
+// have an attestation signed by one of the keys for each required predicate type. Other errors are returned when the
+// registry cannot be reached.
+func (v *CosignVerifier) Verify(ctx context.Context, image string, verification *datamodel.ImageSignatureVerification) error {
+	if verification == nil {
+		return nil
+	}
+
+	keys, err := ParsePublicKeys(verification.PublicKeys)
+	if err != nil {
+		return err
+	}
+
+	reference, err := dockerParser.Parse(image)
+	if err != nil {
+		return &RejectedError{Image: image, Reason: "the image reference is invalid"}
+	}
+
+	newRepository := v.NewRepository
+	if newRepository == nil {
+		newRepository = newRemoteRepository
+	}
+	repository, err := newRepository(reference.Repository())
+	if err != nil {
+		return err
+	}
+
+	imageDesc, err := repository.Resolve(ctx, reference.Tag())
+	if errors.Is(err, errdef.ErrNotFound) {
+		return &RejectedError{Image: image, Reason: "the image does not exist"}
+	} else if err != nil {
+		return fmt.Errorf("failed to resolve the image %q: %w", image, err)
+	}
+
+	layers, err := fetchLayers(ctx, repository, signatureTag(imageDesc.Digest, "sig"))
+	if err != nil {
+		return fmt.Errorf("failed to fetch the signatures of the image %q: %w", image, err)
+	}
+	if !hasSignature(ctx, repository, layers, keys, imageDesc.Digest) {
+		return &RejectedError{Image: image, Reason: "the image is not signed by a trusted key"}
+	}
+
+	if len(verification.RequiredAttestations) == 0 {
+		return nil
+	}
+
+	layers, err = fetchLayers(ctx, repository, signatureTag(imageDesc.Digest, "att"))
+	if err != nil {
+		return fmt.Errorf("failed to fetch the attestations of the image %q: %w", image, err)
+	}
+	predicateTypes := attestedPredicateTypes(ctx, repository, layers, keys, imageDesc.Digest)
+	for _, required := range verification.RequiredAttestations {
+		if !predicateTypes[required] {
+			return &RejectedError{Image: image, Reason: fmt.Sprintf("the image does not have an attestation of type %q signed by a trusted key", required)}
+		}
+	}
+
+	return nil
+}
+
+// newRemoteRepository creates an anonymous client of the repository of an image. Docker Hub repositories are read from
+// the registry host of Docker Hub.
+func newRemoteRepository(repository string) (oras.ReadOnlyTarget, error) {
+	if strings.HasPrefix(repository, "docker.io/") {
+		repository = "registry-1.docker.io/" + strings.TrimPrefix(repository, "docker.io/")
+	}
+
+	repo, err := remote.NewRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	repo.Client, err = authclient.NewAnonymousClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// signatureTag returns the tag cosign pushes the signatures ('sig') or attestations ('att') of an image to.
+func signatureTag(imageDigest digest.Digest, suffix string) string {
+	return fmt.Sprintf("%s-%s.%s", imageDigest.Algorithm(), imageDigest.Encoded(), suffix)
+}
+
+// fetchLayers returns the layers of the manifest of a tag, or nil if the tag does not exist.
+func fetchLayers(ctx context.Context, repository oras.ReadOnlyTarget, tag string) ([]ocispec.Descriptor, error) {
+	desc, err := repository.Resolve(ctx, tag)
+	if errors.Is(err, errdef.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	b, err := content.FetchAll(ctx, repository, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest.Layers, nil
+}
+
+// hasSignature returns true if a signature layer is signed by one of the keys and its payload identifies the image.
+// Layers which cannot be read or verified are ignored.
+func hasSignature(ctx context.Context, repository oras.ReadOnlyTarget, layers []ocispec.Descriptor, keys []crypto.PublicKey, imageDigest digest.Digest) bool {
+	for _, layer := range layers {
+		if layer.MediaType != SimpleSigningMediaType {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[SignatureAnnotation])
+		if err != nil || len(signature) == 0 {
+			continue
+		}
+
+		payload, err := content.FetchAll(ctx, repository, layer)
+		if err != nil || !verifySignature(keys, payload, signature) {
+			continue
+		}
+
+		parsed := simpleSigningPayload{}
+		if err := json.Unmarshal(payload, &parsed); err != nil {
+			continue
+		}
+		if parsed.Critical.Image.DockerManifestDigest == imageDigest.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attestedPredicateTypes returns the predicate types of the in-toto attestations of the image which are signed by one
+// of the keys. Layers which cannot be read or verified are ignored.
+func attestedPredicateTypes(ctx context.Context, repository oras.ReadOnlyTarget, layers []ocispec.Descriptor, keys []crypto.PublicKey, imageDigest digest.Digest) map[string]bool {
+	predicateTypes := map[string]bool{}
+	for _, layer := range layers {
+		if layer.MediaType != DSSEMediaType {
+			continue
+		}
+
+		b, err := content.FetchAll(ctx, repository, layer)
+		if err != nil {
+			continue
+		}
+
+		envelope := dsseEnvelope{}
+		if err := json.Unmarshal(b, &envelope); err != nil || envelope.PayloadType != InTotoPayloadType {
+			continue
+		}
+
+		payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+		if err != nil {
+			continue
+		}
+
+		signed := false
+		for _, s := range envelope.Signatures {
+			signature, err := base64.StdEncoding.DecodeString(s.Sig)
+			if err == nil && verifySignature(keys, preAuthEncoding(envelope.PayloadType, payload), signature) {
+				signed = true
+				break
+			}
+		}
+		if !signed {
+			continue
+		}
+
+		statement := inTotoStatement{}
+		if err := json.Unmarshal(payload, &statement); err != nil {
+			continue
+		}
+		for _, subject := range statement.Subject {
+			if subject.Digest[string(imageDigest.Algorithm())] == imageDigest.Encoded() {
+				predicateTypes[statement.PredicateType] = true
+				break
+			}
+		}
+	}
+
+	return predicateTypes
+}
+
+// preAuthEncoding returns the DSSE pre-authentication encoding of a payload, which is the message signed by the
+// signatures of a DSSE envelope.
+func preAuthEncoding(payloadType string, payload []byte) []byte {
+	b := bytes.Buffer{}
+	fmt.Fprintf(&b, "DSSEv1 %d %s %d ", len(payloadType), payloadType, len(payload))
+	b.Write(payload)
+	return b.Bytes()
+}
+
+// verifySignature returns true if the signature of the message is made by one of the keys. ECDSA and RSA signatures
+// are made over the SHA-256 digest of the message, as cosign does.
+func verifySignature(keys []crypto.PublicKey, message []byte, signature []byte) bool {
+	hash := sha256.Sum256(message)
+	for _, key := range keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, hash[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, message, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
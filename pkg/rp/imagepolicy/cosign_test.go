@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+	"oras.land/oras-go/v2/errdef"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+const testPredicateType = "https://slsa.dev/provenance/v1"
+
+// pushBlob pushes a blob to the store, unless it already exists, and returns its descriptor.
+func pushBlob(t *testing.T, store *memory.Store, mediaType string, data []byte) ocispec.Descriptor {
+	desc := content.NewDescriptorFromBytes(mediaType, data)
+	err := store.Push(context.Background(), desc, bytes.NewReader(data))
+	if !errors.Is(err, errdef.ErrAlreadyExists) {
+		require.NoError(t, err)
+	}
+	return desc
+}
+
+// pushManifest pushes a manifest with the layers to the store and tags it.
+func pushManifest(t *testing.T, store *memory.Store, tag string, layers ...ocispec.Descriptor) ocispec.Descriptor {
+	manifest := ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    pushBlob(t, store, ocispec.MediaTypeEmptyJSON, []byte("{}")),
+		Layers:    layers,
+	}
+	b, err := json.Marshal(manifest)
+	require.NoError(t, err)
+
+	desc := pushBlob(t, store, ocispec.MediaTypeImageManifest, b)
+	require.NoError(t, store.Tag(context.Background(), desc, tag))
+	return desc
+}
+
+// sign returns the ECDSA signature of the SHA-256 digest of the message.
+func sign(t *testing.T, key *ecdsa.PrivateKey, message []byte) []byte {
+	hash := sha256.Sum256(message)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	return signature
+}
+
+// pushSignature pushes a cosign signature of the digest, signed by the key.
+func pushSignature(t *testing.T, store *memory.Store, key *ecdsa.PrivateKey, imageDigest digest.Digest, signedDigest digest.Digest) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"ghcr.io/myorg/app"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, signedDigest))
+	layer := pushBlob(t, store, SimpleSigningMediaType, payload)
+	layer.Annotations = map[string]string{SignatureAnnotation: base64.StdEncoding.EncodeToString(sign(t, key, payload))}
+	pushManifest(t, store, signatureTag(imageDigest, "sig"), layer)
+}
+
+// pushAttestation pushes a cosign attestation of the predicate type for the digest, signed by the key.
+func pushAttestation(t *testing.T, store *memory.Store, key *ecdsa.PrivateKey, imageDigest digest.Digest, predicateType string) {
+	statement := fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v0.1","predicateType":%q,"subject":[{"name":"ghcr.io/myorg/app","digest":{"sha256":%q}}],"predicate":{}}`, predicateType, imageDigest.Encoded())
+	signature := sign(t, key, preAuthEncoding(InTotoPayloadType, []byte(statement)))
+	envelope := fmt.Sprintf(`{"payloadType":%q,"payload":%q,"signatures":[{"keyid":"","sig":%q}]}`,
+		InTotoPayloadType, base64.StdEncoding.EncodeToString([]byte(statement)), base64.StdEncoding.EncodeToString(signature))
+	pushManifest(t, store, signatureTag(imageDigest, "att"), pushBlob(t, store, DSSEMediaType, []byte(envelope)))
+}
+
+func TestCosignVerifier_Verify(t *testing.T) {
+	trustedKey, trustedPublicKey := newTestKey(t)
+	otherKey, _ := newTestKey(t)
+
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, store *memory.Store, imageDigest digest.Digest)
+		image        string
+		attestations []string
+		err          string
+	}{
+		{
+			name: "signed",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {
+				pushSignature(t, store, trustedKey, imageDigest, imageDigest)
+			},
+		},
+		{
+			name: "signed with attestation",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {
+				pushSignature(t, store, trustedKey, imageDigest, imageDigest)
+				pushAttestation(t, store, trustedKey, imageDigest, testPredicateType)
+			},
+			attestations: []string{testPredicateType},
+		},
+		{
+			name:  "not signed",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {},
+			err:   "the image is not signed by a trusted key",
+		},
+		{
+			name: "signed by untrusted key",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {
+				pushSignature(t, store, otherKey, imageDigest, imageDigest)
+			},
+			err: "the image is not signed by a trusted key",
+		},
+		{
+			name: "signature of another image",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {
+				pushSignature(t, store, trustedKey, imageDigest, digest.FromString("other"))
+			},
+			err: "the image is not signed by a trusted key",
+		},
+		{
+			name: "missing attestation",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {
+				pushSignature(t, store, trustedKey, imageDigest, imageDigest)
+			},
+			attestations: []string{testPredicateType},
+			err:          "the image does not have an attestation of type \"https://slsa.dev/provenance/v1\" signed by a trusted key",
+		},
+		{
+			name: "attestation of another type",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {
+				pushSignature(t, store, trustedKey, imageDigest, imageDigest)
+				pushAttestation(t, store, trustedKey, imageDigest, "https://spdx.dev/Document")
+			},
+			attestations: []string{testPredicateType},
+			err:          "the image does not have an attestation of type",
+		},
+		{
+			name: "attestation signed by untrusted key",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {
+				pushSignature(t, store, trustedKey, imageDigest, imageDigest)
+				pushAttestation(t, store, otherKey, imageDigest, testPredicateType)
+			},
+			attestations: []string{testPredicateType},
+			err:          "the image does not have an attestation of type",
+		},
+		{
+			name:  "image not found",
+			setup: func(t *testing.T, store *memory.Store, imageDigest digest.Digest) {},
+			image: "ghcr.io/myorg/app:v2",
+			err:   "the image does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.New()
+			imageDesc := pushManifest(t, store, "v1", pushBlob(t, store, ocispec.MediaTypeImageLayer, []byte("layer")))
+			tt.setup(t, store, imageDesc.Digest)
+
+			image := tt.image
+			if image == "" {
+				image = "ghcr.io/myorg/app:v1"
+			}
+
+			verifier := &CosignVerifier{
+				NewRepository: func(repository string) (oras.ReadOnlyTarget, error) {
+					require.Equal(t, "ghcr.io/myorg/app", repository)
+					return store, nil
+				},
+			}
+			err := verifier.Verify(context.Background(), image, &datamodel.ImageSignatureVerification{
+				PublicKeys:           []string{trustedPublicKey},
+				RequiredAttestations: tt.attestations,
+			})
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			rejected := &RejectedError{}
+			require.ErrorAs(t, err, &rejected)
+			require.Equal(t, image, rejected.Image)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	dockerParser "github.com/novln/docker-parser"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+// RejectedError is returned when a container image is not allowed by the image policy of an environment.
+type RejectedError struct {
+	// Image is the rejected image.
+	Image string
+
+	// Reason describes why the image is rejected.
+	Reason string
+}
+
+// Error returns a string describing the rejected image and the reason of the rejection.
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("the image %q is not allowed by the image policy: %s", e.Image, e.Reason)
+}
+
+// Validate returns an error if an allowed registry of the policy is empty or contains a URL scheme, or if a public key
+// of the signature verification cannot be parsed.
+func Validate(policy *datamodel.ImagePolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	for _, registry := range policy.AllowedRegistries {
+		if strings.TrimSpace(registry) == "" {
+			return errors.New("an allowed registry is empty")
+		} else if strings.Contains(registry, "://") {
+			return fmt.Errorf("the allowed registry %q must not contain a URL scheme", registry)
+		}
+	}
+
+	if policy.SignatureVerification != nil {
+		if len(policy.SignatureVerification.PublicKeys) == 0 {
+			return errors.New("the signature verification must have at least one public key")
+		}
+		if _, err := ParsePublicKeys(policy.SignatureVerification.PublicKeys); err != nil {
+			return err
+		}
+		for _, attestation := range policy.SignatureVerification.RequiredAttestations {
+			if strings.TrimSpace(attestation) == "" {
+				return errors.New("a required attestation is empty")
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckRegistry returns a *RejectedError if the image is not pulled from one of the allowed registries of the policy.
+// The repository of the image is normalized before it is matched, so the image 'nginx' is matched as
+// 'docker.io/library/nginx'. An allowed registry matches the repositories it is a path prefix of.
+func CheckRegistry(policy *datamodel.ImagePolicy, image string) error {
+	if policy == nil || len(policy.AllowedRegistries) == 0 {
+		return nil
+	}
+
+	reference, err := dockerParser.Parse(image)
+	if err != nil {
+		return &RejectedError{Image: image, Reason: "the image reference is invalid"}
+	}
+
+	repository := strings.ToLower(reference.Repository())
+	for _, registry := range policy.AllowedRegistries {
+		prefix := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(registry), "/"))
+		if repository == prefix || strings.HasPrefix(repository, prefix+"/") {
+			return nil
+		}
+	}
+
+	return &RejectedError{
+		Image:  image,
+		Reason: fmt.Sprintf("the repository %q is not in the allowed registries %s", reference.Repository(), strings.Join(policy.AllowedRegistries, ", ")),
+	}
+}
+
+// ParsePublicKeys parses PEM encoded ECDSA, RSA and Ed25519 public keys.
+func ParsePublicKeys(keys []string) ([]crypto.PublicKey, error) {
+	parsed := []crypto.PublicKey{}
+	for i, key := range keys {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			return nil, fmt.Errorf("the public key at index %d is not PEM encoded", i)
+		}
+
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("the public key at index %d is invalid: %w", i, err)
+		}
+
+		switch publicKey.(type) {
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+			parsed = append(parsed, publicKey)
+		default:
+			return nil, fmt.Errorf("the public key at index %d has the unsupported type %T", i, publicKey)
+		}
+	}
+
+	return parsed, nil
+}
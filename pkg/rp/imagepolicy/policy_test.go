@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepolicy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestKey returns a new ECDSA key and its PEM encoded public key.
+func newTestKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestValidate(t *testing.T) {
+	_, publicKey := newTestKey(t)
+
+	require.NoError(t, Validate(nil))
+	require.NoError(t, Validate(&datamodel.ImagePolicy{
+		AllowedRegistries: []string{"ghcr.io/radius-project", "docker.io"},
+		SignatureVerification: &datamodel.ImageSignatureVerification{
+			PublicKeys:           []string{publicKey},
+			RequiredAttestations: []string{"https://slsa.dev/provenance/v1"},
+		},
+	}))
+
+	tests := []struct {
+		name   string
+		policy *datamodel.ImagePolicy
+		err    string
+	}{
+		{
+			name:   "empty registry",
+			policy: &datamodel.ImagePolicy{AllowedRegistries: []string{"ghcr.io", " "}},
+			err:    "an allowed registry is empty",
+		},
+		{
+			name:   "registry with scheme",
+			policy: &datamodel.ImagePolicy{AllowedRegistries: []string{"https://ghcr.io"}},
+			err:    "the allowed registry \"https://ghcr.io\" must not contain a URL scheme",
+		},
+		{
+			name:   "no public keys",
+			policy: &datamodel.ImagePolicy{SignatureVerification: &datamodel.ImageSignatureVerification{}},
+			err:    "the signature verification must have at least one public key",
+		},
+		{
+			name:   "key not PEM encoded",
+			policy: &datamodel.ImagePolicy{SignatureVerification: &datamodel.ImageSignatureVerification{PublicKeys: []string{publicKey, "key"}}},
+			err:    "the public key at index 1 is not PEM encoded",
+		},
+		{
+			name: "invalid key",
+			policy: &datamodel.ImagePolicy{SignatureVerification: &datamodel.ImageSignatureVerification{
+				PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("key")}))},
+			}},
+			err: "the public key at index 0 is invalid",
+		},
+		{
+			name: "empty attestation",
+			policy: &datamodel.ImagePolicy{SignatureVerification: &datamodel.ImageSignatureVerification{
+				PublicKeys:           []string{publicKey},
+				RequiredAttestations: []string{""},
+			}},
+			err: "a required attestation is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorContains(t, Validate(tt.policy), tt.err)
+		})
+	}
+}
+
+func TestCheckRegistry(t *testing.T) {
+	policy := &datamodel.ImagePolicy{AllowedRegistries: []string{"ghcr.io/radius-project/", "docker.io/library", "localhost:5000"}}
+
+	tests := []struct {
+		image  string
+		policy *datamodel.ImagePolicy
+		err    string
+	}{
+		{image: "ghcr.io/radius-project/magpie:latest", policy: policy},
+		{image: "ghcr.io/radius-project/samples/demo@sha256:0123456789012345678901234567890123456789012345678901234567890123", policy: policy},
+		{image: "nginx", policy: policy},
+		{image: "library/redis:7", policy: policy},
+		{image: "localhost:5000/app", policy: policy},
+		{image: "anything.io/app", policy: &datamodel.ImagePolicy{}},
+		{image: "anything.io/app", policy: nil},
+		{
+			image:  "ghcr.io/radius-project-fork/magpie",
+			policy: policy,
+			err:    "the image \"ghcr.io/radius-project-fork/magpie\" is not allowed by the image policy: the repository \"ghcr.io/radius-project-fork/magpie\" is not in the allowed registries ghcr.io/radius-project/, docker.io/library, localhost:5000",
+		},
+		{
+			image:  "bitnami/redis",
+			policy: policy,
+			err:    "the repository \"docker.io/bitnami/redis\" is not in the allowed registries",
+		},
+		{
+			image:  "Invalid Image",
+			policy: policy,
+			err:    "the image reference is invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			err := CheckRegistry(tt.policy, tt.image)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tt.err)
+			rejected := &RejectedError{}
+			require.ErrorAs(t, err, &rejected)
+			require.Equal(t, tt.image, rejected.Image)
+		})
+	}
+}
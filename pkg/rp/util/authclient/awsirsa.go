@@ -31,7 +31,6 @@ import (
 	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
-	"oras.land/oras-go/v2/registry/remote/retry"
 )
 
 var _ AuthClient = (*awsIRSA)(nil)
@@ -58,8 +57,15 @@ func (b *awsIRSA) GetAuthClient(ctx context.Context, templatePath string) (remot
 		return nil, err
 	}
 
+	// Requests to AWS STS and ECR use the proxy and CA bundle configuration of the shared transport.
+	httpClient, err := ucp_aws.NewHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
 	awscfg, err := config.LoadDefaultConfig(ctx,
-		config.WithRegion(region))
+		config.WithRegion(region),
+		config.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
@@ -77,6 +83,7 @@ func (b *awsIRSA) GetAuthClient(ctx context.Context, templatePath string) (remot
 	ecrCfg, err := config.LoadDefaultConfig(ctx,
 		config.WithRegion(region),
 		config.WithCredentialsProvider(credsCache),
+		config.WithHTTPClient(httpClient),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load configuration: %w", err)
@@ -105,8 +112,13 @@ func (b *awsIRSA) GetAuthClient(ctx context.Context, templatePath string) (remot
 		return nil, fmt.Errorf("malformed authorization token")
 	}
 
+	client, err := NewRetryClient()
+	if err != nil {
+		return nil, err
+	}
+
 	return &auth.Client{
-		Client: retry.DefaultClient,
+		Client: client,
 		Credential: auth.StaticCredential(registryHost, auth.Credential{
 			Username: creds[0],
 			Password: creds[1],
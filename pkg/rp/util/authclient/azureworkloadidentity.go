@@ -20,13 +20,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/containers/azcontainerregistry"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/to"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
-	"oras.land/oras-go/v2/registry/remote/retry"
 )
 
 var _ AuthClient = (*azureWorkloadIdentity)(nil)
@@ -47,9 +48,16 @@ func NewAzureWorkloadIdentity(clientID string, tenantID string) AuthClient {
 // The function returns a remote.Client that can authenticate and interact with the container registry
 // using the obtained refresh token.
 func (wi *azureWorkloadIdentity) GetAuthClient(ctx context.Context, templatePath string) (remote.Client, error) {
+	// Requests to Azure AD and ACR use the shared transport for outbound requests.
+	httpClient, err := httpclient.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
 	opt := &azidentity.WorkloadIdentityCredentialOptions{
-		ClientID: wi.clientID,
-		TenantID: wi.tenantID,
+		ClientOptions: azcore.ClientOptions{Transport: httpClient},
+		ClientID:      wi.clientID,
+		TenantID:      wi.tenantID,
 	}
 
 	// Get AAD access token by sending projected federated token from AKS
@@ -69,7 +77,9 @@ func (wi *azureWorkloadIdentity) GetAuthClient(ctx context.Context, templatePath
 		return nil, err
 	}
 
-	ac, err := azcontainerregistry.NewAuthenticationClient(fmt.Sprintf("https://%s", registryHost), &azcontainerregistry.AuthenticationClientOptions{})
+	ac, err := azcontainerregistry.NewAuthenticationClient(fmt.Sprintf("https://%s", registryHost), &azcontainerregistry.AuthenticationClientOptions{
+		ClientOptions: azcore.ClientOptions{Transport: httpClient},
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -84,9 +94,14 @@ func (wi *azureWorkloadIdentity) GetAuthClient(ctx context.Context, templatePath
 		return nil, err
 	}
 
+	client, err := NewRetryClient()
+	if err != nil {
+		return nil, err
+	}
+
 	// Return a new auth.Client using the retrieved refresh token for ACR
 	return &auth.Client{
-		Client: retry.DefaultClient,
+		Client: client,
 		Credential: auth.StaticCredential(registryHost, auth.Credential{
 			RefreshToken: *rt.RefreshToken,
 		}),
@@ -21,7 +21,6 @@ import (
 
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
-	"oras.land/oras-go/v2/registry/remote/retry"
 )
 
 var _ AuthClient = (*basicAuthentication)(nil)
@@ -45,8 +44,13 @@ func (b *basicAuthentication) GetAuthClient(ctx context.Context, templatePath st
 		return nil, err
 	}
 
+	client, err := NewRetryClient()
+	if err != nil {
+		return nil, err
+	}
+
 	return &auth.Client{
-		Client: retry.DefaultClient,
+		Client: client,
 		Credential: auth.StaticCredential(registry, auth.Credential{
 			Username: b.username,
 			Password: b.password,
@@ -19,10 +19,14 @@ package authclient
 import (
 	"context"
 	"errors"
+	"net/http"
 	"net/url"
 
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/recipes"
 	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
 )
 
 //go:generate mockgen -typed -destination=./mock_authclient.go -package=authclient -self_package github.com/radius-project/radius/pkg/rp/util/authclient github.com/radius-project/radius/pkg/rp/util/authclient AuthClient
@@ -49,6 +53,30 @@ func GetNewRegistryAuthClient(secrets recipes.SecretData) (AuthClient, error) {
 
 }
 
+// NewRetryClient creates an HTTP client with retries for accessing container registries. The client uses the shared
+// transport for outbound requests so that custom CA bundles and proxy settings are honored.
+func NewRetryClient() (*http.Client, error) {
+	transport, err := httpclient.DefaultTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: retry.NewTransport(transport)}, nil
+}
+
+// NewAnonymousClient creates an ORAS client for accessing container registries that do not require authentication.
+func NewAnonymousClient() (remote.Client, error) {
+	client, err := NewRetryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.Client{
+		Client: client,
+		Cache:  auth.DefaultCache,
+	}, nil
+}
+
 // getRegistryHostname returns the hostname from the registry template path
 func getRegistryHostname(templatePath string) (string, error) {
 	registryURL, err := url.Parse("https://" + templatePath)
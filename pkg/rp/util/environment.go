@@ -18,10 +18,14 @@ package util
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -45,3 +49,24 @@ func FetchEnvironment(ctx context.Context, environmentID string, ucpOptions *arm
 
 	return &response.EnvironmentResource, nil
 }
+
+// FindEnvironmentID returns the ID of the environment of a resource, or an empty string if the resource is not
+// associated with an environment. The environment of application-scoped resources is found through their application.
+func FindEnvironmentID(ctx context.Context, databaseClient database.Client, properties *rpv1.BasicResourceProperties) (string, error) {
+	if properties.Environment != "" || properties.Application == "" {
+		return properties.Environment, nil
+	}
+
+	app := &cdm.Application{}
+	obj, err := databaseClient.Get(ctx, properties.Application)
+	if errors.Is(err, &database.ErrNotFound{ID: properties.Application}) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	if err := obj.As(app); err != nil {
+		return "", err
+	}
+
+	return app.Properties.Environment, nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/quota"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// GetResourceGroupQuotaLimits returns the quota limits of a resource group. Resource groups which are not found have
+// no limits.
+func GetResourceGroupQuotaLimits(ctx context.Context, databaseClient database.Client, id string) (quota.Limits, error) {
+	obj, err := databaseClient.Get(ctx, id)
+	if errors.Is(err, &database.ErrNotFound{ID: id}) {
+		return quota.Limits{}, nil
+	} else if err != nil {
+		return quota.Limits{}, err
+	}
+
+	resourceGroup := &ucp_dm.ResourceGroup{}
+	if err := obj.As(resourceGroup); err != nil {
+		return quota.Limits{}, err
+	}
+
+	if resourceGroup.Properties.Quota == nil {
+		return quota.Limits{}, nil
+	}
+
+	return quota.Limits{
+		MaxContainers:             resourceGroup.Properties.Quota.MaxContainers,
+		MaxRecipeExecutionsPerDay: resourceGroup.Properties.Quota.MaxRecipeExecutionsPerDay,
+		MaxCloudResources:         resourceGroup.Properties.Quota.MaxCloudResources,
+	}, nil
+}
+
+// GetEnvironmentQuotaLimits returns the quota limits of an environment. Environments which are not found have no
+// limits, they are validated when the resource is deployed.
+func GetEnvironmentQuotaLimits(ctx context.Context, databaseClient database.Client, id string) (quota.Limits, error) {
+	obj, err := databaseClient.Get(ctx, id)
+	if errors.Is(err, &database.ErrNotFound{ID: id}) {
+		return quota.Limits{}, nil
+	} else if err != nil {
+		return quota.Limits{}, err
+	}
+
+	env := &cdm.Environment{}
+	if err := obj.As(env); err != nil {
+		return quota.Limits{}, err
+	}
+
+	if env.Properties.Quota == nil {
+		return quota.Limits{}, nil
+	}
+
+	limits := quota.Limits{
+		MaxContainers:             env.Properties.Quota.MaxContainers,
+		MaxRecipeExecutionsPerDay: env.Properties.Quota.MaxRecipeExecutionsPerDay,
+		MaxCloudResources:         env.Properties.Quota.MaxCloudResources,
+	}
+
+	if env.Properties.Quota.MaxContainerCPU != "" {
+		maxCPU, err := resource.ParseQuantity(env.Properties.Quota.MaxContainerCPU)
+		if err != nil {
+			return quota.Limits{}, err
+		}
+		limits.MaxContainerCPU = &maxCPU
+	}
+
+	if env.Properties.Quota.MaxContainerMemory != "" {
+		maxMemory, err := resource.ParseQuantity(env.Properties.Quota.MaxContainerMemory)
+		if err != nil {
+			return quota.Limits{}, err
+		}
+		limits.MaxContainerMemory = &maxMemory
+	}
+
+	return limits, nil
+}
+
+// RecordRecipeExecution records the execution of the recipe of a resource against the recipe executions per day quota
+// of its resource group and of its environment. The execution is not recorded and a quota.ExceededError is returned
+// when either quota has been reached.
+func RecordRecipeExecution(ctx context.Context, databaseClient database.Client, resourceID resources.ID, properties *rpv1.BasicResourceProperties, now time.Time) error {
+	resourceGroupID, err := resources.ParseScope(resourceID.RootScope())
+	if err != nil {
+		return err
+	}
+
+	limits, err := GetResourceGroupQuotaLimits(ctx, databaseClient, resourceGroupID.String())
+	if err != nil {
+		return err
+	}
+	if err := quota.RecordRecipeExecution(ctx, databaseClient, resourceGroupID, limits.MaxRecipeExecutionsPerDay, now); err != nil {
+		return err
+	}
+
+	environmentID, err := FindEnvironmentID(ctx, databaseClient, properties)
+	if err != nil || environmentID == "" {
+		return err
+	}
+
+	envID, err := resources.ParseResource(environmentID)
+	if err != nil {
+		return err
+	}
+
+	limits, err = GetEnvironmentQuotaLimits(ctx, databaseClient, environmentID)
+	if err != nil {
+		return err
+	}
+
+	err = quota.RecordRecipeExecution(ctx, databaseClient, envID, limits.MaxRecipeExecutionsPerDay, now)
+	if errors.Is(err, &quota.ExceededError{}) {
+		// The recipe is not executed, so the execution recorded for the resource group is released.
+		if releaseErr := quota.ReleaseRecipeExecution(ctx, databaseClient, resourceGroupID, now); releaseErr != nil {
+			return releaseErr
+		}
+	}
+
+	return err
+}
@@ -53,14 +53,17 @@ const (
 	LocalIDDaprPubSubBrokerKafka        = "DaprPubSubBrokerKafka"
 	LocalIDDeployment                   = "Deployment"
 	LocalIDGateway                      = "Gateway"
+	LocalIDHorizontalPodAutoscaler      = "HorizontalPodAutoscaler"
 	LocalIDHttpProxy                    = "HttpProxy"
 	LocalIDKeyVault                     = "KeyVault"
 	LocalIDSecret                       = "Secret"
 	LocalIDConfigMap                    = "ConfigMap"
+	LocalIDContainerGroup               = "ContainerGroup"
 	LocalIDSecretProviderClass          = "SecretProviderClass"
 	LocalIDServiceAccount               = "ServiceAccount"
 	LocalIDKubernetesRole               = "KubernetesRole"
 	LocalIDKubernetesRoleBinding        = "KubernetesRoleBinding"
+	LocalIDKubernetesClusterRoleBinding = "KubernetesClusterRoleBinding"
 	LocalIDService                      = "Service"
 	LocalIDUserAssignedManagedIdentity  = "UserAssignedManagedIdentity"
 	LocalIDFederatedIdentity            = "FederatedIdentity"
@@ -18,6 +18,7 @@ package v1
 
 import (
 	"strings"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 )
@@ -30,12 +31,16 @@ const (
 	UnknownComputeKind EnvironmentComputeKind = "unknown"
 	// KubernetesComputeKind represents kubernetes compute resource type.
 	KubernetesComputeKind EnvironmentComputeKind = "kubernetes"
+	// ACIComputeKind represents Azure Container Instances compute resource type.
+	ACIComputeKind EnvironmentComputeKind = "aci"
 )
 
 // BasicDaprResourceProperties is the basic resource properties for dapr resources.
 type BasicDaprResourceProperties struct {
 	// ComponentName represents the name of the component.
 	ComponentName string `json:"componentName,omitempty"`
+	// Scopes represents the resource IDs of the containers the component is scoped to.
+	Scopes []string `json:"scopes,omitempty"`
 }
 
 // BasicResourceProperties is the basic resource model for Radius resources.
@@ -90,6 +95,9 @@ type ResourceStatus struct {
 	// OutputResources represents the output resources associated with the radius resource.
 	OutputResources []OutputResource `json:"outputResources,omitempty"`
 	Recipe          *RecipeStatus    `json:"recipe,omitempty"`
+
+	// Health represents the latest health reported by the health evaluators registered for the resource type.
+	Health *ResourceHealth `json:"health,omitempty"`
 }
 
 // DeepCopy copies the contents of the ResourceStatus struct from in to out.
@@ -103,12 +111,43 @@ func (in *ResourceStatus) DeepCopy(out *ResourceStatus) {
 			TemplateVersion: out.Recipe.TemplateVersion,
 		}
 	}
+	if out.Health != nil {
+		health := *out.Health
+		in.Health = &health
+	}
+}
+
+// HealthState represents the health of a resource as reported by a health evaluator.
+type HealthState string
+
+const (
+	// HealthStateHealthy indicates that the resource is healthy.
+	HealthStateHealthy HealthState = "Healthy"
+
+	// HealthStateUnhealthy indicates that the resource is unhealthy.
+	HealthStateUnhealthy HealthState = "Unhealthy"
+
+	// HealthStateUnknown indicates that the health of the resource could not be determined.
+	HealthStateUnknown HealthState = "Unknown"
+)
+
+// ResourceHealth represents the health of a resource.
+type ResourceHealth struct {
+	// State is the health state of the resource.
+	State HealthState `json:"state"`
+
+	// Message is a human-readable message describing the health of the resource.
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the time at which the health state or message last changed.
+	LastTransitionTime time.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // EnvironmentCompute represents the compute resource of Environment.
 type EnvironmentCompute struct {
 	Kind              EnvironmentComputeKind      `json:"kind"`
 	KubernetesCompute KubernetesComputeProperties `json:"kubernetes,omitempty"`
+	ACICompute        ACIComputeProperties        `json:"aci,omitempty"`
 
 	// Environment-level identity that can be used by any resource in the environment.
 	// Resources can specify its own identities and they will override the environment-level identity.
@@ -124,6 +163,12 @@ type KubernetesComputeProperties struct {
 	Namespace string `json:"namespace"`
 }
 
+// ACIComputeProperties represents the Azure Container Instances compute of the environment.
+type ACIComputeProperties struct {
+	// ResourceGroup represents the resource ID of the Azure resource group the container groups are deployed to.
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+}
+
 // RadiusResourceModel represents the interface of radius resource type.
 // TODO: Replace DeploymentDataModel with RadiusResourceModel later when link rp leverages generic.
 type RadiusResourceModel interface {
@@ -22,4 +22,7 @@ var (
 
 	// DeploymentOperationsClientAPIVersion is the API version of the UCP deployment operations client.
 	DeploymentOperationsClientAPIVersion = "2020-10-01"
+
+	// OperationStatusesClientAPIVersion is the API version of the operation statuses client.
+	OperationStatusesClientAPIVersion = "2023-10-01-preview"
 )
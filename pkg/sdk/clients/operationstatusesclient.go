@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// OperationStatusesLocation is the location of the operation statuses of the Radius resource providers.
+	OperationStatusesLocation = "global"
+)
+
+// OperationStatusesClient is a client to list the async operation statuses of a resource.
+type OperationStatusesClient struct {
+	pipeline *runtime.Pipeline
+	baseURI  string
+}
+
+// NewOperationStatusesClient creates a new OperationStatusesClient with the provided options and returns it, or returns
+// an error if the client creation fails.
+func NewOperationStatusesClient(options *Options) (*OperationStatusesClient, error) {
+	if options.BaseURI == "" {
+		return nil, errors.New("baseURI cannot be empty")
+	}
+
+	pipeline, err := armruntime.NewPipeline(ModuleName, ModuleVersion, options.Cred, runtime.PipelineOptions{}, options.ARMClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OperationStatusesClient{
+		pipeline: &pipeline,
+		baseURI:  options.BaseURI,
+	}, nil
+}
+
+// List returns the async operation statuses of the given resource, the most recent operation first. It returns an
+// error if the request fails.
+func (client *OperationStatusesClient) List(ctx context.Context, resourceID resources.ID, apiVersion string) ([]v1.AsyncOperationStatus, error) {
+	collectionID := fmt.Sprintf("%s/providers/%s/locations/%s/operationstatuses", resourceID.PlaneScope(), strings.ToLower(resourceID.ProviderNamespace()), OperationStatusesLocation)
+	req, err := runtime.NewRequest(ctx, http.MethodGet, DeploymentEngineURL(client.baseURI, collectionID))
+	if err != nil {
+		return nil, err
+	}
+
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("resourceId", resourceID.String())
+	reqQP.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	resp, err := client.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := struct {
+		Value []v1.AsyncOperationStatus `json:"value"`
+	}{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
@@ -26,6 +26,8 @@ const (
 	ProviderTypeAzure = "AzureResourceManager"
 	// ProviderTypeAWS is used to specify the provider configuration for AWS resources.
 	ProviderTypeAWS = "AWS"
+	// ProviderTypeGCP is used to specify the provider configuration for GCP resources.
+	ProviderTypeGCP = "GCP"
 	// ProviderTypeDeployments is used to specify the provider configuration for Bicep modules.
 	ProviderTypeDeployments = "Microsoft.Resources"
 	// ProviderTypeRadius is used to specify the provider configuration for Radius resources.
@@ -34,7 +36,7 @@ const (
 
 // NewDefaultProviderConfig creates a ProviderConfig instance with two fields, Deployments and Radius, and sets their values
 // based on the resourceGroup parameter. The default config will include configuration for Radius resources, Kubernetes resources,
-// and Bicep modules. AWS and Azure resources must be added separately.
+// and Bicep modules. AWS, Azure and GCP resources must be added separately.
 func NewDefaultProviderConfig(resourceGroup string) ProviderConfig {
 	config := ProviderConfig{
 		Deployments: &Deployments{
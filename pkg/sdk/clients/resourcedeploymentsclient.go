@@ -80,6 +80,11 @@ type AWS struct {
 	Value Value  `json:"value,omitempty"`
 }
 
+type GCP struct {
+	Type  string `json:"type,omitempty"`
+	Value Value  `json:"value,omitempty"`
+}
+
 type Deployments struct {
 	Type  string `json:"type,omitempty"`
 	Value Value  `json:"value,omitempty"`
@@ -89,6 +94,7 @@ type ProviderConfig struct {
 	Radius      *Radius      `json:"radius,omitempty"`
 	Az          *Az          `json:"az,omitempty"`
 	AWS         *AWS         `json:"aws,omitempty"`
+	GCP         *GCP         `json:"gcp,omitempty"`
 	Deployments *Deployments `json:"deployments,omitempty"`
 }
 
@@ -99,6 +105,7 @@ type ResourceDeploymentsClient interface {
 	ContinueCreateOperation(ctx context.Context, resumeToken string) (Poller[ClientCreateOrUpdateResponse], error)
 	Delete(ctx context.Context, resourceID, apiVersion string) (Poller[ClientDeleteResponse], error)
 	ContinueDeleteOperation(ctx context.Context, resumeToken string) (Poller[ClientDeleteResponse], error)
+	WhatIf(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (Poller[ClientWhatIfResponse], error)
 }
 
 type ResourceDeploymentsClientImpl struct {
@@ -144,6 +151,11 @@ type ClientDeleteResponse struct {
 	armresources.DeploymentExtended
 }
 
+// ClientWhatIfResponse contains the response from method Client.WhatIf.
+type ClientWhatIfResponse struct {
+	armresources.WhatIfOperationResult
+}
+
 // CreateOrUpdate creates a request to create or update a deployment and returns a poller to
 // track the progress of the operation.
 func (client *ResourceDeploymentsClientImpl) CreateOrUpdate(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (Poller[ClientCreateOrUpdateResponse], error) {
@@ -245,3 +257,51 @@ func (client *ResourceDeploymentsClientImpl) deleteCreateRequest(ctx context.Con
 func (client *ResourceDeploymentsClientImpl) ContinueDeleteOperation(ctx context.Context, resumeToken string) (Poller[ClientDeleteResponse], error) {
 	return runtime.NewPollerFromResumeToken[ClientDeleteResponse](resumeToken, *client.pipeline, nil)
 }
+
+// WhatIf creates a request to preview the changes a deployment would make without deploying it and returns a poller to
+// track the progress of the operation.
+func (client *ResourceDeploymentsClientImpl) WhatIf(ctx context.Context, parameters Deployment, resourceID, apiVersion string) (Poller[ClientWhatIfResponse], error) {
+	if !strings.HasPrefix(resourceID, "/") {
+		return nil, fmt.Errorf("error previewing a deployment: resourceID must start with a slash")
+	}
+
+	_, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resourceID: %v", resourceID)
+	}
+
+	req, err := client.whatIfCreateRequest(ctx, resourceID, apiVersion, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return runtime.NewPoller[ClientWhatIfResponse](resp, *client.pipeline, &runtime.NewPollerOptions[ClientWhatIfResponse]{
+		FinalStateVia: runtime.FinalStateViaLocation,
+	})
+}
+
+// whatIfCreateRequest creates the WhatIf request.
+func (client *ResourceDeploymentsClientImpl) whatIfCreateRequest(ctx context.Context, resourceID, apiVersion string, parameters Deployment) (*policy.Request, error) {
+	if resourceID == "" {
+		return nil, errors.New("resourceID cannot be empty")
+	}
+
+	urlPath := runtime.JoinPaths(DeploymentEngineURL(client.baseURI, resourceID), "whatIf")
+	req, err := runtime.NewRequest(ctx, http.MethodPost, urlPath)
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, runtime.MarshalAsJSON(req, parameters)
+}
@@ -30,11 +30,16 @@ var _ Connection = (*directConnection)(nil)
 // or intermediate systems. This is mostly used for test scenarios.
 type directConnection struct {
 	endpoint string
+
+	// transport is the http.RoundTripper used to send requests. It is shared by all clients of the connection
+	// so that the circuit breaker tracks every request.
+	transport *resilientRoundTripper
 }
 
 // NewDirectConnection parses the given endpoint string and returns a direct connection if the endpoint uses the http or
-// https scheme, otherwise it returns an error.
-func NewDirectConnection(endpoint string) (Connection, error) {
+// https scheme, otherwise it returns an error. The options configure the retry, circuit breaker and timeout policies of
+// the connection.
+func NewDirectConnection(endpoint string, opts ...ConnectionOption) (Connection, error) {
 	parsed, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse endpoint %q: %w", endpoint, err)
@@ -45,7 +50,8 @@ func NewDirectConnection(endpoint string) (Connection, error) {
 	}
 
 	return &directConnection{
-		endpoint: endpoint,
+		endpoint:  endpoint,
+		transport: newResilientRoundTripper(otelhttp.NewTransport(http.DefaultTransport), newConnectionOptions(opts...)),
 	}, nil
 }
 
@@ -53,7 +59,7 @@ func NewDirectConnection(endpoint string) (Connection, error) {
 // autorest.Sender interface (autorest Track1 Go SDK) and policy.Transporter interface
 // (autorest Track2 Go SDK).
 func (c *directConnection) Client() *http.Client {
-	return &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	return &http.Client{Transport: c.transport}
 }
 
 // Endpoint returns the endpoint (aka. base URL) of the Radius API. This definitely includes
@@ -61,3 +67,8 @@ func (c *directConnection) Client() *http.Client {
 func (c *directConnection) Endpoint() string {
 	return c.endpoint
 }
+
+// connectionOptions returns the policies applied to the requests sent through the connection.
+func (c *directConnection) connectionOptions() ConnectionOptions {
+	return c.transport.Options
+}
@@ -19,6 +19,7 @@ package sdk
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -33,10 +34,25 @@ func Test_NewDirectConnection_Valid(t *testing.T) {
 	require.IsType(t, &directConnection{}, connection)
 	require.Equal(t, endpoint, connection.(*directConnection).endpoint)
 	require.IsType(t, &http.Client{}, connection.Client())
-	require.IsType(t, &otelhttp.Transport{}, connection.Client().Transport)
+	require.IsType(t, &resilientRoundTripper{}, connection.Client().Transport)
+	require.IsType(t, &otelhttp.Transport{}, connection.Client().Transport.(*resilientRoundTripper).RoundTripper)
+	require.Equal(t, newConnectionOptions(), connection.Client().Transport.(*resilientRoundTripper).Options)
 	require.Equal(t, endpoint, connection.Endpoint())
 }
 
+func Test_NewDirectConnection_WithOptions(t *testing.T) {
+	connection, err := NewDirectConnection("http://example.com", WithRetryOptions(RetryOptions{MaxRetries: -1}), WithRequestTimeout(time.Minute))
+	require.NoError(t, err)
+
+	options := connection.(*directConnection).connectionOptions()
+	require.Equal(t, -1, options.Retry.MaxRetries)
+	require.Equal(t, time.Minute, options.RequestTimeout)
+	require.Equal(t, DefaultCircuitBreakerFailureThreshold, options.CircuitBreaker.FailureThreshold)
+
+	// Clients share the transport so that the circuit breaker tracks every request of the connection.
+	require.Same(t, connection.Client().Transport, connection.Client().Transport)
+}
+
 func Test_NewDirectConnection_InvalidUrl(t *testing.T) {
 	// It's genuinely kinda hard to make Go's URL parser reject something :-|
 	endpoint := ":"
@@ -42,13 +42,18 @@ var _ Connection = (*kubernetesConnection)(nil)
 type kubernetesConnection struct {
 	endpoint string
 
-	// roundTripper is the http.roundTripper used to send requests.
+	// roundTripper is the http.roundTripper used to send requests to the Kubernetes API server.
 	roundTripper http.RoundTripper
+
+	// transport wraps roundTripper with the retry, circuit breaker and timeout policies of the connection.
+	// It is shared by all clients of the connection so that the circuit breaker tracks every request.
+	transport *resilientRoundTripper
 }
 
 // NewKubernetesConnectionFromConfig creates a new Kubernetes connection from a given configuration and returns a
-// Connection and an error if one occurs.
-func NewKubernetesConnectionFromConfig(config *rest.Config) (Connection, error) {
+// Connection and an error if one occurs. The options configure the retry, circuit breaker and timeout policies of
+// the connection.
+func NewKubernetesConnectionFromConfig(config *rest.Config, opts ...ConnectionOption) (Connection, error) {
 	// Make a copy of the configuration because we are going to edit it.
 	copied := *config
 
@@ -63,14 +68,18 @@ func NewKubernetesConnectionFromConfig(config *rest.Config) (Connection, error)
 
 	endpoint := strings.TrimSuffix(copied.Host+copied.APIPath, "/") + "/" + ucpGroup + "/" + ucpVersion
 	roundTripper = newLocationRewriteRoundTripper(copied.Host, roundTripper)
-	return &kubernetesConnection{endpoint: endpoint, roundTripper: roundTripper}, nil
+	return &kubernetesConnection{
+		endpoint:     endpoint,
+		roundTripper: roundTripper,
+		transport:    newResilientRoundTripper(roundTripper, newConnectionOptions(opts...)),
+	}, nil
 }
 
 // Client returns an http.Client for communicating with Radius. This satisfies both the
 // autorest.Sender interface (autorest Track1 Go SDK) and policy.Transporter interface
 // (autorest Track2 Go SDK).
 func (c *kubernetesConnection) Client() *http.Client {
-	return &http.Client{Transport: c.roundTripper}
+	return &http.Client{Transport: c.transport}
 }
 
 // Endpoint returns the endpoint (aka. base URL) of the Radius API. This definitely includes
@@ -78,3 +87,8 @@ func (c *kubernetesConnection) Client() *http.Client {
 func (c *kubernetesConnection) Endpoint() string {
 	return c.endpoint
 }
+
+// connectionOptions returns the policies applied to the requests sent through the connection.
+func (c *kubernetesConnection) connectionOptions() ConnectionOptions {
+	return c.transport.Options
+}
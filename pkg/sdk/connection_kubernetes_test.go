@@ -17,7 +17,6 @@ limitations under the License.
 package sdk
 
 import (
-	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -52,6 +51,8 @@ func Test_NewKubernetesConnectionFromConfig_Valid(t *testing.T) {
 	roundTripper := kubernetesConnection.roundTripper
 	require.Equal(t, expectedRoundTripper, roundTripper)
 
-	require.Equal(t, &http.Client{Transport: expectedRoundTripper}, connection.Client())
+	require.IsType(t, &resilientRoundTripper{}, connection.Client().Transport)
+	require.Equal(t, expectedRoundTripper, connection.Client().Transport.(*resilientRoundTripper).RoundTripper)
+	require.Equal(t, newConnectionOptions(), connection.Client().Transport.(*resilientRoundTripper).Options)
 	require.Equal(t, expectedEndpoint, connection.Endpoint())
 }
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a failed request is retried.
+	DefaultMaxRetries = 3
+
+	// DefaultRetryDelay is the default delay before the first retry. The delay doubles with every retry.
+	DefaultRetryDelay = 500 * time.Millisecond
+
+	// DefaultMaxRetryDelay is the default upper bound of the delay between retries, including delays requested
+	// by the server with the Retry-After header.
+	DefaultMaxRetryDelay = 30 * time.Second
+
+	// DefaultCircuitBreakerFailureThreshold is the default number of consecutive failed requests that open the circuit.
+	DefaultCircuitBreakerFailureThreshold = 10
+
+	// DefaultCircuitBreakerOpenDuration is the default duration for which requests are rejected once the circuit is open.
+	DefaultCircuitBreakerOpenDuration = 15 * time.Second
+)
+
+// ConnectionOptions represents the policies applied to the requests sent through a connection.
+type ConnectionOptions struct {
+	// Retry configures how failed requests are retried.
+	Retry RetryOptions
+
+	// CircuitBreaker configures how requests are short-circuited when the Radius API is unavailable.
+	CircuitBreaker CircuitBreakerOptions
+
+	// RequestTimeout is the timeout of each attempt of a request. Zero means no timeout.
+	RequestTimeout time.Duration
+}
+
+// RetryOptions configures the retries of requests that failed with a transient error. Requests are retried
+// with an exponential backoff, honoring the Retry-After header returned by the server.
+type RetryOptions struct {
+	// MaxRetries is the maximum number of retries. Zero uses DefaultMaxRetries and a negative value disables retries.
+	MaxRetries int
+
+	// RetryDelay is the delay before the first retry. Zero uses DefaultRetryDelay.
+	RetryDelay time.Duration
+
+	// MaxRetryDelay is the upper bound of the delay between retries. Zero uses DefaultMaxRetryDelay.
+	MaxRetryDelay time.Duration
+}
+
+// CircuitBreakerOptions configures the circuit breaker of a connection. Once the number of consecutive failed
+// requests reaches the threshold, requests fail immediately with ErrCircuitOpen until the open duration elapses.
+// A single request is then let through, and its outcome decides whether the circuit is closed again.
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failed requests that open the circuit. Zero uses
+	// DefaultCircuitBreakerFailureThreshold and a negative value disables the circuit breaker.
+	FailureThreshold int
+
+	// OpenDuration is the duration for which requests are rejected once the circuit is open. Zero uses
+	// DefaultCircuitBreakerOpenDuration.
+	OpenDuration time.Duration
+}
+
+// ConnectionOption configures the ConnectionOptions of a connection.
+type ConnectionOption func(*ConnectionOptions)
+
+// WithRetryOptions sets the retry options of the connection.
+func WithRetryOptions(options RetryOptions) ConnectionOption {
+	return func(o *ConnectionOptions) {
+		o.Retry = options
+	}
+}
+
+// WithCircuitBreakerOptions sets the circuit breaker options of the connection.
+func WithCircuitBreakerOptions(options CircuitBreakerOptions) ConnectionOption {
+	return func(o *ConnectionOptions) {
+		o.CircuitBreaker = options
+	}
+}
+
+// WithRequestTimeout sets the timeout of each attempt of a request sent through the connection.
+func WithRequestTimeout(timeout time.Duration) ConnectionOption {
+	return func(o *ConnectionOptions) {
+		o.RequestTimeout = timeout
+	}
+}
+
+// newConnectionOptions applies the given options and fills in the defaults.
+func newConnectionOptions(opts ...ConnectionOption) ConnectionOptions {
+	options := ConnectionOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.Retry.MaxRetries == 0 {
+		options.Retry.MaxRetries = DefaultMaxRetries
+	}
+	if options.Retry.RetryDelay == 0 {
+		options.Retry.RetryDelay = DefaultRetryDelay
+	}
+	if options.Retry.MaxRetryDelay == 0 {
+		options.Retry.MaxRetryDelay = DefaultMaxRetryDelay
+	}
+	if options.CircuitBreaker.FailureThreshold == 0 {
+		options.CircuitBreaker.FailureThreshold = DefaultCircuitBreakerFailureThreshold
+	}
+	if options.CircuitBreaker.OpenDuration == 0 {
+		options.CircuitBreaker.OpenDuration = DefaultCircuitBreakerOpenDuration
+	}
+
+	return options
+}
@@ -25,9 +25,10 @@ import (
 )
 
 // NewClientOptions creates a new ARM client options object with the given connection's endpoint, audience, transport and
-// removes the authorization header policy.
+// removes the authorization header policy. The pipeline retries are disabled for connections created by this package,
+// which already retry requests in their transport.
 func NewClientOptions(connection Connection) *arm.ClientOptions {
-	return &arm.ClientOptions{
+	options := &arm.ClientOptions{
 		ClientOptions: policy.ClientOptions{
 			Cloud: cloud.Configuration{
 				Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
@@ -56,6 +57,19 @@ func NewClientOptions(connection Connection) *arm.ClientOptions {
 		},
 		DisableRPRegistration: true,
 	}
+
+	// Connections created by this package retry requests in their transport. The retries of the pipeline are
+	// disabled to avoid multiplying the number of attempts.
+	if _, ok := connection.(policyConnection); ok {
+		options.Retry = policy.RetryOptions{MaxRetries: -1}
+	}
+
+	return options
+}
+
+// policyConnection is implemented by connections which apply their own retry, circuit breaker and timeout policies.
+type policyConnection interface {
+	connectionOptions() ConnectionOptions
 }
 
 var _ policy.Policy = (*removeAuthorizationHeaderPolicy)(nil)
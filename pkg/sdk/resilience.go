@@ -0,0 +1,330 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is the error reported when a request is rejected because the circuit breaker of the
+// connection is open after repeated failures.
+type ErrCircuitOpen struct {
+	// Host is the host of the rejected request.
+	Host string
+
+	// Until is the time at which requests are let through again.
+	Until time.Time
+}
+
+// Is checks if the given error is an instance of ErrCircuitOpen.
+func (*ErrCircuitOpen) Is(other error) bool {
+	_, ok := other.(*ErrCircuitOpen)
+	return ok
+}
+
+// Error returns the error message of ErrCircuitOpen.
+func (e *ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("requests to %q are rejected until %s because of repeated failures to reach the Radius API", e.Host, e.Until.Format(time.RFC3339))
+}
+
+var _ http.RoundTripper = (*resilientRoundTripper)(nil)
+
+// resilientRoundTripper applies the retry, circuit breaker and timeout policies of a connection to the requests
+// sent by the inner http.RoundTripper.
+type resilientRoundTripper struct {
+	// RoundTripper is the inner http.RoundTripper that sends the request.
+	RoundTripper http.RoundTripper
+
+	// Options are the policies applied to the requests.
+	Options ConnectionOptions
+
+	// breaker is the circuit breaker shared by all requests of the connection. Nil if disabled.
+	breaker *circuitBreaker
+
+	// sleep waits for the given duration or until the context is done. Replaced in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// newResilientRoundTripper creates a new roundtripper applying the given policies to the inner roundtripper.
+func newResilientRoundTripper(inner http.RoundTripper, options ConnectionOptions) *resilientRoundTripper {
+	rt := &resilientRoundTripper{
+		RoundTripper: inner,
+		Options:      options,
+		sleep:        sleep,
+	}
+
+	if options.CircuitBreaker.FailureThreshold > 0 {
+		rt.breaker = &circuitBreaker{
+			threshold:    options.CircuitBreaker.FailureThreshold,
+			openDuration: options.CircuitBreaker.OpenDuration,
+			now:          time.Now,
+		}
+	}
+
+	return rt
+}
+
+// RoundTrip is the implementation of http.RoundTripper. It sends the request, retrying transient failures with
+// an exponential backoff, and rejects the request immediately if the circuit breaker is open.
+func (t *resilientRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if t.breaker != nil {
+		if until, ok := t.breaker.allow(); !ok {
+			return nil, &ErrCircuitOpen{Host: request.URL.Host, Until: until}
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.send(request, attempt)
+
+		if attempt >= t.Options.Retry.MaxRetries || !isRetriable(res, err) || !canReplay(request) || request.Context().Err() != nil {
+			if t.breaker != nil {
+				if isCanceled(request, err) {
+					// The caller gave up on the request, which says nothing about the availability of the Radius API.
+					t.breaker.release()
+				} else {
+					t.breaker.record(!isFailure(res, err))
+				}
+			}
+			return res, err
+		}
+
+		delay := t.retryDelay(attempt, res)
+		if res != nil {
+			// Drain the body so that the connection can be reused.
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if err := t.sleep(request.Context(), delay); err != nil {
+			if t.breaker != nil {
+				t.breaker.release()
+			}
+			return nil, err
+		}
+	}
+}
+
+// send sends a single attempt of the request, applying the request timeout.
+func (t *resilientRoundTripper) send(request *http.Request, attempt int) (*http.Response, error) {
+	req := request
+	if attempt > 0 && request.Body != nil && request.Body != http.NoBody {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req = request.Clone(request.Context())
+		req.Body = body
+	}
+
+	if t.Options.RequestTimeout <= 0 {
+		return t.RoundTripper.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Options.RequestTimeout)
+	res, err := t.RoundTripper.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The timeout applies until the body is read, so the context is cancelled when the body is closed.
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// retryDelay returns the delay before the next attempt. The delay requested by the server with the
+// Retry-After header takes precedence over the exponential backoff. Both are capped by MaxRetryDelay.
+func (t *resilientRoundTripper) retryDelay(attempt int, res *http.Response) time.Duration {
+	delay, ok := retryAfter(res)
+	if !ok {
+		// Exponential backoff with a jitter of +/- 20% to avoid synchronized retries from many clients.
+		delay = t.Options.Retry.RetryDelay << attempt
+		delay = time.Duration(float64(delay) * (0.8 + 0.4*rand.Float64()))
+	}
+
+	if delay < 0 {
+		delay = 0
+	} else if delay > t.Options.Retry.MaxRetryDelay {
+		delay = t.Options.Retry.MaxRetryDelay
+	}
+
+	return delay
+}
+
+// retryAfter returns the delay requested by the server with the Retry-After header, which can contain
+// either a number of seconds or an HTTP date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+
+	return 0, false
+}
+
+// isRetriable returns true if the request failed with a transient error.
+func isRetriable(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch res.StatusCode {
+	case http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isFailure returns true if the request failed in a way that indicates the Radius API is unavailable.
+func isFailure(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isCanceled returns true if the request failed because its context was cancelled or its deadline was exceeded.
+func isCanceled(request *http.Request, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return request.Context().Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// canReplay returns true if the request body can be sent again.
+func canReplay(request *http.Request) bool {
+	return request.Body == nil || request.Body == http.NoBody || request.GetBody != nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelOnCloseBody cancels the context of the request when the response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the response body and cancels the context of the request.
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// circuitBreaker tracks the consecutive failed requests of a connection.
+type circuitBreaker struct {
+	mu sync.Mutex
+
+	threshold    int
+	openDuration time.Duration
+	now          func() time.Time
+
+	// failures is the number of consecutive failed requests.
+	failures int
+
+	// openedAt is the time at which the circuit was opened.
+	openedAt time.Time
+
+	// probing is true while a single request is let through to probe whether the Radius API recovered.
+	probing bool
+}
+
+// allow returns true if a request can be sent. If not, it returns the time at which requests are let through again.
+func (b *circuitBreaker) allow() (time.Time, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return time.Time{}, true
+	}
+
+	until := b.openedAt.Add(b.openDuration)
+	if b.now().Before(until) || b.probing {
+		return until, false
+	}
+
+	b.probing = true
+	return time.Time{}, true
+}
+
+// release lets another request probe the Radius API without recording an outcome. It is used when a request
+// ends without telling whether the Radius API is available, for example because its context was cancelled.
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// record records the outcome of a request.
+func (b *circuitBreaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+}
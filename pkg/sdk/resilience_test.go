@@ -0,0 +1,312 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRoundTripper returns the configured responses in order and records the request bodies.
+type fakeRoundTripper struct {
+	responses []func(*http.Request) (*http.Response, error)
+	bodies    []string
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		body = string(b)
+	}
+	f.bodies = append(f.bodies, body)
+
+	next := f.responses[0]
+	if len(f.responses) > 1 {
+		f.responses = f.responses[1:]
+	}
+	return next(req)
+}
+
+func respond(statusCode int, headers ...string) func(*http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		res := &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("")), Request: req}
+		for i := 0; i+1 < len(headers); i += 2 {
+			res.Header.Set(headers[i], headers[i+1])
+		}
+		return res, nil
+	}
+}
+
+func fail(err error) func(*http.Request) (*http.Response, error) {
+	return func(*http.Request) (*http.Response, error) {
+		return nil, err
+	}
+}
+
+func newTestRoundTripper(fake *fakeRoundTripper, opts ...ConnectionOption) (*resilientRoundTripper, *[]time.Duration) {
+	delays := &[]time.Duration{}
+	rt := newResilientRoundTripper(fake, newConnectionOptions(opts...))
+	rt.sleep = func(ctx context.Context, d time.Duration) error {
+		*delays = append(*delays, d)
+		return ctx.Err()
+	}
+	return rt, delays
+}
+
+func Test_ResilientRoundTripper_Retry(t *testing.T) {
+	t.Run("succeeds after transient failures", func(t *testing.T) {
+		fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+			fail(errors.New("connection refused")),
+			respond(http.StatusServiceUnavailable),
+			respond(http.StatusOK),
+		}}
+		rt, delays := newTestRoundTripper(fake)
+
+		req, err := http.NewRequest(http.MethodPut, "http://example.com", bytes.NewBufferString("payload"))
+		require.NoError(t, err)
+		res, err := rt.RoundTrip(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		// The body is sent again with every attempt.
+		require.Equal(t, []string{"payload", "payload", "payload"}, fake.bodies)
+
+		// Exponential backoff with jitter.
+		require.Len(t, *delays, 2)
+		require.InDelta(t, DefaultRetryDelay, (*delays)[0], float64(DefaultRetryDelay)*0.2)
+		require.InDelta(t, 2*DefaultRetryDelay, (*delays)[1], float64(2*DefaultRetryDelay)*0.2)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+			respond(http.StatusBadGateway),
+		}}
+		rt, delays := newTestRoundTripper(fake, WithRetryOptions(RetryOptions{MaxRetries: 2}))
+
+		res, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadGateway, res.StatusCode)
+		require.Len(t, fake.bodies, 3)
+		require.Len(t, *delays, 2)
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+			respond(http.StatusNotFound),
+		}}
+		rt, delays := newTestRoundTripper(fake)
+
+		res, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+		require.Len(t, fake.bodies, 1)
+		require.Empty(t, *delays)
+	})
+
+	t.Run("does not retry when disabled", func(t *testing.T) {
+		fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+			respond(http.StatusServiceUnavailable),
+		}}
+		rt, _ := newTestRoundTripper(fake, WithRetryOptions(RetryOptions{MaxRetries: -1}))
+
+		res, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		require.Len(t, fake.bodies, 1)
+	})
+
+	t.Run("does not retry a body that cannot be replayed", func(t *testing.T) {
+		fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+			respond(http.StatusServiceUnavailable),
+		}}
+		rt, _ := newTestRoundTripper(fake)
+
+		req, err := http.NewRequest(http.MethodPost, "http://example.com", io.NopCloser(strings.NewReader("payload")))
+		require.NoError(t, err)
+		res, err := rt.RoundTrip(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		require.Len(t, fake.bodies, 1)
+	})
+
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+			respond(http.StatusServiceUnavailable),
+		}}
+		rt, _ := newTestRoundTripper(fake)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		res, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil).WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		require.Len(t, fake.bodies, 1)
+	})
+}
+
+func Test_ResilientRoundTripper_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected time.Duration
+	}{
+		{name: "seconds", header: "5", expected: 5 * time.Second},
+		{name: "capped by max retry delay", header: "3600", expected: DefaultMaxRetryDelay},
+		{name: "date in the past", header: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), expected: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+				respond(http.StatusTooManyRequests, "Retry-After", tc.header),
+				respond(http.StatusOK),
+			}}
+			rt, delays := newTestRoundTripper(fake)
+
+			res, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			require.Equal(t, []time.Duration{tc.expected}, *delays)
+		})
+	}
+}
+
+func Test_ResilientRoundTripper_CircuitBreaker(t *testing.T) {
+	fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+		fail(errors.New("connection refused")),
+		fail(errors.New("connection refused")),
+		respond(http.StatusOK),
+	}}
+	rt, _ := newTestRoundTripper(fake,
+		WithRetryOptions(RetryOptions{MaxRetries: -1}),
+		WithCircuitBreakerOptions(CircuitBreakerOptions{FailureThreshold: 2, OpenDuration: time.Minute}))
+
+	now := time.Now()
+	rt.breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		_, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+		require.EqualError(t, err, "connection refused")
+	}
+
+	// The circuit is open, requests are rejected without being sent.
+	_, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.ErrorIs(t, err, &ErrCircuitOpen{})
+	require.Equal(t, &ErrCircuitOpen{Host: "example.com", Until: now.Add(time.Minute)}, err)
+	require.Len(t, fake.bodies, 2)
+
+	// Once the open duration elapsed, a request is let through and closes the circuit when it succeeds.
+	now = now.Add(time.Minute)
+	res, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	res, err = rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Len(t, fake.bodies, 4)
+}
+
+func Test_ResilientRoundTripper_CircuitBreaker_ContextErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+		fail(context.Canceled),
+		fail(context.DeadlineExceeded),
+		func(req *http.Request) (*http.Response, error) { return nil, req.Context().Err() },
+		respond(http.StatusOK),
+	}}
+	rt, _ := newTestRoundTripper(fake,
+		WithRetryOptions(RetryOptions{MaxRetries: -1}),
+		WithCircuitBreakerOptions(CircuitBreakerOptions{FailureThreshold: 1, OpenDuration: time.Minute}))
+
+	_, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil).WithContext(ctx))
+	require.ErrorIs(t, err, context.Canceled)
+
+	// None of the cancelled requests opened the circuit.
+	res, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Len(t, fake.bodies, 4)
+}
+
+func Test_ResilientRoundTripper_CircuitBreaker_CanceledProbe(t *testing.T) {
+	fake := &fakeRoundTripper{responses: []func(*http.Request) (*http.Response, error){
+		fail(errors.New("connection refused")),
+		fail(errors.New("connection refused")),
+		fail(errors.New("connection refused")),
+		respond(http.StatusOK),
+	}}
+	rt, _ := newTestRoundTripper(fake,
+		WithRetryOptions(RetryOptions{MaxRetries: 1}),
+		WithCircuitBreakerOptions(CircuitBreakerOptions{FailureThreshold: 1, OpenDuration: time.Minute}))
+
+	now := time.Now()
+	rt.breaker.now = func() time.Time { return now }
+
+	// The first request fails after its retry and opens the circuit.
+	_, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.EqualError(t, err, "connection refused")
+
+	// The probe is cancelled while waiting to retry, which must not keep the circuit open forever.
+	now = now.Add(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	rt.sleep = func(context.Context, time.Duration) error {
+		cancel()
+		return ctx.Err()
+	}
+	_, err = rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil).WithContext(ctx))
+	require.ErrorIs(t, err, context.Canceled)
+
+	res, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Len(t, fake.bodies, 4)
+}
+
+func Test_ResilientRoundTripper_RequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	connection, err := NewDirectConnection(server.URL, WithRetryOptions(RetryOptions{MaxRetries: -1}), WithRequestTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+
+	_, err = connection.Client().Get(server.URL)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
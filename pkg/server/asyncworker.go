@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/corerp/model"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/kubeutil"
 )
 
@@ -40,15 +41,25 @@ type AsyncWorker struct {
 	handlerBuilder []builder.Builder
 }
 
-// NewAsyncWorker creates new service instance to run AsyncRequestProcessWorker.
+// NewAsyncWorker creates new service instance to run AsyncRequestProcessWorker. The worker settings are updated when
+// the configuration file changes if the configuration file is watched.
 func NewAsyncWorker(options hostoptions.HostOptions, builder []builder.Builder) *AsyncWorker {
-	return &AsyncWorker{
+	w := &AsyncWorker{
 		options:        options,
 		handlerBuilder: builder,
 		Service:        worker.Service{
 			// Will be initialized later
 		},
 	}
+
+	if options.ConfigWatcher != nil {
+		options.ConfigWatcher.OnChange(func(ctx context.Context, config *hostoptions.ProviderConfig) error {
+			w.UpdateOptions(worker.OptionsFromConfig(config.WorkerServer))
+			return nil
+		})
+	}
+
+	return w
 }
 
 // Name represents the service name.
@@ -57,16 +68,6 @@ func (w *AsyncWorker) Name() string {
 }
 
 func (w *AsyncWorker) init(ctx context.Context) error {
-	workerOptions := worker.Options{}
-	if w.options.Config.WorkerServer != nil {
-		if w.options.Config.WorkerServer.MaxOperationConcurrency != nil {
-			workerOptions.MaxOperationConcurrency = *w.options.Config.WorkerServer.MaxOperationConcurrency
-		}
-		if w.options.Config.WorkerServer.MaxOperationRetryCount != nil {
-			workerOptions.MaxOperationRetryCount = *w.options.Config.WorkerServer.MaxOperationRetryCount
-		}
-	}
-
 	queueProvider := queueprovider.New(w.options.Config.QueueProvider)
 	databaseProvider := databaseprovider.FromOptions(w.options.Config.DatabaseProvider)
 
@@ -82,12 +83,10 @@ func (w *AsyncWorker) init(ctx context.Context) error {
 
 	statusManager := statusmanager.New(databaseClient, queueClient, w.options.Config.Env.RoleLocation)
 
-	w.Service = worker.Service{
-		DatabaseClient:         databaseClient,
-		OperationStatusManager: statusManager,
-		Options:                workerOptions,
-		QueueClient:            queueClient,
-	}
+	w.Service.DatabaseClient = databaseClient
+	w.Service.OperationStatusManager = statusManager
+	w.Service.QueueClient = queueClient
+	w.Service.UpdateOptions(worker.OptionsFromConfig(w.options.Config.WorkerServer))
 
 	return nil
 }
@@ -99,7 +98,12 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize kubernetes clients: %w", err)
 	}
 
-	appModel, err := model.NewApplicationModel(w.options.Arm, k8s.RuntimeClient, k8s.ClientSet, k8s.DiscoveryClient, k8s.DynamicClient)
+	naming, err := kubernetes.NewNamingStrategy(w.options.Config.Naming.MaxNameLength)
+	if err != nil {
+		return fmt.Errorf("failed to initialize naming strategy: %w", err)
+	}
+
+	appModel, err := model.NewApplicationModel(w.options.Arm, k8s.RuntimeClient, k8s.ClientSet, k8s.DiscoveryClient, k8s.DynamicClient, w.options.Config.ConnectionAgent.Image, naming)
 	if err != nil {
 		return fmt.Errorf("failed to initialize application model: %w", err)
 	}
@@ -38,7 +38,10 @@ func (src *AwsPlaneResource) ConvertTo() (v1.DataModelInterface, error) {
 				UpdatedAPIVersion: Version,
 			},
 		},
-		Properties: datamodel.AWSPlaneProperties{}, // Empty
+	}
+
+	if src.Properties != nil {
+		converted.Properties.PlaneMetadata = toPlaneMetadataDataModel(src.Properties.DisplayName, src.Properties.DefaultLocation)
 	}
 
 	return converted, nil
@@ -60,6 +63,8 @@ func (dst *AwsPlaneResource) ConvertFrom(src v1.DataModelInterface) error {
 
 	dst.Properties = &AwsPlaneResourceProperties{
 		ProvisioningState: fromProvisioningStateDataModel(plane.InternalMetadata.AsyncProvisioningState),
+		DisplayName:       toStringPtr(plane.Properties.DisplayName),
+		DefaultLocation:   toStringPtr(plane.Properties.DefaultLocation),
 	}
 
 	return nil
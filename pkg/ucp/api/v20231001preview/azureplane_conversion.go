@@ -39,7 +39,8 @@ func (src *AzurePlaneResource) ConvertTo() (v1.DataModelInterface, error) {
 			},
 		},
 		Properties: datamodel.AzurePlaneProperties{
-			URL: to.String(src.Properties.URL),
+			PlaneMetadata: toPlaneMetadataDataModel(src.Properties.DisplayName, src.Properties.DefaultLocation),
+			URL:           to.String(src.Properties.URL),
 		},
 	}
 
@@ -63,6 +64,8 @@ func (dst *AzurePlaneResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &AzurePlaneResourceProperties{
 		ProvisioningState: fromProvisioningStateDataModel(plane.InternalMetadata.AsyncProvisioningState),
 		URL:               to.Ptr(plane.Properties.URL),
+		DisplayName:       toStringPtr(plane.Properties.DisplayName),
+		DefaultLocation:   toStringPtr(plane.Properties.DefaultLocation),
 	}
 
 	return nil
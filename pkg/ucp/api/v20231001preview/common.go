@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 func fromProvisioningStateDataModel(state v1.ProvisioningState) *ProvisioningState {
@@ -40,3 +41,17 @@ func fromSystemDataModel(s v1.SystemData) *SystemData {
 		LastModifiedAt:     v1.UnmarshalTimeString(s.LastModifiedAt),
 	}
 }
+
+func toStringPtr(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func toPlaneMetadataDataModel(displayName *string, defaultLocation *string) datamodel.PlaneMetadata {
+	return datamodel.PlaneMetadata{
+		DisplayName:     to.String(displayName),
+		DefaultLocation: to.String(defaultLocation),
+	}
+}
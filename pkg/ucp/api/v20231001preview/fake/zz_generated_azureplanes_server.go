@@ -38,7 +38,7 @@ type AzurePlanesServer struct{
 
 	// BeginUpdate is the fake for method AzurePlanesClient.BeginUpdate
 	// HTTP status codes to indicate success: http.StatusOK, http.StatusAccepted
-	BeginUpdate func(ctx context.Context, planeName string, properties v20231001preview.AzurePlaneResourceTagsUpdate, options *v20231001preview.AzurePlanesClientBeginUpdateOptions) (resp azfake.PollerResponder[v20231001preview.AzurePlanesClientUpdateResponse], errResp azfake.ErrorResponder)
+	BeginUpdate func(ctx context.Context, planeName string, properties v20231001preview.PlaneResourceUpdate, options *v20231001preview.AzurePlanesClientBeginUpdateOptions) (resp azfake.PollerResponder[v20231001preview.AzurePlanesClientUpdateResponse], errResp azfake.ErrorResponder)
 
 }
 
@@ -269,7 +269,7 @@ func (a *AzurePlanesServerTransport) dispatchBeginUpdate(req *http.Request) (*ht
 	if matches == nil || len(matches) < 1 {
 		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
 	}
-	body, err := server.UnmarshalRequestAsJSON[v20231001preview.AzurePlaneResourceTagsUpdate](req)
+	body, err := server.UnmarshalRequestAsJSON[v20231001preview.PlaneResourceUpdate](req)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +38,7 @@ type RadiusPlanesServer struct{
 
 	// BeginUpdate is the fake for method RadiusPlanesClient.BeginUpdate
 	// HTTP status codes to indicate success: http.StatusOK, http.StatusAccepted
-	BeginUpdate func(ctx context.Context, planeName string, properties v20231001preview.RadiusPlaneResourceTagsUpdate, options *v20231001preview.RadiusPlanesClientBeginUpdateOptions) (resp azfake.PollerResponder[v20231001preview.RadiusPlanesClientUpdateResponse], errResp azfake.ErrorResponder)
+	BeginUpdate func(ctx context.Context, planeName string, properties v20231001preview.PlaneResourceUpdate, options *v20231001preview.RadiusPlanesClientBeginUpdateOptions) (resp azfake.PollerResponder[v20231001preview.RadiusPlanesClientUpdateResponse], errResp azfake.ErrorResponder)
 
 }
 
@@ -269,7 +269,7 @@ func (r *RadiusPlanesServerTransport) dispatchBeginUpdate(req *http.Request) (*h
 	if matches == nil || len(matches) < 1 {
 		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
 	}
-	body, err := server.UnmarshalRequestAsJSON[v20231001preview.RadiusPlaneResourceTagsUpdate](req)
+	body, err := server.UnmarshalRequestAsJSON[v20231001preview.PlaneResourceUpdate](req)
 	if err != nil {
 		return nil, err
 	}
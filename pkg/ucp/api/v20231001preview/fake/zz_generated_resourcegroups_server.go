@@ -32,6 +32,10 @@ type ResourceGroupsServer struct{
 	// HTTP status codes to indicate success: http.StatusOK
 	Get func(ctx context.Context, planeName string, resourceGroupName string, options *v20231001preview.ResourceGroupsClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceGroupsClientGetResponse], errResp azfake.ErrorResponder)
 
+	// GetUsage is the fake for method ResourceGroupsClient.GetUsage
+	// HTTP status codes to indicate success: http.StatusOK
+	GetUsage func(ctx context.Context, planeName string, resourceGroupName string, options *v20231001preview.ResourceGroupsClientGetUsageOptions) (resp azfake.Responder[v20231001preview.ResourceGroupsClientGetUsageResponse], errResp azfake.ErrorResponder)
+
 	// NewListPager is the fake for method ResourceGroupsClient.NewListPager
 	// HTTP status codes to indicate success: http.StatusOK
 	NewListPager func(planeName string, options *v20231001preview.ResourceGroupsClientListOptions) (resp azfake.PagerResponder[v20231001preview.ResourceGroupsClientListResponse])
@@ -88,6 +92,8 @@ func (r *ResourceGroupsServerTransport) dispatchToMethodFake(req *http.Request,
 				res.resp, res.err = r.dispatchDelete(req)
 			case "ResourceGroupsClient.Get":
 				res.resp, res.err = r.dispatchGet(req)
+			case "ResourceGroupsClient.GetUsage":
+				res.resp, res.err = r.dispatchGetUsage(req)
 			case "ResourceGroupsClient.NewListPager":
 				res.resp, res.err = r.dispatchNewListPager(req)
 			case "ResourceGroupsClient.Update":
@@ -214,6 +220,39 @@ func (r *ResourceGroupsServerTransport) dispatchGet(req *http.Request) (*http.Re
 	return resp, nil
 }
 
+func (r *ResourceGroupsServerTransport) dispatchGetUsage(req *http.Request) (*http.Response, error) {
+	if r.srv.GetUsage == nil {
+		return nil, &nonRetriableError{errors.New("fake for method GetUsage not implemented")}
+	}
+	const regexStr = `/planes/radius/(?P<planeName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourcegroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/usage`
+	regex := regexp.MustCompile(regexStr)
+	matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+	if matches == nil || len(matches) < 2 {
+		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+	}
+	planeNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("planeName")])
+	if err != nil {
+		return nil, err
+	}
+	resourceGroupNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceGroupName")])
+	if err != nil {
+		return nil, err
+	}
+	respr, errRespr := r.srv.GetUsage(req.Context(), planeNameParam, resourceGroupNameParam, nil)
+	if respErr := server.GetError(errRespr, req); respErr != nil {
+		return nil, respErr
+	}
+	respContent := server.GetResponseContent(respr)
+	if !contains([]int{http.StatusOK}, respContent.HTTPStatus) {
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusOK", respContent.HTTPStatus)}
+	}
+	resp, err := server.MarshalResponseAsJSON(respContent, server.GetResponse(respr).ResourceGroupUsage, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (r *ResourceGroupsServerTransport) dispatchNewListPager(req *http.Request) (*http.Response, error) {
 	if r.srv.NewListPager == nil {
 		return nil, &nonRetriableError{errors.New("fake for method NewListPager not implemented")}
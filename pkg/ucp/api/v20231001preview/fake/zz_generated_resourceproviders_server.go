@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 )
 
 // ResourceProvidersServer is a fake server for instances of the v20231001preview.ResourceProvidersClient type.
@@ -183,6 +184,7 @@ func (r *ResourceProvidersServerTransport) dispatchBeginDelete(req *http.Request
 	if matches == nil || len(matches) < 2 {
 		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
 	}
+	qp := req.URL.Query()
 	planeNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("planeName")])
 	if err != nil {
 		return nil, err
@@ -191,7 +193,21 @@ func (r *ResourceProvidersServerTransport) dispatchBeginDelete(req *http.Request
 	if err != nil {
 		return nil, err
 	}
-	respr, errRespr := r.srv.BeginDelete(req.Context(), planeNameParam, resourceProviderNameParam, nil)
+	forceUnescaped, err := url.QueryUnescape(qp.Get("force"))
+	if err != nil {
+		return nil, err
+	}
+	forceParam, err := parseOptional(forceUnescaped, strconv.ParseBool)
+	if err != nil {
+		return nil, err
+	}
+	var options *v20231001preview.ResourceProvidersClientBeginDeleteOptions
+	if forceParam != nil {
+		options = &v20231001preview.ResourceProvidersClientBeginDeleteOptions{
+			Force: forceParam,
+		}
+	}
+	respr, errRespr := r.srv.BeginDelete(req.Context(), planeNameParam, resourceProviderNameParam, options)
 	if respErr := server.GetError(errRespr, req); respErr != nil {
 		return nil, respErr
 	}
@@ -30,6 +30,12 @@ type ServerFactory struct {
 	// AzurePlanesServer contains the fakes for client AzurePlanesClient
 	AzurePlanesServer AzurePlanesServer
 
+	// GcpCredentialsServer contains the fakes for client GcpCredentialsClient
+	GcpCredentialsServer GcpCredentialsServer
+
+	// GcpPlanesServer contains the fakes for client GcpPlanesClient
+	GcpPlanesServer GcpPlanesServer
+
 	// LocationsServer contains the fakes for client LocationsClient
 	LocationsServer LocationsServer
 
@@ -51,6 +57,12 @@ type ServerFactory struct {
 	// ResourcesServer contains the fakes for client ResourcesClient
 	ResourcesServer ResourcesServer
 
+	// TemplateSpecVersionsServer contains the fakes for client TemplateSpecVersionsClient
+	TemplateSpecVersionsServer TemplateSpecVersionsServer
+
+	// TemplateSpecsServer contains the fakes for client TemplateSpecsClient
+	TemplateSpecsServer TemplateSpecsServer
+
 }
 
 // NewServerFactoryTransport creates a new instance of ServerFactoryTransport with the provided implementation.
@@ -72,6 +84,8 @@ type ServerFactoryTransport struct {
 	trAwsPlanesServer *AwsPlanesServerTransport
 	trAzureCredentialsServer *AzureCredentialsServerTransport
 	trAzurePlanesServer *AzurePlanesServerTransport
+	trGcpCredentialsServer *GcpCredentialsServerTransport
+	trGcpPlanesServer *GcpPlanesServerTransport
 	trLocationsServer *LocationsServerTransport
 	trPlanesServer *PlanesServerTransport
 	trRadiusPlanesServer *RadiusPlanesServerTransport
@@ -79,6 +93,8 @@ type ServerFactoryTransport struct {
 	trResourceProvidersServer *ResourceProvidersServerTransport
 	trResourceTypesServer *ResourceTypesServerTransport
 	trResourcesServer *ResourcesServerTransport
+	trTemplateSpecVersionsServer *TemplateSpecVersionsServerTransport
+	trTemplateSpecsServer *TemplateSpecsServerTransport
 }
 
 // Do implements the policy.Transporter interface for ServerFactoryTransport.
@@ -109,6 +125,12 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "AzurePlanesClient":
 		initServer(s, &s.trAzurePlanesServer, func() *AzurePlanesServerTransport { return NewAzurePlanesServerTransport(&s.srv.AzurePlanesServer) })
 		resp, err = s.trAzurePlanesServer.Do(req)
+	case "GcpCredentialsClient":
+		initServer(s, &s.trGcpCredentialsServer, func() *GcpCredentialsServerTransport { return NewGcpCredentialsServerTransport(&s.srv.GcpCredentialsServer) })
+		resp, err = s.trGcpCredentialsServer.Do(req)
+	case "GcpPlanesClient":
+		initServer(s, &s.trGcpPlanesServer, func() *GcpPlanesServerTransport { return NewGcpPlanesServerTransport(&s.srv.GcpPlanesServer) })
+		resp, err = s.trGcpPlanesServer.Do(req)
 	case "LocationsClient":
 		initServer(s, &s.trLocationsServer, func() *LocationsServerTransport { return NewLocationsServerTransport(&s.srv.LocationsServer) })
 		resp, err = s.trLocationsServer.Do(req)
@@ -130,6 +152,12 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "ResourcesClient":
 		initServer(s, &s.trResourcesServer, func() *ResourcesServerTransport { return NewResourcesServerTransport(&s.srv.ResourcesServer) })
 		resp, err = s.trResourcesServer.Do(req)
+	case "TemplateSpecVersionsClient":
+		initServer(s, &s.trTemplateSpecVersionsServer, func() *TemplateSpecVersionsServerTransport { return NewTemplateSpecVersionsServerTransport(&s.srv.TemplateSpecVersionsServer) })
+		resp, err = s.trTemplateSpecVersionsServer.Do(req)
+	case "TemplateSpecsClient":
+		initServer(s, &s.trTemplateSpecsServer, func() *TemplateSpecsServerTransport { return NewTemplateSpecsServerTransport(&s.srv.TemplateSpecsServer) })
+		resp, err = s.trTemplateSpecsServer.Do(req)
 	default:
 		err = fmt.Errorf("unhandled client %s", client)
 	}
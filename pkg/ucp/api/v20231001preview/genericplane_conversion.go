@@ -44,10 +44,21 @@ func (dst *GenericPlaneResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Tags = *to.StringMapPtr(plane.Tags)
 	dst.SystemData = fromSystemDataModel(plane.SystemData)
 
-	// Right now we don't output any of the properties of a plane. We can't know if they contain secrets.
+	// Right now we only output the metadata properties of a plane. We can't know if the other properties contain secrets.
 	dst.Properties = &GenericPlaneResourceProperties{
 		ProvisioningState: fromProvisioningStateDataModel(plane.InternalMetadata.AsyncProvisioningState),
 	}
 
+	if properties, ok := plane.Properties.(map[string]any); ok {
+		dst.Properties.DisplayName = stringPropertyPtr(properties, "displayName")
+		dst.Properties.DefaultLocation = stringPropertyPtr(properties, "defaultLocation")
+	}
+
 	return nil
 }
+
+// stringPropertyPtr returns the value of a string property, or nil if the property is not set.
+func stringPropertyPtr(properties map[string]any, name string) *string {
+	value, _ := properties[name].(string)
+	return toStringPtr(value)
+}
@@ -52,6 +52,23 @@ func Test_GenericPlane_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "genericplane-datamodel-metadata.json",
+			expected: &GenericPlaneResource{
+				ID:       to.Ptr("/planes/aws/aws"),
+				Name:     to.Ptr("aws"),
+				Type:     to.Ptr(datamodel.AWSPlaneResourceType),
+				Location: to.Ptr("global"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &GenericPlaneResourceProperties{
+					DefaultLocation:   to.Ptr("us-west-2"),
+					DisplayName:       to.Ptr("Amazon Web Services"),
+					ProvisioningState: fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
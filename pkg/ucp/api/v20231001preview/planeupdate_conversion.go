@@ -0,0 +1,39 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned plane update to the version-agnostic datamodel.
+func (src *PlaneResourceUpdate) ConvertTo() (v1.DataModelInterface, error) {
+	converted := &datamodel.PlaneUpdate{}
+
+	if src.Tags != nil {
+		converted.Tags = to.StringMap(src.Tags)
+	}
+
+	if src.Properties != nil {
+		converted.DisplayName = src.Properties.DisplayName
+		converted.DefaultLocation = src.Properties.DefaultLocation
+	}
+
+	return converted, nil
+}
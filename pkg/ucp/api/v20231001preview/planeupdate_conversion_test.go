@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PlaneUpdate_ConvertVersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("planeupdate-resource.json")
+	r := &PlaneResourceUpdate{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.PlaneUpdate{
+		Tags: map[string]string{
+			"env": "prod",
+		},
+		DefaultLocation: to.Ptr("westus"),
+	}
+	require.Equal(t, expected, dm.(*datamodel.PlaneUpdate))
+}
+
+func Test_PlaneUpdate_ConvertVersionedToDataModel_Empty(t *testing.T) {
+	r := &PlaneResourceUpdate{}
+	err := json.Unmarshal([]byte("{}"), r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+	require.Equal(t, &datamodel.PlaneUpdate{}, dm.(*datamodel.PlaneUpdate))
+}
@@ -40,6 +40,7 @@ func (src *RadiusPlaneResource) ConvertTo() (v1.DataModelInterface, error) {
 		},
 
 		Properties: datamodel.RadiusPlaneProperties{
+			PlaneMetadata:     toPlaneMetadataDataModel(src.Properties.DisplayName, src.Properties.DefaultLocation),
 			ResourceProviders: to.StringMap(src.Properties.ResourceProviders),
 		},
 	}
@@ -64,6 +65,8 @@ func (dst *RadiusPlaneResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &RadiusPlaneResourceProperties{
 		ProvisioningState: fromProvisioningStateDataModel(plane.InternalMetadata.AsyncProvisioningState),
 		ResourceProviders: *to.StringMapPtr(plane.Properties.ResourceProviders),
+		DisplayName:       toStringPtr(plane.Properties.DisplayName),
+		DefaultLocation:   toStringPtr(plane.Properties.DefaultLocation),
 	}
 
 	return nil
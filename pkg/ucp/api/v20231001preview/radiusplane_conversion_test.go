@@ -58,6 +58,34 @@ func Test_RadiusPlane_ConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "radiusplane-resource-metadata.json",
+			expected: &datamodel.RadiusPlane{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local",
+						Name:     "local",
+						Type:     datamodel.RadiusPlaneResourceType,
+						Location: "global",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.RadiusPlaneProperties{
+					PlaneMetadata: datamodel.PlaneMetadata{
+						DisplayName:     "Local",
+						DefaultLocation: "eastus",
+					},
+					ResourceProviders: map[string]string{
+						"Applications.Core": "http://applications-rp:9000",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -104,6 +132,26 @@ func Test_RadiusPlane_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "radiusplane-datamodel-metadata.json",
+			expected: &RadiusPlaneResource{
+				ID:       to.Ptr("/planes/radius/local"),
+				Name:     to.Ptr("local"),
+				Type:     to.Ptr(datamodel.RadiusPlaneResourceType),
+				Location: to.Ptr("global"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &RadiusPlaneResourceProperties{
+					DefaultLocation:   to.Ptr("eastus"),
+					DisplayName:       to.Ptr("Local"),
+					ProvisioningState: fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					ResourceProviders: map[string]*string{
+						"Applications.Core": to.Ptr("http://applications-rp:9000"),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
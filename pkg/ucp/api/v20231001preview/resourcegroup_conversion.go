@@ -42,6 +42,10 @@ func (src *ResourceGroupResource) ConvertTo() (v1.DataModelInterface, error) {
 		},
 	}
 
+	if src.Properties != nil {
+		converted.Properties.Quota = toResourceQuotaDataModel(src.Properties.Quota)
+	}
+
 	return converted, nil
 }
 
@@ -57,6 +61,35 @@ func (dst *ResourceGroupResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Type = to.Ptr(rg.Type)
 	dst.Location = to.Ptr(rg.Location)
 	dst.Tags = *to.StringMapPtr(rg.Tags)
+	if rg.Properties.Quota != nil {
+		dst.Properties = &ResourceGroupProperties{
+			Quota: fromResourceQuotaDataModel(rg.Properties.Quota),
+		}
+	}
 
 	return nil
 }
+
+func toResourceQuotaDataModel(quota *ResourceQuota) *datamodel.ResourceQuota {
+	if quota == nil {
+		return nil
+	}
+
+	return &datamodel.ResourceQuota{
+		MaxContainers:             quota.MaxContainers,
+		MaxRecipeExecutionsPerDay: quota.MaxRecipeExecutionsPerDay,
+		MaxCloudResources:         quota.MaxCloudResources,
+	}
+}
+
+func fromResourceQuotaDataModel(quota *datamodel.ResourceQuota) *ResourceQuota {
+	if quota == nil {
+		return nil
+	}
+
+	return &ResourceQuota{
+		MaxContainers:             quota.MaxContainers,
+		MaxRecipeExecutionsPerDay: quota.MaxRecipeExecutionsPerDay,
+		MaxCloudResources:         quota.MaxCloudResources,
+	}
+}
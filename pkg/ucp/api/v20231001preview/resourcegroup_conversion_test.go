@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/testutil"
@@ -51,6 +52,27 @@ func TestResourceGroupConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "resourcegroup-quota.json",
+			expected: &datamodel.ResourceGroup{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local/resourceGroups/test-rg",
+						Name:     "test-rg",
+						Type:     resources.ResourceGroupType,
+						Location: v1.LocationGlobal,
+						Tags:     map[string]string{},
+					},
+				},
+				Properties: datamodel.ResourceGroupProperties{
+					Quota: &datamodel.ResourceQuota{
+						MaxContainers:             to.Ptr(int32(10)),
+						MaxRecipeExecutionsPerDay: to.Ptr(int32(100)),
+						MaxCloudResources:         to.Ptr(int32(5)),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -89,6 +111,29 @@ func TestResourceGroupConvertDataModelToVersioned(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg", r.TrackedResource.ID)
 	require.Equal(t, "test-rg", r.TrackedResource.Name)
+	require.Nil(t, versioned.Properties)
+}
+
+func TestResourceGroupQuotaConvertDataModelToVersioned(t *testing.T) {
+	r := &datamodel.ResourceGroup{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-rg",
+				Name: "test-rg",
+				Type: resources.ResourceGroupType,
+			},
+		},
+		Properties: datamodel.ResourceGroupProperties{
+			Quota: &datamodel.ResourceQuota{
+				MaxContainers: to.Ptr(int32(10)),
+			},
+		},
+	}
+
+	versioned := &ResourceGroupResource{}
+	err := versioned.ConvertFrom(r)
+	require.NoError(t, err)
+	require.Equal(t, &ResourceQuota{MaxContainers: to.Ptr(int32(10))}, versioned.Properties.Quota)
 }
 
 func TestResourceGroupConvertFromValidation(t *testing.T) {
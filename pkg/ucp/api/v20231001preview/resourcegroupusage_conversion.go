@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned ResourceGroupUsage to version-agnostic datamodel.
+//
+// NOTE: ResourceGroupUsage is READONLY. There is no conversion from versioned to datamodel.
+func (src *ResourceGroupUsage) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, errors.New("the ResourceGroupUsage is READONLY. There is no conversion from versioned to datamodel")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned ResourceGroupUsage.
+func (dst *ResourceGroupUsage) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.ResourceGroupUsage)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.Quota = fromResourceQuotaDataModel(dm.Quota)
+	dst.Usage = fromResourceQuotaUsageDataModel(dm.Usage)
+
+	dst.Environments = []*EnvironmentQuotaUsage{}
+	for _, environment := range dm.Environments {
+		dst.Environments = append(dst.Environments, &EnvironmentQuotaUsage{
+			ID:    to.Ptr(environment.ID),
+			Name:  to.Ptr(environment.Name),
+			Quota: fromResourceQuotaDataModel(environment.Quota),
+			Usage: fromResourceQuotaUsageDataModel(environment.Usage),
+		})
+	}
+
+	return nil
+}
+
+func fromResourceQuotaUsageDataModel(usage datamodel.ResourceQuotaUsage) *ResourceQuotaUsage {
+	return &ResourceQuotaUsage{
+		Containers:            to.Ptr(usage.Containers),
+		RecipeExecutionsToday: to.Ptr(usage.RecipeExecutionsToday),
+		CloudResources:        to.Ptr(usage.CloudResources),
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceGroupUsageConvertDataModelToVersioned(t *testing.T) {
+	dm := &datamodel.ResourceGroupUsage{
+		Quota: &datamodel.ResourceQuota{
+			MaxContainers: to.Ptr(int32(10)),
+		},
+		Usage: datamodel.ResourceQuotaUsage{
+			Containers:            3,
+			RecipeExecutionsToday: 7,
+			CloudResources:        2,
+		},
+		Environments: []datamodel.EnvironmentQuotaUsage{
+			{
+				ID:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env",
+				Name: "test-env",
+				Usage: datamodel.ResourceQuotaUsage{
+					Containers: 1,
+				},
+			},
+		},
+	}
+
+	versioned := &ResourceGroupUsage{}
+	err := versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+
+	expected := &ResourceGroupUsage{
+		Quota: &ResourceQuota{
+			MaxContainers: to.Ptr(int32(10)),
+		},
+		Usage: &ResourceQuotaUsage{
+			Containers:            to.Ptr(int32(3)),
+			RecipeExecutionsToday: to.Ptr(int32(7)),
+			CloudResources:        to.Ptr(int32(2)),
+		},
+		Environments: []*EnvironmentQuotaUsage{
+			{
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"),
+				Name: to.Ptr("test-env"),
+				Usage: &ResourceQuotaUsage{
+					Containers:            to.Ptr(int32(1)),
+					RecipeExecutionsToday: to.Ptr(int32(0)),
+					CloudResources:        to.Ptr(int32(0)),
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func TestResourceGroupUsageConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &ResourceGroupUsage{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned TemplateSpecResource resource to version-agnostic datamodel.
+func (src *TemplateSpecResource) ConvertTo() (v1.DataModelInterface, error) {
+	dst := &datamodel.TemplateSpec{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     datamodel.TemplateSpecResourceType,
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+	}
+
+	if src.Properties != nil {
+		dst.Properties.Description = to.String(src.Properties.Description)
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned TemplateSpecResource resource.
+func (dst *TemplateSpecResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.TemplateSpec)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(dm.ID)
+	dst.Name = to.Ptr(dm.Name)
+	dst.Type = to.Ptr(dm.Type)
+	dst.Location = to.Ptr(dm.Location)
+	dst.Tags = *to.StringMapPtr(dm.Tags)
+	dst.SystemData = fromSystemDataModel(dm.SystemData)
+
+	dst.Properties = &TemplateSpecProperties{
+		ProvisioningState: to.Ptr(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+	}
+	if dm.Properties.Description != "" {
+		dst.Properties.Description = to.Ptr(dm.Properties.Description)
+	}
+
+	return nil
+}
+
+// ConvertTo converts from the versioned TemplateSpecVersionResource resource to version-agnostic datamodel.
+func (src *TemplateSpecVersionResource) ConvertTo() (v1.DataModelInterface, error) {
+	dst := &datamodel.TemplateSpecVersion{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.TemplateSpecVersionResourceType,
+				// NOTE: this is a child resource. It does not have a location, systemData, or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+	}
+
+	if src.Properties != nil {
+		dst.Properties.Description = to.String(src.Properties.Description)
+		dst.Properties.Template = src.Properties.Template
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned TemplateSpecVersionResource resource.
+func (dst *TemplateSpecVersionResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.TemplateSpecVersion)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(dm.ID)
+	dst.Name = to.Ptr(dm.Name)
+	dst.Type = to.Ptr(dm.Type)
+
+	// NOTE: this is a child resource. It does not have a location, systemData, or tags.
+
+	dst.Properties = &TemplateSpecVersionProperties{
+		ProvisioningState: to.Ptr(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+		Template:          dm.Properties.Template,
+	}
+	if dm.Properties.Description != "" {
+		dst.Properties.Description = to.Ptr(dm.Properties.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testTemplate = map[string]any{
+	"$schema":        "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+	"contentVersion": "1.0.0.0",
+	"resources":      map[string]any{},
+}
+
+func Test_TemplateSpec_VersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.TemplateSpec
+		err      error
+	}{
+		{
+			filename: "templatespec_resource.json",
+			expected: &datamodel.TemplateSpec{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local/resourceGroups/rg1/providers/System.Resources/templateSpecs/myapp",
+						Name:     "myapp",
+						Type:     datamodel.TemplateSpecResourceType,
+						Location: "global",
+						Tags:     map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.TemplateSpecProperties{
+					Description: "The myapp application template.",
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			versioned := &TemplateSpecResource{}
+			err := json.Unmarshal(rawPayload, versioned)
+			require.NoError(t, err)
+
+			dm, err := versioned.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm)
+			}
+		})
+	}
+}
+
+func Test_TemplateSpec_DataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *TemplateSpecResource
+		err      error
+	}{
+		{
+			filename: "templatespec_datamodel.json",
+			expected: &TemplateSpecResource{
+				ID:       to.Ptr("/planes/radius/local/resourceGroups/rg1/providers/System.Resources/templateSpecs/myapp"),
+				Type:     to.Ptr(datamodel.TemplateSpecResourceType),
+				Name:     to.Ptr("myapp"),
+				Location: to.Ptr("global"),
+				Tags:     map[string]*string{},
+				Properties: &TemplateSpecProperties{
+					Description:       to.Ptr("The myapp application template."),
+					ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			data := &datamodel.TemplateSpec{}
+			err := json.Unmarshal(rawPayload, data)
+			require.NoError(t, err)
+
+			versioned := &TemplateSpecResource{}
+
+			err = versioned.ConvertFrom(data)
+
+			// Ignore system data.
+			versioned.SystemData = nil
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
+
+func Test_TemplateSpecVersion_VersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.TemplateSpecVersion
+		err      error
+	}{
+		{
+			filename: "templatespecversion_resource.json",
+			expected: &datamodel.TemplateSpecVersion{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/resourceGroups/rg1/providers/System.Resources/templateSpecs/myapp/versions/1.0.0",
+						Name: "1.0.0",
+						Type: datamodel.TemplateSpecVersionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.TemplateSpecVersionProperties{
+					Description: "Initial release.",
+					Template:    testTemplate,
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			versioned := &TemplateSpecVersionResource{}
+			err := json.Unmarshal(rawPayload, versioned)
+			require.NoError(t, err)
+
+			dm, err := versioned.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm)
+			}
+		})
+	}
+}
+
+func Test_TemplateSpecVersion_DataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *TemplateSpecVersionResource
+		err      error
+	}{
+		{
+			filename: "templatespecversion_datamodel.json",
+			expected: &TemplateSpecVersionResource{
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/rg1/providers/System.Resources/templateSpecs/myapp/versions/1.0.0"),
+				Type: to.Ptr(datamodel.TemplateSpecVersionResourceType),
+				Name: to.Ptr("1.0.0"),
+				Properties: &TemplateSpecVersionProperties{
+					Description:       to.Ptr("Initial release."),
+					ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+					Template:          testTemplate,
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			data := &datamodel.TemplateSpecVersion{}
+			err := json.Unmarshal(rawPayload, data)
+			require.NoError(t, err)
+
+			versioned := &TemplateSpecVersionResource{}
+
+			err = versioned.ConvertFrom(data)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
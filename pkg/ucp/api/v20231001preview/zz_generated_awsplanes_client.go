@@ -287,7 +287,7 @@ func (client *AwsPlanesClient) listHandleResponse(resp *http.Response) (AwsPlane
 //   - planeName - The plane name.
 //   - properties - The resource properties to be updated.
 //   - options - AwsPlanesClientBeginUpdateOptions contains the optional parameters for the AwsPlanesClient.BeginUpdate method.
-func (client *AwsPlanesClient) BeginUpdate(ctx context.Context, planeName string, properties AwsPlaneResourceTagsUpdate, options *AwsPlanesClientBeginUpdateOptions) (*runtime.Poller[AwsPlanesClientUpdateResponse], error) {
+func (client *AwsPlanesClient) BeginUpdate(ctx context.Context, planeName string, properties PlaneResourceUpdate, options *AwsPlanesClientBeginUpdateOptions) (*runtime.Poller[AwsPlanesClientUpdateResponse], error) {
 	if options == nil || options.ResumeToken == "" {
 		resp, err := client.update(ctx, planeName, properties, options)
 		if err != nil {
@@ -309,7 +309,7 @@ func (client *AwsPlanesClient) BeginUpdate(ctx context.Context, planeName string
 // If the operation fails it returns an *azcore.ResponseError type.
 //
 // Generated from API version 2023-10-01-preview
-func (client *AwsPlanesClient) update(ctx context.Context, planeName string, properties AwsPlaneResourceTagsUpdate, options *AwsPlanesClientBeginUpdateOptions) (*http.Response, error) {
+func (client *AwsPlanesClient) update(ctx context.Context, planeName string, properties PlaneResourceUpdate, options *AwsPlanesClientBeginUpdateOptions) (*http.Response, error) {
 	var err error
 	const operationName = "AwsPlanesClient.BeginUpdate"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
@@ -331,7 +331,7 @@ func (client *AwsPlanesClient) update(ctx context.Context, planeName string, pro
 }
 
 // updateCreateRequest creates the Update request.
-func (client *AwsPlanesClient) updateCreateRequest(ctx context.Context, planeName string, properties AwsPlaneResourceTagsUpdate, _ *AwsPlanesClientBeginUpdateOptions) (*policy.Request, error) {
+func (client *AwsPlanesClient) updateCreateRequest(ctx context.Context, planeName string, properties PlaneResourceUpdate, _ *AwsPlanesClientBeginUpdateOptions) (*policy.Request, error) {
 	urlPath := "/planes/aws/{planeName}"
 	if planeName == "" {
 		return nil, errors.New("parameter planeName cannot be empty")
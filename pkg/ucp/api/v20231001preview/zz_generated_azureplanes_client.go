@@ -287,7 +287,7 @@ func (client *AzurePlanesClient) listHandleResponse(resp *http.Response) (AzureP
 //   - planeName - The plane name.
 //   - properties - The resource properties to be updated.
 //   - options - AzurePlanesClientBeginUpdateOptions contains the optional parameters for the AzurePlanesClient.BeginUpdate method.
-func (client *AzurePlanesClient) BeginUpdate(ctx context.Context, planeName string, properties AzurePlaneResourceTagsUpdate, options *AzurePlanesClientBeginUpdateOptions) (*runtime.Poller[AzurePlanesClientUpdateResponse], error) {
+func (client *AzurePlanesClient) BeginUpdate(ctx context.Context, planeName string, properties PlaneResourceUpdate, options *AzurePlanesClientBeginUpdateOptions) (*runtime.Poller[AzurePlanesClientUpdateResponse], error) {
 	if options == nil || options.ResumeToken == "" {
 		resp, err := client.update(ctx, planeName, properties, options)
 		if err != nil {
@@ -309,7 +309,7 @@ func (client *AzurePlanesClient) BeginUpdate(ctx context.Context, planeName stri
 // If the operation fails it returns an *azcore.ResponseError type.
 //
 // Generated from API version 2023-10-01-preview
-func (client *AzurePlanesClient) update(ctx context.Context, planeName string, properties AzurePlaneResourceTagsUpdate, options *AzurePlanesClientBeginUpdateOptions) (*http.Response, error) {
+func (client *AzurePlanesClient) update(ctx context.Context, planeName string, properties PlaneResourceUpdate, options *AzurePlanesClientBeginUpdateOptions) (*http.Response, error) {
 	var err error
 	const operationName = "AzurePlanesClient.BeginUpdate"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
@@ -331,7 +331,7 @@ func (client *AzurePlanesClient) update(ctx context.Context, planeName string, p
 }
 
 // updateCreateRequest creates the Update request.
-func (client *AzurePlanesClient) updateCreateRequest(ctx context.Context, planeName string, properties AzurePlaneResourceTagsUpdate, _ *AzurePlanesClientBeginUpdateOptions) (*policy.Request, error) {
+func (client *AzurePlanesClient) updateCreateRequest(ctx context.Context, planeName string, properties PlaneResourceUpdate, _ *AzurePlanesClientBeginUpdateOptions) (*policy.Request, error) {
 	urlPath := "/planes/azure/{planeName}"
 	if planeName == "" {
 		return nil, errors.New("parameter planeName cannot be empty")
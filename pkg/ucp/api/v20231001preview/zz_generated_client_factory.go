@@ -64,6 +64,20 @@ func (c *ClientFactory) NewAzurePlanesClient() *AzurePlanesClient {
 	}
 }
 
+// NewGcpCredentialsClient creates a new instance of GcpCredentialsClient.
+func (c *ClientFactory) NewGcpCredentialsClient() *GcpCredentialsClient {
+	return &GcpCredentialsClient{
+		internal: c.internal,
+	}
+}
+
+// NewGcpPlanesClient creates a new instance of GcpPlanesClient.
+func (c *ClientFactory) NewGcpPlanesClient() *GcpPlanesClient {
+	return &GcpPlanesClient{
+		internal: c.internal,
+	}
+}
+
 // NewLocationsClient creates a new instance of LocationsClient.
 func (c *ClientFactory) NewLocationsClient() *LocationsClient {
 	return &LocationsClient{
@@ -113,3 +127,17 @@ func (c *ClientFactory) NewResourcesClient() *ResourcesClient {
 	}
 }
 
+// NewTemplateSpecVersionsClient creates a new instance of TemplateSpecVersionsClient.
+func (c *ClientFactory) NewTemplateSpecVersionsClient() *TemplateSpecVersionsClient {
+	return &TemplateSpecVersionsClient{
+		internal: c.internal,
+	}
+}
+
+// NewTemplateSpecsClient creates a new instance of TemplateSpecsClient.
+func (c *ClientFactory) NewTemplateSpecsClient() *TemplateSpecsClient {
+	return &TemplateSpecsClient{
+		internal: c.internal,
+	}
+}
+
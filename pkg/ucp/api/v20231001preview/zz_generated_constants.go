@@ -82,6 +82,24 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// GCPCredentialKind - GCP credential kind
+type GCPCredentialKind string
+
+const (
+// GCPCredentialKindServiceAccountKey - The GCP service account key credential
+	GCPCredentialKindServiceAccountKey GCPCredentialKind = "ServiceAccountKey"
+// GCPCredentialKindWorkloadIdentity - GKE workload identity. For more information, please see: https://cloud.google.com/kubernetes-engine/docs/how-to/workload-identity
+	GCPCredentialKindWorkloadIdentity GCPCredentialKind = "WorkloadIdentity"
+)
+
+// PossibleGCPCredentialKindValues returns the possible values for the GCPCredentialKind const type.
+func PossibleGCPCredentialKindValues() []GCPCredentialKind {
+	return []GCPCredentialKind{	
+		GCPCredentialKindServiceAccountKey,
+		GCPCredentialKindWorkloadIdentity,
+	}
+}
+
 // ProvisioningState - Provisioning state of the resource at the time the operation was called
 type ProvisioningState string
 
@@ -176,16 +176,16 @@ type AwsPlaneResourceListResult struct {
 
 // AwsPlaneResourceProperties - The Plane properties.
 type AwsPlaneResourceProperties struct {
+// The location used for the resources of the plane when a location is not specified, for example an Azure or AWS region.
+	DefaultLocation *string
+
+// The display name of the plane.
+	DisplayName *string
+
 // READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
 
-// AwsPlaneResourceTagsUpdate - The type used for updating tags in AwsPlaneResource resources.
-type AwsPlaneResourceTagsUpdate struct {
-// Resource tags.
-	Tags map[string]*string
-}
-
 // AzureCredentialProperties - The base properties of Azure Credential
 type AzureCredentialProperties struct {
 // REQUIRED; The kind of Azure credential
@@ -275,16 +275,16 @@ type AzurePlaneResourceProperties struct {
 // REQUIRED; The URL used to proxy requests.
 	URL *string
 
+// The location used for the resources of the plane when a location is not specified, for example an Azure or AWS region.
+	DefaultLocation *string
+
+// The display name of the plane.
+	DisplayName *string
+
 // READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
 
-// AzurePlaneResourceTagsUpdate - The type used for updating tags in AzurePlaneResource resources.
-type AzurePlaneResourceTagsUpdate struct {
-// Resource tags.
-	Tags map[string]*string
-}
-
 // AzureServicePrincipalProperties - The properties of Azure Service Principal credential storage
 type AzureServicePrincipalProperties struct {
 // REQUIRED; clientId for ServicePrincipal
@@ -368,6 +368,21 @@ type CredentialStorageProperties struct {
 // GetCredentialStorageProperties implements the CredentialStoragePropertiesClassification interface for type CredentialStorageProperties.
 func (c *CredentialStorageProperties) GetCredentialStorageProperties() *CredentialStorageProperties { return c }
 
+// EnvironmentQuotaUsage - The quota and usage of an environment in a resource group.
+type EnvironmentQuotaUsage struct {
+// REQUIRED; The resource id of the environment.
+	ID *string
+
+// REQUIRED; The name of the environment.
+	Name *string
+
+// REQUIRED; The usage of the environment.
+	Usage *ResourceQuotaUsage
+
+// The quota of the environment.
+	Quota *ResourceQuota
+}
+
 // ErrorAdditionalInfo - The resource management error additional info.
 type ErrorAdditionalInfo struct {
 // READ-ONLY; The additional info.
@@ -402,6 +417,149 @@ type ErrorResponse struct {
 	Error *ErrorDetail
 }
 
+// GcpCredentialProperties - GCP Credential properties
+type GcpCredentialProperties struct {
+// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpCredentialProperties.
+func (g *GcpCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties { return g }
+
+// GcpCredentialResource - Concrete tracked resource types can be created by aliasing this type using a specific property
+// type.
+type GcpCredentialResource struct {
+// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+// REQUIRED; The resource-specific properties for this resource.
+	Properties GcpCredentialPropertiesClassification
+
+// Resource tags.
+	Tags map[string]*string
+
+// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+// READ-ONLY; The name of the resource
+	Name *string
+
+// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// GcpCredentialResourceListResult - The response of a GcpCredentialResource list operation.
+type GcpCredentialResourceListResult struct {
+// REQUIRED; The GcpCredentialResource items on this page
+	Value []*GcpCredentialResource
+
+// The link to the next page of items
+	NextLink *string
+}
+
+// GcpCredentialResourceTagsUpdate - The type used for updating tags in GcpCredentialResource resources.
+type GcpCredentialResourceTagsUpdate struct {
+// Resource tags.
+	Tags map[string]*string
+}
+
+// GcpPlaneResource - The GCP plane resource
+type GcpPlaneResource struct {
+// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+// REQUIRED; The resource-specific properties for this resource.
+	Properties *GcpPlaneResourceProperties
+
+// Resource tags.
+	Tags map[string]*string
+
+// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+// READ-ONLY; The name of the resource
+	Name *string
+
+// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// GcpPlaneResourceListResult - The response of a GcpPlaneResource list operation.
+type GcpPlaneResourceListResult struct {
+// REQUIRED; The GcpPlaneResource items on this page
+	Value []*GcpPlaneResource
+
+// The link to the next page of items
+	NextLink *string
+}
+
+// GcpPlaneResourceProperties - The Plane properties.
+type GcpPlaneResourceProperties struct {
+// The location used for the resources of the plane when a location is not specified, for example an Azure or GCP region.
+	DefaultLocation *string
+
+// The display name of the plane.
+	DisplayName *string
+
+// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GcpServiceAccountKeyCredentialProperties - GCP credential properties for a service account key
+type GcpServiceAccountKeyCredentialProperties struct {
+// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+// REQUIRED; The JSON key file contents for the GCP service account
+	ServiceAccountKey *string
+
+// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpServiceAccountKeyCredentialProperties.
+func (g *GcpServiceAccountKeyCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties {
+	return &GcpCredentialProperties{
+		Kind: g.Kind,
+		ProvisioningState: g.ProvisioningState,
+	}
+}
+
+// GcpWorkloadIdentityCredentialProperties - GCP credential properties for GKE workload identity
+type GcpWorkloadIdentityCredentialProperties struct {
+// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+// REQUIRED; The email of the GCP service account to impersonate
+	ServiceAccountEmail *string
+
+// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpWorkloadIdentityCredentialProperties.
+func (g *GcpWorkloadIdentityCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties {
+	return &GcpCredentialProperties{
+		Kind: g.Kind,
+		ProvisioningState: g.ProvisioningState,
+	}
+}
+
 // GenericPlaneResource - The generic representation of a plane resource
 type GenericPlaneResource struct {
 // REQUIRED; The geo-location where the resource lives
@@ -437,6 +595,12 @@ type GenericPlaneResourceListResult struct {
 
 // GenericPlaneResourceProperties - The properties of the generic representation of a plane resource.
 type GenericPlaneResourceProperties struct {
+// The location used for the resources of the plane when a location is not specified, for example an Azure or AWS region.
+	DefaultLocation *string
+
+// The display name of the plane.
+	DisplayName *string
+
 // READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -539,12 +703,31 @@ type PagedResourceProviderSummary struct {
 	NextLink *string
 }
 
+// PlaneMetadataProperties - The metadata properties of a plane. Other components read these values instead of hardcoding
+// them.
+type PlaneMetadataProperties struct {
+// The location used for the resources of the plane when a location is not specified, for example an Azure or AWS region.
+	DefaultLocation *string
+
+// The display name of the plane.
+	DisplayName *string
+}
+
 // PlaneNameParameter - The Plane Name parameter.
 type PlaneNameParameter struct {
 // REQUIRED; The name of the plane
 	PlaneName *string
 }
 
+// PlaneResourceUpdate - The plane properties to be updated.
+type PlaneResourceUpdate struct {
+// The metadata properties of the plane to be updated.
+	Properties *PlaneMetadataProperties
+
+// Resource tags.
+	Tags map[string]*string
+}
+
 // ProxyResource - The resource model definition for a Azure Resource Manager proxy resource. It will not have tags and a
 // location
 type ProxyResource struct {
@@ -599,16 +782,16 @@ type RadiusPlaneResourceProperties struct {
 // REQUIRED; Resource Providers for UCP Native Plane
 	ResourceProviders map[string]*string
 
+// The location used for the resources of the plane when a location is not specified, for example an Azure or AWS region.
+	DefaultLocation *string
+
+// The display name of the plane.
+	DisplayName *string
+
 // READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
 
-// RadiusPlaneResourceTagsUpdate - The type used for updating tags in RadiusPlaneResource resources.
-type RadiusPlaneResourceTagsUpdate struct {
-// Resource tags.
-	Tags map[string]*string
-}
-
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 // READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -626,6 +809,9 @@ type Resource struct {
 
 // ResourceGroupProperties - The resource group resource properties
 type ResourceGroupProperties struct {
+// The quota of the resource group.
+	Quota *ResourceQuota
+
 // READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -669,6 +855,18 @@ type ResourceGroupResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// ResourceGroupUsage - The quota and usage of a resource group and of its environments.
+type ResourceGroupUsage struct {
+// REQUIRED; The quota and usage of the environments in the resource group.
+	Environments []*EnvironmentQuotaUsage
+
+// REQUIRED; The usage of the resource group.
+	Usage *ResourceQuotaUsage
+
+// The quota of the resource group.
+	Quota *ResourceQuota
+}
+
 // ResourceProviderProperties - The properties of a resource provider.
 type ResourceProviderProperties struct {
 // READ-ONLY; The status of the asynchronous operation.
@@ -733,6 +931,30 @@ type ResourceProviderSummaryResourceType struct {
 	DefaultAPIVersion *string
 }
 
+// ResourceQuota - The quota of the resources in a resource group or an environment. A limit is not enforced when omitted.
+type ResourceQuota struct {
+// The maximum number of Azure, AWS and GCP resources deployed by Radius.
+	MaxCloudResources *int32
+
+// The maximum number of containers.
+	MaxContainers *int32
+
+// The maximum number of recipe executions per day (UTC).
+	MaxRecipeExecutionsPerDay *int32
+}
+
+// ResourceQuotaUsage - The current consumption of the quota of a resource group or an environment.
+type ResourceQuotaUsage struct {
+// REQUIRED; The number of Azure, AWS and GCP resources deployed by Radius.
+	CloudResources *int32
+
+// REQUIRED; The number of containers.
+	Containers *int32
+
+// REQUIRED; The number of recipe executions of the current day (UTC).
+	RecipeExecutionsToday *int32
+}
+
 // ResourceTypeProperties - The properties of a resource type.
 type ResourceTypeProperties struct {
 // The resource type capabilities.
@@ -793,6 +1015,89 @@ type SystemData struct {
 	LastModifiedByType *CreatedByType
 }
 
+// TemplateSpecProperties - The properties of a template spec.
+type TemplateSpecProperties struct {
+// The description of the template spec.
+	Description *string
+
+// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// TemplateSpecResource - The resource type for defining a template spec. A template spec is a named collection of versioned
+// deployment templates.
+type TemplateSpecResource struct {
+// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+// The resource-specific properties for this resource.
+	Properties *TemplateSpecProperties
+
+// Resource tags.
+	Tags map[string]*string
+
+// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+// READ-ONLY; The name of the resource
+	Name *string
+
+// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// TemplateSpecResourceListResult - The response of a TemplateSpecResource list operation.
+type TemplateSpecResourceListResult struct {
+// REQUIRED; The TemplateSpecResource items on this page
+	Value []*TemplateSpecResource
+
+// The link to the next page of items
+	NextLink *string
+}
+
+// TemplateSpecVersionProperties - The properties of a template spec version.
+type TemplateSpecVersionProperties struct {
+// REQUIRED; The compiled deployment template. A template spec version is immutable once created.
+	Template map[string]any
+
+// The description of the template spec version.
+	Description *string
+
+// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// TemplateSpecVersionResource - The resource type for defining a version of a template spec. A template spec version stores
+// a compiled deployment template.
+type TemplateSpecVersionResource struct {
+// The resource-specific properties for this resource.
+	Properties *TemplateSpecVersionProperties
+
+// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+// READ-ONLY; The name of the resource
+	Name *string
+
+// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// TemplateSpecVersionResourceListResult - The response of a TemplateSpecVersionResource list operation.
+type TemplateSpecVersionResourceListResult struct {
+// REQUIRED; The TemplateSpecVersionResource items on this page
+	Value []*TemplateSpecVersionResource
+
+// The link to the next page of items
+	NextLink *string
+}
+
 // TrackedResource - The resource model definition for an Azure Resource Manager tracked top level resource which has 'tags'
 // and a 'location'
 type TrackedResource struct {
@@ -137,6 +137,61 @@ type AzurePlanesClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// GcpCredentialsClientCreateOrUpdateOptions contains the optional parameters for the GcpCredentialsClient.CreateOrUpdate
+// method.
+type GcpCredentialsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientDeleteOptions contains the optional parameters for the GcpCredentialsClient.Delete method.
+type GcpCredentialsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientGetOptions contains the optional parameters for the GcpCredentialsClient.Get method.
+type GcpCredentialsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientListOptions contains the optional parameters for the GcpCredentialsClient.NewListPager method.
+type GcpCredentialsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientUpdateOptions contains the optional parameters for the GcpCredentialsClient.Update method.
+type GcpCredentialsClientUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpPlanesClientBeginCreateOrUpdateOptions contains the optional parameters for the GcpPlanesClient.BeginCreateOrUpdate
+// method.
+type GcpPlanesClientBeginCreateOrUpdateOptions struct {
+// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientBeginDeleteOptions contains the optional parameters for the GcpPlanesClient.BeginDelete method.
+type GcpPlanesClientBeginDeleteOptions struct {
+// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientBeginUpdateOptions contains the optional parameters for the GcpPlanesClient.BeginUpdate method.
+type GcpPlanesClientBeginUpdateOptions struct {
+// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientGetOptions contains the optional parameters for the GcpPlanesClient.Get method.
+type GcpPlanesClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpPlanesClientListOptions contains the optional parameters for the GcpPlanesClient.NewListPager method.
+type GcpPlanesClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // LocationsClientBeginCreateOrUpdateOptions contains the optional parameters for the LocationsClient.BeginCreateOrUpdate
 // method.
 type LocationsClientBeginCreateOrUpdateOptions struct {
@@ -210,6 +265,11 @@ type ResourceGroupsClientGetOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceGroupsClientGetUsageOptions contains the optional parameters for the ResourceGroupsClient.GetUsage method.
+type ResourceGroupsClientGetUsageOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceGroupsClientListOptions contains the optional parameters for the ResourceGroupsClient.NewListPager method.
 type ResourceGroupsClientListOptions struct {
 	// placeholder for future optional parameters
@@ -230,6 +290,9 @@ type ResourceProvidersClientBeginCreateOrUpdateOptions struct {
 // ResourceProvidersClientBeginDeleteOptions contains the optional parameters for the ResourceProvidersClient.BeginDelete
 // method.
 type ResourceProvidersClientBeginDeleteOptions struct {
+	// Delete the resource provider even when resources of its resource types exist. The resources are not deleted.
+	Force *bool
+
 // Resumes the long-running operation from the provided token.
 	ResumeToken string
 }
@@ -284,3 +347,45 @@ type ResourcesClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// TemplateSpecVersionsClientCreateOrUpdateOptions contains the optional parameters for the TemplateSpecVersionsClient.CreateOrUpdate
+// method.
+type TemplateSpecVersionsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// TemplateSpecVersionsClientDeleteOptions contains the optional parameters for the TemplateSpecVersionsClient.Delete method.
+type TemplateSpecVersionsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// TemplateSpecVersionsClientGetOptions contains the optional parameters for the TemplateSpecVersionsClient.Get method.
+type TemplateSpecVersionsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// TemplateSpecVersionsClientListOptions contains the optional parameters for the TemplateSpecVersionsClient.NewListPager method.
+type TemplateSpecVersionsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// TemplateSpecsClientCreateOrUpdateOptions contains the optional parameters for the TemplateSpecsClient.CreateOrUpdate
+// method.
+type TemplateSpecsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// TemplateSpecsClientDeleteOptions contains the optional parameters for the TemplateSpecsClient.Delete method.
+type TemplateSpecsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// TemplateSpecsClientGetOptions contains the optional parameters for the TemplateSpecsClient.Get method.
+type TemplateSpecsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// TemplateSpecsClientListOptions contains the optional parameters for the TemplateSpecsClient.NewListPager method.
+type TemplateSpecsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
@@ -73,3 +73,26 @@ func unmarshalCredentialStoragePropertiesClassification(rawMsg json.RawMessage)
 	return b, nil
 }
 
+func unmarshalGcpCredentialPropertiesClassification(rawMsg json.RawMessage) (GcpCredentialPropertiesClassification, error) {
+	if rawMsg == nil || string(rawMsg) == "null" {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(rawMsg, &m); err != nil {
+		return nil, err
+	}
+	var b GcpCredentialPropertiesClassification
+	switch m["kind"] {
+	case string(GCPCredentialKindServiceAccountKey):
+		b = &GcpServiceAccountKeyCredentialProperties{}
+	case string(GCPCredentialKindWorkloadIdentity):
+		b = &GcpWorkloadIdentityCredentialProperties{}
+	default:
+		b = &GcpCredentialProperties{}
+	}
+	if err := json.Unmarshal(rawMsg, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
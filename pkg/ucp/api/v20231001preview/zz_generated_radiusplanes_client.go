@@ -289,7 +289,7 @@ func (client *RadiusPlanesClient) listHandleResponse(resp *http.Response) (Radiu
 //   - properties - The resource properties to be updated.
 //   - options - RadiusPlanesClientBeginUpdateOptions contains the optional parameters for the RadiusPlanesClient.BeginUpdate
 //     method.
-func (client *RadiusPlanesClient) BeginUpdate(ctx context.Context, planeName string, properties RadiusPlaneResourceTagsUpdate, options *RadiusPlanesClientBeginUpdateOptions) (*runtime.Poller[RadiusPlanesClientUpdateResponse], error) {
+func (client *RadiusPlanesClient) BeginUpdate(ctx context.Context, planeName string, properties PlaneResourceUpdate, options *RadiusPlanesClientBeginUpdateOptions) (*runtime.Poller[RadiusPlanesClientUpdateResponse], error) {
 	if options == nil || options.ResumeToken == "" {
 		resp, err := client.update(ctx, planeName, properties, options)
 		if err != nil {
@@ -311,7 +311,7 @@ func (client *RadiusPlanesClient) BeginUpdate(ctx context.Context, planeName str
 // If the operation fails it returns an *azcore.ResponseError type.
 //
 // Generated from API version 2023-10-01-preview
-func (client *RadiusPlanesClient) update(ctx context.Context, planeName string, properties RadiusPlaneResourceTagsUpdate, options *RadiusPlanesClientBeginUpdateOptions) (*http.Response, error) {
+func (client *RadiusPlanesClient) update(ctx context.Context, planeName string, properties PlaneResourceUpdate, options *RadiusPlanesClientBeginUpdateOptions) (*http.Response, error) {
 	var err error
 	const operationName = "RadiusPlanesClient.BeginUpdate"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
@@ -333,7 +333,7 @@ func (client *RadiusPlanesClient) update(ctx context.Context, planeName string,
 }
 
 // updateCreateRequest creates the Update request.
-func (client *RadiusPlanesClient) updateCreateRequest(ctx context.Context, planeName string, properties RadiusPlaneResourceTagsUpdate, _ *RadiusPlanesClientBeginUpdateOptions) (*policy.Request, error) {
+func (client *RadiusPlanesClient) updateCreateRequest(ctx context.Context, planeName string, properties PlaneResourceUpdate, _ *RadiusPlanesClientBeginUpdateOptions) (*policy.Request, error) {
 	urlPath := "/planes/radius/{planeName}"
 	if planeName == "" {
 		return nil, errors.New("parameter planeName cannot be empty")
@@ -211,6 +211,67 @@ func (client *ResourceGroupsClient) getHandleResponse(resp *http.Response) (Reso
 	return result, nil
 }
 
+// GetUsage - Get the quota and usage of a resource group
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - resourceGroupName - The name of resource group
+//   - options - ResourceGroupsClientGetUsageOptions contains the optional parameters for the ResourceGroupsClient.GetUsage
+//     method.
+func (client *ResourceGroupsClient) GetUsage(ctx context.Context, planeName string, resourceGroupName string, options *ResourceGroupsClientGetUsageOptions) (ResourceGroupsClientGetUsageResponse, error) {
+	var err error
+	const operationName = "ResourceGroupsClient.GetUsage"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.getUsageCreateRequest(ctx, planeName, resourceGroupName, options)
+	if err != nil {
+		return ResourceGroupsClientGetUsageResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ResourceGroupsClientGetUsageResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ResourceGroupsClientGetUsageResponse{}, err
+	}
+	resp, err := client.getUsageHandleResponse(httpResp)
+	return resp, err
+}
+
+// getUsageCreateRequest creates the GetUsage request.
+func (client *ResourceGroupsClient) getUsageCreateRequest(ctx context.Context, planeName string, resourceGroupName string, _ *ResourceGroupsClientGetUsageOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/resourcegroups/{resourceGroupName}/usage"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if resourceGroupName == "" {
+		return nil, errors.New("parameter resourceGroupName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceGroupName}", url.PathEscape(resourceGroupName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// getUsageHandleResponse handles the GetUsage response.
+func (client *ResourceGroupsClient) getUsageHandleResponse(resp *http.Response) (ResourceGroupsClientGetUsageResponse, error) {
+	result := ResourceGroupsClientGetUsageResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ResourceGroupUsage); err != nil {
+		return ResourceGroupsClientGetUsageResponse{}, err
+	}
+	return result, nil
+}
+
 // NewListPager - List resource groups
 //
 // Generated from API version 2023-10-01-preview
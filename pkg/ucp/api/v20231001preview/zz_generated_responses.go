@@ -143,6 +143,64 @@ type AzurePlanesClientUpdateResponse struct {
 	AzurePlaneResource
 }
 
+// GcpCredentialsClientCreateOrUpdateResponse contains the response from method GcpCredentialsClient.CreateOrUpdate.
+type GcpCredentialsClientCreateOrUpdateResponse struct {
+// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpCredentialsClientDeleteResponse contains the response from method GcpCredentialsClient.Delete.
+type GcpCredentialsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// GcpCredentialsClientGetResponse contains the response from method GcpCredentialsClient.Get.
+type GcpCredentialsClientGetResponse struct {
+// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpCredentialsClientListResponse contains the response from method GcpCredentialsClient.NewListPager.
+type GcpCredentialsClientListResponse struct {
+// The response of a GcpCredentialResource list operation.
+	GcpCredentialResourceListResult
+}
+
+// GcpCredentialsClientUpdateResponse contains the response from method GcpCredentialsClient.Update.
+type GcpCredentialsClientUpdateResponse struct {
+// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpPlanesClientCreateOrUpdateResponse contains the response from method GcpPlanesClient.BeginCreateOrUpdate.
+type GcpPlanesClientCreateOrUpdateResponse struct {
+// The GCP plane resource
+	GcpPlaneResource
+}
+
+// GcpPlanesClientDeleteResponse contains the response from method GcpPlanesClient.BeginDelete.
+type GcpPlanesClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// GcpPlanesClientGetResponse contains the response from method GcpPlanesClient.Get.
+type GcpPlanesClientGetResponse struct {
+// The GCP plane resource
+	GcpPlaneResource
+}
+
+// GcpPlanesClientListResponse contains the response from method GcpPlanesClient.NewListPager.
+type GcpPlanesClientListResponse struct {
+// The response of a GcpPlaneResource list operation.
+	GcpPlaneResourceListResult
+}
+
+// GcpPlanesClientUpdateResponse contains the response from method GcpPlanesClient.BeginUpdate.
+type GcpPlanesClientUpdateResponse struct {
+// The GCP plane resource
+	GcpPlaneResource
+}
+
 // LocationsClientCreateOrUpdateResponse contains the response from method LocationsClient.BeginCreateOrUpdate.
 type LocationsClientCreateOrUpdateResponse struct {
 // The resource type for defining a location of the containing resource provider. The location resource represents a logical
@@ -220,6 +278,12 @@ type ResourceGroupsClientGetResponse struct {
 	ResourceGroupResource
 }
 
+// ResourceGroupsClientGetUsageResponse contains the response from method ResourceGroupsClient.GetUsage.
+type ResourceGroupsClientGetUsageResponse struct {
+// The quota and usage of a resource group and of its environments.
+	ResourceGroupUsage
+}
+
 // ResourceGroupsClientListResponse contains the response from method ResourceGroupsClient.NewListPager.
 type ResourceGroupsClientListResponse struct {
 // The response of a ResourceGroupResource list operation.
@@ -297,3 +361,49 @@ type ResourcesClientListResponse struct {
 	GenericResourceListResult
 }
 
+// TemplateSpecVersionsClientCreateOrUpdateResponse contains the response from method TemplateSpecVersionsClient.CreateOrUpdate.
+type TemplateSpecVersionsClientCreateOrUpdateResponse struct {
+// The resource type for defining a version of a template spec. A template spec version stores a compiled deployment template.
+	TemplateSpecVersionResource
+}
+
+// TemplateSpecVersionsClientDeleteResponse contains the response from method TemplateSpecVersionsClient.Delete.
+type TemplateSpecVersionsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// TemplateSpecVersionsClientGetResponse contains the response from method TemplateSpecVersionsClient.Get.
+type TemplateSpecVersionsClientGetResponse struct {
+// The resource type for defining a version of a template spec. A template spec version stores a compiled deployment template.
+	TemplateSpecVersionResource
+}
+
+// TemplateSpecVersionsClientListResponse contains the response from method TemplateSpecVersionsClient.NewListPager.
+type TemplateSpecVersionsClientListResponse struct {
+// The response of a TemplateSpecVersionResource list operation.
+	TemplateSpecVersionResourceListResult
+}
+
+// TemplateSpecsClientCreateOrUpdateResponse contains the response from method TemplateSpecsClient.CreateOrUpdate.
+type TemplateSpecsClientCreateOrUpdateResponse struct {
+// The resource type for defining a template spec. A template spec is a named collection of versioned deployment templates.
+	TemplateSpecResource
+}
+
+// TemplateSpecsClientDeleteResponse contains the response from method TemplateSpecsClient.Delete.
+type TemplateSpecsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// TemplateSpecsClientGetResponse contains the response from method TemplateSpecsClient.Get.
+type TemplateSpecsClientGetResponse struct {
+// The resource type for defining a template spec. A template spec is a named collection of versioned deployment templates.
+	TemplateSpecResource
+}
+
+// TemplateSpecsClientListResponse contains the response from method TemplateSpecsClient.NewListPager.
+type TemplateSpecsClientListResponse struct {
+// The response of a TemplateSpecResource list operation.
+	TemplateSpecResourceListResult
+}
+
@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/radius-project/radius/pkg/components/httpclient"
+)
+
+// NewHTTPClient creates the HTTP client for requests to AWS. The client uses the proxy and trusts the certificate
+// authorities of the shared transport for outbound requests. A buildable client is returned rather than an
+// http.Client so that the AWS SDK can still apply its own settings, such as the AWS_CA_BUNDLE environment variable.
+func NewHTTPClient() (*awshttp.BuildableClient, error) {
+	transport, err := httpclient.DefaultTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	return awshttp.NewBuildableClient().WithTransportOptions(func(t *http.Transport) {
+		t.Proxy = transport.Proxy
+		if transport.TLSClientConfig != nil && transport.TLSClientConfig.RootCAs != nil {
+			if t.TLSClientConfig == nil {
+				t.TLSClientConfig = &tls.Config{}
+			}
+			t.TLSClientConfig.RootCAs = transport.TLSClientConfig.RootCAs
+		}
+	}), nil
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultMaxThrottledAttempts is the default maximum number of attempts of a request which is throttled.
+	DefaultMaxThrottledAttempts = 8
+
+	// DefaultMaxThrottlingBackoff is the default maximum delay before retrying a request which is throttled.
+	DefaultMaxThrottlingBackoff = 20 * time.Second
+
+	// DefaultMaxConcurrentRequests is the default maximum number of concurrent requests to an AWS account and region.
+	DefaultMaxConcurrentRequests = 20
+
+	// accountIDParam is the name of the route parameter of the AWS account of a proxied request.
+	accountIDParam = "accountId"
+
+	// unknownLabel is the label used in metrics and logs when the account or the region of a request is unknown.
+	unknownLabel = "unknown"
+)
+
+// ThrottlingOptions represents the options used to handle throttling of the requests to AWS.
+type ThrottlingOptions struct {
+	// MaxAttempts is the maximum number of attempts of a request which is throttled, including the first attempt.
+	MaxAttempts int
+
+	// MaxBackoff is the maximum delay before retrying a request which is throttled. The delay grows exponentially with
+	// the number of attempts and is randomized with jitter.
+	MaxBackoff time.Duration
+
+	// MaxConcurrentRequests is the maximum number of concurrent requests to an AWS account and region. Concurrency is
+	// not limited if this is 0.
+	MaxConcurrentRequests int
+}
+
+// DefaultThrottlingOptions returns the default options used to handle throttling of the requests to AWS.
+func DefaultThrottlingOptions() ThrottlingOptions {
+	return ThrottlingOptions{
+		MaxAttempts:           DefaultMaxThrottledAttempts,
+		MaxBackoff:            DefaultMaxThrottlingBackoff,
+		MaxConcurrentRequests: DefaultMaxConcurrentRequests,
+	}
+}
+
+// IsThrottlingError returns true if the error is returned by AWS because the request was throttled, for example
+// a ThrottlingException.
+func IsThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return awsretry.IsErrorThrottles(awsretry.DefaultThrottles).IsErrorThrottle(err) == aws.TrueTernary
+}
+
+var _ AWSCloudControlClient = (*ThrottledCloudControlClient)(nil)
+
+// ThrottledCloudControlClient is an AWSCloudControlClient which retries the requests throttled by AWS with exponential
+// backoff and jitter, and limits the number of concurrent requests to each AWS account and region. When a request is
+// throttled, the following requests to the same account and region wait for the backoff delay as well, so the rate of
+// the requests adapts to the throttling. The number of requests and throttled requests is reported per account and region.
+type ThrottledCloudControlClient struct {
+	client  AWSCloudControlClient
+	options ThrottlingOptions
+	backoff *awsretry.ExponentialJitterBackoff
+
+	mutex    sync.Mutex
+	limiters map[string]*requestLimiter
+}
+
+// NewThrottledCloudControlClient creates a new ThrottledCloudControlClient which sends the requests to the given client.
+func NewThrottledCloudControlClient(client AWSCloudControlClient, options ThrottlingOptions) *ThrottledCloudControlClient {
+	if options.MaxAttempts < 1 {
+		options.MaxAttempts = 1
+	}
+
+	return &ThrottledCloudControlClient{
+		client:   client,
+		options:  options,
+		backoff:  awsretry.NewExponentialJitterBackoff(options.MaxBackoff),
+		limiters: map[string]*requestLimiter{},
+	}
+}
+
+// GetResource gets a resource, retrying the request if it is throttled.
+func (c *ThrottledCloudControlClient) GetResource(ctx context.Context, params *cloudcontrol.GetResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceOutput, error) {
+	return invoke(ctx, c, "GetResource", optFns, func(ctx context.Context) (*cloudcontrol.GetResourceOutput, error) {
+		return c.client.GetResource(ctx, params, optFns...)
+	})
+}
+
+// ListResources lists resources, retrying the request if it is throttled.
+func (c *ThrottledCloudControlClient) ListResources(ctx context.Context, params *cloudcontrol.ListResourcesInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourcesOutput, error) {
+	return invoke(ctx, c, "ListResources", optFns, func(ctx context.Context) (*cloudcontrol.ListResourcesOutput, error) {
+		return c.client.ListResources(ctx, params, optFns...)
+	})
+}
+
+// DeleteResource deletes a resource, retrying the request if it is throttled.
+func (c *ThrottledCloudControlClient) DeleteResource(ctx context.Context, params *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error) {
+	return invoke(ctx, c, "DeleteResource", optFns, func(ctx context.Context) (*cloudcontrol.DeleteResourceOutput, error) {
+		return c.client.DeleteResource(ctx, params, optFns...)
+	})
+}
+
+// UpdateResource updates a resource, retrying the request if it is throttled.
+func (c *ThrottledCloudControlClient) UpdateResource(ctx context.Context, params *cloudcontrol.UpdateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.UpdateResourceOutput, error) {
+	return invoke(ctx, c, "UpdateResource", optFns, func(ctx context.Context) (*cloudcontrol.UpdateResourceOutput, error) {
+		return c.client.UpdateResource(ctx, params, optFns...)
+	})
+}
+
+// CreateResource creates a resource, retrying the request if it is throttled.
+func (c *ThrottledCloudControlClient) CreateResource(ctx context.Context, params *cloudcontrol.CreateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CreateResourceOutput, error) {
+	return invoke(ctx, c, "CreateResource", optFns, func(ctx context.Context) (*cloudcontrol.CreateResourceOutput, error) {
+		return c.client.CreateResource(ctx, params, optFns...)
+	})
+}
+
+// GetResourceRequestStatus gets the status of a resource request, retrying the request if it is throttled.
+func (c *ThrottledCloudControlClient) GetResourceRequestStatus(ctx context.Context, params *cloudcontrol.GetResourceRequestStatusInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceRequestStatusOutput, error) {
+	return invoke(ctx, c, "GetResourceRequestStatus", optFns, func(ctx context.Context) (*cloudcontrol.GetResourceRequestStatusOutput, error) {
+		return c.client.GetResourceRequestStatus(ctx, params, optFns...)
+	})
+}
+
+// CancelResourceRequest cancels a resource request, retrying the request if it is throttled.
+func (c *ThrottledCloudControlClient) CancelResourceRequest(ctx context.Context, params *cloudcontrol.CancelResourceRequestInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CancelResourceRequestOutput, error) {
+	return invoke(ctx, c, "CancelResourceRequest", optFns, func(ctx context.Context) (*cloudcontrol.CancelResourceRequestOutput, error) {
+		return c.client.CancelResourceRequest(ctx, params, optFns...)
+	})
+}
+
+// ListResourceRequests lists resource requests, retrying the request if it is throttled.
+func (c *ThrottledCloudControlClient) ListResourceRequests(ctx context.Context, params *cloudcontrol.ListResourceRequestsInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourceRequestsOutput, error) {
+	return invoke(ctx, c, "ListResourceRequests", optFns, func(ctx context.Context) (*cloudcontrol.ListResourceRequestsOutput, error) {
+		return c.client.ListResourceRequests(ctx, params, optFns...)
+	})
+}
+
+func invoke[T any](ctx context.Context, c *ThrottledCloudControlClient, operation string, optFns []func(*cloudcontrol.Options), call func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	account, region := requestAccount(ctx), requestRegion(optFns)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	limiter := c.limiter(account, region)
+	if err := limiter.acquire(ctx); err != nil {
+		return zero, err
+	}
+	defer limiter.release()
+
+	for attempt := 1; ; attempt++ {
+		if err := limiter.wait(ctx); err != nil {
+			return zero, err
+		}
+
+		out, err := call(ctx)
+		throttled := IsThrottlingError(err)
+		metrics.DefaultAWSRequestMetrics.RecordRequest(ctx, operation, account, region, throttled)
+		if !throttled || attempt >= c.options.MaxAttempts {
+			return out, err
+		}
+
+		delay, backoffErr := c.backoff.BackoffDelay(attempt, err)
+		if backoffErr != nil {
+			return out, err
+		}
+		limiter.delay(delay)
+
+		logger.Info("AWS request was throttled, retrying", "operation", operation, "account", account, "region", region, "attempt", attempt, "delay", delay.String())
+	}
+}
+
+// limiter returns the request limiter of an AWS account and region.
+func (c *ThrottledCloudControlClient) limiter(account string, region string) *requestLimiter {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := account + "/" + region
+	limiter, ok := c.limiters[key]
+	if !ok {
+		limiter = newRequestLimiter(c.options.MaxConcurrentRequests)
+		c.limiters[key] = limiter
+	}
+
+	return limiter
+}
+
+// requestAccount returns the AWS account of the request proxied by UCP.
+func requestAccount(ctx context.Context) string {
+	if rctx := chi.RouteContext(ctx); rctx != nil {
+		if account := rctx.URLParam(accountIDParam); account != "" {
+			return account
+		}
+	}
+
+	return unknownLabel
+}
+
+// requestRegion returns the AWS region configured by the options of a request.
+func requestRegion(optFns []func(*cloudcontrol.Options)) string {
+	options := cloudcontrol.Options{}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	if options.Region == "" {
+		return unknownLabel
+	}
+
+	return options.Region
+}
+
+// requestLimiter limits the number of concurrent requests to an AWS account and region, and delays the requests after
+// a request is throttled.
+type requestLimiter struct {
+	slots chan struct{}
+
+	mutex    sync.Mutex
+	resumeAt time.Time
+}
+
+func newRequestLimiter(maxConcurrentRequests int) *requestLimiter {
+	limiter := &requestLimiter{}
+	if maxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	return limiter
+}
+
+// acquire waits until a request can be sent.
+func (l *requestLimiter) acquire(ctx context.Context) error {
+	if l.slots == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release releases the slot acquired by a request.
+func (l *requestLimiter) release() {
+	if l.slots == nil {
+		return
+	}
+
+	<-l.slots
+}
+
+// delay delays the requests for the given duration.
+func (l *requestLimiter) delay(d time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if resumeAt := time.Now().Add(d); resumeAt.After(l.resumeAt) {
+		l.resumeAt = resumeAt
+	}
+}
+
+// wait waits until the requests are no longer delayed.
+func (l *requestLimiter) wait(ctx context.Context) error {
+	l.mutex.Lock()
+	d := time.Until(l.resumeAt)
+	l.mutex.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newThrottlingError() error {
+	return &smithy.OperationError{
+		ServiceID:     "CloudControl",
+		OperationName: "GetResource",
+		Err:           &types.ThrottlingException{Message: aws.String("Rate exceeded")},
+	}
+}
+
+func newTestThrottlingOptions() ThrottlingOptions {
+	return ThrottlingOptions{
+		MaxAttempts:           3,
+		MaxBackoff:            time.Millisecond,
+		MaxConcurrentRequests: 1,
+	}
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	require.True(t, IsThrottlingError(newThrottlingError()))
+	require.True(t, IsThrottlingError(&smithy.GenericAPIError{Code: "TooManyRequestsException"}))
+	require.False(t, IsThrottlingError(&smithy.GenericAPIError{Code: "ValidationException"}))
+	require.False(t, IsThrottlingError(errors.New("failed")))
+	require.False(t, IsThrottlingError(nil))
+}
+
+func TestThrottledCloudControlClient(t *testing.T) {
+	withRegion := func(o *cloudcontrol.Options) { o.Region = "us-west-2" }
+
+	t.Run("retries throttled requests", func(t *testing.T) {
+		client := NewMockAWSCloudControlClient(gomock.NewController(t))
+		expected := &cloudcontrol.GetResourceOutput{TypeName: aws.String("AWS::S3::Bucket")}
+		gomock.InOrder(
+			client.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, newThrottlingError()).Times(2),
+			client.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(expected, nil).Times(1),
+		)
+
+		throttled := NewThrottledCloudControlClient(client, newTestThrottlingOptions())
+		out, err := throttled.GetResource(context.Background(), &cloudcontrol.GetResourceInput{}, withRegion)
+		require.NoError(t, err)
+		require.Equal(t, expected, out)
+	})
+
+	t.Run("fails after the maximum number of attempts", func(t *testing.T) {
+		client := NewMockAWSCloudControlClient(gomock.NewController(t))
+		client.EXPECT().DeleteResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, newThrottlingError()).Times(3)
+
+		throttled := NewThrottledCloudControlClient(client, newTestThrottlingOptions())
+		_, err := throttled.DeleteResource(context.Background(), &cloudcontrol.DeleteResourceInput{}, withRegion)
+		require.True(t, IsThrottlingError(err))
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		client := NewMockAWSCloudControlClient(gomock.NewController(t))
+		expectedErr := &smithy.GenericAPIError{Code: "ValidationException"}
+		client.EXPECT().CreateResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr).Times(1)
+
+		throttled := NewThrottledCloudControlClient(client, newTestThrottlingOptions())
+		_, err := throttled.CreateResource(context.Background(), &cloudcontrol.CreateResourceInput{}, withRegion)
+		require.ErrorIs(t, err, expectedErr)
+	})
+
+	t.Run("limits concurrent requests", func(t *testing.T) {
+		client := NewMockAWSCloudControlClient(gomock.NewController(t))
+		started := make(chan struct{})
+		done := make(chan struct{})
+		client.EXPECT().
+			ListResources(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params *cloudcontrol.ListResourcesInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourcesOutput, error) {
+				close(started)
+				<-done
+				return &cloudcontrol.ListResourcesOutput{}, nil
+			}).
+			Times(1)
+
+		throttled := NewThrottledCloudControlClient(client, newTestThrottlingOptions())
+		result := make(chan error)
+		go func() {
+			_, err := throttled.ListResources(context.Background(), &cloudcontrol.ListResourcesInput{}, withRegion)
+			result <- err
+		}()
+		<-started
+
+		// The second request to the same account and region waits for the first request to complete.
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := throttled.ListResources(ctx, &cloudcontrol.ListResourcesInput{}, withRegion)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		close(done)
+		require.NoError(t, <-result)
+	})
+}
+
+func Test_requestAccount(t *testing.T) {
+	require.Equal(t, unknownLabel, requestAccount(context.Background()))
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("accountId", "123456789012")
+	ctx := context.WithValue(context.Background(), chi.RouteCtxKey, rctx)
+	require.Equal(t, "123456789012", requestAccount(ctx))
+}
+
+func Test_requestRegion(t *testing.T) {
+	require.Equal(t, unknownLabel, requestRegion(nil))
+	require.Equal(t, "us-east-1", requestRegion([]func(*cloudcontrol.Options){func(o *cloudcontrol.Options) { o.Region = "us-east-1" }}))
+}
@@ -121,8 +121,14 @@ func (c *UCPCredentialProvider) Retrieve(ctx context.Context) (aws.Credentials,
 		// We should provide the user with ability to configure the STS endpoint region.
 		// For now, we are using the global STS endpoint, which is the default.
 		// Ref. https://github.com/radius-project/radius/issues/7747
+		httpClient, err := NewHTTPClient()
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+
 		awscfg, err := config.LoadDefaultConfig(context.TODO(),
-			config.WithRegion(awsSTSGlobalEndPointSigningRegion))
+			config.WithRegion(awsSTSGlobalEndPointSigningRegion),
+			config.WithHTTPClient(httpClient))
 
 		if err != nil {
 			return aws.Credentials{}, err
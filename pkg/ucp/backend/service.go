@@ -25,6 +25,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -40,15 +41,25 @@ type Service struct {
 	options *ucp.Options
 }
 
-// NewService creates new backend service instance to run the async worker.
+// NewService creates new backend service instance to run the async worker. The worker settings are updated when
+// the configuration file changes if the configuration file is watched.
 func NewService(options *ucp.Options) *Service {
-	return &Service{
+	w := &Service{
 		options: options,
 		Service: worker.Service{
 			// Will be initialized later.
 
 		},
 	}
+
+	if options.ConfigWatcher != nil {
+		options.ConfigWatcher.OnChange(func(ctx context.Context, config *ucp.Config) error {
+			w.UpdateOptions(worker.OptionsFromConfig(&config.Worker))
+			return nil
+		})
+	}
+
+	return w
 }
 
 // Name returns the service name.
@@ -58,12 +69,7 @@ func (w *Service) Name() string {
 
 // Run starts the background worker.
 func (w *Service) Run(ctx context.Context) error {
-	if w.options.Config.Worker.MaxOperationConcurrency != nil {
-		w.Service.Options.MaxOperationConcurrency = *w.options.Config.Worker.MaxOperationConcurrency
-	}
-	if w.options.Config.Worker.MaxOperationRetryCount != nil {
-		w.Service.Options.MaxOperationRetryCount = *w.options.Config.Worker.MaxOperationRetryCount
-	}
+	w.Service.UpdateOptions(worker.OptionsFromConfig(&w.options.Config.Worker))
 
 	databaseClient, err := w.options.DatabaseProvider.GetClient(ctx)
 	if err != nil {
@@ -88,7 +94,11 @@ func (w *Service) Run(ctx context.Context) error {
 		return err
 	}
 
-	transport := otelhttp.NewTransport(http.DefaultTransport)
+	baseTransport, err := httpclient.DefaultTransport()
+	if err != nil {
+		return err
+	}
+	transport := otelhttp.NewTransport(baseTransport)
 	err = RegisterControllers(w.Controllers(), w.options.UCP, transport, opts, defaultDownstream)
 	if err != nil {
 		return err
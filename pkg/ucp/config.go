@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
@@ -35,9 +36,15 @@ import (
 //
 // For testability, all fields on this struct MUST be parsable from YAML without any further initialization required.
 type Config struct {
+	// AWS is the configuration for the AWS plane.
+	AWS AWSConfig `yaml:"aws"`
+
 	// Database is the configuration for the database used for resource data.
 	Database databaseprovider.Options `yaml:"databaseProvider"`
 
+	// DiscoveryLogging is the configuration for logging the requests to the Kubernetes discovery endpoints.
+	DiscoveryLogging DiscoveryLoggingConfig `yaml:"discoveryLogging"`
+
 	// Environment is the configuration for the hosting environment.
 	Environment hostoptions.EnvironmentOptions `yaml:"environment"`
 
@@ -56,6 +63,9 @@ type Config struct {
 	// Profiler is the configuration for the profiler endpoint.
 	Profiler profilerservice.Options `yaml:"profilerProvider"`
 
+	// Proxy is the configuration of the proxy used for outbound requests, including the requests to cloud providers.
+	Proxy httpclient.ProxyOptions `yaml:"proxy"`
+
 	// Routing is the configuration for UCP routing.
 	Routing RoutingConfig `yaml:"routing"`
 
@@ -78,6 +88,12 @@ type Config struct {
 	Worker hostoptions.WorkerServerOptions `yaml:"workerServer"`
 }
 
+const (
+	// DefaultDiscoveryLoggingSampleRate is the default number of requests to the Kubernetes discovery endpoints per
+	// logged request.
+	DefaultDiscoveryLoggingSampleRate = 100
+)
+
 const (
 	// AuthUCPCredential is the authentication method via UCP Credential API.
 	AuthUCPCredential = "UCPCredential"
@@ -92,6 +108,31 @@ type IdentityConfig struct {
 	AuthMethod string `yaml:"authMethod"`
 }
 
+// AWSConfig provides configuration for the AWS plane.
+type AWSConfig struct {
+	// DefaultTags is a set of tags added to every AWS resource created or updated through UCP, when the resource
+	// type supports tagging. Tags specified on the resource take precedence over the default tags.
+	DefaultTags map[string]string `yaml:"defaultTags,omitempty"`
+}
+
+// DiscoveryLoggingConfig provides configuration for logging the requests to the Kubernetes discovery and OpenAPI
+// endpoints. These endpoints are called constantly by the Kubernetes API Server, so the requests are sampled. The count
+// and the latency of all requests are recorded as metrics.
+type DiscoveryLoggingConfig struct {
+	// SampleRate is the number of requests per logged request. Defaults to DefaultDiscoveryLoggingSampleRate if unset.
+	// Logging is disabled if the value is negative.
+	SampleRate int `yaml:"sampleRate,omitempty"`
+}
+
+// GetSampleRate returns the configured sample rate, or the default sample rate if it is unset.
+func (c DiscoveryLoggingConfig) GetSampleRate() int {
+	if c.SampleRate == 0 {
+		return DefaultDiscoveryLoggingSampleRate
+	}
+
+	return c.SampleRate
+}
+
 // RoutingConfig provides configuration for UCP routing.
 type RoutingConfig struct {
 	// DefaultDownstreamEndpoint is the default destination when a resource provider does not provide a downstream endpoint.
@@ -142,13 +183,19 @@ type PlaneProperties struct {
 	URL string `json:"url" yaml:"url"`
 }
 
-// LoadConfig loads a Config from bytes.
+// LoadConfig loads a Config from bytes. References to environment variables and files in the values are resolved by
+// hostoptions.ResolveConfig.
 func LoadConfig(bs []byte) (*Config, error) {
+	bs, err := hostoptions.ResolveConfig(bs)
+	if err != nil {
+		return nil, err
+	}
+
 	decoder := yaml.NewDecoder(bytes.NewBuffer(bs))
 	decoder.KnownFields(true)
 
 	config := Config{}
-	err := decoder.Decode(&config)
+	err = decoder.Decode(&config)
 	if err != nil {
 		return nil, err
 	}
@@ -18,6 +18,7 @@ package config
 
 import (
 	"errors"
+	"time"
 
 	"github.com/radius-project/radius/pkg/sdk"
 	"k8s.io/client-go/rest"
@@ -42,6 +43,10 @@ type UCPOptions struct {
 
 	// Direct describes the connection options for a direct connection.
 	Direct *UCPDirectConnectionOptions `yaml:"direct,omitempty"`
+
+	// Policies describes the retry, circuit breaker and timeout policies of the connection. The defaults of
+	// the sdk package are used for omitted values.
+	Policies *UCPConnectionPolicyOptions `yaml:"policies,omitempty"`
 }
 
 // UCPDirectConnectionOptions describes the connection options for a direct connection.
@@ -50,6 +55,48 @@ type UCPDirectConnectionOptions struct {
 	Endpoint string `yaml:"endpoint"`
 }
 
+// UCPConnectionPolicyOptions describes the retry, circuit breaker and timeout policies of the connection.
+type UCPConnectionPolicyOptions struct {
+	// MaxRetries is the maximum number of retries of a failed request. A negative value disables retries.
+	MaxRetries int `yaml:"maxRetries,omitempty"`
+
+	// RetryDelay is the delay before the first retry. The delay doubles with every retry.
+	RetryDelay time.Duration `yaml:"retryDelay,omitempty"`
+
+	// MaxRetryDelay is the upper bound of the delay between retries.
+	MaxRetryDelay time.Duration `yaml:"maxRetryDelay,omitempty"`
+
+	// CircuitBreakerFailureThreshold is the number of consecutive failed requests that open the circuit.
+	// A negative value disables the circuit breaker.
+	CircuitBreakerFailureThreshold int `yaml:"circuitBreakerFailureThreshold,omitempty"`
+
+	// CircuitBreakerOpenDuration is the duration for which requests are rejected once the circuit is open.
+	CircuitBreakerOpenDuration time.Duration `yaml:"circuitBreakerOpenDuration,omitempty"`
+
+	// RequestTimeout is the timeout of each attempt of a request.
+	RequestTimeout time.Duration `yaml:"requestTimeout,omitempty"`
+}
+
+// connectionOptions converts the policy options to the connection options of the sdk package.
+func (o *UCPConnectionPolicyOptions) connectionOptions() []sdk.ConnectionOption {
+	if o == nil {
+		return nil
+	}
+
+	return []sdk.ConnectionOption{
+		sdk.WithRetryOptions(sdk.RetryOptions{
+			MaxRetries:    o.MaxRetries,
+			RetryDelay:    o.RetryDelay,
+			MaxRetryDelay: o.MaxRetryDelay,
+		}),
+		sdk.WithCircuitBreakerOptions(sdk.CircuitBreakerOptions{
+			FailureThreshold: o.CircuitBreakerFailureThreshold,
+			OpenDuration:     o.CircuitBreakerOpenDuration,
+		}),
+		sdk.WithRequestTimeout(o.RequestTimeout),
+	}
+}
+
 // NewConnectionFromUCPConfig creates a Connection for UCP endpoint. It checks if the connection kind is direct and if so,
 // checks if the endpoint is provided and returns a direct connection, otherwise it returns a Kubernetes connection from
 // the provided config. It returns an error if the endpoint is not provided when the connection kind is direct.
@@ -58,9 +105,9 @@ func NewConnectionFromUCPConfig(option *UCPOptions, k8sConfig *rest.Config) (sdk
 		if option.Direct == nil || option.Direct.Endpoint == "" {
 			return nil, errors.New("the property .ucp.direct.endpoint is required when using a direct connection")
 		}
-		return sdk.NewDirectConnection(option.Direct.Endpoint)
+		return sdk.NewDirectConnection(option.Direct.Endpoint, option.Policies.connectionOptions()...)
 	} else if option.Kind == UCPConnectionKindKubernetes {
-		return sdk.NewKubernetesConnectionFromConfig(k8sConfig)
+		return sdk.NewKubernetesConnectionFromConfig(k8sConfig, option.Policies.connectionOptions()...)
 	}
 
 	return nil, errors.New("invalid connection kind: " + option.Kind)
@@ -27,6 +27,7 @@ const (
 
 // AwsPlaneProperties is the properties of an AWS plane.
 type AWSPlaneProperties struct {
+	PlaneMetadata
 }
 
 // AWSPlane is the representation of an AWS plane.
@@ -41,3 +42,8 @@ type AWSPlane struct {
 func (p AWSPlane) ResourceTypeName() string {
 	return p.Type
 }
+
+// Metadata returns the metadata of the plane.
+func (p *AWSPlane) Metadata() *PlaneMetadata {
+	return &p.Properties.PlaneMetadata
+}
@@ -27,6 +27,8 @@ const (
 
 // AzurePlaneProperties is the properties of an Azure plane.
 type AzurePlaneProperties struct {
+	PlaneMetadata
+
 	URL string
 }
 
@@ -42,3 +44,8 @@ type AzurePlane struct {
 func (p AzurePlane) ResourceTypeName() string {
 	return p.Type
 }
+
+// Metadata returns the metadata of the plane.
+func (p *AzurePlane) Metadata() *PlaneMetadata {
+	return &p.Properties.PlaneMetadata
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"encoding/json"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// TemplateSpecDataModelToVersioned converts version agnostic template spec datamodel to versioned model.
+func TemplateSpecDataModelToVersioned(model *datamodel.TemplateSpec, version string) (v1.VersionedModelInterface, error) {
+	switch version {
+	case v20231001preview.Version:
+		versioned := &v20231001preview.TemplateSpecResource{}
+		if err := versioned.ConvertFrom(model); err != nil {
+			return nil, err
+		}
+		return versioned, nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// TemplateSpecDataModelFromVersioned converts versioned template spec model to datamodel.
+func TemplateSpecDataModelFromVersioned(content []byte, version string) (*datamodel.TemplateSpec, error) {
+	switch version {
+	case v20231001preview.Version:
+		vm := &v20231001preview.TemplateSpecResource{}
+		if err := json.Unmarshal(content, vm); err != nil {
+			return nil, err
+		}
+		dm, err := vm.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.TemplateSpec), nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// TemplateSpecVersionDataModelToVersioned converts version agnostic template spec version datamodel to versioned model.
+func TemplateSpecVersionDataModelToVersioned(model *datamodel.TemplateSpecVersion, version string) (v1.VersionedModelInterface, error) {
+	switch version {
+	case v20231001preview.Version:
+		versioned := &v20231001preview.TemplateSpecVersionResource{}
+		if err := versioned.ConvertFrom(model); err != nil {
+			return nil, err
+		}
+		return versioned, nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// TemplateSpecVersionDataModelFromVersioned converts versioned template spec version model to datamodel.
+func TemplateSpecVersionDataModelFromVersioned(content []byte, version string) (*datamodel.TemplateSpecVersion, error) {
+	switch version {
+	case v20231001preview.Version:
+		vm := &v20231001preview.TemplateSpecVersionResource{}
+		if err := json.Unmarshal(content, vm); err != nil {
+			return nil, err
+		}
+		dm, err := vm.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.TemplateSpecVersion), nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
@@ -29,6 +29,10 @@ const (
 	AWSAccessKeyCredentialKind = "AccessKey"
 	// AWSIRSACredentialKind represents ucp credential kind for aws irsa credentials.
 	AWSIRSACredentialKind = "IRSA"
+	// GCPServiceAccountKeyCredentialKind represents ucp credential kind for gcp service account key credentials.
+	GCPServiceAccountKeyCredentialKind = "ServiceAccountKey"
+	// GCPWorkloadIdentityCredentialKind represents ucp credential kind for gcp workload identity credentials.
+	GCPWorkloadIdentityCredentialKind = "WorkloadIdentity"
 )
 
 // Credential represents UCP Credential.
@@ -55,6 +59,18 @@ func (c *AWSCredential) ResourceTypeName() string {
 	return c.Type
 }
 
+// Credential represents UCP Credential.
+type GCPCredential struct {
+	v1.BaseResource
+
+	Properties *GCPCredentialResourceProperties `json:"properties,omitempty"`
+}
+
+// ResourceTypeName gives the type of ucp resource.
+func (c *GCPCredential) ResourceTypeName() string {
+	return c.Type
+}
+
 // Azure Credential Properties represents UCP Credential Properties.
 type AzureCredentialResourceProperties struct {
 	// Kind is the kind of Azure credential resource.
@@ -75,6 +91,16 @@ type AWSCredentialResourceProperties struct {
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
 }
 
+// GCP Credential Properties represents UCP Credential Properties.
+type GCPCredentialResourceProperties struct {
+	// Kind is the kind of gcp credential resource.
+	Kind string `json:"kind,omitempty"`
+	// GCPCredential is the gcp service account credentials.
+	GCPCredential *GCPCredentialProperties `json:"gcpCredential,omitempty"`
+	// Storage contains the properties of the storage associated with the kind.
+	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+}
+
 // AzureServicePrincipalCredentialProperties contains ucp Azure service principal credential properties.
 type AzureServicePrincipalCredentialProperties struct {
 	// TenantID represents the tenantId of azure service principal credential.
@@ -126,6 +152,28 @@ type AWSCredentialProperties struct {
 	IRSACredential *AWSIRSACredentialProperties `json:"irsa,omitempty"`
 }
 
+// GCPServiceAccountKeyCredentialProperties contains ucp GCP service account key credential properties.
+type GCPServiceAccountKeyCredentialProperties struct {
+	// ServiceAccountKey contains the JSON key file of the gcp service account.
+	ServiceAccountKey string `json:"serviceAccountKey,omitempty"`
+}
+
+// GCPWorkloadIdentityCredentialProperties contains ucp GCP workload identity credential properties.
+type GCPWorkloadIdentityCredentialProperties struct {
+	// ServiceAccountEmail contains the email of the gcp service account to impersonate.
+	ServiceAccountEmail string `json:"serviceAccountEmail"`
+}
+
+// GCPCredentialProperties contains ucp GCP credential properties.
+type GCPCredentialProperties struct {
+	// Kind is the kind of GCP credential.
+	Kind string `json:"kind,omitempty"`
+	// ServiceAccountKey represents the service account key credential properties.
+	ServiceAccountKey *GCPServiceAccountKeyCredentialProperties `json:"serviceAccountKey,omitempty"`
+	// WorkloadIdentity represents the workload identity credential properties.
+	WorkloadIdentity *GCPWorkloadIdentityCredentialProperties `json:"workloadIdentity,omitempty"`
+}
+
 // CredentialStorageProperties contains ucp credential storage properties.
 type CredentialStorageProperties struct {
 	// Kind represents ucp credential storage kind.
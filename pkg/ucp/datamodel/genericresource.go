@@ -66,6 +66,16 @@ type GenericResourceProperties struct {
 	// APIVersion is the version of the API that can be used to query the resource.
 	APIVersion string `json:"apiVersion"`
 
+	// Application is the ID of the application of the resource, if any. It is used to compute the usage of quotas.
+	Application string `json:"application,omitempty"`
+
+	// Environment is the ID of the environment of the resource, if any. It is used to compute the usage of quotas.
+	Environment string `json:"environment,omitempty"`
+
+	// OutputResources are the IDs of the output resources deployed for the resource. They are used to compute the
+	// usage of quotas.
+	OutputResources []string `json:"outputResources,omitempty"`
+
 	// OperationID is the last operation that updated this entry. This is used when an operation
 	// is enqueued as a way to force a different Etag to be returned. This data doesn't need to be
 	// read or used, it's just acting as a "salt" for the Etag.
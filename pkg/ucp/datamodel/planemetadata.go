@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// PlaneMetadata is the metadata shared by all plane types. Other components read these values from the plane
+// instead of hardcoding them.
+type PlaneMetadata struct {
+	// DisplayName is the display name of the plane.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// DefaultLocation is the location used for the resources of the plane when a location is not specified.
+	DefaultLocation string `json:"defaultLocation,omitempty"`
+}
+
+// PlaneUpdate is the representation of a PATCH request for a plane. Fields that are nil are left unchanged.
+type PlaneUpdate struct {
+	// Tags replaces the tags of the plane.
+	Tags map[string]string
+
+	// DisplayName replaces the display name of the plane.
+	DisplayName *string
+
+	// DefaultLocation replaces the default location of the plane.
+	DefaultLocation *string
+}
+
+// ResourceTypeName returns an empty string because a plane update is not stored as a resource.
+func (u *PlaneUpdate) ResourceTypeName() string {
+	return ""
+}
+
+// Apply applies the update to the tags and metadata of a plane.
+func (u *PlaneUpdate) Apply(resource *v1.BaseResource, metadata *PlaneMetadata) {
+	if u.Tags != nil {
+		resource.Tags = u.Tags
+	}
+
+	if u.DisplayName != nil {
+		metadata.DisplayName = *u.DisplayName
+	}
+
+	if u.DefaultLocation != nil {
+		metadata.DefaultLocation = *u.DefaultLocation
+	}
+}
@@ -27,6 +27,7 @@ const (
 
 // RadiusPlaneProperties is the properties of a Radius plane.
 type RadiusPlaneProperties struct {
+	PlaneMetadata
 
 	// ResourceProviders is a map of the support resource providers.
 	ResourceProviders map[string]string `json:"resourceProviders"`
@@ -44,3 +45,8 @@ type RadiusPlane struct {
 func (p RadiusPlane) ResourceTypeName() string {
 	return p.Type
 }
+
+// Metadata returns the metadata of the plane.
+func (p *RadiusPlane) Metadata() *PlaneMetadata {
+	return &p.Properties.PlaneMetadata
+}
@@ -21,14 +21,86 @@ import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 const (
 	// ResourceGroupResourceType is the type of a resource group.
 	ResourceGroupResourceType = "System.Resources/resourceGroups"
+
+	// ResourceGroupUsageResourceType is the type of the quota and usage of a resource group.
+	//
+	// This is a **READONLY** virtual resource served from URLs like:
+	//
+	// /planes/radius/local/resourcegroups/rg/usage
+	ResourceGroupUsageResourceType = "System.Resources/resourceGroups/usage"
 )
 
 // ResourceGroup represents UCP ResourceGroup.
 type ResourceGroup struct {
 	v1.BaseResource
+
+	// Properties is the properties of the resource group.
+	Properties ResourceGroupProperties `json:"properties"`
+}
+
+// ResourceGroupProperties is the properties of a resource group.
+type ResourceGroupProperties struct {
+	// Quota is the quota of the resources in the resource group.
+	Quota *ResourceQuota `json:"quota,omitempty"`
+}
+
+// ResourceQuota is the quota of the resources in a resource group. A nil limit is not enforced.
+type ResourceQuota struct {
+	// MaxContainers is the maximum number of containers.
+	MaxContainers *int32 `json:"maxContainers,omitempty"`
+
+	// MaxRecipeExecutionsPerDay is the maximum number of recipe executions per day (UTC).
+	MaxRecipeExecutionsPerDay *int32 `json:"maxRecipeExecutionsPerDay,omitempty"`
+
+	// MaxCloudResources is the maximum number of Azure, AWS and GCP resources deployed by Radius.
+	MaxCloudResources *int32 `json:"maxCloudResources,omitempty"`
 }
 
 // ResourceTypeName returns a string representing the resource type name of the ResourceGroup object.
 func (p ResourceGroup) ResourceTypeName() string {
 	return ResourceGroupResourceType
 }
+
+// ResourceGroupUsage is the quota and usage of a resource group and of its environments.
+type ResourceGroupUsage struct {
+	// Quota is the quota of the resource group.
+	Quota *ResourceQuota `json:"quota,omitempty"`
+
+	// Usage is the usage of the resource group.
+	Usage ResourceQuotaUsage `json:"usage"`
+
+	// Environments is the quota and usage of the environments in the resource group.
+	Environments []EnvironmentQuotaUsage `json:"environments"`
+}
+
+// ResourceTypeName returns the resource type of the quota and usage of a resource group.
+func (u *ResourceGroupUsage) ResourceTypeName() string {
+	return ResourceGroupUsageResourceType
+}
+
+// ResourceQuotaUsage is the current consumption of the quota of a resource group or an environment.
+type ResourceQuotaUsage struct {
+	// Containers is the number of containers.
+	Containers int32 `json:"containers"`
+
+	// RecipeExecutionsToday is the number of recipe executions of the current day (UTC).
+	RecipeExecutionsToday int32 `json:"recipeExecutionsToday"`
+
+	// CloudResources is the number of Azure, AWS and GCP resources deployed by Radius.
+	CloudResources int32 `json:"cloudResources"`
+}
+
+// EnvironmentQuotaUsage is the quota and usage of an environment in a resource group.
+type EnvironmentQuotaUsage struct {
+	// ID is the resource id of the environment.
+	ID string `json:"id"`
+
+	// Name is the name of the environment.
+	Name string `json:"name"`
+
+	// Quota is the quota of the environment.
+	Quota *ResourceQuota `json:"quota,omitempty"`
+
+	// Usage is the usage of the environment.
+	Usage ResourceQuotaUsage `json:"usage"`
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// TemplateSpecResourceType is the resource type for a template spec.
+	TemplateSpecResourceType = "System.Resources/templateSpecs"
+
+	// TemplateSpecVersionResourceType is the resource type for a version of a template spec.
+	TemplateSpecVersionResourceType = "System.Resources/templateSpecs/versions"
+)
+
+// TemplateSpec represents a named collection of versioned deployment templates.
+type TemplateSpec struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the template spec.
+	Properties TemplateSpecProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *TemplateSpec) ResourceTypeName() string {
+	return TemplateSpecResourceType
+}
+
+// TemplateSpecProperties stores the properties of a template spec.
+type TemplateSpecProperties struct {
+	// Description is the description of the template spec.
+	Description string `json:"description,omitempty"`
+}
+
+// TemplateSpecVersion represents a version of a template spec. A version stores a compiled deployment
+// template and is immutable once created, so the same artifact can be promoted across environments.
+type TemplateSpecVersion struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the template spec version.
+	Properties TemplateSpecVersionProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *TemplateSpecVersion) ResourceTypeName() string {
+	return TemplateSpecVersionResourceType
+}
+
+// TemplateSpecVersionProperties stores the properties of a template spec version.
+type TemplateSpecVersionProperties struct {
+	// Description is the description of the template spec version.
+	Description string `json:"description,omitempty"`
+
+	// Template is the compiled deployment template.
+	Template map[string]any `json:"template,omitempty"`
+}
@@ -38,6 +38,9 @@ const (
 	planeCollectionPath     = "/planes"
 	planeTypeCollectionPath = "/planes/{planeType}"
 
+	openAPIV2Path = "/openapi/v2"
+	openAPIV3Path = "/openapi/v3"
+
 	// OperationTypeKubernetesOpenAPIV2Doc is the operation type for the required OpenAPI v2 discovery document.
 	//
 	// This is required by the Kubernetes API Server.
@@ -74,6 +77,16 @@ func initModules(ctx context.Context, mods []modules.Initializer) (map[string]ht
 	return planeHandlers, planeTypes, nil
 }
 
+// kubernetesDiscoveryPaths returns the paths of the discovery endpoints required by the Kubernetes API Server. It
+// returns nil if UCP is not hosted behind the Kubernetes API Server.
+func kubernetesDiscoveryPaths(pathBase string) []string {
+	if pathBase == "" {
+		return nil
+	}
+
+	return []string{openAPIV2Path, openAPIV3Path, pathBase}
+}
+
 // Register registers the routes for UCP including modules.
 func Register(ctx context.Context, router chi.Router, planeModules []modules.Initializer, options *ucp.Options) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -96,7 +109,7 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 		handlerOptions = append(handlerOptions, []server.HandlerOptions{
 			{
 				ParentRouter:      router,
-				Path:              "/openapi/v2",
+				Path:              openAPIV2Path,
 				OperationType:     &v1.OperationType{Type: OperationTypeKubernetesOpenAPIV2Doc, Method: v1.OperationGet},
 				ResourceType:      OperationTypeKubernetesOpenAPIV2Doc,
 				Method:            v1.OperationGet,
@@ -104,7 +117,7 @@ func Register(ctx context.Context, router chi.Router, planeModules []modules.Ini
 			},
 			{
 				ParentRouter:      router,
-				Path:              "/openapi/v3",
+				Path:              openAPIV3Path,
 				OperationType:     &v1.OperationType{Type: OperationTypeKubernetesOpenAPIV3Doc, Method: v1.OperationGet},
 				ResourceType:      OperationTypeKubernetesOpenAPIV3Doc,
 				Method:            v1.OperationGet,
@@ -73,7 +73,8 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		}
 
 		if m.AWSClients.CloudControl == nil {
-			m.AWSClients.CloudControl = cloudcontrol.NewFromConfig(awsConfig)
+			// Requests throttled by AWS are retried, so that throttling does not fail deployments.
+			m.AWSClients.CloudControl = ucp_aws.NewThrottledCloudControlClient(cloudcontrol.NewFromConfig(awsConfig), ucp_aws.DefaultThrottlingOptions())
 		}
 
 		if m.AWSClients.CloudFormation == nil {
@@ -128,6 +129,15 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return defaultoperation.NewDefaultSyncPut(opts, planeResourceOptions)
 			},
 		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationPatch,
+			ResourceType:  datamodel.AWSPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.AWSPlaneResourceType, Method: v1.OperationPatch},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return planes_ctrl.NewUpdatePlane[*datamodel.AWSPlane](opts, planeResourceOptions)
+			},
+		},
 		{
 			ParentRouter:  planeResourceRouter,
 			Method:        v1.OperationDelete,
@@ -178,7 +188,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			OperationType: &v1.OperationType{Type: OperationTypeAWSResource, Method: v1.OperationPut},
 			ResourceType:  OperationTypeAWSResource,
 			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
-				return awsproxy_ctrl.NewCreateOrUpdateAWSResource(opts, m.AWSClients)
+				return awsproxy_ctrl.NewCreateOrUpdateAWSResource(opts, m.AWSClients, m.options.Config.AWS.DefaultTags)
 			},
 		},
 		{
@@ -216,7 +226,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			OperationType: &v1.OperationType{Type: OperationTypeAWSResource, Method: v1.OperationPutImperative},
 			ResourceType:  OperationTypeAWSResource,
 			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
-				return awsproxy_ctrl.NewCreateOrUpdateAWSResourceWithPost(opt, m.AWSClients)
+				return awsproxy_ctrl.NewCreateOrUpdateAWSResourceWithPost(opt, m.AWSClients, m.options.Config.AWS.DefaultTags)
 			},
 		},
 		{
@@ -319,7 +329,13 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 
 func (m *Module) newAWSConfig(ctx context.Context) (aws.Config, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
-	credProviders := []func(*config.LoadOptions) error{}
+
+	// Requests to AWS use the proxy and CA bundle configuration of the shared transport.
+	httpClient, err := ucp_aws.NewHTTPClient()
+	if err != nil {
+		return aws.Config{}, err
+	}
+	loadOptions := []func(*config.LoadOptions) error{config.WithHTTPClient(httpClient)}
 
 	switch m.options.Config.Identity.AuthMethod {
 	case ucp.AuthUCPCredential:
@@ -328,14 +344,14 @@ func (m *Module) newAWSConfig(ctx context.Context) (aws.Config, error) {
 			return aws.Config{}, err
 		}
 		p := ucp_aws.NewUCPCredentialProvider(provider, ucp_aws.DefaultExpireDuration)
-		credProviders = append(credProviders, config.WithCredentialsProvider(p))
+		loadOptions = append(loadOptions, config.WithCredentialsProvider(p))
 		logger.Info("Configuring 'UCPCredential' authentication mode using UCP Credential API")
 
 	default:
 		logger.Info("Configuring default authentication mode with environment variable.")
 	}
 
-	awscfg, err := config.LoadDefaultConfig(ctx, credProviders...)
+	awscfg, err := config.LoadDefaultConfig(ctx, loadOptions...)
 	if err != nil {
 		return aws.Config{}, err
 	}
@@ -52,6 +52,10 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: datamodel.AWSPlaneResourceType, Method: v1.OperationPut},
 			Method:        http.MethodPut,
 			Path:          "/planes/aws/someName",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.AWSPlaneResourceType, Method: v1.OperationPatch},
+			Method:        http.MethodPatch,
+			Path:          "/planes/aws/someName",
 		}, {
 			OperationType: v1.OperationType{Type: datamodel.AWSPlaneResourceType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
@@ -99,6 +99,15 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return defaultoperation.NewDefaultSyncPut(opts, planeResourceOptions)
 			},
 		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationPatch,
+			ResourceType:  datamodel.AzurePlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.AzurePlaneResourceType, Method: v1.OperationPatch},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return planes_ctrl.NewUpdatePlane[*datamodel.AzurePlane](opts, planeResourceOptions)
+			},
+		},
 		{
 			ParentRouter:  planeResourceRouter,
 			Method:        v1.OperationDelete,
@@ -40,14 +40,17 @@ var _ armrpc_controller.Controller = (*CreateOrUpdateAWSResource)(nil)
 // CreateOrUpdateAWSResource is the controller implementation to create/update an AWS resource.
 type CreateOrUpdateAWSResource struct {
 	armrpc_controller.Operation[*datamodel.AWSResource, datamodel.AWSResource]
-	awsClients ucp_aws.Clients
+	awsClients  ucp_aws.Clients
+	defaultTags map[string]string
 }
 
-// NewCreateOrUpdateAWSResource creates a new CreateOrUpdateAWSResource.
-func NewCreateOrUpdateAWSResource(opts armrpc_controller.Options, awsClients ucp_aws.Clients) (armrpc_controller.Controller, error) {
+// NewCreateOrUpdateAWSResource creates a new CreateOrUpdateAWSResource. The default tags are added to the resource
+// when its resource type supports tagging.
+func NewCreateOrUpdateAWSResource(opts armrpc_controller.Options, awsClients ucp_aws.Clients, defaultTags map[string]string) (armrpc_controller.Controller, error) {
 	return &CreateOrUpdateAWSResource{
 		armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.AWSResource]{}),
 		awsClients,
+		defaultTags,
 	}, nil
 }
 
@@ -102,6 +105,23 @@ func (p *CreateOrUpdateAWSResource) Run(ctx context.Context, w http.ResponseWrit
 		return ucp_aws.HandleAWSError(err)
 	}
 
+	// The resource type schema is needed to update the resource and to add the default tags.
+	var describeTypeOutput *cloudformation.DescribeTypeOutput
+	if existing || len(p.defaultTags) > 0 {
+		describeTypeOutput, err = p.awsClients.CloudFormation.DescribeType(ctx, &cloudformation.DescribeTypeInput{
+			Type:     types.RegistryTypeResource,
+			TypeName: to.Ptr(serviceCtx.ResourceTypeInAWSFormat()),
+		}, cloudFormationOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = awsoperations.ApplyDefaultTags(properties, []byte(*describeTypeOutput.Schema), p.defaultTags)
+		if err != nil {
+			return ucp_aws.HandleAWSError(err)
+		}
+	}
+
 	var operation uuid.UUID
 	desiredState, err := json.Marshal(properties)
 	if err != nil {
@@ -124,15 +144,6 @@ func (p *CreateOrUpdateAWSResource) Run(ctx context.Context, w http.ResponseWrit
 	}
 
 	if existing {
-		// Get resource type schema
-		describeTypeOutput, err := p.awsClients.CloudFormation.DescribeType(ctx, &cloudformation.DescribeTypeInput{
-			Type:     types.RegistryTypeResource,
-			TypeName: to.Ptr(serviceCtx.ResourceTypeInAWSFormat()),
-		}, cloudFormationOpts...)
-		if err != nil {
-			return nil, err
-		}
-
 		// Generate patch
 		currentState := []byte(*getResponse.ResourceDescription.Properties)
 		resourceTypeSchema := []byte(*describeTypeOutput.Schema)
@@ -17,6 +17,7 @@ package awsproxy
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -66,7 +67,7 @@ func Test_CreateAWSResource(t *testing.T) {
 		CloudControl:   testOptions.AWSCloudControlClient,
 		CloudFormation: testOptions.AWSCloudFormationClient,
 	}
-	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients, nil)
 	require.NoError(t, err)
 
 	request, err := http.NewRequest(http.MethodPut, testResource.SingleResourcePath, bytes.NewBuffer(requestBodyBytes))
@@ -114,6 +115,76 @@ func Test_CreateAWSResource(t *testing.T) {
 	require.Equal(t, expectedResponseObject, actualResponseObject)
 }
 
+func Test_CreateAWSResource_DefaultTags(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.ResourceNotFoundException{
+			Message: aws.String("Resource not found"),
+		})
+
+	output := cloudformation.DescribeTypeOutput{
+		TypeName: aws.String(testResource.AWSResourceType),
+		Schema:   aws.String(`{"properties": {"Tags": {"type": "array"}}}`),
+	}
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeType(gomock.Any(), gomock.Any(), gomock.Any()).Return(&output, nil)
+
+	testOptions.AWSCloudControlClient.EXPECT().CreateResource(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, params *cloudcontrol.CreateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CreateResourceOutput, error) {
+			desiredState := map[string]any{}
+			err := json.Unmarshal([]byte(*params.DesiredState), &desiredState)
+			require.NoError(t, err)
+			require.Equal(t, []any{
+				map[string]any{"Key": "owner", "Value": "app-team"},
+				map[string]any{"Key": "costCenter", "Value": "1234"},
+			}, desiredState["Tags"])
+
+			return &cloudcontrol.CreateResourceOutput{
+				ProgressEvent: &types.ProgressEvent{
+					OperationStatus: types.OperationStatusSuccess,
+					RequestToken:    to.Ptr(testAWSRequestToken),
+				},
+			}, nil
+		})
+
+	requestBody := map[string]any{
+		"properties": map[string]any{
+			"ShardCount": 3,
+			"Tags": []any{
+				map[string]any{"Key": "owner", "Value": "app-team"},
+			},
+		},
+	}
+	requestBodyBytes, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	defaultTags := map[string]string{"owner": "platform", "costCenter": "1234"}
+	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients, defaultTags)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPut, testResource.SingleResourcePath, bytes.NewBuffer(requestBodyBytes))
+	require.NoError(t, err)
+	request.Host = testHost
+	request.URL.Host = testHost
+	request.URL.Scheme = testScheme
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	err = actualResponse.Apply(ctx, w, request)
+	require.NoError(t, err)
+
+	res := w.Result()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+}
+
 func Test_CreateAWSResourceInvalidRegion(t *testing.T) {
 	testResource := CreateKinesisStreamTestResourceWithInvalidRegion(uuid.NewString())
 	testOptions := setupTest(t)
@@ -131,7 +202,7 @@ func Test_CreateAWSResourceInvalidRegion(t *testing.T) {
 		CloudControl:   testOptions.AWSCloudControlClient,
 		CloudFormation: testOptions.AWSCloudFormationClient,
 	}
-	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients, nil)
 	require.NoError(t, err)
 
 	request, err := http.NewRequest(http.MethodPut, testResource.SingleResourcePath, bytes.NewBuffer(requestBodyBytes))
@@ -222,7 +293,7 @@ func Test_UpdateAWSResource(t *testing.T) {
 		CloudControl:   testOptions.AWSCloudControlClient,
 		CloudFormation: testOptions.AWSCloudFormationClient,
 	}
-	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients, nil)
 	require.NoError(t, err)
 
 	request, err := http.NewRequest(http.MethodPut, testResource.SingleResourcePath, bytes.NewBuffer(requestBodyBytes))
@@ -304,7 +375,7 @@ func Test_UpdateNoChangesDoesNotCallUpdate(t *testing.T) {
 		CloudControl:   testOptions.AWSCloudControlClient,
 		CloudFormation: testOptions.AWSCloudFormationClient,
 	}
-	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients, nil)
 	require.NoError(t, err)
 
 	request, err := http.NewRequest(http.MethodPut, testResource.SingleResourcePath, bytes.NewBuffer(requestBodyBytes))
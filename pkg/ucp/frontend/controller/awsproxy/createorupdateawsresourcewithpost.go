@@ -43,14 +43,17 @@ var _ armrpc_controller.Controller = (*CreateOrUpdateAWSResourceWithPost)(nil)
 // CreateOrUpdateAWSResourceWithPost is the controller implementation to create/update an AWS resource.
 type CreateOrUpdateAWSResourceWithPost struct {
 	armrpc_controller.Operation[*datamodel.AWSResource, datamodel.AWSResource]
-	awsClients ucp_aws.Clients
+	awsClients  ucp_aws.Clients
+	defaultTags map[string]string
 }
 
-// NewCreateOrUpdateAWSResourceWithPost creates a new CreateOrUpdateAWSResourceWithPost.
-func NewCreateOrUpdateAWSResourceWithPost(opts armrpc_controller.Options, awsClients ucp_aws.Clients) (armrpc_controller.Controller, error) {
+// NewCreateOrUpdateAWSResourceWithPost creates a new CreateOrUpdateAWSResourceWithPost. The default tags are added to
+// the resource when its resource type supports tagging.
+func NewCreateOrUpdateAWSResourceWithPost(opts armrpc_controller.Options, awsClients ucp_aws.Clients, defaultTags map[string]string) (armrpc_controller.Controller, error) {
 	return &CreateOrUpdateAWSResourceWithPost{
-		Operation:  armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.AWSResource]{}),
-		awsClients: awsClients,
+		Operation:   armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.AWSResource]{}),
+		awsClients:  awsClients,
+		defaultTags: defaultTags,
 	}, nil
 }
 
@@ -86,6 +89,11 @@ func (p *CreateOrUpdateAWSResourceWithPost) Run(ctx context.Context, w http.Resp
 		return ucp_aws.HandleAWSError(err)
 	}
 
+	_, err = awsoperations.ApplyDefaultTags(properties, []byte(*describeTypeOutput.Schema), p.defaultTags)
+	if err != nil {
+		return ucp_aws.HandleAWSError(err)
+	}
+
 	var operation uuid.UUID
 	desiredState, err := json.Marshal(properties)
 	if err != nil {
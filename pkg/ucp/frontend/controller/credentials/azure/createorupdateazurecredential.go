@@ -75,6 +75,10 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 		return r, err
 	}
 
+	if err := c.ApplyDefaults(ctx, newResource, old); err != nil {
+		return nil, err
+	}
+
 	secretName := credentials.GetSecretName(serviceCtx.ResourceID)
 	if newResource.Properties.Storage.Kind == datamodel.InternalStorageKind {
 		newResource.Properties.Storage.InternalCredential.SecretName = secretName
@@ -58,6 +58,7 @@ func (e *ListPlanes) Run(ctx context.Context, w http.ResponseWriter, req *http.R
 	planeTypes := []string{
 		"aws",
 		"azure",
+		"gcp",
 		"radius",
 	}
 
@@ -76,6 +76,12 @@ func Test_ListPlanes(t *testing.T) {
 		IsScopeQuery: true,
 	}).Return(&database.ObjectQueryResult{}, nil)
 
+	mockDatabaseClient.EXPECT().Query(gomock.Any(), database.Query{
+		RootScope:    "/planes",
+		ResourceType: "gcp",
+		IsScopeQuery: true,
+	}).Return(&database.ObjectQueryResult{}, nil)
+
 	mockDatabaseClient.EXPECT().Query(gomock.Any(), database.Query{
 		RootScope:    "/planes",
 		ResourceType: "radius",
@@ -24,6 +24,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/proxy"
@@ -106,8 +107,13 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return nil, err
 	}
 
+	transport, err := httpclient.DefaultTransport()
+	if err != nil {
+		return nil, err
+	}
+
 	options := proxy.ReverseProxyOptions{
-		RoundTripper: otelhttp.NewTransport(http.DefaultTransport),
+		RoundTripper: otelhttp.NewTransport(transport),
 	}
 
 	refererURL := url.URL{
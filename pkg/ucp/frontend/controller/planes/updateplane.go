@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planes
+
+import (
+	"context"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+)
+
+var _ armrpc_controller.Controller = (*UpdatePlane[*datamodel.RadiusPlane, datamodel.RadiusPlane])(nil)
+
+// UpdatePlane is the controller implementation to update the tags and metadata of a UCP plane.
+type UpdatePlane[P interface {
+	*T
+	v1.ResourceDataModel
+	Metadata() *datamodel.PlaneMetadata
+}, T any] struct {
+	armrpc_controller.Operation[P, T]
+}
+
+// NewUpdatePlane creates a new UpdatePlane controller.
+func NewUpdatePlane[P interface {
+	*T
+	v1.ResourceDataModel
+	Metadata() *datamodel.PlaneMetadata
+}, T any](opts armrpc_controller.Options, resourceOpts armrpc_controller.ResourceOptions[T]) (armrpc_controller.Controller, error) {
+	return &UpdatePlane[P, T]{armrpc_controller.NewOperation[P](opts, resourceOpts)}, nil
+}
+
+// Run merges the tags and metadata of the request into the existing plane and saves it. It returns 404 if the plane
+// does not exist.
+func (e *UpdatePlane[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	content, err := armrpc_controller.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := converter.PlaneUpdateDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	newResource := new(T)
+	*newResource = *old
+	update.Apply(P(newResource).GetBaseResource(), P(newResource).Metadata())
+
+	if r, err := e.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	if err := e.ApplyDefaults(ctx, newResource, old); err != nil {
+		return nil, err
+	}
+
+	P(newResource).SetProvisioningState(v1.ProvisioningStateSucceeded)
+	newEtag, err := e.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
+}
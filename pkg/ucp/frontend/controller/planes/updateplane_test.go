@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planes
+
+import (
+	"context"
+	http "net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_UpdatePlane(t *testing.T) {
+	url := "/planes/radius/local?api-version=2023-10-01-preview"
+	body := []byte(`{"tags":{"env":"prod"},"properties":{"defaultLocation":"westus"}}`)
+
+	setup := func(t *testing.T) (*database.MockClient, armrpc_controller.Controller) {
+		mockCtrl := gomock.NewController(t)
+		mockDatabaseClient := database.NewMockClient(mockCtrl)
+
+		ctrl, err := NewUpdatePlane[*datamodel.RadiusPlane](
+			armrpc_controller.Options{DatabaseClient: mockDatabaseClient},
+			armrpc_controller.ResourceOptions[datamodel.RadiusPlane]{
+				RequestConverter:  converter.RadiusPlaneDataModelFromVersioned,
+				ResponseConverter: converter.RadiusPlaneDataModelToVersioned,
+			})
+		require.NoError(t, err)
+		return mockDatabaseClient, ctrl
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockDatabaseClient, ctrl := setup(t)
+
+		existing := &datamodel.RadiusPlane{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:       "/planes/radius/local",
+					Name:     "local",
+					Type:     datamodel.RadiusPlaneResourceType,
+					Location: "global",
+					Tags:     map[string]string{"env": "dev"},
+				},
+				InternalMetadata: v1.InternalMetadata{
+					AsyncProvisioningState: v1.ProvisioningStateSucceeded,
+				},
+			},
+			Properties: datamodel.RadiusPlaneProperties{
+				PlaneMetadata: datamodel.PlaneMetadata{
+					DisplayName: "Local",
+				},
+				ResourceProviders: map[string]string{
+					"Applications.Core": "https://applications-rp",
+				},
+			},
+		}
+
+		mockDatabaseClient.EXPECT().Get(gomock.Any(), "/planes/radius/local").Return(&database.Object{
+			Metadata: database.Metadata{ID: "/planes/radius/local", ETag: "etag"},
+			Data:     existing,
+		}, nil)
+
+		var saved *datamodel.RadiusPlane
+		mockDatabaseClient.EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+				saved = obj.Data.(*datamodel.RadiusPlane)
+				obj.ETag = "new-etag"
+				return nil
+			})
+
+		request, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPatch, url, body)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.OKResponse{}, response)
+
+		require.Equal(t, map[string]string{"env": "prod"}, saved.Tags)
+		require.Equal(t, datamodel.PlaneMetadata{DisplayName: "Local", DefaultLocation: "westus"}, saved.Properties.PlaneMetadata)
+		require.Equal(t, map[string]string{"Applications.Core": "https://applications-rp"}, saved.Properties.ResourceProviders)
+
+		// The existing resource must not be modified.
+		require.Equal(t, map[string]string{"env": "dev"}, existing.Tags)
+		require.Empty(t, existing.Properties.DefaultLocation)
+
+		versioned := response.(*armrpc_rest.OKResponse).Body.(*v20231001preview.RadiusPlaneResource)
+		require.Equal(t, to.Ptr("westus"), versioned.Properties.DefaultLocation)
+		require.Equal(t, to.Ptr("Local"), versioned.Properties.DisplayName)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockDatabaseClient, ctrl := setup(t)
+
+		mockDatabaseClient.EXPECT().Get(gomock.Any(), "/planes/radius/local").Return(nil, &database.ErrNotFound{ID: "/planes/radius/local"})
+
+		request, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPatch, url, body)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NotFoundResponse{}, response)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, ctrl := setup(t)
+
+		request, err := rpctest.NewHTTPRequestWithContent(context.Background(), http.MethodPatch, url, []byte(`{"tags":"invalid"}`))
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"errors"
+	http "net/http"
+	"sort"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/quota"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// environmentResourceType is the resource type of environments. The usage of the environments of a resource group is
+	// reported with the usage of the resource group.
+	environmentResourceType = "Applications.Core/environments"
+)
+
+var _ armrpc_controller.Controller = (*GetUsage)(nil)
+
+// GetUsage is the controller implementation to get the quota and usage of a resource group and of its environments.
+type GetUsage struct {
+	armrpc_controller.Operation[*datamodel.ResourceGroup, datamodel.ResourceGroup]
+}
+
+// environmentQuota is the subset of the properties of an environment used to report its quota.
+type environmentQuota struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Properties struct {
+		Quota *datamodel.ResourceQuota `json:"quota,omitempty"`
+	} `json:"properties"`
+}
+
+// NewGetUsage creates a new controller for getting the quota and usage of a resource group.
+func NewGetUsage(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &GetUsage{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.ResourceGroup]{
+				RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
+				ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run implements controller.Controller.
+func (r *GetUsage) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	relativePath := middleware.GetRelativePath(r.Options().PathBase, req.URL.Path)
+	id, err := resources.Parse(relativePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cut off the "usage" part of the ID. The ID should be the ID of a resource group.
+	resourceGroupID := id.Truncate()
+
+	resourceGroup := &datamodel.ResourceGroup{}
+	obj, err := r.DatabaseClient().Get(ctx, resourceGroupID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(resourceGroupID), nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := obj.As(resourceGroup); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	usage, err := quota.Calculate(ctx, r.DatabaseClient(), resourceGroupID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &datamodel.ResourceGroupUsage{
+		Quota:        resourceGroup.Properties.Quota,
+		Usage:        toResourceQuotaUsage(usage),
+		Environments: []datamodel.EnvironmentQuotaUsage{},
+	}
+
+	environments, err := r.DatabaseClient().Query(ctx, database.Query{
+		RootScope:    resourceGroupID.String(),
+		ResourceType: environmentResourceType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range environments.Items {
+		environment := environmentQuota{}
+		if err := item.As(&environment); err != nil {
+			return nil, err
+		}
+
+		environmentID, err := resources.ParseResource(environment.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		usage, err := quota.Calculate(ctx, r.DatabaseClient(), environmentID, now)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Environments = append(result.Environments, datamodel.EnvironmentQuotaUsage{
+			ID:    environment.ID,
+			Name:  environment.Name,
+			Quota: environment.Properties.Quota,
+			Usage: toResourceQuotaUsage(usage),
+		})
+	}
+
+	sort.Slice(result.Environments, func(i, j int) bool {
+		return result.Environments[i].Name < result.Environments[j].Name
+	})
+
+	versioned, err := converter.ResourceGroupUsageDataModelToVersioned(result, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(versioned), nil
+}
+
+func toResourceQuotaUsage(usage quota.Usage) datamodel.ResourceQuotaUsage {
+	return datamodel.ResourceQuotaUsage{
+		Containers:            usage.Containers,
+		RecipeExecutionsToday: usage.RecipeExecutionsToday,
+		CloudResources:        usage.CloudResources,
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+)
+
+func Test_GetUsage(t *testing.T) {
+	resourceGroupID := "/planes/radius/local/resourceGroups/test-rg"
+	environmentID := resourceGroupID + "/providers/Applications.Core/environments/test-env"
+	applicationID := resourceGroupID + "/providers/Applications.Core/applications/test-app"
+	containerID := resourceGroupID + "/providers/Applications.Core/containers/test-container"
+	id := resourceGroupID + "/usage"
+
+	save := func(t *testing.T, databaseClient database.Client, id string, data any) {
+		err := databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: data})
+		require.NoError(t, err)
+	}
+
+	track := func(t *testing.T, databaseClient database.Client, id string, properties datamodel.GenericResourceProperties) {
+		parsed := resources.MustParse(id)
+		tracked := datamodel.GenericResourceFromID(parsed, trackedresource.IDFor(parsed))
+		tracked.Properties.Application = properties.Application
+		tracked.Properties.Environment = properties.Environment
+		tracked.Properties.OutputResources = properties.OutputResources
+		save(t, databaseClient, tracked.ID, tracked)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		databaseClient, ctrl := setupGetUsage(t)
+
+		save(t, databaseClient, resourceGroupID, &datamodel.ResourceGroup{
+			Properties: datamodel.ResourceGroupProperties{
+				Quota: &datamodel.ResourceQuota{MaxContainers: to.Ptr(int32(10))},
+			},
+		})
+		track(t, databaseClient, environmentID, datamodel.GenericResourceProperties{})
+		save(t, databaseClient, environmentID, map[string]any{
+			"id":   environmentID,
+			"name": "test-env",
+			"properties": map[string]any{
+				"quota": map[string]any{"maxCloudResources": 5},
+			},
+		})
+		track(t, databaseClient, applicationID, datamodel.GenericResourceProperties{Environment: environmentID})
+		track(t, databaseClient, containerID, datamodel.GenericResourceProperties{
+			Application:     applicationID,
+			OutputResources: []string{"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/test"},
+		})
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		usage := &v20231001preview.ResourceQuotaUsage{
+			Containers:            to.Ptr(int32(1)),
+			RecipeExecutionsToday: to.Ptr(int32(0)),
+			CloudResources:        to.Ptr(int32(1)),
+		}
+		expected := armrpc_rest.NewOKResponse(&v20231001preview.ResourceGroupUsage{
+			Quota: &v20231001preview.ResourceQuota{MaxContainers: to.Ptr(int32(10))},
+			Usage: usage,
+			Environments: []*v20231001preview.EnvironmentQuotaUsage{
+				{
+					ID:    to.Ptr(environmentID),
+					Name:  to.Ptr("test-env"),
+					Quota: &v20231001preview.ResourceQuota{MaxCloudResources: to.Ptr(int32(5))},
+					Usage: usage,
+				},
+			},
+		})
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("success - empty", func(t *testing.T) {
+		databaseClient, ctrl := setupGetUsage(t)
+
+		save(t, databaseClient, resourceGroupID, &datamodel.ResourceGroup{})
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		expected := armrpc_rest.NewOKResponse(&v20231001preview.ResourceGroupUsage{
+			Usage: &v20231001preview.ResourceQuotaUsage{
+				Containers:            to.Ptr(int32(0)),
+				RecipeExecutionsToday: to.Ptr(int32(0)),
+				CloudResources:        to.Ptr(int32(0)),
+			},
+			Environments: []*v20231001preview.EnvironmentQuotaUsage{},
+		})
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("resource group not found", func(t *testing.T) {
+		_, ctrl := setupGetUsage(t)
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewNotFoundResponse(resources.MustParse(resourceGroupID)), response)
+	})
+}
+
+func setupGetUsage(t *testing.T) (database.Client, *GetUsage) {
+	databaseClient := inmemory.NewClient()
+
+	c, err := NewGetUsage(armrpc_controller.Options{DatabaseClient: databaseClient, PathBase: "/" + uuid.New().String()})
+	require.NoError(t, err)
+
+	return databaseClient, c.(*GetUsage)
+}
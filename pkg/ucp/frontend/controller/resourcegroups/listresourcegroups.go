@@ -66,11 +66,11 @@ func (r *ListResourceGroups) Run(ctx context.Context, w http.ResponseWriter, req
 	query.ResourceType = "resourcegroups"
 	logger.Info(fmt.Sprintf("Listing resource groups in scope %s", query.RootScope))
 
-	result, err := r.DatabaseClient().Query(ctx, query)
+	result, err := r.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
 	if err != nil {
 		return nil, err
 	}
-	listOfResourceGroups, err := r.createResponse(ctx, result)
+	listOfResourceGroups, err := r.createResponse(ctx, req, result)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +79,7 @@ func (r *ListResourceGroups) Run(ctx context.Context, w http.ResponseWriter, req
 	return ok, nil
 }
 
-func (e *ListResourceGroups) createResponse(ctx context.Context, result *database.ObjectQueryResult) (*v1.PaginatedList, error) {
+func (e *ListResourceGroups) createResponse(ctx context.Context, req *http.Request, result *database.ObjectQueryResult) (*v1.PaginatedList, error) {
 	items := v1.PaginatedList{}
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -98,5 +98,7 @@ func (e *ListResourceGroups) createResponse(ctx context.Context, result *databas
 		items.Value = append(items.Value, versioned)
 	}
 
+	items.NextLink = armrpc_controller.GetNextLinkURL(ctx, req, result.PaginationToken)
+
 	return &items, nil
 }
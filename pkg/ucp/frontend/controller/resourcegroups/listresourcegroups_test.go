@@ -63,7 +63,7 @@ func Test_ListResourceGroups(t *testing.T) {
 		},
 	}
 
-	mockDatabaseClient.EXPECT().Query(gomock.Any(), query).DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+	mockDatabaseClient.EXPECT().Query(gomock.Any(), query, gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
 		return &database.ObjectQueryResult{
 			Items: []database.Object{
 				{
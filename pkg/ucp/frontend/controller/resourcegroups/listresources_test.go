@@ -16,7 +16,9 @@ limitations under the License.
 package resourcegroups
 
 import (
+	"context"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/google/uuid"
@@ -71,7 +73,7 @@ func Test_ListResources(t *testing.T) {
 
 		expectedQuery := database.Query{RootScope: resourceGroupID, ResourceType: v20231001preview.ResourceType}
 		databaseClient.EXPECT().
-			Query(gomock.Any(), expectedQuery).
+			Query(gomock.Any(), expectedQuery, gomock.Any(), gomock.Any()).
 			Return(&database.ObjectQueryResult{Items: []database.Object{{Data: entryDatamodel}}}, nil).
 			Times(1)
 
@@ -87,6 +89,41 @@ func Test_ListResources(t *testing.T) {
 		require.Equal(t, expected, response)
 	})
 
+	t.Run("success - paginated", func(t *testing.T) {
+		databaseClient, ctrl := setupListResources(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&database.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+
+		expectedQuery := database.Query{RootScope: resourceGroupID, ResourceType: v20231001preview.ResourceType}
+		databaseClient.EXPECT().
+			Query(gomock.Any(), expectedQuery, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+				config := database.NewQueryConfig(options...)
+				require.Equal(t, "previous-token", config.PaginationToken)
+				require.Equal(t, 5, config.MaxQueryItemCount)
+
+				return &database.ObjectQueryResult{Items: []database.Object{{Data: entryDatamodel}}, PaginationToken: "next-token"}, nil
+			}).
+			Times(1)
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&skipToken=previous-token&top=5", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		list := response.(*armrpc_rest.OKResponse).Body.(*v1.PaginatedList)
+		require.Equal(t, []any{&entryResource}, list.Value)
+
+		nextLink, err := url.Parse(list.NextLink)
+		require.NoError(t, err)
+		require.Equal(t, "next-token", nextLink.Query().Get("skipToken"))
+		require.Equal(t, "5", nextLink.Query().Get("top"))
+	})
+
 	t.Run("success - empty", func(t *testing.T) {
 		databaseClient, ctrl := setupListResources(t)
 
@@ -97,7 +134,7 @@ func Test_ListResources(t *testing.T) {
 
 		expectedQuery := database.Query{RootScope: resourceGroupID, ResourceType: v20231001preview.ResourceType}
 		databaseClient.EXPECT().
-			Query(gomock.Any(), expectedQuery).
+			Query(gomock.Any(), expectedQuery, gomock.Any(), gomock.Any()).
 			Return(&database.ObjectQueryResult{Items: []database.Object{}}, nil).
 			Times(1)
 
@@ -115,6 +115,11 @@ func ValidateResourceType(ctx context.Context, client database.Client, id resour
 
 	// We need to do both because they may not be in sync. This can be be the case if a resource type is being added or deleted.
 
+	// Requests for the revision history of a resource are served by the resource provider of the resource.
+	if isHistoryRequest(id) {
+		id = id.Truncate()
+	}
+
 	if !isOperationResourceType(id) {
 		resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
 		if err != nil {
@@ -194,6 +199,15 @@ func ValidateResourceType(ctx context.Context, client database.Client, id resour
 	return u, nil
 }
 
+// isHistoryRequest returns true if the id refers to the revision history of a resource.
+//
+// For example: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/my-container/history".
+func isHistoryRequest(id resources.ID) bool {
+	typeSegments := id.TypeSegments()
+	return id.IsResourceCollection() && len(typeSegments) >= 2 &&
+		strings.EqualFold(typeSegments[len(typeSegments)-1].Type, "history")
+}
+
 // isOperationResourceType returns true if the resource type is an operation resource type (operationResults/operationStatuses).
 //
 // We special-case these types, and don't require the resource provider to register them.
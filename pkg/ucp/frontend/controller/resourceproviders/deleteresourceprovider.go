@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	http "net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// ForceQueryParameterName is the query parameter used to delete a resource provider even when resources of its
+	// resource types exist.
+	ForceQueryParameterName = "force"
+)
+
+var _ armrpc_controller.Controller = (*DeleteResourceProvider)(nil)
+
+// DeleteResourceProvider is the controller implementation to delete a resource provider.
+//
+// Deleting a resource provider does not delete the resources of its resource types, and those resources can no longer
+// be managed once the resource provider is gone. The delete is rejected with a 409 Conflict listing the affected
+// resources unless the force query parameter is set.
+type DeleteResourceProvider struct {
+	defaultoperation.DefaultAsyncDelete[*datamodel.ResourceProvider, datamodel.ResourceProvider]
+}
+
+// NewDeleteResourceProvider creates a new controller for deleting a resource provider.
+func NewDeleteResourceProvider(opts armrpc_controller.Options, resourceOpts armrpc_controller.ResourceOptions[datamodel.ResourceProvider]) (armrpc_controller.Controller, error) {
+	return &DeleteResourceProvider{
+		DefaultAsyncDelete: defaultoperation.DefaultAsyncDelete[*datamodel.ResourceProvider, datamodel.ResourceProvider]{
+			Operation: armrpc_controller.NewOperation[*datamodel.ResourceProvider](opts, resourceOpts),
+		},
+	}, nil
+}
+
+// Run implements controller.Controller.
+func (r *DeleteResourceProvider) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	force := false
+	if value := req.URL.Query().Get(ForceQueryParameterName); value != "" {
+		var err error
+		force, err = strconv.ParseBool(value)
+		if err != nil {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("the value %q of the query parameter '%s' is not a valid boolean", value, ForceQueryParameterName)), nil
+		}
+	}
+
+	if !force {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		old, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		// A resource provider that does not exist is handled by the default delete operation.
+		if old != nil {
+			affected, err := r.listResources(ctx, serviceCtx.ResourceID)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(affected) > 0 {
+				return newResourcesExistResponse(serviceCtx.ResourceID.Name(), affected), nil
+			}
+		}
+	}
+
+	return r.DefaultAsyncDelete.Run(ctx, w, req)
+}
+
+// listResources returns the ids of the resources tracked by UCP whose resource type belongs to the resource provider,
+// sorted for a stable report.
+func (r *DeleteResourceProvider) listResources(ctx context.Context, id resources.ID) ([]string, error) {
+	query := database.Query{
+		RootScope:      id.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   v20231001preview.ResourceType,
+	}
+
+	result, err := r.DatabaseClient().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	affected := []string{}
+	for _, item := range result.Items {
+		resource := datamodel.GenericResource{}
+		err := item.As(&resource)
+		if err != nil {
+			return nil, err
+		}
+
+		namespace, _, _ := strings.Cut(resource.Properties.Type, resources.SegmentSeparator)
+		if strings.EqualFold(namespace, id.Name()) {
+			affected = append(affected, resource.Properties.ID)
+		}
+	}
+
+	slices.Sort(affected)
+	return affected, nil
+}
+
+// newResourcesExistResponse creates a 409 Conflict response which reports the resources that would be orphaned by
+// deleting the resource provider.
+func newResourcesExistResponse(name string, affected []string) armrpc_rest.Response {
+	details := []*v1.ErrorDetails{}
+	for _, id := range affected {
+		details = append(details, &v1.ErrorDetails{
+			Code:    v1.CodeConflict,
+			Message: fmt.Sprintf("the resource '%s' uses a resource type of the resource provider '%s'", id, name),
+			Target:  id,
+		})
+	}
+
+	return &armrpc_rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeConflict,
+				Message: fmt.Sprintf("the resource provider '%s' cannot be deleted because %d resource(s) of its resource types exist. Delete the resources first, or set the query parameter '%s' to true to delete the resource provider anyway", name, len(affected), ForceQueryParameterName),
+				Details: details,
+			},
+		},
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+)
+
+const (
+	testResourceProviderID = "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test"
+)
+
+func Test_DeleteResourceProvider(t *testing.T) {
+	resourceProvider := &datamodel.ResourceProvider{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   testResourceProviderID,
+				Name: "Applications.Test",
+				Type: datamodel.ResourceProviderResourceType,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				AsyncProvisioningState: v1.ProvisioningStateSucceeded,
+			},
+		},
+	}
+
+	trackedResources := []database.Object{
+		{Data: trackedResource("/planes/radius/local/resourceGroups/rg2/providers/Applications.Test/testResources/b", "Applications.Test/testResources")},
+		{Data: trackedResource("/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/containers/c", "Applications.Core/containers")},
+		{Data: trackedResource("/planes/radius/local/resourceGroups/rg1/providers/applications.test/testResources/a", "applications.test/testResources")},
+		{Data: trackedResource("/planes/radius/local/resourceGroups/rg1/providers/Applications.TestOther/testResources/d", "Applications.TestOther/testResources")},
+	}
+
+	expectedQuery := database.Query{
+		RootScope:      "/planes/radius/local",
+		ScopeRecursive: true,
+		ResourceType:   v20231001preview.ResourceType,
+	}
+
+	t.Run("resources exist", func(t *testing.T) {
+		databaseClient, _, ctrl := setupDeleteResourceProvider(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), testResourceProviderID).
+			Return(&database.Object{Data: resourceProvider}, nil).
+			Times(1)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), expectedQuery).
+			Return(&database.ObjectQueryResult{Items: trackedResources}, nil).
+			Times(1)
+
+		response, err := runDeleteResourceProvider(t, ctrl, "")
+		require.NoError(t, err)
+
+		expected := &armrpc_rest.ConflictResponse{
+			Body: v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeConflict,
+					Message: "the resource provider 'Applications.Test' cannot be deleted because 2 resource(s) of its resource types exist. Delete the resources first, or set the query parameter 'force' to true to delete the resource provider anyway",
+					Details: []*v1.ErrorDetails{
+						{
+							Code:    v1.CodeConflict,
+							Message: "the resource '/planes/radius/local/resourceGroups/rg1/providers/applications.test/testResources/a' uses a resource type of the resource provider 'Applications.Test'",
+							Target:  "/planes/radius/local/resourceGroups/rg1/providers/applications.test/testResources/a",
+						},
+						{
+							Code:    v1.CodeConflict,
+							Message: "the resource '/planes/radius/local/resourceGroups/rg2/providers/Applications.Test/testResources/b' uses a resource type of the resource provider 'Applications.Test'",
+							Target:  "/planes/radius/local/resourceGroups/rg2/providers/Applications.Test/testResources/b",
+						},
+					},
+				},
+			},
+		}
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("no resources", func(t *testing.T) {
+		databaseClient, statusManager, ctrl := setupDeleteResourceProvider(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), testResourceProviderID).
+			Return(&database.Object{Data: resourceProvider}, nil).
+			Times(2)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), expectedQuery).
+			Return(&database.ObjectQueryResult{Items: trackedResources[1:2]}, nil).
+			Times(1)
+		statusManager.EXPECT().
+			QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+		databaseClient.EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		response, err := runDeleteResourceProvider(t, ctrl, "")
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.AsyncOperationResponse{}, response)
+	})
+
+	t.Run("force", func(t *testing.T) {
+		databaseClient, statusManager, ctrl := setupDeleteResourceProvider(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), testResourceProviderID).
+			Return(&database.Object{Data: resourceProvider}, nil).
+			Times(1)
+		statusManager.EXPECT().
+			QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+		databaseClient.EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		response, err := runDeleteResourceProvider(t, ctrl, "true")
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.AsyncOperationResponse{}, response)
+	})
+
+	t.Run("invalid force", func(t *testing.T) {
+		_, _, ctrl := setupDeleteResourceProvider(t)
+
+		response, err := runDeleteResourceProvider(t, ctrl, "sure")
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse("the value \"sure\" of the query parameter 'force' is not a valid boolean"), response)
+	})
+
+	t.Run("resource provider not found", func(t *testing.T) {
+		databaseClient, _, ctrl := setupDeleteResourceProvider(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), testResourceProviderID).
+			Return(nil, &database.ErrNotFound{ID: testResourceProviderID}).
+			Times(2)
+
+		response, err := runDeleteResourceProvider(t, ctrl, "")
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewNoContentResponse(), response)
+	})
+}
+
+func trackedResource(id string, resourceType string) *datamodel.GenericResource {
+	return &datamodel.GenericResource{
+		Properties: datamodel.GenericResourceProperties{
+			ID:   id,
+			Type: resourceType,
+		},
+	}
+}
+
+func runDeleteResourceProvider(t *testing.T, ctrl armrpc_controller.Controller, force string) (armrpc_rest.Response, error) {
+	url := testResourceProviderID + "?api-version=" + v20231001preview.Version
+	if force != "" {
+		url += "&force=" + force
+	}
+
+	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(request)
+	return ctrl.Run(ctx, httptest.NewRecorder(), request)
+}
+
+func setupDeleteResourceProvider(t *testing.T) (*database.MockClient, *statusmanager.MockStatusManager, armrpc_controller.Controller) {
+	mockCtrl := gomock.NewController(t)
+	databaseClient := database.NewMockClient(mockCtrl)
+	statusManager := statusmanager.NewMockStatusManager(mockCtrl)
+
+	c, err := NewDeleteResourceProvider(
+		armrpc_controller.Options{DatabaseClient: databaseClient, StatusManager: statusManager},
+		armrpc_controller.ResourceOptions[datamodel.ResourceProvider]{
+			RequestConverter:  converter.ResourceProviderDataModelFromVersioned,
+			ResponseConverter: converter.ResourceProviderDataModelToVersioned,
+		})
+	require.NoError(t, err)
+
+	return databaseClient, statusManager, c
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatespecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ValidateTemplateSpecExists validates that a template spec version is created in an existing template spec.
+func ValidateTemplateSpecExists(ctx context.Context, newResource, oldResource *datamodel.TemplateSpecVersion, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	templateSpecID := serviceCtx.ResourceID.Truncate()
+
+	_, err := options.DatabaseClient.Get(ctx, templateSpecID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return rest.NewDependencyMissingResponse(fmt.Sprintf("The template spec %q does not exist. Create the template spec before creating a version of it.", templateSpecID.String())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ValidateTemplateImmutable prevents the template of an existing template spec version from being changed. A version
+// is promoted as-is across environments, so a given version must always refer to the same template.
+func ValidateTemplateImmutable(ctx context.Context, newResource, oldResource *datamodel.TemplateSpecVersion, options *controller.Options) (rest.Response, error) {
+	if oldResource == nil {
+		return nil, nil
+	}
+
+	if !reflect.DeepEqual(oldResource.Properties.Template, newResource.Properties.Template) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		return rest.NewConflictResponse(fmt.Sprintf("The template of template spec version %q cannot be changed. Publish the template as a new version instead.", serviceCtx.ResourceID.String())), nil
+	}
+
+	return nil, nil
+}
+
+// ValidateNoTemplateSpecVersions prevents the deletion of a template spec that still contains versions.
+func ValidateNoTemplateSpecVersions(ctx context.Context, oldResource *datamodel.TemplateSpec, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	names, err := listVersionNames(ctx, options.DatabaseClient, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.RoutingScope())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	return rest.NewConflictResponse(fmt.Sprintf("The template spec %q cannot be deleted because it contains version(s): %s. Delete the version(s) before deleting the template spec.",
+		serviceCtx.ResourceID.String(), strings.Join(names, ", "))), nil
+}
+
+// listVersionNames returns the sorted names of the versions of the template spec with the given root scope and routing scope.
+func listVersionNames(ctx context.Context, client database.Client, rootScope string, routingScope string) ([]string, error) {
+	result, err := client.Query(ctx, database.Query{
+		RootScope:          rootScope,
+		ResourceType:       datamodel.TemplateSpecVersionResourceType,
+		RoutingScopePrefix: routingScope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, item := range result.Items {
+		version := &datamodel.TemplateSpecVersion{}
+		if err := item.As(version); err != nil {
+			return nil, err
+		}
+		names = append(names, version.Name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
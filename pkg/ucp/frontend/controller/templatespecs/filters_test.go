@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatespecs
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceGroupID = "/planes/radius/local/resourceGroups/rg1"
+	testTemplateSpecID  = testResourceGroupID + "/providers/System.Resources/templateSpecs/myapp"
+)
+
+func newContext(t *testing.T, id string) context.Context {
+	parsed, err := resources.Parse(id)
+	require.NoError(t, err)
+	return v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{ResourceID: parsed})
+}
+
+func saveTemplateSpec(t *testing.T, client database.Client, id string) {
+	parsed, err := resources.ParseResource(id)
+	require.NoError(t, err)
+
+	err = client.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data: &datamodel.TemplateSpec{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   id,
+					Name: parsed.Name(),
+					Type: datamodel.TemplateSpecResourceType,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func saveTemplateSpecVersion(t *testing.T, client database.Client, templateSpecID string, name string) {
+	id := templateSpecID + "/versions/" + name
+	err := client.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data: &datamodel.TemplateSpecVersion{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   id,
+					Name: name,
+					Type: datamodel.TemplateSpecVersionResourceType,
+				},
+			},
+			Properties: datamodel.TemplateSpecVersionProperties{
+				Template: map[string]any{"contentVersion": "1.0.0.0"},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func Test_ValidateTemplateSpecExists(t *testing.T) {
+	t.Run("template spec exists", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTemplateSpec(t, client, testTemplateSpecID)
+
+		ctx := newContext(t, testTemplateSpecID+"/versions/1.0.0")
+		resp, err := ValidateTemplateSpecExists(ctx, &datamodel.TemplateSpecVersion{}, nil, &controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("template spec not found", func(t *testing.T) {
+		client := inmemory.NewClient()
+
+		ctx := newContext(t, testTemplateSpecID+"/versions/1.0.0")
+		resp, err := ValidateTemplateSpecExists(ctx, &datamodel.TemplateSpecVersion{}, nil, &controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewDependencyMissingResponse(`The template spec "`+testTemplateSpecID+`" does not exist. Create the template spec before creating a version of it.`), resp)
+	})
+}
+
+func Test_ValidateTemplateImmutable(t *testing.T) {
+	id := testTemplateSpecID + "/versions/1.0.0"
+	version := func(contentVersion string) *datamodel.TemplateSpecVersion {
+		return &datamodel.TemplateSpecVersion{
+			Properties: datamodel.TemplateSpecVersionProperties{
+				Description: "description " + contentVersion,
+				Template:    map[string]any{"contentVersion": contentVersion},
+			},
+		}
+	}
+
+	t.Run("new version", func(t *testing.T) {
+		resp, err := ValidateTemplateImmutable(newContext(t, id), version("1.0.0.0"), nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("same template", func(t *testing.T) {
+		newResource := version("1.0.0.0")
+		newResource.Properties.Description = "updated description"
+
+		resp, err := ValidateTemplateImmutable(newContext(t, id), newResource, version("1.0.0.0"), &controller.Options{})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("changed template", func(t *testing.T) {
+		resp, err := ValidateTemplateImmutable(newContext(t, id), version("2.0.0.0"), version("1.0.0.0"), &controller.Options{})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewConflictResponse(`The template of template spec version "`+id+`" cannot be changed. Publish the template as a new version instead.`), resp)
+	})
+}
+
+func Test_ValidateNoTemplateSpecVersions(t *testing.T) {
+	t.Run("no versions", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTemplateSpec(t, client, testTemplateSpecID)
+
+		// Versions of a different template spec must not block the delete.
+		saveTemplateSpecVersion(t, client, testTemplateSpecID+"2", "1.0.0")
+
+		resp, err := ValidateNoTemplateSpecVersions(newContext(t, testTemplateSpecID), &datamodel.TemplateSpec{}, &controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("versions exist", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTemplateSpec(t, client, testTemplateSpecID)
+		saveTemplateSpecVersion(t, client, testTemplateSpecID, "2.0.0")
+		saveTemplateSpecVersion(t, client, testTemplateSpecID, "1.0.0")
+
+		resp, err := ValidateNoTemplateSpecVersions(newContext(t, testTemplateSpecID), &datamodel.TemplateSpec{}, &controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewConflictResponse(`The template spec "`+testTemplateSpecID+`" cannot be deleted because it contains version(s): 1.0.0, 2.0.0. Delete the version(s) before deleting the template spec.`), resp)
+	})
+}
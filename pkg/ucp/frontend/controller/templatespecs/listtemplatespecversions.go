@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatespecs
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+var _ armrpc_controller.Controller = (*ListTemplateSpecVersions)(nil)
+
+// ListTemplateSpecVersions is the controller implementation to list the versions of a template spec.
+//
+// The default list operation queries by resource type only, which would return the versions of every template spec in
+// the resource group. This controller limits the query to the versions of the template spec in the request URL.
+type ListTemplateSpecVersions struct {
+	armrpc_controller.Operation[*datamodel.TemplateSpecVersion, datamodel.TemplateSpecVersion]
+}
+
+// NewListTemplateSpecVersions creates a new controller for listing the versions of a template spec.
+func NewListTemplateSpecVersions(opts armrpc_controller.Options, resourceOpts armrpc_controller.ResourceOptions[datamodel.TemplateSpecVersion]) (armrpc_controller.Controller, error) {
+	return &ListTemplateSpecVersions{
+		Operation: armrpc_controller.NewOperation(opts, resourceOpts),
+	}, nil
+}
+
+// Run implements controller.Controller.
+func (l *ListTemplateSpecVersions) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The request URL is the collection of versions, so the parent is the template spec.
+	templateSpecID := serviceCtx.ResourceID.Truncate()
+
+	result, err := l.DatabaseClient().Query(ctx, database.Query{
+		RootScope:          templateSpecID.RootScope(),
+		ResourceType:       datamodel.TemplateSpecVersionResourceType,
+		RoutingScopePrefix: templateSpecID.RoutingScope(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := v1.PaginatedList{
+		Value: []any{},
+	}
+	for _, item := range result.Items {
+		version := &datamodel.TemplateSpecVersion{}
+		if err := item.As(version); err != nil {
+			return nil, err
+		}
+
+		versioned, err := l.ResponseConverter()(version, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		items.Value = append(items.Value, versioned)
+	}
+
+	return armrpc_rest.NewOKResponse(&items), nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatespecs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListTemplateSpecVersions(t *testing.T) {
+	client := inmemory.NewClient()
+	saveTemplateSpec(t, client, testTemplateSpecID)
+	saveTemplateSpecVersion(t, client, testTemplateSpecID, "1.0.0")
+	saveTemplateSpec(t, client, testTemplateSpecID+"2")
+	saveTemplateSpecVersion(t, client, testTemplateSpecID+"2", "2.0.0")
+
+	ctrl, err := NewListTemplateSpecVersions(controller.Options{DatabaseClient: client}, controller.ResourceOptions[datamodel.TemplateSpecVersion]{
+		RequestConverter:  converter.TemplateSpecVersionDataModelFromVersioned,
+		ResponseConverter: converter.TemplateSpecVersionDataModelToVersioned,
+	})
+	require.NoError(t, err)
+
+	ctx := newContext(t, testTemplateSpecID+"/versions")
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	serviceCtx.APIVersion = v20231001preview.Version
+
+	w := httptest.NewRecorder()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, testTemplateSpecID+"/versions?api-version="+v20231001preview.Version, nil)
+	require.NoError(t, err)
+
+	resp, err := ctrl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	expected := rest.NewOKResponse(&v1.PaginatedList{
+		Value: []any{
+			&v20231001preview.TemplateSpecVersionResource{
+				ID:   to.Ptr(testTemplateSpecID + "/versions/1.0.0"),
+				Name: to.Ptr("1.0.0"),
+				Type: to.Ptr(datamodel.TemplateSpecVersionResourceType),
+				Properties: &v20231001preview.TemplateSpecVersionProperties{
+					ProvisioningState: to.Ptr(v20231001preview.ProvisioningState("")),
+					Template:          map[string]any{"contentVersion": "1.0.0.0"},
+				},
+			},
+		},
+	})
+	require.Equal(t, expected, resp)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	gcp_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/gcp"
+	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
+	"github.com/radius-project/radius/pkg/validator"
+)
+
+const (
+	planeCollectionPath = "/planes/gcp"
+	planeResourcePath   = "/planes/gcp/{planeName}"
+
+	credentialResourcePath   = planeResourcePath + "/providers/System.GCP/credentials/{credentialName}"
+	credentialCollectionPath = planeResourcePath + "/providers/System.GCP/credentials"
+)
+
+func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
+	secretClient, err := m.options.SecretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseRouter := server.NewSubrouter(m.router, m.options.Config.Server.PathBase+"/")
+
+	apiValidator := validator.APIValidator(validator.Options{
+		SpecLoader:         m.options.SpecLoader,
+		ResourceTypeGetter: validator.UCPResourceTypeGetter,
+	})
+
+	planeResourceOptions := controller.ResourceOptions[datamodel.GCPPlane]{
+		RequestConverter:  converter.GCPPlaneDataModelFromVersioned,
+		ResponseConverter: converter.GCPPlaneDataModelToVersioned,
+	}
+
+	// URLs for lifecycle of planes
+	planeCollectionRouter := server.NewSubrouter(baseRouter, planeCollectionPath, apiValidator)
+	planeResourceRouter := server.NewSubrouter(baseRouter, planeResourcePath, apiValidator)
+
+	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
+	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
+
+	handlerOptions := []server.HandlerOptions{
+		{
+			// This is a scope query so we can't use the default operation.
+			ParentRouter:  planeCollectionRouter,
+			Method:        v1.OperationList,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationList},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return &planes_ctrl.ListPlanesByType[*datamodel.GCPPlane, datamodel.GCPPlane]{
+					Operation: controller.NewOperation(opts, planeResourceOptions),
+				}, nil
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationGet,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationGet},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationPut,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationPut},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationPatch,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationPatch},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return planes_ctrl.NewUpdatePlane[*datamodel.GCPPlane](opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationDelete,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationDelete},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter: credentialCollectionRouter,
+			ResourceType: v20231001preview.GCPCredentialType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opt,
+					controller.ResourceOptions[datamodel.GCPCredential]{
+						RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+						ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			ResourceType: v20231001preview.GCPCredentialType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opt,
+					controller.ResourceOptions[datamodel.GCPCredential]{
+						RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+						ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationPut,
+			ResourceType: v20231001preview.GCPCredentialType,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return gcp_credential_ctrl.NewCreateOrUpdateGCPCredential(opt, secretClient)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationDelete,
+			ResourceType: v20231001preview.GCPCredentialType,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return gcp_credential_ctrl.NewDeleteGCPCredential(opt, secretClient)
+			},
+		},
+	}
+
+	databaseClient, err := m.options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrlOpts := controller.Options{
+		Address:        m.options.Config.Server.Address(),
+		DatabaseClient: databaseClient,
+		PathBase:       m.options.Config.Server.PathBase,
+		StatusManager:  m.options.StatusManager,
+
+		KubeClient:   nil, // Unused by GCP module
+		ResourceType: "",  // Set dynamically
+	}
+
+	for _, h := range handlerOptions {
+		if err := server.RegisterHandler(ctx, h, ctrlOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.router, nil
+}
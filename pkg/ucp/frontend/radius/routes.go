@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/components/httpclient"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
@@ -34,6 +35,7 @@ import (
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	resourceproviders_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourceproviders"
+	templatespecs_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/templatespecs"
 	"github.com/radius-project/radius/pkg/validator"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -52,8 +54,6 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		ResourceTypeGetter: validator.UCPResourceTypeGetter,
 	})
 
-	transport := otelhttp.NewTransport(http.DefaultTransport)
-
 	// More convienent way to capture errors
 	var err error
 	capture := func(handler http.HandlerFunc, e error) http.HandlerFunc {
@@ -69,6 +69,12 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		return nil, err
 	}
 
+	baseTransport, err := httpclient.DefaultTransport()
+	if err != nil {
+		return nil, err
+	}
+	transport := otelhttp.NewTransport(baseTransport)
+
 	ctrlOptions := controller.Options{
 		Address:        m.options.Config.Server.Address(),
 		DatabaseClient: databaseClient,
@@ -85,6 +91,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		r.Route("/{planeName}", func(r chi.Router) {
 			r.With(apiValidator).Get("/", capture(radiusPlaneGetHandler(ctx, ctrlOptions)))
 			r.With(apiValidator).Put("/", capture(radiusPlanePutHandler(ctx, ctrlOptions)))
+			r.With(apiValidator).Patch("/", capture(radiusPlanePatchHandler(ctx, ctrlOptions)))
 			r.With(apiValidator).Delete("/", capture(radiusPlaneDeleteHandler(ctx, ctrlOptions)))
 
 			r.Route("/providers", func(r chi.Router) {
@@ -151,8 +158,27 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 					r.With(apiValidator).Route("/resources", func(r chi.Router) {
 						r.Get("/", capture(resourceGroupResourcesHandler(ctx, ctrlOptions)))
 					})
+					r.With(apiValidator).Get("/usage", capture(resourceGroupUsageHandler(ctx, ctrlOptions)))
 
 					r.Route("/providers", func(r chi.Router) {
+						r.Route("/System.Resources/templatespecs", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(templateSpecListHandler(ctx, ctrlOptions)))
+							r.Route("/{templateSpecName}", func(r chi.Router) {
+								r.With(apiValidator).Get("/", capture(templateSpecGetHandler(ctx, ctrlOptions)))
+								r.With(apiValidator).Put("/", capture(templateSpecPutHandler(ctx, ctrlOptions)))
+								r.With(apiValidator).Delete("/", capture(templateSpecDeleteHandler(ctx, ctrlOptions)))
+
+								r.Route("/versions", func(r chi.Router) {
+									r.With(apiValidator).Get("/", capture(templateSpecVersionListHandler(ctx, ctrlOptions)))
+									r.Route("/{templateSpecVersionName}", func(r chi.Router) {
+										r.With(apiValidator).Get("/", capture(templateSpecVersionGetHandler(ctx, ctrlOptions)))
+										r.With(apiValidator).Put("/", capture(templateSpecVersionPutHandler(ctx, ctrlOptions)))
+										r.With(apiValidator).Delete("/", capture(templateSpecVersionDeleteHandler(ctx, ctrlOptions)))
+									})
+								})
+							})
+						})
+
 						// Proxy to resource-group-scoped ResourceProvider APIs
 						//
 						// NOTE: DO NOT validate schema for proxy routes.
@@ -195,6 +221,12 @@ func radiusPlanePutHandler(ctx context.Context, ctrlOptions controller.Options)
 	})
 }
 
+func radiusPlanePatchHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, planeResourceType, v1.OperationPatch, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return planes_ctrl.NewUpdatePlane[*datamodel.RadiusPlane](opts, planeResourceOptions)
+	})
+}
+
 func radiusPlaneDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, planeResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
 		return defaultoperation.NewDefaultSyncDelete(opts, planeResourceOptions)
@@ -234,6 +266,12 @@ func resourceGroupResourcesHandler(ctx context.Context, ctrlOptions controller.O
 	})
 }
 
+func resourceGroupUsageHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.ResourceGroupUsageResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return resourcegroups_ctrl.NewGetUsage(opts)
+	})
+}
+
 func resourceProviderSummaryListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.ResourceProviderSummaryResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
 		return resourceproviders_ctrl.NewListResourceProviderSummaries(opts)
@@ -272,7 +310,7 @@ func resourceProviderPutHandler(ctx context.Context, ctrlOptions controller.Opti
 
 func resourceProviderDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.ResourceProviderResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
-		return defaultoperation.NewDefaultAsyncDelete(opts, resourceProviderResourceOptions)
+		return resourceproviders_ctrl.NewDeleteResourceProvider(opts, resourceProviderResourceOptions)
 	})
 }
 
@@ -366,6 +404,71 @@ func locationDeleteHandler(ctx context.Context, ctrlOptions controller.Options)
 	})
 }
 
+var templateSpecResourceOptions = controller.ResourceOptions[datamodel.TemplateSpec]{
+	RequestConverter:  converter.TemplateSpecDataModelFromVersioned,
+	ResponseConverter: converter.TemplateSpecDataModelToVersioned,
+	DeleteFilters: []controller.DeleteFilter[datamodel.TemplateSpec]{
+		templatespecs_ctrl.ValidateNoTemplateSpecVersions,
+	},
+}
+
+func templateSpecListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.TemplateSpecResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, templateSpecResourceOptions)
+	})
+}
+
+func templateSpecGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.TemplateSpecResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, templateSpecResourceOptions)
+	})
+}
+
+func templateSpecPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.TemplateSpecResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, templateSpecResourceOptions)
+	})
+}
+
+func templateSpecDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.TemplateSpecResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, templateSpecResourceOptions)
+	})
+}
+
+var templateSpecVersionResourceOptions = controller.ResourceOptions[datamodel.TemplateSpecVersion]{
+	RequestConverter:  converter.TemplateSpecVersionDataModelFromVersioned,
+	ResponseConverter: converter.TemplateSpecVersionDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.TemplateSpecVersion]{
+		templatespecs_ctrl.ValidateTemplateSpecExists,
+		templatespecs_ctrl.ValidateTemplateImmutable,
+	},
+}
+
+func templateSpecVersionListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.TemplateSpecVersionResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return templatespecs_ctrl.NewListTemplateSpecVersions(opts, templateSpecVersionResourceOptions)
+	})
+}
+
+func templateSpecVersionGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.TemplateSpecVersionResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, templateSpecVersionResourceOptions)
+	})
+}
+
+func templateSpecVersionPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.TemplateSpecVersionResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, templateSpecVersionResourceOptions)
+	})
+}
+
+func templateSpecVersionDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.TemplateSpecVersionResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, templateSpecVersionResourceOptions)
+	})
+}
+
 func planeScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, OperationTypeUCPRadiusProxy, v1.OperationProxy, ctrlOptions, func(o controller.Options) (controller.Controller, error) {
 		return radius_ctrl.NewProxyController(o, transport, defaultDownstream)
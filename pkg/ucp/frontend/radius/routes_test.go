@@ -54,6 +54,11 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodPut,
 			Path:          "/planes/radius/someName",
 		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RadiusPlaneResourceType, Method: v1.OperationPatch},
+			Method:        http.MethodPatch,
+			Path:          "/planes/radius/someName",
+		},
 		{
 			OperationType: v1.OperationType{Type: datamodel.RadiusPlaneResourceType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
@@ -163,6 +168,11 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodDelete,
 			Path:          "/planes/radius/local/resourcegroups/test-rg",
 		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.ResourceGroupUsageResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/usage",
+		},
 		{
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
@@ -170,6 +180,48 @@ func Test_Routes(t *testing.T) {
 			SkipOperationTypeValidation: true,
 		},
 
+		// Template specs
+		{
+			OperationType: v1.OperationType{Type: datamodel.TemplateSpecResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/templatespecs",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.TemplateSpecResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/templatespecs/myapp",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.TemplateSpecResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/templatespecs/myapp",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.TemplateSpecResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/templatespecs/myapp",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.TemplateSpecVersionResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/templatespecs/myapp/versions",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.TemplateSpecVersionResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/templatespecs/myapp/versions/1.0.0",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.TemplateSpecVersionResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/templatespecs/myapp/versions/1.0.0",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.TemplateSpecVersionResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/templatespecs/myapp/versions/1.0.0",
+		},
+
 		// Proxy
 		{
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
@@ -41,6 +42,10 @@ type Options struct {
 	// Config is the configuration for the server.
 	Config *Config
 
+	// ConfigWatcher watches the configuration file for changes to the settings which can be changed at runtime.
+	// This will default to nil, implying the configuration file is not watched, and is set by the server command.
+	ConfigWatcher *hostoptions.ConfigWatcher[Config]
+
 	// DatabaseProvider provides access to the database used for resource data.
 	DatabaseProvider *databaseprovider.DatabaseProvider
 
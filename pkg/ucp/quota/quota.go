@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// ContainerResourceType is the resource type counted by the containers quota.
+	ContainerResourceType = "Applications.Core/containers"
+
+	// LimitContainers is the name of the containers limit.
+	LimitContainers = "containers"
+
+	// LimitRecipeExecutionsPerDay is the name of the recipe executions per day limit.
+	LimitRecipeExecutionsPerDay = "recipe executions per day"
+
+	// LimitCloudResources is the name of the cloud resources limit.
+	LimitCloudResources = "cloud resources"
+
+	// LimitContainerCPU is the name of the CPU per container limit.
+	LimitContainerCPU = "CPU per container"
+
+	// LimitContainerMemory is the name of the memory per container limit.
+	LimitContainerMemory = "memory per container"
+)
+
+// Limits are the limits of the quota of a resource group or an environment. A nil limit is not enforced.
+type Limits struct {
+	// MaxContainers is the maximum number of containers.
+	MaxContainers *int32
+
+	// MaxRecipeExecutionsPerDay is the maximum number of recipe executions per day (UTC).
+	MaxRecipeExecutionsPerDay *int32
+
+	// MaxCloudResources is the maximum number of Azure, AWS and GCP resources deployed by Radius.
+	MaxCloudResources *int32
+
+	// MaxContainerCPU is the maximum CPU a container can request or be limited to.
+	MaxContainerCPU *resource.Quantity
+
+	// MaxContainerMemory is the maximum memory a container can request or be limited to.
+	MaxContainerMemory *resource.Quantity
+}
+
+// IsEmpty returns true if none of the limits are enforced.
+func (l Limits) IsEmpty() bool {
+	return l.MaxContainers == nil && l.MaxRecipeExecutionsPerDay == nil && l.MaxCloudResources == nil &&
+		l.MaxContainerCPU == nil && l.MaxContainerMemory == nil
+}
+
+// Usage is the current consumption of the quota of a resource group or an environment.
+type Usage struct {
+	// Containers is the number of containers.
+	Containers int32
+
+	// RecipeExecutionsToday is the number of recipe executions of the current day (UTC).
+	RecipeExecutionsToday int32
+
+	// CloudResources is the number of Azure, AWS and GCP resources deployed by Radius.
+	CloudResources int32
+}
+
+// Request describes what a deployment consumes from a quota.
+type Request struct {
+	// NewContainer is true when the deployment creates a container.
+	NewContainer bool
+
+	// RecipeExecution is true when the deployment executes a recipe. Recipes are what deploy cloud resources, so
+	// deployments which execute a recipe are rejected once the cloud resources quota has been reached.
+	RecipeExecution bool
+
+	// ContainerCPU is the largest CPU requested by or limiting a container of the deployment, if any.
+	ContainerCPU *resource.Quantity
+
+	// ContainerMemory is the largest memory requested by or limiting a container of the deployment, if any.
+	ContainerMemory *resource.Quantity
+}
+
+// IsEmpty returns true if the deployment does not consume any quota.
+func (r Request) IsEmpty() bool {
+	return !r.NewContainer && !r.RecipeExecution && r.ContainerCPU == nil && r.ContainerMemory == nil
+}
+
+// ExceededError is returned by Check when a deployment would exceed a limit of a quota.
+type ExceededError struct {
+	// Limit is the name of the limit which would be exceeded.
+	Limit string
+
+	// Max is the value of the limit.
+	Max int32
+
+	// MaxQuantity is the value of a per container limit, such as "2" or "1Gi". Max is not used for these limits.
+	MaxQuantity string
+
+	// Requested is the quantity requested by the container which exceeds a per container limit.
+	Requested string
+}
+
+// Error returns a string describing the limit which would be exceeded.
+func (e *ExceededError) Error() string {
+	if e.MaxQuantity != "" {
+		return fmt.Sprintf("the quota of %s %s is exceeded by a container requesting %s", e.MaxQuantity, e.Limit, e.Requested)
+	}
+
+	return fmt.Sprintf("the quota of %d %s has been reached", e.Max, e.Limit)
+}
+
+// Is checks if the target error is an ExceededError.
+func (e *ExceededError) Is(target error) bool {
+	_, ok := target.(*ExceededError)
+	return ok
+}
+
+// Check returns an ExceededError if the deployment described by the request would exceed the limits given the
+// current usage.
+func Check(limits Limits, usage Usage, request Request) error {
+	if err := checkContainerQuantity(LimitContainerCPU, limits.MaxContainerCPU, request.ContainerCPU); err != nil {
+		return err
+	}
+
+	if err := checkContainerQuantity(LimitContainerMemory, limits.MaxContainerMemory, request.ContainerMemory); err != nil {
+		return err
+	}
+
+	if request.NewContainer && limits.MaxContainers != nil && usage.Containers >= *limits.MaxContainers {
+		return &ExceededError{Limit: LimitContainers, Max: *limits.MaxContainers}
+	}
+
+	if !request.RecipeExecution {
+		return nil
+	}
+
+	if limits.MaxRecipeExecutionsPerDay != nil && usage.RecipeExecutionsToday >= *limits.MaxRecipeExecutionsPerDay {
+		return &ExceededError{Limit: LimitRecipeExecutionsPerDay, Max: *limits.MaxRecipeExecutionsPerDay}
+	}
+
+	if limits.MaxCloudResources != nil && usage.CloudResources >= *limits.MaxCloudResources {
+		return &ExceededError{Limit: LimitCloudResources, Max: *limits.MaxCloudResources}
+	}
+
+	return nil
+}
+
+// checkContainerQuantity returns an ExceededError if the quantity requested by a container is greater than the
+// per container limit.
+func checkContainerQuantity(limit string, max *resource.Quantity, requested *resource.Quantity) error {
+	if max == nil || requested == nil || requested.Cmp(*max) <= 0 {
+		return nil
+	}
+
+	return &ExceededError{Limit: limit, MaxQuantity: max.String(), Requested: requested.String()}
+}
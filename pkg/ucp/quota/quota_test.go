@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func Test_Check(t *testing.T) {
+	limits := Limits{
+		MaxContainers:             to.Ptr(int32(2)),
+		MaxRecipeExecutionsPerDay: to.Ptr(int32(10)),
+		MaxCloudResources:         to.Ptr(int32(5)),
+		MaxContainerCPU:           to.Ptr(resource.MustParse("2")),
+		MaxContainerMemory:        to.Ptr(resource.MustParse("1Gi")),
+	}
+
+	tests := []struct {
+		name     string
+		limits   Limits
+		usage    Usage
+		request  Request
+		expected error
+	}{
+		{
+			name:    "no limits",
+			limits:  Limits{},
+			usage:   Usage{Containers: 100, RecipeExecutionsToday: 100, CloudResources: 100},
+			request: Request{NewContainer: true, RecipeExecution: true},
+		},
+		{
+			name:    "within limits",
+			limits:  limits,
+			usage:   Usage{Containers: 1, RecipeExecutionsToday: 9, CloudResources: 4},
+			request: Request{NewContainer: true, RecipeExecution: true},
+		},
+		{
+			name:    "container resources within limits",
+			limits:  limits,
+			request: Request{ContainerCPU: to.Ptr(resource.MustParse("1500m")), ContainerMemory: to.Ptr(resource.MustParse("1024Mi"))},
+		},
+		{
+			name:     "container CPU exceeded",
+			limits:   limits,
+			request:  Request{ContainerCPU: to.Ptr(resource.MustParse("2500m"))},
+			expected: &ExceededError{Limit: LimitContainerCPU, MaxQuantity: "2", Requested: "2500m"},
+		},
+		{
+			name:     "container memory exceeded",
+			limits:   limits,
+			request:  Request{ContainerMemory: to.Ptr(resource.MustParse("2Gi"))},
+			expected: &ExceededError{Limit: LimitContainerMemory, MaxQuantity: "1Gi", Requested: "2Gi"},
+		},
+		{
+			name:     "containers exceeded",
+			limits:   limits,
+			usage:    Usage{Containers: 2},
+			request:  Request{NewContainer: true},
+			expected: &ExceededError{Limit: LimitContainers, Max: 2},
+		},
+		{
+			name:    "containers reached without new container",
+			limits:  limits,
+			usage:   Usage{Containers: 2},
+			request: Request{RecipeExecution: true},
+		},
+		{
+			name:     "recipe executions exceeded",
+			limits:   limits,
+			usage:    Usage{RecipeExecutionsToday: 10},
+			request:  Request{RecipeExecution: true},
+			expected: &ExceededError{Limit: LimitRecipeExecutionsPerDay, Max: 10},
+		},
+		{
+			name:     "cloud resources exceeded",
+			limits:   limits,
+			usage:    Usage{CloudResources: 5},
+			request:  Request{RecipeExecution: true},
+			expected: &ExceededError{Limit: LimitCloudResources, Max: 5},
+		},
+		{
+			name:    "cloud resources reached without recipe execution",
+			limits:  limits,
+			usage:   Usage{CloudResources: 5},
+			request: Request{},
+		},
+		{
+			name:     "zero limit",
+			limits:   Limits{MaxContainers: to.Ptr(int32(0))},
+			usage:    Usage{},
+			request:  Request{NewContainer: true},
+			expected: &ExceededError{Limit: LimitContainers, Max: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(tt.limits, tt.usage, tt.request)
+			if tt.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, tt.expected, err)
+			require.ErrorIs(t, err, &ExceededError{})
+		})
+	}
+}
+
+func Test_ExceededError(t *testing.T) {
+	err := &ExceededError{Limit: LimitCloudResources, Max: 5}
+	require.Equal(t, "the quota of 5 cloud resources has been reached", err.Error())
+
+	err = &ExceededError{Limit: LimitContainerCPU, MaxQuantity: "2", Requested: "4"}
+	require.Equal(t, "the quota of 2 CPU per container is exceeded by a container requesting 4", err.Error())
+}
@@ -0,0 +1,377 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_gcp "github.com/radius-project/radius/pkg/ucp/resources/gcp"
+)
+
+const (
+	// recipeExecutionsType is the resource type used to store the recipe executions of a resource group.
+	recipeExecutionsType = "System.Resources/recipeExecutions"
+
+	// recipeExecutionsChildType is the child resource type used to store the recipe executions of an environment.
+	recipeExecutionsChildType = "recipeExecutions"
+
+	// containerReservationsType is the resource type used to store the container reservations of a resource group.
+	containerReservationsType = "System.Resources/containerReservations"
+
+	// containerReservationsChildType is the child resource type used to store the container reservations of an
+	// environment.
+	containerReservationsChildType = "containerReservations"
+
+	// recordName is the name of the resources used to store the recipe executions and container reservations of a
+	// scope.
+	recordName = "default"
+
+	// containerReservationDuration is how long a container is reserved for its deployment. The reservation of a
+	// container is dropped earlier once the container is tracked by UCP.
+	containerReservationDuration = time.Hour
+
+	// applicationResourceType is the resource type of applications. Resources belong to the environment of their
+	// application.
+	applicationResourceType = "Applications.Core/applications"
+
+	// dayFormat is the format of the day of recipe executions.
+	dayFormat = time.DateOnly
+
+	// maxRecordAttempts is the number of attempts to update a record when it is updated concurrently.
+	maxRecordAttempts = 5
+)
+
+// cloudProviders are the providers of resources counted by the cloud resources quota.
+var cloudProviders = []string{resourcemodel.ProviderAzure, resourcemodel.ProviderAWS, resources_gcp.PlaneTypeGCP}
+
+// recipeExecutions is the number of recipe executions of a resource group or an environment on a day.
+type recipeExecutions struct {
+	// Day is the day (UTC) of the recipe executions.
+	Day string `json:"day"`
+
+	// Count is the number of recipe executions.
+	Count int32 `json:"count"`
+}
+
+// containerReservations are the containers admitted against the containers quota of a scope, which may not be tracked
+// by UCP yet.
+type containerReservations struct {
+	// Containers maps the lower case resource IDs of the reserved containers to the expiry of their reservation.
+	Containers map[string]time.Time `json:"containers"`
+}
+
+// Calculate computes the usage of the quota of a scope at the given time. The scope is either the ID of a resource group
+// or the ID of an environment. Resources belong to an environment when they are in the resource group of the
+// environment and reference it directly or through their application.
+func Calculate(ctx context.Context, databaseClient database.Client, scope resources.ID, now time.Time) (Usage, error) {
+	usage := Usage{}
+
+	executions, _, err := getRecipeExecutions(ctx, databaseClient, scope)
+	if err != nil {
+		return Usage{}, err
+	}
+	if executions.Day == now.UTC().Format(dayFormat) {
+		usage.RecipeExecutionsToday = executions.Count
+	}
+
+	tracked, err := listTrackedResources(ctx, databaseClient, scope)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	for _, resource := range tracked {
+		if strings.EqualFold(resource.Type, ContainerResourceType) {
+			usage.Containers++
+		}
+
+		for _, outputResource := range resource.OutputResources {
+			if isCloudResource(outputResource) {
+				usage.CloudResources++
+			}
+		}
+	}
+
+	return usage, nil
+}
+
+// ReserveContainer reserves a container against the containers quota of a scope. The scope is either the ID of a
+// resource group or the ID of an environment. The containers tracked by UCP and the reserved containers are counted,
+// and an ExceededError is returned when the container would exceed max. The reservations are saved with the ETag of
+// the record, so concurrent deployments of new containers cannot exceed the limit before the containers are tracked.
+// A container which is already tracked or reserved is not counted twice.
+func ReserveContainer(ctx context.Context, databaseClient database.Client, scope resources.ID, containerID string, max int32, now time.Time) error {
+	key := strings.ToLower(containerID)
+	for attempt := 1; ; attempt++ {
+		reservations := &containerReservations{}
+		etag, err := getRecord(ctx, databaseClient, containerReservationsID(scope), reservations)
+		if err != nil {
+			return err
+		}
+
+		tracked, err := listTrackedResources(ctx, databaseClient, scope)
+		if err != nil {
+			return err
+		}
+
+		containers := map[string]bool{}
+		for _, resource := range tracked {
+			if strings.EqualFold(resource.Type, ContainerResourceType) {
+				containers[strings.ToLower(resource.ID)] = true
+			}
+		}
+		if containers[key] {
+			return nil
+		}
+
+		// Drop the reservations which have expired or whose containers are tracked.
+		reserved := map[string]time.Time{}
+		for id, expiresAt := range reservations.Containers {
+			if expiresAt.After(now) && !containers[id] {
+				reserved[id] = expiresAt
+			}
+		}
+
+		if _, ok := reserved[key]; !ok && int32(len(containers)+len(reserved)) >= max {
+			return &ExceededError{Limit: LimitContainers, Max: max}
+		}
+		reserved[key] = now.Add(containerReservationDuration)
+
+		obj := &database.Object{
+			Metadata: database.Metadata{ID: containerReservationsID(scope)},
+			Data:     &containerReservations{Containers: reserved},
+		}
+		err = databaseClient.Save(ctx, obj, database.WithETag(etag))
+		if errors.Is(err, &database.ErrConcurrency{}) && attempt < maxRecordAttempts {
+			continue
+		}
+
+		return err
+	}
+}
+
+// ReleaseContainer removes the reservation of a container from a scope. It is used when a reserved container is not
+// deployed, for example because the quota of another scope has been reached.
+func ReleaseContainer(ctx context.Context, databaseClient database.Client, scope resources.ID, containerID string) error {
+	key := strings.ToLower(containerID)
+	for attempt := 1; ; attempt++ {
+		reservations := &containerReservations{}
+		etag, err := getRecord(ctx, databaseClient, containerReservationsID(scope), reservations)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := reservations.Containers[key]; !ok {
+			return nil
+		}
+		delete(reservations.Containers, key)
+
+		obj := &database.Object{
+			Metadata: database.Metadata{ID: containerReservationsID(scope)},
+			Data:     reservations,
+		}
+		err = databaseClient.Save(ctx, obj, database.WithETag(etag))
+		if errors.Is(err, &database.ErrConcurrency{}) && attempt < maxRecordAttempts {
+			continue
+		}
+
+		return err
+	}
+}
+
+// RecordRecipeExecution increments the number of recipe executions of the current day of a scope. The scope is
+// either the ID of a resource group or the ID of an environment. When max is set and the number of recipe executions
+// has reached it, the execution is not recorded and an ExceededError is returned. The limit is checked and the count
+// is incremented with the ETag of the record, so concurrent executions cannot exceed the limit.
+func RecordRecipeExecution(ctx context.Context, databaseClient database.Client, scope resources.ID, max *int32, now time.Time) error {
+	return updateRecipeExecutions(ctx, databaseClient, scope, now, func(executions *recipeExecutions) (bool, error) {
+		if max != nil && executions.Count >= *max {
+			return false, &ExceededError{Limit: LimitRecipeExecutionsPerDay, Max: *max}
+		}
+
+		executions.Count++
+		return true, nil
+	})
+}
+
+// ReleaseRecipeExecution decrements the number of recipe executions of the current day of a scope. It is used when a
+// recorded recipe execution does not run, for example because the quota of another scope has been reached.
+func ReleaseRecipeExecution(ctx context.Context, databaseClient database.Client, scope resources.ID, now time.Time) error {
+	return updateRecipeExecutions(ctx, databaseClient, scope, now, func(executions *recipeExecutions) (bool, error) {
+		if executions.Count == 0 {
+			return false, nil
+		}
+
+		executions.Count--
+		return true, nil
+	})
+}
+
+// updateRecipeExecutions applies the update to the recipe executions of the current day of a scope, and saves them
+// with the ETag of the record. The update is retried when the record is updated concurrently. The record is not saved
+// when the update returns false.
+func updateRecipeExecutions(ctx context.Context, databaseClient database.Client, scope resources.ID, now time.Time, update func(*recipeExecutions) (bool, error)) error {
+	day := now.UTC().Format(dayFormat)
+	for attempt := 1; ; attempt++ {
+		executions, etag, err := getRecipeExecutions(ctx, databaseClient, scope)
+		if err != nil {
+			return err
+		}
+
+		if executions.Day != day {
+			executions = &recipeExecutions{Day: day}
+		}
+
+		if save, err := update(executions); err != nil || !save {
+			return err
+		}
+
+		obj := &database.Object{
+			Metadata: database.Metadata{ID: recipeExecutionsID(scope)},
+			Data:     executions,
+		}
+		err = databaseClient.Save(ctx, obj, database.WithETag(etag))
+		if errors.Is(err, &database.ErrConcurrency{}) && attempt < maxRecordAttempts {
+			continue
+		}
+
+		return err
+	}
+}
+
+// recipeExecutionsID returns the id of the object storing the recipe executions of a scope.
+func recipeExecutionsID(scope resources.ID) string {
+	return recordID(scope, recipeExecutionsType, recipeExecutionsChildType)
+}
+
+// containerReservationsID returns the id of the object storing the container reservations of a scope.
+func containerReservationsID(scope resources.ID) string {
+	return recordID(scope, containerReservationsType, containerReservationsChildType)
+}
+
+// recordID returns the id of the object storing a record of a scope, which is a child resource of an environment or a
+// resource of a resource group.
+func recordID(scope resources.ID, resourceType string, childType string) string {
+	if scope.IsResource() {
+		return scope.Append(resources.TypeSegment{Type: childType, Name: recordName}).String()
+	}
+
+	return scope.Append(resources.TypeSegment{Type: resourceType, Name: recordName}).String()
+}
+
+func getRecipeExecutions(ctx context.Context, databaseClient database.Client, scope resources.ID) (*recipeExecutions, database.ETag, error) {
+	executions := &recipeExecutions{}
+	etag, err := getRecord(ctx, databaseClient, recipeExecutionsID(scope), executions)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return executions, etag, nil
+}
+
+// getRecord reads the record with the given id into record and returns its ETag. The record is left empty and the
+// ETag is empty when the record does not exist.
+func getRecord(ctx context.Context, databaseClient database.Client, id string, record any) (database.ETag, error) {
+	obj, err := databaseClient.Get(ctx, id)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	if err := obj.As(record); err != nil {
+		return "", err
+	}
+
+	return obj.ETag, nil
+}
+
+// listTrackedResources returns the properties of the entries of the resources tracked by UCP in a resource group, or
+// of the resources of an environment in its resource group. The entries keep the application, environment and output
+// resources of the resources so that the resources themselves are not read.
+func listTrackedResources(ctx context.Context, databaseClient database.Client, scope resources.ID) ([]datamodel.GenericResourceProperties, error) {
+	query := database.Query{
+		RootScope:    scope.String(),
+		ResourceType: datamodel.GenericResourceType,
+	}
+
+	if scope.IsResource() {
+		query.RootScope = scope.RootScope()
+	}
+
+	result, err := databaseClient.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	tracked := []datamodel.GenericResourceProperties{}
+	for _, item := range result.Items {
+		entry := datamodel.GenericResource{}
+		if err := item.As(&entry); err != nil {
+			return nil, err
+		}
+
+		tracked = append(tracked, entry.Properties)
+	}
+
+	if scope.IsResource() {
+		tracked = filterEnvironmentResources(scope, tracked)
+	}
+
+	return tracked, nil
+}
+
+// filterEnvironmentResources returns the resources which belong to the environment.
+func filterEnvironmentResources(environmentID resources.ID, tracked []datamodel.GenericResourceProperties) []datamodel.GenericResourceProperties {
+	applications := map[string]bool{}
+	for _, resource := range tracked {
+		if strings.EqualFold(resource.Type, applicationResourceType) && strings.EqualFold(resource.Environment, environmentID.String()) {
+			applications[strings.ToLower(resource.ID)] = true
+		}
+	}
+
+	filtered := []datamodel.GenericResourceProperties{}
+	for _, resource := range tracked {
+		if strings.EqualFold(resource.Environment, environmentID.String()) || applications[strings.ToLower(resource.Application)] {
+			filtered = append(filtered, resource)
+		}
+	}
+
+	return filtered
+}
+
+// isCloudResource returns true if the output resource is an Azure, AWS or GCP resource.
+func isCloudResource(id string) bool {
+	parsed, err := resources.Parse(id)
+	if err != nil || len(parsed.ScopeSegments()) == 0 {
+		return false
+	}
+
+	// Resource IDs that are not UCP qualified are ARM resource IDs.
+	if !parsed.IsUCPQualified() {
+		return true
+	}
+
+	return slices.Contains(cloudProviders, strings.ToLower(parsed.ScopeSegments()[0].Type))
+}
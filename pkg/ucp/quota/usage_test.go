@@ -0,0 +1,297 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceGroupID      = "/planes/radius/local/resourceGroups/rg1"
+	testOtherResourceGroupID = "/planes/radius/local/resourceGroups/rg2"
+	testEnvironmentID        = testResourceGroupID + "/providers/Applications.Core/environments/env"
+	testOtherEnvironmentID   = testResourceGroupID + "/providers/Applications.Core/environments/other-env"
+)
+
+func saveTrackedResource(t *testing.T, databaseClient database.Client, id string, properties datamodel.GenericResourceProperties) {
+	parsed := resources.MustParse(id)
+	tracked := datamodel.GenericResourceFromID(parsed, trackedresource.IDFor(parsed))
+	tracked.Properties.Application = properties.Application
+	tracked.Properties.Environment = properties.Environment
+	tracked.Properties.OutputResources = properties.OutputResources
+	err := databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: tracked.ID}, Data: tracked})
+	require.NoError(t, err)
+}
+
+func setupUsage(t *testing.T) database.Client {
+	databaseClient := inmemory.NewClient()
+
+	app := testOtherResourceGroupID + "/providers/Applications.Core/applications/app"
+	otherApp := testResourceGroupID + "/providers/Applications.Core/applications/other-app"
+
+	saveTrackedResource(t, databaseClient, testEnvironmentID, datamodel.GenericResourceProperties{})
+	saveTrackedResource(t, databaseClient, app, datamodel.GenericResourceProperties{Environment: testEnvironmentID})
+	saveTrackedResource(t, databaseClient, otherApp, datamodel.GenericResourceProperties{Environment: testOtherEnvironmentID})
+
+	// Container of the environment in another resource group, which is not counted by the environment.
+	saveTrackedResource(t, databaseClient, testOtherResourceGroupID+"/providers/Applications.Core/containers/frontend", datamodel.GenericResourceProperties{
+		Application: app,
+		OutputResources: []string{
+			"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/frontend",
+			"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/frontend",
+		},
+	})
+
+	// Container of another environment in the resource group.
+	saveTrackedResource(t, databaseClient, testResourceGroupID+"/providers/Applications.Core/containers/backend", datamodel.GenericResourceProperties{
+		Application:     otherApp,
+		OutputResources: []string{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/backend"},
+	})
+
+	// Portable resource of the environment in the resource group.
+	saveTrackedResource(t, databaseClient, testResourceGroupID+"/providers/Applications.Datastores/redisCaches/redis", datamodel.GenericResourceProperties{
+		Environment: testEnvironmentID,
+		OutputResources: []string{
+			"/planes/aws/aws/accounts/123/regions/us-east-1/providers/AWS.MemoryDB/Cluster/redis",
+			"/planes/gcp/gcp/projects/test/regions/us-east1/providers/GCP.Redis/instances/redis",
+		},
+	})
+
+	return databaseClient
+}
+
+func Test_Calculate(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		scope    string
+		expected Usage
+	}{
+		{
+			name:     "resource group",
+			scope:    testResourceGroupID,
+			expected: Usage{Containers: 1, CloudResources: 2},
+		},
+		{
+			name:     "other resource group",
+			scope:    testOtherResourceGroupID,
+			expected: Usage{Containers: 1, CloudResources: 1},
+		},
+		{
+			name:     "environment",
+			scope:    testEnvironmentID,
+			expected: Usage{CloudResources: 2},
+		},
+		{
+			name:     "other environment",
+			scope:    testOtherEnvironmentID,
+			expected: Usage{Containers: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient := setupUsage(t)
+
+			usage, err := Calculate(context.Background(), databaseClient, resources.MustParse(tt.scope), now)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, usage)
+		})
+	}
+}
+
+func Test_RecordRecipeExecution(t *testing.T) {
+	for _, scope := range []string{testResourceGroupID, testEnvironmentID} {
+		t.Run(scope, func(t *testing.T) {
+			databaseClient := inmemory.NewClient()
+			id := resources.MustParse(scope)
+			today := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+			tomorrow := today.Add(24 * time.Hour)
+
+			for i := 0; i < 3; i++ {
+				err := RecordRecipeExecution(context.Background(), databaseClient, id, nil, today)
+				require.NoError(t, err)
+			}
+
+			usage, err := Calculate(context.Background(), databaseClient, id, today)
+			require.NoError(t, err)
+			require.Equal(t, int32(3), usage.RecipeExecutionsToday)
+
+			// Executions are counted per day.
+			usage, err = Calculate(context.Background(), databaseClient, id, tomorrow)
+			require.NoError(t, err)
+			require.Equal(t, int32(0), usage.RecipeExecutionsToday)
+
+			err = RecordRecipeExecution(context.Background(), databaseClient, id, nil, tomorrow)
+			require.NoError(t, err)
+
+			usage, err = Calculate(context.Background(), databaseClient, id, tomorrow)
+			require.NoError(t, err)
+			require.Equal(t, int32(1), usage.RecipeExecutionsToday)
+		})
+	}
+}
+
+func Test_RecordRecipeExecution_Limit(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+	id := resources.MustParse(testEnvironmentID)
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	max := to.Ptr(int32(2))
+
+	for i := 0; i < 2; i++ {
+		err := RecordRecipeExecution(context.Background(), databaseClient, id, max, now)
+		require.NoError(t, err)
+	}
+
+	err := RecordRecipeExecution(context.Background(), databaseClient, id, max, now)
+	require.ErrorIs(t, err, &ExceededError{})
+	require.EqualError(t, err, "the quota of 2 recipe executions per day has been reached")
+
+	usage, err := Calculate(context.Background(), databaseClient, id, now)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), usage.RecipeExecutionsToday, "rejected execution is not recorded")
+
+	// Released executions can be recorded again.
+	err = ReleaseRecipeExecution(context.Background(), databaseClient, id, now)
+	require.NoError(t, err)
+	err = RecordRecipeExecution(context.Background(), databaseClient, id, max, now)
+	require.NoError(t, err)
+}
+
+func Test_RecordRecipeExecution_Concurrent(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+	id := resources.MustParse(testResourceGroupID)
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	max := to.Ptr(int32(3))
+
+	recorded := atomic.Int32{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := RecordRecipeExecution(context.Background(), databaseClient, id, max, now); err == nil {
+				recorded.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	usage, err := Calculate(context.Background(), databaseClient, id, now)
+	require.NoError(t, err)
+	require.LessOrEqual(t, usage.RecipeExecutionsToday, *max)
+	require.Equal(t, recorded.Load(), usage.RecipeExecutionsToday)
+}
+
+func Test_ReserveContainer(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	frontend := testResourceGroupID + "/providers/Applications.Core/containers/frontend"
+	backend := testResourceGroupID + "/providers/Applications.Core/containers/backend"
+	worker := testResourceGroupID + "/providers/Applications.Core/containers/worker"
+
+	for _, scope := range []string{testResourceGroupID, testEnvironmentID} {
+		t.Run(scope, func(t *testing.T) {
+			databaseClient := inmemory.NewClient()
+			id := resources.MustParse(scope)
+			saveTrackedResource(t, databaseClient, frontend, datamodel.GenericResourceProperties{Environment: testEnvironmentID})
+
+			err := ReserveContainer(context.Background(), databaseClient, id, backend, 2, now)
+			require.NoError(t, err)
+
+			// Containers which are tracked or reserved are not counted twice.
+			err = ReserveContainer(context.Background(), databaseClient, id, frontend, 2, now)
+			require.NoError(t, err)
+			err = ReserveContainer(context.Background(), databaseClient, id, backend, 2, now)
+			require.NoError(t, err)
+
+			err = ReserveContainer(context.Background(), databaseClient, id, worker, 2, now)
+			require.ErrorIs(t, err, &ExceededError{})
+			require.EqualError(t, err, "the quota of 2 containers has been reached")
+
+			// Released containers can be reserved by the other containers.
+			err = ReleaseContainer(context.Background(), databaseClient, id, backend)
+			require.NoError(t, err)
+			err = ReserveContainer(context.Background(), databaseClient, id, worker, 2, now)
+			require.NoError(t, err)
+
+			// Reservations expire.
+			err = ReserveContainer(context.Background(), databaseClient, id, backend, 2, now.Add(containerReservationDuration))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_ReserveContainer_Concurrent(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+	id := resources.MustParse(testResourceGroupID)
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	// The first reservation creates the record, the concurrent reservations are guarded by its ETag.
+	err := ReserveContainer(context.Background(), databaseClient, id, testResourceGroupID+"/providers/Applications.Core/containers/first", 3, now)
+	require.NoError(t, err)
+
+	reserved := atomic.Int32{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			containerID := fmt.Sprintf("%s/providers/Applications.Core/containers/container%d", testResourceGroupID, i)
+			if err := ReserveContainer(context.Background(), databaseClient, id, containerID, 3, now); err == nil {
+				reserved.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, reserved.Load(), int32(2))
+}
+
+func Test_isCloudResource(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected bool
+	}{
+		{"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/redis", true},
+		{"/planes/azure/azure/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/redis", true},
+		{"/planes/aws/aws/accounts/123/regions/us-east-1/providers/AWS.S3/Bucket/bucket", true},
+		{"/planes/gcp/gcp/projects/test/regions/us-east1/providers/GCP.Storage/buckets/bucket", true},
+		{"/planes/kubernetes/local/namespaces/default/providers/core/Service/frontend", false},
+		{"/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/frontend", false},
+		{"invalid", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			require.Equal(t, tt.expected, isCloudResource(tt.id))
+		})
+	}
+}
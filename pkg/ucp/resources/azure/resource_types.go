@@ -23,4 +23,6 @@ const (
 	ResourceTypeManagedIdentityUserAssignedManagedIdentityFederatedIdentityCredential = "Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials"
 	// ResourceTypeAuthorizationRoleAssignment is the resource type of a role assignment.
 	ResourceTypeAuthorizationRoleAssignment = "Microsoft.Authorization/roleAssignments"
+	// ResourceTypeContainerInstanceContainerGroup is the resource type of an Azure Container Instances container group.
+	ResourceTypeContainerInstanceContainerGroup = "Microsoft.ContainerInstance/containerGroups"
 )
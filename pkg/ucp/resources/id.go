@@ -372,12 +372,12 @@ func (ri ID) Type() string {
 		// /planes/{planeType} -> 'System.{planeType}/planes'
 
 		// For formatting we uppercase the first latter (title case) and need to special-case
-		// AWS because it's an initialism.
+		// AWS and GCP because they are initialisms.
 
 		// This is just cosmetic. Type names are case-insensitive.
 		planeType := ri.scopeSegments[0].Type
-		if strings.EqualFold(ri.scopeSegments[0].Type, "aws") {
-			planeType = "AWS"
+		if strings.EqualFold(planeType, "aws") || strings.EqualFold(planeType, "gcp") {
+			planeType = strings.ToUpper(planeType)
 		} else {
 			planeType = cases.Title(language.English).String(planeType)
 		}
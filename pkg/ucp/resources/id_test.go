@@ -320,6 +320,16 @@ func Test_ParseValidIDs(t *testing.T) {
 			kind:     kindscope,
 			typeName: "System.AWS/planes",
 		},
+		{
+			id:       "/planes/gcp/gcp/",
+			expected: "/planes/gcp/gcp",
+			scopes: []ScopeSegment{
+				{Type: "gcp", Name: "gcp"},
+			},
+			provider: "",
+			kind:     kindscope,
+			typeName: "System.GCP/planes",
+		},
 		{
 			id:       "/planes/kubernetes/local/",
 			expected: "/planes/kubernetes/local",
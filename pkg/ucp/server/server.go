@@ -48,6 +48,11 @@ func NewServer(options *ucp.Options) (*hosting.Host, error) {
 
 	services = append(services, initializer.NewService(options))
 
+	// Settings which can be changed at runtime are reloaded when the configuration file changes.
+	if options.ConfigWatcher != nil {
+		services = append(services, options.ConfigWatcher)
+	}
+
 	return &hosting.Host{
 		Services: services,
 	}, nil
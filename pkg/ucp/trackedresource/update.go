@@ -95,7 +95,16 @@ type trackedResourceState struct {
 }
 
 type trackedResourceStateProperties struct {
-	ProvisioningState *v1.ProvisioningState `json:"provisioningState,omitempty"`
+	ProvisioningState *v1.ProvisioningState       `json:"provisioningState,omitempty"`
+	Application       string                      `json:"application,omitempty"`
+	Environment       string                      `json:"environment,omitempty"`
+	Status            *trackedResourceStateStatus `json:"status,omitempty"`
+}
+
+type trackedResourceStateStatus struct {
+	OutputResources []struct {
+		ID string `json:"id"`
+	} `json:"outputResources,omitempty"`
 }
 
 // Update updates a tracked resource.
@@ -201,6 +210,17 @@ func (u *Updater) run(ctx context.Context, id resources.ID, trackingID resources
 		entry.AsyncProvisioningState = *data.Properties.ProvisioningState
 	}
 
+	// Keep the application, environment and output resources of the resource so that the usage of quotas can be
+	// computed from the tracked resource entries.
+	entry.Properties.Application = data.Properties.Application
+	entry.Properties.Environment = data.Properties.Environment
+	entry.Properties.OutputResources = nil
+	if data.Properties.Status != nil {
+		for _, outputResource := range data.Properties.Status.OutputResources {
+			entry.Properties.OutputResources = append(entry.Properties.OutputResources, outputResource.ID)
+		}
+	}
+
 	obj = &database.Object{
 		Metadata: database.Metadata{
 			ID: trackingID.String(),
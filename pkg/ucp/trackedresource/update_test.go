@@ -255,10 +255,18 @@ func Test_run(t *testing.T) {
 		updater, databaseClient, roundTripper := setupUpdater(t)
 
 		resource := map[string]any{
-			"id":         testID.String(),
-			"name":       testID.Name(),
-			"type":       testID.Type(),
-			"properties": map[string]any{},
+			"id":   testID.String(),
+			"name": testID.Name(),
+			"type": testID.Type(),
+			"properties": map[string]any{
+				"application": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app",
+				"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+				"status": map[string]any{
+					"outputResources": []any{
+						map[string]any{"id": "/planes/aws/aws/accounts/123/regions/us-east-1/providers/AWS.S3/Bucket/test-bucket"},
+					},
+				},
+			},
 		}
 
 		etag := "some-etag"
@@ -282,6 +290,9 @@ func Test_run(t *testing.T) {
 				require.Equal(t, IDFor(testID).String(), dm.ID)
 				require.Equal(t, testID.String(), dm.Properties.ID)
 				require.Equal(t, apiVersion, dm.Properties.APIVersion)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app", dm.Properties.Application)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env", dm.Properties.Environment)
+				require.Equal(t, []string{"/planes/aws/aws/accounts/123/regions/us-east-1/providers/AWS.S3/Bucket/test-bucket"}, dm.Properties.OutputResources)
 				return nil
 			}).
 			Times(1)
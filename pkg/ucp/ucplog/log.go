@@ -69,9 +69,17 @@ const (
 	DefaultLoggerProfile        = LoggerProfileDev
 )
 
+var (
+	// level is the level of the loggers created by NewLogger.
+	level = zap.NewAtomicLevel()
+
+	// profileLevel is the default level of the logger profile, used when no level is specified.
+	profileLevel = zap.NewAtomicLevel()
+)
+
 func initLoggingConfig(options *LoggingOptions) (*zap.Logger, error) {
 	var cfg zap.Config
-	var loggerProfile, loggerLevel string
+	var loggerProfile string
 
 	// Define the logger profile and level based on the logger profile specified by RADIUS_LOGGING_JSON env variable or config files.
 	// env variable takes precedence over config file settings.
@@ -94,27 +102,15 @@ func initLoggingConfig(options *LoggingOptions) (*zap.Logger, error) {
 
 	// Modify the default log level intialized by the profile preset if a custom value
 	// is specified by config file or the "RADIUS_LOGGING_LEVEL" env variable. env variable takes precedence over config file settings.
-	var logLevel int
-	loggerLevel = options.Level
-	logLevelFromEnv := os.Getenv(LogLevel)
-	if logLevelFromEnv != "" {
-		loggerLevel = logLevelFromEnv
+	profileLevel.SetLevel(cfg.Level.Level())
+	logLevel, err := resolveLevel(options)
+	if err != nil {
+		return nil, err
 	}
+	level.SetLevel(logLevel)
 
-	if loggerLevel != "" {
-		if strings.EqualFold(VerbosityLevelDebug, loggerLevel) {
-			logLevel = int(zapcore.DebugLevel)
-		} else if strings.EqualFold(VerbosityLevelInfo, loggerLevel) {
-			logLevel = int(zapcore.InfoLevel)
-		} else if strings.EqualFold(VerbosityLevelWarn, loggerLevel) {
-			logLevel = int(zapcore.WarnLevel)
-		} else if strings.EqualFold(VerbosityLevelError, loggerLevel) {
-			logLevel = int(zapcore.ErrorLevel)
-		} else {
-			return nil, fmt.Errorf("invalid Radius Logger Level set. Valid options are: %s, %s, %s, %s", VerbosityLevelError, VerbosityLevelWarn, VerbosityLevelInfo, VerbosityLevelDebug)
-		}
-		cfg.Level = zap.NewAtomicLevelAt(zapcore.Level(logLevel))
-	}
+	// All loggers share the same level so that SetLevel can change the level of the loggers of a running service.
+	cfg.Level = level
 
 	cfg.EncoderConfig.NameKey = "name"
 	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
@@ -131,6 +127,45 @@ func initLoggingConfig(options *LoggingOptions) (*zap.Logger, error) {
 	return logger, nil
 }
 
+// resolveLevel returns the log level specified by the "RADIUS_LOGGING_LEVEL" env variable or the logging options, or the
+// default level of the logger profile if neither is specified.
+func resolveLevel(options *LoggingOptions) (zapcore.Level, error) {
+	loggerLevel := options.Level
+	logLevelFromEnv := os.Getenv(LogLevel)
+	if logLevelFromEnv != "" {
+		loggerLevel = logLevelFromEnv
+	}
+
+	if loggerLevel == "" {
+		return profileLevel.Level(), nil
+	}
+
+	if strings.EqualFold(VerbosityLevelDebug, loggerLevel) {
+		return zapcore.DebugLevel, nil
+	} else if strings.EqualFold(VerbosityLevelInfo, loggerLevel) {
+		return zapcore.InfoLevel, nil
+	} else if strings.EqualFold(VerbosityLevelWarn, loggerLevel) {
+		return zapcore.WarnLevel, nil
+	} else if strings.EqualFold(VerbosityLevelError, loggerLevel) {
+		return zapcore.ErrorLevel, nil
+	}
+
+	return zapcore.InfoLevel, fmt.Errorf("invalid Radius Logger Level set. Valid options are: %s, %s, %s, %s", VerbosityLevelError, VerbosityLevelWarn, VerbosityLevelInfo, VerbosityLevelDebug)
+}
+
+// SetLevel changes the level of the loggers created by NewLogger to the level of the given logging options, so that
+// the level can be changed while the service is running. The "RADIUS_LOGGING_LEVEL" env variable still takes
+// precedence over the logging options.
+func SetLevel(options *LoggingOptions) error {
+	logLevel, err := resolveLevel(options)
+	if err != nil {
+		return err
+	}
+
+	level.SetLevel(logLevel)
+	return nil
+}
+
 // NewLogger creates a new logger with zap logger implementation, with the given name and logging options,
 // and returns a function to flush the logs before the server exits.
 func NewLogger(name string, options *LoggingOptions) (logr.Logger, func(), error) {
@@ -83,6 +83,35 @@ func Test_LogConstants(t *testing.T) {
 	}
 }
 
+func Test_SetLevel(t *testing.T) {
+	t.Setenv(LogLevel, "")
+	t.Setenv(LogProfile, "")
+
+	logger, _, err := NewLogger("test", &LoggingOptions{Json: true, Level: VerbosityLevelWarn})
+	require.NoError(t, err)
+	require.False(t, logger.V(LevelInfo).Enabled())
+
+	err = SetLevel(&LoggingOptions{Level: VerbosityLevelDebug})
+	require.NoError(t, err)
+	require.True(t, logger.V(LevelDebug).Enabled())
+
+	// The default level of the production profile is info.
+	err = SetLevel(&LoggingOptions{})
+	require.NoError(t, err)
+	require.True(t, logger.V(LevelInfo).Enabled())
+	require.False(t, logger.V(LevelDebug).Enabled())
+
+	err = SetLevel(&LoggingOptions{Level: "verbose"})
+	require.Error(t, err)
+	require.True(t, logger.V(LevelInfo).Enabled())
+
+	// The env variable takes precedence over the logging options.
+	t.Setenv(LogLevel, VerbosityLevelError)
+	err = SetLevel(&LoggingOptions{Level: VerbosityLevelDebug})
+	require.NoError(t, err)
+	require.False(t, logger.V(LevelInfo).Enabled())
+}
+
 var _ zapcore.Core = (*testCore)(nil)
 
 type testCore struct {
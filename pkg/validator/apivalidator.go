@@ -89,7 +89,7 @@ func APIValidator(options Options) func(h http.Handler) http.Handler {
 			apiVersion := r.URL.Query().Get(APIVersionQueryKey)
 			v, ok := options.SpecLoader.GetValidator(resourceType, apiVersion)
 			if !ok {
-				resp := unsupportedAPIVersionResponse(apiVersion, resourceType, options.SpecLoader.SupportedVersions(resourceType))
+				resp := rest.NewUnsupportedAPIVersionResponse(apiVersion, resourceType, options.SpecLoader.SupportedVersions(resourceType))
 				if err := resp.Apply(r.Context(), w, r); err != nil {
 					handleError(r.Context(), w, err)
 				}
@@ -139,15 +139,6 @@ func invalidResourceIDResponse(id string) rest.Response {
 	})
 }
 
-func unsupportedAPIVersionResponse(apiVersion, resourceType string, supportedAPIVersions []string) rest.Response {
-	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
-		Error: &v1.ErrorDetails{
-			Code:    v1.CodeInvalidApiVersionParameter,
-			Message: fmt.Sprintf("API version '%s' for type '%s' is not supported. The supported api-versions are '%s'.", apiVersion, resourceType, strings.Join(supportedAPIVersions, ", ")),
-		},
-	})
-}
-
 func validationFailedResponse(qualifiedName string, valErrs []ValidationError) rest.Response {
 	errDetails := []*v1.ErrorDetails{}
 
@@ -216,6 +216,15 @@ func runTest(t *testing.T, resourceIDUrl, targetScope, planeRootScope string, pr
 				Error: &v1.ErrorDetails{
 					Code:    "InvalidApiVersionParameter",
 					Message: "API version '2022-06-20-privatepreview' for type 'applications.core/environments' is not supported. The supported api-versions are '2023-10-01-preview'.",
+					AdditionalInfo: []*v1.ErrorAdditionalInfo{
+						{
+							Type: v1.SupportedAPIVersionsInfoType,
+							Info: map[string]any{
+								"resourceType": "applications.core/environments",
+								"apiVersions":  []any{"2023-10-01-preview"},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -40,6 +40,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/setup"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -751,7 +752,7 @@ func Test_DevRecipes(t *testing.T) {
 	)
 	basicRunner.UpdateEnvironmentOptions(true, envName, envNamespace)
 	basicRunner.UpdateRecipePackOptions(true)
-	basicRunner.DevRecipeClient = radinit.NewDevRecipeClient()
+	basicRunner.DevRecipeClient = setup.NewDevRecipeClient()
 	basicRunner.Workspace = &workspaces.Workspace{
 		Name: envName,
 		Connection: map[string]any{
@@ -774,7 +775,7 @@ func Test_DevRecipes(t *testing.T) {
 		tag = "latest"
 	}
 
-	for _, devRecipe := range radinit.AvailableDevRecipes() {
+	for _, devRecipe := range setup.AvailableDevRecipes() {
 		require.Regexp(t, devRecipe.ResourceType, output)
 		require.Regexp(t, devRecipe.RepoPath+":"+tag, output)
 	}
@@ -85,18 +85,30 @@ type testClient struct {
 }
 
 // Patch implements client.Patch for apply patches. It checks if the patch type is Apply, then attempts to get
-// the object, create it if it doesn't exist, or update it if it does. If an error is encountered, it is returned.
+// the object, create it if it doesn't exist, or update it if it does. Dry-run patches leave the object unchanged.
+// If an error is encountered, it is returned.
 func (c *testClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
 	if patch.Type() != client.Apply.Type() {
 		return c.WithWatch.Patch(ctx, obj, patch, opts...)
 	}
 
+	patchOptions := &client.PatchOptions{}
+	patchOptions.ApplyOptions(opts)
+
 	// This is not exactly the same as the real implementation, but it's good enough for our tests.
 	existing := &unstructured.Unstructured{}
 	existing.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
 	err := c.WithWatch.Get(ctx, client.ObjectKey{Name: obj.GetName(), Namespace: obj.GetNamespace()}, existing)
 	if client.IgnoreNotFound(err) != nil {
 		return err
+	} else if len(patchOptions.DryRun) > 0 {
+		// A dry-run returns the applied object without persisting it.
+		if err == nil {
+			obj.SetResourceVersion(existing.GetResourceVersion())
+			obj.SetUID(existing.GetUID())
+			obj.SetCreationTimestamp(existing.GetCreationTimestamp())
+		}
+		return nil
 	} else if err != nil {
 		return c.WithWatch.Create(ctx, obj)
 	} else {
@@ -204,6 +204,66 @@ func RunTest(t *testing.T, cli queue.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, queue.ErrInvalidMessage)
 	})
 
+	t.Run("enqueue messages with deduplication key", func(t *testing.T) {
+		clear(t)
+
+		for i := 0; i < 2; i++ {
+			msg := &testQueueMessage{ID: fmt.Sprintf("%d", i), Message: fmt.Sprintf("hello world %d", i)}
+			err := cli.Enqueue(ctx, queue.NewMessage(msg), queue.WithDeduplicationKey("dedup-key"))
+			require.NoError(t, err)
+		}
+
+		msg1, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, "dedup-key", msg1.DeduplicationKey)
+
+		// Ensure that the duplicated message is not enqueued.
+		_, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+		err = cli.FinishMessage(ctx, msg1)
+		require.NoError(t, err)
+
+		// The deduplication key can be reused once the message is finished.
+		err = cli.Enqueue(ctx, queue.NewMessage(&testQueueMessage{ID: "2"}), queue.WithDeduplicationKey("dedup-key"))
+		require.NoError(t, err)
+
+		msg2, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, "dedup-key", msg2.DeduplicationKey)
+	})
+
+	t.Run("finish message leased by the other client", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg1, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+
+		// Dequeue until message is requeued.
+		var msg2 *queue.Message
+		for {
+			msg2, err = cli.Dequeue(ctx, queue.QueueClientConfig{})
+			if err == nil {
+				break
+			}
+			time.Sleep(pollingInterval)
+		}
+		require.Equal(t, msg1.ID, msg2.ID)
+
+		err = cli.FinishMessage(ctx, msg1)
+		require.ErrorIs(t, err, queue.ErrDequeuedMessage)
+
+		err = cli.FinishMessage(ctx, msg2)
+		require.NoError(t, err)
+
+		// Finishing the message again must fail.
+		err = cli.FinishMessage(ctx, msg2)
+		require.ErrorIs(t, err, queue.ErrInvalidMessage)
+	})
+
 	t.Run("StartDequeuer dequeues message via channel", func(t *testing.T) {
 		clear(t)
 		msgCh, err := queue.StartDequeuer(ctx, cli, queue.WithDequeueInterval(defaultTestDequeueInterval))
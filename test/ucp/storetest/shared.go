@@ -436,6 +436,29 @@ func RunTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
 			CompareObjectLists(t, expected, objs.Items)
 		})
 
+		t.Run("query_resources_at_resource_group_scope_with_pagination", func(t *testing.T) {
+			actual := []database.Object{}
+			token := ""
+			for {
+				objs, err := client.Query(ctx, database.Query{RootScope: ResourceGroup1Scope, ResourceType: NestedResourceType1}, database.WithMaxQueryItemCount(3), database.WithPaginationToken(token))
+				require.NoError(t, err)
+				actual = append(actual, objs.Items...)
+
+				token = objs.PaginationToken
+				if token == "" {
+					break
+				}
+			}
+
+			expected := []database.Object{
+				nested1,
+				nested2,
+				nested3,
+				nested4,
+			}
+			CompareObjectLists(t, expected, actual)
+		})
+
 		t.Run("query_resources_at_resource_group_scope_with_field_filter", func(t *testing.T) {
 			filters := []database.QueryFilter{{Field: "value", Value: "n1"}}
 			objs, err := client.Query(ctx, database.Query{RootScope: ResourceGroup1Scope, ResourceType: NestedResourceType1, Filters: filters})